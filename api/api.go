@@ -0,0 +1,114 @@
+// Package api selects the generated package of objects matching the Restd version of a gateway.
+//
+// Generated objects and nodes packages are kept side by side in api/v<Restd> and listed in Versions
+// by bin/gen.go.
+// Their objects are used through the sophos interfaces (sophos.Endpoint, sophos.RestGetter,
+// sophos.Creatable...), so one codebase can manage gateways of different versions:
+//
+//	a, err := api.Detect(client)
+//	o, err := a.NewObject("network/host")
+//	err = client.GetObject(o)
+//	n, err := a.Node("packetfilter.rules")
+//	err = n.Get(client)
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/esurdam/go-sophos"
+)
+
+// A Version is a generated package of objects
+type Version struct {
+	// Restd is the API version of the package, e.g. 1.3.0
+	Restd string
+	// Schema is the metadata of the definitions of the package
+	Schema *sophos.Schema
+	// Endpoints returns all sophos.Endpoint(s) of the package
+	Endpoints func() []sophos.Endpoint
+	// NewObject returns a new object of the type (e.g. network/host) or false if it is unknown
+	NewObject func(objType string) (sophos.RestGetter, bool)
+	// LookupNode returns the node of the nodes package by name (e.g. packetfilter.rules) or nil
+	LookupNode func(name string) sophos.Node
+}
+
+// Warnf reports that a gateway is managed with the package of another version. It does nothing by
+// default, set it to e.g. log.Printf to print the warnings, they are also kept in API.Warning.
+var Warnf = func(format string, a ...interface{}) {}
+
+// Select returns the Version of the Restd version. If there is none, the Version chosen by
+// sophos.ResolveVersion is returned with exact false.
+func Select(restd string) (v Version, exact bool, err error) {
+	versions := make([]string, len(Versions))
+	for i, c := range Versions {
+		versions[i] = c.Restd
+	}
+	r, ok := sophos.ResolveVersion(restd, versions)
+	if !ok {
+		return v, false, fmt.Errorf("api: no generated package compatible with restd %s", restd)
+	}
+	for _, c := range Versions {
+		if c.Restd == r {
+			v = c
+		}
+	}
+	return v, r == restd, nil
+}
+
+// An API is the Version selected for a gateway
+type API struct {
+	Version
+	// Gateway is the version of the gateway
+	Gateway sophos.Version
+	// Warning is set if the Version is not the Restd version of the gateway
+	Warning string
+
+	client *sophos.Client
+}
+
+// Detect pings the gateway of the Client and returns the API of its Restd version, see Select
+func Detect(c *sophos.Client, options ...sophos.Option) (*API, error) {
+	gv, err := c.Ping(options...)
+	if err != nil {
+		return nil, fmt.Errorf("api: %s", err.Error())
+	}
+	v, exact, err := Select(gv.Restd)
+	if err != nil {
+		return nil, err
+	}
+	a := &API{Version: v, Gateway: *gv, client: c}
+	if !exact {
+		a.Warning = fmt.Sprintf("api: no generated package for restd %s, using %s", gv.Restd, v.Restd)
+		Warnf("%s", a.Warning)
+	}
+	return a, nil
+}
+
+// NewObject returns a new object of the type of the selected Version, e.g. network/host
+func (a *API) NewObject(objType string) (sophos.RestGetter, error) {
+	o, ok := a.Version.NewObject(objType)
+	if !ok {
+		return nil, fmt.Errorf("api: unknown object type %s in restd %s", objType, a.Restd)
+	}
+	return o, nil
+}
+
+// Node returns the node of the selected Version by name, e.g. packetfilter.rules
+func (a *API) Node(name string) (sophos.Node, error) {
+	n := a.LookupNode(name)
+	if n == nil {
+		return nil, fmt.Errorf("api: unknown node %s in restd %s", name, a.Restd)
+	}
+	return n, nil
+}
+
+// CheckCompatibility compares the definitions of the gateway with the Schema of the selected Version,
+// see sophos.Client.CheckCompatibility
+func (a *API) CheckCompatibility(ctx context.Context, options ...sophos.Option) (*sophos.Compatibility, error) {
+	live, err := a.client.FetchSchema(append(options, sophos.WithContext(ctx))...)
+	if err != nil {
+		return nil, fmt.Errorf("api: %s", err.Error())
+	}
+	return a.Schema.Compare(live), nil
+}
@@ -0,0 +1,82 @@
+package api_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api"
+	"github.com/esurdam/go-sophos/api/v1.3.0/nodes"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+)
+
+func TestSelect(t *testing.T) {
+	defer func(vv []api.Version) { api.Versions = vv }(api.Versions)
+	api.Versions = []api.Version{{Restd: "1.2.0"}, {Restd: "1.3.0"}, {Restd: "1.10.1"}, {Restd: "2.0.0"}}
+
+	tests := []struct {
+		restd, want string
+		exact, err  bool
+	}{
+		{"1.3.0", "1.3.0", true, false},
+		{"1.9.0", "1.3.0", false, false},
+		{"1.11.0", "1.10.1", false, false},
+		{"1.1.0", "1.2.0", false, false},
+		{"2.1.0", "2.0.0", false, false},
+		{"3.0.0", "", false, true},
+	}
+	for _, tt := range tests {
+		v, exact, err := api.Select(tt.restd)
+		if v.Restd != tt.want || exact != tt.exact || (err != nil) != tt.err {
+			t.Errorf("%s: got %s %v %v, want %s %v", tt.restd, v.Restd, exact, err, tt.want, tt.exact)
+		}
+	}
+}
+
+func TestDetect(t *testing.T) {
+	restd := "1.3.2"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(sophos.Version{UTM: "9.510-5", Restd: restd})
+	}))
+	defer ts.Close()
+	sophos.DefaultHTTPClient = ts.Client()
+	c, _ := sophos.New(ts.URL)
+
+	var warnings []string
+	defer func(f func(string, ...interface{})) { api.Warnf = f }(api.Warnf)
+	api.Warnf = func(format string, a ...interface{}) { warnings = append(warnings, fmt.Sprintf(format, a...)) }
+
+	a, err := api.Detect(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "api: no generated package for restd 1.3.2, using 1.3.0"
+	if a.Restd != "1.3.0" || a.Gateway.UTM != "9.510-5" || a.Warning != want || len(warnings) != 1 || warnings[0] != want {
+		t.Errorf("unexpected API %+v, warnings %v", a, warnings)
+	}
+	o, err := a.NewObject("network/host")
+	if h, ok := o.(*objects.NetworkHost); err != nil || !ok || h.ObjectType != "network/host" {
+		t.Errorf("unexpected object %#v, %v", o, err)
+	}
+	if _, err := a.NewObject("network/unknown"); err == nil {
+		t.Error("wanted error for unknown type")
+	}
+	if len(a.Endpoints()) == 0 || a.Schema != objects.Schema {
+		t.Error("unexpected Version")
+	}
+	n, err := a.Node("packetfilter.rules")
+	if _, ok := n.(*nodes.PacketfilterRules); err != nil || !ok {
+		t.Errorf("unexpected node %#v, %v", n, err)
+	}
+	if _, err := a.Node("packetfilter.unknown"); err == nil {
+		t.Error("wanted error for unknown node")
+	}
+
+	restd, warnings = "1.3.0", nil
+	if a, err = api.Detect(c); err != nil || a.Warning != "" || len(warnings) != 0 {
+		t.Errorf("unexpected warning %q %v", a.Warning, err)
+	}
+}
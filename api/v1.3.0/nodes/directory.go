@@ -6,713 +6,713 @@ import "github.com/esurdam/go-sophos"
 func Lookup(name string) sophos.Node { return nodeDirectory[name] }
 
 var nodeDirectory = map[string]sophos.Node{
-	"acc.server1.auth.secret":                               &AccServer1AuthSecret{},
-	"acc.server1.auth.status":                               &AccServer1AuthStatus{},
-	"acc.server1.port":                                      &AccServer1Port{},
-	"acc.server1.roles":                                     &AccServer1Roles{},
-	"acc.server1.server":                                    &AccServer1Server{},
-	"acc.server2.auth.secret":                               &AccServer2AuthSecret{},
-	"acc.server2.auth.status":                               &AccServer2AuthStatus{},
-	"acc.server2.port":                                      &AccServer2Port{},
-	"acc.server2.roles":                                     &AccServer2Roles{},
-	"acc.server2.server":                                    &AccServer2Server{},
-	"acc.server2.status":                                    &AccServer2Status{},
-	"acc.sso_admin_group":                                   &AccSsoAdminGroup{},
-	"acc.sso_auditor_group":                                 &AccSsoAuditorGroup{},
-	"acc.status":                                            &AccStatus{},
-	"accd.access.allowed_admins":                            &AccdAccessAllowedAdmins{},
-	"accd.access.allowed_networks":                          &AccdAccessAllowedNetworks{},
-	"accd.access.allowed_users":                             &AccdAccessAllowedUsers{},
-	"accd.access.cert":                                      &AccdAccessCert{},
-	"accd.access.port":                                      &AccdAccessPort{},
-	"accd.devices.allowed_networks":                         &AccdDevicesAllowedNetworks{},
-	"accd.devices.auth.auto":                                &AccdDevicesAuthAuto{},
-	"accd.devices.auth.secret":                              &AccdDevicesAuthSecret{},
-	"accd.devices.auth.status":                              &AccdDevicesAuthStatus{},
-	"accd.devices.cert":                                     &AccdDevicesCert{},
-	"accd.devices.port":                                     &AccdDevicesPort{},
-	"accd.general.allowed_networks":                         &AccdGeneralAllowedNetworks{},
-	"accd.general.cert":                                     &AccdGeneralCert{},
-	"accd.general.language":                                 &AccdGeneralLanguage{},
-	"accd.general.port":                                     &AccdGeneralPort{},
-	"accd.general.timeout":                                  &AccdGeneralTimeout{},
-	"accounting.ipfix.connections":                          &AccountingIpfixConnections{},
-	"accounting.ipfix.status":                               &AccountingIpfixStatus{},
-	"afc.controlled_networks":                               &AfcControlledNetworks{},
-	"afc.hidden_skip":                                       &AfcHiddenSkip{},
-	"afc.http_redirect_url":                                 &AfcHttpRedirectUrl{},
-	"afc.log":                                               &AfcLog{},
-	"afc.nfqueue_length":                                    &AfcNfqueueLength{},
-	"afc.num_queues":                                        &AfcNumQueues{},
-	"afc.rules":                                             &AfcRules{},
-	"afc.status":                                            &AfcStatus{},
-	"afc.submit_unknown_traffic_data":                       &AfcSubmitUnknownTrafficData{},
-	"afc.transparent_skip":                                  &AfcTransparentSkip{},
-	"amazon_vpc.auto_pfrule":                                &AmazonVpcAutoPfrule{},
-	"amazon_vpc.connections":                                &AmazonVpcConnections{},
-	"amazon_vpc.networks":                                   &AmazonVpcNetworks{},
-	"amazon_vpc.status":                                     &AmazonVpcStatus{},
-	"aptp.policy":                                           &AptpPolicy{},
-	"aptp.rule_modifiers":                                   &AptpRuleModifiers{},
-	"aptp.status":                                           &AptpStatus{},
-	"aptp.transparent_skip":                                 &AptpTransparentSkip{},
-	"arm.licensed_ip":                                       &ArmLicensedIp{},
-	"arm.remote.host":                                       &ArmRemoteHost{},
-	"arm.remote.method":                                     &ArmRemoteMethod{},
-	"arm.remote.smb_password":                               &ArmRemoteSmbPassword{},
-	"arm.remote.smb_share":                                  &ArmRemoteSmbShare{},
-	"arm.remote.smb_user":                                   &ArmRemoteSmbUser{},
-	"arm.remote.status":                                     &ArmRemoteStatus{},
-	"arm.remote.syslog_service":                             &ArmRemoteSyslogService{},
-	"arm.status":                                            &ArmStatus{},
-	"auth.ad_sso.force_utf8_sync":                           &AuthAdSsoForceUtf8Sync{},
-	"auth.ad_sso.joinresult":                                &AuthAdSsoJoinresult{},
-	"auth.ad_sso.loadbalancer_fqdn":                         &AuthAdSsoLoadbalancerFqdn{},
-	"auth.ad_sso.ntlmv2_auth":                               &AuthAdSsoNtlmv2Auth{},
-	"auth.ad_sso.secrets":                                   &AuthAdSsoSecrets{},
-	"auth.ad_sso.smbconf":                                   &AuthAdSsoSmbconf{},
-	"auth.ad_sso.sso_domain":                                &AuthAdSsoSsoDomain{},
-	"auth.ad_sso.sso_netbios_domain":                        &AuthAdSsoSsoNetbiosDomain{},
-	"auth.ad_sso.sso_netbios_host":                          &AuthAdSsoSsoNetbiosHost{},
-	"auth.ad_sso.sso_password":                              &AuthAdSsoSsoPassword{},
-	"auth.ad_sso.sso_server":                                &AuthAdSsoSsoServer{},
-	"auth.ad_sso.sso_status":                                &AuthAdSsoSsoStatus{},
-	"auth.ad_sso.sso_sync":                                  &AuthAdSsoSsoSync{},
-	"auth.ad_sso.sso_username":                              &AuthAdSsoSsoUsername{},
-	"auth.api_tokens":                                       &AuthApiTokens{},
-	"auth.auto_add_to_facility":                             &AuthAutoAddToFacility{},
-	"auth.auto_add_users":                                   &AuthAutoAddUsers{},
-	"auth.block.attempts":                                   &AuthBlockAttempts{},
-	"auth.block.facilities":                                 &AuthBlockFacilities{},
-	"auth.block.lockout":                                    &AuthBlockLockout{},
-	"auth.block.never":                                      &AuthBlockNever{},
-	"auth.block.seconds":                                    &AuthBlockSeconds{},
-	"auth.cache_lifetime":                                   &AuthCacheLifetime{},
-	"auth.edir_sso.em_conflict":                             &AuthEdirSsoEmConflict{},
-	"auth.edir_sso.em_socket_timeout":                       &AuthEdirSsoEmSocketTimeout{},
-	"auth.edir_sso.em_verify_logout":                        &AuthEdirSsoEmVerifyLogout{},
-	"auth.edir_sso.sso_aua_search_ip":                       &AuthEdirSsoSsoAuaSearchIp{},
-	"auth.edir_sso.sso_mode":                                &AuthEdirSsoSsoMode{},
-	"auth.edir_sso.sso_server":                              &AuthEdirSsoSsoServer{},
-	"auth.edir_sso.sync_interval":                           &AuthEdirSsoSyncInterval{},
-	"auth.otp.auto_create_token":                            &AuthOtpAutoCreateToken{},
-	"auth.otp.auto_token_digest":                            &AuthOtpAutoTokenDigest{},
-	"auth.otp.default_timestep":                             &AuthOtpDefaultTimestep{},
-	"auth.otp.facilities":                                   &AuthOtpFacilities{},
-	"auth.otp.max_init_timestep_diff":                       &AuthOtpMaxInitTimestepDiff{},
-	"auth.otp.max_timestep_diff":                            &AuthOtpMaxTimestepDiff{},
-	"auth.otp.require_all_users":                            &AuthOtpRequireAllUsers{},
-	"auth.otp.required_users":                               &AuthOtpRequiredUsers{},
-	"auth.otp.status":                                       &AuthOtpStatus{},
-	"auth.servers":                                          &AuthServers{},
-	"auth.update_backend_group_members.debug":               &AuthUpdateBackendGroupMembersDebug{},
-	"auth.update_backend_group_members.status":              &AuthUpdateBackendGroupMembersStatus{},
-	"awe.allowed_interfaces":                                &AweAllowedInterfaces{},
-	"awe.clients":                                           &AweClients{},
-	"awe.devices":                                           &AweDevices{},
-	"awe.global.ap_autoaccept":                              &AweGlobalApAutoaccept{},
-	"awe.global.ap_debuglevel":                              &AweGlobalApDebuglevel{},
-	"awe.global.ap_softlimit":                               &AweGlobalApSoftlimit{},
-	"awe.global.ap_vlantag":                                 &AweGlobalApVlantag{},
-	"awe.global.awe_status":                                 &AweGlobalAweStatus{},
-	"awe.global.bridge_update_kickout":                      &AweGlobalBridgeUpdateKickout{},
-	"awe.global.initial_setup":                              &AweGlobalInitialSetup{},
-	"awe.global.log_level":                                  &AweGlobalLogLevel{},
-	"awe.global.magic_ip":                                   &AweGlobalMagicIp{},
-	"awe.global.notification_timeout":                       &AweGlobalNotificationTimeout{},
-	"awe.global.radius_conf":                                &AweGlobalRadiusConf{},
-	"awe.global.rootpw":                                     &AweGlobalRootpw{},
-	"awe.global.stay_online":                                &AweGlobalStayOnline{},
-	"awe.global.store_bss_stats":                            &AweGlobalStoreBssStats{},
-	"awe.global.tunnel_id_offset":                           &AweGlobalTunnelIdOffset{},
-	"awe.global.vlantagging":                                &AweGlobalVlantagging{},
-	"awe.networks":                                          &AweNetworks{},
-	"awscli.profiles":                                       &AwscliProfiles{},
-	"backup.encryption":                                     &BackupEncryption{},
-	"backup.interval":                                       &BackupInterval{},
-	"backup.max_backups":                                    &BackupMaxBackups{},
-	"backup.password":                                       &BackupPassword{},
-	"backup.recipients":                                     &BackupRecipients{},
-	"backup.status":                                         &BackupStatus{},
-	"ca.ca_gost":                                            &CaCaGost{},
-	"ca.ca_ipsec":                                           &CaCaIpsec{},
-	"ca.ca_proxies":                                         &CaCaProxies{},
-	"ca.ca_red":                                             &CaCaRed{},
-	"ca.def_keysize":                                        &CaDefKeysize{},
-	"ca.global_cas.email_encryption.trust_new_cas":          &CaGlobalCasEmailEncryptionTrustNewCas{},
-	"ca.global_cas.email_encryption.trusted":                &CaGlobalCasEmailEncryptionTrusted{},
-	"ca.global_cas.email_encryption.untrusted":              &CaGlobalCasEmailEncryptionUntrusted{},
-	"ca.global_cas.http_proxy.trust_new_cas":                &CaGlobalCasHttpProxyTrustNewCas{},
-	"ca.global_cas.http_proxy.trusted":                      &CaGlobalCasHttpProxyTrusted{},
-	"ca.global_cas.http_proxy.untrusted":                    &CaGlobalCasHttpProxyUntrusted{},
-	"crls.crls":                                             &CrlsCrls{},
-	"css.av_primary_engine":                                 &CssAvPrimaryEngine{},
-	"css.sxl_liveprotection":                                &CssSxlLiveprotection{},
-	"css.sxl_sample_submit":                                 &CssSxlSampleSubmit{},
-	"customization.epp.last_updated":                        &CustomizationEppLastUpdated{},
-	"customization.epp.resources_root":                      &CustomizationEppResourcesRoot{},
-	"customization.http.custom_assets":                      &CustomizationHttpCustomAssets{},
-	"customization.http.custom_templates":                   &CustomizationHttpCustomTemplates{},
-	"customization.http.last_updated":                       &CustomizationHttpLastUpdated{},
-	"debugmode.crash_report":                                &DebugmodeCrashReport{},
-	"debugmode.enabled":                                     &DebugmodeEnabled{},
-	"dhcp.relay.dhcp_server":                                &DhcpRelayDhcpServer{},
-	"dhcp.relay.interfaces":                                 &DhcpRelayInterfaces{},
-	"dhcp.relay.status":                                     &DhcpRelayStatus{},
-	"dhcp.relay6.itfs_facing_clients":                       &DhcpRelay6ItfsFacingClients{},
-	"dhcp.relay6.itfs_facing_server6":                       &DhcpRelay6ItfsFacingServer6{},
-	"dhcp.relay6.status":                                    &DhcpRelay6Status{},
-	"dhcp.server.custom4":                                   &DhcpServerCustom4{},
-	"dhcp.server.custom6":                                   &DhcpServerCustom6{},
-	"dhcp.server.servers":                                   &DhcpServerServers{},
-	"digest.allowed_networks":                               &DigestAllowedNetworks{},
-	"digest.custom_text":                                    &DigestCustomText{},
-	"digest.domains":                                        &DigestDomains{},
-	"digest.hostname":                                       &DigestHostname{},
-	"digest.mailinglists":                                   &DigestMailinglists{},
-	"digest.port":                                           &DigestPort{},
-	"digest.send_time_one":                                  &DigestSendTimeOne{},
-	"digest.send_time_two":                                  &DigestSendTimeTwo{},
-	"digest.skiplist":                                       &DigestSkiplist{},
-	"digest.status":                                         &DigestStatus{},
-	"digest.user_release":                                   &DigestUserRelease{},
-	"dns.allowed_networks":                                  &DnsAllowedNetworks{},
-	"dns.axfr":                                              &DnsAxfr{},
-	"dns.dnssec":                                            &DnsDnssec{},
-	"dns.email":                                             &DnsEmail{},
-	"dns.empty_zones":                                       &DnsEmptyZones{},
-	"dns.fwd_dynamic":                                       &DnsFwdDynamic{},
-	"dns.fwd_static":                                        &DnsFwdStatic{},
-	"dns.recheck_interval":                                  &DnsRecheckInterval{},
-	"dns.routes":                                            &DnsRoutes{},
-	"dyndns.rules":                                          &DyndnsRules{},
-	"emailpki.authority.cert":                               &EmailpkiAuthorityCert{},
-	"emailpki.authority.fingerprint":                        &EmailpkiAuthorityFingerprint{},
-	"emailpki.authority.key":                                &EmailpkiAuthorityKey{},
-	"emailpki.authority.postmaster_fingerprint":             &EmailpkiAuthorityPostmasterFingerprint{},
-	"emailpki.authority.postmaster_privkey":                 &EmailpkiAuthorityPostmasterPrivkey{},
-	"emailpki.authority.postmaster_pubkey":                  &EmailpkiAuthorityPostmasterPubkey{},
-	"emailpki.global.city":                                  &EmailpkiGlobalCity{},
-	"emailpki.global.country":                               &EmailpkiGlobalCountry{},
-	"emailpki.global.organization":                          &EmailpkiGlobalOrganization{},
-	"emailpki.global.postmaster":                            &EmailpkiGlobalPostmaster{},
-	"emailpki.global.status":                                &EmailpkiGlobalStatus{},
-	"emailpki.objects.cas":                                  &EmailpkiObjectsCas{},
-	"emailpki.objects.openpgp":                              &EmailpkiObjectsOpenpgp{},
-	"emailpki.objects.smime":                                &EmailpkiObjectsSmime{},
-	"emailpki.objects.users":                                &EmailpkiObjectsUsers{},
-	"emailpki.openpgp.main_keysize":                         &EmailpkiOpenpgpMainKeysize{},
-	"emailpki.openpgp.sub_keysize":                          &EmailpkiOpenpgpSubKeysize{},
-	"emailpki.options.external_auto":                        &EmailpkiOptionsExternalAuto{},
-	"emailpki.options.keyserver":                            &EmailpkiOptionsKeyserver{},
-	"emailpki.options.policy_decryption":                    &EmailpkiOptionsPolicyDecryption{},
-	"emailpki.options.policy_encryption":                    &EmailpkiOptionsPolicyEncryption{},
-	"emailpki.options.policy_sign":                          &EmailpkiOptionsPolicySign{},
-	"emailpki.options.policy_verify":                        &EmailpkiOptionsPolicyVerify{},
-	"endpoint.aac.allowed_networks":                         &EndpointAacAllowedNetworks{},
-	"endpoint.aac.allowed_users":                            &EndpointAacAllowedUsers{},
-	"endpoint.aac.ca":                                       &EndpointAacCa{},
-	"endpoint.aac.cert":                                     &EndpointAacCert{},
-	"endpoint.aac.magic_ip":                                 &EndpointAacMagicIp{},
-	"endpoint.aac.max_user_logins":                          &EndpointAacMaxUserLogins{},
-	"endpoint.aac.status":                                   &EndpointAacStatus{},
-	"endpoint.stas.collectors":                              &EndpointStasCollectors{},
-	"endpoint.stas.status":                                  &EndpointStasStatus{},
-	"enduser_messages.company_logo":                         &EnduserMessagesCompanyLogo{},
-	"enduser_messages.company_text":                         &EnduserMessagesCompanyText{},
-	"enduser_messages.dlp.blackhole_part":                   &EnduserMessagesDlpBlackholePart{},
-	"enduser_messages.dlp.footer_part":                      &EnduserMessagesDlpFooterPart{},
-	"enduser_messages.dlp.header_part":                      &EnduserMessagesDlpHeaderPart{},
-	"enduser_messages.dlp.original_part":                    &EnduserMessagesDlpOriginalPart{},
-	"enduser_messages.dlp.spx_part":                         &EnduserMessagesDlpSpxPart{},
-	"enduser_messages.dlp.subject":                          &EnduserMessagesDlpSubject{},
-	"enduser_messages.http.app_desc":                        &EnduserMessagesHttpAppDesc{},
-	"enduser_messages.http.app_subject":                     &EnduserMessagesHttpAppSubject{},
-	"enduser_messages.http.blacklist_desc":                  &EnduserMessagesHttpBlacklistDesc{},
-	"enduser_messages.http.blacklist_subject":               &EnduserMessagesHttpBlacklistSubject{},
-	"enduser_messages.http.certfail_subject":                &EnduserMessagesHttpCertfailSubject{},
-	"enduser_messages.http.cff_override_desc":               &EnduserMessagesHttpCffOverrideDesc{},
-	"enduser_messages.http.cff_override_subject":            &EnduserMessagesHttpCffOverrideSubject{},
-	"enduser_messages.http.cff_override_terms":              &EnduserMessagesHttpCffOverrideTerms{},
-	"enduser_messages.http.download_complete_desc":          &EnduserMessagesHttpDownloadCompleteDesc{},
-	"enduser_messages.http.download_complete_subject":       &EnduserMessagesHttpDownloadCompleteSubject{},
-	"enduser_messages.http.download_desc":                   &EnduserMessagesHttpDownloadDesc{},
-	"enduser_messages.http.download_subject":                &EnduserMessagesHttpDownloadSubject{},
-	"enduser_messages.http.error_desc":                      &EnduserMessagesHttpErrorDesc{},
-	"enduser_messages.http.error_subject":                   &EnduserMessagesHttpErrorSubject{},
-	"enduser_messages.http.fileextension_desc":              &EnduserMessagesHttpFileextensionDesc{},
-	"enduser_messages.http.fileextension_subject":           &EnduserMessagesHttpFileextensionSubject{},
-	"enduser_messages.http.fileextension_warn_desc":         &EnduserMessagesHttpFileextensionWarnDesc{},
-	"enduser_messages.http.fileextension_warn_subject":      &EnduserMessagesHttpFileextensionWarnSubject{},
-	"enduser_messages.http.filesize_desc":                   &EnduserMessagesHttpFilesizeDesc{},
-	"enduser_messages.http.filesize_subject":                &EnduserMessagesHttpFilesizeSubject{},
-	"enduser_messages.http.geoip_desc":                      &EnduserMessagesHttpGeoipDesc{},
-	"enduser_messages.http.geoip_subject":                   &EnduserMessagesHttpGeoipSubject{},
-	"enduser_messages.http.mimetype_desc":                   &EnduserMessagesHttpMimetypeDesc{},
-	"enduser_messages.http.mimetype_subject":                &EnduserMessagesHttpMimetypeSubject{},
-	"enduser_messages.http.mimetype_warn_desc":              &EnduserMessagesHttpMimetypeWarnDesc{},
-	"enduser_messages.http.mimetype_warn_subject":           &EnduserMessagesHttpMimetypeWarnSubject{},
-	"enduser_messages.http.pua_desc":                        &EnduserMessagesHttpPuaDesc{},
-	"enduser_messages.http.pua_subject":                     &EnduserMessagesHttpPuaSubject{},
-	"enduser_messages.http.quota_block_desc":                &EnduserMessagesHttpQuotaBlockDesc{},
-	"enduser_messages.http.quota_block_subject":             &EnduserMessagesHttpQuotaBlockSubject{},
-	"enduser_messages.http.quota_warn_desc":                 &EnduserMessagesHttpQuotaWarnDesc{},
-	"enduser_messages.http.quota_warn_subject":              &EnduserMessagesHttpQuotaWarnSubject{},
-	"enduser_messages.http.sp_desc":                         &EnduserMessagesHttpSpDesc{},
-	"enduser_messages.http.sp_frame_subject":                &EnduserMessagesHttpSpFrameSubject{},
-	"enduser_messages.http.sp_subject":                      &EnduserMessagesHttpSpSubject{},
-	"enduser_messages.http.sp_warn_desc":                    &EnduserMessagesHttpSpWarnDesc{},
-	"enduser_messages.http.sp_warn_subject":                 &EnduserMessagesHttpSpWarnSubject{},
-	"enduser_messages.http.ssl_certraw":                     &EnduserMessagesHttpSslCertraw{},
-	"enduser_messages.http.ssl_certstatus":                  &EnduserMessagesHttpSslCertstatus{},
-	"enduser_messages.http.ssl_issuer":                      &EnduserMessagesHttpSslIssuer{},
-	"enduser_messages.http.ssl_md5fp":                       &EnduserMessagesHttpSslMd5Fp{},
-	"enduser_messages.http.ssl_sha1fp":                      &EnduserMessagesHttpSslSha1Fp{},
-	"enduser_messages.http.ssl_subject":                     &EnduserMessagesHttpSslSubject{},
-	"enduser_messages.http.ssl_validfrom":                   &EnduserMessagesHttpSslValidfrom{},
-	"enduser_messages.http.ssl_validuntil":                  &EnduserMessagesHttpSslValiduntil{},
-	"enduser_messages.http.threat_desc":                     &EnduserMessagesHttpThreatDesc{},
-	"enduser_messages.http.threat_subject":                  &EnduserMessagesHttpThreatSubject{},
-	"enduser_messages.http.transparent_auth_desc":           &EnduserMessagesHttpTransparentAuthDesc{},
-	"enduser_messages.http.transparent_auth_subject":        &EnduserMessagesHttpTransparentAuthSubject{},
-	"enduser_messages.http.transparent_auth_terms":          &EnduserMessagesHttpTransparentAuthTerms{},
-	"enduser_messages.http.virus_desc":                      &EnduserMessagesHttpVirusDesc{},
-	"enduser_messages.http.virus_subject":                   &EnduserMessagesHttpVirusSubject{},
-	"enduser_messages.http.virusscan_desc":                  &EnduserMessagesHttpVirusscanDesc{},
-	"enduser_messages.http.virusscan_subject":               &EnduserMessagesHttpVirusscanSubject{},
-	"enduser_messages.mail.release_err_desc":                &EnduserMessagesMailReleaseErrDesc{},
-	"enduser_messages.mail.release_err_subject":             &EnduserMessagesMailReleaseErrSubject{},
-	"enduser_messages.mail.released_desc":                   &EnduserMessagesMailReleasedDesc{},
-	"enduser_messages.mail.released_subject":                &EnduserMessagesMailReleasedSubject{},
-	"enduser_messages.pop3.blocked_desc":                    &EnduserMessagesPop3BlockedDesc{},
-	"enduser_messages.pop3.blocked_subject":                 &EnduserMessagesPop3BlockedSubject{},
-	"enduser_messages.spx.internal_error.body":              &EnduserMessagesSpxInternalErrorBody{},
-	"enduser_messages.spx.internal_error.subject":           &EnduserMessagesSpxInternalErrorSubject{},
-	"enduser_messages.spx.internal_error_sender.body":       &EnduserMessagesSpxInternalErrorSenderBody{},
-	"enduser_messages.spx.internal_error_sender.subject":    &EnduserMessagesSpxInternalErrorSenderSubject{},
-	"enduser_messages.spx.password_no_spec_chars.body":      &EnduserMessagesSpxPasswordNoSpecCharsBody{},
-	"enduser_messages.spx.password_no_spec_chars.subject":   &EnduserMessagesSpxPasswordNoSpecCharsSubject{},
-	"enduser_messages.spx.password_not_long_enough.body":    &EnduserMessagesSpxPasswordNotLongEnoughBody{},
-	"enduser_messages.spx.password_not_long_enough.subject": &EnduserMessagesSpxPasswordNotLongEnoughSubject{},
-	"enduser_messages.spx.password_not_presented.body":      &EnduserMessagesSpxPasswordNotPresentedBody{},
-	"enduser_messages.spx.password_not_presented.subject":   &EnduserMessagesSpxPasswordNotPresentedSubject{},
-	"enduser_messages.spx.url_not_found.message":            &EnduserMessagesSpxUrlNotFoundMessage{},
-	"enduser_messages.squid.cache_admin":                    &EnduserMessagesSquidCacheAdmin{},
-	"enduser_messages.squid.cache_admin_message":            &EnduserMessagesSquidCacheAdminMessage{},
-	"epp.allowed_networks":                                  &EppAllowedNetworks{},
-	"epp.certificate":                                       &EppCertificate{},
-	"epp.city":                                              &EppCity{},
-	"epp.country":                                           &EppCountry{},
-	"epp.default_endpoints_group":                           &EppDefaultEndpointsGroup{},
-	"epp.devices":                                           &EppDevices{},
-	"epp.email":                                             &EppEmail{},
-	"epp.endpoints":                                         &EppEndpoints{},
-	"epp.endpoints_groups":                                  &EppEndpointsGroups{},
-	"epp.exceptions.av":                                     &EppExceptionsAv{},
-	"epp.exceptions.dc":                                     &EppExceptionsDc{},
-	"epp.fallback_url":                                      &EppFallbackUrl{},
-	"epp.magnet_password":                                   &EppMagnetPassword{},
-	"epp.magnet_username":                                   &EppMagnetUsername{},
-	"epp.organization":                                      &EppOrganization{},
-	"epp.parent_proxy_host":                                 &EppParentProxyHost{},
-	"epp.parent_proxy_port":                                 &EppParentProxyPort{},
-	"epp.parent_proxy_status":                               &EppParentProxyStatus{},
-	"epp.policies.av":                                       &EppPoliciesAv{},
-	"epp.policies.dc":                                       &EppPoliciesDc{},
-	"epp.port":                                              &EppPort{},
-	"epp.private_key":                                       &EppPrivateKey{},
-	"epp.registration_token":                                &EppRegistrationToken{},
-	"epp.status.av":                                         &EppStatusAv{},
-	"epp.status.broker":                                     &EppStatusBroker{},
-	"epp.status.dc":                                         &EppStatusDc{},
-	"epp.status.epp":                                        &EppStatusEpp{},
-	"epp.status.wc":                                         &EppStatusWc{},
-	"epp.tamper_password":                                   &EppTamperPassword{},
-	"epp.version":                                           &EppVersion{},
-	"epp.wdx_token":                                         &EppWdxToken{},
-	"executive_report.daily.archive":                        &ExecutiveReportDailyArchive{},
-	"executive_report.daily.keep":                           &ExecutiveReportDailyKeep{},
-	"executive_report.daily.pdfrecipients":                  &ExecutiveReportDailyPdfrecipients{},
-	"executive_report.daily.recipients":                     &ExecutiveReportDailyRecipients{},
-	"executive_report.daily.status":                         &ExecutiveReportDailyStatus{},
-	"executive_report.monthly.archive":                      &ExecutiveReportMonthlyArchive{},
-	"executive_report.monthly.keep":                         &ExecutiveReportMonthlyKeep{},
-	"executive_report.monthly.pdfrecipients":                &ExecutiveReportMonthlyPdfrecipients{},
-	"executive_report.monthly.recipients":                   &ExecutiveReportMonthlyRecipients{},
-	"executive_report.monthly.status":                       &ExecutiveReportMonthlyStatus{},
-	"executive_report.weekly.archive":                       &ExecutiveReportWeeklyArchive{},
-	"executive_report.weekly.first_day_of_week":             &ExecutiveReportWeeklyFirstDayOfWeek{},
-	"executive_report.weekly.keep":                          &ExecutiveReportWeeklyKeep{},
-	"executive_report.weekly.pdfrecipients":                 &ExecutiveReportWeeklyPdfrecipients{},
-	"executive_report.weekly.recipients":                    &ExecutiveReportWeeklyRecipients{},
-	"executive_report.weekly.status":                        &ExecutiveReportWeeklyStatus{},
-	"flood_protection.icmp.dst_burst":                       &FloodProtectionIcmpDstBurst{},
-	"flood_protection.icmp.dst_expire":                      &FloodProtectionIcmpDstExpire{},
-	"flood_protection.icmp.dst_gc_interval":                 &FloodProtectionIcmpDstGcInterval{},
-	"flood_protection.icmp.dst_rate":                        &FloodProtectionIcmpDstRate{},
-	"flood_protection.icmp.log":                             &FloodProtectionIcmpLog{},
-	"flood_protection.icmp.log_limit_burst":                 &FloodProtectionIcmpLogLimitBurst{},
-	"flood_protection.icmp.log_limit_rate":                  &FloodProtectionIcmpLogLimitRate{},
-	"flood_protection.icmp.mode":                            &FloodProtectionIcmpMode{},
-	"flood_protection.icmp.src_burst":                       &FloodProtectionIcmpSrcBurst{},
-	"flood_protection.icmp.src_expire":                      &FloodProtectionIcmpSrcExpire{},
-	"flood_protection.icmp.src_gc_interval":                 &FloodProtectionIcmpSrcGcInterval{},
-	"flood_protection.icmp.src_rate":                        &FloodProtectionIcmpSrcRate{},
-	"flood_protection.icmp.status":                          &FloodProtectionIcmpStatus{},
-	"flood_protection.syn.dst_burst":                        &FloodProtectionSynDstBurst{},
-	"flood_protection.syn.dst_expire":                       &FloodProtectionSynDstExpire{},
-	"flood_protection.syn.dst_gc_interval":                  &FloodProtectionSynDstGcInterval{},
-	"flood_protection.syn.dst_rate":                         &FloodProtectionSynDstRate{},
-	"flood_protection.syn.log":                              &FloodProtectionSynLog{},
-	"flood_protection.syn.log_limit_burst":                  &FloodProtectionSynLogLimitBurst{},
-	"flood_protection.syn.log_limit_rate":                   &FloodProtectionSynLogLimitRate{},
-	"flood_protection.syn.mode":                             &FloodProtectionSynMode{},
-	"flood_protection.syn.src_burst":                        &FloodProtectionSynSrcBurst{},
-	"flood_protection.syn.src_expire":                       &FloodProtectionSynSrcExpire{},
-	"flood_protection.syn.src_gc_interval":                  &FloodProtectionSynSrcGcInterval{},
-	"flood_protection.syn.src_rate":                         &FloodProtectionSynSrcRate{},
-	"flood_protection.syn.status":                           &FloodProtectionSynStatus{},
-	"flood_protection.udp.dst_burst":                        &FloodProtectionUdpDstBurst{},
-	"flood_protection.udp.dst_expire":                       &FloodProtectionUdpDstExpire{},
-	"flood_protection.udp.dst_gc_interval":                  &FloodProtectionUdpDstGcInterval{},
-	"flood_protection.udp.dst_rate":                         &FloodProtectionUdpDstRate{},
-	"flood_protection.udp.log":                              &FloodProtectionUdpLog{},
-	"flood_protection.udp.log_limit_burst":                  &FloodProtectionUdpLogLimitBurst{},
-	"flood_protection.udp.log_limit_rate":                   &FloodProtectionUdpLogLimitRate{},
-	"flood_protection.udp.mode":                             &FloodProtectionUdpMode{},
-	"flood_protection.udp.src_burst":                        &FloodProtectionUdpSrcBurst{},
-	"flood_protection.udp.src_expire":                       &FloodProtectionUdpSrcExpire{},
-	"flood_protection.udp.src_gc_interval":                  &FloodProtectionUdpSrcGcInterval{},
-	"flood_protection.udp.src_rate":                         &FloodProtectionUdpSrcRate{},
-	"flood_protection.udp.status":                           &FloodProtectionUdpStatus{},
-	"ftp.allowed_clients":                                   &FtpAllowedClients{},
-	"ftp.allowed_servers":                                   &FtpAllowedServers{},
-	"ftp.cff_av":                                            &FtpCffAv{},
-	"ftp.cff_av_engines":                                    &FtpCffAvEngines{},
-	"ftp.cff_file_extensions":                               &FtpCffFileExtensions{},
-	"ftp.exceptions":                                        &FtpExceptions{},
-	"ftp.max_file_size":                                     &FtpMaxFileSize{},
-	"ftp.ms_win_mode":                                       &FtpMsWinMode{},
-	"ftp.operation_mode":                                    &FtpOperationMode{},
-	"ftp.restricted_servers":                                &FtpRestrictedServers{},
-	"ftp.status":                                            &FtpStatus{},
-	"ftp.transparent_skip":                                  &FtpTransparentSkip{},
-	"ftp.transparent_skip_auto_pf":                          &FtpTransparentSkipAutoPf{},
-	"generic_proxy.rules":                                   &GenericProxyRules{},
-	"geoip.countries_dst":                                   &GeoipCountriesDst{},
-	"geoip.countries_src":                                   &GeoipCountriesSrc{},
-	"geoip.exceptions":                                      &GeoipExceptions{},
-	"geoip.log":                                             &GeoipLog{},
-	"geoip.status":                                          &GeoipStatus{},
-	"h323.allowed_networks":                                 &H323AllowedNetworks{},
-	"h323.log_related":                                      &H323LogRelated{},
-	"h323.servers":                                          &H323Servers{},
-	"h323.status":                                           &H323Status{},
-	"ha.advanced.autojoin":                                  &HaAdvancedAutojoin{},
-	"ha.advanced.cold_rollback":                             &HaAdvancedColdRollback{},
-	"ha.advanced.http_persistence_time":                     &HaAdvancedHttpPersistenceTime{},
-	"ha.advanced.load_takeover":                             &HaAdvancedLoadTakeover{},
-	"ha.advanced.load_warn":                                 &HaAdvancedLoadWarn{},
-	"ha.advanced.max_nodes":                                 &HaAdvancedMaxNodes{},
-	"ha.advanced.mtu":                                       &HaAdvancedMtu{},
-	"ha.advanced.netconsole":                                &HaAdvancedNetconsole{},
-	"ha.advanced.preempt":                                   &HaAdvancedPreempt{},
-	"ha.advanced.unique_id":                                 &HaAdvancedUniqueId{},
-	"ha.advanced.virtual_mac":                               &HaAdvancedVirtualMac{},
-	"ha.aws.cloudwatch.profile":                             &HaAwsCloudwatchProfile{},
-	"ha.aws.cloudwatch.status":                              &HaAwsCloudwatchStatus{},
-	"ha.aws.confd.backup":                                   &HaAwsConfdBackup{},
-	"ha.aws.confd.backup_interval":                          &HaAwsConfdBackupInterval{},
-	"ha.aws.confd.restore":                                  &HaAwsConfdRestore{},
-	"ha.aws.confd.restore_done":                             &HaAwsConfdRestoreDone{},
-	"ha.aws.elastic_ip":                                     &HaAwsElasticIp{},
-	"ha.aws.postgres.archive_timeout":                       &HaAwsPostgresArchiveTimeout{},
-	"ha.aws.postgres.backup":                                &HaAwsPostgresBackup{},
-	"ha.aws.postgres.base_backup_interval":                  &HaAwsPostgresBaseBackupInterval{},
-	"ha.aws.postgres.restore":                               &HaAwsPostgresRestore{},
-	"ha.aws.s3_bucket":                                      &HaAwsS3Bucket{},
-	"ha.aws.stack_name":                                     &HaAwsStackName{},
-	"ha.aws.syslog.backup":                                  &HaAwsSyslogBackup{},
-	"ha.aws.syslog.restore":                                 &HaAwsSyslogRestore{},
-	"ha.aws.syslog.restore_period":                          &HaAwsSyslogRestorePeriod{},
-	"ha.aws.trusted_network":                                &HaAwsTrustedNetwork{},
-	"ha.cluster.ftp":                                        &HaClusterFtp{},
-	"ha.cluster.http":                                       &HaClusterHttp{},
-	"ha.cluster.ipsec":                                      &HaClusterIpsec{},
-	"ha.cluster.pop3":                                       &HaClusterPop3{},
-	"ha.cluster.smtp":                                       &HaClusterSmtp{},
-	"ha.cluster.snort":                                      &HaClusterSnort{},
-	"ha.cluster.waf":                                        &HaClusterWaf{},
-	"ha.device_name":                                        &HaDeviceName{},
-	"ha.itfhw":                                              &HaItfhw{},
-	"ha.itfhw_backup":                                       &HaItfhwBackup{},
-	"ha.master_ip":                                          &HaMasterIp{},
-	"ha.mode":                                               &HaMode{},
-	"ha.node_id":                                            &HaNodeId{},
-	"ha.password":                                           &HaPassword{},
-	"ha.postgres_secret":                                    &HaPostgresSecret{},
-	"ha.slave_ip":                                           &HaSlaveIp{},
-	"ha.status":                                             &HaStatus{},
-	"ha.sync.conntrack":                                     &HaSyncConntrack{},
-	"ha.sync.database":                                      &HaSyncDatabase{},
-	"ha.sync.files":                                         &HaSyncFiles{},
-	"ha.sync.ipsec":                                         &HaSyncIpsec{},
-	"ha.sync.syslog":                                        &HaSyncSyslog{},
-	"ha.times.dead_time":                                    &HaTimesDeadTime{},
-	"ha.times.load_time":                                    &HaTimesLoadTime{},
-	"hotspot.cert":                                          &HotspotCert{},
-	"hotspot.delete_days":                                   &HotspotDeleteDays{},
-	"hotspot.ssl_portal":                                    &HotspotSslPortal{},
-	"hotspot.status":                                        &HotspotStatus{},
-	"hotspot.transparent_skip":                              &HotspotTransparentSkip{},
-	"http.ad_sso_interfaces":                                &HttpAdSsoInterfaces{},
-	"http.adsso_redirect_use_hostname":                      &HttpAdssoRedirectUseHostname{},
-	"http.allow_ssl3":                                       &HttpAllowSsl3{},
-	"http.allow_tls_1_2":                                    &HttpAllowTls12{},
-	"http.allowed_puas":                                     &HttpAllowedPuas{},
-	"http.allowed_target_services":                          &HttpAllowedTargetServices{},
-	"http.aua_maxconns":                                     &HttpAuaMaxconns{},
-	"http.aua_timeout":                                      &HttpAuaTimeout{},
-	"http.auth_cache_size":                                  &HttpAuthCacheSize{},
-	"http.auth_cache_ttl":                                   &HttpAuthCacheTtl{},
-	"http.auth_realm":                                       &HttpAuthRealm{},
-	"http.auth_usercache_ttl":                               &HttpAuthUsercacheTtl{},
-	"http.block_unscannable":                                &HttpBlockUnscannable{},
-	"http.bypass_streaming":                                 &HttpBypassStreaming{},
-	"http.ca_list":                                          &HttpCaList{},
-	"http.cache_ignores_cookies":                            &HttpCacheIgnoresCookies{},
-	"http.cachessl":                                         &HttpCachessl{},
-	"http.caching":                                          &HttpCaching{},
-	"http.certcache":                                        &HttpCertcache{},
-	"http.certstore":                                        &HttpCertstore{},
-	"http.cff_override_users":                               &HttpCffOverrideUsers{},
-	"http.client_timeout":                                   &HttpClientTimeout{},
-	"http.conf_lock_workaround":                             &HttpConfLockWorkaround{},
-	"http.connect_timeout":                                  &HttpConnectTimeout{},
-	"http.connect_v6_timeout":                               &HttpConnectV6Timeout{},
-	"http.connlimit":                                        &HttpConnlimit{},
-	"http.ctype_inspect_body":                               &HttpCtypeInspectBody{},
-	"http.ctype_unpack_archive":                             &HttpCtypeUnpackArchive{},
-	"http.debug":                                            &HttpDebug{},
-	"http.defaultblockaction":                               &HttpDefaultblockaction{},
-	"http.deferagents":                                      &HttpDeferagents{},
-	"http.deferlength":                                      &HttpDeferlength{},
-	"http.display_http_blockpage_explicit_mode":             &HttpDisplayHttpBlockpageExplicitMode{},
-	"http.display_intro":                                    &HttpDisplayIntro{},
-	"http.download_manager_default_charset":                 &HttpDownloadManagerDefaultCharset{},
-	"http.edir_delay_basic_auth":                            &HttpEdirDelayBasicAuth{},
-	"http.enable_out_interface":                             &HttpEnableOutInterface{},
-	"http.epp_quota_action":                                 &HttpEppQuotaAction{},
-	"http.exceptions":                                       &HttpExceptions{},
-	"http.forced_caching_extension":                         &HttpForcedCachingExtension{},
-	"http.forced_caching_never_cache_prefix":                &HttpForcedCachingNeverCachePrefix{},
-	"http.forced_caching_status":                            &HttpForcedCachingStatus{},
-	"http.forced_caching_ttl":                               &HttpForcedCachingTtl{},
-	"http.forced_caching_user_agent_prefix":                 &HttpForcedCachingUserAgentPrefix{},
-	"http.http_loopback_detect":                             &HttpHttpLoopbackDetect{},
-	"http.ie_ssl_blockpage_workaround":                      &HttpIeSslBlockpageWorkaround{},
-	"http.limit_ad_sso_interfaces":                          &HttpLimitAdSsoInterfaces{},
-	"http.local_site_list":                                  &HttpLocalSiteList{},
-	"http.max_content_encoding":                             &HttpMaxContentEncoding{},
-	"http.max_tempfile_size":                                &HttpMaxTempfileSize{},
-	"http.maxthreads":                                       &HttpMaxthreads{},
-	"http.maxthreads_unused":                                &HttpMaxthreadsUnused{},
-	"http.modulepath":                                       &HttpModulepath{},
-	"http.modules":                                          &HttpModules{},
-	"http.noscancontent":                                    &HttpNoscancontent{},
-	"http.opendirectory_keytab":                             &HttpOpendirectoryKeytab{},
-	"http.pac_file":                                         &HttpPacFile{},
-	"http.parent_proxy_host":                                &HttpParentProxyHost{},
-	"http.parent_proxy_port":                                &HttpParentProxyPort{},
-	"http.parent_proxy_status":                              &HttpParentProxyStatus{},
-	"http.passthrough_id":                                   &HttpPassthroughId{},
-	"http.pharming_protection":                              &HttpPharmingProtection{},
-	"http.port":                                             &HttpPort{},
-	"http.portal_cert":                                      &HttpPortalCert{},
-	"http.portal_cert_chain":                                &HttpPortalCertChain{},
-	"http.portal_domain":                                    &HttpPortalDomain{},
-	"http.portal_hosts":                                     &HttpPortalHosts{},
-	"http.portal_use_cert":                                  &HttpPortalUseCert{},
-	"http.proceed_cache_timeout":                            &HttpProceedCacheTimeout{},
-	"http.profiles":                                         &HttpProfiles{},
-	"http.quota_slice_time":                                 &HttpQuotaSliceTime{},
-	"http.remove_request":                                   &HttpRemoveRequest{},
-	"http.remove_response":                                  &HttpRemoveResponse{},
-	"http.response_timeout":                                 &HttpResponseTimeout{},
-	"http.sc_local_db":                                      &HttpScLocalDb{},
-	"http.scan_epp_traffic":                                 &HttpScanEppTraffic{},
-	"http.searchdomain":                                     &HttpSearchdomain{},
-	"http.strict_http":                                      &HttpStrictHttp{},
-	"http.tlsciphers_client":                                &HttpTlsciphersClient{},
-	"http.tlsciphers_server":                                &HttpTlsciphersServer{},
-	"http.tmpfs_usage_min_memsize":                          &HttpTmpfsUsageMinMemsize{},
-	"http.transparent_auth_timeout":                         &HttpTransparentAuthTimeout{},
-	"http.transparent_dst_skip":                             &HttpTransparentDstSkip{},
-	"http.transparent_skip_auto_pf":                         &HttpTransparentSkipAutoPf{},
-	"http.transparent_src_skip":                             &HttpTransparentSrcSkip{},
-	"http.tunnel_timeout":                                   &HttpTunnelTimeout{},
-	"http.tunnel_v6_timeout":                                &HttpTunnelV6Timeout{},
-	"http.undefercontent":                                   &HttpUndefercontent{},
-	"http.undeferextension":                                 &HttpUndeferextension{},
-	"http.url_filtering_redirect_url":                       &HttpUrlFilteringRedirectUrl{},
-	"http.use_connection_insteadof_proxyconnection":         &HttpUseConnectionInsteadofProxyconnection{},
-	"http.use_dstaddr_for_geopiplookup":                     &HttpUseDstaddrForGeopiplookup{},
-	"http.use_krb5_adsso":                                   &HttpUseKrb5Adsso{},
-	"http.use_sni":                                          &HttpUseSni{},
-	"http.use_sxl_urid":                                     &HttpUseSxlUrid{},
-	"icmp.forward":                                          &IcmpForward{},
-	"icmp.input":                                            &IcmpInput{},
-	"icmp.log_redirect":                                     &IcmpLogRedirect{},
-	"icmp.ping.forward":                                     &IcmpPingForward{},
-	"icmp.ping.input":                                       &IcmpPingInput{},
-	"icmp.ping.output":                                      &IcmpPingOutput{},
-	"icmp.secure":                                           &IcmpSecure{},
-	"icmp.traceroute.forward":                               &IcmpTracerouteForward{},
-	"icmp.traceroute.input":                                 &IcmpTracerouteInput{},
-	"ident.forward":                                         &IdentForward{},
-	"ident.response":                                        &IdentResponse{},
-	"ident.status":                                          &IdentStatus{},
-	"interfaces.advanced.arp_announce":                      &InterfacesAdvancedArpAnnounce{},
-	"interfaces.advanced.arp_ignore":                        &InterfacesAdvancedArpIgnore{},
-	"interfaces.advanced.default_metric":                    &InterfacesAdvancedDefaultMetric{},
-	"interfaces.interfaces":                                 &InterfacesInterfaces{},
-	"ips.dns_servers":                                       &IpsDnsServers{},
-	"ips.engine":                                            &IpsEngine{},
-	"ips.exceptions":                                        &IpsExceptions{},
-	"ips.failopen":                                          &IpsFailopen{},
-	"ips.file_based_rules":                                  &IpsFileBasedRules{},
-	"ips.groups":                                            &IpsGroups{},
-	"ips.http_servers":                                      &IpsHttpServers{},
-	"ips.ipsfb.alert_interval":                              &IpsIpsfbAlertInterval{},
-	"ips.ipsfb.config_interval":                             &IpsIpsfbConfigInterval{},
-	"ips.ipsfb.debug":                                       &IpsIpsfbDebug{},
-	"ips.local_networks":                                    &IpsLocalNetworks{},
-	"ips.num_instances":                                     &IpsNumInstances{},
-	"ips.pattern_channel":                                   &IpsPatternChannel{},
-	"ips.policy":                                            &IpsPolicy{},
-	"ips.queue_length":                                      &IpsQueueLength{},
-	"ips.queue_threshold":                                   &IpsQueueThreshold{},
-	"ips.reload_method":                                     &IpsReloadMethod{},
-	"ips.restart_policy":                                    &IpsRestartPolicy{},
-	"ips.rule_modifiers":                                    &IpsRuleModifiers{},
-	"ips.rules":                                             &IpsRules{},
-	"ips.skip_acks":                                         &IpsSkipAcks{},
-	"ips.smtp_servers":                                      &IpsSmtpServers{},
-	"ips.snortsettings.max_queued_bytes":                    &IpsSnortsettingsMaxQueuedBytes{},
-	"ips.snortsettings.max_queued_segs":                     &IpsSnortsettingsMaxQueuedSegs{},
-	"ips.snortsettings.max_tcp":                             &IpsSnortsettingsMaxTcp{},
-	"ips.snortsettings.max_udp":                             &IpsSnortsettingsMaxUdp{},
-	"ips.snortsettings.memcap":                              &IpsSnortsettingsMemcap{},
-	"ips.snortsettings.search_method":                       &IpsSnortsettingsSearchMethod{},
-	"ips.sql_servers":                                       &IpsSqlServers{},
-	"ips.status":                                            &IpsStatus{},
-	"ipsec.advanced.crl_auto_fetching":                      &IpsecAdvancedCrlAutoFetching{},
-	"ipsec.advanced.crl_strict_policy":                      &IpsecAdvancedCrlStrictPolicy{},
-	"ipsec.advanced.dead_peer_detection":                    &IpsecAdvancedDeadPeerDetection{},
-	"ipsec.advanced.ike_debug":                              &IpsecAdvancedIkeDebug{},
-	"ipsec.advanced.ike_port":                               &IpsecAdvancedIkePort{},
-	"ipsec.advanced.metric":                                 &IpsecAdvancedMetric{},
-	"ipsec.advanced.nat_traversal":                          &IpsecAdvancedNatTraversal{},
-	"ipsec.advanced.nat_traversal_keepalive":                &IpsecAdvancedNatTraversalKeepalive{},
-	"ipsec.advanced.probe_psk":                              &IpsecAdvancedProbePsk{},
-	"ipsec.advanced.psk_vpn_id":                             &IpsecAdvancedPskVpnId{},
-	"ipsec.advanced.psk_vpn_id_type":                        &IpsecAdvancedPskVpnIdType{},
-	"ipsec.connections":                                     &IpsecConnections{},
-	"ipsec.local_rsa":                                       &IpsecLocalRsa{},
-	"ipsec.local_x509":                                      &IpsecLocalX509{},
-	"ipsec.status":                                          &IpsecStatus{},
-	"ipv6.advanced.hop_limit":                               &Ipv6AdvancedHopLimit{},
-	"ipv6.advanced.max_interval":                            &Ipv6AdvancedMaxInterval{},
-	"ipv6.advanced.min_interval":                            &Ipv6AdvancedMinInterval{},
-	"ipv6.advanced.preference":                              &Ipv6AdvancedPreference{},
-	"ipv6.advanced.reachable_time":                          &Ipv6AdvancedReachableTime{},
-	"ipv6.advanced.retrans_time":                            &Ipv6AdvancedRetransTime{},
-	"ipv6.broker.authentication":                            &Ipv6BrokerAuthentication{},
-	"ipv6.broker.interface":                                 &Ipv6BrokerInterface{},
-	"ipv6.broker.password":                                  &Ipv6BrokerPassword{},
-	"ipv6.broker.protocol":                                  &Ipv6BrokerProtocol{},
-	"ipv6.broker.server":                                    &Ipv6BrokerServer{},
-	"ipv6.broker.status":                                    &Ipv6BrokerStatus{},
-	"ipv6.broker.tunnel_id":                                 &Ipv6BrokerTunnelId{},
-	"ipv6.broker.username":                                  &Ipv6BrokerUsername{},
-	"ipv6.nat64.address":                                    &Ipv6Nat64Address{},
-	"ipv6.nat64.dns64_v6only":                               &Ipv6Nat64Dns64V6Only{},
-	"ipv6.nat64.prefix":                                     &Ipv6Nat64Prefix{},
-	"ipv6.nat64.status":                                     &Ipv6Nat64Status{},
-	"ipv6.prefer":                                           &Ipv6Prefer{},
-	"ipv6.prefixes":                                         &Ipv6Prefixes{},
-	"ipv6.renumbering":                                      &Ipv6Renumbering{},
-	"ipv6.six2four.interface":                               &Ipv6Six2FourInterface{},
-	"ipv6.six2four.server":                                  &Ipv6Six2FourServer{},
-	"ipv6.six2four.status":                                  &Ipv6Six2FourStatus{},
-	"ipv6.status":                                           &Ipv6Status{},
-	"licensing.active_ips":                                  &LicensingActiveIps{},
-	"licensing.license":                                     &LicensingLicense{},
-	"licensing.user_limit_exceeded":                         &LicensingUserLimitExceeded{},
-	"link_aggregation.groups":                               &LinkAggregationGroups{},
-	"loadbalance.http_error_code":                           &LoadbalanceHttpErrorCode{},
-	"loadbalance.rules":                                     &LoadbalanceRules{},
-	"logfiles.local.action_one":                             &LogfilesLocalActionOne{},
-	"logfiles.local.action_three":                           &LogfilesLocalActionThree{},
-	"logfiles.local.action_two":                             &LogfilesLocalActionTwo{},
-	"logfiles.local.delete_after_days":                      &LogfilesLocalDeleteAfterDays{},
-	"logfiles.local.percentage_one":                         &LogfilesLocalPercentageOne{},
-	"logfiles.local.percentage_three":                       &LogfilesLocalPercentageThree{},
-	"logfiles.local.percentage_two":                         &LogfilesLocalPercentageTwo{},
-	"logfiles.local.status":                                 &LogfilesLocalStatus{},
-	"logfiles.remote.ftp_service":                           &LogfilesRemoteFtpService{},
-	"logfiles.remote.host":                                  &LogfilesRemoteHost{},
-	"logfiles.remote.pass":                                  &LogfilesRemotePass{},
-	"logfiles.remote.path":                                  &LogfilesRemotePath{},
-	"logfiles.remote.smb_workgroup":                         &LogfilesRemoteSmbWorkgroup{},
-	"logfiles.remote.smtp_address":                          &LogfilesRemoteSmtpAddress{},
-	"logfiles.remote.status":                                &LogfilesRemoteStatus{},
-	"logfiles.remote.type":                                  &LogfilesRemoteType{},
-	"logfiles.remote.user":                                  &LogfilesRemoteUser{},
-	"masq.rules":                                            &MasqRules{},
-	"migration.access_token":                                &MigrationAccessToken{},
-	"migration.local_override":                              &MigrationLocalOverride{},
-	"migration.refresh_token":                               &MigrationRefreshToken{},
-	"migration.tab_visibility":                              &MigrationTabVisibility{},
-	"migration.toolset_version":                             &MigrationToolsetVersion{},
-	"migration.utm_version":                                 &MigrationUtmVersion{},
-	"mobile_control.ca":                                     &MobileControlCa{},
-	"mobile_control.config.cisco":                           &MobileControlConfigCisco{},
-	"mobile_control.config.eap_method":                      &MobileControlConfigEapMethod{},
-	"mobile_control.config.force_push":                      &MobileControlConfigForcePush{},
-	"mobile_control.config.l2tp":                            &MobileControlConfigL2Tp{},
-	"mobile_control.config.wifi_networks":                   &MobileControlConfigWifiNetworks{},
-	"mobile_control.customer":                               &MobileControlCustomer{},
-	"mobile_control.debug":                                  &MobileControlDebug{},
-	"mobile_control.nac.cisco":                              &MobileControlNacCisco{},
-	"mobile_control.nac.deny_all_vpn":                       &MobileControlNacDenyAllVpn{},
-	"mobile_control.nac.l2tp":                               &MobileControlNacL2Tp{},
-	"mobile_control.nac.macs_allowed":                       &MobileControlNacMacsAllowed{},
-	"mobile_control.nac.macs_denied":                        &MobileControlNacMacsDenied{},
-	"mobile_control.nac.poll_interval":                      &MobileControlNacPollInterval{},
-	"mobile_control.nac.users_denied":                       &MobileControlNacUsersDenied{},
-	"mobile_control.nac.wifi_networks":                      &MobileControlNacWifiNetworks{},
-	"mobile_control.password":                               &MobileControlPassword{},
-	"mobile_control.server":                                 &MobileControlServer{},
-	"mobile_control.status":                                 &MobileControlStatus{},
-	"mobile_control.username":                               &MobileControlUsername{},
-	"nat.rules":                                             &NatRules{},
-	"notifications.device_info":                             &NotificationsDeviceInfo{},
-	"notifications.limiting":                                &NotificationsLimiting{},
-	"notifications.overlay":                                 &NotificationsOverlay{},
-	"notifications.reboot_reason":                           &NotificationsRebootReason{},
-	"notifications.recipients":                              &NotificationsRecipients{},
-	"notifications.sender":                                  &NotificationsSender{},
-	"notifications.smtp.authentication":                     &NotificationsSmtpAuthentication{},
-	"notifications.smtp.password":                           &NotificationsSmtpPassword{},
-	"notifications.smtp.port":                               &NotificationsSmtpPort{},
-	"notifications.smtp.server":                             &NotificationsSmtpServer{},
-	"notifications.smtp.status":                             &NotificationsSmtpStatus{},
-	"notifications.smtp.tls":                                &NotificationsSmtpTls{},
-	"notifications.smtp.username":                           &NotificationsSmtpUsername{},
-	"ntp.allowed_networks":                                  &NtpAllowedNetworks{},
-	"ntp.servers":                                           &NtpServers{},
-	"ntp.status":                                            &NtpStatus{},
+	"acc.server1.auth.secret":                                    &AccServer1AuthSecret{},
+	"acc.server1.auth.status":                                    &AccServer1AuthStatus{},
+	"acc.server1.port":                                           &AccServer1Port{},
+	"acc.server1.roles":                                          &AccServer1Roles{},
+	"acc.server1.server":                                         &AccServer1Server{},
+	"acc.server2.auth.secret":                                    &AccServer2AuthSecret{},
+	"acc.server2.auth.status":                                    &AccServer2AuthStatus{},
+	"acc.server2.port":                                           &AccServer2Port{},
+	"acc.server2.roles":                                          &AccServer2Roles{},
+	"acc.server2.server":                                         &AccServer2Server{},
+	"acc.server2.status":                                         &AccServer2Status{},
+	"acc.sso_admin_group":                                        &AccSsoAdminGroup{},
+	"acc.sso_auditor_group":                                      &AccSsoAuditorGroup{},
+	"acc.status":                                                 &AccStatus{},
+	"accd.access.allowed_admins":                                 &AccdAccessAllowedAdmins{},
+	"accd.access.allowed_networks":                               &AccdAccessAllowedNetworks{},
+	"accd.access.allowed_users":                                  &AccdAccessAllowedUsers{},
+	"accd.access.cert":                                           &AccdAccessCert{},
+	"accd.access.port":                                           &AccdAccessPort{},
+	"accd.devices.allowed_networks":                              &AccdDevicesAllowedNetworks{},
+	"accd.devices.auth.auto":                                     &AccdDevicesAuthAuto{},
+	"accd.devices.auth.secret":                                   &AccdDevicesAuthSecret{},
+	"accd.devices.auth.status":                                   &AccdDevicesAuthStatus{},
+	"accd.devices.cert":                                          &AccdDevicesCert{},
+	"accd.devices.port":                                          &AccdDevicesPort{},
+	"accd.general.allowed_networks":                              &AccdGeneralAllowedNetworks{},
+	"accd.general.cert":                                          &AccdGeneralCert{},
+	"accd.general.language":                                      &AccdGeneralLanguage{},
+	"accd.general.port":                                          &AccdGeneralPort{},
+	"accd.general.timeout":                                       &AccdGeneralTimeout{},
+	"accounting.ipfix.connections":                               &AccountingIpfixConnections{},
+	"accounting.ipfix.status":                                    &AccountingIpfixStatus{},
+	"afc.controlled_networks":                                    &AfcControlledNetworks{},
+	"afc.hidden_skip":                                            &AfcHiddenSkip{},
+	"afc.http_redirect_url":                                      &AfcHttpRedirectUrl{},
+	"afc.log":                                                    &AfcLog{},
+	"afc.nfqueue_length":                                         &AfcNfqueueLength{},
+	"afc.num_queues":                                             &AfcNumQueues{},
+	"afc.rules":                                                  &AfcRules{},
+	"afc.status":                                                 &AfcStatus{},
+	"afc.submit_unknown_traffic_data":                            &AfcSubmitUnknownTrafficData{},
+	"afc.transparent_skip":                                       &AfcTransparentSkip{},
+	"amazon_vpc.auto_pfrule":                                     &AmazonVpcAutoPfrule{},
+	"amazon_vpc.connections":                                     &AmazonVpcConnections{},
+	"amazon_vpc.networks":                                        &AmazonVpcNetworks{},
+	"amazon_vpc.status":                                          &AmazonVpcStatus{},
+	"aptp.policy":                                                &AptpPolicy{},
+	"aptp.rule_modifiers":                                        &AptpRuleModifiers{},
+	"aptp.status":                                                &AptpStatus{},
+	"aptp.transparent_skip":                                      &AptpTransparentSkip{},
+	"arm.licensed_ip":                                            &ArmLicensedIp{},
+	"arm.remote.host":                                            &ArmRemoteHost{},
+	"arm.remote.method":                                          &ArmRemoteMethod{},
+	"arm.remote.smb_password":                                    &ArmRemoteSmbPassword{},
+	"arm.remote.smb_share":                                       &ArmRemoteSmbShare{},
+	"arm.remote.smb_user":                                        &ArmRemoteSmbUser{},
+	"arm.remote.status":                                          &ArmRemoteStatus{},
+	"arm.remote.syslog_service":                                  &ArmRemoteSyslogService{},
+	"arm.status":                                                 &ArmStatus{},
+	"auth.ad_sso.force_utf8_sync":                                &AuthAdSsoForceUtf8Sync{},
+	"auth.ad_sso.joinresult":                                     &AuthAdSsoJoinresult{},
+	"auth.ad_sso.loadbalancer_fqdn":                              &AuthAdSsoLoadbalancerFqdn{},
+	"auth.ad_sso.ntlmv2_auth":                                    &AuthAdSsoNtlmv2Auth{},
+	"auth.ad_sso.secrets":                                        &AuthAdSsoSecrets{},
+	"auth.ad_sso.smbconf":                                        &AuthAdSsoSmbconf{},
+	"auth.ad_sso.sso_domain":                                     &AuthAdSsoSsoDomain{},
+	"auth.ad_sso.sso_netbios_domain":                             &AuthAdSsoSsoNetbiosDomain{},
+	"auth.ad_sso.sso_netbios_host":                               &AuthAdSsoSsoNetbiosHost{},
+	"auth.ad_sso.sso_password":                                   &AuthAdSsoSsoPassword{},
+	"auth.ad_sso.sso_server":                                     &AuthAdSsoSsoServer{},
+	"auth.ad_sso.sso_status":                                     &AuthAdSsoSsoStatus{},
+	"auth.ad_sso.sso_sync":                                       &AuthAdSsoSsoSync{},
+	"auth.ad_sso.sso_username":                                   &AuthAdSsoSsoUsername{},
+	"auth.api_tokens":                                            &AuthApiTokens{},
+	"auth.auto_add_to_facility":                                  &AuthAutoAddToFacility{},
+	"auth.auto_add_users":                                        &AuthAutoAddUsers{},
+	"auth.block.attempts":                                        &AuthBlockAttempts{},
+	"auth.block.facilities":                                      &AuthBlockFacilities{},
+	"auth.block.lockout":                                         &AuthBlockLockout{},
+	"auth.block.never":                                           &AuthBlockNever{},
+	"auth.block.seconds":                                         &AuthBlockSeconds{},
+	"auth.cache_lifetime":                                        &AuthCacheLifetime{},
+	"auth.edir_sso.em_conflict":                                  &AuthEdirSsoEmConflict{},
+	"auth.edir_sso.em_socket_timeout":                            &AuthEdirSsoEmSocketTimeout{},
+	"auth.edir_sso.em_verify_logout":                             &AuthEdirSsoEmVerifyLogout{},
+	"auth.edir_sso.sso_aua_search_ip":                            &AuthEdirSsoSsoAuaSearchIp{},
+	"auth.edir_sso.sso_mode":                                     &AuthEdirSsoSsoMode{},
+	"auth.edir_sso.sso_server":                                   &AuthEdirSsoSsoServer{},
+	"auth.edir_sso.sync_interval":                                &AuthEdirSsoSyncInterval{},
+	"auth.otp.auto_create_token":                                 &AuthOtpAutoCreateToken{},
+	"auth.otp.auto_token_digest":                                 &AuthOtpAutoTokenDigest{},
+	"auth.otp.default_timestep":                                  &AuthOtpDefaultTimestep{},
+	"auth.otp.facilities":                                        &AuthOtpFacilities{},
+	"auth.otp.max_init_timestep_diff":                            &AuthOtpMaxInitTimestepDiff{},
+	"auth.otp.max_timestep_diff":                                 &AuthOtpMaxTimestepDiff{},
+	"auth.otp.require_all_users":                                 &AuthOtpRequireAllUsers{},
+	"auth.otp.required_users":                                    &AuthOtpRequiredUsers{},
+	"auth.otp.status":                                            &AuthOtpStatus{},
+	"auth.servers":                                               &AuthServers{},
+	"auth.update_backend_group_members.debug":                    &AuthUpdateBackendGroupMembersDebug{},
+	"auth.update_backend_group_members.status":                   &AuthUpdateBackendGroupMembersStatus{},
+	"awe.allowed_interfaces":                                     &AweAllowedInterfaces{},
+	"awe.clients":                                                &AweClients{},
+	"awe.devices":                                                &AweDevices{},
+	"awe.global.ap_autoaccept":                                   &AweGlobalApAutoaccept{},
+	"awe.global.ap_debuglevel":                                   &AweGlobalApDebuglevel{},
+	"awe.global.ap_softlimit":                                    &AweGlobalApSoftlimit{},
+	"awe.global.ap_vlantag":                                      &AweGlobalApVlantag{},
+	"awe.global.awe_status":                                      &AweGlobalAweStatus{},
+	"awe.global.bridge_update_kickout":                           &AweGlobalBridgeUpdateKickout{},
+	"awe.global.initial_setup":                                   &AweGlobalInitialSetup{},
+	"awe.global.log_level":                                       &AweGlobalLogLevel{},
+	"awe.global.magic_ip":                                        &AweGlobalMagicIp{},
+	"awe.global.notification_timeout":                            &AweGlobalNotificationTimeout{},
+	"awe.global.radius_conf":                                     &AweGlobalRadiusConf{},
+	"awe.global.rootpw":                                          &AweGlobalRootpw{},
+	"awe.global.stay_online":                                     &AweGlobalStayOnline{},
+	"awe.global.store_bss_stats":                                 &AweGlobalStoreBssStats{},
+	"awe.global.tunnel_id_offset":                                &AweGlobalTunnelIdOffset{},
+	"awe.global.vlantagging":                                     &AweGlobalVlantagging{},
+	"awe.networks":                                               &AweNetworks{},
+	"awscli.profiles":                                            &AwscliProfiles{},
+	"backup.encryption":                                          &BackupEncryption{},
+	"backup.interval":                                            &BackupInterval{},
+	"backup.max_backups":                                         &BackupMaxBackups{},
+	"backup.password":                                            &BackupPassword{},
+	"backup.recipients":                                          &BackupRecipients{},
+	"backup.status":                                              &BackupStatus{},
+	"ca.ca_gost":                                                 &CaCaGost{},
+	"ca.ca_ipsec":                                                &CaCaIpsec{},
+	"ca.ca_proxies":                                              &CaCaProxies{},
+	"ca.ca_red":                                                  &CaCaRed{},
+	"ca.def_keysize":                                             &CaDefKeysize{},
+	"ca.global_cas.email_encryption.trust_new_cas":               &CaGlobalCasEmailEncryptionTrustNewCas{},
+	"ca.global_cas.email_encryption.trusted":                     &CaGlobalCasEmailEncryptionTrusted{},
+	"ca.global_cas.email_encryption.untrusted":                   &CaGlobalCasEmailEncryptionUntrusted{},
+	"ca.global_cas.http_proxy.trust_new_cas":                     &CaGlobalCasHttpProxyTrustNewCas{},
+	"ca.global_cas.http_proxy.trusted":                           &CaGlobalCasHttpProxyTrusted{},
+	"ca.global_cas.http_proxy.untrusted":                         &CaGlobalCasHttpProxyUntrusted{},
+	"crls.crls":                                                  &CrlsCrls{},
+	"css.av_primary_engine":                                      &CssAvPrimaryEngine{},
+	"css.sxl_liveprotection":                                     &CssSxlLiveprotection{},
+	"css.sxl_sample_submit":                                      &CssSxlSampleSubmit{},
+	"customization.epp.last_updated":                             &CustomizationEppLastUpdated{},
+	"customization.epp.resources_root":                           &CustomizationEppResourcesRoot{},
+	"customization.http.custom_assets":                           &CustomizationHttpCustomAssets{},
+	"customization.http.custom_templates":                        &CustomizationHttpCustomTemplates{},
+	"customization.http.last_updated":                            &CustomizationHttpLastUpdated{},
+	"debugmode.crash_report":                                     &DebugmodeCrashReport{},
+	"debugmode.enabled":                                          &DebugmodeEnabled{},
+	"dhcp.relay.dhcp_server":                                     &DhcpRelayDhcpServer{},
+	"dhcp.relay.interfaces":                                      &DhcpRelayInterfaces{},
+	"dhcp.relay.status":                                          &DhcpRelayStatus{},
+	"dhcp.relay6.itfs_facing_clients":                            &DhcpRelay6ItfsFacingClients{},
+	"dhcp.relay6.itfs_facing_server6":                            &DhcpRelay6ItfsFacingServer6{},
+	"dhcp.relay6.status":                                         &DhcpRelay6Status{},
+	"dhcp.server.custom4":                                        &DhcpServerCustom4{},
+	"dhcp.server.custom6":                                        &DhcpServerCustom6{},
+	"dhcp.server.servers":                                        &DhcpServerServers{},
+	"digest.allowed_networks":                                    &DigestAllowedNetworks{},
+	"digest.custom_text":                                         &DigestCustomText{},
+	"digest.domains":                                             &DigestDomains{},
+	"digest.hostname":                                            &DigestHostname{},
+	"digest.mailinglists":                                        &DigestMailinglists{},
+	"digest.port":                                                &DigestPort{},
+	"digest.send_time_one":                                       &DigestSendTimeOne{},
+	"digest.send_time_two":                                       &DigestSendTimeTwo{},
+	"digest.skiplist":                                            &DigestSkiplist{},
+	"digest.status":                                              &DigestStatus{},
+	"digest.user_release":                                        &DigestUserRelease{},
+	"dns.allowed_networks":                                       &DnsAllowedNetworks{},
+	"dns.axfr":                                                   &DnsAxfr{},
+	"dns.dnssec":                                                 &DnsDnssec{},
+	"dns.email":                                                  &DnsEmail{},
+	"dns.empty_zones":                                            &DnsEmptyZones{},
+	"dns.fwd_dynamic":                                            &DnsFwdDynamic{},
+	"dns.fwd_static":                                             &DnsFwdStatic{},
+	"dns.recheck_interval":                                       &DnsRecheckInterval{},
+	"dns.routes":                                                 &DnsRoutes{},
+	"dyndns.rules":                                               &DyndnsRules{},
+	"emailpki.authority.cert":                                    &EmailpkiAuthorityCert{},
+	"emailpki.authority.fingerprint":                             &EmailpkiAuthorityFingerprint{},
+	"emailpki.authority.key":                                     &EmailpkiAuthorityKey{},
+	"emailpki.authority.postmaster_fingerprint":                  &EmailpkiAuthorityPostmasterFingerprint{},
+	"emailpki.authority.postmaster_privkey":                      &EmailpkiAuthorityPostmasterPrivkey{},
+	"emailpki.authority.postmaster_pubkey":                       &EmailpkiAuthorityPostmasterPubkey{},
+	"emailpki.global.city":                                       &EmailpkiGlobalCity{},
+	"emailpki.global.country":                                    &EmailpkiGlobalCountry{},
+	"emailpki.global.organization":                               &EmailpkiGlobalOrganization{},
+	"emailpki.global.postmaster":                                 &EmailpkiGlobalPostmaster{},
+	"emailpki.global.status":                                     &EmailpkiGlobalStatus{},
+	"emailpki.objects.cas":                                       &EmailpkiObjectsCas{},
+	"emailpki.objects.openpgp":                                   &EmailpkiObjectsOpenpgp{},
+	"emailpki.objects.smime":                                     &EmailpkiObjectsSmime{},
+	"emailpki.objects.users":                                     &EmailpkiObjectsUsers{},
+	"emailpki.openpgp.main_keysize":                              &EmailpkiOpenpgpMainKeysize{},
+	"emailpki.openpgp.sub_keysize":                               &EmailpkiOpenpgpSubKeysize{},
+	"emailpki.options.external_auto":                             &EmailpkiOptionsExternalAuto{},
+	"emailpki.options.keyserver":                                 &EmailpkiOptionsKeyserver{},
+	"emailpki.options.policy_decryption":                         &EmailpkiOptionsPolicyDecryption{},
+	"emailpki.options.policy_encryption":                         &EmailpkiOptionsPolicyEncryption{},
+	"emailpki.options.policy_sign":                               &EmailpkiOptionsPolicySign{},
+	"emailpki.options.policy_verify":                             &EmailpkiOptionsPolicyVerify{},
+	"endpoint.aac.allowed_networks":                              &EndpointAacAllowedNetworks{},
+	"endpoint.aac.allowed_users":                                 &EndpointAacAllowedUsers{},
+	"endpoint.aac.ca":                                            &EndpointAacCa{},
+	"endpoint.aac.cert":                                          &EndpointAacCert{},
+	"endpoint.aac.magic_ip":                                      &EndpointAacMagicIp{},
+	"endpoint.aac.max_user_logins":                               &EndpointAacMaxUserLogins{},
+	"endpoint.aac.status":                                        &EndpointAacStatus{},
+	"endpoint.stas.collectors":                                   &EndpointStasCollectors{},
+	"endpoint.stas.status":                                       &EndpointStasStatus{},
+	"enduser_messages.company_logo":                              &EnduserMessagesCompanyLogo{},
+	"enduser_messages.company_text":                              &EnduserMessagesCompanyText{},
+	"enduser_messages.dlp.blackhole_part":                        &EnduserMessagesDlpBlackholePart{},
+	"enduser_messages.dlp.footer_part":                           &EnduserMessagesDlpFooterPart{},
+	"enduser_messages.dlp.header_part":                           &EnduserMessagesDlpHeaderPart{},
+	"enduser_messages.dlp.original_part":                         &EnduserMessagesDlpOriginalPart{},
+	"enduser_messages.dlp.spx_part":                              &EnduserMessagesDlpSpxPart{},
+	"enduser_messages.dlp.subject":                               &EnduserMessagesDlpSubject{},
+	"enduser_messages.http.app_desc":                             &EnduserMessagesHttpAppDesc{},
+	"enduser_messages.http.app_subject":                          &EnduserMessagesHttpAppSubject{},
+	"enduser_messages.http.blacklist_desc":                       &EnduserMessagesHttpBlacklistDesc{},
+	"enduser_messages.http.blacklist_subject":                    &EnduserMessagesHttpBlacklistSubject{},
+	"enduser_messages.http.certfail_subject":                     &EnduserMessagesHttpCertfailSubject{},
+	"enduser_messages.http.cff_override_desc":                    &EnduserMessagesHttpCffOverrideDesc{},
+	"enduser_messages.http.cff_override_subject":                 &EnduserMessagesHttpCffOverrideSubject{},
+	"enduser_messages.http.cff_override_terms":                   &EnduserMessagesHttpCffOverrideTerms{},
+	"enduser_messages.http.download_complete_desc":               &EnduserMessagesHttpDownloadCompleteDesc{},
+	"enduser_messages.http.download_complete_subject":            &EnduserMessagesHttpDownloadCompleteSubject{},
+	"enduser_messages.http.download_desc":                        &EnduserMessagesHttpDownloadDesc{},
+	"enduser_messages.http.download_subject":                     &EnduserMessagesHttpDownloadSubject{},
+	"enduser_messages.http.error_desc":                           &EnduserMessagesHttpErrorDesc{},
+	"enduser_messages.http.error_subject":                        &EnduserMessagesHttpErrorSubject{},
+	"enduser_messages.http.fileextension_desc":                   &EnduserMessagesHttpFileextensionDesc{},
+	"enduser_messages.http.fileextension_subject":                &EnduserMessagesHttpFileextensionSubject{},
+	"enduser_messages.http.fileextension_warn_desc":              &EnduserMessagesHttpFileextensionWarnDesc{},
+	"enduser_messages.http.fileextension_warn_subject":           &EnduserMessagesHttpFileextensionWarnSubject{},
+	"enduser_messages.http.filesize_desc":                        &EnduserMessagesHttpFilesizeDesc{},
+	"enduser_messages.http.filesize_subject":                     &EnduserMessagesHttpFilesizeSubject{},
+	"enduser_messages.http.geoip_desc":                           &EnduserMessagesHttpGeoipDesc{},
+	"enduser_messages.http.geoip_subject":                        &EnduserMessagesHttpGeoipSubject{},
+	"enduser_messages.http.mimetype_desc":                        &EnduserMessagesHttpMimetypeDesc{},
+	"enduser_messages.http.mimetype_subject":                     &EnduserMessagesHttpMimetypeSubject{},
+	"enduser_messages.http.mimetype_warn_desc":                   &EnduserMessagesHttpMimetypeWarnDesc{},
+	"enduser_messages.http.mimetype_warn_subject":                &EnduserMessagesHttpMimetypeWarnSubject{},
+	"enduser_messages.http.pua_desc":                             &EnduserMessagesHttpPuaDesc{},
+	"enduser_messages.http.pua_subject":                          &EnduserMessagesHttpPuaSubject{},
+	"enduser_messages.http.quota_block_desc":                     &EnduserMessagesHttpQuotaBlockDesc{},
+	"enduser_messages.http.quota_block_subject":                  &EnduserMessagesHttpQuotaBlockSubject{},
+	"enduser_messages.http.quota_warn_desc":                      &EnduserMessagesHttpQuotaWarnDesc{},
+	"enduser_messages.http.quota_warn_subject":                   &EnduserMessagesHttpQuotaWarnSubject{},
+	"enduser_messages.http.sp_desc":                              &EnduserMessagesHttpSpDesc{},
+	"enduser_messages.http.sp_frame_subject":                     &EnduserMessagesHttpSpFrameSubject{},
+	"enduser_messages.http.sp_subject":                           &EnduserMessagesHttpSpSubject{},
+	"enduser_messages.http.sp_warn_desc":                         &EnduserMessagesHttpSpWarnDesc{},
+	"enduser_messages.http.sp_warn_subject":                      &EnduserMessagesHttpSpWarnSubject{},
+	"enduser_messages.http.ssl_certraw":                          &EnduserMessagesHttpSslCertraw{},
+	"enduser_messages.http.ssl_certstatus":                       &EnduserMessagesHttpSslCertstatus{},
+	"enduser_messages.http.ssl_issuer":                           &EnduserMessagesHttpSslIssuer{},
+	"enduser_messages.http.ssl_md5fp":                            &EnduserMessagesHttpSslMd5Fp{},
+	"enduser_messages.http.ssl_sha1fp":                           &EnduserMessagesHttpSslSha1Fp{},
+	"enduser_messages.http.ssl_subject":                          &EnduserMessagesHttpSslSubject{},
+	"enduser_messages.http.ssl_validfrom":                        &EnduserMessagesHttpSslValidfrom{},
+	"enduser_messages.http.ssl_validuntil":                       &EnduserMessagesHttpSslValiduntil{},
+	"enduser_messages.http.threat_desc":                          &EnduserMessagesHttpThreatDesc{},
+	"enduser_messages.http.threat_subject":                       &EnduserMessagesHttpThreatSubject{},
+	"enduser_messages.http.transparent_auth_desc":                &EnduserMessagesHttpTransparentAuthDesc{},
+	"enduser_messages.http.transparent_auth_subject":             &EnduserMessagesHttpTransparentAuthSubject{},
+	"enduser_messages.http.transparent_auth_terms":               &EnduserMessagesHttpTransparentAuthTerms{},
+	"enduser_messages.http.virus_desc":                           &EnduserMessagesHttpVirusDesc{},
+	"enduser_messages.http.virus_subject":                        &EnduserMessagesHttpVirusSubject{},
+	"enduser_messages.http.virusscan_desc":                       &EnduserMessagesHttpVirusscanDesc{},
+	"enduser_messages.http.virusscan_subject":                    &EnduserMessagesHttpVirusscanSubject{},
+	"enduser_messages.mail.release_err_desc":                     &EnduserMessagesMailReleaseErrDesc{},
+	"enduser_messages.mail.release_err_subject":                  &EnduserMessagesMailReleaseErrSubject{},
+	"enduser_messages.mail.released_desc":                        &EnduserMessagesMailReleasedDesc{},
+	"enduser_messages.mail.released_subject":                     &EnduserMessagesMailReleasedSubject{},
+	"enduser_messages.pop3.blocked_desc":                         &EnduserMessagesPop3BlockedDesc{},
+	"enduser_messages.pop3.blocked_subject":                      &EnduserMessagesPop3BlockedSubject{},
+	"enduser_messages.spx.internal_error.body":                   &EnduserMessagesSpxInternalErrorBody{},
+	"enduser_messages.spx.internal_error.subject":                &EnduserMessagesSpxInternalErrorSubject{},
+	"enduser_messages.spx.internal_error_sender.body":            &EnduserMessagesSpxInternalErrorSenderBody{},
+	"enduser_messages.spx.internal_error_sender.subject":         &EnduserMessagesSpxInternalErrorSenderSubject{},
+	"enduser_messages.spx.password_no_spec_chars.body":           &EnduserMessagesSpxPasswordNoSpecCharsBody{},
+	"enduser_messages.spx.password_no_spec_chars.subject":        &EnduserMessagesSpxPasswordNoSpecCharsSubject{},
+	"enduser_messages.spx.password_not_long_enough.body":         &EnduserMessagesSpxPasswordNotLongEnoughBody{},
+	"enduser_messages.spx.password_not_long_enough.subject":      &EnduserMessagesSpxPasswordNotLongEnoughSubject{},
+	"enduser_messages.spx.password_not_presented.body":           &EnduserMessagesSpxPasswordNotPresentedBody{},
+	"enduser_messages.spx.password_not_presented.subject":        &EnduserMessagesSpxPasswordNotPresentedSubject{},
+	"enduser_messages.spx.url_not_found.message":                 &EnduserMessagesSpxUrlNotFoundMessage{},
+	"enduser_messages.squid.cache_admin":                         &EnduserMessagesSquidCacheAdmin{},
+	"enduser_messages.squid.cache_admin_message":                 &EnduserMessagesSquidCacheAdminMessage{},
+	"epp.allowed_networks":                                       &EppAllowedNetworks{},
+	"epp.certificate":                                            &EppCertificate{},
+	"epp.city":                                                   &EppCity{},
+	"epp.country":                                                &EppCountry{},
+	"epp.default_endpoints_group":                                &EppDefaultEndpointsGroup{},
+	"epp.devices":                                                &EppDevices{},
+	"epp.email":                                                  &EppEmail{},
+	"epp.endpoints":                                              &EppEndpoints{},
+	"epp.endpoints_groups":                                       &EppEndpointsGroups{},
+	"epp.exceptions.av":                                          &EppExceptionsAv{},
+	"epp.exceptions.dc":                                          &EppExceptionsDc{},
+	"epp.fallback_url":                                           &EppFallbackUrl{},
+	"epp.magnet_password":                                        &EppMagnetPassword{},
+	"epp.magnet_username":                                        &EppMagnetUsername{},
+	"epp.organization":                                           &EppOrganization{},
+	"epp.parent_proxy_host":                                      &EppParentProxyHost{},
+	"epp.parent_proxy_port":                                      &EppParentProxyPort{},
+	"epp.parent_proxy_status":                                    &EppParentProxyStatus{},
+	"epp.policies.av":                                            &EppPoliciesAv{},
+	"epp.policies.dc":                                            &EppPoliciesDc{},
+	"epp.port":                                                   &EppPort{},
+	"epp.private_key":                                            &EppPrivateKey{},
+	"epp.registration_token":                                     &EppRegistrationToken{},
+	"epp.status.av":                                              &EppStatusAv{},
+	"epp.status.broker":                                          &EppStatusBroker{},
+	"epp.status.dc":                                              &EppStatusDc{},
+	"epp.status.epp":                                             &EppStatusEpp{},
+	"epp.status.wc":                                              &EppStatusWc{},
+	"epp.tamper_password":                                        &EppTamperPassword{},
+	"epp.version":                                                &EppVersion{},
+	"epp.wdx_token":                                              &EppWdxToken{},
+	"executive_report.daily.archive":                             &ExecutiveReportDailyArchive{},
+	"executive_report.daily.keep":                                &ExecutiveReportDailyKeep{},
+	"executive_report.daily.pdfrecipients":                       &ExecutiveReportDailyPdfrecipients{},
+	"executive_report.daily.recipients":                          &ExecutiveReportDailyRecipients{},
+	"executive_report.daily.status":                              &ExecutiveReportDailyStatus{},
+	"executive_report.monthly.archive":                           &ExecutiveReportMonthlyArchive{},
+	"executive_report.monthly.keep":                              &ExecutiveReportMonthlyKeep{},
+	"executive_report.monthly.pdfrecipients":                     &ExecutiveReportMonthlyPdfrecipients{},
+	"executive_report.monthly.recipients":                        &ExecutiveReportMonthlyRecipients{},
+	"executive_report.monthly.status":                            &ExecutiveReportMonthlyStatus{},
+	"executive_report.weekly.archive":                            &ExecutiveReportWeeklyArchive{},
+	"executive_report.weekly.first_day_of_week":                  &ExecutiveReportWeeklyFirstDayOfWeek{},
+	"executive_report.weekly.keep":                               &ExecutiveReportWeeklyKeep{},
+	"executive_report.weekly.pdfrecipients":                      &ExecutiveReportWeeklyPdfrecipients{},
+	"executive_report.weekly.recipients":                         &ExecutiveReportWeeklyRecipients{},
+	"executive_report.weekly.status":                             &ExecutiveReportWeeklyStatus{},
+	"flood_protection.icmp.dst_burst":                            &FloodProtectionIcmpDstBurst{},
+	"flood_protection.icmp.dst_expire":                           &FloodProtectionIcmpDstExpire{},
+	"flood_protection.icmp.dst_gc_interval":                      &FloodProtectionIcmpDstGcInterval{},
+	"flood_protection.icmp.dst_rate":                             &FloodProtectionIcmpDstRate{},
+	"flood_protection.icmp.log":                                  &FloodProtectionIcmpLog{},
+	"flood_protection.icmp.log_limit_burst":                      &FloodProtectionIcmpLogLimitBurst{},
+	"flood_protection.icmp.log_limit_rate":                       &FloodProtectionIcmpLogLimitRate{},
+	"flood_protection.icmp.mode":                                 &FloodProtectionIcmpMode{},
+	"flood_protection.icmp.src_burst":                            &FloodProtectionIcmpSrcBurst{},
+	"flood_protection.icmp.src_expire":                           &FloodProtectionIcmpSrcExpire{},
+	"flood_protection.icmp.src_gc_interval":                      &FloodProtectionIcmpSrcGcInterval{},
+	"flood_protection.icmp.src_rate":                             &FloodProtectionIcmpSrcRate{},
+	"flood_protection.icmp.status":                               &FloodProtectionIcmpStatus{},
+	"flood_protection.syn.dst_burst":                             &FloodProtectionSynDstBurst{},
+	"flood_protection.syn.dst_expire":                            &FloodProtectionSynDstExpire{},
+	"flood_protection.syn.dst_gc_interval":                       &FloodProtectionSynDstGcInterval{},
+	"flood_protection.syn.dst_rate":                              &FloodProtectionSynDstRate{},
+	"flood_protection.syn.log":                                   &FloodProtectionSynLog{},
+	"flood_protection.syn.log_limit_burst":                       &FloodProtectionSynLogLimitBurst{},
+	"flood_protection.syn.log_limit_rate":                        &FloodProtectionSynLogLimitRate{},
+	"flood_protection.syn.mode":                                  &FloodProtectionSynMode{},
+	"flood_protection.syn.src_burst":                             &FloodProtectionSynSrcBurst{},
+	"flood_protection.syn.src_expire":                            &FloodProtectionSynSrcExpire{},
+	"flood_protection.syn.src_gc_interval":                       &FloodProtectionSynSrcGcInterval{},
+	"flood_protection.syn.src_rate":                              &FloodProtectionSynSrcRate{},
+	"flood_protection.syn.status":                                &FloodProtectionSynStatus{},
+	"flood_protection.udp.dst_burst":                             &FloodProtectionUdpDstBurst{},
+	"flood_protection.udp.dst_expire":                            &FloodProtectionUdpDstExpire{},
+	"flood_protection.udp.dst_gc_interval":                       &FloodProtectionUdpDstGcInterval{},
+	"flood_protection.udp.dst_rate":                              &FloodProtectionUdpDstRate{},
+	"flood_protection.udp.log":                                   &FloodProtectionUdpLog{},
+	"flood_protection.udp.log_limit_burst":                       &FloodProtectionUdpLogLimitBurst{},
+	"flood_protection.udp.log_limit_rate":                        &FloodProtectionUdpLogLimitRate{},
+	"flood_protection.udp.mode":                                  &FloodProtectionUdpMode{},
+	"flood_protection.udp.src_burst":                             &FloodProtectionUdpSrcBurst{},
+	"flood_protection.udp.src_expire":                            &FloodProtectionUdpSrcExpire{},
+	"flood_protection.udp.src_gc_interval":                       &FloodProtectionUdpSrcGcInterval{},
+	"flood_protection.udp.src_rate":                              &FloodProtectionUdpSrcRate{},
+	"flood_protection.udp.status":                                &FloodProtectionUdpStatus{},
+	"ftp.allowed_clients":                                        &FtpAllowedClients{},
+	"ftp.allowed_servers":                                        &FtpAllowedServers{},
+	"ftp.cff_av":                                                 &FtpCffAv{},
+	"ftp.cff_av_engines":                                         &FtpCffAvEngines{},
+	"ftp.cff_file_extensions":                                    &FtpCffFileExtensions{},
+	"ftp.exceptions":                                             &FtpExceptions{},
+	"ftp.max_file_size":                                          &FtpMaxFileSize{},
+	"ftp.ms_win_mode":                                            &FtpMsWinMode{},
+	"ftp.operation_mode":                                         &FtpOperationMode{},
+	"ftp.restricted_servers":                                     &FtpRestrictedServers{},
+	"ftp.status":                                                 &FtpStatus{},
+	"ftp.transparent_skip":                                       &FtpTransparentSkip{},
+	"ftp.transparent_skip_auto_pf":                               &FtpTransparentSkipAutoPf{},
+	"generic_proxy.rules":                                        &GenericProxyRules{},
+	"geoip.countries_dst":                                        &GeoipCountriesDst{},
+	"geoip.countries_src":                                        &GeoipCountriesSrc{},
+	"geoip.exceptions":                                           &GeoipExceptions{},
+	"geoip.log":                                                  &GeoipLog{},
+	"geoip.status":                                               &GeoipStatus{},
+	"h323.allowed_networks":                                      &H323AllowedNetworks{},
+	"h323.log_related":                                           &H323LogRelated{},
+	"h323.servers":                                               &H323Servers{},
+	"h323.status":                                                &H323Status{},
+	"ha.advanced.autojoin":                                       &HaAdvancedAutojoin{},
+	"ha.advanced.cold_rollback":                                  &HaAdvancedColdRollback{},
+	"ha.advanced.http_persistence_time":                          &HaAdvancedHttpPersistenceTime{},
+	"ha.advanced.load_takeover":                                  &HaAdvancedLoadTakeover{},
+	"ha.advanced.load_warn":                                      &HaAdvancedLoadWarn{},
+	"ha.advanced.max_nodes":                                      &HaAdvancedMaxNodes{},
+	"ha.advanced.mtu":                                            &HaAdvancedMtu{},
+	"ha.advanced.netconsole":                                     &HaAdvancedNetconsole{},
+	"ha.advanced.preempt":                                        &HaAdvancedPreempt{},
+	"ha.advanced.unique_id":                                      &HaAdvancedUniqueId{},
+	"ha.advanced.virtual_mac":                                    &HaAdvancedVirtualMac{},
+	"ha.aws.cloudwatch.profile":                                  &HaAwsCloudwatchProfile{},
+	"ha.aws.cloudwatch.status":                                   &HaAwsCloudwatchStatus{},
+	"ha.aws.confd.backup":                                        &HaAwsConfdBackup{},
+	"ha.aws.confd.backup_interval":                               &HaAwsConfdBackupInterval{},
+	"ha.aws.confd.restore":                                       &HaAwsConfdRestore{},
+	"ha.aws.confd.restore_done":                                  &HaAwsConfdRestoreDone{},
+	"ha.aws.elastic_ip":                                          &HaAwsElasticIp{},
+	"ha.aws.postgres.archive_timeout":                            &HaAwsPostgresArchiveTimeout{},
+	"ha.aws.postgres.backup":                                     &HaAwsPostgresBackup{},
+	"ha.aws.postgres.base_backup_interval":                       &HaAwsPostgresBaseBackupInterval{},
+	"ha.aws.postgres.restore":                                    &HaAwsPostgresRestore{},
+	"ha.aws.s3_bucket":                                           &HaAwsS3Bucket{},
+	"ha.aws.stack_name":                                          &HaAwsStackName{},
+	"ha.aws.syslog.backup":                                       &HaAwsSyslogBackup{},
+	"ha.aws.syslog.restore":                                      &HaAwsSyslogRestore{},
+	"ha.aws.syslog.restore_period":                               &HaAwsSyslogRestorePeriod{},
+	"ha.aws.trusted_network":                                     &HaAwsTrustedNetwork{},
+	"ha.cluster.ftp":                                             &HaClusterFtp{},
+	"ha.cluster.http":                                            &HaClusterHttp{},
+	"ha.cluster.ipsec":                                           &HaClusterIpsec{},
+	"ha.cluster.pop3":                                            &HaClusterPop3{},
+	"ha.cluster.smtp":                                            &HaClusterSmtp{},
+	"ha.cluster.snort":                                           &HaClusterSnort{},
+	"ha.cluster.waf":                                             &HaClusterWaf{},
+	"ha.device_name":                                             &HaDeviceName{},
+	"ha.itfhw":                                                   &HaItfhw{},
+	"ha.itfhw_backup":                                            &HaItfhwBackup{},
+	"ha.master_ip":                                               &HaMasterIp{},
+	"ha.mode":                                                    &HaMode{},
+	"ha.node_id":                                                 &HaNodeId{},
+	"ha.password":                                                &HaPassword{},
+	"ha.postgres_secret":                                         &HaPostgresSecret{},
+	"ha.slave_ip":                                                &HaSlaveIp{},
+	"ha.status":                                                  &HaStatus{},
+	"ha.sync.conntrack":                                          &HaSyncConntrack{},
+	"ha.sync.database":                                           &HaSyncDatabase{},
+	"ha.sync.files":                                              &HaSyncFiles{},
+	"ha.sync.ipsec":                                              &HaSyncIpsec{},
+	"ha.sync.syslog":                                             &HaSyncSyslog{},
+	"ha.times.dead_time":                                         &HaTimesDeadTime{},
+	"ha.times.load_time":                                         &HaTimesLoadTime{},
+	"hotspot.cert":                                               &HotspotCert{},
+	"hotspot.delete_days":                                        &HotspotDeleteDays{},
+	"hotspot.ssl_portal":                                         &HotspotSslPortal{},
+	"hotspot.status":                                             &HotspotStatus{},
+	"hotspot.transparent_skip":                                   &HotspotTransparentSkip{},
+	"http.ad_sso_interfaces":                                     &HttpAdSsoInterfaces{},
+	"http.adsso_redirect_use_hostname":                           &HttpAdssoRedirectUseHostname{},
+	"http.allow_ssl3":                                            &HttpAllowSsl3{},
+	"http.allow_tls_1_2":                                         &HttpAllowTls12{},
+	"http.allowed_puas":                                          &HttpAllowedPuas{},
+	"http.allowed_target_services":                               &HttpAllowedTargetServices{},
+	"http.aua_maxconns":                                          &HttpAuaMaxconns{},
+	"http.aua_timeout":                                           &HttpAuaTimeout{},
+	"http.auth_cache_size":                                       &HttpAuthCacheSize{},
+	"http.auth_cache_ttl":                                        &HttpAuthCacheTtl{},
+	"http.auth_realm":                                            &HttpAuthRealm{},
+	"http.auth_usercache_ttl":                                    &HttpAuthUsercacheTtl{},
+	"http.block_unscannable":                                     &HttpBlockUnscannable{},
+	"http.bypass_streaming":                                      &HttpBypassStreaming{},
+	"http.ca_list":                                               &HttpCaList{},
+	"http.cache_ignores_cookies":                                 &HttpCacheIgnoresCookies{},
+	"http.cachessl":                                              &HttpCachessl{},
+	"http.caching":                                               &HttpCaching{},
+	"http.certcache":                                             &HttpCertcache{},
+	"http.certstore":                                             &HttpCertstore{},
+	"http.cff_override_users":                                    &HttpCffOverrideUsers{},
+	"http.client_timeout":                                        &HttpClientTimeout{},
+	"http.conf_lock_workaround":                                  &HttpConfLockWorkaround{},
+	"http.connect_timeout":                                       &HttpConnectTimeout{},
+	"http.connect_v6_timeout":                                    &HttpConnectV6Timeout{},
+	"http.connlimit":                                             &HttpConnlimit{},
+	"http.ctype_inspect_body":                                    &HttpCtypeInspectBody{},
+	"http.ctype_unpack_archive":                                  &HttpCtypeUnpackArchive{},
+	"http.debug":                                                 &HttpDebug{},
+	"http.defaultblockaction":                                    &HttpDefaultblockaction{},
+	"http.deferagents":                                           &HttpDeferagents{},
+	"http.deferlength":                                           &HttpDeferlength{},
+	"http.display_http_blockpage_explicit_mode":                  &HttpDisplayHttpBlockpageExplicitMode{},
+	"http.display_intro":                                         &HttpDisplayIntro{},
+	"http.download_manager_default_charset":                      &HttpDownloadManagerDefaultCharset{},
+	"http.edir_delay_basic_auth":                                 &HttpEdirDelayBasicAuth{},
+	"http.enable_out_interface":                                  &HttpEnableOutInterface{},
+	"http.epp_quota_action":                                      &HttpEppQuotaAction{},
+	"http.exceptions":                                            &HttpExceptions{},
+	"http.forced_caching_extension":                              &HttpForcedCachingExtension{},
+	"http.forced_caching_never_cache_prefix":                     &HttpForcedCachingNeverCachePrefix{},
+	"http.forced_caching_status":                                 &HttpForcedCachingStatus{},
+	"http.forced_caching_ttl":                                    &HttpForcedCachingTtl{},
+	"http.forced_caching_user_agent_prefix":                      &HttpForcedCachingUserAgentPrefix{},
+	"http.http_loopback_detect":                                  &HttpHttpLoopbackDetect{},
+	"http.ie_ssl_blockpage_workaround":                           &HttpIeSslBlockpageWorkaround{},
+	"http.limit_ad_sso_interfaces":                               &HttpLimitAdSsoInterfaces{},
+	"http.local_site_list":                                       &HttpLocalSiteList{},
+	"http.max_content_encoding":                                  &HttpMaxContentEncoding{},
+	"http.max_tempfile_size":                                     &HttpMaxTempfileSize{},
+	"http.maxthreads":                                            &HttpMaxthreads{},
+	"http.maxthreads_unused":                                     &HttpMaxthreadsUnused{},
+	"http.modulepath":                                            &HttpModulepath{},
+	"http.modules":                                               &HttpModules{},
+	"http.noscancontent":                                         &HttpNoscancontent{},
+	"http.opendirectory_keytab":                                  &HttpOpendirectoryKeytab{},
+	"http.pac_file":                                              &HttpPacFile{},
+	"http.parent_proxy_host":                                     &HttpParentProxyHost{},
+	"http.parent_proxy_port":                                     &HttpParentProxyPort{},
+	"http.parent_proxy_status":                                   &HttpParentProxyStatus{},
+	"http.passthrough_id":                                        &HttpPassthroughId{},
+	"http.pharming_protection":                                   &HttpPharmingProtection{},
+	"http.port":                                                  &HttpPort{},
+	"http.portal_cert":                                           &HttpPortalCert{},
+	"http.portal_cert_chain":                                     &HttpPortalCertChain{},
+	"http.portal_domain":                                         &HttpPortalDomain{},
+	"http.portal_hosts":                                          &HttpPortalHosts{},
+	"http.portal_use_cert":                                       &HttpPortalUseCert{},
+	"http.proceed_cache_timeout":                                 &HttpProceedCacheTimeout{},
+	"http.profiles":                                              &HttpProfiles{},
+	"http.quota_slice_time":                                      &HttpQuotaSliceTime{},
+	"http.remove_request":                                        &HttpRemoveRequest{},
+	"http.remove_response":                                       &HttpRemoveResponse{},
+	"http.response_timeout":                                      &HttpResponseTimeout{},
+	"http.sc_local_db":                                           &HttpScLocalDb{},
+	"http.scan_epp_traffic":                                      &HttpScanEppTraffic{},
+	"http.searchdomain":                                          &HttpSearchdomain{},
+	"http.strict_http":                                           &HttpStrictHttp{},
+	"http.tlsciphers_client":                                     &HttpTlsciphersClient{},
+	"http.tlsciphers_server":                                     &HttpTlsciphersServer{},
+	"http.tmpfs_usage_min_memsize":                               &HttpTmpfsUsageMinMemsize{},
+	"http.transparent_auth_timeout":                              &HttpTransparentAuthTimeout{},
+	"http.transparent_dst_skip":                                  &HttpTransparentDstSkip{},
+	"http.transparent_skip_auto_pf":                              &HttpTransparentSkipAutoPf{},
+	"http.transparent_src_skip":                                  &HttpTransparentSrcSkip{},
+	"http.tunnel_timeout":                                        &HttpTunnelTimeout{},
+	"http.tunnel_v6_timeout":                                     &HttpTunnelV6Timeout{},
+	"http.undefercontent":                                        &HttpUndefercontent{},
+	"http.undeferextension":                                      &HttpUndeferextension{},
+	"http.url_filtering_redirect_url":                            &HttpUrlFilteringRedirectUrl{},
+	"http.use_connection_insteadof_proxyconnection":              &HttpUseConnectionInsteadofProxyconnection{},
+	"http.use_dstaddr_for_geopiplookup":                          &HttpUseDstaddrForGeopiplookup{},
+	"http.use_krb5_adsso":                                        &HttpUseKrb5Adsso{},
+	"http.use_sni":                                               &HttpUseSni{},
+	"http.use_sxl_urid":                                          &HttpUseSxlUrid{},
+	"icmp.forward":                                               &IcmpForward{},
+	"icmp.input":                                                 &IcmpInput{},
+	"icmp.log_redirect":                                          &IcmpLogRedirect{},
+	"icmp.ping.forward":                                          &IcmpPingForward{},
+	"icmp.ping.input":                                            &IcmpPingInput{},
+	"icmp.ping.output":                                           &IcmpPingOutput{},
+	"icmp.secure":                                                &IcmpSecure{},
+	"icmp.traceroute.forward":                                    &IcmpTracerouteForward{},
+	"icmp.traceroute.input":                                      &IcmpTracerouteInput{},
+	"ident.forward":                                              &IdentForward{},
+	"ident.response":                                             &IdentResponse{},
+	"ident.status":                                               &IdentStatus{},
+	"interfaces.advanced.arp_announce":                           &InterfacesAdvancedArpAnnounce{},
+	"interfaces.advanced.arp_ignore":                             &InterfacesAdvancedArpIgnore{},
+	"interfaces.advanced.default_metric":                         &InterfacesAdvancedDefaultMetric{},
+	"interfaces.interfaces":                                      &InterfacesInterfaces{},
+	"ips.dns_servers":                                            &IpsDnsServers{},
+	"ips.engine":                                                 &IpsEngine{},
+	"ips.exceptions":                                             &IpsExceptions{},
+	"ips.failopen":                                               &IpsFailopen{},
+	"ips.file_based_rules":                                       &IpsFileBasedRules{},
+	"ips.groups":                                                 &IpsGroups{},
+	"ips.http_servers":                                           &IpsHttpServers{},
+	"ips.ipsfb.alert_interval":                                   &IpsIpsfbAlertInterval{},
+	"ips.ipsfb.config_interval":                                  &IpsIpsfbConfigInterval{},
+	"ips.ipsfb.debug":                                            &IpsIpsfbDebug{},
+	"ips.local_networks":                                         &IpsLocalNetworks{},
+	"ips.num_instances":                                          &IpsNumInstances{},
+	"ips.pattern_channel":                                        &IpsPatternChannel{},
+	"ips.policy":                                                 &IpsPolicy{},
+	"ips.queue_length":                                           &IpsQueueLength{},
+	"ips.queue_threshold":                                        &IpsQueueThreshold{},
+	"ips.reload_method":                                          &IpsReloadMethod{},
+	"ips.restart_policy":                                         &IpsRestartPolicy{},
+	"ips.rule_modifiers":                                         &IpsRuleModifiers{},
+	"ips.rules":                                                  &IpsRules{},
+	"ips.skip_acks":                                              &IpsSkipAcks{},
+	"ips.smtp_servers":                                           &IpsSmtpServers{},
+	"ips.snortsettings.max_queued_bytes":                         &IpsSnortsettingsMaxQueuedBytes{},
+	"ips.snortsettings.max_queued_segs":                          &IpsSnortsettingsMaxQueuedSegs{},
+	"ips.snortsettings.max_tcp":                                  &IpsSnortsettingsMaxTcp{},
+	"ips.snortsettings.max_udp":                                  &IpsSnortsettingsMaxUdp{},
+	"ips.snortsettings.memcap":                                   &IpsSnortsettingsMemcap{},
+	"ips.snortsettings.search_method":                            &IpsSnortsettingsSearchMethod{},
+	"ips.sql_servers":                                            &IpsSqlServers{},
+	"ips.status":                                                 &IpsStatus{},
+	"ipsec.advanced.crl_auto_fetching":                           &IpsecAdvancedCrlAutoFetching{},
+	"ipsec.advanced.crl_strict_policy":                           &IpsecAdvancedCrlStrictPolicy{},
+	"ipsec.advanced.dead_peer_detection":                         &IpsecAdvancedDeadPeerDetection{},
+	"ipsec.advanced.ike_debug":                                   &IpsecAdvancedIkeDebug{},
+	"ipsec.advanced.ike_port":                                    &IpsecAdvancedIkePort{},
+	"ipsec.advanced.metric":                                      &IpsecAdvancedMetric{},
+	"ipsec.advanced.nat_traversal":                               &IpsecAdvancedNatTraversal{},
+	"ipsec.advanced.nat_traversal_keepalive":                     &IpsecAdvancedNatTraversalKeepalive{},
+	"ipsec.advanced.probe_psk":                                   &IpsecAdvancedProbePsk{},
+	"ipsec.advanced.psk_vpn_id":                                  &IpsecAdvancedPskVpnId{},
+	"ipsec.advanced.psk_vpn_id_type":                             &IpsecAdvancedPskVpnIdType{},
+	"ipsec.connections":                                          &IpsecConnections{},
+	"ipsec.local_rsa":                                            &IpsecLocalRsa{},
+	"ipsec.local_x509":                                           &IpsecLocalX509{},
+	"ipsec.status":                                               &IpsecStatus{},
+	"ipv6.advanced.hop_limit":                                    &Ipv6AdvancedHopLimit{},
+	"ipv6.advanced.max_interval":                                 &Ipv6AdvancedMaxInterval{},
+	"ipv6.advanced.min_interval":                                 &Ipv6AdvancedMinInterval{},
+	"ipv6.advanced.preference":                                   &Ipv6AdvancedPreference{},
+	"ipv6.advanced.reachable_time":                               &Ipv6AdvancedReachableTime{},
+	"ipv6.advanced.retrans_time":                                 &Ipv6AdvancedRetransTime{},
+	"ipv6.broker.authentication":                                 &Ipv6BrokerAuthentication{},
+	"ipv6.broker.interface":                                      &Ipv6BrokerInterface{},
+	"ipv6.broker.password":                                       &Ipv6BrokerPassword{},
+	"ipv6.broker.protocol":                                       &Ipv6BrokerProtocol{},
+	"ipv6.broker.server":                                         &Ipv6BrokerServer{},
+	"ipv6.broker.status":                                         &Ipv6BrokerStatus{},
+	"ipv6.broker.tunnel_id":                                      &Ipv6BrokerTunnelId{},
+	"ipv6.broker.username":                                       &Ipv6BrokerUsername{},
+	"ipv6.nat64.address":                                         &Ipv6Nat64Address{},
+	"ipv6.nat64.dns64_v6only":                                    &Ipv6Nat64Dns64V6Only{},
+	"ipv6.nat64.prefix":                                          &Ipv6Nat64Prefix{},
+	"ipv6.nat64.status":                                          &Ipv6Nat64Status{},
+	"ipv6.prefer":                                                &Ipv6Prefer{},
+	"ipv6.prefixes":                                              &Ipv6Prefixes{},
+	"ipv6.renumbering":                                           &Ipv6Renumbering{},
+	"ipv6.six2four.interface":                                    &Ipv6Six2FourInterface{},
+	"ipv6.six2four.server":                                       &Ipv6Six2FourServer{},
+	"ipv6.six2four.status":                                       &Ipv6Six2FourStatus{},
+	"ipv6.status":                                                &Ipv6Status{},
+	"licensing.active_ips":                                       &LicensingActiveIps{},
+	"licensing.license":                                          &LicensingLicense{},
+	"licensing.user_limit_exceeded":                              &LicensingUserLimitExceeded{},
+	"link_aggregation.groups":                                    &LinkAggregationGroups{},
+	"loadbalance.http_error_code":                                &LoadbalanceHttpErrorCode{},
+	"loadbalance.rules":                                          &LoadbalanceRules{},
+	"logfiles.local.action_one":                                  &LogfilesLocalActionOne{},
+	"logfiles.local.action_three":                                &LogfilesLocalActionThree{},
+	"logfiles.local.action_two":                                  &LogfilesLocalActionTwo{},
+	"logfiles.local.delete_after_days":                           &LogfilesLocalDeleteAfterDays{},
+	"logfiles.local.percentage_one":                              &LogfilesLocalPercentageOne{},
+	"logfiles.local.percentage_three":                            &LogfilesLocalPercentageThree{},
+	"logfiles.local.percentage_two":                              &LogfilesLocalPercentageTwo{},
+	"logfiles.local.status":                                      &LogfilesLocalStatus{},
+	"logfiles.remote.ftp_service":                                &LogfilesRemoteFtpService{},
+	"logfiles.remote.host":                                       &LogfilesRemoteHost{},
+	"logfiles.remote.pass":                                       &LogfilesRemotePass{},
+	"logfiles.remote.path":                                       &LogfilesRemotePath{},
+	"logfiles.remote.smb_workgroup":                              &LogfilesRemoteSmbWorkgroup{},
+	"logfiles.remote.smtp_address":                               &LogfilesRemoteSmtpAddress{},
+	"logfiles.remote.status":                                     &LogfilesRemoteStatus{},
+	"logfiles.remote.type":                                       &LogfilesRemoteType{},
+	"logfiles.remote.user":                                       &LogfilesRemoteUser{},
+	"masq.rules":                                                 &MasqRules{},
+	"migration.access_token":                                     &MigrationAccessToken{},
+	"migration.local_override":                                   &MigrationLocalOverride{},
+	"migration.refresh_token":                                    &MigrationRefreshToken{},
+	"migration.tab_visibility":                                   &MigrationTabVisibility{},
+	"migration.toolset_version":                                  &MigrationToolsetVersion{},
+	"migration.utm_version":                                      &MigrationUtmVersion{},
+	"mobile_control.ca":                                          &MobileControlCa{},
+	"mobile_control.config.cisco":                                &MobileControlConfigCisco{},
+	"mobile_control.config.eap_method":                           &MobileControlConfigEapMethod{},
+	"mobile_control.config.force_push":                           &MobileControlConfigForcePush{},
+	"mobile_control.config.l2tp":                                 &MobileControlConfigL2Tp{},
+	"mobile_control.config.wifi_networks":                        &MobileControlConfigWifiNetworks{},
+	"mobile_control.customer":                                    &MobileControlCustomer{},
+	"mobile_control.debug":                                       &MobileControlDebug{},
+	"mobile_control.nac.cisco":                                   &MobileControlNacCisco{},
+	"mobile_control.nac.deny_all_vpn":                            &MobileControlNacDenyAllVpn{},
+	"mobile_control.nac.l2tp":                                    &MobileControlNacL2Tp{},
+	"mobile_control.nac.macs_allowed":                            &MobileControlNacMacsAllowed{},
+	"mobile_control.nac.macs_denied":                             &MobileControlNacMacsDenied{},
+	"mobile_control.nac.poll_interval":                           &MobileControlNacPollInterval{},
+	"mobile_control.nac.users_denied":                            &MobileControlNacUsersDenied{},
+	"mobile_control.nac.wifi_networks":                           &MobileControlNacWifiNetworks{},
+	"mobile_control.password":                                    &MobileControlPassword{},
+	"mobile_control.server":                                      &MobileControlServer{},
+	"mobile_control.status":                                      &MobileControlStatus{},
+	"mobile_control.username":                                    &MobileControlUsername{},
+	"nat.rules":                                                  &NatRules{},
+	"notifications.device_info":                                  &NotificationsDeviceInfo{},
+	"notifications.limiting":                                     &NotificationsLimiting{},
+	"notifications.overlay":                                      &NotificationsOverlay{},
+	"notifications.reboot_reason":                                &NotificationsRebootReason{},
+	"notifications.recipients":                                   &NotificationsRecipients{},
+	"notifications.sender":                                       &NotificationsSender{},
+	"notifications.smtp.authentication":                          &NotificationsSmtpAuthentication{},
+	"notifications.smtp.password":                                &NotificationsSmtpPassword{},
+	"notifications.smtp.port":                                    &NotificationsSmtpPort{},
+	"notifications.smtp.server":                                  &NotificationsSmtpServer{},
+	"notifications.smtp.status":                                  &NotificationsSmtpStatus{},
+	"notifications.smtp.tls":                                     &NotificationsSmtpTls{},
+	"notifications.smtp.username":                                &NotificationsSmtpUsername{},
+	"ntp.allowed_networks":                                       &NtpAllowedNetworks{},
+	"ntp.servers":                                                &NtpServers{},
+	"ntp.status":                                                 &NtpStatus{},
 	"packetfilter.advanced.block_invalid_ct_packets":             &PacketfilterAdvancedBlockInvalidCtPackets{},
 	"packetfilter.advanced.check_packet_length":                  &PacketfilterAdvancedCheckPacketLength{},
 	"packetfilter.advanced.conntrack_helpers":                    &PacketfilterAdvancedConntrackHelpers{},
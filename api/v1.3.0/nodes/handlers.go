@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
 )
 
 func get(c sophos.ClientInterface, path string, val interface{}, options ...sophos.Option) (err error) {
@@ -177,35 +178,35 @@ func UpdateAccStatus(client sophos.ClientInterface, val bool, options ...sophos.
 }
 
 // GetAccdAccessAllowedAdmins gets the accd.access.allowed_admins value from the UTM
-func GetAccdAccessAllowedAdmins(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAccdAccessAllowedAdmins(client sophos.ClientInterface, options ...sophos.Option) (val objects.AaaRefs, err error) {
 	err = get(client, "/api/nodes/accd.access.allowed_admins", &val, options...)
 	return
 }
 
 // UpdateAccdAccessAllowedAdmins PUTs the accd.access.allowed_admins value to the UTM
-func UpdateAccdAccessAllowedAdmins(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAccdAccessAllowedAdmins(client sophos.ClientInterface, val objects.AaaRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/accd.access.allowed_admins", val, options...)
 }
 
 // GetAccdAccessAllowedNetworks gets the accd.access.allowed_networks value from the UTM
-func GetAccdAccessAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAccdAccessAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/accd.access.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateAccdAccessAllowedNetworks PUTs the accd.access.allowed_networks value to the UTM
-func UpdateAccdAccessAllowedNetworks(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAccdAccessAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/accd.access.allowed_networks", val, options...)
 }
 
 // GetAccdAccessAllowedUsers gets the accd.access.allowed_users value from the UTM
-func GetAccdAccessAllowedUsers(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAccdAccessAllowedUsers(client sophos.ClientInterface, options ...sophos.Option) (val objects.AaaRefs, err error) {
 	err = get(client, "/api/nodes/accd.access.allowed_users", &val, options...)
 	return
 }
 
 // UpdateAccdAccessAllowedUsers PUTs the accd.access.allowed_users value to the UTM
-func UpdateAccdAccessAllowedUsers(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAccdAccessAllowedUsers(client sophos.ClientInterface, val objects.AaaRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/accd.access.allowed_users", val, options...)
 }
 
@@ -232,13 +233,13 @@ func UpdateAccdAccessPort(client sophos.ClientInterface, val int64, options ...s
 }
 
 // GetAccdDevicesAllowedNetworks gets the accd.devices.allowed_networks value from the UTM
-func GetAccdDevicesAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAccdDevicesAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/accd.devices.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateAccdDevicesAllowedNetworks PUTs the accd.devices.allowed_networks value to the UTM
-func UpdateAccdDevicesAllowedNetworks(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAccdDevicesAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/accd.devices.allowed_networks", val, options...)
 }
 
@@ -298,13 +299,13 @@ func UpdateAccdDevicesPort(client sophos.ClientInterface, val int64, options ...
 }
 
 // GetAccdGeneralAllowedNetworks gets the accd.general.allowed_networks value from the UTM
-func GetAccdGeneralAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAccdGeneralAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/accd.general.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateAccdGeneralAllowedNetworks PUTs the accd.general.allowed_networks value to the UTM
-func UpdateAccdGeneralAllowedNetworks(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAccdGeneralAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/accd.general.allowed_networks", val, options...)
 }
 
@@ -353,13 +354,13 @@ func UpdateAccdGeneralTimeout(client sophos.ClientInterface, val int64, options
 }
 
 // GetAccountingIpfixConnections gets the accounting.ipfix.connections value from the UTM
-func GetAccountingIpfixConnections(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAccountingIpfixConnections(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/accounting.ipfix.connections", &val, options...)
 	return
 }
 
 // UpdateAccountingIpfixConnections PUTs the accounting.ipfix.connections value to the UTM
-func UpdateAccountingIpfixConnections(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAccountingIpfixConnections(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/accounting.ipfix.connections", val, options...)
 }
 
@@ -375,24 +376,24 @@ func UpdateAccountingIpfixStatus(client sophos.ClientInterface, val bool, option
 }
 
 // GetAfcControlledNetworks gets the afc.controlled_networks value from the UTM
-func GetAfcControlledNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
+func GetAfcControlledNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/afc.controlled_networks", &val, options...)
 	return
 }
 
 // UpdateAfcControlledNetworks PUTs the afc.controlled_networks value to the UTM
-func UpdateAfcControlledNetworks(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
+func UpdateAfcControlledNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/afc.controlled_networks", val, options...)
 }
 
 // GetAfcHiddenSkip gets the afc.hidden_skip value from the UTM
-func GetAfcHiddenSkip(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
+func GetAfcHiddenSkip(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/afc.hidden_skip", &val, options...)
 	return
 }
 
 // UpdateAfcHiddenSkip PUTs the afc.hidden_skip value to the UTM
-func UpdateAfcHiddenSkip(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
+func UpdateAfcHiddenSkip(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/afc.hidden_skip", val, options...)
 }
 
@@ -474,13 +475,13 @@ func UpdateAfcSubmitUnknownTrafficData(client sophos.ClientInterface, val bool,
 }
 
 // GetAfcTransparentSkip gets the afc.transparent_skip value from the UTM
-func GetAfcTransparentSkip(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAfcTransparentSkip(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/afc.transparent_skip", &val, options...)
 	return
 }
 
 // UpdateAfcTransparentSkip PUTs the afc.transparent_skip value to the UTM
-func UpdateAfcTransparentSkip(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAfcTransparentSkip(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/afc.transparent_skip", val, options...)
 }
 
@@ -507,13 +508,13 @@ func UpdateAmazonVpcConnections(client sophos.ClientInterface, val []string, opt
 }
 
 // GetAmazonVpcNetworks gets the amazon_vpc.networks value from the UTM
-func GetAmazonVpcNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
+func GetAmazonVpcNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/amazon_vpc.networks", &val, options...)
 	return
 }
 
 // UpdateAmazonVpcNetworks PUTs the amazon_vpc.networks value to the UTM
-func UpdateAmazonVpcNetworks(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
+func UpdateAmazonVpcNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/amazon_vpc.networks", val, options...)
 }
 
@@ -540,13 +541,13 @@ func UpdateAptpPolicy(client sophos.ClientInterface, val string, options ...soph
 }
 
 // GetAptpRuleModifiers gets the aptp.rule_modifiers value from the UTM
-func GetAptpRuleModifiers(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAptpRuleModifiers(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/aptp.rule_modifiers", &val, options...)
 	return
 }
 
 // UpdateAptpRuleModifiers PUTs the aptp.rule_modifiers value to the UTM
-func UpdateAptpRuleModifiers(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAptpRuleModifiers(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/aptp.rule_modifiers", val, options...)
 }
 
@@ -562,13 +563,13 @@ func UpdateAptpStatus(client sophos.ClientInterface, val bool, options ...sophos
 }
 
 // GetAptpTransparentSkip gets the aptp.transparent_skip value from the UTM
-func GetAptpTransparentSkip(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAptpTransparentSkip(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/aptp.transparent_skip", &val, options...)
 	return
 }
 
 // UpdateAptpTransparentSkip PUTs the aptp.transparent_skip value to the UTM
-func UpdateAptpTransparentSkip(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAptpTransparentSkip(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/aptp.transparent_skip", val, options...)
 }
 
@@ -826,13 +827,13 @@ func UpdateAuthAdSsoSsoUsername(client sophos.ClientInterface, val string, optio
 }
 
 // GetAuthApiTokens gets the auth.api_tokens value from the UTM
-func GetAuthApiTokens(client sophos.ClientInterface, options ...sophos.Option) (val map[string]interface{}, err error) {
+func GetAuthApiTokens(client sophos.ClientInterface, options ...sophos.Option) (val map[string]string, err error) {
 	err = get(client, "/api/nodes/auth.api_tokens", &val, options...)
 	return
 }
 
 // UpdateAuthApiTokens PUTs the auth.api_tokens value to the UTM
-func UpdateAuthApiTokens(client sophos.ClientInterface, val map[string]interface{}, options ...sophos.Option) (err error) {
+func UpdateAuthApiTokens(client sophos.ClientInterface, val map[string]string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/auth.api_tokens", val, options...)
 }
 
@@ -892,13 +893,13 @@ func UpdateAuthBlockLockout(client sophos.ClientInterface, val bool, options ...
 }
 
 // GetAuthBlockNever gets the auth.block.never value from the UTM
-func GetAuthBlockNever(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAuthBlockNever(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/auth.block.never", &val, options...)
 	return
 }
 
 // UpdateAuthBlockNever PUTs the auth.block.never value to the UTM
-func UpdateAuthBlockNever(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAuthBlockNever(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/auth.block.never", val, options...)
 }
 
@@ -1079,13 +1080,13 @@ func UpdateAuthOtpRequireAllUsers(client sophos.ClientInterface, val bool, optio
 }
 
 // GetAuthOtpRequiredUsers gets the auth.otp.required_users value from the UTM
-func GetAuthOtpRequiredUsers(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAuthOtpRequiredUsers(client sophos.ClientInterface, options ...sophos.Option) (val objects.AaaRefs, err error) {
 	err = get(client, "/api/nodes/auth.otp.required_users", &val, options...)
 	return
 }
 
 // UpdateAuthOtpRequiredUsers PUTs the auth.otp.required_users value to the UTM
-func UpdateAuthOtpRequiredUsers(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAuthOtpRequiredUsers(client sophos.ClientInterface, val objects.AaaRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/auth.otp.required_users", val, options...)
 }
 
@@ -1101,13 +1102,13 @@ func UpdateAuthOtpStatus(client sophos.ClientInterface, val bool, options ...sop
 }
 
 // GetAuthServers gets the auth.servers value from the UTM
-func GetAuthServers(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAuthServers(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/auth.servers", &val, options...)
 	return
 }
 
 // UpdateAuthServers PUTs the auth.servers value to the UTM
-func UpdateAuthServers(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAuthServers(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/auth.servers", val, options...)
 }
 
@@ -1134,35 +1135,35 @@ func UpdateAuthUpdateBackendGroupMembersStatus(client sophos.ClientInterface, va
 }
 
 // GetAweAllowedInterfaces gets the awe.allowed_interfaces value from the UTM
-func GetAweAllowedInterfaces(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAweAllowedInterfaces(client sophos.ClientInterface, options ...sophos.Option) (val objects.InterfaceRefs, err error) {
 	err = get(client, "/api/nodes/awe.allowed_interfaces", &val, options...)
 	return
 }
 
 // UpdateAweAllowedInterfaces PUTs the awe.allowed_interfaces value to the UTM
-func UpdateAweAllowedInterfaces(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAweAllowedInterfaces(client sophos.ClientInterface, val objects.InterfaceRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/awe.allowed_interfaces", val, options...)
 }
 
 // GetAweClients gets the awe.clients value from the UTM
-func GetAweClients(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAweClients(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/awe.clients", &val, options...)
 	return
 }
 
 // UpdateAweClients PUTs the awe.clients value to the UTM
-func UpdateAweClients(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAweClients(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/awe.clients", val, options...)
 }
 
 // GetAweDevices gets the awe.devices value from the UTM
-func GetAweDevices(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAweDevices(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/awe.devices", &val, options...)
 	return
 }
 
 // UpdateAweDevices PUTs the awe.devices value to the UTM
-func UpdateAweDevices(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAweDevices(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/awe.devices", val, options...)
 }
 
@@ -1343,24 +1344,24 @@ func UpdateAweGlobalVlantagging(client sophos.ClientInterface, val bool, options
 }
 
 // GetAweNetworks gets the awe.networks value from the UTM
-func GetAweNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
+func GetAweNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/awe.networks", &val, options...)
 	return
 }
 
 // UpdateAweNetworks PUTs the awe.networks value to the UTM
-func UpdateAweNetworks(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
+func UpdateAweNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/awe.networks", val, options...)
 }
 
 // GetAwscliProfiles gets the awscli.profiles value from the UTM
-func GetAwscliProfiles(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetAwscliProfiles(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/awscli.profiles", &val, options...)
 	return
 }
 
 // UpdateAwscliProfiles PUTs the awscli.profiles value to the UTM
-func UpdateAwscliProfiles(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateAwscliProfiles(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/awscli.profiles", val, options...)
 }
 
@@ -1497,24 +1498,24 @@ func UpdateCaGlobalCasEmailEncryptionTrustNewCas(client sophos.ClientInterface,
 }
 
 // GetCaGlobalCasEmailEncryptionTrusted gets the ca.global_cas.email_encryption.trusted value from the UTM
-func GetCaGlobalCasEmailEncryptionTrusted(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetCaGlobalCasEmailEncryptionTrusted(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ca.global_cas.email_encryption.trusted", &val, options...)
 	return
 }
 
 // UpdateCaGlobalCasEmailEncryptionTrusted PUTs the ca.global_cas.email_encryption.trusted value to the UTM
-func UpdateCaGlobalCasEmailEncryptionTrusted(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateCaGlobalCasEmailEncryptionTrusted(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ca.global_cas.email_encryption.trusted", val, options...)
 }
 
 // GetCaGlobalCasEmailEncryptionUntrusted gets the ca.global_cas.email_encryption.untrusted value from the UTM
-func GetCaGlobalCasEmailEncryptionUntrusted(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetCaGlobalCasEmailEncryptionUntrusted(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ca.global_cas.email_encryption.untrusted", &val, options...)
 	return
 }
 
 // UpdateCaGlobalCasEmailEncryptionUntrusted PUTs the ca.global_cas.email_encryption.untrusted value to the UTM
-func UpdateCaGlobalCasEmailEncryptionUntrusted(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateCaGlobalCasEmailEncryptionUntrusted(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ca.global_cas.email_encryption.untrusted", val, options...)
 }
 
@@ -1530,35 +1531,35 @@ func UpdateCaGlobalCasHttpProxyTrustNewCas(client sophos.ClientInterface, val bo
 }
 
 // GetCaGlobalCasHttpProxyTrusted gets the ca.global_cas.http_proxy.trusted value from the UTM
-func GetCaGlobalCasHttpProxyTrusted(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetCaGlobalCasHttpProxyTrusted(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ca.global_cas.http_proxy.trusted", &val, options...)
 	return
 }
 
 // UpdateCaGlobalCasHttpProxyTrusted PUTs the ca.global_cas.http_proxy.trusted value to the UTM
-func UpdateCaGlobalCasHttpProxyTrusted(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateCaGlobalCasHttpProxyTrusted(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ca.global_cas.http_proxy.trusted", val, options...)
 }
 
 // GetCaGlobalCasHttpProxyUntrusted gets the ca.global_cas.http_proxy.untrusted value from the UTM
-func GetCaGlobalCasHttpProxyUntrusted(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetCaGlobalCasHttpProxyUntrusted(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ca.global_cas.http_proxy.untrusted", &val, options...)
 	return
 }
 
 // UpdateCaGlobalCasHttpProxyUntrusted PUTs the ca.global_cas.http_proxy.untrusted value to the UTM
-func UpdateCaGlobalCasHttpProxyUntrusted(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateCaGlobalCasHttpProxyUntrusted(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ca.global_cas.http_proxy.untrusted", val, options...)
 }
 
 // GetCrlsCrls gets the crls.crls value from the UTM
-func GetCrlsCrls(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetCrlsCrls(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/crls.crls", &val, options...)
 	return
 }
 
 // UpdateCrlsCrls PUTs the crls.crls value to the UTM
-func UpdateCrlsCrls(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateCrlsCrls(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/crls.crls", val, options...)
 }
 
@@ -1684,13 +1685,13 @@ func UpdateDhcpRelayDhcpServer(client sophos.ClientInterface, val string, option
 }
 
 // GetDhcpRelayInterfaces gets the dhcp.relay.interfaces value from the UTM
-func GetDhcpRelayInterfaces(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetDhcpRelayInterfaces(client sophos.ClientInterface, options ...sophos.Option) (val objects.InterfaceRefs, err error) {
 	err = get(client, "/api/nodes/dhcp.relay.interfaces", &val, options...)
 	return
 }
 
 // UpdateDhcpRelayInterfaces PUTs the dhcp.relay.interfaces value to the UTM
-func UpdateDhcpRelayInterfaces(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateDhcpRelayInterfaces(client sophos.ClientInterface, val objects.InterfaceRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/dhcp.relay.interfaces", val, options...)
 }
 
@@ -1706,24 +1707,24 @@ func UpdateDhcpRelayStatus(client sophos.ClientInterface, val bool, options ...s
 }
 
 // GetDhcpRelay6ItfsFacingClients gets the dhcp.relay6.itfs_facing_clients value from the UTM
-func GetDhcpRelay6ItfsFacingClients(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetDhcpRelay6ItfsFacingClients(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/dhcp.relay6.itfs_facing_clients", &val, options...)
 	return
 }
 
 // UpdateDhcpRelay6ItfsFacingClients PUTs the dhcp.relay6.itfs_facing_clients value to the UTM
-func UpdateDhcpRelay6ItfsFacingClients(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateDhcpRelay6ItfsFacingClients(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/dhcp.relay6.itfs_facing_clients", val, options...)
 }
 
 // GetDhcpRelay6ItfsFacingServer6 gets the dhcp.relay6.itfs_facing_server6 value from the UTM
-func GetDhcpRelay6ItfsFacingServer6(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetDhcpRelay6ItfsFacingServer6(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/dhcp.relay6.itfs_facing_server6", &val, options...)
 	return
 }
 
 // UpdateDhcpRelay6ItfsFacingServer6 PUTs the dhcp.relay6.itfs_facing_server6 value to the UTM
-func UpdateDhcpRelay6ItfsFacingServer6(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateDhcpRelay6ItfsFacingServer6(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/dhcp.relay6.itfs_facing_server6", val, options...)
 }
 
@@ -1772,13 +1773,13 @@ func UpdateDhcpServerServers(client sophos.ClientInterface, val []string, option
 }
 
 // GetDigestAllowedNetworks gets the digest.allowed_networks value from the UTM
-func GetDigestAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetDigestAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/digest.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateDigestAllowedNetworks PUTs the digest.allowed_networks value to the UTM
-func UpdateDigestAllowedNetworks(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateDigestAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/digest.allowed_networks", val, options...)
 }
 
@@ -1816,13 +1817,13 @@ func UpdateDigestHostname(client sophos.ClientInterface, val string, options ...
 }
 
 // GetDigestMailinglists gets the digest.mailinglists value from the UTM
-func GetDigestMailinglists(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetDigestMailinglists(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/digest.mailinglists", &val, options...)
 	return
 }
 
 // UpdateDigestMailinglists PUTs the digest.mailinglists value to the UTM
-func UpdateDigestMailinglists(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateDigestMailinglists(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/digest.mailinglists", val, options...)
 }
 
@@ -1860,13 +1861,13 @@ func UpdateDigestSendTimeTwo(client sophos.ClientInterface, val string, options
 }
 
 // GetDigestSkiplist gets the digest.skiplist value from the UTM
-func GetDigestSkiplist(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetDigestSkiplist(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/digest.skiplist", &val, options...)
 	return
 }
 
 // UpdateDigestSkiplist PUTs the digest.skiplist value to the UTM
-func UpdateDigestSkiplist(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateDigestSkiplist(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/digest.skiplist", val, options...)
 }
 
@@ -1893,24 +1894,24 @@ func UpdateDigestUserRelease(client sophos.ClientInterface, val []string, option
 }
 
 // GetDnsAllowedNetworks gets the dns.allowed_networks value from the UTM
-func GetDnsAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
+func GetDnsAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/dns.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateDnsAllowedNetworks PUTs the dns.allowed_networks value to the UTM
-func UpdateDnsAllowedNetworks(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
+func UpdateDnsAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/dns.allowed_networks", val, options...)
 }
 
 // GetDnsAxfr gets the dns.axfr value from the UTM
-func GetDnsAxfr(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetDnsAxfr(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/dns.axfr", &val, options...)
 	return
 }
 
 // UpdateDnsAxfr PUTs the dns.axfr value to the UTM
-func UpdateDnsAxfr(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateDnsAxfr(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/dns.axfr", val, options...)
 }
 
@@ -1992,13 +1993,13 @@ func UpdateDnsRoutes(client sophos.ClientInterface, val []string, options ...sop
 }
 
 // GetDyndnsRules gets the dyndns.rules value from the UTM
-func GetDyndnsRules(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetDyndnsRules(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/dyndns.rules", &val, options...)
 	return
 }
 
 // UpdateDyndnsRules PUTs the dyndns.rules value to the UTM
-func UpdateDyndnsRules(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateDyndnsRules(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/dyndns.rules", val, options...)
 }
 
@@ -2135,35 +2136,35 @@ func UpdateEmailpkiObjectsCas(client sophos.ClientInterface, val []string, optio
 }
 
 // GetEmailpkiObjectsOpenpgp gets the emailpki.objects.openpgp value from the UTM
-func GetEmailpkiObjectsOpenpgp(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetEmailpkiObjectsOpenpgp(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/emailpki.objects.openpgp", &val, options...)
 	return
 }
 
 // UpdateEmailpkiObjectsOpenpgp PUTs the emailpki.objects.openpgp value to the UTM
-func UpdateEmailpkiObjectsOpenpgp(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateEmailpkiObjectsOpenpgp(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/emailpki.objects.openpgp", val, options...)
 }
 
 // GetEmailpkiObjectsSmime gets the emailpki.objects.smime value from the UTM
-func GetEmailpkiObjectsSmime(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetEmailpkiObjectsSmime(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/emailpki.objects.smime", &val, options...)
 	return
 }
 
 // UpdateEmailpkiObjectsSmime PUTs the emailpki.objects.smime value to the UTM
-func UpdateEmailpkiObjectsSmime(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateEmailpkiObjectsSmime(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/emailpki.objects.smime", val, options...)
 }
 
 // GetEmailpkiObjectsUsers gets the emailpki.objects.users value from the UTM
-func GetEmailpkiObjectsUsers(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetEmailpkiObjectsUsers(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/emailpki.objects.users", &val, options...)
 	return
 }
 
 // UpdateEmailpkiObjectsUsers PUTs the emailpki.objects.users value to the UTM
-func UpdateEmailpkiObjectsUsers(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateEmailpkiObjectsUsers(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/emailpki.objects.users", val, options...)
 }
 
@@ -2256,24 +2257,24 @@ func UpdateEmailpkiOptionsPolicyVerify(client sophos.ClientInterface, val bool,
 }
 
 // GetEndpointAacAllowedNetworks gets the endpoint.aac.allowed_networks value from the UTM
-func GetEndpointAacAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
+func GetEndpointAacAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/endpoint.aac.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateEndpointAacAllowedNetworks PUTs the endpoint.aac.allowed_networks value to the UTM
-func UpdateEndpointAacAllowedNetworks(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
+func UpdateEndpointAacAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/endpoint.aac.allowed_networks", val, options...)
 }
 
 // GetEndpointAacAllowedUsers gets the endpoint.aac.allowed_users value from the UTM
-func GetEndpointAacAllowedUsers(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
+func GetEndpointAacAllowedUsers(client sophos.ClientInterface, options ...sophos.Option) (val objects.AaaRefs, err error) {
 	err = get(client, "/api/nodes/endpoint.aac.allowed_users", &val, options...)
 	return
 }
 
 // UpdateEndpointAacAllowedUsers PUTs the endpoint.aac.allowed_users value to the UTM
-func UpdateEndpointAacAllowedUsers(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
+func UpdateEndpointAacAllowedUsers(client sophos.ClientInterface, val objects.AaaRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/endpoint.aac.allowed_users", val, options...)
 }
 
@@ -2333,13 +2334,13 @@ func UpdateEndpointAacStatus(client sophos.ClientInterface, val bool, options ..
 }
 
 // GetEndpointStasCollectors gets the endpoint.stas.collectors value from the UTM
-func GetEndpointStasCollectors(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetEndpointStasCollectors(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/endpoint.stas.collectors", &val, options...)
 	return
 }
 
 // UpdateEndpointStasCollectors PUTs the endpoint.stas.collectors value to the UTM
-func UpdateEndpointStasCollectors(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateEndpointStasCollectors(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/endpoint.stas.collectors", val, options...)
 }
 
@@ -3246,13 +3247,13 @@ func UpdateEnduserMessagesSquidCacheAdminMessage(client sophos.ClientInterface,
 }
 
 // GetEppAllowedNetworks gets the epp.allowed_networks value from the UTM
-func GetEppAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
+func GetEppAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/epp.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateEppAllowedNetworks PUTs the epp.allowed_networks value to the UTM
-func UpdateEppAllowedNetworks(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
+func UpdateEppAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/epp.allowed_networks", val, options...)
 }
 
@@ -3301,13 +3302,13 @@ func UpdateEppDefaultEndpointsGroup(client sophos.ClientInterface, val string, o
 }
 
 // GetEppDevices gets the epp.devices value from the UTM
-func GetEppDevices(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetEppDevices(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/epp.devices", &val, options...)
 	return
 }
 
 // UpdateEppDevices PUTs the epp.devices value to the UTM
-func UpdateEppDevices(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateEppDevices(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/epp.devices", val, options...)
 }
 
@@ -3323,13 +3324,13 @@ func UpdateEppEmail(client sophos.ClientInterface, val string, options ...sophos
 }
 
 // GetEppEndpoints gets the epp.endpoints value from the UTM
-func GetEppEndpoints(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetEppEndpoints(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/epp.endpoints", &val, options...)
 	return
 }
 
 // UpdateEppEndpoints PUTs the epp.endpoints value to the UTM
-func UpdateEppEndpoints(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateEppEndpoints(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/epp.endpoints", val, options...)
 }
 
@@ -3345,24 +3346,24 @@ func UpdateEppEndpointsGroups(client sophos.ClientInterface, val []string, optio
 }
 
 // GetEppExceptionsAv gets the epp.exceptions.av value from the UTM
-func GetEppExceptionsAv(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetEppExceptionsAv(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/epp.exceptions.av", &val, options...)
 	return
 }
 
 // UpdateEppExceptionsAv PUTs the epp.exceptions.av value to the UTM
-func UpdateEppExceptionsAv(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateEppExceptionsAv(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/epp.exceptions.av", val, options...)
 }
 
 // GetEppExceptionsDc gets the epp.exceptions.dc value from the UTM
-func GetEppExceptionsDc(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetEppExceptionsDc(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/epp.exceptions.dc", &val, options...)
 	return
 }
 
 // UpdateEppExceptionsDc PUTs the epp.exceptions.dc value to the UTM
-func UpdateEppExceptionsDc(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateEppExceptionsDc(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/epp.exceptions.dc", val, options...)
 }
 
@@ -3609,13 +3610,13 @@ func UpdateExecutiveReportDailyKeep(client sophos.ClientInterface, val int64, op
 }
 
 // GetExecutiveReportDailyPdfrecipients gets the executive_report.daily.pdfrecipients value from the UTM
-func GetExecutiveReportDailyPdfrecipients(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetExecutiveReportDailyPdfrecipients(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/executive_report.daily.pdfrecipients", &val, options...)
 	return
 }
 
 // UpdateExecutiveReportDailyPdfrecipients PUTs the executive_report.daily.pdfrecipients value to the UTM
-func UpdateExecutiveReportDailyPdfrecipients(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateExecutiveReportDailyPdfrecipients(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/executive_report.daily.pdfrecipients", val, options...)
 }
 
@@ -3664,24 +3665,24 @@ func UpdateExecutiveReportMonthlyKeep(client sophos.ClientInterface, val int64,
 }
 
 // GetExecutiveReportMonthlyPdfrecipients gets the executive_report.monthly.pdfrecipients value from the UTM
-func GetExecutiveReportMonthlyPdfrecipients(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetExecutiveReportMonthlyPdfrecipients(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/executive_report.monthly.pdfrecipients", &val, options...)
 	return
 }
 
 // UpdateExecutiveReportMonthlyPdfrecipients PUTs the executive_report.monthly.pdfrecipients value to the UTM
-func UpdateExecutiveReportMonthlyPdfrecipients(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateExecutiveReportMonthlyPdfrecipients(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/executive_report.monthly.pdfrecipients", val, options...)
 }
 
 // GetExecutiveReportMonthlyRecipients gets the executive_report.monthly.recipients value from the UTM
-func GetExecutiveReportMonthlyRecipients(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetExecutiveReportMonthlyRecipients(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/executive_report.monthly.recipients", &val, options...)
 	return
 }
 
 // UpdateExecutiveReportMonthlyRecipients PUTs the executive_report.monthly.recipients value to the UTM
-func UpdateExecutiveReportMonthlyRecipients(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateExecutiveReportMonthlyRecipients(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/executive_report.monthly.recipients", val, options...)
 }
 
@@ -3730,24 +3731,24 @@ func UpdateExecutiveReportWeeklyKeep(client sophos.ClientInterface, val int64, o
 }
 
 // GetExecutiveReportWeeklyPdfrecipients gets the executive_report.weekly.pdfrecipients value from the UTM
-func GetExecutiveReportWeeklyPdfrecipients(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetExecutiveReportWeeklyPdfrecipients(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/executive_report.weekly.pdfrecipients", &val, options...)
 	return
 }
 
 // UpdateExecutiveReportWeeklyPdfrecipients PUTs the executive_report.weekly.pdfrecipients value to the UTM
-func UpdateExecutiveReportWeeklyPdfrecipients(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateExecutiveReportWeeklyPdfrecipients(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/executive_report.weekly.pdfrecipients", val, options...)
 }
 
 // GetExecutiveReportWeeklyRecipients gets the executive_report.weekly.recipients value from the UTM
-func GetExecutiveReportWeeklyRecipients(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetExecutiveReportWeeklyRecipients(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/executive_report.weekly.recipients", &val, options...)
 	return
 }
 
 // UpdateExecutiveReportWeeklyRecipients PUTs the executive_report.weekly.recipients value to the UTM
-func UpdateExecutiveReportWeeklyRecipients(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateExecutiveReportWeeklyRecipients(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/executive_report.weekly.recipients", val, options...)
 }
 
@@ -4192,13 +4193,13 @@ func UpdateFloodProtectionUdpStatus(client sophos.ClientInterface, val bool, opt
 }
 
 // GetFtpAllowedClients gets the ftp.allowed_clients value from the UTM
-func GetFtpAllowedClients(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetFtpAllowedClients(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ftp.allowed_clients", &val, options...)
 	return
 }
 
 // UpdateFtpAllowedClients PUTs the ftp.allowed_clients value to the UTM
-func UpdateFtpAllowedClients(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateFtpAllowedClients(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ftp.allowed_clients", val, options...)
 }
 
@@ -4236,24 +4237,24 @@ func UpdateFtpCffAvEngines(client sophos.ClientInterface, val string, options ..
 }
 
 // GetFtpCffFileExtensions gets the ftp.cff_file_extensions value from the UTM
-func GetFtpCffFileExtensions(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetFtpCffFileExtensions(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ftp.cff_file_extensions", &val, options...)
 	return
 }
 
 // UpdateFtpCffFileExtensions PUTs the ftp.cff_file_extensions value to the UTM
-func UpdateFtpCffFileExtensions(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateFtpCffFileExtensions(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ftp.cff_file_extensions", val, options...)
 }
 
 // GetFtpExceptions gets the ftp.exceptions value from the UTM
-func GetFtpExceptions(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetFtpExceptions(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ftp.exceptions", &val, options...)
 	return
 }
 
 // UpdateFtpExceptions PUTs the ftp.exceptions value to the UTM
-func UpdateFtpExceptions(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateFtpExceptions(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ftp.exceptions", val, options...)
 }
 
@@ -4313,13 +4314,13 @@ func UpdateFtpStatus(client sophos.ClientInterface, val bool, options ...sophos.
 }
 
 // GetFtpTransparentSkip gets the ftp.transparent_skip value from the UTM
-func GetFtpTransparentSkip(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetFtpTransparentSkip(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/ftp.transparent_skip", &val, options...)
 	return
 }
 
 // UpdateFtpTransparentSkip PUTs the ftp.transparent_skip value to the UTM
-func UpdateFtpTransparentSkip(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateFtpTransparentSkip(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ftp.transparent_skip", val, options...)
 }
 
@@ -4335,13 +4336,13 @@ func UpdateFtpTransparentSkipAutoPf(client sophos.ClientInterface, val bool, opt
 }
 
 // GetGenericProxyRules gets the generic_proxy.rules value from the UTM
-func GetGenericProxyRules(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetGenericProxyRules(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/generic_proxy.rules", &val, options...)
 	return
 }
 
 // UpdateGenericProxyRules PUTs the generic_proxy.rules value to the UTM
-func UpdateGenericProxyRules(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateGenericProxyRules(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/generic_proxy.rules", val, options...)
 }
 
@@ -4368,13 +4369,13 @@ func UpdateGeoipCountriesSrc(client sophos.ClientInterface, val []string, option
 }
 
 // GetGeoipExceptions gets the geoip.exceptions value from the UTM
-func GetGeoipExceptions(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetGeoipExceptions(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/geoip.exceptions", &val, options...)
 	return
 }
 
 // UpdateGeoipExceptions PUTs the geoip.exceptions value to the UTM
-func UpdateGeoipExceptions(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateGeoipExceptions(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/geoip.exceptions", val, options...)
 }
 
@@ -4401,13 +4402,13 @@ func UpdateGeoipStatus(client sophos.ClientInterface, val bool, options ...sopho
 }
 
 // GetH323AllowedNetworks gets the h323.allowed_networks value from the UTM
-func GetH323AllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetH323AllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/h323.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateH323AllowedNetworks PUTs the h323.allowed_networks value to the UTM
-func UpdateH323AllowedNetworks(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateH323AllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/h323.allowed_networks", val, options...)
 }
 
@@ -4423,13 +4424,13 @@ func UpdateH323LogRelated(client sophos.ClientInterface, val bool, options ...so
 }
 
 // GetH323Servers gets the h323.servers value from the UTM
-func GetH323Servers(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetH323Servers(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/h323.servers", &val, options...)
 	return
 }
 
 // UpdateH323Servers PUTs the h323.servers value to the UTM
-func UpdateH323Servers(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateH323Servers(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/h323.servers", val, options...)
 }
 
@@ -4753,79 +4754,79 @@ func UpdateHaAwsTrustedNetwork(client sophos.ClientInterface, val string, option
 }
 
 // GetHaClusterFtp gets the ha.cluster.ftp value from the UTM
-func GetHaClusterFtp(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHaClusterFtp(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ha.cluster.ftp", &val, options...)
 	return
 }
 
 // UpdateHaClusterFtp PUTs the ha.cluster.ftp value to the UTM
-func UpdateHaClusterFtp(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHaClusterFtp(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ha.cluster.ftp", val, options...)
 }
 
 // GetHaClusterHttp gets the ha.cluster.http value from the UTM
-func GetHaClusterHttp(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHaClusterHttp(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ha.cluster.http", &val, options...)
 	return
 }
 
 // UpdateHaClusterHttp PUTs the ha.cluster.http value to the UTM
-func UpdateHaClusterHttp(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHaClusterHttp(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ha.cluster.http", val, options...)
 }
 
 // GetHaClusterIpsec gets the ha.cluster.ipsec value from the UTM
-func GetHaClusterIpsec(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHaClusterIpsec(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ha.cluster.ipsec", &val, options...)
 	return
 }
 
 // UpdateHaClusterIpsec PUTs the ha.cluster.ipsec value to the UTM
-func UpdateHaClusterIpsec(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHaClusterIpsec(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ha.cluster.ipsec", val, options...)
 }
 
 // GetHaClusterPop3 gets the ha.cluster.pop3 value from the UTM
-func GetHaClusterPop3(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHaClusterPop3(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ha.cluster.pop3", &val, options...)
 	return
 }
 
 // UpdateHaClusterPop3 PUTs the ha.cluster.pop3 value to the UTM
-func UpdateHaClusterPop3(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHaClusterPop3(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ha.cluster.pop3", val, options...)
 }
 
 // GetHaClusterSmtp gets the ha.cluster.smtp value from the UTM
-func GetHaClusterSmtp(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHaClusterSmtp(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ha.cluster.smtp", &val, options...)
 	return
 }
 
 // UpdateHaClusterSmtp PUTs the ha.cluster.smtp value to the UTM
-func UpdateHaClusterSmtp(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHaClusterSmtp(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ha.cluster.smtp", val, options...)
 }
 
 // GetHaClusterSnort gets the ha.cluster.snort value from the UTM
-func GetHaClusterSnort(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHaClusterSnort(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ha.cluster.snort", &val, options...)
 	return
 }
 
 // UpdateHaClusterSnort PUTs the ha.cluster.snort value to the UTM
-func UpdateHaClusterSnort(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHaClusterSnort(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ha.cluster.snort", val, options...)
 }
 
 // GetHaClusterWaf gets the ha.cluster.waf value from the UTM
-func GetHaClusterWaf(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHaClusterWaf(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ha.cluster.waf", &val, options...)
 	return
 }
 
 // UpdateHaClusterWaf PUTs the ha.cluster.waf value to the UTM
-func UpdateHaClusterWaf(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHaClusterWaf(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ha.cluster.waf", val, options...)
 }
 
@@ -5061,24 +5062,24 @@ func UpdateHotspotStatus(client sophos.ClientInterface, val bool, options ...sop
 }
 
 // GetHotspotTransparentSkip gets the hotspot.transparent_skip value from the UTM
-func GetHotspotTransparentSkip(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHotspotTransparentSkip(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/hotspot.transparent_skip", &val, options...)
 	return
 }
 
 // UpdateHotspotTransparentSkip PUTs the hotspot.transparent_skip value to the UTM
-func UpdateHotspotTransparentSkip(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHotspotTransparentSkip(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/hotspot.transparent_skip", val, options...)
 }
 
 // GetHttpAdSsoInterfaces gets the http.ad_sso_interfaces value from the UTM
-func GetHttpAdSsoInterfaces(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHttpAdSsoInterfaces(client sophos.ClientInterface, options ...sophos.Option) (val objects.InterfaceRefs, err error) {
 	err = get(client, "/api/nodes/http.ad_sso_interfaces", &val, options...)
 	return
 }
 
 // UpdateHttpAdSsoInterfaces PUTs the http.ad_sso_interfaces value to the UTM
-func UpdateHttpAdSsoInterfaces(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHttpAdSsoInterfaces(client sophos.ClientInterface, val objects.InterfaceRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/http.ad_sso_interfaces", val, options...)
 }
 
@@ -5116,13 +5117,13 @@ func UpdateHttpAllowTls12(client sophos.ClientInterface, val bool, options ...so
 }
 
 // GetHttpAllowedPuas gets the http.allowed_puas value from the UTM
-func GetHttpAllowedPuas(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHttpAllowedPuas(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/http.allowed_puas", &val, options...)
 	return
 }
 
 // UpdateHttpAllowedPuas PUTs the http.allowed_puas value to the UTM
-func UpdateHttpAllowedPuas(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHttpAllowedPuas(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/http.allowed_puas", val, options...)
 }
 
@@ -5226,13 +5227,13 @@ func UpdateHttpBypassStreaming(client sophos.ClientInterface, val bool, options
 }
 
 // GetHttpCaList gets the http.ca_list value from the UTM
-func GetHttpCaList(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHttpCaList(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/http.ca_list", &val, options...)
 	return
 }
 
 // UpdateHttpCaList PUTs the http.ca_list value to the UTM
-func UpdateHttpCaList(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHttpCaList(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/http.ca_list", val, options...)
 }
 
@@ -5292,13 +5293,13 @@ func UpdateHttpCertstore(client sophos.ClientInterface, val string, options ...s
 }
 
 // GetHttpCffOverrideUsers gets the http.cff_override_users value from the UTM
-func GetHttpCffOverrideUsers(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHttpCffOverrideUsers(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/http.cff_override_users", &val, options...)
 	return
 }
 
 // UpdateHttpCffOverrideUsers PUTs the http.cff_override_users value to the UTM
-func UpdateHttpCffOverrideUsers(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHttpCffOverrideUsers(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/http.cff_override_users", val, options...)
 }
 
@@ -5380,13 +5381,13 @@ func UpdateHttpCtypeUnpackArchive(client sophos.ClientInterface, val bool, optio
 }
 
 // GetHttpDebug gets the http.debug value from the UTM
-func GetHttpDebug(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHttpDebug(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/http.debug", &val, options...)
 	return
 }
 
 // UpdateHttpDebug PUTs the http.debug value to the UTM
-func UpdateHttpDebug(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHttpDebug(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/http.debug", val, options...)
 }
 
@@ -5589,13 +5590,13 @@ func UpdateHttpLimitAdSsoInterfaces(client sophos.ClientInterface, val bool, opt
 }
 
 // GetHttpLocalSiteList gets the http.local_site_list value from the UTM
-func GetHttpLocalSiteList(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHttpLocalSiteList(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/http.local_site_list", &val, options...)
 	return
 }
 
 // UpdateHttpLocalSiteList PUTs the http.local_site_list value to the UTM
-func UpdateHttpLocalSiteList(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHttpLocalSiteList(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/http.local_site_list", val, options...)
 }
 
@@ -5776,13 +5777,13 @@ func UpdateHttpPortalCert(client sophos.ClientInterface, val string, options ...
 }
 
 // GetHttpPortalCertChain gets the http.portal_cert_chain value from the UTM
-func GetHttpPortalCertChain(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHttpPortalCertChain(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/http.portal_cert_chain", &val, options...)
 	return
 }
 
 // UpdateHttpPortalCertChain PUTs the http.portal_cert_chain value to the UTM
-func UpdateHttpPortalCertChain(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHttpPortalCertChain(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/http.portal_cert_chain", val, options...)
 }
 
@@ -5798,13 +5799,13 @@ func UpdateHttpPortalDomain(client sophos.ClientInterface, val string, options .
 }
 
 // GetHttpPortalHosts gets the http.portal_hosts value from the UTM
-func GetHttpPortalHosts(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHttpPortalHosts(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/http.portal_hosts", &val, options...)
 	return
 }
 
 // UpdateHttpPortalHosts PUTs the http.portal_hosts value to the UTM
-func UpdateHttpPortalHosts(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHttpPortalHosts(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/http.portal_hosts", val, options...)
 }
 
@@ -5853,13 +5854,13 @@ func UpdateHttpQuotaSliceTime(client sophos.ClientInterface, val int64, options
 }
 
 // GetHttpRemoveRequest gets the http.remove_request value from the UTM
-func GetHttpRemoveRequest(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHttpRemoveRequest(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/http.remove_request", &val, options...)
 	return
 }
 
 // UpdateHttpRemoveRequest PUTs the http.remove_request value to the UTM
-func UpdateHttpRemoveRequest(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHttpRemoveRequest(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/http.remove_request", val, options...)
 }
 
@@ -5974,13 +5975,13 @@ func UpdateHttpTransparentAuthTimeout(client sophos.ClientInterface, val int64,
 }
 
 // GetHttpTransparentDstSkip gets the http.transparent_dst_skip value from the UTM
-func GetHttpTransparentDstSkip(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHttpTransparentDstSkip(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/http.transparent_dst_skip", &val, options...)
 	return
 }
 
 // UpdateHttpTransparentDstSkip PUTs the http.transparent_dst_skip value to the UTM
-func UpdateHttpTransparentDstSkip(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHttpTransparentDstSkip(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/http.transparent_dst_skip", val, options...)
 }
 
@@ -5996,13 +5997,13 @@ func UpdateHttpTransparentSkipAutoPf(client sophos.ClientInterface, val bool, op
 }
 
 // GetHttpTransparentSrcSkip gets the http.transparent_src_skip value from the UTM
-func GetHttpTransparentSrcSkip(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetHttpTransparentSrcSkip(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/http.transparent_src_skip", &val, options...)
 	return
 }
 
 // UpdateHttpTransparentSrcSkip PUTs the http.transparent_src_skip value to the UTM
-func UpdateHttpTransparentSrcSkip(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateHttpTransparentSrcSkip(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/http.transparent_src_skip", val, options...)
 }
 
@@ -6293,13 +6294,13 @@ func UpdateInterfacesInterfaces(client sophos.ClientInterface, val []string, opt
 }
 
 // GetIpsDnsServers gets the ips.dns_servers value from the UTM
-func GetIpsDnsServers(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetIpsDnsServers(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ips.dns_servers", &val, options...)
 	return
 }
 
 // UpdateIpsDnsServers PUTs the ips.dns_servers value to the UTM
-func UpdateIpsDnsServers(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateIpsDnsServers(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ips.dns_servers", val, options...)
 }
 
@@ -6359,13 +6360,13 @@ func UpdateIpsGroups(client sophos.ClientInterface, val []string, options ...sop
 }
 
 // GetIpsHttpServers gets the ips.http_servers value from the UTM
-func GetIpsHttpServers(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetIpsHttpServers(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ips.http_servers", &val, options...)
 	return
 }
 
 // UpdateIpsHttpServers PUTs the ips.http_servers value to the UTM
-func UpdateIpsHttpServers(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateIpsHttpServers(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ips.http_servers", val, options...)
 }
 
@@ -6403,13 +6404,13 @@ func UpdateIpsIpsfbDebug(client sophos.ClientInterface, val bool, options ...sop
 }
 
 // GetIpsLocalNetworks gets the ips.local_networks value from the UTM
-func GetIpsLocalNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
+func GetIpsLocalNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/ips.local_networks", &val, options...)
 	return
 }
 
 // UpdateIpsLocalNetworks PUTs the ips.local_networks value to the UTM
-func UpdateIpsLocalNetworks(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
+func UpdateIpsLocalNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ips.local_networks", val, options...)
 }
 
@@ -6491,24 +6492,24 @@ func UpdateIpsRestartPolicy(client sophos.ClientInterface, val string, options .
 }
 
 // GetIpsRuleModifiers gets the ips.rule_modifiers value from the UTM
-func GetIpsRuleModifiers(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetIpsRuleModifiers(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ips.rule_modifiers", &val, options...)
 	return
 }
 
 // UpdateIpsRuleModifiers PUTs the ips.rule_modifiers value to the UTM
-func UpdateIpsRuleModifiers(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateIpsRuleModifiers(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ips.rule_modifiers", val, options...)
 }
 
 // GetIpsRules gets the ips.rules value from the UTM
-func GetIpsRules(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetIpsRules(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ips.rules", &val, options...)
 	return
 }
 
 // UpdateIpsRules PUTs the ips.rules value to the UTM
-func UpdateIpsRules(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateIpsRules(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ips.rules", val, options...)
 }
 
@@ -6524,13 +6525,13 @@ func UpdateIpsSkipAcks(client sophos.ClientInterface, val bool, options ...sopho
 }
 
 // GetIpsSmtpServers gets the ips.smtp_servers value from the UTM
-func GetIpsSmtpServers(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetIpsSmtpServers(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ips.smtp_servers", &val, options...)
 	return
 }
 
 // UpdateIpsSmtpServers PUTs the ips.smtp_servers value to the UTM
-func UpdateIpsSmtpServers(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateIpsSmtpServers(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ips.smtp_servers", val, options...)
 }
 
@@ -6601,13 +6602,13 @@ func UpdateIpsSnortsettingsSearchMethod(client sophos.ClientInterface, val strin
 }
 
 // GetIpsSqlServers gets the ips.sql_servers value from the UTM
-func GetIpsSqlServers(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetIpsSqlServers(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ips.sql_servers", &val, options...)
 	return
 }
 
 // UpdateIpsSqlServers PUTs the ips.sql_servers value to the UTM
-func UpdateIpsSqlServers(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateIpsSqlServers(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ips.sql_servers", val, options...)
 }
 
@@ -6656,13 +6657,13 @@ func UpdateIpsecAdvancedDeadPeerDetection(client sophos.ClientInterface, val boo
 }
 
 // GetIpsecAdvancedIkeDebug gets the ipsec.advanced.ike_debug value from the UTM
-func GetIpsecAdvancedIkeDebug(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetIpsecAdvancedIkeDebug(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ipsec.advanced.ike_debug", &val, options...)
 	return
 }
 
 // UpdateIpsecAdvancedIkeDebug PUTs the ipsec.advanced.ike_debug value to the UTM
-func UpdateIpsecAdvancedIkeDebug(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateIpsecAdvancedIkeDebug(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ipsec.advanced.ike_debug", val, options...)
 }
 
@@ -6997,13 +6998,13 @@ func UpdateIpv6Prefer(client sophos.ClientInterface, val bool, options ...sophos
 }
 
 // GetIpv6Prefixes gets the ipv6.prefixes value from the UTM
-func GetIpv6Prefixes(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetIpv6Prefixes(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/ipv6.prefixes", &val, options...)
 	return
 }
 
 // UpdateIpv6Prefixes PUTs the ipv6.prefixes value to the UTM
-func UpdateIpv6Prefixes(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateIpv6Prefixes(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ipv6.prefixes", val, options...)
 }
 
@@ -7118,13 +7119,13 @@ func UpdateLoadbalanceHttpErrorCode(client sophos.ClientInterface, val int64, op
 }
 
 // GetLoadbalanceRules gets the loadbalance.rules value from the UTM
-func GetLoadbalanceRules(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetLoadbalanceRules(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/loadbalance.rules", &val, options...)
 	return
 }
 
 // UpdateLoadbalanceRules PUTs the loadbalance.rules value to the UTM
-func UpdateLoadbalanceRules(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateLoadbalanceRules(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/loadbalance.rules", val, options...)
 }
 
@@ -7448,13 +7449,13 @@ func UpdateMobileControlConfigL2Tp(client sophos.ClientInterface, val bool, opti
 }
 
 // GetMobileControlConfigWifiNetworks gets the mobile_control.config.wifi_networks value from the UTM
-func GetMobileControlConfigWifiNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetMobileControlConfigWifiNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/mobile_control.config.wifi_networks", &val, options...)
 	return
 }
 
 // UpdateMobileControlConfigWifiNetworks PUTs the mobile_control.config.wifi_networks value to the UTM
-func UpdateMobileControlConfigWifiNetworks(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateMobileControlConfigWifiNetworks(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/mobile_control.config.wifi_networks", val, options...)
 }
 
@@ -7547,24 +7548,24 @@ func UpdateMobileControlNacPollInterval(client sophos.ClientInterface, val int64
 }
 
 // GetMobileControlNacUsersDenied gets the mobile_control.nac.users_denied value from the UTM
-func GetMobileControlNacUsersDenied(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetMobileControlNacUsersDenied(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/mobile_control.nac.users_denied", &val, options...)
 	return
 }
 
 // UpdateMobileControlNacUsersDenied PUTs the mobile_control.nac.users_denied value to the UTM
-func UpdateMobileControlNacUsersDenied(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateMobileControlNacUsersDenied(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/mobile_control.nac.users_denied", val, options...)
 }
 
 // GetMobileControlNacWifiNetworks gets the mobile_control.nac.wifi_networks value from the UTM
-func GetMobileControlNacWifiNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetMobileControlNacWifiNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/mobile_control.nac.wifi_networks", &val, options...)
 	return
 }
 
 // UpdateMobileControlNacWifiNetworks PUTs the mobile_control.nac.wifi_networks value to the UTM
-func UpdateMobileControlNacWifiNetworks(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateMobileControlNacWifiNetworks(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/mobile_control.nac.wifi_networks", val, options...)
 }
 
@@ -7646,13 +7647,13 @@ func UpdateNotificationsLimiting(client sophos.ClientInterface, val bool, option
 }
 
 // GetNotificationsOverlay gets the notifications.overlay value from the UTM
-func GetNotificationsOverlay(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetNotificationsOverlay(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/notifications.overlay", &val, options...)
 	return
 }
 
 // UpdateNotificationsOverlay PUTs the notifications.overlay value to the UTM
-func UpdateNotificationsOverlay(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateNotificationsOverlay(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/notifications.overlay", val, options...)
 }
 
@@ -7767,13 +7768,13 @@ func UpdateNotificationsSmtpUsername(client sophos.ClientInterface, val string,
 }
 
 // GetNtpAllowedNetworks gets the ntp.allowed_networks value from the UTM
-func GetNtpAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
+func GetNtpAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/ntp.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateNtpAllowedNetworks PUTs the ntp.allowed_networks value to the UTM
-func UpdateNtpAllowedNetworks(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
+func UpdateNtpAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ntp.allowed_networks", val, options...)
 }
 
@@ -8262,24 +8263,24 @@ func UpdatePimSmDebug(client sophos.ClientInterface, val bool, options ...sophos
 }
 
 // GetPimSmInterfaces gets the pim_sm.interfaces value from the UTM
-func GetPimSmInterfaces(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetPimSmInterfaces(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/pim_sm.interfaces", &val, options...)
 	return
 }
 
 // UpdatePimSmInterfaces PUTs the pim_sm.interfaces value to the UTM
-func UpdatePimSmInterfaces(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdatePimSmInterfaces(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/pim_sm.interfaces", val, options...)
 }
 
 // GetPimSmRpRouters gets the pim_sm.rp_routers value from the UTM
-func GetPimSmRpRouters(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetPimSmRpRouters(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/pim_sm.rp_routers", &val, options...)
 	return
 }
 
 // UpdatePimSmRpRouters PUTs the pim_sm.rp_routers value to the UTM
-func UpdatePimSmRpRouters(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdatePimSmRpRouters(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/pim_sm.rp_routers", val, options...)
 }
 
@@ -8317,13 +8318,13 @@ func UpdatePimSmStatus(client sophos.ClientInterface, val bool, options ...sopho
 }
 
 // GetPop3AllowedClients gets the pop3.allowed_clients value from the UTM
-func GetPop3AllowedClients(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetPop3AllowedClients(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/pop3.allowed_clients", &val, options...)
 	return
 }
 
 // UpdatePop3AllowedClients PUTs the pop3.allowed_clients value to the UTM
-func UpdatePop3AllowedClients(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdatePop3AllowedClients(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/pop3.allowed_clients", val, options...)
 }
 
@@ -8405,24 +8406,24 @@ func UpdatePop3DirectlyDeleteQuarantined(client sophos.ClientInterface, val bool
 }
 
 // GetPop3Exceptions gets the pop3.exceptions value from the UTM
-func GetPop3Exceptions(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetPop3Exceptions(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/pop3.exceptions", &val, options...)
 	return
 }
 
 // UpdatePop3Exceptions PUTs the pop3.exceptions value to the UTM
-func UpdatePop3Exceptions(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdatePop3Exceptions(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/pop3.exceptions", val, options...)
 }
 
 // GetPop3KnownServers gets the pop3.known_servers value from the UTM
-func GetPop3KnownServers(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetPop3KnownServers(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/pop3.known_servers", &val, options...)
 	return
 }
 
 // UpdatePop3KnownServers PUTs the pop3.known_servers value to the UTM
-func UpdatePop3KnownServers(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdatePop3KnownServers(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/pop3.known_servers", val, options...)
 }
 
@@ -8526,13 +8527,13 @@ func UpdatePop3ScanTls(client sophos.ClientInterface, val bool, options ...sopho
 }
 
 // GetPop3SenderBlacklist gets the pop3.sender_blacklist value from the UTM
-func GetPop3SenderBlacklist(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetPop3SenderBlacklist(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/pop3.sender_blacklist", &val, options...)
 	return
 }
 
 // UpdatePop3SenderBlacklist PUTs the pop3.sender_blacklist value to the UTM
-func UpdatePop3SenderBlacklist(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdatePop3SenderBlacklist(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/pop3.sender_blacklist", val, options...)
 }
 
@@ -8548,13 +8549,13 @@ func UpdatePop3Spam(client sophos.ClientInterface, val string, options ...sophos
 }
 
 // GetPop3SpamExpressions gets the pop3.spam_expressions value from the UTM
-func GetPop3SpamExpressions(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetPop3SpamExpressions(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/pop3.spam_expressions", &val, options...)
 	return
 }
 
 // UpdatePop3SpamExpressions PUTs the pop3.spam_expressions value to the UTM
-func UpdatePop3SpamExpressions(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdatePop3SpamExpressions(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/pop3.spam_expressions", val, options...)
 }
 
@@ -8603,13 +8604,13 @@ func UpdatePop3TlsCert(client sophos.ClientInterface, val string, options ...sop
 }
 
 // GetPop3TransparentSkip gets the pop3.transparent_skip value from the UTM
-func GetPop3TransparentSkip(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetPop3TransparentSkip(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/pop3.transparent_skip", &val, options...)
 	return
 }
 
 // UpdatePop3TransparentSkip PUTs the pop3.transparent_skip value to the UTM
-func UpdatePop3TransparentSkip(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdatePop3TransparentSkip(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/pop3.transparent_skip", val, options...)
 }
 
@@ -8647,35 +8648,35 @@ func UpdatePortalAllowAnyUser(client sophos.ClientInterface, val bool, options .
 }
 
 // GetPortalAllowedNetworks gets the portal.allowed_networks value from the UTM
-func GetPortalAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
+func GetPortalAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/portal.allowed_networks", &val, options...)
 	return
 }
 
 // UpdatePortalAllowedNetworks PUTs the portal.allowed_networks value to the UTM
-func UpdatePortalAllowedNetworks(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
+func UpdatePortalAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/portal.allowed_networks", val, options...)
 }
 
 // GetPortalAllowedUsers gets the portal.allowed_users value from the UTM
-func GetPortalAllowedUsers(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetPortalAllowedUsers(client sophos.ClientInterface, options ...sophos.Option) (val objects.AaaRefs, err error) {
 	err = get(client, "/api/nodes/portal.allowed_users", &val, options...)
 	return
 }
 
 // UpdatePortalAllowedUsers PUTs the portal.allowed_users value to the UTM
-func UpdatePortalAllowedUsers(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdatePortalAllowedUsers(client sophos.ClientInterface, val objects.AaaRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/portal.allowed_users", val, options...)
 }
 
 // GetPortalHideItems gets the portal.hide_items value from the UTM
-func GetPortalHideItems(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetPortalHideItems(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/portal.hide_items", &val, options...)
 	return
 }
 
 // UpdatePortalHideItems PUTs the portal.hide_items value to the UTM
-func UpdatePortalHideItems(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdatePortalHideItems(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/portal.hide_items", val, options...)
 }
 
@@ -8977,13 +8978,13 @@ func UpdateRedCaSettingsOrganization(client sophos.ClientInterface, val string,
 }
 
 // GetRedClients gets the red.clients value from the UTM
-func GetRedClients(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetRedClients(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/red.clients", &val, options...)
 	return
 }
 
 // UpdateRedClients PUTs the red.clients value to the UTM
-func UpdateRedClients(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateRedClients(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/red.clients", val, options...)
 }
 
@@ -9054,13 +9055,13 @@ func UpdateRedServerCert(client sophos.ClientInterface, val string, options ...s
 }
 
 // GetRedServers gets the red.servers value from the UTM
-func GetRedServers(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetRedServers(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/red.servers", &val, options...)
 	return
 }
 
 // UpdateRedServers PUTs the red.servers value to the UTM
-func UpdateRedServers(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateRedServers(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/red.servers", val, options...)
 }
 
@@ -9340,13 +9341,13 @@ func UpdateRemoteSyslogBuffer(client sophos.ClientInterface, val int64, options
 }
 
 // GetRemoteSyslogLogs gets the remote_syslog.logs value from the UTM
-func GetRemoteSyslogLogs(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetRemoteSyslogLogs(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/remote_syslog.logs", &val, options...)
 	return
 }
 
 // UpdateRemoteSyslogLogs PUTs the remote_syslog.logs value to the UTM
-func UpdateRemoteSyslogLogs(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateRemoteSyslogLogs(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/remote_syslog.logs", val, options...)
 }
 
@@ -9362,13 +9363,13 @@ func UpdateRemoteSyslogStatus(client sophos.ClientInterface, val bool, options .
 }
 
 // GetRemoteSyslogTarget gets the remote_syslog.target value from the UTM
-func GetRemoteSyslogTarget(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetRemoteSyslogTarget(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/remote_syslog.target", &val, options...)
 	return
 }
 
 // UpdateRemoteSyslogTarget PUTs the remote_syslog.target value to the UTM
-func UpdateRemoteSyslogTarget(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateRemoteSyslogTarget(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/remote_syslog.target", val, options...)
 }
 
@@ -9494,13 +9495,13 @@ func UpdateReportingCsvSeparator(client sophos.ClientInterface, val string, opti
 }
 
 // GetReportingEmailsecurityImport gets the reporting.emailsecurity_import value from the UTM
-func GetReportingEmailsecurityImport(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetReportingEmailsecurityImport(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/reporting.emailsecurity_import", &val, options...)
 	return
 }
 
 // UpdateReportingEmailsecurityImport PUTs the reporting.emailsecurity_import value to the UTM
-func UpdateReportingEmailsecurityImport(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateReportingEmailsecurityImport(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/reporting.emailsecurity_import", val, options...)
 }
 
@@ -9538,68 +9539,68 @@ func UpdateReportingEnableVpnAccounting(client sophos.ClientInterface, val bool,
 }
 
 // GetReportingHideAccountingips gets the reporting.hide_accountingips value from the UTM
-func GetReportingHideAccountingips(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetReportingHideAccountingips(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/reporting.hide_accountingips", &val, options...)
 	return
 }
 
 // UpdateReportingHideAccountingips PUTs the reporting.hide_accountingips value to the UTM
-func UpdateReportingHideAccountingips(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateReportingHideAccountingips(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/reporting.hide_accountingips", val, options...)
 }
 
 // GetReportingHideMailaddresses gets the reporting.hide_mailaddresses value from the UTM
-func GetReportingHideMailaddresses(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetReportingHideMailaddresses(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/reporting.hide_mailaddresses", &val, options...)
 	return
 }
 
 // UpdateReportingHideMailaddresses PUTs the reporting.hide_mailaddresses value to the UTM
-func UpdateReportingHideMailaddresses(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateReportingHideMailaddresses(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/reporting.hide_mailaddresses", val, options...)
 }
 
 // GetReportingHideMaildomains gets the reporting.hide_maildomains value from the UTM
-func GetReportingHideMaildomains(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetReportingHideMaildomains(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/reporting.hide_maildomains", &val, options...)
 	return
 }
 
 // UpdateReportingHideMaildomains PUTs the reporting.hide_maildomains value to the UTM
-func UpdateReportingHideMaildomains(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateReportingHideMaildomains(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/reporting.hide_maildomains", val, options...)
 }
 
 // GetReportingHideNetsecips gets the reporting.hide_netsecips value from the UTM
-func GetReportingHideNetsecips(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetReportingHideNetsecips(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/reporting.hide_netsecips", &val, options...)
 	return
 }
 
 // UpdateReportingHideNetsecips PUTs the reporting.hide_netsecips value to the UTM
-func UpdateReportingHideNetsecips(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateReportingHideNetsecips(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/reporting.hide_netsecips", val, options...)
 }
 
 // GetReportingHideWebdomains gets the reporting.hide_webdomains value from the UTM
-func GetReportingHideWebdomains(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetReportingHideWebdomains(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/reporting.hide_webdomains", &val, options...)
 	return
 }
 
 // UpdateReportingHideWebdomains PUTs the reporting.hide_webdomains value to the UTM
-func UpdateReportingHideWebdomains(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateReportingHideWebdomains(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/reporting.hide_webdomains", val, options...)
 }
 
 // GetReportingIpsImport gets the reporting.ips_import value from the UTM
-func GetReportingIpsImport(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetReportingIpsImport(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/reporting.ips_import", &val, options...)
 	return
 }
 
 // UpdateReportingIpsImport PUTs the reporting.ips_import value to the UTM
-func UpdateReportingIpsImport(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateReportingIpsImport(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/reporting.ips_import", val, options...)
 }
 
@@ -9626,13 +9627,13 @@ func UpdateReportingIpsStatus(client sophos.ClientInterface, val bool, options .
 }
 
 // GetReportingPacketfilterImport gets the reporting.packetfilter_import value from the UTM
-func GetReportingPacketfilterImport(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetReportingPacketfilterImport(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/reporting.packetfilter_import", &val, options...)
 	return
 }
 
 // UpdateReportingPacketfilterImport PUTs the reporting.packetfilter_import value to the UTM
-func UpdateReportingPacketfilterImport(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateReportingPacketfilterImport(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/reporting.packetfilter_import", val, options...)
 }
 
@@ -9758,13 +9759,13 @@ func UpdateReportingWebsecurityDetail(client sophos.ClientInterface, val map[str
 }
 
 // GetReportingWebsecurityImport gets the reporting.websecurity_import value from the UTM
-func GetReportingWebsecurityImport(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetReportingWebsecurityImport(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/reporting.websecurity_import", &val, options...)
 	return
 }
 
 // UpdateReportingWebsecurityImport PUTs the reporting.websecurity_import value to the UTM
-func UpdateReportingWebsecurityImport(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateReportingWebsecurityImport(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/reporting.websecurity_import", val, options...)
 }
 
@@ -10088,13 +10089,13 @@ func UpdateReverseProxyRequestLineLimit(client sophos.ClientInterface, val map[s
 }
 
 // GetReverseProxySlowhttpExceptions gets the reverse_proxy.slowhttp_exceptions value from the UTM
-func GetReverseProxySlowhttpExceptions(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetReverseProxySlowhttpExceptions(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/reverse_proxy.slowhttp_exceptions", &val, options...)
 	return
 }
 
 // UpdateReverseProxySlowhttpExceptions PUTs the reverse_proxy.slowhttp_exceptions value to the UTM
-func UpdateReverseProxySlowhttpExceptions(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateReverseProxySlowhttpExceptions(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/reverse_proxy.slowhttp_exceptions", val, options...)
 }
 
@@ -10187,13 +10188,13 @@ func UpdateReverseProxyWhatkilledus(client sophos.ClientInterface, val bool, opt
 }
 
 // GetRoutesPolicy gets the routes.policy value from the UTM
-func GetRoutesPolicy(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetRoutesPolicy(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/routes.policy", &val, options...)
 	return
 }
 
 // UpdateRoutesPolicy PUTs the routes.policy value to the UTM
-func UpdateRoutesPolicy(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateRoutesPolicy(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/routes.policy", val, options...)
 }
 
@@ -10264,13 +10265,13 @@ func UpdateRoutingBgpStrictMatch(client sophos.ClientInterface, val bool, option
 }
 
 // GetRoutingBgpSystems gets the routing.bgp.systems value from the UTM
-func GetRoutingBgpSystems(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetRoutingBgpSystems(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/routing.bgp.systems", &val, options...)
 	return
 }
 
 // UpdateRoutingBgpSystems PUTs the routing.bgp.systems value to the UTM
-func UpdateRoutingBgpSystems(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateRoutingBgpSystems(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/routing.bgp.systems", val, options...)
 }
 
@@ -10286,13 +10287,13 @@ func UpdateRoutingOspfAbrType(client sophos.ClientInterface, val string, options
 }
 
 // GetRoutingOspfAreas gets the routing.ospf.areas value from the UTM
-func GetRoutingOspfAreas(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetRoutingOspfAreas(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/routing.ospf.areas", &val, options...)
 	return
 }
 
 // UpdateRoutingOspfAreas PUTs the routing.ospf.areas value to the UTM
-func UpdateRoutingOspfAreas(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateRoutingOspfAreas(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/routing.ospf.areas", val, options...)
 }
 
@@ -10440,13 +10441,13 @@ func UpdateRoutingOspfStatus(client sophos.ClientInterface, val bool, options ..
 }
 
 // GetRoutingQuaggaAllowedNetworks gets the routing.quagga.allowed_networks value from the UTM
-func GetRoutingQuaggaAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetRoutingQuaggaAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/routing.quagga.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateRoutingQuaggaAllowedNetworks PUTs the routing.quagga.allowed_networks value to the UTM
-func UpdateRoutingQuaggaAllowedNetworks(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateRoutingQuaggaAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/routing.quagga.allowed_networks", val, options...)
 }
 
@@ -10671,13 +10672,13 @@ func UpdateSandboxdEdgeServerPort(client sophos.ClientInterface, val int64, opti
 }
 
 // GetSandboxdFiletypeSkiplist gets the sandboxd.filetype_skiplist value from the UTM
-func GetSandboxdFiletypeSkiplist(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSandboxdFiletypeSkiplist(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/sandboxd.filetype_skiplist", &val, options...)
 	return
 }
 
 // UpdateSandboxdFiletypeSkiplist PUTs the sandboxd.filetype_skiplist value to the UTM
-func UpdateSandboxdFiletypeSkiplist(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSandboxdFiletypeSkiplist(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/sandboxd.filetype_skiplist", val, options...)
 }
 
@@ -11012,13 +11013,13 @@ func UpdateSettingsTimezone(client sophos.ClientInterface, val string, options .
 }
 
 // GetSipAllowedNetworks gets the sip.allowed_networks value from the UTM
-func GetSipAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
+func GetSipAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/sip.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateSipAllowedNetworks PUTs the sip.allowed_networks value to the UTM
-func UpdateSipAllowedNetworks(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
+func UpdateSipAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/sip.allowed_networks", val, options...)
 }
 
@@ -11133,13 +11134,13 @@ func UpdateSmsClientUsername(client sophos.ClientInterface, val string, options
 }
 
 // GetSmtpAuthAaa gets the smtp.auth_aaa value from the UTM
-func GetSmtpAuthAaa(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSmtpAuthAaa(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/smtp.auth_aaa", &val, options...)
 	return
 }
 
 // UpdateSmtpAuthAaa PUTs the smtp.auth_aaa value to the UTM
-func UpdateSmtpAuthAaa(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSmtpAuthAaa(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/smtp.auth_aaa", val, options...)
 }
 
@@ -11210,13 +11211,13 @@ func UpdateSmtpCffAsMarker(client sophos.ClientInterface, val string, options ..
 }
 
 // GetSmtpDkimDomains gets the smtp.dkim_domains value from the UTM
-func GetSmtpDkimDomains(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSmtpDkimDomains(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/smtp.dkim_domains", &val, options...)
 	return
 }
 
 // UpdateSmtpDkimDomains PUTs the smtp.dkim_domains value to the UTM
-func UpdateSmtpDkimDomains(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSmtpDkimDomains(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/smtp.dkim_domains", val, options...)
 }
 
@@ -11265,13 +11266,13 @@ func UpdateSmtpEncryptionUtility(client sophos.ClientInterface, val string, opti
 }
 
 // GetSmtpExceptions gets the smtp.exceptions value from the UTM
-func GetSmtpExceptions(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSmtpExceptions(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/smtp.exceptions", &val, options...)
 	return
 }
 
 // UpdateSmtpExceptions PUTs the smtp.exceptions value to the UTM
-func UpdateSmtpExceptions(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSmtpExceptions(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/smtp.exceptions", val, options...)
 }
 
@@ -11320,13 +11321,13 @@ func UpdateSmtpGlobalProfile(client sophos.ClientInterface, val string, options
 }
 
 // GetSmtpHostBlacklist gets the smtp.host_blacklist value from the UTM
-func GetSmtpHostBlacklist(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSmtpHostBlacklist(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/smtp.host_blacklist", &val, options...)
 	return
 }
 
 // UpdateSmtpHostBlacklist PUTs the smtp.host_blacklist value to the UTM
-func UpdateSmtpHostBlacklist(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSmtpHostBlacklist(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/smtp.host_blacklist", val, options...)
 }
 
@@ -11441,13 +11442,13 @@ func UpdateSmtpPostmaster(client sophos.ClientInterface, val string, options ...
 }
 
 // GetSmtpProfiles gets the smtp.profiles value from the UTM
-func GetSmtpProfiles(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSmtpProfiles(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/smtp.profiles", &val, options...)
 	return
 }
 
 // UpdateSmtpProfiles PUTs the smtp.profiles value to the UTM
-func UpdateSmtpProfiles(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSmtpProfiles(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/smtp.profiles", val, options...)
 }
 
@@ -11463,13 +11464,13 @@ func UpdateSmtpRecipientsMax(client sophos.ClientInterface, val int64, options .
 }
 
 // GetSmtpRelays gets the smtp.relays value from the UTM
-func GetSmtpRelays(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSmtpRelays(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/smtp.relays", &val, options...)
 	return
 }
 
 // UpdateSmtpRelays PUTs the smtp.relays value to the UTM
-func UpdateSmtpRelays(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSmtpRelays(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/smtp.relays", val, options...)
 }
 
@@ -11628,13 +11629,13 @@ func UpdateSmtpStatus(client sophos.ClientInterface, val bool, options ...sophos
 }
 
 // GetSmtpTlsAvoid gets the smtp.tls_avoid value from the UTM
-func GetSmtpTlsAvoid(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSmtpTlsAvoid(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/smtp.tls_avoid", &val, options...)
 	return
 }
 
 // UpdateSmtpTlsAvoid PUTs the smtp.tls_avoid value to the UTM
-func UpdateSmtpTlsAvoid(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSmtpTlsAvoid(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/smtp.tls_avoid", val, options...)
 }
 
@@ -11650,24 +11651,24 @@ func UpdateSmtpTlsCert(client sophos.ClientInterface, val string, options ...sop
 }
 
 // GetSmtpTlsRequire gets the smtp.tls_require value from the UTM
-func GetSmtpTlsRequire(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSmtpTlsRequire(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/smtp.tls_require", &val, options...)
 	return
 }
 
 // UpdateSmtpTlsRequire PUTs the smtp.tls_require value to the UTM
-func UpdateSmtpTlsRequire(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSmtpTlsRequire(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/smtp.tls_require", val, options...)
 }
 
 // GetSmtpTlsRequireSenderDomains gets the smtp.tls_require_sender_domains value from the UTM
-func GetSmtpTlsRequireSenderDomains(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSmtpTlsRequireSenderDomains(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/smtp.tls_require_sender_domains", &val, options...)
 	return
 }
 
 // UpdateSmtpTlsRequireSenderDomains PUTs the smtp.tls_require_sender_domains value to the UTM
-func UpdateSmtpTlsRequireSenderDomains(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSmtpTlsRequireSenderDomains(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/smtp.tls_require_sender_domains", val, options...)
 }
 
@@ -11694,13 +11695,13 @@ func UpdateSmtpTransparent(client sophos.ClientInterface, val bool, options ...s
 }
 
 // GetSmtpTransparentSkip gets the smtp.transparent_skip value from the UTM
-func GetSmtpTransparentSkip(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSmtpTransparentSkip(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/smtp.transparent_skip", &val, options...)
 	return
 }
 
 // UpdateSmtpTransparentSkip PUTs the smtp.transparent_skip value to the UTM
-func UpdateSmtpTransparentSkip(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSmtpTransparentSkip(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/smtp.transparent_skip", val, options...)
 }
 
@@ -11716,13 +11717,13 @@ func UpdateSmtpTransparentSkipAutoPf(client sophos.ClientInterface, val bool, op
 }
 
 // GetSmtpUpstreamHosts gets the smtp.upstream_hosts value from the UTM
-func GetSmtpUpstreamHosts(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSmtpUpstreamHosts(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/smtp.upstream_hosts", &val, options...)
 	return
 }
 
 // UpdateSmtpUpstreamHosts PUTs the smtp.upstream_hosts value to the UTM
-func UpdateSmtpUpstreamHosts(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSmtpUpstreamHosts(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/smtp.upstream_hosts", val, options...)
 }
 
@@ -11738,13 +11739,13 @@ func UpdateSmtpUpstreamHostsOnly(client sophos.ClientInterface, val bool, option
 }
 
 // GetSnmpAllowedNetworks gets the snmp.allowed_networks value from the UTM
-func GetSnmpAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSnmpAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/snmp.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateSnmpAllowedNetworks PUTs the snmp.allowed_networks value to the UTM
-func UpdateSnmpAllowedNetworks(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSnmpAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/snmp.allowed_networks", val, options...)
 }
 
@@ -11848,13 +11849,13 @@ func UpdateSnmpStatus(client sophos.ClientInterface, val bool, options ...sophos
 }
 
 // GetSnmpTraps gets the snmp.traps value from the UTM
-func GetSnmpTraps(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSnmpTraps(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/snmp.traps", &val, options...)
 	return
 }
 
 // UpdateSnmpTraps PUTs the snmp.traps value to the UTM
-func UpdateSnmpTraps(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSnmpTraps(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/snmp.traps", val, options...)
 }
 
@@ -11903,13 +11904,13 @@ func UpdateSocksAaa(client sophos.ClientInterface, val []string, options ...soph
 }
 
 // GetSocksAllowedNetworks gets the socks.allowed_networks value from the UTM
-func GetSocksAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
+func GetSocksAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/socks.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateSocksAllowedNetworks PUTs the socks.allowed_networks value to the UTM
-func UpdateSocksAllowedNetworks(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
+func UpdateSocksAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/socks.allowed_networks", val, options...)
 }
 
@@ -12013,13 +12014,13 @@ func UpdateSpxGlobalPasswordStrengthRequireSpecChars(client sophos.ClientInterfa
 }
 
 // GetSpxGlobalPortalSettingsAllowedNetworks gets the spx.global.portal_settings.allowed_networks value from the UTM
-func GetSpxGlobalPortalSettingsAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSpxGlobalPortalSettingsAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/spx.global.portal_settings.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateSpxGlobalPortalSettingsAllowedNetworks PUTs the spx.global.portal_settings.allowed_networks value to the UTM
-func UpdateSpxGlobalPortalSettingsAllowedNetworks(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSpxGlobalPortalSettingsAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/spx.global.portal_settings.allowed_networks", val, options...)
 }
 
@@ -12145,13 +12146,13 @@ func UpdateSpxTemplates(client sophos.ClientInterface, val []string, options ...
 }
 
 // GetSshAllowedNetworks gets the ssh.allowed_networks value from the UTM
-func GetSshAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
+func GetSshAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/ssh.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateSshAllowedNetworks PUTs the ssh.allowed_networks value to the UTM
-func UpdateSshAllowedNetworks(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
+func UpdateSshAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/ssh.allowed_networks", val, options...)
 }
 
@@ -12442,13 +12443,13 @@ func UpdateSupportAccessLifetimeEnd(client sophos.ClientInterface, val map[strin
 }
 
 // GetSupportAccessSshKeys gets the support_access.ssh_keys value from the UTM
-func GetSupportAccessSshKeys(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetSupportAccessSshKeys(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/support_access.ssh_keys", &val, options...)
 	return
 }
 
 // UpdateSupportAccessSshKeys PUTs the support_access.ssh_keys value to the UTM
-func UpdateSupportAccessSshKeys(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateSupportAccessSshKeys(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/support_access.ssh_keys", val, options...)
 }
 
@@ -12464,13 +12465,13 @@ func UpdateSupportAccessStatus(client sophos.ClientInterface, val bool, options
 }
 
 // GetU2DcacheAllowedNetworks gets the u2dcache.allowed_networks value from the UTM
-func GetU2DcacheAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetU2DcacheAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/u2dcache.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateU2DcacheAllowedNetworks PUTs the u2dcache.allowed_networks value to the UTM
-func UpdateU2DcacheAllowedNetworks(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateU2DcacheAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/u2dcache.allowed_networks", val, options...)
 }
 
@@ -12629,13 +12630,13 @@ func UpdateUp2DatePatternDownloadStatus(client sophos.ClientInterface, val bool,
 }
 
 // GetUp2DateScheduledUp2Date gets the up2date.scheduled_up2date value from the UTM
-func GetUp2DateScheduledUp2Date(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetUp2DateScheduledUp2Date(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/up2date.scheduled_up2date", &val, options...)
 	return
 }
 
 // UpdateUp2DateScheduledUp2Date PUTs the up2date.scheduled_up2date value to the UTM
-func UpdateUp2DateScheduledUp2Date(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateUp2DateScheduledUp2Date(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/up2date.scheduled_up2date", val, options...)
 }
 
@@ -12695,13 +12696,13 @@ func UpdateUp2DateSystemDownloadStatus(client sophos.ClientInterface, val bool,
 }
 
 // GetUplinkActions gets the uplink.actions value from the UTM
-func GetUplinkActions(client sophos.ClientInterface, options ...sophos.Option) (val []interface{}, err error) {
+func GetUplinkActions(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
 	err = get(client, "/api/nodes/uplink.actions", &val, options...)
 	return
 }
 
 // UpdateUplinkActions PUTs the uplink.actions value to the UTM
-func UpdateUplinkActions(client sophos.ClientInterface, val []interface{}, options ...sophos.Option) (err error) {
+func UpdateUplinkActions(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/uplink.actions", val, options...)
 }
 
@@ -12805,13 +12806,13 @@ func UpdateUplinkStatus(client sophos.ClientInterface, val bool, options ...soph
 }
 
 // GetWebadminAllowedNetworks gets the webadmin.allowed_networks value from the UTM
-func GetWebadminAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val []string, err error) {
+func GetWebadminAllowedNetworks(client sophos.ClientInterface, options ...sophos.Option) (val objects.NetworkRefs, err error) {
 	err = get(client, "/api/nodes/webadmin.allowed_networks", &val, options...)
 	return
 }
 
 // UpdateWebadminAllowedNetworks PUTs the webadmin.allowed_networks value to the UTM
-func UpdateWebadminAllowedNetworks(client sophos.ClientInterface, val []string, options ...sophos.Option) (err error) {
+func UpdateWebadminAllowedNetworks(client sophos.ClientInterface, val objects.NetworkRefs, options ...sophos.Option) (err error) {
 	return put(client, "/api/nodes/webadmin.allowed_networks", val, options...)
 }
 
@@ -3,7 +3,10 @@
 // This file was generated by bin/gen.go! DO NOT EDIT!
 package nodes
 
-import "github.com/esurdam/go-sophos"
+import (
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+)
 
 // AccServer1AuthSecret represents the acc.server1.auth.secret node and implements sophos.Node
 type AccServer1AuthSecret struct{ Value string }
@@ -188,7 +191,7 @@ func (a *AccStatus) Update(client sophos.ClientInterface, options ...sophos.Opti
 }
 
 // AccdAccessAllowedAdmins represents the accd.access.allowed_admins node and implements sophos.Node
-type AccdAccessAllowedAdmins struct{ Value []interface{} }
+type AccdAccessAllowedAdmins struct{ Value objects.AaaRefs }
 
 // Get gets the accd.access.allowed_admins value from the UTM
 func (a *AccdAccessAllowedAdmins) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -201,7 +204,7 @@ func (a *AccdAccessAllowedAdmins) Update(client sophos.ClientInterface, options
 }
 
 // AccdAccessAllowedNetworks represents the accd.access.allowed_networks node and implements sophos.Node
-type AccdAccessAllowedNetworks struct{ Value []interface{} }
+type AccdAccessAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the accd.access.allowed_networks value from the UTM
 func (a *AccdAccessAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -214,7 +217,7 @@ func (a *AccdAccessAllowedNetworks) Update(client sophos.ClientInterface, option
 }
 
 // AccdAccessAllowedUsers represents the accd.access.allowed_users node and implements sophos.Node
-type AccdAccessAllowedUsers struct{ Value []interface{} }
+type AccdAccessAllowedUsers struct{ Value objects.AaaRefs }
 
 // Get gets the accd.access.allowed_users value from the UTM
 func (a *AccdAccessAllowedUsers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -253,7 +256,7 @@ func (a *AccdAccessPort) Update(client sophos.ClientInterface, options ...sophos
 }
 
 // AccdDevicesAllowedNetworks represents the accd.devices.allowed_networks node and implements sophos.Node
-type AccdDevicesAllowedNetworks struct{ Value []interface{} }
+type AccdDevicesAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the accd.devices.allowed_networks value from the UTM
 func (a *AccdDevicesAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -331,7 +334,7 @@ func (a *AccdDevicesPort) Update(client sophos.ClientInterface, options ...sopho
 }
 
 // AccdGeneralAllowedNetworks represents the accd.general.allowed_networks node and implements sophos.Node
-type AccdGeneralAllowedNetworks struct{ Value []interface{} }
+type AccdGeneralAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the accd.general.allowed_networks value from the UTM
 func (a *AccdGeneralAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -396,7 +399,7 @@ func (a *AccdGeneralTimeout) Update(client sophos.ClientInterface, options ...so
 }
 
 // AccountingIpfixConnections represents the accounting.ipfix.connections node and implements sophos.Node
-type AccountingIpfixConnections struct{ Value []interface{} }
+type AccountingIpfixConnections struct{ Value []string }
 
 // Get gets the accounting.ipfix.connections value from the UTM
 func (a *AccountingIpfixConnections) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -422,7 +425,7 @@ func (a *AccountingIpfixStatus) Update(client sophos.ClientInterface, options ..
 }
 
 // AfcControlledNetworks represents the afc.controlled_networks node and implements sophos.Node
-type AfcControlledNetworks struct{ Value []string }
+type AfcControlledNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the afc.controlled_networks value from the UTM
 func (a *AfcControlledNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -435,7 +438,7 @@ func (a *AfcControlledNetworks) Update(client sophos.ClientInterface, options ..
 }
 
 // AfcHiddenSkip represents the afc.hidden_skip node and implements sophos.Node
-type AfcHiddenSkip struct{ Value []string }
+type AfcHiddenSkip struct{ Value objects.NetworkRefs }
 
 // Get gets the afc.hidden_skip value from the UTM
 func (a *AfcHiddenSkip) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -539,7 +542,7 @@ func (a *AfcSubmitUnknownTrafficData) Update(client sophos.ClientInterface, opti
 }
 
 // AfcTransparentSkip represents the afc.transparent_skip node and implements sophos.Node
-type AfcTransparentSkip struct{ Value []interface{} }
+type AfcTransparentSkip struct{ Value objects.NetworkRefs }
 
 // Get gets the afc.transparent_skip value from the UTM
 func (a *AfcTransparentSkip) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -578,7 +581,7 @@ func (a *AmazonVpcConnections) Update(client sophos.ClientInterface, options ...
 }
 
 // AmazonVpcNetworks represents the amazon_vpc.networks node and implements sophos.Node
-type AmazonVpcNetworks struct{ Value []string }
+type AmazonVpcNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the amazon_vpc.networks value from the UTM
 func (a *AmazonVpcNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -617,7 +620,7 @@ func (a *AptpPolicy) Update(client sophos.ClientInterface, options ...sophos.Opt
 }
 
 // AptpRuleModifiers represents the aptp.rule_modifiers node and implements sophos.Node
-type AptpRuleModifiers struct{ Value []interface{} }
+type AptpRuleModifiers struct{ Value []string }
 
 // Get gets the aptp.rule_modifiers value from the UTM
 func (a *AptpRuleModifiers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -643,7 +646,7 @@ func (a *AptpStatus) Update(client sophos.ClientInterface, options ...sophos.Opt
 }
 
 // AptpTransparentSkip represents the aptp.transparent_skip node and implements sophos.Node
-type AptpTransparentSkip struct{ Value []interface{} }
+type AptpTransparentSkip struct{ Value objects.NetworkRefs }
 
 // Get gets the aptp.transparent_skip value from the UTM
 func (a *AptpTransparentSkip) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -955,7 +958,7 @@ func (a *AuthAdSsoSsoUsername) Update(client sophos.ClientInterface, options ...
 }
 
 // AuthApiTokens represents the auth.api_tokens node and implements sophos.Node
-type AuthApiTokens struct{ Value map[string]interface{} }
+type AuthApiTokens struct{ Value map[string]string }
 
 // Get gets the auth.api_tokens value from the UTM
 func (a *AuthApiTokens) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -1033,7 +1036,7 @@ func (a *AuthBlockLockout) Update(client sophos.ClientInterface, options ...soph
 }
 
 // AuthBlockNever represents the auth.block.never node and implements sophos.Node
-type AuthBlockNever struct{ Value []interface{} }
+type AuthBlockNever struct{ Value []string }
 
 // Get gets the auth.block.never value from the UTM
 func (a *AuthBlockNever) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -1254,7 +1257,7 @@ func (a *AuthOtpRequireAllUsers) Update(client sophos.ClientInterface, options .
 }
 
 // AuthOtpRequiredUsers represents the auth.otp.required_users node and implements sophos.Node
-type AuthOtpRequiredUsers struct{ Value []interface{} }
+type AuthOtpRequiredUsers struct{ Value objects.AaaRefs }
 
 // Get gets the auth.otp.required_users value from the UTM
 func (a *AuthOtpRequiredUsers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -1280,7 +1283,7 @@ func (a *AuthOtpStatus) Update(client sophos.ClientInterface, options ...sophos.
 }
 
 // AuthServers represents the auth.servers node and implements sophos.Node
-type AuthServers struct{ Value []interface{} }
+type AuthServers struct{ Value []string }
 
 // Get gets the auth.servers value from the UTM
 func (a *AuthServers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -1319,7 +1322,7 @@ func (a *AuthUpdateBackendGroupMembersStatus) Update(client sophos.ClientInterfa
 }
 
 // AweAllowedInterfaces represents the awe.allowed_interfaces node and implements sophos.Node
-type AweAllowedInterfaces struct{ Value []interface{} }
+type AweAllowedInterfaces struct{ Value objects.InterfaceRefs }
 
 // Get gets the awe.allowed_interfaces value from the UTM
 func (a *AweAllowedInterfaces) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -1332,7 +1335,7 @@ func (a *AweAllowedInterfaces) Update(client sophos.ClientInterface, options ...
 }
 
 // AweClients represents the awe.clients node and implements sophos.Node
-type AweClients struct{ Value []interface{} }
+type AweClients struct{ Value []string }
 
 // Get gets the awe.clients value from the UTM
 func (a *AweClients) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -1345,7 +1348,7 @@ func (a *AweClients) Update(client sophos.ClientInterface, options ...sophos.Opt
 }
 
 // AweDevices represents the awe.devices node and implements sophos.Node
-type AweDevices struct{ Value []interface{} }
+type AweDevices struct{ Value []string }
 
 // Get gets the awe.devices value from the UTM
 func (a *AweDevices) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -1566,7 +1569,7 @@ func (a *AweGlobalVlantagging) Update(client sophos.ClientInterface, options ...
 }
 
 // AweNetworks represents the awe.networks node and implements sophos.Node
-type AweNetworks struct{ Value []string }
+type AweNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the awe.networks value from the UTM
 func (a *AweNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -1579,7 +1582,7 @@ func (a *AweNetworks) Update(client sophos.ClientInterface, options ...sophos.Op
 }
 
 // AwscliProfiles represents the awscli.profiles node and implements sophos.Node
-type AwscliProfiles struct{ Value []interface{} }
+type AwscliProfiles struct{ Value []string }
 
 // Get gets the awscli.profiles value from the UTM
 func (a *AwscliProfiles) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -1748,7 +1751,7 @@ func (c *CaGlobalCasEmailEncryptionTrustNewCas) Update(client sophos.ClientInter
 }
 
 // CaGlobalCasEmailEncryptionTrusted represents the ca.global_cas.email_encryption.trusted node and implements sophos.Node
-type CaGlobalCasEmailEncryptionTrusted struct{ Value []interface{} }
+type CaGlobalCasEmailEncryptionTrusted struct{ Value []string }
 
 // Get gets the ca.global_cas.email_encryption.trusted value from the UTM
 func (c *CaGlobalCasEmailEncryptionTrusted) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -1761,7 +1764,7 @@ func (c *CaGlobalCasEmailEncryptionTrusted) Update(client sophos.ClientInterface
 }
 
 // CaGlobalCasEmailEncryptionUntrusted represents the ca.global_cas.email_encryption.untrusted node and implements sophos.Node
-type CaGlobalCasEmailEncryptionUntrusted struct{ Value []interface{} }
+type CaGlobalCasEmailEncryptionUntrusted struct{ Value []string }
 
 // Get gets the ca.global_cas.email_encryption.untrusted value from the UTM
 func (c *CaGlobalCasEmailEncryptionUntrusted) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -1787,7 +1790,7 @@ func (c *CaGlobalCasHttpProxyTrustNewCas) Update(client sophos.ClientInterface,
 }
 
 // CaGlobalCasHttpProxyTrusted represents the ca.global_cas.http_proxy.trusted node and implements sophos.Node
-type CaGlobalCasHttpProxyTrusted struct{ Value []interface{} }
+type CaGlobalCasHttpProxyTrusted struct{ Value []string }
 
 // Get gets the ca.global_cas.http_proxy.trusted value from the UTM
 func (c *CaGlobalCasHttpProxyTrusted) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -1800,7 +1803,7 @@ func (c *CaGlobalCasHttpProxyTrusted) Update(client sophos.ClientInterface, opti
 }
 
 // CaGlobalCasHttpProxyUntrusted represents the ca.global_cas.http_proxy.untrusted node and implements sophos.Node
-type CaGlobalCasHttpProxyUntrusted struct{ Value []interface{} }
+type CaGlobalCasHttpProxyUntrusted struct{ Value []string }
 
 // Get gets the ca.global_cas.http_proxy.untrusted value from the UTM
 func (c *CaGlobalCasHttpProxyUntrusted) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -1813,7 +1816,7 @@ func (c *CaGlobalCasHttpProxyUntrusted) Update(client sophos.ClientInterface, op
 }
 
 // CrlsCrls represents the crls.crls node and implements sophos.Node
-type CrlsCrls struct{ Value []interface{} }
+type CrlsCrls struct{ Value []string }
 
 // Get gets the crls.crls value from the UTM
 func (c *CrlsCrls) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -1969,7 +1972,7 @@ func (d *DhcpRelayDhcpServer) Update(client sophos.ClientInterface, options ...s
 }
 
 // DhcpRelayInterfaces represents the dhcp.relay.interfaces node and implements sophos.Node
-type DhcpRelayInterfaces struct{ Value []interface{} }
+type DhcpRelayInterfaces struct{ Value objects.InterfaceRefs }
 
 // Get gets the dhcp.relay.interfaces value from the UTM
 func (d *DhcpRelayInterfaces) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -1995,7 +1998,7 @@ func (d *DhcpRelayStatus) Update(client sophos.ClientInterface, options ...sopho
 }
 
 // DhcpRelay6ItfsFacingClients represents the dhcp.relay6.itfs_facing_clients node and implements sophos.Node
-type DhcpRelay6ItfsFacingClients struct{ Value []interface{} }
+type DhcpRelay6ItfsFacingClients struct{ Value []string }
 
 // Get gets the dhcp.relay6.itfs_facing_clients value from the UTM
 func (d *DhcpRelay6ItfsFacingClients) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -2008,7 +2011,7 @@ func (d *DhcpRelay6ItfsFacingClients) Update(client sophos.ClientInterface, opti
 }
 
 // DhcpRelay6ItfsFacingServer6 represents the dhcp.relay6.itfs_facing_server6 node and implements sophos.Node
-type DhcpRelay6ItfsFacingServer6 struct{ Value []interface{} }
+type DhcpRelay6ItfsFacingServer6 struct{ Value []string }
 
 // Get gets the dhcp.relay6.itfs_facing_server6 value from the UTM
 func (d *DhcpRelay6ItfsFacingServer6) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -2073,7 +2076,7 @@ func (d *DhcpServerServers) Update(client sophos.ClientInterface, options ...sop
 }
 
 // DigestAllowedNetworks represents the digest.allowed_networks node and implements sophos.Node
-type DigestAllowedNetworks struct{ Value []interface{} }
+type DigestAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the digest.allowed_networks value from the UTM
 func (d *DigestAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -2125,7 +2128,7 @@ func (d *DigestHostname) Update(client sophos.ClientInterface, options ...sophos
 }
 
 // DigestMailinglists represents the digest.mailinglists node and implements sophos.Node
-type DigestMailinglists struct{ Value []interface{} }
+type DigestMailinglists struct{ Value []string }
 
 // Get gets the digest.mailinglists value from the UTM
 func (d *DigestMailinglists) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -2177,7 +2180,7 @@ func (d *DigestSendTimeTwo) Update(client sophos.ClientInterface, options ...sop
 }
 
 // DigestSkiplist represents the digest.skiplist node and implements sophos.Node
-type DigestSkiplist struct{ Value []interface{} }
+type DigestSkiplist struct{ Value []string }
 
 // Get gets the digest.skiplist value from the UTM
 func (d *DigestSkiplist) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -2216,7 +2219,7 @@ func (d *DigestUserRelease) Update(client sophos.ClientInterface, options ...sop
 }
 
 // DnsAllowedNetworks represents the dns.allowed_networks node and implements sophos.Node
-type DnsAllowedNetworks struct{ Value []string }
+type DnsAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the dns.allowed_networks value from the UTM
 func (d *DnsAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -2229,7 +2232,7 @@ func (d *DnsAllowedNetworks) Update(client sophos.ClientInterface, options ...so
 }
 
 // DnsAxfr represents the dns.axfr node and implements sophos.Node
-type DnsAxfr struct{ Value []interface{} }
+type DnsAxfr struct{ Value []string }
 
 // Get gets the dns.axfr value from the UTM
 func (d *DnsAxfr) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -2333,7 +2336,7 @@ func (d *DnsRoutes) Update(client sophos.ClientInterface, options ...sophos.Opti
 }
 
 // DyndnsRules represents the dyndns.rules node and implements sophos.Node
-type DyndnsRules struct{ Value []interface{} }
+type DyndnsRules struct{ Value []string }
 
 // Get gets the dyndns.rules value from the UTM
 func (d *DyndnsRules) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -2502,7 +2505,7 @@ func (e *EmailpkiObjectsCas) Update(client sophos.ClientInterface, options ...so
 }
 
 // EmailpkiObjectsOpenpgp represents the emailpki.objects.openpgp node and implements sophos.Node
-type EmailpkiObjectsOpenpgp struct{ Value []interface{} }
+type EmailpkiObjectsOpenpgp struct{ Value []string }
 
 // Get gets the emailpki.objects.openpgp value from the UTM
 func (e *EmailpkiObjectsOpenpgp) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -2515,7 +2518,7 @@ func (e *EmailpkiObjectsOpenpgp) Update(client sophos.ClientInterface, options .
 }
 
 // EmailpkiObjectsSmime represents the emailpki.objects.smime node and implements sophos.Node
-type EmailpkiObjectsSmime struct{ Value []interface{} }
+type EmailpkiObjectsSmime struct{ Value []string }
 
 // Get gets the emailpki.objects.smime value from the UTM
 func (e *EmailpkiObjectsSmime) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -2528,7 +2531,7 @@ func (e *EmailpkiObjectsSmime) Update(client sophos.ClientInterface, options ...
 }
 
 // EmailpkiObjectsUsers represents the emailpki.objects.users node and implements sophos.Node
-type EmailpkiObjectsUsers struct{ Value []interface{} }
+type EmailpkiObjectsUsers struct{ Value []string }
 
 // Get gets the emailpki.objects.users value from the UTM
 func (e *EmailpkiObjectsUsers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -2645,7 +2648,7 @@ func (e *EmailpkiOptionsPolicyVerify) Update(client sophos.ClientInterface, opti
 }
 
 // EndpointAacAllowedNetworks represents the endpoint.aac.allowed_networks node and implements sophos.Node
-type EndpointAacAllowedNetworks struct{ Value []string }
+type EndpointAacAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the endpoint.aac.allowed_networks value from the UTM
 func (e *EndpointAacAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -2658,7 +2661,7 @@ func (e *EndpointAacAllowedNetworks) Update(client sophos.ClientInterface, optio
 }
 
 // EndpointAacAllowedUsers represents the endpoint.aac.allowed_users node and implements sophos.Node
-type EndpointAacAllowedUsers struct{ Value []string }
+type EndpointAacAllowedUsers struct{ Value objects.AaaRefs }
 
 // Get gets the endpoint.aac.allowed_users value from the UTM
 func (e *EndpointAacAllowedUsers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -2736,7 +2739,7 @@ func (e *EndpointAacStatus) Update(client sophos.ClientInterface, options ...sop
 }
 
 // EndpointStasCollectors represents the endpoint.stas.collectors node and implements sophos.Node
-type EndpointStasCollectors struct{ Value []interface{} }
+type EndpointStasCollectors struct{ Value []string }
 
 // Get gets the endpoint.stas.collectors value from the UTM
 func (e *EndpointStasCollectors) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -3815,7 +3818,7 @@ func (e *EnduserMessagesSquidCacheAdminMessage) Update(client sophos.ClientInter
 }
 
 // EppAllowedNetworks represents the epp.allowed_networks node and implements sophos.Node
-type EppAllowedNetworks struct{ Value []string }
+type EppAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the epp.allowed_networks value from the UTM
 func (e *EppAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -3880,7 +3883,7 @@ func (e *EppDefaultEndpointsGroup) Update(client sophos.ClientInterface, options
 }
 
 // EppDevices represents the epp.devices node and implements sophos.Node
-type EppDevices struct{ Value []interface{} }
+type EppDevices struct{ Value []string }
 
 // Get gets the epp.devices value from the UTM
 func (e *EppDevices) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -3906,7 +3909,7 @@ func (e *EppEmail) Update(client sophos.ClientInterface, options ...sophos.Optio
 }
 
 // EppEndpoints represents the epp.endpoints node and implements sophos.Node
-type EppEndpoints struct{ Value []interface{} }
+type EppEndpoints struct{ Value []string }
 
 // Get gets the epp.endpoints value from the UTM
 func (e *EppEndpoints) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -3932,7 +3935,7 @@ func (e *EppEndpointsGroups) Update(client sophos.ClientInterface, options ...so
 }
 
 // EppExceptionsAv represents the epp.exceptions.av node and implements sophos.Node
-type EppExceptionsAv struct{ Value []interface{} }
+type EppExceptionsAv struct{ Value []string }
 
 // Get gets the epp.exceptions.av value from the UTM
 func (e *EppExceptionsAv) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -3945,7 +3948,7 @@ func (e *EppExceptionsAv) Update(client sophos.ClientInterface, options ...sopho
 }
 
 // EppExceptionsDc represents the epp.exceptions.dc node and implements sophos.Node
-type EppExceptionsDc struct{ Value []interface{} }
+type EppExceptionsDc struct{ Value []string }
 
 // Get gets the epp.exceptions.dc value from the UTM
 func (e *EppExceptionsDc) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -4244,7 +4247,7 @@ func (e *ExecutiveReportDailyKeep) Update(client sophos.ClientInterface, options
 }
 
 // ExecutiveReportDailyPdfrecipients represents the executive_report.daily.pdfrecipients node and implements sophos.Node
-type ExecutiveReportDailyPdfrecipients struct{ Value []interface{} }
+type ExecutiveReportDailyPdfrecipients struct{ Value []string }
 
 // Get gets the executive_report.daily.pdfrecipients value from the UTM
 func (e *ExecutiveReportDailyPdfrecipients) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -4309,7 +4312,7 @@ func (e *ExecutiveReportMonthlyKeep) Update(client sophos.ClientInterface, optio
 }
 
 // ExecutiveReportMonthlyPdfrecipients represents the executive_report.monthly.pdfrecipients node and implements sophos.Node
-type ExecutiveReportMonthlyPdfrecipients struct{ Value []interface{} }
+type ExecutiveReportMonthlyPdfrecipients struct{ Value []string }
 
 // Get gets the executive_report.monthly.pdfrecipients value from the UTM
 func (e *ExecutiveReportMonthlyPdfrecipients) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -4322,7 +4325,7 @@ func (e *ExecutiveReportMonthlyPdfrecipients) Update(client sophos.ClientInterfa
 }
 
 // ExecutiveReportMonthlyRecipients represents the executive_report.monthly.recipients node and implements sophos.Node
-type ExecutiveReportMonthlyRecipients struct{ Value []interface{} }
+type ExecutiveReportMonthlyRecipients struct{ Value []string }
 
 // Get gets the executive_report.monthly.recipients value from the UTM
 func (e *ExecutiveReportMonthlyRecipients) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -4387,7 +4390,7 @@ func (e *ExecutiveReportWeeklyKeep) Update(client sophos.ClientInterface, option
 }
 
 // ExecutiveReportWeeklyPdfrecipients represents the executive_report.weekly.pdfrecipients node and implements sophos.Node
-type ExecutiveReportWeeklyPdfrecipients struct{ Value []interface{} }
+type ExecutiveReportWeeklyPdfrecipients struct{ Value []string }
 
 // Get gets the executive_report.weekly.pdfrecipients value from the UTM
 func (e *ExecutiveReportWeeklyPdfrecipients) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -4400,7 +4403,7 @@ func (e *ExecutiveReportWeeklyPdfrecipients) Update(client sophos.ClientInterfac
 }
 
 // ExecutiveReportWeeklyRecipients represents the executive_report.weekly.recipients node and implements sophos.Node
-type ExecutiveReportWeeklyRecipients struct{ Value []interface{} }
+type ExecutiveReportWeeklyRecipients struct{ Value []string }
 
 // Get gets the executive_report.weekly.recipients value from the UTM
 func (e *ExecutiveReportWeeklyRecipients) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -4933,7 +4936,7 @@ func (f *FloodProtectionUdpStatus) Update(client sophos.ClientInterface, options
 }
 
 // FtpAllowedClients represents the ftp.allowed_clients node and implements sophos.Node
-type FtpAllowedClients struct{ Value []interface{} }
+type FtpAllowedClients struct{ Value []string }
 
 // Get gets the ftp.allowed_clients value from the UTM
 func (f *FtpAllowedClients) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -4985,7 +4988,7 @@ func (f *FtpCffAvEngines) Update(client sophos.ClientInterface, options ...sopho
 }
 
 // FtpCffFileExtensions represents the ftp.cff_file_extensions node and implements sophos.Node
-type FtpCffFileExtensions struct{ Value []interface{} }
+type FtpCffFileExtensions struct{ Value []string }
 
 // Get gets the ftp.cff_file_extensions value from the UTM
 func (f *FtpCffFileExtensions) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -4998,7 +5001,7 @@ func (f *FtpCffFileExtensions) Update(client sophos.ClientInterface, options ...
 }
 
 // FtpExceptions represents the ftp.exceptions node and implements sophos.Node
-type FtpExceptions struct{ Value []interface{} }
+type FtpExceptions struct{ Value []string }
 
 // Get gets the ftp.exceptions value from the UTM
 func (f *FtpExceptions) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -5076,7 +5079,7 @@ func (f *FtpStatus) Update(client sophos.ClientInterface, options ...sophos.Opti
 }
 
 // FtpTransparentSkip represents the ftp.transparent_skip node and implements sophos.Node
-type FtpTransparentSkip struct{ Value []interface{} }
+type FtpTransparentSkip struct{ Value objects.NetworkRefs }
 
 // Get gets the ftp.transparent_skip value from the UTM
 func (f *FtpTransparentSkip) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -5102,7 +5105,7 @@ func (f *FtpTransparentSkipAutoPf) Update(client sophos.ClientInterface, options
 }
 
 // GenericProxyRules represents the generic_proxy.rules node and implements sophos.Node
-type GenericProxyRules struct{ Value []interface{} }
+type GenericProxyRules struct{ Value []string }
 
 // Get gets the generic_proxy.rules value from the UTM
 func (g *GenericProxyRules) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -5141,7 +5144,7 @@ func (g *GeoipCountriesSrc) Update(client sophos.ClientInterface, options ...sop
 }
 
 // GeoipExceptions represents the geoip.exceptions node and implements sophos.Node
-type GeoipExceptions struct{ Value []interface{} }
+type GeoipExceptions struct{ Value []string }
 
 // Get gets the geoip.exceptions value from the UTM
 func (g *GeoipExceptions) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -5180,7 +5183,7 @@ func (g *GeoipStatus) Update(client sophos.ClientInterface, options ...sophos.Op
 }
 
 // H323AllowedNetworks represents the h323.allowed_networks node and implements sophos.Node
-type H323AllowedNetworks struct{ Value []interface{} }
+type H323AllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the h323.allowed_networks value from the UTM
 func (h *H323AllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -5206,7 +5209,7 @@ func (h *H323LogRelated) Update(client sophos.ClientInterface, options ...sophos
 }
 
 // H323Servers represents the h323.servers node and implements sophos.Node
-type H323Servers struct{ Value []interface{} }
+type H323Servers struct{ Value []string }
 
 // Get gets the h323.servers value from the UTM
 func (h *H323Servers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -5596,7 +5599,7 @@ func (h *HaAwsTrustedNetwork) Update(client sophos.ClientInterface, options ...s
 }
 
 // HaClusterFtp represents the ha.cluster.ftp node and implements sophos.Node
-type HaClusterFtp struct{ Value []interface{} }
+type HaClusterFtp struct{ Value []string }
 
 // Get gets the ha.cluster.ftp value from the UTM
 func (h *HaClusterFtp) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -5609,7 +5612,7 @@ func (h *HaClusterFtp) Update(client sophos.ClientInterface, options ...sophos.O
 }
 
 // HaClusterHttp represents the ha.cluster.http node and implements sophos.Node
-type HaClusterHttp struct{ Value []interface{} }
+type HaClusterHttp struct{ Value []string }
 
 // Get gets the ha.cluster.http value from the UTM
 func (h *HaClusterHttp) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -5622,7 +5625,7 @@ func (h *HaClusterHttp) Update(client sophos.ClientInterface, options ...sophos.
 }
 
 // HaClusterIpsec represents the ha.cluster.ipsec node and implements sophos.Node
-type HaClusterIpsec struct{ Value []interface{} }
+type HaClusterIpsec struct{ Value []string }
 
 // Get gets the ha.cluster.ipsec value from the UTM
 func (h *HaClusterIpsec) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -5635,7 +5638,7 @@ func (h *HaClusterIpsec) Update(client sophos.ClientInterface, options ...sophos
 }
 
 // HaClusterPop3 represents the ha.cluster.pop3 node and implements sophos.Node
-type HaClusterPop3 struct{ Value []interface{} }
+type HaClusterPop3 struct{ Value []string }
 
 // Get gets the ha.cluster.pop3 value from the UTM
 func (h *HaClusterPop3) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -5648,7 +5651,7 @@ func (h *HaClusterPop3) Update(client sophos.ClientInterface, options ...sophos.
 }
 
 // HaClusterSmtp represents the ha.cluster.smtp node and implements sophos.Node
-type HaClusterSmtp struct{ Value []interface{} }
+type HaClusterSmtp struct{ Value []string }
 
 // Get gets the ha.cluster.smtp value from the UTM
 func (h *HaClusterSmtp) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -5661,7 +5664,7 @@ func (h *HaClusterSmtp) Update(client sophos.ClientInterface, options ...sophos.
 }
 
 // HaClusterSnort represents the ha.cluster.snort node and implements sophos.Node
-type HaClusterSnort struct{ Value []interface{} }
+type HaClusterSnort struct{ Value []string }
 
 // Get gets the ha.cluster.snort value from the UTM
 func (h *HaClusterSnort) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -5674,7 +5677,7 @@ func (h *HaClusterSnort) Update(client sophos.ClientInterface, options ...sophos
 }
 
 // HaClusterWaf represents the ha.cluster.waf node and implements sophos.Node
-type HaClusterWaf struct{ Value []interface{} }
+type HaClusterWaf struct{ Value []string }
 
 // Get gets the ha.cluster.waf value from the UTM
 func (h *HaClusterWaf) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -5960,7 +5963,7 @@ func (h *HotspotStatus) Update(client sophos.ClientInterface, options ...sophos.
 }
 
 // HotspotTransparentSkip represents the hotspot.transparent_skip node and implements sophos.Node
-type HotspotTransparentSkip struct{ Value []interface{} }
+type HotspotTransparentSkip struct{ Value objects.NetworkRefs }
 
 // Get gets the hotspot.transparent_skip value from the UTM
 func (h *HotspotTransparentSkip) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -5973,7 +5976,7 @@ func (h *HotspotTransparentSkip) Update(client sophos.ClientInterface, options .
 }
 
 // HttpAdSsoInterfaces represents the http.ad_sso_interfaces node and implements sophos.Node
-type HttpAdSsoInterfaces struct{ Value []interface{} }
+type HttpAdSsoInterfaces struct{ Value objects.InterfaceRefs }
 
 // Get gets the http.ad_sso_interfaces value from the UTM
 func (h *HttpAdSsoInterfaces) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -6025,7 +6028,7 @@ func (h *HttpAllowTls12) Update(client sophos.ClientInterface, options ...sophos
 }
 
 // HttpAllowedPuas represents the http.allowed_puas node and implements sophos.Node
-type HttpAllowedPuas struct{ Value []interface{} }
+type HttpAllowedPuas struct{ Value []string }
 
 // Get gets the http.allowed_puas value from the UTM
 func (h *HttpAllowedPuas) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -6155,7 +6158,7 @@ func (h *HttpBypassStreaming) Update(client sophos.ClientInterface, options ...s
 }
 
 // HttpCaList represents the http.ca_list node and implements sophos.Node
-type HttpCaList struct{ Value []interface{} }
+type HttpCaList struct{ Value []string }
 
 // Get gets the http.ca_list value from the UTM
 func (h *HttpCaList) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -6233,7 +6236,7 @@ func (h *HttpCertstore) Update(client sophos.ClientInterface, options ...sophos.
 }
 
 // HttpCffOverrideUsers represents the http.cff_override_users node and implements sophos.Node
-type HttpCffOverrideUsers struct{ Value []interface{} }
+type HttpCffOverrideUsers struct{ Value []string }
 
 // Get gets the http.cff_override_users value from the UTM
 func (h *HttpCffOverrideUsers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -6337,7 +6340,7 @@ func (h *HttpCtypeUnpackArchive) Update(client sophos.ClientInterface, options .
 }
 
 // HttpDebug represents the http.debug node and implements sophos.Node
-type HttpDebug struct{ Value []interface{} }
+type HttpDebug struct{ Value []string }
 
 // Get gets the http.debug value from the UTM
 func (h *HttpDebug) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -6584,7 +6587,7 @@ func (h *HttpLimitAdSsoInterfaces) Update(client sophos.ClientInterface, options
 }
 
 // HttpLocalSiteList represents the http.local_site_list node and implements sophos.Node
-type HttpLocalSiteList struct{ Value []interface{} }
+type HttpLocalSiteList struct{ Value []string }
 
 // Get gets the http.local_site_list value from the UTM
 func (h *HttpLocalSiteList) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -6805,7 +6808,7 @@ func (h *HttpPortalCert) Update(client sophos.ClientInterface, options ...sophos
 }
 
 // HttpPortalCertChain represents the http.portal_cert_chain node and implements sophos.Node
-type HttpPortalCertChain struct{ Value []interface{} }
+type HttpPortalCertChain struct{ Value []string }
 
 // Get gets the http.portal_cert_chain value from the UTM
 func (h *HttpPortalCertChain) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -6831,7 +6834,7 @@ func (h *HttpPortalDomain) Update(client sophos.ClientInterface, options ...soph
 }
 
 // HttpPortalHosts represents the http.portal_hosts node and implements sophos.Node
-type HttpPortalHosts struct{ Value []interface{} }
+type HttpPortalHosts struct{ Value []string }
 
 // Get gets the http.portal_hosts value from the UTM
 func (h *HttpPortalHosts) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -6896,7 +6899,7 @@ func (h *HttpQuotaSliceTime) Update(client sophos.ClientInterface, options ...so
 }
 
 // HttpRemoveRequest represents the http.remove_request node and implements sophos.Node
-type HttpRemoveRequest struct{ Value []interface{} }
+type HttpRemoveRequest struct{ Value []string }
 
 // Get gets the http.remove_request value from the UTM
 func (h *HttpRemoveRequest) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -7039,7 +7042,7 @@ func (h *HttpTransparentAuthTimeout) Update(client sophos.ClientInterface, optio
 }
 
 // HttpTransparentDstSkip represents the http.transparent_dst_skip node and implements sophos.Node
-type HttpTransparentDstSkip struct{ Value []interface{} }
+type HttpTransparentDstSkip struct{ Value objects.NetworkRefs }
 
 // Get gets the http.transparent_dst_skip value from the UTM
 func (h *HttpTransparentDstSkip) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -7065,7 +7068,7 @@ func (h *HttpTransparentSkipAutoPf) Update(client sophos.ClientInterface, option
 }
 
 // HttpTransparentSrcSkip represents the http.transparent_src_skip node and implements sophos.Node
-type HttpTransparentSrcSkip struct{ Value []interface{} }
+type HttpTransparentSrcSkip struct{ Value objects.NetworkRefs }
 
 // Get gets the http.transparent_src_skip value from the UTM
 func (h *HttpTransparentSrcSkip) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -7416,7 +7419,7 @@ func (i *InterfacesInterfaces) Update(client sophos.ClientInterface, options ...
 }
 
 // IpsDnsServers represents the ips.dns_servers node and implements sophos.Node
-type IpsDnsServers struct{ Value []interface{} }
+type IpsDnsServers struct{ Value []string }
 
 // Get gets the ips.dns_servers value from the UTM
 func (i *IpsDnsServers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -7494,7 +7497,7 @@ func (i *IpsGroups) Update(client sophos.ClientInterface, options ...sophos.Opti
 }
 
 // IpsHttpServers represents the ips.http_servers node and implements sophos.Node
-type IpsHttpServers struct{ Value []interface{} }
+type IpsHttpServers struct{ Value []string }
 
 // Get gets the ips.http_servers value from the UTM
 func (i *IpsHttpServers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -7546,7 +7549,7 @@ func (i *IpsIpsfbDebug) Update(client sophos.ClientInterface, options ...sophos.
 }
 
 // IpsLocalNetworks represents the ips.local_networks node and implements sophos.Node
-type IpsLocalNetworks struct{ Value []string }
+type IpsLocalNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the ips.local_networks value from the UTM
 func (i *IpsLocalNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -7650,7 +7653,7 @@ func (i *IpsRestartPolicy) Update(client sophos.ClientInterface, options ...soph
 }
 
 // IpsRuleModifiers represents the ips.rule_modifiers node and implements sophos.Node
-type IpsRuleModifiers struct{ Value []interface{} }
+type IpsRuleModifiers struct{ Value []string }
 
 // Get gets the ips.rule_modifiers value from the UTM
 func (i *IpsRuleModifiers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -7663,7 +7666,7 @@ func (i *IpsRuleModifiers) Update(client sophos.ClientInterface, options ...soph
 }
 
 // IpsRules represents the ips.rules node and implements sophos.Node
-type IpsRules struct{ Value []interface{} }
+type IpsRules struct{ Value []string }
 
 // Get gets the ips.rules value from the UTM
 func (i *IpsRules) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -7689,7 +7692,7 @@ func (i *IpsSkipAcks) Update(client sophos.ClientInterface, options ...sophos.Op
 }
 
 // IpsSmtpServers represents the ips.smtp_servers node and implements sophos.Node
-type IpsSmtpServers struct{ Value []interface{} }
+type IpsSmtpServers struct{ Value []string }
 
 // Get gets the ips.smtp_servers value from the UTM
 func (i *IpsSmtpServers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -7780,7 +7783,7 @@ func (i *IpsSnortsettingsSearchMethod) Update(client sophos.ClientInterface, opt
 }
 
 // IpsSqlServers represents the ips.sql_servers node and implements sophos.Node
-type IpsSqlServers struct{ Value []interface{} }
+type IpsSqlServers struct{ Value []string }
 
 // Get gets the ips.sql_servers value from the UTM
 func (i *IpsSqlServers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -7845,7 +7848,7 @@ func (i *IpsecAdvancedDeadPeerDetection) Update(client sophos.ClientInterface, o
 }
 
 // IpsecAdvancedIkeDebug represents the ipsec.advanced.ike_debug node and implements sophos.Node
-type IpsecAdvancedIkeDebug struct{ Value []interface{} }
+type IpsecAdvancedIkeDebug struct{ Value []string }
 
 // Get gets the ipsec.advanced.ike_debug value from the UTM
 func (i *IpsecAdvancedIkeDebug) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -8248,7 +8251,7 @@ func (i *Ipv6Prefer) Update(client sophos.ClientInterface, options ...sophos.Opt
 }
 
 // Ipv6Prefixes represents the ipv6.prefixes node and implements sophos.Node
-type Ipv6Prefixes struct{ Value []interface{} }
+type Ipv6Prefixes struct{ Value []string }
 
 // Get gets the ipv6.prefixes value from the UTM
 func (i *Ipv6Prefixes) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -8391,7 +8394,7 @@ func (l *LoadbalanceHttpErrorCode) Update(client sophos.ClientInterface, options
 }
 
 // LoadbalanceRules represents the loadbalance.rules node and implements sophos.Node
-type LoadbalanceRules struct{ Value []interface{} }
+type LoadbalanceRules struct{ Value []string }
 
 // Get gets the loadbalance.rules value from the UTM
 func (l *LoadbalanceRules) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -8781,7 +8784,7 @@ func (m *MobileControlConfigL2Tp) Update(client sophos.ClientInterface, options
 }
 
 // MobileControlConfigWifiNetworks represents the mobile_control.config.wifi_networks node and implements sophos.Node
-type MobileControlConfigWifiNetworks struct{ Value []interface{} }
+type MobileControlConfigWifiNetworks struct{ Value []string }
 
 // Get gets the mobile_control.config.wifi_networks value from the UTM
 func (m *MobileControlConfigWifiNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -8898,7 +8901,7 @@ func (m *MobileControlNacPollInterval) Update(client sophos.ClientInterface, opt
 }
 
 // MobileControlNacUsersDenied represents the mobile_control.nac.users_denied node and implements sophos.Node
-type MobileControlNacUsersDenied struct{ Value []interface{} }
+type MobileControlNacUsersDenied struct{ Value []string }
 
 // Get gets the mobile_control.nac.users_denied value from the UTM
 func (m *MobileControlNacUsersDenied) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -8911,7 +8914,7 @@ func (m *MobileControlNacUsersDenied) Update(client sophos.ClientInterface, opti
 }
 
 // MobileControlNacWifiNetworks represents the mobile_control.nac.wifi_networks node and implements sophos.Node
-type MobileControlNacWifiNetworks struct{ Value []interface{} }
+type MobileControlNacWifiNetworks struct{ Value []string }
 
 // Get gets the mobile_control.nac.wifi_networks value from the UTM
 func (m *MobileControlNacWifiNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -9015,7 +9018,7 @@ func (n *NotificationsLimiting) Update(client sophos.ClientInterface, options ..
 }
 
 // NotificationsOverlay represents the notifications.overlay node and implements sophos.Node
-type NotificationsOverlay struct{ Value []interface{} }
+type NotificationsOverlay struct{ Value []string }
 
 // Get gets the notifications.overlay value from the UTM
 func (n *NotificationsOverlay) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -9158,7 +9161,7 @@ func (n *NotificationsSmtpUsername) Update(client sophos.ClientInterface, option
 }
 
 // NtpAllowedNetworks represents the ntp.allowed_networks node and implements sophos.Node
-type NtpAllowedNetworks struct{ Value []string }
+type NtpAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the ntp.allowed_networks value from the UTM
 func (n *NtpAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -9743,7 +9746,7 @@ func (p *PimSmDebug) Update(client sophos.ClientInterface, options ...sophos.Opt
 }
 
 // PimSmInterfaces represents the pim_sm.interfaces node and implements sophos.Node
-type PimSmInterfaces struct{ Value []interface{} }
+type PimSmInterfaces struct{ Value []string }
 
 // Get gets the pim_sm.interfaces value from the UTM
 func (p *PimSmInterfaces) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -9756,7 +9759,7 @@ func (p *PimSmInterfaces) Update(client sophos.ClientInterface, options ...sopho
 }
 
 // PimSmRpRouters represents the pim_sm.rp_routers node and implements sophos.Node
-type PimSmRpRouters struct{ Value []interface{} }
+type PimSmRpRouters struct{ Value []string }
 
 // Get gets the pim_sm.rp_routers value from the UTM
 func (p *PimSmRpRouters) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -9808,7 +9811,7 @@ func (p *PimSmStatus) Update(client sophos.ClientInterface, options ...sophos.Op
 }
 
 // Pop3AllowedClients represents the pop3.allowed_clients node and implements sophos.Node
-type Pop3AllowedClients struct{ Value []interface{} }
+type Pop3AllowedClients struct{ Value []string }
 
 // Get gets the pop3.allowed_clients value from the UTM
 func (p *Pop3AllowedClients) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -9912,7 +9915,7 @@ func (p *Pop3DirectlyDeleteQuarantined) Update(client sophos.ClientInterface, op
 }
 
 // Pop3Exceptions represents the pop3.exceptions node and implements sophos.Node
-type Pop3Exceptions struct{ Value []interface{} }
+type Pop3Exceptions struct{ Value []string }
 
 // Get gets the pop3.exceptions value from the UTM
 func (p *Pop3Exceptions) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -9925,7 +9928,7 @@ func (p *Pop3Exceptions) Update(client sophos.ClientInterface, options ...sophos
 }
 
 // Pop3KnownServers represents the pop3.known_servers node and implements sophos.Node
-type Pop3KnownServers struct{ Value []interface{} }
+type Pop3KnownServers struct{ Value []string }
 
 // Get gets the pop3.known_servers value from the UTM
 func (p *Pop3KnownServers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -10055,7 +10058,7 @@ func (p *Pop3ScanTls) Update(client sophos.ClientInterface, options ...sophos.Op
 }
 
 // Pop3SenderBlacklist represents the pop3.sender_blacklist node and implements sophos.Node
-type Pop3SenderBlacklist struct{ Value []interface{} }
+type Pop3SenderBlacklist struct{ Value []string }
 
 // Get gets the pop3.sender_blacklist value from the UTM
 func (p *Pop3SenderBlacklist) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -10081,7 +10084,7 @@ func (p *Pop3Spam) Update(client sophos.ClientInterface, options ...sophos.Optio
 }
 
 // Pop3SpamExpressions represents the pop3.spam_expressions node and implements sophos.Node
-type Pop3SpamExpressions struct{ Value []interface{} }
+type Pop3SpamExpressions struct{ Value []string }
 
 // Get gets the pop3.spam_expressions value from the UTM
 func (p *Pop3SpamExpressions) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -10146,7 +10149,7 @@ func (p *Pop3TlsCert) Update(client sophos.ClientInterface, options ...sophos.Op
 }
 
 // Pop3TransparentSkip represents the pop3.transparent_skip node and implements sophos.Node
-type Pop3TransparentSkip struct{ Value []interface{} }
+type Pop3TransparentSkip struct{ Value objects.NetworkRefs }
 
 // Get gets the pop3.transparent_skip value from the UTM
 func (p *Pop3TransparentSkip) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -10198,7 +10201,7 @@ func (p *PortalAllowAnyUser) Update(client sophos.ClientInterface, options ...so
 }
 
 // PortalAllowedNetworks represents the portal.allowed_networks node and implements sophos.Node
-type PortalAllowedNetworks struct{ Value []string }
+type PortalAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the portal.allowed_networks value from the UTM
 func (p *PortalAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -10211,7 +10214,7 @@ func (p *PortalAllowedNetworks) Update(client sophos.ClientInterface, options ..
 }
 
 // PortalAllowedUsers represents the portal.allowed_users node and implements sophos.Node
-type PortalAllowedUsers struct{ Value []interface{} }
+type PortalAllowedUsers struct{ Value objects.AaaRefs }
 
 // Get gets the portal.allowed_users value from the UTM
 func (p *PortalAllowedUsers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -10224,7 +10227,7 @@ func (p *PortalAllowedUsers) Update(client sophos.ClientInterface, options ...so
 }
 
 // PortalHideItems represents the portal.hide_items node and implements sophos.Node
-type PortalHideItems struct{ Value []interface{} }
+type PortalHideItems struct{ Value []string }
 
 // Get gets the portal.hide_items value from the UTM
 func (p *PortalHideItems) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -10588,7 +10591,7 @@ func (r *RedCaSettingsOrganization) Update(client sophos.ClientInterface, option
 }
 
 // RedClients represents the red.clients node and implements sophos.Node
-type RedClients struct{ Value []interface{} }
+type RedClients struct{ Value []string }
 
 // Get gets the red.clients value from the UTM
 func (r *RedClients) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -10679,7 +10682,7 @@ func (r *RedServerCert) Update(client sophos.ClientInterface, options ...sophos.
 }
 
 // RedServers represents the red.servers node and implements sophos.Node
-type RedServers struct{ Value []interface{} }
+type RedServers struct{ Value []string }
 
 // Get gets the red.servers value from the UTM
 func (r *RedServers) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -11017,7 +11020,7 @@ func (r *RemoteSyslogBuffer) Update(client sophos.ClientInterface, options ...so
 }
 
 // RemoteSyslogLogs represents the remote_syslog.logs node and implements sophos.Node
-type RemoteSyslogLogs struct{ Value []interface{} }
+type RemoteSyslogLogs struct{ Value []string }
 
 // Get gets the remote_syslog.logs value from the UTM
 func (r *RemoteSyslogLogs) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -11043,7 +11046,7 @@ func (r *RemoteSyslogStatus) Update(client sophos.ClientInterface, options ...so
 }
 
 // RemoteSyslogTarget represents the remote_syslog.target node and implements sophos.Node
-type RemoteSyslogTarget struct{ Value []interface{} }
+type RemoteSyslogTarget struct{ Value []string }
 
 // Get gets the remote_syslog.target value from the UTM
 func (r *RemoteSyslogTarget) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -11199,7 +11202,7 @@ func (r *ReportingCsvSeparator) Update(client sophos.ClientInterface, options ..
 }
 
 // ReportingEmailsecurityImport represents the reporting.emailsecurity_import node and implements sophos.Node
-type ReportingEmailsecurityImport struct{ Value []interface{} }
+type ReportingEmailsecurityImport struct{ Value []string }
 
 // Get gets the reporting.emailsecurity_import value from the UTM
 func (r *ReportingEmailsecurityImport) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -11251,7 +11254,7 @@ func (r *ReportingEnableVpnAccounting) Update(client sophos.ClientInterface, opt
 }
 
 // ReportingHideAccountingips represents the reporting.hide_accountingips node and implements sophos.Node
-type ReportingHideAccountingips struct{ Value []interface{} }
+type ReportingHideAccountingips struct{ Value []string }
 
 // Get gets the reporting.hide_accountingips value from the UTM
 func (r *ReportingHideAccountingips) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -11264,7 +11267,7 @@ func (r *ReportingHideAccountingips) Update(client sophos.ClientInterface, optio
 }
 
 // ReportingHideMailaddresses represents the reporting.hide_mailaddresses node and implements sophos.Node
-type ReportingHideMailaddresses struct{ Value []interface{} }
+type ReportingHideMailaddresses struct{ Value []string }
 
 // Get gets the reporting.hide_mailaddresses value from the UTM
 func (r *ReportingHideMailaddresses) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -11277,7 +11280,7 @@ func (r *ReportingHideMailaddresses) Update(client sophos.ClientInterface, optio
 }
 
 // ReportingHideMaildomains represents the reporting.hide_maildomains node and implements sophos.Node
-type ReportingHideMaildomains struct{ Value []interface{} }
+type ReportingHideMaildomains struct{ Value []string }
 
 // Get gets the reporting.hide_maildomains value from the UTM
 func (r *ReportingHideMaildomains) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -11290,7 +11293,7 @@ func (r *ReportingHideMaildomains) Update(client sophos.ClientInterface, options
 }
 
 // ReportingHideNetsecips represents the reporting.hide_netsecips node and implements sophos.Node
-type ReportingHideNetsecips struct{ Value []interface{} }
+type ReportingHideNetsecips struct{ Value []string }
 
 // Get gets the reporting.hide_netsecips value from the UTM
 func (r *ReportingHideNetsecips) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -11303,7 +11306,7 @@ func (r *ReportingHideNetsecips) Update(client sophos.ClientInterface, options .
 }
 
 // ReportingHideWebdomains represents the reporting.hide_webdomains node and implements sophos.Node
-type ReportingHideWebdomains struct{ Value []interface{} }
+type ReportingHideWebdomains struct{ Value []string }
 
 // Get gets the reporting.hide_webdomains value from the UTM
 func (r *ReportingHideWebdomains) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -11316,7 +11319,7 @@ func (r *ReportingHideWebdomains) Update(client sophos.ClientInterface, options
 }
 
 // ReportingIpsImport represents the reporting.ips_import node and implements sophos.Node
-type ReportingIpsImport struct{ Value []interface{} }
+type ReportingIpsImport struct{ Value []string }
 
 // Get gets the reporting.ips_import value from the UTM
 func (r *ReportingIpsImport) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -11355,7 +11358,7 @@ func (r *ReportingIpsStatus) Update(client sophos.ClientInterface, options ...so
 }
 
 // ReportingPacketfilterImport represents the reporting.packetfilter_import node and implements sophos.Node
-type ReportingPacketfilterImport struct{ Value []interface{} }
+type ReportingPacketfilterImport struct{ Value []string }
 
 // Get gets the reporting.packetfilter_import value from the UTM
 func (r *ReportingPacketfilterImport) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -11511,7 +11514,7 @@ func (r *ReportingWebsecurityDetail) Update(client sophos.ClientInterface, optio
 }
 
 // ReportingWebsecurityImport represents the reporting.websecurity_import node and implements sophos.Node
-type ReportingWebsecurityImport struct{ Value []interface{} }
+type ReportingWebsecurityImport struct{ Value []string }
 
 // Get gets the reporting.websecurity_import value from the UTM
 func (r *ReportingWebsecurityImport) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -11901,7 +11904,7 @@ func (r *ReverseProxyRequestLineLimit) Update(client sophos.ClientInterface, opt
 }
 
 // ReverseProxySlowhttpExceptions represents the reverse_proxy.slowhttp_exceptions node and implements sophos.Node
-type ReverseProxySlowhttpExceptions struct{ Value []interface{} }
+type ReverseProxySlowhttpExceptions struct{ Value []string }
 
 // Get gets the reverse_proxy.slowhttp_exceptions value from the UTM
 func (r *ReverseProxySlowhttpExceptions) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -12018,7 +12021,7 @@ func (r *ReverseProxyWhatkilledus) Update(client sophos.ClientInterface, options
 }
 
 // RoutesPolicy represents the routes.policy node and implements sophos.Node
-type RoutesPolicy struct{ Value []interface{} }
+type RoutesPolicy struct{ Value []string }
 
 // Get gets the routes.policy value from the UTM
 func (r *RoutesPolicy) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -12109,7 +12112,7 @@ func (r *RoutingBgpStrictMatch) Update(client sophos.ClientInterface, options ..
 }
 
 // RoutingBgpSystems represents the routing.bgp.systems node and implements sophos.Node
-type RoutingBgpSystems struct{ Value []interface{} }
+type RoutingBgpSystems struct{ Value []string }
 
 // Get gets the routing.bgp.systems value from the UTM
 func (r *RoutingBgpSystems) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -12135,7 +12138,7 @@ func (r *RoutingOspfAbrType) Update(client sophos.ClientInterface, options ...so
 }
 
 // RoutingOspfAreas represents the routing.ospf.areas node and implements sophos.Node
-type RoutingOspfAreas struct{ Value []interface{} }
+type RoutingOspfAreas struct{ Value []string }
 
 // Get gets the routing.ospf.areas value from the UTM
 func (r *RoutingOspfAreas) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -12317,7 +12320,7 @@ func (r *RoutingOspfStatus) Update(client sophos.ClientInterface, options ...sop
 }
 
 // RoutingQuaggaAllowedNetworks represents the routing.quagga.allowed_networks node and implements sophos.Node
-type RoutingQuaggaAllowedNetworks struct{ Value []interface{} }
+type RoutingQuaggaAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the routing.quagga.allowed_networks value from the UTM
 func (r *RoutingQuaggaAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -12590,7 +12593,7 @@ func (s *SandboxdEdgeServerPort) Update(client sophos.ClientInterface, options .
 }
 
 // SandboxdFiletypeSkiplist represents the sandboxd.filetype_skiplist node and implements sophos.Node
-type SandboxdFiletypeSkiplist struct{ Value []interface{} }
+type SandboxdFiletypeSkiplist struct{ Value []string }
 
 // Get gets the sandboxd.filetype_skiplist value from the UTM
 func (s *SandboxdFiletypeSkiplist) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -12993,7 +12996,7 @@ func (s *SettingsTimezone) Update(client sophos.ClientInterface, options ...soph
 }
 
 // SipAllowedNetworks represents the sip.allowed_networks node and implements sophos.Node
-type SipAllowedNetworks struct{ Value []string }
+type SipAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the sip.allowed_networks value from the UTM
 func (s *SipAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -13136,7 +13139,7 @@ func (s *SmsClientUsername) Update(client sophos.ClientInterface, options ...sop
 }
 
 // SmtpAuthAaa represents the smtp.auth_aaa node and implements sophos.Node
-type SmtpAuthAaa struct{ Value []interface{} }
+type SmtpAuthAaa struct{ Value []string }
 
 // Get gets the smtp.auth_aaa value from the UTM
 func (s *SmtpAuthAaa) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -13227,7 +13230,7 @@ func (s *SmtpCffAsMarker) Update(client sophos.ClientInterface, options ...sopho
 }
 
 // SmtpDkimDomains represents the smtp.dkim_domains node and implements sophos.Node
-type SmtpDkimDomains struct{ Value []interface{} }
+type SmtpDkimDomains struct{ Value []string }
 
 // Get gets the smtp.dkim_domains value from the UTM
 func (s *SmtpDkimDomains) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -13292,7 +13295,7 @@ func (s *SmtpEncryptionUtility) Update(client sophos.ClientInterface, options ..
 }
 
 // SmtpExceptions represents the smtp.exceptions node and implements sophos.Node
-type SmtpExceptions struct{ Value []interface{} }
+type SmtpExceptions struct{ Value []string }
 
 // Get gets the smtp.exceptions value from the UTM
 func (s *SmtpExceptions) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -13357,7 +13360,7 @@ func (s *SmtpGlobalProfile) Update(client sophos.ClientInterface, options ...sop
 }
 
 // SmtpHostBlacklist represents the smtp.host_blacklist node and implements sophos.Node
-type SmtpHostBlacklist struct{ Value []interface{} }
+type SmtpHostBlacklist struct{ Value []string }
 
 // Get gets the smtp.host_blacklist value from the UTM
 func (s *SmtpHostBlacklist) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -13500,7 +13503,7 @@ func (s *SmtpPostmaster) Update(client sophos.ClientInterface, options ...sophos
 }
 
 // SmtpProfiles represents the smtp.profiles node and implements sophos.Node
-type SmtpProfiles struct{ Value []interface{} }
+type SmtpProfiles struct{ Value []string }
 
 // Get gets the smtp.profiles value from the UTM
 func (s *SmtpProfiles) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -13526,7 +13529,7 @@ func (s *SmtpRecipientsMax) Update(client sophos.ClientInterface, options ...sop
 }
 
 // SmtpRelays represents the smtp.relays node and implements sophos.Node
-type SmtpRelays struct{ Value []interface{} }
+type SmtpRelays struct{ Value []string }
 
 // Get gets the smtp.relays value from the UTM
 func (s *SmtpRelays) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -13721,7 +13724,7 @@ func (s *SmtpStatus) Update(client sophos.ClientInterface, options ...sophos.Opt
 }
 
 // SmtpTlsAvoid represents the smtp.tls_avoid node and implements sophos.Node
-type SmtpTlsAvoid struct{ Value []interface{} }
+type SmtpTlsAvoid struct{ Value []string }
 
 // Get gets the smtp.tls_avoid value from the UTM
 func (s *SmtpTlsAvoid) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -13747,7 +13750,7 @@ func (s *SmtpTlsCert) Update(client sophos.ClientInterface, options ...sophos.Op
 }
 
 // SmtpTlsRequire represents the smtp.tls_require node and implements sophos.Node
-type SmtpTlsRequire struct{ Value []interface{} }
+type SmtpTlsRequire struct{ Value []string }
 
 // Get gets the smtp.tls_require value from the UTM
 func (s *SmtpTlsRequire) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -13760,7 +13763,7 @@ func (s *SmtpTlsRequire) Update(client sophos.ClientInterface, options ...sophos
 }
 
 // SmtpTlsRequireSenderDomains represents the smtp.tls_require_sender_domains node and implements sophos.Node
-type SmtpTlsRequireSenderDomains struct{ Value []interface{} }
+type SmtpTlsRequireSenderDomains struct{ Value []string }
 
 // Get gets the smtp.tls_require_sender_domains value from the UTM
 func (s *SmtpTlsRequireSenderDomains) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -13799,7 +13802,7 @@ func (s *SmtpTransparent) Update(client sophos.ClientInterface, options ...sopho
 }
 
 // SmtpTransparentSkip represents the smtp.transparent_skip node and implements sophos.Node
-type SmtpTransparentSkip struct{ Value []interface{} }
+type SmtpTransparentSkip struct{ Value objects.NetworkRefs }
 
 // Get gets the smtp.transparent_skip value from the UTM
 func (s *SmtpTransparentSkip) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -13825,7 +13828,7 @@ func (s *SmtpTransparentSkipAutoPf) Update(client sophos.ClientInterface, option
 }
 
 // SmtpUpstreamHosts represents the smtp.upstream_hosts node and implements sophos.Node
-type SmtpUpstreamHosts struct{ Value []interface{} }
+type SmtpUpstreamHosts struct{ Value []string }
 
 // Get gets the smtp.upstream_hosts value from the UTM
 func (s *SmtpUpstreamHosts) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -13851,7 +13854,7 @@ func (s *SmtpUpstreamHostsOnly) Update(client sophos.ClientInterface, options ..
 }
 
 // SnmpAllowedNetworks represents the snmp.allowed_networks node and implements sophos.Node
-type SnmpAllowedNetworks struct{ Value []interface{} }
+type SnmpAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the snmp.allowed_networks value from the UTM
 func (s *SnmpAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -13981,7 +13984,7 @@ func (s *SnmpStatus) Update(client sophos.ClientInterface, options ...sophos.Opt
 }
 
 // SnmpTraps represents the snmp.traps node and implements sophos.Node
-type SnmpTraps struct{ Value []interface{} }
+type SnmpTraps struct{ Value []string }
 
 // Get gets the snmp.traps value from the UTM
 func (s *SnmpTraps) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -14046,7 +14049,7 @@ func (s *SocksAaa) Update(client sophos.ClientInterface, options ...sophos.Optio
 }
 
 // SocksAllowedNetworks represents the socks.allowed_networks node and implements sophos.Node
-type SocksAllowedNetworks struct{ Value []string }
+type SocksAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the socks.allowed_networks value from the UTM
 func (s *SocksAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -14176,7 +14179,7 @@ func (s *SpxGlobalPasswordStrengthRequireSpecChars) Update(client sophos.ClientI
 }
 
 // SpxGlobalPortalSettingsAllowedNetworks represents the spx.global.portal_settings.allowed_networks node and implements sophos.Node
-type SpxGlobalPortalSettingsAllowedNetworks struct{ Value []interface{} }
+type SpxGlobalPortalSettingsAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the spx.global.portal_settings.allowed_networks value from the UTM
 func (s *SpxGlobalPortalSettingsAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -14332,7 +14335,7 @@ func (s *SpxTemplates) Update(client sophos.ClientInterface, options ...sophos.O
 }
 
 // SshAllowedNetworks represents the ssh.allowed_networks node and implements sophos.Node
-type SshAllowedNetworks struct{ Value []string }
+type SshAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the ssh.allowed_networks value from the UTM
 func (s *SshAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -14683,7 +14686,7 @@ func (s *SupportAccessLifetimeEnd) Update(client sophos.ClientInterface, options
 }
 
 // SupportAccessSshKeys represents the support_access.ssh_keys node and implements sophos.Node
-type SupportAccessSshKeys struct{ Value []interface{} }
+type SupportAccessSshKeys struct{ Value []string }
 
 // Get gets the support_access.ssh_keys value from the UTM
 func (s *SupportAccessSshKeys) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -14709,7 +14712,7 @@ func (s *SupportAccessStatus) Update(client sophos.ClientInterface, options ...s
 }
 
 // U2DcacheAllowedNetworks represents the u2dcache.allowed_networks node and implements sophos.Node
-type U2DcacheAllowedNetworks struct{ Value []interface{} }
+type U2DcacheAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the u2dcache.allowed_networks value from the UTM
 func (u *U2DcacheAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -14904,7 +14907,7 @@ func (u *Up2DatePatternDownloadStatus) Update(client sophos.ClientInterface, opt
 }
 
 // Up2DateScheduledUp2Date represents the up2date.scheduled_up2date node and implements sophos.Node
-type Up2DateScheduledUp2Date struct{ Value []interface{} }
+type Up2DateScheduledUp2Date struct{ Value []string }
 
 // Get gets the up2date.scheduled_up2date value from the UTM
 func (u *Up2DateScheduledUp2Date) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -14982,7 +14985,7 @@ func (u *Up2DateSystemDownloadStatus) Update(client sophos.ClientInterface, opti
 }
 
 // UplinkActions represents the uplink.actions node and implements sophos.Node
-type UplinkActions struct{ Value []interface{} }
+type UplinkActions struct{ Value []string }
 
 // Get gets the uplink.actions value from the UTM
 func (u *UplinkActions) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
@@ -15112,7 +15115,7 @@ func (u *UplinkStatus) Update(client sophos.ClientInterface, options ...sophos.O
 }
 
 // WebadminAllowedNetworks represents the webadmin.allowed_networks node and implements sophos.Node
-type WebadminAllowedNetworks struct{ Value []string }
+type WebadminAllowedNetworks struct{ Value objects.NetworkRefs }
 
 // Get gets the webadmin.allowed_networks value from the UTM
 func (w *WebadminAllowedNetworks) Get(client sophos.ClientInterface, options ...sophos.Option) (err error) {
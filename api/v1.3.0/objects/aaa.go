@@ -15,13 +15,13 @@ type Aaa struct {
 
 var _ sophos.Endpoint = &Aaa{}
 
-var defsAaa = map[string]sophos.RestObject{
+var defsAaa = map[string]sophos.RestGetter{
 	"AaaGroup": &AaaGroup{},
 	"AaaUser":  &AaaUser{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Aaa's Objects
-func (Aaa) RestObjects() map[string]sophos.RestObject { return defsAaa }
+func (Aaa) RestObjects() map[string]sophos.RestGetter { return defsAaa }
 
 // GetPath implements sophos.RestGetter
 func (*Aaa) GetPath() string { return "/api/nodes/aaa" }
@@ -55,71 +55,89 @@ func (Aaa) References() []string {
 	}
 }
 
-// AaaGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// AaaGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type AaaGroups []AaaGroup
 
-// AaaGroup is a generated Sophos object
+// AaaGroup represents a UTM aaa/group object
 type AaaGroup struct {
-	Locked               string        `json:"_locked"`
-	Reference            string        `json:"_ref"`
-	ObjectType           string        `json:"_type"`
-	AdirectoryGroups     []interface{} `json:"adirectory_groups"`
-	AdirectoryGroupsSids struct{}      `json:"adirectory_groups_sids"`
-	BackendMatch         string        `json:"backend_match"`
-	Comment              string        `json:"comment"`
-	Dynamic              string        `json:"dynamic"`
-	EdirectoryGroups     []interface{} `json:"edirectory_groups"`
-	IpsecDn              string        `json:"ipsec_dn"`
-	LdapAttribute        string        `json:"ldap_attribute"`
-	LdapAttributeValue   string        `json:"ldap_attribute_value"`
-	Members              []string      `json:"members"`
-	Name                 string        `json:"name"`
-	Network              string        `json:"network"`
-	RadiusGroups         []interface{} `json:"radius_groups"`
-	TacacsGroups         []interface{} `json:"tacacs_groups"`
+	Locked               string                 `json:"_locked"`
+	ObjectType           string                 `json:"_type"`
+	Reference            string                 `json:"_ref"`
+	AdirectoryGroups     []string               `json:"adirectory_groups"`
+	AdirectoryGroupsSids map[string]interface{} `json:"adirectory_groups_sids"`
+	BackendMatch         string                 `json:"backend_match"`
+	Comment              string                 `json:"comment"`
+	Dynamic              string                 `json:"dynamic"`
+	EdirectoryGroups     []string               `json:"edirectory_groups"`
+	IpsecDn              string                 `json:"ipsec_dn"`
+	LdapAttribute        string                 `json:"ldap_attribute"`
+	LdapAttributeValue   string                 `json:"ldap_attribute_value"`
+	Members              []string               `json:"members"`
+	Name                 string                 `json:"name"`
+	Network              string                 `json:"network"`
+	RadiusGroups         []string               `json:"radius_groups"`
+	TacacsGroups         []string               `json:"tacacs_groups"`
+}
+
+// NewAaaGroup returns a AaaGroup with the default values of its swagger definition
+func NewAaaGroup() *AaaGroup {
+	return &AaaGroup{
+		ObjectType: "aaa/group",
+	}
+}
+
+// Validate checks the AaaGroup before it is sent, see ValidateWith
+func (a *AaaGroup) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AaaGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AaaGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AaaGroup", typeOf)
+	check.Required("name", a.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &AaaGroup{}
 
-// GetPath implements sophos.RestObject and returns the AaaGroups GET path
+// GetPath implements sophos.RestGetter and returns the AaaGroups GET path
 // Returns all available aaa/group objects
 func (*AaaGroups) GetPath() string { return "/api/objects/aaa/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AaaGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AaaGroups GET path
+// GetPath implements sophos.RestGetter and returns the AaaGroups GET path
 // Returns all available group types
 func (a *AaaGroup) GetPath() string { return fmt.Sprintf("/api/objects/aaa/group/%s", a.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AaaGroup) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AaaGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the AaaGroup DELETE path
 // Creates or updates the complete object group
 func (*AaaGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/aaa/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AaaGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the AaaGroup PATCH path
 // Changes to parts of the object group types
 func (*AaaGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/aaa/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AaaGroup POST path
+// PostPath implements sophos.Creatable and returns the AaaGroup POST path
 // Create a new aaa/group object
 func (*AaaGroup) PostPath() string {
 	return "/api/objects/aaa/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the AaaGroup PUT path
+// PutPath implements sophos.Updatable and returns the AaaGroup PUT path
 // Creates or updates the complete object group
 func (*AaaGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/aaa/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AaaGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/aaa/group/%s/usedby", ref)
@@ -128,84 +146,102 @@ func (*AaaGroup) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (a *AaaGroup) GetType() string { return a.ObjectType }
 
-// AaaUsers is an Sophos Endpoint subType and implements sophos.RestObject
+// AaaUsers is an Sophos Endpoint subType and implements sophos.RestGetter
 type AaaUsers []AaaUser
 
-// AaaUser is a generated Sophos object
+// AaaUser represents a UTM aaa/user object
 type AaaUser struct {
-	Locked           string        `json:"_locked"`
-	Reference        string        `json:"_ref"`
-	ObjectType       string        `json:"_type"`
-	AccManaged       bool          `json:"acc_managed"`
-	AllowedNetworks  []string      `json:"allowed_networks"`
-	Authentication   string        `json:"authentication"`
-	BackendUpdate    bool          `json:"backend_update"`
-	Clearpass        string        `json:"clearpass"`
-	Comment          string        `json:"comment"`
-	EmailPrimary     string        `json:"email_primary"`
-	EmailSecondary   []interface{} `json:"email_secondary"`
-	Enabled          bool          `json:"enabled"`
-	LastauthBackend  string        `json:"lastauth_backend"`
-	LastauthFacility string        `json:"lastauth_facility"`
-	LastauthTime     int64         `json:"lastauth_time"`
-	Loc              string        `json:"loc"`
-	Md4hash          string        `json:"md4hash"`
-	Name             string        `json:"name"`
-	Network          string        `json:"network"`
-	Pop3Accounts     []interface{} `json:"pop3_accounts"`
-	RasIP            string        `json:"ras_ip"`
-	RasOnline        bool          `json:"ras_online"`
-	Realname         string        `json:"realname"`
-	SenderBlacklist  []interface{} `json:"sender_blacklist"`
-	SenderWhitelist  []interface{} `json:"sender_whitelist"`
-	Status           bool          `json:"status"`
-	UseRasIP         bool          `json:"use_ras_ip"`
-	UserPreferences  string        `json:"user_preferences"`
-	X509Cert         string        `json:"x509_cert"`
-	X509CertGost     string        `json:"x509_cert_gost"`
+	Locked           string   `json:"_locked"`
+	ObjectType       string   `json:"_type"`
+	Reference        string   `json:"_ref"`
+	AccManaged       bool     `json:"acc_managed"`
+	AllowedNetworks  []string `json:"allowed_networks"`
+	Authentication   string   `json:"authentication"`
+	BackendUpdate    bool     `json:"backend_update"`
+	Clearpass        string   `json:"clearpass"`
+	Comment          string   `json:"comment"`
+	EmailPrimary     string   `json:"email_primary"`
+	EmailSecondary   []string `json:"email_secondary"`
+	Enabled          bool     `json:"enabled"`
+	LastauthBackend  string   `json:"lastauth_backend"`
+	LastauthFacility string   `json:"lastauth_facility"`
+	LastauthTime     int64    `json:"lastauth_time"`
+	Loc              string   `json:"loc"`
+	Md4hash          string   `json:"md4hash"`
+	Name             string   `json:"name"`
+	Network          string   `json:"network"`
+	Pop3Accounts     []string `json:"pop3_accounts"`
+	RasIP            string   `json:"ras_ip"`
+	RasOnline        bool     `json:"ras_online"`
+	Realname         string   `json:"realname"`
+	SenderBlacklist  []string `json:"sender_blacklist"`
+	SenderWhitelist  []string `json:"sender_whitelist"`
+	Status           bool     `json:"status"`
+	UseRasIP         bool     `json:"use_ras_ip"`
+	UserPreferences  string   `json:"user_preferences"`
+	X509Cert         string   `json:"x509_cert"`
+	X509CertGost     string   `json:"x509_cert_gost"`
+}
+
+// NewAaaUser returns a AaaUser with the default values of its swagger definition
+func NewAaaUser() *AaaUser {
+	return &AaaUser{
+		ObjectType: "aaa/user",
+	}
+}
+
+// Validate checks the AaaUser before it is sent, see ValidateWith
+func (a *AaaUser) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AaaUser.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AaaUser) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AaaUser", typeOf)
+	check.Required("name", a.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &AaaUser{}
 
-// GetPath implements sophos.RestObject and returns the AaaUsers GET path
+// GetPath implements sophos.RestGetter and returns the AaaUsers GET path
 // Returns all available aaa/user objects
 func (*AaaUsers) GetPath() string { return "/api/objects/aaa/user/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AaaUsers) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AaaUsers GET path
+// GetPath implements sophos.RestGetter and returns the AaaUsers GET path
 // Returns all available user types
 func (a *AaaUser) GetPath() string { return fmt.Sprintf("/api/objects/aaa/user/%s", a.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AaaUser) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AaaUser DELETE path
+// DeletePath implements sophos.Deletable and returns the AaaUser DELETE path
 // Creates or updates the complete object user
 func (*AaaUser) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/aaa/user/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AaaUser PATCH path
+// PatchPath implements sophos.Patchable and returns the AaaUser PATCH path
 // Changes to parts of the object user types
 func (*AaaUser) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/aaa/user/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AaaUser POST path
+// PostPath implements sophos.Creatable and returns the AaaUser POST path
 // Create a new aaa/user object
 func (*AaaUser) PostPath() string {
 	return "/api/objects/aaa/user/"
 }
 
-// PutPath implements sophos.RestObject and returns the AaaUser PUT path
+// PutPath implements sophos.Updatable and returns the AaaUser PUT path
 // Creates or updates the complete object user
 func (*AaaUser) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/aaa/user/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AaaUser) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/aaa/user/%s/usedby", ref)
@@ -9,22 +9,22 @@ import (
 // AmazonVpc is a generated struct representing the Sophos AmazonVpc Endpoint
 // GET /api/nodes/amazon_vpc
 type AmazonVpc struct {
-	AutoPfrule  int64    `json:"auto_pfrule"`
-	Connections []string `json:"connections"`
-	Networks    []string `json:"networks"`
-	Status      int64    `json:"status"`
+	AutoPfrule  bool        `json:"auto_pfrule"`
+	Connections []string    `json:"connections"`
+	Networks    NetworkRefs `json:"networks"`
+	Status      bool        `json:"status"`
 }
 
 var _ sophos.Endpoint = &AmazonVpc{}
 
-var defsAmazonVpc = map[string]sophos.RestObject{
+var defsAmazonVpc = map[string]sophos.RestGetter{
 	"AmazonVpcConnection": &AmazonVpcConnection{},
 	"AmazonVpcGroup":      &AmazonVpcGroup{},
 	"AmazonVpcTunnel":     &AmazonVpcTunnel{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of AmazonVpc's Objects
-func (AmazonVpc) RestObjects() map[string]sophos.RestObject { return defsAmazonVpc }
+func (AmazonVpc) RestObjects() map[string]sophos.RestGetter { return defsAmazonVpc }
 
 // GetPath implements sophos.RestGetter
 func (*AmazonVpc) GetPath() string { return "/api/nodes/amazon_vpc" }
@@ -62,14 +62,14 @@ func (AmazonVpc) References() []string {
 	}
 }
 
-// AmazonVpcConnections is an Sophos Endpoint subType and implements sophos.RestObject
+// AmazonVpcConnections is an Sophos Endpoint subType and implements sophos.RestGetter
 type AmazonVpcConnections []AmazonVpcConnection
 
-// AmazonVpcConnection is a generated Sophos object
+// AmazonVpcConnection represents a UTM amazon_vpc/connection object
 type AmazonVpcConnection struct {
 	Locked     string   `json:"_locked"`
-	Reference  string   `json:"_ref"`
 	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
 	Comment    string   `json:"comment"`
 	Dev        string   `json:"dev"`
 	ID         string   `json:"id"`
@@ -83,49 +83,68 @@ type AmazonVpcConnection struct {
 	VpcNetwork string   `json:"vpc_network"`
 }
 
+// NewAmazonVpcConnection returns a AmazonVpcConnection with the default values of its swagger definition
+func NewAmazonVpcConnection() *AmazonVpcConnection {
+	return &AmazonVpcConnection{
+		ObjectType: "amazon_vpc/connection",
+	}
+}
+
+// Validate checks the AmazonVpcConnection before it is sent, see ValidateWith
+func (a *AmazonVpcConnection) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AmazonVpcConnection.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AmazonVpcConnection) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AmazonVpcConnection", typeOf)
+	check.Required("name", a.Name)
+	check.Between("vpc_netmask", a.VpcNetmask, 0, 32)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &AmazonVpcConnection{}
 
-// GetPath implements sophos.RestObject and returns the AmazonVpcConnections GET path
+// GetPath implements sophos.RestGetter and returns the AmazonVpcConnections GET path
 // Returns all available amazon_vpc/connection objects
 func (*AmazonVpcConnections) GetPath() string { return "/api/objects/amazon_vpc/connection/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AmazonVpcConnections) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AmazonVpcConnections GET path
+// GetPath implements sophos.RestGetter and returns the AmazonVpcConnections GET path
 // Returns all available connection types
 func (a *AmazonVpcConnection) GetPath() string {
 	return fmt.Sprintf("/api/objects/amazon_vpc/connection/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AmazonVpcConnection) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AmazonVpcConnection DELETE path
+// DeletePath implements sophos.Deletable and returns the AmazonVpcConnection DELETE path
 // Creates or updates the complete object connection
 func (*AmazonVpcConnection) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/amazon_vpc/connection/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AmazonVpcConnection PATCH path
+// PatchPath implements sophos.Patchable and returns the AmazonVpcConnection PATCH path
 // Changes to parts of the object connection types
 func (*AmazonVpcConnection) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/amazon_vpc/connection/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AmazonVpcConnection POST path
+// PostPath implements sophos.Creatable and returns the AmazonVpcConnection POST path
 // Create a new amazon_vpc/connection object
 func (*AmazonVpcConnection) PostPath() string {
 	return "/api/objects/amazon_vpc/connection/"
 }
 
-// PutPath implements sophos.RestObject and returns the AmazonVpcConnection PUT path
+// PutPath implements sophos.Updatable and returns the AmazonVpcConnection PUT path
 // Creates or updates the complete object connection
 func (*AmazonVpcConnection) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/amazon_vpc/connection/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AmazonVpcConnection) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/amazon_vpc/connection/%s/usedby", ref)
@@ -134,7 +153,7 @@ func (*AmazonVpcConnection) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (a *AmazonVpcConnection) GetType() string { return a.ObjectType }
 
-// AmazonVpcGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// AmazonVpcGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type AmazonVpcGroups []AmazonVpcGroup
 
 // AmazonVpcGroup represents a UTM group
@@ -146,62 +165,83 @@ type AmazonVpcGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewAmazonVpcGroup returns a AmazonVpcGroup with the default values of its swagger definition
+func NewAmazonVpcGroup() *AmazonVpcGroup {
+	return &AmazonVpcGroup{
+		ObjectType: "amazon_vpc/group",
+	}
+}
+
+// Validate checks the AmazonVpcGroup before it is sent, see ValidateWith
+func (a *AmazonVpcGroup) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AmazonVpcGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AmazonVpcGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AmazonVpcGroup", typeOf)
+	check.Required("name", a.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &AmazonVpcGroup{}
 
-// GetPath implements sophos.RestObject and returns the AmazonVpcGroups GET path
+// GetPath implements sophos.RestGetter and returns the AmazonVpcGroups GET path
 // Returns all available amazon_vpc/group objects
 func (*AmazonVpcGroups) GetPath() string { return "/api/objects/amazon_vpc/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AmazonVpcGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AmazonVpcGroups GET path
+// GetPath implements sophos.RestGetter and returns the AmazonVpcGroups GET path
 // Returns all available group types
 func (a *AmazonVpcGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/amazon_vpc/group/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AmazonVpcGroup) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AmazonVpcGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the AmazonVpcGroup DELETE path
 // Creates or updates the complete object group
 func (*AmazonVpcGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/amazon_vpc/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AmazonVpcGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the AmazonVpcGroup PATCH path
 // Changes to parts of the object group types
 func (*AmazonVpcGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/amazon_vpc/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AmazonVpcGroup POST path
+// PostPath implements sophos.Creatable and returns the AmazonVpcGroup POST path
 // Create a new amazon_vpc/group object
 func (*AmazonVpcGroup) PostPath() string {
 	return "/api/objects/amazon_vpc/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the AmazonVpcGroup PUT path
+// PutPath implements sophos.Updatable and returns the AmazonVpcGroup PUT path
 // Creates or updates the complete object group
 func (*AmazonVpcGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/amazon_vpc/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AmazonVpcGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/amazon_vpc/group/%s/usedby", ref)
 }
 
-// AmazonVpcTunnels is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (a *AmazonVpcGroup) GetType() string { return a.ObjectType }
+
+// AmazonVpcTunnels is an Sophos Endpoint subType and implements sophos.RestGetter
 type AmazonVpcTunnels []AmazonVpcTunnel
 
-// AmazonVpcTunnel is a generated Sophos object
+// AmazonVpcTunnel represents a UTM amazon_vpc/tunnel object
 type AmazonVpcTunnel struct {
 	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
 	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	Address    string `json:"address"`
 	Bgp        string `json:"bgp"`
 	Comment    string `json:"comment"`
@@ -210,49 +250,68 @@ type AmazonVpcTunnel struct {
 	Netmask    int64  `json:"netmask"`
 }
 
+// NewAmazonVpcTunnel returns a AmazonVpcTunnel with the default values of its swagger definition
+func NewAmazonVpcTunnel() *AmazonVpcTunnel {
+	return &AmazonVpcTunnel{
+		ObjectType: "amazon_vpc/tunnel",
+	}
+}
+
+// Validate checks the AmazonVpcTunnel before it is sent, see ValidateWith
+func (a *AmazonVpcTunnel) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AmazonVpcTunnel.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AmazonVpcTunnel) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AmazonVpcTunnel", typeOf)
+	check.Required("name", a.Name)
+	check.Between("netmask", a.Netmask, 0, 32)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &AmazonVpcTunnel{}
 
-// GetPath implements sophos.RestObject and returns the AmazonVpcTunnels GET path
+// GetPath implements sophos.RestGetter and returns the AmazonVpcTunnels GET path
 // Returns all available amazon_vpc/tunnel objects
 func (*AmazonVpcTunnels) GetPath() string { return "/api/objects/amazon_vpc/tunnel/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AmazonVpcTunnels) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AmazonVpcTunnels GET path
+// GetPath implements sophos.RestGetter and returns the AmazonVpcTunnels GET path
 // Returns all available tunnel types
 func (a *AmazonVpcTunnel) GetPath() string {
 	return fmt.Sprintf("/api/objects/amazon_vpc/tunnel/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AmazonVpcTunnel) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AmazonVpcTunnel DELETE path
+// DeletePath implements sophos.Deletable and returns the AmazonVpcTunnel DELETE path
 // Creates or updates the complete object tunnel
 func (*AmazonVpcTunnel) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/amazon_vpc/tunnel/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AmazonVpcTunnel PATCH path
+// PatchPath implements sophos.Patchable and returns the AmazonVpcTunnel PATCH path
 // Changes to parts of the object tunnel types
 func (*AmazonVpcTunnel) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/amazon_vpc/tunnel/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AmazonVpcTunnel POST path
+// PostPath implements sophos.Creatable and returns the AmazonVpcTunnel POST path
 // Create a new amazon_vpc/tunnel object
 func (*AmazonVpcTunnel) PostPath() string {
 	return "/api/objects/amazon_vpc/tunnel/"
 }
 
-// PutPath implements sophos.RestObject and returns the AmazonVpcTunnel PUT path
+// PutPath implements sophos.Updatable and returns the AmazonVpcTunnel PUT path
 // Creates or updates the complete object tunnel
 func (*AmazonVpcTunnel) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/amazon_vpc/tunnel/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AmazonVpcTunnel) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/amazon_vpc/tunnel/%s/usedby", ref)
@@ -15,13 +15,13 @@ type ApplicationControl struct {
 
 var _ sophos.Endpoint = &ApplicationControl{}
 
-var defsApplicationControl = map[string]sophos.RestObject{
+var defsApplicationControl = map[string]sophos.RestGetter{
 	"ApplicationControlGroup": &ApplicationControlGroup{},
 	"ApplicationControlRule":  &ApplicationControlRule{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of ApplicationControl's Objects
-func (ApplicationControl) RestObjects() map[string]sophos.RestObject { return defsApplicationControl }
+func (ApplicationControl) RestObjects() map[string]sophos.RestGetter { return defsApplicationControl }
 
 // GetPath implements sophos.RestGetter
 func (*ApplicationControl) GetPath() string { return "/api/nodes/application_control" }
@@ -55,7 +55,7 @@ func (ApplicationControl) References() []string {
 	}
 }
 
-// ApplicationControlGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// ApplicationControlGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type ApplicationControlGroups []ApplicationControlGroup
 
 // ApplicationControlGroup represents a UTM group
@@ -67,119 +67,158 @@ type ApplicationControlGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewApplicationControlGroup returns a ApplicationControlGroup with the default values of its swagger definition
+func NewApplicationControlGroup() *ApplicationControlGroup {
+	return &ApplicationControlGroup{
+		ObjectType: "application_control/group",
+	}
+}
+
+// Validate checks the ApplicationControlGroup before it is sent, see ValidateWith
+func (a *ApplicationControlGroup) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ApplicationControlGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *ApplicationControlGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ApplicationControlGroup", typeOf)
+	check.Required("name", a.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ApplicationControlGroup{}
 
-// GetPath implements sophos.RestObject and returns the ApplicationControlGroups GET path
+// GetPath implements sophos.RestGetter and returns the ApplicationControlGroups GET path
 // Returns all available application_control/group objects
 func (*ApplicationControlGroups) GetPath() string { return "/api/objects/application_control/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ApplicationControlGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ApplicationControlGroups GET path
+// GetPath implements sophos.RestGetter and returns the ApplicationControlGroups GET path
 // Returns all available group types
 func (a *ApplicationControlGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/application_control/group/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *ApplicationControlGroup) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ApplicationControlGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the ApplicationControlGroup DELETE path
 // Creates or updates the complete object group
 func (*ApplicationControlGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/application_control/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ApplicationControlGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the ApplicationControlGroup PATCH path
 // Changes to parts of the object group types
 func (*ApplicationControlGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/application_control/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ApplicationControlGroup POST path
+// PostPath implements sophos.Creatable and returns the ApplicationControlGroup POST path
 // Create a new application_control/group object
 func (*ApplicationControlGroup) PostPath() string {
 	return "/api/objects/application_control/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the ApplicationControlGroup PUT path
+// PutPath implements sophos.Updatable and returns the ApplicationControlGroup PUT path
 // Creates or updates the complete object group
 func (*ApplicationControlGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/application_control/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ApplicationControlGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/application_control/group/%s/usedby", ref)
 }
 
-// ApplicationControlRules is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (a *ApplicationControlGroup) GetType() string { return a.ObjectType }
+
+// ApplicationControlRules is an Sophos Endpoint subType and implements sophos.RestGetter
 type ApplicationControlRules []ApplicationControlRule
 
-// ApplicationControlRule is a generated Sophos object
+// ApplicationControlRule represents a UTM application_control/rule object
 type ApplicationControlRule struct {
-	Locked                  string        `json:"_locked"`
-	Reference               string        `json:"_ref"`
-	ObjectType              string        `json:"_type"`
-	Action                  string        `json:"action"`
-	Applications            []string      `json:"applications"`
-	Comment                 string        `json:"comment"`
-	DestinationNetworks     []string      `json:"destination_networks"`
-	Group                   string        `json:"group"`
-	GroupFilterProductivity int64         `json:"group_filter_productivity"`
-	GroupFilterRisk         int64         `json:"group_filter_risk"`
-	Groups                  []interface{} `json:"groups"`
-	Log                     bool          `json:"log"`
-	Name                    string        `json:"name"`
-	SourceNetworks          []string      `json:"source_networks"`
-	Status                  bool          `json:"status"`
+	Locked                  string   `json:"_locked"`
+	ObjectType              string   `json:"_type"`
+	Reference               string   `json:"_ref"`
+	Action                  string   `json:"action"`
+	Applications            []string `json:"applications"`
+	Comment                 string   `json:"comment"`
+	DestinationNetworks     []string `json:"destination_networks"`
+	Group                   string   `json:"group"`
+	GroupFilterProductivity int64    `json:"group_filter_productivity"`
+	GroupFilterRisk         int64    `json:"group_filter_risk"`
+	Groups                  []string `json:"groups"`
+	Log                     bool     `json:"log"`
+	Name                    string   `json:"name"`
+	SourceNetworks          []string `json:"source_networks"`
+	Status                  bool     `json:"status"`
+}
+
+// NewApplicationControlRule returns a ApplicationControlRule with the default values of its swagger definition
+func NewApplicationControlRule() *ApplicationControlRule {
+	return &ApplicationControlRule{
+		ObjectType: "application_control/rule",
+	}
+}
+
+// Validate checks the ApplicationControlRule before it is sent, see ValidateWith
+func (a *ApplicationControlRule) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ApplicationControlRule.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *ApplicationControlRule) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ApplicationControlRule", typeOf)
+	check.Required("name", a.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ApplicationControlRule{}
 
-// GetPath implements sophos.RestObject and returns the ApplicationControlRules GET path
+// GetPath implements sophos.RestGetter and returns the ApplicationControlRules GET path
 // Returns all available application_control/rule objects
 func (*ApplicationControlRules) GetPath() string { return "/api/objects/application_control/rule/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ApplicationControlRules) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ApplicationControlRules GET path
+// GetPath implements sophos.RestGetter and returns the ApplicationControlRules GET path
 // Returns all available rule types
 func (a *ApplicationControlRule) GetPath() string {
 	return fmt.Sprintf("/api/objects/application_control/rule/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *ApplicationControlRule) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ApplicationControlRule DELETE path
+// DeletePath implements sophos.Deletable and returns the ApplicationControlRule DELETE path
 // Creates or updates the complete object rule
 func (*ApplicationControlRule) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/application_control/rule/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ApplicationControlRule PATCH path
+// PatchPath implements sophos.Patchable and returns the ApplicationControlRule PATCH path
 // Changes to parts of the object rule types
 func (*ApplicationControlRule) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/application_control/rule/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ApplicationControlRule POST path
+// PostPath implements sophos.Creatable and returns the ApplicationControlRule POST path
 // Create a new application_control/rule object
 func (*ApplicationControlRule) PostPath() string {
 	return "/api/objects/application_control/rule/"
 }
 
-// PutPath implements sophos.RestObject and returns the ApplicationControlRule PUT path
+// PutPath implements sophos.Updatable and returns the ApplicationControlRule PUT path
 // Creates or updates the complete object rule
 func (*ApplicationControlRule) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/application_control/rule/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ApplicationControlRule) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/application_control/rule/%s/usedby", ref)
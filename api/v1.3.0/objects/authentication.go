@@ -20,7 +20,7 @@ type Authentication struct {
 
 var _ sophos.Endpoint = &Authentication{}
 
-var defsAuthentication = map[string]sophos.RestObject{
+var defsAuthentication = map[string]sophos.RestGetter{
 	"AuthenticationAdirectory": &AuthenticationAdirectory{},
 	"AuthenticationEdirectory": &AuthenticationEdirectory{},
 	"AuthenticationGroup":      &AuthenticationGroup{},
@@ -31,7 +31,7 @@ var defsAuthentication = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Authentication's Objects
-func (Authentication) RestObjects() map[string]sophos.RestObject { return defsAuthentication }
+func (Authentication) RestObjects() map[string]sophos.RestGetter { return defsAuthentication }
 
 // GetPath implements sophos.RestGetter
 func (*Authentication) GetPath() string { return "/api/nodes/authentication" }
@@ -85,7 +85,7 @@ func (Authentication) References() []string {
 	}
 }
 
-// AuthenticationAdirectorys is an Sophos Endpoint subType and implements sophos.RestObject
+// AuthenticationAdirectorys is an Sophos Endpoint subType and implements sophos.RestGetter
 type AuthenticationAdirectorys []AuthenticationAdirectory
 
 // AuthenticationAdirectory represents a UTM Microsoft Active Directory server
@@ -93,162 +93,211 @@ type AuthenticationAdirectory struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// Ssl default value is false
-	Ssl     bool `json:"ssl"`
-	Timeout int  `json:"timeout"`
+	// Backend default value is ""
+	Backend string `json:"backend"`
 	// BaseDn default value is ""
 	BaseDn string `json:"base_dn"`
-	// BindPw default value is ""
-	BindPw string `json:"bind_pw"`
-	// Server description: REF(network/host), REF(network/dns_host), REF(network/availability_group)
-	Server string `json:"server"`
-	// PrefetchBackendSync default value is false
-	PrefetchBackendSync bool `json:"prefetch_backend_sync"`
 	// BindDn default value is ""
-	BindDn  string `json:"bind_dn"`
+	BindDn string `json:"bind_dn"`
+	// BindPw default value is ""
+	BindPw  string `json:"bind_pw"`
 	Comment string `json:"comment"`
-	Port    int    `json:"port"`
-	// Status default value is false
-	Status bool `json:"status"`
-	// Backend default value is ""
-	Backend          string        `json:"backend"`
-	Name             string        `json:"name"`
-	PrefetchInterval []interface{} `json:"prefetch_interval"`
-	PrefetchContexts []interface{} `json:"prefetch_contexts"`
+	Name    string `json:"name"`
+	Port    int64  `json:"port"`
+	// PrefetchBackendSync default value is false
+	PrefetchBackendSync bool     `json:"prefetch_backend_sync"`
+	PrefetchContexts    []string `json:"prefetch_contexts"`
+	PrefetchInterval    []string `json:"prefetch_interval"`
 	// Sasl default value is false
 	Sasl bool `json:"sasl"`
+	// Server description: REF(network/host), REF(network/dns_host), REF(network/availability_group)
+	Server NetworkRef `json:"server"`
+	// Ssl default value is false
+	Ssl bool `json:"ssl"`
+	// Status default value is false
+	Status  bool  `json:"status"`
+	Timeout int64 `json:"timeout"`
+}
+
+// NewAuthenticationAdirectory returns a AuthenticationAdirectory with the default values of its swagger definition
+func NewAuthenticationAdirectory() *AuthenticationAdirectory {
+	return &AuthenticationAdirectory{
+		ObjectType: "authentication/adirectory",
+	}
+}
+
+// Validate checks the AuthenticationAdirectory before it is sent, see ValidateWith
+func (a *AuthenticationAdirectory) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AuthenticationAdirectory.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AuthenticationAdirectory) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AuthenticationAdirectory", typeOf)
+	check.Required("name", a.Name)
+	check.Range("port", a.Port, "0-65535")
+	check.Required("server", string(a.Server))
+	check.Ref("server", string(a.Server), "REF(network/host), REF(network/dns_host), REF(network/availability_group)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &AuthenticationAdirectory{}
 
-// GetPath implements sophos.RestObject and returns the AuthenticationAdirectorys GET path
+// GetPath implements sophos.RestGetter and returns the AuthenticationAdirectorys GET path
 // Returns all available authentication/adirectory objects
 func (*AuthenticationAdirectorys) GetPath() string { return "/api/objects/authentication/adirectory/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AuthenticationAdirectorys) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AuthenticationAdirectorys GET path
+// GetPath implements sophos.RestGetter and returns the AuthenticationAdirectorys GET path
 // Returns all available adirectory types
 func (a *AuthenticationAdirectory) GetPath() string {
 	return fmt.Sprintf("/api/objects/authentication/adirectory/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AuthenticationAdirectory) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AuthenticationAdirectory DELETE path
+// DeletePath implements sophos.Deletable and returns the AuthenticationAdirectory DELETE path
 // Creates or updates the complete object adirectory
 func (*AuthenticationAdirectory) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/adirectory/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AuthenticationAdirectory PATCH path
+// PatchPath implements sophos.Patchable and returns the AuthenticationAdirectory PATCH path
 // Changes to parts of the object adirectory types
 func (*AuthenticationAdirectory) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/adirectory/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AuthenticationAdirectory POST path
+// PostPath implements sophos.Creatable and returns the AuthenticationAdirectory POST path
 // Create a new authentication/adirectory object
 func (*AuthenticationAdirectory) PostPath() string {
 	return "/api/objects/authentication/adirectory/"
 }
 
-// PutPath implements sophos.RestObject and returns the AuthenticationAdirectory PUT path
+// PutPath implements sophos.Updatable and returns the AuthenticationAdirectory PUT path
 // Creates or updates the complete object adirectory
 func (*AuthenticationAdirectory) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/adirectory/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AuthenticationAdirectory) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/adirectory/%s/usedby", ref)
 }
 
-// AuthenticationEdirectorys is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (a *AuthenticationAdirectory) GetType() string { return a.ObjectType }
+
+// AuthenticationEdirectorys is an Sophos Endpoint subType and implements sophos.RestGetter
 type AuthenticationEdirectorys []AuthenticationEdirectory
 
 // AuthenticationEdirectory represents a UTM Novell eDirectory server
 type AuthenticationEdirectory struct {
-	Locked           string        `json:"_locked"`
-	ObjectType       string        `json:"_type"`
-	Reference        string        `json:"_ref"`
-	PrefetchInterval []interface{} `json:"prefetch_interval"`
-	// Ssl default value is true
-	Ssl  bool   `json:"ssl"`
-	Name string `json:"name"`
-	// BindDn default value is ""
-	BindDn           string        `json:"bind_dn"`
-	Port             int           `json:"port"`
-	PrefetchContexts []interface{} `json:"prefetch_contexts"`
-	Timeout          int           `json:"timeout"`
+	Locked     string `json:"_locked"`
+	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	// Backend default value is ""
 	Backend string `json:"backend"`
-	// PrefetchBackendSync default value is false
-	PrefetchBackendSync bool `json:"prefetch_backend_sync"`
-	// Server description: REF(network/host), REF(network/dns_host), REF(network/availability_group)
-	Server string `json:"server"`
+	// BindDn default value is ""
+	BindDn string `json:"bind_dn"`
 	// BindPw default value is ""
-	BindPw   string        `json:"bind_pw"`
-	Contexts []interface{} `json:"contexts"`
+	BindPw   string   `json:"bind_pw"`
+	Comment  string   `json:"comment"`
+	Contexts []string `json:"contexts"`
+	Name     string   `json:"name"`
+	Port     int64    `json:"port"`
+	// PrefetchBackendSync default value is false
+	PrefetchBackendSync bool     `json:"prefetch_backend_sync"`
+	PrefetchContexts    []string `json:"prefetch_contexts"`
+	PrefetchInterval    []string `json:"prefetch_interval"`
 	// Sasl default value is false
 	Sasl bool `json:"sasl"`
+	// Server description: REF(network/host), REF(network/dns_host), REF(network/availability_group)
+	Server NetworkRef `json:"server"`
+	// Ssl default value is true
+	Ssl bool `json:"ssl"`
 	// Status default value is false
-	Status  bool   `json:"status"`
-	Comment string `json:"comment"`
+	Status  bool  `json:"status"`
+	Timeout int64 `json:"timeout"`
+}
+
+// NewAuthenticationEdirectory returns a AuthenticationEdirectory with the default values of its swagger definition
+func NewAuthenticationEdirectory() *AuthenticationEdirectory {
+	return &AuthenticationEdirectory{
+		ObjectType: "authentication/edirectory",
+		Ssl:        true,
+	}
+}
+
+// Validate checks the AuthenticationEdirectory before it is sent, see ValidateWith
+func (a *AuthenticationEdirectory) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AuthenticationEdirectory.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AuthenticationEdirectory) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AuthenticationEdirectory", typeOf)
+	check.Required("name", a.Name)
+	check.Range("port", a.Port, "0-65535")
+	check.Required("server", string(a.Server))
+	check.Ref("server", string(a.Server), "REF(network/host), REF(network/dns_host), REF(network/availability_group)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &AuthenticationEdirectory{}
 
-// GetPath implements sophos.RestObject and returns the AuthenticationEdirectorys GET path
+// GetPath implements sophos.RestGetter and returns the AuthenticationEdirectorys GET path
 // Returns all available authentication/edirectory objects
 func (*AuthenticationEdirectorys) GetPath() string { return "/api/objects/authentication/edirectory/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AuthenticationEdirectorys) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AuthenticationEdirectorys GET path
+// GetPath implements sophos.RestGetter and returns the AuthenticationEdirectorys GET path
 // Returns all available edirectory types
 func (a *AuthenticationEdirectory) GetPath() string {
 	return fmt.Sprintf("/api/objects/authentication/edirectory/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AuthenticationEdirectory) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AuthenticationEdirectory DELETE path
+// DeletePath implements sophos.Deletable and returns the AuthenticationEdirectory DELETE path
 // Creates or updates the complete object edirectory
 func (*AuthenticationEdirectory) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/edirectory/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AuthenticationEdirectory PATCH path
+// PatchPath implements sophos.Patchable and returns the AuthenticationEdirectory PATCH path
 // Changes to parts of the object edirectory types
 func (*AuthenticationEdirectory) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/edirectory/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AuthenticationEdirectory POST path
+// PostPath implements sophos.Creatable and returns the AuthenticationEdirectory POST path
 // Create a new authentication/edirectory object
 func (*AuthenticationEdirectory) PostPath() string {
 	return "/api/objects/authentication/edirectory/"
 }
 
-// PutPath implements sophos.RestObject and returns the AuthenticationEdirectory PUT path
+// PutPath implements sophos.Updatable and returns the AuthenticationEdirectory PUT path
 // Creates or updates the complete object edirectory
 func (*AuthenticationEdirectory) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/edirectory/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AuthenticationEdirectory) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/edirectory/%s/usedby", ref)
 }
 
-// AuthenticationGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (a *AuthenticationEdirectory) GetType() string { return a.ObjectType }
+
+// AuthenticationGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type AuthenticationGroups []AuthenticationGroup
 
 // AuthenticationGroup represents a UTM group
@@ -260,55 +309,76 @@ type AuthenticationGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewAuthenticationGroup returns a AuthenticationGroup with the default values of its swagger definition
+func NewAuthenticationGroup() *AuthenticationGroup {
+	return &AuthenticationGroup{
+		ObjectType: "authentication/group",
+	}
+}
+
+// Validate checks the AuthenticationGroup before it is sent, see ValidateWith
+func (a *AuthenticationGroup) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AuthenticationGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AuthenticationGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AuthenticationGroup", typeOf)
+	check.Required("name", a.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &AuthenticationGroup{}
 
-// GetPath implements sophos.RestObject and returns the AuthenticationGroups GET path
+// GetPath implements sophos.RestGetter and returns the AuthenticationGroups GET path
 // Returns all available authentication/group objects
 func (*AuthenticationGroups) GetPath() string { return "/api/objects/authentication/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AuthenticationGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AuthenticationGroups GET path
+// GetPath implements sophos.RestGetter and returns the AuthenticationGroups GET path
 // Returns all available group types
 func (a *AuthenticationGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/authentication/group/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AuthenticationGroup) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AuthenticationGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the AuthenticationGroup DELETE path
 // Creates or updates the complete object group
 func (*AuthenticationGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AuthenticationGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the AuthenticationGroup PATCH path
 // Changes to parts of the object group types
 func (*AuthenticationGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AuthenticationGroup POST path
+// PostPath implements sophos.Creatable and returns the AuthenticationGroup POST path
 // Create a new authentication/group object
 func (*AuthenticationGroup) PostPath() string {
 	return "/api/objects/authentication/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the AuthenticationGroup PUT path
+// PutPath implements sophos.Updatable and returns the AuthenticationGroup PUT path
 // Creates or updates the complete object group
 func (*AuthenticationGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AuthenticationGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/group/%s/usedby", ref)
 }
 
-// AuthenticationLdaps is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (a *AuthenticationGroup) GetType() string { return a.ObjectType }
+
+// AuthenticationLdaps is an Sophos Endpoint subType and implements sophos.RestGetter
 type AuthenticationLdaps []AuthenticationLdap
 
 // AuthenticationLdap represents a UTM LDAP server
@@ -316,86 +386,132 @@ type AuthenticationLdap struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
+	// Backend default value is ""
+	Backend string `json:"backend"`
+	// BaseDn default value is ""
+	BaseDn string `json:"base_dn"`
+	// BindDn default value is ""
+	BindDn string `json:"bind_dn"`
+	// BindPw default value is ""
+	BindPw  string `json:"bind_pw"`
+	Comment string `json:"comment"`
+	Name    string `json:"name"`
+	Port    int64  `json:"port"`
+	// PrefetchBackendSync default value is false
+	PrefetchBackendSync bool     `json:"prefetch_backend_sync"`
+	PrefetchContexts    []string `json:"prefetch_contexts"`
+	PrefetchInterval    []string `json:"prefetch_interval"`
 	// Sasl default value is false
 	Sasl bool `json:"sasl"`
-	// Ssl default value is false
-	Ssl              bool          `json:"ssl"`
-	PrefetchContexts []interface{} `json:"prefetch_contexts"`
-	Port             int           `json:"port"`
-	// PrefetchBackendSync default value is false
-	PrefetchBackendSync bool `json:"prefetch_backend_sync"`
 	// Server description: REF(network/host), REF(network/dns_host), REF(network/availability_group)
-	Server string `json:"server"`
+	Server NetworkRef `json:"server"`
+	// Ssl default value is false
+	Ssl bool `json:"ssl"`
 	// Status default value is false
-	Status bool `json:"status"`
+	Status  bool  `json:"status"`
+	Timeout int64 `json:"timeout"`
 	// UserAttrib can be one of: []string{"cn", "sn", "uid", "custom"}
 	// UserAttrib default value is "cn"
-	UserAttrib string `json:"user_attrib"`
+	UserAttrib AuthenticationLdapUserAttrib `json:"user_attrib"`
 	// UserAttribCustom default value is ""
 	UserAttribCustom string `json:"user_attrib_custom"`
-	// BindDn default value is ""
-	BindDn           string        `json:"bind_dn"`
-	Comment          string        `json:"comment"`
-	Name             string        `json:"name"`
-	PrefetchInterval []interface{} `json:"prefetch_interval"`
-	Timeout          int           `json:"timeout"`
-	// Backend default value is ""
-	Backend string `json:"backend"`
-	// BindPw default value is ""
-	BindPw string `json:"bind_pw"`
-	// BaseDn default value is ""
-	BaseDn string `json:"base_dn"`
+}
+
+// AuthenticationLdapUserAttrib is the UserAttrib of a AuthenticationLdap
+type AuthenticationLdapUserAttrib string
+
+// Known values of AuthenticationLdapUserAttrib
+const (
+	AuthenticationLdapUserAttribCn     AuthenticationLdapUserAttrib = "cn"
+	AuthenticationLdapUserAttribSn     AuthenticationLdapUserAttrib = "sn"
+	AuthenticationLdapUserAttribUid    AuthenticationLdapUserAttrib = "uid"
+	AuthenticationLdapUserAttribCustom AuthenticationLdapUserAttrib = "custom"
+)
+
+// Valid returns true if the value is a known AuthenticationLdapUserAttrib
+func (v AuthenticationLdapUserAttrib) Valid() bool {
+	switch v {
+	case AuthenticationLdapUserAttribCn, AuthenticationLdapUserAttribSn, AuthenticationLdapUserAttribUid, AuthenticationLdapUserAttribCustom:
+		return true
+	}
+	return false
+}
+
+// NewAuthenticationLdap returns a AuthenticationLdap with the default values of its swagger definition
+func NewAuthenticationLdap() *AuthenticationLdap {
+	return &AuthenticationLdap{
+		ObjectType: "authentication/ldap",
+		UserAttrib: AuthenticationLdapUserAttribCn,
+	}
+}
+
+// Validate checks the AuthenticationLdap before it is sent, see ValidateWith
+func (a *AuthenticationLdap) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AuthenticationLdap.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AuthenticationLdap) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AuthenticationLdap", typeOf)
+	check.Required("name", a.Name)
+	check.Range("port", a.Port, "0-65535")
+	check.Required("server", string(a.Server))
+	check.Ref("server", string(a.Server), "REF(network/host), REF(network/dns_host), REF(network/availability_group)")
+	check.Enum("user_attrib", string(a.UserAttrib), a.UserAttrib.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &AuthenticationLdap{}
 
-// GetPath implements sophos.RestObject and returns the AuthenticationLdaps GET path
+// GetPath implements sophos.RestGetter and returns the AuthenticationLdaps GET path
 // Returns all available authentication/ldap objects
 func (*AuthenticationLdaps) GetPath() string { return "/api/objects/authentication/ldap/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AuthenticationLdaps) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AuthenticationLdaps GET path
+// GetPath implements sophos.RestGetter and returns the AuthenticationLdaps GET path
 // Returns all available ldap types
 func (a *AuthenticationLdap) GetPath() string {
 	return fmt.Sprintf("/api/objects/authentication/ldap/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AuthenticationLdap) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AuthenticationLdap DELETE path
+// DeletePath implements sophos.Deletable and returns the AuthenticationLdap DELETE path
 // Creates or updates the complete object ldap
 func (*AuthenticationLdap) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/ldap/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AuthenticationLdap PATCH path
+// PatchPath implements sophos.Patchable and returns the AuthenticationLdap PATCH path
 // Changes to parts of the object ldap types
 func (*AuthenticationLdap) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/ldap/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AuthenticationLdap POST path
+// PostPath implements sophos.Creatable and returns the AuthenticationLdap POST path
 // Create a new authentication/ldap object
 func (*AuthenticationLdap) PostPath() string {
 	return "/api/objects/authentication/ldap/"
 }
 
-// PutPath implements sophos.RestObject and returns the AuthenticationLdap PUT path
+// PutPath implements sophos.Updatable and returns the AuthenticationLdap PUT path
 // Creates or updates the complete object ldap
 func (*AuthenticationLdap) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/ldap/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AuthenticationLdap) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/ldap/%s/usedby", ref)
 }
 
-// AuthenticationOtpTokens is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (a *AuthenticationLdap) GetType() string { return a.ObjectType }
+
+// AuthenticationOtpTokens is an Sophos Endpoint subType and implements sophos.RestGetter
 type AuthenticationOtpTokens []AuthenticationOtpToken
 
 // AuthenticationOtpToken represents a UTM One Time Password token
@@ -403,77 +519,121 @@ type AuthenticationOtpToken struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// Status default value is false
-	Status bool `json:"status"`
-	// Timestep description: Constraints: 0, 10-120
-	Timestep   int           `json:"timestep"`
-	ExtraCodes []interface{} `json:"extra_codes"`
+	Comment    string `json:"comment"`
+	// Digest can be one of: []string{"sha1", "sha256", "sha512"}
+	// Digest default value is "sha1"
+	Digest     AuthenticationOtpTokenDigest `json:"digest"`
+	ExtraCodes []string                     `json:"extra_codes"`
 	// ForSsh default value is false
-	ForSsh  bool   `json:"for_ssh"`
-	Lastuse int    `json:"lastuse"`
+	ForSsh bool `json:"for_ssh"`
+	// Hide default value is false
+	Hide    bool   `json:"hide"`
+	Lastuse int64  `json:"lastuse"`
 	Name    string `json:"name"`
-	Offset  int    `json:"offset"`
+	Offset  int64  `json:"offset"`
 	Secret  string `json:"secret"`
+	// Status default value is false
+	Status bool `json:"status"`
+	// Timestep description: Constraints: 0, 10-120
+	Timestep int64 `json:"timestep"`
 	// User description: REF(aaa/user)
 	// User default value is ""
-	User    string `json:"user"`
-	Comment string `json:"comment"`
-	// Digest can be one of: []string{"sha1", "sha256", "sha512"}
-	// Digest default value is "sha1"
-	Digest string `json:"digest"`
-	// Hide default value is false
-	Hide bool `json:"hide"`
+	User AaaRef `json:"user"`
+}
+
+// AuthenticationOtpTokenDigest is the Digest of a AuthenticationOtpToken
+type AuthenticationOtpTokenDigest string
+
+// Known values of AuthenticationOtpTokenDigest
+const (
+	AuthenticationOtpTokenDigestSha1   AuthenticationOtpTokenDigest = "sha1"
+	AuthenticationOtpTokenDigestSha256 AuthenticationOtpTokenDigest = "sha256"
+	AuthenticationOtpTokenDigestSha512 AuthenticationOtpTokenDigest = "sha512"
+)
+
+// Valid returns true if the value is a known AuthenticationOtpTokenDigest
+func (v AuthenticationOtpTokenDigest) Valid() bool {
+	switch v {
+	case AuthenticationOtpTokenDigestSha1, AuthenticationOtpTokenDigestSha256, AuthenticationOtpTokenDigestSha512:
+		return true
+	}
+	return false
+}
+
+// NewAuthenticationOtpToken returns a AuthenticationOtpToken with the default values of its swagger definition
+func NewAuthenticationOtpToken() *AuthenticationOtpToken {
+	return &AuthenticationOtpToken{
+		ObjectType: "authentication/otp_token",
+		Digest:     AuthenticationOtpTokenDigestSha1,
+	}
+}
+
+// Validate checks the AuthenticationOtpToken before it is sent, see ValidateWith
+func (a *AuthenticationOtpToken) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AuthenticationOtpToken.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AuthenticationOtpToken) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AuthenticationOtpToken", typeOf)
+	check.Enum("digest", string(a.Digest), a.Digest.Valid())
+	check.Required("name", a.Name)
+	check.Range("timestep", a.Timestep, "0, 10-120")
+	check.Ref("user", string(a.User), "REF(aaa/user)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &AuthenticationOtpToken{}
 
-// GetPath implements sophos.RestObject and returns the AuthenticationOtpTokens GET path
+// GetPath implements sophos.RestGetter and returns the AuthenticationOtpTokens GET path
 // Returns all available authentication/otp_token objects
 func (*AuthenticationOtpTokens) GetPath() string { return "/api/objects/authentication/otp_token/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AuthenticationOtpTokens) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AuthenticationOtpTokens GET path
+// GetPath implements sophos.RestGetter and returns the AuthenticationOtpTokens GET path
 // Returns all available otp_token types
 func (a *AuthenticationOtpToken) GetPath() string {
 	return fmt.Sprintf("/api/objects/authentication/otp_token/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AuthenticationOtpToken) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AuthenticationOtpToken DELETE path
+// DeletePath implements sophos.Deletable and returns the AuthenticationOtpToken DELETE path
 // Creates or updates the complete object otp_token
 func (*AuthenticationOtpToken) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/otp_token/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AuthenticationOtpToken PATCH path
+// PatchPath implements sophos.Patchable and returns the AuthenticationOtpToken PATCH path
 // Changes to parts of the object otp_token types
 func (*AuthenticationOtpToken) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/otp_token/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AuthenticationOtpToken POST path
+// PostPath implements sophos.Creatable and returns the AuthenticationOtpToken POST path
 // Create a new authentication/otp_token object
 func (*AuthenticationOtpToken) PostPath() string {
 	return "/api/objects/authentication/otp_token/"
 }
 
-// PutPath implements sophos.RestObject and returns the AuthenticationOtpToken PUT path
+// PutPath implements sophos.Updatable and returns the AuthenticationOtpToken PUT path
 // Creates or updates the complete object otp_token
 func (*AuthenticationOtpToken) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/otp_token/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AuthenticationOtpToken) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/otp_token/%s/usedby", ref)
 }
 
-// AuthenticationRadiuss is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (a *AuthenticationOtpToken) GetType() string { return a.ObjectType }
+
+// AuthenticationRadiuss is an Sophos Endpoint subType and implements sophos.RestGetter
 type AuthenticationRadiuss []AuthenticationRadius
 
 // AuthenticationRadius represents a UTM RADIUS server
@@ -481,69 +641,93 @@ type AuthenticationRadius struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// Secret default value is ""
-	Secret string `json:"secret"`
-	// Server description: REF(network/host), REF(network/dns_host), REF(network/availability_group)
-	Server string `json:"server"`
-	// Status default value is false
-	Status  bool `json:"status"`
-	Timeout int  `json:"timeout"`
 	// Backend default value is ""
 	Backend string `json:"backend"`
 	Comment string `json:"comment"`
 	Name    string `json:"name"`
-	Port    int    `json:"port"`
+	Port    int64  `json:"port"`
+	// Secret default value is ""
+	Secret string `json:"secret"`
+	// Server description: REF(network/host), REF(network/dns_host), REF(network/availability_group)
+	Server NetworkRef `json:"server"`
+	// Status default value is false
+	Status  bool  `json:"status"`
+	Timeout int64 `json:"timeout"`
+}
+
+// NewAuthenticationRadius returns a AuthenticationRadius with the default values of its swagger definition
+func NewAuthenticationRadius() *AuthenticationRadius {
+	return &AuthenticationRadius{
+		ObjectType: "authentication/radius",
+	}
+}
+
+// Validate checks the AuthenticationRadius before it is sent, see ValidateWith
+func (a *AuthenticationRadius) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AuthenticationRadius.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AuthenticationRadius) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AuthenticationRadius", typeOf)
+	check.Required("name", a.Name)
+	check.Range("port", a.Port, "0-65535")
+	check.Required("server", string(a.Server))
+	check.Ref("server", string(a.Server), "REF(network/host), REF(network/dns_host), REF(network/availability_group)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &AuthenticationRadius{}
 
-// GetPath implements sophos.RestObject and returns the AuthenticationRadiuss GET path
+// GetPath implements sophos.RestGetter and returns the AuthenticationRadiuss GET path
 // Returns all available authentication/radius objects
 func (*AuthenticationRadiuss) GetPath() string { return "/api/objects/authentication/radius/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AuthenticationRadiuss) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AuthenticationRadiuss GET path
+// GetPath implements sophos.RestGetter and returns the AuthenticationRadiuss GET path
 // Returns all available radius types
 func (a *AuthenticationRadius) GetPath() string {
 	return fmt.Sprintf("/api/objects/authentication/radius/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AuthenticationRadius) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AuthenticationRadius DELETE path
+// DeletePath implements sophos.Deletable and returns the AuthenticationRadius DELETE path
 // Creates or updates the complete object radius
 func (*AuthenticationRadius) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/radius/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AuthenticationRadius PATCH path
+// PatchPath implements sophos.Patchable and returns the AuthenticationRadius PATCH path
 // Changes to parts of the object radius types
 func (*AuthenticationRadius) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/radius/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AuthenticationRadius POST path
+// PostPath implements sophos.Creatable and returns the AuthenticationRadius POST path
 // Create a new authentication/radius object
 func (*AuthenticationRadius) PostPath() string {
 	return "/api/objects/authentication/radius/"
 }
 
-// PutPath implements sophos.RestObject and returns the AuthenticationRadius PUT path
+// PutPath implements sophos.Updatable and returns the AuthenticationRadius PUT path
 // Creates or updates the complete object radius
 func (*AuthenticationRadius) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/radius/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AuthenticationRadius) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/radius/%s/usedby", ref)
 }
 
-// AuthenticationTacacss is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (a *AuthenticationRadius) GetType() string { return a.ObjectType }
+
+// AuthenticationTacacss is an Sophos Endpoint subType and implements sophos.RestGetter
 type AuthenticationTacacss []AuthenticationTacacs
 
 // AuthenticationTacacs represents a UTM TACACS+ server
@@ -557,58 +741,82 @@ type AuthenticationTacacs struct {
 	// Key default value is ""
 	Key  string `json:"key"`
 	Name string `json:"name"`
-	Port int    `json:"port"`
+	Port int64  `json:"port"`
 	// Server description: REF(network/host), REF(network/dns_host), REF(network/availability_group)
-	Server string `json:"server"`
+	Server NetworkRef `json:"server"`
 	// Status default value is false
-	Status  bool `json:"status"`
-	Timeout int  `json:"timeout"`
+	Status  bool  `json:"status"`
+	Timeout int64 `json:"timeout"`
+}
+
+// NewAuthenticationTacacs returns a AuthenticationTacacs with the default values of its swagger definition
+func NewAuthenticationTacacs() *AuthenticationTacacs {
+	return &AuthenticationTacacs{
+		ObjectType: "authentication/tacacs",
+	}
+}
+
+// Validate checks the AuthenticationTacacs before it is sent, see ValidateWith
+func (a *AuthenticationTacacs) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AuthenticationTacacs.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AuthenticationTacacs) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AuthenticationTacacs", typeOf)
+	check.Required("name", a.Name)
+	check.Range("port", a.Port, "0-65535")
+	check.Required("server", string(a.Server))
+	check.Ref("server", string(a.Server), "REF(network/host), REF(network/dns_host), REF(network/availability_group)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &AuthenticationTacacs{}
 
-// GetPath implements sophos.RestObject and returns the AuthenticationTacacss GET path
+// GetPath implements sophos.RestGetter and returns the AuthenticationTacacss GET path
 // Returns all available authentication/tacacs objects
 func (*AuthenticationTacacss) GetPath() string { return "/api/objects/authentication/tacacs/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AuthenticationTacacss) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AuthenticationTacacss GET path
+// GetPath implements sophos.RestGetter and returns the AuthenticationTacacss GET path
 // Returns all available tacacs types
 func (a *AuthenticationTacacs) GetPath() string {
 	return fmt.Sprintf("/api/objects/authentication/tacacs/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AuthenticationTacacs) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AuthenticationTacacs DELETE path
+// DeletePath implements sophos.Deletable and returns the AuthenticationTacacs DELETE path
 // Creates or updates the complete object tacacs
 func (*AuthenticationTacacs) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/tacacs/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AuthenticationTacacs PATCH path
+// PatchPath implements sophos.Patchable and returns the AuthenticationTacacs PATCH path
 // Changes to parts of the object tacacs types
 func (*AuthenticationTacacs) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/tacacs/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AuthenticationTacacs POST path
+// PostPath implements sophos.Creatable and returns the AuthenticationTacacs POST path
 // Create a new authentication/tacacs object
 func (*AuthenticationTacacs) PostPath() string {
 	return "/api/objects/authentication/tacacs/"
 }
 
-// PutPath implements sophos.RestObject and returns the AuthenticationTacacs PUT path
+// PutPath implements sophos.Updatable and returns the AuthenticationTacacs PUT path
 // Creates or updates the complete object tacacs
 func (*AuthenticationTacacs) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/tacacs/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AuthenticationTacacs) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/authentication/tacacs/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (a *AuthenticationTacacs) GetType() string { return a.ObjectType }
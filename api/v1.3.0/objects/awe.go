@@ -9,33 +9,33 @@ import (
 // Awe is a generated struct representing the Sophos Awe Endpoint
 // GET /api/nodes/awe
 type Awe struct {
-	AllowedInterfaces []interface{} `json:"allowed_interfaces"`
-	Clients           []interface{} `json:"clients"`
-	Devices           []interface{} `json:"devices"`
+	AllowedInterfaces InterfaceRefs `json:"allowed_interfaces"`
+	Clients           []string      `json:"clients"`
+	Devices           []string      `json:"devices"`
 	Global            struct {
-		ApAutoaccept        int64  `json:"ap_autoaccept"`
-		ApDebuglevel        int64  `json:"ap_debuglevel"`
-		ApSoftlimit         int64  `json:"ap_softlimit"`
-		ApVlantag           int64  `json:"ap_vlantag"`
-		AweStatus           int64  `json:"awe_status"`
-		BridgeUpdateKickout int64  `json:"bridge_update_kickout"`
-		InitialSetup        int64  `json:"initial_setup"`
-		LogLevel            int64  `json:"log_level"`
-		MagicIP             string `json:"magic_ip"`
-		NotificationTimeout int64  `json:"notification_timeout"`
-		RadiusConf          string `json:"radius_conf"`
-		Rootpw              string `json:"rootpw"`
-		StayOnline          int64  `json:"stay_online"`
-		StoreBssStats       int64  `json:"store_bss_stats"`
-		TunnelIDOffset      int64  `json:"tunnel_id_offset"`
-		Vlantagging         int64  `json:"vlantagging"`
+		ApAutoaccept        bool                   `json:"ap_autoaccept"`
+		ApDebuglevel        map[string]interface{} `json:"ap_debuglevel"`
+		ApSoftlimit         int64                  `json:"ap_softlimit"`
+		ApVlantag           int64                  `json:"ap_vlantag"`
+		AweStatus           map[string]interface{} `json:"awe_status"`
+		BridgeUpdateKickout bool                   `json:"bridge_update_kickout"`
+		InitialSetup        bool                   `json:"initial_setup"`
+		LogLevel            int64                  `json:"log_level"`
+		MagicIP             string                 `json:"magic_ip"`
+		NotificationTimeout int64                  `json:"notification_timeout"`
+		RadiusConf          string                 `json:"radius_conf"`
+		Rootpw              string                 `json:"rootpw"`
+		StayOnline          bool                   `json:"stay_online"`
+		StoreBssStats       bool                   `json:"store_bss_stats"`
+		TunnelIDOffset      int64                  `json:"tunnel_id_offset"`
+		Vlantagging         bool                   `json:"vlantagging"`
 	} `json:"global"`
-	Networks []string `json:"networks"`
+	Networks NetworkRefs `json:"networks"`
 }
 
 var _ sophos.Endpoint = &Awe{}
 
-var defsAwe = map[string]sophos.RestObject{
+var defsAwe = map[string]sophos.RestGetter{
 	"AweClient": &AweClient{},
 	"AweDevice": &AweDevice{},
 	"AweGroup":  &AweGroup{},
@@ -44,7 +44,7 @@ var defsAwe = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Awe's Objects
-func (Awe) RestObjects() map[string]sophos.RestObject { return defsAwe }
+func (Awe) RestObjects() map[string]sophos.RestGetter { return defsAwe }
 
 // GetPath implements sophos.RestGetter
 func (*Awe) GetPath() string { return "/api/nodes/awe" }
@@ -90,7 +90,7 @@ func (Awe) References() []string {
 	}
 }
 
-// AweClients is an Sophos Endpoint subType and implements sophos.RestObject
+// AweClients is an Sophos Endpoint subType and implements sophos.RestGetter
 type AweClients []AweClient
 
 // AweClient represents a UTM wireless client
@@ -99,7 +99,7 @@ type AweClient struct {
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
 	Comment    string `json:"comment"`
-	Lastseen   int    `json:"lastseen"`
+	Lastseen   int64  `json:"lastseen"`
 	// Mac description: (MACADDR)
 	// Mac default value is "00:00:00:00:00:00"
 	Mac  string `json:"mac"`
@@ -108,191 +108,305 @@ type AweClient struct {
 	Vendor string `json:"vendor"`
 }
 
+// NewAweClient returns a AweClient with the default values of its swagger definition
+func NewAweClient() *AweClient {
+	return &AweClient{
+		ObjectType: "awe/client",
+		Mac:        "00:00:00:00:00:00",
+		Vendor:     "unknown",
+	}
+}
+
+// Validate checks the AweClient before it is sent, see ValidateWith
+func (a *AweClient) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AweClient.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AweClient) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AweClient", typeOf)
+	check.Format("mac", sophos.FormatMAC, a.Mac)
+	check.Required("name", a.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &AweClient{}
 
-// GetPath implements sophos.RestObject and returns the AweClients GET path
+// GetPath implements sophos.RestGetter and returns the AweClients GET path
 // Returns all available awe/client objects
 func (*AweClients) GetPath() string { return "/api/objects/awe/client/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AweClients) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AweClients GET path
+// GetPath implements sophos.RestGetter and returns the AweClients GET path
 // Returns all available client types
 func (a *AweClient) GetPath() string { return fmt.Sprintf("/api/objects/awe/client/%s", a.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AweClient) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AweClient DELETE path
+// DeletePath implements sophos.Deletable and returns the AweClient DELETE path
 // Creates or updates the complete object client
 func (*AweClient) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/client/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AweClient PATCH path
+// PatchPath implements sophos.Patchable and returns the AweClient PATCH path
 // Changes to parts of the object client types
 func (*AweClient) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/client/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AweClient POST path
+// PostPath implements sophos.Creatable and returns the AweClient POST path
 // Create a new awe/client object
 func (*AweClient) PostPath() string {
 	return "/api/objects/awe/client/"
 }
 
-// PutPath implements sophos.RestObject and returns the AweClient PUT path
+// PutPath implements sophos.Updatable and returns the AweClient PUT path
 // Creates or updates the complete object client
 func (*AweClient) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/client/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AweClient) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/client/%s/usedby", ref)
 }
 
-// AweDevices is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (a *AweClient) GetType() string { return a.ObjectType }
+
+// AweDevices is an Sophos Endpoint subType and implements sophos.RestGetter
 type AweDevices []AweDevice
 
 // AweDevice represents a UTM wireless access point
 type AweDevice struct {
-	Locked               string `json:"_locked"`
-	ObjectType           string `json:"_type"`
-	Reference            string `json:"_ref"`
-	Name                 string `json:"name"`
-	SchedScanInterval    int    `json:"sched_scan_interval"`
-	SchedScanInterval11A int    `json:"sched_scan_interval11a"`
+	Locked     string `json:"_locked"`
+	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
+	// AcAbility default value is false
+	AcAbility         bool     `json:"ac_ability"`
+	ActiveChannels    []int64  `json:"active_channels"`
+	AllowedChannels   []int64  `json:"allowed_channels"`
+	AllowedCountries  []string `json:"allowed_countries"`
+	ApLocaldebuglevel int64    `json:"ap_localdebuglevel"`
+	ApVlantag         int64    `json:"ap_vlantag"`
+	AutoChannel       int64    `json:"auto_channel"`
+	AutoChannel11A    int64    `json:"auto_channel11a"`
+	// Band can be one of: []string{"g", "a"}
+	// Band default value is ""
+	Band        AweDeviceBand `json:"band"`
+	BridgeModes []string      `json:"bridge_modes"`
+	Channel     int64         `json:"channel"`
+	Channel11A  int64         `json:"channel11a"`
+	// ChannelWidth can be one of: []string{"HT20", "HT40"}
+	// ChannelWidth default value is "HT20"
+	ChannelWidth AweDeviceChannelWidth `json:"channel_width"`
+	// ChannelWidth11A can be one of: []string{"HT20", "HT40", "VHT20", "VHT40", "VHT80"}
+	// ChannelWidth11A default value is "HT20"
+	ChannelWidth11A AweDeviceChannelWidth11A `json:"channel_width11a"`
+	Comment         string                   `json:"comment"`
+	// Country description: (REGEX)
+	// Country default value is ""
+	Country string `json:"country"`
+	// DfsAbility default value is false
+	DfsAbility bool `json:"dfs_ability"`
+	// Enabled default value is false
+	Enabled bool `json:"enabled"`
+	// Id default value is "Remote Wifi Device"
+	Id string `json:"id"`
+	// Interface description: REF(interface/*)
+	// Interface default value is ""
+	Interface InterfaceRef `json:"interface"`
+	// Key default value is ""
+	Key string `json:"key"`
+	// LanMac description: (MACADDR)
+	// LanMac default value is "00:00:00:00:00:00"
+	LanMac string `json:"lan_mac"`
 	// LastIp description: (IPADDR)
 	// LastIp default value is ""
-	LastIp   string `json:"last_ip"`
-	MaxSsids int    `json:"max_ssids"`
+	LastIp string `json:"last_ip"`
+	// Location default value is ""
+	Location string `json:"location"`
+	MaxSsids int64  `json:"max_ssids"`
 	// MeshAbility default value is false
 	MeshAbility bool `json:"mesh_ability"`
 	// MeshAbility11A default value is false
-	MeshAbility11A    bool          `json:"mesh_ability11a"`
-	ScanInterval11A   int           `json:"scan_interval11a"`
-	ActiveChannels    []interface{} `json:"active_channels"`
-	ApLocaldebuglevel int           `json:"ap_localdebuglevel"`
-	// Id default value is "Remote Wifi Device"
-	Id string `json:"id"`
+	MeshAbility11A bool `json:"mesh_ability11a"`
+	// MeshAbility11G default value is false
+	MeshAbility11G bool     `json:"mesh_ability11g"`
+	Name           string   `json:"name"`
+	Networks       []string `json:"networks"`
+	// R0KhSecret default value is ""
+	R0KhSecret           string `json:"r0kh_secret"`
+	ScanInterval         int64  `json:"scan_interval"`
+	ScanInterval11A      int64  `json:"scan_interval11a"`
+	SchedScanInterval    int64  `json:"sched_scan_interval"`
+	SchedScanInterval11A int64  `json:"sched_scan_interval11a"`
+	// Status default value is false
+	Status bool `json:"status"`
+	// Stp default value is false
+	Stp bool `json:"stp"`
+	// TimeScheduling default value is false
+	TimeScheduling bool `json:"time_scheduling"`
+	// TimeScheduling11A default value is false
+	TimeScheduling11A bool     `json:"time_scheduling11a"`
+	TimeSelect        []string `json:"time_select"`
+	TimeSelect11A     []string `json:"time_select11a"`
 	// TunnelId default value is ""
 	TunnelId string `json:"tunnel_id"`
 	// TxPowerControl default value is false
-	TxPowerControl bool `json:"tx_power_control"`
+	TxPowerControl bool  `json:"tx_power_control"`
+	Txpower        int64 `json:"txpower"`
+	Txpower11A     int64 `json:"txpower11a"`
 	// Type default value is ""
 	Type string `json:"type"`
-	// Country description: (REGEX)
-	// Country default value is ""
-	Country      string `json:"country"`
-	ScanInterval int    `json:"scan_interval"`
-	// Stp default value is false
-	Stp bool `json:"stp"`
-	// TimeScheduling11A default value is false
-	TimeScheduling11A bool          `json:"time_scheduling11a"`
-	AllowedCountries  []interface{} `json:"allowed_countries"`
-	// Band can be one of: []string{"g", "a"}
-	// Band default value is ""
-	Band        string        `json:"band"`
-	BridgeModes []interface{} `json:"bridge_modes"`
-	// Location default value is ""
-	Location string `json:"location"`
-	// R0KhSecret default value is ""
-	R0KhSecret string `json:"r0kh_secret"`
 	// Vlantagging default value is false
 	Vlantagging bool `json:"vlantagging"`
-	// Enabled default value is false
-	Enabled bool `json:"enabled"`
-	// Interface description: REF(interface/*)
-	// Interface default value is ""
-	Interface string `json:"interface"`
-	// LanMac description: (MACADDR)
-	// LanMac default value is "00:00:00:00:00:00"
-	LanMac     string `json:"lan_mac"`
-	Txpower11A int    `json:"txpower11a"`
-	Comment    string `json:"comment"`
-	// MeshAbility11G default value is false
-	MeshAbility11G bool          `json:"mesh_ability11g"`
-	TimeSelect     []interface{} `json:"time_select"`
-	AutoChannel11A int           `json:"auto_channel11a"`
-	// ChannelWidth can be one of: []string{"HT20", "HT40"}
-	// ChannelWidth default value is "HT20"
-	ChannelWidth string `json:"channel_width"`
-	// Key default value is ""
-	Key string `json:"key"`
 	// WifiMac description: (MACADDR)
 	// WifiMac default value is "00:00:00:00:00:00"
 	WifiMac string `json:"wifi_mac"`
-	// AcAbility default value is false
-	AcAbility       bool          `json:"ac_ability"`
-	ApVlantag       int           `json:"ap_vlantag"`
-	AutoChannel     int           `json:"auto_channel"`
-	Txpower         int           `json:"txpower"`
-	AllowedChannels []interface{} `json:"allowed_channels"`
-	// ChannelWidth11A can be one of: []string{"HT20", "HT40", "VHT20", "VHT40", "VHT80"}
-	// ChannelWidth11A default value is "HT20"
-	ChannelWidth11A string `json:"channel_width11a"`
-	// Status default value is false
-	Status   bool          `json:"status"`
-	Networks []interface{} `json:"networks"`
-	// TimeScheduling default value is false
-	TimeScheduling bool          `json:"time_scheduling"`
-	TimeSelect11A  []interface{} `json:"time_select11a"`
-	Channel        int           `json:"channel"`
-	Channel11A     int           `json:"channel11a"`
-	// DfsAbility default value is false
-	DfsAbility bool `json:"dfs_ability"`
+}
+
+// AweDeviceBand is the Band of a AweDevice
+type AweDeviceBand string
+
+// Known values of AweDeviceBand
+const (
+	AweDeviceBandG AweDeviceBand = "g"
+	AweDeviceBandA AweDeviceBand = "a"
+)
+
+// Valid returns true if the value is a known AweDeviceBand
+func (v AweDeviceBand) Valid() bool {
+	switch v {
+	case AweDeviceBandG, AweDeviceBandA:
+		return true
+	}
+	return false
+}
+
+// AweDeviceChannelWidth is the ChannelWidth of a AweDevice
+type AweDeviceChannelWidth string
+
+// Known values of AweDeviceChannelWidth
+const (
+	AweDeviceChannelWidthHT20 AweDeviceChannelWidth = "HT20"
+	AweDeviceChannelWidthHT40 AweDeviceChannelWidth = "HT40"
+)
+
+// Valid returns true if the value is a known AweDeviceChannelWidth
+func (v AweDeviceChannelWidth) Valid() bool {
+	switch v {
+	case AweDeviceChannelWidthHT20, AweDeviceChannelWidthHT40:
+		return true
+	}
+	return false
+}
+
+// AweDeviceChannelWidth11A is the ChannelWidth11A of a AweDevice
+type AweDeviceChannelWidth11A string
+
+// Known values of AweDeviceChannelWidth11A
+const (
+	AweDeviceChannelWidth11AHT20  AweDeviceChannelWidth11A = "HT20"
+	AweDeviceChannelWidth11AHT40  AweDeviceChannelWidth11A = "HT40"
+	AweDeviceChannelWidth11AVHT20 AweDeviceChannelWidth11A = "VHT20"
+	AweDeviceChannelWidth11AVHT40 AweDeviceChannelWidth11A = "VHT40"
+	AweDeviceChannelWidth11AVHT80 AweDeviceChannelWidth11A = "VHT80"
+)
+
+// Valid returns true if the value is a known AweDeviceChannelWidth11A
+func (v AweDeviceChannelWidth11A) Valid() bool {
+	switch v {
+	case AweDeviceChannelWidth11AHT20, AweDeviceChannelWidth11AHT40, AweDeviceChannelWidth11AVHT20, AweDeviceChannelWidth11AVHT40, AweDeviceChannelWidth11AVHT80:
+		return true
+	}
+	return false
+}
+
+// NewAweDevice returns a AweDevice with the default values of its swagger definition
+func NewAweDevice() *AweDevice {
+	return &AweDevice{
+		ObjectType:      "awe/device",
+		ChannelWidth:    AweDeviceChannelWidthHT20,
+		ChannelWidth11A: AweDeviceChannelWidth11AHT20,
+		Id:              "Remote Wifi Device",
+		LanMac:          "00:00:00:00:00:00",
+		WifiMac:         "00:00:00:00:00:00",
+	}
+}
+
+// Validate checks the AweDevice before it is sent, see ValidateWith
+func (a *AweDevice) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AweDevice.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AweDevice) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AweDevice", typeOf)
+	check.Enum("band", string(a.Band), a.Band.Valid())
+	check.Enum("channel_width", string(a.ChannelWidth), a.ChannelWidth.Valid())
+	check.Enum("channel_width11a", string(a.ChannelWidth11A), a.ChannelWidth11A.Valid())
+	check.Ref("interface", string(a.Interface), "REF(interface/*)")
+	check.Format("lan_mac", sophos.FormatMAC, a.LanMac)
+	check.Format("last_ip", sophos.FormatIPv4, a.LastIp)
+	check.Required("name", a.Name)
+	check.Format("wifi_mac", sophos.FormatMAC, a.WifiMac)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &AweDevice{}
 
-// GetPath implements sophos.RestObject and returns the AweDevices GET path
+// GetPath implements sophos.RestGetter and returns the AweDevices GET path
 // Returns all available awe/device objects
 func (*AweDevices) GetPath() string { return "/api/objects/awe/device/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AweDevices) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AweDevices GET path
+// GetPath implements sophos.RestGetter and returns the AweDevices GET path
 // Returns all available device types
 func (a *AweDevice) GetPath() string { return fmt.Sprintf("/api/objects/awe/device/%s", a.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AweDevice) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AweDevice DELETE path
+// DeletePath implements sophos.Deletable and returns the AweDevice DELETE path
 // Creates or updates the complete object device
 func (*AweDevice) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/device/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AweDevice PATCH path
+// PatchPath implements sophos.Patchable and returns the AweDevice PATCH path
 // Changes to parts of the object device types
 func (*AweDevice) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/device/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AweDevice POST path
+// PostPath implements sophos.Creatable and returns the AweDevice POST path
 // Create a new awe/device object
 func (*AweDevice) PostPath() string {
 	return "/api/objects/awe/device/"
 }
 
-// PutPath implements sophos.RestObject and returns the AweDevice PUT path
+// PutPath implements sophos.Updatable and returns the AweDevice PUT path
 // Creates or updates the complete object device
 func (*AweDevice) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/device/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AweDevice) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/device/%s/usedby", ref)
 }
 
-// AweGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (a *AweDevice) GetType() string { return a.ObjectType }
+
+// AweGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type AweGroups []AweGroup
 
 // AweGroup represents a UTM group
@@ -304,147 +418,212 @@ type AweGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewAweGroup returns a AweGroup with the default values of its swagger definition
+func NewAweGroup() *AweGroup {
+	return &AweGroup{
+		ObjectType: "awe/group",
+	}
+}
+
+// Validate checks the AweGroup before it is sent, see ValidateWith
+func (a *AweGroup) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AweGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AweGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AweGroup", typeOf)
+	check.Required("name", a.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &AweGroup{}
 
-// GetPath implements sophos.RestObject and returns the AweGroups GET path
+// GetPath implements sophos.RestGetter and returns the AweGroups GET path
 // Returns all available awe/group objects
 func (*AweGroups) GetPath() string { return "/api/objects/awe/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AweGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AweGroups GET path
+// GetPath implements sophos.RestGetter and returns the AweGroups GET path
 // Returns all available group types
 func (a *AweGroup) GetPath() string { return fmt.Sprintf("/api/objects/awe/group/%s", a.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AweGroup) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AweGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the AweGroup DELETE path
 // Creates or updates the complete object group
 func (*AweGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AweGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the AweGroup PATCH path
 // Changes to parts of the object group types
 func (*AweGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AweGroup POST path
+// PostPath implements sophos.Creatable and returns the AweGroup POST path
 // Create a new awe/group object
 func (*AweGroup) PostPath() string {
 	return "/api/objects/awe/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the AweGroup PUT path
+// PutPath implements sophos.Updatable and returns the AweGroup PUT path
 // Creates or updates the complete object group
 func (*AweGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AweGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/group/%s/usedby", ref)
 }
 
-// AweLocals is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (a *AweGroup) GetType() string { return a.ObjectType }
+
+// AweLocals is an Sophos Endpoint subType and implements sophos.RestGetter
 type AweLocals []AweLocal
 
 // AweLocal represents a UTM SG wifi
 type AweLocal struct {
-	Locked          string        `json:"_locked"`
-	ObjectType      string        `json:"_type"`
-	Reference       string        `json:"_ref"`
-	AllowedChannels []interface{} `json:"allowed_channels"`
-	Channel         int           `json:"channel"`
-	// WifiMac description: (MACADDR)
-	// WifiMac default value is "00:00:00:00:00:00"
-	WifiMac        string        `json:"wifi_mac"`
-	ActiveChannels []interface{} `json:"active_channels"`
+	Locked            string  `json:"_locked"`
+	ObjectType        string  `json:"_type"`
+	Reference         string  `json:"_ref"`
+	ActiveChannels    []int64 `json:"active_channels"`
+	AllowedChannels   []int64 `json:"allowed_channels"`
+	ApLocaldebuglevel int64   `json:"ap_localdebuglevel"`
+	AutoChannel       int64   `json:"auto_channel"`
 	// Band can be one of: []string{"g", "a"}
 	// Band default value is "g"
-	Band        string        `json:"band"`
-	BridgeModes []interface{} `json:"bridge_modes"`
-	MaxSsids    int           `json:"max_ssids"`
-	Networks    []interface{} `json:"networks"`
-	// TimeScheduling default value is false
-	TimeScheduling bool `json:"time_scheduling"`
-	// TxPowerControl default value is false
-	TxPowerControl bool `json:"tx_power_control"`
-	Txpower        int  `json:"txpower"`
+	Band        AweLocalBand `json:"band"`
+	BridgeModes []string     `json:"bridge_modes"`
+	Channel     int64        `json:"channel"`
+	Comment     string       `json:"comment"`
 	// DfsAbility default value is false
 	DfsAbility bool `json:"dfs_ability"`
 	// Id default value is "Remote Wifi Device"
-	Id string `json:"id"`
+	Id       string `json:"id"`
+	MaxSsids int64  `json:"max_ssids"`
 	// MeshAbility default value is false
 	MeshAbility bool `json:"mesh_ability"`
 	// MeshAbility11A default value is false
 	MeshAbility11A bool `json:"mesh_ability11a"`
 	// MeshAbility11G default value is false
-	MeshAbility11G bool   `json:"mesh_ability11g"`
-	Name           string `json:"name"`
+	MeshAbility11G    bool     `json:"mesh_ability11g"`
+	Name              string   `json:"name"`
+	Networks          []string `json:"networks"`
+	ScanInterval      int64    `json:"scan_interval"`
+	SchedScanInterval int64    `json:"sched_scan_interval"`
 	// Status default value is false
-	Status            bool          `json:"status"`
-	ApLocaldebuglevel int           `json:"ap_localdebuglevel"`
-	AutoChannel       int           `json:"auto_channel"`
-	Comment           string        `json:"comment"`
-	ScanInterval      int           `json:"scan_interval"`
-	SchedScanInterval int           `json:"sched_scan_interval"`
-	TimeSelect        []interface{} `json:"time_select"`
+	Status bool `json:"status"`
+	// TimeScheduling default value is false
+	TimeScheduling bool     `json:"time_scheduling"`
+	TimeSelect     []string `json:"time_select"`
+	// TxPowerControl default value is false
+	TxPowerControl bool  `json:"tx_power_control"`
+	Txpower        int64 `json:"txpower"`
 	// Type default value is ""
 	Type string `json:"type"`
+	// WifiMac description: (MACADDR)
+	// WifiMac default value is "00:00:00:00:00:00"
+	WifiMac string `json:"wifi_mac"`
+}
+
+// AweLocalBand is the Band of a AweLocal
+type AweLocalBand string
+
+// Known values of AweLocalBand
+const (
+	AweLocalBandG AweLocalBand = "g"
+	AweLocalBandA AweLocalBand = "a"
+)
+
+// Valid returns true if the value is a known AweLocalBand
+func (v AweLocalBand) Valid() bool {
+	switch v {
+	case AweLocalBandG, AweLocalBandA:
+		return true
+	}
+	return false
+}
+
+// NewAweLocal returns a AweLocal with the default values of its swagger definition
+func NewAweLocal() *AweLocal {
+	return &AweLocal{
+		ObjectType: "awe/local",
+		Band:       AweLocalBandG,
+		Id:         "Remote Wifi Device",
+		WifiMac:    "00:00:00:00:00:00",
+	}
+}
+
+// Validate checks the AweLocal before it is sent, see ValidateWith
+func (a *AweLocal) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AweLocal.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AweLocal) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AweLocal", typeOf)
+	check.Enum("band", string(a.Band), a.Band.Valid())
+	check.Required("name", a.Name)
+	check.Format("wifi_mac", sophos.FormatMAC, a.WifiMac)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &AweLocal{}
 
-// GetPath implements sophos.RestObject and returns the AweLocals GET path
+// GetPath implements sophos.RestGetter and returns the AweLocals GET path
 // Returns all available awe/local objects
 func (*AweLocals) GetPath() string { return "/api/objects/awe/local/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AweLocals) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AweLocals GET path
+// GetPath implements sophos.RestGetter and returns the AweLocals GET path
 // Returns all available local types
 func (a *AweLocal) GetPath() string { return fmt.Sprintf("/api/objects/awe/local/%s", a.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AweLocal) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AweLocal DELETE path
+// DeletePath implements sophos.Deletable and returns the AweLocal DELETE path
 // Creates or updates the complete object local
 func (*AweLocal) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/local/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AweLocal PATCH path
+// PatchPath implements sophos.Patchable and returns the AweLocal PATCH path
 // Changes to parts of the object local types
 func (*AweLocal) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/local/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AweLocal POST path
+// PostPath implements sophos.Creatable and returns the AweLocal POST path
 // Create a new awe/local object
 func (*AweLocal) PostPath() string {
 	return "/api/objects/awe/local/"
 }
 
-// PutPath implements sophos.RestObject and returns the AweLocal PUT path
+// PutPath implements sophos.Updatable and returns the AweLocal PUT path
 // Creates or updates the complete object local
 func (*AweLocal) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/local/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AweLocal) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/local/%s/usedby", ref)
 }
 
-// AweReds is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (a *AweLocal) GetType() string { return a.ObjectType }
+
+// AweReds is an Sophos Endpoint subType and implements sophos.RestGetter
 type AweReds []AweRed
 
 // AweRed represents a UTM RED wifi
@@ -452,117 +631,185 @@ type AweRed struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
+	// AcAbility default value is false
+	AcAbility         bool     `json:"ac_ability"`
+	ActiveChannels    []int64  `json:"active_channels"`
+	AllowedChannels   []int64  `json:"allowed_channels"`
+	AllowedCountries  []string `json:"allowed_countries"`
+	ApLocaldebuglevel int64    `json:"ap_localdebuglevel"`
+	ApVlantag         int64    `json:"ap_vlantag"`
+	AutoChannel       int64    `json:"auto_channel"`
 	// Band can be one of: []string{"g", "a"}
 	// Band default value is "g"
-	Band string `json:"band"`
-	// R0KhSecret default value is ""
-	R0KhSecret   string        `json:"r0kh_secret"`
-	Channel      int           `json:"channel"`
-	Networks     []interface{} `json:"networks"`
-	ScanInterval int           `json:"scan_interval"`
-	// TimeScheduling default value is false
-	TimeScheduling bool `json:"time_scheduling"`
-	// TxPowerControl default value is false
-	TxPowerControl  bool          `json:"tx_power_control"`
-	AllowedChannels []interface{} `json:"allowed_channels"`
+	Band        AweRedBand `json:"band"`
+	BridgeModes []string   `json:"bridge_modes"`
+	Channel     int64      `json:"channel"`
+	// ChannelWidth can be one of: []string{"HT20", "HT40"}
+	// ChannelWidth default value is "HT20"
+	ChannelWidth AweRedChannelWidth `json:"channel_width"`
+	Comment      string             `json:"comment"`
+	// Country description: (REGEX)
+	// Country default value is ""
+	Country string `json:"country"`
 	// DfsAbility default value is false
 	DfsAbility bool `json:"dfs_ability"`
 	// Enabled default value is false
 	Enabled bool `json:"enabled"`
-	// LastIp description: (IPADDR)
-	// LastIp default value is ""
-	LastIp            string `json:"last_ip"`
-	ApLocaldebuglevel int    `json:"ap_localdebuglevel"`
-	ApVlantag         int    `json:"ap_vlantag"`
-	// Vlantagging default value is false
-	Vlantagging bool `json:"vlantagging"`
-	// Status default value is false
-	Status         bool          `json:"status"`
-	ActiveChannels []interface{} `json:"active_channels"`
-	Comment        string        `json:"comment"`
+	// ForcedCountry default value is ""
+	ForcedCountry string `json:"forced_country"`
 	// Id default value is "Remote Wifi Device"
 	Id string `json:"id"`
-	// LanMac description: (MACADDR)
-	// LanMac default value is "00:00:00:00:00:00"
-	LanMac   string `json:"lan_mac"`
-	MaxSsids int    `json:"max_ssids"`
-	// AcAbility default value is false
-	AcAbility        bool          `json:"ac_ability"`
-	AllowedCountries []interface{} `json:"allowed_countries"`
-	AutoChannel      int           `json:"auto_channel"`
 	// Interface description: REF(interface/*)
 	// Interface default value is ""
-	Interface         string        `json:"interface"`
-	Name              string        `json:"name"`
-	SchedScanInterval int           `json:"sched_scan_interval"`
-	Txpower           int           `json:"txpower"`
-	BridgeModes       []interface{} `json:"bridge_modes"`
-	// ChannelWidth can be one of: []string{"HT20", "HT40"}
-	// ChannelWidth default value is "HT20"
-	ChannelWidth string `json:"channel_width"`
+	Interface InterfaceRef `json:"interface"`
 	// Key default value is ""
 	Key string `json:"key"`
+	// LanMac description: (MACADDR)
+	// LanMac default value is "00:00:00:00:00:00"
+	LanMac string `json:"lan_mac"`
+	// LastIp description: (IPADDR)
+	// LastIp default value is ""
+	LastIp string `json:"last_ip"`
 	// Location default value is ""
 	Location string `json:"location"`
+	MaxSsids int64  `json:"max_ssids"`
 	// MeshAbility default value is false
-	MeshAbility bool `json:"mesh_ability"`
-	// Type default value is ""
-	Type string `json:"type"`
-	// Country description: (REGEX)
-	// Country default value is ""
-	Country string `json:"country"`
-	// ForcedCountry default value is ""
-	ForcedCountry string        `json:"forced_country"`
-	TimeSelect    []interface{} `json:"time_select"`
+	MeshAbility bool     `json:"mesh_ability"`
+	Name        string   `json:"name"`
+	Networks    []string `json:"networks"`
+	// R0KhSecret default value is ""
+	R0KhSecret        string `json:"r0kh_secret"`
+	ScanInterval      int64  `json:"scan_interval"`
+	SchedScanInterval int64  `json:"sched_scan_interval"`
+	// Status default value is false
+	Status bool `json:"status"`
+	// TimeScheduling default value is false
+	TimeScheduling bool     `json:"time_scheduling"`
+	TimeSelect     []string `json:"time_select"`
 	// TunnelId default value is ""
 	TunnelId string `json:"tunnel_id"`
+	// TxPowerControl default value is false
+	TxPowerControl bool  `json:"tx_power_control"`
+	Txpower        int64 `json:"txpower"`
+	// Type default value is ""
+	Type string `json:"type"`
+	// Vlantagging default value is false
+	Vlantagging bool `json:"vlantagging"`
 	// WifiMac description: (MACADDR)
 	// WifiMac default value is "00:00:00:00:00:00"
 	WifiMac string `json:"wifi_mac"`
 }
 
+// AweRedBand is the Band of a AweRed
+type AweRedBand string
+
+// Known values of AweRedBand
+const (
+	AweRedBandG AweRedBand = "g"
+	AweRedBandA AweRedBand = "a"
+)
+
+// Valid returns true if the value is a known AweRedBand
+func (v AweRedBand) Valid() bool {
+	switch v {
+	case AweRedBandG, AweRedBandA:
+		return true
+	}
+	return false
+}
+
+// AweRedChannelWidth is the ChannelWidth of a AweRed
+type AweRedChannelWidth string
+
+// Known values of AweRedChannelWidth
+const (
+	AweRedChannelWidthHT20 AweRedChannelWidth = "HT20"
+	AweRedChannelWidthHT40 AweRedChannelWidth = "HT40"
+)
+
+// Valid returns true if the value is a known AweRedChannelWidth
+func (v AweRedChannelWidth) Valid() bool {
+	switch v {
+	case AweRedChannelWidthHT20, AweRedChannelWidthHT40:
+		return true
+	}
+	return false
+}
+
+// NewAweRed returns a AweRed with the default values of its swagger definition
+func NewAweRed() *AweRed {
+	return &AweRed{
+		ObjectType:   "awe/red",
+		Band:         AweRedBandG,
+		ChannelWidth: AweRedChannelWidthHT20,
+		Id:           "Remote Wifi Device",
+		LanMac:       "00:00:00:00:00:00",
+		WifiMac:      "00:00:00:00:00:00",
+	}
+}
+
+// Validate checks the AweRed before it is sent, see ValidateWith
+func (a *AweRed) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AweRed.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AweRed) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AweRed", typeOf)
+	check.Enum("band", string(a.Band), a.Band.Valid())
+	check.Enum("channel_width", string(a.ChannelWidth), a.ChannelWidth.Valid())
+	check.Ref("interface", string(a.Interface), "REF(interface/*)")
+	check.Format("lan_mac", sophos.FormatMAC, a.LanMac)
+	check.Format("last_ip", sophos.FormatIPv4, a.LastIp)
+	check.Required("name", a.Name)
+	check.Format("wifi_mac", sophos.FormatMAC, a.WifiMac)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &AweRed{}
 
-// GetPath implements sophos.RestObject and returns the AweReds GET path
+// GetPath implements sophos.RestGetter and returns the AweReds GET path
 // Returns all available awe/red objects
 func (*AweReds) GetPath() string { return "/api/objects/awe/red/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AweReds) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AweReds GET path
+// GetPath implements sophos.RestGetter and returns the AweReds GET path
 // Returns all available red types
 func (a *AweRed) GetPath() string { return fmt.Sprintf("/api/objects/awe/red/%s", a.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AweRed) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AweRed DELETE path
+// DeletePath implements sophos.Deletable and returns the AweRed DELETE path
 // Creates or updates the complete object red
 func (*AweRed) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/red/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AweRed PATCH path
+// PatchPath implements sophos.Patchable and returns the AweRed PATCH path
 // Changes to parts of the object red types
 func (*AweRed) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/red/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AweRed POST path
+// PostPath implements sophos.Creatable and returns the AweRed POST path
 // Create a new awe/red object
 func (*AweRed) PostPath() string {
 	return "/api/objects/awe/red/"
 }
 
-// PutPath implements sophos.RestObject and returns the AweRed PUT path
+// PutPath implements sophos.Updatable and returns the AweRed PUT path
 // Creates or updates the complete object red
 func (*AweRed) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/red/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AweRed) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe/red/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (a *AweRed) GetType() string { return a.ObjectType }
@@ -15,13 +15,13 @@ type AweNetworkDeviceAssociation struct {
 
 var _ sophos.Endpoint = &AweNetworkDeviceAssociation{}
 
-var defsAweNetworkDeviceAssociation = map[string]sophos.RestObject{
+var defsAweNetworkDeviceAssociation = map[string]sophos.RestGetter{
 	"AweNetworkDeviceAssociationGroup":    &AweNetworkDeviceAssociationGroup{},
 	"AweNetworkDeviceAssociationMeshRole": &AweNetworkDeviceAssociationMeshRole{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of AweNetworkDeviceAssociation's Objects
-func (AweNetworkDeviceAssociation) RestObjects() map[string]sophos.RestObject {
+func (AweNetworkDeviceAssociation) RestObjects() map[string]sophos.RestGetter {
 	return defsAweNetworkDeviceAssociation
 }
 
@@ -61,7 +61,7 @@ func (AweNetworkDeviceAssociation) References() []string {
 	}
 }
 
-// AweNetworkDeviceAssociationGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// AweNetworkDeviceAssociationGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type AweNetworkDeviceAssociationGroups []AweNetworkDeviceAssociationGroup
 
 // AweNetworkDeviceAssociationGroup represents a UTM group
@@ -73,57 +73,78 @@ type AweNetworkDeviceAssociationGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewAweNetworkDeviceAssociationGroup returns a AweNetworkDeviceAssociationGroup with the default values of its swagger definition
+func NewAweNetworkDeviceAssociationGroup() *AweNetworkDeviceAssociationGroup {
+	return &AweNetworkDeviceAssociationGroup{
+		ObjectType: "awe_network_device_association/group",
+	}
+}
+
+// Validate checks the AweNetworkDeviceAssociationGroup before it is sent, see ValidateWith
+func (a *AweNetworkDeviceAssociationGroup) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AweNetworkDeviceAssociationGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AweNetworkDeviceAssociationGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AweNetworkDeviceAssociationGroup", typeOf)
+	check.Required("name", a.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &AweNetworkDeviceAssociationGroup{}
 
-// GetPath implements sophos.RestObject and returns the AweNetworkDeviceAssociationGroups GET path
+// GetPath implements sophos.RestGetter and returns the AweNetworkDeviceAssociationGroups GET path
 // Returns all available awe_network_device_association/group objects
 func (*AweNetworkDeviceAssociationGroups) GetPath() string {
 	return "/api/objects/awe_network_device_association/group/"
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AweNetworkDeviceAssociationGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AweNetworkDeviceAssociationGroups GET path
+// GetPath implements sophos.RestGetter and returns the AweNetworkDeviceAssociationGroups GET path
 // Returns all available group types
 func (a *AweNetworkDeviceAssociationGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/awe_network_device_association/group/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AweNetworkDeviceAssociationGroup) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AweNetworkDeviceAssociationGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the AweNetworkDeviceAssociationGroup DELETE path
 // Creates or updates the complete object group
 func (*AweNetworkDeviceAssociationGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe_network_device_association/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AweNetworkDeviceAssociationGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the AweNetworkDeviceAssociationGroup PATCH path
 // Changes to parts of the object group types
 func (*AweNetworkDeviceAssociationGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe_network_device_association/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AweNetworkDeviceAssociationGroup POST path
+// PostPath implements sophos.Creatable and returns the AweNetworkDeviceAssociationGroup POST path
 // Create a new awe_network_device_association/group object
 func (*AweNetworkDeviceAssociationGroup) PostPath() string {
 	return "/api/objects/awe_network_device_association/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the AweNetworkDeviceAssociationGroup PUT path
+// PutPath implements sophos.Updatable and returns the AweNetworkDeviceAssociationGroup PUT path
 // Creates or updates the complete object group
 func (*AweNetworkDeviceAssociationGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe_network_device_association/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AweNetworkDeviceAssociationGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe_network_device_association/group/%s/usedby", ref)
 }
 
-// AweNetworkDeviceAssociationMeshRoles is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (a *AweNetworkDeviceAssociationGroup) GetType() string { return a.ObjectType }
+
+// AweNetworkDeviceAssociationMeshRoles is an Sophos Endpoint subType and implements sophos.RestGetter
 type AweNetworkDeviceAssociationMeshRoles []AweNetworkDeviceAssociationMeshRole
 
 // AweNetworkDeviceAssociationMeshRole represents a UTM assign device to mesh network
@@ -131,63 +152,108 @@ type AweNetworkDeviceAssociationMeshRole struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// Role can be one of: []string{"point", "portal"}
-	// Role default value is "portal"
-	Role    string `json:"role"`
-	Comment string `json:"comment"`
+	Comment    string `json:"comment"`
 	// Device description: REF(awe/device)
-	Device string `json:"device"`
+	Device AweRef `json:"device"`
 	// Mesh description: REF(itfhw/awe_network)
-	Mesh string `json:"mesh"`
-	Name string `json:"name"`
+	Mesh ItfhwRef `json:"mesh"`
+	Name string   `json:"name"`
+	// Role can be one of: []string{"point", "portal"}
+	// Role default value is "portal"
+	Role AweNetworkDeviceAssociationMeshRoleRole `json:"role"`
+}
+
+// AweNetworkDeviceAssociationMeshRoleRole is the Role of a AweNetworkDeviceAssociationMeshRole
+type AweNetworkDeviceAssociationMeshRoleRole string
+
+// Known values of AweNetworkDeviceAssociationMeshRoleRole
+const (
+	AweNetworkDeviceAssociationMeshRoleRolePoint  AweNetworkDeviceAssociationMeshRoleRole = "point"
+	AweNetworkDeviceAssociationMeshRoleRolePortal AweNetworkDeviceAssociationMeshRoleRole = "portal"
+)
+
+// Valid returns true if the value is a known AweNetworkDeviceAssociationMeshRoleRole
+func (v AweNetworkDeviceAssociationMeshRoleRole) Valid() bool {
+	switch v {
+	case AweNetworkDeviceAssociationMeshRoleRolePoint, AweNetworkDeviceAssociationMeshRoleRolePortal:
+		return true
+	}
+	return false
+}
+
+// NewAweNetworkDeviceAssociationMeshRole returns a AweNetworkDeviceAssociationMeshRole with the default values of its swagger definition
+func NewAweNetworkDeviceAssociationMeshRole() *AweNetworkDeviceAssociationMeshRole {
+	return &AweNetworkDeviceAssociationMeshRole{
+		ObjectType: "awe_network_device_association/mesh_role",
+		Role:       AweNetworkDeviceAssociationMeshRoleRolePortal,
+	}
+}
+
+// Validate checks the AweNetworkDeviceAssociationMeshRole before it is sent, see ValidateWith
+func (a *AweNetworkDeviceAssociationMeshRole) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AweNetworkDeviceAssociationMeshRole.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AweNetworkDeviceAssociationMeshRole) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AweNetworkDeviceAssociationMeshRole", typeOf)
+	check.Required("device", string(a.Device))
+	check.Ref("device", string(a.Device), "REF(awe/device)")
+	check.Required("mesh", string(a.Mesh))
+	check.Ref("mesh", string(a.Mesh), "REF(itfhw/awe_network)")
+	check.Required("name", a.Name)
+	check.Enum("role", string(a.Role), a.Role.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &AweNetworkDeviceAssociationMeshRole{}
 
-// GetPath implements sophos.RestObject and returns the AweNetworkDeviceAssociationMeshRoles GET path
+// GetPath implements sophos.RestGetter and returns the AweNetworkDeviceAssociationMeshRoles GET path
 // Returns all available awe_network_device_association/mesh_role objects
 func (*AweNetworkDeviceAssociationMeshRoles) GetPath() string {
 	return "/api/objects/awe_network_device_association/mesh_role/"
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AweNetworkDeviceAssociationMeshRoles) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AweNetworkDeviceAssociationMeshRoles GET path
+// GetPath implements sophos.RestGetter and returns the AweNetworkDeviceAssociationMeshRoles GET path
 // Returns all available mesh_role types
 func (a *AweNetworkDeviceAssociationMeshRole) GetPath() string {
 	return fmt.Sprintf("/api/objects/awe_network_device_association/mesh_role/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AweNetworkDeviceAssociationMeshRole) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AweNetworkDeviceAssociationMeshRole DELETE path
+// DeletePath implements sophos.Deletable and returns the AweNetworkDeviceAssociationMeshRole DELETE path
 // Creates or updates the complete object mesh_role
 func (*AweNetworkDeviceAssociationMeshRole) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe_network_device_association/mesh_role/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AweNetworkDeviceAssociationMeshRole PATCH path
+// PatchPath implements sophos.Patchable and returns the AweNetworkDeviceAssociationMeshRole PATCH path
 // Changes to parts of the object mesh_role types
 func (*AweNetworkDeviceAssociationMeshRole) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe_network_device_association/mesh_role/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AweNetworkDeviceAssociationMeshRole POST path
+// PostPath implements sophos.Creatable and returns the AweNetworkDeviceAssociationMeshRole POST path
 // Create a new awe_network_device_association/mesh_role object
 func (*AweNetworkDeviceAssociationMeshRole) PostPath() string {
 	return "/api/objects/awe_network_device_association/mesh_role/"
 }
 
-// PutPath implements sophos.RestObject and returns the AweNetworkDeviceAssociationMeshRole PUT path
+// PutPath implements sophos.Updatable and returns the AweNetworkDeviceAssociationMeshRole PUT path
 // Creates or updates the complete object mesh_role
 func (*AweNetworkDeviceAssociationMeshRole) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe_network_device_association/mesh_role/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AweNetworkDeviceAssociationMeshRole) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awe_network_device_association/mesh_role/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (a *AweNetworkDeviceAssociationMeshRole) GetType() string { return a.ObjectType }
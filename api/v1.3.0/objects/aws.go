@@ -16,14 +16,14 @@ type Aws struct {
 
 var _ sophos.Endpoint = &Aws{}
 
-var defsAws = map[string]sophos.RestObject{
+var defsAws = map[string]sophos.RestGetter{
 	"AwsGroup":        &AwsGroup{},
 	"AwsInstanceType": &AwsInstanceType{},
 	"AwsRegion":       &AwsRegion{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Aws's Objects
-func (Aws) RestObjects() map[string]sophos.RestObject { return defsAws }
+func (Aws) RestObjects() map[string]sophos.RestGetter { return defsAws }
 
 // GetPath implements sophos.RestGetter
 func (*Aws) GetPath() string { return "/api/nodes/aws" }
@@ -61,7 +61,7 @@ func (Aws) References() []string {
 	}
 }
 
-// AwsGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// AwsGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type AwsGroups []AwsGroup
 
 // AwsGroup represents a UTM aws->group
@@ -73,112 +73,151 @@ type AwsGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewAwsGroup returns a AwsGroup with the default values of its swagger definition
+func NewAwsGroup() *AwsGroup {
+	return &AwsGroup{
+		ObjectType: "aws/group",
+	}
+}
+
+// Validate checks the AwsGroup before it is sent, see ValidateWith
+func (a *AwsGroup) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AwsGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AwsGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AwsGroup", typeOf)
+	check.Required("name", a.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &AwsGroup{}
 
-// GetPath implements sophos.RestObject and returns the AwsGroups GET path
+// GetPath implements sophos.RestGetter and returns the AwsGroups GET path
 // Returns all available aws/group objects
 func (*AwsGroups) GetPath() string { return "/api/objects/aws/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AwsGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AwsGroups GET path
+// GetPath implements sophos.RestGetter and returns the AwsGroups GET path
 // Returns all available group types
 func (a *AwsGroup) GetPath() string { return fmt.Sprintf("/api/objects/aws/group/%s", a.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AwsGroup) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AwsGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the AwsGroup DELETE path
 // Creates or updates the complete object group
 func (*AwsGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/aws/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AwsGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the AwsGroup PATCH path
 // Changes to parts of the object group types
 func (*AwsGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/aws/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AwsGroup POST path
+// PostPath implements sophos.Creatable and returns the AwsGroup POST path
 // Create a new aws/group object
 func (*AwsGroup) PostPath() string {
 	return "/api/objects/aws/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the AwsGroup PUT path
+// PutPath implements sophos.Updatable and returns the AwsGroup PUT path
 // Creates or updates the complete object group
 func (*AwsGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/aws/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AwsGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/aws/group/%s/usedby", ref)
 }
 
-// AwsInstanceTypes is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (a *AwsGroup) GetType() string { return a.ObjectType }
+
+// AwsInstanceTypes is an Sophos Endpoint subType and implements sophos.RestGetter
 type AwsInstanceTypes []AwsInstanceType
 
-// AwsInstanceType is a generated Sophos object
+// AwsInstanceType represents a UTM aws/instance_type object
 type AwsInstanceType struct {
-	Locked             string      `json:"_locked"`
-	Reference          string      `json:"_ref"`
-	ObjectType         string      `json:"_type"`
-	Comment            string      `json:"comment"`
-	CPUCores           int64       `json:"cpu_cores"`
-	Deprecated         bool        `json:"deprecated"`
-	MemoryBytes        interface{} `json:"memory_bytes"`
-	Model              string      `json:"model"`
-	Name               string      `json:"name"`
-	NetworkPerformance string      `json:"network_performance"`
+	Locked             string `json:"_locked"`
+	ObjectType         string `json:"_type"`
+	Reference          string `json:"_ref"`
+	Comment            string `json:"comment"`
+	CPUCores           int64  `json:"cpu_cores"`
+	Deprecated         bool   `json:"deprecated"`
+	MemoryBytes        int64  `json:"memory_bytes"`
+	Model              string `json:"model"`
+	Name               string `json:"name"`
+	NetworkPerformance string `json:"network_performance"`
+}
+
+// NewAwsInstanceType returns a AwsInstanceType with the default values of its swagger definition
+func NewAwsInstanceType() *AwsInstanceType {
+	return &AwsInstanceType{
+		ObjectType: "aws/instance_type",
+	}
+}
+
+// Validate checks the AwsInstanceType before it is sent, see ValidateWith
+func (a *AwsInstanceType) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AwsInstanceType.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AwsInstanceType) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AwsInstanceType", typeOf)
+	check.Required("name", a.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &AwsInstanceType{}
 
-// GetPath implements sophos.RestObject and returns the AwsInstanceTypes GET path
+// GetPath implements sophos.RestGetter and returns the AwsInstanceTypes GET path
 // Returns all available aws/instance_type objects
 func (*AwsInstanceTypes) GetPath() string { return "/api/objects/aws/instance_type/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AwsInstanceTypes) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AwsInstanceTypes GET path
+// GetPath implements sophos.RestGetter and returns the AwsInstanceTypes GET path
 // Returns all available instance_type types
 func (a *AwsInstanceType) GetPath() string {
 	return fmt.Sprintf("/api/objects/aws/instance_type/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AwsInstanceType) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AwsInstanceType DELETE path
+// DeletePath implements sophos.Deletable and returns the AwsInstanceType DELETE path
 // Creates or updates the complete object instance_type
 func (*AwsInstanceType) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/aws/instance_type/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AwsInstanceType PATCH path
+// PatchPath implements sophos.Patchable and returns the AwsInstanceType PATCH path
 // Changes to parts of the object instance_type types
 func (*AwsInstanceType) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/aws/instance_type/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AwsInstanceType POST path
+// PostPath implements sophos.Creatable and returns the AwsInstanceType POST path
 // Create a new aws/instance_type object
 func (*AwsInstanceType) PostPath() string {
 	return "/api/objects/aws/instance_type/"
 }
 
-// PutPath implements sophos.RestObject and returns the AwsInstanceType PUT path
+// PutPath implements sophos.Updatable and returns the AwsInstanceType PUT path
 // Creates or updates the complete object instance_type
 func (*AwsInstanceType) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/aws/instance_type/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AwsInstanceType) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/aws/instance_type/%s/usedby", ref)
@@ -187,14 +226,14 @@ func (*AwsInstanceType) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (a *AwsInstanceType) GetType() string { return a.ObjectType }
 
-// AwsRegions is an Sophos Endpoint subType and implements sophos.RestObject
+// AwsRegions is an Sophos Endpoint subType and implements sophos.RestGetter
 type AwsRegions []AwsRegion
 
-// AwsRegion is a generated Sophos object
+// AwsRegion represents a UTM aws/region object
 type AwsRegion struct {
 	Locked            string   `json:"_locked"`
-	Reference         string   `json:"_ref"`
 	ObjectType        string   `json:"_type"`
+	Reference         string   `json:"_ref"`
 	AvailabilityZones []string `json:"availability_zones"`
 	Code              string   `json:"code"`
 	Comment           string   `json:"comment"`
@@ -203,47 +242,65 @@ type AwsRegion struct {
 	Partition         string   `json:"partition"`
 }
 
+// NewAwsRegion returns a AwsRegion with the default values of its swagger definition
+func NewAwsRegion() *AwsRegion {
+	return &AwsRegion{
+		ObjectType: "aws/region",
+	}
+}
+
+// Validate checks the AwsRegion before it is sent, see ValidateWith
+func (a *AwsRegion) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AwsRegion.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AwsRegion) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AwsRegion", typeOf)
+	check.Required("name", a.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &AwsRegion{}
 
-// GetPath implements sophos.RestObject and returns the AwsRegions GET path
+// GetPath implements sophos.RestGetter and returns the AwsRegions GET path
 // Returns all available aws/region objects
 func (*AwsRegions) GetPath() string { return "/api/objects/aws/region/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AwsRegions) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AwsRegions GET path
+// GetPath implements sophos.RestGetter and returns the AwsRegions GET path
 // Returns all available region types
 func (a *AwsRegion) GetPath() string { return fmt.Sprintf("/api/objects/aws/region/%s", a.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AwsRegion) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AwsRegion DELETE path
+// DeletePath implements sophos.Deletable and returns the AwsRegion DELETE path
 // Creates or updates the complete object region
 func (*AwsRegion) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/aws/region/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AwsRegion PATCH path
+// PatchPath implements sophos.Patchable and returns the AwsRegion PATCH path
 // Changes to parts of the object region types
 func (*AwsRegion) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/aws/region/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AwsRegion POST path
+// PostPath implements sophos.Creatable and returns the AwsRegion POST path
 // Create a new aws/region object
 func (*AwsRegion) PostPath() string {
 	return "/api/objects/aws/region/"
 }
 
-// PutPath implements sophos.RestObject and returns the AwsRegion PUT path
+// PutPath implements sophos.Updatable and returns the AwsRegion PUT path
 // Creates or updates the complete object region
 func (*AwsRegion) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/aws/region/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AwsRegion) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/aws/region/%s/usedby", ref)
@@ -9,18 +9,18 @@ import (
 // Awscli is a generated struct representing the Sophos Awscli Endpoint
 // GET /api/nodes/awscli
 type Awscli struct {
-	Profiles []interface{} `json:"profiles"`
+	Profiles []string `json:"profiles"`
 }
 
 var _ sophos.Endpoint = &Awscli{}
 
-var defsAwscli = map[string]sophos.RestObject{
+var defsAwscli = map[string]sophos.RestGetter{
 	"AwscliGroup":   &AwscliGroup{},
 	"AwscliProfile": &AwscliProfile{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Awscli's Objects
-func (Awscli) RestObjects() map[string]sophos.RestObject { return defsAwscli }
+func (Awscli) RestObjects() map[string]sophos.RestGetter { return defsAwscli }
 
 // GetPath implements sophos.RestGetter
 func (*Awscli) GetPath() string { return "/api/nodes/awscli" }
@@ -54,7 +54,7 @@ func (Awscli) References() []string {
 	}
 }
 
-// AwscliGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// AwscliGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type AwscliGroups []AwscliGroup
 
 // AwscliGroup represents a UTM awscli->group
@@ -62,59 +62,80 @@ type AwscliGroup struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Name       string `json:"name"`
 	Comment    string `json:"comment"`
+	Name       string `json:"name"`
+}
+
+// NewAwscliGroup returns a AwscliGroup with the default values of its swagger definition
+func NewAwscliGroup() *AwscliGroup {
+	return &AwscliGroup{
+		ObjectType: "awscli/group",
+	}
+}
+
+// Validate checks the AwscliGroup before it is sent, see ValidateWith
+func (a *AwscliGroup) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AwscliGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AwscliGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AwscliGroup", typeOf)
+	check.Required("name", a.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &AwscliGroup{}
 
-// GetPath implements sophos.RestObject and returns the AwscliGroups GET path
+// GetPath implements sophos.RestGetter and returns the AwscliGroups GET path
 // Returns all available awscli/group objects
 func (*AwscliGroups) GetPath() string { return "/api/objects/awscli/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AwscliGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AwscliGroups GET path
+// GetPath implements sophos.RestGetter and returns the AwscliGroups GET path
 // Returns all available group types
 func (a *AwscliGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/awscli/group/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AwscliGroup) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AwscliGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the AwscliGroup DELETE path
 // Creates or updates the complete object group
 func (*AwscliGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/awscli/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AwscliGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the AwscliGroup PATCH path
 // Changes to parts of the object group types
 func (*AwscliGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awscli/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AwscliGroup POST path
+// PostPath implements sophos.Creatable and returns the AwscliGroup POST path
 // Create a new awscli/group object
 func (*AwscliGroup) PostPath() string {
 	return "/api/objects/awscli/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the AwscliGroup PUT path
+// PutPath implements sophos.Updatable and returns the AwscliGroup PUT path
 // Creates or updates the complete object group
 func (*AwscliGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awscli/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AwscliGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awscli/group/%s/usedby", ref)
 }
 
-// AwscliProfiles is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (a *AwscliGroup) GetType() string { return a.ObjectType }
+
+// AwscliProfiles is an Sophos Endpoint subType and implements sophos.RestGetter
 type AwscliProfiles []AwscliProfile
 
 // AwscliProfile represents a UTM AWS CLI Profile
@@ -122,6 +143,9 @@ type AwscliProfile struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
+	// AwsAccessKeyId description: (REGEX)
+	// AwsAccessKeyId default value is ""
+	AwsAccessKeyId string `json:"aws_access_key_id"`
 	// AwsSecretAccessKey default value is ""
 	AwsSecretAccessKey string `json:"aws_secret_access_key"`
 	// AwsSessionToken default value is ""
@@ -130,61 +154,103 @@ type AwscliProfile struct {
 	Name            string `json:"name"`
 	// Output can be one of: []string{"json", "text", "table"}
 	// Output default value is "json"
-	Output string `json:"output"`
+	Output AwscliProfileOutput `json:"output"`
 	// ProfileName description: (REGEX)
 	// ProfileName default value is "default"
 	ProfileName string `json:"profile_name"`
 	// Region description: REF(aws/region)
-	Region string `json:"region"`
-	// AwsAccessKeyId description: (REGEX)
-	// AwsAccessKeyId default value is ""
-	AwsAccessKeyId string `json:"aws_access_key_id"`
+	Region AwsRef `json:"region"`
+}
+
+// AwscliProfileOutput is the Output of a AwscliProfile
+type AwscliProfileOutput string
+
+// Known values of AwscliProfileOutput
+const (
+	AwscliProfileOutputJson  AwscliProfileOutput = "json"
+	AwscliProfileOutputText  AwscliProfileOutput = "text"
+	AwscliProfileOutputTable AwscliProfileOutput = "table"
+)
+
+// Valid returns true if the value is a known AwscliProfileOutput
+func (v AwscliProfileOutput) Valid() bool {
+	switch v {
+	case AwscliProfileOutputJson, AwscliProfileOutputText, AwscliProfileOutputTable:
+		return true
+	}
+	return false
+}
+
+// NewAwscliProfile returns a AwscliProfile with the default values of its swagger definition
+func NewAwscliProfile() *AwscliProfile {
+	return &AwscliProfile{
+		ObjectType:  "awscli/profile",
+		Output:      AwscliProfileOutputJson,
+		ProfileName: "default",
+	}
+}
+
+// Validate checks the AwscliProfile before it is sent, see ValidateWith
+func (a *AwscliProfile) Validate() error { return a.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the AwscliProfile.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (a *AwscliProfile) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("AwscliProfile", typeOf)
+	check.Required("name", a.Name)
+	check.Enum("output", string(a.Output), a.Output.Valid())
+	check.Required("region", string(a.Region))
+	check.Ref("region", string(a.Region), "REF(aws/region)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &AwscliProfile{}
 
-// GetPath implements sophos.RestObject and returns the AwscliProfiles GET path
+// GetPath implements sophos.RestGetter and returns the AwscliProfiles GET path
 // Returns all available awscli/profile objects
 func (*AwscliProfiles) GetPath() string { return "/api/objects/awscli/profile/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*AwscliProfiles) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the AwscliProfiles GET path
+// GetPath implements sophos.RestGetter and returns the AwscliProfiles GET path
 // Returns all available profile types
 func (a *AwscliProfile) GetPath() string {
 	return fmt.Sprintf("/api/objects/awscli/profile/%s", a.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (a *AwscliProfile) RefRequired() (string, bool) { return a.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the AwscliProfile DELETE path
+// DeletePath implements sophos.Deletable and returns the AwscliProfile DELETE path
 // Creates or updates the complete object profile
 func (*AwscliProfile) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/awscli/profile/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the AwscliProfile PATCH path
+// PatchPath implements sophos.Patchable and returns the AwscliProfile PATCH path
 // Changes to parts of the object profile types
 func (*AwscliProfile) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awscli/profile/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the AwscliProfile POST path
+// PostPath implements sophos.Creatable and returns the AwscliProfile POST path
 // Create a new awscli/profile object
 func (*AwscliProfile) PostPath() string {
 	return "/api/objects/awscli/profile/"
 }
 
-// PutPath implements sophos.RestObject and returns the AwscliProfile PUT path
+// PutPath implements sophos.Updatable and returns the AwscliProfile PUT path
 // Creates or updates the complete object profile
 func (*AwscliProfile) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awscli/profile/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*AwscliProfile) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/awscli/profile/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (a *AwscliProfile) GetType() string { return a.ObjectType }
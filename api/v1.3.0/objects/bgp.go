@@ -19,7 +19,7 @@ type Bgp struct {
 
 var _ sophos.Endpoint = &Bgp{}
 
-var defsBgp = map[string]sophos.RestObject{
+var defsBgp = map[string]sophos.RestGetter{
 	"BgpAmazonVpc": &BgpAmazonVpc{},
 	"BgpFilter":    &BgpFilter{},
 	"BgpGroup":     &BgpGroup{},
@@ -29,7 +29,7 @@ var defsBgp = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Bgp's Objects
-func (Bgp) RestObjects() map[string]sophos.RestObject { return defsBgp }
+func (Bgp) RestObjects() map[string]sophos.RestGetter { return defsBgp }
 
 // GetPath implements sophos.RestGetter
 func (*Bgp) GetPath() string { return "/api/nodes/bgp" }
@@ -79,14 +79,14 @@ func (Bgp) References() []string {
 	}
 }
 
-// BgpAmazonVpcs is an Sophos Endpoint subType and implements sophos.RestObject
+// BgpAmazonVpcs is an Sophos Endpoint subType and implements sophos.RestGetter
 type BgpAmazonVpcs []BgpAmazonVpc
 
-// BgpAmazonVpc is a generated Sophos object
+// BgpAmazonVpc represents a UTM bgp/amazon_vpc object
 type BgpAmazonVpc struct {
 	Locked       string   `json:"_locked"`
-	Reference    string   `json:"_ref"`
 	ObjectType   string   `json:"_type"`
+	Reference    string   `json:"_ref"`
 	Comment      string   `json:"comment"`
 	Custom       string   `json:"custom"`
 	Host         string   `json:"host"`
@@ -98,49 +98,67 @@ type BgpAmazonVpc struct {
 	RemoteAsn    int64    `json:"remote_asn"`
 }
 
+// NewBgpAmazonVpc returns a BgpAmazonVpc with the default values of its swagger definition
+func NewBgpAmazonVpc() *BgpAmazonVpc {
+	return &BgpAmazonVpc{
+		ObjectType: "bgp/amazon_vpc",
+	}
+}
+
+// Validate checks the BgpAmazonVpc before it is sent, see ValidateWith
+func (b *BgpAmazonVpc) Validate() error { return b.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the BgpAmazonVpc.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (b *BgpAmazonVpc) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("BgpAmazonVpc", typeOf)
+	check.Required("name", b.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &BgpAmazonVpc{}
 
-// GetPath implements sophos.RestObject and returns the BgpAmazonVpcs GET path
+// GetPath implements sophos.RestGetter and returns the BgpAmazonVpcs GET path
 // Returns all available bgp/amazon_vpc objects
 func (*BgpAmazonVpcs) GetPath() string { return "/api/objects/bgp/amazon_vpc/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*BgpAmazonVpcs) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the BgpAmazonVpcs GET path
+// GetPath implements sophos.RestGetter and returns the BgpAmazonVpcs GET path
 // Returns all available amazon_vpc types
 func (b *BgpAmazonVpc) GetPath() string {
 	return fmt.Sprintf("/api/objects/bgp/amazon_vpc/%s", b.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (b *BgpAmazonVpc) RefRequired() (string, bool) { return b.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the BgpAmazonVpc DELETE path
+// DeletePath implements sophos.Deletable and returns the BgpAmazonVpc DELETE path
 // Creates or updates the complete object amazon_vpc
 func (*BgpAmazonVpc) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/amazon_vpc/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the BgpAmazonVpc PATCH path
+// PatchPath implements sophos.Patchable and returns the BgpAmazonVpc PATCH path
 // Changes to parts of the object amazon_vpc types
 func (*BgpAmazonVpc) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/amazon_vpc/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the BgpAmazonVpc POST path
+// PostPath implements sophos.Creatable and returns the BgpAmazonVpc POST path
 // Create a new bgp/amazon_vpc object
 func (*BgpAmazonVpc) PostPath() string {
 	return "/api/objects/bgp/amazon_vpc/"
 }
 
-// PutPath implements sophos.RestObject and returns the BgpAmazonVpc PUT path
+// PutPath implements sophos.Updatable and returns the BgpAmazonVpc PUT path
 // Creates or updates the complete object amazon_vpc
 func (*BgpAmazonVpc) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/amazon_vpc/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*BgpAmazonVpc) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/amazon_vpc/%s/usedby", ref)
@@ -149,71 +167,130 @@ func (*BgpAmazonVpc) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (b *BgpAmazonVpc) GetType() string { return b.ObjectType }
 
-// BgpFilters is an Sophos Endpoint subType and implements sophos.RestObject
+// BgpFilters is an Sophos Endpoint subType and implements sophos.RestGetter
 type BgpFilters []BgpFilter
 
 // BgpFilter represents a UTM BGP filter list
 type BgpFilter struct {
-	Locked     string        `json:"_locked"`
-	ObjectType string        `json:"_type"`
-	Reference  string        `json:"_ref"`
-	Address    []interface{} `json:"address"`
-	AsRegex    []interface{} `json:"as_regex"`
-	Comment    string        `json:"comment"`
-	Name       string        `json:"name"`
-	// Type can be one of: []string{"as_number", "ip_address"}
-	Type string `json:"type"`
+	Locked     string `json:"_locked"`
+	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	// Action can be one of: []string{"permit", "deny"}
-	Action string `json:"action"`
+	Action  BgpFilterAction `json:"action"`
+	Address []string        `json:"address"`
+	AsRegex []string        `json:"as_regex"`
+	Comment string          `json:"comment"`
+	Name    string          `json:"name"`
+	// Type can be one of: []string{"as_number", "ip_address"}
+	Type BgpFilterType `json:"type"`
+}
+
+// BgpFilterAction is the Action of a BgpFilter
+type BgpFilterAction string
+
+// Known values of BgpFilterAction
+const (
+	BgpFilterActionPermit BgpFilterAction = "permit"
+	BgpFilterActionDeny   BgpFilterAction = "deny"
+)
+
+// Valid returns true if the value is a known BgpFilterAction
+func (v BgpFilterAction) Valid() bool {
+	switch v {
+	case BgpFilterActionPermit, BgpFilterActionDeny:
+		return true
+	}
+	return false
+}
+
+// BgpFilterType is the Type of a BgpFilter
+type BgpFilterType string
+
+// Known values of BgpFilterType
+const (
+	BgpFilterTypeAsNumber  BgpFilterType = "as_number"
+	BgpFilterTypeIpAddress BgpFilterType = "ip_address"
+)
+
+// Valid returns true if the value is a known BgpFilterType
+func (v BgpFilterType) Valid() bool {
+	switch v {
+	case BgpFilterTypeAsNumber, BgpFilterTypeIpAddress:
+		return true
+	}
+	return false
+}
+
+// NewBgpFilter returns a BgpFilter with the default values of its swagger definition
+func NewBgpFilter() *BgpFilter {
+	return &BgpFilter{
+		ObjectType: "bgp/filter",
+	}
+}
+
+// Validate checks the BgpFilter before it is sent, see ValidateWith
+func (b *BgpFilter) Validate() error { return b.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the BgpFilter.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (b *BgpFilter) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("BgpFilter", typeOf)
+	check.Enum("action", string(b.Action), b.Action.Valid())
+	check.Required("name", b.Name)
+	check.Enum("type", string(b.Type), b.Type.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &BgpFilter{}
 
-// GetPath implements sophos.RestObject and returns the BgpFilters GET path
+// GetPath implements sophos.RestGetter and returns the BgpFilters GET path
 // Returns all available bgp/filter objects
 func (*BgpFilters) GetPath() string { return "/api/objects/bgp/filter/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*BgpFilters) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the BgpFilters GET path
+// GetPath implements sophos.RestGetter and returns the BgpFilters GET path
 // Returns all available filter types
 func (b *BgpFilter) GetPath() string { return fmt.Sprintf("/api/objects/bgp/filter/%s", b.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (b *BgpFilter) RefRequired() (string, bool) { return b.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the BgpFilter DELETE path
+// DeletePath implements sophos.Deletable and returns the BgpFilter DELETE path
 // Creates or updates the complete object filter
 func (*BgpFilter) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/filter/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the BgpFilter PATCH path
+// PatchPath implements sophos.Patchable and returns the BgpFilter PATCH path
 // Changes to parts of the object filter types
 func (*BgpFilter) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/filter/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the BgpFilter POST path
+// PostPath implements sophos.Creatable and returns the BgpFilter POST path
 // Create a new bgp/filter object
 func (*BgpFilter) PostPath() string {
 	return "/api/objects/bgp/filter/"
 }
 
-// PutPath implements sophos.RestObject and returns the BgpFilter PUT path
+// PutPath implements sophos.Updatable and returns the BgpFilter PUT path
 // Creates or updates the complete object filter
 func (*BgpFilter) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/filter/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*BgpFilter) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/filter/%s/usedby", ref)
 }
 
-// BgpGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (b *BgpFilter) GetType() string { return b.ObjectType }
+
+// BgpGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type BgpGroups []BgpGroup
 
 // BgpGroup represents a UTM group
@@ -225,53 +302,74 @@ type BgpGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewBgpGroup returns a BgpGroup with the default values of its swagger definition
+func NewBgpGroup() *BgpGroup {
+	return &BgpGroup{
+		ObjectType: "bgp/group",
+	}
+}
+
+// Validate checks the BgpGroup before it is sent, see ValidateWith
+func (b *BgpGroup) Validate() error { return b.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the BgpGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (b *BgpGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("BgpGroup", typeOf)
+	check.Required("name", b.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &BgpGroup{}
 
-// GetPath implements sophos.RestObject and returns the BgpGroups GET path
+// GetPath implements sophos.RestGetter and returns the BgpGroups GET path
 // Returns all available bgp/group objects
 func (*BgpGroups) GetPath() string { return "/api/objects/bgp/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*BgpGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the BgpGroups GET path
+// GetPath implements sophos.RestGetter and returns the BgpGroups GET path
 // Returns all available group types
 func (b *BgpGroup) GetPath() string { return fmt.Sprintf("/api/objects/bgp/group/%s", b.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (b *BgpGroup) RefRequired() (string, bool) { return b.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the BgpGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the BgpGroup DELETE path
 // Creates or updates the complete object group
 func (*BgpGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the BgpGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the BgpGroup PATCH path
 // Changes to parts of the object group types
 func (*BgpGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the BgpGroup POST path
+// PostPath implements sophos.Creatable and returns the BgpGroup POST path
 // Create a new bgp/group object
 func (*BgpGroup) PostPath() string {
 	return "/api/objects/bgp/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the BgpGroup PUT path
+// PutPath implements sophos.Updatable and returns the BgpGroup PUT path
 // Creates or updates the complete object group
 func (*BgpGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*BgpGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/group/%s/usedby", ref)
 }
 
-// BgpNeighbors is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (b *BgpGroup) GetType() string { return b.ObjectType }
+
+// BgpNeighbors is an Sophos Endpoint subType and implements sophos.RestGetter
 type BgpNeighbors []BgpNeighbor
 
 // BgpNeighbor represents a UTM BGP neighbor
@@ -279,156 +377,245 @@ type BgpNeighbor struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
+	Asn        int64  `json:"asn"`
 	// Authentication can be one of: []string{"null", "password"}
 	// Authentication default value is "null"
-	Authentication string `json:"authentication"`
+	Authentication BgpNeighborAuthentication `json:"authentication"`
+	Comment        string                    `json:"comment"`
 	// DefaultOriginate default value is false
 	DefaultOriginate bool `json:"default_originate"`
-	// SoftReconfiguration default value is true
-	SoftReconfiguration bool `json:"soft_reconfiguration"`
-	Weight              int  `json:"weight"`
-	// Status default value is true
-	Status  bool   `json:"status"`
-	Comment string `json:"comment"`
+	// FilterIn description: REF(bgp/filter)
+	FilterIn BgpRef `json:"filter_in"`
+	// FilterOut description: REF(bgp/filter)
+	FilterOut BgpRef `json:"filter_out"`
+	// Host description: REF(network/host)
+	Host NetworkRef `json:"host"`
 	// Multihop default value is false
-	Multihop bool `json:"multihop"`
+	Multihop bool   `json:"multihop"`
+	Name     string `json:"name"`
+	// NextHopSelf default value is false
+	NextHopSelf bool `json:"next_hop_self"`
 	// Password description: (REGEX)
 	Password string `json:"password"`
 	// RouteIn description: REF(bgp/route_map)
-	RouteIn string `json:"route_in"`
-	Asn     int    `json:"asn"`
-	// NextHopSelf default value is false
-	NextHopSelf bool `json:"next_hop_self"`
+	RouteIn BgpRef `json:"route_in"`
 	// RouteOut description: REF(bgp/route_map)
-	RouteOut string `json:"route_out"`
-	// FilterIn description: REF(bgp/filter)
-	FilterIn string `json:"filter_in"`
-	// FilterOut description: REF(bgp/filter)
-	FilterOut string `json:"filter_out"`
-	// Host description: REF(network/host)
-	Host string `json:"host"`
-	Name string `json:"name"`
+	RouteOut BgpRef `json:"route_out"`
+	// SoftReconfiguration default value is true
+	SoftReconfiguration bool `json:"soft_reconfiguration"`
+	// Status default value is true
+	Status bool  `json:"status"`
+	Weight int64 `json:"weight"`
+}
+
+// BgpNeighborAuthentication is the Authentication of a BgpNeighbor
+type BgpNeighborAuthentication string
+
+// Known values of BgpNeighborAuthentication
+const (
+	BgpNeighborAuthenticationNull     BgpNeighborAuthentication = "null"
+	BgpNeighborAuthenticationPassword BgpNeighborAuthentication = "password"
+)
+
+// Valid returns true if the value is a known BgpNeighborAuthentication
+func (v BgpNeighborAuthentication) Valid() bool {
+	switch v {
+	case BgpNeighborAuthenticationNull, BgpNeighborAuthenticationPassword:
+		return true
+	}
+	return false
+}
+
+// NewBgpNeighbor returns a BgpNeighbor with the default values of its swagger definition
+func NewBgpNeighbor() *BgpNeighbor {
+	return &BgpNeighbor{
+		ObjectType:          "bgp/neighbor",
+		Authentication:      BgpNeighborAuthenticationNull,
+		SoftReconfiguration: true,
+		Status:              true,
+	}
+}
+
+// Validate checks the BgpNeighbor before it is sent, see ValidateWith
+func (b *BgpNeighbor) Validate() error { return b.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the BgpNeighbor.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (b *BgpNeighbor) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("BgpNeighbor", typeOf)
+	check.Enum("authentication", string(b.Authentication), b.Authentication.Valid())
+	check.Ref("filter_in", string(b.FilterIn), "REF(bgp/filter)")
+	check.Ref("filter_out", string(b.FilterOut), "REF(bgp/filter)")
+	check.Required("host", string(b.Host))
+	check.Ref("host", string(b.Host), "REF(network/host)")
+	check.Required("name", b.Name)
+	check.Ref("route_in", string(b.RouteIn), "REF(bgp/route_map)")
+	check.Ref("route_out", string(b.RouteOut), "REF(bgp/route_map)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &BgpNeighbor{}
 
-// GetPath implements sophos.RestObject and returns the BgpNeighbors GET path
+// GetPath implements sophos.RestGetter and returns the BgpNeighbors GET path
 // Returns all available bgp/neighbor objects
 func (*BgpNeighbors) GetPath() string { return "/api/objects/bgp/neighbor/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*BgpNeighbors) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the BgpNeighbors GET path
+// GetPath implements sophos.RestGetter and returns the BgpNeighbors GET path
 // Returns all available neighbor types
 func (b *BgpNeighbor) GetPath() string {
 	return fmt.Sprintf("/api/objects/bgp/neighbor/%s", b.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (b *BgpNeighbor) RefRequired() (string, bool) { return b.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the BgpNeighbor DELETE path
+// DeletePath implements sophos.Deletable and returns the BgpNeighbor DELETE path
 // Creates or updates the complete object neighbor
 func (*BgpNeighbor) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/neighbor/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the BgpNeighbor PATCH path
+// PatchPath implements sophos.Patchable and returns the BgpNeighbor PATCH path
 // Changes to parts of the object neighbor types
 func (*BgpNeighbor) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/neighbor/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the BgpNeighbor POST path
+// PostPath implements sophos.Creatable and returns the BgpNeighbor POST path
 // Create a new bgp/neighbor object
 func (*BgpNeighbor) PostPath() string {
 	return "/api/objects/bgp/neighbor/"
 }
 
-// PutPath implements sophos.RestObject and returns the BgpNeighbor PUT path
+// PutPath implements sophos.Updatable and returns the BgpNeighbor PUT path
 // Creates or updates the complete object neighbor
 func (*BgpNeighbor) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/neighbor/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*BgpNeighbor) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/neighbor/%s/usedby", ref)
 }
 
-// BgpRouteMaps is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (b *BgpNeighbor) GetType() string { return b.ObjectType }
+
+// BgpRouteMaps is an Sophos Endpoint subType and implements sophos.RestGetter
 type BgpRouteMaps []BgpRouteMap
 
 // BgpRouteMap represents a UTM route_map
 type BgpRouteMap struct {
-	Locked     string        `json:"_locked"`
-	ObjectType string        `json:"_type"`
-	Reference  string        `json:"_ref"`
-	Address    []interface{} `json:"address"`
-	Metric     int           `json:"metric"`
-	Name       string        `json:"name"`
-	Preference int           `json:"preference"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Address    []string `json:"address"`
+	AsRegex    []string `json:"as_regex"`
+	Comment    string   `json:"comment"`
+	Metric     int64    `json:"metric"`
+	Name       string   `json:"name"`
+	Preference int64    `json:"preference"`
 	// Prepend description: (REGEX)
 	// Prepend default value is ""
 	Prepend string `json:"prepend"`
 	// Type can be one of: []string{"as_number", "ip_address"}
-	Type    string        `json:"type"`
-	AsRegex []interface{} `json:"as_regex"`
-	Comment string        `json:"comment"`
-	Weight  int           `json:"weight"`
+	Type   BgpRouteMapType `json:"type"`
+	Weight int64           `json:"weight"`
+}
+
+// BgpRouteMapType is the Type of a BgpRouteMap
+type BgpRouteMapType string
+
+// Known values of BgpRouteMapType
+const (
+	BgpRouteMapTypeAsNumber  BgpRouteMapType = "as_number"
+	BgpRouteMapTypeIpAddress BgpRouteMapType = "ip_address"
+)
+
+// Valid returns true if the value is a known BgpRouteMapType
+func (v BgpRouteMapType) Valid() bool {
+	switch v {
+	case BgpRouteMapTypeAsNumber, BgpRouteMapTypeIpAddress:
+		return true
+	}
+	return false
+}
+
+// NewBgpRouteMap returns a BgpRouteMap with the default values of its swagger definition
+func NewBgpRouteMap() *BgpRouteMap {
+	return &BgpRouteMap{
+		ObjectType: "bgp/route_map",
+	}
+}
+
+// Validate checks the BgpRouteMap before it is sent, see ValidateWith
+func (b *BgpRouteMap) Validate() error { return b.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the BgpRouteMap.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (b *BgpRouteMap) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("BgpRouteMap", typeOf)
+	check.Required("name", b.Name)
+	check.Enum("type", string(b.Type), b.Type.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &BgpRouteMap{}
 
-// GetPath implements sophos.RestObject and returns the BgpRouteMaps GET path
+// GetPath implements sophos.RestGetter and returns the BgpRouteMaps GET path
 // Returns all available bgp/route_map objects
 func (*BgpRouteMaps) GetPath() string { return "/api/objects/bgp/route_map/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*BgpRouteMaps) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the BgpRouteMaps GET path
+// GetPath implements sophos.RestGetter and returns the BgpRouteMaps GET path
 // Returns all available route_map types
 func (b *BgpRouteMap) GetPath() string {
 	return fmt.Sprintf("/api/objects/bgp/route_map/%s", b.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (b *BgpRouteMap) RefRequired() (string, bool) { return b.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the BgpRouteMap DELETE path
+// DeletePath implements sophos.Deletable and returns the BgpRouteMap DELETE path
 // Creates or updates the complete object route_map
 func (*BgpRouteMap) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/route_map/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the BgpRouteMap PATCH path
+// PatchPath implements sophos.Patchable and returns the BgpRouteMap PATCH path
 // Changes to parts of the object route_map types
 func (*BgpRouteMap) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/route_map/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the BgpRouteMap POST path
+// PostPath implements sophos.Creatable and returns the BgpRouteMap POST path
 // Create a new bgp/route_map object
 func (*BgpRouteMap) PostPath() string {
 	return "/api/objects/bgp/route_map/"
 }
 
-// PutPath implements sophos.RestObject and returns the BgpRouteMap PUT path
+// PutPath implements sophos.Updatable and returns the BgpRouteMap PUT path
 // Creates or updates the complete object route_map
 func (*BgpRouteMap) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/route_map/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*BgpRouteMap) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/route_map/%s/usedby", ref)
 }
 
-// BgpSystems is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (b *BgpRouteMap) GetType() string { return b.ObjectType }
+
+// BgpSystems is an Sophos Endpoint subType and implements sophos.RestGetter
 type BgpSystems []BgpSystem
 
 // BgpSystem represents a UTM BGP system
@@ -436,64 +623,88 @@ type BgpSystem struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// Status default value is true
-	Status bool `json:"status"`
-	Asn    int  `json:"asn"`
+	Asn        int64  `json:"asn"`
+	Comment    string `json:"comment"`
 	// Custom default value is ""
 	Custom string `json:"custom"`
-	// InstallRoutes default value is true
-	InstallRoutes bool          `json:"install_routes"`
-	Name          string        `json:"name"`
-	Neighbor      []interface{} `json:"neighbor"`
-	Network       []interface{} `json:"network"`
-	Comment       string        `json:"comment"`
 	// Id description: (IPADDR)
-	Id           string `json:"id"`
-	MaximumPaths int    `json:"maximum_paths"`
+	Id string `json:"id"`
+	// InstallRoutes default value is true
+	InstallRoutes bool     `json:"install_routes"`
+	MaximumPaths  int64    `json:"maximum_paths"`
+	Name          string   `json:"name"`
+	Neighbor      []string `json:"neighbor"`
+	Network       []string `json:"network"`
+	// Status default value is true
+	Status bool `json:"status"`
+}
+
+// NewBgpSystem returns a BgpSystem with the default values of its swagger definition
+func NewBgpSystem() *BgpSystem {
+	return &BgpSystem{
+		ObjectType:    "bgp/system",
+		InstallRoutes: true,
+		Status:        true,
+	}
+}
+
+// Validate checks the BgpSystem before it is sent, see ValidateWith
+func (b *BgpSystem) Validate() error { return b.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the BgpSystem.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (b *BgpSystem) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("BgpSystem", typeOf)
+	check.Format("id", sophos.FormatIPv4, b.Id)
+	check.Required("name", b.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &BgpSystem{}
 
-// GetPath implements sophos.RestObject and returns the BgpSystems GET path
+// GetPath implements sophos.RestGetter and returns the BgpSystems GET path
 // Returns all available bgp/system objects
 func (*BgpSystems) GetPath() string { return "/api/objects/bgp/system/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*BgpSystems) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the BgpSystems GET path
+// GetPath implements sophos.RestGetter and returns the BgpSystems GET path
 // Returns all available system types
 func (b *BgpSystem) GetPath() string { return fmt.Sprintf("/api/objects/bgp/system/%s", b.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (b *BgpSystem) RefRequired() (string, bool) { return b.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the BgpSystem DELETE path
+// DeletePath implements sophos.Deletable and returns the BgpSystem DELETE path
 // Creates or updates the complete object system
 func (*BgpSystem) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/system/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the BgpSystem PATCH path
+// PatchPath implements sophos.Patchable and returns the BgpSystem PATCH path
 // Changes to parts of the object system types
 func (*BgpSystem) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/system/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the BgpSystem POST path
+// PostPath implements sophos.Creatable and returns the BgpSystem POST path
 // Create a new bgp/system object
 func (*BgpSystem) PostPath() string {
 	return "/api/objects/bgp/system/"
 }
 
-// PutPath implements sophos.RestObject and returns the BgpSystem PUT path
+// PutPath implements sophos.Updatable and returns the BgpSystem PUT path
 // Creates or updates the complete object system
 func (*BgpSystem) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/system/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*BgpSystem) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/bgp/system/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (b *BgpSystem) GetType() string { return b.ObjectType }
@@ -16,21 +16,21 @@ type Ca struct {
 	DefKeysize int64  `json:"def_keysize"`
 	GlobalCas  struct {
 		EmailEncryption struct {
-			TrustNewCas int64         `json:"trust_new_cas"`
-			Trusted     []interface{} `json:"trusted"`
-			Untrusted   []interface{} `json:"untrusted"`
+			TrustNewCas bool     `json:"trust_new_cas"`
+			Trusted     []string `json:"trusted"`
+			Untrusted   []string `json:"untrusted"`
 		} `json:"email_encryption"`
 		HTTPProxy struct {
-			TrustNewCas int64         `json:"trust_new_cas"`
-			Trusted     []interface{} `json:"trusted"`
-			Untrusted   []interface{} `json:"untrusted"`
+			TrustNewCas bool     `json:"trust_new_cas"`
+			Trusted     []string `json:"trusted"`
+			Untrusted   []string `json:"untrusted"`
 		} `json:"http_proxy"`
 	} `json:"global_cas"`
 }
 
 var _ sophos.Endpoint = &Ca{}
 
-var defsCa = map[string]sophos.RestObject{
+var defsCa = map[string]sophos.RestGetter{
 	"CaCrl":                &CaCrl{},
 	"CaGroup":              &CaGroup{},
 	"CaHostCert":           &CaHostCert{},
@@ -44,7 +44,7 @@ var defsCa = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Ca's Objects
-func (Ca) RestObjects() map[string]sophos.RestObject { return defsCa }
+func (Ca) RestObjects() map[string]sophos.RestGetter { return defsCa }
 
 // GetPath implements sophos.RestGetter
 func (*Ca) GetPath() string { return "/api/nodes/ca" }
@@ -110,7 +110,7 @@ func (Ca) References() []string {
 	}
 }
 
-// CaCrls is an Sophos Endpoint subType and implements sophos.RestObject
+// CaCrls is an Sophos Endpoint subType and implements sophos.RestGetter
 type CaCrls []CaCrl
 
 // CaCrl represents a UTM certificate revocation list
@@ -118,60 +118,83 @@ type CaCrl struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Name       string `json:"name"`
 	Comment    string `json:"comment"`
 	Crl        string `json:"crl"`
 	// Meta description: REF(ca/meta_crl)
-	Meta string `json:"meta"`
+	Meta CaRef  `json:"meta"`
+	Name string `json:"name"`
+}
+
+// NewCaCrl returns a CaCrl with the default values of its swagger definition
+func NewCaCrl() *CaCrl {
+	return &CaCrl{
+		ObjectType: "ca/crl",
+	}
+}
+
+// Validate checks the CaCrl before it is sent, see ValidateWith
+func (c *CaCrl) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the CaCrl.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *CaCrl) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("CaCrl", typeOf)
+	check.Required("meta", string(c.Meta))
+	check.Ref("meta", string(c.Meta), "REF(ca/meta_crl)")
+	check.Required("name", c.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &CaCrl{}
 
-// GetPath implements sophos.RestObject and returns the CaCrls GET path
+// GetPath implements sophos.RestGetter and returns the CaCrls GET path
 // Returns all available ca/crl objects
 func (*CaCrls) GetPath() string { return "/api/objects/ca/crl/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*CaCrls) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the CaCrls GET path
+// GetPath implements sophos.RestGetter and returns the CaCrls GET path
 // Returns all available crl types
 func (c *CaCrl) GetPath() string { return fmt.Sprintf("/api/objects/ca/crl/%s", c.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *CaCrl) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the CaCrl DELETE path
+// DeletePath implements sophos.Deletable and returns the CaCrl DELETE path
 // Creates or updates the complete object crl
 func (*CaCrl) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/crl/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the CaCrl PATCH path
+// PatchPath implements sophos.Patchable and returns the CaCrl PATCH path
 // Changes to parts of the object crl types
 func (*CaCrl) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/crl/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the CaCrl POST path
+// PostPath implements sophos.Creatable and returns the CaCrl POST path
 // Create a new ca/crl object
 func (*CaCrl) PostPath() string {
 	return "/api/objects/ca/crl/"
 }
 
-// PutPath implements sophos.RestObject and returns the CaCrl PUT path
+// PutPath implements sophos.Updatable and returns the CaCrl PUT path
 // Creates or updates the complete object crl
 func (*CaCrl) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/crl/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*CaCrl) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/crl/%s/usedby", ref)
 }
 
-// CaGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (c *CaCrl) GetType() string { return c.ObjectType }
+
+// CaGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type CaGroups []CaGroup
 
 // CaGroup represents a UTM group
@@ -183,107 +206,148 @@ type CaGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewCaGroup returns a CaGroup with the default values of its swagger definition
+func NewCaGroup() *CaGroup {
+	return &CaGroup{
+		ObjectType: "ca/group",
+	}
+}
+
+// Validate checks the CaGroup before it is sent, see ValidateWith
+func (c *CaGroup) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the CaGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *CaGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("CaGroup", typeOf)
+	check.Required("name", c.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &CaGroup{}
 
-// GetPath implements sophos.RestObject and returns the CaGroups GET path
+// GetPath implements sophos.RestGetter and returns the CaGroups GET path
 // Returns all available ca/group objects
 func (*CaGroups) GetPath() string { return "/api/objects/ca/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*CaGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the CaGroups GET path
+// GetPath implements sophos.RestGetter and returns the CaGroups GET path
 // Returns all available group types
 func (c *CaGroup) GetPath() string { return fmt.Sprintf("/api/objects/ca/group/%s", c.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *CaGroup) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the CaGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the CaGroup DELETE path
 // Creates or updates the complete object group
 func (*CaGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the CaGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the CaGroup PATCH path
 // Changes to parts of the object group types
 func (*CaGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the CaGroup POST path
+// PostPath implements sophos.Creatable and returns the CaGroup POST path
 // Create a new ca/group object
 func (*CaGroup) PostPath() string {
 	return "/api/objects/ca/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the CaGroup PUT path
+// PutPath implements sophos.Updatable and returns the CaGroup PUT path
 // Creates or updates the complete object group
 func (*CaGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*CaGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/group/%s/usedby", ref)
 }
 
-// CaHostCerts is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (c *CaGroup) GetType() string { return c.ObjectType }
+
+// CaHostCerts is an Sophos Endpoint subType and implements sophos.RestGetter
 type CaHostCerts []CaHostCert
 
-// CaHostCert is a generated Sophos object
+// CaHostCert represents a UTM ca/host_cert object
 type CaHostCert struct {
 	Locked      string `json:"_locked"`
-	Reference   string `json:"_ref"`
 	ObjectType  string `json:"_type"`
+	Reference   string `json:"_ref"`
 	Certificate string `json:"certificate"`
 	Comment     string `json:"comment"`
 	Meta        string `json:"meta"`
 	Name        string `json:"name"`
 }
 
+// NewCaHostCert returns a CaHostCert with the default values of its swagger definition
+func NewCaHostCert() *CaHostCert {
+	return &CaHostCert{
+		ObjectType: "ca/host_cert",
+	}
+}
+
+// Validate checks the CaHostCert before it is sent, see ValidateWith
+func (c *CaHostCert) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the CaHostCert.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *CaHostCert) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("CaHostCert", typeOf)
+	check.Required("name", c.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &CaHostCert{}
 
-// GetPath implements sophos.RestObject and returns the CaHostCerts GET path
+// GetPath implements sophos.RestGetter and returns the CaHostCerts GET path
 // Returns all available ca/host_cert objects
 func (*CaHostCerts) GetPath() string { return "/api/objects/ca/host_cert/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*CaHostCerts) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the CaHostCerts GET path
+// GetPath implements sophos.RestGetter and returns the CaHostCerts GET path
 // Returns all available host_cert types
-func (c *CaHostCert) GetPath() string { return fmt.Sprintf("/api/objects/ca/host_cert/%s", c.Reference) }
+func (c *CaHostCert) GetPath() string {
+	return fmt.Sprintf("/api/objects/ca/host_cert/%s", c.Reference)
+}
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *CaHostCert) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the CaHostCert DELETE path
+// DeletePath implements sophos.Deletable and returns the CaHostCert DELETE path
 // Creates or updates the complete object host_cert
 func (*CaHostCert) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/host_cert/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the CaHostCert PATCH path
+// PatchPath implements sophos.Patchable and returns the CaHostCert PATCH path
 // Changes to parts of the object host_cert types
 func (*CaHostCert) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/host_cert/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the CaHostCert POST path
+// PostPath implements sophos.Creatable and returns the CaHostCert POST path
 // Create a new ca/host_cert object
 func (*CaHostCert) PostPath() string {
 	return "/api/objects/ca/host_cert/"
 }
 
-// PutPath implements sophos.RestObject and returns the CaHostCert PUT path
+// PutPath implements sophos.Updatable and returns the CaHostCert PUT path
 // Creates or updates the complete object host_cert
 func (*CaHostCert) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/host_cert/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*CaHostCert) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/host_cert/%s/usedby", ref)
@@ -292,14 +356,14 @@ func (*CaHostCert) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (c *CaHostCert) GetType() string { return c.ObjectType }
 
-// CaHostKeyCerts is an Sophos Endpoint subType and implements sophos.RestObject
+// CaHostKeyCerts is an Sophos Endpoint subType and implements sophos.RestGetter
 type CaHostKeyCerts []CaHostKeyCert
 
-// CaHostKeyCert is a generated Sophos object
+// CaHostKeyCert represents a UTM ca/host_key_cert object
 type CaHostKeyCert struct {
 	Locked      string `json:"_locked"`
-	Reference   string `json:"_ref"`
 	ObjectType  string `json:"_type"`
+	Reference   string `json:"_ref"`
 	Ca          string `json:"ca"`
 	Certificate string `json:"certificate"`
 	Comment     string `json:"comment"`
@@ -309,49 +373,67 @@ type CaHostKeyCert struct {
 	Name        string `json:"name"`
 }
 
+// NewCaHostKeyCert returns a CaHostKeyCert with the default values of its swagger definition
+func NewCaHostKeyCert() *CaHostKeyCert {
+	return &CaHostKeyCert{
+		ObjectType: "ca/host_key_cert",
+	}
+}
+
+// Validate checks the CaHostKeyCert before it is sent, see ValidateWith
+func (c *CaHostKeyCert) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the CaHostKeyCert.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *CaHostKeyCert) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("CaHostKeyCert", typeOf)
+	check.Required("name", c.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &CaHostKeyCert{}
 
-// GetPath implements sophos.RestObject and returns the CaHostKeyCerts GET path
+// GetPath implements sophos.RestGetter and returns the CaHostKeyCerts GET path
 // Returns all available ca/host_key_cert objects
 func (*CaHostKeyCerts) GetPath() string { return "/api/objects/ca/host_key_cert/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*CaHostKeyCerts) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the CaHostKeyCerts GET path
+// GetPath implements sophos.RestGetter and returns the CaHostKeyCerts GET path
 // Returns all available host_key_cert types
 func (c *CaHostKeyCert) GetPath() string {
 	return fmt.Sprintf("/api/objects/ca/host_key_cert/%s", c.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *CaHostKeyCert) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the CaHostKeyCert DELETE path
+// DeletePath implements sophos.Deletable and returns the CaHostKeyCert DELETE path
 // Creates or updates the complete object host_key_cert
 func (*CaHostKeyCert) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/host_key_cert/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the CaHostKeyCert PATCH path
+// PatchPath implements sophos.Patchable and returns the CaHostKeyCert PATCH path
 // Changes to parts of the object host_key_cert types
 func (*CaHostKeyCert) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/host_key_cert/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the CaHostKeyCert POST path
+// PostPath implements sophos.Creatable and returns the CaHostKeyCert POST path
 // Create a new ca/host_key_cert object
 func (*CaHostKeyCert) PostPath() string {
 	return "/api/objects/ca/host_key_cert/"
 }
 
-// PutPath implements sophos.RestObject and returns the CaHostKeyCert PUT path
+// PutPath implements sophos.Updatable and returns the CaHostKeyCert PUT path
 // Creates or updates the complete object host_key_cert
 func (*CaHostKeyCert) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/host_key_cert/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*CaHostKeyCert) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/host_key_cert/%s/usedby", ref)
@@ -360,72 +442,95 @@ func (*CaHostKeyCert) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (c *CaHostKeyCert) GetType() string { return c.ObjectType }
 
-// CaHttpVerificationCas is an Sophos Endpoint subType and implements sophos.RestObject
+// CaHttpVerificationCas is an Sophos Endpoint subType and implements sophos.RestGetter
 type CaHttpVerificationCas []CaHttpVerificationCa
 
 // CaHttpVerificationCa represents a UTM HTTPS verification CA
 type CaHttpVerificationCa struct {
-	Locked     string `json:"_locked"`
-	ObjectType string `json:"_type"`
-	Reference  string `json:"_ref"`
-	// Trust default value is false
-	Trust       bool   `json:"trust"`
+	Locked      string `json:"_locked"`
+	ObjectType  string `json:"_type"`
+	Reference   string `json:"_ref"`
 	Certificate string `json:"certificate"`
 	Comment     string `json:"comment"`
 	// Meta description: REF(ca/meta_x509)
-	Meta string `json:"meta"`
+	Meta CaRef  `json:"meta"`
 	Name string `json:"name"`
+	// Trust default value is false
+	Trust bool `json:"trust"`
+}
+
+// NewCaHttpVerificationCa returns a CaHttpVerificationCa with the default values of its swagger definition
+func NewCaHttpVerificationCa() *CaHttpVerificationCa {
+	return &CaHttpVerificationCa{
+		ObjectType: "ca/http_verification_ca",
+	}
+}
+
+// Validate checks the CaHttpVerificationCa before it is sent, see ValidateWith
+func (c *CaHttpVerificationCa) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the CaHttpVerificationCa.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *CaHttpVerificationCa) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("CaHttpVerificationCa", typeOf)
+	check.Required("meta", string(c.Meta))
+	check.Ref("meta", string(c.Meta), "REF(ca/meta_x509)")
+	check.Required("name", c.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &CaHttpVerificationCa{}
 
-// GetPath implements sophos.RestObject and returns the CaHttpVerificationCas GET path
+// GetPath implements sophos.RestGetter and returns the CaHttpVerificationCas GET path
 // Returns all available ca/http_verification_ca objects
 func (*CaHttpVerificationCas) GetPath() string { return "/api/objects/ca/http_verification_ca/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*CaHttpVerificationCas) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the CaHttpVerificationCas GET path
+// GetPath implements sophos.RestGetter and returns the CaHttpVerificationCas GET path
 // Returns all available http_verification_ca types
 func (c *CaHttpVerificationCa) GetPath() string {
 	return fmt.Sprintf("/api/objects/ca/http_verification_ca/%s", c.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *CaHttpVerificationCa) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the CaHttpVerificationCa DELETE path
+// DeletePath implements sophos.Deletable and returns the CaHttpVerificationCa DELETE path
 // Creates or updates the complete object http_verification_ca
 func (*CaHttpVerificationCa) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/http_verification_ca/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the CaHttpVerificationCa PATCH path
+// PatchPath implements sophos.Patchable and returns the CaHttpVerificationCa PATCH path
 // Changes to parts of the object http_verification_ca types
 func (*CaHttpVerificationCa) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/http_verification_ca/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the CaHttpVerificationCa POST path
+// PostPath implements sophos.Creatable and returns the CaHttpVerificationCa POST path
 // Create a new ca/http_verification_ca object
 func (*CaHttpVerificationCa) PostPath() string {
 	return "/api/objects/ca/http_verification_ca/"
 }
 
-// PutPath implements sophos.RestObject and returns the CaHttpVerificationCa PUT path
+// PutPath implements sophos.Updatable and returns the CaHttpVerificationCa PUT path
 // Creates or updates the complete object http_verification_ca
 func (*CaHttpVerificationCa) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/http_verification_ca/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*CaHttpVerificationCa) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/http_verification_ca/%s/usedby", ref)
 }
 
-// CaMetaCrls is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (c *CaHttpVerificationCa) GetType() string { return c.ObjectType }
+
+// CaMetaCrls is an Sophos Endpoint subType and implements sophos.RestGetter
 type CaMetaCrls []CaMetaCrl
 
 // CaMetaCrl represents a UTM CRL meta information
@@ -433,68 +538,89 @@ type CaMetaCrl struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
+	Comment    string `json:"comment"`
+	Hash       string `json:"hash"`
 	Issuer     string `json:"issuer"`
 	Lastupdate string `json:"lastupdate"`
 	Name       string `json:"name"`
 	Nextupdate string `json:"nextupdate"`
-	Comment    string `json:"comment"`
-	Hash       string `json:"hash"`
+}
+
+// NewCaMetaCrl returns a CaMetaCrl with the default values of its swagger definition
+func NewCaMetaCrl() *CaMetaCrl {
+	return &CaMetaCrl{
+		ObjectType: "ca/meta_crl",
+	}
+}
+
+// Validate checks the CaMetaCrl before it is sent, see ValidateWith
+func (c *CaMetaCrl) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the CaMetaCrl.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *CaMetaCrl) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("CaMetaCrl", typeOf)
+	check.Required("name", c.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &CaMetaCrl{}
 
-// GetPath implements sophos.RestObject and returns the CaMetaCrls GET path
+// GetPath implements sophos.RestGetter and returns the CaMetaCrls GET path
 // Returns all available ca/meta_crl objects
 func (*CaMetaCrls) GetPath() string { return "/api/objects/ca/meta_crl/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*CaMetaCrls) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the CaMetaCrls GET path
+// GetPath implements sophos.RestGetter and returns the CaMetaCrls GET path
 // Returns all available meta_crl types
 func (c *CaMetaCrl) GetPath() string { return fmt.Sprintf("/api/objects/ca/meta_crl/%s", c.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *CaMetaCrl) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the CaMetaCrl DELETE path
+// DeletePath implements sophos.Deletable and returns the CaMetaCrl DELETE path
 // Creates or updates the complete object meta_crl
 func (*CaMetaCrl) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/meta_crl/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the CaMetaCrl PATCH path
+// PatchPath implements sophos.Patchable and returns the CaMetaCrl PATCH path
 // Changes to parts of the object meta_crl types
 func (*CaMetaCrl) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/meta_crl/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the CaMetaCrl POST path
+// PostPath implements sophos.Creatable and returns the CaMetaCrl POST path
 // Create a new ca/meta_crl object
 func (*CaMetaCrl) PostPath() string {
 	return "/api/objects/ca/meta_crl/"
 }
 
-// PutPath implements sophos.RestObject and returns the CaMetaCrl PUT path
+// PutPath implements sophos.Updatable and returns the CaMetaCrl PUT path
 // Creates or updates the complete object meta_crl
 func (*CaMetaCrl) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/meta_crl/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*CaMetaCrl) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/meta_crl/%s/usedby", ref)
 }
 
-// CaMetaX509s is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (c *CaMetaCrl) GetType() string { return c.ObjectType }
+
+// CaMetaX509s is an Sophos Endpoint subType and implements sophos.RestGetter
 type CaMetaX509s []CaMetaX509
 
-// CaMetaX509 is a generated Sophos object
+// CaMetaX509 represents a UTM ca/meta_x509 object
 type CaMetaX509 struct {
 	Locked             string   `json:"_locked"`
-	Reference          string   `json:"_ref"`
 	ObjectType         string   `json:"_type"`
+	Reference          string   `json:"_ref"`
 	Comment            string   `json:"comment"`
 	Enddate            string   `json:"enddate"`
 	Fingerprint        string   `json:"fingerprint"`
@@ -511,47 +637,67 @@ type CaMetaX509 struct {
 	VpnIDType          string   `json:"vpn_id_type"`
 }
 
+// NewCaMetaX509 returns a CaMetaX509 with the default values of its swagger definition
+func NewCaMetaX509() *CaMetaX509 {
+	return &CaMetaX509{
+		ObjectType: "ca/meta_x509",
+	}
+}
+
+// Validate checks the CaMetaX509 before it is sent, see ValidateWith
+func (c *CaMetaX509) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the CaMetaX509.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *CaMetaX509) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("CaMetaX509", typeOf)
+	check.Required("name", c.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &CaMetaX509{}
 
-// GetPath implements sophos.RestObject and returns the CaMetaX509s GET path
+// GetPath implements sophos.RestGetter and returns the CaMetaX509s GET path
 // Returns all available ca/meta_x509 objects
 func (*CaMetaX509s) GetPath() string { return "/api/objects/ca/meta_x509/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*CaMetaX509s) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the CaMetaX509s GET path
+// GetPath implements sophos.RestGetter and returns the CaMetaX509s GET path
 // Returns all available meta_x509 types
-func (c *CaMetaX509) GetPath() string { return fmt.Sprintf("/api/objects/ca/meta_x509/%s", c.Reference) }
+func (c *CaMetaX509) GetPath() string {
+	return fmt.Sprintf("/api/objects/ca/meta_x509/%s", c.Reference)
+}
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *CaMetaX509) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the CaMetaX509 DELETE path
+// DeletePath implements sophos.Deletable and returns the CaMetaX509 DELETE path
 // Creates or updates the complete object meta_x509
 func (*CaMetaX509) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/meta_x509/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the CaMetaX509 PATCH path
+// PatchPath implements sophos.Patchable and returns the CaMetaX509 PATCH path
 // Changes to parts of the object meta_x509 types
 func (*CaMetaX509) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/meta_x509/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the CaMetaX509 POST path
+// PostPath implements sophos.Creatable and returns the CaMetaX509 POST path
 // Create a new ca/meta_x509 object
 func (*CaMetaX509) PostPath() string {
 	return "/api/objects/ca/meta_x509/"
 }
 
-// PutPath implements sophos.RestObject and returns the CaMetaX509 PUT path
+// PutPath implements sophos.Updatable and returns the CaMetaX509 PUT path
 // Creates or updates the complete object meta_x509
 func (*CaMetaX509) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/meta_x509/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*CaMetaX509) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/meta_x509/%s/usedby", ref)
@@ -560,14 +706,14 @@ func (*CaMetaX509) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (c *CaMetaX509) GetType() string { return c.ObjectType }
 
-// CaRsas is an Sophos Endpoint subType and implements sophos.RestObject
+// CaRsas is an Sophos Endpoint subType and implements sophos.RestGetter
 type CaRsas []CaRsa
 
-// CaRsa is a generated Sophos object
+// CaRsa represents a UTM ca/rsa object
 type CaRsa struct {
 	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
 	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	Comment    string `json:"comment"`
 	Key        string `json:"key"`
 	KeySize    int64  `json:"key_size"`
@@ -577,47 +723,65 @@ type CaRsa struct {
 	VpnIDType  string `json:"vpn_id_type"`
 }
 
+// NewCaRsa returns a CaRsa with the default values of its swagger definition
+func NewCaRsa() *CaRsa {
+	return &CaRsa{
+		ObjectType: "ca/rsa",
+	}
+}
+
+// Validate checks the CaRsa before it is sent, see ValidateWith
+func (c *CaRsa) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the CaRsa.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *CaRsa) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("CaRsa", typeOf)
+	check.Required("name", c.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &CaRsa{}
 
-// GetPath implements sophos.RestObject and returns the CaRsas GET path
+// GetPath implements sophos.RestGetter and returns the CaRsas GET path
 // Returns all available ca/rsa objects
 func (*CaRsas) GetPath() string { return "/api/objects/ca/rsa/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*CaRsas) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the CaRsas GET path
+// GetPath implements sophos.RestGetter and returns the CaRsas GET path
 // Returns all available rsa types
 func (c *CaRsa) GetPath() string { return fmt.Sprintf("/api/objects/ca/rsa/%s", c.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *CaRsa) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the CaRsa DELETE path
+// DeletePath implements sophos.Deletable and returns the CaRsa DELETE path
 // Creates or updates the complete object rsa
 func (*CaRsa) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/rsa/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the CaRsa PATCH path
+// PatchPath implements sophos.Patchable and returns the CaRsa PATCH path
 // Changes to parts of the object rsa types
 func (*CaRsa) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/rsa/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the CaRsa POST path
+// PostPath implements sophos.Creatable and returns the CaRsa POST path
 // Create a new ca/rsa object
 func (*CaRsa) PostPath() string {
 	return "/api/objects/ca/rsa/"
 }
 
-// PutPath implements sophos.RestObject and returns the CaRsa PUT path
+// PutPath implements sophos.Updatable and returns the CaRsa PUT path
 // Creates or updates the complete object rsa
 func (*CaRsa) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/rsa/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*CaRsa) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/rsa/%s/usedby", ref)
@@ -626,14 +790,14 @@ func (*CaRsa) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (c *CaRsa) GetType() string { return c.ObjectType }
 
-// CaSigningCas is an Sophos Endpoint subType and implements sophos.RestObject
+// CaSigningCas is an Sophos Endpoint subType and implements sophos.RestGetter
 type CaSigningCas []CaSigningCa
 
-// CaSigningCa is a generated Sophos object
+// CaSigningCa represents a UTM ca/signing_ca object
 type CaSigningCa struct {
 	Locked      string `json:"_locked"`
-	Reference   string `json:"_ref"`
 	ObjectType  string `json:"_type"`
+	Reference   string `json:"_ref"`
 	Certificate string `json:"certificate"`
 	Comment     string `json:"comment"`
 	Config      string `json:"config"`
@@ -645,49 +809,67 @@ type CaSigningCa struct {
 	Serial      string `json:"serial"`
 }
 
+// NewCaSigningCa returns a CaSigningCa with the default values of its swagger definition
+func NewCaSigningCa() *CaSigningCa {
+	return &CaSigningCa{
+		ObjectType: "ca/signing_ca",
+	}
+}
+
+// Validate checks the CaSigningCa before it is sent, see ValidateWith
+func (c *CaSigningCa) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the CaSigningCa.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *CaSigningCa) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("CaSigningCa", typeOf)
+	check.Required("name", c.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &CaSigningCa{}
 
-// GetPath implements sophos.RestObject and returns the CaSigningCas GET path
+// GetPath implements sophos.RestGetter and returns the CaSigningCas GET path
 // Returns all available ca/signing_ca objects
 func (*CaSigningCas) GetPath() string { return "/api/objects/ca/signing_ca/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*CaSigningCas) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the CaSigningCas GET path
+// GetPath implements sophos.RestGetter and returns the CaSigningCas GET path
 // Returns all available signing_ca types
 func (c *CaSigningCa) GetPath() string {
 	return fmt.Sprintf("/api/objects/ca/signing_ca/%s", c.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *CaSigningCa) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the CaSigningCa DELETE path
+// DeletePath implements sophos.Deletable and returns the CaSigningCa DELETE path
 // Creates or updates the complete object signing_ca
 func (*CaSigningCa) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/signing_ca/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the CaSigningCa PATCH path
+// PatchPath implements sophos.Patchable and returns the CaSigningCa PATCH path
 // Changes to parts of the object signing_ca types
 func (*CaSigningCa) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/signing_ca/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the CaSigningCa POST path
+// PostPath implements sophos.Creatable and returns the CaSigningCa POST path
 // Create a new ca/signing_ca object
 func (*CaSigningCa) PostPath() string {
 	return "/api/objects/ca/signing_ca/"
 }
 
-// PutPath implements sophos.RestObject and returns the CaSigningCa PUT path
+// PutPath implements sophos.Updatable and returns the CaSigningCa PUT path
 // Creates or updates the complete object signing_ca
 func (*CaSigningCa) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/signing_ca/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*CaSigningCa) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/signing_ca/%s/usedby", ref)
@@ -696,7 +878,7 @@ func (*CaSigningCa) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (c *CaSigningCa) GetType() string { return c.ObjectType }
 
-// CaVerificationCas is an Sophos Endpoint subType and implements sophos.RestObject
+// CaVerificationCas is an Sophos Endpoint subType and implements sophos.RestGetter
 type CaVerificationCas []CaVerificationCa
 
 // CaVerificationCa represents a UTM X509 verification CA
@@ -707,54 +889,77 @@ type CaVerificationCa struct {
 	Certificate string `json:"certificate"`
 	Comment     string `json:"comment"`
 	// Meta description: REF(ca/meta_x509)
-	Meta string `json:"meta"`
+	Meta CaRef  `json:"meta"`
 	Name string `json:"name"`
 }
 
+// NewCaVerificationCa returns a CaVerificationCa with the default values of its swagger definition
+func NewCaVerificationCa() *CaVerificationCa {
+	return &CaVerificationCa{
+		ObjectType: "ca/verification_ca",
+	}
+}
+
+// Validate checks the CaVerificationCa before it is sent, see ValidateWith
+func (c *CaVerificationCa) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the CaVerificationCa.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *CaVerificationCa) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("CaVerificationCa", typeOf)
+	check.Required("meta", string(c.Meta))
+	check.Ref("meta", string(c.Meta), "REF(ca/meta_x509)")
+	check.Required("name", c.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &CaVerificationCa{}
 
-// GetPath implements sophos.RestObject and returns the CaVerificationCas GET path
+// GetPath implements sophos.RestGetter and returns the CaVerificationCas GET path
 // Returns all available ca/verification_ca objects
 func (*CaVerificationCas) GetPath() string { return "/api/objects/ca/verification_ca/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*CaVerificationCas) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the CaVerificationCas GET path
+// GetPath implements sophos.RestGetter and returns the CaVerificationCas GET path
 // Returns all available verification_ca types
 func (c *CaVerificationCa) GetPath() string {
 	return fmt.Sprintf("/api/objects/ca/verification_ca/%s", c.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *CaVerificationCa) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the CaVerificationCa DELETE path
+// DeletePath implements sophos.Deletable and returns the CaVerificationCa DELETE path
 // Creates or updates the complete object verification_ca
 func (*CaVerificationCa) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/verification_ca/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the CaVerificationCa PATCH path
+// PatchPath implements sophos.Patchable and returns the CaVerificationCa PATCH path
 // Changes to parts of the object verification_ca types
 func (*CaVerificationCa) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/verification_ca/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the CaVerificationCa POST path
+// PostPath implements sophos.Creatable and returns the CaVerificationCa POST path
 // Create a new ca/verification_ca object
 func (*CaVerificationCa) PostPath() string {
 	return "/api/objects/ca/verification_ca/"
 }
 
-// PutPath implements sophos.RestObject and returns the CaVerificationCa PUT path
+// PutPath implements sophos.Updatable and returns the CaVerificationCa PUT path
 // Creates or updates the complete object verification_ca
 func (*CaVerificationCa) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/verification_ca/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*CaVerificationCa) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ca/verification_ca/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (c *CaVerificationCa) GetType() string { return c.ObjectType }
@@ -15,13 +15,13 @@ type ClientlessVpn struct {
 
 var _ sophos.Endpoint = &ClientlessVpn{}
 
-var defsClientlessVpn = map[string]sophos.RestObject{
+var defsClientlessVpn = map[string]sophos.RestGetter{
 	"ClientlessVpnConnection": &ClientlessVpnConnection{},
 	"ClientlessVpnGroup":      &ClientlessVpnGroup{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of ClientlessVpn's Objects
-func (ClientlessVpn) RestObjects() map[string]sophos.RestObject { return defsClientlessVpn }
+func (ClientlessVpn) RestObjects() map[string]sophos.RestGetter { return defsClientlessVpn }
 
 // GetPath implements sophos.RestGetter
 func (*ClientlessVpn) GetPath() string { return "/api/nodes/clientless_vpn" }
@@ -55,77 +55,96 @@ func (ClientlessVpn) References() []string {
 	}
 }
 
-// ClientlessVpnConnections is an Sophos Endpoint subType and implements sophos.RestObject
+// ClientlessVpnConnections is an Sophos Endpoint subType and implements sophos.RestGetter
 type ClientlessVpnConnections []ClientlessVpnConnection
 
-// ClientlessVpnConnection is a generated Sophos object
+// ClientlessVpnConnection represents a UTM clientless_vpn/connection object
 type ClientlessVpnConnection struct {
-	Locked        string        `json:"_locked"`
-	Reference     string        `json:"_ref"`
-	ObjectType    string        `json:"_type"`
-	AllowedUsers  []string      `json:"allowed_users"`
-	AutoLogin     bool          `json:"auto_login"`
-	Comment       string        `json:"comment"`
-	Destination   string        `json:"destination"`
-	HostKeyCert   string        `json:"host_key_cert"`
-	Login         string        `json:"login"`
-	Name          string        `json:"name"`
-	Password      string        `json:"password"`
-	PfExceptions  []interface{} `json:"pf_exceptions"`
-	Port          int64         `json:"port"`
-	PrivateKey    string        `json:"private_key"`
-	RdpSecurity   string        `json:"rdp_security"`
-	RecordSession bool          `json:"record_session"`
-	Service       string        `json:"service"`
-	ShareSession  bool          `json:"share_session"`
-	Status        bool          `json:"status"`
-	UID           int64         `json:"uid"`
-	WebPath       string        `json:"web_path"`
+	Locked        string   `json:"_locked"`
+	ObjectType    string   `json:"_type"`
+	Reference     string   `json:"_ref"`
+	AllowedUsers  []string `json:"allowed_users"`
+	AutoLogin     bool     `json:"auto_login"`
+	Comment       string   `json:"comment"`
+	Destination   string   `json:"destination"`
+	HostKeyCert   string   `json:"host_key_cert"`
+	Login         string   `json:"login"`
+	Name          string   `json:"name"`
+	Password      string   `json:"password"`
+	PfExceptions  []string `json:"pf_exceptions"`
+	Port          int64    `json:"port"`
+	PrivateKey    string   `json:"private_key"`
+	RdpSecurity   string   `json:"rdp_security"`
+	RecordSession bool     `json:"record_session"`
+	Service       string   `json:"service"`
+	ShareSession  bool     `json:"share_session"`
+	Status        bool     `json:"status"`
+	UID           int64    `json:"uid"`
+	WebPath       string   `json:"web_path"`
+}
+
+// NewClientlessVpnConnection returns a ClientlessVpnConnection with the default values of its swagger definition
+func NewClientlessVpnConnection() *ClientlessVpnConnection {
+	return &ClientlessVpnConnection{
+		ObjectType: "clientless_vpn/connection",
+	}
+}
+
+// Validate checks the ClientlessVpnConnection before it is sent, see ValidateWith
+func (c *ClientlessVpnConnection) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ClientlessVpnConnection.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *ClientlessVpnConnection) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ClientlessVpnConnection", typeOf)
+	check.Required("name", c.Name)
+	check.Range("port", c.Port, "0-65535")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ClientlessVpnConnection{}
 
-// GetPath implements sophos.RestObject and returns the ClientlessVpnConnections GET path
+// GetPath implements sophos.RestGetter and returns the ClientlessVpnConnections GET path
 // Returns all available clientless_vpn/connection objects
 func (*ClientlessVpnConnections) GetPath() string { return "/api/objects/clientless_vpn/connection/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ClientlessVpnConnections) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ClientlessVpnConnections GET path
+// GetPath implements sophos.RestGetter and returns the ClientlessVpnConnections GET path
 // Returns all available connection types
 func (c *ClientlessVpnConnection) GetPath() string {
 	return fmt.Sprintf("/api/objects/clientless_vpn/connection/%s", c.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *ClientlessVpnConnection) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ClientlessVpnConnection DELETE path
+// DeletePath implements sophos.Deletable and returns the ClientlessVpnConnection DELETE path
 // Creates or updates the complete object connection
 func (*ClientlessVpnConnection) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/clientless_vpn/connection/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ClientlessVpnConnection PATCH path
+// PatchPath implements sophos.Patchable and returns the ClientlessVpnConnection PATCH path
 // Changes to parts of the object connection types
 func (*ClientlessVpnConnection) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/clientless_vpn/connection/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ClientlessVpnConnection POST path
+// PostPath implements sophos.Creatable and returns the ClientlessVpnConnection POST path
 // Create a new clientless_vpn/connection object
 func (*ClientlessVpnConnection) PostPath() string {
 	return "/api/objects/clientless_vpn/connection/"
 }
 
-// PutPath implements sophos.RestObject and returns the ClientlessVpnConnection PUT path
+// PutPath implements sophos.Updatable and returns the ClientlessVpnConnection PUT path
 // Creates or updates the complete object connection
 func (*ClientlessVpnConnection) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/clientless_vpn/connection/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ClientlessVpnConnection) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/clientless_vpn/connection/%s/usedby", ref)
@@ -134,7 +153,7 @@ func (*ClientlessVpnConnection) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (c *ClientlessVpnConnection) GetType() string { return c.ObjectType }
 
-// ClientlessVpnGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// ClientlessVpnGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type ClientlessVpnGroups []ClientlessVpnGroup
 
 // ClientlessVpnGroup represents a UTM group
@@ -146,50 +165,71 @@ type ClientlessVpnGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewClientlessVpnGroup returns a ClientlessVpnGroup with the default values of its swagger definition
+func NewClientlessVpnGroup() *ClientlessVpnGroup {
+	return &ClientlessVpnGroup{
+		ObjectType: "clientless_vpn/group",
+	}
+}
+
+// Validate checks the ClientlessVpnGroup before it is sent, see ValidateWith
+func (c *ClientlessVpnGroup) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ClientlessVpnGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *ClientlessVpnGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ClientlessVpnGroup", typeOf)
+	check.Required("name", c.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ClientlessVpnGroup{}
 
-// GetPath implements sophos.RestObject and returns the ClientlessVpnGroups GET path
+// GetPath implements sophos.RestGetter and returns the ClientlessVpnGroups GET path
 // Returns all available clientless_vpn/group objects
 func (*ClientlessVpnGroups) GetPath() string { return "/api/objects/clientless_vpn/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ClientlessVpnGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ClientlessVpnGroups GET path
+// GetPath implements sophos.RestGetter and returns the ClientlessVpnGroups GET path
 // Returns all available group types
 func (c *ClientlessVpnGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/clientless_vpn/group/%s", c.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *ClientlessVpnGroup) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ClientlessVpnGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the ClientlessVpnGroup DELETE path
 // Creates or updates the complete object group
 func (*ClientlessVpnGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/clientless_vpn/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ClientlessVpnGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the ClientlessVpnGroup PATCH path
 // Changes to parts of the object group types
 func (*ClientlessVpnGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/clientless_vpn/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ClientlessVpnGroup POST path
+// PostPath implements sophos.Creatable and returns the ClientlessVpnGroup POST path
 // Create a new clientless_vpn/group object
 func (*ClientlessVpnGroup) PostPath() string {
 	return "/api/objects/clientless_vpn/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the ClientlessVpnGroup PUT path
+// PutPath implements sophos.Updatable and returns the ClientlessVpnGroup PUT path
 // Creates or updates the complete object group
 func (*ClientlessVpnGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/clientless_vpn/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ClientlessVpnGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/clientless_vpn/group/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (c *ClientlessVpnGroup) GetType() string { return c.ObjectType }
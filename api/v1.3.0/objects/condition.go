@@ -15,13 +15,13 @@ type Condition struct {
 
 var _ sophos.Endpoint = &Condition{}
 
-var defsCondition = map[string]sophos.RestObject{
+var defsCondition = map[string]sophos.RestGetter{
 	"ConditionGroup":  &ConditionGroup{},
 	"ConditionObjref": &ConditionObjref{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Condition's Objects
-func (Condition) RestObjects() map[string]sophos.RestObject { return defsCondition }
+func (Condition) RestObjects() map[string]sophos.RestGetter { return defsCondition }
 
 // GetPath implements sophos.RestGetter
 func (*Condition) GetPath() string { return "/api/nodes/condition" }
@@ -55,7 +55,7 @@ func (Condition) References() []string {
 	}
 }
 
-// ConditionGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// ConditionGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type ConditionGroups []ConditionGroup
 
 // ConditionGroup represents a UTM group
@@ -67,62 +67,83 @@ type ConditionGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewConditionGroup returns a ConditionGroup with the default values of its swagger definition
+func NewConditionGroup() *ConditionGroup {
+	return &ConditionGroup{
+		ObjectType: "condition/group",
+	}
+}
+
+// Validate checks the ConditionGroup before it is sent, see ValidateWith
+func (c *ConditionGroup) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ConditionGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *ConditionGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ConditionGroup", typeOf)
+	check.Required("name", c.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ConditionGroup{}
 
-// GetPath implements sophos.RestObject and returns the ConditionGroups GET path
+// GetPath implements sophos.RestGetter and returns the ConditionGroups GET path
 // Returns all available condition/group objects
 func (*ConditionGroups) GetPath() string { return "/api/objects/condition/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ConditionGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ConditionGroups GET path
+// GetPath implements sophos.RestGetter and returns the ConditionGroups GET path
 // Returns all available group types
 func (c *ConditionGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/condition/group/%s", c.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *ConditionGroup) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ConditionGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the ConditionGroup DELETE path
 // Creates or updates the complete object group
 func (*ConditionGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/condition/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ConditionGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the ConditionGroup PATCH path
 // Changes to parts of the object group types
 func (*ConditionGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/condition/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ConditionGroup POST path
+// PostPath implements sophos.Creatable and returns the ConditionGroup POST path
 // Create a new condition/group object
 func (*ConditionGroup) PostPath() string {
 	return "/api/objects/condition/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the ConditionGroup PUT path
+// PutPath implements sophos.Updatable and returns the ConditionGroup PUT path
 // Creates or updates the complete object group
 func (*ConditionGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/condition/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ConditionGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/condition/group/%s/usedby", ref)
 }
 
-// ConditionObjrefs is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (c *ConditionGroup) GetType() string { return c.ObjectType }
+
+// ConditionObjrefs is an Sophos Endpoint subType and implements sophos.RestGetter
 type ConditionObjrefs []ConditionObjref
 
-// ConditionObjref is a generated Sophos object
+// ConditionObjref represents a UTM condition/objref object
 type ConditionObjref struct {
 	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
 	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	Attr       string `json:"attr"`
 	Comment    string `json:"comment"`
 	Name       string `json:"name"`
@@ -131,49 +152,67 @@ type ConditionObjref struct {
 	Value      string `json:"value"`
 }
 
+// NewConditionObjref returns a ConditionObjref with the default values of its swagger definition
+func NewConditionObjref() *ConditionObjref {
+	return &ConditionObjref{
+		ObjectType: "condition/objref",
+	}
+}
+
+// Validate checks the ConditionObjref before it is sent, see ValidateWith
+func (c *ConditionObjref) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ConditionObjref.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *ConditionObjref) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ConditionObjref", typeOf)
+	check.Required("name", c.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ConditionObjref{}
 
-// GetPath implements sophos.RestObject and returns the ConditionObjrefs GET path
+// GetPath implements sophos.RestGetter and returns the ConditionObjrefs GET path
 // Returns all available condition/objref objects
 func (*ConditionObjrefs) GetPath() string { return "/api/objects/condition/objref/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ConditionObjrefs) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ConditionObjrefs GET path
+// GetPath implements sophos.RestGetter and returns the ConditionObjrefs GET path
 // Returns all available objref types
 func (c *ConditionObjref) GetPath() string {
 	return fmt.Sprintf("/api/objects/condition/objref/%s", c.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *ConditionObjref) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ConditionObjref DELETE path
+// DeletePath implements sophos.Deletable and returns the ConditionObjref DELETE path
 // Creates or updates the complete object objref
 func (*ConditionObjref) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/condition/objref/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ConditionObjref PATCH path
+// PatchPath implements sophos.Patchable and returns the ConditionObjref PATCH path
 // Changes to parts of the object objref types
 func (*ConditionObjref) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/condition/objref/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ConditionObjref POST path
+// PostPath implements sophos.Creatable and returns the ConditionObjref POST path
 // Create a new condition/objref object
 func (*ConditionObjref) PostPath() string {
 	return "/api/objects/condition/objref/"
 }
 
-// PutPath implements sophos.RestObject and returns the ConditionObjref PUT path
+// PutPath implements sophos.Updatable and returns the ConditionObjref PUT path
 // Creates or updates the complete object objref
 func (*ConditionObjref) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/condition/objref/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ConditionObjref) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/condition/objref/%s/usedby", ref)
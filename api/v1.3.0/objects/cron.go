@@ -15,13 +15,13 @@ type Cron struct {
 
 var _ sophos.Endpoint = &Cron{}
 
-var defsCron = map[string]sophos.RestObject{
+var defsCron = map[string]sophos.RestGetter{
 	"CronAt":    &CronAt{},
 	"CronGroup": &CronGroup{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Cron's Objects
-func (Cron) RestObjects() map[string]sophos.RestObject { return defsCron }
+func (Cron) RestObjects() map[string]sophos.RestGetter { return defsCron }
 
 // GetPath implements sophos.RestGetter
 func (*Cron) GetPath() string { return "/api/nodes/cron" }
@@ -55,7 +55,7 @@ func (Cron) References() []string {
 	}
 }
 
-// CronAts is an Sophos Endpoint subType and implements sophos.RestObject
+// CronAts is an Sophos Endpoint subType and implements sophos.RestGetter
 type CronAts []CronAt
 
 // CronAt represents a UTM scheduled job
@@ -72,53 +72,74 @@ type CronAt struct {
 	Time string `json:"time"`
 }
 
+// NewCronAt returns a CronAt with the default values of its swagger definition
+func NewCronAt() *CronAt {
+	return &CronAt{
+		ObjectType: "cron/at",
+	}
+}
+
+// Validate checks the CronAt before it is sent, see ValidateWith
+func (c *CronAt) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the CronAt.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *CronAt) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("CronAt", typeOf)
+	check.Required("name", c.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &CronAt{}
 
-// GetPath implements sophos.RestObject and returns the CronAts GET path
+// GetPath implements sophos.RestGetter and returns the CronAts GET path
 // Returns all available cron/at objects
 func (*CronAts) GetPath() string { return "/api/objects/cron/at/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*CronAts) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the CronAts GET path
+// GetPath implements sophos.RestGetter and returns the CronAts GET path
 // Returns all available at types
 func (c *CronAt) GetPath() string { return fmt.Sprintf("/api/objects/cron/at/%s", c.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *CronAt) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the CronAt DELETE path
+// DeletePath implements sophos.Deletable and returns the CronAt DELETE path
 // Creates or updates the complete object at
 func (*CronAt) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/cron/at/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the CronAt PATCH path
+// PatchPath implements sophos.Patchable and returns the CronAt PATCH path
 // Changes to parts of the object at types
 func (*CronAt) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/cron/at/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the CronAt POST path
+// PostPath implements sophos.Creatable and returns the CronAt POST path
 // Create a new cron/at object
 func (*CronAt) PostPath() string {
 	return "/api/objects/cron/at/"
 }
 
-// PutPath implements sophos.RestObject and returns the CronAt PUT path
+// PutPath implements sophos.Updatable and returns the CronAt PUT path
 // Creates or updates the complete object at
 func (*CronAt) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/cron/at/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*CronAt) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/cron/at/%s/usedby", ref)
 }
 
-// CronGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (c *CronAt) GetType() string { return c.ObjectType }
+
+// CronGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type CronGroups []CronGroup
 
 // CronGroup represents a UTM group
@@ -130,48 +151,69 @@ type CronGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewCronGroup returns a CronGroup with the default values of its swagger definition
+func NewCronGroup() *CronGroup {
+	return &CronGroup{
+		ObjectType: "cron/group",
+	}
+}
+
+// Validate checks the CronGroup before it is sent, see ValidateWith
+func (c *CronGroup) Validate() error { return c.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the CronGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (c *CronGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("CronGroup", typeOf)
+	check.Required("name", c.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &CronGroup{}
 
-// GetPath implements sophos.RestObject and returns the CronGroups GET path
+// GetPath implements sophos.RestGetter and returns the CronGroups GET path
 // Returns all available cron/group objects
 func (*CronGroups) GetPath() string { return "/api/objects/cron/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*CronGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the CronGroups GET path
+// GetPath implements sophos.RestGetter and returns the CronGroups GET path
 // Returns all available group types
 func (c *CronGroup) GetPath() string { return fmt.Sprintf("/api/objects/cron/group/%s", c.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (c *CronGroup) RefRequired() (string, bool) { return c.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the CronGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the CronGroup DELETE path
 // Creates or updates the complete object group
 func (*CronGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/cron/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the CronGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the CronGroup PATCH path
 // Changes to parts of the object group types
 func (*CronGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/cron/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the CronGroup POST path
+// PostPath implements sophos.Creatable and returns the CronGroup POST path
 // Create a new cron/group object
 func (*CronGroup) PostPath() string {
 	return "/api/objects/cron/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the CronGroup PUT path
+// PutPath implements sophos.Updatable and returns the CronGroup PUT path
 // Creates or updates the complete object group
 func (*CronGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/cron/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*CronGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/cron/group/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (c *CronGroup) GetType() string { return c.ObjectType }
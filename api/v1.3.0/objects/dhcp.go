@@ -11,13 +11,13 @@ import (
 type Dhcp struct {
 	Relay struct {
 		DhcpServer string        `json:"dhcp_server"`
-		Interfaces []interface{} `json:"interfaces"`
-		Status     int64         `json:"status"`
+		Interfaces InterfaceRefs `json:"interfaces"`
+		Status     bool          `json:"status"`
 	} `json:"relay"`
 	Relay6 struct {
-		ItfsFacingClients []interface{} `json:"itfs_facing_clients"`
-		ItfsFacingServer6 []interface{} `json:"itfs_facing_server6"`
-		Status            int64         `json:"status"`
+		ItfsFacingClients []string `json:"itfs_facing_clients"`
+		ItfsFacingServer6 []string `json:"itfs_facing_server6"`
+		Status            bool     `json:"status"`
 	} `json:"relay6"`
 	Server struct {
 		Custom4 string   `json:"custom4"`
@@ -28,7 +28,7 @@ type Dhcp struct {
 
 var _ sophos.Endpoint = &Dhcp{}
 
-var defsDhcp = map[string]sophos.RestObject{
+var defsDhcp = map[string]sophos.RestGetter{
 	"DhcpGroup":     &DhcpGroup{},
 	"DhcpOption":    &DhcpOption{},
 	"DhcpOption6":   &DhcpOption6{},
@@ -38,7 +38,7 @@ var defsDhcp = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Dhcp's Objects
-func (Dhcp) RestObjects() map[string]sophos.RestObject { return defsDhcp }
+func (Dhcp) RestObjects() map[string]sophos.RestGetter { return defsDhcp }
 
 // GetPath implements sophos.RestGetter
 func (*Dhcp) GetPath() string { return "/api/nodes/dhcp" }
@@ -88,7 +88,7 @@ func (Dhcp) References() []string {
 	}
 }
 
-// DhcpGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// DhcpGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type DhcpGroups []DhcpGroup
 
 // DhcpGroup represents a UTM group
@@ -100,118 +100,157 @@ type DhcpGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewDhcpGroup returns a DhcpGroup with the default values of its swagger definition
+func NewDhcpGroup() *DhcpGroup {
+	return &DhcpGroup{
+		ObjectType: "dhcp/group",
+	}
+}
+
+// Validate checks the DhcpGroup before it is sent, see ValidateWith
+func (d *DhcpGroup) Validate() error { return d.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the DhcpGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (d *DhcpGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("DhcpGroup", typeOf)
+	check.Required("name", d.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &DhcpGroup{}
 
-// GetPath implements sophos.RestObject and returns the DhcpGroups GET path
+// GetPath implements sophos.RestGetter and returns the DhcpGroups GET path
 // Returns all available dhcp/group objects
 func (*DhcpGroups) GetPath() string { return "/api/objects/dhcp/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*DhcpGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the DhcpGroups GET path
+// GetPath implements sophos.RestGetter and returns the DhcpGroups GET path
 // Returns all available group types
 func (d *DhcpGroup) GetPath() string { return fmt.Sprintf("/api/objects/dhcp/group/%s", d.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (d *DhcpGroup) RefRequired() (string, bool) { return d.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the DhcpGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the DhcpGroup DELETE path
 // Creates or updates the complete object group
 func (*DhcpGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the DhcpGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the DhcpGroup PATCH path
 // Changes to parts of the object group types
 func (*DhcpGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the DhcpGroup POST path
+// PostPath implements sophos.Creatable and returns the DhcpGroup POST path
 // Create a new dhcp/group object
 func (*DhcpGroup) PostPath() string {
 	return "/api/objects/dhcp/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the DhcpGroup PUT path
+// PutPath implements sophos.Updatable and returns the DhcpGroup PUT path
 // Creates or updates the complete object group
 func (*DhcpGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*DhcpGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/group/%s/usedby", ref)
 }
 
-// DhcpOptions is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (d *DhcpGroup) GetType() string { return d.ObjectType }
+
+// DhcpOptions is an Sophos Endpoint subType and implements sophos.RestGetter
 type DhcpOptions []DhcpOption
 
-// DhcpOption is a generated Sophos object
+// DhcpOption represents a UTM dhcp/option object
 type DhcpOption struct {
-	Locked     string        `json:"_locked"`
-	Reference  string        `json:"_ref"`
-	ObjectType string        `json:"_type"`
-	Address    string        `json:"address"`
-	Code       int64         `json:"code"`
-	Comment    string        `json:"comment"`
-	DhcpName   string        `json:"dhcp_name"`
-	Host       []interface{} `json:"host"`
-	Integer    int64         `json:"integer"`
-	Mac        string        `json:"mac"`
-	Name       string        `json:"name"`
-	Scope      string        `json:"scope"`
-	Server     []interface{} `json:"server"`
-	Status     bool          `json:"status"`
-	String     string        `json:"string"`
-	Text       string        `json:"text"`
-	Type       string        `json:"type"`
-	Vendor     string        `json:"vendor"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Address    string   `json:"address"`
+	Code       int64    `json:"code"`
+	Comment    string   `json:"comment"`
+	DhcpName   string   `json:"dhcp_name"`
+	Host       []string `json:"host"`
+	Integer    int64    `json:"integer"`
+	Mac        string   `json:"mac"`
+	Name       string   `json:"name"`
+	Scope      string   `json:"scope"`
+	Server     []string `json:"server"`
+	Status     bool     `json:"status"`
+	String     string   `json:"string"`
+	Text       string   `json:"text"`
+	Type       string   `json:"type"`
+	Vendor     string   `json:"vendor"`
+}
+
+// NewDhcpOption returns a DhcpOption with the default values of its swagger definition
+func NewDhcpOption() *DhcpOption {
+	return &DhcpOption{
+		ObjectType: "dhcp/option",
+	}
+}
+
+// Validate checks the DhcpOption before it is sent, see ValidateWith
+func (d *DhcpOption) Validate() error { return d.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the DhcpOption.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (d *DhcpOption) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("DhcpOption", typeOf)
+	check.Required("name", d.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &DhcpOption{}
 
-// GetPath implements sophos.RestObject and returns the DhcpOptions GET path
+// GetPath implements sophos.RestGetter and returns the DhcpOptions GET path
 // Returns all available dhcp/option objects
 func (*DhcpOptions) GetPath() string { return "/api/objects/dhcp/option/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*DhcpOptions) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the DhcpOptions GET path
+// GetPath implements sophos.RestGetter and returns the DhcpOptions GET path
 // Returns all available option types
 func (d *DhcpOption) GetPath() string { return fmt.Sprintf("/api/objects/dhcp/option/%s", d.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (d *DhcpOption) RefRequired() (string, bool) { return d.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the DhcpOption DELETE path
+// DeletePath implements sophos.Deletable and returns the DhcpOption DELETE path
 // Creates or updates the complete object option
 func (*DhcpOption) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/option/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the DhcpOption PATCH path
+// PatchPath implements sophos.Patchable and returns the DhcpOption PATCH path
 // Changes to parts of the object option types
 func (*DhcpOption) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/option/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the DhcpOption POST path
+// PostPath implements sophos.Creatable and returns the DhcpOption POST path
 // Create a new dhcp/option object
 func (*DhcpOption) PostPath() string {
 	return "/api/objects/dhcp/option/"
 }
 
-// PutPath implements sophos.RestObject and returns the DhcpOption PUT path
+// PutPath implements sophos.Updatable and returns the DhcpOption PUT path
 // Creates or updates the complete object option
 func (*DhcpOption) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/option/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*DhcpOption) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/option/%s/usedby", ref)
@@ -220,94 +259,160 @@ func (*DhcpOption) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (d *DhcpOption) GetType() string { return d.ObjectType }
 
-// DhcpOption6s is an Sophos Endpoint subType and implements sophos.RestObject
+// DhcpOption6s is an Sophos Endpoint subType and implements sophos.RestGetter
 type DhcpOption6s []DhcpOption6
 
 // DhcpOption6 represents a UTM DHCPv6 option
 type DhcpOption6 struct {
-	Locked     string        `json:"_locked"`
-	ObjectType string        `json:"_type"`
-	Reference  string        `json:"_ref"`
-	Host       []interface{} `json:"host"`
-	Server     []interface{} `json:"server"`
-	// String description: (HEXSTRING)
-	String string `json:"string"`
+	Locked     string `json:"_locked"`
+	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
+	// Address description: REF(network/interface_address), REF(network/host), REF(network/dns_host), REF(network/dns_group), REF(network/availability_group), REF(network/group)
+	Address NetworkRef `json:"address"`
 	// Code description: Constraints: 7, 10-12, 15-18, 21-255
-	Code     int    `json:"code"`
-	DhcpName string `json:"dhcp_name"`
-	// Scope can be one of: []string{"global", "server", "host", "mac", "vendor"}
-	Scope string `json:"scope"`
-	Text  string `json:"text"`
+	Code     int64    `json:"code"`
+	Comment  string   `json:"comment"`
+	DhcpName string   `json:"dhcp_name"`
+	Host     []string `json:"host"`
+	Integer  int64    `json:"integer"`
 	// Mac description: (HEXSTRING)
 	Mac  string `json:"mac"`
 	Name string `json:"name"`
-	// Type can be one of: []string{"ip-address", "text", "string", "integer"}
-	Type    string `json:"type"`
-	Integer int    `json:"integer"`
+	// Scope can be one of: []string{"global", "server", "host", "mac", "vendor"}
+	Scope  DhcpOption6Scope `json:"scope"`
+	Server []string         `json:"server"`
 	// Status default value is false
-	Status bool   `json:"status"`
-	Vendor string `json:"vendor"`
-	// Address description: REF(network/interface_address), REF(network/host), REF(network/dns_host), REF(network/dns_group), REF(network/availability_group), REF(network/group)
-	Address string `json:"address"`
-	Comment string `json:"comment"`
+	Status bool `json:"status"`
+	// String description: (HEXSTRING)
+	String string `json:"string"`
+	Text   string `json:"text"`
+	// Type can be one of: []string{"ip-address", "text", "string", "integer"}
+	Type   DhcpOption6Type `json:"type"`
+	Vendor string          `json:"vendor"`
+}
+
+// DhcpOption6Scope is the Scope of a DhcpOption6
+type DhcpOption6Scope string
+
+// Known values of DhcpOption6Scope
+const (
+	DhcpOption6ScopeGlobal DhcpOption6Scope = "global"
+	DhcpOption6ScopeServer DhcpOption6Scope = "server"
+	DhcpOption6ScopeHost   DhcpOption6Scope = "host"
+	DhcpOption6ScopeMac    DhcpOption6Scope = "mac"
+	DhcpOption6ScopeVendor DhcpOption6Scope = "vendor"
+)
+
+// Valid returns true if the value is a known DhcpOption6Scope
+func (v DhcpOption6Scope) Valid() bool {
+	switch v {
+	case DhcpOption6ScopeGlobal, DhcpOption6ScopeServer, DhcpOption6ScopeHost, DhcpOption6ScopeMac, DhcpOption6ScopeVendor:
+		return true
+	}
+	return false
+}
+
+// DhcpOption6Type is the Type of a DhcpOption6
+type DhcpOption6Type string
+
+// Known values of DhcpOption6Type
+const (
+	DhcpOption6TypeIpAddress DhcpOption6Type = "ip-address"
+	DhcpOption6TypeText      DhcpOption6Type = "text"
+	DhcpOption6TypeString    DhcpOption6Type = "string"
+	DhcpOption6TypeInteger   DhcpOption6Type = "integer"
+)
+
+// Valid returns true if the value is a known DhcpOption6Type
+func (v DhcpOption6Type) Valid() bool {
+	switch v {
+	case DhcpOption6TypeIpAddress, DhcpOption6TypeText, DhcpOption6TypeString, DhcpOption6TypeInteger:
+		return true
+	}
+	return false
+}
+
+// NewDhcpOption6 returns a DhcpOption6 with the default values of its swagger definition
+func NewDhcpOption6() *DhcpOption6 {
+	return &DhcpOption6{
+		ObjectType: "dhcp/option6",
+	}
+}
+
+// Validate checks the DhcpOption6 before it is sent, see ValidateWith
+func (d *DhcpOption6) Validate() error { return d.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the DhcpOption6.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (d *DhcpOption6) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("DhcpOption6", typeOf)
+	check.Ref("address", string(d.Address), "REF(network/interface_address), REF(network/host), REF(network/dns_host), REF(network/dns_group), REF(network/availability_group), REF(network/group)")
+	check.Range("code", d.Code, "7, 10-12, 15-18, 21-255")
+	check.Required("name", d.Name)
+	check.Enum("scope", string(d.Scope), d.Scope.Valid())
+	check.Enum("type", string(d.Type), d.Type.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &DhcpOption6{}
 
-// GetPath implements sophos.RestObject and returns the DhcpOption6s GET path
+// GetPath implements sophos.RestGetter and returns the DhcpOption6s GET path
 // Returns all available dhcp/option6 objects
 func (*DhcpOption6s) GetPath() string { return "/api/objects/dhcp/option6/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*DhcpOption6s) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the DhcpOption6s GET path
+// GetPath implements sophos.RestGetter and returns the DhcpOption6s GET path
 // Returns all available option6 types
 func (d *DhcpOption6) GetPath() string {
 	return fmt.Sprintf("/api/objects/dhcp/option6/%s", d.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (d *DhcpOption6) RefRequired() (string, bool) { return d.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the DhcpOption6 DELETE path
+// DeletePath implements sophos.Deletable and returns the DhcpOption6 DELETE path
 // Creates or updates the complete object option6
 func (*DhcpOption6) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/option6/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the DhcpOption6 PATCH path
+// PatchPath implements sophos.Patchable and returns the DhcpOption6 PATCH path
 // Changes to parts of the object option6 types
 func (*DhcpOption6) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/option6/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the DhcpOption6 POST path
+// PostPath implements sophos.Creatable and returns the DhcpOption6 POST path
 // Create a new dhcp/option6 object
 func (*DhcpOption6) PostPath() string {
 	return "/api/objects/dhcp/option6/"
 }
 
-// PutPath implements sophos.RestObject and returns the DhcpOption6 PUT path
+// PutPath implements sophos.Updatable and returns the DhcpOption6 PUT path
 // Creates or updates the complete object option6
 func (*DhcpOption6) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/option6/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*DhcpOption6) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/option6/%s/usedby", ref)
 }
 
-// DhcpServers is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (d *DhcpOption6) GetType() string { return d.ObjectType }
+
+// DhcpServers is an Sophos Endpoint subType and implements sophos.RestGetter
 type DhcpServers []DhcpServer
 
-// DhcpServer is a generated Sophos object
+// DhcpServer represents a UTM dhcp/server object
 type DhcpServer struct {
 	Locked          string   `json:"_locked"`
-	Reference       string   `json:"_ref"`
 	ObjectType      string   `json:"_type"`
+	Reference       string   `json:"_ref"`
 	Address         string   `json:"address"`
 	Comment         string   `json:"comment"`
 	Custom          string   `json:"custom"`
@@ -330,47 +435,66 @@ type DhcpServer struct {
 	WinsNodeType    string   `json:"wins_node_type"`
 }
 
+// NewDhcpServer returns a DhcpServer with the default values of its swagger definition
+func NewDhcpServer() *DhcpServer {
+	return &DhcpServer{
+		ObjectType: "dhcp/server",
+	}
+}
+
+// Validate checks the DhcpServer before it is sent, see ValidateWith
+func (d *DhcpServer) Validate() error { return d.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the DhcpServer.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (d *DhcpServer) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("DhcpServer", typeOf)
+	check.Required("name", d.Name)
+	check.Between("netmask", d.Netmask, 0, 32)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &DhcpServer{}
 
-// GetPath implements sophos.RestObject and returns the DhcpServers GET path
+// GetPath implements sophos.RestGetter and returns the DhcpServers GET path
 // Returns all available dhcp/server objects
 func (*DhcpServers) GetPath() string { return "/api/objects/dhcp/server/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*DhcpServers) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the DhcpServers GET path
+// GetPath implements sophos.RestGetter and returns the DhcpServers GET path
 // Returns all available server types
 func (d *DhcpServer) GetPath() string { return fmt.Sprintf("/api/objects/dhcp/server/%s", d.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (d *DhcpServer) RefRequired() (string, bool) { return d.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the DhcpServer DELETE path
+// DeletePath implements sophos.Deletable and returns the DhcpServer DELETE path
 // Creates or updates the complete object server
 func (*DhcpServer) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/server/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the DhcpServer PATCH path
+// PatchPath implements sophos.Patchable and returns the DhcpServer PATCH path
 // Changes to parts of the object server types
 func (*DhcpServer) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/server/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the DhcpServer POST path
+// PostPath implements sophos.Creatable and returns the DhcpServer POST path
 // Create a new dhcp/server object
 func (*DhcpServer) PostPath() string {
 	return "/api/objects/dhcp/server/"
 }
 
-// PutPath implements sophos.RestObject and returns the DhcpServer PUT path
+// PutPath implements sophos.Updatable and returns the DhcpServer PUT path
 // Creates or updates the complete object server
 func (*DhcpServer) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/server/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*DhcpServer) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/server/%s/usedby", ref)
@@ -379,7 +503,7 @@ func (*DhcpServer) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (d *DhcpServer) GetType() string { return d.ObjectType }
 
-// DhcpServer6s is an Sophos Endpoint subType and implements sophos.RestObject
+// DhcpServer6s is an Sophos Endpoint subType and implements sophos.RestGetter
 type DhcpServer6s []DhcpServer6
 
 // DhcpServer6 represents a UTM DHCPv6 server
@@ -388,93 +512,126 @@ type DhcpServer6 struct {
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
 	// Address description: REF(itfparams/*)
-	Address  string `json:"address"`
-	Name     string `json:"name"`
-	Netmask6 int    `json:"netmask6"`
-	// ProxyAutoconfig default value is false
-	ProxyAutoconfig bool `json:"proxy_autoconfig"`
-	// Status default value is false
-	Status bool `json:"status"`
-	// RelayMode default value is false
-	RelayMode bool   `json:"relay_mode"`
-	Comment   string `json:"comment"`
+	Address ItfparamsRef `json:"address"`
+	Comment string       `json:"comment"`
 	// Custom default value is ""
 	Custom     string `json:"custom"`
-	DefaultLft int    `json:"default_lft"`
+	DefaultLft int64  `json:"default_lft"`
 	// DenyUnknown default value is false
 	DenyUnknown bool `json:"deny_unknown"`
-	// Domain default value is ""
-	Domain string `json:"domain"`
-	// Mtu description: Constraints: 0, 1280-9000
-	Mtu      int `json:"mtu"`
-	PrefdLft int `json:"prefd_lft"`
-	ValidLft int `json:"valid_lft"`
 	// Dns1 description: (IP6ADDR)
 	// Dns1 default value is "::"
 	Dns1 string `json:"dns1"`
 	// Dns2 description: (IP6ADDR)
 	// Dns2 default value is "::"
-	Dns2     string        `json:"dns2"`
-	Mappings []interface{} `json:"mappings"`
-	// OnLink default value is false
-	OnLink bool `json:"on_link"`
-	// RangeStart description: (IP6ADDR)
-	RangeStart string `json:"range_start"`
+	Dns2 string `json:"dns2"`
+	// Domain default value is ""
+	Domain string `json:"domain"`
 	// Interface description: REF(interface/ethernet), REF(interface/vlan), REF(interface/bridge)
-	Interface string `json:"interface"`
+	Interface InterfaceRef `json:"interface"`
+	Mappings  []string     `json:"mappings"`
+	// Mtu description: Constraints: 0, 1280-9000
+	Mtu      int64  `json:"mtu"`
+	Name     string `json:"name"`
+	Netmask6 int64  `json:"netmask6"`
+	// OnLink default value is false
+	OnLink   bool  `json:"on_link"`
+	PrefdLft int64 `json:"prefd_lft"`
+	// ProxyAutoconfig default value is false
+	ProxyAutoconfig bool `json:"proxy_autoconfig"`
 	// RangeEnd description: (IP6ADDR)
 	RangeEnd string `json:"range_end"`
+	// RangeStart description: (IP6ADDR)
+	RangeStart string `json:"range_start"`
+	// RelayMode default value is false
+	RelayMode bool `json:"relay_mode"`
+	// Status default value is false
+	Status   bool  `json:"status"`
+	ValidLft int64 `json:"valid_lft"`
+}
+
+// NewDhcpServer6 returns a DhcpServer6 with the default values of its swagger definition
+func NewDhcpServer6() *DhcpServer6 {
+	return &DhcpServer6{
+		ObjectType: "dhcp/server6",
+		Dns1:       "::",
+		Dns2:       "::",
+	}
+}
+
+// Validate checks the DhcpServer6 before it is sent, see ValidateWith
+func (d *DhcpServer6) Validate() error { return d.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the DhcpServer6.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (d *DhcpServer6) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("DhcpServer6", typeOf)
+	check.Required("address", string(d.Address))
+	check.Ref("address", string(d.Address), "REF(itfparams/*)")
+	check.Format("dns1", sophos.FormatIPv6, d.Dns1)
+	check.Format("dns2", sophos.FormatIPv6, d.Dns2)
+	check.Required("interface", string(d.Interface))
+	check.Ref("interface", string(d.Interface), "REF(interface/ethernet), REF(interface/vlan), REF(interface/bridge)")
+	check.Range("mtu", d.Mtu, "0, 1280-9000")
+	check.Required("name", d.Name)
+	check.Between("netmask6", d.Netmask6, 0, 128)
+	check.Format("range_end", sophos.FormatIPv6, d.RangeEnd)
+	check.Format("range_start", sophos.FormatIPv6, d.RangeStart)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &DhcpServer6{}
 
-// GetPath implements sophos.RestObject and returns the DhcpServer6s GET path
+// GetPath implements sophos.RestGetter and returns the DhcpServer6s GET path
 // Returns all available dhcp/server6 objects
 func (*DhcpServer6s) GetPath() string { return "/api/objects/dhcp/server6/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*DhcpServer6s) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the DhcpServer6s GET path
+// GetPath implements sophos.RestGetter and returns the DhcpServer6s GET path
 // Returns all available server6 types
 func (d *DhcpServer6) GetPath() string {
 	return fmt.Sprintf("/api/objects/dhcp/server6/%s", d.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (d *DhcpServer6) RefRequired() (string, bool) { return d.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the DhcpServer6 DELETE path
+// DeletePath implements sophos.Deletable and returns the DhcpServer6 DELETE path
 // Creates or updates the complete object server6
 func (*DhcpServer6) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/server6/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the DhcpServer6 PATCH path
+// PatchPath implements sophos.Patchable and returns the DhcpServer6 PATCH path
 // Changes to parts of the object server6 types
 func (*DhcpServer6) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/server6/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the DhcpServer6 POST path
+// PostPath implements sophos.Creatable and returns the DhcpServer6 POST path
 // Create a new dhcp/server6 object
 func (*DhcpServer6) PostPath() string {
 	return "/api/objects/dhcp/server6/"
 }
 
-// PutPath implements sophos.RestObject and returns the DhcpServer6 PUT path
+// PutPath implements sophos.Updatable and returns the DhcpServer6 PUT path
 // Creates or updates the complete object server6
 func (*DhcpServer6) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/server6/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*DhcpServer6) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/server6/%s/usedby", ref)
 }
 
-// DhcpStatelesss is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (d *DhcpServer6) GetType() string { return d.ObjectType }
+
+// DhcpStatelesss is an Sophos Endpoint subType and implements sophos.RestGetter
 type DhcpStatelesss []DhcpStateless
 
 // DhcpStateless represents a UTM IPv6 prefix advertisement
@@ -482,85 +639,116 @@ type DhcpStateless struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	ValidLft   int    `json:"valid_lft"`
+	// Address description: REF(itfparams/*)
+	Address ItfparamsRef `json:"address"`
+	Comment string       `json:"comment"`
 	// Custom default value is ""
 	Custom     string `json:"custom"`
-	DefaultLft int    `json:"default_lft"`
+	DefaultLft int64  `json:"default_lft"`
 	// Dns1 description: (IP6ADDR)
 	// Dns1 default value is "::"
 	Dns1 string `json:"dns1"`
-	// Interface description: REF(interface/ethernet), REF(interface/vlan), REF(interface/bridge)
-	Interface string `json:"interface"`
-	// Mtu description: Constraints: 0, 1280-9000
-	Mtu int `json:"mtu"`
-	// OnLink default value is false
-	OnLink bool `json:"on_link"`
-	// StatelessServerStatus default value is true
-	StatelessServerStatus bool   `json:"stateless_server_status"`
-	Comment               string `json:"comment"`
-	Name                  string `json:"name"`
 	// Dns2 description: (IP6ADDR)
 	// Dns2 default value is "::"
 	Dns2 string `json:"dns2"`
 	// Domain default value is ""
 	Domain string `json:"domain"`
+	// Interface description: REF(interface/ethernet), REF(interface/vlan), REF(interface/bridge)
+	Interface InterfaceRef `json:"interface"`
 	// ManagedFlag default value is false
 	ManagedFlag bool `json:"managed_flag"`
-	// Address description: REF(itfparams/*)
-	Address string `json:"address"`
+	// Mtu description: Constraints: 0, 1280-9000
+	Mtu  int64  `json:"mtu"`
+	Name string `json:"name"`
+	// OnLink default value is false
+	OnLink bool `json:"on_link"`
 	// OtherConfig default value is false
-	OtherConfig bool `json:"other_config"`
-	PrefdLft    int  `json:"prefd_lft"`
+	OtherConfig bool  `json:"other_config"`
+	PrefdLft    int64 `json:"prefd_lft"`
 	// ProxyAutoconfig default value is false
 	ProxyAutoconfig bool `json:"proxy_autoconfig"`
+	// StatelessServerStatus default value is true
+	StatelessServerStatus bool `json:"stateless_server_status"`
 	// Status default value is false
-	Status bool `json:"status"`
+	Status   bool  `json:"status"`
+	ValidLft int64 `json:"valid_lft"`
+}
+
+// NewDhcpStateless returns a DhcpStateless with the default values of its swagger definition
+func NewDhcpStateless() *DhcpStateless {
+	return &DhcpStateless{
+		ObjectType:            "dhcp/stateless",
+		Dns1:                  "::",
+		Dns2:                  "::",
+		StatelessServerStatus: true,
+	}
+}
+
+// Validate checks the DhcpStateless before it is sent, see ValidateWith
+func (d *DhcpStateless) Validate() error { return d.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the DhcpStateless.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (d *DhcpStateless) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("DhcpStateless", typeOf)
+	check.Required("address", string(d.Address))
+	check.Ref("address", string(d.Address), "REF(itfparams/*)")
+	check.Format("dns1", sophos.FormatIPv6, d.Dns1)
+	check.Format("dns2", sophos.FormatIPv6, d.Dns2)
+	check.Required("interface", string(d.Interface))
+	check.Ref("interface", string(d.Interface), "REF(interface/ethernet), REF(interface/vlan), REF(interface/bridge)")
+	check.Range("mtu", d.Mtu, "0, 1280-9000")
+	check.Required("name", d.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &DhcpStateless{}
 
-// GetPath implements sophos.RestObject and returns the DhcpStatelesss GET path
+// GetPath implements sophos.RestGetter and returns the DhcpStatelesss GET path
 // Returns all available dhcp/stateless objects
 func (*DhcpStatelesss) GetPath() string { return "/api/objects/dhcp/stateless/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*DhcpStatelesss) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the DhcpStatelesss GET path
+// GetPath implements sophos.RestGetter and returns the DhcpStatelesss GET path
 // Returns all available stateless types
 func (d *DhcpStateless) GetPath() string {
 	return fmt.Sprintf("/api/objects/dhcp/stateless/%s", d.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (d *DhcpStateless) RefRequired() (string, bool) { return d.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the DhcpStateless DELETE path
+// DeletePath implements sophos.Deletable and returns the DhcpStateless DELETE path
 // Creates or updates the complete object stateless
 func (*DhcpStateless) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/stateless/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the DhcpStateless PATCH path
+// PatchPath implements sophos.Patchable and returns the DhcpStateless PATCH path
 // Changes to parts of the object stateless types
 func (*DhcpStateless) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/stateless/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the DhcpStateless POST path
+// PostPath implements sophos.Creatable and returns the DhcpStateless POST path
 // Create a new dhcp/stateless object
 func (*DhcpStateless) PostPath() string {
 	return "/api/objects/dhcp/stateless/"
 }
 
-// PutPath implements sophos.RestObject and returns the DhcpStateless PUT path
+// PutPath implements sophos.Updatable and returns the DhcpStateless PUT path
 // Creates or updates the complete object stateless
 func (*DhcpStateless) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/stateless/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*DhcpStateless) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dhcp/stateless/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (d *DhcpStateless) GetType() string { return d.ObjectType }
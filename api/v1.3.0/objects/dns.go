@@ -9,27 +9,27 @@ import (
 // Dns is a generated struct representing the Sophos Dns Endpoint
 // GET /api/nodes/dns
 type Dns struct {
-	AllowedNetworks []string      `json:"allowed_networks"`
-	Axfr            []interface{} `json:"axfr"`
-	Dnssec          int64         `json:"dnssec"`
-	Email           string        `json:"email"`
-	EmptyZones      string        `json:"empty_zones"`
-	FwdDynamic      int64         `json:"fwd_dynamic"`
-	FwdStatic       []string      `json:"fwd_static"`
-	RecheckInterval int64         `json:"recheck_interval"`
-	Routes          []string      `json:"routes"`
+	AllowedNetworks NetworkRefs `json:"allowed_networks"`
+	Axfr            []string    `json:"axfr"`
+	Dnssec          bool        `json:"dnssec"`
+	Email           string      `json:"email"`
+	EmptyZones      string      `json:"empty_zones"`
+	FwdDynamic      bool        `json:"fwd_dynamic"`
+	FwdStatic       []string    `json:"fwd_static"`
+	RecheckInterval int64       `json:"recheck_interval"`
+	Routes          []string    `json:"routes"`
 }
 
 var _ sophos.Endpoint = &Dns{}
 
-var defsDns = map[string]sophos.RestObject{
+var defsDns = map[string]sophos.RestGetter{
 	"DnsAxfr":  &DnsAxfr{},
 	"DnsGroup": &DnsGroup{},
 	"DnsRoute": &DnsRoute{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Dns's Objects
-func (Dns) RestObjects() map[string]sophos.RestObject { return defsDns }
+func (Dns) RestObjects() map[string]sophos.RestGetter { return defsDns }
 
 // GetPath implements sophos.RestGetter
 func (*Dns) GetPath() string { return "/api/nodes/dns" }
@@ -67,70 +67,92 @@ func (Dns) References() []string {
 	}
 }
 
-// DnsAxfrs is an Sophos Endpoint subType and implements sophos.RestObject
+// DnsAxfrs is an Sophos Endpoint subType and implements sophos.RestGetter
 type DnsAxfrs []DnsAxfr
 
 // DnsAxfr represents a UTM DNS slave zone
 type DnsAxfr struct {
-	Locked     string        `json:"_locked"`
-	ObjectType string        `json:"_type"`
-	Reference  string        `json:"_ref"`
-	Comment    string        `json:"comment"`
-	Master     []interface{} `json:"master"`
-	Name       string        `json:"name"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Comment    string   `json:"comment"`
+	Master     []string `json:"master"`
+	Name       string   `json:"name"`
 	// Status default value is false
 	Status bool `json:"status"`
 	// Zone description: (HOSTNAME)
 	Zone string `json:"zone"`
 }
 
+// NewDnsAxfr returns a DnsAxfr with the default values of its swagger definition
+func NewDnsAxfr() *DnsAxfr {
+	return &DnsAxfr{
+		ObjectType: "dns/axfr",
+	}
+}
+
+// Validate checks the DnsAxfr before it is sent, see ValidateWith
+func (d *DnsAxfr) Validate() error { return d.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the DnsAxfr.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (d *DnsAxfr) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("DnsAxfr", typeOf)
+	check.Required("name", d.Name)
+	check.Format("zone", sophos.FormatHostname, d.Zone)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &DnsAxfr{}
 
-// GetPath implements sophos.RestObject and returns the DnsAxfrs GET path
+// GetPath implements sophos.RestGetter and returns the DnsAxfrs GET path
 // Returns all available dns/axfr objects
 func (*DnsAxfrs) GetPath() string { return "/api/objects/dns/axfr/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*DnsAxfrs) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the DnsAxfrs GET path
+// GetPath implements sophos.RestGetter and returns the DnsAxfrs GET path
 // Returns all available axfr types
 func (d *DnsAxfr) GetPath() string { return fmt.Sprintf("/api/objects/dns/axfr/%s", d.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (d *DnsAxfr) RefRequired() (string, bool) { return d.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the DnsAxfr DELETE path
+// DeletePath implements sophos.Deletable and returns the DnsAxfr DELETE path
 // Creates or updates the complete object axfr
 func (*DnsAxfr) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/dns/axfr/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the DnsAxfr PATCH path
+// PatchPath implements sophos.Patchable and returns the DnsAxfr PATCH path
 // Changes to parts of the object axfr types
 func (*DnsAxfr) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dns/axfr/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the DnsAxfr POST path
+// PostPath implements sophos.Creatable and returns the DnsAxfr POST path
 // Create a new dns/axfr object
 func (*DnsAxfr) PostPath() string {
 	return "/api/objects/dns/axfr/"
 }
 
-// PutPath implements sophos.RestObject and returns the DnsAxfr PUT path
+// PutPath implements sophos.Updatable and returns the DnsAxfr PUT path
 // Creates or updates the complete object axfr
 func (*DnsAxfr) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dns/axfr/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*DnsAxfr) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dns/axfr/%s/usedby", ref)
 }
 
-// DnsGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (d *DnsAxfr) GetType() string { return d.ObjectType }
+
+// DnsGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type DnsGroups []DnsGroup
 
 // DnsGroup represents a UTM group
@@ -142,60 +164,81 @@ type DnsGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewDnsGroup returns a DnsGroup with the default values of its swagger definition
+func NewDnsGroup() *DnsGroup {
+	return &DnsGroup{
+		ObjectType: "dns/group",
+	}
+}
+
+// Validate checks the DnsGroup before it is sent, see ValidateWith
+func (d *DnsGroup) Validate() error { return d.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the DnsGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (d *DnsGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("DnsGroup", typeOf)
+	check.Required("name", d.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &DnsGroup{}
 
-// GetPath implements sophos.RestObject and returns the DnsGroups GET path
+// GetPath implements sophos.RestGetter and returns the DnsGroups GET path
 // Returns all available dns/group objects
 func (*DnsGroups) GetPath() string { return "/api/objects/dns/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*DnsGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the DnsGroups GET path
+// GetPath implements sophos.RestGetter and returns the DnsGroups GET path
 // Returns all available group types
 func (d *DnsGroup) GetPath() string { return fmt.Sprintf("/api/objects/dns/group/%s", d.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (d *DnsGroup) RefRequired() (string, bool) { return d.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the DnsGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the DnsGroup DELETE path
 // Creates or updates the complete object group
 func (*DnsGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/dns/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the DnsGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the DnsGroup PATCH path
 // Changes to parts of the object group types
 func (*DnsGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dns/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the DnsGroup POST path
+// PostPath implements sophos.Creatable and returns the DnsGroup POST path
 // Create a new dns/group object
 func (*DnsGroup) PostPath() string {
 	return "/api/objects/dns/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the DnsGroup PUT path
+// PutPath implements sophos.Updatable and returns the DnsGroup PUT path
 // Creates or updates the complete object group
 func (*DnsGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dns/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*DnsGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dns/group/%s/usedby", ref)
 }
 
-// DnsRoutes is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (d *DnsGroup) GetType() string { return d.ObjectType }
+
+// DnsRoutes is an Sophos Endpoint subType and implements sophos.RestGetter
 type DnsRoutes []DnsRoute
 
-// DnsRoute is a generated Sophos object
+// DnsRoute represents a UTM dns/route object
 type DnsRoute struct {
 	Locked     string   `json:"_locked"`
-	Reference  string   `json:"_ref"`
 	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
 	Comment    string   `json:"comment"`
 	Name       string   `json:"name"`
 	Prefix     string   `json:"prefix"`
@@ -203,47 +246,65 @@ type DnsRoute struct {
 	Targets    []string `json:"targets"`
 }
 
+// NewDnsRoute returns a DnsRoute with the default values of its swagger definition
+func NewDnsRoute() *DnsRoute {
+	return &DnsRoute{
+		ObjectType: "dns/route",
+	}
+}
+
+// Validate checks the DnsRoute before it is sent, see ValidateWith
+func (d *DnsRoute) Validate() error { return d.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the DnsRoute.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (d *DnsRoute) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("DnsRoute", typeOf)
+	check.Required("name", d.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &DnsRoute{}
 
-// GetPath implements sophos.RestObject and returns the DnsRoutes GET path
+// GetPath implements sophos.RestGetter and returns the DnsRoutes GET path
 // Returns all available dns/route objects
 func (*DnsRoutes) GetPath() string { return "/api/objects/dns/route/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*DnsRoutes) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the DnsRoutes GET path
+// GetPath implements sophos.RestGetter and returns the DnsRoutes GET path
 // Returns all available route types
 func (d *DnsRoute) GetPath() string { return fmt.Sprintf("/api/objects/dns/route/%s", d.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (d *DnsRoute) RefRequired() (string, bool) { return d.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the DnsRoute DELETE path
+// DeletePath implements sophos.Deletable and returns the DnsRoute DELETE path
 // Creates or updates the complete object route
 func (*DnsRoute) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/dns/route/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the DnsRoute PATCH path
+// PatchPath implements sophos.Patchable and returns the DnsRoute PATCH path
 // Changes to parts of the object route types
 func (*DnsRoute) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dns/route/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the DnsRoute POST path
+// PostPath implements sophos.Creatable and returns the DnsRoute POST path
 // Create a new dns/route object
 func (*DnsRoute) PostPath() string {
 	return "/api/objects/dns/route/"
 }
 
-// PutPath implements sophos.RestObject and returns the DnsRoute PUT path
+// PutPath implements sophos.Updatable and returns the DnsRoute PUT path
 // Creates or updates the complete object route
 func (*DnsRoute) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dns/route/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*DnsRoute) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dns/route/%s/usedby", ref)
@@ -9,18 +9,18 @@ import (
 // Dyndns is a generated struct representing the Sophos Dyndns Endpoint
 // GET /api/nodes/dyndns
 type Dyndns struct {
-	Rules []interface{} `json:"rules"`
+	Rules []string `json:"rules"`
 }
 
 var _ sophos.Endpoint = &Dyndns{}
 
-var defsDyndns = map[string]sophos.RestObject{
+var defsDyndns = map[string]sophos.RestGetter{
 	"DyndnsDyndns": &DyndnsDyndns{},
 	"DyndnsGroup":  &DyndnsGroup{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Dyndns's Objects
-func (Dyndns) RestObjects() map[string]sophos.RestObject { return defsDyndns }
+func (Dyndns) RestObjects() map[string]sophos.RestGetter { return defsDyndns }
 
 // GetPath implements sophos.RestGetter
 func (*Dyndns) GetPath() string { return "/api/nodes/dyndns" }
@@ -54,96 +54,193 @@ func (Dyndns) References() []string {
 	}
 }
 
-// DyndnsDyndnss is an Sophos Endpoint subType and implements sophos.RestObject
+// DyndnsDyndnss is an Sophos Endpoint subType and implements sophos.RestGetter
 type DyndnsDyndnss []DyndnsDyndns
 
 // DyndnsDyndns represents a UTM DynDNS mapping
 type DyndnsDyndns struct {
-	Locked     string `json:"_locked"`
-	ObjectType string `json:"_type"`
-	Reference  string `json:"_ref"`
-	// Type can be one of: []string{"dns-o-matic", "dnsdynamic", "dnspark", "dtdns", "dyndns", "dyndns-custom", "easydns", "freedns", "namecheap", "no-ip", "opendns", "selfhost", "strato", "zoneedit"}
-	// Type default value is "dyndns"
-	Type    string        `json:"type"`
-	Aliases []interface{} `json:"aliases"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Aliases    []string `json:"aliases"`
+	// Backupmx default value is false
+	Backupmx bool   `json:"backupmx"`
+	Comment  string `json:"comment"`
 	// Hostname default value is ""
 	Hostname string `json:"hostname"`
+	// Interface description: REF(interface/*)
+	Interface InterfaceRef `json:"interface"`
 	// Label default value is ""
 	Label string `json:"label"`
 	// Mx description: (HOSTNAME)
 	// Mx default value is ""
-	Mx string `json:"mx"`
+	Mx    string `json:"mx"`
+	Mxpri int64  `json:"mxpri"`
+	Name  string `json:"name"`
 	// Password default value is ""
 	Password string `json:"password"`
-	// Interface description: REF(interface/*)
-	Interface string `json:"interface"`
 	// Record can be one of: []string{"a", "aaaa", "both"}
 	// Record default value is "a"
-	Record  string `json:"record"`
-	User    string `json:"user"`
-	Comment string `json:"comment"`
+	Record DyndnsDyndnsRecord `json:"record"`
 	// Status default value is false
 	Status bool `json:"status"`
 	// Strategy can be one of: []string{"if", "web"}
 	// Strategy default value is "if"
-	Strategy string `json:"strategy"`
+	Strategy DyndnsDyndnsStrategy `json:"strategy"`
+	// Type can be one of: []string{"dns-o-matic", "dnsdynamic", "dnspark", "dtdns", "dyndns", "dyndns-custom", "easydns", "freedns", "namecheap", "no-ip", "opendns", "selfhost", "strato", "zoneedit"}
+	// Type default value is "dyndns"
+	Type DyndnsDyndnsType `json:"type"`
+	User string           `json:"user"`
 	// Wildcard default value is false
 	Wildcard bool `json:"wildcard"`
-	// Backupmx default value is false
-	Backupmx bool   `json:"backupmx"`
-	Mxpri    int    `json:"mxpri"`
-	Name     string `json:"name"`
+}
+
+// DyndnsDyndnsRecord is the Record of a DyndnsDyndns
+type DyndnsDyndnsRecord string
+
+// Known values of DyndnsDyndnsRecord
+const (
+	DyndnsDyndnsRecordA    DyndnsDyndnsRecord = "a"
+	DyndnsDyndnsRecordAaaa DyndnsDyndnsRecord = "aaaa"
+	DyndnsDyndnsRecordBoth DyndnsDyndnsRecord = "both"
+)
+
+// Valid returns true if the value is a known DyndnsDyndnsRecord
+func (v DyndnsDyndnsRecord) Valid() bool {
+	switch v {
+	case DyndnsDyndnsRecordA, DyndnsDyndnsRecordAaaa, DyndnsDyndnsRecordBoth:
+		return true
+	}
+	return false
+}
+
+// DyndnsDyndnsStrategy is the Strategy of a DyndnsDyndns
+type DyndnsDyndnsStrategy string
+
+// Known values of DyndnsDyndnsStrategy
+const (
+	DyndnsDyndnsStrategyIf  DyndnsDyndnsStrategy = "if"
+	DyndnsDyndnsStrategyWeb DyndnsDyndnsStrategy = "web"
+)
+
+// Valid returns true if the value is a known DyndnsDyndnsStrategy
+func (v DyndnsDyndnsStrategy) Valid() bool {
+	switch v {
+	case DyndnsDyndnsStrategyIf, DyndnsDyndnsStrategyWeb:
+		return true
+	}
+	return false
+}
+
+// DyndnsDyndnsType is the Type of a DyndnsDyndns
+type DyndnsDyndnsType string
+
+// Known values of DyndnsDyndnsType
+const (
+	DyndnsDyndnsTypeDnsOMatic    DyndnsDyndnsType = "dns-o-matic"
+	DyndnsDyndnsTypeDnsdynamic   DyndnsDyndnsType = "dnsdynamic"
+	DyndnsDyndnsTypeDnspark      DyndnsDyndnsType = "dnspark"
+	DyndnsDyndnsTypeDtdns        DyndnsDyndnsType = "dtdns"
+	DyndnsDyndnsTypeDyndns       DyndnsDyndnsType = "dyndns"
+	DyndnsDyndnsTypeDyndnsCustom DyndnsDyndnsType = "dyndns-custom"
+	DyndnsDyndnsTypeEasydns      DyndnsDyndnsType = "easydns"
+	DyndnsDyndnsTypeFreedns      DyndnsDyndnsType = "freedns"
+	DyndnsDyndnsTypeNamecheap    DyndnsDyndnsType = "namecheap"
+	DyndnsDyndnsTypeNoIp         DyndnsDyndnsType = "no-ip"
+	DyndnsDyndnsTypeOpendns      DyndnsDyndnsType = "opendns"
+	DyndnsDyndnsTypeSelfhost     DyndnsDyndnsType = "selfhost"
+	DyndnsDyndnsTypeStrato       DyndnsDyndnsType = "strato"
+	DyndnsDyndnsTypeZoneedit     DyndnsDyndnsType = "zoneedit"
+)
+
+// Valid returns true if the value is a known DyndnsDyndnsType
+func (v DyndnsDyndnsType) Valid() bool {
+	switch v {
+	case DyndnsDyndnsTypeDnsOMatic, DyndnsDyndnsTypeDnsdynamic, DyndnsDyndnsTypeDnspark, DyndnsDyndnsTypeDtdns, DyndnsDyndnsTypeDyndns, DyndnsDyndnsTypeDyndnsCustom, DyndnsDyndnsTypeEasydns, DyndnsDyndnsTypeFreedns, DyndnsDyndnsTypeNamecheap, DyndnsDyndnsTypeNoIp, DyndnsDyndnsTypeOpendns, DyndnsDyndnsTypeSelfhost, DyndnsDyndnsTypeStrato, DyndnsDyndnsTypeZoneedit:
+		return true
+	}
+	return false
+}
+
+// NewDyndnsDyndns returns a DyndnsDyndns with the default values of its swagger definition
+func NewDyndnsDyndns() *DyndnsDyndns {
+	return &DyndnsDyndns{
+		ObjectType: "dyndns/dyndns",
+		Record:     DyndnsDyndnsRecordA,
+		Strategy:   DyndnsDyndnsStrategyIf,
+		Type:       DyndnsDyndnsTypeDyndns,
+	}
+}
+
+// Validate checks the DyndnsDyndns before it is sent, see ValidateWith
+func (d *DyndnsDyndns) Validate() error { return d.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the DyndnsDyndns.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (d *DyndnsDyndns) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("DyndnsDyndns", typeOf)
+	check.Required("interface", string(d.Interface))
+	check.Ref("interface", string(d.Interface), "REF(interface/*)")
+	check.Format("mx", sophos.FormatHostname, d.Mx)
+	check.Required("name", d.Name)
+	check.Enum("record", string(d.Record), d.Record.Valid())
+	check.Enum("strategy", string(d.Strategy), d.Strategy.Valid())
+	check.Enum("type", string(d.Type), d.Type.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &DyndnsDyndns{}
 
-// GetPath implements sophos.RestObject and returns the DyndnsDyndnss GET path
+// GetPath implements sophos.RestGetter and returns the DyndnsDyndnss GET path
 // Returns all available dyndns/dyndns objects
 func (*DyndnsDyndnss) GetPath() string { return "/api/objects/dyndns/dyndns/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*DyndnsDyndnss) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the DyndnsDyndnss GET path
+// GetPath implements sophos.RestGetter and returns the DyndnsDyndnss GET path
 // Returns all available dyndns types
 func (d *DyndnsDyndns) GetPath() string {
 	return fmt.Sprintf("/api/objects/dyndns/dyndns/%s", d.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (d *DyndnsDyndns) RefRequired() (string, bool) { return d.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the DyndnsDyndns DELETE path
+// DeletePath implements sophos.Deletable and returns the DyndnsDyndns DELETE path
 // Creates or updates the complete object dyndns
 func (*DyndnsDyndns) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/dyndns/dyndns/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the DyndnsDyndns PATCH path
+// PatchPath implements sophos.Patchable and returns the DyndnsDyndns PATCH path
 // Changes to parts of the object dyndns types
 func (*DyndnsDyndns) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dyndns/dyndns/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the DyndnsDyndns POST path
+// PostPath implements sophos.Creatable and returns the DyndnsDyndns POST path
 // Create a new dyndns/dyndns object
 func (*DyndnsDyndns) PostPath() string {
 	return "/api/objects/dyndns/dyndns/"
 }
 
-// PutPath implements sophos.RestObject and returns the DyndnsDyndns PUT path
+// PutPath implements sophos.Updatable and returns the DyndnsDyndns PUT path
 // Creates or updates the complete object dyndns
 func (*DyndnsDyndns) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dyndns/dyndns/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*DyndnsDyndns) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dyndns/dyndns/%s/usedby", ref)
 }
 
-// DyndnsGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (d *DyndnsDyndns) GetType() string { return d.ObjectType }
+
+// DyndnsGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type DyndnsGroups []DyndnsGroup
 
 // DyndnsGroup represents a UTM group
@@ -155,50 +252,71 @@ type DyndnsGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewDyndnsGroup returns a DyndnsGroup with the default values of its swagger definition
+func NewDyndnsGroup() *DyndnsGroup {
+	return &DyndnsGroup{
+		ObjectType: "dyndns/group",
+	}
+}
+
+// Validate checks the DyndnsGroup before it is sent, see ValidateWith
+func (d *DyndnsGroup) Validate() error { return d.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the DyndnsGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (d *DyndnsGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("DyndnsGroup", typeOf)
+	check.Required("name", d.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &DyndnsGroup{}
 
-// GetPath implements sophos.RestObject and returns the DyndnsGroups GET path
+// GetPath implements sophos.RestGetter and returns the DyndnsGroups GET path
 // Returns all available dyndns/group objects
 func (*DyndnsGroups) GetPath() string { return "/api/objects/dyndns/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*DyndnsGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the DyndnsGroups GET path
+// GetPath implements sophos.RestGetter and returns the DyndnsGroups GET path
 // Returns all available group types
 func (d *DyndnsGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/dyndns/group/%s", d.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (d *DyndnsGroup) RefRequired() (string, bool) { return d.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the DyndnsGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the DyndnsGroup DELETE path
 // Creates or updates the complete object group
 func (*DyndnsGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/dyndns/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the DyndnsGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the DyndnsGroup PATCH path
 // Changes to parts of the object group types
 func (*DyndnsGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dyndns/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the DyndnsGroup POST path
+// PostPath implements sophos.Creatable and returns the DyndnsGroup POST path
 // Create a new dyndns/group object
 func (*DyndnsGroup) PostPath() string {
 	return "/api/objects/dyndns/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the DyndnsGroup PUT path
+// PutPath implements sophos.Updatable and returns the DyndnsGroup PUT path
 // Creates or updates the complete object group
 func (*DyndnsGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dyndns/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*DyndnsGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/dyndns/group/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (d *DyndnsGroup) GetType() string { return d.ObjectType }
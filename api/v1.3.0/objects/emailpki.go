@@ -22,31 +22,31 @@ type Emailpki struct {
 		Country      string `json:"country"`
 		Organization string `json:"organization"`
 		Postmaster   string `json:"postmaster"`
-		Status       int64  `json:"status"`
+		Status       bool   `json:"status"`
 	} `json:"global"`
 	Objects struct {
-		Cas     []string      `json:"cas"`
-		Openpgp []interface{} `json:"openpgp"`
-		Smime   []interface{} `json:"smime"`
-		Users   []interface{} `json:"users"`
+		Cas     []string `json:"cas"`
+		Openpgp []string `json:"openpgp"`
+		Smime   []string `json:"smime"`
+		Users   []string `json:"users"`
 	} `json:"objects"`
 	Openpgp struct {
 		MainKeysize int64 `json:"main_keysize"`
 		SubKeysize  int64 `json:"sub_keysize"`
 	} `json:"openpgp"`
 	Options struct {
-		ExternalAuto     int64  `json:"external_auto"`
+		ExternalAuto     bool   `json:"external_auto"`
 		Keyserver        string `json:"keyserver"`
-		PolicyDecryption int64  `json:"policy_decryption"`
-		PolicyEncryption int64  `json:"policy_encryption"`
-		PolicySign       int64  `json:"policy_sign"`
-		PolicyVerify     int64  `json:"policy_verify"`
+		PolicyDecryption bool   `json:"policy_decryption"`
+		PolicyEncryption bool   `json:"policy_encryption"`
+		PolicySign       bool   `json:"policy_sign"`
+		PolicyVerify     bool   `json:"policy_verify"`
 	} `json:"options"`
 }
 
 var _ sophos.Endpoint = &Emailpki{}
 
-var defsEmailpki = map[string]sophos.RestObject{
+var defsEmailpki = map[string]sophos.RestGetter{
 	"EmailpkiGroup":   &EmailpkiGroup{},
 	"EmailpkiOpenpgp": &EmailpkiOpenpgp{},
 	"EmailpkiSmime":   &EmailpkiSmime{},
@@ -54,7 +54,7 @@ var defsEmailpki = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Emailpki's Objects
-func (Emailpki) RestObjects() map[string]sophos.RestObject { return defsEmailpki }
+func (Emailpki) RestObjects() map[string]sophos.RestGetter { return defsEmailpki }
 
 // GetPath implements sophos.RestGetter
 func (*Emailpki) GetPath() string { return "/api/nodes/emailpki" }
@@ -96,7 +96,7 @@ func (Emailpki) References() []string {
 	}
 }
 
-// EmailpkiGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// EmailpkiGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type EmailpkiGroups []EmailpkiGroup
 
 // EmailpkiGroup represents a UTM group
@@ -108,124 +108,166 @@ type EmailpkiGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewEmailpkiGroup returns a EmailpkiGroup with the default values of its swagger definition
+func NewEmailpkiGroup() *EmailpkiGroup {
+	return &EmailpkiGroup{
+		ObjectType: "emailpki/group",
+	}
+}
+
+// Validate checks the EmailpkiGroup before it is sent, see ValidateWith
+func (e *EmailpkiGroup) Validate() error { return e.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the EmailpkiGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (e *EmailpkiGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("EmailpkiGroup", typeOf)
+	check.Required("name", e.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &EmailpkiGroup{}
 
-// GetPath implements sophos.RestObject and returns the EmailpkiGroups GET path
+// GetPath implements sophos.RestGetter and returns the EmailpkiGroups GET path
 // Returns all available emailpki/group objects
 func (*EmailpkiGroups) GetPath() string { return "/api/objects/emailpki/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*EmailpkiGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the EmailpkiGroups GET path
+// GetPath implements sophos.RestGetter and returns the EmailpkiGroups GET path
 // Returns all available group types
 func (e *EmailpkiGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/emailpki/group/%s", e.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (e *EmailpkiGroup) RefRequired() (string, bool) { return e.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the EmailpkiGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the EmailpkiGroup DELETE path
 // Creates or updates the complete object group
 func (*EmailpkiGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the EmailpkiGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the EmailpkiGroup PATCH path
 // Changes to parts of the object group types
 func (*EmailpkiGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the EmailpkiGroup POST path
+// PostPath implements sophos.Creatable and returns the EmailpkiGroup POST path
 // Create a new emailpki/group object
 func (*EmailpkiGroup) PostPath() string {
 	return "/api/objects/emailpki/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the EmailpkiGroup PUT path
+// PutPath implements sophos.Updatable and returns the EmailpkiGroup PUT path
 // Creates or updates the complete object group
 func (*EmailpkiGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*EmailpkiGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/group/%s/usedby", ref)
 }
 
-// EmailpkiOpenpgps is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (e *EmailpkiGroup) GetType() string { return e.ObjectType }
+
+// EmailpkiOpenpgps is an Sophos Endpoint subType and implements sophos.RestGetter
 type EmailpkiOpenpgps []EmailpkiOpenpgp
 
 // EmailpkiOpenpgp represents a UTM Email encryption OpenPGP key
 type EmailpkiOpenpgp struct {
-	Locked     string `json:"_locked"`
-	ObjectType string `json:"_type"`
-	Reference  string `json:"_ref"`
-	// Privkey default value is ""
-	Privkey string `json:"privkey"`
-	Pubkey  string `json:"pubkey"`
-	// Realname default value is ""
-	Realname string        `json:"realname"`
-	Comment  string        `json:"comment"`
-	Emails   []interface{} `json:"emails"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Comment    string   `json:"comment"`
+	Emails     []string `json:"emails"`
 	// Expires default value is ""
 	Expires     string `json:"expires"`
 	Fingerprint string `json:"fingerprint"`
 	Name        string `json:"name"`
+	// Privkey default value is ""
+	Privkey string `json:"privkey"`
+	Pubkey  string `json:"pubkey"`
+	// Realname default value is ""
+	Realname string `json:"realname"`
+}
+
+// NewEmailpkiOpenpgp returns a EmailpkiOpenpgp with the default values of its swagger definition
+func NewEmailpkiOpenpgp() *EmailpkiOpenpgp {
+	return &EmailpkiOpenpgp{
+		ObjectType: "emailpki/openpgp",
+	}
+}
+
+// Validate checks the EmailpkiOpenpgp before it is sent, see ValidateWith
+func (e *EmailpkiOpenpgp) Validate() error { return e.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the EmailpkiOpenpgp.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (e *EmailpkiOpenpgp) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("EmailpkiOpenpgp", typeOf)
+	check.Required("name", e.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &EmailpkiOpenpgp{}
 
-// GetPath implements sophos.RestObject and returns the EmailpkiOpenpgps GET path
+// GetPath implements sophos.RestGetter and returns the EmailpkiOpenpgps GET path
 // Returns all available emailpki/openpgp objects
 func (*EmailpkiOpenpgps) GetPath() string { return "/api/objects/emailpki/openpgp/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*EmailpkiOpenpgps) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the EmailpkiOpenpgps GET path
+// GetPath implements sophos.RestGetter and returns the EmailpkiOpenpgps GET path
 // Returns all available openpgp types
 func (e *EmailpkiOpenpgp) GetPath() string {
 	return fmt.Sprintf("/api/objects/emailpki/openpgp/%s", e.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (e *EmailpkiOpenpgp) RefRequired() (string, bool) { return e.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the EmailpkiOpenpgp DELETE path
+// DeletePath implements sophos.Deletable and returns the EmailpkiOpenpgp DELETE path
 // Creates or updates the complete object openpgp
 func (*EmailpkiOpenpgp) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/openpgp/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the EmailpkiOpenpgp PATCH path
+// PatchPath implements sophos.Patchable and returns the EmailpkiOpenpgp PATCH path
 // Changes to parts of the object openpgp types
 func (*EmailpkiOpenpgp) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/openpgp/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the EmailpkiOpenpgp POST path
+// PostPath implements sophos.Creatable and returns the EmailpkiOpenpgp POST path
 // Create a new emailpki/openpgp object
 func (*EmailpkiOpenpgp) PostPath() string {
 	return "/api/objects/emailpki/openpgp/"
 }
 
-// PutPath implements sophos.RestObject and returns the EmailpkiOpenpgp PUT path
+// PutPath implements sophos.Updatable and returns the EmailpkiOpenpgp PUT path
 // Creates or updates the complete object openpgp
 func (*EmailpkiOpenpgp) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/openpgp/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*EmailpkiOpenpgp) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/openpgp/%s/usedby", ref)
 }
 
-// EmailpkiSmimes is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (e *EmailpkiOpenpgp) GetType() string { return e.ObjectType }
+
+// EmailpkiSmimes is an Sophos Endpoint subType and implements sophos.RestGetter
 type EmailpkiSmimes []EmailpkiSmime
 
 // EmailpkiSmime represents a UTM email encryption S/MIME certificate
@@ -233,72 +275,93 @@ type EmailpkiSmime struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
+	Cert       string `json:"cert"`
+	Comment    string `json:"comment"`
+	Dn         string `json:"dn"`
+	// Domaincert default value is false
+	Domaincert  bool     `json:"domaincert"`
+	Emails      []string `json:"emails"`
+	Expires     string   `json:"expires"`
+	Fingerprint string   `json:"fingerprint"`
+	// Key default value is ""
+	Key  string `json:"key"`
+	Name string `json:"name"`
 	// Realname default value is ""
 	Realname string `json:"realname"`
 	// Trust default value is false
-	Trust       bool   `json:"trust"`
-	Cert        string `json:"cert"`
-	Fingerprint string `json:"fingerprint"`
-	// Key default value is ""
-	Key     string `json:"key"`
-	Name    string `json:"name"`
-	Expires string `json:"expires"`
-	Comment string `json:"comment"`
-	Dn      string `json:"dn"`
-	// Domaincert default value is false
-	Domaincert bool          `json:"domaincert"`
-	Emails     []interface{} `json:"emails"`
+	Trust bool `json:"trust"`
+}
+
+// NewEmailpkiSmime returns a EmailpkiSmime with the default values of its swagger definition
+func NewEmailpkiSmime() *EmailpkiSmime {
+	return &EmailpkiSmime{
+		ObjectType: "emailpki/smime",
+	}
+}
+
+// Validate checks the EmailpkiSmime before it is sent, see ValidateWith
+func (e *EmailpkiSmime) Validate() error { return e.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the EmailpkiSmime.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (e *EmailpkiSmime) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("EmailpkiSmime", typeOf)
+	check.Required("name", e.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &EmailpkiSmime{}
 
-// GetPath implements sophos.RestObject and returns the EmailpkiSmimes GET path
+// GetPath implements sophos.RestGetter and returns the EmailpkiSmimes GET path
 // Returns all available emailpki/smime objects
 func (*EmailpkiSmimes) GetPath() string { return "/api/objects/emailpki/smime/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*EmailpkiSmimes) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the EmailpkiSmimes GET path
+// GetPath implements sophos.RestGetter and returns the EmailpkiSmimes GET path
 // Returns all available smime types
 func (e *EmailpkiSmime) GetPath() string {
 	return fmt.Sprintf("/api/objects/emailpki/smime/%s", e.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (e *EmailpkiSmime) RefRequired() (string, bool) { return e.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the EmailpkiSmime DELETE path
+// DeletePath implements sophos.Deletable and returns the EmailpkiSmime DELETE path
 // Creates or updates the complete object smime
 func (*EmailpkiSmime) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/smime/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the EmailpkiSmime PATCH path
+// PatchPath implements sophos.Patchable and returns the EmailpkiSmime PATCH path
 // Changes to parts of the object smime types
 func (*EmailpkiSmime) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/smime/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the EmailpkiSmime POST path
+// PostPath implements sophos.Creatable and returns the EmailpkiSmime POST path
 // Create a new emailpki/smime object
 func (*EmailpkiSmime) PostPath() string {
 	return "/api/objects/emailpki/smime/"
 }
 
-// PutPath implements sophos.RestObject and returns the EmailpkiSmime PUT path
+// PutPath implements sophos.Updatable and returns the EmailpkiSmime PUT path
 // Creates or updates the complete object smime
 func (*EmailpkiSmime) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/smime/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*EmailpkiSmime) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/smime/%s/usedby", ref)
 }
 
-// EmailpkiUsers is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (e *EmailpkiSmime) GetType() string { return e.ObjectType }
+
+// EmailpkiUsers is an Sophos Endpoint subType and implements sophos.RestGetter
 type EmailpkiUsers []EmailpkiUser
 
 // EmailpkiUser represents a UTM email encryption user
@@ -309,76 +372,185 @@ type EmailpkiUser struct {
 	Comment    string `json:"comment"`
 	// Decrypt can be one of: []string{"global", "on", "off"}
 	// Decrypt default value is "global"
-	Decrypt string `json:"decrypt"`
+	Decrypt EmailpkiUserDecrypt `json:"decrypt"`
+	// Domaincert default value is false
+	Domaincert bool `json:"domaincert"`
 	// Encrypt can be one of: []string{"global", "on", "off"}
 	// Encrypt default value is "global"
-	Encrypt string `json:"encrypt"`
+	Encrypt EmailpkiUserEncrypt `json:"encrypt"`
+	Name    string              `json:"name"`
 	// Openpgp description: REF(emailpki/openpgp)
 	// Openpgp default value is ""
-	Openpgp  string `json:"openpgp"`
-	Realname string `json:"realname"`
+	Openpgp EmailpkiRef `json:"openpgp"`
+	// OpenpgpStatus default value is true
+	OpenpgpStatus bool   `json:"openpgp_status"`
+	Realname      string `json:"realname"`
 	// Sign can be one of: []string{"global", "on", "off"}
 	// Sign default value is "global"
-	Sign string `json:"sign"`
+	Sign EmailpkiUserSign `json:"sign"`
 	// Smime description: REF(emailpki/smime)
 	// Smime default value is ""
-	Smime string `json:"smime"`
-	// Domaincert default value is false
-	Domaincert bool   `json:"domaincert"`
-	Name       string `json:"name"`
-	// OpenpgpStatus default value is true
-	OpenpgpStatus bool `json:"openpgp_status"`
+	Smime EmailpkiRef `json:"smime"`
 	// SmimeStatus default value is true
 	SmimeStatus bool `json:"smime_status"`
 	// Verify can be one of: []string{"global", "on", "off"}
 	// Verify default value is "global"
-	Verify string `json:"verify"`
+	Verify EmailpkiUserVerify `json:"verify"`
+}
+
+// EmailpkiUserDecrypt is the Decrypt of a EmailpkiUser
+type EmailpkiUserDecrypt string
+
+// Known values of EmailpkiUserDecrypt
+const (
+	EmailpkiUserDecryptGlobal EmailpkiUserDecrypt = "global"
+	EmailpkiUserDecryptOn     EmailpkiUserDecrypt = "on"
+	EmailpkiUserDecryptOff    EmailpkiUserDecrypt = "off"
+)
+
+// Valid returns true if the value is a known EmailpkiUserDecrypt
+func (v EmailpkiUserDecrypt) Valid() bool {
+	switch v {
+	case EmailpkiUserDecryptGlobal, EmailpkiUserDecryptOn, EmailpkiUserDecryptOff:
+		return true
+	}
+	return false
+}
+
+// EmailpkiUserEncrypt is the Encrypt of a EmailpkiUser
+type EmailpkiUserEncrypt string
+
+// Known values of EmailpkiUserEncrypt
+const (
+	EmailpkiUserEncryptGlobal EmailpkiUserEncrypt = "global"
+	EmailpkiUserEncryptOn     EmailpkiUserEncrypt = "on"
+	EmailpkiUserEncryptOff    EmailpkiUserEncrypt = "off"
+)
+
+// Valid returns true if the value is a known EmailpkiUserEncrypt
+func (v EmailpkiUserEncrypt) Valid() bool {
+	switch v {
+	case EmailpkiUserEncryptGlobal, EmailpkiUserEncryptOn, EmailpkiUserEncryptOff:
+		return true
+	}
+	return false
+}
+
+// EmailpkiUserSign is the Sign of a EmailpkiUser
+type EmailpkiUserSign string
+
+// Known values of EmailpkiUserSign
+const (
+	EmailpkiUserSignGlobal EmailpkiUserSign = "global"
+	EmailpkiUserSignOn     EmailpkiUserSign = "on"
+	EmailpkiUserSignOff    EmailpkiUserSign = "off"
+)
+
+// Valid returns true if the value is a known EmailpkiUserSign
+func (v EmailpkiUserSign) Valid() bool {
+	switch v {
+	case EmailpkiUserSignGlobal, EmailpkiUserSignOn, EmailpkiUserSignOff:
+		return true
+	}
+	return false
+}
+
+// EmailpkiUserVerify is the Verify of a EmailpkiUser
+type EmailpkiUserVerify string
+
+// Known values of EmailpkiUserVerify
+const (
+	EmailpkiUserVerifyGlobal EmailpkiUserVerify = "global"
+	EmailpkiUserVerifyOn     EmailpkiUserVerify = "on"
+	EmailpkiUserVerifyOff    EmailpkiUserVerify = "off"
+)
+
+// Valid returns true if the value is a known EmailpkiUserVerify
+func (v EmailpkiUserVerify) Valid() bool {
+	switch v {
+	case EmailpkiUserVerifyGlobal, EmailpkiUserVerifyOn, EmailpkiUserVerifyOff:
+		return true
+	}
+	return false
+}
+
+// NewEmailpkiUser returns a EmailpkiUser with the default values of its swagger definition
+func NewEmailpkiUser() *EmailpkiUser {
+	return &EmailpkiUser{
+		ObjectType:    "emailpki/user",
+		Decrypt:       EmailpkiUserDecryptGlobal,
+		Encrypt:       EmailpkiUserEncryptGlobal,
+		OpenpgpStatus: true,
+		Sign:          EmailpkiUserSignGlobal,
+		SmimeStatus:   true,
+		Verify:        EmailpkiUserVerifyGlobal,
+	}
+}
+
+// Validate checks the EmailpkiUser before it is sent, see ValidateWith
+func (e *EmailpkiUser) Validate() error { return e.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the EmailpkiUser.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (e *EmailpkiUser) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("EmailpkiUser", typeOf)
+	check.Enum("decrypt", string(e.Decrypt), e.Decrypt.Valid())
+	check.Enum("encrypt", string(e.Encrypt), e.Encrypt.Valid())
+	check.Required("name", e.Name)
+	check.Ref("openpgp", string(e.Openpgp), "REF(emailpki/openpgp)")
+	check.Enum("sign", string(e.Sign), e.Sign.Valid())
+	check.Ref("smime", string(e.Smime), "REF(emailpki/smime)")
+	check.Enum("verify", string(e.Verify), e.Verify.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &EmailpkiUser{}
 
-// GetPath implements sophos.RestObject and returns the EmailpkiUsers GET path
+// GetPath implements sophos.RestGetter and returns the EmailpkiUsers GET path
 // Returns all available emailpki/user objects
 func (*EmailpkiUsers) GetPath() string { return "/api/objects/emailpki/user/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*EmailpkiUsers) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the EmailpkiUsers GET path
+// GetPath implements sophos.RestGetter and returns the EmailpkiUsers GET path
 // Returns all available user types
 func (e *EmailpkiUser) GetPath() string {
 	return fmt.Sprintf("/api/objects/emailpki/user/%s", e.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (e *EmailpkiUser) RefRequired() (string, bool) { return e.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the EmailpkiUser DELETE path
+// DeletePath implements sophos.Deletable and returns the EmailpkiUser DELETE path
 // Creates or updates the complete object user
 func (*EmailpkiUser) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/user/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the EmailpkiUser PATCH path
+// PatchPath implements sophos.Patchable and returns the EmailpkiUser PATCH path
 // Changes to parts of the object user types
 func (*EmailpkiUser) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/user/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the EmailpkiUser POST path
+// PostPath implements sophos.Creatable and returns the EmailpkiUser POST path
 // Create a new emailpki/user object
 func (*EmailpkiUser) PostPath() string {
 	return "/api/objects/emailpki/user/"
 }
 
-// PutPath implements sophos.RestObject and returns the EmailpkiUser PUT path
+// PutPath implements sophos.Updatable and returns the EmailpkiUser PUT path
 // Creates or updates the complete object user
 func (*EmailpkiUser) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/user/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*EmailpkiUser) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/emailpki/user/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (e *EmailpkiUser) GetType() string { return e.ObjectType }
@@ -0,0 +1,558 @@
+package objects
+
+import "github.com/esurdam/go-sophos"
+
+// Endpoints returns all known sophos.Endpoint(s)
+func Endpoints() []sophos.Endpoint {
+	return []sophos.Endpoint{
+		&Aaa{},
+		&AmazonVpc{},
+		&ApplicationControl{},
+		&Authentication{},
+		&Awe{},
+		&AweNetworkDeviceAssociation{},
+		&Aws{},
+		&Awscli{},
+		&Bgp{},
+		&Ca{},
+		&ClientlessVpn{},
+		&Condition{},
+		&Cron{},
+		&Dhcp{},
+		&Dns{},
+		&Dyndns{},
+		&Emailpki{},
+		&Epp{},
+		&Ftp{},
+		&Geoip{},
+		&Hotspot{},
+		&Http{},
+		&Interface{},
+		&IpfixConnection{},
+		&Ips{},
+		&Ipsec{},
+		&IpsecConnection{},
+		&IpsecRemoteAuth{},
+		&Itfhw{},
+		&Itfparams{},
+		&MacList{},
+		&Network{},
+		&Nodes{},
+		&Notification{},
+		&Ospf{},
+		&Override{},
+		&Packetfilter{},
+		&PimSm{},
+		&Pop3{},
+		&Qos{},
+		&RemoteSyslog{},
+		&Reporting{},
+		&ReverseProxy{},
+		&Right{},
+		&Role{},
+		&Route{},
+		&Scheduler{},
+		&Service{},
+		&Smtp{},
+		&Snmp{},
+		&Spx{},
+		&SslVpn{},
+		&Stas{},
+		&Status{},
+		&Time{},
+		&UserPreferences{},
+	}
+}
+
+// NewObject returns a new object of the type (e.g. network/host) or false if the type is unknown
+func NewObject(objType string) (sophos.RestGetter, bool) {
+	switch objType {
+	case "aaa/group":
+		return NewAaaGroup(), true
+	case "aaa/user":
+		return NewAaaUser(), true
+	case "amazon_vpc/connection":
+		return NewAmazonVpcConnection(), true
+	case "amazon_vpc/group":
+		return NewAmazonVpcGroup(), true
+	case "amazon_vpc/tunnel":
+		return NewAmazonVpcTunnel(), true
+	case "application_control/group":
+		return NewApplicationControlGroup(), true
+	case "application_control/rule":
+		return NewApplicationControlRule(), true
+	case "authentication/adirectory":
+		return NewAuthenticationAdirectory(), true
+	case "authentication/edirectory":
+		return NewAuthenticationEdirectory(), true
+	case "authentication/group":
+		return NewAuthenticationGroup(), true
+	case "authentication/ldap":
+		return NewAuthenticationLdap(), true
+	case "authentication/otp_token":
+		return NewAuthenticationOtpToken(), true
+	case "authentication/radius":
+		return NewAuthenticationRadius(), true
+	case "authentication/tacacs":
+		return NewAuthenticationTacacs(), true
+	case "awe/client":
+		return NewAweClient(), true
+	case "awe/device":
+		return NewAweDevice(), true
+	case "awe/group":
+		return NewAweGroup(), true
+	case "awe/local":
+		return NewAweLocal(), true
+	case "awe/red":
+		return NewAweRed(), true
+	case "awe_network_device_association/group":
+		return NewAweNetworkDeviceAssociationGroup(), true
+	case "awe_network_device_association/mesh_role":
+		return NewAweNetworkDeviceAssociationMeshRole(), true
+	case "aws/group":
+		return NewAwsGroup(), true
+	case "aws/instance_type":
+		return NewAwsInstanceType(), true
+	case "aws/region":
+		return NewAwsRegion(), true
+	case "awscli/group":
+		return NewAwscliGroup(), true
+	case "awscli/profile":
+		return NewAwscliProfile(), true
+	case "bgp/amazon_vpc":
+		return NewBgpAmazonVpc(), true
+	case "bgp/filter":
+		return NewBgpFilter(), true
+	case "bgp/group":
+		return NewBgpGroup(), true
+	case "bgp/neighbor":
+		return NewBgpNeighbor(), true
+	case "bgp/route_map":
+		return NewBgpRouteMap(), true
+	case "bgp/system":
+		return NewBgpSystem(), true
+	case "ca/crl":
+		return NewCaCrl(), true
+	case "ca/group":
+		return NewCaGroup(), true
+	case "ca/host_cert":
+		return NewCaHostCert(), true
+	case "ca/host_key_cert":
+		return NewCaHostKeyCert(), true
+	case "ca/http_verification_ca":
+		return NewCaHttpVerificationCa(), true
+	case "ca/meta_crl":
+		return NewCaMetaCrl(), true
+	case "ca/meta_x509":
+		return NewCaMetaX509(), true
+	case "ca/rsa":
+		return NewCaRsa(), true
+	case "ca/signing_ca":
+		return NewCaSigningCa(), true
+	case "ca/verification_ca":
+		return NewCaVerificationCa(), true
+	case "clientless_vpn/connection":
+		return NewClientlessVpnConnection(), true
+	case "clientless_vpn/group":
+		return NewClientlessVpnGroup(), true
+	case "condition/group":
+		return NewConditionGroup(), true
+	case "condition/objref":
+		return NewConditionObjref(), true
+	case "cron/at":
+		return NewCronAt(), true
+	case "cron/group":
+		return NewCronGroup(), true
+	case "dhcp/group":
+		return NewDhcpGroup(), true
+	case "dhcp/option":
+		return NewDhcpOption(), true
+	case "dhcp/option6":
+		return NewDhcpOption6(), true
+	case "dhcp/server":
+		return NewDhcpServer(), true
+	case "dhcp/server6":
+		return NewDhcpServer6(), true
+	case "dhcp/stateless":
+		return NewDhcpStateless(), true
+	case "dns/axfr":
+		return NewDnsAxfr(), true
+	case "dns/group":
+		return NewDnsGroup(), true
+	case "dns/route":
+		return NewDnsRoute(), true
+	case "dyndns/dyndns":
+		return NewDyndnsDyndns(), true
+	case "dyndns/group":
+		return NewDyndnsGroup(), true
+	case "emailpki/group":
+		return NewEmailpkiGroup(), true
+	case "emailpki/openpgp":
+		return NewEmailpkiOpenpgp(), true
+	case "emailpki/smime":
+		return NewEmailpkiSmime(), true
+	case "emailpki/user":
+		return NewEmailpkiUser(), true
+	case "epp/av_exception":
+		return NewEppAvException(), true
+	case "epp/av_policy":
+		return NewEppAvPolicy(), true
+	case "epp/dc_exception":
+		return NewEppDcException(), true
+	case "epp/dc_policy":
+		return NewEppDcPolicy(), true
+	case "epp/device":
+		return NewEppDevice(), true
+	case "epp/endpoint":
+		return NewEppEndpoint(), true
+	case "epp/endpoints_group":
+		return NewEppEndpointsGroup(), true
+	case "epp/group":
+		return NewEppGroup(), true
+	case "ftp/exception":
+		return NewFtpException(), true
+	case "ftp/group":
+		return NewFtpGroup(), true
+	case "geoip/dstexception":
+		return NewGeoipDstexception(), true
+	case "geoip/geoipgroup":
+		return NewGeoipGeoipgroup(), true
+	case "geoip/group":
+		return NewGeoipGroup(), true
+	case "geoip/srcexception":
+		return NewGeoipSrcexception(), true
+	case "hotspot/group":
+		return NewHotspotGroup(), true
+	case "hotspot/portal":
+		return NewHotspotPortal(), true
+	case "hotspot/voucher":
+		return NewHotspotVoucher(), true
+	case "http/cff_action":
+		return NewHttpCffAction(), true
+	case "http/cff_profile":
+		return NewHttpCffProfile(), true
+	case "http/device_auth":
+		return NewHttpDeviceAuth(), true
+	case "http/domain_regex":
+		return NewHttpDomainRegex(), true
+	case "http/exception":
+		return NewHttpException(), true
+	case "http/group":
+		return NewHttpGroup(), true
+	case "http/local_site":
+		return NewHttpLocalSite(), true
+	case "http/lsl_tag":
+		return NewHttpLslTag(), true
+	case "http/pac_file":
+		return NewHttpPacFile(), true
+	case "http/parent_proxy":
+		return NewHttpParentProxy(), true
+	case "http/profile":
+		return NewHttpProfile(), true
+	case "http/sp_category":
+		return NewHttpSpCategory(), true
+	case "http/sp_subcat":
+		return NewHttpSpSubcat(), true
+	case "interface/bridge":
+		return NewInterfaceBridge(), true
+	case "interface/ethernet":
+		return NewInterfaceEthernet(), true
+	case "interface/group":
+		return NewInterfaceGroup(), true
+	case "interface/ppp3g":
+		return NewInterfacePpp3G(), true
+	case "interface/pppmodem":
+		return NewInterfacePppmodem(), true
+	case "interface/pppoa":
+		return NewInterfacePppoa(), true
+	case "interface/pppoe":
+		return NewInterfacePppoe(), true
+	case "interface/tunnel":
+		return NewInterfaceTunnel(), true
+	case "interface/vlan":
+		return NewInterfaceVlan(), true
+	case "ipfix_connection/group":
+		return NewIpfixConnectionGroup(), true
+	case "ipfix_connection/ipfix_connection":
+		return NewIpfixConnectionIpfixConnection(), true
+	case "ips/exception":
+		return NewIpsException(), true
+	case "ips/group":
+		return NewIpsGroup(), true
+	case "ips/rule":
+		return NewIpsRule(), true
+	case "ips/rule_modifier":
+		return NewIpsRuleModifier(), true
+	case "ipsec/group":
+		return NewIpsecGroup(), true
+	case "ipsec/policy":
+		return NewIpsecPolicy(), true
+	case "ipsec/remote_gateway":
+		return NewIpsecRemoteGateway(), true
+	case "ipsec_connection/amazon_vpc":
+		return NewIpsecConnectionAmazonVpc(), true
+	case "ipsec_connection/group":
+		return NewIpsecConnectionGroup(), true
+	case "ipsec_connection/l2tp":
+		return NewIpsecConnectionL2Tp(), true
+	case "ipsec_connection/roadwarrior_ca":
+		return NewIpsecConnectionRoadwarriorCa(), true
+	case "ipsec_connection/roadwarrior_cisco":
+		return NewIpsecConnectionRoadwarriorCisco(), true
+	case "ipsec_connection/roadwarrior_psk":
+		return NewIpsecConnectionRoadwarriorPsk(), true
+	case "ipsec_connection/roadwarrior_x509":
+		return NewIpsecConnectionRoadwarriorX509(), true
+	case "ipsec_connection/site_to_site":
+		return NewIpsecConnectionSiteToSite(), true
+	case "ipsec_remote_auth/ca":
+		return NewIpsecRemoteAuthCa(), true
+	case "ipsec_remote_auth/group":
+		return NewIpsecRemoteAuthGroup(), true
+	case "ipsec_remote_auth/psk":
+		return NewIpsecRemoteAuthPsk(), true
+	case "ipsec_remote_auth/rsa":
+		return NewIpsecRemoteAuthRsa(), true
+	case "ipsec_remote_auth/x509":
+		return NewIpsecRemoteAuthX509(), true
+	case "itfhw/awe_network":
+		return NewItfhwAweNetwork(), true
+	case "itfhw/awe_network_group":
+		return NewItfhwAweNetworkGroup(), true
+	case "itfhw/bridge":
+		return NewItfhwBridge(), true
+	case "itfhw/ethernet":
+		return NewItfhwEthernet(), true
+	case "itfhw/group":
+		return NewItfhwGroup(), true
+	case "itfhw/lag":
+		return NewItfhwLag(), true
+	case "itfhw/red_client":
+		return NewItfhwRedClient(), true
+	case "itfhw/red_server":
+		return NewItfhwRedServer(), true
+	case "itfhw/serial":
+		return NewItfhwSerial(), true
+	case "itfhw/usbserial":
+		return NewItfhwUsbserial(), true
+	case "itfhw/virtual":
+		return NewItfhwVirtual(), true
+	case "itfparams/bridge_port":
+		return NewItfparamsBridgePort(), true
+	case "itfparams/group":
+		return NewItfparamsGroup(), true
+	case "itfparams/link_aggregation_group":
+		return NewItfparamsLinkAggregationGroup(), true
+	case "itfparams/primary":
+		return NewItfparamsPrimary(), true
+	case "itfparams/secondary":
+		return NewItfparamsSecondary(), true
+	case "mac_list/group":
+		return NewMacListGroup(), true
+	case "mac_list/mac_list":
+		return NewMacListMacList(), true
+	case "network/aaa":
+		return NewNetworkAaa(), true
+	case "network/any":
+		return NewNetworkAny(), true
+	case "network/availability_group":
+		return NewNetworkAvailabilityGroup(), true
+	case "network/dns_group":
+		return NewNetworkDnsGroup(), true
+	case "network/dns_host":
+		return NewNetworkDnsHost(), true
+	case "network/group":
+		return NewNetworkGroup(), true
+	case "network/host":
+		return NewNetworkHost(), true
+	case "network/interface_address":
+		return NewNetworkInterfaceAddress(), true
+	case "network/interface_broadcast":
+		return NewNetworkInterfaceBroadcast(), true
+	case "network/interface_network":
+		return NewNetworkInterfaceNetwork(), true
+	case "network/multicast":
+		return NewNetworkMulticast(), true
+	case "network/network":
+		return NewNetworkNetwork(), true
+	case "network/range":
+		return NewNetworkRange(), true
+	case "notification/group":
+		return NewNotificationGroup(), true
+	case "notification/notification":
+		return NewNotificationNotification(), true
+	case "ospf/area":
+		return NewOspfArea(), true
+	case "ospf/group":
+		return NewOspfGroup(), true
+	case "ospf/interface":
+		return NewOspfInterface(), true
+	case "ospf/message_digest_key":
+		return NewOspfMessageDigestKey(), true
+	case "override/group":
+		return NewOverrideGroup(), true
+	case "override/objref":
+		return NewOverrideObjref(), true
+	case "packetfilter/1to1nat":
+		return NewPacketfilter1to1Nat(), true
+	case "packetfilter/generic_proxy":
+		return NewPacketfilterGenericProxy(), true
+	case "packetfilter/group":
+		return NewPacketfilterGroup(), true
+	case "packetfilter/loadbalance":
+		return NewPacketfilterLoadbalance(), true
+	case "packetfilter/mangle":
+		return NewPacketfilterMangle(), true
+	case "packetfilter/masq":
+		return NewPacketfilterMasq(), true
+	case "packetfilter/nat":
+		return NewPacketfilterNat(), true
+	case "packetfilter/packetfilter":
+		return NewPacketfilterPacketfilter(), true
+	case "packetfilter/ruleset":
+		return NewPacketfilterRuleset(), true
+	case "pim_sm/group":
+		return NewPimSmGroup(), true
+	case "pim_sm/interface":
+		return NewPimSmInterface(), true
+	case "pim_sm/route":
+		return NewPimSmRoute(), true
+	case "pim_sm/rp_router":
+		return NewPimSmRpRouter(), true
+	case "pop3/account":
+		return NewPop3Account(), true
+	case "pop3/exception":
+		return NewPop3Exception(), true
+	case "pop3/group":
+		return NewPop3Group(), true
+	case "pop3/server":
+		return NewPop3Server(), true
+	case "qos/application_selector":
+		return NewQosApplicationSelector(), true
+	case "qos/group":
+		return NewQosGroup(), true
+	case "qos/ingress_rule":
+		return NewQosIngressRule(), true
+	case "qos/interface":
+		return NewQosInterface(), true
+	case "qos/rule":
+		return NewQosRule(), true
+	case "qos/traffic_selector":
+		return NewQosTrafficSelector(), true
+	case "qos/traffic_selector_group":
+		return NewQosTrafficSelectorGroup(), true
+	case "remote_syslog/group":
+		return NewRemoteSyslogGroup(), true
+	case "remote_syslog/server":
+		return NewRemoteSyslogServer(), true
+	case "reporting/department":
+		return NewReportingDepartment(), true
+	case "reporting/filter":
+		return NewReportingFilter(), true
+	case "reporting/group":
+		return NewReportingGroup(), true
+	case "reporting/mail":
+		return NewReportingMail(), true
+	case "reverse_proxy/auth_profile":
+		return NewReverseProxyAuthProfile(), true
+	case "reverse_proxy/backend":
+		return NewReverseProxyBackend(), true
+	case "reverse_proxy/exception":
+		return NewReverseProxyException(), true
+	case "reverse_proxy/filter":
+		return NewReverseProxyFilter(), true
+	case "reverse_proxy/form_template":
+		return NewReverseProxyFormTemplate(), true
+	case "reverse_proxy/frontend":
+		return NewReverseProxyFrontend(), true
+	case "reverse_proxy/group":
+		return NewReverseProxyGroup(), true
+	case "reverse_proxy/location":
+		return NewReverseProxyLocation(), true
+	case "reverse_proxy/profile":
+		return NewReverseProxyProfile(), true
+	case "reverse_proxy/redirection":
+		return NewReverseProxyRedirection(), true
+	case "reverse_proxy/threats_filter":
+		return NewReverseProxyThreatsFilter(), true
+	case "right/group":
+		return NewRightGroup(), true
+	case "right/right":
+		return NewRightRight(), true
+	case "role/group":
+		return NewRoleGroup(), true
+	case "role/role":
+		return NewRoleRole(), true
+	case "route/group":
+		return NewRouteGroup(), true
+	case "route/policy":
+		return NewRoutePolicy(), true
+	case "route/static":
+		return NewRouteStatic(), true
+	case "scheduler/group":
+		return NewSchedulerGroup(), true
+	case "scheduler/loadbalance":
+		return NewSchedulerLoadbalance(), true
+	case "scheduler/rule":
+		return NewSchedulerRule(), true
+	case "service/ah":
+		return NewServiceAh(), true
+	case "service/any":
+		return NewServiceAny(), true
+	case "service/esp":
+		return NewServiceEsp(), true
+	case "service/group":
+		return NewServiceGroup(), true
+	case "service/icmp":
+		return NewServiceIcmp(), true
+	case "service/icmpv6":
+		return NewServiceIcmpv6(), true
+	case "service/ip":
+		return NewServiceIp(), true
+	case "service/tcp":
+		return NewServiceTcp(), true
+	case "service/tcpudp":
+		return NewServiceTcpudp(), true
+	case "service/udp":
+		return NewServiceUdp(), true
+	case "smtp/exception":
+		return NewSmtpException(), true
+	case "smtp/group":
+		return NewSmtpGroup(), true
+	case "smtp/header_operation":
+		return NewSmtpHeaderOperation(), true
+	case "smtp/profile":
+		return NewSmtpProfile(), true
+	case "snmp/group":
+		return NewSnmpGroup(), true
+	case "snmp/trap":
+		return NewSnmpTrap(), true
+	case "spx/group":
+		return NewSpxGroup(), true
+	case "spx/template":
+		return NewSpxTemplate(), true
+	case "ssl_vpn/client_connection":
+		return NewSslVpnClientConnection(), true
+	case "ssl_vpn/group":
+		return NewSslVpnGroup(), true
+	case "ssl_vpn/remote_access_profile":
+		return NewSslVpnRemoteAccessProfile(), true
+	case "ssl_vpn/server_connection":
+		return NewSslVpnServerConnection(), true
+	case "stas/collector":
+		return NewStasCollector(), true
+	case "stas/group":
+		return NewStasGroup(), true
+	case "time/group":
+		return NewTimeGroup(), true
+	case "time/recurring":
+		return NewTimeRecurring(), true
+	case "time/single":
+		return NewTimeSingle(), true
+	case "user_preferences/group":
+		return NewUserPreferencesGroup(), true
+	case "user_preferences/webadmin":
+		return NewUserPreferencesWebadmin(), true
+	}
+	return nil, false
+}
@@ -9,18 +9,18 @@ import (
 // Epp is a generated struct representing the Sophos Epp Endpoint
 // GET /api/nodes/epp
 type Epp struct {
-	AllowedNetworks       []string      `json:"allowed_networks"`
-	Certificate           string        `json:"certificate"`
-	City                  string        `json:"city"`
-	Country               string        `json:"country"`
-	DefaultEndpointsGroup string        `json:"default_endpoints_group"`
-	Devices               []interface{} `json:"devices"`
-	Email                 string        `json:"email"`
-	Endpoints             []interface{} `json:"endpoints"`
-	EndpointsGroups       []string      `json:"endpoints_groups"`
+	AllowedNetworks       NetworkRefs `json:"allowed_networks"`
+	Certificate           string      `json:"certificate"`
+	City                  string      `json:"city"`
+	Country               string      `json:"country"`
+	DefaultEndpointsGroup string      `json:"default_endpoints_group"`
+	Devices               []string    `json:"devices"`
+	Email                 string      `json:"email"`
+	Endpoints             []string    `json:"endpoints"`
+	EndpointsGroups       []string    `json:"endpoints_groups"`
 	Exceptions            struct {
-		Av []interface{} `json:"av"`
-		Dc []interface{} `json:"dc"`
+		Av []string `json:"av"`
+		Dc []string `json:"dc"`
 	} `json:"exceptions"`
 	FallbackURL       string `json:"fallback_url"`
 	MagnetPassword    string `json:"magnet_password"`
@@ -28,7 +28,7 @@ type Epp struct {
 	Organization      string `json:"organization"`
 	ParentProxyHost   string `json:"parent_proxy_host"`
 	ParentProxyPort   int64  `json:"parent_proxy_port"`
-	ParentProxyStatus int64  `json:"parent_proxy_status"`
+	ParentProxyStatus bool   `json:"parent_proxy_status"`
 	Policies          struct {
 		Av []string `json:"av"`
 		Dc []string `json:"dc"`
@@ -37,11 +37,11 @@ type Epp struct {
 	PrivateKey        string `json:"private_key"`
 	RegistrationToken string `json:"registration_token"`
 	Status            struct {
-		Av     int64 `json:"av"`
-		Broker int64 `json:"broker"`
-		Dc     int64 `json:"dc"`
-		Epp    int64 `json:"epp"`
-		Wc     int64 `json:"wc"`
+		Av     bool `json:"av"`
+		Broker bool `json:"broker"`
+		Dc     bool `json:"dc"`
+		Epp    bool `json:"epp"`
+		Wc     bool `json:"wc"`
 	} `json:"status"`
 	TamperPassword string `json:"tamper_password"`
 	Version        string `json:"version"`
@@ -50,7 +50,7 @@ type Epp struct {
 
 var _ sophos.Endpoint = &Epp{}
 
-var defsEpp = map[string]sophos.RestObject{
+var defsEpp = map[string]sophos.RestGetter{
 	"EppAvException":    &EppAvException{},
 	"EppAvPolicy":       &EppAvPolicy{},
 	"EppDcException":    &EppDcException{},
@@ -62,7 +62,7 @@ var defsEpp = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Epp's Objects
-func (Epp) RestObjects() map[string]sophos.RestObject { return defsEpp }
+func (Epp) RestObjects() map[string]sophos.RestGetter { return defsEpp }
 
 // GetPath implements sophos.RestGetter
 func (*Epp) GetPath() string { return "/api/nodes/epp" }
@@ -120,90 +120,158 @@ func (Epp) References() []string {
 	}
 }
 
-// EppAvExceptions is an Sophos Endpoint subType and implements sophos.RestObject
+// EppAvExceptions is an Sophos Endpoint subType and implements sophos.RestGetter
 type EppAvExceptions []EppAvException
 
 // EppAvException represents a UTM antivirus exception
 type EppAvException struct {
-	Locked          string        `json:"_locked"`
-	ObjectType      string        `json:"_type"`
-	Reference       string        `json:"_ref"`
-	EndpointsGroups []interface{} `json:"endpoints_groups"`
-	HipsName        string        `json:"hips_name"`
+	Locked     string `json:"_locked"`
+	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
+	// Checksum default value is ""
+	Checksum        string   `json:"checksum"`
+	Comment         string   `json:"comment"`
+	EndpointsGroups []string `json:"endpoints_groups"`
+	HipsName        string   `json:"hips_name"`
 	// IpAddress description: (IPADDR)
 	// IpAddress default value is ""
-	IpAddress string `json:"ip_address"`
+	IpAddress     string `json:"ip_address"`
+	IpAddressMask int64  `json:"ip_address_mask"`
+	Name          string `json:"name"`
 	// Timeline default value is ""
 	Timeline string `json:"timeline"`
 	// Type can be one of: []string{"adware_pua", "scanning_exclusions", "scanning_extensions", "buffer_overflow", "suspicious_files", "suspicious_behaviours", "websites"}
 	// Type default value is "websites"
-	Type string `json:"type"`
-	// Checksum default value is ""
-	Checksum      string `json:"checksum"`
-	Comment       string `json:"comment"`
-	IpAddressMask int    `json:"ip_address_mask"`
-	Name          string `json:"name"`
+	Type EppAvExceptionType `json:"type"`
 	// WebFormat can be one of: []string{"domain_name", "ip_address", "ip_address_mask"}
 	// WebFormat default value is "domain_name"
-	WebFormat string `json:"web_format"`
+	WebFormat EppAvExceptionWebFormat `json:"web_format"`
+}
+
+// EppAvExceptionType is the Type of a EppAvException
+type EppAvExceptionType string
+
+// Known values of EppAvExceptionType
+const (
+	EppAvExceptionTypeAdwarePua            EppAvExceptionType = "adware_pua"
+	EppAvExceptionTypeScanningExclusions   EppAvExceptionType = "scanning_exclusions"
+	EppAvExceptionTypeScanningExtensions   EppAvExceptionType = "scanning_extensions"
+	EppAvExceptionTypeBufferOverflow       EppAvExceptionType = "buffer_overflow"
+	EppAvExceptionTypeSuspiciousFiles      EppAvExceptionType = "suspicious_files"
+	EppAvExceptionTypeSuspiciousBehaviours EppAvExceptionType = "suspicious_behaviours"
+	EppAvExceptionTypeWebsites             EppAvExceptionType = "websites"
+)
+
+// Valid returns true if the value is a known EppAvExceptionType
+func (v EppAvExceptionType) Valid() bool {
+	switch v {
+	case EppAvExceptionTypeAdwarePua, EppAvExceptionTypeScanningExclusions, EppAvExceptionTypeScanningExtensions, EppAvExceptionTypeBufferOverflow, EppAvExceptionTypeSuspiciousFiles, EppAvExceptionTypeSuspiciousBehaviours, EppAvExceptionTypeWebsites:
+		return true
+	}
+	return false
+}
+
+// EppAvExceptionWebFormat is the WebFormat of a EppAvException
+type EppAvExceptionWebFormat string
+
+// Known values of EppAvExceptionWebFormat
+const (
+	EppAvExceptionWebFormatDomainName    EppAvExceptionWebFormat = "domain_name"
+	EppAvExceptionWebFormatIpAddress     EppAvExceptionWebFormat = "ip_address"
+	EppAvExceptionWebFormatIpAddressMask EppAvExceptionWebFormat = "ip_address_mask"
+)
+
+// Valid returns true if the value is a known EppAvExceptionWebFormat
+func (v EppAvExceptionWebFormat) Valid() bool {
+	switch v {
+	case EppAvExceptionWebFormatDomainName, EppAvExceptionWebFormatIpAddress, EppAvExceptionWebFormatIpAddressMask:
+		return true
+	}
+	return false
+}
+
+// NewEppAvException returns a EppAvException with the default values of its swagger definition
+func NewEppAvException() *EppAvException {
+	return &EppAvException{
+		ObjectType: "epp/av_exception",
+		Type:       EppAvExceptionTypeWebsites,
+		WebFormat:  EppAvExceptionWebFormatDomainName,
+	}
+}
+
+// Validate checks the EppAvException before it is sent, see ValidateWith
+func (e *EppAvException) Validate() error { return e.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the EppAvException.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (e *EppAvException) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("EppAvException", typeOf)
+	check.Format("ip_address", sophos.FormatIPv4, e.IpAddress)
+	check.Required("name", e.Name)
+	check.Enum("type", string(e.Type), e.Type.Valid())
+	check.Enum("web_format", string(e.WebFormat), e.WebFormat.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &EppAvException{}
 
-// GetPath implements sophos.RestObject and returns the EppAvExceptions GET path
+// GetPath implements sophos.RestGetter and returns the EppAvExceptions GET path
 // Returns all available epp/av_exception objects
 func (*EppAvExceptions) GetPath() string { return "/api/objects/epp/av_exception/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*EppAvExceptions) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the EppAvExceptions GET path
+// GetPath implements sophos.RestGetter and returns the EppAvExceptions GET path
 // Returns all available av_exception types
 func (e *EppAvException) GetPath() string {
 	return fmt.Sprintf("/api/objects/epp/av_exception/%s", e.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (e *EppAvException) RefRequired() (string, bool) { return e.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the EppAvException DELETE path
+// DeletePath implements sophos.Deletable and returns the EppAvException DELETE path
 // Creates or updates the complete object av_exception
 func (*EppAvException) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/av_exception/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the EppAvException PATCH path
+// PatchPath implements sophos.Patchable and returns the EppAvException PATCH path
 // Changes to parts of the object av_exception types
 func (*EppAvException) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/av_exception/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the EppAvException POST path
+// PostPath implements sophos.Creatable and returns the EppAvException POST path
 // Create a new epp/av_exception object
 func (*EppAvException) PostPath() string {
 	return "/api/objects/epp/av_exception/"
 }
 
-// PutPath implements sophos.RestObject and returns the EppAvException PUT path
+// PutPath implements sophos.Updatable and returns the EppAvException PUT path
 // Creates or updates the complete object av_exception
 func (*EppAvException) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/av_exception/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*EppAvException) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/av_exception/%s/usedby", ref)
 }
 
-// EppAvPolicys is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (e *EppAvException) GetType() string { return e.ObjectType }
+
+// EppAvPolicys is an Sophos Endpoint subType and implements sophos.RestGetter
 type EppAvPolicys []EppAvPolicy
 
-// EppAvPolicy is a generated Sophos object
+// EppAvPolicy represents a UTM epp/av_policy object
 type EppAvPolicy struct {
 	Locked                    string `json:"_locked"`
-	Reference                 string `json:"_ref"`
 	ObjectType                string `json:"_type"`
+	Reference                 string `json:"_ref"`
 	AlertOnly                 bool   `json:"alert_only"`
 	AutoCleanup               bool   `json:"auto_cleanup"`
 	BlockMaliciousSites       bool   `json:"block_malicious_sites"`
@@ -231,49 +299,67 @@ type EppAvPolicy struct {
 	WebProtection             bool   `json:"web_protection"`
 }
 
+// NewEppAvPolicy returns a EppAvPolicy with the default values of its swagger definition
+func NewEppAvPolicy() *EppAvPolicy {
+	return &EppAvPolicy{
+		ObjectType: "epp/av_policy",
+	}
+}
+
+// Validate checks the EppAvPolicy before it is sent, see ValidateWith
+func (e *EppAvPolicy) Validate() error { return e.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the EppAvPolicy.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (e *EppAvPolicy) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("EppAvPolicy", typeOf)
+	check.Required("name", e.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &EppAvPolicy{}
 
-// GetPath implements sophos.RestObject and returns the EppAvPolicys GET path
+// GetPath implements sophos.RestGetter and returns the EppAvPolicys GET path
 // Returns all available epp/av_policy objects
 func (*EppAvPolicys) GetPath() string { return "/api/objects/epp/av_policy/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*EppAvPolicys) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the EppAvPolicys GET path
+// GetPath implements sophos.RestGetter and returns the EppAvPolicys GET path
 // Returns all available av_policy types
 func (e *EppAvPolicy) GetPath() string {
 	return fmt.Sprintf("/api/objects/epp/av_policy/%s", e.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (e *EppAvPolicy) RefRequired() (string, bool) { return e.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the EppAvPolicy DELETE path
+// DeletePath implements sophos.Deletable and returns the EppAvPolicy DELETE path
 // Creates or updates the complete object av_policy
 func (*EppAvPolicy) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/av_policy/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the EppAvPolicy PATCH path
+// PatchPath implements sophos.Patchable and returns the EppAvPolicy PATCH path
 // Changes to parts of the object av_policy types
 func (*EppAvPolicy) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/av_policy/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the EppAvPolicy POST path
+// PostPath implements sophos.Creatable and returns the EppAvPolicy POST path
 // Create a new epp/av_policy object
 func (*EppAvPolicy) PostPath() string {
 	return "/api/objects/epp/av_policy/"
 }
 
-// PutPath implements sophos.RestObject and returns the EppAvPolicy PUT path
+// PutPath implements sophos.Updatable and returns the EppAvPolicy PUT path
 // Creates or updates the complete object av_policy
 func (*EppAvPolicy) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/av_policy/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*EppAvPolicy) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/av_policy/%s/usedby", ref)
@@ -282,80 +368,127 @@ func (*EppAvPolicy) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (e *EppAvPolicy) GetType() string { return e.ObjectType }
 
-// EppDcExceptions is an Sophos Endpoint subType and implements sophos.RestObject
+// EppDcExceptions is an Sophos Endpoint subType and implements sophos.RestGetter
 type EppDcExceptions []EppDcException
 
 // EppDcException represents a UTM device exception
 type EppDcException struct {
-	Locked                       string        `json:"_locked"`
-	ObjectType                   string        `json:"_type"`
-	Reference                    string        `json:"_ref"`
-	CustomBlockedEndpointsGroups []interface{} `json:"custom_blocked_endpoints_groups"`
-	DeviceId                     string        `json:"device_id"`
+	Locked                       string   `json:"_locked"`
+	ObjectType                   string   `json:"_type"`
+	Reference                    string   `json:"_ref"`
+	AllowedEndpointsGroups       []string `json:"allowed_endpoints_groups"`
+	Comment                      string   `json:"comment"`
+	CustomBlockedEndpointsGroups []string `json:"custom_blocked_endpoints_groups"`
+	DeviceId                     string   `json:"device_id"`
 	// DeviceType can be one of: []string{"floppy_drive", "optical_drive", "removable_storage", "encrypted_storage", "modem", "wireless", "bluetooth", "infrared"}
 	// DeviceType default value is "removable_storage"
-	DeviceType             string        `json:"device_type"`
-	Name                   string        `json:"name"`
-	AllowedEndpointsGroups []interface{} `json:"allowed_endpoints_groups"`
-	Comment                string        `json:"comment"`
+	DeviceType EppDcExceptionDeviceType `json:"device_type"`
+	Name       string                   `json:"name"`
+}
+
+// EppDcExceptionDeviceType is the DeviceType of a EppDcException
+type EppDcExceptionDeviceType string
+
+// Known values of EppDcExceptionDeviceType
+const (
+	EppDcExceptionDeviceTypeFloppyDrive      EppDcExceptionDeviceType = "floppy_drive"
+	EppDcExceptionDeviceTypeOpticalDrive     EppDcExceptionDeviceType = "optical_drive"
+	EppDcExceptionDeviceTypeRemovableStorage EppDcExceptionDeviceType = "removable_storage"
+	EppDcExceptionDeviceTypeEncryptedStorage EppDcExceptionDeviceType = "encrypted_storage"
+	EppDcExceptionDeviceTypeModem            EppDcExceptionDeviceType = "modem"
+	EppDcExceptionDeviceTypeWireless         EppDcExceptionDeviceType = "wireless"
+	EppDcExceptionDeviceTypeBluetooth        EppDcExceptionDeviceType = "bluetooth"
+	EppDcExceptionDeviceTypeInfrared         EppDcExceptionDeviceType = "infrared"
+)
+
+// Valid returns true if the value is a known EppDcExceptionDeviceType
+func (v EppDcExceptionDeviceType) Valid() bool {
+	switch v {
+	case EppDcExceptionDeviceTypeFloppyDrive, EppDcExceptionDeviceTypeOpticalDrive, EppDcExceptionDeviceTypeRemovableStorage, EppDcExceptionDeviceTypeEncryptedStorage, EppDcExceptionDeviceTypeModem, EppDcExceptionDeviceTypeWireless, EppDcExceptionDeviceTypeBluetooth, EppDcExceptionDeviceTypeInfrared:
+		return true
+	}
+	return false
+}
+
+// NewEppDcException returns a EppDcException with the default values of its swagger definition
+func NewEppDcException() *EppDcException {
+	return &EppDcException{
+		ObjectType: "epp/dc_exception",
+		DeviceType: EppDcExceptionDeviceTypeRemovableStorage,
+	}
+}
+
+// Validate checks the EppDcException before it is sent, see ValidateWith
+func (e *EppDcException) Validate() error { return e.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the EppDcException.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (e *EppDcException) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("EppDcException", typeOf)
+	check.Enum("device_type", string(e.DeviceType), e.DeviceType.Valid())
+	check.Required("name", e.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &EppDcException{}
 
-// GetPath implements sophos.RestObject and returns the EppDcExceptions GET path
+// GetPath implements sophos.RestGetter and returns the EppDcExceptions GET path
 // Returns all available epp/dc_exception objects
 func (*EppDcExceptions) GetPath() string { return "/api/objects/epp/dc_exception/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*EppDcExceptions) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the EppDcExceptions GET path
+// GetPath implements sophos.RestGetter and returns the EppDcExceptions GET path
 // Returns all available dc_exception types
 func (e *EppDcException) GetPath() string {
 	return fmt.Sprintf("/api/objects/epp/dc_exception/%s", e.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (e *EppDcException) RefRequired() (string, bool) { return e.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the EppDcException DELETE path
+// DeletePath implements sophos.Deletable and returns the EppDcException DELETE path
 // Creates or updates the complete object dc_exception
 func (*EppDcException) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/dc_exception/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the EppDcException PATCH path
+// PatchPath implements sophos.Patchable and returns the EppDcException PATCH path
 // Changes to parts of the object dc_exception types
 func (*EppDcException) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/dc_exception/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the EppDcException POST path
+// PostPath implements sophos.Creatable and returns the EppDcException POST path
 // Create a new epp/dc_exception object
 func (*EppDcException) PostPath() string {
 	return "/api/objects/epp/dc_exception/"
 }
 
-// PutPath implements sophos.RestObject and returns the EppDcException PUT path
+// PutPath implements sophos.Updatable and returns the EppDcException PUT path
 // Creates or updates the complete object dc_exception
 func (*EppDcException) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/dc_exception/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*EppDcException) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/dc_exception/%s/usedby", ref)
 }
 
-// EppDcPolicys is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (e *EppDcException) GetType() string { return e.ObjectType }
+
+// EppDcPolicys is an Sophos Endpoint subType and implements sophos.RestGetter
 type EppDcPolicys []EppDcPolicy
 
-// EppDcPolicy is a generated Sophos object
+// EppDcPolicy represents a UTM epp/dc_policy object
 type EppDcPolicy struct {
 	Locked           string `json:"_locked"`
-	Reference        string `json:"_ref"`
 	ObjectType       string `json:"_type"`
+	Reference        string `json:"_ref"`
 	Bluetooth        string `json:"bluetooth"`
 	Comment          string `json:"comment"`
 	EncryptedStorage string `json:"encrypted_storage"`
@@ -368,49 +501,67 @@ type EppDcPolicy struct {
 	Wireless         string `json:"wireless"`
 }
 
+// NewEppDcPolicy returns a EppDcPolicy with the default values of its swagger definition
+func NewEppDcPolicy() *EppDcPolicy {
+	return &EppDcPolicy{
+		ObjectType: "epp/dc_policy",
+	}
+}
+
+// Validate checks the EppDcPolicy before it is sent, see ValidateWith
+func (e *EppDcPolicy) Validate() error { return e.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the EppDcPolicy.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (e *EppDcPolicy) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("EppDcPolicy", typeOf)
+	check.Required("name", e.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &EppDcPolicy{}
 
-// GetPath implements sophos.RestObject and returns the EppDcPolicys GET path
+// GetPath implements sophos.RestGetter and returns the EppDcPolicys GET path
 // Returns all available epp/dc_policy objects
 func (*EppDcPolicys) GetPath() string { return "/api/objects/epp/dc_policy/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*EppDcPolicys) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the EppDcPolicys GET path
+// GetPath implements sophos.RestGetter and returns the EppDcPolicys GET path
 // Returns all available dc_policy types
 func (e *EppDcPolicy) GetPath() string {
 	return fmt.Sprintf("/api/objects/epp/dc_policy/%s", e.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (e *EppDcPolicy) RefRequired() (string, bool) { return e.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the EppDcPolicy DELETE path
+// DeletePath implements sophos.Deletable and returns the EppDcPolicy DELETE path
 // Creates or updates the complete object dc_policy
 func (*EppDcPolicy) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/dc_policy/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the EppDcPolicy PATCH path
+// PatchPath implements sophos.Patchable and returns the EppDcPolicy PATCH path
 // Changes to parts of the object dc_policy types
 func (*EppDcPolicy) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/dc_policy/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the EppDcPolicy POST path
+// PostPath implements sophos.Creatable and returns the EppDcPolicy POST path
 // Create a new epp/dc_policy object
 func (*EppDcPolicy) PostPath() string {
 	return "/api/objects/epp/dc_policy/"
 }
 
-// PutPath implements sophos.RestObject and returns the EppDcPolicy PUT path
+// PutPath implements sophos.Updatable and returns the EppDcPolicy PUT path
 // Creates or updates the complete object dc_policy
 func (*EppDcPolicy) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/dc_policy/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*EppDcPolicy) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/dc_policy/%s/usedby", ref)
@@ -419,80 +570,128 @@ func (*EppDcPolicy) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (e *EppDcPolicy) GetType() string { return e.ObjectType }
 
-// EppDevices is an Sophos Endpoint subType and implements sophos.RestObject
+// EppDevices is an Sophos Endpoint subType and implements sophos.RestGetter
 type EppDevices []EppDevice
 
 // EppDevice represents a UTM device
 type EppDevice struct {
-	Locked     string `json:"_locked"`
-	ObjectType string `json:"_type"`
-	Reference  string `json:"_ref"`
-	// ProductName default value is ""
-	ProductName                  string        `json:"product_name"`
-	AllowedEndpointsGroups       []interface{} `json:"allowed_endpoints_groups"`
-	CustomBlockedEndpointsGroups []interface{} `json:"custom_blocked_endpoints_groups"`
-	DeviceId                     string        `json:"device_id"`
+	Locked                       string   `json:"_locked"`
+	ObjectType                   string   `json:"_type"`
+	Reference                    string   `json:"_ref"`
+	AllowedEndpointsGroups       []string `json:"allowed_endpoints_groups"`
+	Comment                      string   `json:"comment"`
+	CustomBlockedEndpointsGroups []string `json:"custom_blocked_endpoints_groups"`
+	DeviceId                     string   `json:"device_id"`
+	// DeviceType can be one of: []string{"floppy_drive", "optical_drive", "removable_storage", "encrypted_storage", "modem", "wireless", "bluetooth", "infrared"}
+	// DeviceType default value is "removable_storage"
+	DeviceType EppDeviceDeviceType `json:"device_type"`
 	// GenericFlag default value is false
 	GenericFlag bool `json:"generic_flag"`
+	// InstanceId default value is ""
+	InstanceId string `json:"instance_id"`
 	// LastEndpoint description: REF(epp/endpoint)
 	// LastEndpoint default value is ""
-	LastEndpoint string `json:"last_endpoint"`
+	LastEndpoint EppRef `json:"last_endpoint"`
 	Name         string `json:"name"`
-	Comment      string `json:"comment"`
-	// DeviceType can be one of: []string{"floppy_drive", "optical_drive", "removable_storage", "encrypted_storage", "modem", "wireless", "bluetooth", "infrared"}
-	// DeviceType default value is "removable_storage"
-	DeviceType string `json:"device_type"`
-	// InstanceId default value is ""
-	InstanceId string `json:"instance_id"`
+	// ProductName default value is ""
+	ProductName string `json:"product_name"`
+}
+
+// EppDeviceDeviceType is the DeviceType of a EppDevice
+type EppDeviceDeviceType string
+
+// Known values of EppDeviceDeviceType
+const (
+	EppDeviceDeviceTypeFloppyDrive      EppDeviceDeviceType = "floppy_drive"
+	EppDeviceDeviceTypeOpticalDrive     EppDeviceDeviceType = "optical_drive"
+	EppDeviceDeviceTypeRemovableStorage EppDeviceDeviceType = "removable_storage"
+	EppDeviceDeviceTypeEncryptedStorage EppDeviceDeviceType = "encrypted_storage"
+	EppDeviceDeviceTypeModem            EppDeviceDeviceType = "modem"
+	EppDeviceDeviceTypeWireless         EppDeviceDeviceType = "wireless"
+	EppDeviceDeviceTypeBluetooth        EppDeviceDeviceType = "bluetooth"
+	EppDeviceDeviceTypeInfrared         EppDeviceDeviceType = "infrared"
+)
+
+// Valid returns true if the value is a known EppDeviceDeviceType
+func (v EppDeviceDeviceType) Valid() bool {
+	switch v {
+	case EppDeviceDeviceTypeFloppyDrive, EppDeviceDeviceTypeOpticalDrive, EppDeviceDeviceTypeRemovableStorage, EppDeviceDeviceTypeEncryptedStorage, EppDeviceDeviceTypeModem, EppDeviceDeviceTypeWireless, EppDeviceDeviceTypeBluetooth, EppDeviceDeviceTypeInfrared:
+		return true
+	}
+	return false
+}
+
+// NewEppDevice returns a EppDevice with the default values of its swagger definition
+func NewEppDevice() *EppDevice {
+	return &EppDevice{
+		ObjectType: "epp/device",
+		DeviceType: EppDeviceDeviceTypeRemovableStorage,
+	}
+}
+
+// Validate checks the EppDevice before it is sent, see ValidateWith
+func (e *EppDevice) Validate() error { return e.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the EppDevice.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (e *EppDevice) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("EppDevice", typeOf)
+	check.Enum("device_type", string(e.DeviceType), e.DeviceType.Valid())
+	check.Ref("last_endpoint", string(e.LastEndpoint), "REF(epp/endpoint)")
+	check.Required("name", e.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &EppDevice{}
 
-// GetPath implements sophos.RestObject and returns the EppDevices GET path
+// GetPath implements sophos.RestGetter and returns the EppDevices GET path
 // Returns all available epp/device objects
 func (*EppDevices) GetPath() string { return "/api/objects/epp/device/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*EppDevices) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the EppDevices GET path
+// GetPath implements sophos.RestGetter and returns the EppDevices GET path
 // Returns all available device types
 func (e *EppDevice) GetPath() string { return fmt.Sprintf("/api/objects/epp/device/%s", e.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (e *EppDevice) RefRequired() (string, bool) { return e.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the EppDevice DELETE path
+// DeletePath implements sophos.Deletable and returns the EppDevice DELETE path
 // Creates or updates the complete object device
 func (*EppDevice) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/device/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the EppDevice PATCH path
+// PatchPath implements sophos.Patchable and returns the EppDevice PATCH path
 // Changes to parts of the object device types
 func (*EppDevice) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/device/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the EppDevice POST path
+// PostPath implements sophos.Creatable and returns the EppDevice POST path
 // Create a new epp/device object
 func (*EppDevice) PostPath() string {
 	return "/api/objects/epp/device/"
 }
 
-// PutPath implements sophos.RestObject and returns the EppDevice PUT path
+// PutPath implements sophos.Updatable and returns the EppDevice PUT path
 // Creates or updates the complete object device
 func (*EppDevice) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/device/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*EppDevice) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/device/%s/usedby", ref)
 }
 
-// EppEndpoints is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (e *EppDevice) GetType() string { return e.ObjectType }
+
+// EppEndpoints is an Sophos Endpoint subType and implements sophos.RestGetter
 type EppEndpoints []EppEndpoint
 
 // EppEndpoint represents a UTM computer
@@ -500,140 +699,201 @@ type EppEndpoint struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// Os default value is ""
-	Os string `json:"os"`
-	// SavVersion default value is ""
-	SavVersion string `json:"sav_version"`
-	// TamperProtection default value is false
-	TamperProtection bool `json:"tamper_protection"`
-	// McsId default value is ""
-	McsId string `json:"mcs_id"`
-	Name  string `json:"name"`
-	// SavStatus default value is false
-	SavStatus bool `json:"sav_status"`
 	// Accepted default value is false
 	Accepted bool   `json:"accepted"`
 	Comment  string `json:"comment"`
 	// EndpointType can be one of: []string{"laptop", "desktop", "server"}
 	// EndpointType default value is "desktop"
-	EndpointType string `json:"endpoint_type"`
+	EndpointType EppEndpointEndpointType `json:"endpoint_type"`
 	// InventoryNumber default value is ""
 	InventoryNumber string `json:"inventory_number"`
+	// McsId default value is ""
+	McsId string `json:"mcs_id"`
+	Name  string `json:"name"`
+	// Os default value is ""
+	Os string `json:"os"`
+	// SavStatus default value is false
+	SavStatus bool `json:"sav_status"`
+	// SavVersion default value is ""
+	SavVersion string `json:"sav_version"`
+	// TamperProtection default value is false
+	TamperProtection bool `json:"tamper_protection"`
+}
+
+// EppEndpointEndpointType is the EndpointType of a EppEndpoint
+type EppEndpointEndpointType string
+
+// Known values of EppEndpointEndpointType
+const (
+	EppEndpointEndpointTypeLaptop  EppEndpointEndpointType = "laptop"
+	EppEndpointEndpointTypeDesktop EppEndpointEndpointType = "desktop"
+	EppEndpointEndpointTypeServer  EppEndpointEndpointType = "server"
+)
+
+// Valid returns true if the value is a known EppEndpointEndpointType
+func (v EppEndpointEndpointType) Valid() bool {
+	switch v {
+	case EppEndpointEndpointTypeLaptop, EppEndpointEndpointTypeDesktop, EppEndpointEndpointTypeServer:
+		return true
+	}
+	return false
+}
+
+// NewEppEndpoint returns a EppEndpoint with the default values of its swagger definition
+func NewEppEndpoint() *EppEndpoint {
+	return &EppEndpoint{
+		ObjectType:   "epp/endpoint",
+		EndpointType: EppEndpointEndpointTypeDesktop,
+	}
+}
+
+// Validate checks the EppEndpoint before it is sent, see ValidateWith
+func (e *EppEndpoint) Validate() error { return e.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the EppEndpoint.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (e *EppEndpoint) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("EppEndpoint", typeOf)
+	check.Enum("endpoint_type", string(e.EndpointType), e.EndpointType.Valid())
+	check.Required("name", e.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &EppEndpoint{}
 
-// GetPath implements sophos.RestObject and returns the EppEndpoints GET path
+// GetPath implements sophos.RestGetter and returns the EppEndpoints GET path
 // Returns all available epp/endpoint objects
 func (*EppEndpoints) GetPath() string { return "/api/objects/epp/endpoint/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*EppEndpoints) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the EppEndpoints GET path
+// GetPath implements sophos.RestGetter and returns the EppEndpoints GET path
 // Returns all available endpoint types
 func (e *EppEndpoint) GetPath() string {
 	return fmt.Sprintf("/api/objects/epp/endpoint/%s", e.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (e *EppEndpoint) RefRequired() (string, bool) { return e.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the EppEndpoint DELETE path
+// DeletePath implements sophos.Deletable and returns the EppEndpoint DELETE path
 // Creates or updates the complete object endpoint
 func (*EppEndpoint) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/endpoint/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the EppEndpoint PATCH path
+// PatchPath implements sophos.Patchable and returns the EppEndpoint PATCH path
 // Changes to parts of the object endpoint types
 func (*EppEndpoint) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/endpoint/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the EppEndpoint POST path
+// PostPath implements sophos.Creatable and returns the EppEndpoint POST path
 // Create a new epp/endpoint object
 func (*EppEndpoint) PostPath() string {
 	return "/api/objects/epp/endpoint/"
 }
 
-// PutPath implements sophos.RestObject and returns the EppEndpoint PUT path
+// PutPath implements sophos.Updatable and returns the EppEndpoint PUT path
 // Creates or updates the complete object endpoint
 func (*EppEndpoint) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/endpoint/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*EppEndpoint) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/endpoint/%s/usedby", ref)
 }
 
-// EppEndpointsGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (e *EppEndpoint) GetType() string { return e.ObjectType }
+
+// EppEndpointsGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type EppEndpointsGroups []EppEndpointsGroup
 
-// EppEndpointsGroup is a generated Sophos object
+// EppEndpointsGroup represents a UTM epp/endpoints_group object
 type EppEndpointsGroup struct {
-	Locked           string        `json:"_locked"`
-	Reference        string        `json:"_ref"`
-	ObjectType       string        `json:"_type"`
-	AvPolicy         string        `json:"av_policy"`
-	Comment          string        `json:"comment"`
-	DcPolicy         string        `json:"dc_policy"`
-	Endpoints        []interface{} `json:"endpoints"`
-	Name             string        `json:"name"`
-	ProxyAddress     string        `json:"proxy_address"`
-	ProxyPassword    string        `json:"proxy_password"`
-	ProxyPort        int64         `json:"proxy_port"`
-	ProxySupport     bool          `json:"proxy_support"`
-	ProxyUser        string        `json:"proxy_user"`
-	TamperProtection bool          `json:"tamper_protection"`
-	WebControl       bool          `json:"web_control"`
+	Locked           string   `json:"_locked"`
+	ObjectType       string   `json:"_type"`
+	Reference        string   `json:"_ref"`
+	AvPolicy         string   `json:"av_policy"`
+	Comment          string   `json:"comment"`
+	DcPolicy         string   `json:"dc_policy"`
+	Endpoints        []string `json:"endpoints"`
+	Name             string   `json:"name"`
+	ProxyAddress     string   `json:"proxy_address"`
+	ProxyPassword    string   `json:"proxy_password"`
+	ProxyPort        int64    `json:"proxy_port"`
+	ProxySupport     bool     `json:"proxy_support"`
+	ProxyUser        string   `json:"proxy_user"`
+	TamperProtection bool     `json:"tamper_protection"`
+	WebControl       bool     `json:"web_control"`
+}
+
+// NewEppEndpointsGroup returns a EppEndpointsGroup with the default values of its swagger definition
+func NewEppEndpointsGroup() *EppEndpointsGroup {
+	return &EppEndpointsGroup{
+		ObjectType: "epp/endpoints_group",
+	}
+}
+
+// Validate checks the EppEndpointsGroup before it is sent, see ValidateWith
+func (e *EppEndpointsGroup) Validate() error { return e.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the EppEndpointsGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (e *EppEndpointsGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("EppEndpointsGroup", typeOf)
+	check.Required("name", e.Name)
+	check.Range("proxy_port", e.ProxyPort, "0-65535")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &EppEndpointsGroup{}
 
-// GetPath implements sophos.RestObject and returns the EppEndpointsGroups GET path
+// GetPath implements sophos.RestGetter and returns the EppEndpointsGroups GET path
 // Returns all available epp/endpoints_group objects
 func (*EppEndpointsGroups) GetPath() string { return "/api/objects/epp/endpoints_group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*EppEndpointsGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the EppEndpointsGroups GET path
+// GetPath implements sophos.RestGetter and returns the EppEndpointsGroups GET path
 // Returns all available endpoints_group types
 func (e *EppEndpointsGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/epp/endpoints_group/%s", e.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (e *EppEndpointsGroup) RefRequired() (string, bool) { return e.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the EppEndpointsGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the EppEndpointsGroup DELETE path
 // Creates or updates the complete object endpoints_group
 func (*EppEndpointsGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/endpoints_group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the EppEndpointsGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the EppEndpointsGroup PATCH path
 // Changes to parts of the object endpoints_group types
 func (*EppEndpointsGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/endpoints_group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the EppEndpointsGroup POST path
+// PostPath implements sophos.Creatable and returns the EppEndpointsGroup POST path
 // Create a new epp/endpoints_group object
 func (*EppEndpointsGroup) PostPath() string {
 	return "/api/objects/epp/endpoints_group/"
 }
 
-// PutPath implements sophos.RestObject and returns the EppEndpointsGroup PUT path
+// PutPath implements sophos.Updatable and returns the EppEndpointsGroup PUT path
 // Creates or updates the complete object endpoints_group
 func (*EppEndpointsGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/endpoints_group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*EppEndpointsGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/endpoints_group/%s/usedby", ref)
@@ -642,7 +902,7 @@ func (*EppEndpointsGroup) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (e *EppEndpointsGroup) GetType() string { return e.ObjectType }
 
-// EppGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// EppGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type EppGroups []EppGroup
 
 // EppGroup represents a UTM group
@@ -654,48 +914,69 @@ type EppGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewEppGroup returns a EppGroup with the default values of its swagger definition
+func NewEppGroup() *EppGroup {
+	return &EppGroup{
+		ObjectType: "epp/group",
+	}
+}
+
+// Validate checks the EppGroup before it is sent, see ValidateWith
+func (e *EppGroup) Validate() error { return e.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the EppGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (e *EppGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("EppGroup", typeOf)
+	check.Required("name", e.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &EppGroup{}
 
-// GetPath implements sophos.RestObject and returns the EppGroups GET path
+// GetPath implements sophos.RestGetter and returns the EppGroups GET path
 // Returns all available epp/group objects
 func (*EppGroups) GetPath() string { return "/api/objects/epp/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*EppGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the EppGroups GET path
+// GetPath implements sophos.RestGetter and returns the EppGroups GET path
 // Returns all available group types
 func (e *EppGroup) GetPath() string { return fmt.Sprintf("/api/objects/epp/group/%s", e.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (e *EppGroup) RefRequired() (string, bool) { return e.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the EppGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the EppGroup DELETE path
 // Creates or updates the complete object group
 func (*EppGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the EppGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the EppGroup PATCH path
 // Changes to parts of the object group types
 func (*EppGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the EppGroup POST path
+// PostPath implements sophos.Creatable and returns the EppGroup POST path
 // Create a new epp/group object
 func (*EppGroup) PostPath() string {
 	return "/api/objects/epp/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the EppGroup PUT path
+// PutPath implements sophos.Updatable and returns the EppGroup PUT path
 // Creates or updates the complete object group
 func (*EppGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*EppGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/epp/group/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (e *EppGroup) GetType() string { return e.ObjectType }
@@ -9,30 +9,30 @@ import (
 // Ftp is a generated struct representing the Sophos Ftp Endpoint
 // GET /api/nodes/ftp
 type Ftp struct {
-	AllowedClients        []interface{} `json:"allowed_clients"`
-	AllowedServers        []string      `json:"allowed_servers"`
-	CffAv                 int64         `json:"cff_av"`
-	CffAvEngines          string        `json:"cff_av_engines"`
-	CffFileExtensions     []interface{} `json:"cff_file_extensions"`
-	Exceptions            []interface{} `json:"exceptions"`
-	MaxFileSize           int64         `json:"max_file_size"`
-	MsWinMode             int64         `json:"ms_win_mode"`
-	OperationMode         string        `json:"operation_mode"`
-	RestrictedServers     []string      `json:"restricted_servers"`
-	Status                int64         `json:"status"`
-	TransparentSkip       []interface{} `json:"transparent_skip"`
-	TransparentSkipAutoPf int64         `json:"transparent_skip_auto_pf"`
+	AllowedClients        []string    `json:"allowed_clients"`
+	AllowedServers        []string    `json:"allowed_servers"`
+	CffAv                 bool        `json:"cff_av"`
+	CffAvEngines          string      `json:"cff_av_engines"`
+	CffFileExtensions     []string    `json:"cff_file_extensions"`
+	Exceptions            []string    `json:"exceptions"`
+	MaxFileSize           int64       `json:"max_file_size"`
+	MsWinMode             bool        `json:"ms_win_mode"`
+	OperationMode         string      `json:"operation_mode"`
+	RestrictedServers     []string    `json:"restricted_servers"`
+	Status                bool        `json:"status"`
+	TransparentSkip       NetworkRefs `json:"transparent_skip"`
+	TransparentSkipAutoPf bool        `json:"transparent_skip_auto_pf"`
 }
 
 var _ sophos.Endpoint = &Ftp{}
 
-var defsFtp = map[string]sophos.RestObject{
+var defsFtp = map[string]sophos.RestGetter{
 	"FtpException": &FtpException{},
 	"FtpGroup":     &FtpGroup{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Ftp's Objects
-func (Ftp) RestObjects() map[string]sophos.RestObject { return defsFtp }
+func (Ftp) RestObjects() map[string]sophos.RestGetter { return defsFtp }
 
 // GetPath implements sophos.RestGetter
 func (*Ftp) GetPath() string { return "/api/nodes/ftp" }
@@ -66,72 +66,93 @@ func (Ftp) References() []string {
 	}
 }
 
-// FtpExceptions is an Sophos Endpoint subType and implements sophos.RestObject
+// FtpExceptions is an Sophos Endpoint subType and implements sophos.RestGetter
 type FtpExceptions []FtpException
 
 // FtpException represents a UTM FTP filter exception
 type FtpException struct {
-	Locked     string        `json:"_locked"`
-	ObjectType string        `json:"_type"`
-	Reference  string        `json:"_ref"`
-	Skiplist   []interface{} `json:"skiplist"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Client     []string `json:"client"`
+	Comment    string   `json:"comment"`
+	Name       string   `json:"name"`
+	Server     []string `json:"server"`
+	Skiplist   []string `json:"skiplist"`
 	// Status default value is false
-	Status  bool          `json:"status"`
-	Client  []interface{} `json:"client"`
-	Comment string        `json:"comment"`
-	Name    string        `json:"name"`
-	Server  []interface{} `json:"server"`
+	Status bool `json:"status"`
+}
+
+// NewFtpException returns a FtpException with the default values of its swagger definition
+func NewFtpException() *FtpException {
+	return &FtpException{
+		ObjectType: "ftp/exception",
+	}
+}
+
+// Validate checks the FtpException before it is sent, see ValidateWith
+func (f *FtpException) Validate() error { return f.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the FtpException.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (f *FtpException) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("FtpException", typeOf)
+	check.Required("name", f.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &FtpException{}
 
-// GetPath implements sophos.RestObject and returns the FtpExceptions GET path
+// GetPath implements sophos.RestGetter and returns the FtpExceptions GET path
 // Returns all available ftp/exception objects
 func (*FtpExceptions) GetPath() string { return "/api/objects/ftp/exception/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*FtpExceptions) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the FtpExceptions GET path
+// GetPath implements sophos.RestGetter and returns the FtpExceptions GET path
 // Returns all available exception types
 func (f *FtpException) GetPath() string {
 	return fmt.Sprintf("/api/objects/ftp/exception/%s", f.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (f *FtpException) RefRequired() (string, bool) { return f.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the FtpException DELETE path
+// DeletePath implements sophos.Deletable and returns the FtpException DELETE path
 // Creates or updates the complete object exception
 func (*FtpException) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ftp/exception/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the FtpException PATCH path
+// PatchPath implements sophos.Patchable and returns the FtpException PATCH path
 // Changes to parts of the object exception types
 func (*FtpException) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ftp/exception/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the FtpException POST path
+// PostPath implements sophos.Creatable and returns the FtpException POST path
 // Create a new ftp/exception object
 func (*FtpException) PostPath() string {
 	return "/api/objects/ftp/exception/"
 }
 
-// PutPath implements sophos.RestObject and returns the FtpException PUT path
+// PutPath implements sophos.Updatable and returns the FtpException PUT path
 // Creates or updates the complete object exception
 func (*FtpException) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ftp/exception/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*FtpException) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ftp/exception/%s/usedby", ref)
 }
 
-// FtpGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (f *FtpException) GetType() string { return f.ObjectType }
+
+// FtpGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type FtpGroups []FtpGroup
 
 // FtpGroup represents a UTM group
@@ -143,48 +164,69 @@ type FtpGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewFtpGroup returns a FtpGroup with the default values of its swagger definition
+func NewFtpGroup() *FtpGroup {
+	return &FtpGroup{
+		ObjectType: "ftp/group",
+	}
+}
+
+// Validate checks the FtpGroup before it is sent, see ValidateWith
+func (f *FtpGroup) Validate() error { return f.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the FtpGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (f *FtpGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("FtpGroup", typeOf)
+	check.Required("name", f.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &FtpGroup{}
 
-// GetPath implements sophos.RestObject and returns the FtpGroups GET path
+// GetPath implements sophos.RestGetter and returns the FtpGroups GET path
 // Returns all available ftp/group objects
 func (*FtpGroups) GetPath() string { return "/api/objects/ftp/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*FtpGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the FtpGroups GET path
+// GetPath implements sophos.RestGetter and returns the FtpGroups GET path
 // Returns all available group types
 func (f *FtpGroup) GetPath() string { return fmt.Sprintf("/api/objects/ftp/group/%s", f.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (f *FtpGroup) RefRequired() (string, bool) { return f.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the FtpGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the FtpGroup DELETE path
 // Creates or updates the complete object group
 func (*FtpGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ftp/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the FtpGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the FtpGroup PATCH path
 // Changes to parts of the object group types
 func (*FtpGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ftp/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the FtpGroup POST path
+// PostPath implements sophos.Creatable and returns the FtpGroup POST path
 // Create a new ftp/group object
 func (*FtpGroup) PostPath() string {
 	return "/api/objects/ftp/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the FtpGroup PUT path
+// PutPath implements sophos.Updatable and returns the FtpGroup PUT path
 // Creates or updates the complete object group
 func (*FtpGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ftp/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*FtpGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ftp/group/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (f *FtpGroup) GetType() string { return f.ObjectType }
@@ -9,16 +9,16 @@ import (
 // Geoip is a generated struct representing the Sophos Geoip Endpoint
 // GET /api/nodes/geoip
 type Geoip struct {
-	CountriesDst []string      `json:"countries_dst"`
-	CountriesSrc []string      `json:"countries_src"`
-	Exceptions   []interface{} `json:"exceptions"`
-	Log          string        `json:"log"`
-	Status       int64         `json:"status"`
+	CountriesDst []string `json:"countries_dst"`
+	CountriesSrc []string `json:"countries_src"`
+	Exceptions   []string `json:"exceptions"`
+	Log          string   `json:"log"`
+	Status       bool     `json:"status"`
 }
 
 var _ sophos.Endpoint = &Geoip{}
 
-var defsGeoip = map[string]sophos.RestObject{
+var defsGeoip = map[string]sophos.RestGetter{
 	"GeoipDstexception": &GeoipDstexception{},
 	"GeoipGeoipgroup":   &GeoipGeoipgroup{},
 	"GeoipGroup":        &GeoipGroup{},
@@ -26,7 +26,7 @@ var defsGeoip = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Geoip's Objects
-func (Geoip) RestObjects() map[string]sophos.RestObject { return defsGeoip }
+func (Geoip) RestObjects() map[string]sophos.RestGetter { return defsGeoip }
 
 // GetPath implements sophos.RestGetter
 func (*Geoip) GetPath() string { return "/api/nodes/geoip" }
@@ -68,127 +68,166 @@ func (Geoip) References() []string {
 	}
 }
 
-// GeoipDstexceptions is an Sophos Endpoint subType and implements sophos.RestObject
+// GeoipDstexceptions is an Sophos Endpoint subType and implements sophos.RestGetter
 type GeoipDstexceptions []GeoipDstexception
 
 // GeoipDstexception represents a UTM incoming exceptions
 type GeoipDstexception struct {
-	Locked              string        `json:"_locked"`
-	ObjectType          string        `json:"_type"`
-	Reference           string        `json:"_ref"`
-	DestinationNetworks []interface{} `json:"destination_networks"`
-	Name                string        `json:"name"`
-	Services            []interface{} `json:"services"`
+	Locked              string   `json:"_locked"`
+	ObjectType          string   `json:"_type"`
+	Reference           string   `json:"_ref"`
+	Comment             string   `json:"comment"`
+	Countries           []string `json:"countries"`
+	DestinationNetworks []string `json:"destination_networks"`
+	Name                string   `json:"name"`
+	Services            []string `json:"services"`
 	// Status default value is false
-	Status    bool          `json:"status"`
-	Comment   string        `json:"comment"`
-	Countries []interface{} `json:"countries"`
+	Status bool `json:"status"`
+}
+
+// NewGeoipDstexception returns a GeoipDstexception with the default values of its swagger definition
+func NewGeoipDstexception() *GeoipDstexception {
+	return &GeoipDstexception{
+		ObjectType: "geoip/dstexception",
+	}
+}
+
+// Validate checks the GeoipDstexception before it is sent, see ValidateWith
+func (g *GeoipDstexception) Validate() error { return g.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the GeoipDstexception.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (g *GeoipDstexception) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("GeoipDstexception", typeOf)
+	check.Required("name", g.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &GeoipDstexception{}
 
-// GetPath implements sophos.RestObject and returns the GeoipDstexceptions GET path
+// GetPath implements sophos.RestGetter and returns the GeoipDstexceptions GET path
 // Returns all available geoip/dstexception objects
 func (*GeoipDstexceptions) GetPath() string { return "/api/objects/geoip/dstexception/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*GeoipDstexceptions) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the GeoipDstexceptions GET path
+// GetPath implements sophos.RestGetter and returns the GeoipDstexceptions GET path
 // Returns all available dstexception types
 func (g *GeoipDstexception) GetPath() string {
 	return fmt.Sprintf("/api/objects/geoip/dstexception/%s", g.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (g *GeoipDstexception) RefRequired() (string, bool) { return g.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the GeoipDstexception DELETE path
+// DeletePath implements sophos.Deletable and returns the GeoipDstexception DELETE path
 // Creates or updates the complete object dstexception
 func (*GeoipDstexception) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/dstexception/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the GeoipDstexception PATCH path
+// PatchPath implements sophos.Patchable and returns the GeoipDstexception PATCH path
 // Changes to parts of the object dstexception types
 func (*GeoipDstexception) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/dstexception/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the GeoipDstexception POST path
+// PostPath implements sophos.Creatable and returns the GeoipDstexception POST path
 // Create a new geoip/dstexception object
 func (*GeoipDstexception) PostPath() string {
 	return "/api/objects/geoip/dstexception/"
 }
 
-// PutPath implements sophos.RestObject and returns the GeoipDstexception PUT path
+// PutPath implements sophos.Updatable and returns the GeoipDstexception PUT path
 // Creates or updates the complete object dstexception
 func (*GeoipDstexception) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/dstexception/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*GeoipDstexception) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/dstexception/%s/usedby", ref)
 }
 
-// GeoipGeoipgroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (g *GeoipDstexception) GetType() string { return g.ObjectType }
+
+// GeoipGeoipgroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type GeoipGeoipgroups []GeoipGeoipgroup
 
-// GeoipGeoipgroup is a generated Sophos object
+// GeoipGeoipgroup represents a UTM geoip/geoipgroup object
 type GeoipGeoipgroup struct {
 	Locked     string   `json:"_locked"`
-	Reference  string   `json:"_ref"`
 	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
 	Comment    string   `json:"comment"`
 	Countries  []string `json:"countries"`
 	Name       string   `json:"name"`
 }
 
+// NewGeoipGeoipgroup returns a GeoipGeoipgroup with the default values of its swagger definition
+func NewGeoipGeoipgroup() *GeoipGeoipgroup {
+	return &GeoipGeoipgroup{
+		ObjectType: "geoip/geoipgroup",
+	}
+}
+
+// Validate checks the GeoipGeoipgroup before it is sent, see ValidateWith
+func (g *GeoipGeoipgroup) Validate() error { return g.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the GeoipGeoipgroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (g *GeoipGeoipgroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("GeoipGeoipgroup", typeOf)
+	check.Required("name", g.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &GeoipGeoipgroup{}
 
-// GetPath implements sophos.RestObject and returns the GeoipGeoipgroups GET path
+// GetPath implements sophos.RestGetter and returns the GeoipGeoipgroups GET path
 // Returns all available geoip/geoipgroup objects
 func (*GeoipGeoipgroups) GetPath() string { return "/api/objects/geoip/geoipgroup/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*GeoipGeoipgroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the GeoipGeoipgroups GET path
+// GetPath implements sophos.RestGetter and returns the GeoipGeoipgroups GET path
 // Returns all available geoipgroup types
 func (g *GeoipGeoipgroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/geoip/geoipgroup/%s", g.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (g *GeoipGeoipgroup) RefRequired() (string, bool) { return g.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the GeoipGeoipgroup DELETE path
+// DeletePath implements sophos.Deletable and returns the GeoipGeoipgroup DELETE path
 // Creates or updates the complete object geoipgroup
 func (*GeoipGeoipgroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/geoipgroup/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the GeoipGeoipgroup PATCH path
+// PatchPath implements sophos.Patchable and returns the GeoipGeoipgroup PATCH path
 // Changes to parts of the object geoipgroup types
 func (*GeoipGeoipgroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/geoipgroup/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the GeoipGeoipgroup POST path
+// PostPath implements sophos.Creatable and returns the GeoipGeoipgroup POST path
 // Create a new geoip/geoipgroup object
 func (*GeoipGeoipgroup) PostPath() string {
 	return "/api/objects/geoip/geoipgroup/"
 }
 
-// PutPath implements sophos.RestObject and returns the GeoipGeoipgroup PUT path
+// PutPath implements sophos.Updatable and returns the GeoipGeoipgroup PUT path
 // Creates or updates the complete object geoipgroup
 func (*GeoipGeoipgroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/geoipgroup/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*GeoipGeoipgroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/geoipgroup/%s/usedby", ref)
@@ -197,7 +236,7 @@ func (*GeoipGeoipgroup) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (g *GeoipGeoipgroup) GetType() string { return g.ObjectType }
 
-// GeoipGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GeoipGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type GeoipGroups []GeoipGroup
 
 // GeoipGroup represents a UTM group
@@ -209,113 +248,155 @@ type GeoipGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewGeoipGroup returns a GeoipGroup with the default values of its swagger definition
+func NewGeoipGroup() *GeoipGroup {
+	return &GeoipGroup{
+		ObjectType: "geoip/group",
+	}
+}
+
+// Validate checks the GeoipGroup before it is sent, see ValidateWith
+func (g *GeoipGroup) Validate() error { return g.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the GeoipGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (g *GeoipGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("GeoipGroup", typeOf)
+	check.Required("name", g.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &GeoipGroup{}
 
-// GetPath implements sophos.RestObject and returns the GeoipGroups GET path
+// GetPath implements sophos.RestGetter and returns the GeoipGroups GET path
 // Returns all available geoip/group objects
 func (*GeoipGroups) GetPath() string { return "/api/objects/geoip/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*GeoipGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the GeoipGroups GET path
+// GetPath implements sophos.RestGetter and returns the GeoipGroups GET path
 // Returns all available group types
 func (g *GeoipGroup) GetPath() string { return fmt.Sprintf("/api/objects/geoip/group/%s", g.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (g *GeoipGroup) RefRequired() (string, bool) { return g.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the GeoipGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the GeoipGroup DELETE path
 // Creates or updates the complete object group
 func (*GeoipGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the GeoipGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the GeoipGroup PATCH path
 // Changes to parts of the object group types
 func (*GeoipGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the GeoipGroup POST path
+// PostPath implements sophos.Creatable and returns the GeoipGroup POST path
 // Create a new geoip/group object
 func (*GeoipGroup) PostPath() string {
 	return "/api/objects/geoip/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the GeoipGroup PUT path
+// PutPath implements sophos.Updatable and returns the GeoipGroup PUT path
 // Creates or updates the complete object group
 func (*GeoipGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*GeoipGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/group/%s/usedby", ref)
 }
 
-// GeoipSrcexceptions is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (g *GeoipGroup) GetType() string { return g.ObjectType }
+
+// GeoipSrcexceptions is an Sophos Endpoint subType and implements sophos.RestGetter
 type GeoipSrcexceptions []GeoipSrcexception
 
 // GeoipSrcexception represents a UTM outgoing exceptions
 type GeoipSrcexception struct {
-	Locked         string        `json:"_locked"`
-	ObjectType     string        `json:"_type"`
-	Reference      string        `json:"_ref"`
-	Comment        string        `json:"comment"`
-	Countries      []interface{} `json:"countries"`
-	Name           string        `json:"name"`
-	Services       []interface{} `json:"services"`
-	SourceNetworks []interface{} `json:"source_networks"`
+	Locked         string   `json:"_locked"`
+	ObjectType     string   `json:"_type"`
+	Reference      string   `json:"_ref"`
+	Comment        string   `json:"comment"`
+	Countries      []string `json:"countries"`
+	Name           string   `json:"name"`
+	Services       []string `json:"services"`
+	SourceNetworks []string `json:"source_networks"`
 	// Status default value is false
 	Status bool `json:"status"`
 }
 
+// NewGeoipSrcexception returns a GeoipSrcexception with the default values of its swagger definition
+func NewGeoipSrcexception() *GeoipSrcexception {
+	return &GeoipSrcexception{
+		ObjectType: "geoip/srcexception",
+	}
+}
+
+// Validate checks the GeoipSrcexception before it is sent, see ValidateWith
+func (g *GeoipSrcexception) Validate() error { return g.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the GeoipSrcexception.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (g *GeoipSrcexception) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("GeoipSrcexception", typeOf)
+	check.Required("name", g.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &GeoipSrcexception{}
 
-// GetPath implements sophos.RestObject and returns the GeoipSrcexceptions GET path
+// GetPath implements sophos.RestGetter and returns the GeoipSrcexceptions GET path
 // Returns all available geoip/srcexception objects
 func (*GeoipSrcexceptions) GetPath() string { return "/api/objects/geoip/srcexception/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*GeoipSrcexceptions) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the GeoipSrcexceptions GET path
+// GetPath implements sophos.RestGetter and returns the GeoipSrcexceptions GET path
 // Returns all available srcexception types
 func (g *GeoipSrcexception) GetPath() string {
 	return fmt.Sprintf("/api/objects/geoip/srcexception/%s", g.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (g *GeoipSrcexception) RefRequired() (string, bool) { return g.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the GeoipSrcexception DELETE path
+// DeletePath implements sophos.Deletable and returns the GeoipSrcexception DELETE path
 // Creates or updates the complete object srcexception
 func (*GeoipSrcexception) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/srcexception/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the GeoipSrcexception PATCH path
+// PatchPath implements sophos.Patchable and returns the GeoipSrcexception PATCH path
 // Changes to parts of the object srcexception types
 func (*GeoipSrcexception) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/srcexception/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the GeoipSrcexception POST path
+// PostPath implements sophos.Creatable and returns the GeoipSrcexception POST path
 // Create a new geoip/srcexception object
 func (*GeoipSrcexception) PostPath() string {
 	return "/api/objects/geoip/srcexception/"
 }
 
-// PutPath implements sophos.RestObject and returns the GeoipSrcexception PUT path
+// PutPath implements sophos.Updatable and returns the GeoipSrcexception PUT path
 // Creates or updates the complete object srcexception
 func (*GeoipSrcexception) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/srcexception/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*GeoipSrcexception) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/geoip/srcexception/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (g *GeoipSrcexception) GetType() string { return g.ObjectType }
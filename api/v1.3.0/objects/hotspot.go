@@ -9,23 +9,23 @@ import (
 // Hotspot is a generated struct representing the Sophos Hotspot Endpoint
 // GET /api/nodes/hotspot
 type Hotspot struct {
-	Cert            string        `json:"cert"`
-	DeleteDays      int64         `json:"delete_days"`
-	SslPortal       int64         `json:"ssl_portal"`
-	Status          int64         `json:"status"`
-	TransparentSkip []interface{} `json:"transparent_skip"`
+	Cert            string      `json:"cert"`
+	DeleteDays      int64       `json:"delete_days"`
+	SslPortal       bool        `json:"ssl_portal"`
+	Status          bool        `json:"status"`
+	TransparentSkip NetworkRefs `json:"transparent_skip"`
 }
 
 var _ sophos.Endpoint = &Hotspot{}
 
-var defsHotspot = map[string]sophos.RestObject{
+var defsHotspot = map[string]sophos.RestGetter{
 	"HotspotGroup":   &HotspotGroup{},
 	"HotspotPortal":  &HotspotPortal{},
 	"HotspotVoucher": &HotspotVoucher{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Hotspot's Objects
-func (Hotspot) RestObjects() map[string]sophos.RestObject { return defsHotspot }
+func (Hotspot) RestObjects() map[string]sophos.RestGetter { return defsHotspot }
 
 // GetPath implements sophos.RestGetter
 func (*Hotspot) GetPath() string { return "/api/nodes/hotspot" }
@@ -63,7 +63,7 @@ func (Hotspot) References() []string {
 	}
 }
 
-// HotspotGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// HotspotGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type HotspotGroups []HotspotGroup
 
 // HotspotGroup represents a UTM group
@@ -75,170 +75,300 @@ type HotspotGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewHotspotGroup returns a HotspotGroup with the default values of its swagger definition
+func NewHotspotGroup() *HotspotGroup {
+	return &HotspotGroup{
+		ObjectType: "hotspot/group",
+	}
+}
+
+// Validate checks the HotspotGroup before it is sent, see ValidateWith
+func (h *HotspotGroup) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HotspotGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HotspotGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HotspotGroup", typeOf)
+	check.Required("name", h.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &HotspotGroup{}
 
-// GetPath implements sophos.RestObject and returns the HotspotGroups GET path
+// GetPath implements sophos.RestGetter and returns the HotspotGroups GET path
 // Returns all available hotspot/group objects
 func (*HotspotGroups) GetPath() string { return "/api/objects/hotspot/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HotspotGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HotspotGroups GET path
+// GetPath implements sophos.RestGetter and returns the HotspotGroups GET path
 // Returns all available group types
 func (h *HotspotGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/hotspot/group/%s", h.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HotspotGroup) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HotspotGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the HotspotGroup DELETE path
 // Creates or updates the complete object group
 func (*HotspotGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/hotspot/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HotspotGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the HotspotGroup PATCH path
 // Changes to parts of the object group types
 func (*HotspotGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/hotspot/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HotspotGroup POST path
+// PostPath implements sophos.Creatable and returns the HotspotGroup POST path
 // Create a new hotspot/group object
 func (*HotspotGroup) PostPath() string {
 	return "/api/objects/hotspot/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the HotspotGroup PUT path
+// PutPath implements sophos.Updatable and returns the HotspotGroup PUT path
 // Creates or updates the complete object group
 func (*HotspotGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/hotspot/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HotspotGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/hotspot/group/%s/usedby", ref)
 }
 
-// HotspotPortals is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (h *HotspotGroup) GetType() string { return h.ObjectType }
+
+// HotspotPortals is an Sophos Endpoint subType and implements sophos.RestGetter
 type HotspotPortals []HotspotPortal
 
 // HotspotPortal represents a UTM hotspot
 type HotspotPortal struct {
-	Locked     string `json:"_locked"`
-	ObjectType string `json:"_type"`
-	Reference  string `json:"_ref"`
-	// HostnameType can be one of: []string{"none", "custom"}
-	// HostnameType default value is "none"
-	HostnameType string `json:"hostname_type"`
-	Name         string `json:"name"`
-	SmsText      string `json:"sms_text"`
-	Terms        string `json:"terms"`
-	// VoucherQrcode default value is false
-	VoucherQrcode bool   `json:"voucher_qrcode"`
-	Description   string `json:"description"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	AdminUsers []string `json:"admin_users"`
+	Comment    string   `json:"comment"`
+	// CustomAssets description: (HASH)
+	CustomAssets map[string]interface{} `json:"custom_assets"`
+	// CustomizationType can be one of: []string{"basic", "full"}
+	// CustomizationType default value is "basic"
+	CustomizationType HotspotPortalCustomizationType `json:"customization_type"`
+	Description       string                         `json:"description"`
+	Expiry            int64                          `json:"expiry"`
 	// FiasCodeset can be one of: []string{"cp850", "cp1252"}
 	// FiasCodeset default value is "cp850"
-	FiasCodeset string `json:"fias_codeset"`
+	FiasCodeset HotspotPortalFiasCodeset `json:"fias_codeset"`
+	// FiasPort description: REF(service/tcp)
+	// FiasPort default value is ""
+	FiasPort ServiceRef `json:"fias_port"`
 	// FiasServer description: REF(network/host), REF(network/dns_host)
 	// FiasServer default value is ""
-	FiasServer string        `json:"fias_server"`
-	Vouchers   []interface{} `json:"vouchers"`
-	Maclimit   int           `json:"maclimit"`
-	AdminUsers []interface{} `json:"admin_users"`
+	FiasServer NetworkRef `json:"fias_server"`
+	// Hostname description: REF(network/dns_host)
+	// Hostname default value is ""
+	Hostname NetworkRef `json:"hostname"`
+	// HostnameType can be one of: []string{"none", "custom"}
+	// HostnameType default value is "none"
+	HostnameType HotspotPortalHostnameType `json:"hostname_type"`
+	HotspotUsers []string                  `json:"hotspot_users"`
+	Interfaces   []string                  `json:"interfaces"`
 	// Logo default value is ""
 	Logo string `json:"logo"`
+	// LogoFilename default value is "default_logo.png"
+	LogoFilename string `json:"logo_filename"`
 	// LogoResize default value is false
-	LogoResize bool `json:"logo_resize"`
-	// CustomAssets description: (HASH)
-	CustomAssets interface{} `json:"custom_assets"`
+	LogoResize bool     `json:"logo_resize"`
+	Maclimit   int64    `json:"maclimit"`
+	Mail       []string `json:"mail"`
+	Name       string   `json:"name"`
 	// Pagesize default value is "a4"
-	Pagesize     string        `json:"pagesize"`
-	Title        string        `json:"title"`
-	HotspotUsers []interface{} `json:"hotspot_users"`
-	// SslRedirect default value is false
-	SslRedirect bool `json:"ssl_redirect"`
-	// VoucherTemplate description: (HASH)
-	VoucherTemplate interface{}   `json:"voucher_template"`
-	VouchersPerPage int           `json:"vouchers_per_page"`
-	Comment         string        `json:"comment"`
-	Interfaces      []interface{} `json:"interfaces"`
-	Mail            []interface{} `json:"mail"`
+	Pagesize string `json:"pagesize"`
 	// PwTime description: (TIME)
 	PwTime      string `json:"pw_time"`
 	RedirectUrl string `json:"redirect_url"`
+	SmsText     string `json:"sms_text"`
+	// SslRedirect default value is false
+	SslRedirect bool `json:"ssl_redirect"`
 	// SyncPsk default value is false
 	SyncPsk bool `json:"sync_psk"`
-	// Type can be one of: []string{"terms", "password", "voucher", "backend_auth", "sms", "fias"}
-	Type string `json:"type"`
-	// CustomizationType can be one of: []string{"basic", "full"}
-	// CustomizationType default value is "basic"
-	CustomizationType string `json:"customization_type"`
-	// FiasPort description: REF(service/tcp)
-	// FiasPort default value is ""
-	FiasPort string `json:"fias_port"`
-	// LogoFilename default value is "default_logo.png"
-	LogoFilename string `json:"logo_filename"`
-	Expiry       int    `json:"expiry"`
-	// Hostname description: REF(network/dns_host)
-	// Hostname default value is ""
-	Hostname string `json:"hostname"`
 	// Template description: (HASH)
-	Template interface{} `json:"template"`
+	Template map[string]interface{} `json:"template"`
+	Terms    string                 `json:"terms"`
+	Title    string                 `json:"title"`
+	// Type can be one of: []string{"terms", "password", "voucher", "backend_auth", "sms", "fias"}
+	Type HotspotPortalType `json:"type"`
+	// VoucherQrcode default value is false
+	VoucherQrcode bool `json:"voucher_qrcode"`
+	// VoucherTemplate description: (HASH)
+	VoucherTemplate map[string]interface{} `json:"voucher_template"`
+	Vouchers        []string               `json:"vouchers"`
+	VouchersPerPage int64                  `json:"vouchers_per_page"`
+}
+
+// HotspotPortalCustomizationType is the CustomizationType of a HotspotPortal
+type HotspotPortalCustomizationType string
+
+// Known values of HotspotPortalCustomizationType
+const (
+	HotspotPortalCustomizationTypeBasic HotspotPortalCustomizationType = "basic"
+	HotspotPortalCustomizationTypeFull  HotspotPortalCustomizationType = "full"
+)
+
+// Valid returns true if the value is a known HotspotPortalCustomizationType
+func (v HotspotPortalCustomizationType) Valid() bool {
+	switch v {
+	case HotspotPortalCustomizationTypeBasic, HotspotPortalCustomizationTypeFull:
+		return true
+	}
+	return false
+}
+
+// HotspotPortalFiasCodeset is the FiasCodeset of a HotspotPortal
+type HotspotPortalFiasCodeset string
+
+// Known values of HotspotPortalFiasCodeset
+const (
+	HotspotPortalFiasCodesetCp850  HotspotPortalFiasCodeset = "cp850"
+	HotspotPortalFiasCodesetCp1252 HotspotPortalFiasCodeset = "cp1252"
+)
+
+// Valid returns true if the value is a known HotspotPortalFiasCodeset
+func (v HotspotPortalFiasCodeset) Valid() bool {
+	switch v {
+	case HotspotPortalFiasCodesetCp850, HotspotPortalFiasCodesetCp1252:
+		return true
+	}
+	return false
+}
+
+// HotspotPortalHostnameType is the HostnameType of a HotspotPortal
+type HotspotPortalHostnameType string
+
+// Known values of HotspotPortalHostnameType
+const (
+	HotspotPortalHostnameTypeNone   HotspotPortalHostnameType = "none"
+	HotspotPortalHostnameTypeCustom HotspotPortalHostnameType = "custom"
+)
+
+// Valid returns true if the value is a known HotspotPortalHostnameType
+func (v HotspotPortalHostnameType) Valid() bool {
+	switch v {
+	case HotspotPortalHostnameTypeNone, HotspotPortalHostnameTypeCustom:
+		return true
+	}
+	return false
+}
+
+// HotspotPortalType is the Type of a HotspotPortal
+type HotspotPortalType string
+
+// Known values of HotspotPortalType
+const (
+	HotspotPortalTypeTerms       HotspotPortalType = "terms"
+	HotspotPortalTypePassword    HotspotPortalType = "password"
+	HotspotPortalTypeVoucher     HotspotPortalType = "voucher"
+	HotspotPortalTypeBackendAuth HotspotPortalType = "backend_auth"
+	HotspotPortalTypeSms         HotspotPortalType = "sms"
+	HotspotPortalTypeFias        HotspotPortalType = "fias"
+)
+
+// Valid returns true if the value is a known HotspotPortalType
+func (v HotspotPortalType) Valid() bool {
+	switch v {
+	case HotspotPortalTypeTerms, HotspotPortalTypePassword, HotspotPortalTypeVoucher, HotspotPortalTypeBackendAuth, HotspotPortalTypeSms, HotspotPortalTypeFias:
+		return true
+	}
+	return false
+}
+
+// NewHotspotPortal returns a HotspotPortal with the default values of its swagger definition
+func NewHotspotPortal() *HotspotPortal {
+	return &HotspotPortal{
+		ObjectType:        "hotspot/portal",
+		CustomizationType: HotspotPortalCustomizationTypeBasic,
+		FiasCodeset:       HotspotPortalFiasCodesetCp850,
+		HostnameType:      HotspotPortalHostnameTypeNone,
+		LogoFilename:      "default_logo.png",
+		Pagesize:          "a4",
+	}
+}
+
+// Validate checks the HotspotPortal before it is sent, see ValidateWith
+func (h *HotspotPortal) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HotspotPortal.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HotspotPortal) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HotspotPortal", typeOf)
+	check.Enum("customization_type", string(h.CustomizationType), h.CustomizationType.Valid())
+	check.Enum("fias_codeset", string(h.FiasCodeset), h.FiasCodeset.Valid())
+	check.Ref("fias_port", string(h.FiasPort), "REF(service/tcp)")
+	check.Ref("fias_server", string(h.FiasServer), "REF(network/host), REF(network/dns_host)")
+	check.Ref("hostname", string(h.Hostname), "REF(network/dns_host)")
+	check.Enum("hostname_type", string(h.HostnameType), h.HostnameType.Valid())
+	check.Required("name", h.Name)
+	check.Enum("type", string(h.Type), h.Type.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &HotspotPortal{}
 
-// GetPath implements sophos.RestObject and returns the HotspotPortals GET path
+// GetPath implements sophos.RestGetter and returns the HotspotPortals GET path
 // Returns all available hotspot/portal objects
 func (*HotspotPortals) GetPath() string { return "/api/objects/hotspot/portal/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HotspotPortals) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HotspotPortals GET path
+// GetPath implements sophos.RestGetter and returns the HotspotPortals GET path
 // Returns all available portal types
 func (h *HotspotPortal) GetPath() string {
 	return fmt.Sprintf("/api/objects/hotspot/portal/%s", h.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HotspotPortal) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HotspotPortal DELETE path
+// DeletePath implements sophos.Deletable and returns the HotspotPortal DELETE path
 // Creates or updates the complete object portal
 func (*HotspotPortal) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/hotspot/portal/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HotspotPortal PATCH path
+// PatchPath implements sophos.Patchable and returns the HotspotPortal PATCH path
 // Changes to parts of the object portal types
 func (*HotspotPortal) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/hotspot/portal/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HotspotPortal POST path
+// PostPath implements sophos.Creatable and returns the HotspotPortal POST path
 // Create a new hotspot/portal object
 func (*HotspotPortal) PostPath() string {
 	return "/api/objects/hotspot/portal/"
 }
 
-// PutPath implements sophos.RestObject and returns the HotspotPortal PUT path
+// PutPath implements sophos.Updatable and returns the HotspotPortal PUT path
 // Creates or updates the complete object portal
 func (*HotspotPortal) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/hotspot/portal/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HotspotPortal) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/hotspot/portal/%s/usedby", ref)
 }
 
-// HotspotVouchers is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (h *HotspotPortal) GetType() string { return h.ObjectType }
+
+// HotspotVouchers is an Sophos Endpoint subType and implements sophos.RestGetter
 type HotspotVouchers []HotspotVoucher
 
 // HotspotVoucher represents a UTM voucher definiton
@@ -246,57 +376,78 @@ type HotspotVoucher struct {
 	Locked       string `json:"_locked"`
 	ObjectType   string `json:"_type"`
 	Reference    string `json:"_ref"`
-	Name         string `json:"name"`
-	Timequota    int    `json:"timequota"`
-	Trafficlimit int    `json:"trafficlimit"`
 	Comment      string `json:"comment"`
-	Expiry       int    `json:"expiry"`
+	Expiry       int64  `json:"expiry"`
+	Name         string `json:"name"`
+	Timequota    int64  `json:"timequota"`
+	Trafficlimit int64  `json:"trafficlimit"`
+}
+
+// NewHotspotVoucher returns a HotspotVoucher with the default values of its swagger definition
+func NewHotspotVoucher() *HotspotVoucher {
+	return &HotspotVoucher{
+		ObjectType: "hotspot/voucher",
+	}
+}
+
+// Validate checks the HotspotVoucher before it is sent, see ValidateWith
+func (h *HotspotVoucher) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HotspotVoucher.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HotspotVoucher) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HotspotVoucher", typeOf)
+	check.Required("name", h.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &HotspotVoucher{}
 
-// GetPath implements sophos.RestObject and returns the HotspotVouchers GET path
+// GetPath implements sophos.RestGetter and returns the HotspotVouchers GET path
 // Returns all available hotspot/voucher objects
 func (*HotspotVouchers) GetPath() string { return "/api/objects/hotspot/voucher/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HotspotVouchers) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HotspotVouchers GET path
+// GetPath implements sophos.RestGetter and returns the HotspotVouchers GET path
 // Returns all available voucher types
 func (h *HotspotVoucher) GetPath() string {
 	return fmt.Sprintf("/api/objects/hotspot/voucher/%s", h.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HotspotVoucher) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HotspotVoucher DELETE path
+// DeletePath implements sophos.Deletable and returns the HotspotVoucher DELETE path
 // Creates or updates the complete object voucher
 func (*HotspotVoucher) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/hotspot/voucher/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HotspotVoucher PATCH path
+// PatchPath implements sophos.Patchable and returns the HotspotVoucher PATCH path
 // Changes to parts of the object voucher types
 func (*HotspotVoucher) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/hotspot/voucher/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HotspotVoucher POST path
+// PostPath implements sophos.Creatable and returns the HotspotVoucher POST path
 // Create a new hotspot/voucher object
 func (*HotspotVoucher) PostPath() string {
 	return "/api/objects/hotspot/voucher/"
 }
 
-// PutPath implements sophos.RestObject and returns the HotspotVoucher PUT path
+// PutPath implements sophos.Updatable and returns the HotspotVoucher PUT path
 // Creates or updates the complete object voucher
 func (*HotspotVoucher) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/hotspot/voucher/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HotspotVoucher) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/hotspot/voucher/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (h *HotspotVoucher) GetType() string { return h.ObjectType }
@@ -9,106 +9,106 @@ import (
 // Http is a generated struct representing the Sophos Http Endpoint
 // GET /api/nodes/http
 type Http struct {
-	AdSsoInterfaces                       []interface{} `json:"ad_sso_interfaces"`
-	AdssoRedirectUseHostname              int64         `json:"adsso_redirect_use_hostname"`
-	AllowSsl3                             int64         `json:"allow_ssl3"`
-	AllowTLS1_2                           int64         `json:"allow_tls_1_2"`
-	AllowedPuas                           []interface{} `json:"allowed_puas"`
-	AllowedTargetServices                 []string      `json:"allowed_target_services"`
-	AuaMaxconns                           int64         `json:"aua_maxconns"`
-	AuaTimeout                            int64         `json:"aua_timeout"`
-	AuthCacheSize                         int64         `json:"auth_cache_size"`
-	AuthCacheTTL                          int64         `json:"auth_cache_ttl"`
-	AuthRealm                             string        `json:"auth_realm"`
-	AuthUsercacheTTL                      int64         `json:"auth_usercache_ttl"`
-	BlockUnscannable                      int64         `json:"block_unscannable"`
-	BypassStreaming                       int64         `json:"bypass_streaming"`
-	CaList                                []interface{} `json:"ca_list"`
-	CacheIgnoresCookies                   int64         `json:"cache_ignores_cookies"`
-	Cachessl                              int64         `json:"cachessl"`
-	Caching                               int64         `json:"caching"`
-	Certcache                             string        `json:"certcache"`
-	Certstore                             string        `json:"certstore"`
-	CffOverrideUsers                      []interface{} `json:"cff_override_users"`
-	ClientTimeout                         int64         `json:"client_timeout"`
-	ConfLockWorkaround                    int64         `json:"conf_lock_workaround"`
-	ConnectTimeout                        int64         `json:"connect_timeout"`
-	ConnectV6Timeout                      int64         `json:"connect_v6_timeout"`
-	Connlimit                             int64         `json:"connlimit"`
-	CtypeInspectBody                      int64         `json:"ctype_inspect_body"`
-	CtypeUnpackArchive                    int64         `json:"ctype_unpack_archive"`
-	Debug                                 []interface{} `json:"debug"`
-	Defaultblockaction                    string        `json:"defaultblockaction"`
-	Deferagents                           []string      `json:"deferagents"`
-	Deferlength                           int64         `json:"deferlength"`
-	DisplayHTTPBlockpageExplicitMode      int64         `json:"display_http_blockpage_explicit_mode"`
-	DisplayIntro                          int64         `json:"display_intro"`
-	DownloadManagerDefaultCharset         string        `json:"download_manager_default_charset"`
-	EdirDelayBasicAuth                    int64         `json:"edir_delay_basic_auth"`
-	EnableOutInterface                    int64         `json:"enable_out_interface"`
-	EppQuotaAction                        string        `json:"epp_quota_action"`
-	Exceptions                            []string      `json:"exceptions"`
-	ForcedCachingExtension                []string      `json:"forced_caching_extension"`
-	ForcedCachingNeverCachePrefix         []string      `json:"forced_caching_never_cache_prefix"`
-	ForcedCachingStatus                   int64         `json:"forced_caching_status"`
-	ForcedCachingTTL                      int64         `json:"forced_caching_ttl"`
-	ForcedCachingUserAgentPrefix          []string      `json:"forced_caching_user_agent_prefix"`
-	HTTPLoopbackDetect                    int64         `json:"http_loopback_detect"`
-	IeSslBlockpageWorkaround              int64         `json:"ie_ssl_blockpage_workaround"`
-	LimitAdSsoInterfaces                  int64         `json:"limit_ad_sso_interfaces"`
-	LocalSiteList                         []interface{} `json:"local_site_list"`
-	MaxContentEncoding                    int64         `json:"max_content_encoding"`
-	MaxTempfileSize                       int64         `json:"max_tempfile_size"`
-	Maxthreads                            int64         `json:"maxthreads"`
-	MaxthreadsUnused                      int64         `json:"maxthreads_unused"`
-	Modulepath                            string        `json:"modulepath"`
-	Modules                               []string      `json:"modules"`
-	Noscancontent                         []string      `json:"noscancontent"`
-	OpendirectoryKeytab                   string        `json:"opendirectory_keytab"`
-	PacFile                               string        `json:"pac_file"`
-	ParentProxyHost                       string        `json:"parent_proxy_host"`
-	ParentProxyPort                       int64         `json:"parent_proxy_port"`
-	ParentProxyStatus                     int64         `json:"parent_proxy_status"`
-	PassthroughID                         string        `json:"passthrough_id"`
-	PharmingProtection                    int64         `json:"pharming_protection"`
-	Port                                  int64         `json:"port"`
-	PortalCert                            string        `json:"portal_cert"`
-	PortalCertChain                       []interface{} `json:"portal_cert_chain"`
-	PortalDomain                          string        `json:"portal_domain"`
-	PortalHosts                           []interface{} `json:"portal_hosts"`
-	PortalUseCert                         int64         `json:"portal_use_cert"`
-	ProceedCacheTimeout                   int64         `json:"proceed_cache_timeout"`
-	Profiles                              []string      `json:"profiles"`
-	QuotaSliceTime                        int64         `json:"quota_slice_time"`
-	RemoveRequest                         []interface{} `json:"remove_request"`
-	RemoveResponse                        []string      `json:"remove_response"`
-	ResponseTimeout                       int64         `json:"response_timeout"`
-	ScLocalDB                             string        `json:"sc_local_db"`
-	ScanEppTraffic                        int64         `json:"scan_epp_traffic"`
-	Searchdomain                          string        `json:"searchdomain"`
-	StrictHTTP                            int64         `json:"strict_http"`
-	TlsciphersClient                      string        `json:"tlsciphers_client"`
-	TlsciphersServer                      string        `json:"tlsciphers_server"`
-	TmpfsUsageMinMemsize                  int64         `json:"tmpfs_usage_min_memsize"`
-	TransparentAuthTimeout                int64         `json:"transparent_auth_timeout"`
-	TransparentDstSkip                    []interface{} `json:"transparent_dst_skip"`
-	TransparentSkipAutoPf                 int64         `json:"transparent_skip_auto_pf"`
-	TransparentSrcSkip                    []interface{} `json:"transparent_src_skip"`
-	TunnelTimeout                         int64         `json:"tunnel_timeout"`
-	TunnelV6Timeout                       int64         `json:"tunnel_v6_timeout"`
-	Undefercontent                        []string      `json:"undefercontent"`
-	Undeferextension                      []string      `json:"undeferextension"`
-	URLFilteringRedirectURL               string        `json:"url_filtering_redirect_url"`
-	UseConnectionInsteadofProxyconnection int64         `json:"use_connection_insteadof_proxyconnection"`
-	UseDstaddrForGeopiplookup             int64         `json:"use_dstaddr_for_geopiplookup"`
-	UseKrb5Adsso                          int64         `json:"use_krb5_adsso"`
-	UseSni                                int64         `json:"use_sni"`
-	UseSxlUrid                            int64         `json:"use_sxl_urid"`
+	AdSsoInterfaces                       InterfaceRefs          `json:"ad_sso_interfaces"`
+	AdssoRedirectUseHostname              bool                   `json:"adsso_redirect_use_hostname"`
+	AllowSsl3                             bool                   `json:"allow_ssl3"`
+	AllowTLS1_2                           bool                   `json:"allow_tls_1_2"`
+	AllowedPuas                           []string               `json:"allowed_puas"`
+	AllowedTargetServices                 []string               `json:"allowed_target_services"`
+	AuaMaxconns                           int64                  `json:"aua_maxconns"`
+	AuaTimeout                            int64                  `json:"aua_timeout"`
+	AuthCacheSize                         int64                  `json:"auth_cache_size"`
+	AuthCacheTTL                          int64                  `json:"auth_cache_ttl"`
+	AuthRealm                             string                 `json:"auth_realm"`
+	AuthUsercacheTTL                      int64                  `json:"auth_usercache_ttl"`
+	BlockUnscannable                      bool                   `json:"block_unscannable"`
+	BypassStreaming                       bool                   `json:"bypass_streaming"`
+	CaList                                []string               `json:"ca_list"`
+	CacheIgnoresCookies                   bool                   `json:"cache_ignores_cookies"`
+	Cachessl                              bool                   `json:"cachessl"`
+	Caching                               bool                   `json:"caching"`
+	Certcache                             string                 `json:"certcache"`
+	Certstore                             string                 `json:"certstore"`
+	CffOverrideUsers                      []string               `json:"cff_override_users"`
+	ClientTimeout                         int64                  `json:"client_timeout"`
+	ConfLockWorkaround                    bool                   `json:"conf_lock_workaround"`
+	ConnectTimeout                        int64                  `json:"connect_timeout"`
+	ConnectV6Timeout                      map[string]interface{} `json:"connect_v6_timeout"`
+	Connlimit                             int64                  `json:"connlimit"`
+	CtypeInspectBody                      bool                   `json:"ctype_inspect_body"`
+	CtypeUnpackArchive                    bool                   `json:"ctype_unpack_archive"`
+	Debug                                 []string               `json:"debug"`
+	Defaultblockaction                    string                 `json:"defaultblockaction"`
+	Deferagents                           []string               `json:"deferagents"`
+	Deferlength                           int64                  `json:"deferlength"`
+	DisplayHTTPBlockpageExplicitMode      bool                   `json:"display_http_blockpage_explicit_mode"`
+	DisplayIntro                          bool                   `json:"display_intro"`
+	DownloadManagerDefaultCharset         string                 `json:"download_manager_default_charset"`
+	EdirDelayBasicAuth                    bool                   `json:"edir_delay_basic_auth"`
+	EnableOutInterface                    bool                   `json:"enable_out_interface"`
+	EppQuotaAction                        string                 `json:"epp_quota_action"`
+	Exceptions                            []string               `json:"exceptions"`
+	ForcedCachingExtension                []string               `json:"forced_caching_extension"`
+	ForcedCachingNeverCachePrefix         []string               `json:"forced_caching_never_cache_prefix"`
+	ForcedCachingStatus                   bool                   `json:"forced_caching_status"`
+	ForcedCachingTTL                      int64                  `json:"forced_caching_ttl"`
+	ForcedCachingUserAgentPrefix          []string               `json:"forced_caching_user_agent_prefix"`
+	HTTPLoopbackDetect                    bool                   `json:"http_loopback_detect"`
+	IeSslBlockpageWorkaround              bool                   `json:"ie_ssl_blockpage_workaround"`
+	LimitAdSsoInterfaces                  bool                   `json:"limit_ad_sso_interfaces"`
+	LocalSiteList                         []string               `json:"local_site_list"`
+	MaxContentEncoding                    int64                  `json:"max_content_encoding"`
+	MaxTempfileSize                       int64                  `json:"max_tempfile_size"`
+	Maxthreads                            int64                  `json:"maxthreads"`
+	MaxthreadsUnused                      int64                  `json:"maxthreads_unused"`
+	Modulepath                            string                 `json:"modulepath"`
+	Modules                               []string               `json:"modules"`
+	Noscancontent                         []string               `json:"noscancontent"`
+	OpendirectoryKeytab                   string                 `json:"opendirectory_keytab"`
+	PacFile                               string                 `json:"pac_file"`
+	ParentProxyHost                       string                 `json:"parent_proxy_host"`
+	ParentProxyPort                       int64                  `json:"parent_proxy_port"`
+	ParentProxyStatus                     bool                   `json:"parent_proxy_status"`
+	PassthroughID                         string                 `json:"passthrough_id"`
+	PharmingProtection                    bool                   `json:"pharming_protection"`
+	Port                                  int64                  `json:"port"`
+	PortalCert                            string                 `json:"portal_cert"`
+	PortalCertChain                       []string               `json:"portal_cert_chain"`
+	PortalDomain                          string                 `json:"portal_domain"`
+	PortalHosts                           []string               `json:"portal_hosts"`
+	PortalUseCert                         bool                   `json:"portal_use_cert"`
+	ProceedCacheTimeout                   int64                  `json:"proceed_cache_timeout"`
+	Profiles                              []string               `json:"profiles"`
+	QuotaSliceTime                        int64                  `json:"quota_slice_time"`
+	RemoveRequest                         []string               `json:"remove_request"`
+	RemoveResponse                        []string               `json:"remove_response"`
+	ResponseTimeout                       int64                  `json:"response_timeout"`
+	ScLocalDB                             string                 `json:"sc_local_db"`
+	ScanEppTraffic                        bool                   `json:"scan_epp_traffic"`
+	Searchdomain                          string                 `json:"searchdomain"`
+	StrictHTTP                            bool                   `json:"strict_http"`
+	TlsciphersClient                      string                 `json:"tlsciphers_client"`
+	TlsciphersServer                      string                 `json:"tlsciphers_server"`
+	TmpfsUsageMinMemsize                  int64                  `json:"tmpfs_usage_min_memsize"`
+	TransparentAuthTimeout                int64                  `json:"transparent_auth_timeout"`
+	TransparentDstSkip                    NetworkRefs            `json:"transparent_dst_skip"`
+	TransparentSkipAutoPf                 bool                   `json:"transparent_skip_auto_pf"`
+	TransparentSrcSkip                    NetworkRefs            `json:"transparent_src_skip"`
+	TunnelTimeout                         int64                  `json:"tunnel_timeout"`
+	TunnelV6Timeout                       map[string]interface{} `json:"tunnel_v6_timeout"`
+	Undefercontent                        []string               `json:"undefercontent"`
+	Undeferextension                      []string               `json:"undeferextension"`
+	URLFilteringRedirectURL               string                 `json:"url_filtering_redirect_url"`
+	UseConnectionInsteadofProxyconnection bool                   `json:"use_connection_insteadof_proxyconnection"`
+	UseDstaddrForGeopiplookup             bool                   `json:"use_dstaddr_for_geopiplookup"`
+	UseKrb5Adsso                          bool                   `json:"use_krb5_adsso"`
+	UseSni                                bool                   `json:"use_sni"`
+	UseSxlUrid                            bool                   `json:"use_sxl_urid"`
 }
 
 var _ sophos.Endpoint = &Http{}
 
-var defsHttp = map[string]sophos.RestObject{
+var defsHttp = map[string]sophos.RestGetter{
 	"HttpCffAction":   &HttpCffAction{},
 	"HttpCffProfile":  &HttpCffProfile{},
 	"HttpDeviceAuth":  &HttpDeviceAuth{},
@@ -125,7 +125,7 @@ var defsHttp = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Http's Objects
-func (Http) RestObjects() map[string]sophos.RestObject { return defsHttp }
+func (Http) RestObjects() map[string]sophos.RestGetter { return defsHttp }
 
 // GetPath implements sophos.RestGetter
 func (*Http) GetPath() string { return "/api/nodes/http" }
@@ -203,97 +203,115 @@ func (Http) References() []string {
 	}
 }
 
-// HttpCffActions is an Sophos Endpoint subType and implements sophos.RestObject
+// HttpCffActions is an Sophos Endpoint subType and implements sophos.RestGetter
 type HttpCffActions []HttpCffAction
 
-// HttpCffAction is a generated Sophos object
+// HttpCffAction represents a UTM http/cff_action object
 type HttpCffAction struct {
-	Locked                   string        `json:"_locked"`
-	Reference                string        `json:"_ref"`
-	ObjectType               string        `json:"_type"`
-	AllowTags                []interface{} `json:"allow_tags"`
-	Av                       bool          `json:"av"`
-	AvEngines                string        `json:"av_engines"`
-	BingSafesearch           string        `json:"bing_safesearch"`
-	BlockTags                []interface{} `json:"block_tags"`
-	CheckMaxDownload         bool          `json:"check_max_download"`
-	Comment                  string        `json:"comment"`
-	ContenttypeBlacklist     []interface{} `json:"contenttype_blacklist"`
-	ContenttypeBlacklistWarn []interface{} `json:"contenttype_blacklist_warn"`
-	CreativeCommonsFilter    bool          `json:"creative_commons_filter"`
-	EmbeddedRemoval          bool          `json:"embedded_removal"`
-	Extensions               []string      `json:"extensions"`
-	ExtensionsWarn           []interface{} `json:"extensions_warn"`
-	GoogleSafesearch         string        `json:"google_safesearch"`
-	Googleappdomains         []interface{} `json:"googleappdomains"`
-	GoogleappdomainsEnabled  bool          `json:"googleappdomains_enabled"`
-	LogAccess                bool          `json:"log_access"`
-	LogBlocked               bool          `json:"log_blocked"`
-	MaxDownloadSize          int64         `json:"max_download_size"`
-	MaxFilesize              int64         `json:"max_filesize"`
-	Mode                     string        `json:"mode"`
-	Name                     string        `json:"name"`
-	ParentProxies            []interface{} `json:"parent_proxies"`
-	Pua                      bool          `json:"pua"`
-	QuotaTags                []interface{} `json:"quota_tags"`
-	QuotaTime                int64         `json:"quota_time"`
-	Sandbox                  bool          `json:"sandbox"`
-	ScriptRemoval            bool          `json:"script_removal"`
-	SpCategories             []interface{} `json:"sp_categories"`
-	SpCategoriesQuota        []interface{} `json:"sp_categories_quota"`
-	SpCategoriesWarn         []interface{} `json:"sp_categories_warn"`
-	SpMinreputation          string        `json:"sp_minreputation"`
-	Spyware                  bool          `json:"spyware"`
-	UncategorizedWebsites    string        `json:"uncategorized_websites"`
-	URLBlacklist             []interface{} `json:"url_blacklist"`
-	URLWhitelist             []interface{} `json:"url_whitelist"`
-	WarnTags                 []interface{} `json:"warn_tags"`
-	YahooSafesearch          string        `json:"yahoo_safesearch"`
+	Locked                   string   `json:"_locked"`
+	ObjectType               string   `json:"_type"`
+	Reference                string   `json:"_ref"`
+	AllowTags                []string `json:"allow_tags"`
+	Av                       bool     `json:"av"`
+	AvEngines                string   `json:"av_engines"`
+	BingSafesearch           string   `json:"bing_safesearch"`
+	BlockTags                []string `json:"block_tags"`
+	CheckMaxDownload         bool     `json:"check_max_download"`
+	Comment                  string   `json:"comment"`
+	ContenttypeBlacklist     []string `json:"contenttype_blacklist"`
+	ContenttypeBlacklistWarn []string `json:"contenttype_blacklist_warn"`
+	CreativeCommonsFilter    bool     `json:"creative_commons_filter"`
+	EmbeddedRemoval          bool     `json:"embedded_removal"`
+	Extensions               []string `json:"extensions"`
+	ExtensionsWarn           []string `json:"extensions_warn"`
+	GoogleSafesearch         string   `json:"google_safesearch"`
+	Googleappdomains         []string `json:"googleappdomains"`
+	GoogleappdomainsEnabled  bool     `json:"googleappdomains_enabled"`
+	LogAccess                bool     `json:"log_access"`
+	LogBlocked               bool     `json:"log_blocked"`
+	MaxDownloadSize          int64    `json:"max_download_size"`
+	MaxFilesize              int64    `json:"max_filesize"`
+	Mode                     string   `json:"mode"`
+	Name                     string   `json:"name"`
+	ParentProxies            []string `json:"parent_proxies"`
+	Pua                      bool     `json:"pua"`
+	QuotaTags                []string `json:"quota_tags"`
+	QuotaTime                int64    `json:"quota_time"`
+	Sandbox                  bool     `json:"sandbox"`
+	ScriptRemoval            bool     `json:"script_removal"`
+	SpCategories             []string `json:"sp_categories"`
+	SpCategoriesQuota        []string `json:"sp_categories_quota"`
+	SpCategoriesWarn         []string `json:"sp_categories_warn"`
+	SpMinreputation          string   `json:"sp_minreputation"`
+	Spyware                  bool     `json:"spyware"`
+	UncategorizedWebsites    string   `json:"uncategorized_websites"`
+	URLBlacklist             []string `json:"url_blacklist"`
+	URLWhitelist             []string `json:"url_whitelist"`
+	WarnTags                 []string `json:"warn_tags"`
+	YahooSafesearch          string   `json:"yahoo_safesearch"`
+}
+
+// NewHttpCffAction returns a HttpCffAction with the default values of its swagger definition
+func NewHttpCffAction() *HttpCffAction {
+	return &HttpCffAction{
+		ObjectType: "http/cff_action",
+	}
+}
+
+// Validate checks the HttpCffAction before it is sent, see ValidateWith
+func (h *HttpCffAction) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HttpCffAction.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HttpCffAction) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HttpCffAction", typeOf)
+	check.Required("name", h.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &HttpCffAction{}
 
-// GetPath implements sophos.RestObject and returns the HttpCffActions GET path
+// GetPath implements sophos.RestGetter and returns the HttpCffActions GET path
 // Returns all available http/cff_action objects
 func (*HttpCffActions) GetPath() string { return "/api/objects/http/cff_action/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HttpCffActions) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HttpCffActions GET path
+// GetPath implements sophos.RestGetter and returns the HttpCffActions GET path
 // Returns all available cff_action types
 func (h *HttpCffAction) GetPath() string {
 	return fmt.Sprintf("/api/objects/http/cff_action/%s", h.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HttpCffAction) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HttpCffAction DELETE path
+// DeletePath implements sophos.Deletable and returns the HttpCffAction DELETE path
 // Creates or updates the complete object cff_action
 func (*HttpCffAction) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/cff_action/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HttpCffAction PATCH path
+// PatchPath implements sophos.Patchable and returns the HttpCffAction PATCH path
 // Changes to parts of the object cff_action types
 func (*HttpCffAction) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/cff_action/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HttpCffAction POST path
+// PostPath implements sophos.Creatable and returns the HttpCffAction POST path
 // Create a new http/cff_action object
 func (*HttpCffAction) PostPath() string {
 	return "/api/objects/http/cff_action/"
 }
 
-// PutPath implements sophos.RestObject and returns the HttpCffAction PUT path
+// PutPath implements sophos.Updatable and returns the HttpCffAction PUT path
 // Creates or updates the complete object cff_action
 func (*HttpCffAction) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/cff_action/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HttpCffAction) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/cff_action/%s/usedby", ref)
@@ -302,14 +320,14 @@ func (*HttpCffAction) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (h *HttpCffAction) GetType() string { return h.ObjectType }
 
-// HttpCffProfiles is an Sophos Endpoint subType and implements sophos.RestObject
+// HttpCffProfiles is an Sophos Endpoint subType and implements sophos.RestGetter
 type HttpCffProfiles []HttpCffProfile
 
-// HttpCffProfile is a generated Sophos object
+// HttpCffProfile represents a UTM http/cff_profile object
 type HttpCffProfile struct {
 	Locked         string   `json:"_locked"`
-	Reference      string   `json:"_ref"`
 	ObjectType     string   `json:"_type"`
+	Reference      string   `json:"_ref"`
 	Aaa            []string `json:"aaa"`
 	Action         string   `json:"action"`
 	CffProfileName string   `json:"cff_profile_name"`
@@ -320,49 +338,67 @@ type HttpCffProfile struct {
 	TimeEvent      string   `json:"time_event"`
 }
 
+// NewHttpCffProfile returns a HttpCffProfile with the default values of its swagger definition
+func NewHttpCffProfile() *HttpCffProfile {
+	return &HttpCffProfile{
+		ObjectType: "http/cff_profile",
+	}
+}
+
+// Validate checks the HttpCffProfile before it is sent, see ValidateWith
+func (h *HttpCffProfile) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HttpCffProfile.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HttpCffProfile) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HttpCffProfile", typeOf)
+	check.Required("name", h.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &HttpCffProfile{}
 
-// GetPath implements sophos.RestObject and returns the HttpCffProfiles GET path
+// GetPath implements sophos.RestGetter and returns the HttpCffProfiles GET path
 // Returns all available http/cff_profile objects
 func (*HttpCffProfiles) GetPath() string { return "/api/objects/http/cff_profile/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HttpCffProfiles) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HttpCffProfiles GET path
+// GetPath implements sophos.RestGetter and returns the HttpCffProfiles GET path
 // Returns all available cff_profile types
 func (h *HttpCffProfile) GetPath() string {
 	return fmt.Sprintf("/api/objects/http/cff_profile/%s", h.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HttpCffProfile) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HttpCffProfile DELETE path
+// DeletePath implements sophos.Deletable and returns the HttpCffProfile DELETE path
 // Creates or updates the complete object cff_profile
 func (*HttpCffProfile) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/cff_profile/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HttpCffProfile PATCH path
+// PatchPath implements sophos.Patchable and returns the HttpCffProfile PATCH path
 // Changes to parts of the object cff_profile types
 func (*HttpCffProfile) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/cff_profile/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HttpCffProfile POST path
+// PostPath implements sophos.Creatable and returns the HttpCffProfile POST path
 // Create a new http/cff_profile object
 func (*HttpCffProfile) PostPath() string {
 	return "/api/objects/http/cff_profile/"
 }
 
-// PutPath implements sophos.RestObject and returns the HttpCffProfile PUT path
+// PutPath implements sophos.Updatable and returns the HttpCffProfile PUT path
 // Creates or updates the complete object cff_profile
 func (*HttpCffProfile) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/cff_profile/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HttpCffProfile) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/cff_profile/%s/usedby", ref)
@@ -371,7 +407,7 @@ func (*HttpCffProfile) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (h *HttpCffProfile) GetType() string { return h.ObjectType }
 
-// HttpDeviceAuths is an Sophos Endpoint subType and implements sophos.RestObject
+// HttpDeviceAuths is an Sophos Endpoint subType and implements sophos.RestGetter
 type HttpDeviceAuths []HttpDeviceAuth
 
 // HttpDeviceAuth represents a UTM device-specific authentication
@@ -380,194 +416,321 @@ type HttpDeviceAuth struct {
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
 	// AuthMode can be one of: []string{"none", "aua", "edir_sso", "ntlm", "opendirectory_auth", "browser", "agent"}
-	AuthMode string `json:"auth_mode"`
-	Comment  string `json:"comment"`
+	AuthMode HttpDeviceAuthAuthMode `json:"auth_mode"`
+	Comment  string                 `json:"comment"`
 	// DeviceType can be one of: []string{"Windows", "Mac OS X", "Linux", "iOS", "Android", "Kindle", "Blackberry"}
-	DeviceType string `json:"device_type"`
-	Name       string `json:"name"`
+	DeviceType HttpDeviceAuthDeviceType `json:"device_type"`
+	Name       string                   `json:"name"`
+}
+
+// HttpDeviceAuthAuthMode is the AuthMode of a HttpDeviceAuth
+type HttpDeviceAuthAuthMode string
+
+// Known values of HttpDeviceAuthAuthMode
+const (
+	HttpDeviceAuthAuthModeNone              HttpDeviceAuthAuthMode = "none"
+	HttpDeviceAuthAuthModeAua               HttpDeviceAuthAuthMode = "aua"
+	HttpDeviceAuthAuthModeEdirSso           HttpDeviceAuthAuthMode = "edir_sso"
+	HttpDeviceAuthAuthModeNtlm              HttpDeviceAuthAuthMode = "ntlm"
+	HttpDeviceAuthAuthModeOpendirectoryAuth HttpDeviceAuthAuthMode = "opendirectory_auth"
+	HttpDeviceAuthAuthModeBrowser           HttpDeviceAuthAuthMode = "browser"
+	HttpDeviceAuthAuthModeAgent             HttpDeviceAuthAuthMode = "agent"
+)
+
+// Valid returns true if the value is a known HttpDeviceAuthAuthMode
+func (v HttpDeviceAuthAuthMode) Valid() bool {
+	switch v {
+	case HttpDeviceAuthAuthModeNone, HttpDeviceAuthAuthModeAua, HttpDeviceAuthAuthModeEdirSso, HttpDeviceAuthAuthModeNtlm, HttpDeviceAuthAuthModeOpendirectoryAuth, HttpDeviceAuthAuthModeBrowser, HttpDeviceAuthAuthModeAgent:
+		return true
+	}
+	return false
+}
+
+// HttpDeviceAuthDeviceType is the DeviceType of a HttpDeviceAuth
+type HttpDeviceAuthDeviceType string
+
+// Known values of HttpDeviceAuthDeviceType
+const (
+	HttpDeviceAuthDeviceTypeWindows    HttpDeviceAuthDeviceType = "Windows"
+	HttpDeviceAuthDeviceTypeMacOSX     HttpDeviceAuthDeviceType = "Mac OS X"
+	HttpDeviceAuthDeviceTypeLinux      HttpDeviceAuthDeviceType = "Linux"
+	HttpDeviceAuthDeviceTypeIOS        HttpDeviceAuthDeviceType = "iOS"
+	HttpDeviceAuthDeviceTypeAndroid    HttpDeviceAuthDeviceType = "Android"
+	HttpDeviceAuthDeviceTypeKindle     HttpDeviceAuthDeviceType = "Kindle"
+	HttpDeviceAuthDeviceTypeBlackberry HttpDeviceAuthDeviceType = "Blackberry"
+)
+
+// Valid returns true if the value is a known HttpDeviceAuthDeviceType
+func (v HttpDeviceAuthDeviceType) Valid() bool {
+	switch v {
+	case HttpDeviceAuthDeviceTypeWindows, HttpDeviceAuthDeviceTypeMacOSX, HttpDeviceAuthDeviceTypeLinux, HttpDeviceAuthDeviceTypeIOS, HttpDeviceAuthDeviceTypeAndroid, HttpDeviceAuthDeviceTypeKindle, HttpDeviceAuthDeviceTypeBlackberry:
+		return true
+	}
+	return false
+}
+
+// NewHttpDeviceAuth returns a HttpDeviceAuth with the default values of its swagger definition
+func NewHttpDeviceAuth() *HttpDeviceAuth {
+	return &HttpDeviceAuth{
+		ObjectType: "http/device_auth",
+	}
+}
+
+// Validate checks the HttpDeviceAuth before it is sent, see ValidateWith
+func (h *HttpDeviceAuth) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HttpDeviceAuth.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HttpDeviceAuth) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HttpDeviceAuth", typeOf)
+	check.Enum("auth_mode", string(h.AuthMode), h.AuthMode.Valid())
+	check.Enum("device_type", string(h.DeviceType), h.DeviceType.Valid())
+	check.Required("name", h.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &HttpDeviceAuth{}
 
-// GetPath implements sophos.RestObject and returns the HttpDeviceAuths GET path
+// GetPath implements sophos.RestGetter and returns the HttpDeviceAuths GET path
 // Returns all available http/device_auth objects
 func (*HttpDeviceAuths) GetPath() string { return "/api/objects/http/device_auth/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HttpDeviceAuths) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HttpDeviceAuths GET path
+// GetPath implements sophos.RestGetter and returns the HttpDeviceAuths GET path
 // Returns all available device_auth types
 func (h *HttpDeviceAuth) GetPath() string {
 	return fmt.Sprintf("/api/objects/http/device_auth/%s", h.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HttpDeviceAuth) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HttpDeviceAuth DELETE path
+// DeletePath implements sophos.Deletable and returns the HttpDeviceAuth DELETE path
 // Creates or updates the complete object device_auth
 func (*HttpDeviceAuth) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/device_auth/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HttpDeviceAuth PATCH path
+// PatchPath implements sophos.Patchable and returns the HttpDeviceAuth PATCH path
 // Changes to parts of the object device_auth types
 func (*HttpDeviceAuth) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/device_auth/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HttpDeviceAuth POST path
+// PostPath implements sophos.Creatable and returns the HttpDeviceAuth POST path
 // Create a new http/device_auth object
 func (*HttpDeviceAuth) PostPath() string {
 	return "/api/objects/http/device_auth/"
 }
 
-// PutPath implements sophos.RestObject and returns the HttpDeviceAuth PUT path
+// PutPath implements sophos.Updatable and returns the HttpDeviceAuth PUT path
 // Creates or updates the complete object device_auth
 func (*HttpDeviceAuth) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/device_auth/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HttpDeviceAuth) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/device_auth/%s/usedby", ref)
 }
 
-// HttpDomainRegexs is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (h *HttpDeviceAuth) GetType() string { return h.ObjectType }
+
+// HttpDomainRegexs is an Sophos Endpoint subType and implements sophos.RestGetter
 type HttpDomainRegexs []HttpDomainRegex
 
 // HttpDomainRegex represents a UTM whitelist/blacklist
 type HttpDomainRegex struct {
-	Locked     string        `json:"_locked"`
-	ObjectType string        `json:"_type"`
-	Reference  string        `json:"_ref"`
-	Comment    string        `json:"comment"`
-	Domain     []interface{} `json:"domain"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Comment    string   `json:"comment"`
+	Domain     []string `json:"domain"`
 	// IncludeSubdomains default value is false
 	IncludeSubdomains bool `json:"include_subdomains"`
 	// Mode can be one of: []string{"Domain", "Regex"}
-	Mode    string        `json:"mode"`
-	Name    string        `json:"name"`
-	Regexps []interface{} `json:"regexps"`
+	Mode    HttpDomainRegexMode `json:"mode"`
+	Name    string              `json:"name"`
+	Regexps []string            `json:"regexps"`
 	// RestrictRegex default value is false
 	RestrictRegex bool `json:"restrict_regex"`
 }
 
+// HttpDomainRegexMode is the Mode of a HttpDomainRegex
+type HttpDomainRegexMode string
+
+// Known values of HttpDomainRegexMode
+const (
+	HttpDomainRegexModeDomain HttpDomainRegexMode = "Domain"
+	HttpDomainRegexModeRegex  HttpDomainRegexMode = "Regex"
+)
+
+// Valid returns true if the value is a known HttpDomainRegexMode
+func (v HttpDomainRegexMode) Valid() bool {
+	switch v {
+	case HttpDomainRegexModeDomain, HttpDomainRegexModeRegex:
+		return true
+	}
+	return false
+}
+
+// NewHttpDomainRegex returns a HttpDomainRegex with the default values of its swagger definition
+func NewHttpDomainRegex() *HttpDomainRegex {
+	return &HttpDomainRegex{
+		ObjectType: "http/domain_regex",
+	}
+}
+
+// Validate checks the HttpDomainRegex before it is sent, see ValidateWith
+func (h *HttpDomainRegex) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HttpDomainRegex.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HttpDomainRegex) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HttpDomainRegex", typeOf)
+	check.Enum("mode", string(h.Mode), h.Mode.Valid())
+	check.Required("name", h.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &HttpDomainRegex{}
 
-// GetPath implements sophos.RestObject and returns the HttpDomainRegexs GET path
+// GetPath implements sophos.RestGetter and returns the HttpDomainRegexs GET path
 // Returns all available http/domain_regex objects
 func (*HttpDomainRegexs) GetPath() string { return "/api/objects/http/domain_regex/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HttpDomainRegexs) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HttpDomainRegexs GET path
+// GetPath implements sophos.RestGetter and returns the HttpDomainRegexs GET path
 // Returns all available domain_regex types
 func (h *HttpDomainRegex) GetPath() string {
 	return fmt.Sprintf("/api/objects/http/domain_regex/%s", h.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HttpDomainRegex) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HttpDomainRegex DELETE path
+// DeletePath implements sophos.Deletable and returns the HttpDomainRegex DELETE path
 // Creates or updates the complete object domain_regex
 func (*HttpDomainRegex) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/domain_regex/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HttpDomainRegex PATCH path
+// PatchPath implements sophos.Patchable and returns the HttpDomainRegex PATCH path
 // Changes to parts of the object domain_regex types
 func (*HttpDomainRegex) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/domain_regex/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HttpDomainRegex POST path
+// PostPath implements sophos.Creatable and returns the HttpDomainRegex POST path
 // Create a new http/domain_regex object
 func (*HttpDomainRegex) PostPath() string {
 	return "/api/objects/http/domain_regex/"
 }
 
-// PutPath implements sophos.RestObject and returns the HttpDomainRegex PUT path
+// PutPath implements sophos.Updatable and returns the HttpDomainRegex PUT path
 // Creates or updates the complete object domain_regex
 func (*HttpDomainRegex) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/domain_regex/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HttpDomainRegex) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/domain_regex/%s/usedby", ref)
 }
 
-// HttpExceptions is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (h *HttpDomainRegex) GetType() string { return h.ObjectType }
+
+// HttpExceptions is an Sophos Endpoint subType and implements sophos.RestGetter
 type HttpExceptions []HttpException
 
-// HttpException is a generated Sophos object
+// HttpException represents a UTM http/exception object
 type HttpException struct {
-	Locked          string        `json:"_locked"`
-	Reference       string        `json:"_ref"`
-	ObjectType      string        `json:"_type"`
-	Aaa             []interface{} `json:"aaa"`
-	Comment         string        `json:"comment"`
-	Domains         []string      `json:"domains"`
-	EndpointsGroups []interface{} `json:"endpoints_groups"`
-	Name            string        `json:"name"`
-	Networks        []interface{} `json:"networks"`
-	Operator        string        `json:"operator"`
-	Skiplist        []string      `json:"skiplist"`
-	SpCategories    []interface{} `json:"sp_categories"`
-	Status          bool          `json:"status"`
-	Tags            []interface{} `json:"tags"`
-	UserAgents      []interface{} `json:"user_agents"`
+	Locked          string   `json:"_locked"`
+	ObjectType      string   `json:"_type"`
+	Reference       string   `json:"_ref"`
+	Aaa             []string `json:"aaa"`
+	Comment         string   `json:"comment"`
+	Domains         []string `json:"domains"`
+	EndpointsGroups []string `json:"endpoints_groups"`
+	Name            string   `json:"name"`
+	Networks        []string `json:"networks"`
+	Operator        string   `json:"operator"`
+	Skiplist        []string `json:"skiplist"`
+	SpCategories    []string `json:"sp_categories"`
+	Status          bool     `json:"status"`
+	Tags            []string `json:"tags"`
+	UserAgents      []string `json:"user_agents"`
+}
+
+// NewHttpException returns a HttpException with the default values of its swagger definition
+func NewHttpException() *HttpException {
+	return &HttpException{
+		ObjectType: "http/exception",
+	}
+}
+
+// Validate checks the HttpException before it is sent, see ValidateWith
+func (h *HttpException) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HttpException.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HttpException) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HttpException", typeOf)
+	check.Required("name", h.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &HttpException{}
 
-// GetPath implements sophos.RestObject and returns the HttpExceptions GET path
+// GetPath implements sophos.RestGetter and returns the HttpExceptions GET path
 // Returns all available http/exception objects
 func (*HttpExceptions) GetPath() string { return "/api/objects/http/exception/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HttpExceptions) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HttpExceptions GET path
+// GetPath implements sophos.RestGetter and returns the HttpExceptions GET path
 // Returns all available exception types
 func (h *HttpException) GetPath() string {
 	return fmt.Sprintf("/api/objects/http/exception/%s", h.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HttpException) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HttpException DELETE path
+// DeletePath implements sophos.Deletable and returns the HttpException DELETE path
 // Creates or updates the complete object exception
 func (*HttpException) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/exception/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HttpException PATCH path
+// PatchPath implements sophos.Patchable and returns the HttpException PATCH path
 // Changes to parts of the object exception types
 func (*HttpException) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/exception/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HttpException POST path
+// PostPath implements sophos.Creatable and returns the HttpException POST path
 // Create a new http/exception object
 func (*HttpException) PostPath() string {
 	return "/api/objects/http/exception/"
 }
 
-// PutPath implements sophos.RestObject and returns the HttpException PUT path
+// PutPath implements sophos.Updatable and returns the HttpException PUT path
 // Creates or updates the complete object exception
 func (*HttpException) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/exception/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HttpException) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/exception/%s/usedby", ref)
@@ -576,7 +739,7 @@ func (*HttpException) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (h *HttpException) GetType() string { return h.ObjectType }
 
-// HttpGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// HttpGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type HttpGroups []HttpGroup
 
 // HttpGroup represents a UTM group
@@ -588,53 +751,74 @@ type HttpGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewHttpGroup returns a HttpGroup with the default values of its swagger definition
+func NewHttpGroup() *HttpGroup {
+	return &HttpGroup{
+		ObjectType: "http/group",
+	}
+}
+
+// Validate checks the HttpGroup before it is sent, see ValidateWith
+func (h *HttpGroup) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HttpGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HttpGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HttpGroup", typeOf)
+	check.Required("name", h.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &HttpGroup{}
 
-// GetPath implements sophos.RestObject and returns the HttpGroups GET path
+// GetPath implements sophos.RestGetter and returns the HttpGroups GET path
 // Returns all available http/group objects
 func (*HttpGroups) GetPath() string { return "/api/objects/http/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HttpGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HttpGroups GET path
+// GetPath implements sophos.RestGetter and returns the HttpGroups GET path
 // Returns all available group types
 func (h *HttpGroup) GetPath() string { return fmt.Sprintf("/api/objects/http/group/%s", h.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HttpGroup) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HttpGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the HttpGroup DELETE path
 // Creates or updates the complete object group
 func (*HttpGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HttpGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the HttpGroup PATCH path
 // Changes to parts of the object group types
 func (*HttpGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HttpGroup POST path
+// PostPath implements sophos.Creatable and returns the HttpGroup POST path
 // Create a new http/group object
 func (*HttpGroup) PostPath() string {
 	return "/api/objects/http/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the HttpGroup PUT path
+// PutPath implements sophos.Updatable and returns the HttpGroup PUT path
 // Creates or updates the complete object group
 func (*HttpGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HttpGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/group/%s/usedby", ref)
 }
 
-// HttpLocalSites is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (h *HttpGroup) GetType() string { return h.ObjectType }
+
+// HttpLocalSites is an Sophos Endpoint subType and implements sophos.RestGetter
 type HttpLocalSites []HttpLocalSite
 
 // HttpLocalSite represents a UTM local site list entry
@@ -642,69 +826,115 @@ type HttpLocalSite struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Comment    string `json:"comment"`
+	// Category description: REF(http/sp_subcat)
+	// Category default value is ""
+	Category HttpRef `json:"category"`
+	Comment  string  `json:"comment"`
 	// IncludeSubdomains default value is false
 	IncludeSubdomains bool   `json:"include_subdomains"`
 	Name              string `json:"name"`
 	// Reputation can be one of: []string{"off", "malicious", "suspicious", "unverified", "neutral", "trusted"}
 	// Reputation default value is "off"
-	Reputation string        `json:"reputation"`
-	Site       string        `json:"site"`
-	Tags       []interface{} `json:"tags"`
-	// Category description: REF(http/sp_subcat)
-	// Category default value is ""
-	Category string `json:"category"`
+	Reputation HttpLocalSiteReputation `json:"reputation"`
+	Site       string                  `json:"site"`
+	Tags       []string                `json:"tags"`
+}
+
+// HttpLocalSiteReputation is the Reputation of a HttpLocalSite
+type HttpLocalSiteReputation string
+
+// Known values of HttpLocalSiteReputation
+const (
+	HttpLocalSiteReputationOff        HttpLocalSiteReputation = "off"
+	HttpLocalSiteReputationMalicious  HttpLocalSiteReputation = "malicious"
+	HttpLocalSiteReputationSuspicious HttpLocalSiteReputation = "suspicious"
+	HttpLocalSiteReputationUnverified HttpLocalSiteReputation = "unverified"
+	HttpLocalSiteReputationNeutral    HttpLocalSiteReputation = "neutral"
+	HttpLocalSiteReputationTrusted    HttpLocalSiteReputation = "trusted"
+)
+
+// Valid returns true if the value is a known HttpLocalSiteReputation
+func (v HttpLocalSiteReputation) Valid() bool {
+	switch v {
+	case HttpLocalSiteReputationOff, HttpLocalSiteReputationMalicious, HttpLocalSiteReputationSuspicious, HttpLocalSiteReputationUnverified, HttpLocalSiteReputationNeutral, HttpLocalSiteReputationTrusted:
+		return true
+	}
+	return false
+}
+
+// NewHttpLocalSite returns a HttpLocalSite with the default values of its swagger definition
+func NewHttpLocalSite() *HttpLocalSite {
+	return &HttpLocalSite{
+		ObjectType: "http/local_site",
+		Reputation: HttpLocalSiteReputationOff,
+	}
+}
+
+// Validate checks the HttpLocalSite before it is sent, see ValidateWith
+func (h *HttpLocalSite) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HttpLocalSite.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HttpLocalSite) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HttpLocalSite", typeOf)
+	check.Ref("category", string(h.Category), "REF(http/sp_subcat)")
+	check.Required("name", h.Name)
+	check.Enum("reputation", string(h.Reputation), h.Reputation.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &HttpLocalSite{}
 
-// GetPath implements sophos.RestObject and returns the HttpLocalSites GET path
+// GetPath implements sophos.RestGetter and returns the HttpLocalSites GET path
 // Returns all available http/local_site objects
 func (*HttpLocalSites) GetPath() string { return "/api/objects/http/local_site/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HttpLocalSites) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HttpLocalSites GET path
+// GetPath implements sophos.RestGetter and returns the HttpLocalSites GET path
 // Returns all available local_site types
 func (h *HttpLocalSite) GetPath() string {
 	return fmt.Sprintf("/api/objects/http/local_site/%s", h.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HttpLocalSite) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HttpLocalSite DELETE path
+// DeletePath implements sophos.Deletable and returns the HttpLocalSite DELETE path
 // Creates or updates the complete object local_site
 func (*HttpLocalSite) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/local_site/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HttpLocalSite PATCH path
+// PatchPath implements sophos.Patchable and returns the HttpLocalSite PATCH path
 // Changes to parts of the object local_site types
 func (*HttpLocalSite) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/local_site/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HttpLocalSite POST path
+// PostPath implements sophos.Creatable and returns the HttpLocalSite POST path
 // Create a new http/local_site object
 func (*HttpLocalSite) PostPath() string {
 	return "/api/objects/http/local_site/"
 }
 
-// PutPath implements sophos.RestObject and returns the HttpLocalSite PUT path
+// PutPath implements sophos.Updatable and returns the HttpLocalSite PUT path
 // Creates or updates the complete object local_site
 func (*HttpLocalSite) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/local_site/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HttpLocalSite) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/local_site/%s/usedby", ref)
 }
 
-// HttpLslTags is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (h *HttpLocalSite) GetType() string { return h.ObjectType }
+
+// HttpLslTags is an Sophos Endpoint subType and implements sophos.RestGetter
 type HttpLslTags []HttpLslTag
 
 // HttpLslTag represents a UTM tags
@@ -716,109 +946,150 @@ type HttpLslTag struct {
 	Name       string `json:"name"`
 }
 
+// NewHttpLslTag returns a HttpLslTag with the default values of its swagger definition
+func NewHttpLslTag() *HttpLslTag {
+	return &HttpLslTag{
+		ObjectType: "http/lsl_tag",
+	}
+}
+
+// Validate checks the HttpLslTag before it is sent, see ValidateWith
+func (h *HttpLslTag) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HttpLslTag.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HttpLslTag) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HttpLslTag", typeOf)
+	check.Required("name", h.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &HttpLslTag{}
 
-// GetPath implements sophos.RestObject and returns the HttpLslTags GET path
+// GetPath implements sophos.RestGetter and returns the HttpLslTags GET path
 // Returns all available http/lsl_tag objects
 func (*HttpLslTags) GetPath() string { return "/api/objects/http/lsl_tag/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HttpLslTags) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HttpLslTags GET path
+// GetPath implements sophos.RestGetter and returns the HttpLslTags GET path
 // Returns all available lsl_tag types
-func (h *HttpLslTag) GetPath() string { return fmt.Sprintf("/api/objects/http/lsl_tag/%s", h.Reference) }
+func (h *HttpLslTag) GetPath() string {
+	return fmt.Sprintf("/api/objects/http/lsl_tag/%s", h.Reference)
+}
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HttpLslTag) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HttpLslTag DELETE path
+// DeletePath implements sophos.Deletable and returns the HttpLslTag DELETE path
 // Creates or updates the complete object lsl_tag
 func (*HttpLslTag) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/lsl_tag/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HttpLslTag PATCH path
+// PatchPath implements sophos.Patchable and returns the HttpLslTag PATCH path
 // Changes to parts of the object lsl_tag types
 func (*HttpLslTag) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/lsl_tag/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HttpLslTag POST path
+// PostPath implements sophos.Creatable and returns the HttpLslTag POST path
 // Create a new http/lsl_tag object
 func (*HttpLslTag) PostPath() string {
 	return "/api/objects/http/lsl_tag/"
 }
 
-// PutPath implements sophos.RestObject and returns the HttpLslTag PUT path
+// PutPath implements sophos.Updatable and returns the HttpLslTag PUT path
 // Creates or updates the complete object lsl_tag
 func (*HttpLslTag) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/lsl_tag/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HttpLslTag) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/lsl_tag/%s/usedby", ref)
 }
 
-// HttpPacFiles is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (h *HttpLslTag) GetType() string { return h.ObjectType }
+
+// HttpPacFiles is an Sophos Endpoint subType and implements sophos.RestGetter
 type HttpPacFiles []HttpPacFile
 
-// HttpPacFile is a generated Sophos object
+// HttpPacFile represents a UTM http/pac_file object
 type HttpPacFile struct {
 	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
 	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	Comment    string `json:"comment"`
 	Content    string `json:"content"`
 	Name       string `json:"name"`
 	Status     bool   `json:"status"`
 }
 
+// NewHttpPacFile returns a HttpPacFile with the default values of its swagger definition
+func NewHttpPacFile() *HttpPacFile {
+	return &HttpPacFile{
+		ObjectType: "http/pac_file",
+	}
+}
+
+// Validate checks the HttpPacFile before it is sent, see ValidateWith
+func (h *HttpPacFile) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HttpPacFile.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HttpPacFile) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HttpPacFile", typeOf)
+	check.Required("name", h.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &HttpPacFile{}
 
-// GetPath implements sophos.RestObject and returns the HttpPacFiles GET path
+// GetPath implements sophos.RestGetter and returns the HttpPacFiles GET path
 // Returns all available http/pac_file objects
 func (*HttpPacFiles) GetPath() string { return "/api/objects/http/pac_file/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HttpPacFiles) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HttpPacFiles GET path
+// GetPath implements sophos.RestGetter and returns the HttpPacFiles GET path
 // Returns all available pac_file types
 func (h *HttpPacFile) GetPath() string {
 	return fmt.Sprintf("/api/objects/http/pac_file/%s", h.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HttpPacFile) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HttpPacFile DELETE path
+// DeletePath implements sophos.Deletable and returns the HttpPacFile DELETE path
 // Creates or updates the complete object pac_file
 func (*HttpPacFile) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/pac_file/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HttpPacFile PATCH path
+// PatchPath implements sophos.Patchable and returns the HttpPacFile PATCH path
 // Changes to parts of the object pac_file types
 func (*HttpPacFile) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/pac_file/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HttpPacFile POST path
+// PostPath implements sophos.Creatable and returns the HttpPacFile POST path
 // Create a new http/pac_file object
 func (*HttpPacFile) PostPath() string {
 	return "/api/objects/http/pac_file/"
 }
 
-// PutPath implements sophos.RestObject and returns the HttpPacFile PUT path
+// PutPath implements sophos.Updatable and returns the HttpPacFile PUT path
 // Creates or updates the complete object pac_file
 func (*HttpPacFile) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/pac_file/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HttpPacFile) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/pac_file/%s/usedby", ref)
@@ -827,149 +1098,191 @@ func (*HttpPacFile) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (h *HttpPacFile) GetType() string { return h.ObjectType }
 
-// HttpParentProxys is an Sophos Endpoint subType and implements sophos.RestObject
+// HttpParentProxys is an Sophos Endpoint subType and implements sophos.RestGetter
 type HttpParentProxys []HttpParentProxy
 
 // HttpParentProxy represents a UTM parent web proxy
 type HttpParentProxy struct {
-	Locked     string `json:"_locked"`
-	ObjectType string `json:"_type"`
-	Reference  string `json:"_ref"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Comment    string   `json:"comment"`
+	Match      []string `json:"match"`
+	Name       string   `json:"name"`
+	Pass       string   `json:"pass"`
+	Port       int64    `json:"port"`
 	// Target description: REF(network/host), REF(network/dns_host), REF(network/availability_group)
-	Target  string        `json:"target"`
-	User    string        `json:"user"`
-	Comment string        `json:"comment"`
-	Match   []interface{} `json:"match"`
-	Name    string        `json:"name"`
-	Pass    string        `json:"pass"`
-	Port    int           `json:"port"`
+	Target NetworkRef `json:"target"`
+	User   string     `json:"user"`
+}
+
+// NewHttpParentProxy returns a HttpParentProxy with the default values of its swagger definition
+func NewHttpParentProxy() *HttpParentProxy {
+	return &HttpParentProxy{
+		ObjectType: "http/parent_proxy",
+	}
+}
+
+// Validate checks the HttpParentProxy before it is sent, see ValidateWith
+func (h *HttpParentProxy) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HttpParentProxy.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HttpParentProxy) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HttpParentProxy", typeOf)
+	check.Required("name", h.Name)
+	check.Range("port", h.Port, "0-65535")
+	check.Required("target", string(h.Target))
+	check.Ref("target", string(h.Target), "REF(network/host), REF(network/dns_host), REF(network/availability_group)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &HttpParentProxy{}
 
-// GetPath implements sophos.RestObject and returns the HttpParentProxys GET path
+// GetPath implements sophos.RestGetter and returns the HttpParentProxys GET path
 // Returns all available http/parent_proxy objects
 func (*HttpParentProxys) GetPath() string { return "/api/objects/http/parent_proxy/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HttpParentProxys) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HttpParentProxys GET path
+// GetPath implements sophos.RestGetter and returns the HttpParentProxys GET path
 // Returns all available parent_proxy types
 func (h *HttpParentProxy) GetPath() string {
 	return fmt.Sprintf("/api/objects/http/parent_proxy/%s", h.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HttpParentProxy) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HttpParentProxy DELETE path
+// DeletePath implements sophos.Deletable and returns the HttpParentProxy DELETE path
 // Creates or updates the complete object parent_proxy
 func (*HttpParentProxy) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/parent_proxy/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HttpParentProxy PATCH path
+// PatchPath implements sophos.Patchable and returns the HttpParentProxy PATCH path
 // Changes to parts of the object parent_proxy types
 func (*HttpParentProxy) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/parent_proxy/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HttpParentProxy POST path
+// PostPath implements sophos.Creatable and returns the HttpParentProxy POST path
 // Create a new http/parent_proxy object
 func (*HttpParentProxy) PostPath() string {
 	return "/api/objects/http/parent_proxy/"
 }
 
-// PutPath implements sophos.RestObject and returns the HttpParentProxy PUT path
+// PutPath implements sophos.Updatable and returns the HttpParentProxy PUT path
 // Creates or updates the complete object parent_proxy
 func (*HttpParentProxy) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/parent_proxy/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HttpParentProxy) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/parent_proxy/%s/usedby", ref)
 }
 
-// HttpProfiles is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (h *HttpParentProxy) GetType() string { return h.ObjectType }
+
+// HttpProfiles is an Sophos Endpoint subType and implements sophos.RestGetter
 type HttpProfiles []HttpProfile
 
-// HttpProfile is a generated Sophos object
+// HttpProfile represents a UTM http/profile object
 type HttpProfile struct {
-	Locked             string        `json:"_locked"`
-	Reference          string        `json:"_ref"`
-	ObjectType         string        `json:"_type"`
-	Aua                bool          `json:"aua"`
-	BlockOnAuthFailed  bool          `json:"block_on_auth_failed"`
-	CffProfiles        []string      `json:"cff_profiles"`
-	Comment            string        `json:"comment"`
-	DefaultCffAction   string        `json:"default_cff_action"`
-	DeviceAuth         []interface{} `json:"device_auth"`
-	EdirSso            bool          `json:"edir_sso"`
-	EnableDeviceAuth   bool          `json:"enable_device_auth"`
-	EndpointsGroups    []interface{} `json:"endpoints_groups"`
-	FullTransparent    bool          `json:"full_transparent"`
-	InProgress         bool          `json:"in_progress"`
-	Name               string        `json:"name"`
-	Networks           []interface{} `json:"networks"`
-	Ntlm               bool          `json:"ntlm"`
-	OpendirectoryAuth  bool          `json:"opendirectory_auth"`
-	OrderedCffProfiles []string      `json:"ordered_cff_profiles"`
-	OutInterface       string        `json:"out_interface"`
-	ScanSslOpt         string        `json:"scan_ssl_opt"`
-	SelectiveScanCat   []string      `json:"selective_scan_cat"`
-	SelectiveScanTags  []interface{} `json:"selective_scan_tags"`
-	Status             bool          `json:"status"`
-	Transparent        bool          `json:"transparent"`
-	TransparentAac     bool          `json:"transparent_aac"`
-	TransparentAuth    bool          `json:"transparent_auth"`
+	Locked             string   `json:"_locked"`
+	ObjectType         string   `json:"_type"`
+	Reference          string   `json:"_ref"`
+	Aua                bool     `json:"aua"`
+	BlockOnAuthFailed  bool     `json:"block_on_auth_failed"`
+	CffProfiles        []string `json:"cff_profiles"`
+	Comment            string   `json:"comment"`
+	DefaultCffAction   string   `json:"default_cff_action"`
+	DeviceAuth         []string `json:"device_auth"`
+	EdirSso            bool     `json:"edir_sso"`
+	EnableDeviceAuth   bool     `json:"enable_device_auth"`
+	EndpointsGroups    []string `json:"endpoints_groups"`
+	FullTransparent    bool     `json:"full_transparent"`
+	InProgress         bool     `json:"in_progress"`
+	Name               string   `json:"name"`
+	Networks           []string `json:"networks"`
+	Ntlm               bool     `json:"ntlm"`
+	OpendirectoryAuth  bool     `json:"opendirectory_auth"`
+	OrderedCffProfiles []string `json:"ordered_cff_profiles"`
+	OutInterface       string   `json:"out_interface"`
+	ScanSslOpt         string   `json:"scan_ssl_opt"`
+	SelectiveScanCat   []string `json:"selective_scan_cat"`
+	SelectiveScanTags  []string `json:"selective_scan_tags"`
+	Status             bool     `json:"status"`
+	Transparent        bool     `json:"transparent"`
+	TransparentAac     bool     `json:"transparent_aac"`
+	TransparentAuth    bool     `json:"transparent_auth"`
+}
+
+// NewHttpProfile returns a HttpProfile with the default values of its swagger definition
+func NewHttpProfile() *HttpProfile {
+	return &HttpProfile{
+		ObjectType: "http/profile",
+	}
+}
+
+// Validate checks the HttpProfile before it is sent, see ValidateWith
+func (h *HttpProfile) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HttpProfile.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HttpProfile) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HttpProfile", typeOf)
+	check.Required("name", h.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &HttpProfile{}
 
-// GetPath implements sophos.RestObject and returns the HttpProfiles GET path
+// GetPath implements sophos.RestGetter and returns the HttpProfiles GET path
 // Returns all available http/profile objects
 func (*HttpProfiles) GetPath() string { return "/api/objects/http/profile/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HttpProfiles) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HttpProfiles GET path
+// GetPath implements sophos.RestGetter and returns the HttpProfiles GET path
 // Returns all available profile types
 func (h *HttpProfile) GetPath() string {
 	return fmt.Sprintf("/api/objects/http/profile/%s", h.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HttpProfile) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HttpProfile DELETE path
+// DeletePath implements sophos.Deletable and returns the HttpProfile DELETE path
 // Creates or updates the complete object profile
 func (*HttpProfile) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/profile/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HttpProfile PATCH path
+// PatchPath implements sophos.Patchable and returns the HttpProfile PATCH path
 // Changes to parts of the object profile types
 func (*HttpProfile) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/profile/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HttpProfile POST path
+// PostPath implements sophos.Creatable and returns the HttpProfile POST path
 // Create a new http/profile object
 func (*HttpProfile) PostPath() string {
 	return "/api/objects/http/profile/"
 }
 
-// PutPath implements sophos.RestObject and returns the HttpProfile PUT path
+// PutPath implements sophos.Updatable and returns the HttpProfile PUT path
 // Creates or updates the complete object profile
 func (*HttpProfile) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/profile/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HttpProfile) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/profile/%s/usedby", ref)
@@ -978,63 +1291,81 @@ func (*HttpProfile) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (h *HttpProfile) GetType() string { return h.ObjectType }
 
-// HttpSpCategorys is an Sophos Endpoint subType and implements sophos.RestObject
+// HttpSpCategorys is an Sophos Endpoint subType and implements sophos.RestGetter
 type HttpSpCategorys []HttpSpCategory
 
-// HttpSpCategory is a generated Sophos object
+// HttpSpCategory represents a UTM http/sp_category object
 type HttpSpCategory struct {
 	Locked     string   `json:"_locked"`
-	Reference  string   `json:"_ref"`
 	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
 	Comment    string   `json:"comment"`
 	ID         string   `json:"id"`
 	Name       string   `json:"name"`
 	Subcats    []string `json:"subcats"`
 }
 
+// NewHttpSpCategory returns a HttpSpCategory with the default values of its swagger definition
+func NewHttpSpCategory() *HttpSpCategory {
+	return &HttpSpCategory{
+		ObjectType: "http/sp_category",
+	}
+}
+
+// Validate checks the HttpSpCategory before it is sent, see ValidateWith
+func (h *HttpSpCategory) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HttpSpCategory.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HttpSpCategory) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HttpSpCategory", typeOf)
+	check.Required("name", h.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &HttpSpCategory{}
 
-// GetPath implements sophos.RestObject and returns the HttpSpCategorys GET path
+// GetPath implements sophos.RestGetter and returns the HttpSpCategorys GET path
 // Returns all available http/sp_category objects
 func (*HttpSpCategorys) GetPath() string { return "/api/objects/http/sp_category/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HttpSpCategorys) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HttpSpCategorys GET path
+// GetPath implements sophos.RestGetter and returns the HttpSpCategorys GET path
 // Returns all available sp_category types
 func (h *HttpSpCategory) GetPath() string {
 	return fmt.Sprintf("/api/objects/http/sp_category/%s", h.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HttpSpCategory) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HttpSpCategory DELETE path
+// DeletePath implements sophos.Deletable and returns the HttpSpCategory DELETE path
 // Creates or updates the complete object sp_category
 func (*HttpSpCategory) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/sp_category/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HttpSpCategory PATCH path
+// PatchPath implements sophos.Patchable and returns the HttpSpCategory PATCH path
 // Changes to parts of the object sp_category types
 func (*HttpSpCategory) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/sp_category/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HttpSpCategory POST path
+// PostPath implements sophos.Creatable and returns the HttpSpCategory POST path
 // Create a new http/sp_category object
 func (*HttpSpCategory) PostPath() string {
 	return "/api/objects/http/sp_category/"
 }
 
-// PutPath implements sophos.RestObject and returns the HttpSpCategory PUT path
+// PutPath implements sophos.Updatable and returns the HttpSpCategory PUT path
 // Creates or updates the complete object sp_category
 func (*HttpSpCategory) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/sp_category/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HttpSpCategory) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/sp_category/%s/usedby", ref)
@@ -1043,62 +1374,80 @@ func (*HttpSpCategory) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (h *HttpSpCategory) GetType() string { return h.ObjectType }
 
-// HttpSpSubcats is an Sophos Endpoint subType and implements sophos.RestObject
+// HttpSpSubcats is an Sophos Endpoint subType and implements sophos.RestGetter
 type HttpSpSubcats []HttpSpSubcat
 
-// HttpSpSubcat is a generated Sophos object
+// HttpSpSubcat represents a UTM http/sp_subcat object
 type HttpSpSubcat struct {
 	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
 	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	Comment    string `json:"comment"`
 	ID         string `json:"id"`
 	Name       string `json:"name"`
 }
 
+// NewHttpSpSubcat returns a HttpSpSubcat with the default values of its swagger definition
+func NewHttpSpSubcat() *HttpSpSubcat {
+	return &HttpSpSubcat{
+		ObjectType: "http/sp_subcat",
+	}
+}
+
+// Validate checks the HttpSpSubcat before it is sent, see ValidateWith
+func (h *HttpSpSubcat) Validate() error { return h.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the HttpSpSubcat.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (h *HttpSpSubcat) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("HttpSpSubcat", typeOf)
+	check.Required("name", h.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &HttpSpSubcat{}
 
-// GetPath implements sophos.RestObject and returns the HttpSpSubcats GET path
+// GetPath implements sophos.RestGetter and returns the HttpSpSubcats GET path
 // Returns all available http/sp_subcat objects
 func (*HttpSpSubcats) GetPath() string { return "/api/objects/http/sp_subcat/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*HttpSpSubcats) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the HttpSpSubcats GET path
+// GetPath implements sophos.RestGetter and returns the HttpSpSubcats GET path
 // Returns all available sp_subcat types
 func (h *HttpSpSubcat) GetPath() string {
 	return fmt.Sprintf("/api/objects/http/sp_subcat/%s", h.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (h *HttpSpSubcat) RefRequired() (string, bool) { return h.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the HttpSpSubcat DELETE path
+// DeletePath implements sophos.Deletable and returns the HttpSpSubcat DELETE path
 // Creates or updates the complete object sp_subcat
 func (*HttpSpSubcat) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/sp_subcat/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the HttpSpSubcat PATCH path
+// PatchPath implements sophos.Patchable and returns the HttpSpSubcat PATCH path
 // Changes to parts of the object sp_subcat types
 func (*HttpSpSubcat) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/sp_subcat/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the HttpSpSubcat POST path
+// PostPath implements sophos.Creatable and returns the HttpSpSubcat POST path
 // Create a new http/sp_subcat object
 func (*HttpSpSubcat) PostPath() string {
 	return "/api/objects/http/sp_subcat/"
 }
 
-// PutPath implements sophos.RestObject and returns the HttpSpSubcat PUT path
+// PutPath implements sophos.Updatable and returns the HttpSpSubcat PUT path
 // Creates or updates the complete object sp_subcat
 func (*HttpSpSubcat) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/sp_subcat/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*HttpSpSubcat) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/http/sp_subcat/%s/usedby", ref)
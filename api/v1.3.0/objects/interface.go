@@ -22,7 +22,7 @@ type Interface struct {
 
 var _ sophos.Endpoint = &Interface{}
 
-var defsInterface = map[string]sophos.RestObject{
+var defsInterface = map[string]sophos.RestGetter{
 	"InterfaceBridge":   &InterfaceBridge{},
 	"InterfaceEthernet": &InterfaceEthernet{},
 	"InterfaceGroup":    &InterfaceGroup{},
@@ -35,7 +35,7 @@ var defsInterface = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Interface's Objects
-func (Interface) RestObjects() map[string]sophos.RestObject { return defsInterface }
+func (Interface) RestObjects() map[string]sophos.RestGetter { return defsInterface }
 
 // GetPath implements sophos.RestGetter
 func (*Interface) GetPath() string { return "/api/nodes/interface" }
@@ -97,171 +97,217 @@ func (Interface) References() []string {
 	}
 }
 
-// InterfaceBridges is an Sophos Endpoint subType and implements sophos.RestObject
+// InterfaceBridges is an Sophos Endpoint subType and implements sophos.RestGetter
 type InterfaceBridges []InterfaceBridge
 
 // InterfaceBridge represents a UTM bridge
 type InterfaceBridge struct {
-	Locked     string `json:"_locked"`
-	ObjectType string `json:"_type"`
-	Reference  string `json:"_ref"`
-	// UseDhcp default value is false
-	UseDhcp bool `json:"use_dhcp"`
-	// UseDhcpv6 default value is false
-	UseDhcpv6 bool `json:"use_dhcpv6"`
+	Locked              string   `json:"_locked"`
+	ObjectType          string   `json:"_type"`
+	Reference           string   `json:"_ref"`
+	AdditionalAddresses []string `json:"additional_addresses"`
+	Ageing              int64    `json:"ageing"`
 	// ArpBcast default value is false
-	ArpBcast bool `json:"arp_bcast"`
+	ArpBcast bool   `json:"arp_bcast"`
+	Comment  string `json:"comment"`
+	// ConvertedFromHw description: REF(itfhw/*)
+	// ConvertedFromHw default value is ""
+	ConvertedFromHw     ItfhwRef `json:"converted_from_hw"`
+	ForwardedEthertypes []string `json:"forwarded_ethertypes"`
 	// Itfhw description: REF(itfhw/bridge)
-	Itfhw string `json:"itfhw"`
-	Name  string `json:"name"`
-	// Proxyndp default value is false
-	Proxyndp bool `json:"proxyndp"`
-	Mtu      int  `json:"mtu"`
+	Itfhw ItfhwRef `json:"itfhw"`
+	// Link default value is true
+	Link bool  `json:"link"`
+	Mtu  int64 `json:"mtu"`
+	// MtuAutoDiscovery default value is false
+	MtuAutoDiscovery bool     `json:"mtu_auto_discovery"`
+	Name             string   `json:"name"`
+	Ports            []string `json:"ports"`
+	// PrimaryAddress description: REF(itfparams/primary)
+	// PrimaryAddress default value is ""
+	PrimaryAddress ItfparamsRef `json:"primary_address"`
 	// Proxyarp default value is false
 	Proxyarp bool `json:"proxyarp"`
+	// Proxyndp default value is false
+	Proxyndp bool `json:"proxyndp"`
 	// Status default value is false
-	Status              bool          `json:"status"`
-	StpHello            int           `json:"stp_hello"`
-	AdditionalAddresses []interface{} `json:"additional_addresses"`
-	Ageing              int           `json:"ageing"`
-	ForwardedEthertypes []interface{} `json:"forwarded_ethertypes"`
-	// Link default value is true
-	Link bool `json:"link"`
+	Status    bool  `json:"status"`
+	StpFd     int64 `json:"stp_fd"`
+	StpHello  int64 `json:"stp_hello"`
+	StpMaxage int64 `json:"stp_maxage"`
+	StpPrio   int64 `json:"stp_prio"`
+	// StpStatus default value is false
+	StpStatus bool `json:"stp_status"`
+	// UseDhcp default value is false
+	UseDhcp bool `json:"use_dhcp"`
+	// UseDhcpv6 default value is false
+	UseDhcpv6 bool `json:"use_dhcpv6"`
 	// VirtualMac description: (MACADDR)
 	// VirtualMac default value is "00:00:00:00:00:00"
 	VirtualMac string `json:"virtual_mac"`
-	// MtuAutoDiscovery default value is false
-	MtuAutoDiscovery bool          `json:"mtu_auto_discovery"`
-	Ports            []interface{} `json:"ports"`
-	StpMaxage        int           `json:"stp_maxage"`
-	// StpStatus default value is false
-	StpStatus bool   `json:"stp_status"`
-	StpPrio   int    `json:"stp_prio"`
-	Comment   string `json:"comment"`
-	// ConvertedFromHw description: REF(itfhw/*)
-	// ConvertedFromHw default value is ""
-	ConvertedFromHw string `json:"converted_from_hw"`
-	// PrimaryAddress description: REF(itfparams/primary)
-	// PrimaryAddress default value is ""
-	PrimaryAddress string `json:"primary_address"`
-	StpFd          int    `json:"stp_fd"`
+}
+
+// NewInterfaceBridge returns a InterfaceBridge with the default values of its swagger definition
+func NewInterfaceBridge() *InterfaceBridge {
+	return &InterfaceBridge{
+		ObjectType: "interface/bridge",
+		Link:       true,
+		VirtualMac: "00:00:00:00:00:00",
+	}
+}
+
+// Validate checks the InterfaceBridge before it is sent, see ValidateWith
+func (i *InterfaceBridge) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the InterfaceBridge.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *InterfaceBridge) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("InterfaceBridge", typeOf)
+	check.Ref("converted_from_hw", string(i.ConvertedFromHw), "REF(itfhw/*)")
+	check.Required("itfhw", string(i.Itfhw))
+	check.Ref("itfhw", string(i.Itfhw), "REF(itfhw/bridge)")
+	check.Required("name", i.Name)
+	check.Ref("primary_address", string(i.PrimaryAddress), "REF(itfparams/primary)")
+	check.Format("virtual_mac", sophos.FormatMAC, i.VirtualMac)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &InterfaceBridge{}
 
-// GetPath implements sophos.RestObject and returns the InterfaceBridges GET path
+// GetPath implements sophos.RestGetter and returns the InterfaceBridges GET path
 // Returns all available interface/bridge objects
 func (*InterfaceBridges) GetPath() string { return "/api/objects/interface/bridge/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*InterfaceBridges) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the InterfaceBridges GET path
+// GetPath implements sophos.RestGetter and returns the InterfaceBridges GET path
 // Returns all available bridge types
 func (i *InterfaceBridge) GetPath() string {
 	return fmt.Sprintf("/api/objects/interface/bridge/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *InterfaceBridge) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the InterfaceBridge DELETE path
+// DeletePath implements sophos.Deletable and returns the InterfaceBridge DELETE path
 // Creates or updates the complete object bridge
 func (*InterfaceBridge) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/bridge/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the InterfaceBridge PATCH path
+// PatchPath implements sophos.Patchable and returns the InterfaceBridge PATCH path
 // Changes to parts of the object bridge types
 func (*InterfaceBridge) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/bridge/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the InterfaceBridge POST path
+// PostPath implements sophos.Creatable and returns the InterfaceBridge POST path
 // Create a new interface/bridge object
 func (*InterfaceBridge) PostPath() string {
 	return "/api/objects/interface/bridge/"
 }
 
-// PutPath implements sophos.RestObject and returns the InterfaceBridge PUT path
+// PutPath implements sophos.Updatable and returns the InterfaceBridge PUT path
 // Creates or updates the complete object bridge
 func (*InterfaceBridge) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/bridge/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*InterfaceBridge) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/bridge/%s/usedby", ref)
 }
 
-// InterfaceEthernets is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *InterfaceBridge) GetType() string { return i.ObjectType }
+
+// InterfaceEthernets is an Sophos Endpoint subType and implements sophos.RestGetter
 type InterfaceEthernets []InterfaceEthernet
 
-// InterfaceEthernet is a generated Sophos object
+// InterfaceEthernet represents a UTM interface/ethernet object
 type InterfaceEthernet struct {
-	Locked              string        `json:"_locked"`
-	Reference           string        `json:"_ref"`
-	ObjectType          string        `json:"_type"`
-	AdditionalAddresses []interface{} `json:"additional_addresses"`
-	Bandwidth           int64         `json:"bandwidth"`
-	Comment             string        `json:"comment"`
-	Inbandwidth         int64         `json:"inbandwidth"`
-	Itfhw               string        `json:"itfhw"`
-	Link                bool          `json:"link"`
-	Mtu                 int64         `json:"mtu"`
-	MtuAutoDiscovery    bool          `json:"mtu_auto_discovery"`
-	Name                string        `json:"name"`
-	Outbandwidth        int64         `json:"outbandwidth"`
-	PrimaryAddress      string        `json:"primary_address"`
-	Proxyarp            bool          `json:"proxyarp"`
-	Proxyndp            bool          `json:"proxyndp"`
-	Status              bool          `json:"status"`
+	Locked              string   `json:"_locked"`
+	ObjectType          string   `json:"_type"`
+	Reference           string   `json:"_ref"`
+	AdditionalAddresses []string `json:"additional_addresses"`
+	Bandwidth           int64    `json:"bandwidth"`
+	Comment             string   `json:"comment"`
+	Inbandwidth         int64    `json:"inbandwidth"`
+	Itfhw               string   `json:"itfhw"`
+	Link                bool     `json:"link"`
+	Mtu                 int64    `json:"mtu"`
+	MtuAutoDiscovery    bool     `json:"mtu_auto_discovery"`
+	Name                string   `json:"name"`
+	Outbandwidth        int64    `json:"outbandwidth"`
+	PrimaryAddress      string   `json:"primary_address"`
+	Proxyarp            bool     `json:"proxyarp"`
+	Proxyndp            bool     `json:"proxyndp"`
+	Status              bool     `json:"status"`
+}
+
+// NewInterfaceEthernet returns a InterfaceEthernet with the default values of its swagger definition
+func NewInterfaceEthernet() *InterfaceEthernet {
+	return &InterfaceEthernet{
+		ObjectType: "interface/ethernet",
+	}
+}
+
+// Validate checks the InterfaceEthernet before it is sent, see ValidateWith
+func (i *InterfaceEthernet) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the InterfaceEthernet.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *InterfaceEthernet) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("InterfaceEthernet", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &InterfaceEthernet{}
 
-// GetPath implements sophos.RestObject and returns the InterfaceEthernets GET path
+// GetPath implements sophos.RestGetter and returns the InterfaceEthernets GET path
 // Returns all available interface/ethernet objects
 func (*InterfaceEthernets) GetPath() string { return "/api/objects/interface/ethernet/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*InterfaceEthernets) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the InterfaceEthernets GET path
+// GetPath implements sophos.RestGetter and returns the InterfaceEthernets GET path
 // Returns all available ethernet types
 func (i *InterfaceEthernet) GetPath() string {
 	return fmt.Sprintf("/api/objects/interface/ethernet/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *InterfaceEthernet) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the InterfaceEthernet DELETE path
+// DeletePath implements sophos.Deletable and returns the InterfaceEthernet DELETE path
 // Creates or updates the complete object ethernet
 func (*InterfaceEthernet) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/ethernet/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the InterfaceEthernet PATCH path
+// PatchPath implements sophos.Patchable and returns the InterfaceEthernet PATCH path
 // Changes to parts of the object ethernet types
 func (*InterfaceEthernet) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/ethernet/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the InterfaceEthernet POST path
+// PostPath implements sophos.Creatable and returns the InterfaceEthernet POST path
 // Create a new interface/ethernet object
 func (*InterfaceEthernet) PostPath() string {
 	return "/api/objects/interface/ethernet/"
 }
 
-// PutPath implements sophos.RestObject and returns the InterfaceEthernet PUT path
+// PutPath implements sophos.Updatable and returns the InterfaceEthernet PUT path
 // Creates or updates the complete object ethernet
 func (*InterfaceEthernet) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/ethernet/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*InterfaceEthernet) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/ethernet/%s/usedby", ref)
@@ -270,64 +316,82 @@ func (*InterfaceEthernet) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (i *InterfaceEthernet) GetType() string { return i.ObjectType }
 
-// InterfaceGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// InterfaceGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type InterfaceGroups []InterfaceGroup
 
-// InterfaceGroup is a generated Sophos object
+// InterfaceGroup represents a UTM interface/group object
 type InterfaceGroup struct {
-	Locked           string        `json:"_locked"`
-	Reference        string        `json:"_ref"`
-	ObjectType       string        `json:"_type"`
-	Comment          string        `json:"comment"`
-	Link             bool          `json:"link"`
-	Members          []interface{} `json:"members"`
-	Name             string        `json:"name"`
-	PrimaryAddresses string        `json:"primary_addresses"`
+	Locked           string   `json:"_locked"`
+	ObjectType       string   `json:"_type"`
+	Reference        string   `json:"_ref"`
+	Comment          string   `json:"comment"`
+	Link             bool     `json:"link"`
+	Members          []string `json:"members"`
+	Name             string   `json:"name"`
+	PrimaryAddresses string   `json:"primary_addresses"`
+}
+
+// NewInterfaceGroup returns a InterfaceGroup with the default values of its swagger definition
+func NewInterfaceGroup() *InterfaceGroup {
+	return &InterfaceGroup{
+		ObjectType: "interface/group",
+	}
+}
+
+// Validate checks the InterfaceGroup before it is sent, see ValidateWith
+func (i *InterfaceGroup) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the InterfaceGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *InterfaceGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("InterfaceGroup", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &InterfaceGroup{}
 
-// GetPath implements sophos.RestObject and returns the InterfaceGroups GET path
+// GetPath implements sophos.RestGetter and returns the InterfaceGroups GET path
 // Returns all available interface/group objects
 func (*InterfaceGroups) GetPath() string { return "/api/objects/interface/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*InterfaceGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the InterfaceGroups GET path
+// GetPath implements sophos.RestGetter and returns the InterfaceGroups GET path
 // Returns all available group types
 func (i *InterfaceGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/interface/group/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *InterfaceGroup) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the InterfaceGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the InterfaceGroup DELETE path
 // Creates or updates the complete object group
 func (*InterfaceGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the InterfaceGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the InterfaceGroup PATCH path
 // Changes to parts of the object group types
 func (*InterfaceGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the InterfaceGroup POST path
+// PostPath implements sophos.Creatable and returns the InterfaceGroup POST path
 // Create a new interface/group object
 func (*InterfaceGroup) PostPath() string {
 	return "/api/objects/interface/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the InterfaceGroup PUT path
+// PutPath implements sophos.Updatable and returns the InterfaceGroup PUT path
 // Creates or updates the complete object group
 func (*InterfaceGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*InterfaceGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/group/%s/usedby", ref)
@@ -336,7 +400,7 @@ func (*InterfaceGroup) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (i *InterfaceGroup) GetType() string { return i.ObjectType }
 
-// InterfacePpp3Gs is an Sophos Endpoint subType and implements sophos.RestObject
+// InterfacePpp3Gs is an Sophos Endpoint subType and implements sophos.RestGetter
 type InterfacePpp3Gs []InterfacePpp3G
 
 // InterfacePpp3G represents a UTM ppp3g
@@ -344,102 +408,153 @@ type InterfacePpp3G struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// Pin default value is ""
-	Pin string `json:"pin"`
+	// Apn default value is "unknown"
+	Apn string `json:"apn"`
 	// ApnAuto default value is true
-	ApnAuto bool   `json:"apn_auto"`
-	Comment string `json:"comment"`
+	ApnAuto   bool   `json:"apn_auto"`
+	Bandwidth int64  `json:"bandwidth"`
+	Comment   string `json:"comment"`
 	// Custom default value is ""
 	Custom string `json:"custom"`
-	// MobileNetwork can be one of: []string{"gsm", "cdma", "lte"}
-	// MobileNetwork default value is "gsm"
-	MobileNetwork string `json:"mobile_network"`
-	// Multilink default value is false
-	Multilink    bool   `json:"multilink"`
-	Outbandwidth int    `json:"outbandwidth"`
-	Mtu          int    `json:"mtu"`
-	Name         string `json:"name"`
-	// MtuAutoDiscovery default value is false
-	MtuAutoDiscovery bool `json:"mtu_auto_discovery"`
-	// PrimaryAddress description: REF(itfparams/primary)
-	// PrimaryAddress default value is ""
-	PrimaryAddress string `json:"primary_address"`
 	// DialString default value is "*99#"
 	DialString string `json:"dial_string"`
 	// IdleTime default value is ""
 	IdleTime    string `json:"idle_time"`
-	Inbandwidth int    `json:"inbandwidth"`
+	Inbandwidth int64  `json:"inbandwidth"`
 	// InitString default value is "ATZ"
 	InitString string `json:"init_string"`
 	// Itfhw description: REF(itfhw/usbserial)
-	Itfhw string `json:"itfhw"`
+	Itfhw ItfhwRef `json:"itfhw"`
 	// Link default value is true
-	Link   bool `json:"link"`
-	Signal int  `json:"signal"`
-	// VirtualDevice description: (REGEX)
-	// VirtualDevice default value is ""
-	VirtualDevice string `json:"virtual_device"`
-	// Apn default value is "unknown"
-	Apn       string `json:"apn"`
-	Bandwidth int    `json:"bandwidth"`
+	Link bool `json:"link"`
+	// MobileNetwork can be one of: []string{"gsm", "cdma", "lte"}
+	// MobileNetwork default value is "gsm"
+	MobileNetwork InterfacePpp3GMobileNetwork `json:"mobile_network"`
+	Mtu           int64                       `json:"mtu"`
+	// MtuAutoDiscovery default value is false
+	MtuAutoDiscovery bool `json:"mtu_auto_discovery"`
+	// Multilink default value is false
+	Multilink    bool   `json:"multilink"`
+	Name         string `json:"name"`
+	Outbandwidth int64  `json:"outbandwidth"`
 	// Password default value is ""
 	Password string `json:"password"`
+	// Pin default value is ""
+	Pin string `json:"pin"`
+	// PrimaryAddress description: REF(itfparams/primary)
+	// PrimaryAddress default value is ""
+	PrimaryAddress ItfparamsRef `json:"primary_address"`
 	// ResetString default value is "ATZ"
 	ResetString string `json:"reset_string"`
+	Signal      int64  `json:"signal"`
 	// Status default value is false
 	Status   bool   `json:"status"`
 	Username string `json:"username"`
+	// VirtualDevice description: (REGEX)
+	// VirtualDevice default value is ""
+	VirtualDevice string `json:"virtual_device"`
+}
+
+// InterfacePpp3GMobileNetwork is the MobileNetwork of a InterfacePpp3G
+type InterfacePpp3GMobileNetwork string
+
+// Known values of InterfacePpp3GMobileNetwork
+const (
+	InterfacePpp3GMobileNetworkGsm  InterfacePpp3GMobileNetwork = "gsm"
+	InterfacePpp3GMobileNetworkCdma InterfacePpp3GMobileNetwork = "cdma"
+	InterfacePpp3GMobileNetworkLte  InterfacePpp3GMobileNetwork = "lte"
+)
+
+// Valid returns true if the value is a known InterfacePpp3GMobileNetwork
+func (v InterfacePpp3GMobileNetwork) Valid() bool {
+	switch v {
+	case InterfacePpp3GMobileNetworkGsm, InterfacePpp3GMobileNetworkCdma, InterfacePpp3GMobileNetworkLte:
+		return true
+	}
+	return false
+}
+
+// NewInterfacePpp3G returns a InterfacePpp3G with the default values of its swagger definition
+func NewInterfacePpp3G() *InterfacePpp3G {
+	return &InterfacePpp3G{
+		ObjectType:    "interface/ppp3g",
+		Apn:           "unknown",
+		ApnAuto:       true,
+		DialString:    "*99#",
+		InitString:    "ATZ",
+		Link:          true,
+		MobileNetwork: InterfacePpp3GMobileNetworkGsm,
+		ResetString:   "ATZ",
+	}
+}
+
+// Validate checks the InterfacePpp3G before it is sent, see ValidateWith
+func (i *InterfacePpp3G) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the InterfacePpp3G.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *InterfacePpp3G) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("InterfacePpp3G", typeOf)
+	check.Required("itfhw", string(i.Itfhw))
+	check.Ref("itfhw", string(i.Itfhw), "REF(itfhw/usbserial)")
+	check.Enum("mobile_network", string(i.MobileNetwork), i.MobileNetwork.Valid())
+	check.Required("name", i.Name)
+	check.Ref("primary_address", string(i.PrimaryAddress), "REF(itfparams/primary)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &InterfacePpp3G{}
 
-// GetPath implements sophos.RestObject and returns the InterfacePpp3Gs GET path
+// GetPath implements sophos.RestGetter and returns the InterfacePpp3Gs GET path
 // Returns all available interface/ppp3g objects
 func (*InterfacePpp3Gs) GetPath() string { return "/api/objects/interface/ppp3g/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*InterfacePpp3Gs) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the InterfacePpp3Gs GET path
+// GetPath implements sophos.RestGetter and returns the InterfacePpp3Gs GET path
 // Returns all available ppp3g types
 func (i *InterfacePpp3G) GetPath() string {
 	return fmt.Sprintf("/api/objects/interface/ppp3g/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *InterfacePpp3G) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the InterfacePpp3G DELETE path
+// DeletePath implements sophos.Deletable and returns the InterfacePpp3G DELETE path
 // Creates or updates the complete object ppp3g
 func (*InterfacePpp3G) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/ppp3g/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the InterfacePpp3G PATCH path
+// PatchPath implements sophos.Patchable and returns the InterfacePpp3G PATCH path
 // Changes to parts of the object ppp3g types
 func (*InterfacePpp3G) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/ppp3g/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the InterfacePpp3G POST path
+// PostPath implements sophos.Creatable and returns the InterfacePpp3G POST path
 // Create a new interface/ppp3g object
 func (*InterfacePpp3G) PostPath() string {
 	return "/api/objects/interface/ppp3g/"
 }
 
-// PutPath implements sophos.RestObject and returns the InterfacePpp3G PUT path
+// PutPath implements sophos.Updatable and returns the InterfacePpp3G PUT path
 // Creates or updates the complete object ppp3g
 func (*InterfacePpp3G) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/ppp3g/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*InterfacePpp3G) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/ppp3g/%s/usedby", ref)
 }
 
-// InterfacePppmodems is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *InterfacePpp3G) GetType() string { return i.ObjectType }
+
+// InterfacePppmodems is an Sophos Endpoint subType and implements sophos.RestGetter
 type InterfacePppmodems []InterfacePppmodem
 
 // InterfacePppmodem represents a UTM PPP modem interface
@@ -447,98 +562,172 @@ type InterfacePppmodem struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// ResetString default value is "ATZ"
-	ResetString string `json:"reset_string"`
-	// Status default value is false
-	Status  bool   `json:"status"`
-	Comment string `json:"comment"`
+	Bandwidth  int64  `json:"bandwidth"`
+	Comment    string `json:"comment"`
+	// Custom default value is ""
+	Custom string `json:"custom"`
 	// DialString default value is ""
 	DialString string `json:"dial_string"`
 	// FlowControl can be one of: []string{"hardware", "software"}
 	// FlowControl default value is "hardware"
-	FlowControl string `json:"flow_control"`
+	FlowControl InterfacePppmodemFlowControl `json:"flow_control"`
 	// IdleTime default value is ""
-	IdleTime string `json:"idle_time"`
+	IdleTime    string `json:"idle_time"`
+	Inbandwidth int64  `json:"inbandwidth"`
+	// InitString default value is "ATZ"
+	InitString string `json:"init_string"`
 	// Itfhw description: REF(itfhw/serial)
-	Itfhw string `json:"itfhw"`
-	// Multilink default value is false
-	Multilink bool `json:"multilink"`
-	// PrimaryAddress description: REF(itfparams/primary)
-	// PrimaryAddress default value is ""
-	PrimaryAddress string `json:"primary_address"`
-	Username       string `json:"username"`
-	Bandwidth      int    `json:"bandwidth"`
-	// Custom default value is ""
-	Custom string `json:"custom"`
+	Itfhw ItfhwRef `json:"itfhw"`
 	// LineSpeed can be one of: []string{"9600", "14400", "19200", "26400", "31200", "38400", "57600", "115200", "230400"}
 	// LineSpeed default value is "115200"
-	LineSpeed string `json:"line_speed"`
-	Mtu       int    `json:"mtu"`
+	LineSpeed InterfacePppmodemLineSpeed `json:"line_speed"`
+	// Link default value is true
+	Link bool  `json:"link"`
+	Mtu  int64 `json:"mtu"`
 	// MtuAutoDiscovery default value is false
 	MtuAutoDiscovery bool `json:"mtu_auto_discovery"`
+	// Multilink default value is false
+	Multilink    bool   `json:"multilink"`
+	Name         string `json:"name"`
+	Outbandwidth int64  `json:"outbandwidth"`
 	// Password default value is ""
 	Password string `json:"password"`
-	// Link default value is true
-	Link         bool   `json:"link"`
-	Name         string `json:"name"`
-	Outbandwidth int    `json:"outbandwidth"`
+	// PrimaryAddress description: REF(itfparams/primary)
+	// PrimaryAddress default value is ""
+	PrimaryAddress ItfparamsRef `json:"primary_address"`
+	// ResetString default value is "ATZ"
+	ResetString string `json:"reset_string"`
+	// Status default value is false
+	Status   bool   `json:"status"`
+	Username string `json:"username"`
 	// VirtualDevice description: (REGEX)
 	// VirtualDevice default value is ""
 	VirtualDevice string `json:"virtual_device"`
-	Inbandwidth   int    `json:"inbandwidth"`
-	// InitString default value is "ATZ"
-	InitString string `json:"init_string"`
+}
+
+// InterfacePppmodemFlowControl is the FlowControl of a InterfacePppmodem
+type InterfacePppmodemFlowControl string
+
+// Known values of InterfacePppmodemFlowControl
+const (
+	InterfacePppmodemFlowControlHardware InterfacePppmodemFlowControl = "hardware"
+	InterfacePppmodemFlowControlSoftware InterfacePppmodemFlowControl = "software"
+)
+
+// Valid returns true if the value is a known InterfacePppmodemFlowControl
+func (v InterfacePppmodemFlowControl) Valid() bool {
+	switch v {
+	case InterfacePppmodemFlowControlHardware, InterfacePppmodemFlowControlSoftware:
+		return true
+	}
+	return false
+}
+
+// InterfacePppmodemLineSpeed is the LineSpeed of a InterfacePppmodem
+type InterfacePppmodemLineSpeed string
+
+// Known values of InterfacePppmodemLineSpeed
+const (
+	InterfacePppmodemLineSpeed9600   InterfacePppmodemLineSpeed = "9600"
+	InterfacePppmodemLineSpeed14400  InterfacePppmodemLineSpeed = "14400"
+	InterfacePppmodemLineSpeed19200  InterfacePppmodemLineSpeed = "19200"
+	InterfacePppmodemLineSpeed26400  InterfacePppmodemLineSpeed = "26400"
+	InterfacePppmodemLineSpeed31200  InterfacePppmodemLineSpeed = "31200"
+	InterfacePppmodemLineSpeed38400  InterfacePppmodemLineSpeed = "38400"
+	InterfacePppmodemLineSpeed57600  InterfacePppmodemLineSpeed = "57600"
+	InterfacePppmodemLineSpeed115200 InterfacePppmodemLineSpeed = "115200"
+	InterfacePppmodemLineSpeed230400 InterfacePppmodemLineSpeed = "230400"
+)
+
+// Valid returns true if the value is a known InterfacePppmodemLineSpeed
+func (v InterfacePppmodemLineSpeed) Valid() bool {
+	switch v {
+	case InterfacePppmodemLineSpeed9600, InterfacePppmodemLineSpeed14400, InterfacePppmodemLineSpeed19200, InterfacePppmodemLineSpeed26400, InterfacePppmodemLineSpeed31200, InterfacePppmodemLineSpeed38400, InterfacePppmodemLineSpeed57600, InterfacePppmodemLineSpeed115200, InterfacePppmodemLineSpeed230400:
+		return true
+	}
+	return false
+}
+
+// NewInterfacePppmodem returns a InterfacePppmodem with the default values of its swagger definition
+func NewInterfacePppmodem() *InterfacePppmodem {
+	return &InterfacePppmodem{
+		ObjectType:  "interface/pppmodem",
+		FlowControl: InterfacePppmodemFlowControlHardware,
+		InitString:  "ATZ",
+		LineSpeed:   InterfacePppmodemLineSpeed115200,
+		Link:        true,
+		ResetString: "ATZ",
+	}
+}
+
+// Validate checks the InterfacePppmodem before it is sent, see ValidateWith
+func (i *InterfacePppmodem) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the InterfacePppmodem.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *InterfacePppmodem) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("InterfacePppmodem", typeOf)
+	check.Enum("flow_control", string(i.FlowControl), i.FlowControl.Valid())
+	check.Required("itfhw", string(i.Itfhw))
+	check.Ref("itfhw", string(i.Itfhw), "REF(itfhw/serial)")
+	check.Enum("line_speed", string(i.LineSpeed), i.LineSpeed.Valid())
+	check.Required("name", i.Name)
+	check.Ref("primary_address", string(i.PrimaryAddress), "REF(itfparams/primary)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &InterfacePppmodem{}
 
-// GetPath implements sophos.RestObject and returns the InterfacePppmodems GET path
+// GetPath implements sophos.RestGetter and returns the InterfacePppmodems GET path
 // Returns all available interface/pppmodem objects
 func (*InterfacePppmodems) GetPath() string { return "/api/objects/interface/pppmodem/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*InterfacePppmodems) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the InterfacePppmodems GET path
+// GetPath implements sophos.RestGetter and returns the InterfacePppmodems GET path
 // Returns all available pppmodem types
 func (i *InterfacePppmodem) GetPath() string {
 	return fmt.Sprintf("/api/objects/interface/pppmodem/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *InterfacePppmodem) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the InterfacePppmodem DELETE path
+// DeletePath implements sophos.Deletable and returns the InterfacePppmodem DELETE path
 // Creates or updates the complete object pppmodem
 func (*InterfacePppmodem) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/pppmodem/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the InterfacePppmodem PATCH path
+// PatchPath implements sophos.Patchable and returns the InterfacePppmodem PATCH path
 // Changes to parts of the object pppmodem types
 func (*InterfacePppmodem) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/pppmodem/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the InterfacePppmodem POST path
+// PostPath implements sophos.Creatable and returns the InterfacePppmodem POST path
 // Create a new interface/pppmodem object
 func (*InterfacePppmodem) PostPath() string {
 	return "/api/objects/interface/pppmodem/"
 }
 
-// PutPath implements sophos.RestObject and returns the InterfacePppmodem PUT path
+// PutPath implements sophos.Updatable and returns the InterfacePppmodem PUT path
 // Creates or updates the complete object pppmodem
 func (*InterfacePppmodem) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/pppmodem/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*InterfacePppmodem) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/pppmodem/%s/usedby", ref)
 }
 
-// InterfacePppoas is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *InterfacePppmodem) GetType() string { return i.ObjectType }
+
+// InterfacePppoas is an Sophos Endpoint subType and implements sophos.RestGetter
 type InterfacePppoas []InterfacePppoa
 
 // InterfacePppoa represents a UTM PPPoA/PPTP DSL interface
@@ -546,336 +735,432 @@ type InterfacePppoa struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
+	Bandwidth  int64  `json:"bandwidth"`
 	Comment    string `json:"comment"`
+	// Custom default value is ""
+	Custom      string `json:"custom"`
+	Inbandwidth int64  `json:"inbandwidth"`
+	// Itfhw description: REF(itfhw/ethernet)
+	Itfhw ItfhwRef `json:"itfhw"`
+	// Link default value is true
+	Link bool `json:"link"`
+	// ModemAddress description: (IPADDR)
+	ModemAddress string `json:"modem_address"`
+	Mtu          int64  `json:"mtu"`
 	// MtuAutoDiscovery default value is false
 	MtuAutoDiscovery bool `json:"mtu_auto_discovery"`
+	// Multilink default value is false
+	Multilink bool   `json:"multilink"`
+	Name      string `json:"name"`
+	// NicAddress description: (IPADDR)
+	NicAddress   string `json:"nic_address"`
+	NicNetmask   int64  `json:"nic_netmask"`
+	Outbandwidth int64  `json:"outbandwidth"`
 	// Password default value is ""
 	Password string `json:"password"`
 	// PingAddress description: (IPADDR)
 	// PingAddress default value is ""
 	PingAddress string `json:"ping_address"`
-	// VirtualDevice description: (REGEX)
-	// VirtualDevice default value is ""
-	VirtualDevice string `json:"virtual_device"`
-	Bandwidth     int    `json:"bandwidth"`
-	Mtu           int    `json:"mtu"`
-	Outbandwidth  int    `json:"outbandwidth"`
-	// Status default value is false
-	Status   bool   `json:"status"`
-	Username string `json:"username"`
-	// Custom default value is ""
-	Custom string `json:"custom"`
-	// Link default value is true
-	Link bool `json:"link"`
-	// ModemAddress description: (IPADDR)
-	ModemAddress     string `json:"modem_address"`
-	Name             string `json:"name"`
-	ReconnectTimeout int    `json:"reconnect_timeout"`
-	Inbandwidth      int    `json:"inbandwidth"`
-	// Itfhw description: REF(itfhw/ethernet)
-	Itfhw string `json:"itfhw"`
-	// Multilink default value is false
-	Multilink bool `json:"multilink"`
-	// NicAddress description: (IPADDR)
-	NicAddress string `json:"nic_address"`
-	NicNetmask int    `json:"nic_netmask"`
 	// PrimaryAddress description: REF(itfparams/primary)
 	// PrimaryAddress default value is ""
-	PrimaryAddress string `json:"primary_address"`
+	PrimaryAddress ItfparamsRef `json:"primary_address"`
 	// ReconnectDaily description: (TIME)
 	// ReconnectDaily default value is ""
-	ReconnectDaily string `json:"reconnect_daily"`
+	ReconnectDaily   string `json:"reconnect_daily"`
+	ReconnectTimeout int64  `json:"reconnect_timeout"`
+	// Status default value is false
+	Status   bool   `json:"status"`
+	Username string `json:"username"`
+	// VirtualDevice description: (REGEX)
+	// VirtualDevice default value is ""
+	VirtualDevice string `json:"virtual_device"`
+}
+
+// NewInterfacePppoa returns a InterfacePppoa with the default values of its swagger definition
+func NewInterfacePppoa() *InterfacePppoa {
+	return &InterfacePppoa{
+		ObjectType: "interface/pppoa",
+		Link:       true,
+	}
+}
+
+// Validate checks the InterfacePppoa before it is sent, see ValidateWith
+func (i *InterfacePppoa) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the InterfacePppoa.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *InterfacePppoa) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("InterfacePppoa", typeOf)
+	check.Required("itfhw", string(i.Itfhw))
+	check.Ref("itfhw", string(i.Itfhw), "REF(itfhw/ethernet)")
+	check.Format("modem_address", sophos.FormatIPv4, i.ModemAddress)
+	check.Required("name", i.Name)
+	check.Format("nic_address", sophos.FormatIPv4, i.NicAddress)
+	check.Between("nic_netmask", i.NicNetmask, 0, 32)
+	check.Format("ping_address", sophos.FormatIPv4, i.PingAddress)
+	check.Ref("primary_address", string(i.PrimaryAddress), "REF(itfparams/primary)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &InterfacePppoa{}
 
-// GetPath implements sophos.RestObject and returns the InterfacePppoas GET path
+// GetPath implements sophos.RestGetter and returns the InterfacePppoas GET path
 // Returns all available interface/pppoa objects
 func (*InterfacePppoas) GetPath() string { return "/api/objects/interface/pppoa/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*InterfacePppoas) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the InterfacePppoas GET path
+// GetPath implements sophos.RestGetter and returns the InterfacePppoas GET path
 // Returns all available pppoa types
 func (i *InterfacePppoa) GetPath() string {
 	return fmt.Sprintf("/api/objects/interface/pppoa/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *InterfacePppoa) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the InterfacePppoa DELETE path
+// DeletePath implements sophos.Deletable and returns the InterfacePppoa DELETE path
 // Creates or updates the complete object pppoa
 func (*InterfacePppoa) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/pppoa/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the InterfacePppoa PATCH path
+// PatchPath implements sophos.Patchable and returns the InterfacePppoa PATCH path
 // Changes to parts of the object pppoa types
 func (*InterfacePppoa) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/pppoa/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the InterfacePppoa POST path
+// PostPath implements sophos.Creatable and returns the InterfacePppoa POST path
 // Create a new interface/pppoa object
 func (*InterfacePppoa) PostPath() string {
 	return "/api/objects/interface/pppoa/"
 }
 
-// PutPath implements sophos.RestObject and returns the InterfacePppoa PUT path
+// PutPath implements sophos.Updatable and returns the InterfacePppoa PUT path
 // Creates or updates the complete object pppoa
 func (*InterfacePppoa) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/pppoa/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*InterfacePppoa) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/pppoa/%s/usedby", ref)
 }
 
-// InterfacePppoes is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *InterfacePppoa) GetType() string { return i.ObjectType }
+
+// InterfacePppoes is an Sophos Endpoint subType and implements sophos.RestGetter
 type InterfacePppoes []InterfacePppoe
 
 // InterfacePppoe represents a UTM PPPoE DSL interface
 type InterfacePppoe struct {
-	Locked      string `json:"_locked"`
-	ObjectType  string `json:"_type"`
-	Reference   string `json:"_ref"`
-	Inbandwidth int    `json:"inbandwidth"`
-	// MtuAutoDiscovery default value is false
-	MtuAutoDiscovery bool   `json:"mtu_auto_discovery"`
-	Name             string `json:"name"`
-	// PrimaryAddress description: REF(itfparams/primary)
-	// PrimaryAddress default value is ""
-	PrimaryAddress string `json:"primary_address"`
-	// VirtualDevice description: (REGEX)
-	// VirtualDevice default value is ""
-	VirtualDevice string `json:"virtual_device"`
-	Bandwidth     int    `json:"bandwidth"`
+	Locked              string   `json:"_locked"`
+	ObjectType          string   `json:"_type"`
+	Reference           string   `json:"_ref"`
+	AdditionalAddresses []string `json:"additional_addresses"`
+	Bandwidth           int64    `json:"bandwidth"`
+	Comment             string   `json:"comment"`
+	// Custom default value is ""
+	Custom      string `json:"custom"`
+	Inbandwidth int64  `json:"inbandwidth"`
+	// Itfhw description: REF(itfhw/ethernet)
+	Itfhw       ItfhwRef `json:"itfhw"`
+	ItfhwSlaves []string `json:"itfhw_slaves"`
 	// Link default value is true
 	Link bool `json:"link"`
-	// Multilink default value is false
-	Multilink        bool   `json:"multilink"`
-	Username         string `json:"username"`
-	Vlantag          int    `json:"vlantag"`
-	ReconnectTimeout int    `json:"reconnect_timeout"`
-	// Custom default value is ""
-	Custom      string        `json:"custom"`
-	ItfhwSlaves []interface{} `json:"itfhw_slaves"`
 	// Macvlan default value is false
-	Macvlan bool `json:"macvlan"`
-	Mtu     int  `json:"mtu"`
+	Macvlan bool  `json:"macvlan"`
+	Mtu     int64 `json:"mtu"`
+	// MtuAutoDiscovery default value is false
+	MtuAutoDiscovery bool `json:"mtu_auto_discovery"`
+	// Multilink default value is false
+	Multilink bool `json:"multilink"`
 	// MultilinkStatus description: (HASH)
-	MultilinkStatus interface{} `json:"multilink_status"`
-	Outbandwidth    int         `json:"outbandwidth"`
-	// ReconnectDaily description: (TIME)
-	// ReconnectDaily default value is ""
-	ReconnectDaily      string        `json:"reconnect_daily"`
-	AdditionalAddresses []interface{} `json:"additional_addresses"`
-	Comment             string        `json:"comment"`
-	// Itfhw description: REF(itfhw/ethernet)
-	Itfhw string `json:"itfhw"`
+	MultilinkStatus map[string]interface{} `json:"multilink_status"`
+	Name            string                 `json:"name"`
+	Outbandwidth    int64                  `json:"outbandwidth"`
 	// Password default value is ""
 	Password string `json:"password"`
+	// PrimaryAddress description: REF(itfparams/primary)
+	// PrimaryAddress default value is ""
+	PrimaryAddress ItfparamsRef `json:"primary_address"`
+	// ReconnectDaily description: (TIME)
+	// ReconnectDaily default value is ""
+	ReconnectDaily   string `json:"reconnect_daily"`
+	ReconnectTimeout int64  `json:"reconnect_timeout"`
 	// Status default value is false
-	Status bool `json:"status"`
+	Status   bool   `json:"status"`
+	Username string `json:"username"`
+	// VirtualDevice description: (REGEX)
+	// VirtualDevice default value is ""
+	VirtualDevice string `json:"virtual_device"`
+	Vlantag       int64  `json:"vlantag"`
+}
+
+// NewInterfacePppoe returns a InterfacePppoe with the default values of its swagger definition
+func NewInterfacePppoe() *InterfacePppoe {
+	return &InterfacePppoe{
+		ObjectType: "interface/pppoe",
+		Link:       true,
+	}
+}
+
+// Validate checks the InterfacePppoe before it is sent, see ValidateWith
+func (i *InterfacePppoe) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the InterfacePppoe.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *InterfacePppoe) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("InterfacePppoe", typeOf)
+	check.Required("itfhw", string(i.Itfhw))
+	check.Ref("itfhw", string(i.Itfhw), "REF(itfhw/ethernet)")
+	check.Required("name", i.Name)
+	check.Ref("primary_address", string(i.PrimaryAddress), "REF(itfparams/primary)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &InterfacePppoe{}
 
-// GetPath implements sophos.RestObject and returns the InterfacePppoes GET path
+// GetPath implements sophos.RestGetter and returns the InterfacePppoes GET path
 // Returns all available interface/pppoe objects
 func (*InterfacePppoes) GetPath() string { return "/api/objects/interface/pppoe/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*InterfacePppoes) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the InterfacePppoes GET path
+// GetPath implements sophos.RestGetter and returns the InterfacePppoes GET path
 // Returns all available pppoe types
 func (i *InterfacePppoe) GetPath() string {
 	return fmt.Sprintf("/api/objects/interface/pppoe/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *InterfacePppoe) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the InterfacePppoe DELETE path
+// DeletePath implements sophos.Deletable and returns the InterfacePppoe DELETE path
 // Creates or updates the complete object pppoe
 func (*InterfacePppoe) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/pppoe/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the InterfacePppoe PATCH path
+// PatchPath implements sophos.Patchable and returns the InterfacePppoe PATCH path
 // Changes to parts of the object pppoe types
 func (*InterfacePppoe) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/pppoe/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the InterfacePppoe POST path
+// PostPath implements sophos.Creatable and returns the InterfacePppoe POST path
 // Create a new interface/pppoe object
 func (*InterfacePppoe) PostPath() string {
 	return "/api/objects/interface/pppoe/"
 }
 
-// PutPath implements sophos.RestObject and returns the InterfacePppoe PUT path
+// PutPath implements sophos.Updatable and returns the InterfacePppoe PUT path
 // Creates or updates the complete object pppoe
 func (*InterfacePppoe) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/pppoe/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*InterfacePppoe) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/pppoe/%s/usedby", ref)
 }
 
-// InterfaceTunnels is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *InterfacePppoe) GetType() string { return i.ObjectType }
+
+// InterfaceTunnels is an Sophos Endpoint subType and implements sophos.RestGetter
 type InterfaceTunnels []InterfaceTunnel
 
 // InterfaceTunnel represents a UTM tunnel interface
 type InterfaceTunnel struct {
-	Locked      string `json:"_locked"`
-	ObjectType  string `json:"_type"`
-	Reference   string `json:"_ref"`
-	Bandwidth   int    `json:"bandwidth"`
-	Comment     string `json:"comment"`
-	Inbandwidth int    `json:"inbandwidth"`
+	Locked              string   `json:"_locked"`
+	ObjectType          string   `json:"_type"`
+	Reference           string   `json:"_ref"`
+	AdditionalAddresses []string `json:"additional_addresses"`
+	Bandwidth           int64    `json:"bandwidth"`
+	Comment             string   `json:"comment"`
+	Inbandwidth         int64    `json:"inbandwidth"`
 	// Itfhw description: REF(itfhw/virtual)
-	Itfhw string `json:"itfhw"`
-	Mtu   int    `json:"mtu"`
-	// Status default value is false
-	Status              bool          `json:"status"`
-	AdditionalAddresses []interface{} `json:"additional_addresses"`
+	Itfhw ItfhwRef `json:"itfhw"`
 	// Link default value is true
-	Link bool `json:"link"`
+	Link bool  `json:"link"`
+	Mtu  int64 `json:"mtu"`
 	// MtuAutoDiscovery default value is false
 	MtuAutoDiscovery bool   `json:"mtu_auto_discovery"`
 	Name             string `json:"name"`
-	Outbandwidth     int    `json:"outbandwidth"`
+	Outbandwidth     int64  `json:"outbandwidth"`
 	// PrimaryAddress description: REF(itfparams/primary)
 	// PrimaryAddress default value is ""
-	PrimaryAddress string `json:"primary_address"`
+	PrimaryAddress ItfparamsRef `json:"primary_address"`
+	// Status default value is false
+	Status bool `json:"status"`
+}
+
+// NewInterfaceTunnel returns a InterfaceTunnel with the default values of its swagger definition
+func NewInterfaceTunnel() *InterfaceTunnel {
+	return &InterfaceTunnel{
+		ObjectType: "interface/tunnel",
+		Link:       true,
+	}
+}
+
+// Validate checks the InterfaceTunnel before it is sent, see ValidateWith
+func (i *InterfaceTunnel) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the InterfaceTunnel.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *InterfaceTunnel) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("InterfaceTunnel", typeOf)
+	check.Ref("itfhw", string(i.Itfhw), "REF(itfhw/virtual)")
+	check.Required("name", i.Name)
+	check.Ref("primary_address", string(i.PrimaryAddress), "REF(itfparams/primary)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &InterfaceTunnel{}
 
-// GetPath implements sophos.RestObject and returns the InterfaceTunnels GET path
+// GetPath implements sophos.RestGetter and returns the InterfaceTunnels GET path
 // Returns all available interface/tunnel objects
 func (*InterfaceTunnels) GetPath() string { return "/api/objects/interface/tunnel/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*InterfaceTunnels) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the InterfaceTunnels GET path
+// GetPath implements sophos.RestGetter and returns the InterfaceTunnels GET path
 // Returns all available tunnel types
 func (i *InterfaceTunnel) GetPath() string {
 	return fmt.Sprintf("/api/objects/interface/tunnel/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *InterfaceTunnel) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the InterfaceTunnel DELETE path
+// DeletePath implements sophos.Deletable and returns the InterfaceTunnel DELETE path
 // Creates or updates the complete object tunnel
 func (*InterfaceTunnel) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/tunnel/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the InterfaceTunnel PATCH path
+// PatchPath implements sophos.Patchable and returns the InterfaceTunnel PATCH path
 // Changes to parts of the object tunnel types
 func (*InterfaceTunnel) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/tunnel/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the InterfaceTunnel POST path
+// PostPath implements sophos.Creatable and returns the InterfaceTunnel POST path
 // Create a new interface/tunnel object
 func (*InterfaceTunnel) PostPath() string {
 	return "/api/objects/interface/tunnel/"
 }
 
-// PutPath implements sophos.RestObject and returns the InterfaceTunnel PUT path
+// PutPath implements sophos.Updatable and returns the InterfaceTunnel PUT path
 // Creates or updates the complete object tunnel
 func (*InterfaceTunnel) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/tunnel/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*InterfaceTunnel) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/tunnel/%s/usedby", ref)
 }
 
-// InterfaceVlans is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *InterfaceTunnel) GetType() string { return i.ObjectType }
+
+// InterfaceVlans is an Sophos Endpoint subType and implements sophos.RestGetter
 type InterfaceVlans []InterfaceVlan
 
-// InterfaceVlan is a generated Sophos object
+// InterfaceVlan represents a UTM interface/vlan object
 type InterfaceVlan struct {
-	Locked              string        `json:"_locked"`
-	Reference           string        `json:"_ref"`
-	ObjectType          string        `json:"_type"`
-	AdditionalAddresses []interface{} `json:"additional_addresses"`
-	Bandwidth           int64         `json:"bandwidth"`
-	Comment             string        `json:"comment"`
-	Inbandwidth         int64         `json:"inbandwidth"`
-	Itfhw               string        `json:"itfhw"`
-	Link                bool          `json:"link"`
-	Macvlan             bool          `json:"macvlan"`
-	Mtu                 int64         `json:"mtu"`
-	MtuAutoDiscovery    bool          `json:"mtu_auto_discovery"`
-	Name                string        `json:"name"`
-	Outbandwidth        int64         `json:"outbandwidth"`
-	PrimaryAddress      string        `json:"primary_address"`
-	Proxyarp            bool          `json:"proxyarp"`
-	Proxyndp            bool          `json:"proxyndp"`
-	Status              bool          `json:"status"`
-	Vlantag             int64         `json:"vlantag"`
+	Locked              string   `json:"_locked"`
+	ObjectType          string   `json:"_type"`
+	Reference           string   `json:"_ref"`
+	AdditionalAddresses []string `json:"additional_addresses"`
+	Bandwidth           int64    `json:"bandwidth"`
+	Comment             string   `json:"comment"`
+	Inbandwidth         int64    `json:"inbandwidth"`
+	Itfhw               string   `json:"itfhw"`
+	Link                bool     `json:"link"`
+	Macvlan             bool     `json:"macvlan"`
+	Mtu                 int64    `json:"mtu"`
+	MtuAutoDiscovery    bool     `json:"mtu_auto_discovery"`
+	Name                string   `json:"name"`
+	Outbandwidth        int64    `json:"outbandwidth"`
+	PrimaryAddress      string   `json:"primary_address"`
+	Proxyarp            bool     `json:"proxyarp"`
+	Proxyndp            bool     `json:"proxyndp"`
+	Status              bool     `json:"status"`
+	Vlantag             int64    `json:"vlantag"`
+}
+
+// NewInterfaceVlan returns a InterfaceVlan with the default values of its swagger definition
+func NewInterfaceVlan() *InterfaceVlan {
+	return &InterfaceVlan{
+		ObjectType: "interface/vlan",
+	}
+}
+
+// Validate checks the InterfaceVlan before it is sent, see ValidateWith
+func (i *InterfaceVlan) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the InterfaceVlan.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *InterfaceVlan) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("InterfaceVlan", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &InterfaceVlan{}
 
-// GetPath implements sophos.RestObject and returns the InterfaceVlans GET path
+// GetPath implements sophos.RestGetter and returns the InterfaceVlans GET path
 // Returns all available interface/vlan objects
 func (*InterfaceVlans) GetPath() string { return "/api/objects/interface/vlan/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*InterfaceVlans) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the InterfaceVlans GET path
+// GetPath implements sophos.RestGetter and returns the InterfaceVlans GET path
 // Returns all available vlan types
 func (i *InterfaceVlan) GetPath() string {
 	return fmt.Sprintf("/api/objects/interface/vlan/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *InterfaceVlan) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the InterfaceVlan DELETE path
+// DeletePath implements sophos.Deletable and returns the InterfaceVlan DELETE path
 // Creates or updates the complete object vlan
 func (*InterfaceVlan) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/vlan/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the InterfaceVlan PATCH path
+// PatchPath implements sophos.Patchable and returns the InterfaceVlan PATCH path
 // Changes to parts of the object vlan types
 func (*InterfaceVlan) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/vlan/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the InterfaceVlan POST path
+// PostPath implements sophos.Creatable and returns the InterfaceVlan POST path
 // Create a new interface/vlan object
 func (*InterfaceVlan) PostPath() string {
 	return "/api/objects/interface/vlan/"
 }
 
-// PutPath implements sophos.RestObject and returns the InterfaceVlan PUT path
+// PutPath implements sophos.Updatable and returns the InterfaceVlan PUT path
 // Creates or updates the complete object vlan
 func (*InterfaceVlan) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/vlan/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*InterfaceVlan) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/interface/vlan/%s/usedby", ref)
@@ -15,13 +15,13 @@ type IpfixConnection struct {
 
 var _ sophos.Endpoint = &IpfixConnection{}
 
-var defsIpfixConnection = map[string]sophos.RestObject{
+var defsIpfixConnection = map[string]sophos.RestGetter{
 	"IpfixConnectionGroup":           &IpfixConnectionGroup{},
 	"IpfixConnectionIpfixConnection": &IpfixConnectionIpfixConnection{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of IpfixConnection's Objects
-func (IpfixConnection) RestObjects() map[string]sophos.RestObject { return defsIpfixConnection }
+func (IpfixConnection) RestObjects() map[string]sophos.RestGetter { return defsIpfixConnection }
 
 // GetPath implements sophos.RestGetter
 func (*IpfixConnection) GetPath() string { return "/api/nodes/ipfix_connection" }
@@ -55,7 +55,7 @@ func (IpfixConnection) References() []string {
 	}
 }
 
-// IpfixConnectionGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// IpfixConnectionGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpfixConnectionGroups []IpfixConnectionGroup
 
 // IpfixConnectionGroup represents a UTM group
@@ -63,59 +63,80 @@ type IpfixConnectionGroup struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Name       string `json:"name"`
 	Comment    string `json:"comment"`
+	Name       string `json:"name"`
+}
+
+// NewIpfixConnectionGroup returns a IpfixConnectionGroup with the default values of its swagger definition
+func NewIpfixConnectionGroup() *IpfixConnectionGroup {
+	return &IpfixConnectionGroup{
+		ObjectType: "ipfix_connection/group",
+	}
+}
+
+// Validate checks the IpfixConnectionGroup before it is sent, see ValidateWith
+func (i *IpfixConnectionGroup) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpfixConnectionGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpfixConnectionGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpfixConnectionGroup", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &IpfixConnectionGroup{}
 
-// GetPath implements sophos.RestObject and returns the IpfixConnectionGroups GET path
+// GetPath implements sophos.RestGetter and returns the IpfixConnectionGroups GET path
 // Returns all available ipfix_connection/group objects
 func (*IpfixConnectionGroups) GetPath() string { return "/api/objects/ipfix_connection/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpfixConnectionGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpfixConnectionGroups GET path
+// GetPath implements sophos.RestGetter and returns the IpfixConnectionGroups GET path
 // Returns all available group types
 func (i *IpfixConnectionGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipfix_connection/group/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpfixConnectionGroup) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpfixConnectionGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the IpfixConnectionGroup DELETE path
 // Creates or updates the complete object group
 func (*IpfixConnectionGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipfix_connection/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpfixConnectionGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the IpfixConnectionGroup PATCH path
 // Changes to parts of the object group types
 func (*IpfixConnectionGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipfix_connection/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpfixConnectionGroup POST path
+// PostPath implements sophos.Creatable and returns the IpfixConnectionGroup POST path
 // Create a new ipfix_connection/group object
 func (*IpfixConnectionGroup) PostPath() string {
 	return "/api/objects/ipfix_connection/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpfixConnectionGroup PUT path
+// PutPath implements sophos.Updatable and returns the IpfixConnectionGroup PUT path
 // Creates or updates the complete object group
 func (*IpfixConnectionGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipfix_connection/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpfixConnectionGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipfix_connection/group/%s/usedby", ref)
 }
 
-// IpfixConnectionIpfixConnections is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *IpfixConnectionGroup) GetType() string { return i.ObjectType }
+
+// IpfixConnectionIpfixConnections is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpfixConnectionIpfixConnections []IpfixConnectionIpfixConnection
 
 // IpfixConnectionIpfixConnection represents a UTM ipfix_connection
@@ -125,59 +146,82 @@ type IpfixConnectionIpfixConnection struct {
 	Reference  string `json:"_ref"`
 	Comment    string `json:"comment"`
 	// Host description: REF(network/host), REF(network/dns_host)
-	Host string `json:"host"`
-	Name string `json:"name"`
-	Oid  int    `json:"oid"`
+	Host NetworkRef `json:"host"`
+	Name string     `json:"name"`
+	Oid  int64      `json:"oid"`
 	// Status default value is false
 	Status bool `json:"status"`
 }
 
+// NewIpfixConnectionIpfixConnection returns a IpfixConnectionIpfixConnection with the default values of its swagger definition
+func NewIpfixConnectionIpfixConnection() *IpfixConnectionIpfixConnection {
+	return &IpfixConnectionIpfixConnection{
+		ObjectType: "ipfix_connection/ipfix_connection",
+	}
+}
+
+// Validate checks the IpfixConnectionIpfixConnection before it is sent, see ValidateWith
+func (i *IpfixConnectionIpfixConnection) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpfixConnectionIpfixConnection.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpfixConnectionIpfixConnection) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpfixConnectionIpfixConnection", typeOf)
+	check.Required("host", string(i.Host))
+	check.Ref("host", string(i.Host), "REF(network/host), REF(network/dns_host)")
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &IpfixConnectionIpfixConnection{}
 
-// GetPath implements sophos.RestObject and returns the IpfixConnectionIpfixConnections GET path
+// GetPath implements sophos.RestGetter and returns the IpfixConnectionIpfixConnections GET path
 // Returns all available ipfix_connection/ipfix_connection objects
 func (*IpfixConnectionIpfixConnections) GetPath() string {
 	return "/api/objects/ipfix_connection/ipfix_connection/"
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpfixConnectionIpfixConnections) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpfixConnectionIpfixConnections GET path
+// GetPath implements sophos.RestGetter and returns the IpfixConnectionIpfixConnections GET path
 // Returns all available ipfix_connection types
 func (i *IpfixConnectionIpfixConnection) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipfix_connection/ipfix_connection/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpfixConnectionIpfixConnection) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpfixConnectionIpfixConnection DELETE path
+// DeletePath implements sophos.Deletable and returns the IpfixConnectionIpfixConnection DELETE path
 // Creates or updates the complete object ipfix_connection
 func (*IpfixConnectionIpfixConnection) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipfix_connection/ipfix_connection/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpfixConnectionIpfixConnection PATCH path
+// PatchPath implements sophos.Patchable and returns the IpfixConnectionIpfixConnection PATCH path
 // Changes to parts of the object ipfix_connection types
 func (*IpfixConnectionIpfixConnection) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipfix_connection/ipfix_connection/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpfixConnectionIpfixConnection POST path
+// PostPath implements sophos.Creatable and returns the IpfixConnectionIpfixConnection POST path
 // Create a new ipfix_connection/ipfix_connection object
 func (*IpfixConnectionIpfixConnection) PostPath() string {
 	return "/api/objects/ipfix_connection/ipfix_connection/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpfixConnectionIpfixConnection PUT path
+// PutPath implements sophos.Updatable and returns the IpfixConnectionIpfixConnection PUT path
 // Creates or updates the complete object ipfix_connection
 func (*IpfixConnectionIpfixConnection) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipfix_connection/ipfix_connection/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpfixConnectionIpfixConnection) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipfix_connection/ipfix_connection/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (i *IpfixConnectionIpfixConnection) GetType() string { return i.ObjectType }
@@ -9,45 +9,45 @@ import (
 // Ips is a generated struct representing the Sophos Ips Endpoint
 // GET /api/nodes/ips
 type Ips struct {
-	DNSServers     []interface{} `json:"dns_servers"`
-	Engine         string        `json:"engine"`
-	Exceptions     []string      `json:"exceptions"`
-	Failopen       int64         `json:"failopen"`
-	FileBasedRules int64         `json:"file_based_rules"`
-	Groups         []string      `json:"groups"`
-	HTTPServers    []interface{} `json:"http_servers"`
+	DNSServers     []string `json:"dns_servers"`
+	Engine         string   `json:"engine"`
+	Exceptions     []string `json:"exceptions"`
+	Failopen       bool     `json:"failopen"`
+	FileBasedRules bool     `json:"file_based_rules"`
+	Groups         []string `json:"groups"`
+	HTTPServers    []string `json:"http_servers"`
 	Ipsfb          struct {
 		AlertInterval  int64 `json:"alert_interval"`
 		ConfigInterval int64 `json:"config_interval"`
-		Debug          int64 `json:"debug"`
+		Debug          bool  `json:"debug"`
 	} `json:"ipsfb"`
-	LocalNetworks  []string      `json:"local_networks"`
-	NumInstances   int64         `json:"num_instances"`
-	PatternChannel string        `json:"pattern_channel"`
-	Policy         string        `json:"policy"`
-	QueueLength    int64         `json:"queue_length"`
-	QueueThreshold int64         `json:"queue_threshold"`
-	ReloadMethod   string        `json:"reload_method"`
-	RestartPolicy  string        `json:"restart_policy"`
-	RuleModifiers  []interface{} `json:"rule_modifiers"`
-	Rules          []interface{} `json:"rules"`
-	SkipAcks       int64         `json:"skip_acks"`
-	SMTPServers    []interface{} `json:"smtp_servers"`
+	LocalNetworks  NetworkRefs            `json:"local_networks"`
+	NumInstances   map[string]interface{} `json:"num_instances"`
+	PatternChannel string                 `json:"pattern_channel"`
+	Policy         string                 `json:"policy"`
+	QueueLength    int64                  `json:"queue_length"`
+	QueueThreshold map[string]interface{} `json:"queue_threshold"`
+	ReloadMethod   string                 `json:"reload_method"`
+	RestartPolicy  string                 `json:"restart_policy"`
+	RuleModifiers  []string               `json:"rule_modifiers"`
+	Rules          []string               `json:"rules"`
+	SkipAcks       bool                   `json:"skip_acks"`
+	SMTPServers    []string               `json:"smtp_servers"`
 	Snortsettings  struct {
-		MaxQueuedBytes int64  `json:"max_queued_bytes"`
-		MaxQueuedSegs  int64  `json:"max_queued_segs"`
-		MaxTcp         int64  `json:"max_tcp"`
-		MaxUdp         int64  `json:"max_udp"`
-		Memcap         int64  `json:"memcap"`
-		SearchMethod   string `json:"search_method"`
+		MaxQueuedBytes map[string]interface{} `json:"max_queued_bytes"`
+		MaxQueuedSegs  map[string]interface{} `json:"max_queued_segs"`
+		MaxTcp         map[string]interface{} `json:"max_tcp"`
+		MaxUdp         map[string]interface{} `json:"max_udp"`
+		Memcap         map[string]interface{} `json:"memcap"`
+		SearchMethod   string                 `json:"search_method"`
 	} `json:"snortsettings"`
-	SqlServers []interface{} `json:"sql_servers"`
-	Status     int64         `json:"status"`
+	SqlServers []string `json:"sql_servers"`
+	Status     bool     `json:"status"`
 }
 
 var _ sophos.Endpoint = &Ips{}
 
-var defsIps = map[string]sophos.RestObject{
+var defsIps = map[string]sophos.RestGetter{
 	"IpsException":    &IpsException{},
 	"IpsGroup":        &IpsGroup{},
 	"IpsRule":         &IpsRule{},
@@ -55,7 +55,7 @@ var defsIps = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Ips's Objects
-func (Ips) RestObjects() map[string]sophos.RestObject { return defsIps }
+func (Ips) RestObjects() map[string]sophos.RestGetter { return defsIps }
 
 // GetPath implements sophos.RestGetter
 func (*Ips) GetPath() string { return "/api/nodes/ips" }
@@ -97,14 +97,14 @@ func (Ips) References() []string {
 	}
 }
 
-// IpsExceptions is an Sophos Endpoint subType and implements sophos.RestObject
+// IpsExceptions is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsExceptions []IpsException
 
-// IpsException is a generated Sophos object
+// IpsException represents a UTM ips/exception object
 type IpsException struct {
 	Locked              string   `json:"_locked"`
-	Reference           string   `json:"_ref"`
 	ObjectType          string   `json:"_type"`
+	Reference           string   `json:"_ref"`
 	Comment             string   `json:"comment"`
 	DestinationNetworks []string `json:"destination_networks"`
 	Name                string   `json:"name"`
@@ -115,49 +115,67 @@ type IpsException struct {
 	Status              bool     `json:"status"`
 }
 
+// NewIpsException returns a IpsException with the default values of its swagger definition
+func NewIpsException() *IpsException {
+	return &IpsException{
+		ObjectType: "ips/exception",
+	}
+}
+
+// Validate checks the IpsException before it is sent, see ValidateWith
+func (i *IpsException) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsException.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsException) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsException", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &IpsException{}
 
-// GetPath implements sophos.RestObject and returns the IpsExceptions GET path
+// GetPath implements sophos.RestGetter and returns the IpsExceptions GET path
 // Returns all available ips/exception objects
 func (*IpsExceptions) GetPath() string { return "/api/objects/ips/exception/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsExceptions) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsExceptions GET path
+// GetPath implements sophos.RestGetter and returns the IpsExceptions GET path
 // Returns all available exception types
 func (i *IpsException) GetPath() string {
 	return fmt.Sprintf("/api/objects/ips/exception/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsException) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsException DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsException DELETE path
 // Creates or updates the complete object exception
 func (*IpsException) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/exception/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsException PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsException PATCH path
 // Changes to parts of the object exception types
 func (*IpsException) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/exception/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsException POST path
+// PostPath implements sophos.Creatable and returns the IpsException POST path
 // Create a new ips/exception object
 func (*IpsException) PostPath() string {
 	return "/api/objects/ips/exception/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsException PUT path
+// PutPath implements sophos.Updatable and returns the IpsException PUT path
 // Creates or updates the complete object exception
 func (*IpsException) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/exception/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsException) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/exception/%s/usedby", ref)
@@ -166,14 +184,14 @@ func (*IpsException) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (i *IpsException) GetType() string { return i.ObjectType }
 
-// IpsGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// IpsGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsGroups []IpsGroup
 
-// IpsGroup is a generated Sophos object
+// IpsGroup represents a UTM ips/group object
 type IpsGroup struct {
 	Locked       string   `json:"_locked"`
-	Reference    string   `json:"_ref"`
 	ObjectType   string   `json:"_type"`
+	Reference    string   `json:"_ref"`
 	Action       string   `json:"action"`
 	Age          int64    `json:"age"`
 	Comment      string   `json:"comment"`
@@ -185,47 +203,65 @@ type IpsGroup struct {
 	Warnings     bool     `json:"warnings"`
 }
 
+// NewIpsGroup returns a IpsGroup with the default values of its swagger definition
+func NewIpsGroup() *IpsGroup {
+	return &IpsGroup{
+		ObjectType: "ips/group",
+	}
+}
+
+// Validate checks the IpsGroup before it is sent, see ValidateWith
+func (i *IpsGroup) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsGroup", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &IpsGroup{}
 
-// GetPath implements sophos.RestObject and returns the IpsGroups GET path
+// GetPath implements sophos.RestGetter and returns the IpsGroups GET path
 // Returns all available ips/group objects
 func (*IpsGroups) GetPath() string { return "/api/objects/ips/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsGroups GET path
+// GetPath implements sophos.RestGetter and returns the IpsGroups GET path
 // Returns all available group types
 func (i *IpsGroup) GetPath() string { return fmt.Sprintf("/api/objects/ips/group/%s", i.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsGroup) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsGroup DELETE path
 // Creates or updates the complete object group
 func (*IpsGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsGroup PATCH path
 // Changes to parts of the object group types
 func (*IpsGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsGroup POST path
+// PostPath implements sophos.Creatable and returns the IpsGroup POST path
 // Create a new ips/group object
 func (*IpsGroup) PostPath() string {
 	return "/api/objects/ips/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsGroup PUT path
+// PutPath implements sophos.Updatable and returns the IpsGroup PUT path
 // Creates or updates the complete object group
 func (*IpsGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/group/%s/usedby", ref)
@@ -234,7 +270,7 @@ func (*IpsGroup) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (i *IpsGroup) GetType() string { return i.ObjectType }
 
-// IpsRules is an Sophos Endpoint subType and implements sophos.RestObject
+// IpsRules is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsRules []IpsRule
 
 // IpsRule represents a UTM IPS rule
@@ -242,66 +278,107 @@ type IpsRule struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// Status default value is false
-	Status bool `json:"status"`
 	// Action can be one of: []string{"alert", "drop"}
 	// Action default value is "alert"
-	Action  string `json:"action"`
-	Comment string `json:"comment"`
-	Filter1 string `json:"filter1"`
-	Filter2 string `json:"filter2"`
-	Msg     string `json:"msg"`
-	Name    string `json:"name"`
-	Sid     int    `json:"sid"`
+	Action  IpsRuleAction `json:"action"`
+	Comment string        `json:"comment"`
+	Filter1 string        `json:"filter1"`
+	Filter2 string        `json:"filter2"`
+	Msg     string        `json:"msg"`
+	Name    string        `json:"name"`
+	Sid     int64         `json:"sid"`
+	// Status default value is false
+	Status bool `json:"status"`
+}
+
+// IpsRuleAction is the Action of a IpsRule
+type IpsRuleAction string
+
+// Known values of IpsRuleAction
+const (
+	IpsRuleActionAlert IpsRuleAction = "alert"
+	IpsRuleActionDrop  IpsRuleAction = "drop"
+)
+
+// Valid returns true if the value is a known IpsRuleAction
+func (v IpsRuleAction) Valid() bool {
+	switch v {
+	case IpsRuleActionAlert, IpsRuleActionDrop:
+		return true
+	}
+	return false
+}
+
+// NewIpsRule returns a IpsRule with the default values of its swagger definition
+func NewIpsRule() *IpsRule {
+	return &IpsRule{
+		ObjectType: "ips/rule",
+		Action:     IpsRuleActionAlert,
+	}
+}
+
+// Validate checks the IpsRule before it is sent, see ValidateWith
+func (i *IpsRule) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsRule.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsRule) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsRule", typeOf)
+	check.Enum("action", string(i.Action), i.Action.Valid())
+	check.Required("name", i.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &IpsRule{}
 
-// GetPath implements sophos.RestObject and returns the IpsRules GET path
+// GetPath implements sophos.RestGetter and returns the IpsRules GET path
 // Returns all available ips/rule objects
 func (*IpsRules) GetPath() string { return "/api/objects/ips/rule/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsRules) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsRules GET path
+// GetPath implements sophos.RestGetter and returns the IpsRules GET path
 // Returns all available rule types
 func (i *IpsRule) GetPath() string { return fmt.Sprintf("/api/objects/ips/rule/%s", i.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsRule) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsRule DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsRule DELETE path
 // Creates or updates the complete object rule
 func (*IpsRule) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/rule/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsRule PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsRule PATCH path
 // Changes to parts of the object rule types
 func (*IpsRule) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/rule/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsRule POST path
+// PostPath implements sophos.Creatable and returns the IpsRule POST path
 // Create a new ips/rule object
 func (*IpsRule) PostPath() string {
 	return "/api/objects/ips/rule/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsRule PUT path
+// PutPath implements sophos.Updatable and returns the IpsRule PUT path
 // Creates or updates the complete object rule
 func (*IpsRule) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/rule/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsRule) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/rule/%s/usedby", ref)
 }
 
-// IpsRuleModifiers is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *IpsRule) GetType() string { return i.ObjectType }
+
+// IpsRuleModifiers is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsRuleModifiers []IpsRuleModifier
 
 // IpsRuleModifier represents a UTM IPS rule modifier
@@ -309,62 +386,103 @@ type IpsRuleModifier struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Comment    string `json:"comment"`
-	Name       string `json:"name"`
+	// Action can be one of: []string{"alert", "drop"}
+	// Action default value is "drop"
+	Action  IpsRuleModifierAction `json:"action"`
+	Comment string                `json:"comment"`
+	Name    string                `json:"name"`
 	// Notification default value is false
-	Notification bool `json:"notification"`
-	Sid          int  `json:"sid"`
+	Notification bool  `json:"notification"`
+	Sid          int64 `json:"sid"`
 	// Status default value is false
 	Status bool `json:"status"`
-	// Action can be one of: []string{"alert", "drop"}
-	// Action default value is "drop"
-	Action string `json:"action"`
+}
+
+// IpsRuleModifierAction is the Action of a IpsRuleModifier
+type IpsRuleModifierAction string
+
+// Known values of IpsRuleModifierAction
+const (
+	IpsRuleModifierActionAlert IpsRuleModifierAction = "alert"
+	IpsRuleModifierActionDrop  IpsRuleModifierAction = "drop"
+)
+
+// Valid returns true if the value is a known IpsRuleModifierAction
+func (v IpsRuleModifierAction) Valid() bool {
+	switch v {
+	case IpsRuleModifierActionAlert, IpsRuleModifierActionDrop:
+		return true
+	}
+	return false
+}
+
+// NewIpsRuleModifier returns a IpsRuleModifier with the default values of its swagger definition
+func NewIpsRuleModifier() *IpsRuleModifier {
+	return &IpsRuleModifier{
+		ObjectType: "ips/rule_modifier",
+		Action:     IpsRuleModifierActionDrop,
+	}
+}
+
+// Validate checks the IpsRuleModifier before it is sent, see ValidateWith
+func (i *IpsRuleModifier) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsRuleModifier.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsRuleModifier) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsRuleModifier", typeOf)
+	check.Enum("action", string(i.Action), i.Action.Valid())
+	check.Required("name", i.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &IpsRuleModifier{}
 
-// GetPath implements sophos.RestObject and returns the IpsRuleModifiers GET path
+// GetPath implements sophos.RestGetter and returns the IpsRuleModifiers GET path
 // Returns all available ips/rule_modifier objects
 func (*IpsRuleModifiers) GetPath() string { return "/api/objects/ips/rule_modifier/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsRuleModifiers) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsRuleModifiers GET path
+// GetPath implements sophos.RestGetter and returns the IpsRuleModifiers GET path
 // Returns all available rule_modifier types
 func (i *IpsRuleModifier) GetPath() string {
 	return fmt.Sprintf("/api/objects/ips/rule_modifier/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsRuleModifier) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsRuleModifier DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsRuleModifier DELETE path
 // Creates or updates the complete object rule_modifier
 func (*IpsRuleModifier) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/rule_modifier/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsRuleModifier PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsRuleModifier PATCH path
 // Changes to parts of the object rule_modifier types
 func (*IpsRuleModifier) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/rule_modifier/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsRuleModifier POST path
+// PostPath implements sophos.Creatable and returns the IpsRuleModifier POST path
 // Create a new ips/rule_modifier object
 func (*IpsRuleModifier) PostPath() string {
 	return "/api/objects/ips/rule_modifier/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsRuleModifier PUT path
+// PutPath implements sophos.Updatable and returns the IpsRuleModifier PUT path
 // Creates or updates the complete object rule_modifier
 func (*IpsRuleModifier) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/rule_modifier/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsRuleModifier) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ips/rule_modifier/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (i *IpsRuleModifier) GetType() string { return i.ObjectType }
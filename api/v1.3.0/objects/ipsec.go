@@ -10,34 +10,34 @@ import (
 // GET /api/nodes/ipsec
 type Ipsec struct {
 	Advanced struct {
-		CrlAutoFetching       int64         `json:"crl_auto_fetching"`
-		CrlStrictPolicy       int64         `json:"crl_strict_policy"`
-		DeadPeerDetection     int64         `json:"dead_peer_detection"`
-		IkeDebug              []interface{} `json:"ike_debug"`
-		IkePort               int64         `json:"ike_port"`
-		Metric                int64         `json:"metric"`
-		NatTraversal          int64         `json:"nat_traversal"`
-		NatTraversalKeepalive int64         `json:"nat_traversal_keepalive"`
-		ProbePsk              int64         `json:"probe_psk"`
-		PskVpnID              string        `json:"psk_vpn_id"`
-		PskVpnIDType          string        `json:"psk_vpn_id_type"`
+		CrlAutoFetching       bool                   `json:"crl_auto_fetching"`
+		CrlStrictPolicy       bool                   `json:"crl_strict_policy"`
+		DeadPeerDetection     bool                   `json:"dead_peer_detection"`
+		IkeDebug              []string               `json:"ike_debug"`
+		IkePort               int64                  `json:"ike_port"`
+		Metric                map[string]interface{} `json:"metric"`
+		NatTraversal          bool                   `json:"nat_traversal"`
+		NatTraversalKeepalive int64                  `json:"nat_traversal_keepalive"`
+		ProbePsk              bool                   `json:"probe_psk"`
+		PskVpnID              string                 `json:"psk_vpn_id"`
+		PskVpnIDType          string                 `json:"psk_vpn_id_type"`
 	} `json:"advanced"`
 	Connections []string `json:"connections"`
 	LocalRsa    string   `json:"local_rsa"`
 	LocalX509   string   `json:"local_x509"`
-	Status      int64    `json:"status"`
+	Status      bool     `json:"status"`
 }
 
 var _ sophos.Endpoint = &Ipsec{}
 
-var defsIpsec = map[string]sophos.RestObject{
+var defsIpsec = map[string]sophos.RestGetter{
 	"IpsecGroup":         &IpsecGroup{},
 	"IpsecPolicy":        &IpsecPolicy{},
 	"IpsecRemoteGateway": &IpsecRemoteGateway{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Ipsec's Objects
-func (Ipsec) RestObjects() map[string]sophos.RestObject { return defsIpsec }
+func (Ipsec) RestObjects() map[string]sophos.RestGetter { return defsIpsec }
 
 // GetPath implements sophos.RestGetter
 func (*Ipsec) GetPath() string { return "/api/nodes/ipsec" }
@@ -75,7 +75,7 @@ func (Ipsec) References() []string {
 	}
 }
 
-// IpsecGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// IpsecGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecGroups []IpsecGroup
 
 // IpsecGroup represents a UTM group
@@ -87,60 +87,81 @@ type IpsecGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewIpsecGroup returns a IpsecGroup with the default values of its swagger definition
+func NewIpsecGroup() *IpsecGroup {
+	return &IpsecGroup{
+		ObjectType: "ipsec/group",
+	}
+}
+
+// Validate checks the IpsecGroup before it is sent, see ValidateWith
+func (i *IpsecGroup) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecGroup", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &IpsecGroup{}
 
-// GetPath implements sophos.RestObject and returns the IpsecGroups GET path
+// GetPath implements sophos.RestGetter and returns the IpsecGroups GET path
 // Returns all available ipsec/group objects
 func (*IpsecGroups) GetPath() string { return "/api/objects/ipsec/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecGroups GET path
+// GetPath implements sophos.RestGetter and returns the IpsecGroups GET path
 // Returns all available group types
 func (i *IpsecGroup) GetPath() string { return fmt.Sprintf("/api/objects/ipsec/group/%s", i.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecGroup) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecGroup DELETE path
 // Creates or updates the complete object group
 func (*IpsecGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecGroup PATCH path
 // Changes to parts of the object group types
 func (*IpsecGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecGroup POST path
+// PostPath implements sophos.Creatable and returns the IpsecGroup POST path
 // Create a new ipsec/group object
 func (*IpsecGroup) PostPath() string {
 	return "/api/objects/ipsec/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecGroup PUT path
+// PutPath implements sophos.Updatable and returns the IpsecGroup PUT path
 // Creates or updates the complete object group
 func (*IpsecGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec/group/%s/usedby", ref)
 }
 
-// IpsecPolicys is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *IpsecGroup) GetType() string { return i.ObjectType }
+
+// IpsecPolicys is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecPolicys []IpsecPolicy
 
-// IpsecPolicy is a generated Sophos object
+// IpsecPolicy represents a UTM ipsec/policy object
 type IpsecPolicy struct {
 	Locked            string `json:"_locked"`
-	Reference         string `json:"_ref"`
 	ObjectType        string `json:"_type"`
+	Reference         string `json:"_ref"`
 	Comment           string `json:"comment"`
 	IkeAuthAlg        string `json:"ike_auth_alg"`
 	IkeDhGroup        string `json:"ike_dh_group"`
@@ -155,49 +176,67 @@ type IpsecPolicy struct {
 	Name              string `json:"name"`
 }
 
+// NewIpsecPolicy returns a IpsecPolicy with the default values of its swagger definition
+func NewIpsecPolicy() *IpsecPolicy {
+	return &IpsecPolicy{
+		ObjectType: "ipsec/policy",
+	}
+}
+
+// Validate checks the IpsecPolicy before it is sent, see ValidateWith
+func (i *IpsecPolicy) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecPolicy.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecPolicy) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecPolicy", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &IpsecPolicy{}
 
-// GetPath implements sophos.RestObject and returns the IpsecPolicys GET path
+// GetPath implements sophos.RestGetter and returns the IpsecPolicys GET path
 // Returns all available ipsec/policy objects
 func (*IpsecPolicys) GetPath() string { return "/api/objects/ipsec/policy/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecPolicys) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecPolicys GET path
+// GetPath implements sophos.RestGetter and returns the IpsecPolicys GET path
 // Returns all available policy types
 func (i *IpsecPolicy) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipsec/policy/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecPolicy) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecPolicy DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecPolicy DELETE path
 // Creates or updates the complete object policy
 func (*IpsecPolicy) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec/policy/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecPolicy PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecPolicy PATCH path
 // Changes to parts of the object policy types
 func (*IpsecPolicy) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec/policy/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecPolicy POST path
+// PostPath implements sophos.Creatable and returns the IpsecPolicy POST path
 // Create a new ipsec/policy object
 func (*IpsecPolicy) PostPath() string {
 	return "/api/objects/ipsec/policy/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecPolicy PUT path
+// PutPath implements sophos.Updatable and returns the IpsecPolicy PUT path
 // Creates or updates the complete object policy
 func (*IpsecPolicy) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec/policy/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecPolicy) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec/policy/%s/usedby", ref)
@@ -206,14 +245,14 @@ func (*IpsecPolicy) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (i *IpsecPolicy) GetType() string { return i.ObjectType }
 
-// IpsecRemoteGateways is an Sophos Endpoint subType and implements sophos.RestObject
+// IpsecRemoteGateways is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecRemoteGateways []IpsecRemoteGateway
 
-// IpsecRemoteGateway is a generated Sophos object
+// IpsecRemoteGateway represents a UTM ipsec/remote_gateway object
 type IpsecRemoteGateway struct {
 	Locked         string   `json:"_locked"`
-	Reference      string   `json:"_ref"`
 	ObjectType     string   `json:"_type"`
+	Reference      string   `json:"_ref"`
 	Authentication string   `json:"authentication"`
 	Comment        string   `json:"comment"`
 	Ecn            bool     `json:"ecn"`
@@ -226,49 +265,67 @@ type IpsecRemoteGateway struct {
 	XauthUsername  string   `json:"xauth_username"`
 }
 
+// NewIpsecRemoteGateway returns a IpsecRemoteGateway with the default values of its swagger definition
+func NewIpsecRemoteGateway() *IpsecRemoteGateway {
+	return &IpsecRemoteGateway{
+		ObjectType: "ipsec/remote_gateway",
+	}
+}
+
+// Validate checks the IpsecRemoteGateway before it is sent, see ValidateWith
+func (i *IpsecRemoteGateway) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecRemoteGateway.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecRemoteGateway) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecRemoteGateway", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &IpsecRemoteGateway{}
 
-// GetPath implements sophos.RestObject and returns the IpsecRemoteGateways GET path
+// GetPath implements sophos.RestGetter and returns the IpsecRemoteGateways GET path
 // Returns all available ipsec/remote_gateway objects
 func (*IpsecRemoteGateways) GetPath() string { return "/api/objects/ipsec/remote_gateway/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecRemoteGateways) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecRemoteGateways GET path
+// GetPath implements sophos.RestGetter and returns the IpsecRemoteGateways GET path
 // Returns all available remote_gateway types
 func (i *IpsecRemoteGateway) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipsec/remote_gateway/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecRemoteGateway) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecRemoteGateway DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecRemoteGateway DELETE path
 // Creates or updates the complete object remote_gateway
 func (*IpsecRemoteGateway) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec/remote_gateway/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecRemoteGateway PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecRemoteGateway PATCH path
 // Changes to parts of the object remote_gateway types
 func (*IpsecRemoteGateway) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec/remote_gateway/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecRemoteGateway POST path
+// PostPath implements sophos.Creatable and returns the IpsecRemoteGateway POST path
 // Create a new ipsec/remote_gateway object
 func (*IpsecRemoteGateway) PostPath() string {
 	return "/api/objects/ipsec/remote_gateway/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecRemoteGateway PUT path
+// PutPath implements sophos.Updatable and returns the IpsecRemoteGateway PUT path
 // Creates or updates the complete object remote_gateway
 func (*IpsecRemoteGateway) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec/remote_gateway/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecRemoteGateway) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec/remote_gateway/%s/usedby", ref)
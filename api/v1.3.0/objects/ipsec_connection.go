@@ -21,7 +21,7 @@ type IpsecConnection struct {
 
 var _ sophos.Endpoint = &IpsecConnection{}
 
-var defsIpsecConnection = map[string]sophos.RestObject{
+var defsIpsecConnection = map[string]sophos.RestGetter{
 	"IpsecConnectionAmazonVpc":        &IpsecConnectionAmazonVpc{},
 	"IpsecConnectionGroup":            &IpsecConnectionGroup{},
 	"IpsecConnectionL2Tp":             &IpsecConnectionL2Tp{},
@@ -33,7 +33,7 @@ var defsIpsecConnection = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of IpsecConnection's Objects
-func (IpsecConnection) RestObjects() map[string]sophos.RestObject { return defsIpsecConnection }
+func (IpsecConnection) RestObjects() map[string]sophos.RestGetter { return defsIpsecConnection }
 
 // GetPath implements sophos.RestGetter
 func (*IpsecConnection) GetPath() string { return "/api/nodes/ipsec_connection" }
@@ -91,14 +91,14 @@ func (IpsecConnection) References() []string {
 	}
 }
 
-// IpsecConnectionAmazonVpcs is an Sophos Endpoint subType and implements sophos.RestObject
+// IpsecConnectionAmazonVpcs is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecConnectionAmazonVpcs []IpsecConnectionAmazonVpc
 
-// IpsecConnectionAmazonVpc is a generated Sophos object
+// IpsecConnectionAmazonVpc represents a UTM ipsec_connection/amazon_vpc object
 type IpsecConnectionAmazonVpc struct {
 	Locked         string `json:"_locked"`
-	Reference      string `json:"_ref"`
 	ObjectType     string `json:"_type"`
+	Reference      string `json:"_ref"`
 	Authentication string `json:"authentication"`
 	Comment        string `json:"comment"`
 	Interface      string `json:"interface"`
@@ -107,49 +107,69 @@ type IpsecConnectionAmazonVpc struct {
 	Remote         string `json:"remote"`
 }
 
+// NewIpsecConnectionAmazonVpc returns a IpsecConnectionAmazonVpc with the default values of its swagger definition
+func NewIpsecConnectionAmazonVpc() *IpsecConnectionAmazonVpc {
+	return &IpsecConnectionAmazonVpc{
+		ObjectType: "ipsec_connection/amazon_vpc",
+	}
+}
+
+// Validate checks the IpsecConnectionAmazonVpc before it is sent, see ValidateWith
+func (i *IpsecConnectionAmazonVpc) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecConnectionAmazonVpc.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecConnectionAmazonVpc) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecConnectionAmazonVpc", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &IpsecConnectionAmazonVpc{}
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionAmazonVpcs GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionAmazonVpcs GET path
 // Returns all available ipsec_connection/amazon_vpc objects
-func (*IpsecConnectionAmazonVpcs) GetPath() string { return "/api/objects/ipsec_connection/amazon_vpc/" }
+func (*IpsecConnectionAmazonVpcs) GetPath() string {
+	return "/api/objects/ipsec_connection/amazon_vpc/"
+}
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecConnectionAmazonVpcs) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionAmazonVpcs GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionAmazonVpcs GET path
 // Returns all available amazon_vpc types
 func (i *IpsecConnectionAmazonVpc) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/amazon_vpc/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecConnectionAmazonVpc) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecConnectionAmazonVpc DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecConnectionAmazonVpc DELETE path
 // Creates or updates the complete object amazon_vpc
 func (*IpsecConnectionAmazonVpc) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/amazon_vpc/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecConnectionAmazonVpc PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecConnectionAmazonVpc PATCH path
 // Changes to parts of the object amazon_vpc types
 func (*IpsecConnectionAmazonVpc) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/amazon_vpc/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecConnectionAmazonVpc POST path
+// PostPath implements sophos.Creatable and returns the IpsecConnectionAmazonVpc POST path
 // Create a new ipsec_connection/amazon_vpc object
 func (*IpsecConnectionAmazonVpc) PostPath() string {
 	return "/api/objects/ipsec_connection/amazon_vpc/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecConnectionAmazonVpc PUT path
+// PutPath implements sophos.Updatable and returns the IpsecConnectionAmazonVpc PUT path
 // Creates or updates the complete object amazon_vpc
 func (*IpsecConnectionAmazonVpc) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/amazon_vpc/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecConnectionAmazonVpc) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/amazon_vpc/%s/usedby", ref)
@@ -158,7 +178,7 @@ func (*IpsecConnectionAmazonVpc) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (i *IpsecConnectionAmazonVpc) GetType() string { return i.ObjectType }
 
-// IpsecConnectionGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// IpsecConnectionGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecConnectionGroups []IpsecConnectionGroup
 
 // IpsecConnectionGroup represents a UTM group
@@ -170,62 +190,83 @@ type IpsecConnectionGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewIpsecConnectionGroup returns a IpsecConnectionGroup with the default values of its swagger definition
+func NewIpsecConnectionGroup() *IpsecConnectionGroup {
+	return &IpsecConnectionGroup{
+		ObjectType: "ipsec_connection/group",
+	}
+}
+
+// Validate checks the IpsecConnectionGroup before it is sent, see ValidateWith
+func (i *IpsecConnectionGroup) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecConnectionGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecConnectionGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecConnectionGroup", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &IpsecConnectionGroup{}
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionGroups GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionGroups GET path
 // Returns all available ipsec_connection/group objects
 func (*IpsecConnectionGroups) GetPath() string { return "/api/objects/ipsec_connection/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecConnectionGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionGroups GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionGroups GET path
 // Returns all available group types
 func (i *IpsecConnectionGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/group/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecConnectionGroup) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecConnectionGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecConnectionGroup DELETE path
 // Creates or updates the complete object group
 func (*IpsecConnectionGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecConnectionGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecConnectionGroup PATCH path
 // Changes to parts of the object group types
 func (*IpsecConnectionGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecConnectionGroup POST path
+// PostPath implements sophos.Creatable and returns the IpsecConnectionGroup POST path
 // Create a new ipsec_connection/group object
 func (*IpsecConnectionGroup) PostPath() string {
 	return "/api/objects/ipsec_connection/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecConnectionGroup PUT path
+// PutPath implements sophos.Updatable and returns the IpsecConnectionGroup PUT path
 // Creates or updates the complete object group
 func (*IpsecConnectionGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecConnectionGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/group/%s/usedby", ref)
 }
 
-// IpsecConnectionL2Tps is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *IpsecConnectionGroup) GetType() string { return i.ObjectType }
+
+// IpsecConnectionL2Tps is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecConnectionL2Tps []IpsecConnectionL2Tp
 
-// IpsecConnectionL2Tp is a generated Sophos object
+// IpsecConnectionL2Tp represents a UTM ipsec_connection/l2tp object
 type IpsecConnectionL2Tp struct {
 	Locked                    string   `json:"_locked"`
-	Reference                 string   `json:"_ref"`
 	ObjectType                string   `json:"_type"`
+	Reference                 string   `json:"_ref"`
 	AuthenticationType        string   `json:"authentication_type"`
 	Certificate               string   `json:"certificate"`
 	Comment                   string   `json:"comment"`
@@ -245,49 +286,67 @@ type IpsecConnectionL2Tp struct {
 	Users                     []string `json:"users"`
 }
 
+// NewIpsecConnectionL2Tp returns a IpsecConnectionL2Tp with the default values of its swagger definition
+func NewIpsecConnectionL2Tp() *IpsecConnectionL2Tp {
+	return &IpsecConnectionL2Tp{
+		ObjectType: "ipsec_connection/l2tp",
+	}
+}
+
+// Validate checks the IpsecConnectionL2Tp before it is sent, see ValidateWith
+func (i *IpsecConnectionL2Tp) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecConnectionL2Tp.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecConnectionL2Tp) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecConnectionL2Tp", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &IpsecConnectionL2Tp{}
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionL2Tps GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionL2Tps GET path
 // Returns all available ipsec_connection/l2tp objects
 func (*IpsecConnectionL2Tps) GetPath() string { return "/api/objects/ipsec_connection/l2tp/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecConnectionL2Tps) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionL2Tps GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionL2Tps GET path
 // Returns all available l2tp types
 func (i *IpsecConnectionL2Tp) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/l2tp/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecConnectionL2Tp) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecConnectionL2Tp DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecConnectionL2Tp DELETE path
 // Creates or updates the complete object l2tp
 func (*IpsecConnectionL2Tp) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/l2tp/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecConnectionL2Tp PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecConnectionL2Tp PATCH path
 // Changes to parts of the object l2tp types
 func (*IpsecConnectionL2Tp) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/l2tp/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecConnectionL2Tp POST path
+// PostPath implements sophos.Creatable and returns the IpsecConnectionL2Tp POST path
 // Create a new ipsec_connection/l2tp object
 func (*IpsecConnectionL2Tp) PostPath() string {
 	return "/api/objects/ipsec_connection/l2tp/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecConnectionL2Tp PUT path
+// PutPath implements sophos.Updatable and returns the IpsecConnectionL2Tp PUT path
 // Creates or updates the complete object l2tp
 func (*IpsecConnectionL2Tp) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/l2tp/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecConnectionL2Tp) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/l2tp/%s/usedby", ref)
@@ -296,7 +355,7 @@ func (*IpsecConnectionL2Tp) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (i *IpsecConnectionL2Tp) GetType() string { return i.ObjectType }
 
-// IpsecConnectionRoadwarriorCas is an Sophos Endpoint subType and implements sophos.RestObject
+// IpsecConnectionRoadwarriorCas is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecConnectionRoadwarriorCas []IpsecConnectionRoadwarriorCa
 
 // IpsecConnectionRoadwarriorCa represents a UTM IPsec CA remote access
@@ -305,170 +364,249 @@ type IpsecConnectionRoadwarriorCa struct {
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
 	// Authentication description: REF(ipsec_remote_auth/ca)
-	Authentication string `json:"authentication"`
-	// IpPool description: REF(network/network)
-	IpPool   string        `json:"ip_pool"`
-	Name     string        `json:"name"`
-	Networks []interface{} `json:"networks"`
-	// UseIpPool default value is false
-	UseIpPool bool   `json:"use_ip_pool"`
-	Comment   string `json:"comment"`
+	Authentication IpsecRemoteAuthRef `json:"authentication"`
+	Comment        string             `json:"comment"`
 	// Interface description: REF(interface/*)
-	Interface string `json:"interface"`
+	Interface InterfaceRef `json:"interface"`
+	// IpPool description: REF(network/network)
+	IpPool   NetworkRef `json:"ip_pool"`
+	Name     string     `json:"name"`
+	Networks []string   `json:"networks"`
 	// Policy description: REF(ipsec/policy)
-	Policy string `json:"policy"`
+	Policy IpsecRef `json:"policy"`
 	// Status default value is false
-	Status bool          `json:"status"`
-	Users  []interface{} `json:"users"`
+	Status bool `json:"status"`
+	// UseIpPool default value is false
+	UseIpPool bool     `json:"use_ip_pool"`
+	Users     []string `json:"users"`
 	// Xauth default value is false
 	Xauth bool `json:"xauth"`
 }
 
+// NewIpsecConnectionRoadwarriorCa returns a IpsecConnectionRoadwarriorCa with the default values of its swagger definition
+func NewIpsecConnectionRoadwarriorCa() *IpsecConnectionRoadwarriorCa {
+	return &IpsecConnectionRoadwarriorCa{
+		ObjectType: "ipsec_connection/roadwarrior_ca",
+	}
+}
+
+// Validate checks the IpsecConnectionRoadwarriorCa before it is sent, see ValidateWith
+func (i *IpsecConnectionRoadwarriorCa) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecConnectionRoadwarriorCa.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecConnectionRoadwarriorCa) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecConnectionRoadwarriorCa", typeOf)
+	check.Ref("authentication", string(i.Authentication), "REF(ipsec_remote_auth/ca)")
+	check.Required("interface", string(i.Interface))
+	check.Ref("interface", string(i.Interface), "REF(interface/*)")
+	check.Required("ip_pool", string(i.IpPool))
+	check.Ref("ip_pool", string(i.IpPool), "REF(network/network)")
+	check.Required("name", i.Name)
+	check.Required("policy", string(i.Policy))
+	check.Ref("policy", string(i.Policy), "REF(ipsec/policy)")
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &IpsecConnectionRoadwarriorCa{}
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorCas GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionRoadwarriorCas GET path
 // Returns all available ipsec_connection/roadwarrior_ca objects
 func (*IpsecConnectionRoadwarriorCas) GetPath() string {
 	return "/api/objects/ipsec_connection/roadwarrior_ca/"
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecConnectionRoadwarriorCas) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorCas GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionRoadwarriorCas GET path
 // Returns all available roadwarrior_ca types
 func (i *IpsecConnectionRoadwarriorCa) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_ca/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecConnectionRoadwarriorCa) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorCa DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecConnectionRoadwarriorCa DELETE path
 // Creates or updates the complete object roadwarrior_ca
 func (*IpsecConnectionRoadwarriorCa) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_ca/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorCa PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecConnectionRoadwarriorCa PATCH path
 // Changes to parts of the object roadwarrior_ca types
 func (*IpsecConnectionRoadwarriorCa) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_ca/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorCa POST path
+// PostPath implements sophos.Creatable and returns the IpsecConnectionRoadwarriorCa POST path
 // Create a new ipsec_connection/roadwarrior_ca object
 func (*IpsecConnectionRoadwarriorCa) PostPath() string {
 	return "/api/objects/ipsec_connection/roadwarrior_ca/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorCa PUT path
+// PutPath implements sophos.Updatable and returns the IpsecConnectionRoadwarriorCa PUT path
 // Creates or updates the complete object roadwarrior_ca
 func (*IpsecConnectionRoadwarriorCa) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_ca/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecConnectionRoadwarriorCa) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_ca/%s/usedby", ref)
 }
 
-// IpsecConnectionRoadwarriorCiscos is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *IpsecConnectionRoadwarriorCa) GetType() string { return i.ObjectType }
+
+// IpsecConnectionRoadwarriorCiscos is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecConnectionRoadwarriorCiscos []IpsecConnectionRoadwarriorCisco
 
 // IpsecConnectionRoadwarriorCisco represents a UTM Cisco VPN client connection
 type IpsecConnectionRoadwarriorCisco struct {
-	Locked     string `json:"_locked"`
-	ObjectType string `json:"_type"`
-	Reference  string `json:"_ref"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Aaa        []string `json:"aaa"`
+	// AutoPfIn description: REF(packetfilter/packetfilter)
+	// AutoPfIn default value is ""
+	AutoPfIn PacketfilterRef `json:"auto_pf_in"`
 	// AutoPfOut description: REF(packetfilter/packetfilter)
 	// AutoPfOut default value is ""
-	AutoPfOut string `json:"auto_pf_out"`
+	AutoPfOut PacketfilterRef `json:"auto_pf_out"`
 	// AutoPfrule default value is false
 	AutoPfrule bool `json:"auto_pfrule"`
-	// IphoneOndemandEnabled default value is false
-	IphoneOndemandEnabled bool   `json:"iphone_ondemand_enabled"`
-	Name                  string `json:"name"`
-	// Status default value is false
-	Status bool `json:"status"`
-	// AutoPfIn description: REF(packetfilter/packetfilter)
-	// AutoPfIn default value is ""
-	AutoPfIn string `json:"auto_pf_in"`
+	// Certificate description: REF(ca/host_key_cert)
+	Certificate CaRef  `json:"certificate"`
+	Comment     string `json:"comment"`
 	// Interface description: REF(interface/*)
-	Interface             string        `json:"interface"`
-	IphoneOndemandDomains []interface{} `json:"iphone_ondemand_domains"`
-	Networks              []interface{} `json:"networks"`
-	// Policy description: REF(ipsec/policy)
-	// Policy default value is "REF_IPsecPolicyCisco"
-	Policy  string        `json:"policy"`
-	Aaa     []interface{} `json:"aaa"`
-	Comment string        `json:"comment"`
+	Interface InterfaceRef `json:"interface"`
 	// IpAssignmentPool description: REF(network/network)
-	IpAssignmentPool string `json:"ip_assignment_pool"`
+	IpAssignmentPool     NetworkRef `json:"ip_assignment_pool"`
+	IphoneConnectionName string     `json:"iphone_connection_name"`
+	// IphoneHostname default value is ""
+	IphoneHostname        string   `json:"iphone_hostname"`
+	IphoneOndemandDomains []string `json:"iphone_ondemand_domains"`
+	// IphoneOndemandEnabled default value is false
+	IphoneOndemandEnabled bool `json:"iphone_ondemand_enabled"`
 	// IphoneOndemandType can be one of: []string{"OnDemandMatchDomainsAlways", "OnDemandMatchDomainsOnRetry"}
 	// IphoneOndemandType default value is "OnDemandMatchDomainsOnRetry"
-	IphoneOndemandType string `json:"iphone_ondemand_type"`
+	IphoneOndemandType IpsecConnectionRoadwarriorCiscoIphoneOndemandType `json:"iphone_ondemand_type"`
 	// IphoneStatus default value is false
-	IphoneStatus bool `json:"iphone_status"`
-	// Certificate description: REF(ca/host_key_cert)
-	Certificate          string `json:"certificate"`
-	IphoneConnectionName string `json:"iphone_connection_name"`
-	// IphoneHostname default value is ""
-	IphoneHostname string `json:"iphone_hostname"`
+	IphoneStatus bool     `json:"iphone_status"`
+	Name         string   `json:"name"`
+	Networks     []string `json:"networks"`
+	// Policy description: REF(ipsec/policy)
+	// Policy default value is "REF_IPsecPolicyCisco"
+	Policy IpsecRef `json:"policy"`
+	// Status default value is false
+	Status bool `json:"status"`
+}
+
+// IpsecConnectionRoadwarriorCiscoIphoneOndemandType is the IphoneOndemandType of a IpsecConnectionRoadwarriorCisco
+type IpsecConnectionRoadwarriorCiscoIphoneOndemandType string
+
+// Known values of IpsecConnectionRoadwarriorCiscoIphoneOndemandType
+const (
+	IpsecConnectionRoadwarriorCiscoIphoneOndemandTypeOnDemandMatchDomainsAlways  IpsecConnectionRoadwarriorCiscoIphoneOndemandType = "OnDemandMatchDomainsAlways"
+	IpsecConnectionRoadwarriorCiscoIphoneOndemandTypeOnDemandMatchDomainsOnRetry IpsecConnectionRoadwarriorCiscoIphoneOndemandType = "OnDemandMatchDomainsOnRetry"
+)
+
+// Valid returns true if the value is a known IpsecConnectionRoadwarriorCiscoIphoneOndemandType
+func (v IpsecConnectionRoadwarriorCiscoIphoneOndemandType) Valid() bool {
+	switch v {
+	case IpsecConnectionRoadwarriorCiscoIphoneOndemandTypeOnDemandMatchDomainsAlways, IpsecConnectionRoadwarriorCiscoIphoneOndemandTypeOnDemandMatchDomainsOnRetry:
+		return true
+	}
+	return false
+}
+
+// NewIpsecConnectionRoadwarriorCisco returns a IpsecConnectionRoadwarriorCisco with the default values of its swagger definition
+func NewIpsecConnectionRoadwarriorCisco() *IpsecConnectionRoadwarriorCisco {
+	return &IpsecConnectionRoadwarriorCisco{
+		ObjectType:         "ipsec_connection/roadwarrior_cisco",
+		IphoneOndemandType: IpsecConnectionRoadwarriorCiscoIphoneOndemandTypeOnDemandMatchDomainsOnRetry,
+		Policy:             "REF_IPsecPolicyCisco",
+	}
+}
+
+// Validate checks the IpsecConnectionRoadwarriorCisco before it is sent, see ValidateWith
+func (i *IpsecConnectionRoadwarriorCisco) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecConnectionRoadwarriorCisco.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecConnectionRoadwarriorCisco) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecConnectionRoadwarriorCisco", typeOf)
+	check.Ref("auto_pf_in", string(i.AutoPfIn), "REF(packetfilter/packetfilter)")
+	check.Ref("auto_pf_out", string(i.AutoPfOut), "REF(packetfilter/packetfilter)")
+	check.Required("certificate", string(i.Certificate))
+	check.Ref("certificate", string(i.Certificate), "REF(ca/host_key_cert)")
+	check.Required("interface", string(i.Interface))
+	check.Ref("interface", string(i.Interface), "REF(interface/*)")
+	check.Required("ip_assignment_pool", string(i.IpAssignmentPool))
+	check.Ref("ip_assignment_pool", string(i.IpAssignmentPool), "REF(network/network)")
+	check.Enum("iphone_ondemand_type", string(i.IphoneOndemandType), i.IphoneOndemandType.Valid())
+	check.Required("name", i.Name)
+	check.Ref("policy", string(i.Policy), "REF(ipsec/policy)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &IpsecConnectionRoadwarriorCisco{}
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorCiscos GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionRoadwarriorCiscos GET path
 // Returns all available ipsec_connection/roadwarrior_cisco objects
 func (*IpsecConnectionRoadwarriorCiscos) GetPath() string {
 	return "/api/objects/ipsec_connection/roadwarrior_cisco/"
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecConnectionRoadwarriorCiscos) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorCiscos GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionRoadwarriorCiscos GET path
 // Returns all available roadwarrior_cisco types
 func (i *IpsecConnectionRoadwarriorCisco) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_cisco/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecConnectionRoadwarriorCisco) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorCisco DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecConnectionRoadwarriorCisco DELETE path
 // Creates or updates the complete object roadwarrior_cisco
 func (*IpsecConnectionRoadwarriorCisco) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_cisco/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorCisco PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecConnectionRoadwarriorCisco PATCH path
 // Changes to parts of the object roadwarrior_cisco types
 func (*IpsecConnectionRoadwarriorCisco) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_cisco/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorCisco POST path
+// PostPath implements sophos.Creatable and returns the IpsecConnectionRoadwarriorCisco POST path
 // Create a new ipsec_connection/roadwarrior_cisco object
 func (*IpsecConnectionRoadwarriorCisco) PostPath() string {
 	return "/api/objects/ipsec_connection/roadwarrior_cisco/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorCisco PUT path
+// PutPath implements sophos.Updatable and returns the IpsecConnectionRoadwarriorCisco PUT path
 // Creates or updates the complete object roadwarrior_cisco
 func (*IpsecConnectionRoadwarriorCisco) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_cisco/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecConnectionRoadwarriorCisco) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_cisco/%s/usedby", ref)
 }
 
-// IpsecConnectionRoadwarriorPsks is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *IpsecConnectionRoadwarriorCisco) GetType() string { return i.ObjectType }
+
+// IpsecConnectionRoadwarriorPsks is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecConnectionRoadwarriorPsks []IpsecConnectionRoadwarriorPsk
 
 // IpsecConnectionRoadwarriorPsk represents a UTM IPsec PSK remote access
@@ -476,227 +614,314 @@ type IpsecConnectionRoadwarriorPsk struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Comment    string `json:"comment"`
+	// Authentication description: REF(ipsec_remote_auth/psk)
+	Authentication IpsecRemoteAuthRef `json:"authentication"`
+	Comment        string             `json:"comment"`
 	// Interface description: REF(interface/*)
-	Interface string `json:"interface"`
+	Interface InterfaceRef `json:"interface"`
 	// IpPool description: REF(network/network)
-	IpPool   string        `json:"ip_pool"`
-	Name     string        `json:"name"`
-	Networks []interface{} `json:"networks"`
+	IpPool   NetworkRef `json:"ip_pool"`
+	Name     string     `json:"name"`
+	Networks []string   `json:"networks"`
 	// Policy description: REF(ipsec/policy)
-	Policy string `json:"policy"`
+	Policy IpsecRef `json:"policy"`
 	// Status default value is false
 	Status bool `json:"status"`
 	// UseIpPool default value is false
-	UseIpPool bool `json:"use_ip_pool"`
-	// Authentication description: REF(ipsec_remote_auth/psk)
-	Authentication string `json:"authentication"`
+	UseIpPool bool     `json:"use_ip_pool"`
+	Users     []string `json:"users"`
 	// Xauth default value is false
-	Xauth bool          `json:"xauth"`
-	Users []interface{} `json:"users"`
+	Xauth bool `json:"xauth"`
+}
+
+// NewIpsecConnectionRoadwarriorPsk returns a IpsecConnectionRoadwarriorPsk with the default values of its swagger definition
+func NewIpsecConnectionRoadwarriorPsk() *IpsecConnectionRoadwarriorPsk {
+	return &IpsecConnectionRoadwarriorPsk{
+		ObjectType: "ipsec_connection/roadwarrior_psk",
+	}
+}
+
+// Validate checks the IpsecConnectionRoadwarriorPsk before it is sent, see ValidateWith
+func (i *IpsecConnectionRoadwarriorPsk) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecConnectionRoadwarriorPsk.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecConnectionRoadwarriorPsk) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecConnectionRoadwarriorPsk", typeOf)
+	check.Ref("authentication", string(i.Authentication), "REF(ipsec_remote_auth/psk)")
+	check.Required("interface", string(i.Interface))
+	check.Ref("interface", string(i.Interface), "REF(interface/*)")
+	check.Required("ip_pool", string(i.IpPool))
+	check.Ref("ip_pool", string(i.IpPool), "REF(network/network)")
+	check.Required("name", i.Name)
+	check.Required("policy", string(i.Policy))
+	check.Ref("policy", string(i.Policy), "REF(ipsec/policy)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &IpsecConnectionRoadwarriorPsk{}
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorPsks GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionRoadwarriorPsks GET path
 // Returns all available ipsec_connection/roadwarrior_psk objects
 func (*IpsecConnectionRoadwarriorPsks) GetPath() string {
 	return "/api/objects/ipsec_connection/roadwarrior_psk/"
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecConnectionRoadwarriorPsks) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorPsks GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionRoadwarriorPsks GET path
 // Returns all available roadwarrior_psk types
 func (i *IpsecConnectionRoadwarriorPsk) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_psk/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecConnectionRoadwarriorPsk) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorPsk DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecConnectionRoadwarriorPsk DELETE path
 // Creates or updates the complete object roadwarrior_psk
 func (*IpsecConnectionRoadwarriorPsk) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_psk/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorPsk PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecConnectionRoadwarriorPsk PATCH path
 // Changes to parts of the object roadwarrior_psk types
 func (*IpsecConnectionRoadwarriorPsk) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_psk/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorPsk POST path
+// PostPath implements sophos.Creatable and returns the IpsecConnectionRoadwarriorPsk POST path
 // Create a new ipsec_connection/roadwarrior_psk object
 func (*IpsecConnectionRoadwarriorPsk) PostPath() string {
 	return "/api/objects/ipsec_connection/roadwarrior_psk/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorPsk PUT path
+// PutPath implements sophos.Updatable and returns the IpsecConnectionRoadwarriorPsk PUT path
 // Creates or updates the complete object roadwarrior_psk
 func (*IpsecConnectionRoadwarriorPsk) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_psk/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecConnectionRoadwarriorPsk) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_psk/%s/usedby", ref)
 }
 
-// IpsecConnectionRoadwarriorX509s is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *IpsecConnectionRoadwarriorPsk) GetType() string { return i.ObjectType }
+
+// IpsecConnectionRoadwarriorX509s is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecConnectionRoadwarriorX509s []IpsecConnectionRoadwarriorX509
 
 // IpsecConnectionRoadwarriorX509 represents a UTM IPsec X509 remote access
 type IpsecConnectionRoadwarriorX509 struct {
-	Locked     string        `json:"_locked"`
-	ObjectType string        `json:"_type"`
-	Reference  string        `json:"_ref"`
-	Networks   []interface{} `json:"networks"`
-	// Policy description: REF(ipsec/policy)
-	Policy string `json:"policy"`
-	// Status default value is false
-	Status bool `json:"status"`
-	// Xauth default value is false
-	Xauth bool `json:"xauth"`
+	Locked     string `json:"_locked"`
+	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	// AutoPfIn description: REF(packetfilter/packetfilter)
 	// AutoPfIn default value is ""
-	AutoPfIn string `json:"auto_pf_in"`
+	AutoPfIn PacketfilterRef `json:"auto_pf_in"`
 	// AutoPfOut description: REF(packetfilter/packetfilter)
 	// AutoPfOut default value is ""
-	AutoPfOut string        `json:"auto_pf_out"`
-	Comment   string        `json:"comment"`
-	Name      string        `json:"name"`
-	Users     []interface{} `json:"users"`
+	AutoPfOut PacketfilterRef `json:"auto_pf_out"`
 	// AutoPfrule default value is false
-	AutoPfrule bool `json:"auto_pfrule"`
+	AutoPfrule bool   `json:"auto_pfrule"`
+	Comment    string `json:"comment"`
 	// Interface description: REF(interface/*)
-	Interface string `json:"interface"`
+	Interface InterfaceRef `json:"interface"`
 	// IpPool description: REF(network/network)
-	IpPool string `json:"ip_pool"`
+	IpPool   NetworkRef `json:"ip_pool"`
+	Name     string     `json:"name"`
+	Networks []string   `json:"networks"`
+	// Policy description: REF(ipsec/policy)
+	Policy IpsecRef `json:"policy"`
+	// Status default value is false
+	Status bool `json:"status"`
 	// UseIpPool default value is false
-	UseIpPool bool `json:"use_ip_pool"`
+	UseIpPool bool     `json:"use_ip_pool"`
+	Users     []string `json:"users"`
+	// Xauth default value is false
+	Xauth bool `json:"xauth"`
+}
+
+// NewIpsecConnectionRoadwarriorX509 returns a IpsecConnectionRoadwarriorX509 with the default values of its swagger definition
+func NewIpsecConnectionRoadwarriorX509() *IpsecConnectionRoadwarriorX509 {
+	return &IpsecConnectionRoadwarriorX509{
+		ObjectType: "ipsec_connection/roadwarrior_x509",
+	}
+}
+
+// Validate checks the IpsecConnectionRoadwarriorX509 before it is sent, see ValidateWith
+func (i *IpsecConnectionRoadwarriorX509) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecConnectionRoadwarriorX509.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecConnectionRoadwarriorX509) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecConnectionRoadwarriorX509", typeOf)
+	check.Ref("auto_pf_in", string(i.AutoPfIn), "REF(packetfilter/packetfilter)")
+	check.Ref("auto_pf_out", string(i.AutoPfOut), "REF(packetfilter/packetfilter)")
+	check.Required("interface", string(i.Interface))
+	check.Ref("interface", string(i.Interface), "REF(interface/*)")
+	check.Required("ip_pool", string(i.IpPool))
+	check.Ref("ip_pool", string(i.IpPool), "REF(network/network)")
+	check.Required("name", i.Name)
+	check.Required("policy", string(i.Policy))
+	check.Ref("policy", string(i.Policy), "REF(ipsec/policy)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &IpsecConnectionRoadwarriorX509{}
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorX509s GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionRoadwarriorX509s GET path
 // Returns all available ipsec_connection/roadwarrior_x509 objects
 func (*IpsecConnectionRoadwarriorX509s) GetPath() string {
 	return "/api/objects/ipsec_connection/roadwarrior_x509/"
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecConnectionRoadwarriorX509s) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorX509s GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionRoadwarriorX509s GET path
 // Returns all available roadwarrior_x509 types
 func (i *IpsecConnectionRoadwarriorX509) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_x509/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecConnectionRoadwarriorX509) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorX509 DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecConnectionRoadwarriorX509 DELETE path
 // Creates or updates the complete object roadwarrior_x509
 func (*IpsecConnectionRoadwarriorX509) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_x509/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorX509 PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecConnectionRoadwarriorX509 PATCH path
 // Changes to parts of the object roadwarrior_x509 types
 func (*IpsecConnectionRoadwarriorX509) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_x509/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorX509 POST path
+// PostPath implements sophos.Creatable and returns the IpsecConnectionRoadwarriorX509 POST path
 // Create a new ipsec_connection/roadwarrior_x509 object
 func (*IpsecConnectionRoadwarriorX509) PostPath() string {
 	return "/api/objects/ipsec_connection/roadwarrior_x509/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecConnectionRoadwarriorX509 PUT path
+// PutPath implements sophos.Updatable and returns the IpsecConnectionRoadwarriorX509 PUT path
 // Creates or updates the complete object roadwarrior_x509
 func (*IpsecConnectionRoadwarriorX509) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_x509/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecConnectionRoadwarriorX509) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/roadwarrior_x509/%s/usedby", ref)
 }
 
-// IpsecConnectionSiteToSites is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *IpsecConnectionRoadwarriorX509) GetType() string { return i.ObjectType }
+
+// IpsecConnectionSiteToSites is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecConnectionSiteToSites []IpsecConnectionSiteToSite
 
-// IpsecConnectionSiteToSite is a generated Sophos object
+// IpsecConnectionSiteToSite represents a UTM ipsec_connection/site_to_site object
 type IpsecConnectionSiteToSite struct {
-	Locked        string   `json:"_locked"`
-	Reference     string   `json:"_ref"`
-	ObjectType    string   `json:"_type"`
-	AutoPfIn      string   `json:"auto_pf_in"`
-	AutoPfOut     string   `json:"auto_pf_out"`
-	AutoPfrule    bool     `json:"auto_pfrule"`
-	Bind          bool     `json:"bind"`
-	Comment       string   `json:"comment"`
-	Interface     string   `json:"interface"`
-	Name          string   `json:"name"`
-	Networks      []string `json:"networks"`
-	Policy        string   `json:"policy"`
+	Locked     string `json:"_locked"`
+	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
+	// AutoPfIn description: REF(packetfilter/packetfilter)
+	// AutoPfIn default value is ""
+	AutoPfIn PacketfilterRef `json:"auto_pf_in"`
+	// AutoPfOut description: REF(packetfilter/packetfilter)
+	// AutoPfOut default value is ""
+	AutoPfOut  PacketfilterRef `json:"auto_pf_out"`
+	AutoPfrule bool            `json:"auto_pfrule"`
+	Bind       bool            `json:"bind"`
+	Comment    string          `json:"comment"`
+	// Interface description: REF(interface/*)
+	Interface InterfaceRef `json:"interface"`
+	Name      string       `json:"name"`
+	Networks  []string     `json:"networks"`
+	// Policy description: REF(ipsec/policy)
+	Policy        IpsecRef `json:"policy"`
 	RemoteGateway string   `json:"remote_gateway"`
 	Status        bool     `json:"status"`
 	StrictRouting bool     `json:"strict_routing"`
 }
 
+// NewIpsecConnectionSiteToSite returns a IpsecConnectionSiteToSite with the default values of its swagger definition
+func NewIpsecConnectionSiteToSite() *IpsecConnectionSiteToSite {
+	return &IpsecConnectionSiteToSite{
+		ObjectType: "ipsec_connection/site_to_site",
+	}
+}
+
+// Validate checks the IpsecConnectionSiteToSite before it is sent, see ValidateWith
+func (i *IpsecConnectionSiteToSite) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecConnectionSiteToSite.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecConnectionSiteToSite) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecConnectionSiteToSite", typeOf)
+	check.Ref("auto_pf_in", string(i.AutoPfIn), "REF(packetfilter/packetfilter)")
+	check.Ref("auto_pf_out", string(i.AutoPfOut), "REF(packetfilter/packetfilter)")
+	check.Required("interface", string(i.Interface))
+	check.Ref("interface", string(i.Interface), "REF(interface/*)")
+	check.Required("name", i.Name)
+	check.Required("policy", string(i.Policy))
+	check.Ref("policy", string(i.Policy), "REF(ipsec/policy)")
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &IpsecConnectionSiteToSite{}
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionSiteToSites GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionSiteToSites GET path
 // Returns all available ipsec_connection/site_to_site objects
 func (*IpsecConnectionSiteToSites) GetPath() string {
 	return "/api/objects/ipsec_connection/site_to_site/"
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecConnectionSiteToSites) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecConnectionSiteToSites GET path
+// GetPath implements sophos.RestGetter and returns the IpsecConnectionSiteToSites GET path
 // Returns all available site_to_site types
 func (i *IpsecConnectionSiteToSite) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/site_to_site/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecConnectionSiteToSite) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecConnectionSiteToSite DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecConnectionSiteToSite DELETE path
 // Creates or updates the complete object site_to_site
 func (*IpsecConnectionSiteToSite) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/site_to_site/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecConnectionSiteToSite PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecConnectionSiteToSite PATCH path
 // Changes to parts of the object site_to_site types
 func (*IpsecConnectionSiteToSite) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/site_to_site/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecConnectionSiteToSite POST path
+// PostPath implements sophos.Creatable and returns the IpsecConnectionSiteToSite POST path
 // Create a new ipsec_connection/site_to_site object
 func (*IpsecConnectionSiteToSite) PostPath() string {
 	return "/api/objects/ipsec_connection/site_to_site/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecConnectionSiteToSite PUT path
+// PutPath implements sophos.Updatable and returns the IpsecConnectionSiteToSite PUT path
 // Creates or updates the complete object site_to_site
 func (*IpsecConnectionSiteToSite) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/site_to_site/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecConnectionSiteToSite) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_connection/site_to_site/%s/usedby", ref)
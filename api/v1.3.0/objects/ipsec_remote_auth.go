@@ -18,7 +18,7 @@ type IpsecRemoteAuth struct {
 
 var _ sophos.Endpoint = &IpsecRemoteAuth{}
 
-var defsIpsecRemoteAuth = map[string]sophos.RestObject{
+var defsIpsecRemoteAuth = map[string]sophos.RestGetter{
 	"IpsecRemoteAuthCa":    &IpsecRemoteAuthCa{},
 	"IpsecRemoteAuthGroup": &IpsecRemoteAuthGroup{},
 	"IpsecRemoteAuthPsk":   &IpsecRemoteAuthPsk{},
@@ -27,7 +27,7 @@ var defsIpsecRemoteAuth = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of IpsecRemoteAuth's Objects
-func (IpsecRemoteAuth) RestObjects() map[string]sophos.RestObject { return defsIpsecRemoteAuth }
+func (IpsecRemoteAuth) RestObjects() map[string]sophos.RestGetter { return defsIpsecRemoteAuth }
 
 // GetPath implements sophos.RestGetter
 func (*IpsecRemoteAuth) GetPath() string { return "/api/nodes/ipsec_remote_auth" }
@@ -73,7 +73,7 @@ func (IpsecRemoteAuth) References() []string {
 	}
 }
 
-// IpsecRemoteAuthCas is an Sophos Endpoint subType and implements sophos.RestObject
+// IpsecRemoteAuthCas is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecRemoteAuthCas []IpsecRemoteAuthCa
 
 // IpsecRemoteAuthCa represents a UTM X509 CA and DN match
@@ -82,62 +82,86 @@ type IpsecRemoteAuthCa struct {
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
 	// Certificate description: REF(ca/signing_ca), REF(ca/verification_ca)
-	Certificate string `json:"certificate"`
+	Certificate CaRef  `json:"certificate"`
 	Comment     string `json:"comment"`
 	Name        string `json:"name"`
 	// VpnId default value is "C=*, ST=*, L=*, O=*, OU=*, CN=*, E=*"
 	VpnId string `json:"vpn_id"`
 }
 
+// NewIpsecRemoteAuthCa returns a IpsecRemoteAuthCa with the default values of its swagger definition
+func NewIpsecRemoteAuthCa() *IpsecRemoteAuthCa {
+	return &IpsecRemoteAuthCa{
+		ObjectType: "ipsec_remote_auth/ca",
+		VpnId:      "C=*, ST=*, L=*, O=*, OU=*, CN=*, E=*",
+	}
+}
+
+// Validate checks the IpsecRemoteAuthCa before it is sent, see ValidateWith
+func (i *IpsecRemoteAuthCa) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecRemoteAuthCa.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecRemoteAuthCa) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecRemoteAuthCa", typeOf)
+	check.Required("certificate", string(i.Certificate))
+	check.Ref("certificate", string(i.Certificate), "REF(ca/signing_ca), REF(ca/verification_ca)")
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &IpsecRemoteAuthCa{}
 
-// GetPath implements sophos.RestObject and returns the IpsecRemoteAuthCas GET path
+// GetPath implements sophos.RestGetter and returns the IpsecRemoteAuthCas GET path
 // Returns all available ipsec_remote_auth/ca objects
 func (*IpsecRemoteAuthCas) GetPath() string { return "/api/objects/ipsec_remote_auth/ca/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecRemoteAuthCas) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecRemoteAuthCas GET path
+// GetPath implements sophos.RestGetter and returns the IpsecRemoteAuthCas GET path
 // Returns all available ca types
 func (i *IpsecRemoteAuthCa) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/ca/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecRemoteAuthCa) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecRemoteAuthCa DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecRemoteAuthCa DELETE path
 // Creates or updates the complete object ca
 func (*IpsecRemoteAuthCa) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/ca/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecRemoteAuthCa PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecRemoteAuthCa PATCH path
 // Changes to parts of the object ca types
 func (*IpsecRemoteAuthCa) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/ca/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecRemoteAuthCa POST path
+// PostPath implements sophos.Creatable and returns the IpsecRemoteAuthCa POST path
 // Create a new ipsec_remote_auth/ca object
 func (*IpsecRemoteAuthCa) PostPath() string {
 	return "/api/objects/ipsec_remote_auth/ca/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecRemoteAuthCa PUT path
+// PutPath implements sophos.Updatable and returns the IpsecRemoteAuthCa PUT path
 // Creates or updates the complete object ca
 func (*IpsecRemoteAuthCa) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/ca/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecRemoteAuthCa) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/ca/%s/usedby", ref)
 }
 
-// IpsecRemoteAuthGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *IpsecRemoteAuthCa) GetType() string { return i.ObjectType }
+
+// IpsecRemoteAuthGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecRemoteAuthGroups []IpsecRemoteAuthGroup
 
 // IpsecRemoteAuthGroup represents a UTM group
@@ -149,62 +173,83 @@ type IpsecRemoteAuthGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewIpsecRemoteAuthGroup returns a IpsecRemoteAuthGroup with the default values of its swagger definition
+func NewIpsecRemoteAuthGroup() *IpsecRemoteAuthGroup {
+	return &IpsecRemoteAuthGroup{
+		ObjectType: "ipsec_remote_auth/group",
+	}
+}
+
+// Validate checks the IpsecRemoteAuthGroup before it is sent, see ValidateWith
+func (i *IpsecRemoteAuthGroup) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecRemoteAuthGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecRemoteAuthGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecRemoteAuthGroup", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &IpsecRemoteAuthGroup{}
 
-// GetPath implements sophos.RestObject and returns the IpsecRemoteAuthGroups GET path
+// GetPath implements sophos.RestGetter and returns the IpsecRemoteAuthGroups GET path
 // Returns all available ipsec_remote_auth/group objects
 func (*IpsecRemoteAuthGroups) GetPath() string { return "/api/objects/ipsec_remote_auth/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecRemoteAuthGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecRemoteAuthGroups GET path
+// GetPath implements sophos.RestGetter and returns the IpsecRemoteAuthGroups GET path
 // Returns all available group types
 func (i *IpsecRemoteAuthGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/group/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecRemoteAuthGroup) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecRemoteAuthGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecRemoteAuthGroup DELETE path
 // Creates or updates the complete object group
 func (*IpsecRemoteAuthGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecRemoteAuthGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecRemoteAuthGroup PATCH path
 // Changes to parts of the object group types
 func (*IpsecRemoteAuthGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecRemoteAuthGroup POST path
+// PostPath implements sophos.Creatable and returns the IpsecRemoteAuthGroup POST path
 // Create a new ipsec_remote_auth/group object
 func (*IpsecRemoteAuthGroup) PostPath() string {
 	return "/api/objects/ipsec_remote_auth/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecRemoteAuthGroup PUT path
+// PutPath implements sophos.Updatable and returns the IpsecRemoteAuthGroup PUT path
 // Creates or updates the complete object group
 func (*IpsecRemoteAuthGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecRemoteAuthGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/group/%s/usedby", ref)
 }
 
-// IpsecRemoteAuthPsks is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *IpsecRemoteAuthGroup) GetType() string { return i.ObjectType }
+
+// IpsecRemoteAuthPsks is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecRemoteAuthPsks []IpsecRemoteAuthPsk
 
-// IpsecRemoteAuthPsk is a generated Sophos object
+// IpsecRemoteAuthPsk represents a UTM ipsec_remote_auth/psk object
 type IpsecRemoteAuthPsk struct {
 	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
 	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	Comment    string `json:"comment"`
 	Name       string `json:"name"`
 	Psk        string `json:"psk"`
@@ -212,49 +257,67 @@ type IpsecRemoteAuthPsk struct {
 	VpnIDType  string `json:"vpn_id_type"`
 }
 
+// NewIpsecRemoteAuthPsk returns a IpsecRemoteAuthPsk with the default values of its swagger definition
+func NewIpsecRemoteAuthPsk() *IpsecRemoteAuthPsk {
+	return &IpsecRemoteAuthPsk{
+		ObjectType: "ipsec_remote_auth/psk",
+	}
+}
+
+// Validate checks the IpsecRemoteAuthPsk before it is sent, see ValidateWith
+func (i *IpsecRemoteAuthPsk) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecRemoteAuthPsk.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecRemoteAuthPsk) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecRemoteAuthPsk", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &IpsecRemoteAuthPsk{}
 
-// GetPath implements sophos.RestObject and returns the IpsecRemoteAuthPsks GET path
+// GetPath implements sophos.RestGetter and returns the IpsecRemoteAuthPsks GET path
 // Returns all available ipsec_remote_auth/psk objects
 func (*IpsecRemoteAuthPsks) GetPath() string { return "/api/objects/ipsec_remote_auth/psk/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecRemoteAuthPsks) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecRemoteAuthPsks GET path
+// GetPath implements sophos.RestGetter and returns the IpsecRemoteAuthPsks GET path
 // Returns all available psk types
 func (i *IpsecRemoteAuthPsk) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/psk/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecRemoteAuthPsk) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecRemoteAuthPsk DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecRemoteAuthPsk DELETE path
 // Creates or updates the complete object psk
 func (*IpsecRemoteAuthPsk) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/psk/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecRemoteAuthPsk PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecRemoteAuthPsk PATCH path
 // Changes to parts of the object psk types
 func (*IpsecRemoteAuthPsk) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/psk/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecRemoteAuthPsk POST path
+// PostPath implements sophos.Creatable and returns the IpsecRemoteAuthPsk POST path
 // Create a new ipsec_remote_auth/psk object
 func (*IpsecRemoteAuthPsk) PostPath() string {
 	return "/api/objects/ipsec_remote_auth/psk/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecRemoteAuthPsk PUT path
+// PutPath implements sophos.Updatable and returns the IpsecRemoteAuthPsk PUT path
 // Creates or updates the complete object psk
 func (*IpsecRemoteAuthPsk) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/psk/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecRemoteAuthPsk) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/psk/%s/usedby", ref)
@@ -263,7 +326,7 @@ func (*IpsecRemoteAuthPsk) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (i *IpsecRemoteAuthPsk) GetType() string { return i.ObjectType }
 
-// IpsecRemoteAuthRsas is an Sophos Endpoint subType and implements sophos.RestObject
+// IpsecRemoteAuthRsas is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecRemoteAuthRsas []IpsecRemoteAuthRsa
 
 // IpsecRemoteAuthRsa represents a UTM RSA public key
@@ -271,71 +334,112 @@ type IpsecRemoteAuthRsa struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
+	Comment    string `json:"comment"`
+	Name       string `json:"name"`
+	Pubkey     string `json:"pubkey"`
 	// VpnId default value is ""
 	VpnId string `json:"vpn_id"`
 	// VpnIdType can be one of: []string{"ipv4_address", "fqdn", "user_fqdn"}
-	VpnIdType string `json:"vpn_id_type"`
-	Comment   string `json:"comment"`
-	Name      string `json:"name"`
-	Pubkey    string `json:"pubkey"`
+	VpnIdType IpsecRemoteAuthRsaVpnIdType `json:"vpn_id_type"`
+}
+
+// IpsecRemoteAuthRsaVpnIdType is the VpnIdType of a IpsecRemoteAuthRsa
+type IpsecRemoteAuthRsaVpnIdType string
+
+// Known values of IpsecRemoteAuthRsaVpnIdType
+const (
+	IpsecRemoteAuthRsaVpnIdTypeIpv4Address IpsecRemoteAuthRsaVpnIdType = "ipv4_address"
+	IpsecRemoteAuthRsaVpnIdTypeFqdn        IpsecRemoteAuthRsaVpnIdType = "fqdn"
+	IpsecRemoteAuthRsaVpnIdTypeUserFqdn    IpsecRemoteAuthRsaVpnIdType = "user_fqdn"
+)
+
+// Valid returns true if the value is a known IpsecRemoteAuthRsaVpnIdType
+func (v IpsecRemoteAuthRsaVpnIdType) Valid() bool {
+	switch v {
+	case IpsecRemoteAuthRsaVpnIdTypeIpv4Address, IpsecRemoteAuthRsaVpnIdTypeFqdn, IpsecRemoteAuthRsaVpnIdTypeUserFqdn:
+		return true
+	}
+	return false
+}
+
+// NewIpsecRemoteAuthRsa returns a IpsecRemoteAuthRsa with the default values of its swagger definition
+func NewIpsecRemoteAuthRsa() *IpsecRemoteAuthRsa {
+	return &IpsecRemoteAuthRsa{
+		ObjectType: "ipsec_remote_auth/rsa",
+	}
+}
+
+// Validate checks the IpsecRemoteAuthRsa before it is sent, see ValidateWith
+func (i *IpsecRemoteAuthRsa) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecRemoteAuthRsa.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecRemoteAuthRsa) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecRemoteAuthRsa", typeOf)
+	check.Required("name", i.Name)
+	check.Enum("vpn_id_type", string(i.VpnIdType), i.VpnIdType.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &IpsecRemoteAuthRsa{}
 
-// GetPath implements sophos.RestObject and returns the IpsecRemoteAuthRsas GET path
+// GetPath implements sophos.RestGetter and returns the IpsecRemoteAuthRsas GET path
 // Returns all available ipsec_remote_auth/rsa objects
 func (*IpsecRemoteAuthRsas) GetPath() string { return "/api/objects/ipsec_remote_auth/rsa/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecRemoteAuthRsas) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecRemoteAuthRsas GET path
+// GetPath implements sophos.RestGetter and returns the IpsecRemoteAuthRsas GET path
 // Returns all available rsa types
 func (i *IpsecRemoteAuthRsa) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/rsa/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecRemoteAuthRsa) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecRemoteAuthRsa DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecRemoteAuthRsa DELETE path
 // Creates or updates the complete object rsa
 func (*IpsecRemoteAuthRsa) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/rsa/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecRemoteAuthRsa PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecRemoteAuthRsa PATCH path
 // Changes to parts of the object rsa types
 func (*IpsecRemoteAuthRsa) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/rsa/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecRemoteAuthRsa POST path
+// PostPath implements sophos.Creatable and returns the IpsecRemoteAuthRsa POST path
 // Create a new ipsec_remote_auth/rsa object
 func (*IpsecRemoteAuthRsa) PostPath() string {
 	return "/api/objects/ipsec_remote_auth/rsa/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecRemoteAuthRsa PUT path
+// PutPath implements sophos.Updatable and returns the IpsecRemoteAuthRsa PUT path
 // Creates or updates the complete object rsa
 func (*IpsecRemoteAuthRsa) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/rsa/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecRemoteAuthRsa) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/rsa/%s/usedby", ref)
 }
 
-// IpsecRemoteAuthX509s is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *IpsecRemoteAuthRsa) GetType() string { return i.ObjectType }
+
+// IpsecRemoteAuthX509s is an Sophos Endpoint subType and implements sophos.RestGetter
 type IpsecRemoteAuthX509s []IpsecRemoteAuthX509
 
-// IpsecRemoteAuthX509 is a generated Sophos object
+// IpsecRemoteAuthX509 represents a UTM ipsec_remote_auth/x509 object
 type IpsecRemoteAuthX509 struct {
 	Locked      string `json:"_locked"`
-	Reference   string `json:"_ref"`
 	ObjectType  string `json:"_type"`
+	Reference   string `json:"_ref"`
 	Certificate string `json:"certificate"`
 	Comment     string `json:"comment"`
 	Name        string `json:"name"`
@@ -343,49 +447,67 @@ type IpsecRemoteAuthX509 struct {
 	VpnIDType   string `json:"vpn_id_type"`
 }
 
+// NewIpsecRemoteAuthX509 returns a IpsecRemoteAuthX509 with the default values of its swagger definition
+func NewIpsecRemoteAuthX509() *IpsecRemoteAuthX509 {
+	return &IpsecRemoteAuthX509{
+		ObjectType: "ipsec_remote_auth/x509",
+	}
+}
+
+// Validate checks the IpsecRemoteAuthX509 before it is sent, see ValidateWith
+func (i *IpsecRemoteAuthX509) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the IpsecRemoteAuthX509.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *IpsecRemoteAuthX509) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("IpsecRemoteAuthX509", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &IpsecRemoteAuthX509{}
 
-// GetPath implements sophos.RestObject and returns the IpsecRemoteAuthX509s GET path
+// GetPath implements sophos.RestGetter and returns the IpsecRemoteAuthX509s GET path
 // Returns all available ipsec_remote_auth/x509 objects
 func (*IpsecRemoteAuthX509s) GetPath() string { return "/api/objects/ipsec_remote_auth/x509/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*IpsecRemoteAuthX509s) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the IpsecRemoteAuthX509s GET path
+// GetPath implements sophos.RestGetter and returns the IpsecRemoteAuthX509s GET path
 // Returns all available x509 types
 func (i *IpsecRemoteAuthX509) GetPath() string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/x509/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *IpsecRemoteAuthX509) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the IpsecRemoteAuthX509 DELETE path
+// DeletePath implements sophos.Deletable and returns the IpsecRemoteAuthX509 DELETE path
 // Creates or updates the complete object x509
 func (*IpsecRemoteAuthX509) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/x509/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the IpsecRemoteAuthX509 PATCH path
+// PatchPath implements sophos.Patchable and returns the IpsecRemoteAuthX509 PATCH path
 // Changes to parts of the object x509 types
 func (*IpsecRemoteAuthX509) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/x509/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the IpsecRemoteAuthX509 POST path
+// PostPath implements sophos.Creatable and returns the IpsecRemoteAuthX509 POST path
 // Create a new ipsec_remote_auth/x509 object
 func (*IpsecRemoteAuthX509) PostPath() string {
 	return "/api/objects/ipsec_remote_auth/x509/"
 }
 
-// PutPath implements sophos.RestObject and returns the IpsecRemoteAuthX509 PUT path
+// PutPath implements sophos.Updatable and returns the IpsecRemoteAuthX509 PUT path
 // Creates or updates the complete object x509
 func (*IpsecRemoteAuthX509) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/x509/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*IpsecRemoteAuthX509) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ipsec_remote_auth/x509/%s/usedby", ref)
@@ -24,7 +24,7 @@ type Itfhw struct {
 
 var _ sophos.Endpoint = &Itfhw{}
 
-var defsItfhw = map[string]sophos.RestObject{
+var defsItfhw = map[string]sophos.RestGetter{
 	"ItfhwAweNetwork":      &ItfhwAweNetwork{},
 	"ItfhwAweNetworkGroup": &ItfhwAweNetworkGroup{},
 	"ItfhwBridge":          &ItfhwBridge{},
@@ -39,7 +39,7 @@ var defsItfhw = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Itfhw's Objects
-func (Itfhw) RestObjects() map[string]sophos.RestObject { return defsItfhw }
+func (Itfhw) RestObjects() map[string]sophos.RestGetter { return defsItfhw }
 
 // GetPath implements sophos.RestGetter
 func (*Itfhw) GetPath() string { return "/api/nodes/itfhw" }
@@ -109,93 +109,111 @@ func (Itfhw) References() []string {
 	}
 }
 
-// ItfhwAweNetworks is an Sophos Endpoint subType and implements sophos.RestObject
+// ItfhwAweNetworks is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfhwAweNetworks []ItfhwAweNetwork
 
-// ItfhwAweNetwork is a generated Sophos object
+// ItfhwAweNetwork represents a UTM itfhw/awe_network object
 type ItfhwAweNetwork struct {
-	Locked            string        `json:"_locked"`
-	Reference         string        `json:"_ref"`
-	ObjectType        string        `json:"_type"`
-	ApBridgemode      string        `json:"ap_bridgemode"`
-	Bridge            string        `json:"bridge"`
-	ClientIsolation   bool          `json:"client_isolation"`
-	Comment           string        `json:"comment"`
-	CryptoAlg         string        `json:"crypto_alg"`
-	Description       string        `json:"description"`
-	Dot11r            bool          `json:"dot11r"`
-	DynamicVlan       int64         `json:"dynamic_vlan"`
-	EncryptionMode    string        `json:"encryption_mode"`
-	FreqBands         string        `json:"freq_bands"`
-	Hardware          string        `json:"hardware"`
-	HideSsid          bool          `json:"hide_ssid"`
-	InterfaceName     string        `json:"interface_name"`
-	Mac               string        `json:"mac"`
-	MacFilter         string        `json:"mac_filter"`
-	MacList           string        `json:"mac_list"`
-	MeshID            string        `json:"mesh_id"`
-	MeshMode          string        `json:"mesh_mode"`
-	MeshSubtag        string        `json:"mesh_subtag"`
-	Name              string        `json:"name"`
-	NetworkMode       string        `json:"network_mode"`
-	NetworkName       string        `json:"network_name"`
-	Psk               string        `json:"psk"`
-	R0khSecret        string        `json:"r0kh_secret"`
-	Ssid              string        `json:"ssid"`
-	SsidVlantag       interface{}   `json:"ssid_vlantag"`
-	Status            bool          `json:"status"`
-	TimeScheduling    bool          `json:"time_scheduling"`
-	TimeSelect        []interface{} `json:"time_select"`
-	Uapsd             bool          `json:"uapsd"`
-	Utf8Ssid          bool          `json:"utf8_ssid"`
-	Vlantag           string        `json:"vlantag"`
-	Wep128            string        `json:"wep128"`
-	WepAuthentication string        `json:"wep_authentication"`
+	Locked            string   `json:"_locked"`
+	ObjectType        string   `json:"_type"`
+	Reference         string   `json:"_ref"`
+	ApBridgemode      string   `json:"ap_bridgemode"`
+	Bridge            string   `json:"bridge"`
+	ClientIsolation   bool     `json:"client_isolation"`
+	Comment           string   `json:"comment"`
+	CryptoAlg         string   `json:"crypto_alg"`
+	Description       string   `json:"description"`
+	Dot11r            bool     `json:"dot11r"`
+	DynamicVlan       int64    `json:"dynamic_vlan"`
+	EncryptionMode    string   `json:"encryption_mode"`
+	FreqBands         string   `json:"freq_bands"`
+	Hardware          string   `json:"hardware"`
+	HideSsid          bool     `json:"hide_ssid"`
+	InterfaceName     string   `json:"interface_name"`
+	Mac               string   `json:"mac"`
+	MacFilter         string   `json:"mac_filter"`
+	MacList           string   `json:"mac_list"`
+	MeshID            string   `json:"mesh_id"`
+	MeshMode          string   `json:"mesh_mode"`
+	MeshSubtag        string   `json:"mesh_subtag"`
+	Name              string   `json:"name"`
+	NetworkMode       string   `json:"network_mode"`
+	NetworkName       string   `json:"network_name"`
+	Psk               string   `json:"psk"`
+	R0khSecret        string   `json:"r0kh_secret"`
+	Ssid              string   `json:"ssid"`
+	SsidVlantag       int64    `json:"ssid_vlantag"`
+	Status            bool     `json:"status"`
+	TimeScheduling    bool     `json:"time_scheduling"`
+	TimeSelect        []string `json:"time_select"`
+	Uapsd             bool     `json:"uapsd"`
+	Utf8Ssid          bool     `json:"utf8_ssid"`
+	Vlantag           string   `json:"vlantag"`
+	Wep128            string   `json:"wep128"`
+	WepAuthentication string   `json:"wep_authentication"`
+}
+
+// NewItfhwAweNetwork returns a ItfhwAweNetwork with the default values of its swagger definition
+func NewItfhwAweNetwork() *ItfhwAweNetwork {
+	return &ItfhwAweNetwork{
+		ObjectType: "itfhw/awe_network",
+	}
+}
+
+// Validate checks the ItfhwAweNetwork before it is sent, see ValidateWith
+func (i *ItfhwAweNetwork) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfhwAweNetwork.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfhwAweNetwork) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfhwAweNetwork", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ItfhwAweNetwork{}
 
-// GetPath implements sophos.RestObject and returns the ItfhwAweNetworks GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwAweNetworks GET path
 // Returns all available itfhw/awe_network objects
 func (*ItfhwAweNetworks) GetPath() string { return "/api/objects/itfhw/awe_network/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfhwAweNetworks) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfhwAweNetworks GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwAweNetworks GET path
 // Returns all available awe_network types
 func (i *ItfhwAweNetwork) GetPath() string {
 	return fmt.Sprintf("/api/objects/itfhw/awe_network/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfhwAweNetwork) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfhwAweNetwork DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfhwAweNetwork DELETE path
 // Creates or updates the complete object awe_network
 func (*ItfhwAweNetwork) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/awe_network/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfhwAweNetwork PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfhwAweNetwork PATCH path
 // Changes to parts of the object awe_network types
 func (*ItfhwAweNetwork) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/awe_network/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfhwAweNetwork POST path
+// PostPath implements sophos.Creatable and returns the ItfhwAweNetwork POST path
 // Create a new itfhw/awe_network object
 func (*ItfhwAweNetwork) PostPath() string {
 	return "/api/objects/itfhw/awe_network/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfhwAweNetwork PUT path
+// PutPath implements sophos.Updatable and returns the ItfhwAweNetwork PUT path
 // Creates or updates the complete object awe_network
 func (*ItfhwAweNetwork) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/awe_network/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfhwAweNetwork) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/awe_network/%s/usedby", ref)
@@ -204,73 +222,94 @@ func (*ItfhwAweNetwork) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (i *ItfhwAweNetwork) GetType() string { return i.ObjectType }
 
-// ItfhwAweNetworkGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// ItfhwAweNetworkGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfhwAweNetworkGroups []ItfhwAweNetworkGroup
 
 // ItfhwAweNetworkGroup represents a UTM wireless access point group
 type ItfhwAweNetworkGroup struct {
-	Locked     string        `json:"_locked"`
-	ObjectType string        `json:"_type"`
-	Reference  string        `json:"_ref"`
-	ApVlantag  int           `json:"ap_vlantag"`
-	Comment    string        `json:"comment"`
-	Members    []interface{} `json:"members"`
-	Name       string        `json:"name"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	ApVlantag  int64    `json:"ap_vlantag"`
+	Comment    string   `json:"comment"`
+	Members    []string `json:"members"`
+	Name       string   `json:"name"`
 	// Status default value is false
 	Status bool `json:"status"`
 	// Vlantagging default value is false
 	Vlantagging bool `json:"vlantagging"`
 }
 
+// NewItfhwAweNetworkGroup returns a ItfhwAweNetworkGroup with the default values of its swagger definition
+func NewItfhwAweNetworkGroup() *ItfhwAweNetworkGroup {
+	return &ItfhwAweNetworkGroup{
+		ObjectType: "itfhw/awe_network_group",
+	}
+}
+
+// Validate checks the ItfhwAweNetworkGroup before it is sent, see ValidateWith
+func (i *ItfhwAweNetworkGroup) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfhwAweNetworkGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfhwAweNetworkGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfhwAweNetworkGroup", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ItfhwAweNetworkGroup{}
 
-// GetPath implements sophos.RestObject and returns the ItfhwAweNetworkGroups GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwAweNetworkGroups GET path
 // Returns all available itfhw/awe_network_group objects
 func (*ItfhwAweNetworkGroups) GetPath() string { return "/api/objects/itfhw/awe_network_group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfhwAweNetworkGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfhwAweNetworkGroups GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwAweNetworkGroups GET path
 // Returns all available awe_network_group types
 func (i *ItfhwAweNetworkGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/itfhw/awe_network_group/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfhwAweNetworkGroup) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfhwAweNetworkGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfhwAweNetworkGroup DELETE path
 // Creates or updates the complete object awe_network_group
 func (*ItfhwAweNetworkGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/awe_network_group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfhwAweNetworkGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfhwAweNetworkGroup PATCH path
 // Changes to parts of the object awe_network_group types
 func (*ItfhwAweNetworkGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/awe_network_group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfhwAweNetworkGroup POST path
+// PostPath implements sophos.Creatable and returns the ItfhwAweNetworkGroup POST path
 // Create a new itfhw/awe_network_group object
 func (*ItfhwAweNetworkGroup) PostPath() string {
 	return "/api/objects/itfhw/awe_network_group/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfhwAweNetworkGroup PUT path
+// PutPath implements sophos.Updatable and returns the ItfhwAweNetworkGroup PUT path
 // Creates or updates the complete object awe_network_group
 func (*ItfhwAweNetworkGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/awe_network_group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfhwAweNetworkGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/awe_network_group/%s/usedby", ref)
 }
 
-// ItfhwBridges is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *ItfhwAweNetworkGroup) GetType() string { return i.ObjectType }
+
+// ItfhwBridges is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfhwBridges []ItfhwBridge
 
 // ItfhwBridge represents a UTM bridge interface
@@ -278,73 +317,97 @@ type ItfhwBridge struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// Mac description: (MACADDR)
-	// Mac default value is "00:00:00:00:00:00"
-	Mac     string `json:"mac"`
-	Name    string `json:"name"`
-	Comment string `json:"comment"`
+	Comment    string `json:"comment"`
 	// Description default value is "Bridge"
 	Description string `json:"description"`
 	// Hardware description: (REGEX)
 	Hardware string `json:"hardware"`
+	// Mac description: (MACADDR)
+	// Mac default value is "00:00:00:00:00:00"
+	Mac  string `json:"mac"`
+	Name string `json:"name"`
+}
+
+// NewItfhwBridge returns a ItfhwBridge with the default values of its swagger definition
+func NewItfhwBridge() *ItfhwBridge {
+	return &ItfhwBridge{
+		ObjectType:  "itfhw/bridge",
+		Description: "Bridge",
+		Mac:         "00:00:00:00:00:00",
+	}
+}
+
+// Validate checks the ItfhwBridge before it is sent, see ValidateWith
+func (i *ItfhwBridge) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfhwBridge.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfhwBridge) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfhwBridge", typeOf)
+	check.Format("mac", sophos.FormatMAC, i.Mac)
+	check.Required("name", i.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ItfhwBridge{}
 
-// GetPath implements sophos.RestObject and returns the ItfhwBridges GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwBridges GET path
 // Returns all available itfhw/bridge objects
 func (*ItfhwBridges) GetPath() string { return "/api/objects/itfhw/bridge/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfhwBridges) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfhwBridges GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwBridges GET path
 // Returns all available bridge types
 func (i *ItfhwBridge) GetPath() string {
 	return fmt.Sprintf("/api/objects/itfhw/bridge/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfhwBridge) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfhwBridge DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfhwBridge DELETE path
 // Creates or updates the complete object bridge
 func (*ItfhwBridge) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/bridge/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfhwBridge PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfhwBridge PATCH path
 // Changes to parts of the object bridge types
 func (*ItfhwBridge) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/bridge/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfhwBridge POST path
+// PostPath implements sophos.Creatable and returns the ItfhwBridge POST path
 // Create a new itfhw/bridge object
 func (*ItfhwBridge) PostPath() string {
 	return "/api/objects/itfhw/bridge/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfhwBridge PUT path
+// PutPath implements sophos.Updatable and returns the ItfhwBridge PUT path
 // Creates or updates the complete object bridge
 func (*ItfhwBridge) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/bridge/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfhwBridge) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/bridge/%s/usedby", ref)
 }
 
-// ItfhwEthernets is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *ItfhwBridge) GetType() string { return i.ObjectType }
+
+// ItfhwEthernets is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfhwEthernets []ItfhwEthernet
 
-// ItfhwEthernet is a generated Sophos object
+// ItfhwEthernet represents a UTM itfhw/ethernet object
 type ItfhwEthernet struct {
 	Locked                string `json:"_locked"`
-	Reference             string `json:"_ref"`
 	ObjectType            string `json:"_type"`
+	Reference             string `json:"_ref"`
 	AutoNegotiation       bool   `json:"auto_negotiation"`
 	AutoNegotiationStatus bool   `json:"auto_negotiation_status"`
 	Comment               string `json:"comment"`
@@ -365,49 +428,67 @@ type ItfhwEthernet struct {
 	VirtualMac            string `json:"virtual_mac"`
 }
 
+// NewItfhwEthernet returns a ItfhwEthernet with the default values of its swagger definition
+func NewItfhwEthernet() *ItfhwEthernet {
+	return &ItfhwEthernet{
+		ObjectType: "itfhw/ethernet",
+	}
+}
+
+// Validate checks the ItfhwEthernet before it is sent, see ValidateWith
+func (i *ItfhwEthernet) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfhwEthernet.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfhwEthernet) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfhwEthernet", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ItfhwEthernet{}
 
-// GetPath implements sophos.RestObject and returns the ItfhwEthernets GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwEthernets GET path
 // Returns all available itfhw/ethernet objects
 func (*ItfhwEthernets) GetPath() string { return "/api/objects/itfhw/ethernet/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfhwEthernets) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfhwEthernets GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwEthernets GET path
 // Returns all available ethernet types
 func (i *ItfhwEthernet) GetPath() string {
 	return fmt.Sprintf("/api/objects/itfhw/ethernet/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfhwEthernet) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfhwEthernet DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfhwEthernet DELETE path
 // Creates or updates the complete object ethernet
 func (*ItfhwEthernet) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/ethernet/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfhwEthernet PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfhwEthernet PATCH path
 // Changes to parts of the object ethernet types
 func (*ItfhwEthernet) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/ethernet/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfhwEthernet POST path
+// PostPath implements sophos.Creatable and returns the ItfhwEthernet POST path
 // Create a new itfhw/ethernet object
 func (*ItfhwEthernet) PostPath() string {
 	return "/api/objects/itfhw/ethernet/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfhwEthernet PUT path
+// PutPath implements sophos.Updatable and returns the ItfhwEthernet PUT path
 // Creates or updates the complete object ethernet
 func (*ItfhwEthernet) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/ethernet/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfhwEthernet) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/ethernet/%s/usedby", ref)
@@ -416,7 +497,7 @@ func (*ItfhwEthernet) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (i *ItfhwEthernet) GetType() string { return i.ObjectType }
 
-// ItfhwGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// ItfhwGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfhwGroups []ItfhwGroup
 
 // ItfhwGroup represents a UTM group
@@ -428,60 +509,81 @@ type ItfhwGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewItfhwGroup returns a ItfhwGroup with the default values of its swagger definition
+func NewItfhwGroup() *ItfhwGroup {
+	return &ItfhwGroup{
+		ObjectType: "itfhw/group",
+	}
+}
+
+// Validate checks the ItfhwGroup before it is sent, see ValidateWith
+func (i *ItfhwGroup) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfhwGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfhwGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfhwGroup", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ItfhwGroup{}
 
-// GetPath implements sophos.RestObject and returns the ItfhwGroups GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwGroups GET path
 // Returns all available itfhw/group objects
 func (*ItfhwGroups) GetPath() string { return "/api/objects/itfhw/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfhwGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfhwGroups GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwGroups GET path
 // Returns all available group types
 func (i *ItfhwGroup) GetPath() string { return fmt.Sprintf("/api/objects/itfhw/group/%s", i.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfhwGroup) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfhwGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfhwGroup DELETE path
 // Creates or updates the complete object group
 func (*ItfhwGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfhwGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfhwGroup PATCH path
 // Changes to parts of the object group types
 func (*ItfhwGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfhwGroup POST path
+// PostPath implements sophos.Creatable and returns the ItfhwGroup POST path
 // Create a new itfhw/group object
 func (*ItfhwGroup) PostPath() string {
 	return "/api/objects/itfhw/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfhwGroup PUT path
+// PutPath implements sophos.Updatable and returns the ItfhwGroup PUT path
 // Creates or updates the complete object group
 func (*ItfhwGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfhwGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/group/%s/usedby", ref)
 }
 
-// ItfhwLags is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *ItfhwGroup) GetType() string { return i.ObjectType }
+
+// ItfhwLags is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfhwLags []ItfhwLag
 
-// ItfhwLag is a generated Sophos object
+// ItfhwLag represents a UTM itfhw/lag object
 type ItfhwLag struct {
 	Locked         string `json:"_locked"`
-	Reference      string `json:"_ref"`
 	ObjectType     string `json:"_type"`
+	Reference      string `json:"_ref"`
 	Comment        string `json:"comment"`
 	Description    string `json:"description"`
 	Hardware       string `json:"hardware"`
@@ -490,47 +592,65 @@ type ItfhwLag struct {
 	Name           string `json:"name"`
 }
 
+// NewItfhwLag returns a ItfhwLag with the default values of its swagger definition
+func NewItfhwLag() *ItfhwLag {
+	return &ItfhwLag{
+		ObjectType: "itfhw/lag",
+	}
+}
+
+// Validate checks the ItfhwLag before it is sent, see ValidateWith
+func (i *ItfhwLag) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfhwLag.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfhwLag) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfhwLag", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ItfhwLag{}
 
-// GetPath implements sophos.RestObject and returns the ItfhwLags GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwLags GET path
 // Returns all available itfhw/lag objects
 func (*ItfhwLags) GetPath() string { return "/api/objects/itfhw/lag/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfhwLags) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfhwLags GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwLags GET path
 // Returns all available lag types
 func (i *ItfhwLag) GetPath() string { return fmt.Sprintf("/api/objects/itfhw/lag/%s", i.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfhwLag) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfhwLag DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfhwLag DELETE path
 // Creates or updates the complete object lag
 func (*ItfhwLag) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/lag/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfhwLag PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfhwLag PATCH path
 // Changes to parts of the object lag types
 func (*ItfhwLag) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/lag/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfhwLag POST path
+// PostPath implements sophos.Creatable and returns the ItfhwLag POST path
 // Create a new itfhw/lag object
 func (*ItfhwLag) PostPath() string {
 	return "/api/objects/itfhw/lag/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfhwLag PUT path
+// PutPath implements sophos.Updatable and returns the ItfhwLag PUT path
 // Creates or updates the complete object lag
 func (*ItfhwLag) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/lag/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfhwLag) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/lag/%s/usedby", ref)
@@ -539,7 +659,7 @@ func (*ItfhwLag) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (i *ItfhwLag) GetType() string { return i.ObjectType }
 
-// ItfhwRedClients is an Sophos Endpoint subType and implements sophos.RestObject
+// ItfhwRedClients is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfhwRedClients []ItfhwRedClient
 
 // ItfhwRedClient represents a UTM RED client interface
@@ -547,79 +667,126 @@ type ItfhwRedClient struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	LocalKey   string `json:"local_key"`
+	Comment    string `json:"comment"`
+	// Description default value is "Remote Ethernet Client Device"
+	Description string `json:"description"`
+	// Hardware description: (REGEX)
+	Hardware string `json:"hardware"`
+	HubCa    string `json:"hub_ca"`
+	// HubHost description: REF(network/host), REF(network/dns_host)
+	HubHost   NetworkRef `json:"hub_host"`
+	LocalCert string     `json:"local_cert"`
+	LocalKey  string     `json:"local_key"`
 	// Mac description: (MACADDR)
 	// Mac default value is "00:00:00:00:00:00"
-	Mac string `json:"mac"`
+	Mac  string `json:"mac"`
+	Name string `json:"name"`
 	// Status default value is false
-	Status   bool   `json:"status"`
-	TunnelId int    `json:"tunnel_id"`
-	Comment  string `json:"comment"`
-	// HubHost description: REF(network/host), REF(network/dns_host)
-	HubHost   string `json:"hub_host"`
-	LocalCert string `json:"local_cert"`
-	Name      string `json:"name"`
+	Status bool `json:"status"`
 	// TunnelCompression default value is false
 	TunnelCompression bool `json:"tunnel_compression"`
 	// TunnelCompressionAlgorithm can be one of: []string{"deflate", "lzo", "gzip"}
 	// TunnelCompressionAlgorithm default value is "lzo"
-	TunnelCompressionAlgorithm string `json:"tunnel_compression_algorithm"`
-	// Description default value is "Remote Ethernet Client Device"
-	Description string `json:"description"`
-	// Hardware description: (REGEX)
-	Hardware string `json:"hardware"`
-	HubCa    string `json:"hub_ca"`
+	TunnelCompressionAlgorithm ItfhwRedClientTunnelCompressionAlgorithm `json:"tunnel_compression_algorithm"`
+	TunnelId                   int64                                    `json:"tunnel_id"`
+}
+
+// ItfhwRedClientTunnelCompressionAlgorithm is the TunnelCompressionAlgorithm of a ItfhwRedClient
+type ItfhwRedClientTunnelCompressionAlgorithm string
+
+// Known values of ItfhwRedClientTunnelCompressionAlgorithm
+const (
+	ItfhwRedClientTunnelCompressionAlgorithmDeflate ItfhwRedClientTunnelCompressionAlgorithm = "deflate"
+	ItfhwRedClientTunnelCompressionAlgorithmLzo     ItfhwRedClientTunnelCompressionAlgorithm = "lzo"
+	ItfhwRedClientTunnelCompressionAlgorithmGzip    ItfhwRedClientTunnelCompressionAlgorithm = "gzip"
+)
+
+// Valid returns true if the value is a known ItfhwRedClientTunnelCompressionAlgorithm
+func (v ItfhwRedClientTunnelCompressionAlgorithm) Valid() bool {
+	switch v {
+	case ItfhwRedClientTunnelCompressionAlgorithmDeflate, ItfhwRedClientTunnelCompressionAlgorithmLzo, ItfhwRedClientTunnelCompressionAlgorithmGzip:
+		return true
+	}
+	return false
+}
+
+// NewItfhwRedClient returns a ItfhwRedClient with the default values of its swagger definition
+func NewItfhwRedClient() *ItfhwRedClient {
+	return &ItfhwRedClient{
+		ObjectType:                 "itfhw/red_client",
+		Description:                "Remote Ethernet Client Device",
+		Mac:                        "00:00:00:00:00:00",
+		TunnelCompressionAlgorithm: ItfhwRedClientTunnelCompressionAlgorithmLzo,
+	}
+}
+
+// Validate checks the ItfhwRedClient before it is sent, see ValidateWith
+func (i *ItfhwRedClient) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfhwRedClient.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfhwRedClient) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfhwRedClient", typeOf)
+	check.Required("hub_host", string(i.HubHost))
+	check.Ref("hub_host", string(i.HubHost), "REF(network/host), REF(network/dns_host)")
+	check.Format("mac", sophos.FormatMAC, i.Mac)
+	check.Required("name", i.Name)
+	check.Enum("tunnel_compression_algorithm", string(i.TunnelCompressionAlgorithm), i.TunnelCompressionAlgorithm.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ItfhwRedClient{}
 
-// GetPath implements sophos.RestObject and returns the ItfhwRedClients GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwRedClients GET path
 // Returns all available itfhw/red_client objects
 func (*ItfhwRedClients) GetPath() string { return "/api/objects/itfhw/red_client/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfhwRedClients) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfhwRedClients GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwRedClients GET path
 // Returns all available red_client types
 func (i *ItfhwRedClient) GetPath() string {
 	return fmt.Sprintf("/api/objects/itfhw/red_client/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfhwRedClient) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfhwRedClient DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfhwRedClient DELETE path
 // Creates or updates the complete object red_client
 func (*ItfhwRedClient) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/red_client/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfhwRedClient PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfhwRedClient PATCH path
 // Changes to parts of the object red_client types
 func (*ItfhwRedClient) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/red_client/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfhwRedClient POST path
+// PostPath implements sophos.Creatable and returns the ItfhwRedClient POST path
 // Create a new itfhw/red_client object
 func (*ItfhwRedClient) PostPath() string {
 	return "/api/objects/itfhw/red_client/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfhwRedClient PUT path
+// PutPath implements sophos.Updatable and returns the ItfhwRedClient PUT path
 // Creates or updates the complete object red_client
 func (*ItfhwRedClient) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/red_client/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfhwRedClient) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/red_client/%s/usedby", ref)
 }
 
-// ItfhwRedServers is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *ItfhwRedClient) GetType() string { return i.ObjectType }
+
+// ItfhwRedServers is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfhwRedServers []ItfhwRedServer
 
 // ItfhwRedServer represents a UTM RED server interface
@@ -627,275 +794,721 @@ type ItfhwRedServer struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// PrevUnlockCode default value is ""
-	PrevUnlockCode string `json:"prev_unlock_code"`
-	// RouteMode can be one of: []string{"default", "split", "fullbr"}
-	// RouteMode default value is "default"
-	RouteMode string `json:"route_mode"`
-	// Password default value is ""
-	Password string `json:"password"`
 	// ActivateModem default value is false
 	ActivateModem bool `json:"activate_modem"`
+	// Apn default value is ""
+	Apn string `json:"apn"`
+	// Authorized default value is false
+	Authorized bool `json:"authorized"`
 	// BridgeAddress description: (IPADDR)
 	// BridgeAddress default value is "0.0.0.0"
 	BridgeAddress string `json:"bridge_address"`
-	// FailoverDirect default value is true
-	FailoverDirect bool `json:"failover_direct"`
-	// Lan1Vids default value is ""
-	Lan1Vids string `json:"lan1_vids"`
-	// Lan3Vids default value is ""
-	Lan3Vids       string `json:"lan3_vids"`
-	Manual2Netmask int    `json:"manual2_netmask"`
-	// ManualDns description: (IPADDR)
-	// ManualDns default value is "0.0.0.0"
-	ManualDns string `json:"manual_dns"`
-	// RemoteCert description: REF(ca/host_key_cert)
-	// RemoteCert default value is ""
-	RemoteCert            string        `json:"remote_cert"`
-	FullbrDomains         []interface{} `json:"fullbr_domains"`
-	LocalNetworks         []interface{} `json:"local_networks"`
-	MacFilterEntriesRed15 int           `json:"mac_filter_entries_red15"`
-	MacFilterEntriesRed50 int           `json:"mac_filter_entries_red50"`
-	ManualNetmask         int           `json:"manual_netmask"`
-	// Status default value is false
-	Status bool `json:"status"`
-	// TunnelState default value is false
-	TunnelState bool `json:"tunnel_state"`
+	BridgeNetmask int64  `json:"bridge_netmask"`
 	// BridgeProto can be one of: []string{"dhcp", "static", "none"}
 	// BridgeProto default value is "none"
-	BridgeProto string `json:"bridge_proto"`
-	// Manual2Defgw description: (IPADDR)
-	// Manual2Defgw default value is "0.0.0.0"
-	Manual2Defgw string `json:"manual2_defgw"`
-	// ManualDefgw description: (IPADDR)
-	// ManualDefgw default value is "0.0.0.0"
-	ManualDefgw string `json:"manual_defgw"`
-	Pin         int    `json:"pin"`
-	// TunnelCompressionAlgorithm can be one of: []string{"deflate", "lzo", "gzip"}
-	// TunnelCompressionAlgorithm default value is "lzo"
-	TunnelCompressionAlgorithm string `json:"tunnel_compression_algorithm"`
-	// Type can be one of: []string{"red", "red15", "red15w", "red50", "asg", "software"}
-	// Type default value is "asg"
-	Type    string `json:"type"`
-	Comment string `json:"comment"`
-	// FastFailover default value is false
-	FastFailover bool `json:"fast_failover"`
-	// Lan1Mode can be one of: []string{"tagged", "untagged", "untagged_drop_tagged", "unused"}
-	// Lan1Mode default value is "unused"
-	Lan1Mode               string `json:"lan1_mode"`
-	MacFilterEntriesRed15W int    `json:"mac_filter_entries_red15w"`
-	// Manual2Dns description: (IPADDR)
-	// Manual2Dns default value is "0.0.0.0"
-	Manual2Dns string `json:"manual2_dns"`
-	Name       string `json:"name"`
-	// PinAsString description: (REGEX)
-	// PinAsString default value is ""
-	PinAsString string `json:"pin_as_string"`
-	// Username default value is ""
-	Username string `json:"username"`
-	// Authorized default value is false
-	Authorized bool `json:"authorized"`
+	BridgeProto ItfhwRedServerBridgeProto `json:"bridge_proto"`
+	Comment     string                    `json:"comment"`
+	DebugLevel  int64                     `json:"debug_level"`
+	// DeploymentMode can be one of: []string{"online", "offline"}
+	// DeploymentMode default value is "online"
+	DeploymentMode ItfhwRedServerDeploymentMode `json:"deployment_mode"`
 	// Description default value is "Remote Ethernet Server Device"
 	Description string `json:"description"`
+	// DialString default value is "*99#"
+	DialString string `json:"dial_string"`
+	// FailoverDirect default value is true
+	FailoverDirect bool `json:"failover_direct"`
+	// FastFailover default value is false
+	FastFailover bool `json:"fast_failover"`
+	// FullbrDns description: REF(network/host), REF(network/dns_host), REF(network/interface_address)
+	// FullbrDns default value is ""
+	FullbrDns     NetworkRef `json:"fullbr_dns"`
+	FullbrDomains []string   `json:"fullbr_domains"`
+	// Hardware description: (REGEX)
+	Hardware string `json:"hardware"`
+	// HostnameBalancing can be one of: []string{"balance", "failover"}
+	// HostnameBalancing default value is "failover"
+	HostnameBalancing ItfhwRedServerHostnameBalancing `json:"hostname_balancing"`
 	// Hub2Hostname default value is ""
 	Hub2Hostname string `json:"hub2_hostname"`
-	// MobileNetwork can be one of: []string{"gsm", "cdma"}
-	// MobileNetwork default value is "gsm"
-	MobileNetwork string `json:"mobile_network"`
-	// State can be one of: []string{"initializing", "runnable", "notbound"}
-	// State default value is "initializing"
-	State string `json:"state"`
-	// DeploymentMode can be one of: []string{"online", "offline"}
-	// DeploymentMode default value is "online"
-	DeploymentMode string `json:"deployment_mode"`
+	// HubHostname default value is ""
+	HubHostname string `json:"hub_hostname"`
+	// Lan1Mode can be one of: []string{"tagged", "untagged", "untagged_drop_tagged", "unused"}
+	// Lan1Mode default value is "unused"
+	Lan1Mode ItfhwRedServerLan1Mode `json:"lan1_mode"`
+	// Lan1Vids default value is ""
+	Lan1Vids string `json:"lan1_vids"`
+	// Lan2Mode can be one of: []string{"tagged", "untagged", "untagged_drop_tagged", "unused"}
+	// Lan2Mode default value is "unused"
+	Lan2Mode ItfhwRedServerLan2Mode `json:"lan2_mode"`
+	// Lan2Vids default value is ""
+	Lan2Vids string `json:"lan2_vids"`
 	// Lan3Mode can be one of: []string{"tagged", "untagged", "untagged_drop_tagged", "unused"}
 	// Lan3Mode default value is "unused"
-	Lan3Mode string `json:"lan3_mode"`
-	// LocalNetworksTarget description: REF(network/host), REF(network/dns_host), REF(network/interface_address)
-	// LocalNetworksTarget default value is ""
-	LocalNetworksTarget string `json:"local_networks_target"`
-	// Manual2Address description: (IPADDR)
-	// Manual2Address default value is "0.0.0.0"
-	Manual2Address string `json:"manual2_address"`
-	RedId          string `json:"red_id"`
-	// Uplink2Mode can be one of: []string{"dhcp", "manual"}
-	// Uplink2Mode default value is "dhcp"
-	Uplink2Mode string `json:"uplink2_mode"`
-	// Hardware description: (REGEX)
-	Hardware string `json:"hardware"`
+	Lan3Mode ItfhwRedServerLan3Mode `json:"lan3_mode"`
+	// Lan3Vids default value is ""
+	Lan3Vids string `json:"lan3_vids"`
 	// Lan4Mode can be one of: []string{"tagged", "untagged", "untagged_drop_tagged", "unused"}
 	// Lan4Mode default value is "unused"
-	Lan4Mode string `json:"lan4_mode"`
-	// UplinkMode can be one of: []string{"dhcp", "manual"}
-	// UplinkMode default value is "dhcp"
-	UplinkMode string `json:"uplink_mode"`
-	// Apn default value is ""
-	Apn           string `json:"apn"`
-	BridgeNetmask int    `json:"bridge_netmask"`
-	// DialString default value is "*99#"
-	DialString string `json:"dial_string"`
-	// Lan2Vids default value is ""
-	Lan2Vids string `json:"lan2_vids"`
+	Lan4Mode ItfhwRedServerLan4Mode `json:"lan4_mode"`
+	// Lan4Vids default value is ""
+	Lan4Vids string `json:"lan4_vids"`
 	// LanportMode can be one of: []string{"switch", "vlan"}
 	// LanportMode default value is "switch"
-	LanportMode string `json:"lanport_mode"`
+	LanportMode   ItfhwRedServerLanportMode `json:"lanport_mode"`
+	LocalNetworks []string                  `json:"local_networks"`
+	// LocalNetworksTarget description: REF(network/host), REF(network/dns_host), REF(network/interface_address)
+	// LocalNetworksTarget default value is ""
+	LocalNetworksTarget NetworkRef `json:"local_networks_target"`
+	// Mac description: (MACADDR)
+	// Mac default value is "00:00:00:00:00:00"
+	Mac                    string `json:"mac"`
+	MacFilterEntriesRed10  int64  `json:"mac_filter_entries_red10"`
+	MacFilterEntriesRed15  int64  `json:"mac_filter_entries_red15"`
+	MacFilterEntriesRed15W int64  `json:"mac_filter_entries_red15w"`
+	MacFilterEntriesRed50  int64  `json:"mac_filter_entries_red50"`
+	// MacFilterList description: REF(mac_list/*)
+	// MacFilterList default value is ""
+	MacFilterList MacListRef `json:"mac_filter_list"`
 	// MacFilterType can be one of: []string{"none", "whitelist", "blacklist"}
 	// MacFilterType default value is "none"
-	MacFilterType string `json:"mac_filter_type"`
+	MacFilterType ItfhwRedServerMacFilterType `json:"mac_filter_type"`
+	// Manual2Address description: (IPADDR)
+	// Manual2Address default value is "0.0.0.0"
+	Manual2Address string `json:"manual2_address"`
+	// Manual2Defgw description: (IPADDR)
+	// Manual2Defgw default value is "0.0.0.0"
+	Manual2Defgw string `json:"manual2_defgw"`
+	// Manual2Dns description: (IPADDR)
+	// Manual2Dns default value is "0.0.0.0"
+	Manual2Dns     string `json:"manual2_dns"`
+	Manual2Netmask int64  `json:"manual2_netmask"`
 	// ManualAddress description: (IPADDR)
 	// ManualAddress default value is "0.0.0.0"
 	ManualAddress string `json:"manual_address"`
-	DebugLevel    int    `json:"debug_level"`
-	// HubHostname default value is ""
-	HubHostname string `json:"hub_hostname"`
-	// Lan4Vids default value is ""
-	Lan4Vids string `json:"lan4_vids"`
-	// Mac description: (MACADDR)
-	// Mac default value is "00:00:00:00:00:00"
-	Mac           string        `json:"mac"`
-	SplitNetworks []interface{} `json:"split_networks"`
-	TunnelId      int           `json:"tunnel_id"`
-	// FullbrDns description: REF(network/host), REF(network/dns_host), REF(network/interface_address)
-	// FullbrDns default value is ""
-	FullbrDns string `json:"fullbr_dns"`
-	// HostnameBalancing can be one of: []string{"balance", "failover"}
-	// HostnameBalancing default value is "failover"
-	HostnameBalancing string `json:"hostname_balancing"`
-	// Lan2Mode can be one of: []string{"tagged", "untagged", "untagged_drop_tagged", "unused"}
-	// Lan2Mode default value is "unused"
-	Lan2Mode string `json:"lan2_mode"`
-	// MacFilterList description: REF(mac_list/*)
-	// MacFilterList default value is ""
-	MacFilterList string `json:"mac_filter_list"`
-	// UnlockCode default value is ""
-	UnlockCode string `json:"unlock_code"`
-	// UplinkBalancing can be one of: []string{"balance", "failover"}
-	// UplinkBalancing default value is "failover"
-	UplinkBalancing       string `json:"uplink_balancing"`
-	MacFilterEntriesRed10 int    `json:"mac_filter_entries_red10"`
+	// ManualDefgw description: (IPADDR)
+	// ManualDefgw default value is "0.0.0.0"
+	ManualDefgw string `json:"manual_defgw"`
+	// ManualDns description: (IPADDR)
+	// ManualDns default value is "0.0.0.0"
+	ManualDns     string `json:"manual_dns"`
+	ManualNetmask int64  `json:"manual_netmask"`
+	// MobileNetwork can be one of: []string{"gsm", "cdma"}
+	// MobileNetwork default value is "gsm"
+	MobileNetwork ItfhwRedServerMobileNetwork `json:"mobile_network"`
+	Name          string                      `json:"name"`
+	// Password default value is ""
+	Password string `json:"password"`
+	Pin      int64  `json:"pin"`
+	// PinAsString description: (REGEX)
+	// PinAsString default value is ""
+	PinAsString string `json:"pin_as_string"`
+	// PrevUnlockCode default value is ""
+	PrevUnlockCode string `json:"prev_unlock_code"`
+	RedId          string `json:"red_id"`
+	// RemoteCert description: REF(ca/host_key_cert)
+	// RemoteCert default value is ""
+	RemoteCert CaRef `json:"remote_cert"`
+	// RouteMode can be one of: []string{"default", "split", "fullbr"}
+	// RouteMode default value is "default"
+	RouteMode     ItfhwRedServerRouteMode `json:"route_mode"`
+	SplitNetworks []string                `json:"split_networks"`
+	// State can be one of: []string{"initializing", "runnable", "notbound"}
+	// State default value is "initializing"
+	State ItfhwRedServerState `json:"state"`
+	// Status default value is false
+	Status bool `json:"status"`
 	// TunnelCompression default value is false
 	TunnelCompression bool `json:"tunnel_compression"`
+	// TunnelCompressionAlgorithm can be one of: []string{"deflate", "lzo", "gzip"}
+	// TunnelCompressionAlgorithm default value is "lzo"
+	TunnelCompressionAlgorithm ItfhwRedServerTunnelCompressionAlgorithm `json:"tunnel_compression_algorithm"`
+	TunnelId                   int64                                    `json:"tunnel_id"`
+	// TunnelState default value is false
+	TunnelState bool `json:"tunnel_state"`
+	// Type can be one of: []string{"red", "red15", "red15w", "red50", "asg", "software"}
+	// Type default value is "asg"
+	Type ItfhwRedServerType `json:"type"`
 	// UmtsState can be one of: []string{"READY", "PIN", "PUK"}
 	// UmtsState default value is "READY"
-	UmtsState string `json:"umts_state"`
+	UmtsState ItfhwRedServerUmtsState `json:"umts_state"`
+	// UnlockCode default value is ""
+	UnlockCode string `json:"unlock_code"`
+	// Uplink2Mode can be one of: []string{"dhcp", "manual"}
+	// Uplink2Mode default value is "dhcp"
+	Uplink2Mode ItfhwRedServerUplink2Mode `json:"uplink2_mode"`
+	// UplinkBalancing can be one of: []string{"balance", "failover"}
+	// UplinkBalancing default value is "failover"
+	UplinkBalancing ItfhwRedServerUplinkBalancing `json:"uplink_balancing"`
+	// UplinkMode can be one of: []string{"dhcp", "manual"}
+	// UplinkMode default value is "dhcp"
+	UplinkMode ItfhwRedServerUplinkMode `json:"uplink_mode"`
+	// Username default value is ""
+	Username string `json:"username"`
+}
+
+// ItfhwRedServerBridgeProto is the BridgeProto of a ItfhwRedServer
+type ItfhwRedServerBridgeProto string
+
+// Known values of ItfhwRedServerBridgeProto
+const (
+	ItfhwRedServerBridgeProtoDhcp   ItfhwRedServerBridgeProto = "dhcp"
+	ItfhwRedServerBridgeProtoStatic ItfhwRedServerBridgeProto = "static"
+	ItfhwRedServerBridgeProtoNone   ItfhwRedServerBridgeProto = "none"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerBridgeProto
+func (v ItfhwRedServerBridgeProto) Valid() bool {
+	switch v {
+	case ItfhwRedServerBridgeProtoDhcp, ItfhwRedServerBridgeProtoStatic, ItfhwRedServerBridgeProtoNone:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerDeploymentMode is the DeploymentMode of a ItfhwRedServer
+type ItfhwRedServerDeploymentMode string
+
+// Known values of ItfhwRedServerDeploymentMode
+const (
+	ItfhwRedServerDeploymentModeOnline  ItfhwRedServerDeploymentMode = "online"
+	ItfhwRedServerDeploymentModeOffline ItfhwRedServerDeploymentMode = "offline"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerDeploymentMode
+func (v ItfhwRedServerDeploymentMode) Valid() bool {
+	switch v {
+	case ItfhwRedServerDeploymentModeOnline, ItfhwRedServerDeploymentModeOffline:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerHostnameBalancing is the HostnameBalancing of a ItfhwRedServer
+type ItfhwRedServerHostnameBalancing string
+
+// Known values of ItfhwRedServerHostnameBalancing
+const (
+	ItfhwRedServerHostnameBalancingBalance  ItfhwRedServerHostnameBalancing = "balance"
+	ItfhwRedServerHostnameBalancingFailover ItfhwRedServerHostnameBalancing = "failover"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerHostnameBalancing
+func (v ItfhwRedServerHostnameBalancing) Valid() bool {
+	switch v {
+	case ItfhwRedServerHostnameBalancingBalance, ItfhwRedServerHostnameBalancingFailover:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerLan1Mode is the Lan1Mode of a ItfhwRedServer
+type ItfhwRedServerLan1Mode string
+
+// Known values of ItfhwRedServerLan1Mode
+const (
+	ItfhwRedServerLan1ModeTagged             ItfhwRedServerLan1Mode = "tagged"
+	ItfhwRedServerLan1ModeUntagged           ItfhwRedServerLan1Mode = "untagged"
+	ItfhwRedServerLan1ModeUntaggedDropTagged ItfhwRedServerLan1Mode = "untagged_drop_tagged"
+	ItfhwRedServerLan1ModeUnused             ItfhwRedServerLan1Mode = "unused"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerLan1Mode
+func (v ItfhwRedServerLan1Mode) Valid() bool {
+	switch v {
+	case ItfhwRedServerLan1ModeTagged, ItfhwRedServerLan1ModeUntagged, ItfhwRedServerLan1ModeUntaggedDropTagged, ItfhwRedServerLan1ModeUnused:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerLan2Mode is the Lan2Mode of a ItfhwRedServer
+type ItfhwRedServerLan2Mode string
+
+// Known values of ItfhwRedServerLan2Mode
+const (
+	ItfhwRedServerLan2ModeTagged             ItfhwRedServerLan2Mode = "tagged"
+	ItfhwRedServerLan2ModeUntagged           ItfhwRedServerLan2Mode = "untagged"
+	ItfhwRedServerLan2ModeUntaggedDropTagged ItfhwRedServerLan2Mode = "untagged_drop_tagged"
+	ItfhwRedServerLan2ModeUnused             ItfhwRedServerLan2Mode = "unused"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerLan2Mode
+func (v ItfhwRedServerLan2Mode) Valid() bool {
+	switch v {
+	case ItfhwRedServerLan2ModeTagged, ItfhwRedServerLan2ModeUntagged, ItfhwRedServerLan2ModeUntaggedDropTagged, ItfhwRedServerLan2ModeUnused:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerLan3Mode is the Lan3Mode of a ItfhwRedServer
+type ItfhwRedServerLan3Mode string
+
+// Known values of ItfhwRedServerLan3Mode
+const (
+	ItfhwRedServerLan3ModeTagged             ItfhwRedServerLan3Mode = "tagged"
+	ItfhwRedServerLan3ModeUntagged           ItfhwRedServerLan3Mode = "untagged"
+	ItfhwRedServerLan3ModeUntaggedDropTagged ItfhwRedServerLan3Mode = "untagged_drop_tagged"
+	ItfhwRedServerLan3ModeUnused             ItfhwRedServerLan3Mode = "unused"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerLan3Mode
+func (v ItfhwRedServerLan3Mode) Valid() bool {
+	switch v {
+	case ItfhwRedServerLan3ModeTagged, ItfhwRedServerLan3ModeUntagged, ItfhwRedServerLan3ModeUntaggedDropTagged, ItfhwRedServerLan3ModeUnused:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerLan4Mode is the Lan4Mode of a ItfhwRedServer
+type ItfhwRedServerLan4Mode string
+
+// Known values of ItfhwRedServerLan4Mode
+const (
+	ItfhwRedServerLan4ModeTagged             ItfhwRedServerLan4Mode = "tagged"
+	ItfhwRedServerLan4ModeUntagged           ItfhwRedServerLan4Mode = "untagged"
+	ItfhwRedServerLan4ModeUntaggedDropTagged ItfhwRedServerLan4Mode = "untagged_drop_tagged"
+	ItfhwRedServerLan4ModeUnused             ItfhwRedServerLan4Mode = "unused"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerLan4Mode
+func (v ItfhwRedServerLan4Mode) Valid() bool {
+	switch v {
+	case ItfhwRedServerLan4ModeTagged, ItfhwRedServerLan4ModeUntagged, ItfhwRedServerLan4ModeUntaggedDropTagged, ItfhwRedServerLan4ModeUnused:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerLanportMode is the LanportMode of a ItfhwRedServer
+type ItfhwRedServerLanportMode string
+
+// Known values of ItfhwRedServerLanportMode
+const (
+	ItfhwRedServerLanportModeSwitch ItfhwRedServerLanportMode = "switch"
+	ItfhwRedServerLanportModeVlan   ItfhwRedServerLanportMode = "vlan"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerLanportMode
+func (v ItfhwRedServerLanportMode) Valid() bool {
+	switch v {
+	case ItfhwRedServerLanportModeSwitch, ItfhwRedServerLanportModeVlan:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerMacFilterType is the MacFilterType of a ItfhwRedServer
+type ItfhwRedServerMacFilterType string
+
+// Known values of ItfhwRedServerMacFilterType
+const (
+	ItfhwRedServerMacFilterTypeNone      ItfhwRedServerMacFilterType = "none"
+	ItfhwRedServerMacFilterTypeWhitelist ItfhwRedServerMacFilterType = "whitelist"
+	ItfhwRedServerMacFilterTypeBlacklist ItfhwRedServerMacFilterType = "blacklist"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerMacFilterType
+func (v ItfhwRedServerMacFilterType) Valid() bool {
+	switch v {
+	case ItfhwRedServerMacFilterTypeNone, ItfhwRedServerMacFilterTypeWhitelist, ItfhwRedServerMacFilterTypeBlacklist:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerMobileNetwork is the MobileNetwork of a ItfhwRedServer
+type ItfhwRedServerMobileNetwork string
+
+// Known values of ItfhwRedServerMobileNetwork
+const (
+	ItfhwRedServerMobileNetworkGsm  ItfhwRedServerMobileNetwork = "gsm"
+	ItfhwRedServerMobileNetworkCdma ItfhwRedServerMobileNetwork = "cdma"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerMobileNetwork
+func (v ItfhwRedServerMobileNetwork) Valid() bool {
+	switch v {
+	case ItfhwRedServerMobileNetworkGsm, ItfhwRedServerMobileNetworkCdma:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerRouteMode is the RouteMode of a ItfhwRedServer
+type ItfhwRedServerRouteMode string
+
+// Known values of ItfhwRedServerRouteMode
+const (
+	ItfhwRedServerRouteModeDefault ItfhwRedServerRouteMode = "default"
+	ItfhwRedServerRouteModeSplit   ItfhwRedServerRouteMode = "split"
+	ItfhwRedServerRouteModeFullbr  ItfhwRedServerRouteMode = "fullbr"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerRouteMode
+func (v ItfhwRedServerRouteMode) Valid() bool {
+	switch v {
+	case ItfhwRedServerRouteModeDefault, ItfhwRedServerRouteModeSplit, ItfhwRedServerRouteModeFullbr:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerState is the State of a ItfhwRedServer
+type ItfhwRedServerState string
+
+// Known values of ItfhwRedServerState
+const (
+	ItfhwRedServerStateInitializing ItfhwRedServerState = "initializing"
+	ItfhwRedServerStateRunnable     ItfhwRedServerState = "runnable"
+	ItfhwRedServerStateNotbound     ItfhwRedServerState = "notbound"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerState
+func (v ItfhwRedServerState) Valid() bool {
+	switch v {
+	case ItfhwRedServerStateInitializing, ItfhwRedServerStateRunnable, ItfhwRedServerStateNotbound:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerTunnelCompressionAlgorithm is the TunnelCompressionAlgorithm of a ItfhwRedServer
+type ItfhwRedServerTunnelCompressionAlgorithm string
+
+// Known values of ItfhwRedServerTunnelCompressionAlgorithm
+const (
+	ItfhwRedServerTunnelCompressionAlgorithmDeflate ItfhwRedServerTunnelCompressionAlgorithm = "deflate"
+	ItfhwRedServerTunnelCompressionAlgorithmLzo     ItfhwRedServerTunnelCompressionAlgorithm = "lzo"
+	ItfhwRedServerTunnelCompressionAlgorithmGzip    ItfhwRedServerTunnelCompressionAlgorithm = "gzip"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerTunnelCompressionAlgorithm
+func (v ItfhwRedServerTunnelCompressionAlgorithm) Valid() bool {
+	switch v {
+	case ItfhwRedServerTunnelCompressionAlgorithmDeflate, ItfhwRedServerTunnelCompressionAlgorithmLzo, ItfhwRedServerTunnelCompressionAlgorithmGzip:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerType is the Type of a ItfhwRedServer
+type ItfhwRedServerType string
+
+// Known values of ItfhwRedServerType
+const (
+	ItfhwRedServerTypeRed      ItfhwRedServerType = "red"
+	ItfhwRedServerTypeRed15    ItfhwRedServerType = "red15"
+	ItfhwRedServerTypeRed15W   ItfhwRedServerType = "red15w"
+	ItfhwRedServerTypeRed50    ItfhwRedServerType = "red50"
+	ItfhwRedServerTypeAsg      ItfhwRedServerType = "asg"
+	ItfhwRedServerTypeSoftware ItfhwRedServerType = "software"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerType
+func (v ItfhwRedServerType) Valid() bool {
+	switch v {
+	case ItfhwRedServerTypeRed, ItfhwRedServerTypeRed15, ItfhwRedServerTypeRed15W, ItfhwRedServerTypeRed50, ItfhwRedServerTypeAsg, ItfhwRedServerTypeSoftware:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerUmtsState is the UmtsState of a ItfhwRedServer
+type ItfhwRedServerUmtsState string
+
+// Known values of ItfhwRedServerUmtsState
+const (
+	ItfhwRedServerUmtsStateREADY ItfhwRedServerUmtsState = "READY"
+	ItfhwRedServerUmtsStatePIN   ItfhwRedServerUmtsState = "PIN"
+	ItfhwRedServerUmtsStatePUK   ItfhwRedServerUmtsState = "PUK"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerUmtsState
+func (v ItfhwRedServerUmtsState) Valid() bool {
+	switch v {
+	case ItfhwRedServerUmtsStateREADY, ItfhwRedServerUmtsStatePIN, ItfhwRedServerUmtsStatePUK:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerUplink2Mode is the Uplink2Mode of a ItfhwRedServer
+type ItfhwRedServerUplink2Mode string
+
+// Known values of ItfhwRedServerUplink2Mode
+const (
+	ItfhwRedServerUplink2ModeDhcp   ItfhwRedServerUplink2Mode = "dhcp"
+	ItfhwRedServerUplink2ModeManual ItfhwRedServerUplink2Mode = "manual"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerUplink2Mode
+func (v ItfhwRedServerUplink2Mode) Valid() bool {
+	switch v {
+	case ItfhwRedServerUplink2ModeDhcp, ItfhwRedServerUplink2ModeManual:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerUplinkBalancing is the UplinkBalancing of a ItfhwRedServer
+type ItfhwRedServerUplinkBalancing string
+
+// Known values of ItfhwRedServerUplinkBalancing
+const (
+	ItfhwRedServerUplinkBalancingBalance  ItfhwRedServerUplinkBalancing = "balance"
+	ItfhwRedServerUplinkBalancingFailover ItfhwRedServerUplinkBalancing = "failover"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerUplinkBalancing
+func (v ItfhwRedServerUplinkBalancing) Valid() bool {
+	switch v {
+	case ItfhwRedServerUplinkBalancingBalance, ItfhwRedServerUplinkBalancingFailover:
+		return true
+	}
+	return false
+}
+
+// ItfhwRedServerUplinkMode is the UplinkMode of a ItfhwRedServer
+type ItfhwRedServerUplinkMode string
+
+// Known values of ItfhwRedServerUplinkMode
+const (
+	ItfhwRedServerUplinkModeDhcp   ItfhwRedServerUplinkMode = "dhcp"
+	ItfhwRedServerUplinkModeManual ItfhwRedServerUplinkMode = "manual"
+)
+
+// Valid returns true if the value is a known ItfhwRedServerUplinkMode
+func (v ItfhwRedServerUplinkMode) Valid() bool {
+	switch v {
+	case ItfhwRedServerUplinkModeDhcp, ItfhwRedServerUplinkModeManual:
+		return true
+	}
+	return false
+}
+
+// NewItfhwRedServer returns a ItfhwRedServer with the default values of its swagger definition
+func NewItfhwRedServer() *ItfhwRedServer {
+	return &ItfhwRedServer{
+		ObjectType:                 "itfhw/red_server",
+		BridgeAddress:              "0.0.0.0",
+		BridgeProto:                ItfhwRedServerBridgeProtoNone,
+		DeploymentMode:             ItfhwRedServerDeploymentModeOnline,
+		Description:                "Remote Ethernet Server Device",
+		DialString:                 "*99#",
+		FailoverDirect:             true,
+		HostnameBalancing:          ItfhwRedServerHostnameBalancingFailover,
+		Lan1Mode:                   ItfhwRedServerLan1ModeUnused,
+		Lan2Mode:                   ItfhwRedServerLan2ModeUnused,
+		Lan3Mode:                   ItfhwRedServerLan3ModeUnused,
+		Lan4Mode:                   ItfhwRedServerLan4ModeUnused,
+		LanportMode:                ItfhwRedServerLanportModeSwitch,
+		Mac:                        "00:00:00:00:00:00",
+		MacFilterType:              ItfhwRedServerMacFilterTypeNone,
+		Manual2Address:             "0.0.0.0",
+		Manual2Defgw:               "0.0.0.0",
+		Manual2Dns:                 "0.0.0.0",
+		ManualAddress:              "0.0.0.0",
+		ManualDefgw:                "0.0.0.0",
+		ManualDns:                  "0.0.0.0",
+		MobileNetwork:              ItfhwRedServerMobileNetworkGsm,
+		RouteMode:                  ItfhwRedServerRouteModeDefault,
+		State:                      ItfhwRedServerStateInitializing,
+		TunnelCompressionAlgorithm: ItfhwRedServerTunnelCompressionAlgorithmLzo,
+		Type:                       ItfhwRedServerTypeAsg,
+		UmtsState:                  ItfhwRedServerUmtsStateREADY,
+		Uplink2Mode:                ItfhwRedServerUplink2ModeDhcp,
+		UplinkBalancing:            ItfhwRedServerUplinkBalancingFailover,
+		UplinkMode:                 ItfhwRedServerUplinkModeDhcp,
+	}
+}
+
+// Validate checks the ItfhwRedServer before it is sent, see ValidateWith
+func (i *ItfhwRedServer) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfhwRedServer.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfhwRedServer) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfhwRedServer", typeOf)
+	check.Format("bridge_address", sophos.FormatIPv4, i.BridgeAddress)
+	check.Between("bridge_netmask", i.BridgeNetmask, 0, 32)
+	check.Enum("bridge_proto", string(i.BridgeProto), i.BridgeProto.Valid())
+	check.Enum("deployment_mode", string(i.DeploymentMode), i.DeploymentMode.Valid())
+	check.Ref("fullbr_dns", string(i.FullbrDns), "REF(network/host), REF(network/dns_host), REF(network/interface_address)")
+	check.Enum("hostname_balancing", string(i.HostnameBalancing), i.HostnameBalancing.Valid())
+	check.Enum("lan1_mode", string(i.Lan1Mode), i.Lan1Mode.Valid())
+	check.Enum("lan2_mode", string(i.Lan2Mode), i.Lan2Mode.Valid())
+	check.Enum("lan3_mode", string(i.Lan3Mode), i.Lan3Mode.Valid())
+	check.Enum("lan4_mode", string(i.Lan4Mode), i.Lan4Mode.Valid())
+	check.Enum("lanport_mode", string(i.LanportMode), i.LanportMode.Valid())
+	check.Ref("local_networks_target", string(i.LocalNetworksTarget), "REF(network/host), REF(network/dns_host), REF(network/interface_address)")
+	check.Format("mac", sophos.FormatMAC, i.Mac)
+	check.Ref("mac_filter_list", string(i.MacFilterList), "REF(mac_list/*)")
+	check.Enum("mac_filter_type", string(i.MacFilterType), i.MacFilterType.Valid())
+	check.Format("manual2_address", sophos.FormatIPv4, i.Manual2Address)
+	check.Format("manual2_defgw", sophos.FormatIPv4, i.Manual2Defgw)
+	check.Format("manual2_dns", sophos.FormatIPv4, i.Manual2Dns)
+	check.Between("manual2_netmask", i.Manual2Netmask, 0, 32)
+	check.Format("manual_address", sophos.FormatIPv4, i.ManualAddress)
+	check.Format("manual_defgw", sophos.FormatIPv4, i.ManualDefgw)
+	check.Format("manual_dns", sophos.FormatIPv4, i.ManualDns)
+	check.Between("manual_netmask", i.ManualNetmask, 0, 32)
+	check.Enum("mobile_network", string(i.MobileNetwork), i.MobileNetwork.Valid())
+	check.Required("name", i.Name)
+	check.Ref("remote_cert", string(i.RemoteCert), "REF(ca/host_key_cert)")
+	check.Enum("route_mode", string(i.RouteMode), i.RouteMode.Valid())
+	check.Enum("state", string(i.State), i.State.Valid())
+	check.Enum("tunnel_compression_algorithm", string(i.TunnelCompressionAlgorithm), i.TunnelCompressionAlgorithm.Valid())
+	check.Enum("type", string(i.Type), i.Type.Valid())
+	check.Enum("umts_state", string(i.UmtsState), i.UmtsState.Valid())
+	check.Enum("uplink2_mode", string(i.Uplink2Mode), i.Uplink2Mode.Valid())
+	check.Enum("uplink_balancing", string(i.UplinkBalancing), i.UplinkBalancing.Valid())
+	check.Enum("uplink_mode", string(i.UplinkMode), i.UplinkMode.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ItfhwRedServer{}
 
-// GetPath implements sophos.RestObject and returns the ItfhwRedServers GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwRedServers GET path
 // Returns all available itfhw/red_server objects
 func (*ItfhwRedServers) GetPath() string { return "/api/objects/itfhw/red_server/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfhwRedServers) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfhwRedServers GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwRedServers GET path
 // Returns all available red_server types
 func (i *ItfhwRedServer) GetPath() string {
 	return fmt.Sprintf("/api/objects/itfhw/red_server/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfhwRedServer) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfhwRedServer DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfhwRedServer DELETE path
 // Creates or updates the complete object red_server
 func (*ItfhwRedServer) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/red_server/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfhwRedServer PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfhwRedServer PATCH path
 // Changes to parts of the object red_server types
 func (*ItfhwRedServer) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/red_server/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfhwRedServer POST path
+// PostPath implements sophos.Creatable and returns the ItfhwRedServer POST path
 // Create a new itfhw/red_server object
 func (*ItfhwRedServer) PostPath() string {
 	return "/api/objects/itfhw/red_server/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfhwRedServer PUT path
+// PutPath implements sophos.Updatable and returns the ItfhwRedServer PUT path
 // Creates or updates the complete object red_server
 func (*ItfhwRedServer) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/red_server/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfhwRedServer) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/red_server/%s/usedby", ref)
 }
 
-// ItfhwSerials is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *ItfhwRedServer) GetType() string { return i.ObjectType }
+
+// ItfhwSerials is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfhwSerials []ItfhwSerial
 
 // ItfhwSerial represents a UTM serial interface
 type ItfhwSerial struct {
-	Locked      string `json:"_locked"`
-	ObjectType  string `json:"_type"`
-	Reference   string `json:"_ref"`
+	Locked     string `json:"_locked"`
+	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
+	// Baud default value is ""
+	Baud        string `json:"baud"`
+	Comment     string `json:"comment"`
 	Description string `json:"description"`
 	// Hardware description: (REGEX)
 	Hardware string `json:"hardware"`
-	Irq      int    `json:"irq"`
+	Irq      int64  `json:"irq"`
 	Name     string `json:"name"`
 	Port     string `json:"port"`
-	// Baud default value is ""
-	Baud    string `json:"baud"`
-	Comment string `json:"comment"`
+}
+
+// NewItfhwSerial returns a ItfhwSerial with the default values of its swagger definition
+func NewItfhwSerial() *ItfhwSerial {
+	return &ItfhwSerial{
+		ObjectType: "itfhw/serial",
+	}
+}
+
+// Validate checks the ItfhwSerial before it is sent, see ValidateWith
+func (i *ItfhwSerial) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfhwSerial.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfhwSerial) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfhwSerial", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ItfhwSerial{}
 
-// GetPath implements sophos.RestObject and returns the ItfhwSerials GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwSerials GET path
 // Returns all available itfhw/serial objects
 func (*ItfhwSerials) GetPath() string { return "/api/objects/itfhw/serial/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfhwSerials) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfhwSerials GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwSerials GET path
 // Returns all available serial types
 func (i *ItfhwSerial) GetPath() string {
 	return fmt.Sprintf("/api/objects/itfhw/serial/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfhwSerial) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfhwSerial DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfhwSerial DELETE path
 // Creates or updates the complete object serial
 func (*ItfhwSerial) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/serial/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfhwSerial PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfhwSerial PATCH path
 // Changes to parts of the object serial types
 func (*ItfhwSerial) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/serial/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfhwSerial POST path
+// PostPath implements sophos.Creatable and returns the ItfhwSerial POST path
 // Create a new itfhw/serial object
 func (*ItfhwSerial) PostPath() string {
 	return "/api/objects/itfhw/serial/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfhwSerial PUT path
+// PutPath implements sophos.Updatable and returns the ItfhwSerial PUT path
 // Creates or updates the complete object serial
 func (*ItfhwSerial) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/serial/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfhwSerial) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/serial/%s/usedby", ref)
 }
 
-// ItfhwUsbserials is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *ItfhwSerial) GetType() string { return i.ObjectType }
+
+// ItfhwUsbserials is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfhwUsbserials []ItfhwUsbserial
 
 // ItfhwUsbserial represents a UTM USB serial interface
@@ -903,69 +1516,90 @@ type ItfhwUsbserial struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Name       string `json:"name"`
-	// Product default value is ""
-	Product string `json:"product"`
-	// Vendor default value is ""
-	Vendor  string `json:"vendor"`
-	Comment string `json:"comment"`
+	Comment    string `json:"comment"`
 	// Control description: (REGEX)
 	// Control default value is ""
 	Control     string `json:"control"`
 	Description string `json:"description"`
 	// Hardware description: (REGEX)
 	Hardware string `json:"hardware"`
+	Name     string `json:"name"`
+	// Product default value is ""
+	Product string `json:"product"`
+	// Vendor default value is ""
+	Vendor string `json:"vendor"`
+}
+
+// NewItfhwUsbserial returns a ItfhwUsbserial with the default values of its swagger definition
+func NewItfhwUsbserial() *ItfhwUsbserial {
+	return &ItfhwUsbserial{
+		ObjectType: "itfhw/usbserial",
+	}
+}
+
+// Validate checks the ItfhwUsbserial before it is sent, see ValidateWith
+func (i *ItfhwUsbserial) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfhwUsbserial.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfhwUsbserial) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfhwUsbserial", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ItfhwUsbserial{}
 
-// GetPath implements sophos.RestObject and returns the ItfhwUsbserials GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwUsbserials GET path
 // Returns all available itfhw/usbserial objects
 func (*ItfhwUsbserials) GetPath() string { return "/api/objects/itfhw/usbserial/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfhwUsbserials) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfhwUsbserials GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwUsbserials GET path
 // Returns all available usbserial types
 func (i *ItfhwUsbserial) GetPath() string {
 	return fmt.Sprintf("/api/objects/itfhw/usbserial/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfhwUsbserial) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfhwUsbserial DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfhwUsbserial DELETE path
 // Creates or updates the complete object usbserial
 func (*ItfhwUsbserial) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/usbserial/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfhwUsbserial PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfhwUsbserial PATCH path
 // Changes to parts of the object usbserial types
 func (*ItfhwUsbserial) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/usbserial/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfhwUsbserial POST path
+// PostPath implements sophos.Creatable and returns the ItfhwUsbserial POST path
 // Create a new itfhw/usbserial object
 func (*ItfhwUsbserial) PostPath() string {
 	return "/api/objects/itfhw/usbserial/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfhwUsbserial PUT path
+// PutPath implements sophos.Updatable and returns the ItfhwUsbserial PUT path
 // Creates or updates the complete object usbserial
 func (*ItfhwUsbserial) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/usbserial/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfhwUsbserial) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/usbserial/%s/usedby", ref)
 }
 
-// ItfhwVirtuals is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *ItfhwUsbserial) GetType() string { return i.ObjectType }
+
+// ItfhwVirtuals is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfhwVirtuals []ItfhwVirtual
 
 // ItfhwVirtual represents a UTM virtual interface
@@ -973,59 +1607,104 @@ type ItfhwVirtual struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Name       string `json:"name"`
 	Comment    string `json:"comment"`
 	// Description default value is "IPv6 Tunnel"
 	Description string `json:"description"`
 	// Hardware can be one of: []string{"6to4", "aiccu", "tspc", "teredo", "he.net"}
 	// Hardware default value is "teredo"
-	Hardware string `json:"hardware"`
+	Hardware ItfhwVirtualHardware `json:"hardware"`
+	Name     string               `json:"name"`
+}
+
+// ItfhwVirtualHardware is the Hardware of a ItfhwVirtual
+type ItfhwVirtualHardware string
+
+// Known values of ItfhwVirtualHardware
+const (
+	ItfhwVirtualHardware6to4   ItfhwVirtualHardware = "6to4"
+	ItfhwVirtualHardwareAiccu  ItfhwVirtualHardware = "aiccu"
+	ItfhwVirtualHardwareTspc   ItfhwVirtualHardware = "tspc"
+	ItfhwVirtualHardwareTeredo ItfhwVirtualHardware = "teredo"
+	ItfhwVirtualHardwareHenet  ItfhwVirtualHardware = "he.net"
+)
+
+// Valid returns true if the value is a known ItfhwVirtualHardware
+func (v ItfhwVirtualHardware) Valid() bool {
+	switch v {
+	case ItfhwVirtualHardware6to4, ItfhwVirtualHardwareAiccu, ItfhwVirtualHardwareTspc, ItfhwVirtualHardwareTeredo, ItfhwVirtualHardwareHenet:
+		return true
+	}
+	return false
+}
+
+// NewItfhwVirtual returns a ItfhwVirtual with the default values of its swagger definition
+func NewItfhwVirtual() *ItfhwVirtual {
+	return &ItfhwVirtual{
+		ObjectType:  "itfhw/virtual",
+		Description: "IPv6 Tunnel",
+		Hardware:    ItfhwVirtualHardwareTeredo,
+	}
+}
+
+// Validate checks the ItfhwVirtual before it is sent, see ValidateWith
+func (i *ItfhwVirtual) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfhwVirtual.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfhwVirtual) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfhwVirtual", typeOf)
+	check.Enum("hardware", string(i.Hardware), i.Hardware.Valid())
+	check.Required("name", i.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ItfhwVirtual{}
 
-// GetPath implements sophos.RestObject and returns the ItfhwVirtuals GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwVirtuals GET path
 // Returns all available itfhw/virtual objects
 func (*ItfhwVirtuals) GetPath() string { return "/api/objects/itfhw/virtual/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfhwVirtuals) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfhwVirtuals GET path
+// GetPath implements sophos.RestGetter and returns the ItfhwVirtuals GET path
 // Returns all available virtual types
 func (i *ItfhwVirtual) GetPath() string {
 	return fmt.Sprintf("/api/objects/itfhw/virtual/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfhwVirtual) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfhwVirtual DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfhwVirtual DELETE path
 // Creates or updates the complete object virtual
 func (*ItfhwVirtual) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/virtual/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfhwVirtual PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfhwVirtual PATCH path
 // Changes to parts of the object virtual types
 func (*ItfhwVirtual) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/virtual/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfhwVirtual POST path
+// PostPath implements sophos.Creatable and returns the ItfhwVirtual POST path
 // Create a new itfhw/virtual object
 func (*ItfhwVirtual) PostPath() string {
 	return "/api/objects/itfhw/virtual/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfhwVirtual PUT path
+// PutPath implements sophos.Updatable and returns the ItfhwVirtual PUT path
 // Creates or updates the complete object virtual
 func (*ItfhwVirtual) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/virtual/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfhwVirtual) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfhw/virtual/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (i *ItfhwVirtual) GetType() string { return i.ObjectType }
@@ -18,7 +18,7 @@ type Itfparams struct {
 
 var _ sophos.Endpoint = &Itfparams{}
 
-var defsItfparams = map[string]sophos.RestObject{
+var defsItfparams = map[string]sophos.RestGetter{
 	"ItfparamsBridgePort":           &ItfparamsBridgePort{},
 	"ItfparamsGroup":                &ItfparamsGroup{},
 	"ItfparamsLinkAggregationGroup": &ItfparamsLinkAggregationGroup{},
@@ -27,7 +27,7 @@ var defsItfparams = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Itfparams's Objects
-func (Itfparams) RestObjects() map[string]sophos.RestObject { return defsItfparams }
+func (Itfparams) RestObjects() map[string]sophos.RestGetter { return defsItfparams }
 
 // GetPath implements sophos.RestGetter
 func (*Itfparams) GetPath() string { return "/api/nodes/itfparams" }
@@ -73,7 +73,7 @@ func (Itfparams) References() []string {
 	}
 }
 
-// ItfparamsBridgePorts is an Sophos Endpoint subType and implements sophos.RestObject
+// ItfparamsBridgePorts is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfparamsBridgePorts []ItfparamsBridgePort
 
 // ItfparamsBridgePort represents a UTM bridge port
@@ -83,63 +83,87 @@ type ItfparamsBridgePort struct {
 	Reference  string `json:"_ref"`
 	Comment    string `json:"comment"`
 	// Itfhw description: REF(itfhw/ethernet), REF(itfhw/red_server), REF(itfhw/red_client), REF(itfhw/awe_network), REF(itfhw/lag)
-	Itfhw string `json:"itfhw"`
-	Name  string `json:"name"`
+	Itfhw ItfhwRef `json:"itfhw"`
+	Name  string   `json:"name"`
 	// Status default value is true
-	Status      bool `json:"status"`
-	StpPathcost int  `json:"stp_pathcost"`
-	StpPortprio int  `json:"stp_portprio"`
+	Status      bool  `json:"status"`
+	StpPathcost int64 `json:"stp_pathcost"`
+	StpPortprio int64 `json:"stp_portprio"`
+}
+
+// NewItfparamsBridgePort returns a ItfparamsBridgePort with the default values of its swagger definition
+func NewItfparamsBridgePort() *ItfparamsBridgePort {
+	return &ItfparamsBridgePort{
+		ObjectType: "itfparams/bridge_port",
+		Status:     true,
+	}
+}
+
+// Validate checks the ItfparamsBridgePort before it is sent, see ValidateWith
+func (i *ItfparamsBridgePort) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfparamsBridgePort.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfparamsBridgePort) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfparamsBridgePort", typeOf)
+	check.Required("itfhw", string(i.Itfhw))
+	check.Ref("itfhw", string(i.Itfhw), "REF(itfhw/ethernet), REF(itfhw/red_server), REF(itfhw/red_client), REF(itfhw/awe_network), REF(itfhw/lag)")
+	check.Required("name", i.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ItfparamsBridgePort{}
 
-// GetPath implements sophos.RestObject and returns the ItfparamsBridgePorts GET path
+// GetPath implements sophos.RestGetter and returns the ItfparamsBridgePorts GET path
 // Returns all available itfparams/bridge_port objects
 func (*ItfparamsBridgePorts) GetPath() string { return "/api/objects/itfparams/bridge_port/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfparamsBridgePorts) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfparamsBridgePorts GET path
+// GetPath implements sophos.RestGetter and returns the ItfparamsBridgePorts GET path
 // Returns all available bridge_port types
 func (i *ItfparamsBridgePort) GetPath() string {
 	return fmt.Sprintf("/api/objects/itfparams/bridge_port/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfparamsBridgePort) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfparamsBridgePort DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfparamsBridgePort DELETE path
 // Creates or updates the complete object bridge_port
 func (*ItfparamsBridgePort) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/bridge_port/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfparamsBridgePort PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfparamsBridgePort PATCH path
 // Changes to parts of the object bridge_port types
 func (*ItfparamsBridgePort) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/bridge_port/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfparamsBridgePort POST path
+// PostPath implements sophos.Creatable and returns the ItfparamsBridgePort POST path
 // Create a new itfparams/bridge_port object
 func (*ItfparamsBridgePort) PostPath() string {
 	return "/api/objects/itfparams/bridge_port/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfparamsBridgePort PUT path
+// PutPath implements sophos.Updatable and returns the ItfparamsBridgePort PUT path
 // Creates or updates the complete object bridge_port
 func (*ItfparamsBridgePort) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/bridge_port/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfparamsBridgePort) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/bridge_port/%s/usedby", ref)
 }
 
-// ItfparamsGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *ItfparamsBridgePort) GetType() string { return i.ObjectType }
+
+// ItfparamsGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfparamsGroups []ItfparamsGroup
 
 // ItfparamsGroup represents a UTM group
@@ -151,69 +175,90 @@ type ItfparamsGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewItfparamsGroup returns a ItfparamsGroup with the default values of its swagger definition
+func NewItfparamsGroup() *ItfparamsGroup {
+	return &ItfparamsGroup{
+		ObjectType: "itfparams/group",
+	}
+}
+
+// Validate checks the ItfparamsGroup before it is sent, see ValidateWith
+func (i *ItfparamsGroup) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfparamsGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfparamsGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfparamsGroup", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ItfparamsGroup{}
 
-// GetPath implements sophos.RestObject and returns the ItfparamsGroups GET path
+// GetPath implements sophos.RestGetter and returns the ItfparamsGroups GET path
 // Returns all available itfparams/group objects
 func (*ItfparamsGroups) GetPath() string { return "/api/objects/itfparams/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfparamsGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfparamsGroups GET path
+// GetPath implements sophos.RestGetter and returns the ItfparamsGroups GET path
 // Returns all available group types
 func (i *ItfparamsGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/itfparams/group/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfparamsGroup) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfparamsGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfparamsGroup DELETE path
 // Creates or updates the complete object group
 func (*ItfparamsGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfparamsGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfparamsGroup PATCH path
 // Changes to parts of the object group types
 func (*ItfparamsGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfparamsGroup POST path
+// PostPath implements sophos.Creatable and returns the ItfparamsGroup POST path
 // Create a new itfparams/group object
 func (*ItfparamsGroup) PostPath() string {
 	return "/api/objects/itfparams/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfparamsGroup PUT path
+// PutPath implements sophos.Updatable and returns the ItfparamsGroup PUT path
 // Creates or updates the complete object group
 func (*ItfparamsGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfparamsGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/group/%s/usedby", ref)
 }
 
-// ItfparamsLinkAggregationGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (i *ItfparamsGroup) GetType() string { return i.ObjectType }
+
+// ItfparamsLinkAggregationGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfparamsLinkAggregationGroups []ItfparamsLinkAggregationGroup
 
-// ItfparamsLinkAggregationGroup is a generated Sophos object
+// ItfparamsLinkAggregationGroup represents a UTM itfparams/link_aggregation_group object
 type ItfparamsLinkAggregationGroup struct {
 	Locked         string   `json:"_locked"`
-	Reference      string   `json:"_ref"`
 	ObjectType     string   `json:"_type"`
+	Reference      string   `json:"_ref"`
 	AdSelect       int64    `json:"ad_select"`
 	ArpInterval    int64    `json:"arp_interval"`
-	ArpIPTarget    string   `json:"arp_ip_target"`
+	ArpIpTarget    string   `json:"arp_ip_target"`
 	Comment        string   `json:"comment"`
 	Downdelay      int64    `json:"downdelay"`
 	EnforceMac     bool     `json:"enforce_mac"`
-	ID             int64    `json:"id"`
+	Id             int64    `json:"id"`
 	Itfhw          []string `json:"itfhw"`
 	LacpRate       int64    `json:"lacp_rate"`
 	Mac            string   `json:"mac"`
@@ -228,51 +273,69 @@ type ItfparamsLinkAggregationGroup struct {
 	XmitHashPolicy string   `json:"xmit_hash_policy"`
 }
 
+// NewItfparamsLinkAggregationGroup returns a ItfparamsLinkAggregationGroup with the default values of its swagger definition
+func NewItfparamsLinkAggregationGroup() *ItfparamsLinkAggregationGroup {
+	return &ItfparamsLinkAggregationGroup{
+		ObjectType: "itfparams/link_aggregation_group",
+	}
+}
+
+// Validate checks the ItfparamsLinkAggregationGroup before it is sent, see ValidateWith
+func (i *ItfparamsLinkAggregationGroup) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfparamsLinkAggregationGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfparamsLinkAggregationGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfparamsLinkAggregationGroup", typeOf)
+	check.Required("name", i.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ItfparamsLinkAggregationGroup{}
 
-// GetPath implements sophos.RestObject and returns the ItfparamsLinkAggregationGroups GET path
+// GetPath implements sophos.RestGetter and returns the ItfparamsLinkAggregationGroups GET path
 // Returns all available itfparams/link_aggregation_group objects
 func (*ItfparamsLinkAggregationGroups) GetPath() string {
 	return "/api/objects/itfparams/link_aggregation_group/"
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfparamsLinkAggregationGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfparamsLinkAggregationGroups GET path
+// GetPath implements sophos.RestGetter and returns the ItfparamsLinkAggregationGroups GET path
 // Returns all available link_aggregation_group types
 func (i *ItfparamsLinkAggregationGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/itfparams/link_aggregation_group/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfparamsLinkAggregationGroup) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfparamsLinkAggregationGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfparamsLinkAggregationGroup DELETE path
 // Creates or updates the complete object link_aggregation_group
 func (*ItfparamsLinkAggregationGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/link_aggregation_group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfparamsLinkAggregationGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfparamsLinkAggregationGroup PATCH path
 // Changes to parts of the object link_aggregation_group types
 func (*ItfparamsLinkAggregationGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/link_aggregation_group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfparamsLinkAggregationGroup POST path
+// PostPath implements sophos.Creatable and returns the ItfparamsLinkAggregationGroup POST path
 // Create a new itfparams/link_aggregation_group object
 func (*ItfparamsLinkAggregationGroup) PostPath() string {
 	return "/api/objects/itfparams/link_aggregation_group/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfparamsLinkAggregationGroup PUT path
+// PutPath implements sophos.Updatable and returns the ItfparamsLinkAggregationGroup PUT path
 // Creates or updates the complete object link_aggregation_group
 func (*ItfparamsLinkAggregationGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/link_aggregation_group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfparamsLinkAggregationGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/link_aggregation_group/%s/usedby", ref)
@@ -281,14 +344,14 @@ func (*ItfparamsLinkAggregationGroup) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (i *ItfparamsLinkAggregationGroup) GetType() string { return i.ObjectType }
 
-// ItfparamsPrimarys is an Sophos Endpoint subType and implements sophos.RestObject
+// ItfparamsPrimarys is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfparamsPrimarys []ItfparamsPrimary
 
-// ItfparamsPrimary is a generated Sophos object
+// ItfparamsPrimary represents a UTM itfparams/primary object
 type ItfparamsPrimary struct {
 	Locked                 string `json:"_locked"`
-	Reference              string `json:"_ref"`
 	ObjectType             string `json:"_type"`
+	Reference              string `json:"_ref"`
 	Address                string `json:"address"`
 	Address6               string `json:"address6"`
 	Comment                string `json:"comment"`
@@ -320,49 +383,72 @@ type ItfparamsPrimary struct {
 	Type6                  string `json:"type6"`
 }
 
+// NewItfparamsPrimary returns a ItfparamsPrimary with the default values of its swagger definition
+func NewItfparamsPrimary() *ItfparamsPrimary {
+	return &ItfparamsPrimary{
+		ObjectType: "itfparams/primary",
+	}
+}
+
+// Validate checks the ItfparamsPrimary before it is sent, see ValidateWith
+func (i *ItfparamsPrimary) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfparamsPrimary.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfparamsPrimary) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfparamsPrimary", typeOf)
+	check.Format("address", sophos.FormatIPv4, i.Address)
+	check.Format("address6", sophos.FormatIPv6, i.Address6)
+	check.Required("name", i.Name)
+	check.Between("netmask", i.Netmask, 0, 32)
+	check.Between("netmask6", i.Netmask6, 0, 128)
+	check.Between("pd_netmask6", i.PdNetmask6, 0, 128)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ItfparamsPrimary{}
 
-// GetPath implements sophos.RestObject and returns the ItfparamsPrimarys GET path
+// GetPath implements sophos.RestGetter and returns the ItfparamsPrimarys GET path
 // Returns all available itfparams/primary objects
 func (*ItfparamsPrimarys) GetPath() string { return "/api/objects/itfparams/primary/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfparamsPrimarys) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfparamsPrimarys GET path
+// GetPath implements sophos.RestGetter and returns the ItfparamsPrimarys GET path
 // Returns all available primary types
 func (i *ItfparamsPrimary) GetPath() string {
 	return fmt.Sprintf("/api/objects/itfparams/primary/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfparamsPrimary) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfparamsPrimary DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfparamsPrimary DELETE path
 // Creates or updates the complete object primary
 func (*ItfparamsPrimary) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/primary/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfparamsPrimary PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfparamsPrimary PATCH path
 // Changes to parts of the object primary types
 func (*ItfparamsPrimary) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/primary/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfparamsPrimary POST path
+// PostPath implements sophos.Creatable and returns the ItfparamsPrimary POST path
 // Create a new itfparams/primary object
 func (*ItfparamsPrimary) PostPath() string {
 	return "/api/objects/itfparams/primary/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfparamsPrimary PUT path
+// PutPath implements sophos.Updatable and returns the ItfparamsPrimary PUT path
 // Creates or updates the complete object primary
 func (*ItfparamsPrimary) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/primary/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfparamsPrimary) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/primary/%s/usedby", ref)
@@ -371,7 +457,7 @@ func (*ItfparamsPrimary) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (i *ItfparamsPrimary) GetType() string { return i.ObjectType }
 
-// ItfparamsSecondarys is an Sophos Endpoint subType and implements sophos.RestObject
+// ItfparamsSecondarys is an Sophos Endpoint subType and implements sophos.RestGetter
 type ItfparamsSecondarys []ItfparamsSecondary
 
 // ItfparamsSecondary represents a UTM additional interface address
@@ -379,86 +465,153 @@ type ItfparamsSecondary struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
+	// Address description: (IPADDR)
+	// Address default value is "0.0.0.0"
+	Address string `json:"address"`
 	// Address6 description: (IP6ADDR)
 	// Address6 default value is ""
 	Address6 string `json:"address6"`
 	Comment  string `json:"comment"`
+	HaNode   int64  `json:"ha_node"`
 	// Id default value is ""
-	Id       string `json:"id"`
-	Netmask  int    `json:"netmask"`
-	Netmask6 int    `json:"netmask6"`
-	// Resolved6 default value is false
-	Resolved6 bool `json:"resolved6"`
-	// Type6 can be one of: []string{"static"}
-	// Type6 default value is "static"
-	Type6 string `json:"type6"`
+	Id string `json:"id"`
 	// InterfaceAddress description: REF(network/interface_address)
 	// InterfaceAddress default value is ""
-	InterfaceAddress string `json:"interface_address"`
-	Name             string `json:"name"`
-	HaNode           int    `json:"ha_node"`
-	// Resolved default value is false
-	Resolved bool `json:"resolved"`
-	// Address description: (IPADDR)
-	// Address default value is "0.0.0.0"
-	Address string `json:"address"`
+	InterfaceAddress NetworkRef `json:"interface_address"`
 	// InterfaceBroadcast description: REF(network/interface_broadcast)
 	// InterfaceBroadcast default value is ""
-	InterfaceBroadcast string `json:"interface_broadcast"`
+	InterfaceBroadcast NetworkRef `json:"interface_broadcast"`
 	// InterfaceNetwork description: REF(network/interface_network)
 	// InterfaceNetwork default value is ""
-	InterfaceNetwork string `json:"interface_network"`
+	InterfaceNetwork NetworkRef `json:"interface_network"`
+	Name             string     `json:"name"`
+	Netmask          int64      `json:"netmask"`
+	Netmask6         int64      `json:"netmask6"`
+	// Resolved default value is false
+	Resolved bool `json:"resolved"`
+	// Resolved6 default value is false
+	Resolved6 bool `json:"resolved6"`
 	// Status default value is false
 	Status bool `json:"status"`
 	// Type can be one of: []string{"static"}
 	// Type default value is "static"
-	Type string `json:"type"`
+	Type ItfparamsSecondaryType `json:"type"`
+	// Type6 can be one of: []string{"static"}
+	// Type6 default value is "static"
+	Type6 ItfparamsSecondaryType6 `json:"type6"`
+}
+
+// ItfparamsSecondaryType is the Type of a ItfparamsSecondary
+type ItfparamsSecondaryType string
+
+// Known values of ItfparamsSecondaryType
+const (
+	ItfparamsSecondaryTypeStatic ItfparamsSecondaryType = "static"
+)
+
+// Valid returns true if the value is a known ItfparamsSecondaryType
+func (v ItfparamsSecondaryType) Valid() bool {
+	switch v {
+	case ItfparamsSecondaryTypeStatic:
+		return true
+	}
+	return false
+}
+
+// ItfparamsSecondaryType6 is the Type6 of a ItfparamsSecondary
+type ItfparamsSecondaryType6 string
+
+// Known values of ItfparamsSecondaryType6
+const (
+	ItfparamsSecondaryType6Static ItfparamsSecondaryType6 = "static"
+)
+
+// Valid returns true if the value is a known ItfparamsSecondaryType6
+func (v ItfparamsSecondaryType6) Valid() bool {
+	switch v {
+	case ItfparamsSecondaryType6Static:
+		return true
+	}
+	return false
+}
+
+// NewItfparamsSecondary returns a ItfparamsSecondary with the default values of its swagger definition
+func NewItfparamsSecondary() *ItfparamsSecondary {
+	return &ItfparamsSecondary{
+		ObjectType: "itfparams/secondary",
+		Address:    "0.0.0.0",
+		Type:       ItfparamsSecondaryTypeStatic,
+		Type6:      ItfparamsSecondaryType6Static,
+	}
+}
+
+// Validate checks the ItfparamsSecondary before it is sent, see ValidateWith
+func (i *ItfparamsSecondary) Validate() error { return i.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ItfparamsSecondary.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (i *ItfparamsSecondary) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ItfparamsSecondary", typeOf)
+	check.Format("address", sophos.FormatIPv4, i.Address)
+	check.Format("address6", sophos.FormatIPv6, i.Address6)
+	check.Ref("interface_address", string(i.InterfaceAddress), "REF(network/interface_address)")
+	check.Ref("interface_broadcast", string(i.InterfaceBroadcast), "REF(network/interface_broadcast)")
+	check.Ref("interface_network", string(i.InterfaceNetwork), "REF(network/interface_network)")
+	check.Required("name", i.Name)
+	check.Between("netmask", i.Netmask, 0, 32)
+	check.Between("netmask6", i.Netmask6, 0, 128)
+	check.Enum("type", string(i.Type), i.Type.Valid())
+	check.Enum("type6", string(i.Type6), i.Type6.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ItfparamsSecondary{}
 
-// GetPath implements sophos.RestObject and returns the ItfparamsSecondarys GET path
+// GetPath implements sophos.RestGetter and returns the ItfparamsSecondarys GET path
 // Returns all available itfparams/secondary objects
 func (*ItfparamsSecondarys) GetPath() string { return "/api/objects/itfparams/secondary/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ItfparamsSecondarys) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ItfparamsSecondarys GET path
+// GetPath implements sophos.RestGetter and returns the ItfparamsSecondarys GET path
 // Returns all available secondary types
 func (i *ItfparamsSecondary) GetPath() string {
 	return fmt.Sprintf("/api/objects/itfparams/secondary/%s", i.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (i *ItfparamsSecondary) RefRequired() (string, bool) { return i.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ItfparamsSecondary DELETE path
+// DeletePath implements sophos.Deletable and returns the ItfparamsSecondary DELETE path
 // Creates or updates the complete object secondary
 func (*ItfparamsSecondary) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/secondary/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ItfparamsSecondary PATCH path
+// PatchPath implements sophos.Patchable and returns the ItfparamsSecondary PATCH path
 // Changes to parts of the object secondary types
 func (*ItfparamsSecondary) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/secondary/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ItfparamsSecondary POST path
+// PostPath implements sophos.Creatable and returns the ItfparamsSecondary POST path
 // Create a new itfparams/secondary object
 func (*ItfparamsSecondary) PostPath() string {
 	return "/api/objects/itfparams/secondary/"
 }
 
-// PutPath implements sophos.RestObject and returns the ItfparamsSecondary PUT path
+// PutPath implements sophos.Updatable and returns the ItfparamsSecondary PUT path
 // Creates or updates the complete object secondary
 func (*ItfparamsSecondary) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/secondary/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ItfparamsSecondary) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/itfparams/secondary/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (i *ItfparamsSecondary) GetType() string { return i.ObjectType }
@@ -15,13 +15,13 @@ type MacList struct {
 
 var _ sophos.Endpoint = &MacList{}
 
-var defsMacList = map[string]sophos.RestObject{
+var defsMacList = map[string]sophos.RestGetter{
 	"MacListGroup":   &MacListGroup{},
 	"MacListMacList": &MacListMacList{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of MacList's Objects
-func (MacList) RestObjects() map[string]sophos.RestObject { return defsMacList }
+func (MacList) RestObjects() map[string]sophos.RestGetter { return defsMacList }
 
 // GetPath implements sophos.RestGetter
 func (*MacList) GetPath() string { return "/api/nodes/mac_list" }
@@ -55,7 +55,7 @@ func (MacList) References() []string {
 	}
 }
 
-// MacListGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// MacListGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type MacListGroups []MacListGroup
 
 // MacListGroup represents a UTM group
@@ -67,112 +67,154 @@ type MacListGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewMacListGroup returns a MacListGroup with the default values of its swagger definition
+func NewMacListGroup() *MacListGroup {
+	return &MacListGroup{
+		ObjectType: "mac_list/group",
+	}
+}
+
+// Validate checks the MacListGroup before it is sent, see ValidateWith
+func (m *MacListGroup) Validate() error { return m.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the MacListGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (m *MacListGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("MacListGroup", typeOf)
+	check.Required("name", m.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &MacListGroup{}
 
-// GetPath implements sophos.RestObject and returns the MacListGroups GET path
+// GetPath implements sophos.RestGetter and returns the MacListGroups GET path
 // Returns all available mac_list/group objects
 func (*MacListGroups) GetPath() string { return "/api/objects/mac_list/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*MacListGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the MacListGroups GET path
+// GetPath implements sophos.RestGetter and returns the MacListGroups GET path
 // Returns all available group types
 func (m *MacListGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/mac_list/group/%s", m.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (m *MacListGroup) RefRequired() (string, bool) { return m.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the MacListGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the MacListGroup DELETE path
 // Creates or updates the complete object group
 func (*MacListGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/mac_list/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the MacListGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the MacListGroup PATCH path
 // Changes to parts of the object group types
 func (*MacListGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/mac_list/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the MacListGroup POST path
+// PostPath implements sophos.Creatable and returns the MacListGroup POST path
 // Create a new mac_list/group object
 func (*MacListGroup) PostPath() string {
 	return "/api/objects/mac_list/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the MacListGroup PUT path
+// PutPath implements sophos.Updatable and returns the MacListGroup PUT path
 // Creates or updates the complete object group
 func (*MacListGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/mac_list/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*MacListGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/mac_list/group/%s/usedby", ref)
 }
 
-// MacListMacLists is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (m *MacListGroup) GetType() string { return m.ObjectType }
+
+// MacListMacLists is an Sophos Endpoint subType and implements sophos.RestGetter
 type MacListMacLists []MacListMacList
 
 // MacListMacList represents a UTM MAC address list
 type MacListMacList struct {
-	Locked      string        `json:"_locked"`
-	ObjectType  string        `json:"_type"`
-	Reference   string        `json:"_ref"`
-	AddressList []interface{} `json:"address_list"`
-	Comment     string        `json:"comment"`
-	HostList    []interface{} `json:"host_list"`
-	Name        string        `json:"name"`
+	Locked      string   `json:"_locked"`
+	ObjectType  string   `json:"_type"`
+	Reference   string   `json:"_ref"`
+	AddressList []string `json:"address_list"`
+	Comment     string   `json:"comment"`
+	HostList    []string `json:"host_list"`
+	Name        string   `json:"name"`
+}
+
+// NewMacListMacList returns a MacListMacList with the default values of its swagger definition
+func NewMacListMacList() *MacListMacList {
+	return &MacListMacList{
+		ObjectType: "mac_list/mac_list",
+	}
+}
+
+// Validate checks the MacListMacList before it is sent, see ValidateWith
+func (m *MacListMacList) Validate() error { return m.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the MacListMacList.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (m *MacListMacList) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("MacListMacList", typeOf)
+	check.Required("name", m.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &MacListMacList{}
 
-// GetPath implements sophos.RestObject and returns the MacListMacLists GET path
+// GetPath implements sophos.RestGetter and returns the MacListMacLists GET path
 // Returns all available mac_list/mac_list objects
 func (*MacListMacLists) GetPath() string { return "/api/objects/mac_list/mac_list/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*MacListMacLists) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the MacListMacLists GET path
+// GetPath implements sophos.RestGetter and returns the MacListMacLists GET path
 // Returns all available mac_list types
 func (m *MacListMacList) GetPath() string {
 	return fmt.Sprintf("/api/objects/mac_list/mac_list/%s", m.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (m *MacListMacList) RefRequired() (string, bool) { return m.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the MacListMacList DELETE path
+// DeletePath implements sophos.Deletable and returns the MacListMacList DELETE path
 // Creates or updates the complete object mac_list
 func (*MacListMacList) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/mac_list/mac_list/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the MacListMacList PATCH path
+// PatchPath implements sophos.Patchable and returns the MacListMacList PATCH path
 // Changes to parts of the object mac_list types
 func (*MacListMacList) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/mac_list/mac_list/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the MacListMacList POST path
+// PostPath implements sophos.Creatable and returns the MacListMacList POST path
 // Create a new mac_list/mac_list object
 func (*MacListMacList) PostPath() string {
 	return "/api/objects/mac_list/mac_list/"
 }
 
-// PutPath implements sophos.RestObject and returns the MacListMacList PUT path
+// PutPath implements sophos.Updatable and returns the MacListMacList PUT path
 // Creates or updates the complete object mac_list
 func (*MacListMacList) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/mac_list/mac_list/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*MacListMacList) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/mac_list/mac_list/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (m *MacListMacList) GetType() string { return m.ObjectType }
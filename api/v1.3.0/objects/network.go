@@ -1,7 +1,6 @@
 package objects
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/esurdam/go-sophos"
@@ -27,7 +26,7 @@ type Network struct {
 
 var _ sophos.Endpoint = &Network{}
 
-var defsNetwork = map[string]sophos.RestObject{
+var defsNetwork = map[string]sophos.RestGetter{
 	"NetworkAaa":                &NetworkAaa{},
 	"NetworkAny":                &NetworkAny{},
 	"NetworkAvailabilityGroup":  &NetworkAvailabilityGroup{},
@@ -44,7 +43,7 @@ var defsNetwork = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Network's Objects
-func (Network) RestObjects() map[string]sophos.RestObject { return defsNetwork }
+func (Network) RestObjects() map[string]sophos.RestGetter { return defsNetwork }
 
 // GetPath implements sophos.RestGetter
 func (*Network) GetPath() string { return "/api/nodes/network" }
@@ -122,63 +121,81 @@ func (Network) References() []string {
 	}
 }
 
-// NetworkAaas is an Sophos Endpoint subType and implements sophos.RestObject
+// NetworkAaas is an Sophos Endpoint subType and implements sophos.RestGetter
 type NetworkAaas []NetworkAaa
 
-// NetworkAaa is a generated Sophos object
+// NetworkAaa represents a UTM network/aaa object
 type NetworkAaa struct {
-	Locked     string        `json:"_locked"`
-	Reference  string        `json:"_ref"`
-	ObjectType string        `json:"_type"`
-	Addresses  []interface{} `json:"addresses"`
-	Addresses6 []interface{} `json:"addresses6"`
-	Comment    string        `json:"comment"`
-	Name       string        `json:"name"`
-	Resolved   bool          `json:"resolved"`
-	Resolved6  bool          `json:"resolved6"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Addresses  []string `json:"addresses"`
+	Addresses6 []string `json:"addresses6"`
+	Comment    string   `json:"comment"`
+	Name       string   `json:"name"`
+	Resolved   bool     `json:"resolved"`
+	Resolved6  bool     `json:"resolved6"`
+}
+
+// NewNetworkAaa returns a NetworkAaa with the default values of its swagger definition
+func NewNetworkAaa() *NetworkAaa {
+	return &NetworkAaa{
+		ObjectType: "network/aaa",
+	}
+}
+
+// Validate checks the NetworkAaa before it is sent, see ValidateWith
+func (n *NetworkAaa) Validate() error { return n.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the NetworkAaa.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (n *NetworkAaa) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("NetworkAaa", typeOf)
+	check.Required("name", n.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &NetworkAaa{}
 
-// GetPath implements sophos.RestObject and returns the NetworkAaas GET path
+// GetPath implements sophos.RestGetter and returns the NetworkAaas GET path
 // Returns all available network/aaa objects
 func (*NetworkAaas) GetPath() string { return "/api/objects/network/aaa/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*NetworkAaas) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the NetworkAaas GET path
+// GetPath implements sophos.RestGetter and returns the NetworkAaas GET path
 // Returns all available aaa types
 func (n *NetworkAaa) GetPath() string { return fmt.Sprintf("/api/objects/network/aaa/%s", n.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (n *NetworkAaa) RefRequired() (string, bool) { return n.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the NetworkAaa DELETE path
+// DeletePath implements sophos.Deletable and returns the NetworkAaa DELETE path
 // Creates or updates the complete object aaa
 func (*NetworkAaa) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/aaa/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the NetworkAaa PATCH path
+// PatchPath implements sophos.Patchable and returns the NetworkAaa PATCH path
 // Changes to parts of the object aaa types
 func (*NetworkAaa) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/aaa/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the NetworkAaa POST path
+// PostPath implements sophos.Creatable and returns the NetworkAaa POST path
 // Create a new network/aaa object
 func (*NetworkAaa) PostPath() string {
 	return "/api/objects/network/aaa/"
 }
 
-// PutPath implements sophos.RestObject and returns the NetworkAaa PUT path
+// PutPath implements sophos.Updatable and returns the NetworkAaa PUT path
 // Creates or updates the complete object aaa
 func (*NetworkAaa) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/aaa/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*NetworkAaa) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/aaa/%s/usedby", ref)
@@ -187,66 +204,89 @@ func (*NetworkAaa) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (n *NetworkAaa) GetType() string { return n.ObjectType }
 
-// NetworkAnys is an Sophos Endpoint subType and implements sophos.RestObject
+// NetworkAnys is an Sophos Endpoint subType and implements sophos.RestGetter
 type NetworkAnys []NetworkAny
 
-// NetworkAny is a generated Sophos object
+// NetworkAny represents a UTM network/any object
 type NetworkAny struct {
-	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
-	ObjectType string `json:"_type"`
-	Address    string `json:"address"`
-	Address6   string `json:"address6"`
-	Comment    string `json:"comment"`
-	Interface  string `json:"interface"`
-	Name       string `json:"name"`
-	Netmask    int64  `json:"netmask"`
-	Netmask6   int64  `json:"netmask6"`
-	Resolved   bool   `json:"resolved"`
-	Resolved6  bool   `json:"resolved6"`
+	Locked     string       `json:"_locked"`
+	ObjectType string       `json:"_type"`
+	Reference  string       `json:"_ref"`
+	Address    string       `json:"address"`
+	Address6   string       `json:"address6"`
+	Comment    string       `json:"comment"`
+	Interface  InterfaceRef `json:"interface"`
+	Name       string       `json:"name"`
+	Netmask    int64        `json:"netmask"`
+	Netmask6   int64        `json:"netmask6"`
+	Resolved   bool         `json:"resolved"`
+	Resolved6  bool         `json:"resolved6"`
+}
+
+// NewNetworkAny returns a NetworkAny with the default values of its swagger definition
+func NewNetworkAny() *NetworkAny {
+	return &NetworkAny{
+		ObjectType: "network/any",
+	}
+}
+
+// Validate checks the NetworkAny before it is sent, see ValidateWith
+func (n *NetworkAny) Validate() error { return n.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the NetworkAny.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (n *NetworkAny) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("NetworkAny", typeOf)
+	check.Format("address", sophos.FormatIPv4, n.Address)
+	check.Format("address6", sophos.FormatIPv6, n.Address6)
+	check.Ref("interface", string(n.Interface), "REF(interface/*)")
+	check.Required("name", n.Name)
+	check.Between("netmask", n.Netmask, 0, 32)
+	check.Between("netmask6", n.Netmask6, 0, 128)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &NetworkAny{}
 
-// GetPath implements sophos.RestObject and returns the NetworkAnys GET path
+// GetPath implements sophos.RestGetter and returns the NetworkAnys GET path
 // Returns all available network/any objects
 func (*NetworkAnys) GetPath() string { return "/api/objects/network/any/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*NetworkAnys) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the NetworkAnys GET path
+// GetPath implements sophos.RestGetter and returns the NetworkAnys GET path
 // Returns all available any types
 func (n *NetworkAny) GetPath() string { return fmt.Sprintf("/api/objects/network/any/%s", n.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (n *NetworkAny) RefRequired() (string, bool) { return n.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the NetworkAny DELETE path
+// DeletePath implements sophos.Deletable and returns the NetworkAny DELETE path
 // Creates or updates the complete object any
 func (*NetworkAny) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/any/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the NetworkAny PATCH path
+// PatchPath implements sophos.Patchable and returns the NetworkAny PATCH path
 // Changes to parts of the object any types
 func (*NetworkAny) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/any/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the NetworkAny POST path
+// PostPath implements sophos.Creatable and returns the NetworkAny POST path
 // Create a new network/any object
 func (*NetworkAny) PostPath() string {
 	return "/api/objects/network/any/"
 }
 
-// PutPath implements sophos.RestObject and returns the NetworkAny PUT path
+// PutPath implements sophos.Updatable and returns the NetworkAny PUT path
 // Creates or updates the complete object any
 func (*NetworkAny) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/any/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*NetworkAny) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/any/%s/usedby", ref)
@@ -255,7 +295,7 @@ func (*NetworkAny) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (n *NetworkAny) GetType() string { return n.ObjectType }
 
-// NetworkAvailabilityGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// NetworkAvailabilityGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type NetworkAvailabilityGroups []NetworkAvailabilityGroup
 
 // NetworkAvailabilityGroup represents a UTM availability group
@@ -263,144 +303,215 @@ type NetworkAvailabilityGroup struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// CheckType can be one of: []string{"icmp", "udp", "tcp", "http", "https"}
-	// CheckType default value is "icmp"
-	CheckType string `json:"check_type"`
-	Comment   string `json:"comment"`
 	// Address description: (IPADDR)
 	// Address default value is "0.0.0.0"
 	Address string `json:"address"`
 	// Address6 description: (IP6ADDR)
 	// Address6 default value is "::"
 	Address6 string `json:"address6"`
-	// Resolved default value is false
-	Resolved bool          `json:"resolved"`
-	Timeout2 int           `json:"timeout2"`
-	Members  []interface{} `json:"members"`
-	// Sticky default value is true
-	Sticky bool `json:"sticky"`
 	// CheckData default value is ""
 	CheckData string `json:"check_data"`
+	CheckPort int64  `json:"check_port"`
+	// CheckType can be one of: []string{"icmp", "udp", "tcp", "http", "https"}
+	// CheckType default value is "icmp"
+	CheckType NetworkAvailabilityGroupCheckType `json:"check_type"`
+	Comment   string                            `json:"comment"`
 	// Interface description: REF(interface/*)
 	// Interface default value is ""
-	Interface string `json:"interface"`
+	Interface InterfaceRef `json:"interface"`
+	Members   []string     `json:"members"`
+	Name      string       `json:"name"`
+	// Resolved default value is false
+	Resolved bool `json:"resolved"`
 	// Resolved6 default value is false
-	Resolved6 bool   `json:"resolved6"`
-	Timeout   int    `json:"timeout"`
-	CheckPort int    `json:"check_port"`
-	Name      string `json:"name"`
+	Resolved6 bool `json:"resolved6"`
+	// Sticky default value is true
+	Sticky   bool  `json:"sticky"`
+	Timeout  int64 `json:"timeout"`
+	Timeout2 int64 `json:"timeout2"`
+}
+
+// NetworkAvailabilityGroupCheckType is the CheckType of a NetworkAvailabilityGroup
+type NetworkAvailabilityGroupCheckType string
+
+// Known values of NetworkAvailabilityGroupCheckType
+const (
+	NetworkAvailabilityGroupCheckTypeIcmp  NetworkAvailabilityGroupCheckType = "icmp"
+	NetworkAvailabilityGroupCheckTypeUdp   NetworkAvailabilityGroupCheckType = "udp"
+	NetworkAvailabilityGroupCheckTypeTcp   NetworkAvailabilityGroupCheckType = "tcp"
+	NetworkAvailabilityGroupCheckTypeHttp  NetworkAvailabilityGroupCheckType = "http"
+	NetworkAvailabilityGroupCheckTypeHttps NetworkAvailabilityGroupCheckType = "https"
+)
+
+// Valid returns true if the value is a known NetworkAvailabilityGroupCheckType
+func (v NetworkAvailabilityGroupCheckType) Valid() bool {
+	switch v {
+	case NetworkAvailabilityGroupCheckTypeIcmp, NetworkAvailabilityGroupCheckTypeUdp, NetworkAvailabilityGroupCheckTypeTcp, NetworkAvailabilityGroupCheckTypeHttp, NetworkAvailabilityGroupCheckTypeHttps:
+		return true
+	}
+	return false
+}
+
+// NewNetworkAvailabilityGroup returns a NetworkAvailabilityGroup with the default values of its swagger definition
+func NewNetworkAvailabilityGroup() *NetworkAvailabilityGroup {
+	return &NetworkAvailabilityGroup{
+		ObjectType: "network/availability_group",
+		Address:    "0.0.0.0",
+		Address6:   "::",
+		CheckType:  NetworkAvailabilityGroupCheckTypeIcmp,
+		Sticky:     true,
+	}
+}
+
+// Validate checks the NetworkAvailabilityGroup before it is sent, see ValidateWith
+func (n *NetworkAvailabilityGroup) Validate() error { return n.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the NetworkAvailabilityGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (n *NetworkAvailabilityGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("NetworkAvailabilityGroup", typeOf)
+	check.Format("address", sophos.FormatIPv4, n.Address)
+	check.Format("address6", sophos.FormatIPv6, n.Address6)
+	check.Range("check_port", n.CheckPort, "0-65535")
+	check.Enum("check_type", string(n.CheckType), n.CheckType.Valid())
+	check.Ref("interface", string(n.Interface), "REF(interface/*)")
+	check.Required("name", n.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &NetworkAvailabilityGroup{}
 
-// GetPath implements sophos.RestObject and returns the NetworkAvailabilityGroups GET path
+// GetPath implements sophos.RestGetter and returns the NetworkAvailabilityGroups GET path
 // Returns all available network/availability_group objects
 func (*NetworkAvailabilityGroups) GetPath() string { return "/api/objects/network/availability_group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*NetworkAvailabilityGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the NetworkAvailabilityGroups GET path
+// GetPath implements sophos.RestGetter and returns the NetworkAvailabilityGroups GET path
 // Returns all available availability_group types
 func (n *NetworkAvailabilityGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/network/availability_group/%s", n.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (n *NetworkAvailabilityGroup) RefRequired() (string, bool) { return n.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the NetworkAvailabilityGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the NetworkAvailabilityGroup DELETE path
 // Creates or updates the complete object availability_group
 func (*NetworkAvailabilityGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/availability_group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the NetworkAvailabilityGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the NetworkAvailabilityGroup PATCH path
 // Changes to parts of the object availability_group types
 func (*NetworkAvailabilityGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/availability_group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the NetworkAvailabilityGroup POST path
+// PostPath implements sophos.Creatable and returns the NetworkAvailabilityGroup POST path
 // Create a new network/availability_group object
 func (*NetworkAvailabilityGroup) PostPath() string {
 	return "/api/objects/network/availability_group/"
 }
 
-// PutPath implements sophos.RestObject and returns the NetworkAvailabilityGroup PUT path
+// PutPath implements sophos.Updatable and returns the NetworkAvailabilityGroup PUT path
 // Creates or updates the complete object availability_group
 func (*NetworkAvailabilityGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/availability_group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*NetworkAvailabilityGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/availability_group/%s/usedby", ref)
 }
 
-// NetworkDnsGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (n *NetworkAvailabilityGroup) GetType() string { return n.ObjectType }
+
+// NetworkDnsGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type NetworkDnsGroups []NetworkDnsGroup
 
-// NetworkDnsGroup is a generated Sophos object
+// NetworkDnsGroup represents a UTM network/dns_group object
 type NetworkDnsGroup struct {
-	Locked     string        `json:"_locked"`
-	Reference  string        `json:"_ref"`
-	ObjectType string        `json:"_type"`
-	Addresses  []string      `json:"addresses"`
-	Addresses6 []interface{} `json:"addresses6"`
-	Comment    string        `json:"comment"`
-	Hostname   string        `json:"hostname"`
-	Interface  string        `json:"interface"`
-	Name       string        `json:"name"`
-	Resolved   bool          `json:"resolved"`
-	Resolved6  bool          `json:"resolved6"`
-	Timeout    int64         `json:"timeout"`
+	Locked     string       `json:"_locked"`
+	ObjectType string       `json:"_type"`
+	Reference  string       `json:"_ref"`
+	Addresses  []string     `json:"addresses"`
+	Addresses6 []string     `json:"addresses6"`
+	Comment    string       `json:"comment"`
+	Hostname   string       `json:"hostname"`
+	Interface  InterfaceRef `json:"interface"`
+	Name       string       `json:"name"`
+	Resolved   bool         `json:"resolved"`
+	Resolved6  bool         `json:"resolved6"`
+	Timeout    int64        `json:"timeout"`
+}
+
+// NewNetworkDnsGroup returns a NetworkDnsGroup with the default values of its swagger definition
+func NewNetworkDnsGroup() *NetworkDnsGroup {
+	return &NetworkDnsGroup{
+		ObjectType: "network/dns_group",
+	}
+}
+
+// Validate checks the NetworkDnsGroup before it is sent, see ValidateWith
+func (n *NetworkDnsGroup) Validate() error { return n.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the NetworkDnsGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (n *NetworkDnsGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("NetworkDnsGroup", typeOf)
+	check.Format("hostname", sophos.FormatHostname, n.Hostname)
+	check.Ref("interface", string(n.Interface), "REF(interface/*)")
+	check.Required("name", n.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &NetworkDnsGroup{}
 
-// GetPath implements sophos.RestObject and returns the NetworkDnsGroups GET path
+// GetPath implements sophos.RestGetter and returns the NetworkDnsGroups GET path
 // Returns all available network/dns_group objects
 func (*NetworkDnsGroups) GetPath() string { return "/api/objects/network/dns_group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*NetworkDnsGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the NetworkDnsGroups GET path
+// GetPath implements sophos.RestGetter and returns the NetworkDnsGroups GET path
 // Returns all available dns_group types
 func (n *NetworkDnsGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/network/dns_group/%s", n.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (n *NetworkDnsGroup) RefRequired() (string, bool) { return n.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the NetworkDnsGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the NetworkDnsGroup DELETE path
 // Creates or updates the complete object dns_group
 func (*NetworkDnsGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/dns_group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the NetworkDnsGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the NetworkDnsGroup PATCH path
 // Changes to parts of the object dns_group types
 func (*NetworkDnsGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/dns_group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the NetworkDnsGroup POST path
+// PostPath implements sophos.Creatable and returns the NetworkDnsGroup POST path
 // Create a new network/dns_group object
 func (*NetworkDnsGroup) PostPath() string {
 	return "/api/objects/network/dns_group/"
 }
 
-// PutPath implements sophos.RestObject and returns the NetworkDnsGroup PUT path
+// PutPath implements sophos.Updatable and returns the NetworkDnsGroup PUT path
 // Creates or updates the complete object dns_group
 func (*NetworkDnsGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/dns_group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*NetworkDnsGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/dns_group/%s/usedby", ref)
@@ -409,68 +520,90 @@ func (*NetworkDnsGroup) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (n *NetworkDnsGroup) GetType() string { return n.ObjectType }
 
-// NetworkDnsHosts is an Sophos Endpoint subType and implements sophos.RestObject
+// NetworkDnsHosts is an Sophos Endpoint subType and implements sophos.RestGetter
 type NetworkDnsHosts []NetworkDnsHost
 
-// NetworkDnsHost is a generated Sophos object
+// NetworkDnsHost represents a UTM network/dns_host object
 type NetworkDnsHost struct {
-	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
-	ObjectType string `json:"_type"`
-	Address    string `json:"address"`
-	Address6   string `json:"address6"`
-	Comment    string `json:"comment"`
-	Hostname   string `json:"hostname"`
-	Interface  string `json:"interface"`
-	Name       string `json:"name"`
-	Resolved   bool   `json:"resolved"`
-	Resolved6  bool   `json:"resolved6"`
-	Timeout    int64  `json:"timeout"`
+	Locked     string       `json:"_locked"`
+	ObjectType string       `json:"_type"`
+	Reference  string       `json:"_ref"`
+	Address    string       `json:"address"`
+	Address6   string       `json:"address6"`
+	Comment    string       `json:"comment"`
+	Hostname   string       `json:"hostname"`
+	Interface  InterfaceRef `json:"interface"`
+	Name       string       `json:"name"`
+	Resolved   bool         `json:"resolved"`
+	Resolved6  bool         `json:"resolved6"`
+	Timeout    int64        `json:"timeout"`
+}
+
+// NewNetworkDnsHost returns a NetworkDnsHost with the default values of its swagger definition
+func NewNetworkDnsHost() *NetworkDnsHost {
+	return &NetworkDnsHost{
+		ObjectType: "network/dns_host",
+	}
+}
+
+// Validate checks the NetworkDnsHost before it is sent, see ValidateWith
+func (n *NetworkDnsHost) Validate() error { return n.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the NetworkDnsHost.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (n *NetworkDnsHost) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("NetworkDnsHost", typeOf)
+	check.Format("address", sophos.FormatIPv4, n.Address)
+	check.Format("address6", sophos.FormatIPv6, n.Address6)
+	check.Format("hostname", sophos.FormatHostname, n.Hostname)
+	check.Ref("interface", string(n.Interface), "REF(interface/*)")
+	check.Required("name", n.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &NetworkDnsHost{}
 
-// GetPath implements sophos.RestObject and returns the NetworkDnsHosts GET path
+// GetPath implements sophos.RestGetter and returns the NetworkDnsHosts GET path
 // Returns all available network/dns_host objects
 func (*NetworkDnsHosts) GetPath() string { return "/api/objects/network/dns_host/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*NetworkDnsHosts) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the NetworkDnsHosts GET path
+// GetPath implements sophos.RestGetter and returns the NetworkDnsHosts GET path
 // Returns all available dns_host types
 func (n *NetworkDnsHost) GetPath() string {
 	return fmt.Sprintf("/api/objects/network/dns_host/%s", n.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (n *NetworkDnsHost) RefRequired() (string, bool) { return n.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the NetworkDnsHost DELETE path
+// DeletePath implements sophos.Deletable and returns the NetworkDnsHost DELETE path
 // Creates or updates the complete object dns_host
 func (*NetworkDnsHost) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/dns_host/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the NetworkDnsHost PATCH path
+// PatchPath implements sophos.Patchable and returns the NetworkDnsHost PATCH path
 // Changes to parts of the object dns_host types
 func (*NetworkDnsHost) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/dns_host/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the NetworkDnsHost POST path
+// PostPath implements sophos.Creatable and returns the NetworkDnsHost POST path
 // Create a new network/dns_host object
 func (*NetworkDnsHost) PostPath() string {
 	return "/api/objects/network/dns_host/"
 }
 
-// PutPath implements sophos.RestObject and returns the NetworkDnsHost PUT path
+// PutPath implements sophos.Updatable and returns the NetworkDnsHost PUT path
 // Creates or updates the complete object dns_host
 func (*NetworkDnsHost) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/dns_host/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*NetworkDnsHost) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/dns_host/%s/usedby", ref)
@@ -479,63 +612,81 @@ func (*NetworkDnsHost) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (n *NetworkDnsHost) GetType() string { return n.ObjectType }
 
-// NetworkGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// NetworkGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type NetworkGroups []NetworkGroup
 
-// NetworkGroup is a generated Sophos object
+// NetworkGroup represents a UTM network/group object
 type NetworkGroup struct {
 	Locked     string   `json:"_locked"`
-	Reference  string   `json:"_ref"`
 	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
 	Comment    string   `json:"comment"`
 	Members    []string `json:"members"`
 	Name       string   `json:"name"`
 	Types      []string `json:"types"`
 }
 
+// NewNetworkGroup returns a NetworkGroup with the default values of its swagger definition
+func NewNetworkGroup() *NetworkGroup {
+	return &NetworkGroup{
+		ObjectType: "network/group",
+	}
+}
+
+// Validate checks the NetworkGroup before it is sent, see ValidateWith
+func (n *NetworkGroup) Validate() error { return n.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the NetworkGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (n *NetworkGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("NetworkGroup", typeOf)
+	check.Required("name", n.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &NetworkGroup{}
 
-// GetPath implements sophos.RestObject and returns the NetworkGroups GET path
+// GetPath implements sophos.RestGetter and returns the NetworkGroups GET path
 // Returns all available network/group objects
 func (*NetworkGroups) GetPath() string { return "/api/objects/network/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*NetworkGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the NetworkGroups GET path
+// GetPath implements sophos.RestGetter and returns the NetworkGroups GET path
 // Returns all available group types
 func (n *NetworkGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/network/group/%s", n.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (n *NetworkGroup) RefRequired() (string, bool) { return n.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the NetworkGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the NetworkGroup DELETE path
 // Creates or updates the complete object group
 func (*NetworkGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the NetworkGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the NetworkGroup PATCH path
 // Changes to parts of the object group types
 func (*NetworkGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the NetworkGroup POST path
+// PostPath implements sophos.Creatable and returns the NetworkGroup POST path
 // Create a new network/group object
 func (*NetworkGroup) PostPath() string {
 	return "/api/objects/network/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the NetworkGroup PUT path
+// PutPath implements sophos.Updatable and returns the NetworkGroup PUT path
 // Creates or updates the complete object group
 func (*NetworkGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*NetworkGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/group/%s/usedby", ref)
@@ -544,70 +695,93 @@ func (*NetworkGroup) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (n *NetworkGroup) GetType() string { return n.ObjectType }
 
-// NetworkHosts is an Sophos Endpoint subType and implements sophos.RestObject
+// NetworkHosts is an Sophos Endpoint subType and implements sophos.RestGetter
 type NetworkHosts []NetworkHost
 
-// NetworkHost is a generated Sophos object
+// NetworkHost represents a UTM network/host object
 type NetworkHost struct {
-	Locked     string        `json:"_locked"`
-	Reference  string        `json:"_ref"`
-	ObjectType string        `json:"_type"`
-	Address    string        `json:"address"`
-	Address6   string        `json:"address6"`
-	Comment    string        `json:"comment"`
-	Duids      []interface{} `json:"duids"`
-	Hostnames  []string      `json:"hostnames"`
-	Interface  string        `json:"interface"`
-	Macs       []interface{} `json:"macs"`
-	Name       string        `json:"name"`
-	Resolved   bool          `json:"resolved"`
-	Resolved6  bool          `json:"resolved6"`
-	ReverseDNS bool          `json:"reverse_dns"`
+	Locked     string       `json:"_locked"`
+	ObjectType string       `json:"_type"`
+	Reference  string       `json:"_ref"`
+	Address    string       `json:"address"`
+	Address6   string       `json:"address6"`
+	Comment    string       `json:"comment"`
+	Duids      []string     `json:"duids"`
+	Hostnames  []string     `json:"hostnames"`
+	Interface  InterfaceRef `json:"interface"`
+	Macs       []string     `json:"macs"`
+	Name       string       `json:"name"`
+	Resolved   bool         `json:"resolved"`
+	Resolved6  bool         `json:"resolved6"`
+	ReverseDNS bool         `json:"reverse_dns"`
+}
+
+// NewNetworkHost returns a NetworkHost with the default values of its swagger definition
+func NewNetworkHost() *NetworkHost {
+	return &NetworkHost{
+		ObjectType: "network/host",
+	}
+}
+
+// Validate checks the NetworkHost before it is sent, see ValidateWith
+func (n *NetworkHost) Validate() error { return n.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the NetworkHost.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (n *NetworkHost) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("NetworkHost", typeOf)
+	check.Format("address", sophos.FormatIPv4, n.Address)
+	check.Format("address6", sophos.FormatIPv6, n.Address6)
+	check.Formats("hostnames", sophos.FormatHostname, n.Hostnames)
+	check.Ref("interface", string(n.Interface), "REF(interface/*)")
+	check.Formats("macs", sophos.FormatMAC, n.Macs)
+	check.Required("name", n.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &NetworkHost{}
 
-// GetPath implements sophos.RestObject and returns the NetworkHosts GET path
+// GetPath implements sophos.RestGetter and returns the NetworkHosts GET path
 // Returns all available network/host objects
 func (*NetworkHosts) GetPath() string { return "/api/objects/network/host/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*NetworkHosts) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the NetworkHosts GET path
+// GetPath implements sophos.RestGetter and returns the NetworkHosts GET path
 // Returns all available host types
 func (n *NetworkHost) GetPath() string {
 	return fmt.Sprintf("/api/objects/network/host/%s", n.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (n *NetworkHost) RefRequired() (string, bool) { return n.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the NetworkHost DELETE path
+// DeletePath implements sophos.Deletable and returns the NetworkHost DELETE path
 // Creates or updates the complete object host
 func (*NetworkHost) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/host/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the NetworkHost PATCH path
+// PatchPath implements sophos.Patchable and returns the NetworkHost PATCH path
 // Changes to parts of the object host types
 func (*NetworkHost) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/host/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the NetworkHost POST path
+// PostPath implements sophos.Creatable and returns the NetworkHost POST path
 // Create a new network/host object
 func (*NetworkHost) PostPath() string {
 	return "/api/objects/network/host/"
 }
 
-// PutPath implements sophos.RestObject and returns the NetworkHost PUT path
+// PutPath implements sophos.Updatable and returns the NetworkHost PUT path
 // Creates or updates the complete object host
 func (*NetworkHost) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/host/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*NetworkHost) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/host/%s/usedby", ref)
@@ -616,14 +790,14 @@ func (*NetworkHost) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (n *NetworkHost) GetType() string { return n.ObjectType }
 
-// NetworkInterfaceAddresss is an Sophos Endpoint subType and implements sophos.RestObject
+// NetworkInterfaceAddresss is an Sophos Endpoint subType and implements sophos.RestGetter
 type NetworkInterfaceAddresss []NetworkInterfaceAddress
 
-// NetworkInterfaceAddress is a generated Sophos object
+// NetworkInterfaceAddress represents a UTM network/interface_address object
 type NetworkInterfaceAddress struct {
 	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
 	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	Address    string `json:"address"`
 	Address6   string `json:"address6"`
 	Comment    string `json:"comment"`
@@ -632,49 +806,69 @@ type NetworkInterfaceAddress struct {
 	Resolved6  bool   `json:"resolved6"`
 }
 
+// NewNetworkInterfaceAddress returns a NetworkInterfaceAddress with the default values of its swagger definition
+func NewNetworkInterfaceAddress() *NetworkInterfaceAddress {
+	return &NetworkInterfaceAddress{
+		ObjectType: "network/interface_address",
+	}
+}
+
+// Validate checks the NetworkInterfaceAddress before it is sent, see ValidateWith
+func (n *NetworkInterfaceAddress) Validate() error { return n.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the NetworkInterfaceAddress.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (n *NetworkInterfaceAddress) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("NetworkInterfaceAddress", typeOf)
+	check.Format("address", sophos.FormatIPv4, n.Address)
+	check.Format("address6", sophos.FormatIPv6, n.Address6)
+	check.Required("name", n.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &NetworkInterfaceAddress{}
 
-// GetPath implements sophos.RestObject and returns the NetworkInterfaceAddresss GET path
+// GetPath implements sophos.RestGetter and returns the NetworkInterfaceAddresss GET path
 // Returns all available network/interface_address objects
 func (*NetworkInterfaceAddresss) GetPath() string { return "/api/objects/network/interface_address/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*NetworkInterfaceAddresss) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the NetworkInterfaceAddresss GET path
+// GetPath implements sophos.RestGetter and returns the NetworkInterfaceAddresss GET path
 // Returns all available interface_address types
 func (n *NetworkInterfaceAddress) GetPath() string {
 	return fmt.Sprintf("/api/objects/network/interface_address/%s", n.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (n *NetworkInterfaceAddress) RefRequired() (string, bool) { return n.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the NetworkInterfaceAddress DELETE path
+// DeletePath implements sophos.Deletable and returns the NetworkInterfaceAddress DELETE path
 // Creates or updates the complete object interface_address
 func (*NetworkInterfaceAddress) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/interface_address/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the NetworkInterfaceAddress PATCH path
+// PatchPath implements sophos.Patchable and returns the NetworkInterfaceAddress PATCH path
 // Changes to parts of the object interface_address types
 func (*NetworkInterfaceAddress) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/interface_address/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the NetworkInterfaceAddress POST path
+// PostPath implements sophos.Creatable and returns the NetworkInterfaceAddress POST path
 // Create a new network/interface_address object
 func (*NetworkInterfaceAddress) PostPath() string {
 	return "/api/objects/network/interface_address/"
 }
 
-// PutPath implements sophos.RestObject and returns the NetworkInterfaceAddress PUT path
+// PutPath implements sophos.Updatable and returns the NetworkInterfaceAddress PUT path
 // Creates or updates the complete object interface_address
 func (*NetworkInterfaceAddress) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/interface_address/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*NetworkInterfaceAddress) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/interface_address/%s/usedby", ref)
@@ -683,65 +877,85 @@ func (*NetworkInterfaceAddress) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (n *NetworkInterfaceAddress) GetType() string { return n.ObjectType }
 
-// NetworkInterfaceBroadcasts is an Sophos Endpoint subType and implements sophos.RestObject
+// NetworkInterfaceBroadcasts is an Sophos Endpoint subType and implements sophos.RestGetter
 type NetworkInterfaceBroadcasts []NetworkInterfaceBroadcast
 
-// NetworkInterfaceBroadcast is a generated Sophos object
+// NetworkInterfaceBroadcast represents a UTM network/interface_broadcast object
 type NetworkInterfaceBroadcast struct {
 	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
 	ObjectType string `json:"_type"`
-	Address    string `json:"address"`
-	Comment    string `json:"comment"`
-	Name       string `json:"name"`
-	Resolved   bool   `json:"resolved"`
+	Reference  string `json:"_ref"`
+	// Address description: (IPADDR)
+	Address  string `json:"address"`
+	Comment  string `json:"comment"`
+	Name     string `json:"name"`
+	Resolved bool   `json:"resolved"`
+}
+
+// NewNetworkInterfaceBroadcast returns a NetworkInterfaceBroadcast with the default values of its swagger definition
+func NewNetworkInterfaceBroadcast() *NetworkInterfaceBroadcast {
+	return &NetworkInterfaceBroadcast{
+		ObjectType: "network/interface_broadcast",
+	}
+}
+
+// Validate checks the NetworkInterfaceBroadcast before it is sent, see ValidateWith
+func (n *NetworkInterfaceBroadcast) Validate() error { return n.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the NetworkInterfaceBroadcast.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (n *NetworkInterfaceBroadcast) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("NetworkInterfaceBroadcast", typeOf)
+	check.Format("address", sophos.FormatIPv4, n.Address)
+	check.Required("name", n.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &NetworkInterfaceBroadcast{}
 
-// GetPath implements sophos.RestObject and returns the NetworkInterfaceBroadcasts GET path
+// GetPath implements sophos.RestGetter and returns the NetworkInterfaceBroadcasts GET path
 // Returns all available network/interface_broadcast objects
 func (*NetworkInterfaceBroadcasts) GetPath() string {
 	return "/api/objects/network/interface_broadcast/"
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*NetworkInterfaceBroadcasts) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the NetworkInterfaceBroadcasts GET path
+// GetPath implements sophos.RestGetter and returns the NetworkInterfaceBroadcasts GET path
 // Returns all available interface_broadcast types
 func (n *NetworkInterfaceBroadcast) GetPath() string {
 	return fmt.Sprintf("/api/objects/network/interface_broadcast/%s", n.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (n *NetworkInterfaceBroadcast) RefRequired() (string, bool) { return n.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the NetworkInterfaceBroadcast DELETE path
+// DeletePath implements sophos.Deletable and returns the NetworkInterfaceBroadcast DELETE path
 // Creates or updates the complete object interface_broadcast
 func (*NetworkInterfaceBroadcast) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/interface_broadcast/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the NetworkInterfaceBroadcast PATCH path
+// PatchPath implements sophos.Patchable and returns the NetworkInterfaceBroadcast PATCH path
 // Changes to parts of the object interface_broadcast types
 func (*NetworkInterfaceBroadcast) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/interface_broadcast/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the NetworkInterfaceBroadcast POST path
+// PostPath implements sophos.Creatable and returns the NetworkInterfaceBroadcast POST path
 // Create a new network/interface_broadcast object
 func (*NetworkInterfaceBroadcast) PostPath() string {
 	return "/api/objects/network/interface_broadcast/"
 }
 
-// PutPath implements sophos.RestObject and returns the NetworkInterfaceBroadcast PUT path
+// PutPath implements sophos.Updatable and returns the NetworkInterfaceBroadcast PUT path
 // Creates or updates the complete object interface_broadcast
 func (*NetworkInterfaceBroadcast) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/interface_broadcast/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*NetworkInterfaceBroadcast) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/interface_broadcast/%s/usedby", ref)
@@ -750,14 +964,14 @@ func (*NetworkInterfaceBroadcast) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (n *NetworkInterfaceBroadcast) GetType() string { return n.ObjectType }
 
-// NetworkInterfaceNetworks is an Sophos Endpoint subType and implements sophos.RestObject
+// NetworkInterfaceNetworks is an Sophos Endpoint subType and implements sophos.RestGetter
 type NetworkInterfaceNetworks []NetworkInterfaceNetwork
 
-// NetworkInterfaceNetwork is a generated Sophos object
+// NetworkInterfaceNetwork represents a UTM network/interface_network object
 type NetworkInterfaceNetwork struct {
 	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
 	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	Address    string `json:"address"`
 	Address6   string `json:"address6"`
 	Comment    string `json:"comment"`
@@ -768,49 +982,71 @@ type NetworkInterfaceNetwork struct {
 	Resolved6  bool   `json:"resolved6"`
 }
 
+// NewNetworkInterfaceNetwork returns a NetworkInterfaceNetwork with the default values of its swagger definition
+func NewNetworkInterfaceNetwork() *NetworkInterfaceNetwork {
+	return &NetworkInterfaceNetwork{
+		ObjectType: "network/interface_network",
+	}
+}
+
+// Validate checks the NetworkInterfaceNetwork before it is sent, see ValidateWith
+func (n *NetworkInterfaceNetwork) Validate() error { return n.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the NetworkInterfaceNetwork.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (n *NetworkInterfaceNetwork) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("NetworkInterfaceNetwork", typeOf)
+	check.Format("address", sophos.FormatIPv4, n.Address)
+	check.Format("address6", sophos.FormatIPv6, n.Address6)
+	check.Required("name", n.Name)
+	check.Between("netmask", n.Netmask, 0, 32)
+	check.Between("netmask6", n.Netmask6, 0, 128)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &NetworkInterfaceNetwork{}
 
-// GetPath implements sophos.RestObject and returns the NetworkInterfaceNetworks GET path
+// GetPath implements sophos.RestGetter and returns the NetworkInterfaceNetworks GET path
 // Returns all available network/interface_network objects
 func (*NetworkInterfaceNetworks) GetPath() string { return "/api/objects/network/interface_network/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*NetworkInterfaceNetworks) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the NetworkInterfaceNetworks GET path
+// GetPath implements sophos.RestGetter and returns the NetworkInterfaceNetworks GET path
 // Returns all available interface_network types
 func (n *NetworkInterfaceNetwork) GetPath() string {
 	return fmt.Sprintf("/api/objects/network/interface_network/%s", n.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (n *NetworkInterfaceNetwork) RefRequired() (string, bool) { return n.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the NetworkInterfaceNetwork DELETE path
+// DeletePath implements sophos.Deletable and returns the NetworkInterfaceNetwork DELETE path
 // Creates or updates the complete object interface_network
 func (*NetworkInterfaceNetwork) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/interface_network/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the NetworkInterfaceNetwork PATCH path
+// PatchPath implements sophos.Patchable and returns the NetworkInterfaceNetwork PATCH path
 // Changes to parts of the object interface_network types
 func (*NetworkInterfaceNetwork) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/interface_network/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the NetworkInterfaceNetwork POST path
+// PostPath implements sophos.Creatable and returns the NetworkInterfaceNetwork POST path
 // Create a new network/interface_network object
 func (*NetworkInterfaceNetwork) PostPath() string {
 	return "/api/objects/network/interface_network/"
 }
 
-// PutPath implements sophos.RestObject and returns the NetworkInterfaceNetwork PUT path
+// PutPath implements sophos.Updatable and returns the NetworkInterfaceNetwork PUT path
 // Creates or updates the complete object interface_network
 func (*NetworkInterfaceNetwork) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/interface_network/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*NetworkInterfaceNetwork) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/interface_network/%s/usedby", ref)
@@ -819,7 +1055,7 @@ func (*NetworkInterfaceNetwork) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (n *NetworkInterfaceNetwork) GetType() string { return n.ObjectType }
 
-// NetworkMulticasts is an Sophos Endpoint subType and implements sophos.RestObject
+// NetworkMulticasts is an Sophos Endpoint subType and implements sophos.RestGetter
 type NetworkMulticasts []NetworkMulticast
 
 // NetworkMulticast represents a UTM multicast group
@@ -827,128 +1063,176 @@ type NetworkMulticast struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Name       string `json:"name"`
-	Netmask    int    `json:"netmask"`
-	// Resolved default value is true
-	Resolved bool `json:"resolved"`
 	// Address description: (IPADDR)
 	Address string `json:"address"`
 	Comment string `json:"comment"`
 	// Interface description: REF(interface/*)
 	// Interface default value is ""
-	Interface string `json:"interface"`
+	Interface InterfaceRef `json:"interface"`
+	Name      string       `json:"name"`
+	Netmask   int64        `json:"netmask"`
+	// Resolved default value is true
+	Resolved bool `json:"resolved"`
+}
+
+// NewNetworkMulticast returns a NetworkMulticast with the default values of its swagger definition
+func NewNetworkMulticast() *NetworkMulticast {
+	return &NetworkMulticast{
+		ObjectType: "network/multicast",
+		Resolved:   true,
+	}
+}
+
+// Validate checks the NetworkMulticast before it is sent, see ValidateWith
+func (n *NetworkMulticast) Validate() error { return n.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the NetworkMulticast.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (n *NetworkMulticast) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("NetworkMulticast", typeOf)
+	check.Format("address", sophos.FormatIPv4, n.Address)
+	check.Ref("interface", string(n.Interface), "REF(interface/*)")
+	check.Required("name", n.Name)
+	check.Between("netmask", n.Netmask, 0, 32)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &NetworkMulticast{}
 
-// GetPath implements sophos.RestObject and returns the NetworkMulticasts GET path
+// GetPath implements sophos.RestGetter and returns the NetworkMulticasts GET path
 // Returns all available network/multicast objects
 func (*NetworkMulticasts) GetPath() string { return "/api/objects/network/multicast/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*NetworkMulticasts) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the NetworkMulticasts GET path
+// GetPath implements sophos.RestGetter and returns the NetworkMulticasts GET path
 // Returns all available multicast types
 func (n *NetworkMulticast) GetPath() string {
 	return fmt.Sprintf("/api/objects/network/multicast/%s", n.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (n *NetworkMulticast) RefRequired() (string, bool) { return n.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the NetworkMulticast DELETE path
+// DeletePath implements sophos.Deletable and returns the NetworkMulticast DELETE path
 // Creates or updates the complete object multicast
 func (*NetworkMulticast) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/multicast/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the NetworkMulticast PATCH path
+// PatchPath implements sophos.Patchable and returns the NetworkMulticast PATCH path
 // Changes to parts of the object multicast types
 func (*NetworkMulticast) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/multicast/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the NetworkMulticast POST path
+// PostPath implements sophos.Creatable and returns the NetworkMulticast POST path
 // Create a new network/multicast object
 func (*NetworkMulticast) PostPath() string {
 	return "/api/objects/network/multicast/"
 }
 
-// PutPath implements sophos.RestObject and returns the NetworkMulticast PUT path
+// PutPath implements sophos.Updatable and returns the NetworkMulticast PUT path
 // Creates or updates the complete object multicast
 func (*NetworkMulticast) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/multicast/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*NetworkMulticast) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/multicast/%s/usedby", ref)
 }
 
-// NetworkNetworks is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (n *NetworkMulticast) GetType() string { return n.ObjectType }
+
+// NetworkNetworks is an Sophos Endpoint subType and implements sophos.RestGetter
 type NetworkNetworks []NetworkNetwork
 
-// NetworkNetwork is a generated Sophos object
+// NetworkNetwork represents a UTM network/network object
 type NetworkNetwork struct {
-	Locked     string      `json:"_locked"`
-	Reference  string      `json:"_ref"`
-	ObjectType string      `json:"_type"`
-	Address    string      `json:"address"`
-	Address6   string      `json:"address6"`
-	Comment    string      `json:"comment"`
-	Interface  string      `json:"interface"`
-	Name       string      `json:"name"`
-	Netmask    json.Number `json:"netmask"`
-	Netmask6   json.Number `json:"netmask6"`
-	Resolved   bool        `json:"resolved"`
-	Resolved6  bool        `json:"resolved6"`
+	Locked     string       `json:"_locked"`
+	ObjectType string       `json:"_type"`
+	Reference  string       `json:"_ref"`
+	Address    string       `json:"address"`
+	Address6   string       `json:"address6"`
+	Comment    string       `json:"comment"`
+	Interface  InterfaceRef `json:"interface"`
+	Name       string       `json:"name"`
+	Netmask    int64        `json:"netmask"`
+	Netmask6   int64        `json:"netmask6"`
+	Resolved   bool         `json:"resolved"`
+	Resolved6  bool         `json:"resolved6"`
+}
+
+// NewNetworkNetwork returns a NetworkNetwork with the default values of its swagger definition
+func NewNetworkNetwork() *NetworkNetwork {
+	return &NetworkNetwork{
+		ObjectType: "network/network",
+	}
+}
+
+// Validate checks the NetworkNetwork before it is sent, see ValidateWith
+func (n *NetworkNetwork) Validate() error { return n.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the NetworkNetwork.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (n *NetworkNetwork) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("NetworkNetwork", typeOf)
+	check.Format("address", sophos.FormatIPv4, n.Address)
+	check.Format("address6", sophos.FormatIPv6, n.Address6)
+	check.Ref("interface", string(n.Interface), "REF(interface/*)")
+	check.Required("name", n.Name)
+	check.Between("netmask", n.Netmask, 0, 32)
+	check.Between("netmask6", n.Netmask6, 0, 128)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &NetworkNetwork{}
 
-// GetPath implements sophos.RestObject and returns the NetworkNetworks GET path
+// GetPath implements sophos.RestGetter and returns the NetworkNetworks GET path
 // Returns all available network/network objects
 func (*NetworkNetworks) GetPath() string { return "/api/objects/network/network/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*NetworkNetworks) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the NetworkNetworks GET path
+// GetPath implements sophos.RestGetter and returns the NetworkNetworks GET path
 // Returns all available network types
 func (n *NetworkNetwork) GetPath() string {
 	return fmt.Sprintf("/api/objects/network/network/%s", n.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (n *NetworkNetwork) RefRequired() (string, bool) { return n.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the NetworkNetwork DELETE path
+// DeletePath implements sophos.Deletable and returns the NetworkNetwork DELETE path
 // Creates or updates the complete object network
 func (*NetworkNetwork) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/network/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the NetworkNetwork PATCH path
+// PatchPath implements sophos.Patchable and returns the NetworkNetwork PATCH path
 // Changes to parts of the object network types
 func (*NetworkNetwork) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/network/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the NetworkNetwork POST path
+// PostPath implements sophos.Creatable and returns the NetworkNetwork POST path
 // Create a new network/network object
 func (*NetworkNetwork) PostPath() string {
 	return "/api/objects/network/network/"
 }
 
-// PutPath implements sophos.RestObject and returns the NetworkNetwork PUT path
+// PutPath implements sophos.Updatable and returns the NetworkNetwork PUT path
 // Creates or updates the complete object network
 func (*NetworkNetwork) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/network/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*NetworkNetwork) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/network/%s/usedby", ref)
@@ -957,68 +1241,87 @@ func (*NetworkNetwork) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (n *NetworkNetwork) GetType() string { return n.ObjectType }
 
-// NetworkRanges is an Sophos Endpoint subType and implements sophos.RestObject
+// NetworkRanges is an Sophos Endpoint subType and implements sophos.RestGetter
 type NetworkRanges []NetworkRange
 
-// NetworkRange is a generated Sophos object
+// NetworkRange represents a UTM network/range object
 type NetworkRange struct {
-	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
-	ObjectType string `json:"_type"`
-	Comment    string `json:"comment"`
-	From       string `json:"from"`
-	From6      string `json:"from6"`
-	Interface  string `json:"interface"`
-	Name       string `json:"name"`
-	Resolved   bool   `json:"resolved"`
-	Resolved6  bool   `json:"resolved6"`
-	To         string `json:"to"`
-	To6        string `json:"to6"`
+	Locked     string       `json:"_locked"`
+	ObjectType string       `json:"_type"`
+	Reference  string       `json:"_ref"`
+	Comment    string       `json:"comment"`
+	From       string       `json:"from"`
+	From6      string       `json:"from6"`
+	Interface  InterfaceRef `json:"interface"`
+	Name       string       `json:"name"`
+	Resolved   bool         `json:"resolved"`
+	Resolved6  bool         `json:"resolved6"`
+	To         string       `json:"to"`
+	To6        string       `json:"to6"`
+}
+
+// NewNetworkRange returns a NetworkRange with the default values of its swagger definition
+func NewNetworkRange() *NetworkRange {
+	return &NetworkRange{
+		ObjectType: "network/range",
+	}
+}
+
+// Validate checks the NetworkRange before it is sent, see ValidateWith
+func (n *NetworkRange) Validate() error { return n.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the NetworkRange.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (n *NetworkRange) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("NetworkRange", typeOf)
+	check.Ref("interface", string(n.Interface), "REF(interface/*)")
+	check.Required("name", n.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &NetworkRange{}
 
-// GetPath implements sophos.RestObject and returns the NetworkRanges GET path
+// GetPath implements sophos.RestGetter and returns the NetworkRanges GET path
 // Returns all available network/range objects
 func (*NetworkRanges) GetPath() string { return "/api/objects/network/range/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*NetworkRanges) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the NetworkRanges GET path
+// GetPath implements sophos.RestGetter and returns the NetworkRanges GET path
 // Returns all available range types
 func (n *NetworkRange) GetPath() string {
 	return fmt.Sprintf("/api/objects/network/range/%s", n.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (n *NetworkRange) RefRequired() (string, bool) { return n.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the NetworkRange DELETE path
+// DeletePath implements sophos.Deletable and returns the NetworkRange DELETE path
 // Creates or updates the complete object range
 func (*NetworkRange) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/range/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the NetworkRange PATCH path
+// PatchPath implements sophos.Patchable and returns the NetworkRange PATCH path
 // Changes to parts of the object range types
 func (*NetworkRange) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/range/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the NetworkRange POST path
+// PostPath implements sophos.Creatable and returns the NetworkRange POST path
 // Create a new network/range object
 func (*NetworkRange) PostPath() string {
 	return "/api/objects/network/range/"
 }
 
-// PutPath implements sophos.RestObject and returns the NetworkRange PUT path
+// PutPath implements sophos.Updatable and returns the NetworkRange PUT path
 // Creates or updates the complete object range
 func (*NetworkRange) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/range/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*NetworkRange) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/network/range/%s/usedby", ref)
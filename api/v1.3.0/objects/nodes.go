@@ -10,1194 +10,1191 @@ import (
 // Nodes is a generated struct representing the Sophos Nodes Endpoint
 // GET /api/nodes
 type Nodes struct {
-	AccServer1AuthSecret                           string            `json:"acc.server1.auth.secret"`
-	AccServer1AuthStatus                           bool              `json:"acc.server1.auth.status"`
-	AccServer1Port                                 int64             `json:"acc.server1.port"`
-	AccServer1Roles                                []string          `json:"acc.server1.roles"`
-	AccServer1Server                               string            `json:"acc.server1.server"`
-	AccServer2AuthSecret                           string            `json:"acc.server2.auth.secret"`
-	AccServer2AuthStatus                           bool              `json:"acc.server2.auth.status"`
-	AccServer2Port                                 int64             `json:"acc.server2.port"`
-	AccServer2Roles                                []string          `json:"acc.server2.roles"`
-	AccServer2Server                               string            `json:"acc.server2.server"`
-	AccServer2Status                               bool              `json:"acc.server2.status"`
-	AccSsoAdminGroup                               string            `json:"acc.sso_admin_group"`
-	AccSsoAuditorGroup                             string            `json:"acc.sso_auditor_group"`
-	AccStatus                                      bool              `json:"acc.status"`
-	AccdAccessAllowedAdmins                        []interface{}     `json:"accd.access.allowed_admins"`
-	AccdAccessAllowedNetworks                      []interface{}     `json:"accd.access.allowed_networks"`
-	AccdAccessAllowedUsers                         []interface{}     `json:"accd.access.allowed_users"`
-	AccdAccessCert                                 string            `json:"accd.access.cert"`
-	AccdAccessPort                                 int64             `json:"accd.access.port"`
-	AccdDevicesAllowedNetworks                     []interface{}     `json:"accd.devices.allowed_networks"`
-	AccdDevicesAuthAuto                            bool              `json:"accd.devices.auth.auto"`
-	AccdDevicesAuthSecret                          string            `json:"accd.devices.auth.secret"`
-	AccdDevicesAuthStatus                          bool              `json:"accd.devices.auth.status"`
-	AccdDevicesCert                                string            `json:"accd.devices.cert"`
-	AccdDevicesPort                                int64             `json:"accd.devices.port"`
-	AccdGeneralAllowedNetworks                     []interface{}     `json:"accd.general.allowed_networks"`
-	AccdGeneralCert                                string            `json:"accd.general.cert"`
-	AccdGeneralLanguage                            string            `json:"accd.general.language"`
-	AccdGeneralPort                                int64             `json:"accd.general.port"`
-	AccdGeneralTimeout                             int64             `json:"accd.general.timeout"`
-	AccountingIpfixConnections                     []interface{}     `json:"accounting.ipfix.connections"`
-	AccountingIpfixStatus                          bool              `json:"accounting.ipfix.status"`
-	AfcControlledNetworks                          []string          `json:"afc.controlled_networks"`
-	AfcHiddenSkip                                  []string          `json:"afc.hidden_skip"`
-	AfcHttpRedirectURL                             string            `json:"afc.http_redirect_url"`
-	AfcLog                                         string            `json:"afc.log"`
-	AfcNfqueueLength                               int64             `json:"afc.nfqueue_length"`
-	AfcNumQueues                                   struct{}          `json:"afc.num_queues"`
-	AfcRules                                       []string          `json:"afc.rules"`
-	AfcStatus                                      bool              `json:"afc.status"`
-	AfcSubmitUnknownTrafficData                    bool              `json:"afc.submit_unknown_traffic_data"`
-	AfcTransparentSkip                             []interface{}     `json:"afc.transparent_skip"`
-	AmazonVpcAutoPfrule                            bool              `json:"amazon_vpc.auto_pfrule"`
-	AmazonVpcConnections                           []string          `json:"amazon_vpc.connections"`
-	AmazonVpcNetworks                              []string          `json:"amazon_vpc.networks"`
-	AmazonVpcStatus                                bool              `json:"amazon_vpc.status"`
-	AptpPolicy                                     string            `json:"aptp.policy"`
-	AptpRuleModifiers                              []interface{}     `json:"aptp.rule_modifiers"`
-	AptpStatus                                     bool              `json:"aptp.status"`
-	AptpTransparentSkip                            []interface{}     `json:"aptp.transparent_skip"`
-	ArmLicensedIP                                  string            `json:"arm.licensed_ip"`
-	ArmRemoteHost                                  string            `json:"arm.remote.host"`
-	ArmRemoteMethod                                string            `json:"arm.remote.method"`
-	ArmRemoteSmbPassword                           string            `json:"arm.remote.smb_password"`
-	ArmRemoteSmbShare                              string            `json:"arm.remote.smb_share"`
-	ArmRemoteSmbUser                               string            `json:"arm.remote.smb_user"`
-	ArmRemoteStatus                                bool              `json:"arm.remote.status"`
-	ArmRemoteSyslogService                         string            `json:"arm.remote.syslog_service"`
-	ArmStatus                                      bool              `json:"arm.status"`
-	AuthAdSsoForceUtf8Sync                         bool              `json:"auth.ad_sso.force_utf8_sync"`
-	AuthAdSsoJoinresult                            string            `json:"auth.ad_sso.joinresult"`
-	AuthAdSsoLoadbalancerFqdn                      string            `json:"auth.ad_sso.loadbalancer_fqdn"`
-	AuthAdSsoNtlmv2Auth                            bool              `json:"auth.ad_sso.ntlmv2_auth"`
-	AuthAdSsoSecrets                               string            `json:"auth.ad_sso.secrets"`
-	AuthAdSsoSmbconf                               string            `json:"auth.ad_sso.smbconf"`
-	AuthAdSsoSsoDomain                             string            `json:"auth.ad_sso.sso_domain"`
-	AuthAdSsoSsoNetbiosDomain                      string            `json:"auth.ad_sso.sso_netbios_domain"`
-	AuthAdSsoSsoNetbiosHost                        string            `json:"auth.ad_sso.sso_netbios_host"`
-	AuthAdSsoSsoPassword                           string            `json:"auth.ad_sso.sso_password"`
-	AuthAdSsoSsoServer                             string            `json:"auth.ad_sso.sso_server"`
-	AuthAdSsoSsoStatus                             bool              `json:"auth.ad_sso.sso_status"`
-	AuthAdSsoSsoSync                               bool              `json:"auth.ad_sso.sso_sync"`
-	AuthAdSsoSsoUsername                           string            `json:"auth.ad_sso.sso_username"`
-	AuthApiTokens                                  map[string]string `json:"auth.api_tokens"`
-	AuthAutoAddToFacility                          []string          `json:"auth.auto_add_to_facility"`
-	AuthAutoAddUsers                               bool              `json:"auth.auto_add_users"`
-	AuthBlockAttempts                              int64             `json:"auth.block.attempts"`
-	AuthBlockFacilities                            []string          `json:"auth.block.facilities"`
-	AuthBlockLockout                               bool              `json:"auth.block.lockout"`
-	AuthBlockNever                                 []interface{}     `json:"auth.block.never"`
-	AuthBlockSeconds                               int64             `json:"auth.block.seconds"`
-	AuthCacheLifetime                              int64             `json:"auth.cache_lifetime"`
-	AuthEdirSsoEmConflict                          string            `json:"auth.edir_sso.em_conflict"`
-	AuthEdirSsoEmSocketTimeout                     int64             `json:"auth.edir_sso.em_socket_timeout"`
-	AuthEdirSsoEmVerifyLogout                      bool              `json:"auth.edir_sso.em_verify_logout"`
-	AuthEdirSsoSsoAuaSearchIP                      bool              `json:"auth.edir_sso.sso_aua_search_ip"`
-	AuthEdirSsoSsoMode                             string            `json:"auth.edir_sso.sso_mode"`
-	AuthEdirSsoSsoServer                           string            `json:"auth.edir_sso.sso_server"`
-	AuthEdirSsoSyncInterval                        int64             `json:"auth.edir_sso.sync_interval"`
-	AuthOtpAutoCreateToken                         bool              `json:"auth.otp.auto_create_token"`
-	AuthOtpAutoTokenDigest                         string            `json:"auth.otp.auto_token_digest"`
-	AuthOtpDefaultTimestep                         int64             `json:"auth.otp.default_timestep"`
-	AuthOtpFacilities                              []string          `json:"auth.otp.facilities"`
-	AuthOtpMaxInitTimestepDiff                     int64             `json:"auth.otp.max_init_timestep_diff"`
-	AuthOtpMaxTimestepDiff                         int64             `json:"auth.otp.max_timestep_diff"`
-	AuthOtpRequireAllUsers                         bool              `json:"auth.otp.require_all_users"`
-	AuthOtpRequiredUsers                           []interface{}     `json:"auth.otp.required_users"`
-	AuthOtpStatus                                  bool              `json:"auth.otp.status"`
-	AuthServers                                    []interface{}     `json:"auth.servers"`
-	AuthUpdateBackendGroupMembersDebug             bool              `json:"auth.update_backend_group_members.debug"`
-	AuthUpdateBackendGroupMembersStatus            bool              `json:"auth.update_backend_group_members.status"`
-	AweAllowedInterfaces                           []interface{}     `json:"awe.allowed_interfaces"`
-	AweClients                                     []interface{}     `json:"awe.clients"`
-	AweDevices                                     []interface{}     `json:"awe.devices"`
-	AweGlobalApAutoaccept                          bool              `json:"awe.global.ap_autoaccept"`
-	AweGlobalApDebuglevel                          struct{}          `json:"awe.global.ap_debuglevel"`
-	AweGlobalApSoftlimit                           int64             `json:"awe.global.ap_softlimit"`
-	AweGlobalApVlantag                             int64             `json:"awe.global.ap_vlantag"`
-	AweGlobalAweStatus                             struct{}          `json:"awe.global.awe_status"`
-	AweGlobalBridgeUpdateKickout                   bool              `json:"awe.global.bridge_update_kickout"`
-	AweGlobalInitialSetup                          bool              `json:"awe.global.initial_setup"`
-	AweGlobalLogLevel                              int64             `json:"awe.global.log_level"`
-	AweGlobalMagicIP                               string            `json:"awe.global.magic_ip"`
-	AweGlobalNotificationTimeout                   int64             `json:"awe.global.notification_timeout"`
-	AweGlobalRadiusConf                            string            `json:"awe.global.radius_conf"`
-	AweGlobalRootpw                                string            `json:"awe.global.rootpw"`
-	AweGlobalStayOnline                            bool              `json:"awe.global.stay_online"`
-	AweGlobalStoreBssStats                         bool              `json:"awe.global.store_bss_stats"`
-	AweGlobalTunnelIDOffset                        int64             `json:"awe.global.tunnel_id_offset"`
-	AweGlobalVlantagging                           bool              `json:"awe.global.vlantagging"`
-	AweNetworks                                    []string          `json:"awe.networks"`
-	AwscliProfiles                                 []interface{}     `json:"awscli.profiles"`
-	BackupEncryption                               bool              `json:"backup.encryption"`
-	BackupInterval                                 string            `json:"backup.interval"`
-	BackupMaxBackups                               int64             `json:"backup.max_backups"`
-	BackupPassword                                 string            `json:"backup.password"`
-	BackupRecipients                               []string          `json:"backup.recipients"`
-	BackupStatus                                   bool              `json:"backup.status"`
-	CaCaGost                                       string            `json:"ca.ca_gost"`
-	CaCaIpsec                                      string            `json:"ca.ca_ipsec"`
-	CaCaProxies                                    string            `json:"ca.ca_proxies"`
-	CaCaRed                                        string            `json:"ca.ca_red"`
-	CaDefKeysize                                   int64             `json:"ca.def_keysize"`
-	CaGlobalCasEmailEncryptionTrustNewCas          bool              `json:"ca.global_cas.email_encryption.trust_new_cas"`
-	CaGlobalCasEmailEncryptionTrusted              []interface{}     `json:"ca.global_cas.email_encryption.trusted"`
-	CaGlobalCasEmailEncryptionUntrusted            []interface{}     `json:"ca.global_cas.email_encryption.untrusted"`
-	CaGlobalCasHttpProxyTrustNewCas                bool              `json:"ca.global_cas.http_proxy.trust_new_cas"`
-	CaGlobalCasHttpProxyTrusted                    []interface{}     `json:"ca.global_cas.http_proxy.trusted"`
-	CaGlobalCasHttpProxyUntrusted                  []interface{}     `json:"ca.global_cas.http_proxy.untrusted"`
-	CrlsCrls                                       []interface{}     `json:"crls.crls"`
-	CSSAvPrimaryEngine                             string            `json:"css.av_primary_engine"`
-	CSSSxlLiveprotection                           bool              `json:"css.sxl_liveprotection"`
-	CSSSxlSampleSubmit                             bool              `json:"css.sxl_sample_submit"`
-	CustomizationEppLastUpdated                    int64             `json:"customization.epp.last_updated"`
-	CustomizationEppResourcesRoot                  string            `json:"customization.epp.resources_root"`
-	CustomizationHttpCustomAssets                  struct{}          `json:"customization.http.custom_assets"`
-	CustomizationHttpCustomTemplates               struct{}          `json:"customization.http.custom_templates"`
-	CustomizationHttpLastUpdated                   int64             `json:"customization.http.last_updated"`
-	DebugmodeCrashReport                           bool              `json:"debugmode.crash_report"`
-	DebugmodeEnabled                               bool              `json:"debugmode.enabled"`
-	DhcpRelayDhcpServer                            string            `json:"dhcp.relay.dhcp_server"`
-	DhcpRelayInterfaces                            []interface{}     `json:"dhcp.relay.interfaces"`
-	DhcpRelayStatus                                bool              `json:"dhcp.relay.status"`
-	DhcpRelay6ItfsFacingClients                    []interface{}     `json:"dhcp.relay6.itfs_facing_clients"`
-	DhcpRelay6ItfsFacingServer6                    []interface{}     `json:"dhcp.relay6.itfs_facing_server6"`
-	DhcpRelay6Status                               bool              `json:"dhcp.relay6.status"`
-	DhcpServerCustom4                              string            `json:"dhcp.server.custom4"`
-	DhcpServerCustom6                              string            `json:"dhcp.server.custom6"`
-	DhcpServerServers                              []string          `json:"dhcp.server.servers"`
-	DigestAllowedNetworks                          []interface{}     `json:"digest.allowed_networks"`
-	DigestCustomText                               string            `json:"digest.custom_text"`
-	DigestDomains                                  []string          `json:"digest.domains"`
-	DigestHostname                                 string            `json:"digest.hostname"`
-	DigestMailinglists                             []interface{}     `json:"digest.mailinglists"`
-	DigestPort                                     int64             `json:"digest.port"`
-	DigestSendTimeOne                              string            `json:"digest.send_time_one"`
-	DigestSendTimeTwo                              string            `json:"digest.send_time_two"`
-	DigestSkiplist                                 []interface{}     `json:"digest.skiplist"`
-	DigestStatus                                   bool              `json:"digest.status"`
-	DigestUserRelease                              []string          `json:"digest.user_release"`
-	DNSAllowedNetworks                             []string          `json:"dns.allowed_networks"`
-	DNSAxfr                                        []interface{}     `json:"dns.axfr"`
-	DNSDnssec                                      bool              `json:"dns.dnssec"`
-	DNSEmail                                       string            `json:"dns.email"`
-	DNSEmptyZones                                  string            `json:"dns.empty_zones"`
-	DNSFwdDynamic                                  bool              `json:"dns.fwd_dynamic"`
-	DNSFwdStatic                                   []string          `json:"dns.fwd_static"`
-	DNSRecheckInterval                             int64             `json:"dns.recheck_interval"`
-	DNSRoutes                                      []string          `json:"dns.routes"`
-	DyndnsRules                                    []interface{}     `json:"dyndns.rules"`
-	EmailpkiAuthorityCert                          string            `json:"emailpki.authority.cert"`
-	EmailpkiAuthorityFingerprint                   string            `json:"emailpki.authority.fingerprint"`
-	EmailpkiAuthorityKey                           string            `json:"emailpki.authority.key"`
-	EmailpkiAuthorityPostmasterFingerprint         string            `json:"emailpki.authority.postmaster_fingerprint"`
-	EmailpkiAuthorityPostmasterPrivkey             string            `json:"emailpki.authority.postmaster_privkey"`
-	EmailpkiAuthorityPostmasterPubkey              string            `json:"emailpki.authority.postmaster_pubkey"`
-	EmailpkiGlobalCity                             string            `json:"emailpki.global.city"`
-	EmailpkiGlobalCountry                          string            `json:"emailpki.global.country"`
-	EmailpkiGlobalOrganization                     string            `json:"emailpki.global.organization"`
-	EmailpkiGlobalPostmaster                       string            `json:"emailpki.global.postmaster"`
-	EmailpkiGlobalStatus                           bool              `json:"emailpki.global.status"`
-	EmailpkiObjectsCas                             []string          `json:"emailpki.objects.cas"`
-	EmailpkiObjectsOpenpgp                         []interface{}     `json:"emailpki.objects.openpgp"`
-	EmailpkiObjectsSmime                           []interface{}     `json:"emailpki.objects.smime"`
-	EmailpkiObjectsUsers                           []interface{}     `json:"emailpki.objects.users"`
-	EmailpkiOpenpgpMainKeysize                     int64             `json:"emailpki.openpgp.main_keysize"`
-	EmailpkiOpenpgpSubKeysize                      int64             `json:"emailpki.openpgp.sub_keysize"`
-	EmailpkiOptionsExternalAuto                    bool              `json:"emailpki.options.external_auto"`
-	EmailpkiOptionsKeyserver                       string            `json:"emailpki.options.keyserver"`
-	EmailpkiOptionsPolicyDecryption                bool              `json:"emailpki.options.policy_decryption"`
-	EmailpkiOptionsPolicyEncryption                bool              `json:"emailpki.options.policy_encryption"`
-	EmailpkiOptionsPolicySign                      bool              `json:"emailpki.options.policy_sign"`
-	EmailpkiOptionsPolicyVerify                    bool              `json:"emailpki.options.policy_verify"`
-	EndpointAacAllowedNetworks                     []string          `json:"endpoint.aac.allowed_networks"`
-	EndpointAacAllowedUsers                        []string          `json:"endpoint.aac.allowed_users"`
-	EndpointAacCa                                  string            `json:"endpoint.aac.ca"`
-	EndpointAacCert                                string            `json:"endpoint.aac.cert"`
-	EndpointAacMagicIP                             string            `json:"endpoint.aac.magic_ip"`
-	EndpointAacMaxUserLogins                       int64             `json:"endpoint.aac.max_user_logins"`
-	EndpointAacStatus                              bool              `json:"endpoint.aac.status"`
-	EndpointStasCollectors                         []interface{}     `json:"endpoint.stas.collectors"`
-	EndpointStasStatus                             bool              `json:"endpoint.stas.status"`
-	EnduserMessagesCompanyLogo                     string            `json:"enduser_messages.company_logo"`
-	EnduserMessagesCompanyText                     string            `json:"enduser_messages.company_text"`
-	EnduserMessagesDlpBlackholePart                string            `json:"enduser_messages.dlp.blackhole_part"`
-	EnduserMessagesDlpFooterPart                   string            `json:"enduser_messages.dlp.footer_part"`
-	EnduserMessagesDlpHeaderPart                   string            `json:"enduser_messages.dlp.header_part"`
-	EnduserMessagesDlpOriginalPart                 string            `json:"enduser_messages.dlp.original_part"`
-	EnduserMessagesDlpSpxPart                      string            `json:"enduser_messages.dlp.spx_part"`
-	EnduserMessagesDlpSubject                      string            `json:"enduser_messages.dlp.subject"`
-	EnduserMessagesHttpAppDesc                     string            `json:"enduser_messages.http.app_desc"`
-	EnduserMessagesHttpAppSubject                  string            `json:"enduser_messages.http.app_subject"`
-	EnduserMessagesHttpBlacklistDesc               string            `json:"enduser_messages.http.blacklist_desc"`
-	EnduserMessagesHttpBlacklistSubject            string            `json:"enduser_messages.http.blacklist_subject"`
-	EnduserMessagesHttpCertfailSubject             string            `json:"enduser_messages.http.certfail_subject"`
-	EnduserMessagesHttpCffOverrideDesc             string            `json:"enduser_messages.http.cff_override_desc"`
-	EnduserMessagesHttpCffOverrideSubject          string            `json:"enduser_messages.http.cff_override_subject"`
-	EnduserMessagesHttpCffOverrideTerms            string            `json:"enduser_messages.http.cff_override_terms"`
-	EnduserMessagesHttpDownloadCompleteDesc        string            `json:"enduser_messages.http.download_complete_desc"`
-	EnduserMessagesHttpDownloadCompleteSubject     string            `json:"enduser_messages.http.download_complete_subject"`
-	EnduserMessagesHttpDownloadDesc                string            `json:"enduser_messages.http.download_desc"`
-	EnduserMessagesHttpDownloadSubject             string            `json:"enduser_messages.http.download_subject"`
-	EnduserMessagesHttpErrorDesc                   string            `json:"enduser_messages.http.error_desc"`
-	EnduserMessagesHttpErrorSubject                string            `json:"enduser_messages.http.error_subject"`
-	EnduserMessagesHttpFileextensionDesc           string            `json:"enduser_messages.http.fileextension_desc"`
-	EnduserMessagesHttpFileextensionSubject        string            `json:"enduser_messages.http.fileextension_subject"`
-	EnduserMessagesHttpFileextensionWarnDesc       string            `json:"enduser_messages.http.fileextension_warn_desc"`
-	EnduserMessagesHttpFileextensionWarnSubject    string            `json:"enduser_messages.http.fileextension_warn_subject"`
-	EnduserMessagesHttpFilesizeDesc                string            `json:"enduser_messages.http.filesize_desc"`
-	EnduserMessagesHttpFilesizeSubject             string            `json:"enduser_messages.http.filesize_subject"`
-	EnduserMessagesHttpGeoipDesc                   string            `json:"enduser_messages.http.geoip_desc"`
-	EnduserMessagesHttpGeoipSubject                string            `json:"enduser_messages.http.geoip_subject"`
-	EnduserMessagesHttpMimetypeDesc                string            `json:"enduser_messages.http.mimetype_desc"`
-	EnduserMessagesHttpMimetypeSubject             string            `json:"enduser_messages.http.mimetype_subject"`
-	EnduserMessagesHttpMimetypeWarnDesc            string            `json:"enduser_messages.http.mimetype_warn_desc"`
-	EnduserMessagesHttpMimetypeWarnSubject         string            `json:"enduser_messages.http.mimetype_warn_subject"`
-	EnduserMessagesHttpPuaDesc                     string            `json:"enduser_messages.http.pua_desc"`
-	EnduserMessagesHttpPuaSubject                  string            `json:"enduser_messages.http.pua_subject"`
-	EnduserMessagesHttpQuotaBlockDesc              string            `json:"enduser_messages.http.quota_block_desc"`
-	EnduserMessagesHttpQuotaBlockSubject           string            `json:"enduser_messages.http.quota_block_subject"`
-	EnduserMessagesHttpQuotaWarnDesc               string            `json:"enduser_messages.http.quota_warn_desc"`
-	EnduserMessagesHttpQuotaWarnSubject            string            `json:"enduser_messages.http.quota_warn_subject"`
-	EnduserMessagesHttpSpDesc                      string            `json:"enduser_messages.http.sp_desc"`
-	EnduserMessagesHttpSpFrameSubject              string            `json:"enduser_messages.http.sp_frame_subject"`
-	EnduserMessagesHttpSpSubject                   string            `json:"enduser_messages.http.sp_subject"`
-	EnduserMessagesHttpSpWarnDesc                  string            `json:"enduser_messages.http.sp_warn_desc"`
-	EnduserMessagesHttpSpWarnSubject               string            `json:"enduser_messages.http.sp_warn_subject"`
-	EnduserMessagesHttpSslCertraw                  string            `json:"enduser_messages.http.ssl_certraw"`
-	EnduserMessagesHttpSslCertstatus               string            `json:"enduser_messages.http.ssl_certstatus"`
-	EnduserMessagesHttpSslIssuer                   string            `json:"enduser_messages.http.ssl_issuer"`
-	EnduserMessagesHttpSslMd5Fp                    string            `json:"enduser_messages.http.ssl_md5fp"`
-	EnduserMessagesHttpSslSha1Fp                   string            `json:"enduser_messages.http.ssl_sha1fp"`
-	EnduserMessagesHttpSslSubject                  string            `json:"enduser_messages.http.ssl_subject"`
-	EnduserMessagesHttpSslValidfrom                string            `json:"enduser_messages.http.ssl_validfrom"`
-	EnduserMessagesHttpSslValiduntil               string            `json:"enduser_messages.http.ssl_validuntil"`
-	EnduserMessagesHttpThreatDesc                  string            `json:"enduser_messages.http.threat_desc"`
-	EnduserMessagesHttpThreatSubject               string            `json:"enduser_messages.http.threat_subject"`
-	EnduserMessagesHttpTransparentAuthDesc         string            `json:"enduser_messages.http.transparent_auth_desc"`
-	EnduserMessagesHttpTransparentAuthSubject      string            `json:"enduser_messages.http.transparent_auth_subject"`
-	EnduserMessagesHttpTransparentAuthTerms        string            `json:"enduser_messages.http.transparent_auth_terms"`
-	EnduserMessagesHttpVirusDesc                   string            `json:"enduser_messages.http.virus_desc"`
-	EnduserMessagesHttpVirusSubject                string            `json:"enduser_messages.http.virus_subject"`
-	EnduserMessagesHttpVirusscanDesc               string            `json:"enduser_messages.http.virusscan_desc"`
-	EnduserMessagesHttpVirusscanSubject            string            `json:"enduser_messages.http.virusscan_subject"`
-	EnduserMessagesMailReleaseErrDesc              string            `json:"enduser_messages.mail.release_err_desc"`
-	EnduserMessagesMailReleaseErrSubject           string            `json:"enduser_messages.mail.release_err_subject"`
-	EnduserMessagesMailReleasedDesc                string            `json:"enduser_messages.mail.released_desc"`
-	EnduserMessagesMailReleasedSubject             string            `json:"enduser_messages.mail.released_subject"`
-	EnduserMessagesPop3BlockedDesc                 string            `json:"enduser_messages.pop3.blocked_desc"`
-	EnduserMessagesPop3BlockedSubject              string            `json:"enduser_messages.pop3.blocked_subject"`
-	EnduserMessagesSpxInternalErrorBody            string            `json:"enduser_messages.spx.internal_error.body"`
-	EnduserMessagesSpxInternalErrorSubject         string            `json:"enduser_messages.spx.internal_error.subject"`
-	EnduserMessagesSpxInternalErrorSenderBody      string            `json:"enduser_messages.spx.internal_error_sender.body"`
-	EnduserMessagesSpxInternalErrorSenderSubject   string            `json:"enduser_messages.spx.internal_error_sender.subject"`
-	EnduserMessagesSpxPasswordNoSpecCharsBody      string            `json:"enduser_messages.spx.password_no_spec_chars.body"`
-	EnduserMessagesSpxPasswordNoSpecCharsSubject   string            `json:"enduser_messages.spx.password_no_spec_chars.subject"`
-	EnduserMessagesSpxPasswordNotLongEnoughBody    string            `json:"enduser_messages.spx.password_not_long_enough.body"`
-	EnduserMessagesSpxPasswordNotLongEnoughSubject string            `json:"enduser_messages.spx.password_not_long_enough.subject"`
-	EnduserMessagesSpxPasswordNotPresentedBody     string            `json:"enduser_messages.spx.password_not_presented.body"`
-	EnduserMessagesSpxPasswordNotPresentedSubject  string            `json:"enduser_messages.spx.password_not_presented.subject"`
-	EnduserMessagesSpxUrlNotFoundMessage           string            `json:"enduser_messages.spx.url_not_found.message"`
-	EnduserMessagesSquidCacheAdmin                 string            `json:"enduser_messages.squid.cache_admin"`
-	EnduserMessagesSquidCacheAdminMessage          string            `json:"enduser_messages.squid.cache_admin_message"`
-	EppAllowedNetworks                             []string          `json:"epp.allowed_networks"`
-	EppCertificate                                 string            `json:"epp.certificate"`
-	EppCity                                        string            `json:"epp.city"`
-	EppCountry                                     string            `json:"epp.country"`
-	EppDefaultEndpointsGroup                       string            `json:"epp.default_endpoints_group"`
-	EppDevices                                     []interface{}     `json:"epp.devices"`
-	EppEmail                                       string            `json:"epp.email"`
-	EppEndpoints                                   []interface{}     `json:"epp.endpoints"`
-	EppEndpointsGroups                             []string          `json:"epp.endpoints_groups"`
-	EppExceptionsAv                                []interface{}     `json:"epp.exceptions.av"`
-	EppExceptionsDc                                []interface{}     `json:"epp.exceptions.dc"`
-	EppFallbackURL                                 string            `json:"epp.fallback_url"`
-	EppMagnetPassword                              string            `json:"epp.magnet_password"`
-	EppMagnetUsername                              string            `json:"epp.magnet_username"`
-	EppOrganization                                string            `json:"epp.organization"`
-	EppParentProxyHost                             string            `json:"epp.parent_proxy_host"`
-	EppParentProxyPort                             int64             `json:"epp.parent_proxy_port"`
-	EppParentProxyStatus                           bool              `json:"epp.parent_proxy_status"`
-	EppPoliciesAv                                  []string          `json:"epp.policies.av"`
-	EppPoliciesDc                                  []string          `json:"epp.policies.dc"`
-	EppPort                                        int64             `json:"epp.port"`
-	EppPrivateKey                                  string            `json:"epp.private_key"`
-	EppRegistrationToken                           string            `json:"epp.registration_token"`
-	EppStatusAv                                    bool              `json:"epp.status.av"`
-	EppStatusBroker                                bool              `json:"epp.status.broker"`
-	EppStatusDc                                    bool              `json:"epp.status.dc"`
-	EppStatusEpp                                   bool              `json:"epp.status.epp"`
-	EppStatusWc                                    bool              `json:"epp.status.wc"`
-	EppTamperPassword                              string            `json:"epp.tamper_password"`
-	EppVersion                                     string            `json:"epp.version"`
-	EppWdxToken                                    string            `json:"epp.wdx_token"`
-	ExecutiveReportDailyArchive                    bool              `json:"executive_report.daily.archive"`
-	ExecutiveReportDailyKeep                       int64             `json:"executive_report.daily.keep"`
-	ExecutiveReportDailyPdfrecipients              []interface{}     `json:"executive_report.daily.pdfrecipients"`
-	ExecutiveReportDailyRecipients                 []string          `json:"executive_report.daily.recipients"`
-	ExecutiveReportDailyStatus                     bool              `json:"executive_report.daily.status"`
-	ExecutiveReportMonthlyArchive                  bool              `json:"executive_report.monthly.archive"`
-	ExecutiveReportMonthlyKeep                     int64             `json:"executive_report.monthly.keep"`
-	ExecutiveReportMonthlyPdfrecipients            []interface{}     `json:"executive_report.monthly.pdfrecipients"`
-	ExecutiveReportMonthlyRecipients               []interface{}     `json:"executive_report.monthly.recipients"`
-	ExecutiveReportMonthlyStatus                   bool              `json:"executive_report.monthly.status"`
-	ExecutiveReportWeeklyArchive                   bool              `json:"executive_report.weekly.archive"`
-	ExecutiveReportWeeklyFirstDayOfWeek            struct{}          `json:"executive_report.weekly.first_day_of_week"`
-	ExecutiveReportWeeklyKeep                      int64             `json:"executive_report.weekly.keep"`
-	ExecutiveReportWeeklyPdfrecipients             []interface{}     `json:"executive_report.weekly.pdfrecipients"`
-	ExecutiveReportWeeklyRecipients                []interface{}     `json:"executive_report.weekly.recipients"`
-	ExecutiveReportWeeklyStatus                    bool              `json:"executive_report.weekly.status"`
-	FloodProtectionIcmpDstBurst                    int64             `json:"flood_protection.icmp.dst_burst"`
-	FloodProtectionIcmpDstExpire                   int64             `json:"flood_protection.icmp.dst_expire"`
-	FloodProtectionIcmpDstGcInterval               int64             `json:"flood_protection.icmp.dst_gc_interval"`
-	FloodProtectionIcmpDstRate                     int64             `json:"flood_protection.icmp.dst_rate"`
-	FloodProtectionIcmpLog                         string            `json:"flood_protection.icmp.log"`
-	FloodProtectionIcmpLogLimitBurst               int64             `json:"flood_protection.icmp.log_limit_burst"`
-	FloodProtectionIcmpLogLimitRate                int64             `json:"flood_protection.icmp.log_limit_rate"`
-	FloodProtectionIcmpMode                        string            `json:"flood_protection.icmp.mode"`
-	FloodProtectionIcmpSrcBurst                    int64             `json:"flood_protection.icmp.src_burst"`
-	FloodProtectionIcmpSrcExpire                   int64             `json:"flood_protection.icmp.src_expire"`
-	FloodProtectionIcmpSrcGcInterval               int64             `json:"flood_protection.icmp.src_gc_interval"`
-	FloodProtectionIcmpSrcRate                     int64             `json:"flood_protection.icmp.src_rate"`
-	FloodProtectionIcmpStatus                      bool              `json:"flood_protection.icmp.status"`
-	FloodProtectionSynDstBurst                     int64             `json:"flood_protection.syn.dst_burst"`
-	FloodProtectionSynDstExpire                    int64             `json:"flood_protection.syn.dst_expire"`
-	FloodProtectionSynDstGcInterval                int64             `json:"flood_protection.syn.dst_gc_interval"`
-	FloodProtectionSynDstRate                      int64             `json:"flood_protection.syn.dst_rate"`
-	FloodProtectionSynLog                          string            `json:"flood_protection.syn.log"`
-	FloodProtectionSynLogLimitBurst                int64             `json:"flood_protection.syn.log_limit_burst"`
-	FloodProtectionSynLogLimitRate                 int64             `json:"flood_protection.syn.log_limit_rate"`
-	FloodProtectionSynMode                         string            `json:"flood_protection.syn.mode"`
-	FloodProtectionSynSrcBurst                     int64             `json:"flood_protection.syn.src_burst"`
-	FloodProtectionSynSrcExpire                    int64             `json:"flood_protection.syn.src_expire"`
-	FloodProtectionSynSrcGcInterval                int64             `json:"flood_protection.syn.src_gc_interval"`
-	FloodProtectionSynSrcRate                      int64             `json:"flood_protection.syn.src_rate"`
-	FloodProtectionSynStatus                       bool              `json:"flood_protection.syn.status"`
-	FloodProtectionUdpDstBurst                     int64             `json:"flood_protection.udp.dst_burst"`
-	FloodProtectionUdpDstExpire                    int64             `json:"flood_protection.udp.dst_expire"`
-	FloodProtectionUdpDstGcInterval                int64             `json:"flood_protection.udp.dst_gc_interval"`
-	FloodProtectionUdpDstRate                      int64             `json:"flood_protection.udp.dst_rate"`
-	FloodProtectionUdpLog                          string            `json:"flood_protection.udp.log"`
-	FloodProtectionUdpLogLimitBurst                int64             `json:"flood_protection.udp.log_limit_burst"`
-	FloodProtectionUdpLogLimitRate                 int64             `json:"flood_protection.udp.log_limit_rate"`
-	FloodProtectionUdpMode                         string            `json:"flood_protection.udp.mode"`
-	FloodProtectionUdpSrcBurst                     int64             `json:"flood_protection.udp.src_burst"`
-	FloodProtectionUdpSrcExpire                    int64             `json:"flood_protection.udp.src_expire"`
-	FloodProtectionUdpSrcGcInterval                int64             `json:"flood_protection.udp.src_gc_interval"`
-	FloodProtectionUdpSrcRate                      int64             `json:"flood_protection.udp.src_rate"`
-	FloodProtectionUdpStatus                       bool              `json:"flood_protection.udp.status"`
-	FtpAllowedClients                              []interface{}     `json:"ftp.allowed_clients"`
-	FtpAllowedServers                              []string          `json:"ftp.allowed_servers"`
-	FtpCffAv                                       bool              `json:"ftp.cff_av"`
-	FtpCffAvEngines                                string            `json:"ftp.cff_av_engines"`
-	FtpCffFileExtensions                           []interface{}     `json:"ftp.cff_file_extensions"`
-	FtpExceptions                                  []interface{}     `json:"ftp.exceptions"`
-	FtpMaxFileSize                                 int64             `json:"ftp.max_file_size"`
-	FtpMsWinMode                                   bool              `json:"ftp.ms_win_mode"`
-	FtpOperationMode                               string            `json:"ftp.operation_mode"`
-	FtpRestrictedServers                           []string          `json:"ftp.restricted_servers"`
-	FtpStatus                                      bool              `json:"ftp.status"`
-	FtpTransparentSkip                             []interface{}     `json:"ftp.transparent_skip"`
-	FtpTransparentSkipAutoPf                       bool              `json:"ftp.transparent_skip_auto_pf"`
-	GenericProxyRules                              []interface{}     `json:"generic_proxy.rules"`
-	GeoipCountriesDst                              []string          `json:"geoip.countries_dst"`
-	GeoipCountriesSrc                              []string          `json:"geoip.countries_src"`
-	GeoipExceptions                                []interface{}     `json:"geoip.exceptions"`
-	GeoipLog                                       string            `json:"geoip.log"`
-	GeoipStatus                                    bool              `json:"geoip.status"`
-	H323AllowedNetworks                            []interface{}     `json:"h323.allowed_networks"`
-	H323LogRelated                                 bool              `json:"h323.log_related"`
-	H323Servers                                    []interface{}     `json:"h323.servers"`
-	H323Status                                     bool              `json:"h323.status"`
-	HaAdvancedAutojoin                             bool              `json:"ha.advanced.autojoin"`
-	HaAdvancedColdRollback                         bool              `json:"ha.advanced.cold_rollback"`
-	HaAdvancedHttpPersistenceTime                  struct{}          `json:"ha.advanced.http_persistence_time"`
-	HaAdvancedLoadTakeover                         int64             `json:"ha.advanced.load_takeover"`
-	HaAdvancedLoadWarn                             int64             `json:"ha.advanced.load_warn"`
-	HaAdvancedMaxNodes                             int64             `json:"ha.advanced.max_nodes"`
-	HaAdvancedMtu                                  string            `json:"ha.advanced.mtu"`
-	HaAdvancedNetconsole                           bool              `json:"ha.advanced.netconsole"`
-	HaAdvancedPreempt                              string            `json:"ha.advanced.preempt"`
-	HaAdvancedUniqueID                             int64             `json:"ha.advanced.unique_id"`
-	HaAdvancedVirtualMac                           bool              `json:"ha.advanced.virtual_mac"`
-	HaAwsCloudwatchProfile                         string            `json:"ha.aws.cloudwatch.profile"`
-	HaAwsCloudwatchStatus                          bool              `json:"ha.aws.cloudwatch.status"`
-	HaAwsConfdBackup                               bool              `json:"ha.aws.confd.backup"`
-	HaAwsConfdBackupInterval                       int64             `json:"ha.aws.confd.backup_interval"`
-	HaAwsConfdRestore                              bool              `json:"ha.aws.confd.restore"`
-	HaAwsConfdRestoreDone                          bool              `json:"ha.aws.confd.restore_done"`
-	HaAwsElasticIP                                 string            `json:"ha.aws.elastic_ip"`
-	HaAwsPostgresArchiveTimeout                    int64             `json:"ha.aws.postgres.archive_timeout"`
-	HaAwsPostgresBackup                            bool              `json:"ha.aws.postgres.backup"`
-	HaAwsPostgresBaseBackupInterval                int64             `json:"ha.aws.postgres.base_backup_interval"`
-	HaAwsPostgresRestore                           bool              `json:"ha.aws.postgres.restore"`
-	HaAwsS3Bucket                                  string            `json:"ha.aws.s3_bucket"`
-	HaAwsStackName                                 string            `json:"ha.aws.stack_name"`
-	HaAwsSyslogBackup                              bool              `json:"ha.aws.syslog.backup"`
-	HaAwsSyslogRestore                             bool              `json:"ha.aws.syslog.restore"`
-	HaAwsSyslogRestorePeriod                       int64             `json:"ha.aws.syslog.restore_period"`
-	HaAwsTrustedNetwork                            string            `json:"ha.aws.trusted_network"`
-	HaClusterFtp                                   []interface{}     `json:"ha.cluster.ftp"`
-	HaClusterHttp                                  []interface{}     `json:"ha.cluster.http"`
-	HaClusterIpsec                                 []interface{}     `json:"ha.cluster.ipsec"`
-	HaClusterPop3                                  []interface{}     `json:"ha.cluster.pop3"`
-	HaClusterSmtp                                  []interface{}     `json:"ha.cluster.smtp"`
-	HaClusterSnort                                 []interface{}     `json:"ha.cluster.snort"`
-	HaClusterWaf                                   []interface{}     `json:"ha.cluster.waf"`
-	HaDeviceName                                   string            `json:"ha.device_name"`
-	HaItfhw                                        string            `json:"ha.itfhw"`
-	HaItfhwBackup                                  string            `json:"ha.itfhw_backup"`
-	HaMasterIP                                     string            `json:"ha.master_ip"`
-	HaMode                                         string            `json:"ha.mode"`
-	HaNodeID                                       struct{}          `json:"ha.node_id"`
-	HaPassword                                     string            `json:"ha.password"`
-	HaPostgresSecret                               string            `json:"ha.postgres_secret"`
-	HaSlaveIP                                      string            `json:"ha.slave_ip"`
-	HaStatus                                       string            `json:"ha.status"`
-	HaSyncConntrack                                bool              `json:"ha.sync.conntrack"`
-	HaSyncDatabase                                 bool              `json:"ha.sync.database"`
-	HaSyncFiles                                    bool              `json:"ha.sync.files"`
-	HaSyncIpsec                                    bool              `json:"ha.sync.ipsec"`
-	HaSyncSyslog                                   bool              `json:"ha.sync.syslog"`
-	HaTimesDeadTime                                int64             `json:"ha.times.dead_time"`
-	HaTimesLoadTime                                int64             `json:"ha.times.load_time"`
-	HotspotCert                                    string            `json:"hotspot.cert"`
-	HotspotDeleteDays                              int64             `json:"hotspot.delete_days"`
-	HotspotSslPortal                               bool              `json:"hotspot.ssl_portal"`
-	HotspotStatus                                  bool              `json:"hotspot.status"`
-	HotspotTransparentSkip                         []interface{}     `json:"hotspot.transparent_skip"`
-	HTTPAdSsoInterfaces                            []interface{}     `json:"http.ad_sso_interfaces"`
-	HTTPAdssoRedirectUseHostname                   bool              `json:"http.adsso_redirect_use_hostname"`
-	HTTPAllowSsl3                                  bool              `json:"http.allow_ssl3"`
-	HTTPAllowTLS12                                 bool              `json:"http.allow_tls_1_2"`
-	HTTPAllowedPuas                                []interface{}     `json:"http.allowed_puas"`
-	HTTPAllowedTargetServices                      []string          `json:"http.allowed_target_services"`
-	HTTPAuaMaxconns                                int64             `json:"http.aua_maxconns"`
-	HTTPAuaTimeout                                 int64             `json:"http.aua_timeout"`
-	HTTPAuthCacheSize                              int64             `json:"http.auth_cache_size"`
-	HTTPAuthCacheTTL                               int64             `json:"http.auth_cache_ttl"`
-	HTTPAuthRealm                                  string            `json:"http.auth_realm"`
-	HTTPAuthUsercacheTTL                           int64             `json:"http.auth_usercache_ttl"`
-	HTTPBlockUnscannable                           bool              `json:"http.block_unscannable"`
-	HTTPBypassStreaming                            bool              `json:"http.bypass_streaming"`
-	HTTPCaList                                     []interface{}     `json:"http.ca_list"`
-	HTTPCacheIgnoresCookies                        bool              `json:"http.cache_ignores_cookies"`
-	HTTPCachessl                                   bool              `json:"http.cachessl"`
-	HTTPCaching                                    bool              `json:"http.caching"`
-	HTTPCertcache                                  string            `json:"http.certcache"`
-	HTTPCertstore                                  string            `json:"http.certstore"`
-	HTTPCffOverrideUsers                           []interface{}     `json:"http.cff_override_users"`
-	HTTPClientTimeout                              int64             `json:"http.client_timeout"`
-	HTTPConfLockWorkaround                         bool              `json:"http.conf_lock_workaround"`
-	HTTPConnectTimeout                             int64             `json:"http.connect_timeout"`
-	HTTPConnectV6Timeout                           struct{}          `json:"http.connect_v6_timeout"`
-	HTTPConnlimit                                  int64             `json:"http.connlimit"`
-	HTTPCtypeInspectBody                           bool              `json:"http.ctype_inspect_body"`
-	HTTPCtypeUnpackArchive                         bool              `json:"http.ctype_unpack_archive"`
-	HTTPDebug                                      []interface{}     `json:"http.debug"`
-	HTTPDefaultblockaction                         string            `json:"http.defaultblockaction"`
-	HTTPDeferagents                                []string          `json:"http.deferagents"`
-	HTTPDeferlength                                int64             `json:"http.deferlength"`
-	HTTPDisplayHTTPBlockpageExplicitMode           bool              `json:"http.display_http_blockpage_explicit_mode"`
-	HTTPDisplayIntro                               bool              `json:"http.display_intro"`
-	HTTPDownloadManagerDefaultCharset              string            `json:"http.download_manager_default_charset"`
-	HTTPEdirDelayBasicAuth                         bool              `json:"http.edir_delay_basic_auth"`
-	HTTPEnableOutInterface                         bool              `json:"http.enable_out_interface"`
-	HTTPEppQuotaAction                             string            `json:"http.epp_quota_action"`
-	HTTPExceptions                                 []string          `json:"http.exceptions"`
-	HTTPForcedCachingExtension                     []string          `json:"http.forced_caching_extension"`
-	HTTPForcedCachingNeverCachePrefix              []string          `json:"http.forced_caching_never_cache_prefix"`
-	HTTPForcedCachingStatus                        bool              `json:"http.forced_caching_status"`
-	HTTPForcedCachingTTL                           int64             `json:"http.forced_caching_ttl"`
-	HTTPForcedCachingUserAgentPrefix               []string          `json:"http.forced_caching_user_agent_prefix"`
-	HTTPHttpLoopbackDetect                         bool              `json:"http.http_loopback_detect"`
-	HTTPIeSslBlockpageWorkaround                   bool              `json:"http.ie_ssl_blockpage_workaround"`
-	HTTPLimitAdSsoInterfaces                       bool              `json:"http.limit_ad_sso_interfaces"`
-	HTTPLocalSiteList                              []interface{}     `json:"http.local_site_list"`
-	HTTPMaxContentEncoding                         int64             `json:"http.max_content_encoding"`
-	HTTPMaxTempfileSize                            int64             `json:"http.max_tempfile_size"`
-	HTTPMaxthreads                                 int64             `json:"http.maxthreads"`
-	HTTPMaxthreadsUnused                           int64             `json:"http.maxthreads_unused"`
-	HTTPModulepath                                 string            `json:"http.modulepath"`
-	HTTPModules                                    []string          `json:"http.modules"`
-	HTTPNoscancontent                              []string          `json:"http.noscancontent"`
-	HTTPOpendirectoryKeytab                        string            `json:"http.opendirectory_keytab"`
-	HTTPPacFile                                    string            `json:"http.pac_file"`
-	HTTPParentProxyHost                            string            `json:"http.parent_proxy_host"`
-	HTTPParentProxyPort                            int64             `json:"http.parent_proxy_port"`
-	HTTPParentProxyStatus                          bool              `json:"http.parent_proxy_status"`
-	HTTPPassthroughID                              string            `json:"http.passthrough_id"`
-	HTTPPharmingProtection                         bool              `json:"http.pharming_protection"`
-	HTTPPort                                       int64             `json:"http.port"`
-	HTTPPortalCert                                 string            `json:"http.portal_cert"`
-	HTTPPortalCertChain                            []interface{}     `json:"http.portal_cert_chain"`
-	HTTPPortalDomain                               string            `json:"http.portal_domain"`
-	HTTPPortalHosts                                []interface{}     `json:"http.portal_hosts"`
-	HTTPPortalUseCert                              bool              `json:"http.portal_use_cert"`
-	HTTPProceedCacheTimeout                        int64             `json:"http.proceed_cache_timeout"`
-	HTTPProfiles                                   []string          `json:"http.profiles"`
-	HTTPQuotaSliceTime                             int64             `json:"http.quota_slice_time"`
-	HTTPRemoveRequest                              []interface{}     `json:"http.remove_request"`
-	HTTPRemoveResponse                             []string          `json:"http.remove_response"`
-	HTTPResponseTimeout                            int64             `json:"http.response_timeout"`
-	HTTPScLocalDB                                  string            `json:"http.sc_local_db"`
-	HTTPScanEppTraffic                             bool              `json:"http.scan_epp_traffic"`
-	HTTPSearchdomain                               string            `json:"http.searchdomain"`
-	HTTPStrictHTTP                                 bool              `json:"http.strict_http"`
-	HTTPTlsciphersClient                           string            `json:"http.tlsciphers_client"`
-	HTTPTlsciphersServer                           string            `json:"http.tlsciphers_server"`
-	HTTPTmpfsUsageMinMemsize                       int64             `json:"http.tmpfs_usage_min_memsize"`
-	HTTPTransparentAuthTimeout                     int64             `json:"http.transparent_auth_timeout"`
-	HTTPTransparentDstSkip                         []interface{}     `json:"http.transparent_dst_skip"`
-	HTTPTransparentSkipAutoPf                      bool              `json:"http.transparent_skip_auto_pf"`
-	HTTPTransparentSrcSkip                         []interface{}     `json:"http.transparent_src_skip"`
-	HTTPTunnelTimeout                              int64             `json:"http.tunnel_timeout"`
-	HTTPTunnelV6Timeout                            struct{}          `json:"http.tunnel_v6_timeout"`
-	HTTPUndefercontent                             []string          `json:"http.undefercontent"`
-	HTTPUndeferextension                           []string          `json:"http.undeferextension"`
-	HTTPUrlFilteringRedirectURL                    string            `json:"http.url_filtering_redirect_url"`
-	HTTPUseConnectionInsteadofProxyconnection      bool              `json:"http.use_connection_insteadof_proxyconnection"`
-	HTTPUseDstaddrForGeopiplookup                  bool              `json:"http.use_dstaddr_for_geopiplookup"`
-	HTTPUseKrb5Adsso                               bool              `json:"http.use_krb5_adsso"`
-	HTTPUseSni                                     bool              `json:"http.use_sni"`
-	HTTPUseSxlUrid                                 bool              `json:"http.use_sxl_urid"`
-	IcmpForward                                    bool              `json:"icmp.forward"`
-	IcmpInput                                      bool              `json:"icmp.input"`
-	IcmpLogRedirect                                bool              `json:"icmp.log_redirect"`
-	IcmpPingForward                                bool              `json:"icmp.ping.forward"`
-	IcmpPingInput                                  bool              `json:"icmp.ping.input"`
-	IcmpPingOutput                                 bool              `json:"icmp.ping.output"`
-	IcmpSecure                                     bool              `json:"icmp.secure"`
-	IcmpTracerouteForward                          bool              `json:"icmp.traceroute.forward"`
-	IcmpTracerouteInput                            bool              `json:"icmp.traceroute.input"`
-	IdentForward                                   bool              `json:"ident.forward"`
-	IdentResponse                                  string            `json:"ident.response"`
-	IdentStatus                                    bool              `json:"ident.status"`
-	InterfacesAdvancedArpAnnounce                  struct{}          `json:"interfaces.advanced.arp_announce"`
-	InterfacesAdvancedArpIgnore                    struct{}          `json:"interfaces.advanced.arp_ignore"`
-	InterfacesAdvancedDefaultMetric                int64             `json:"interfaces.advanced.default_metric"`
-	InterfacesInterfaces                           []string          `json:"interfaces.interfaces"`
-	IpsDnsServers                                  []interface{}     `json:"ips.dns_servers"`
-	IpsEngine                                      string            `json:"ips.engine"`
-	IpsExceptions                                  []string          `json:"ips.exceptions"`
-	IpsFailopen                                    bool              `json:"ips.failopen"`
-	IpsFileBasedRules                              bool              `json:"ips.file_based_rules"`
-	IpsGroups                                      []string          `json:"ips.groups"`
-	IpsHttpServers                                 []interface{}     `json:"ips.http_servers"`
-	IpsIpsfbAlertInterval                          int64             `json:"ips.ipsfb.alert_interval"`
-	IpsIpsfbConfigInterval                         int64             `json:"ips.ipsfb.config_interval"`
-	IpsIpsfbDebug                                  bool              `json:"ips.ipsfb.debug"`
-	IpsLocalNetworks                               []string          `json:"ips.local_networks"`
-	IpsNumInstances                                struct{}          `json:"ips.num_instances"`
-	IpsPatternChannel                              string            `json:"ips.pattern_channel"`
-	IpsPolicy                                      string            `json:"ips.policy"`
-	IpsQueueLength                                 int64             `json:"ips.queue_length"`
-	IpsQueueThreshold                              struct{}          `json:"ips.queue_threshold"`
-	IpsReloadMethod                                string            `json:"ips.reload_method"`
-	IpsRestartPolicy                               string            `json:"ips.restart_policy"`
-	IpsRuleModifiers                               []interface{}     `json:"ips.rule_modifiers"`
-	IpsRules                                       []interface{}     `json:"ips.rules"`
-	IpsSkipAcks                                    bool              `json:"ips.skip_acks"`
-	IpsSmtpServers                                 []interface{}     `json:"ips.smtp_servers"`
-	IpsSnortsettingsMaxQueuedBytes                 struct{}          `json:"ips.snortsettings.max_queued_bytes"`
-	IpsSnortsettingsMaxQueuedSegs                  struct{}          `json:"ips.snortsettings.max_queued_segs"`
-	IpsSnortsettingsMaxTcp                         struct{}          `json:"ips.snortsettings.max_tcp"`
-	IpsSnortsettingsMaxUdp                         struct{}          `json:"ips.snortsettings.max_udp"`
-	IpsSnortsettingsMemcap                         struct{}          `json:"ips.snortsettings.memcap"`
-	IpsSnortsettingsSearchMethod                   string            `json:"ips.snortsettings.search_method"`
-	IpsSqlServers                                  []interface{}     `json:"ips.sql_servers"`
-	IpsStatus                                      bool              `json:"ips.status"`
-	IpsecAdvancedCrlAutoFetching                   bool              `json:"ipsec.advanced.crl_auto_fetching"`
-	IpsecAdvancedCrlStrictPolicy                   bool              `json:"ipsec.advanced.crl_strict_policy"`
-	IpsecAdvancedDeadPeerDetection                 bool              `json:"ipsec.advanced.dead_peer_detection"`
-	IpsecAdvancedIkeDebug                          []interface{}     `json:"ipsec.advanced.ike_debug"`
-	IpsecAdvancedIkePort                           int64             `json:"ipsec.advanced.ike_port"`
-	IpsecAdvancedMetric                            struct{}          `json:"ipsec.advanced.metric"`
-	IpsecAdvancedNatTraversal                      bool              `json:"ipsec.advanced.nat_traversal"`
-	IpsecAdvancedNatTraversalKeepalive             int64             `json:"ipsec.advanced.nat_traversal_keepalive"`
-	IpsecAdvancedProbePsk                          bool              `json:"ipsec.advanced.probe_psk"`
-	IpsecAdvancedPskVpnID                          string            `json:"ipsec.advanced.psk_vpn_id"`
-	IpsecAdvancedPskVpnIDType                      string            `json:"ipsec.advanced.psk_vpn_id_type"`
-	IpsecConnections                               []string          `json:"ipsec.connections"`
-	IpsecLocalRsa                                  string            `json:"ipsec.local_rsa"`
-	IpsecLocalX509                                 string            `json:"ipsec.local_x509"`
-	IpsecStatus                                    bool              `json:"ipsec.status"`
-	Ipv6AdvancedHopLimit                           int64             `json:"ipv6.advanced.hop_limit"`
-	Ipv6AdvancedMaxInterval                        int64             `json:"ipv6.advanced.max_interval"`
-	Ipv6AdvancedMinInterval                        int64             `json:"ipv6.advanced.min_interval"`
-	Ipv6AdvancedPreference                         string            `json:"ipv6.advanced.preference"`
-	Ipv6AdvancedReachableTime                      struct{}          `json:"ipv6.advanced.reachable_time"`
-	Ipv6AdvancedRetransTime                        struct{}          `json:"ipv6.advanced.retrans_time"`
-	Ipv6BrokerAuthentication                       string            `json:"ipv6.broker.authentication"`
-	Ipv6BrokerInterface                            string            `json:"ipv6.broker.interface"`
-	Ipv6BrokerPassword                             string            `json:"ipv6.broker.password"`
-	Ipv6BrokerProtocol                             string            `json:"ipv6.broker.protocol"`
-	Ipv6BrokerServer                               string            `json:"ipv6.broker.server"`
-	Ipv6BrokerStatus                               bool              `json:"ipv6.broker.status"`
-	Ipv6BrokerTunnelID                             string            `json:"ipv6.broker.tunnel_id"`
-	Ipv6BrokerUsername                             string            `json:"ipv6.broker.username"`
-	Ipv6Nat64Address                               string            `json:"ipv6.nat64.address"`
-	Ipv6Nat64Dns64V6only                           bool              `json:"ipv6.nat64.dns64_v6only"`
-	Ipv6Nat64Prefix                                string            `json:"ipv6.nat64.prefix"`
-	Ipv6Nat64Status                                bool              `json:"ipv6.nat64.status"`
-	Ipv6Prefer                                     bool              `json:"ipv6.prefer"`
-	Ipv6Prefixes                                   []interface{}     `json:"ipv6.prefixes"`
-	Ipv6Renumbering                                bool              `json:"ipv6.renumbering"`
-	Ipv6Six2FourInterface                          string            `json:"ipv6.six2four.interface"`
-	Ipv6Six2FourServer                             string            `json:"ipv6.six2four.server"`
-	Ipv6Six2FourStatus                             bool              `json:"ipv6.six2four.status"`
-	Ipv6Status                                     bool              `json:"ipv6.status"`
-	LicensingActiveIps                             []string          `json:"licensing.active_ips"`
-	LicensingLicense                               string            `json:"licensing.license"`
-	LicensingUserLimitExceeded                     struct{}          `json:"licensing.user_limit_exceeded"`
-	LinkAggregationGroups                          []string          `json:"link_aggregation.groups"`
-	LoadbalanceHttpErrorCode                       int64             `json:"loadbalance.http_error_code"`
-	LoadbalanceRules                               []interface{}     `json:"loadbalance.rules"`
-	LogfilesLocalActionOne                         string            `json:"logfiles.local.action_one"`
-	LogfilesLocalActionThree                       string            `json:"logfiles.local.action_three"`
-	LogfilesLocalActionTwo                         string            `json:"logfiles.local.action_two"`
-	LogfilesLocalDeleteAfterDays                   struct{}          `json:"logfiles.local.delete_after_days"`
-	LogfilesLocalPercentageOne                     int64             `json:"logfiles.local.percentage_one"`
-	LogfilesLocalPercentageThree                   int64             `json:"logfiles.local.percentage_three"`
-	LogfilesLocalPercentageTwo                     int64             `json:"logfiles.local.percentage_two"`
-	LogfilesLocalStatus                            bool              `json:"logfiles.local.status"`
-	LogfilesRemoteFtpService                       string            `json:"logfiles.remote.ftp_service"`
-	LogfilesRemoteHost                             string            `json:"logfiles.remote.host"`
-	LogfilesRemotePass                             string            `json:"logfiles.remote.pass"`
-	LogfilesRemotePath                             string            `json:"logfiles.remote.path"`
-	LogfilesRemoteSmbWorkgroup                     string            `json:"logfiles.remote.smb_workgroup"`
-	LogfilesRemoteSmtpAddress                      string            `json:"logfiles.remote.smtp_address"`
-	LogfilesRemoteStatus                           bool              `json:"logfiles.remote.status"`
-	LogfilesRemoteType                             string            `json:"logfiles.remote.type"`
-	LogfilesRemoteUser                             string            `json:"logfiles.remote.user"`
-	MasqRules                                      []string          `json:"masq.rules"`
-	MigrationAccessToken                           string            `json:"migration.access_token"`
-	MigrationLocalOverride                         bool              `json:"migration.local_override"`
-	MigrationRefreshToken                          string            `json:"migration.refresh_token"`
-	MigrationTabVisibility                         string            `json:"migration.tab_visibility"`
-	MigrationToolsetVersion                        string            `json:"migration.toolset_version"`
-	MigrationUtmVersion                            string            `json:"migration.utm_version"`
-	MobileControlCa                                string            `json:"mobile_control.ca"`
-	MobileControlConfigCisco                       bool              `json:"mobile_control.config.cisco"`
-	MobileControlConfigEapMethod                   string            `json:"mobile_control.config.eap_method"`
-	MobileControlConfigForcePush                   bool              `json:"mobile_control.config.force_push"`
-	MobileControlConfigL2Tp                        bool              `json:"mobile_control.config.l2tp"`
-	MobileControlConfigWifiNetworks                []interface{}     `json:"mobile_control.config.wifi_networks"`
-	MobileControlCustomer                          string            `json:"mobile_control.customer"`
-	MobileControlDebug                             bool              `json:"mobile_control.debug"`
-	MobileControlNacCisco                          bool              `json:"mobile_control.nac.cisco"`
-	MobileControlNacDenyAllVpn                     bool              `json:"mobile_control.nac.deny_all_vpn"`
-	MobileControlNacL2Tp                           bool              `json:"mobile_control.nac.l2tp"`
-	MobileControlNacMacsAllowed                    string            `json:"mobile_control.nac.macs_allowed"`
-	MobileControlNacMacsDenied                     string            `json:"mobile_control.nac.macs_denied"`
-	MobileControlNacPollInterval                   int64             `json:"mobile_control.nac.poll_interval"`
-	MobileControlNacUsersDenied                    []interface{}     `json:"mobile_control.nac.users_denied"`
-	MobileControlNacWifiNetworks                   []interface{}     `json:"mobile_control.nac.wifi_networks"`
-	MobileControlPassword                          string            `json:"mobile_control.password"`
-	MobileControlServer                            string            `json:"mobile_control.server"`
-	MobileControlStatus                            bool              `json:"mobile_control.status"`
-	MobileControlUsername                          string            `json:"mobile_control.username"`
-	NatRules                                       []string          `json:"nat.rules"`
-	NotificationsDeviceInfo                        string            `json:"notifications.device_info"`
-	NotificationsLimiting                          bool              `json:"notifications.limiting"`
-	NotificationsOverlay                           []interface{}     `json:"notifications.overlay"`
-	NotificationsRebootReason                      struct {
-		Zero string `json:"0"`
-		One  string `json:"1"`
-	} `json:"notifications.reboot_reason"`
-	NotificationsRecipients                              []string      `json:"notifications.recipients"`
-	NotificationsSender                                  string        `json:"notifications.sender"`
-	NotificationsSmtpAuthentication                      bool          `json:"notifications.smtp.authentication"`
-	NotificationsSmtpPassword                            string        `json:"notifications.smtp.password"`
-	NotificationsSmtpPort                                int64         `json:"notifications.smtp.port"`
-	NotificationsSmtpServer                              string        `json:"notifications.smtp.server"`
-	NotificationsSmtpStatus                              bool          `json:"notifications.smtp.status"`
-	NotificationsSmtpTls                                 bool          `json:"notifications.smtp.tls"`
-	NotificationsSmtpUsername                            string        `json:"notifications.smtp.username"`
-	NTPAllowedNetworks                                   []string      `json:"ntp.allowed_networks"`
-	NTPServers                                           []string      `json:"ntp.servers"`
-	NTPStatus                                            bool          `json:"ntp.status"`
-	PacketfilterAdvancedBlockInvalidCtPackets            bool          `json:"packetfilter.advanced.block_invalid_ct_packets"`
-	PacketfilterAdvancedCheckPacketLength                bool          `json:"packetfilter.advanced.check_packet_length"`
-	PacketfilterAdvancedConntrackHelpers                 []string      `json:"packetfilter.advanced.conntrack_helpers"`
-	PacketfilterAdvancedFtpPorts                         []int64       `json:"packetfilter.advanced.ftp_ports"`
-	PacketfilterAdvancedLogBroadcasts                    bool          `json:"packetfilter.advanced.log_broadcasts"`
-	PacketfilterAdvancedLogDNSRequests                   bool          `json:"packetfilter.advanced.log_dns_requests"`
-	PacketfilterAdvancedLogFtpData                       bool          `json:"packetfilter.advanced.log_ftp_data"`
-	PacketfilterAdvancedLogInvalid                       struct{}      `json:"packetfilter.advanced.log_invalid"`
-	PacketfilterAdvancedLogMcast                         bool          `json:"packetfilter.advanced.log_mcast"`
-	PacketfilterAdvancedLogStrictTcpState                string        `json:"packetfilter.advanced.log_strict_tcp_state"`
-	PacketfilterAdvancedNoErrorReplay                    bool          `json:"packetfilter.advanced.no_error_replay"`
-	PacketfilterAdvancedOptimizeIpset                    string        `json:"packetfilter.advanced.optimize.ipset"`
-	PacketfilterAdvancedOptimizePorts                    bool          `json:"packetfilter.advanced.optimize.ports"`
-	PacketfilterAdvancedSpoofProtection                  string        `json:"packetfilter.advanced.spoof_protection"`
-	PacketfilterAdvancedStrictTcpState                   bool          `json:"packetfilter.advanced.strict_tcp_state"`
-	PacketfilterAdvancedTcpMaxRetrans                    int64         `json:"packetfilter.advanced.tcp_max_retrans"`
-	PacketfilterAdvancedTcpWindowScaling                 bool          `json:"packetfilter.advanced.tcp_window_scaling"`
-	PacketfilterRules                                    []string      `json:"packetfilter.rules"`
-	PacketfilterRulesAuto                                []string      `json:"packetfilter.rules_auto"`
-	PacketfilterRulesBack                                string        `json:"packetfilter.rules_back"`
-	PacketfilterRulesFront                               string        `json:"packetfilter.rules_front"`
-	PacketfilterTimeoutsIpConntrackGenericTimeout        int64         `json:"packetfilter.timeouts.ip_conntrack_generic_timeout"`
-	PacketfilterTimeoutsIpConntrackIcmpTimeout           int64         `json:"packetfilter.timeouts.ip_conntrack_icmp_timeout"`
-	PacketfilterTimeoutsIpConntrackTcpTimeoutClose       int64         `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_close"`
-	PacketfilterTimeoutsIpConntrackTcpTimeoutCloseWait   int64         `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_close_wait"`
-	PacketfilterTimeoutsIpConntrackTcpTimeoutEstablished int64         `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_established"`
-	PacketfilterTimeoutsIpConntrackTcpTimeoutFinWait     int64         `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_fin_wait"`
-	PacketfilterTimeoutsIpConntrackTcpTimeoutLastAck     int64         `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_last_ack"`
-	PacketfilterTimeoutsIpConntrackTcpTimeoutMaxRetrans  int64         `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_max_retrans"`
-	PacketfilterTimeoutsIpConntrackTcpTimeoutSynRecv     int64         `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_syn_recv"`
-	PacketfilterTimeoutsIpConntrackTcpTimeoutSynSent     int64         `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_syn_sent"`
-	PacketfilterTimeoutsIpConntrackTcpTimeoutTimeWait    int64         `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_time_wait"`
-	PacketfilterTimeoutsIpConntrackUdpTimeout            int64         `json:"packetfilter.timeouts.ip_conntrack_udp_timeout"`
-	PacketfilterTimeoutsIpConntrackUdpTimeoutStream      int64         `json:"packetfilter.timeouts.ip_conntrack_udp_timeout_stream"`
-	PasswdLoginuserClearpass                             string        `json:"passwd.loginuser.clearpass"`
-	PasswdLoginuserCryptpass                             string        `json:"passwd.loginuser.cryptpass"`
-	PasswdRootClearpass                                  string        `json:"passwd.root.clearpass"`
-	PasswdRootCryptpass                                  string        `json:"passwd.root.cryptpass"`
-	PdfPaper                                             string        `json:"pdf.paper"`
-	PimSmAutoPfOut                                       string        `json:"pim_sm.auto_pf_out"`
-	PimSmAutoPfrule                                      bool          `json:"pim_sm.auto_pfrule"`
-	PimSmDebug                                           bool          `json:"pim_sm.debug"`
-	PimSmInterfaces                                      []interface{} `json:"pim_sm.interfaces"`
-	PimSmRpRouters                                       []interface{} `json:"pim_sm.rp_routers"`
-	PimSmSptSwitchBytes                                  int64         `json:"pim_sm.spt_switch_bytes"`
-	PimSmSptSwitchStatus                                 bool          `json:"pim_sm.spt_switch_status"`
-	PimSmStatus                                          bool          `json:"pim_sm.status"`
-	Pop3AllowedClients                                   []interface{} `json:"pop3.allowed_clients"`
-	Pop3AllowedServers                                   []string      `json:"pop3.allowed_servers"`
-	Pop3CffAsMarker                                      string        `json:"pop3.cff_as_marker"`
-	Pop3CffAv                                            bool          `json:"pop3.cff_av"`
-	Pop3CffAvAction                                      string        `json:"pop3.cff_av_action"`
-	Pop3CffAvEngines                                     string        `json:"pop3.cff_av_engines"`
-	Pop3CffFileExtensions                                []string      `json:"pop3.cff_file_extensions"`
-	Pop3DirectlyDeleteQuarantined                        bool          `json:"pop3.directly_delete_quarantined"`
-	Pop3Exceptions                                       []interface{} `json:"pop3.exceptions"`
-	Pop3KnownServers                                     []interface{} `json:"pop3.known_servers"`
-	Pop3MaxMessageSize                                   int64         `json:"pop3.max_message_size"`
-	Pop3PrefetchingInterval                              int64         `json:"pop3.prefetching.interval"`
-	Pop3PrefetchingOptimizeStorage                       bool          `json:"pop3.prefetching.optimize_storage"`
-	Pop3PrefetchingStatus                                bool          `json:"pop3.prefetching.status"`
-	Pop3PrefetchingStorageMinHoldDays                    int64         `json:"pop3.prefetching.storage_min_hold_days"`
-	Pop3QuarantineUnscannable                            bool          `json:"pop3.quarantine_unscannable"`
-	Pop3SandboxMaxFilesizeMb                             int64         `json:"pop3.sandbox_max_filesize_mb"`
-	Pop3SandboxScanStatus                                bool          `json:"pop3.sandbox_scan_status"`
-	Pop3ScanTLS                                          bool          `json:"pop3.scan_tls"`
-	Pop3SenderBlacklist                                  []interface{} `json:"pop3.sender_blacklist"`
-	Pop3Spam                                             string        `json:"pop3.spam"`
-	Pop3SpamExpressions                                  []interface{} `json:"pop3.spam_expressions"`
-	Pop3Spamplus                                         string        `json:"pop3.spamplus"`
-	Pop3Spamstatus                                       bool          `json:"pop3.spamstatus"`
-	Pop3Status                                           bool          `json:"pop3.status"`
-	Pop3TlsCert                                          string        `json:"pop3.tls_cert"`
-	Pop3TransparentSkip                                  []interface{} `json:"pop3.transparent_skip"`
-	Pop3TransparentSkipAutoPf                            bool          `json:"pop3.transparent_skip_auto_pf"`
-	Pop3UserCharset                                      string        `json:"pop3.user_charset"`
-	PortalAllowAnyUser                                   bool          `json:"portal.allow_any_user"`
-	PortalAllowedNetworks                                []string      `json:"portal.allowed_networks"`
-	PortalAllowedUsers                                   []interface{} `json:"portal.allowed_users"`
-	PortalHideItems                                      []interface{} `json:"portal.hide_items"`
-	PortalHostname                                       string        `json:"portal.hostname"`
-	PortalInterfaceAddress                               string        `json:"portal.interface_address"`
-	PortalLanguage                                       string        `json:"portal.language"`
-	PortalPersistentCookies                              bool          `json:"portal.persistent_cookies"`
-	PortalPort                                           int64         `json:"portal.port"`
-	PortalStatus                                         bool          `json:"portal.status"`
-	PortalWelcomeMsg                                     string        `json:"portal.welcome_msg"`
-	PsdAction                                            string        `json:"psd.action"`
-	PsdDelayThreshold                                    int64         `json:"psd.delay_threshold"`
-	PsdHiPortsWeight                                     int64         `json:"psd.hi_ports_weight"`
-	PsdLoPortsWeight                                     int64         `json:"psd.lo_ports_weight"`
-	PsdLogLimiterBurst                                   int64         `json:"psd.log_limiter.burst"`
-	PsdLogLimiterRate                                    int64         `json:"psd.log_limiter.rate"`
-	PsdLogLimiterStatus                                  bool          `json:"psd.log_limiter.status"`
-	PsdStatus                                            bool          `json:"psd.status"`
-	PsdWeightThreshold                                   int64         `json:"psd.weight_threshold"`
-	QosAdvancedEcn                                       int64         `json:"qos.advanced.ecn"`
-	QosAdvancedKeepClassAfterEncap                       bool          `json:"qos.advanced.keep_class_after_encap"`
-	QosInterfaces                                        []string      `json:"qos.interfaces"`
-	QuarantineKeepDBLogDays                              int64         `json:"quarantine.keep_db_log_days"`
-	QuarantineKeepQuarantineDays                         int64         `json:"quarantine.keep_quarantine_days"`
-	RedActivateProvFw                                    bool          `json:"red.activate_prov_fw"`
-	RedAuthorization                                     bool          `json:"red.authorization"`
-	RedCaSettingsCity                                    string        `json:"red.ca_settings.city"`
-	RedCaSettingsCountry                                 string        `json:"red.ca_settings.country"`
-	RedCaSettingsEmail                                   string        `json:"red.ca_settings.email"`
-	RedCaSettingsOrganization                            string        `json:"red.ca_settings.organization"`
-	RedClients                                           []interface{} `json:"red.clients"`
-	RedDeauthTimeout                                     string        `json:"red.deauth_timeout"`
-	RedOverlayFwEnabled                                  bool          `json:"red.overlay_fw_enabled"`
-	RedRegistryCert                                      string        `json:"red.registry_cert"`
-	RedRegistryID                                        string        `json:"red.registry_id"`
-	RedRegistryKey                                       string        `json:"red.registry_key"`
-	RedServerCert                                        string        `json:"red.server_cert"`
-	RedServers                                           []interface{} `json:"red.servers"`
-	RedStatus                                            bool          `json:"red.status"`
-	RedTls12Only                                         bool          `json:"red.tls_1_2_only"`
-	RemoteAccessAdvancedMsdns1                           string        `json:"remote_access.advanced.msdns1"`
-	RemoteAccessAdvancedMsdns2                           string        `json:"remote_access.advanced.msdns2"`
-	RemoteAccessAdvancedMsdomain                         string        `json:"remote_access.advanced.msdomain"`
-	RemoteAccessAdvancedMswins1                          string        `json:"remote_access.advanced.mswins1"`
-	RemoteAccessAdvancedMswins2                          string        `json:"remote_access.advanced.mswins2"`
-	RemoteAccessCisco                                    string        `json:"remote_access.cisco"`
-	RemoteAccessClientlessVpnDebug                       bool          `json:"remote_access.clientless_vpn.debug"`
-	RemoteAccessClientlessVpnStatus                      bool          `json:"remote_access.clientless_vpn.status"`
-	RemoteAccessL2Tp                                     string        `json:"remote_access.l2tp"`
-	RemoteAccessPptpAaa                                  []string      `json:"remote_access.pptp.aaa"`
-	RemoteAccessPptpAuthentication                       string        `json:"remote_access.pptp.authentication"`
-	RemoteAccessPptpDebug                                bool          `json:"remote_access.pptp.debug"`
-	RemoteAccessPptpEncryption                           string        `json:"remote_access.pptp.encryption"`
-	RemoteAccessPptpIpAssignmentDhcp                     string        `json:"remote_access.pptp.ip_assignment_dhcp"`
-	RemoteAccessPptpIpAssignmentDhcpInterface            string        `json:"remote_access.pptp.ip_assignment_dhcp_interface"`
-	RemoteAccessPptpIpAssignmentMode                     string        `json:"remote_access.pptp.ip_assignment_mode"`
-	RemoteAccessPptpIpAssignmentPool                     string        `json:"remote_access.pptp.ip_assignment_pool"`
-	RemoteAccessPptpIphoneConnectionName                 string        `json:"remote_access.pptp.iphone_connection_name"`
-	RemoteAccessPptpIphoneHostname                       string        `json:"remote_access.pptp.iphone_hostname"`
-	RemoteAccessPptpIphoneStatus                         bool          `json:"remote_access.pptp.iphone_status"`
-	RemoteAccessPptpMtu                                  int64         `json:"remote_access.pptp.mtu"`
-	RemoteAccessPptpStatus                               bool          `json:"remote_access.pptp.status"`
-	RemoteSyslogBuffer                                   int64         `json:"remote_syslog.buffer"`
-	RemoteSyslogLogs                                     []interface{} `json:"remote_syslog.logs"`
-	RemoteSyslogStatus                                   bool          `json:"remote_syslog.status"`
-	RemoteSyslogTarget                                   []interface{} `json:"remote_syslog.target"`
-	ReportingAccountingKeepdays                          int64         `json:"reporting.accounting_keepdays"`
-	ReportingAccountingStatus                            bool          `json:"reporting.accounting_status"`
-	ReportingAnonymizing                                 bool          `json:"reporting.anonymizing"`
-	ReportingAppctrlKeepdays                             int64         `json:"reporting.appctrl_keepdays"`
-	ReportingAppctrlStatus                               bool          `json:"reporting.appctrl_status"`
-	ReportingAtpKeepdays                                 int64         `json:"reporting.atp_keepdays"`
-	ReportingAtpReset                                    struct{}      `json:"reporting.atp_reset"`
-	ReportingAtpStatus                                   bool          `json:"reporting.atp_status"`
-	ReportingAuthenticationKeepdays                      int64         `json:"reporting.authentication_keepdays"`
-	ReportingAuthenticationStatus                        bool          `json:"reporting.authentication_status"`
-	ReportingCsvSeparator                                string        `json:"reporting.csv_separator"`
-	ReportingEmailsecurityImport                         []interface{} `json:"reporting.emailsecurity_import"`
-	ReportingEmailsecurityKeepdays                       int64         `json:"reporting.emailsecurity_keepdays"`
-	ReportingEmailsecurityStatus                         bool          `json:"reporting.emailsecurity_status"`
-	ReportingEnableVpnAccounting                         bool          `json:"reporting.enable_vpn_accounting"`
-	ReportingHideAccountingips                           []interface{} `json:"reporting.hide_accountingips"`
-	ReportingHideMailaddresses                           []interface{} `json:"reporting.hide_mailaddresses"`
-	ReportingHideMaildomains                             []interface{} `json:"reporting.hide_maildomains"`
-	ReportingHideNetsecips                               []interface{} `json:"reporting.hide_netsecips"`
-	ReportingHideWebdomains                              []interface{} `json:"reporting.hide_webdomains"`
-	ReportingIpsImport                                   []interface{} `json:"reporting.ips_import"`
-	ReportingIpsKeepdays                                 int64         `json:"reporting.ips_keepdays"`
-	ReportingIpsStatus                                   bool          `json:"reporting.ips_status"`
-	ReportingPacketfilterImport                          []interface{} `json:"reporting.packetfilter_import"`
-	ReportingPacketfilterKeepdays                        int64         `json:"reporting.packetfilter_keepdays"`
-	ReportingPacketfilterStatus                          bool          `json:"reporting.packetfilter_status"`
-	ReportingPassword1                                   string        `json:"reporting.password1"`
-	ReportingPassword2                                   string        `json:"reporting.password2"`
-	ReportingSandboxKeepdays                             int64         `json:"reporting.sandbox_keepdays"`
-	ReportingUserlogFromLogs                             bool          `json:"reporting.userlog_from_logs"`
-	ReportingVpnKeepdays                                 int64         `json:"reporting.vpn_keepdays"`
-	ReportingVpnStatus                                   bool          `json:"reporting.vpn_status"`
-	ReportingWafKeepdays                                 int64         `json:"reporting.waf_keepdays"`
-	ReportingWafStatus                                   bool          `json:"reporting.waf_status"`
-	ReportingWebsecurityDetail                           struct{}      `json:"reporting.websecurity_detail"`
-	ReportingWebsecurityImport                           []interface{} `json:"reporting.websecurity_import"`
-	ReportingWebsecurityKeepdays                         int64         `json:"reporting.websecurity_keepdays"`
-	ReportingWebsecurityStatus                           bool          `json:"reporting.websecurity_status"`
-	ReverseProxyAuaRefreshEnabled                        bool          `json:"reverse_proxy.aua_refresh_enabled"`
-	ReverseProxyAuaRefreshInterval                       int64         `json:"reverse_proxy.aua_refresh_interval"`
-	ReverseProxyBlacklistDnsrblZones                     []string      `json:"reverse_proxy.blacklist.dnsrbl_zones"`
-	ReverseProxyBlacklistGeoipCodes                      []string      `json:"reverse_proxy.blacklist.geoip_codes"`
-	ReverseProxyCookiesignkey                            string        `json:"reverse_proxy.cookiesignkey"`
-	ReverseProxyCssdHostname                             string        `json:"reverse_proxy.cssd_hostname"`
-	ReverseProxyCssdPort                                 struct{}      `json:"reverse_proxy.cssd_port"`
-	ReverseProxyCustomThreatFiltersEnabled               bool          `json:"reverse_proxy.custom_threat_filters_enabled"`
-	ReverseProxyFormhardeningSecret                      string        `json:"reverse_proxy.formhardening_secret"`
-	ReverseProxyKeepalive                                bool          `json:"reverse_proxy.keepalive"`
-	ReverseProxyManualmode                               bool          `json:"reverse_proxy.manualmode"`
-	ReverseProxyMaxConnectionsPerChild                   struct{}      `json:"reverse_proxy.max_connections_per_child"`
-	ReverseProxyMaxPreforkProcesses                      int64         `json:"reverse_proxy.max_prefork_processes"`
-	ReverseProxyMaxProcesses                             int64         `json:"reverse_proxy.max_processes"`
-	ReverseProxyMaxSessionFiles                          int64         `json:"reverse_proxy.max_session_files"`
-	ReverseProxyMaxSpareProcesses                        int64         `json:"reverse_proxy.max_spare_processes"`
-	ReverseProxyMaxSpareThreads                          int64         `json:"reverse_proxy.max_spare_threads"`
-	ReverseProxyMaxThreadsPerProcess                     int64         `json:"reverse_proxy.max_threads_per_process"`
-	ReverseProxyMinSpareProcesses                        int64         `json:"reverse_proxy.min_spare_processes"`
-	ReverseProxyMinSpareThreads                          int64         `json:"reverse_proxy.min_spare_threads"`
-	ReverseProxyMinTLS                                   string        `json:"reverse_proxy.min_tls"`
-	ReverseProxyModsecurityBeta                          bool          `json:"reverse_proxy.modsecurity_beta"`
-	ReverseProxyMpmMode                                  string        `json:"reverse_proxy.mpm_mode"`
-	ReverseProxyPatternversion                           string        `json:"reverse_proxy.patternversion"`
-	ReverseProxyPort                                     int64         `json:"reverse_proxy.port"`
-	ReverseProxyProxyprotocol                            bool          `json:"reverse_proxy.proxyprotocol"`
-	ReverseProxyRequestLineLimit                         struct{}      `json:"reverse_proxy.request_line_limit"`
-	ReverseProxySlowhttpExceptions                       []interface{} `json:"reverse_proxy.slowhttp_exceptions"`
-	ReverseProxySlowhttpRequestHeaderTimeoutBase         int64         `json:"reverse_proxy.slowhttp_request_header_timeout_base"`
-	ReverseProxySlowhttpRequestHeaderTimeoutEnabled      bool          `json:"reverse_proxy.slowhttp_request_header_timeout_enabled"`
-	ReverseProxySlowhttpRequestHeaderTimeoutMax          int64         `json:"reverse_proxy.slowhttp_request_header_timeout_max"`
-	ReverseProxySlowhttpRequestHeaderTimeoutRate         int64         `json:"reverse_proxy.slowhttp_request_header_timeout_rate"`
-	ReverseProxyStatus                                   bool          `json:"reverse_proxy.status"`
-	ReverseProxyTraceEnabled                             bool          `json:"reverse_proxy.trace_enabled"`
-	ReverseProxyUrlhardeningsignkey                      string        `json:"reverse_proxy.urlhardeningsignkey"`
-	ReverseProxyWhatkilledus                             bool          `json:"reverse_proxy.whatkilledus"`
-	RoutesPolicy                                         []interface{} `json:"routes.policy"`
-	RoutesStatic                                         []string      `json:"routes.static"`
-	RoutingBgpMaximumPaths                               struct{}      `json:"routing.bgp.maximum_paths"`
-	RoutingBgpMaximumPathsIbgp                           struct{}      `json:"routing.bgp.maximum_paths_ibgp"`
-	RoutingBgpMultipleAs                                 bool          `json:"routing.bgp.multiple_as"`
-	RoutingBgpStatus                                     bool          `json:"routing.bgp.status"`
-	RoutingBgpStrictMatch                                bool          `json:"routing.bgp.strict_match"`
-	RoutingBgpSystems                                    []interface{} `json:"routing.bgp.systems"`
-	RoutingOspfAbrType                                   string        `json:"routing.ospf.abr_type"`
-	RoutingOspfAreas                                     []interface{} `json:"routing.ospf.areas"`
-	RoutingOspfDefaultInformation                        string        `json:"routing.ospf.default_information"`
-	RoutingOspfDefaultRouteMetric                        int64         `json:"routing.ospf.default_route_metric"`
-	RoutingOspfRedistributeBgpMetric                     int64         `json:"routing.ospf.redistribute.bgp.metric"`
-	RoutingOspfRedistributeBgpStatus                     bool          `json:"routing.ospf.redistribute.bgp.status"`
-	RoutingOspfRedistributeConnectedMetric               int64         `json:"routing.ospf.redistribute.connected.metric"`
-	RoutingOspfRedistributeConnectedStatus               bool          `json:"routing.ospf.redistribute.connected.status"`
-	RoutingOspfRedistributeIpsecStatus                   bool          `json:"routing.ospf.redistribute.ipsec.status"`
-	RoutingOspfRedistributeSslVpnStatus                  bool          `json:"routing.ospf.redistribute.ssl_vpn.status"`
-	RoutingOspfRedistributeStaticMetric                  int64         `json:"routing.ospf.redistribute.static.metric"`
-	RoutingOspfRedistributeStaticStatus                  bool          `json:"routing.ospf.redistribute.static.status"`
-	RoutingOspfReferenceBandwidth                        struct{}      `json:"routing.ospf.reference_bandwidth"`
-	RoutingOspfRouterID                                  string        `json:"routing.ospf.router_id"`
-	RoutingOspfStatus                                    bool          `json:"routing.ospf.status"`
-	RoutingQuaggaAllowedNetworks                         []interface{} `json:"routing.quagga.allowed_networks"`
-	RoutingQuaggaEnablePassword                          string        `json:"routing.quagga.enable_password"`
-	RoutingQuaggaLinkDetect                              bool          `json:"routing.quagga.link_detect"`
-	RoutingQuaggaPassword                                string        `json:"routing.quagga.password"`
-	SandboxReportdDebug                                  string        `json:"sandbox_reportd.debug"`
-	SandboxReportdPollInterval                           int64         `json:"sandbox_reportd.poll_interval"`
-	SandboxReportdRequestTimeout                         int64         `json:"sandbox_reportd.request_timeout"`
-	SandboxReportdRetryTimes                             int64         `json:"sandbox_reportd.retry_times"`
-	SandboxdBypassSandboxLimit                           int64         `json:"sandboxd.bypass_sandbox_limit"`
-	SandboxdCacheExpire                                  int64         `json:"sandboxd.cache_expire"`
-	SandboxdCertstore                                    string        `json:"sandboxd.certstore"`
-	SandboxdClientTimeout                                int64         `json:"sandboxd.client_timeout"`
-	SandboxdCloudPollInterval                            int64         `json:"sandboxd.cloud_poll_interval"`
-	SandboxdCloudPollRequestMaximum                      int64         `json:"sandboxd.cloud_poll_request_maximum"`
-	SandboxdCloudPollTimeout                             int64         `json:"sandboxd.cloud_poll_timeout"`
-	SandboxdConnectTimeout                               int64         `json:"sandboxd.connect_timeout"`
-	SandboxdDebug                                        struct{}      `json:"sandboxd.debug"`
-	SandboxdDhparams2048                                 string        `json:"sandboxd.dhparams_2048"`
-	SandboxdEdgeServerCertValidation                     bool          `json:"sandboxd.edge_server_cert_validation"`
-	SandboxdEdgeServerHost                               string        `json:"sandboxd.edge_server_host"`
-	SandboxdEdgeServerPort                               int64         `json:"sandboxd.edge_server_port"`
-	SandboxdFiletypeSkiplist                             []interface{} `json:"sandboxd.filetype_skiplist"`
-	SandboxdNumOfEventThreads                            int64         `json:"sandboxd.num_of_event_threads"`
-	SandboxdNumOfWorkerThreads                           int64         `json:"sandboxd.num_of_worker_threads"`
-	SandboxdResponseTimeout                              int64         `json:"sandboxd.response_timeout"`
-	SandboxdRetryIntervals                               string        `json:"sandboxd.retry_intervals"`
-	SandboxdSandboxEnabled                               bool          `json:"sandboxd.sandbox_enabled"`
-	SandboxdSandboxdScoreThreshold                       int64         `json:"sandboxd.sandboxd_score_threshold"`
-	SandboxdSbxVersion                                   int64         `json:"sandboxd.sbx_version"`
-	SandboxdSqliteBusyTimeout                            int64         `json:"sandboxd.sqlite_busy_timeout"`
-	SandboxdSslCertFile                                  string        `json:"sandboxd.ssl_cert_file"`
-	SandboxdSslKeyFile                                   string        `json:"sandboxd.ssl_key_file"`
-	SandboxdSslciphers                                   string        `json:"sandboxd.sslciphers"`
-	SettingsAdminEmail                                   string        `json:"settings.admin_email"`
-	SettingsBasicSetup                                   bool          `json:"settings.basic_setup"`
-	SettingsCcMode                                       bool          `json:"settings.cc_mode"`
-	SettingsCity                                         string        `json:"settings.city"`
-	SettingsCountry                                      string        `json:"settings.country"`
-	SettingsExtraSwap                                    struct{}      `json:"settings.extra_swap"`
-	SettingsHostname                                     string        `json:"settings.hostname"`
-	SettingsIcsaMode                                     bool          `json:"settings.icsa_mode"`
-	SettingsOrganization                                 string        `json:"settings.organization"`
-	SettingsPasswordComplexityMinDigits                  int64         `json:"settings.password_complexity.min_digits"`
-	SettingsPasswordComplexityMinLength                  int64         `json:"settings.password_complexity.min_length"`
-	SettingsPasswordComplexityMinLowerChars              int64         `json:"settings.password_complexity.min_lower_chars"`
-	SettingsPasswordComplexityMinSpecialChars            int64         `json:"settings.password_complexity.min_special_chars"`
-	SettingsPasswordComplexityMinUpperChars              int64         `json:"settings.password_complexity.min_upper_chars"`
-	SettingsPasswordComplexityStatus                     bool          `json:"settings.password_complexity.status"`
-	SettingsPopularity                                   string        `json:"settings.popularity"`
-	SettingsRasUpdate                                    string        `json:"settings.ras_update"`
-	SettingsSystemID                                     string        `json:"settings.system_id"`
-	SettingsTimezone                                     string        `json:"settings.timezone"`
-	SipAllowedNetworks                                   []string      `json:"sip.allowed_networks"`
-	SipExpectMode                                        string        `json:"sip.expect_mode"`
-	SipLogRelated                                        bool          `json:"sip.log_related"`
-	SipServers                                           []string      `json:"sip.servers"`
-	SipStatus                                            bool          `json:"sip.status"`
-	SmsClientHostname                                    string        `json:"sms_client.hostname"`
-	SmsClientName                                        string        `json:"sms_client.name"`
-	SmsClientPassword                                    string        `json:"sms_client.password"`
-	SmsClientPort                                        struct{}      `json:"sms_client.port"`
-	SmsClientStatus                                      bool          `json:"sms_client.status"`
-	SmsClientUsername                                    string        `json:"sms_client.username"`
-	SMTPAuthAaa                                          []interface{} `json:"smtp.auth_aaa"`
-	SMTPAuthStatus                                       bool          `json:"smtp.auth_status"`
-	SMTPAvFooter                                         string        `json:"smtp.av_footer"`
-	SMTPAvFooterStatus                                   bool          `json:"smtp.av_footer_status"`
-	SMTPBatvSecret                                       string        `json:"smtp.batv_secret"`
-	SMTPBlockerService                                   string        `json:"smtp.blocker_service"`
-	SMTPCffAsMarker                                      string        `json:"smtp.cff_as_marker"`
-	SMTPDkimDomains                                      []interface{} `json:"smtp.dkim_domains"`
-	SMTPDkimPrivateKey                                   string        `json:"smtp.dkim_private_key"`
-	SMTPDkimSelector                                     string        `json:"smtp.dkim_selector"`
-	SMTPEnableOldExpressionFilter                        bool          `json:"smtp.enable_old_expression_filter"`
-	SMTPEncryptionUtility                                string        `json:"smtp.encryption_utility"`
-	SMTPExceptions                                       []interface{} `json:"smtp.exceptions"`
-	SMTPFootersMode                                      string        `json:"smtp.footers_mode"`
-	SMTPGlobalAsReject                                   string        `json:"smtp.global_as_reject"`
-	SMTPGlobalAvReject                                   bool          `json:"smtp.global_av_reject"`
-	SMTPGlobalProfile                                    string        `json:"smtp.global_profile"`
-	SMTPHostBlacklist                                    []interface{} `json:"smtp.host_blacklist"`
-	SMTPHostname                                         string        `json:"smtp.hostname"`
-	SMTPMaxMessageSize                                   int64         `json:"smtp.max_message_size"`
-	SMTPMode                                             string        `json:"smtp.mode"`
-	SMTPParentProxyAuthPass                              string        `json:"smtp.parent_proxy_auth_pass"`
-	SMTPParentProxyAuthStatus                            bool          `json:"smtp.parent_proxy_auth_status"`
-	SMTPParentProxyAuthUser                              string        `json:"smtp.parent_proxy_auth_user"`
-	SMTPParentProxyHost                                  string        `json:"smtp.parent_proxy_host"`
-	SMTPParentProxyPort                                  int64         `json:"smtp.parent_proxy_port"`
-	SMTPParentProxyStatus                                bool          `json:"smtp.parent_proxy_status"`
-	SMTPPostmaster                                       string        `json:"smtp.postmaster"`
-	SMTPProfiles                                         []interface{} `json:"smtp.profiles"`
-	SMTPRecipientsMax                                    int64         `json:"smtp.recipients_max"`
-	SMTPRelays                                           []interface{} `json:"smtp.relays"`
-	SMTPScanOutgoingEmails                               bool          `json:"smtp.scan_outgoing_emails"`
-	SMTPScannerPoolMaxPool                               int64         `json:"smtp.scanner_pool.max_pool"`
-	SMTPScannerPoolThresholds                            []int64       `json:"smtp.scanner_pool.thresholds"`
-	SMTPScannerTimeout                                   int64         `json:"smtp.scanner_timeout"`
-	SMTPSmarthostAuth                                    bool          `json:"smtp.smarthost_auth"`
-	SMTPSmarthostHostname                                string        `json:"smtp.smarthost_hostname"`
-	SMTPSmarthostPass                                    string        `json:"smtp.smarthost_pass"`
-	SMTPSmarthostPort                                    int64         `json:"smtp.smarthost_port"`
-	SMTPSmarthostStatus                                  bool          `json:"smtp.smarthost_status"`
-	SMTPSmarthostUser                                    string        `json:"smtp.smarthost_user"`
-	SMTPSmtpAcceptMax                                    int64         `json:"smtp.smtp_accept_max"`
-	SMTPSmtpAcceptPerConnectionMax                       int64         `json:"smtp.smtp_accept_per_connection_max"`
-	SMTPSmtpAcceptPerHostMax                             int64         `json:"smtp.smtp_accept_per_host_max"`
-	SMTPStatus                                           bool          `json:"smtp.status"`
-	SMTPTlsAvoid                                         []interface{} `json:"smtp.tls_avoid"`
-	SMTPTlsCert                                          string        `json:"smtp.tls_cert"`
-	SMTPTlsRequire                                       []interface{} `json:"smtp.tls_require"`
-	SMTPTlsRequireSenderDomains                          []interface{} `json:"smtp.tls_require_sender_domains"`
-	SMTPTlsVersion                                       string        `json:"smtp.tls_version"`
-	SMTPTransparent                                      bool          `json:"smtp.transparent"`
-	SMTPTransparentSkip                                  []interface{} `json:"smtp.transparent_skip"`
-	SMTPTransparentSkipAutoPf                            bool          `json:"smtp.transparent_skip_auto_pf"`
-	SMTPUpstreamHosts                                    []interface{} `json:"smtp.upstream_hosts"`
-	SMTPUpstreamHostsOnly                                bool          `json:"smtp.upstream_hosts_only"`
-	SnmpAllowedNetworks                                  []interface{} `json:"snmp.allowed_networks"`
-	SnmpAuthPassword                                     string        `json:"snmp.auth_password"`
-	SnmpAuthType                                         string        `json:"snmp.auth_type"`
-	SnmpCommunity                                        string        `json:"snmp.community"`
-	SnmpDeviceAdmin                                      string        `json:"snmp.device_admin"`
-	SnmpDeviceLocation                                   string        `json:"snmp.device_location"`
-	SnmpDeviceName                                       string        `json:"snmp.device_name"`
-	SnmpEncryptPassword                                  string        `json:"snmp.encrypt_password"`
-	SnmpEncryptType                                      string        `json:"snmp.encrypt_type"`
-	SnmpStatus                                           bool          `json:"snmp.status"`
-	SnmpTraps                                            []interface{} `json:"snmp.traps"`
-	SnmpTrapsUseOldOids                                  bool          `json:"snmp.traps_use_old_oids"`
-	SnmpUsername                                         string        `json:"snmp.username"`
-	SnmpVersion                                          string        `json:"snmp.version"`
-	SocksAaa                                             []string      `json:"socks.aaa"`
-	SocksAllowedNetworks                                 []string      `json:"socks.allowed_networks"`
-	SocksAuthentication                                  bool          `json:"socks.authentication"`
-	SocksStatus                                          bool          `json:"socks.status"`
-	SpxGlobalErrorNotificationTarget                     string        `json:"spx.global.error_notification_target"`
-	SpxGlobalExpirySettingsAllowSecureReplyDays          int64         `json:"spx.global.expiry_settings.allow_secure_reply_days"`
-	SpxGlobalExpirySettingsKeepDelayedMailDays           int64         `json:"spx.global.expiry_settings.keep_delayed_mail_days"`
-	SpxGlobalExpirySettingsKeepUnusedPwdDays             int64         `json:"spx.global.expiry_settings.keep_unused_pwd_days"`
-	SpxGlobalExpirySettingsRegistrationReminderDays      int64         `json:"spx.global.expiry_settings.registration_reminder_days"`
-	SpxGlobalPasswordStrengthMinLength                   int64         `json:"spx.global.password_strength.min_length"`
-	SpxGlobalPasswordStrengthRequireSpecChars            bool          `json:"spx.global.password_strength.require_spec_chars"`
-	SpxGlobalPortalSettingsAllowedNetworks               []interface{} `json:"spx.global.portal_settings.allowed_networks"`
-	SpxGlobalPortalSettingsHostname                      string        `json:"spx.global.portal_settings.hostname"`
-	SpxGlobalPortalSettingsInterfaceAddress              string        `json:"spx.global.portal_settings.interface_address"`
-	SpxGlobalPortalSettingsPort                          int64         `json:"spx.global.portal_settings.port"`
-	SpxGlobalPoweredByLogo                               string        `json:"spx.global.powered_by_logo"`
-	SpxGlobalSpxPriority                                 bool          `json:"spx.global.spx_priority"`
-	SpxGlobalStatus                                      bool          `json:"spx.global.status"`
-	SpxSpxAuthPassword                                   string        `json:"spx.spx_auth.password"`
-	SpxSpxAuthPort                                       int64         `json:"spx.spx_auth.port"`
-	SpxSpxAuthServer                                     string        `json:"spx.spx_auth.server"`
-	SpxSpxAuthUrl                                        string        `json:"spx.spx_auth.url"`
-	SpxTemplates                                         []string      `json:"spx.templates"`
-	SSHAllowedNetworks                                   []string      `json:"ssh.allowed_networks"`
-	SSHLoginKeys                                         []string      `json:"ssh.login_keys"`
-	SSHPasswordAuth                                      bool          `json:"ssh.password_auth"`
-	SSHPort                                              int64         `json:"ssh.port"`
-	SSHPubkeyAuth                                        bool          `json:"ssh.pubkey_auth"`
-	SSHRootKeys                                          []string      `json:"ssh.root_keys"`
-	SSHRootLogin                                         string        `json:"ssh.root_login"`
-	SSHStatus                                            bool          `json:"ssh.status"`
-	SslVpnAuthenticationAlgorithm                        string        `json:"ssl_vpn.authentication_algorithm"`
-	SslVpnCertificate                                    string        `json:"ssl_vpn.certificate"`
-	SslVpnCompression                                    bool          `json:"ssl_vpn.compression"`
-	SslVpnDatachannelKeyLifetime                         int64         `json:"ssl_vpn.datachannel_key_lifetime"`
-	SslVpnDebug                                          bool          `json:"ssl_vpn.debug"`
-	SslVpnDhKeySize                                      string        `json:"ssl_vpn.dh_key_size"`
-	SslVpnDuplicateCn                                    bool          `json:"ssl_vpn.duplicate_cn"`
-	SslVpnEncryptionAlgorithm                            string        `json:"ssl_vpn.encryption_algorithm"`
-	SslVpnHostname                                       string        `json:"ssl_vpn.hostname"`
-	SslVpnInterface                                      string        `json:"ssl_vpn.interface"`
-	SslVpnInterfaceAddress                               string        `json:"ssl_vpn.interface_address"`
-	SslVpnIpAssignmentPool                               string        `json:"ssl_vpn.ip_assignment_pool"`
-	SslVpnPort                                           int64         `json:"ssl_vpn.port"`
-	SslVpnProtocol                                       string        `json:"ssl_vpn.protocol"`
-	SslVpnUserAuthOptional                               bool          `json:"ssl_vpn.user_auth_optional"`
-	SupportAccessAccessID                                string        `json:"support_access.access_id"`
-	SupportAccessApuServer                               string        `json:"support_access.apu_server"`
-	SupportAccessLifetimeDuration                        string        `json:"support_access.lifetime_duration"`
-	SupportAccessLifetimeEnd                             struct{}      `json:"support_access.lifetime_end"`
-	SupportAccessSshKeys                                 []interface{} `json:"support_access.ssh_keys"`
-	SupportAccessStatus                                  bool          `json:"support_access.status"`
-	U2DcacheAllowedNetworks                              []interface{} `json:"u2dcache.allowed_networks"`
-	U2DcachePort                                         int64         `json:"u2dcache.port"`
-	U2DcacheStatus                                       bool          `json:"u2dcache.status"`
-	Up2DateCacheHost                                     string        `json:"up2date.cache_host"`
-	Up2DateCachePort                                     int64         `json:"up2date.cache_port"`
-	Up2DateCacheStatus                                   bool          `json:"up2date.cache_status"`
-	Up2DateCacheUseAcc                                   bool          `json:"up2date.cache_use_acc"`
-	Up2DateParentProxyAuthPass                           string        `json:"up2date.parent_proxy_auth_pass"`
-	Up2DateParentProxyAuthStatus                         bool          `json:"up2date.parent_proxy_auth_status"`
-	Up2DateParentProxyAuthUser                           string        `json:"up2date.parent_proxy_auth_user"`
-	Up2DateParentProxyHost                               string        `json:"up2date.parent_proxy_host"`
-	Up2DateParentProxyPort                               int64         `json:"up2date.parent_proxy_port"`
-	Up2DateParentProxyStatus                             bool          `json:"up2date.parent_proxy_status"`
-	Up2DatePatternDownloadInterval                       struct{}      `json:"up2date.pattern_download_interval"`
-	Up2DatePatternDownloadStatus                         bool          `json:"up2date.pattern_download_status"`
-	Up2DateScheduledUp2Date                              []interface{} `json:"up2date.scheduled_up2date"`
-	Up2DateServers                                       []string      `json:"up2date.servers"`
-	Up2DateStatus                                        bool          `json:"up2date.status"`
-	Up2DateSystemAutoinstallTime                         string        `json:"up2date.system_autoinstall_time"`
-	Up2DateSystemDownloadInterval                        int64         `json:"up2date.system_download_interval"`
-	Up2DateSystemDownloadStatus                          bool          `json:"up2date.system_download_status"`
-	UplinkActions                                        []interface{} `json:"uplink.actions"`
-	UplinkActive                                         string        `json:"uplink.active"`
-	UplinkCondition                                      string        `json:"uplink.condition"`
-	UplinkInterfaces                                     string        `json:"uplink.interfaces"`
-	UplinkMonitoring                                     bool          `json:"uplink.monitoring"`
-	UplinkPassive                                        string        `json:"uplink.passive"`
-	UplinkPrimary                                        string        `json:"uplink.primary"`
-	UplinkRules                                          []string      `json:"uplink.rules"`
-	UplinkScheduler                                      string        `json:"uplink.scheduler"`
-	UplinkStatus                                         bool          `json:"uplink.status"`
-	WebadminAllowedNetworks                              []string      `json:"webadmin.allowed_networks"`
-	WebadminCa                                           string        `json:"webadmin.ca"`
-	WebadminCert                                         string        `json:"webadmin.cert"`
-	WebadminDashboardRefresh                             string        `json:"webadmin.dashboard_refresh"`
-	WebadminLanguage                                     string        `json:"webadmin.language"`
-	WebadminLogAdminConnect                              bool          `json:"webadmin.log_admin_connect"`
-	WebadminOfferWizard                                  bool          `json:"webadmin.offer_wizard"`
-	WebadminPort                                         int64         `json:"webadmin.port"`
-	WebadminRestAPI                                      bool          `json:"webadmin.rest_api"`
-	WebadminTermsOfUseStatus                             bool          `json:"webadmin.terms_of_use.status"`
-	WebadminTermsOfUseText                               string        `json:"webadmin.terms_of_use.text"`
-	WebadminTimeout                                      int64         `json:"webadmin.timeout"`
-	WebadminTimeoutOnDashboard                           bool          `json:"webadmin.timeout_on_dashboard"`
-	WebadminTlsCiphers                                   string        `json:"webadmin.tls_ciphers"`
-	WebadminTlsProtocols                                 string        `json:"webadmin.tls_protocols"`
+	AccServer1AuthSecret                                 string                 `json:"acc.server1.auth.secret"`
+	AccServer1AuthStatus                                 bool                   `json:"acc.server1.auth.status"`
+	AccServer1Port                                       int64                  `json:"acc.server1.port"`
+	AccServer1Roles                                      []string               `json:"acc.server1.roles"`
+	AccServer1Server                                     string                 `json:"acc.server1.server"`
+	AccServer2AuthSecret                                 string                 `json:"acc.server2.auth.secret"`
+	AccServer2AuthStatus                                 bool                   `json:"acc.server2.auth.status"`
+	AccServer2Port                                       int64                  `json:"acc.server2.port"`
+	AccServer2Roles                                      []string               `json:"acc.server2.roles"`
+	AccServer2Server                                     string                 `json:"acc.server2.server"`
+	AccServer2Status                                     bool                   `json:"acc.server2.status"`
+	AccSsoAdminGroup                                     string                 `json:"acc.sso_admin_group"`
+	AccSsoAuditorGroup                                   string                 `json:"acc.sso_auditor_group"`
+	AccStatus                                            bool                   `json:"acc.status"`
+	AccdAccessAllowedAdmins                              AaaRefs                `json:"accd.access.allowed_admins"`
+	AccdAccessAllowedNetworks                            NetworkRefs            `json:"accd.access.allowed_networks"`
+	AccdAccessAllowedUsers                               AaaRefs                `json:"accd.access.allowed_users"`
+	AccdAccessCert                                       string                 `json:"accd.access.cert"`
+	AccdAccessPort                                       int64                  `json:"accd.access.port"`
+	AccdDevicesAllowedNetworks                           NetworkRefs            `json:"accd.devices.allowed_networks"`
+	AccdDevicesAuthAuto                                  bool                   `json:"accd.devices.auth.auto"`
+	AccdDevicesAuthSecret                                string                 `json:"accd.devices.auth.secret"`
+	AccdDevicesAuthStatus                                bool                   `json:"accd.devices.auth.status"`
+	AccdDevicesCert                                      string                 `json:"accd.devices.cert"`
+	AccdDevicesPort                                      int64                  `json:"accd.devices.port"`
+	AccdGeneralAllowedNetworks                           NetworkRefs            `json:"accd.general.allowed_networks"`
+	AccdGeneralCert                                      string                 `json:"accd.general.cert"`
+	AccdGeneralLanguage                                  string                 `json:"accd.general.language"`
+	AccdGeneralPort                                      int64                  `json:"accd.general.port"`
+	AccdGeneralTimeout                                   int64                  `json:"accd.general.timeout"`
+	AccountingIpfixConnections                           []string               `json:"accounting.ipfix.connections"`
+	AccountingIpfixStatus                                bool                   `json:"accounting.ipfix.status"`
+	AfcControlledNetworks                                NetworkRefs            `json:"afc.controlled_networks"`
+	AfcHiddenSkip                                        NetworkRefs            `json:"afc.hidden_skip"`
+	AfcHttpRedirectURL                                   string                 `json:"afc.http_redirect_url"`
+	AfcLog                                               string                 `json:"afc.log"`
+	AfcNfqueueLength                                     int64                  `json:"afc.nfqueue_length"`
+	AfcNumQueues                                         map[string]interface{} `json:"afc.num_queues"`
+	AfcRules                                             []string               `json:"afc.rules"`
+	AfcStatus                                            bool                   `json:"afc.status"`
+	AfcSubmitUnknownTrafficData                          bool                   `json:"afc.submit_unknown_traffic_data"`
+	AfcTransparentSkip                                   NetworkRefs            `json:"afc.transparent_skip"`
+	AmazonVpcAutoPfrule                                  bool                   `json:"amazon_vpc.auto_pfrule"`
+	AmazonVpcConnections                                 []string               `json:"amazon_vpc.connections"`
+	AmazonVpcNetworks                                    NetworkRefs            `json:"amazon_vpc.networks"`
+	AmazonVpcStatus                                      bool                   `json:"amazon_vpc.status"`
+	AptpPolicy                                           string                 `json:"aptp.policy"`
+	AptpRuleModifiers                                    []string               `json:"aptp.rule_modifiers"`
+	AptpStatus                                           bool                   `json:"aptp.status"`
+	AptpTransparentSkip                                  NetworkRefs            `json:"aptp.transparent_skip"`
+	ArmLicensedIP                                        string                 `json:"arm.licensed_ip"`
+	ArmRemoteHost                                        string                 `json:"arm.remote.host"`
+	ArmRemoteMethod                                      string                 `json:"arm.remote.method"`
+	ArmRemoteSmbPassword                                 string                 `json:"arm.remote.smb_password"`
+	ArmRemoteSmbShare                                    string                 `json:"arm.remote.smb_share"`
+	ArmRemoteSmbUser                                     string                 `json:"arm.remote.smb_user"`
+	ArmRemoteStatus                                      bool                   `json:"arm.remote.status"`
+	ArmRemoteSyslogService                               string                 `json:"arm.remote.syslog_service"`
+	ArmStatus                                            bool                   `json:"arm.status"`
+	AuthAdSsoForceUtf8Sync                               bool                   `json:"auth.ad_sso.force_utf8_sync"`
+	AuthAdSsoJoinresult                                  string                 `json:"auth.ad_sso.joinresult"`
+	AuthAdSsoLoadbalancerFqdn                            string                 `json:"auth.ad_sso.loadbalancer_fqdn"`
+	AuthAdSsoNtlmv2Auth                                  bool                   `json:"auth.ad_sso.ntlmv2_auth"`
+	AuthAdSsoSecrets                                     string                 `json:"auth.ad_sso.secrets"`
+	AuthAdSsoSmbconf                                     string                 `json:"auth.ad_sso.smbconf"`
+	AuthAdSsoSsoDomain                                   string                 `json:"auth.ad_sso.sso_domain"`
+	AuthAdSsoSsoNetbiosDomain                            string                 `json:"auth.ad_sso.sso_netbios_domain"`
+	AuthAdSsoSsoNetbiosHost                              string                 `json:"auth.ad_sso.sso_netbios_host"`
+	AuthAdSsoSsoPassword                                 string                 `json:"auth.ad_sso.sso_password"`
+	AuthAdSsoSsoServer                                   string                 `json:"auth.ad_sso.sso_server"`
+	AuthAdSsoSsoStatus                                   bool                   `json:"auth.ad_sso.sso_status"`
+	AuthAdSsoSsoSync                                     bool                   `json:"auth.ad_sso.sso_sync"`
+	AuthAdSsoSsoUsername                                 string                 `json:"auth.ad_sso.sso_username"`
+	AuthApiTokens                                        map[string]string      `json:"auth.api_tokens"`
+	AuthAutoAddToFacility                                []string               `json:"auth.auto_add_to_facility"`
+	AuthAutoAddUsers                                     bool                   `json:"auth.auto_add_users"`
+	AuthBlockAttempts                                    int64                  `json:"auth.block.attempts"`
+	AuthBlockFacilities                                  []string               `json:"auth.block.facilities"`
+	AuthBlockLockout                                     bool                   `json:"auth.block.lockout"`
+	AuthBlockNever                                       []string               `json:"auth.block.never"`
+	AuthBlockSeconds                                     int64                  `json:"auth.block.seconds"`
+	AuthCacheLifetime                                    int64                  `json:"auth.cache_lifetime"`
+	AuthEdirSsoEmConflict                                string                 `json:"auth.edir_sso.em_conflict"`
+	AuthEdirSsoEmSocketTimeout                           int64                  `json:"auth.edir_sso.em_socket_timeout"`
+	AuthEdirSsoEmVerifyLogout                            bool                   `json:"auth.edir_sso.em_verify_logout"`
+	AuthEdirSsoSsoAuaSearchIP                            bool                   `json:"auth.edir_sso.sso_aua_search_ip"`
+	AuthEdirSsoSsoMode                                   string                 `json:"auth.edir_sso.sso_mode"`
+	AuthEdirSsoSsoServer                                 string                 `json:"auth.edir_sso.sso_server"`
+	AuthEdirSsoSyncInterval                              int64                  `json:"auth.edir_sso.sync_interval"`
+	AuthOtpAutoCreateToken                               bool                   `json:"auth.otp.auto_create_token"`
+	AuthOtpAutoTokenDigest                               string                 `json:"auth.otp.auto_token_digest"`
+	AuthOtpDefaultTimestep                               int64                  `json:"auth.otp.default_timestep"`
+	AuthOtpFacilities                                    []string               `json:"auth.otp.facilities"`
+	AuthOtpMaxInitTimestepDiff                           int64                  `json:"auth.otp.max_init_timestep_diff"`
+	AuthOtpMaxTimestepDiff                               int64                  `json:"auth.otp.max_timestep_diff"`
+	AuthOtpRequireAllUsers                               bool                   `json:"auth.otp.require_all_users"`
+	AuthOtpRequiredUsers                                 AaaRefs                `json:"auth.otp.required_users"`
+	AuthOtpStatus                                        bool                   `json:"auth.otp.status"`
+	AuthServers                                          []string               `json:"auth.servers"`
+	AuthUpdateBackendGroupMembersDebug                   bool                   `json:"auth.update_backend_group_members.debug"`
+	AuthUpdateBackendGroupMembersStatus                  bool                   `json:"auth.update_backend_group_members.status"`
+	AweAllowedInterfaces                                 InterfaceRefs          `json:"awe.allowed_interfaces"`
+	AweClients                                           []string               `json:"awe.clients"`
+	AweDevices                                           []string               `json:"awe.devices"`
+	AweGlobalApAutoaccept                                bool                   `json:"awe.global.ap_autoaccept"`
+	AweGlobalApDebuglevel                                map[string]interface{} `json:"awe.global.ap_debuglevel"`
+	AweGlobalApSoftlimit                                 int64                  `json:"awe.global.ap_softlimit"`
+	AweGlobalApVlantag                                   int64                  `json:"awe.global.ap_vlantag"`
+	AweGlobalAweStatus                                   map[string]interface{} `json:"awe.global.awe_status"`
+	AweGlobalBridgeUpdateKickout                         bool                   `json:"awe.global.bridge_update_kickout"`
+	AweGlobalInitialSetup                                bool                   `json:"awe.global.initial_setup"`
+	AweGlobalLogLevel                                    int64                  `json:"awe.global.log_level"`
+	AweGlobalMagicIP                                     string                 `json:"awe.global.magic_ip"`
+	AweGlobalNotificationTimeout                         int64                  `json:"awe.global.notification_timeout"`
+	AweGlobalRadiusConf                                  string                 `json:"awe.global.radius_conf"`
+	AweGlobalRootpw                                      string                 `json:"awe.global.rootpw"`
+	AweGlobalStayOnline                                  bool                   `json:"awe.global.stay_online"`
+	AweGlobalStoreBssStats                               bool                   `json:"awe.global.store_bss_stats"`
+	AweGlobalTunnelIDOffset                              int64                  `json:"awe.global.tunnel_id_offset"`
+	AweGlobalVlantagging                                 bool                   `json:"awe.global.vlantagging"`
+	AweNetworks                                          NetworkRefs            `json:"awe.networks"`
+	AwscliProfiles                                       []string               `json:"awscli.profiles"`
+	BackupEncryption                                     bool                   `json:"backup.encryption"`
+	BackupInterval                                       string                 `json:"backup.interval"`
+	BackupMaxBackups                                     int64                  `json:"backup.max_backups"`
+	BackupPassword                                       string                 `json:"backup.password"`
+	BackupRecipients                                     []string               `json:"backup.recipients"`
+	BackupStatus                                         bool                   `json:"backup.status"`
+	CaCaGost                                             string                 `json:"ca.ca_gost"`
+	CaCaIpsec                                            string                 `json:"ca.ca_ipsec"`
+	CaCaProxies                                          string                 `json:"ca.ca_proxies"`
+	CaCaRed                                              string                 `json:"ca.ca_red"`
+	CaDefKeysize                                         int64                  `json:"ca.def_keysize"`
+	CaGlobalCasEmailEncryptionTrustNewCas                bool                   `json:"ca.global_cas.email_encryption.trust_new_cas"`
+	CaGlobalCasEmailEncryptionTrusted                    []string               `json:"ca.global_cas.email_encryption.trusted"`
+	CaGlobalCasEmailEncryptionUntrusted                  []string               `json:"ca.global_cas.email_encryption.untrusted"`
+	CaGlobalCasHttpProxyTrustNewCas                      bool                   `json:"ca.global_cas.http_proxy.trust_new_cas"`
+	CaGlobalCasHttpProxyTrusted                          []string               `json:"ca.global_cas.http_proxy.trusted"`
+	CaGlobalCasHttpProxyUntrusted                        []string               `json:"ca.global_cas.http_proxy.untrusted"`
+	CrlsCrls                                             []string               `json:"crls.crls"`
+	CSSAvPrimaryEngine                                   string                 `json:"css.av_primary_engine"`
+	CSSSxlLiveprotection                                 bool                   `json:"css.sxl_liveprotection"`
+	CSSSxlSampleSubmit                                   bool                   `json:"css.sxl_sample_submit"`
+	CustomizationEppLastUpdated                          int64                  `json:"customization.epp.last_updated"`
+	CustomizationEppResourcesRoot                        string                 `json:"customization.epp.resources_root"`
+	CustomizationHttpCustomAssets                        map[string]interface{} `json:"customization.http.custom_assets"`
+	CustomizationHttpCustomTemplates                     map[string]interface{} `json:"customization.http.custom_templates"`
+	CustomizationHttpLastUpdated                         int64                  `json:"customization.http.last_updated"`
+	DebugmodeCrashReport                                 bool                   `json:"debugmode.crash_report"`
+	DebugmodeEnabled                                     bool                   `json:"debugmode.enabled"`
+	DhcpRelayDhcpServer                                  string                 `json:"dhcp.relay.dhcp_server"`
+	DhcpRelayInterfaces                                  InterfaceRefs          `json:"dhcp.relay.interfaces"`
+	DhcpRelayStatus                                      bool                   `json:"dhcp.relay.status"`
+	DhcpRelay6ItfsFacingClients                          []string               `json:"dhcp.relay6.itfs_facing_clients"`
+	DhcpRelay6ItfsFacingServer6                          []string               `json:"dhcp.relay6.itfs_facing_server6"`
+	DhcpRelay6Status                                     bool                   `json:"dhcp.relay6.status"`
+	DhcpServerCustom4                                    string                 `json:"dhcp.server.custom4"`
+	DhcpServerCustom6                                    string                 `json:"dhcp.server.custom6"`
+	DhcpServerServers                                    []string               `json:"dhcp.server.servers"`
+	DigestAllowedNetworks                                NetworkRefs            `json:"digest.allowed_networks"`
+	DigestCustomText                                     string                 `json:"digest.custom_text"`
+	DigestDomains                                        []string               `json:"digest.domains"`
+	DigestHostname                                       string                 `json:"digest.hostname"`
+	DigestMailinglists                                   []string               `json:"digest.mailinglists"`
+	DigestPort                                           int64                  `json:"digest.port"`
+	DigestSendTimeOne                                    string                 `json:"digest.send_time_one"`
+	DigestSendTimeTwo                                    string                 `json:"digest.send_time_two"`
+	DigestSkiplist                                       []string               `json:"digest.skiplist"`
+	DigestStatus                                         bool                   `json:"digest.status"`
+	DigestUserRelease                                    []string               `json:"digest.user_release"`
+	DNSAllowedNetworks                                   NetworkRefs            `json:"dns.allowed_networks"`
+	DNSAxfr                                              []string               `json:"dns.axfr"`
+	DNSDnssec                                            bool                   `json:"dns.dnssec"`
+	DNSEmail                                             string                 `json:"dns.email"`
+	DNSEmptyZones                                        string                 `json:"dns.empty_zones"`
+	DNSFwdDynamic                                        bool                   `json:"dns.fwd_dynamic"`
+	DNSFwdStatic                                         []string               `json:"dns.fwd_static"`
+	DNSRecheckInterval                                   int64                  `json:"dns.recheck_interval"`
+	DNSRoutes                                            []string               `json:"dns.routes"`
+	DyndnsRules                                          []string               `json:"dyndns.rules"`
+	EmailpkiAuthorityCert                                string                 `json:"emailpki.authority.cert"`
+	EmailpkiAuthorityFingerprint                         string                 `json:"emailpki.authority.fingerprint"`
+	EmailpkiAuthorityKey                                 string                 `json:"emailpki.authority.key"`
+	EmailpkiAuthorityPostmasterFingerprint               string                 `json:"emailpki.authority.postmaster_fingerprint"`
+	EmailpkiAuthorityPostmasterPrivkey                   string                 `json:"emailpki.authority.postmaster_privkey"`
+	EmailpkiAuthorityPostmasterPubkey                    string                 `json:"emailpki.authority.postmaster_pubkey"`
+	EmailpkiGlobalCity                                   string                 `json:"emailpki.global.city"`
+	EmailpkiGlobalCountry                                string                 `json:"emailpki.global.country"`
+	EmailpkiGlobalOrganization                           string                 `json:"emailpki.global.organization"`
+	EmailpkiGlobalPostmaster                             string                 `json:"emailpki.global.postmaster"`
+	EmailpkiGlobalStatus                                 bool                   `json:"emailpki.global.status"`
+	EmailpkiObjectsCas                                   []string               `json:"emailpki.objects.cas"`
+	EmailpkiObjectsOpenpgp                               []string               `json:"emailpki.objects.openpgp"`
+	EmailpkiObjectsSmime                                 []string               `json:"emailpki.objects.smime"`
+	EmailpkiObjectsUsers                                 []string               `json:"emailpki.objects.users"`
+	EmailpkiOpenpgpMainKeysize                           int64                  `json:"emailpki.openpgp.main_keysize"`
+	EmailpkiOpenpgpSubKeysize                            int64                  `json:"emailpki.openpgp.sub_keysize"`
+	EmailpkiOptionsExternalAuto                          bool                   `json:"emailpki.options.external_auto"`
+	EmailpkiOptionsKeyserver                             string                 `json:"emailpki.options.keyserver"`
+	EmailpkiOptionsPolicyDecryption                      bool                   `json:"emailpki.options.policy_decryption"`
+	EmailpkiOptionsPolicyEncryption                      bool                   `json:"emailpki.options.policy_encryption"`
+	EmailpkiOptionsPolicySign                            bool                   `json:"emailpki.options.policy_sign"`
+	EmailpkiOptionsPolicyVerify                          bool                   `json:"emailpki.options.policy_verify"`
+	EndpointAacAllowedNetworks                           NetworkRefs            `json:"endpoint.aac.allowed_networks"`
+	EndpointAacAllowedUsers                              AaaRefs                `json:"endpoint.aac.allowed_users"`
+	EndpointAacCa                                        string                 `json:"endpoint.aac.ca"`
+	EndpointAacCert                                      string                 `json:"endpoint.aac.cert"`
+	EndpointAacMagicIP                                   string                 `json:"endpoint.aac.magic_ip"`
+	EndpointAacMaxUserLogins                             int64                  `json:"endpoint.aac.max_user_logins"`
+	EndpointAacStatus                                    bool                   `json:"endpoint.aac.status"`
+	EndpointStasCollectors                               []string               `json:"endpoint.stas.collectors"`
+	EndpointStasStatus                                   bool                   `json:"endpoint.stas.status"`
+	EnduserMessagesCompanyLogo                           string                 `json:"enduser_messages.company_logo"`
+	EnduserMessagesCompanyText                           string                 `json:"enduser_messages.company_text"`
+	EnduserMessagesDlpBlackholePart                      string                 `json:"enduser_messages.dlp.blackhole_part"`
+	EnduserMessagesDlpFooterPart                         string                 `json:"enduser_messages.dlp.footer_part"`
+	EnduserMessagesDlpHeaderPart                         string                 `json:"enduser_messages.dlp.header_part"`
+	EnduserMessagesDlpOriginalPart                       string                 `json:"enduser_messages.dlp.original_part"`
+	EnduserMessagesDlpSpxPart                            string                 `json:"enduser_messages.dlp.spx_part"`
+	EnduserMessagesDlpSubject                            string                 `json:"enduser_messages.dlp.subject"`
+	EnduserMessagesHttpAppDesc                           string                 `json:"enduser_messages.http.app_desc"`
+	EnduserMessagesHttpAppSubject                        string                 `json:"enduser_messages.http.app_subject"`
+	EnduserMessagesHttpBlacklistDesc                     string                 `json:"enduser_messages.http.blacklist_desc"`
+	EnduserMessagesHttpBlacklistSubject                  string                 `json:"enduser_messages.http.blacklist_subject"`
+	EnduserMessagesHttpCertfailSubject                   string                 `json:"enduser_messages.http.certfail_subject"`
+	EnduserMessagesHttpCffOverrideDesc                   string                 `json:"enduser_messages.http.cff_override_desc"`
+	EnduserMessagesHttpCffOverrideSubject                string                 `json:"enduser_messages.http.cff_override_subject"`
+	EnduserMessagesHttpCffOverrideTerms                  string                 `json:"enduser_messages.http.cff_override_terms"`
+	EnduserMessagesHttpDownloadCompleteDesc              string                 `json:"enduser_messages.http.download_complete_desc"`
+	EnduserMessagesHttpDownloadCompleteSubject           string                 `json:"enduser_messages.http.download_complete_subject"`
+	EnduserMessagesHttpDownloadDesc                      string                 `json:"enduser_messages.http.download_desc"`
+	EnduserMessagesHttpDownloadSubject                   string                 `json:"enduser_messages.http.download_subject"`
+	EnduserMessagesHttpErrorDesc                         string                 `json:"enduser_messages.http.error_desc"`
+	EnduserMessagesHttpErrorSubject                      string                 `json:"enduser_messages.http.error_subject"`
+	EnduserMessagesHttpFileextensionDesc                 string                 `json:"enduser_messages.http.fileextension_desc"`
+	EnduserMessagesHttpFileextensionSubject              string                 `json:"enduser_messages.http.fileextension_subject"`
+	EnduserMessagesHttpFileextensionWarnDesc             string                 `json:"enduser_messages.http.fileextension_warn_desc"`
+	EnduserMessagesHttpFileextensionWarnSubject          string                 `json:"enduser_messages.http.fileextension_warn_subject"`
+	EnduserMessagesHttpFilesizeDesc                      string                 `json:"enduser_messages.http.filesize_desc"`
+	EnduserMessagesHttpFilesizeSubject                   string                 `json:"enduser_messages.http.filesize_subject"`
+	EnduserMessagesHttpGeoipDesc                         string                 `json:"enduser_messages.http.geoip_desc"`
+	EnduserMessagesHttpGeoipSubject                      string                 `json:"enduser_messages.http.geoip_subject"`
+	EnduserMessagesHttpMimetypeDesc                      string                 `json:"enduser_messages.http.mimetype_desc"`
+	EnduserMessagesHttpMimetypeSubject                   string                 `json:"enduser_messages.http.mimetype_subject"`
+	EnduserMessagesHttpMimetypeWarnDesc                  string                 `json:"enduser_messages.http.mimetype_warn_desc"`
+	EnduserMessagesHttpMimetypeWarnSubject               string                 `json:"enduser_messages.http.mimetype_warn_subject"`
+	EnduserMessagesHttpPuaDesc                           string                 `json:"enduser_messages.http.pua_desc"`
+	EnduserMessagesHttpPuaSubject                        string                 `json:"enduser_messages.http.pua_subject"`
+	EnduserMessagesHttpQuotaBlockDesc                    string                 `json:"enduser_messages.http.quota_block_desc"`
+	EnduserMessagesHttpQuotaBlockSubject                 string                 `json:"enduser_messages.http.quota_block_subject"`
+	EnduserMessagesHttpQuotaWarnDesc                     string                 `json:"enduser_messages.http.quota_warn_desc"`
+	EnduserMessagesHttpQuotaWarnSubject                  string                 `json:"enduser_messages.http.quota_warn_subject"`
+	EnduserMessagesHttpSpDesc                            string                 `json:"enduser_messages.http.sp_desc"`
+	EnduserMessagesHttpSpFrameSubject                    string                 `json:"enduser_messages.http.sp_frame_subject"`
+	EnduserMessagesHttpSpSubject                         string                 `json:"enduser_messages.http.sp_subject"`
+	EnduserMessagesHttpSpWarnDesc                        string                 `json:"enduser_messages.http.sp_warn_desc"`
+	EnduserMessagesHttpSpWarnSubject                     string                 `json:"enduser_messages.http.sp_warn_subject"`
+	EnduserMessagesHttpSslCertraw                        string                 `json:"enduser_messages.http.ssl_certraw"`
+	EnduserMessagesHttpSslCertstatus                     string                 `json:"enduser_messages.http.ssl_certstatus"`
+	EnduserMessagesHttpSslIssuer                         string                 `json:"enduser_messages.http.ssl_issuer"`
+	EnduserMessagesHttpSslMd5Fp                          string                 `json:"enduser_messages.http.ssl_md5fp"`
+	EnduserMessagesHttpSslSha1Fp                         string                 `json:"enduser_messages.http.ssl_sha1fp"`
+	EnduserMessagesHttpSslSubject                        string                 `json:"enduser_messages.http.ssl_subject"`
+	EnduserMessagesHttpSslValidfrom                      string                 `json:"enduser_messages.http.ssl_validfrom"`
+	EnduserMessagesHttpSslValiduntil                     string                 `json:"enduser_messages.http.ssl_validuntil"`
+	EnduserMessagesHttpThreatDesc                        string                 `json:"enduser_messages.http.threat_desc"`
+	EnduserMessagesHttpThreatSubject                     string                 `json:"enduser_messages.http.threat_subject"`
+	EnduserMessagesHttpTransparentAuthDesc               string                 `json:"enduser_messages.http.transparent_auth_desc"`
+	EnduserMessagesHttpTransparentAuthSubject            string                 `json:"enduser_messages.http.transparent_auth_subject"`
+	EnduserMessagesHttpTransparentAuthTerms              string                 `json:"enduser_messages.http.transparent_auth_terms"`
+	EnduserMessagesHttpVirusDesc                         string                 `json:"enduser_messages.http.virus_desc"`
+	EnduserMessagesHttpVirusSubject                      string                 `json:"enduser_messages.http.virus_subject"`
+	EnduserMessagesHttpVirusscanDesc                     string                 `json:"enduser_messages.http.virusscan_desc"`
+	EnduserMessagesHttpVirusscanSubject                  string                 `json:"enduser_messages.http.virusscan_subject"`
+	EnduserMessagesMailReleaseErrDesc                    string                 `json:"enduser_messages.mail.release_err_desc"`
+	EnduserMessagesMailReleaseErrSubject                 string                 `json:"enduser_messages.mail.release_err_subject"`
+	EnduserMessagesMailReleasedDesc                      string                 `json:"enduser_messages.mail.released_desc"`
+	EnduserMessagesMailReleasedSubject                   string                 `json:"enduser_messages.mail.released_subject"`
+	EnduserMessagesPop3BlockedDesc                       string                 `json:"enduser_messages.pop3.blocked_desc"`
+	EnduserMessagesPop3BlockedSubject                    string                 `json:"enduser_messages.pop3.blocked_subject"`
+	EnduserMessagesSpxInternalErrorBody                  string                 `json:"enduser_messages.spx.internal_error.body"`
+	EnduserMessagesSpxInternalErrorSubject               string                 `json:"enduser_messages.spx.internal_error.subject"`
+	EnduserMessagesSpxInternalErrorSenderBody            string                 `json:"enduser_messages.spx.internal_error_sender.body"`
+	EnduserMessagesSpxInternalErrorSenderSubject         string                 `json:"enduser_messages.spx.internal_error_sender.subject"`
+	EnduserMessagesSpxPasswordNoSpecCharsBody            string                 `json:"enduser_messages.spx.password_no_spec_chars.body"`
+	EnduserMessagesSpxPasswordNoSpecCharsSubject         string                 `json:"enduser_messages.spx.password_no_spec_chars.subject"`
+	EnduserMessagesSpxPasswordNotLongEnoughBody          string                 `json:"enduser_messages.spx.password_not_long_enough.body"`
+	EnduserMessagesSpxPasswordNotLongEnoughSubject       string                 `json:"enduser_messages.spx.password_not_long_enough.subject"`
+	EnduserMessagesSpxPasswordNotPresentedBody           string                 `json:"enduser_messages.spx.password_not_presented.body"`
+	EnduserMessagesSpxPasswordNotPresentedSubject        string                 `json:"enduser_messages.spx.password_not_presented.subject"`
+	EnduserMessagesSpxUrlNotFoundMessage                 string                 `json:"enduser_messages.spx.url_not_found.message"`
+	EnduserMessagesSquidCacheAdmin                       string                 `json:"enduser_messages.squid.cache_admin"`
+	EnduserMessagesSquidCacheAdminMessage                string                 `json:"enduser_messages.squid.cache_admin_message"`
+	EppAllowedNetworks                                   NetworkRefs            `json:"epp.allowed_networks"`
+	EppCertificate                                       string                 `json:"epp.certificate"`
+	EppCity                                              string                 `json:"epp.city"`
+	EppCountry                                           string                 `json:"epp.country"`
+	EppDefaultEndpointsGroup                             string                 `json:"epp.default_endpoints_group"`
+	EppDevices                                           []string               `json:"epp.devices"`
+	EppEmail                                             string                 `json:"epp.email"`
+	EppEndpoints                                         []string               `json:"epp.endpoints"`
+	EppEndpointsGroups                                   []string               `json:"epp.endpoints_groups"`
+	EppExceptionsAv                                      []string               `json:"epp.exceptions.av"`
+	EppExceptionsDc                                      []string               `json:"epp.exceptions.dc"`
+	EppFallbackURL                                       string                 `json:"epp.fallback_url"`
+	EppMagnetPassword                                    string                 `json:"epp.magnet_password"`
+	EppMagnetUsername                                    string                 `json:"epp.magnet_username"`
+	EppOrganization                                      string                 `json:"epp.organization"`
+	EppParentProxyHost                                   string                 `json:"epp.parent_proxy_host"`
+	EppParentProxyPort                                   int64                  `json:"epp.parent_proxy_port"`
+	EppParentProxyStatus                                 bool                   `json:"epp.parent_proxy_status"`
+	EppPoliciesAv                                        []string               `json:"epp.policies.av"`
+	EppPoliciesDc                                        []string               `json:"epp.policies.dc"`
+	EppPort                                              int64                  `json:"epp.port"`
+	EppPrivateKey                                        string                 `json:"epp.private_key"`
+	EppRegistrationToken                                 string                 `json:"epp.registration_token"`
+	EppStatusAv                                          bool                   `json:"epp.status.av"`
+	EppStatusBroker                                      bool                   `json:"epp.status.broker"`
+	EppStatusDc                                          bool                   `json:"epp.status.dc"`
+	EppStatusEpp                                         bool                   `json:"epp.status.epp"`
+	EppStatusWc                                          bool                   `json:"epp.status.wc"`
+	EppTamperPassword                                    string                 `json:"epp.tamper_password"`
+	EppVersion                                           string                 `json:"epp.version"`
+	EppWdxToken                                          string                 `json:"epp.wdx_token"`
+	ExecutiveReportDailyArchive                          bool                   `json:"executive_report.daily.archive"`
+	ExecutiveReportDailyKeep                             int64                  `json:"executive_report.daily.keep"`
+	ExecutiveReportDailyPdfrecipients                    []string               `json:"executive_report.daily.pdfrecipients"`
+	ExecutiveReportDailyRecipients                       []string               `json:"executive_report.daily.recipients"`
+	ExecutiveReportDailyStatus                           bool                   `json:"executive_report.daily.status"`
+	ExecutiveReportMonthlyArchive                        bool                   `json:"executive_report.monthly.archive"`
+	ExecutiveReportMonthlyKeep                           int64                  `json:"executive_report.monthly.keep"`
+	ExecutiveReportMonthlyPdfrecipients                  []string               `json:"executive_report.monthly.pdfrecipients"`
+	ExecutiveReportMonthlyRecipients                     []string               `json:"executive_report.monthly.recipients"`
+	ExecutiveReportMonthlyStatus                         bool                   `json:"executive_report.monthly.status"`
+	ExecutiveReportWeeklyArchive                         bool                   `json:"executive_report.weekly.archive"`
+	ExecutiveReportWeeklyFirstDayOfWeek                  map[string]interface{} `json:"executive_report.weekly.first_day_of_week"`
+	ExecutiveReportWeeklyKeep                            int64                  `json:"executive_report.weekly.keep"`
+	ExecutiveReportWeeklyPdfrecipients                   []string               `json:"executive_report.weekly.pdfrecipients"`
+	ExecutiveReportWeeklyRecipients                      []string               `json:"executive_report.weekly.recipients"`
+	ExecutiveReportWeeklyStatus                          bool                   `json:"executive_report.weekly.status"`
+	FloodProtectionIcmpDstBurst                          int64                  `json:"flood_protection.icmp.dst_burst"`
+	FloodProtectionIcmpDstExpire                         int64                  `json:"flood_protection.icmp.dst_expire"`
+	FloodProtectionIcmpDstGcInterval                     int64                  `json:"flood_protection.icmp.dst_gc_interval"`
+	FloodProtectionIcmpDstRate                           int64                  `json:"flood_protection.icmp.dst_rate"`
+	FloodProtectionIcmpLog                               string                 `json:"flood_protection.icmp.log"`
+	FloodProtectionIcmpLogLimitBurst                     int64                  `json:"flood_protection.icmp.log_limit_burst"`
+	FloodProtectionIcmpLogLimitRate                      int64                  `json:"flood_protection.icmp.log_limit_rate"`
+	FloodProtectionIcmpMode                              string                 `json:"flood_protection.icmp.mode"`
+	FloodProtectionIcmpSrcBurst                          int64                  `json:"flood_protection.icmp.src_burst"`
+	FloodProtectionIcmpSrcExpire                         int64                  `json:"flood_protection.icmp.src_expire"`
+	FloodProtectionIcmpSrcGcInterval                     int64                  `json:"flood_protection.icmp.src_gc_interval"`
+	FloodProtectionIcmpSrcRate                           int64                  `json:"flood_protection.icmp.src_rate"`
+	FloodProtectionIcmpStatus                            bool                   `json:"flood_protection.icmp.status"`
+	FloodProtectionSynDstBurst                           int64                  `json:"flood_protection.syn.dst_burst"`
+	FloodProtectionSynDstExpire                          int64                  `json:"flood_protection.syn.dst_expire"`
+	FloodProtectionSynDstGcInterval                      int64                  `json:"flood_protection.syn.dst_gc_interval"`
+	FloodProtectionSynDstRate                            int64                  `json:"flood_protection.syn.dst_rate"`
+	FloodProtectionSynLog                                string                 `json:"flood_protection.syn.log"`
+	FloodProtectionSynLogLimitBurst                      int64                  `json:"flood_protection.syn.log_limit_burst"`
+	FloodProtectionSynLogLimitRate                       int64                  `json:"flood_protection.syn.log_limit_rate"`
+	FloodProtectionSynMode                               string                 `json:"flood_protection.syn.mode"`
+	FloodProtectionSynSrcBurst                           int64                  `json:"flood_protection.syn.src_burst"`
+	FloodProtectionSynSrcExpire                          int64                  `json:"flood_protection.syn.src_expire"`
+	FloodProtectionSynSrcGcInterval                      int64                  `json:"flood_protection.syn.src_gc_interval"`
+	FloodProtectionSynSrcRate                            int64                  `json:"flood_protection.syn.src_rate"`
+	FloodProtectionSynStatus                             bool                   `json:"flood_protection.syn.status"`
+	FloodProtectionUdpDstBurst                           int64                  `json:"flood_protection.udp.dst_burst"`
+	FloodProtectionUdpDstExpire                          int64                  `json:"flood_protection.udp.dst_expire"`
+	FloodProtectionUdpDstGcInterval                      int64                  `json:"flood_protection.udp.dst_gc_interval"`
+	FloodProtectionUdpDstRate                            int64                  `json:"flood_protection.udp.dst_rate"`
+	FloodProtectionUdpLog                                string                 `json:"flood_protection.udp.log"`
+	FloodProtectionUdpLogLimitBurst                      int64                  `json:"flood_protection.udp.log_limit_burst"`
+	FloodProtectionUdpLogLimitRate                       int64                  `json:"flood_protection.udp.log_limit_rate"`
+	FloodProtectionUdpMode                               string                 `json:"flood_protection.udp.mode"`
+	FloodProtectionUdpSrcBurst                           int64                  `json:"flood_protection.udp.src_burst"`
+	FloodProtectionUdpSrcExpire                          int64                  `json:"flood_protection.udp.src_expire"`
+	FloodProtectionUdpSrcGcInterval                      int64                  `json:"flood_protection.udp.src_gc_interval"`
+	FloodProtectionUdpSrcRate                            int64                  `json:"flood_protection.udp.src_rate"`
+	FloodProtectionUdpStatus                             bool                   `json:"flood_protection.udp.status"`
+	FtpAllowedClients                                    []string               `json:"ftp.allowed_clients"`
+	FtpAllowedServers                                    []string               `json:"ftp.allowed_servers"`
+	FtpCffAv                                             bool                   `json:"ftp.cff_av"`
+	FtpCffAvEngines                                      string                 `json:"ftp.cff_av_engines"`
+	FtpCffFileExtensions                                 []string               `json:"ftp.cff_file_extensions"`
+	FtpExceptions                                        []string               `json:"ftp.exceptions"`
+	FtpMaxFileSize                                       int64                  `json:"ftp.max_file_size"`
+	FtpMsWinMode                                         bool                   `json:"ftp.ms_win_mode"`
+	FtpOperationMode                                     string                 `json:"ftp.operation_mode"`
+	FtpRestrictedServers                                 []string               `json:"ftp.restricted_servers"`
+	FtpStatus                                            bool                   `json:"ftp.status"`
+	FtpTransparentSkip                                   NetworkRefs            `json:"ftp.transparent_skip"`
+	FtpTransparentSkipAutoPf                             bool                   `json:"ftp.transparent_skip_auto_pf"`
+	GenericProxyRules                                    []string               `json:"generic_proxy.rules"`
+	GeoipCountriesDst                                    []string               `json:"geoip.countries_dst"`
+	GeoipCountriesSrc                                    []string               `json:"geoip.countries_src"`
+	GeoipExceptions                                      []string               `json:"geoip.exceptions"`
+	GeoipLog                                             string                 `json:"geoip.log"`
+	GeoipStatus                                          bool                   `json:"geoip.status"`
+	H323AllowedNetworks                                  NetworkRefs            `json:"h323.allowed_networks"`
+	H323LogRelated                                       bool                   `json:"h323.log_related"`
+	H323Servers                                          []string               `json:"h323.servers"`
+	H323Status                                           bool                   `json:"h323.status"`
+	HaAdvancedAutojoin                                   bool                   `json:"ha.advanced.autojoin"`
+	HaAdvancedColdRollback                               bool                   `json:"ha.advanced.cold_rollback"`
+	HaAdvancedHttpPersistenceTime                        map[string]interface{} `json:"ha.advanced.http_persistence_time"`
+	HaAdvancedLoadTakeover                               int64                  `json:"ha.advanced.load_takeover"`
+	HaAdvancedLoadWarn                                   int64                  `json:"ha.advanced.load_warn"`
+	HaAdvancedMaxNodes                                   int64                  `json:"ha.advanced.max_nodes"`
+	HaAdvancedMtu                                        string                 `json:"ha.advanced.mtu"`
+	HaAdvancedNetconsole                                 bool                   `json:"ha.advanced.netconsole"`
+	HaAdvancedPreempt                                    string                 `json:"ha.advanced.preempt"`
+	HaAdvancedUniqueID                                   int64                  `json:"ha.advanced.unique_id"`
+	HaAdvancedVirtualMac                                 bool                   `json:"ha.advanced.virtual_mac"`
+	HaAwsCloudwatchProfile                               string                 `json:"ha.aws.cloudwatch.profile"`
+	HaAwsCloudwatchStatus                                bool                   `json:"ha.aws.cloudwatch.status"`
+	HaAwsConfdBackup                                     bool                   `json:"ha.aws.confd.backup"`
+	HaAwsConfdBackupInterval                             int64                  `json:"ha.aws.confd.backup_interval"`
+	HaAwsConfdRestore                                    bool                   `json:"ha.aws.confd.restore"`
+	HaAwsConfdRestoreDone                                bool                   `json:"ha.aws.confd.restore_done"`
+	HaAwsElasticIP                                       string                 `json:"ha.aws.elastic_ip"`
+	HaAwsPostgresArchiveTimeout                          int64                  `json:"ha.aws.postgres.archive_timeout"`
+	HaAwsPostgresBackup                                  bool                   `json:"ha.aws.postgres.backup"`
+	HaAwsPostgresBaseBackupInterval                      int64                  `json:"ha.aws.postgres.base_backup_interval"`
+	HaAwsPostgresRestore                                 bool                   `json:"ha.aws.postgres.restore"`
+	HaAwsS3Bucket                                        string                 `json:"ha.aws.s3_bucket"`
+	HaAwsStackName                                       string                 `json:"ha.aws.stack_name"`
+	HaAwsSyslogBackup                                    bool                   `json:"ha.aws.syslog.backup"`
+	HaAwsSyslogRestore                                   bool                   `json:"ha.aws.syslog.restore"`
+	HaAwsSyslogRestorePeriod                             int64                  `json:"ha.aws.syslog.restore_period"`
+	HaAwsTrustedNetwork                                  string                 `json:"ha.aws.trusted_network"`
+	HaClusterFtp                                         []string               `json:"ha.cluster.ftp"`
+	HaClusterHttp                                        []string               `json:"ha.cluster.http"`
+	HaClusterIpsec                                       []string               `json:"ha.cluster.ipsec"`
+	HaClusterPop3                                        []string               `json:"ha.cluster.pop3"`
+	HaClusterSmtp                                        []string               `json:"ha.cluster.smtp"`
+	HaClusterSnort                                       []string               `json:"ha.cluster.snort"`
+	HaClusterWaf                                         []string               `json:"ha.cluster.waf"`
+	HaDeviceName                                         string                 `json:"ha.device_name"`
+	HaItfhw                                              string                 `json:"ha.itfhw"`
+	HaItfhwBackup                                        string                 `json:"ha.itfhw_backup"`
+	HaMasterIP                                           string                 `json:"ha.master_ip"`
+	HaMode                                               string                 `json:"ha.mode"`
+	HaNodeID                                             map[string]interface{} `json:"ha.node_id"`
+	HaPassword                                           string                 `json:"ha.password"`
+	HaPostgresSecret                                     string                 `json:"ha.postgres_secret"`
+	HaSlaveIP                                            string                 `json:"ha.slave_ip"`
+	HaStatus                                             string                 `json:"ha.status"`
+	HaSyncConntrack                                      bool                   `json:"ha.sync.conntrack"`
+	HaSyncDatabase                                       bool                   `json:"ha.sync.database"`
+	HaSyncFiles                                          bool                   `json:"ha.sync.files"`
+	HaSyncIpsec                                          bool                   `json:"ha.sync.ipsec"`
+	HaSyncSyslog                                         bool                   `json:"ha.sync.syslog"`
+	HaTimesDeadTime                                      int64                  `json:"ha.times.dead_time"`
+	HaTimesLoadTime                                      int64                  `json:"ha.times.load_time"`
+	HotspotCert                                          string                 `json:"hotspot.cert"`
+	HotspotDeleteDays                                    int64                  `json:"hotspot.delete_days"`
+	HotspotSslPortal                                     bool                   `json:"hotspot.ssl_portal"`
+	HotspotStatus                                        bool                   `json:"hotspot.status"`
+	HotspotTransparentSkip                               NetworkRefs            `json:"hotspot.transparent_skip"`
+	HTTPAdSsoInterfaces                                  InterfaceRefs          `json:"http.ad_sso_interfaces"`
+	HTTPAdssoRedirectUseHostname                         bool                   `json:"http.adsso_redirect_use_hostname"`
+	HTTPAllowSsl3                                        bool                   `json:"http.allow_ssl3"`
+	HTTPAllowTLS12                                       bool                   `json:"http.allow_tls_1_2"`
+	HTTPAllowedPuas                                      []string               `json:"http.allowed_puas"`
+	HTTPAllowedTargetServices                            []string               `json:"http.allowed_target_services"`
+	HTTPAuaMaxconns                                      int64                  `json:"http.aua_maxconns"`
+	HTTPAuaTimeout                                       int64                  `json:"http.aua_timeout"`
+	HTTPAuthCacheSize                                    int64                  `json:"http.auth_cache_size"`
+	HTTPAuthCacheTTL                                     int64                  `json:"http.auth_cache_ttl"`
+	HTTPAuthRealm                                        string                 `json:"http.auth_realm"`
+	HTTPAuthUsercacheTTL                                 int64                  `json:"http.auth_usercache_ttl"`
+	HTTPBlockUnscannable                                 bool                   `json:"http.block_unscannable"`
+	HTTPBypassStreaming                                  bool                   `json:"http.bypass_streaming"`
+	HTTPCaList                                           []string               `json:"http.ca_list"`
+	HTTPCacheIgnoresCookies                              bool                   `json:"http.cache_ignores_cookies"`
+	HTTPCachessl                                         bool                   `json:"http.cachessl"`
+	HTTPCaching                                          bool                   `json:"http.caching"`
+	HTTPCertcache                                        string                 `json:"http.certcache"`
+	HTTPCertstore                                        string                 `json:"http.certstore"`
+	HTTPCffOverrideUsers                                 []string               `json:"http.cff_override_users"`
+	HTTPClientTimeout                                    int64                  `json:"http.client_timeout"`
+	HTTPConfLockWorkaround                               bool                   `json:"http.conf_lock_workaround"`
+	HTTPConnectTimeout                                   int64                  `json:"http.connect_timeout"`
+	HTTPConnectV6Timeout                                 map[string]interface{} `json:"http.connect_v6_timeout"`
+	HTTPConnlimit                                        int64                  `json:"http.connlimit"`
+	HTTPCtypeInspectBody                                 bool                   `json:"http.ctype_inspect_body"`
+	HTTPCtypeUnpackArchive                               bool                   `json:"http.ctype_unpack_archive"`
+	HTTPDebug                                            []string               `json:"http.debug"`
+	HTTPDefaultblockaction                               string                 `json:"http.defaultblockaction"`
+	HTTPDeferagents                                      []string               `json:"http.deferagents"`
+	HTTPDeferlength                                      int64                  `json:"http.deferlength"`
+	HTTPDisplayHTTPBlockpageExplicitMode                 bool                   `json:"http.display_http_blockpage_explicit_mode"`
+	HTTPDisplayIntro                                     bool                   `json:"http.display_intro"`
+	HTTPDownloadManagerDefaultCharset                    string                 `json:"http.download_manager_default_charset"`
+	HTTPEdirDelayBasicAuth                               bool                   `json:"http.edir_delay_basic_auth"`
+	HTTPEnableOutInterface                               bool                   `json:"http.enable_out_interface"`
+	HTTPEppQuotaAction                                   string                 `json:"http.epp_quota_action"`
+	HTTPExceptions                                       []string               `json:"http.exceptions"`
+	HTTPForcedCachingExtension                           []string               `json:"http.forced_caching_extension"`
+	HTTPForcedCachingNeverCachePrefix                    []string               `json:"http.forced_caching_never_cache_prefix"`
+	HTTPForcedCachingStatus                              bool                   `json:"http.forced_caching_status"`
+	HTTPForcedCachingTTL                                 int64                  `json:"http.forced_caching_ttl"`
+	HTTPForcedCachingUserAgentPrefix                     []string               `json:"http.forced_caching_user_agent_prefix"`
+	HTTPHttpLoopbackDetect                               bool                   `json:"http.http_loopback_detect"`
+	HTTPIeSslBlockpageWorkaround                         bool                   `json:"http.ie_ssl_blockpage_workaround"`
+	HTTPLimitAdSsoInterfaces                             bool                   `json:"http.limit_ad_sso_interfaces"`
+	HTTPLocalSiteList                                    []string               `json:"http.local_site_list"`
+	HTTPMaxContentEncoding                               int64                  `json:"http.max_content_encoding"`
+	HTTPMaxTempfileSize                                  int64                  `json:"http.max_tempfile_size"`
+	HTTPMaxthreads                                       int64                  `json:"http.maxthreads"`
+	HTTPMaxthreadsUnused                                 int64                  `json:"http.maxthreads_unused"`
+	HTTPModulepath                                       string                 `json:"http.modulepath"`
+	HTTPModules                                          []string               `json:"http.modules"`
+	HTTPNoscancontent                                    []string               `json:"http.noscancontent"`
+	HTTPOpendirectoryKeytab                              string                 `json:"http.opendirectory_keytab"`
+	HTTPPacFile                                          string                 `json:"http.pac_file"`
+	HTTPParentProxyHost                                  string                 `json:"http.parent_proxy_host"`
+	HTTPParentProxyPort                                  int64                  `json:"http.parent_proxy_port"`
+	HTTPParentProxyStatus                                bool                   `json:"http.parent_proxy_status"`
+	HTTPPassthroughID                                    string                 `json:"http.passthrough_id"`
+	HTTPPharmingProtection                               bool                   `json:"http.pharming_protection"`
+	HTTPPort                                             int64                  `json:"http.port"`
+	HTTPPortalCert                                       string                 `json:"http.portal_cert"`
+	HTTPPortalCertChain                                  []string               `json:"http.portal_cert_chain"`
+	HTTPPortalDomain                                     string                 `json:"http.portal_domain"`
+	HTTPPortalHosts                                      []string               `json:"http.portal_hosts"`
+	HTTPPortalUseCert                                    bool                   `json:"http.portal_use_cert"`
+	HTTPProceedCacheTimeout                              int64                  `json:"http.proceed_cache_timeout"`
+	HTTPProfiles                                         []string               `json:"http.profiles"`
+	HTTPQuotaSliceTime                                   int64                  `json:"http.quota_slice_time"`
+	HTTPRemoveRequest                                    []string               `json:"http.remove_request"`
+	HTTPRemoveResponse                                   []string               `json:"http.remove_response"`
+	HTTPResponseTimeout                                  int64                  `json:"http.response_timeout"`
+	HTTPScLocalDB                                        string                 `json:"http.sc_local_db"`
+	HTTPScanEppTraffic                                   bool                   `json:"http.scan_epp_traffic"`
+	HTTPSearchdomain                                     string                 `json:"http.searchdomain"`
+	HTTPStrictHTTP                                       bool                   `json:"http.strict_http"`
+	HTTPTlsciphersClient                                 string                 `json:"http.tlsciphers_client"`
+	HTTPTlsciphersServer                                 string                 `json:"http.tlsciphers_server"`
+	HTTPTmpfsUsageMinMemsize                             int64                  `json:"http.tmpfs_usage_min_memsize"`
+	HTTPTransparentAuthTimeout                           int64                  `json:"http.transparent_auth_timeout"`
+	HTTPTransparentDstSkip                               NetworkRefs            `json:"http.transparent_dst_skip"`
+	HTTPTransparentSkipAutoPf                            bool                   `json:"http.transparent_skip_auto_pf"`
+	HTTPTransparentSrcSkip                               NetworkRefs            `json:"http.transparent_src_skip"`
+	HTTPTunnelTimeout                                    int64                  `json:"http.tunnel_timeout"`
+	HTTPTunnelV6Timeout                                  map[string]interface{} `json:"http.tunnel_v6_timeout"`
+	HTTPUndefercontent                                   []string               `json:"http.undefercontent"`
+	HTTPUndeferextension                                 []string               `json:"http.undeferextension"`
+	HTTPUrlFilteringRedirectURL                          string                 `json:"http.url_filtering_redirect_url"`
+	HTTPUseConnectionInsteadofProxyconnection            bool                   `json:"http.use_connection_insteadof_proxyconnection"`
+	HTTPUseDstaddrForGeopiplookup                        bool                   `json:"http.use_dstaddr_for_geopiplookup"`
+	HTTPUseKrb5Adsso                                     bool                   `json:"http.use_krb5_adsso"`
+	HTTPUseSni                                           bool                   `json:"http.use_sni"`
+	HTTPUseSxlUrid                                       bool                   `json:"http.use_sxl_urid"`
+	IcmpForward                                          bool                   `json:"icmp.forward"`
+	IcmpInput                                            bool                   `json:"icmp.input"`
+	IcmpLogRedirect                                      bool                   `json:"icmp.log_redirect"`
+	IcmpPingForward                                      bool                   `json:"icmp.ping.forward"`
+	IcmpPingInput                                        bool                   `json:"icmp.ping.input"`
+	IcmpPingOutput                                       bool                   `json:"icmp.ping.output"`
+	IcmpSecure                                           bool                   `json:"icmp.secure"`
+	IcmpTracerouteForward                                bool                   `json:"icmp.traceroute.forward"`
+	IcmpTracerouteInput                                  bool                   `json:"icmp.traceroute.input"`
+	IdentForward                                         bool                   `json:"ident.forward"`
+	IdentResponse                                        string                 `json:"ident.response"`
+	IdentStatus                                          bool                   `json:"ident.status"`
+	InterfacesAdvancedArpAnnounce                        map[string]interface{} `json:"interfaces.advanced.arp_announce"`
+	InterfacesAdvancedArpIgnore                          map[string]interface{} `json:"interfaces.advanced.arp_ignore"`
+	InterfacesAdvancedDefaultMetric                      int64                  `json:"interfaces.advanced.default_metric"`
+	InterfacesInterfaces                                 []string               `json:"interfaces.interfaces"`
+	IpsDnsServers                                        []string               `json:"ips.dns_servers"`
+	IpsEngine                                            string                 `json:"ips.engine"`
+	IpsExceptions                                        []string               `json:"ips.exceptions"`
+	IpsFailopen                                          bool                   `json:"ips.failopen"`
+	IpsFileBasedRules                                    bool                   `json:"ips.file_based_rules"`
+	IpsGroups                                            []string               `json:"ips.groups"`
+	IpsHttpServers                                       []string               `json:"ips.http_servers"`
+	IpsIpsfbAlertInterval                                int64                  `json:"ips.ipsfb.alert_interval"`
+	IpsIpsfbConfigInterval                               int64                  `json:"ips.ipsfb.config_interval"`
+	IpsIpsfbDebug                                        bool                   `json:"ips.ipsfb.debug"`
+	IpsLocalNetworks                                     NetworkRefs            `json:"ips.local_networks"`
+	IpsNumInstances                                      map[string]interface{} `json:"ips.num_instances"`
+	IpsPatternChannel                                    string                 `json:"ips.pattern_channel"`
+	IpsPolicy                                            string                 `json:"ips.policy"`
+	IpsQueueLength                                       int64                  `json:"ips.queue_length"`
+	IpsQueueThreshold                                    map[string]interface{} `json:"ips.queue_threshold"`
+	IpsReloadMethod                                      string                 `json:"ips.reload_method"`
+	IpsRestartPolicy                                     string                 `json:"ips.restart_policy"`
+	IpsRuleModifiers                                     []string               `json:"ips.rule_modifiers"`
+	IpsRules                                             []string               `json:"ips.rules"`
+	IpsSkipAcks                                          bool                   `json:"ips.skip_acks"`
+	IpsSmtpServers                                       []string               `json:"ips.smtp_servers"`
+	IpsSnortsettingsMaxQueuedBytes                       map[string]interface{} `json:"ips.snortsettings.max_queued_bytes"`
+	IpsSnortsettingsMaxQueuedSegs                        map[string]interface{} `json:"ips.snortsettings.max_queued_segs"`
+	IpsSnortsettingsMaxTcp                               map[string]interface{} `json:"ips.snortsettings.max_tcp"`
+	IpsSnortsettingsMaxUdp                               map[string]interface{} `json:"ips.snortsettings.max_udp"`
+	IpsSnortsettingsMemcap                               map[string]interface{} `json:"ips.snortsettings.memcap"`
+	IpsSnortsettingsSearchMethod                         string                 `json:"ips.snortsettings.search_method"`
+	IpsSqlServers                                        []string               `json:"ips.sql_servers"`
+	IpsStatus                                            bool                   `json:"ips.status"`
+	IpsecAdvancedCrlAutoFetching                         bool                   `json:"ipsec.advanced.crl_auto_fetching"`
+	IpsecAdvancedCrlStrictPolicy                         bool                   `json:"ipsec.advanced.crl_strict_policy"`
+	IpsecAdvancedDeadPeerDetection                       bool                   `json:"ipsec.advanced.dead_peer_detection"`
+	IpsecAdvancedIkeDebug                                []string               `json:"ipsec.advanced.ike_debug"`
+	IpsecAdvancedIkePort                                 int64                  `json:"ipsec.advanced.ike_port"`
+	IpsecAdvancedMetric                                  map[string]interface{} `json:"ipsec.advanced.metric"`
+	IpsecAdvancedNatTraversal                            bool                   `json:"ipsec.advanced.nat_traversal"`
+	IpsecAdvancedNatTraversalKeepalive                   int64                  `json:"ipsec.advanced.nat_traversal_keepalive"`
+	IpsecAdvancedProbePsk                                bool                   `json:"ipsec.advanced.probe_psk"`
+	IpsecAdvancedPskVpnID                                string                 `json:"ipsec.advanced.psk_vpn_id"`
+	IpsecAdvancedPskVpnIDType                            string                 `json:"ipsec.advanced.psk_vpn_id_type"`
+	IpsecConnections                                     []string               `json:"ipsec.connections"`
+	IpsecLocalRsa                                        string                 `json:"ipsec.local_rsa"`
+	IpsecLocalX509                                       string                 `json:"ipsec.local_x509"`
+	IpsecStatus                                          bool                   `json:"ipsec.status"`
+	Ipv6AdvancedHopLimit                                 int64                  `json:"ipv6.advanced.hop_limit"`
+	Ipv6AdvancedMaxInterval                              int64                  `json:"ipv6.advanced.max_interval"`
+	Ipv6AdvancedMinInterval                              int64                  `json:"ipv6.advanced.min_interval"`
+	Ipv6AdvancedPreference                               string                 `json:"ipv6.advanced.preference"`
+	Ipv6AdvancedReachableTime                            map[string]interface{} `json:"ipv6.advanced.reachable_time"`
+	Ipv6AdvancedRetransTime                              map[string]interface{} `json:"ipv6.advanced.retrans_time"`
+	Ipv6BrokerAuthentication                             string                 `json:"ipv6.broker.authentication"`
+	Ipv6BrokerInterface                                  string                 `json:"ipv6.broker.interface"`
+	Ipv6BrokerPassword                                   string                 `json:"ipv6.broker.password"`
+	Ipv6BrokerProtocol                                   string                 `json:"ipv6.broker.protocol"`
+	Ipv6BrokerServer                                     string                 `json:"ipv6.broker.server"`
+	Ipv6BrokerStatus                                     bool                   `json:"ipv6.broker.status"`
+	Ipv6BrokerTunnelID                                   string                 `json:"ipv6.broker.tunnel_id"`
+	Ipv6BrokerUsername                                   string                 `json:"ipv6.broker.username"`
+	Ipv6Nat64Address                                     string                 `json:"ipv6.nat64.address"`
+	Ipv6Nat64Dns64V6only                                 bool                   `json:"ipv6.nat64.dns64_v6only"`
+	Ipv6Nat64Prefix                                      string                 `json:"ipv6.nat64.prefix"`
+	Ipv6Nat64Status                                      bool                   `json:"ipv6.nat64.status"`
+	Ipv6Prefer                                           bool                   `json:"ipv6.prefer"`
+	Ipv6Prefixes                                         []string               `json:"ipv6.prefixes"`
+	Ipv6Renumbering                                      bool                   `json:"ipv6.renumbering"`
+	Ipv6Six2FourInterface                                string                 `json:"ipv6.six2four.interface"`
+	Ipv6Six2FourServer                                   string                 `json:"ipv6.six2four.server"`
+	Ipv6Six2FourStatus                                   bool                   `json:"ipv6.six2four.status"`
+	Ipv6Status                                           bool                   `json:"ipv6.status"`
+	LicensingActiveIps                                   []string               `json:"licensing.active_ips"`
+	LicensingLicense                                     string                 `json:"licensing.license"`
+	LicensingUserLimitExceeded                           map[string]interface{} `json:"licensing.user_limit_exceeded"`
+	LinkAggregationGroups                                []string               `json:"link_aggregation.groups"`
+	LoadbalanceHttpErrorCode                             int64                  `json:"loadbalance.http_error_code"`
+	LoadbalanceRules                                     []string               `json:"loadbalance.rules"`
+	LogfilesLocalActionOne                               string                 `json:"logfiles.local.action_one"`
+	LogfilesLocalActionThree                             string                 `json:"logfiles.local.action_three"`
+	LogfilesLocalActionTwo                               string                 `json:"logfiles.local.action_two"`
+	LogfilesLocalDeleteAfterDays                         map[string]interface{} `json:"logfiles.local.delete_after_days"`
+	LogfilesLocalPercentageOne                           int64                  `json:"logfiles.local.percentage_one"`
+	LogfilesLocalPercentageThree                         int64                  `json:"logfiles.local.percentage_three"`
+	LogfilesLocalPercentageTwo                           int64                  `json:"logfiles.local.percentage_two"`
+	LogfilesLocalStatus                                  bool                   `json:"logfiles.local.status"`
+	LogfilesRemoteFtpService                             string                 `json:"logfiles.remote.ftp_service"`
+	LogfilesRemoteHost                                   string                 `json:"logfiles.remote.host"`
+	LogfilesRemotePass                                   string                 `json:"logfiles.remote.pass"`
+	LogfilesRemotePath                                   string                 `json:"logfiles.remote.path"`
+	LogfilesRemoteSmbWorkgroup                           string                 `json:"logfiles.remote.smb_workgroup"`
+	LogfilesRemoteSmtpAddress                            string                 `json:"logfiles.remote.smtp_address"`
+	LogfilesRemoteStatus                                 bool                   `json:"logfiles.remote.status"`
+	LogfilesRemoteType                                   string                 `json:"logfiles.remote.type"`
+	LogfilesRemoteUser                                   string                 `json:"logfiles.remote.user"`
+	MasqRules                                            []string               `json:"masq.rules"`
+	MigrationAccessToken                                 string                 `json:"migration.access_token"`
+	MigrationLocalOverride                               bool                   `json:"migration.local_override"`
+	MigrationRefreshToken                                string                 `json:"migration.refresh_token"`
+	MigrationTabVisibility                               string                 `json:"migration.tab_visibility"`
+	MigrationToolsetVersion                              string                 `json:"migration.toolset_version"`
+	MigrationUtmVersion                                  string                 `json:"migration.utm_version"`
+	MobileControlCa                                      string                 `json:"mobile_control.ca"`
+	MobileControlConfigCisco                             bool                   `json:"mobile_control.config.cisco"`
+	MobileControlConfigEapMethod                         string                 `json:"mobile_control.config.eap_method"`
+	MobileControlConfigForcePush                         bool                   `json:"mobile_control.config.force_push"`
+	MobileControlConfigL2Tp                              bool                   `json:"mobile_control.config.l2tp"`
+	MobileControlConfigWifiNetworks                      []string               `json:"mobile_control.config.wifi_networks"`
+	MobileControlCustomer                                string                 `json:"mobile_control.customer"`
+	MobileControlDebug                                   bool                   `json:"mobile_control.debug"`
+	MobileControlNacCisco                                bool                   `json:"mobile_control.nac.cisco"`
+	MobileControlNacDenyAllVpn                           bool                   `json:"mobile_control.nac.deny_all_vpn"`
+	MobileControlNacL2Tp                                 bool                   `json:"mobile_control.nac.l2tp"`
+	MobileControlNacMacsAllowed                          string                 `json:"mobile_control.nac.macs_allowed"`
+	MobileControlNacMacsDenied                           string                 `json:"mobile_control.nac.macs_denied"`
+	MobileControlNacPollInterval                         int64                  `json:"mobile_control.nac.poll_interval"`
+	MobileControlNacUsersDenied                          []string               `json:"mobile_control.nac.users_denied"`
+	MobileControlNacWifiNetworks                         []string               `json:"mobile_control.nac.wifi_networks"`
+	MobileControlPassword                                string                 `json:"mobile_control.password"`
+	MobileControlServer                                  string                 `json:"mobile_control.server"`
+	MobileControlStatus                                  bool                   `json:"mobile_control.status"`
+	MobileControlUsername                                string                 `json:"mobile_control.username"`
+	NatRules                                             []string               `json:"nat.rules"`
+	NotificationsDeviceInfo                              string                 `json:"notifications.device_info"`
+	NotificationsLimiting                                bool                   `json:"notifications.limiting"`
+	NotificationsOverlay                                 []string               `json:"notifications.overlay"`
+	NotificationsRebootReason                            map[string]interface{} `json:"notifications.reboot_reason"`
+	NotificationsRecipients                              []string               `json:"notifications.recipients"`
+	NotificationsSender                                  string                 `json:"notifications.sender"`
+	NotificationsSmtpAuthentication                      bool                   `json:"notifications.smtp.authentication"`
+	NotificationsSmtpPassword                            string                 `json:"notifications.smtp.password"`
+	NotificationsSmtpPort                                int64                  `json:"notifications.smtp.port"`
+	NotificationsSmtpServer                              string                 `json:"notifications.smtp.server"`
+	NotificationsSmtpStatus                              bool                   `json:"notifications.smtp.status"`
+	NotificationsSmtpTls                                 bool                   `json:"notifications.smtp.tls"`
+	NotificationsSmtpUsername                            string                 `json:"notifications.smtp.username"`
+	NTPAllowedNetworks                                   NetworkRefs            `json:"ntp.allowed_networks"`
+	NTPServers                                           []string               `json:"ntp.servers"`
+	NTPStatus                                            bool                   `json:"ntp.status"`
+	PacketfilterAdvancedBlockInvalidCtPackets            bool                   `json:"packetfilter.advanced.block_invalid_ct_packets"`
+	PacketfilterAdvancedCheckPacketLength                bool                   `json:"packetfilter.advanced.check_packet_length"`
+	PacketfilterAdvancedConntrackHelpers                 []string               `json:"packetfilter.advanced.conntrack_helpers"`
+	PacketfilterAdvancedFtpPorts                         []int64                `json:"packetfilter.advanced.ftp_ports"`
+	PacketfilterAdvancedLogBroadcasts                    bool                   `json:"packetfilter.advanced.log_broadcasts"`
+	PacketfilterAdvancedLogDNSRequests                   bool                   `json:"packetfilter.advanced.log_dns_requests"`
+	PacketfilterAdvancedLogFtpData                       bool                   `json:"packetfilter.advanced.log_ftp_data"`
+	PacketfilterAdvancedLogInvalid                       map[string]interface{} `json:"packetfilter.advanced.log_invalid"`
+	PacketfilterAdvancedLogMcast                         bool                   `json:"packetfilter.advanced.log_mcast"`
+	PacketfilterAdvancedLogStrictTcpState                string                 `json:"packetfilter.advanced.log_strict_tcp_state"`
+	PacketfilterAdvancedNoErrorReplay                    bool                   `json:"packetfilter.advanced.no_error_replay"`
+	PacketfilterAdvancedOptimizeIpset                    string                 `json:"packetfilter.advanced.optimize.ipset"`
+	PacketfilterAdvancedOptimizePorts                    bool                   `json:"packetfilter.advanced.optimize.ports"`
+	PacketfilterAdvancedSpoofProtection                  string                 `json:"packetfilter.advanced.spoof_protection"`
+	PacketfilterAdvancedStrictTcpState                   bool                   `json:"packetfilter.advanced.strict_tcp_state"`
+	PacketfilterAdvancedTcpMaxRetrans                    int64                  `json:"packetfilter.advanced.tcp_max_retrans"`
+	PacketfilterAdvancedTcpWindowScaling                 bool                   `json:"packetfilter.advanced.tcp_window_scaling"`
+	PacketfilterRules                                    []string               `json:"packetfilter.rules"`
+	PacketfilterRulesAuto                                []string               `json:"packetfilter.rules_auto"`
+	PacketfilterRulesBack                                string                 `json:"packetfilter.rules_back"`
+	PacketfilterRulesFront                               string                 `json:"packetfilter.rules_front"`
+	PacketfilterTimeoutsIpConntrackGenericTimeout        int64                  `json:"packetfilter.timeouts.ip_conntrack_generic_timeout"`
+	PacketfilterTimeoutsIpConntrackIcmpTimeout           int64                  `json:"packetfilter.timeouts.ip_conntrack_icmp_timeout"`
+	PacketfilterTimeoutsIpConntrackTcpTimeoutClose       int64                  `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_close"`
+	PacketfilterTimeoutsIpConntrackTcpTimeoutCloseWait   int64                  `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_close_wait"`
+	PacketfilterTimeoutsIpConntrackTcpTimeoutEstablished int64                  `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_established"`
+	PacketfilterTimeoutsIpConntrackTcpTimeoutFinWait     int64                  `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_fin_wait"`
+	PacketfilterTimeoutsIpConntrackTcpTimeoutLastAck     int64                  `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_last_ack"`
+	PacketfilterTimeoutsIpConntrackTcpTimeoutMaxRetrans  int64                  `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_max_retrans"`
+	PacketfilterTimeoutsIpConntrackTcpTimeoutSynRecv     int64                  `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_syn_recv"`
+	PacketfilterTimeoutsIpConntrackTcpTimeoutSynSent     int64                  `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_syn_sent"`
+	PacketfilterTimeoutsIpConntrackTcpTimeoutTimeWait    int64                  `json:"packetfilter.timeouts.ip_conntrack_tcp_timeout_time_wait"`
+	PacketfilterTimeoutsIpConntrackUdpTimeout            int64                  `json:"packetfilter.timeouts.ip_conntrack_udp_timeout"`
+	PacketfilterTimeoutsIpConntrackUdpTimeoutStream      int64                  `json:"packetfilter.timeouts.ip_conntrack_udp_timeout_stream"`
+	PasswdLoginuserClearpass                             string                 `json:"passwd.loginuser.clearpass"`
+	PasswdLoginuserCryptpass                             string                 `json:"passwd.loginuser.cryptpass"`
+	PasswdRootClearpass                                  string                 `json:"passwd.root.clearpass"`
+	PasswdRootCryptpass                                  string                 `json:"passwd.root.cryptpass"`
+	PdfPaper                                             string                 `json:"pdf.paper"`
+	PimSmAutoPfOut                                       string                 `json:"pim_sm.auto_pf_out"`
+	PimSmAutoPfrule                                      bool                   `json:"pim_sm.auto_pfrule"`
+	PimSmDebug                                           bool                   `json:"pim_sm.debug"`
+	PimSmInterfaces                                      []string               `json:"pim_sm.interfaces"`
+	PimSmRpRouters                                       []string               `json:"pim_sm.rp_routers"`
+	PimSmSptSwitchBytes                                  int64                  `json:"pim_sm.spt_switch_bytes"`
+	PimSmSptSwitchStatus                                 bool                   `json:"pim_sm.spt_switch_status"`
+	PimSmStatus                                          bool                   `json:"pim_sm.status"`
+	Pop3AllowedClients                                   []string               `json:"pop3.allowed_clients"`
+	Pop3AllowedServers                                   []string               `json:"pop3.allowed_servers"`
+	Pop3CffAsMarker                                      string                 `json:"pop3.cff_as_marker"`
+	Pop3CffAv                                            bool                   `json:"pop3.cff_av"`
+	Pop3CffAvAction                                      string                 `json:"pop3.cff_av_action"`
+	Pop3CffAvEngines                                     string                 `json:"pop3.cff_av_engines"`
+	Pop3CffFileExtensions                                []string               `json:"pop3.cff_file_extensions"`
+	Pop3DirectlyDeleteQuarantined                        bool                   `json:"pop3.directly_delete_quarantined"`
+	Pop3Exceptions                                       []string               `json:"pop3.exceptions"`
+	Pop3KnownServers                                     []string               `json:"pop3.known_servers"`
+	Pop3MaxMessageSize                                   int64                  `json:"pop3.max_message_size"`
+	Pop3PrefetchingInterval                              int64                  `json:"pop3.prefetching.interval"`
+	Pop3PrefetchingOptimizeStorage                       bool                   `json:"pop3.prefetching.optimize_storage"`
+	Pop3PrefetchingStatus                                bool                   `json:"pop3.prefetching.status"`
+	Pop3PrefetchingStorageMinHoldDays                    int64                  `json:"pop3.prefetching.storage_min_hold_days"`
+	Pop3QuarantineUnscannable                            bool                   `json:"pop3.quarantine_unscannable"`
+	Pop3SandboxMaxFilesizeMb                             int64                  `json:"pop3.sandbox_max_filesize_mb"`
+	Pop3SandboxScanStatus                                bool                   `json:"pop3.sandbox_scan_status"`
+	Pop3ScanTLS                                          bool                   `json:"pop3.scan_tls"`
+	Pop3SenderBlacklist                                  []string               `json:"pop3.sender_blacklist"`
+	Pop3Spam                                             string                 `json:"pop3.spam"`
+	Pop3SpamExpressions                                  []string               `json:"pop3.spam_expressions"`
+	Pop3Spamplus                                         string                 `json:"pop3.spamplus"`
+	Pop3Spamstatus                                       bool                   `json:"pop3.spamstatus"`
+	Pop3Status                                           bool                   `json:"pop3.status"`
+	Pop3TlsCert                                          string                 `json:"pop3.tls_cert"`
+	Pop3TransparentSkip                                  NetworkRefs            `json:"pop3.transparent_skip"`
+	Pop3TransparentSkipAutoPf                            bool                   `json:"pop3.transparent_skip_auto_pf"`
+	Pop3UserCharset                                      string                 `json:"pop3.user_charset"`
+	PortalAllowAnyUser                                   bool                   `json:"portal.allow_any_user"`
+	PortalAllowedNetworks                                NetworkRefs            `json:"portal.allowed_networks"`
+	PortalAllowedUsers                                   AaaRefs                `json:"portal.allowed_users"`
+	PortalHideItems                                      []string               `json:"portal.hide_items"`
+	PortalHostname                                       string                 `json:"portal.hostname"`
+	PortalInterfaceAddress                               string                 `json:"portal.interface_address"`
+	PortalLanguage                                       string                 `json:"portal.language"`
+	PortalPersistentCookies                              bool                   `json:"portal.persistent_cookies"`
+	PortalPort                                           int64                  `json:"portal.port"`
+	PortalStatus                                         bool                   `json:"portal.status"`
+	PortalWelcomeMsg                                     string                 `json:"portal.welcome_msg"`
+	PsdAction                                            string                 `json:"psd.action"`
+	PsdDelayThreshold                                    int64                  `json:"psd.delay_threshold"`
+	PsdHiPortsWeight                                     int64                  `json:"psd.hi_ports_weight"`
+	PsdLoPortsWeight                                     int64                  `json:"psd.lo_ports_weight"`
+	PsdLogLimiterBurst                                   int64                  `json:"psd.log_limiter.burst"`
+	PsdLogLimiterRate                                    int64                  `json:"psd.log_limiter.rate"`
+	PsdLogLimiterStatus                                  bool                   `json:"psd.log_limiter.status"`
+	PsdStatus                                            bool                   `json:"psd.status"`
+	PsdWeightThreshold                                   int64                  `json:"psd.weight_threshold"`
+	QosAdvancedEcn                                       int64                  `json:"qos.advanced.ecn"`
+	QosAdvancedKeepClassAfterEncap                       bool                   `json:"qos.advanced.keep_class_after_encap"`
+	QosInterfaces                                        []string               `json:"qos.interfaces"`
+	QuarantineKeepDBLogDays                              int64                  `json:"quarantine.keep_db_log_days"`
+	QuarantineKeepQuarantineDays                         int64                  `json:"quarantine.keep_quarantine_days"`
+	RedActivateProvFw                                    bool                   `json:"red.activate_prov_fw"`
+	RedAuthorization                                     bool                   `json:"red.authorization"`
+	RedCaSettingsCity                                    string                 `json:"red.ca_settings.city"`
+	RedCaSettingsCountry                                 string                 `json:"red.ca_settings.country"`
+	RedCaSettingsEmail                                   string                 `json:"red.ca_settings.email"`
+	RedCaSettingsOrganization                            string                 `json:"red.ca_settings.organization"`
+	RedClients                                           []string               `json:"red.clients"`
+	RedDeauthTimeout                                     string                 `json:"red.deauth_timeout"`
+	RedOverlayFwEnabled                                  bool                   `json:"red.overlay_fw_enabled"`
+	RedRegistryCert                                      string                 `json:"red.registry_cert"`
+	RedRegistryID                                        string                 `json:"red.registry_id"`
+	RedRegistryKey                                       string                 `json:"red.registry_key"`
+	RedServerCert                                        string                 `json:"red.server_cert"`
+	RedServers                                           []string               `json:"red.servers"`
+	RedStatus                                            bool                   `json:"red.status"`
+	RedTls12Only                                         bool                   `json:"red.tls_1_2_only"`
+	RemoteAccessAdvancedMsdns1                           string                 `json:"remote_access.advanced.msdns1"`
+	RemoteAccessAdvancedMsdns2                           string                 `json:"remote_access.advanced.msdns2"`
+	RemoteAccessAdvancedMsdomain                         string                 `json:"remote_access.advanced.msdomain"`
+	RemoteAccessAdvancedMswins1                          string                 `json:"remote_access.advanced.mswins1"`
+	RemoteAccessAdvancedMswins2                          string                 `json:"remote_access.advanced.mswins2"`
+	RemoteAccessCisco                                    string                 `json:"remote_access.cisco"`
+	RemoteAccessClientlessVpnDebug                       bool                   `json:"remote_access.clientless_vpn.debug"`
+	RemoteAccessClientlessVpnStatus                      bool                   `json:"remote_access.clientless_vpn.status"`
+	RemoteAccessL2Tp                                     string                 `json:"remote_access.l2tp"`
+	RemoteAccessPptpAaa                                  []string               `json:"remote_access.pptp.aaa"`
+	RemoteAccessPptpAuthentication                       string                 `json:"remote_access.pptp.authentication"`
+	RemoteAccessPptpDebug                                bool                   `json:"remote_access.pptp.debug"`
+	RemoteAccessPptpEncryption                           string                 `json:"remote_access.pptp.encryption"`
+	RemoteAccessPptpIpAssignmentDhcp                     string                 `json:"remote_access.pptp.ip_assignment_dhcp"`
+	RemoteAccessPptpIpAssignmentDhcpInterface            string                 `json:"remote_access.pptp.ip_assignment_dhcp_interface"`
+	RemoteAccessPptpIpAssignmentMode                     string                 `json:"remote_access.pptp.ip_assignment_mode"`
+	RemoteAccessPptpIpAssignmentPool                     string                 `json:"remote_access.pptp.ip_assignment_pool"`
+	RemoteAccessPptpIphoneConnectionName                 string                 `json:"remote_access.pptp.iphone_connection_name"`
+	RemoteAccessPptpIphoneHostname                       string                 `json:"remote_access.pptp.iphone_hostname"`
+	RemoteAccessPptpIphoneStatus                         bool                   `json:"remote_access.pptp.iphone_status"`
+	RemoteAccessPptpMtu                                  int64                  `json:"remote_access.pptp.mtu"`
+	RemoteAccessPptpStatus                               bool                   `json:"remote_access.pptp.status"`
+	RemoteSyslogBuffer                                   int64                  `json:"remote_syslog.buffer"`
+	RemoteSyslogLogs                                     []string               `json:"remote_syslog.logs"`
+	RemoteSyslogStatus                                   bool                   `json:"remote_syslog.status"`
+	RemoteSyslogTarget                                   []string               `json:"remote_syslog.target"`
+	ReportingAccountingKeepdays                          int64                  `json:"reporting.accounting_keepdays"`
+	ReportingAccountingStatus                            bool                   `json:"reporting.accounting_status"`
+	ReportingAnonymizing                                 bool                   `json:"reporting.anonymizing"`
+	ReportingAppctrlKeepdays                             int64                  `json:"reporting.appctrl_keepdays"`
+	ReportingAppctrlStatus                               bool                   `json:"reporting.appctrl_status"`
+	ReportingAtpKeepdays                                 int64                  `json:"reporting.atp_keepdays"`
+	ReportingAtpReset                                    map[string]interface{} `json:"reporting.atp_reset"`
+	ReportingAtpStatus                                   bool                   `json:"reporting.atp_status"`
+	ReportingAuthenticationKeepdays                      int64                  `json:"reporting.authentication_keepdays"`
+	ReportingAuthenticationStatus                        bool                   `json:"reporting.authentication_status"`
+	ReportingCsvSeparator                                string                 `json:"reporting.csv_separator"`
+	ReportingEmailsecurityImport                         []string               `json:"reporting.emailsecurity_import"`
+	ReportingEmailsecurityKeepdays                       int64                  `json:"reporting.emailsecurity_keepdays"`
+	ReportingEmailsecurityStatus                         bool                   `json:"reporting.emailsecurity_status"`
+	ReportingEnableVpnAccounting                         bool                   `json:"reporting.enable_vpn_accounting"`
+	ReportingHideAccountingips                           []string               `json:"reporting.hide_accountingips"`
+	ReportingHideMailaddresses                           []string               `json:"reporting.hide_mailaddresses"`
+	ReportingHideMaildomains                             []string               `json:"reporting.hide_maildomains"`
+	ReportingHideNetsecips                               []string               `json:"reporting.hide_netsecips"`
+	ReportingHideWebdomains                              []string               `json:"reporting.hide_webdomains"`
+	ReportingIpsImport                                   []string               `json:"reporting.ips_import"`
+	ReportingIpsKeepdays                                 int64                  `json:"reporting.ips_keepdays"`
+	ReportingIpsStatus                                   bool                   `json:"reporting.ips_status"`
+	ReportingPacketfilterImport                          []string               `json:"reporting.packetfilter_import"`
+	ReportingPacketfilterKeepdays                        int64                  `json:"reporting.packetfilter_keepdays"`
+	ReportingPacketfilterStatus                          bool                   `json:"reporting.packetfilter_status"`
+	ReportingPassword1                                   string                 `json:"reporting.password1"`
+	ReportingPassword2                                   string                 `json:"reporting.password2"`
+	ReportingSandboxKeepdays                             int64                  `json:"reporting.sandbox_keepdays"`
+	ReportingUserlogFromLogs                             bool                   `json:"reporting.userlog_from_logs"`
+	ReportingVpnKeepdays                                 int64                  `json:"reporting.vpn_keepdays"`
+	ReportingVpnStatus                                   bool                   `json:"reporting.vpn_status"`
+	ReportingWafKeepdays                                 int64                  `json:"reporting.waf_keepdays"`
+	ReportingWafStatus                                   bool                   `json:"reporting.waf_status"`
+	ReportingWebsecurityDetail                           map[string]interface{} `json:"reporting.websecurity_detail"`
+	ReportingWebsecurityImport                           []string               `json:"reporting.websecurity_import"`
+	ReportingWebsecurityKeepdays                         int64                  `json:"reporting.websecurity_keepdays"`
+	ReportingWebsecurityStatus                           bool                   `json:"reporting.websecurity_status"`
+	ReverseProxyAuaRefreshEnabled                        bool                   `json:"reverse_proxy.aua_refresh_enabled"`
+	ReverseProxyAuaRefreshInterval                       int64                  `json:"reverse_proxy.aua_refresh_interval"`
+	ReverseProxyBlacklistDnsrblZones                     []string               `json:"reverse_proxy.blacklist.dnsrbl_zones"`
+	ReverseProxyBlacklistGeoipCodes                      []string               `json:"reverse_proxy.blacklist.geoip_codes"`
+	ReverseProxyCookiesignkey                            string                 `json:"reverse_proxy.cookiesignkey"`
+	ReverseProxyCssdHostname                             string                 `json:"reverse_proxy.cssd_hostname"`
+	ReverseProxyCssdPort                                 map[string]interface{} `json:"reverse_proxy.cssd_port"`
+	ReverseProxyCustomThreatFiltersEnabled               bool                   `json:"reverse_proxy.custom_threat_filters_enabled"`
+	ReverseProxyFormhardeningSecret                      string                 `json:"reverse_proxy.formhardening_secret"`
+	ReverseProxyKeepalive                                bool                   `json:"reverse_proxy.keepalive"`
+	ReverseProxyManualmode                               bool                   `json:"reverse_proxy.manualmode"`
+	ReverseProxyMaxConnectionsPerChild                   map[string]interface{} `json:"reverse_proxy.max_connections_per_child"`
+	ReverseProxyMaxPreforkProcesses                      int64                  `json:"reverse_proxy.max_prefork_processes"`
+	ReverseProxyMaxProcesses                             int64                  `json:"reverse_proxy.max_processes"`
+	ReverseProxyMaxSessionFiles                          int64                  `json:"reverse_proxy.max_session_files"`
+	ReverseProxyMaxSpareProcesses                        int64                  `json:"reverse_proxy.max_spare_processes"`
+	ReverseProxyMaxSpareThreads                          int64                  `json:"reverse_proxy.max_spare_threads"`
+	ReverseProxyMaxThreadsPerProcess                     int64                  `json:"reverse_proxy.max_threads_per_process"`
+	ReverseProxyMinSpareProcesses                        int64                  `json:"reverse_proxy.min_spare_processes"`
+	ReverseProxyMinSpareThreads                          int64                  `json:"reverse_proxy.min_spare_threads"`
+	ReverseProxyMinTLS                                   string                 `json:"reverse_proxy.min_tls"`
+	ReverseProxyModsecurityBeta                          bool                   `json:"reverse_proxy.modsecurity_beta"`
+	ReverseProxyMpmMode                                  string                 `json:"reverse_proxy.mpm_mode"`
+	ReverseProxyPatternversion                           string                 `json:"reverse_proxy.patternversion"`
+	ReverseProxyPort                                     int64                  `json:"reverse_proxy.port"`
+	ReverseProxyProxyprotocol                            bool                   `json:"reverse_proxy.proxyprotocol"`
+	ReverseProxyRequestLineLimit                         map[string]interface{} `json:"reverse_proxy.request_line_limit"`
+	ReverseProxySlowhttpExceptions                       []string               `json:"reverse_proxy.slowhttp_exceptions"`
+	ReverseProxySlowhttpRequestHeaderTimeoutBase         int64                  `json:"reverse_proxy.slowhttp_request_header_timeout_base"`
+	ReverseProxySlowhttpRequestHeaderTimeoutEnabled      bool                   `json:"reverse_proxy.slowhttp_request_header_timeout_enabled"`
+	ReverseProxySlowhttpRequestHeaderTimeoutMax          int64                  `json:"reverse_proxy.slowhttp_request_header_timeout_max"`
+	ReverseProxySlowhttpRequestHeaderTimeoutRate         int64                  `json:"reverse_proxy.slowhttp_request_header_timeout_rate"`
+	ReverseProxyStatus                                   bool                   `json:"reverse_proxy.status"`
+	ReverseProxyTraceEnabled                             bool                   `json:"reverse_proxy.trace_enabled"`
+	ReverseProxyUrlhardeningsignkey                      string                 `json:"reverse_proxy.urlhardeningsignkey"`
+	ReverseProxyWhatkilledus                             bool                   `json:"reverse_proxy.whatkilledus"`
+	RoutesPolicy                                         []string               `json:"routes.policy"`
+	RoutesStatic                                         []string               `json:"routes.static"`
+	RoutingBgpMaximumPaths                               map[string]interface{} `json:"routing.bgp.maximum_paths"`
+	RoutingBgpMaximumPathsIbgp                           map[string]interface{} `json:"routing.bgp.maximum_paths_ibgp"`
+	RoutingBgpMultipleAs                                 bool                   `json:"routing.bgp.multiple_as"`
+	RoutingBgpStatus                                     bool                   `json:"routing.bgp.status"`
+	RoutingBgpStrictMatch                                bool                   `json:"routing.bgp.strict_match"`
+	RoutingBgpSystems                                    []string               `json:"routing.bgp.systems"`
+	RoutingOspfAbrType                                   string                 `json:"routing.ospf.abr_type"`
+	RoutingOspfAreas                                     []string               `json:"routing.ospf.areas"`
+	RoutingOspfDefaultInformation                        string                 `json:"routing.ospf.default_information"`
+	RoutingOspfDefaultRouteMetric                        int64                  `json:"routing.ospf.default_route_metric"`
+	RoutingOspfRedistributeBgpMetric                     int64                  `json:"routing.ospf.redistribute.bgp.metric"`
+	RoutingOspfRedistributeBgpStatus                     bool                   `json:"routing.ospf.redistribute.bgp.status"`
+	RoutingOspfRedistributeConnectedMetric               int64                  `json:"routing.ospf.redistribute.connected.metric"`
+	RoutingOspfRedistributeConnectedStatus               bool                   `json:"routing.ospf.redistribute.connected.status"`
+	RoutingOspfRedistributeIpsecStatus                   bool                   `json:"routing.ospf.redistribute.ipsec.status"`
+	RoutingOspfRedistributeSslVpnStatus                  bool                   `json:"routing.ospf.redistribute.ssl_vpn.status"`
+	RoutingOspfRedistributeStaticMetric                  int64                  `json:"routing.ospf.redistribute.static.metric"`
+	RoutingOspfRedistributeStaticStatus                  bool                   `json:"routing.ospf.redistribute.static.status"`
+	RoutingOspfReferenceBandwidth                        map[string]interface{} `json:"routing.ospf.reference_bandwidth"`
+	RoutingOspfRouterID                                  string                 `json:"routing.ospf.router_id"`
+	RoutingOspfStatus                                    bool                   `json:"routing.ospf.status"`
+	RoutingQuaggaAllowedNetworks                         NetworkRefs            `json:"routing.quagga.allowed_networks"`
+	RoutingQuaggaEnablePassword                          string                 `json:"routing.quagga.enable_password"`
+	RoutingQuaggaLinkDetect                              bool                   `json:"routing.quagga.link_detect"`
+	RoutingQuaggaPassword                                string                 `json:"routing.quagga.password"`
+	SandboxReportdDebug                                  string                 `json:"sandbox_reportd.debug"`
+	SandboxReportdPollInterval                           int64                  `json:"sandbox_reportd.poll_interval"`
+	SandboxReportdRequestTimeout                         int64                  `json:"sandbox_reportd.request_timeout"`
+	SandboxReportdRetryTimes                             int64                  `json:"sandbox_reportd.retry_times"`
+	SandboxdBypassSandboxLimit                           int64                  `json:"sandboxd.bypass_sandbox_limit"`
+	SandboxdCacheExpire                                  int64                  `json:"sandboxd.cache_expire"`
+	SandboxdCertstore                                    string                 `json:"sandboxd.certstore"`
+	SandboxdClientTimeout                                int64                  `json:"sandboxd.client_timeout"`
+	SandboxdCloudPollInterval                            int64                  `json:"sandboxd.cloud_poll_interval"`
+	SandboxdCloudPollRequestMaximum                      int64                  `json:"sandboxd.cloud_poll_request_maximum"`
+	SandboxdCloudPollTimeout                             int64                  `json:"sandboxd.cloud_poll_timeout"`
+	SandboxdConnectTimeout                               int64                  `json:"sandboxd.connect_timeout"`
+	SandboxdDebug                                        map[string]interface{} `json:"sandboxd.debug"`
+	SandboxdDhparams2048                                 string                 `json:"sandboxd.dhparams_2048"`
+	SandboxdEdgeServerCertValidation                     bool                   `json:"sandboxd.edge_server_cert_validation"`
+	SandboxdEdgeServerHost                               string                 `json:"sandboxd.edge_server_host"`
+	SandboxdEdgeServerPort                               int64                  `json:"sandboxd.edge_server_port"`
+	SandboxdFiletypeSkiplist                             []string               `json:"sandboxd.filetype_skiplist"`
+	SandboxdNumOfEventThreads                            int64                  `json:"sandboxd.num_of_event_threads"`
+	SandboxdNumOfWorkerThreads                           int64                  `json:"sandboxd.num_of_worker_threads"`
+	SandboxdResponseTimeout                              int64                  `json:"sandboxd.response_timeout"`
+	SandboxdRetryIntervals                               string                 `json:"sandboxd.retry_intervals"`
+	SandboxdSandboxEnabled                               bool                   `json:"sandboxd.sandbox_enabled"`
+	SandboxdSandboxdScoreThreshold                       int64                  `json:"sandboxd.sandboxd_score_threshold"`
+	SandboxdSbxVersion                                   int64                  `json:"sandboxd.sbx_version"`
+	SandboxdSqliteBusyTimeout                            int64                  `json:"sandboxd.sqlite_busy_timeout"`
+	SandboxdSslCertFile                                  string                 `json:"sandboxd.ssl_cert_file"`
+	SandboxdSslKeyFile                                   string                 `json:"sandboxd.ssl_key_file"`
+	SandboxdSslciphers                                   string                 `json:"sandboxd.sslciphers"`
+	SettingsAdminEmail                                   string                 `json:"settings.admin_email"`
+	SettingsBasicSetup                                   bool                   `json:"settings.basic_setup"`
+	SettingsCcMode                                       bool                   `json:"settings.cc_mode"`
+	SettingsCity                                         string                 `json:"settings.city"`
+	SettingsCountry                                      string                 `json:"settings.country"`
+	SettingsExtraSwap                                    map[string]interface{} `json:"settings.extra_swap"`
+	SettingsHostname                                     string                 `json:"settings.hostname"`
+	SettingsIcsaMode                                     bool                   `json:"settings.icsa_mode"`
+	SettingsOrganization                                 string                 `json:"settings.organization"`
+	SettingsPasswordComplexityMinDigits                  int64                  `json:"settings.password_complexity.min_digits"`
+	SettingsPasswordComplexityMinLength                  int64                  `json:"settings.password_complexity.min_length"`
+	SettingsPasswordComplexityMinLowerChars              int64                  `json:"settings.password_complexity.min_lower_chars"`
+	SettingsPasswordComplexityMinSpecialChars            int64                  `json:"settings.password_complexity.min_special_chars"`
+	SettingsPasswordComplexityMinUpperChars              int64                  `json:"settings.password_complexity.min_upper_chars"`
+	SettingsPasswordComplexityStatus                     bool                   `json:"settings.password_complexity.status"`
+	SettingsPopularity                                   string                 `json:"settings.popularity"`
+	SettingsRasUpdate                                    string                 `json:"settings.ras_update"`
+	SettingsSystemID                                     string                 `json:"settings.system_id"`
+	SettingsTimezone                                     string                 `json:"settings.timezone"`
+	SipAllowedNetworks                                   NetworkRefs            `json:"sip.allowed_networks"`
+	SipExpectMode                                        string                 `json:"sip.expect_mode"`
+	SipLogRelated                                        bool                   `json:"sip.log_related"`
+	SipServers                                           []string               `json:"sip.servers"`
+	SipStatus                                            bool                   `json:"sip.status"`
+	SmsClientHostname                                    string                 `json:"sms_client.hostname"`
+	SmsClientName                                        string                 `json:"sms_client.name"`
+	SmsClientPassword                                    string                 `json:"sms_client.password"`
+	SmsClientPort                                        map[string]interface{} `json:"sms_client.port"`
+	SmsClientStatus                                      bool                   `json:"sms_client.status"`
+	SmsClientUsername                                    string                 `json:"sms_client.username"`
+	SMTPAuthAaa                                          []string               `json:"smtp.auth_aaa"`
+	SMTPAuthStatus                                       bool                   `json:"smtp.auth_status"`
+	SMTPAvFooter                                         string                 `json:"smtp.av_footer"`
+	SMTPAvFooterStatus                                   bool                   `json:"smtp.av_footer_status"`
+	SMTPBatvSecret                                       string                 `json:"smtp.batv_secret"`
+	SMTPBlockerService                                   string                 `json:"smtp.blocker_service"`
+	SMTPCffAsMarker                                      string                 `json:"smtp.cff_as_marker"`
+	SMTPDkimDomains                                      []string               `json:"smtp.dkim_domains"`
+	SMTPDkimPrivateKey                                   string                 `json:"smtp.dkim_private_key"`
+	SMTPDkimSelector                                     string                 `json:"smtp.dkim_selector"`
+	SMTPEnableOldExpressionFilter                        bool                   `json:"smtp.enable_old_expression_filter"`
+	SMTPEncryptionUtility                                string                 `json:"smtp.encryption_utility"`
+	SMTPExceptions                                       []string               `json:"smtp.exceptions"`
+	SMTPFootersMode                                      string                 `json:"smtp.footers_mode"`
+	SMTPGlobalAsReject                                   string                 `json:"smtp.global_as_reject"`
+	SMTPGlobalAvReject                                   bool                   `json:"smtp.global_av_reject"`
+	SMTPGlobalProfile                                    string                 `json:"smtp.global_profile"`
+	SMTPHostBlacklist                                    []string               `json:"smtp.host_blacklist"`
+	SMTPHostname                                         string                 `json:"smtp.hostname"`
+	SMTPMaxMessageSize                                   int64                  `json:"smtp.max_message_size"`
+	SMTPMode                                             string                 `json:"smtp.mode"`
+	SMTPParentProxyAuthPass                              string                 `json:"smtp.parent_proxy_auth_pass"`
+	SMTPParentProxyAuthStatus                            bool                   `json:"smtp.parent_proxy_auth_status"`
+	SMTPParentProxyAuthUser                              string                 `json:"smtp.parent_proxy_auth_user"`
+	SMTPParentProxyHost                                  string                 `json:"smtp.parent_proxy_host"`
+	SMTPParentProxyPort                                  int64                  `json:"smtp.parent_proxy_port"`
+	SMTPParentProxyStatus                                bool                   `json:"smtp.parent_proxy_status"`
+	SMTPPostmaster                                       string                 `json:"smtp.postmaster"`
+	SMTPProfiles                                         []string               `json:"smtp.profiles"`
+	SMTPRecipientsMax                                    int64                  `json:"smtp.recipients_max"`
+	SMTPRelays                                           []string               `json:"smtp.relays"`
+	SMTPScanOutgoingEmails                               bool                   `json:"smtp.scan_outgoing_emails"`
+	SMTPScannerPoolMaxPool                               int64                  `json:"smtp.scanner_pool.max_pool"`
+	SMTPScannerPoolThresholds                            []int64                `json:"smtp.scanner_pool.thresholds"`
+	SMTPScannerTimeout                                   int64                  `json:"smtp.scanner_timeout"`
+	SMTPSmarthostAuth                                    bool                   `json:"smtp.smarthost_auth"`
+	SMTPSmarthostHostname                                string                 `json:"smtp.smarthost_hostname"`
+	SMTPSmarthostPass                                    string                 `json:"smtp.smarthost_pass"`
+	SMTPSmarthostPort                                    int64                  `json:"smtp.smarthost_port"`
+	SMTPSmarthostStatus                                  bool                   `json:"smtp.smarthost_status"`
+	SMTPSmarthostUser                                    string                 `json:"smtp.smarthost_user"`
+	SMTPSmtpAcceptMax                                    int64                  `json:"smtp.smtp_accept_max"`
+	SMTPSmtpAcceptPerConnectionMax                       int64                  `json:"smtp.smtp_accept_per_connection_max"`
+	SMTPSmtpAcceptPerHostMax                             int64                  `json:"smtp.smtp_accept_per_host_max"`
+	SMTPStatus                                           bool                   `json:"smtp.status"`
+	SMTPTlsAvoid                                         []string               `json:"smtp.tls_avoid"`
+	SMTPTlsCert                                          string                 `json:"smtp.tls_cert"`
+	SMTPTlsRequire                                       []string               `json:"smtp.tls_require"`
+	SMTPTlsRequireSenderDomains                          []string               `json:"smtp.tls_require_sender_domains"`
+	SMTPTlsVersion                                       string                 `json:"smtp.tls_version"`
+	SMTPTransparent                                      bool                   `json:"smtp.transparent"`
+	SMTPTransparentSkip                                  NetworkRefs            `json:"smtp.transparent_skip"`
+	SMTPTransparentSkipAutoPf                            bool                   `json:"smtp.transparent_skip_auto_pf"`
+	SMTPUpstreamHosts                                    []string               `json:"smtp.upstream_hosts"`
+	SMTPUpstreamHostsOnly                                bool                   `json:"smtp.upstream_hosts_only"`
+	SnmpAllowedNetworks                                  NetworkRefs            `json:"snmp.allowed_networks"`
+	SnmpAuthPassword                                     string                 `json:"snmp.auth_password"`
+	SnmpAuthType                                         string                 `json:"snmp.auth_type"`
+	SnmpCommunity                                        string                 `json:"snmp.community"`
+	SnmpDeviceAdmin                                      string                 `json:"snmp.device_admin"`
+	SnmpDeviceLocation                                   string                 `json:"snmp.device_location"`
+	SnmpDeviceName                                       string                 `json:"snmp.device_name"`
+	SnmpEncryptPassword                                  string                 `json:"snmp.encrypt_password"`
+	SnmpEncryptType                                      string                 `json:"snmp.encrypt_type"`
+	SnmpStatus                                           bool                   `json:"snmp.status"`
+	SnmpTraps                                            []string               `json:"snmp.traps"`
+	SnmpTrapsUseOldOids                                  bool                   `json:"snmp.traps_use_old_oids"`
+	SnmpUsername                                         string                 `json:"snmp.username"`
+	SnmpVersion                                          string                 `json:"snmp.version"`
+	SocksAaa                                             []string               `json:"socks.aaa"`
+	SocksAllowedNetworks                                 NetworkRefs            `json:"socks.allowed_networks"`
+	SocksAuthentication                                  bool                   `json:"socks.authentication"`
+	SocksStatus                                          bool                   `json:"socks.status"`
+	SpxGlobalErrorNotificationTarget                     string                 `json:"spx.global.error_notification_target"`
+	SpxGlobalExpirySettingsAllowSecureReplyDays          int64                  `json:"spx.global.expiry_settings.allow_secure_reply_days"`
+	SpxGlobalExpirySettingsKeepDelayedMailDays           int64                  `json:"spx.global.expiry_settings.keep_delayed_mail_days"`
+	SpxGlobalExpirySettingsKeepUnusedPwdDays             int64                  `json:"spx.global.expiry_settings.keep_unused_pwd_days"`
+	SpxGlobalExpirySettingsRegistrationReminderDays      int64                  `json:"spx.global.expiry_settings.registration_reminder_days"`
+	SpxGlobalPasswordStrengthMinLength                   int64                  `json:"spx.global.password_strength.min_length"`
+	SpxGlobalPasswordStrengthRequireSpecChars            bool                   `json:"spx.global.password_strength.require_spec_chars"`
+	SpxGlobalPortalSettingsAllowedNetworks               NetworkRefs            `json:"spx.global.portal_settings.allowed_networks"`
+	SpxGlobalPortalSettingsHostname                      string                 `json:"spx.global.portal_settings.hostname"`
+	SpxGlobalPortalSettingsInterfaceAddress              string                 `json:"spx.global.portal_settings.interface_address"`
+	SpxGlobalPortalSettingsPort                          int64                  `json:"spx.global.portal_settings.port"`
+	SpxGlobalPoweredByLogo                               string                 `json:"spx.global.powered_by_logo"`
+	SpxGlobalSpxPriority                                 bool                   `json:"spx.global.spx_priority"`
+	SpxGlobalStatus                                      bool                   `json:"spx.global.status"`
+	SpxSpxAuthPassword                                   string                 `json:"spx.spx_auth.password"`
+	SpxSpxAuthPort                                       int64                  `json:"spx.spx_auth.port"`
+	SpxSpxAuthServer                                     string                 `json:"spx.spx_auth.server"`
+	SpxSpxAuthUrl                                        string                 `json:"spx.spx_auth.url"`
+	SpxTemplates                                         []string               `json:"spx.templates"`
+	SSHAllowedNetworks                                   NetworkRefs            `json:"ssh.allowed_networks"`
+	SSHLoginKeys                                         []string               `json:"ssh.login_keys"`
+	SSHPasswordAuth                                      bool                   `json:"ssh.password_auth"`
+	SSHPort                                              int64                  `json:"ssh.port"`
+	SSHPubkeyAuth                                        bool                   `json:"ssh.pubkey_auth"`
+	SSHRootKeys                                          []string               `json:"ssh.root_keys"`
+	SSHRootLogin                                         string                 `json:"ssh.root_login"`
+	SSHStatus                                            bool                   `json:"ssh.status"`
+	SslVpnAuthenticationAlgorithm                        string                 `json:"ssl_vpn.authentication_algorithm"`
+	SslVpnCertificate                                    string                 `json:"ssl_vpn.certificate"`
+	SslVpnCompression                                    bool                   `json:"ssl_vpn.compression"`
+	SslVpnDatachannelKeyLifetime                         int64                  `json:"ssl_vpn.datachannel_key_lifetime"`
+	SslVpnDebug                                          bool                   `json:"ssl_vpn.debug"`
+	SslVpnDhKeySize                                      string                 `json:"ssl_vpn.dh_key_size"`
+	SslVpnDuplicateCn                                    bool                   `json:"ssl_vpn.duplicate_cn"`
+	SslVpnEncryptionAlgorithm                            string                 `json:"ssl_vpn.encryption_algorithm"`
+	SslVpnHostname                                       string                 `json:"ssl_vpn.hostname"`
+	SslVpnInterface                                      string                 `json:"ssl_vpn.interface"`
+	SslVpnInterfaceAddress                               string                 `json:"ssl_vpn.interface_address"`
+	SslVpnIpAssignmentPool                               string                 `json:"ssl_vpn.ip_assignment_pool"`
+	SslVpnPort                                           int64                  `json:"ssl_vpn.port"`
+	SslVpnProtocol                                       string                 `json:"ssl_vpn.protocol"`
+	SslVpnUserAuthOptional                               bool                   `json:"ssl_vpn.user_auth_optional"`
+	SupportAccessAccessID                                string                 `json:"support_access.access_id"`
+	SupportAccessApuServer                               string                 `json:"support_access.apu_server"`
+	SupportAccessLifetimeDuration                        string                 `json:"support_access.lifetime_duration"`
+	SupportAccessLifetimeEnd                             map[string]interface{} `json:"support_access.lifetime_end"`
+	SupportAccessSshKeys                                 []string               `json:"support_access.ssh_keys"`
+	SupportAccessStatus                                  bool                   `json:"support_access.status"`
+	U2DcacheAllowedNetworks                              NetworkRefs            `json:"u2dcache.allowed_networks"`
+	U2DcachePort                                         int64                  `json:"u2dcache.port"`
+	U2DcacheStatus                                       bool                   `json:"u2dcache.status"`
+	Up2DateCacheHost                                     string                 `json:"up2date.cache_host"`
+	Up2DateCachePort                                     int64                  `json:"up2date.cache_port"`
+	Up2DateCacheStatus                                   bool                   `json:"up2date.cache_status"`
+	Up2DateCacheUseAcc                                   bool                   `json:"up2date.cache_use_acc"`
+	Up2DateParentProxyAuthPass                           string                 `json:"up2date.parent_proxy_auth_pass"`
+	Up2DateParentProxyAuthStatus                         bool                   `json:"up2date.parent_proxy_auth_status"`
+	Up2DateParentProxyAuthUser                           string                 `json:"up2date.parent_proxy_auth_user"`
+	Up2DateParentProxyHost                               string                 `json:"up2date.parent_proxy_host"`
+	Up2DateParentProxyPort                               int64                  `json:"up2date.parent_proxy_port"`
+	Up2DateParentProxyStatus                             bool                   `json:"up2date.parent_proxy_status"`
+	Up2DatePatternDownloadInterval                       map[string]interface{} `json:"up2date.pattern_download_interval"`
+	Up2DatePatternDownloadStatus                         bool                   `json:"up2date.pattern_download_status"`
+	Up2DateScheduledUp2Date                              []string               `json:"up2date.scheduled_up2date"`
+	Up2DateServers                                       []string               `json:"up2date.servers"`
+	Up2DateStatus                                        bool                   `json:"up2date.status"`
+	Up2DateSystemAutoinstallTime                         string                 `json:"up2date.system_autoinstall_time"`
+	Up2DateSystemDownloadInterval                        int64                  `json:"up2date.system_download_interval"`
+	Up2DateSystemDownloadStatus                          bool                   `json:"up2date.system_download_status"`
+	UplinkActions                                        []string               `json:"uplink.actions"`
+	UplinkActive                                         string                 `json:"uplink.active"`
+	UplinkCondition                                      string                 `json:"uplink.condition"`
+	UplinkInterfaces                                     string                 `json:"uplink.interfaces"`
+	UplinkMonitoring                                     bool                   `json:"uplink.monitoring"`
+	UplinkPassive                                        string                 `json:"uplink.passive"`
+	UplinkPrimary                                        string                 `json:"uplink.primary"`
+	UplinkRules                                          []string               `json:"uplink.rules"`
+	UplinkScheduler                                      string                 `json:"uplink.scheduler"`
+	UplinkStatus                                         bool                   `json:"uplink.status"`
+	WebadminAllowedNetworks                              NetworkRefs            `json:"webadmin.allowed_networks"`
+	WebadminCa                                           string                 `json:"webadmin.ca"`
+	WebadminCert                                         string                 `json:"webadmin.cert"`
+	WebadminDashboardRefresh                             string                 `json:"webadmin.dashboard_refresh"`
+	WebadminLanguage                                     string                 `json:"webadmin.language"`
+	WebadminLogAdminConnect                              bool                   `json:"webadmin.log_admin_connect"`
+	WebadminOfferWizard                                  bool                   `json:"webadmin.offer_wizard"`
+	WebadminPort                                         int64                  `json:"webadmin.port"`
+	WebadminRestAPI                                      bool                   `json:"webadmin.rest_api"`
+	WebadminTermsOfUseStatus                             bool                   `json:"webadmin.terms_of_use.status"`
+	WebadminTermsOfUseText                               string                 `json:"webadmin.terms_of_use.text"`
+	WebadminTimeout                                      int64                  `json:"webadmin.timeout"`
+	WebadminTimeoutOnDashboard                           bool                   `json:"webadmin.timeout_on_dashboard"`
+	WebadminTlsCiphers                                   string                 `json:"webadmin.tls_ciphers"`
+	WebadminTlsProtocols                                 string                 `json:"webadmin.tls_protocols"`
 }
 
 var _ sophos.Endpoint = &Nodes{}
 
-var defsNodes = map[string]sophos.RestObject{}
+var defsNodes = map[string]sophos.RestGetter{}
 
 // RestObjects implements the sophos.Node interface and returns a map of Nodes's Objects
-func (Nodes) RestObjects() map[string]sophos.RestObject { return defsNodes }
+func (Nodes) RestObjects() map[string]sophos.RestGetter { return defsNodes }
 
 // GetPath implements sophos.RestGetter
 func (*Nodes) GetPath() string { return "/api/nodes" }
@@ -15,13 +15,13 @@ type Notification struct {
 
 var _ sophos.Endpoint = &Notification{}
 
-var defsNotification = map[string]sophos.RestObject{
+var defsNotification = map[string]sophos.RestGetter{
 	"NotificationGroup":        &NotificationGroup{},
 	"NotificationNotification": &NotificationNotification{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Notification's Objects
-func (Notification) RestObjects() map[string]sophos.RestObject { return defsNotification }
+func (Notification) RestObjects() map[string]sophos.RestGetter { return defsNotification }
 
 // GetPath implements sophos.RestGetter
 func (*Notification) GetPath() string { return "/api/nodes/notification" }
@@ -55,7 +55,7 @@ func (Notification) References() []string {
 	}
 }
 
-// NotificationGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// NotificationGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type NotificationGroups []NotificationGroup
 
 // NotificationGroup represents a UTM group
@@ -67,55 +67,76 @@ type NotificationGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewNotificationGroup returns a NotificationGroup with the default values of its swagger definition
+func NewNotificationGroup() *NotificationGroup {
+	return &NotificationGroup{
+		ObjectType: "notification/group",
+	}
+}
+
+// Validate checks the NotificationGroup before it is sent, see ValidateWith
+func (n *NotificationGroup) Validate() error { return n.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the NotificationGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (n *NotificationGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("NotificationGroup", typeOf)
+	check.Required("name", n.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &NotificationGroup{}
 
-// GetPath implements sophos.RestObject and returns the NotificationGroups GET path
+// GetPath implements sophos.RestGetter and returns the NotificationGroups GET path
 // Returns all available notification/group objects
 func (*NotificationGroups) GetPath() string { return "/api/objects/notification/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*NotificationGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the NotificationGroups GET path
+// GetPath implements sophos.RestGetter and returns the NotificationGroups GET path
 // Returns all available group types
 func (n *NotificationGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/notification/group/%s", n.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (n *NotificationGroup) RefRequired() (string, bool) { return n.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the NotificationGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the NotificationGroup DELETE path
 // Creates or updates the complete object group
 func (*NotificationGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/notification/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the NotificationGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the NotificationGroup PATCH path
 // Changes to parts of the object group types
 func (*NotificationGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/notification/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the NotificationGroup POST path
+// PostPath implements sophos.Creatable and returns the NotificationGroup POST path
 // Create a new notification/group object
 func (*NotificationGroup) PostPath() string {
 	return "/api/objects/notification/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the NotificationGroup PUT path
+// PutPath implements sophos.Updatable and returns the NotificationGroup PUT path
 // Creates or updates the complete object group
 func (*NotificationGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/notification/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*NotificationGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/notification/group/%s/usedby", ref)
 }
 
-// NotificationNotifications is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (n *NotificationGroup) GetType() string { return n.ObjectType }
+
+// NotificationNotifications is an Sophos Endpoint subType and implements sophos.RestGetter
 type NotificationNotifications []NotificationNotification
 
 // NotificationNotification represents a UTM notification
@@ -123,59 +144,80 @@ type NotificationNotification struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// Snmp default value is false
-	Snmp    bool   `json:"snmp"`
-	Comment string `json:"comment"`
+	Comment    string `json:"comment"`
 	// Email default value is false
 	Email bool   `json:"email"`
 	Id    string `json:"id"`
 	Name  string `json:"name"`
+	// Snmp default value is false
+	Snmp bool `json:"snmp"`
+}
+
+// NewNotificationNotification returns a NotificationNotification with the default values of its swagger definition
+func NewNotificationNotification() *NotificationNotification {
+	return &NotificationNotification{
+		ObjectType: "notification/notification",
+	}
+}
+
+// Validate checks the NotificationNotification before it is sent, see ValidateWith
+func (n *NotificationNotification) Validate() error { return n.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the NotificationNotification.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (n *NotificationNotification) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("NotificationNotification", typeOf)
+	check.Required("name", n.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &NotificationNotification{}
 
-// GetPath implements sophos.RestObject and returns the NotificationNotifications GET path
+// GetPath implements sophos.RestGetter and returns the NotificationNotifications GET path
 // Returns all available notification/notification objects
 func (*NotificationNotifications) GetPath() string { return "/api/objects/notification/notification/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*NotificationNotifications) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the NotificationNotifications GET path
+// GetPath implements sophos.RestGetter and returns the NotificationNotifications GET path
 // Returns all available notification types
 func (n *NotificationNotification) GetPath() string {
 	return fmt.Sprintf("/api/objects/notification/notification/%s", n.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (n *NotificationNotification) RefRequired() (string, bool) { return n.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the NotificationNotification DELETE path
+// DeletePath implements sophos.Deletable and returns the NotificationNotification DELETE path
 // Creates or updates the complete object notification
 func (*NotificationNotification) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/notification/notification/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the NotificationNotification PATCH path
+// PatchPath implements sophos.Patchable and returns the NotificationNotification PATCH path
 // Changes to parts of the object notification types
 func (*NotificationNotification) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/notification/notification/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the NotificationNotification POST path
+// PostPath implements sophos.Creatable and returns the NotificationNotification POST path
 // Create a new notification/notification object
 func (*NotificationNotification) PostPath() string {
 	return "/api/objects/notification/notification/"
 }
 
-// PutPath implements sophos.RestObject and returns the NotificationNotification PUT path
+// PutPath implements sophos.Updatable and returns the NotificationNotification PUT path
 // Creates or updates the complete object notification
 func (*NotificationNotification) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/notification/notification/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*NotificationNotification) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/notification/notification/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (n *NotificationNotification) GetType() string { return n.ObjectType }
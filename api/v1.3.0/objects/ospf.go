@@ -17,7 +17,7 @@ type Ospf struct {
 
 var _ sophos.Endpoint = &Ospf{}
 
-var defsOspf = map[string]sophos.RestObject{
+var defsOspf = map[string]sophos.RestGetter{
 	"OspfArea":             &OspfArea{},
 	"OspfGroup":            &OspfGroup{},
 	"OspfInterface":        &OspfInterface{},
@@ -25,7 +25,7 @@ var defsOspf = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Ospf's Objects
-func (Ospf) RestObjects() map[string]sophos.RestObject { return defsOspf }
+func (Ospf) RestObjects() map[string]sophos.RestGetter { return defsOspf }
 
 // GetPath implements sophos.RestGetter
 func (*Ospf) GetPath() string { return "/api/nodes/ospf" }
@@ -67,7 +67,7 @@ func (Ospf) References() []string {
 	}
 }
 
-// OspfAreas is an Sophos Endpoint subType and implements sophos.RestObject
+// OspfAreas is an Sophos Endpoint subType and implements sophos.RestGetter
 type OspfAreas []OspfArea
 
 // OspfArea represents a UTM OSPF area
@@ -75,67 +75,132 @@ type OspfArea struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
+	// Authentication can be one of: []string{"message-digest", "plain-text", "null"}
+	Authentication OspfAreaAuthentication `json:"authentication"`
+	Comment        string                 `json:"comment"`
+	DefaultCost    int64                  `json:"default_cost"`
 	// Id description: (IPADDR)
-	Id         string        `json:"id"`
-	Interfaces []interface{} `json:"interfaces"`
-	Name       string        `json:"name"`
+	Id         string   `json:"id"`
+	Interfaces []string `json:"interfaces"`
+	Name       string   `json:"name"`
 	// Type can be one of: []string{"normal", "stub", "nssa", "stub no-summary", "nssa no-summary"}
 	// Type default value is "normal"
-	Type         string        `json:"type"`
-	VirtualLinks []interface{} `json:"virtual_links"`
-	// Authentication can be one of: []string{"message-digest", "plain-text", "null"}
-	Authentication string `json:"authentication"`
-	Comment        string `json:"comment"`
-	DefaultCost    int    `json:"default_cost"`
+	Type         OspfAreaType `json:"type"`
+	VirtualLinks []string     `json:"virtual_links"`
+}
+
+// OspfAreaAuthentication is the Authentication of a OspfArea
+type OspfAreaAuthentication string
+
+// Known values of OspfAreaAuthentication
+const (
+	OspfAreaAuthenticationMessageDigest OspfAreaAuthentication = "message-digest"
+	OspfAreaAuthenticationPlainText     OspfAreaAuthentication = "plain-text"
+	OspfAreaAuthenticationNull          OspfAreaAuthentication = "null"
+)
+
+// Valid returns true if the value is a known OspfAreaAuthentication
+func (v OspfAreaAuthentication) Valid() bool {
+	switch v {
+	case OspfAreaAuthenticationMessageDigest, OspfAreaAuthenticationPlainText, OspfAreaAuthenticationNull:
+		return true
+	}
+	return false
+}
+
+// OspfAreaType is the Type of a OspfArea
+type OspfAreaType string
+
+// Known values of OspfAreaType
+const (
+	OspfAreaTypeNormal        OspfAreaType = "normal"
+	OspfAreaTypeStub          OspfAreaType = "stub"
+	OspfAreaTypeNssa          OspfAreaType = "nssa"
+	OspfAreaTypeStubNoSummary OspfAreaType = "stub no-summary"
+	OspfAreaTypeNssaNoSummary OspfAreaType = "nssa no-summary"
+)
+
+// Valid returns true if the value is a known OspfAreaType
+func (v OspfAreaType) Valid() bool {
+	switch v {
+	case OspfAreaTypeNormal, OspfAreaTypeStub, OspfAreaTypeNssa, OspfAreaTypeStubNoSummary, OspfAreaTypeNssaNoSummary:
+		return true
+	}
+	return false
+}
+
+// NewOspfArea returns a OspfArea with the default values of its swagger definition
+func NewOspfArea() *OspfArea {
+	return &OspfArea{
+		ObjectType: "ospf/area",
+		Type:       OspfAreaTypeNormal,
+	}
+}
+
+// Validate checks the OspfArea before it is sent, see ValidateWith
+func (o *OspfArea) Validate() error { return o.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the OspfArea.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (o *OspfArea) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("OspfArea", typeOf)
+	check.Enum("authentication", string(o.Authentication), o.Authentication.Valid())
+	check.Format("id", sophos.FormatIPv4, o.Id)
+	check.Required("name", o.Name)
+	check.Enum("type", string(o.Type), o.Type.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &OspfArea{}
 
-// GetPath implements sophos.RestObject and returns the OspfAreas GET path
+// GetPath implements sophos.RestGetter and returns the OspfAreas GET path
 // Returns all available ospf/area objects
 func (*OspfAreas) GetPath() string { return "/api/objects/ospf/area/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*OspfAreas) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the OspfAreas GET path
+// GetPath implements sophos.RestGetter and returns the OspfAreas GET path
 // Returns all available area types
 func (o *OspfArea) GetPath() string { return fmt.Sprintf("/api/objects/ospf/area/%s", o.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (o *OspfArea) RefRequired() (string, bool) { return o.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the OspfArea DELETE path
+// DeletePath implements sophos.Deletable and returns the OspfArea DELETE path
 // Creates or updates the complete object area
 func (*OspfArea) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/area/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the OspfArea PATCH path
+// PatchPath implements sophos.Patchable and returns the OspfArea PATCH path
 // Changes to parts of the object area types
 func (*OspfArea) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/area/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the OspfArea POST path
+// PostPath implements sophos.Creatable and returns the OspfArea POST path
 // Create a new ospf/area object
 func (*OspfArea) PostPath() string {
 	return "/api/objects/ospf/area/"
 }
 
-// PutPath implements sophos.RestObject and returns the OspfArea PUT path
+// PutPath implements sophos.Updatable and returns the OspfArea PUT path
 // Creates or updates the complete object area
 func (*OspfArea) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/area/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*OspfArea) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/area/%s/usedby", ref)
 }
 
-// OspfGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (o *OspfArea) GetType() string { return o.ObjectType }
+
+// OspfGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type OspfGroups []OspfGroup
 
 // OspfGroup represents a UTM group
@@ -147,53 +212,74 @@ type OspfGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewOspfGroup returns a OspfGroup with the default values of its swagger definition
+func NewOspfGroup() *OspfGroup {
+	return &OspfGroup{
+		ObjectType: "ospf/group",
+	}
+}
+
+// Validate checks the OspfGroup before it is sent, see ValidateWith
+func (o *OspfGroup) Validate() error { return o.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the OspfGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (o *OspfGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("OspfGroup", typeOf)
+	check.Required("name", o.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &OspfGroup{}
 
-// GetPath implements sophos.RestObject and returns the OspfGroups GET path
+// GetPath implements sophos.RestGetter and returns the OspfGroups GET path
 // Returns all available ospf/group objects
 func (*OspfGroups) GetPath() string { return "/api/objects/ospf/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*OspfGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the OspfGroups GET path
+// GetPath implements sophos.RestGetter and returns the OspfGroups GET path
 // Returns all available group types
 func (o *OspfGroup) GetPath() string { return fmt.Sprintf("/api/objects/ospf/group/%s", o.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (o *OspfGroup) RefRequired() (string, bool) { return o.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the OspfGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the OspfGroup DELETE path
 // Creates or updates the complete object group
 func (*OspfGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the OspfGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the OspfGroup PATCH path
 // Changes to parts of the object group types
 func (*OspfGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the OspfGroup POST path
+// PostPath implements sophos.Creatable and returns the OspfGroup POST path
 // Create a new ospf/group object
 func (*OspfGroup) PostPath() string {
 	return "/api/objects/ospf/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the OspfGroup PUT path
+// PutPath implements sophos.Updatable and returns the OspfGroup PUT path
 // Creates or updates the complete object group
 func (*OspfGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*OspfGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/group/%s/usedby", ref)
 }
 
-// OspfInterfaces is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (o *OspfGroup) GetType() string { return o.ObjectType }
+
+// OspfInterfaces is an Sophos Endpoint subType and implements sophos.RestGetter
 type OspfInterfaces []OspfInterface
 
 // OspfInterface represents a UTM OSPF interface
@@ -201,134 +287,202 @@ type OspfInterface struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// RetransmitInterval description: Constraints: 0, 3-65535
-	RetransmitInterval int `json:"retransmit_interval"`
 	// Authentication can be one of: []string{"message-digest", "plain-text", "null"}
-	Authentication string `json:"authentication"`
+	Authentication OspfInterfaceAuthentication `json:"authentication"`
 	// AuthenticationKey description: (REGEX)
 	AuthenticationKey string `json:"authentication_key"`
 	Comment           string `json:"comment"`
+	Cost              int64  `json:"cost"`
+	// DeadInterval description: Constraints: 0, 1-65535
+	DeadInterval int64 `json:"dead_interval"`
 	// HelloInterval description: Constraints: 0, 1-65535
-	HelloInterval int `json:"hello_interval"`
+	HelloInterval int64 `json:"hello_interval"`
 	// Interface description: REF(interface/*)
-	Interface         string        `json:"interface"`
-	MessageDigestKeys []interface{} `json:"message_digest_keys"`
-	Priority          int           `json:"priority"`
+	Interface         InterfaceRef `json:"interface"`
+	MessageDigestKeys []string     `json:"message_digest_keys"`
+	Name              string       `json:"name"`
+	Priority          int64        `json:"priority"`
+	// RetransmitInterval description: Constraints: 0, 3-65535
+	RetransmitInterval int64 `json:"retransmit_interval"`
 	// TransmitDelay description: Constraints: 0, 1-65535
-	TransmitDelay int `json:"transmit_delay"`
-	Cost          int `json:"cost"`
-	// DeadInterval description: Constraints: 0, 1-65535
-	DeadInterval int    `json:"dead_interval"`
-	Name         string `json:"name"`
+	TransmitDelay int64 `json:"transmit_delay"`
+}
+
+// OspfInterfaceAuthentication is the Authentication of a OspfInterface
+type OspfInterfaceAuthentication string
+
+// Known values of OspfInterfaceAuthentication
+const (
+	OspfInterfaceAuthenticationMessageDigest OspfInterfaceAuthentication = "message-digest"
+	OspfInterfaceAuthenticationPlainText     OspfInterfaceAuthentication = "plain-text"
+	OspfInterfaceAuthenticationNull          OspfInterfaceAuthentication = "null"
+)
+
+// Valid returns true if the value is a known OspfInterfaceAuthentication
+func (v OspfInterfaceAuthentication) Valid() bool {
+	switch v {
+	case OspfInterfaceAuthenticationMessageDigest, OspfInterfaceAuthenticationPlainText, OspfInterfaceAuthenticationNull:
+		return true
+	}
+	return false
+}
+
+// NewOspfInterface returns a OspfInterface with the default values of its swagger definition
+func NewOspfInterface() *OspfInterface {
+	return &OspfInterface{
+		ObjectType: "ospf/interface",
+	}
+}
+
+// Validate checks the OspfInterface before it is sent, see ValidateWith
+func (o *OspfInterface) Validate() error { return o.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the OspfInterface.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (o *OspfInterface) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("OspfInterface", typeOf)
+	check.Enum("authentication", string(o.Authentication), o.Authentication.Valid())
+	check.Range("dead_interval", o.DeadInterval, "0, 1-65535")
+	check.Range("hello_interval", o.HelloInterval, "0, 1-65535")
+	check.Required("interface", string(o.Interface))
+	check.Ref("interface", string(o.Interface), "REF(interface/*)")
+	check.Required("name", o.Name)
+	check.Range("retransmit_interval", o.RetransmitInterval, "0, 3-65535")
+	check.Range("transmit_delay", o.TransmitDelay, "0, 1-65535")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &OspfInterface{}
 
-// GetPath implements sophos.RestObject and returns the OspfInterfaces GET path
+// GetPath implements sophos.RestGetter and returns the OspfInterfaces GET path
 // Returns all available ospf/interface objects
 func (*OspfInterfaces) GetPath() string { return "/api/objects/ospf/interface/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*OspfInterfaces) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the OspfInterfaces GET path
+// GetPath implements sophos.RestGetter and returns the OspfInterfaces GET path
 // Returns all available interface types
 func (o *OspfInterface) GetPath() string {
 	return fmt.Sprintf("/api/objects/ospf/interface/%s", o.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (o *OspfInterface) RefRequired() (string, bool) { return o.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the OspfInterface DELETE path
+// DeletePath implements sophos.Deletable and returns the OspfInterface DELETE path
 // Creates or updates the complete object interface
 func (*OspfInterface) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/interface/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the OspfInterface PATCH path
+// PatchPath implements sophos.Patchable and returns the OspfInterface PATCH path
 // Changes to parts of the object interface types
 func (*OspfInterface) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/interface/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the OspfInterface POST path
+// PostPath implements sophos.Creatable and returns the OspfInterface POST path
 // Create a new ospf/interface object
 func (*OspfInterface) PostPath() string {
 	return "/api/objects/ospf/interface/"
 }
 
-// PutPath implements sophos.RestObject and returns the OspfInterface PUT path
+// PutPath implements sophos.Updatable and returns the OspfInterface PUT path
 // Creates or updates the complete object interface
 func (*OspfInterface) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/interface/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*OspfInterface) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/interface/%s/usedby", ref)
 }
 
-// OspfMessageDigestKeys is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (o *OspfInterface) GetType() string { return o.ObjectType }
+
+// OspfMessageDigestKeys is an Sophos Endpoint subType and implements sophos.RestGetter
 type OspfMessageDigestKeys []OspfMessageDigestKey
 
 // OspfMessageDigestKey represents a UTM OSPF message digest key
 type OspfMessageDigestKey struct {
-	Locked             string `json:"_locked"`
-	ObjectType         string `json:"_type"`
-	Reference          string `json:"_ref"`
-	MessageDigestKeyId int    `json:"message_digest_key_id"`
-	Name               string `json:"name"`
-	Comment            string `json:"comment"`
+	Locked     string `json:"_locked"`
+	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
+	Comment    string `json:"comment"`
 	// MessageDigestKey description: (REGEX)
-	MessageDigestKey string `json:"message_digest_key"`
+	MessageDigestKey   string `json:"message_digest_key"`
+	MessageDigestKeyId int64  `json:"message_digest_key_id"`
+	Name               string `json:"name"`
+}
+
+// NewOspfMessageDigestKey returns a OspfMessageDigestKey with the default values of its swagger definition
+func NewOspfMessageDigestKey() *OspfMessageDigestKey {
+	return &OspfMessageDigestKey{
+		ObjectType: "ospf/message_digest_key",
+	}
+}
+
+// Validate checks the OspfMessageDigestKey before it is sent, see ValidateWith
+func (o *OspfMessageDigestKey) Validate() error { return o.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the OspfMessageDigestKey.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (o *OspfMessageDigestKey) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("OspfMessageDigestKey", typeOf)
+	check.Required("name", o.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &OspfMessageDigestKey{}
 
-// GetPath implements sophos.RestObject and returns the OspfMessageDigestKeys GET path
+// GetPath implements sophos.RestGetter and returns the OspfMessageDigestKeys GET path
 // Returns all available ospf/message_digest_key objects
 func (*OspfMessageDigestKeys) GetPath() string { return "/api/objects/ospf/message_digest_key/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*OspfMessageDigestKeys) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the OspfMessageDigestKeys GET path
+// GetPath implements sophos.RestGetter and returns the OspfMessageDigestKeys GET path
 // Returns all available message_digest_key types
 func (o *OspfMessageDigestKey) GetPath() string {
 	return fmt.Sprintf("/api/objects/ospf/message_digest_key/%s", o.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (o *OspfMessageDigestKey) RefRequired() (string, bool) { return o.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the OspfMessageDigestKey DELETE path
+// DeletePath implements sophos.Deletable and returns the OspfMessageDigestKey DELETE path
 // Creates or updates the complete object message_digest_key
 func (*OspfMessageDigestKey) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/message_digest_key/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the OspfMessageDigestKey PATCH path
+// PatchPath implements sophos.Patchable and returns the OspfMessageDigestKey PATCH path
 // Changes to parts of the object message_digest_key types
 func (*OspfMessageDigestKey) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/message_digest_key/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the OspfMessageDigestKey POST path
+// PostPath implements sophos.Creatable and returns the OspfMessageDigestKey POST path
 // Create a new ospf/message_digest_key object
 func (*OspfMessageDigestKey) PostPath() string {
 	return "/api/objects/ospf/message_digest_key/"
 }
 
-// PutPath implements sophos.RestObject and returns the OspfMessageDigestKey PUT path
+// PutPath implements sophos.Updatable and returns the OspfMessageDigestKey PUT path
 // Creates or updates the complete object message_digest_key
 func (*OspfMessageDigestKey) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/message_digest_key/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*OspfMessageDigestKey) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ospf/message_digest_key/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (o *OspfMessageDigestKey) GetType() string { return o.ObjectType }
@@ -15,13 +15,13 @@ type Override struct {
 
 var _ sophos.Endpoint = &Override{}
 
-var defsOverride = map[string]sophos.RestObject{
+var defsOverride = map[string]sophos.RestGetter{
 	"OverrideGroup":  &OverrideGroup{},
 	"OverrideObjref": &OverrideObjref{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Override's Objects
-func (Override) RestObjects() map[string]sophos.RestObject { return defsOverride }
+func (Override) RestObjects() map[string]sophos.RestGetter { return defsOverride }
 
 // GetPath implements sophos.RestGetter
 func (*Override) GetPath() string { return "/api/nodes/override" }
@@ -55,7 +55,7 @@ func (Override) References() []string {
 	}
 }
 
-// OverrideGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// OverrideGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type OverrideGroups []OverrideGroup
 
 // OverrideGroup represents a UTM group
@@ -67,55 +67,76 @@ type OverrideGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewOverrideGroup returns a OverrideGroup with the default values of its swagger definition
+func NewOverrideGroup() *OverrideGroup {
+	return &OverrideGroup{
+		ObjectType: "override/group",
+	}
+}
+
+// Validate checks the OverrideGroup before it is sent, see ValidateWith
+func (o *OverrideGroup) Validate() error { return o.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the OverrideGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (o *OverrideGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("OverrideGroup", typeOf)
+	check.Required("name", o.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &OverrideGroup{}
 
-// GetPath implements sophos.RestObject and returns the OverrideGroups GET path
+// GetPath implements sophos.RestGetter and returns the OverrideGroups GET path
 // Returns all available override/group objects
 func (*OverrideGroups) GetPath() string { return "/api/objects/override/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*OverrideGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the OverrideGroups GET path
+// GetPath implements sophos.RestGetter and returns the OverrideGroups GET path
 // Returns all available group types
 func (o *OverrideGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/override/group/%s", o.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (o *OverrideGroup) RefRequired() (string, bool) { return o.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the OverrideGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the OverrideGroup DELETE path
 // Creates or updates the complete object group
 func (*OverrideGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/override/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the OverrideGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the OverrideGroup PATCH path
 // Changes to parts of the object group types
 func (*OverrideGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/override/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the OverrideGroup POST path
+// PostPath implements sophos.Creatable and returns the OverrideGroup POST path
 // Create a new override/group object
 func (*OverrideGroup) PostPath() string {
 	return "/api/objects/override/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the OverrideGroup PUT path
+// PutPath implements sophos.Updatable and returns the OverrideGroup PUT path
 // Creates or updates the complete object group
 func (*OverrideGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/override/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*OverrideGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/override/group/%s/usedby", ref)
 }
 
-// OverrideObjrefs is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (o *OverrideGroup) GetType() string { return o.ObjectType }
+
+// OverrideObjrefs is an Sophos Endpoint subType and implements sophos.RestGetter
 type OverrideObjrefs []OverrideObjref
 
 // OverrideObjref represents a UTM monitoring action
@@ -123,60 +144,85 @@ type OverrideObjref struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Name       string `json:"name"`
-	// Ref description: REF(/*)
-	Ref     string `json:"ref"`
-	Value   string `json:"value"`
-	Attr    string `json:"attr"`
-	Comment string `json:"comment"`
+	Attr       string `json:"attr"`
+	Comment    string `json:"comment"`
 	// Condition description: REF(condition/*)
-	Condition string `json:"condition"`
+	Condition ConditionRef `json:"condition"`
+	Name      string       `json:"name"`
+	// Ref description: REF(/*)
+	Ref   sophos.Reference `json:"ref"`
+	Value string           `json:"value"`
+}
+
+// NewOverrideObjref returns a OverrideObjref with the default values of its swagger definition
+func NewOverrideObjref() *OverrideObjref {
+	return &OverrideObjref{
+		ObjectType: "override/objref",
+	}
+}
+
+// Validate checks the OverrideObjref before it is sent, see ValidateWith
+func (o *OverrideObjref) Validate() error { return o.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the OverrideObjref.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (o *OverrideObjref) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("OverrideObjref", typeOf)
+	check.Required("condition", string(o.Condition))
+	check.Ref("condition", string(o.Condition), "REF(condition/*)")
+	check.Required("name", o.Name)
+	check.Required("ref", string(o.Ref))
+	check.Ref("ref", string(o.Ref), "REF(/*)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &OverrideObjref{}
 
-// GetPath implements sophos.RestObject and returns the OverrideObjrefs GET path
+// GetPath implements sophos.RestGetter and returns the OverrideObjrefs GET path
 // Returns all available override/objref objects
 func (*OverrideObjrefs) GetPath() string { return "/api/objects/override/objref/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*OverrideObjrefs) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the OverrideObjrefs GET path
+// GetPath implements sophos.RestGetter and returns the OverrideObjrefs GET path
 // Returns all available objref types
 func (o *OverrideObjref) GetPath() string {
 	return fmt.Sprintf("/api/objects/override/objref/%s", o.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (o *OverrideObjref) RefRequired() (string, bool) { return o.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the OverrideObjref DELETE path
+// DeletePath implements sophos.Deletable and returns the OverrideObjref DELETE path
 // Creates or updates the complete object objref
 func (*OverrideObjref) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/override/objref/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the OverrideObjref PATCH path
+// PatchPath implements sophos.Patchable and returns the OverrideObjref PATCH path
 // Changes to parts of the object objref types
 func (*OverrideObjref) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/override/objref/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the OverrideObjref POST path
+// PostPath implements sophos.Creatable and returns the OverrideObjref POST path
 // Create a new override/objref object
 func (*OverrideObjref) PostPath() string {
 	return "/api/objects/override/objref/"
 }
 
-// PutPath implements sophos.RestObject and returns the OverrideObjref PUT path
+// PutPath implements sophos.Updatable and returns the OverrideObjref PUT path
 // Creates or updates the complete object objref
 func (*OverrideObjref) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/override/objref/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*OverrideObjref) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/override/objref/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (o *OverrideObjref) GetType() string { return o.ObjectType }
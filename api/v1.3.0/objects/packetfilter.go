@@ -10,25 +10,25 @@ import (
 // GET /api/nodes/packetfilter
 type Packetfilter struct {
 	Advanced struct {
-		BlockInvalidCtPackets int64    `json:"block_invalid_ct_packets"`
-		CheckPacketLength     int64    `json:"check_packet_length"`
-		ConntrackHelpers      []string `json:"conntrack_helpers"`
-		FtpPorts              []int64  `json:"ftp_ports"`
-		LogBroadcasts         int64    `json:"log_broadcasts"`
-		LogDNSRequests        int64    `json:"log_dns_requests"`
-		LogFtpData            int64    `json:"log_ftp_data"`
-		LogInvalid            int64    `json:"log_invalid"`
-		LogMcast              int64    `json:"log_mcast"`
-		LogStrictTcpState     string   `json:"log_strict_tcp_state"`
-		NoErrorReplay         int64    `json:"no_error_replay"`
+		BlockInvalidCtPackets bool                   `json:"block_invalid_ct_packets"`
+		CheckPacketLength     bool                   `json:"check_packet_length"`
+		ConntrackHelpers      []string               `json:"conntrack_helpers"`
+		FtpPorts              []int64                `json:"ftp_ports"`
+		LogBroadcasts         bool                   `json:"log_broadcasts"`
+		LogDNSRequests        bool                   `json:"log_dns_requests"`
+		LogFtpData            bool                   `json:"log_ftp_data"`
+		LogInvalid            map[string]interface{} `json:"log_invalid"`
+		LogMcast              bool                   `json:"log_mcast"`
+		LogStrictTcpState     string                 `json:"log_strict_tcp_state"`
+		NoErrorReplay         bool                   `json:"no_error_replay"`
 		Optimize              struct {
 			Ipset string `json:"ipset"`
-			Ports int64  `json:"ports"`
+			Ports bool   `json:"ports"`
 		} `json:"optimize"`
 		SpoofProtection  string `json:"spoof_protection"`
-		StrictTcpState   int64  `json:"strict_tcp_state"`
+		StrictTcpState   bool   `json:"strict_tcp_state"`
 		TcpMaxRetrans    int64  `json:"tcp_max_retrans"`
-		TcpWindowScaling int64  `json:"tcp_window_scaling"`
+		TcpWindowScaling bool   `json:"tcp_window_scaling"`
 	} `json:"advanced"`
 	Rules      []string `json:"rules"`
 	RulesAuto  []string `json:"rules_auto"`
@@ -53,7 +53,7 @@ type Packetfilter struct {
 
 var _ sophos.Endpoint = &Packetfilter{}
 
-var defsPacketfilter = map[string]sophos.RestObject{
+var defsPacketfilter = map[string]sophos.RestGetter{
 	"Packetfilter1to1Nat":      &Packetfilter1to1Nat{},
 	"PacketfilterGenericProxy": &PacketfilterGenericProxy{},
 	"PacketfilterGroup":        &PacketfilterGroup{},
@@ -66,7 +66,7 @@ var defsPacketfilter = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Packetfilter's Objects
-func (Packetfilter) RestObjects() map[string]sophos.RestObject { return defsPacketfilter }
+func (Packetfilter) RestObjects() map[string]sophos.RestGetter { return defsPacketfilter }
 
 // GetPath implements sophos.RestGetter
 func (*Packetfilter) GetPath() string { return "/api/nodes/packetfilter" }
@@ -128,7 +128,7 @@ func (Packetfilter) References() []string {
 	}
 }
 
-// Packetfilter1to1Nats is an Sophos Endpoint subType and implements sophos.RestObject
+// Packetfilter1to1Nats is an Sophos Endpoint subType and implements sophos.RestGetter
 type Packetfilter1to1Nats []Packetfilter1to1Nat
 
 // Packetfilter1to1Nat represents a UTM 1to1nat
@@ -136,151 +136,229 @@ type Packetfilter1to1Nat struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// Service description: REF(service/*)
-	Service string `json:"service"`
-	// Source description: REF(network/*)
-	Source string `json:"source"`
 	// AutoPfIn description: REF(packetfilter/packetfilter)
 	// AutoPfIn default value is ""
-	AutoPfIn string `json:"auto_pf_in"`
+	AutoPfIn PacketfilterRef `json:"auto_pf_in"`
+	// AutoPfrule default value is false
+	AutoPfrule bool   `json:"auto_pfrule"`
+	Comment    string `json:"comment"`
 	// Destination description: REF(network/*)
-	Destination string `json:"destination"`
+	Destination NetworkRef `json:"destination"`
 	// Group default value is ""
 	Group string `json:"group"`
 	// Log default value is false
 	Log bool `json:"log"`
 	// MapTo description: REF(network/network)
-	MapTo string `json:"map_to"`
-	// AutoPfrule default value is false
-	AutoPfrule bool   `json:"auto_pfrule"`
-	Comment    string `json:"comment"`
+	MapTo NetworkRef `json:"map_to"`
 	// Mode can be one of: []string{"mapsrc", "mapdst"}
-	Mode string `json:"mode"`
-	Name string `json:"name"`
+	Mode Packetfilter1to1NatMode `json:"mode"`
+	Name string                  `json:"name"`
+	// Service description: REF(service/*)
+	Service ServiceRef `json:"service"`
+	// Source description: REF(network/*)
+	Source NetworkRef `json:"source"`
 	// Status default value is false
 	Status bool `json:"status"`
 }
 
+// Packetfilter1to1NatMode is the Mode of a Packetfilter1to1Nat
+type Packetfilter1to1NatMode string
+
+// Known values of Packetfilter1to1NatMode
+const (
+	Packetfilter1to1NatModeMapsrc Packetfilter1to1NatMode = "mapsrc"
+	Packetfilter1to1NatModeMapdst Packetfilter1to1NatMode = "mapdst"
+)
+
+// Valid returns true if the value is a known Packetfilter1to1NatMode
+func (v Packetfilter1to1NatMode) Valid() bool {
+	switch v {
+	case Packetfilter1to1NatModeMapsrc, Packetfilter1to1NatModeMapdst:
+		return true
+	}
+	return false
+}
+
+// NewPacketfilter1to1Nat returns a Packetfilter1to1Nat with the default values of its swagger definition
+func NewPacketfilter1to1Nat() *Packetfilter1to1Nat {
+	return &Packetfilter1to1Nat{
+		ObjectType: "packetfilter/1to1nat",
+	}
+}
+
+// Validate checks the Packetfilter1to1Nat before it is sent, see ValidateWith
+func (p *Packetfilter1to1Nat) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the Packetfilter1to1Nat.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *Packetfilter1to1Nat) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("Packetfilter1to1Nat", typeOf)
+	check.Ref("auto_pf_in", string(p.AutoPfIn), "REF(packetfilter/packetfilter)")
+	check.Required("destination", string(p.Destination))
+	check.Ref("destination", string(p.Destination), "REF(network/*)")
+	check.Required("map_to", string(p.MapTo))
+	check.Ref("map_to", string(p.MapTo), "REF(network/network)")
+	check.Enum("mode", string(p.Mode), p.Mode.Valid())
+	check.Required("name", p.Name)
+	check.Required("service", string(p.Service))
+	check.Ref("service", string(p.Service), "REF(service/*)")
+	check.Required("source", string(p.Source))
+	check.Ref("source", string(p.Source), "REF(network/*)")
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &Packetfilter1to1Nat{}
 
-// GetPath implements sophos.RestObject and returns the Packetfilter1to1Nats GET path
+// GetPath implements sophos.RestGetter and returns the Packetfilter1to1Nats GET path
 // Returns all available packetfilter/1to1nat objects
 func (*Packetfilter1to1Nats) GetPath() string { return "/api/objects/packetfilter/1to1nat/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*Packetfilter1to1Nats) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the Packetfilter1to1Nats GET path
+// GetPath implements sophos.RestGetter and returns the Packetfilter1to1Nats GET path
 // Returns all available 1to1nat types
 func (p *Packetfilter1to1Nat) GetPath() string {
 	return fmt.Sprintf("/api/objects/packetfilter/1to1nat/%s", p.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *Packetfilter1to1Nat) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the Packetfilter1to1Nat DELETE path
+// DeletePath implements sophos.Deletable and returns the Packetfilter1to1Nat DELETE path
 // Creates or updates the complete object 1to1nat
 func (*Packetfilter1to1Nat) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/1to1nat/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the Packetfilter1to1Nat PATCH path
+// PatchPath implements sophos.Patchable and returns the Packetfilter1to1Nat PATCH path
 // Changes to parts of the object 1to1nat types
 func (*Packetfilter1to1Nat) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/1to1nat/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the Packetfilter1to1Nat POST path
+// PostPath implements sophos.Creatable and returns the Packetfilter1to1Nat POST path
 // Create a new packetfilter/1to1nat object
 func (*Packetfilter1to1Nat) PostPath() string {
 	return "/api/objects/packetfilter/1to1nat/"
 }
 
-// PutPath implements sophos.RestObject and returns the Packetfilter1to1Nat PUT path
+// PutPath implements sophos.Updatable and returns the Packetfilter1to1Nat PUT path
 // Creates or updates the complete object 1to1nat
 func (*Packetfilter1to1Nat) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/1to1nat/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*Packetfilter1to1Nat) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/1to1nat/%s/usedby", ref)
 }
 
-// PacketfilterGenericProxys is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (p *Packetfilter1to1Nat) GetType() string { return p.ObjectType }
+
+// PacketfilterGenericProxys is an Sophos Endpoint subType and implements sophos.RestGetter
 type PacketfilterGenericProxys []PacketfilterGenericProxy
 
 // PacketfilterGenericProxy represents a UTM generic proxy rule
 type PacketfilterGenericProxy struct {
-	Locked     string `json:"_locked"`
-	ObjectType string `json:"_type"`
-	Reference  string `json:"_ref"`
-	// Toservice description: REF(service/*)
-	Toservice       string        `json:"toservice"`
-	AllowedNetworks []interface{} `json:"allowed_networks"`
-	Comment         string        `json:"comment"`
+	Locked          string   `json:"_locked"`
+	ObjectType      string   `json:"_type"`
+	Reference       string   `json:"_ref"`
+	AllowedNetworks []string `json:"allowed_networks"`
+	Comment         string   `json:"comment"`
 	// Ininterface description: REF(interface/*)
-	Ininterface string `json:"ininterface"`
-	Name        string `json:"name"`
+	Ininterface InterfaceRef `json:"ininterface"`
+	Name        string       `json:"name"`
 	// Service description: REF(service/*)
-	Service string `json:"service"`
+	Service ServiceRef `json:"service"`
 	// Status default value is false
 	Status bool `json:"status"`
 	// Tohost description: REF(network/host), REF(network/dns_host), REF(network/availability_group)
-	Tohost string `json:"tohost"`
+	Tohost NetworkRef `json:"tohost"`
+	// Toservice description: REF(service/*)
+	Toservice ServiceRef `json:"toservice"`
+}
+
+// NewPacketfilterGenericProxy returns a PacketfilterGenericProxy with the default values of its swagger definition
+func NewPacketfilterGenericProxy() *PacketfilterGenericProxy {
+	return &PacketfilterGenericProxy{
+		ObjectType: "packetfilter/generic_proxy",
+	}
+}
+
+// Validate checks the PacketfilterGenericProxy before it is sent, see ValidateWith
+func (p *PacketfilterGenericProxy) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the PacketfilterGenericProxy.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *PacketfilterGenericProxy) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("PacketfilterGenericProxy", typeOf)
+	check.Required("ininterface", string(p.Ininterface))
+	check.Ref("ininterface", string(p.Ininterface), "REF(interface/*)")
+	check.Required("name", p.Name)
+	check.Required("service", string(p.Service))
+	check.Ref("service", string(p.Service), "REF(service/*)")
+	check.Required("tohost", string(p.Tohost))
+	check.Ref("tohost", string(p.Tohost), "REF(network/host), REF(network/dns_host), REF(network/availability_group)")
+	check.Required("toservice", string(p.Toservice))
+	check.Ref("toservice", string(p.Toservice), "REF(service/*)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &PacketfilterGenericProxy{}
 
-// GetPath implements sophos.RestObject and returns the PacketfilterGenericProxys GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterGenericProxys GET path
 // Returns all available packetfilter/generic_proxy objects
 func (*PacketfilterGenericProxys) GetPath() string { return "/api/objects/packetfilter/generic_proxy/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*PacketfilterGenericProxys) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the PacketfilterGenericProxys GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterGenericProxys GET path
 // Returns all available generic_proxy types
 func (p *PacketfilterGenericProxy) GetPath() string {
 	return fmt.Sprintf("/api/objects/packetfilter/generic_proxy/%s", p.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *PacketfilterGenericProxy) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the PacketfilterGenericProxy DELETE path
+// DeletePath implements sophos.Deletable and returns the PacketfilterGenericProxy DELETE path
 // Creates or updates the complete object generic_proxy
 func (*PacketfilterGenericProxy) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/generic_proxy/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the PacketfilterGenericProxy PATCH path
+// PatchPath implements sophos.Patchable and returns the PacketfilterGenericProxy PATCH path
 // Changes to parts of the object generic_proxy types
 func (*PacketfilterGenericProxy) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/generic_proxy/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the PacketfilterGenericProxy POST path
+// PostPath implements sophos.Creatable and returns the PacketfilterGenericProxy POST path
 // Create a new packetfilter/generic_proxy object
 func (*PacketfilterGenericProxy) PostPath() string {
 	return "/api/objects/packetfilter/generic_proxy/"
 }
 
-// PutPath implements sophos.RestObject and returns the PacketfilterGenericProxy PUT path
+// PutPath implements sophos.Updatable and returns the PacketfilterGenericProxy PUT path
 // Creates or updates the complete object generic_proxy
 func (*PacketfilterGenericProxy) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/generic_proxy/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*PacketfilterGenericProxy) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/generic_proxy/%s/usedby", ref)
 }
 
-// PacketfilterGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (p *PacketfilterGenericProxy) GetType() string { return p.ObjectType }
+
+// PacketfilterGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type PacketfilterGroups []PacketfilterGroup
 
 // PacketfilterGroup represents a UTM group
@@ -292,55 +370,76 @@ type PacketfilterGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewPacketfilterGroup returns a PacketfilterGroup with the default values of its swagger definition
+func NewPacketfilterGroup() *PacketfilterGroup {
+	return &PacketfilterGroup{
+		ObjectType: "packetfilter/group",
+	}
+}
+
+// Validate checks the PacketfilterGroup before it is sent, see ValidateWith
+func (p *PacketfilterGroup) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the PacketfilterGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *PacketfilterGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("PacketfilterGroup", typeOf)
+	check.Required("name", p.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &PacketfilterGroup{}
 
-// GetPath implements sophos.RestObject and returns the PacketfilterGroups GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterGroups GET path
 // Returns all available packetfilter/group objects
 func (*PacketfilterGroups) GetPath() string { return "/api/objects/packetfilter/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*PacketfilterGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the PacketfilterGroups GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterGroups GET path
 // Returns all available group types
 func (p *PacketfilterGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/packetfilter/group/%s", p.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *PacketfilterGroup) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the PacketfilterGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the PacketfilterGroup DELETE path
 // Creates or updates the complete object group
 func (*PacketfilterGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the PacketfilterGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the PacketfilterGroup PATCH path
 // Changes to parts of the object group types
 func (*PacketfilterGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the PacketfilterGroup POST path
+// PostPath implements sophos.Creatable and returns the PacketfilterGroup POST path
 // Create a new packetfilter/group object
 func (*PacketfilterGroup) PostPath() string {
 	return "/api/objects/packetfilter/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the PacketfilterGroup PUT path
+// PutPath implements sophos.Updatable and returns the PacketfilterGroup PUT path
 // Creates or updates the complete object group
 func (*PacketfilterGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*PacketfilterGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/group/%s/usedby", ref)
 }
 
-// PacketfilterLoadbalances is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (p *PacketfilterGroup) GetType() string { return p.ObjectType }
+
+// PacketfilterLoadbalances is an Sophos Endpoint subType and implements sophos.RestGetter
 type PacketfilterLoadbalances []PacketfilterLoadbalance
 
 // PacketfilterLoadbalance represents a UTM load balancing rule
@@ -348,164 +447,240 @@ type PacketfilterLoadbalance struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	// Scheduler description: REF(scheduler/loadbalance)
-	// Scheduler default value is ""
-	Scheduler string `json:"scheduler"`
-	// Status default value is false
-	Status bool `json:"status"`
 	// AutoPfIn description: REF(packetfilter/packetfilter)
 	// AutoPfIn default value is ""
-	AutoPfIn string `json:"auto_pf_in"`
+	AutoPfIn PacketfilterRef `json:"auto_pf_in"`
+	// AutoPfrule default value is true
+	AutoPfrule bool   `json:"auto_pfrule"`
+	Comment    string `json:"comment"`
 	// Destination description: REF(network/*)
-	Destination         string        `json:"destination"`
-	DestinationNatGroup []interface{} `json:"destination_nat_group"`
-	// ShutdownAddress default value is false
-	ShutdownAddress bool `json:"shutdown_address"`
+	Destination         NetworkRef `json:"destination"`
+	DestinationNatGroup []string   `json:"destination_nat_group"`
 	// DestinationNatStatus description: (HASH)
-	DestinationNatStatus interface{} `json:"destination_nat_status"`
+	DestinationNatStatus map[string]interface{} `json:"destination_nat_status"`
 	// DestinationNatStatus6 description: (HASH)
-	DestinationNatStatus6 interface{} `json:"destination_nat_status6"`
-	Name                  string      `json:"name"`
+	DestinationNatStatus6 map[string]interface{} `json:"destination_nat_status6"`
+	Name                  string                 `json:"name"`
+	// Scheduler description: REF(scheduler/loadbalance)
+	// Scheduler default value is ""
+	Scheduler SchedulerRef `json:"scheduler"`
 	// Service description: REF(service/*)
-	Service string `json:"service"`
+	Service ServiceRef `json:"service"`
+	// ShutdownAddress default value is false
+	ShutdownAddress bool `json:"shutdown_address"`
 	// ShutdownCondition description: REF(condition/objref)
 	// ShutdownCondition default value is ""
-	ShutdownCondition string `json:"shutdown_condition"`
+	ShutdownCondition ConditionRef `json:"shutdown_condition"`
 	// ShutdownOverride description: REF(override/objref)
 	// ShutdownOverride default value is ""
-	ShutdownOverride string `json:"shutdown_override"`
-	// AutoPfrule default value is true
-	AutoPfrule bool   `json:"auto_pfrule"`
-	Comment    string `json:"comment"`
+	ShutdownOverride OverrideRef `json:"shutdown_override"`
+	// Status default value is false
+	Status bool `json:"status"`
+}
+
+// NewPacketfilterLoadbalance returns a PacketfilterLoadbalance with the default values of its swagger definition
+func NewPacketfilterLoadbalance() *PacketfilterLoadbalance {
+	return &PacketfilterLoadbalance{
+		ObjectType: "packetfilter/loadbalance",
+		AutoPfrule: true,
+	}
+}
+
+// Validate checks the PacketfilterLoadbalance before it is sent, see ValidateWith
+func (p *PacketfilterLoadbalance) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the PacketfilterLoadbalance.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *PacketfilterLoadbalance) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("PacketfilterLoadbalance", typeOf)
+	check.Ref("auto_pf_in", string(p.AutoPfIn), "REF(packetfilter/packetfilter)")
+	check.Required("destination", string(p.Destination))
+	check.Ref("destination", string(p.Destination), "REF(network/*)")
+	check.Required("name", p.Name)
+	check.Ref("scheduler", string(p.Scheduler), "REF(scheduler/loadbalance)")
+	check.Required("service", string(p.Service))
+	check.Ref("service", string(p.Service), "REF(service/*)")
+	check.Ref("shutdown_condition", string(p.ShutdownCondition), "REF(condition/objref)")
+	check.Ref("shutdown_override", string(p.ShutdownOverride), "REF(override/objref)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &PacketfilterLoadbalance{}
 
-// GetPath implements sophos.RestObject and returns the PacketfilterLoadbalances GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterLoadbalances GET path
 // Returns all available packetfilter/loadbalance objects
 func (*PacketfilterLoadbalances) GetPath() string { return "/api/objects/packetfilter/loadbalance/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*PacketfilterLoadbalances) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the PacketfilterLoadbalances GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterLoadbalances GET path
 // Returns all available loadbalance types
 func (p *PacketfilterLoadbalance) GetPath() string {
 	return fmt.Sprintf("/api/objects/packetfilter/loadbalance/%s", p.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *PacketfilterLoadbalance) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the PacketfilterLoadbalance DELETE path
+// DeletePath implements sophos.Deletable and returns the PacketfilterLoadbalance DELETE path
 // Creates or updates the complete object loadbalance
 func (*PacketfilterLoadbalance) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/loadbalance/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the PacketfilterLoadbalance PATCH path
+// PatchPath implements sophos.Patchable and returns the PacketfilterLoadbalance PATCH path
 // Changes to parts of the object loadbalance types
 func (*PacketfilterLoadbalance) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/loadbalance/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the PacketfilterLoadbalance POST path
+// PostPath implements sophos.Creatable and returns the PacketfilterLoadbalance POST path
 // Create a new packetfilter/loadbalance object
 func (*PacketfilterLoadbalance) PostPath() string {
 	return "/api/objects/packetfilter/loadbalance/"
 }
 
-// PutPath implements sophos.RestObject and returns the PacketfilterLoadbalance PUT path
+// PutPath implements sophos.Updatable and returns the PacketfilterLoadbalance PUT path
 // Creates or updates the complete object loadbalance
 func (*PacketfilterLoadbalance) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/loadbalance/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*PacketfilterLoadbalance) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/loadbalance/%s/usedby", ref)
 }
 
-// PacketfilterMangles is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (p *PacketfilterLoadbalance) GetType() string { return p.ObjectType }
+
+// PacketfilterMangles is an Sophos Endpoint subType and implements sophos.RestGetter
 type PacketfilterMangles []PacketfilterMangle
 
 // PacketfilterMangle represents a UTM packet mangle rule
 type PacketfilterMangle struct {
-	Locked     string `json:"_locked"`
-	ObjectType string `json:"_type"`
-	Reference  string `json:"_ref"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Action     []string `json:"action"`
+	Comment    string   `json:"comment"`
 	// Destination description: REF(network/*)
-	Destination string `json:"destination"`
+	Destination NetworkRef `json:"destination"`
 	// Direction can be one of: []string{"in", "out"}
-	Direction string `json:"direction"`
-	Name      string `json:"name"`
+	Direction PacketfilterMangleDirection `json:"direction"`
+	Name      string                      `json:"name"`
 	// Service description: REF(service/*)
-	Service string `json:"service"`
+	Service ServiceRef `json:"service"`
 	// Source description: REF(network/*)
-	Source string `json:"source"`
+	Source NetworkRef `json:"source"`
 	// Status default value is false
-	Status  bool          `json:"status"`
-	Action  []interface{} `json:"action"`
-	Comment string        `json:"comment"`
+	Status bool `json:"status"`
+}
+
+// PacketfilterMangleDirection is the Direction of a PacketfilterMangle
+type PacketfilterMangleDirection string
+
+// Known values of PacketfilterMangleDirection
+const (
+	PacketfilterMangleDirectionIn  PacketfilterMangleDirection = "in"
+	PacketfilterMangleDirectionOut PacketfilterMangleDirection = "out"
+)
+
+// Valid returns true if the value is a known PacketfilterMangleDirection
+func (v PacketfilterMangleDirection) Valid() bool {
+	switch v {
+	case PacketfilterMangleDirectionIn, PacketfilterMangleDirectionOut:
+		return true
+	}
+	return false
+}
+
+// NewPacketfilterMangle returns a PacketfilterMangle with the default values of its swagger definition
+func NewPacketfilterMangle() *PacketfilterMangle {
+	return &PacketfilterMangle{
+		ObjectType: "packetfilter/mangle",
+	}
+}
+
+// Validate checks the PacketfilterMangle before it is sent, see ValidateWith
+func (p *PacketfilterMangle) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the PacketfilterMangle.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *PacketfilterMangle) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("PacketfilterMangle", typeOf)
+	check.Required("destination", string(p.Destination))
+	check.Ref("destination", string(p.Destination), "REF(network/*)")
+	check.Enum("direction", string(p.Direction), p.Direction.Valid())
+	check.Required("name", p.Name)
+	check.Required("service", string(p.Service))
+	check.Ref("service", string(p.Service), "REF(service/*)")
+	check.Required("source", string(p.Source))
+	check.Ref("source", string(p.Source), "REF(network/*)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &PacketfilterMangle{}
 
-// GetPath implements sophos.RestObject and returns the PacketfilterMangles GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterMangles GET path
 // Returns all available packetfilter/mangle objects
 func (*PacketfilterMangles) GetPath() string { return "/api/objects/packetfilter/mangle/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*PacketfilterMangles) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the PacketfilterMangles GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterMangles GET path
 // Returns all available mangle types
 func (p *PacketfilterMangle) GetPath() string {
 	return fmt.Sprintf("/api/objects/packetfilter/mangle/%s", p.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *PacketfilterMangle) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the PacketfilterMangle DELETE path
+// DeletePath implements sophos.Deletable and returns the PacketfilterMangle DELETE path
 // Creates or updates the complete object mangle
 func (*PacketfilterMangle) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/mangle/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the PacketfilterMangle PATCH path
+// PatchPath implements sophos.Patchable and returns the PacketfilterMangle PATCH path
 // Changes to parts of the object mangle types
 func (*PacketfilterMangle) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/mangle/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the PacketfilterMangle POST path
+// PostPath implements sophos.Creatable and returns the PacketfilterMangle POST path
 // Create a new packetfilter/mangle object
 func (*PacketfilterMangle) PostPath() string {
 	return "/api/objects/packetfilter/mangle/"
 }
 
-// PutPath implements sophos.RestObject and returns the PacketfilterMangle PUT path
+// PutPath implements sophos.Updatable and returns the PacketfilterMangle PUT path
 // Creates or updates the complete object mangle
 func (*PacketfilterMangle) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/mangle/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*PacketfilterMangle) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/mangle/%s/usedby", ref)
 }
 
-// PacketfilterMasqs is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (p *PacketfilterMangle) GetType() string { return p.ObjectType }
+
+// PacketfilterMasqs is an Sophos Endpoint subType and implements sophos.RestGetter
 type PacketfilterMasqs []PacketfilterMasq
 
-// PacketfilterMasq is a generated Sophos object
+// PacketfilterMasq represents a UTM packetfilter/masq object
 type PacketfilterMasq struct {
 	Locked                   string `json:"_locked"`
-	Reference                string `json:"_ref"`
 	ObjectType               string `json:"_type"`
+	Reference                string `json:"_ref"`
 	AdditionalAddress        string `json:"additional_address"`
 	AdditionalAddressRestore string `json:"additional_address_restore"`
 	Comment                  string `json:"comment"`
@@ -515,49 +690,67 @@ type PacketfilterMasq struct {
 	Status                   bool   `json:"status"`
 }
 
+// NewPacketfilterMasq returns a PacketfilterMasq with the default values of its swagger definition
+func NewPacketfilterMasq() *PacketfilterMasq {
+	return &PacketfilterMasq{
+		ObjectType: "packetfilter/masq",
+	}
+}
+
+// Validate checks the PacketfilterMasq before it is sent, see ValidateWith
+func (p *PacketfilterMasq) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the PacketfilterMasq.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *PacketfilterMasq) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("PacketfilterMasq", typeOf)
+	check.Required("name", p.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &PacketfilterMasq{}
 
-// GetPath implements sophos.RestObject and returns the PacketfilterMasqs GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterMasqs GET path
 // Returns all available packetfilter/masq objects
 func (*PacketfilterMasqs) GetPath() string { return "/api/objects/packetfilter/masq/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*PacketfilterMasqs) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the PacketfilterMasqs GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterMasqs GET path
 // Returns all available masq types
 func (p *PacketfilterMasq) GetPath() string {
 	return fmt.Sprintf("/api/objects/packetfilter/masq/%s", p.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *PacketfilterMasq) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the PacketfilterMasq DELETE path
+// DeletePath implements sophos.Deletable and returns the PacketfilterMasq DELETE path
 // Creates or updates the complete object masq
 func (*PacketfilterMasq) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/masq/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the PacketfilterMasq PATCH path
+// PatchPath implements sophos.Patchable and returns the PacketfilterMasq PATCH path
 // Changes to parts of the object masq types
 func (*PacketfilterMasq) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/masq/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the PacketfilterMasq POST path
+// PostPath implements sophos.Creatable and returns the PacketfilterMasq POST path
 // Create a new packetfilter/masq object
 func (*PacketfilterMasq) PostPath() string {
 	return "/api/objects/packetfilter/masq/"
 }
 
-// PutPath implements sophos.RestObject and returns the PacketfilterMasq PUT path
+// PutPath implements sophos.Updatable and returns the PacketfilterMasq PUT path
 // Creates or updates the complete object masq
 func (*PacketfilterMasq) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/masq/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*PacketfilterMasq) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/masq/%s/usedby", ref)
@@ -566,75 +759,126 @@ func (*PacketfilterMasq) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (p *PacketfilterMasq) GetType() string { return p.ObjectType }
 
-// PacketfilterNats is an Sophos Endpoint subType and implements sophos.RestObject
+// PacketfilterNats is an Sophos Endpoint subType and implements sophos.RestGetter
 type PacketfilterNats []PacketfilterNat
 
-// PacketfilterNat is a generated Sophos object
+// PacketfilterNat represents a UTM packetfilter/nat object
 type PacketfilterNat struct {
-	Locked                string `json:"_locked"`
-	Reference             string `json:"_ref"`
-	ObjectType            string `json:"_type"`
-	AutoPfIn              string `json:"auto_pf_in"`
-	AutoPfrule            bool   `json:"auto_pfrule"`
-	Comment               string `json:"comment"`
-	Destination           string `json:"destination"`
-	DestinationNatAddress string `json:"destination_nat_address"`
-	DestinationNatService string `json:"destination_nat_service"`
-	Group                 string `json:"group"`
-	Ipsec                 bool   `json:"ipsec"`
-	Log                   bool   `json:"log"`
-	Mode                  string `json:"mode"`
-	Name                  string `json:"name"`
-	Service               string `json:"service"`
-	Source                string `json:"source"`
-	SourceNatAddress      string `json:"source_nat_address"`
-	SourceNatService      string `json:"source_nat_service"`
-	Status                bool   `json:"status"`
+	Locked                string          `json:"_locked"`
+	ObjectType            string          `json:"_type"`
+	Reference             string          `json:"_ref"`
+	AutoPfIn              PacketfilterRef `json:"auto_pf_in"`
+	AutoPfrule            bool            `json:"auto_pfrule"`
+	Comment               string          `json:"comment"`
+	Destination           NetworkRef      `json:"destination"`
+	DestinationNatAddress NetworkRef      `json:"destination_nat_address"`
+	DestinationNatService ServiceRef      `json:"destination_nat_service"`
+	Group                 string          `json:"group"`
+	Ipsec                 bool            `json:"ipsec"`
+	Log                   bool            `json:"log"`
+	// Mode can be one of: []string{"dnat", "snat", "fullnat", "nonat"}
+	Mode             PacketfilterNatMode `json:"mode"`
+	Name             string              `json:"name"`
+	Service          ServiceRef          `json:"service"`
+	Source           NetworkRef          `json:"source"`
+	SourceNatAddress NetworkRef          `json:"source_nat_address"`
+	SourceNatService ServiceRef          `json:"source_nat_service"`
+	Status           bool                `json:"status"`
+}
+
+// PacketfilterNatMode is the Mode of a PacketfilterNat
+type PacketfilterNatMode string
+
+// Known values of PacketfilterNatMode
+const (
+	PacketfilterNatModeDnat    PacketfilterNatMode = "dnat"
+	PacketfilterNatModeSnat    PacketfilterNatMode = "snat"
+	PacketfilterNatModeFullnat PacketfilterNatMode = "fullnat"
+	PacketfilterNatModeNonat   PacketfilterNatMode = "nonat"
+)
+
+// Valid returns true if the value is a known PacketfilterNatMode
+func (v PacketfilterNatMode) Valid() bool {
+	switch v {
+	case PacketfilterNatModeDnat, PacketfilterNatModeSnat, PacketfilterNatModeFullnat, PacketfilterNatModeNonat:
+		return true
+	}
+	return false
+}
+
+// NewPacketfilterNat returns a PacketfilterNat with the default values of its swagger definition
+func NewPacketfilterNat() *PacketfilterNat {
+	return &PacketfilterNat{
+		ObjectType: "packetfilter/nat",
+	}
+}
+
+// Validate checks the PacketfilterNat before it is sent, see ValidateWith
+func (p *PacketfilterNat) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the PacketfilterNat.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *PacketfilterNat) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("PacketfilterNat", typeOf)
+	check.Ref("auto_pf_in", string(p.AutoPfIn), "REF(packetfilter/packetfilter)")
+	check.Required("destination", string(p.Destination))
+	check.Ref("destination", string(p.Destination), "REF(network/*)")
+	check.Ref("destination_nat_address", string(p.DestinationNatAddress), "REF(network/*)")
+	check.Ref("destination_nat_service", string(p.DestinationNatService), "REF(service/*)")
+	check.Enum("mode", string(p.Mode), p.Mode.Valid())
+	check.Required("name", p.Name)
+	check.Required("service", string(p.Service))
+	check.Ref("service", string(p.Service), "REF(service/*)")
+	check.Required("source", string(p.Source))
+	check.Ref("source", string(p.Source), "REF(network/*)")
+	check.Ref("source_nat_address", string(p.SourceNatAddress), "REF(network/*)")
+	check.Ref("source_nat_service", string(p.SourceNatService), "REF(service/*)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &PacketfilterNat{}
 
-// GetPath implements sophos.RestObject and returns the PacketfilterNats GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterNats GET path
 // Returns all available packetfilter/nat objects
 func (*PacketfilterNats) GetPath() string { return "/api/objects/packetfilter/nat/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*PacketfilterNats) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the PacketfilterNats GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterNats GET path
 // Returns all available nat types
 func (p *PacketfilterNat) GetPath() string {
 	return fmt.Sprintf("/api/objects/packetfilter/nat/%s", p.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *PacketfilterNat) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the PacketfilterNat DELETE path
+// DeletePath implements sophos.Deletable and returns the PacketfilterNat DELETE path
 // Creates or updates the complete object nat
 func (*PacketfilterNat) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/nat/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the PacketfilterNat PATCH path
+// PatchPath implements sophos.Patchable and returns the PacketfilterNat PATCH path
 // Changes to parts of the object nat types
 func (*PacketfilterNat) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/nat/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the PacketfilterNat POST path
+// PostPath implements sophos.Creatable and returns the PacketfilterNat POST path
 // Create a new packetfilter/nat object
 func (*PacketfilterNat) PostPath() string {
 	return "/api/objects/packetfilter/nat/"
 }
 
-// PutPath implements sophos.RestObject and returns the PacketfilterNat PUT path
+// PutPath implements sophos.Updatable and returns the PacketfilterNat PUT path
 // Creates or updates the complete object nat
 func (*PacketfilterNat) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/nat/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*PacketfilterNat) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/nat/%s/usedby", ref)
@@ -643,74 +887,124 @@ func (*PacketfilterNat) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (p *PacketfilterNat) GetType() string { return p.ObjectType }
 
-// PacketfilterPacketfilters is an Sophos Endpoint subType and implements sophos.RestObject
+// PacketfilterPacketfilters is an Sophos Endpoint subType and implements sophos.RestGetter
 type PacketfilterPacketfilters []PacketfilterPacketfilter
 
-// PacketfilterPacketfilter is a generated Sophos object
+// PacketfilterPacketfilter represents a UTM packetfilter/packetfilter object
 type PacketfilterPacketfilter struct {
-	Locked             string   `json:"_locked"`
-	Reference          string   `json:"_ref"`
-	ObjectType         string   `json:"_type"`
-	Action             string   `json:"action"`
-	Auto               bool     `json:"auto"`
-	AutoType           string   `json:"auto_type"`
-	Comment            string   `json:"comment"`
-	Destinations       []string `json:"destinations"`
-	Direction          string   `json:"direction"`
-	Group              string   `json:"group"`
-	Interface          string   `json:"interface"`
-	Log                bool     `json:"log"`
-	Name               string   `json:"name"`
-	Services           []string `json:"services"`
-	SourceMacAddresses string   `json:"source_mac_addresses"`
-	Sources            []string `json:"sources"`
-	Status             bool     `json:"status"`
-	Time               string   `json:"time"`
+	Locked     string `json:"_locked"`
+	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
+	// Action can be one of: []string{"accept", "drop", "reject"}
+	Action   PacketfilterPacketfilterAction `json:"action"`
+	Auto     bool                           `json:"auto"`
+	AutoType string                         `json:"auto_type"`
+	Comment  string                         `json:"comment"`
+	// Destinations description: REF(network/*)
+	Destinations NetworkRefs  `json:"destinations"`
+	Direction    string       `json:"direction"`
+	Group        string       `json:"group"`
+	Interface    InterfaceRef `json:"interface"`
+	Log          bool         `json:"log"`
+	Name         string       `json:"name"`
+	// Services description: REF(service/*)
+	Services           ServiceRefs `json:"services"`
+	SourceMacAddresses string      `json:"source_mac_addresses"`
+	// Sources description: REF(network/*)
+	Sources NetworkRefs `json:"sources"`
+	Status  bool        `json:"status"`
+	Time    TimeRef     `json:"time"`
+}
+
+// PacketfilterPacketfilterAction is the Action of a PacketfilterPacketfilter
+type PacketfilterPacketfilterAction string
+
+// Known values of PacketfilterPacketfilterAction
+const (
+	PacketfilterPacketfilterActionAccept PacketfilterPacketfilterAction = "accept"
+	PacketfilterPacketfilterActionDrop   PacketfilterPacketfilterAction = "drop"
+	PacketfilterPacketfilterActionReject PacketfilterPacketfilterAction = "reject"
+)
+
+// Valid returns true if the value is a known PacketfilterPacketfilterAction
+func (v PacketfilterPacketfilterAction) Valid() bool {
+	switch v {
+	case PacketfilterPacketfilterActionAccept, PacketfilterPacketfilterActionDrop, PacketfilterPacketfilterActionReject:
+		return true
+	}
+	return false
+}
+
+// NewPacketfilterPacketfilter returns a PacketfilterPacketfilter with the default values of its swagger definition
+func NewPacketfilterPacketfilter() *PacketfilterPacketfilter {
+	return &PacketfilterPacketfilter{
+		ObjectType: "packetfilter/packetfilter",
+	}
+}
+
+// Validate checks the PacketfilterPacketfilter before it is sent, see ValidateWith
+func (p *PacketfilterPacketfilter) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the PacketfilterPacketfilter.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *PacketfilterPacketfilter) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("PacketfilterPacketfilter", typeOf)
+	check.Enum("action", string(p.Action), p.Action.Valid())
+	check.NotEmpty("destinations", len(p.Destinations))
+	check.Refs("destinations", p.Destinations, "REF(network/*)")
+	check.Ref("interface", string(p.Interface), "REF(interface/*)")
+	check.Required("name", p.Name)
+	check.NotEmpty("services", len(p.Services))
+	check.Refs("services", p.Services, "REF(service/*)")
+	check.NotEmpty("sources", len(p.Sources))
+	check.Refs("sources", p.Sources, "REF(network/*)")
+	check.Ref("time", string(p.Time), "REF(time/*)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &PacketfilterPacketfilter{}
 
-// GetPath implements sophos.RestObject and returns the PacketfilterPacketfilters GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterPacketfilters GET path
 // Returns all available packetfilter/packetfilter objects
 func (*PacketfilterPacketfilters) GetPath() string { return "/api/objects/packetfilter/packetfilter/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*PacketfilterPacketfilters) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the PacketfilterPacketfilters GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterPacketfilters GET path
 // Returns all available packetfilter types
 func (p *PacketfilterPacketfilter) GetPath() string {
 	return fmt.Sprintf("/api/objects/packetfilter/packetfilter/%s", p.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *PacketfilterPacketfilter) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the PacketfilterPacketfilter DELETE path
+// DeletePath implements sophos.Deletable and returns the PacketfilterPacketfilter DELETE path
 // Creates or updates the complete object packetfilter
 func (*PacketfilterPacketfilter) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/packetfilter/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the PacketfilterPacketfilter PATCH path
+// PatchPath implements sophos.Patchable and returns the PacketfilterPacketfilter PATCH path
 // Changes to parts of the object packetfilter types
 func (*PacketfilterPacketfilter) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/packetfilter/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the PacketfilterPacketfilter POST path
+// PostPath implements sophos.Creatable and returns the PacketfilterPacketfilter POST path
 // Create a new packetfilter/packetfilter object
 func (*PacketfilterPacketfilter) PostPath() string {
 	return "/api/objects/packetfilter/packetfilter/"
 }
 
-// PutPath implements sophos.RestObject and returns the PacketfilterPacketfilter PUT path
+// PutPath implements sophos.Updatable and returns the PacketfilterPacketfilter PUT path
 // Creates or updates the complete object packetfilter
 func (*PacketfilterPacketfilter) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/packetfilter/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*PacketfilterPacketfilter) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/packetfilter/%s/usedby", ref)
@@ -719,67 +1013,88 @@ func (*PacketfilterPacketfilter) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (p *PacketfilterPacketfilter) GetType() string { return p.ObjectType }
 
-// PacketfilterRulesets is an Sophos Endpoint subType and implements sophos.RestObject
+// PacketfilterRulesets is an Sophos Endpoint subType and implements sophos.RestGetter
 type PacketfilterRulesets []PacketfilterRuleset
 
 // PacketfilterRuleset represents a UTM ruleset
 type PacketfilterRuleset struct {
-	Locked     string        `json:"_locked"`
-	ObjectType string        `json:"_type"`
-	Reference  string        `json:"_ref"`
-	Rules      []interface{} `json:"rules"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Comment    string   `json:"comment"`
+	Name       string   `json:"name"`
+	Rules      []string `json:"rules"`
 	// RulesStatus description: (HASH)
-	RulesStatus interface{} `json:"rules_status"`
+	RulesStatus map[string]interface{} `json:"rules_status"`
 	// Status default value is false
-	Status  bool   `json:"status"`
-	Comment string `json:"comment"`
-	Name    string `json:"name"`
+	Status bool `json:"status"`
+}
+
+// NewPacketfilterRuleset returns a PacketfilterRuleset with the default values of its swagger definition
+func NewPacketfilterRuleset() *PacketfilterRuleset {
+	return &PacketfilterRuleset{
+		ObjectType: "packetfilter/ruleset",
+	}
+}
+
+// Validate checks the PacketfilterRuleset before it is sent, see ValidateWith
+func (p *PacketfilterRuleset) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the PacketfilterRuleset.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *PacketfilterRuleset) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("PacketfilterRuleset", typeOf)
+	check.Required("name", p.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &PacketfilterRuleset{}
 
-// GetPath implements sophos.RestObject and returns the PacketfilterRulesets GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterRulesets GET path
 // Returns all available packetfilter/ruleset objects
 func (*PacketfilterRulesets) GetPath() string { return "/api/objects/packetfilter/ruleset/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*PacketfilterRulesets) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the PacketfilterRulesets GET path
+// GetPath implements sophos.RestGetter and returns the PacketfilterRulesets GET path
 // Returns all available ruleset types
 func (p *PacketfilterRuleset) GetPath() string {
 	return fmt.Sprintf("/api/objects/packetfilter/ruleset/%s", p.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *PacketfilterRuleset) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the PacketfilterRuleset DELETE path
+// DeletePath implements sophos.Deletable and returns the PacketfilterRuleset DELETE path
 // Creates or updates the complete object ruleset
 func (*PacketfilterRuleset) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/ruleset/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the PacketfilterRuleset PATCH path
+// PatchPath implements sophos.Patchable and returns the PacketfilterRuleset PATCH path
 // Changes to parts of the object ruleset types
 func (*PacketfilterRuleset) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/ruleset/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the PacketfilterRuleset POST path
+// PostPath implements sophos.Creatable and returns the PacketfilterRuleset POST path
 // Create a new packetfilter/ruleset object
 func (*PacketfilterRuleset) PostPath() string {
 	return "/api/objects/packetfilter/ruleset/"
 }
 
-// PutPath implements sophos.RestObject and returns the PacketfilterRuleset PUT path
+// PutPath implements sophos.Updatable and returns the PacketfilterRuleset PUT path
 // Creates or updates the complete object ruleset
 func (*PacketfilterRuleset) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/ruleset/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*PacketfilterRuleset) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/packetfilter/ruleset/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (p *PacketfilterRuleset) GetType() string { return p.ObjectType }
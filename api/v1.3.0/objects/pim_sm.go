@@ -9,19 +9,19 @@ import (
 // PimSm is a generated struct representing the Sophos PimSm Endpoint
 // GET /api/nodes/pim_sm
 type PimSm struct {
-	AutoPfOut       string        `json:"auto_pf_out"`
-	AutoPfrule      int64         `json:"auto_pfrule"`
-	Debug           int64         `json:"debug"`
-	Interfaces      []interface{} `json:"interfaces"`
-	RpRouters       []interface{} `json:"rp_routers"`
-	SptSwitchBytes  int64         `json:"spt_switch_bytes"`
-	SptSwitchStatus int64         `json:"spt_switch_status"`
-	Status          int64         `json:"status"`
+	AutoPfOut       string   `json:"auto_pf_out"`
+	AutoPfrule      bool     `json:"auto_pfrule"`
+	Debug           bool     `json:"debug"`
+	Interfaces      []string `json:"interfaces"`
+	RpRouters       []string `json:"rp_routers"`
+	SptSwitchBytes  int64    `json:"spt_switch_bytes"`
+	SptSwitchStatus bool     `json:"spt_switch_status"`
+	Status          bool     `json:"status"`
 }
 
 var _ sophos.Endpoint = &PimSm{}
 
-var defsPimSm = map[string]sophos.RestObject{
+var defsPimSm = map[string]sophos.RestGetter{
 	"PimSmGroup":     &PimSmGroup{},
 	"PimSmInterface": &PimSmInterface{},
 	"PimSmRoute":     &PimSmRoute{},
@@ -29,7 +29,7 @@ var defsPimSm = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of PimSm's Objects
-func (PimSm) RestObjects() map[string]sophos.RestObject { return defsPimSm }
+func (PimSm) RestObjects() map[string]sophos.RestGetter { return defsPimSm }
 
 // GetPath implements sophos.RestGetter
 func (*PimSm) GetPath() string { return "/api/nodes/pim_sm" }
@@ -71,7 +71,7 @@ func (PimSm) References() []string {
 	}
 }
 
-// PimSmGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// PimSmGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type PimSmGroups []PimSmGroup
 
 // PimSmGroup represents a UTM group
@@ -83,117 +83,163 @@ type PimSmGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewPimSmGroup returns a PimSmGroup with the default values of its swagger definition
+func NewPimSmGroup() *PimSmGroup {
+	return &PimSmGroup{
+		ObjectType: "pim_sm/group",
+	}
+}
+
+// Validate checks the PimSmGroup before it is sent, see ValidateWith
+func (p *PimSmGroup) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the PimSmGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *PimSmGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("PimSmGroup", typeOf)
+	check.Required("name", p.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &PimSmGroup{}
 
-// GetPath implements sophos.RestObject and returns the PimSmGroups GET path
+// GetPath implements sophos.RestGetter and returns the PimSmGroups GET path
 // Returns all available pim_sm/group objects
 func (*PimSmGroups) GetPath() string { return "/api/objects/pim_sm/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*PimSmGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the PimSmGroups GET path
+// GetPath implements sophos.RestGetter and returns the PimSmGroups GET path
 // Returns all available group types
-func (p *PimSmGroup) GetPath() string { return fmt.Sprintf("/api/objects/pim_sm/group/%s", p.Reference) }
+func (p *PimSmGroup) GetPath() string {
+	return fmt.Sprintf("/api/objects/pim_sm/group/%s", p.Reference)
+}
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *PimSmGroup) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the PimSmGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the PimSmGroup DELETE path
 // Creates or updates the complete object group
 func (*PimSmGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the PimSmGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the PimSmGroup PATCH path
 // Changes to parts of the object group types
 func (*PimSmGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the PimSmGroup POST path
+// PostPath implements sophos.Creatable and returns the PimSmGroup POST path
 // Create a new pim_sm/group object
 func (*PimSmGroup) PostPath() string {
 	return "/api/objects/pim_sm/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the PimSmGroup PUT path
+// PutPath implements sophos.Updatable and returns the PimSmGroup PUT path
 // Creates or updates the complete object group
 func (*PimSmGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*PimSmGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/group/%s/usedby", ref)
 }
 
-// PimSmInterfaces is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (p *PimSmGroup) GetType() string { return p.ObjectType }
+
+// PimSmInterfaces is an Sophos Endpoint subType and implements sophos.RestGetter
 type PimSmInterfaces []PimSmInterface
 
 // PimSmInterface represents a UTM multicast routing interface
 type PimSmInterface struct {
-	Locked       string        `json:"_locked"`
-	ObjectType   string        `json:"_type"`
-	Reference    string        `json:"_ref"`
-	DrPriority   int           `json:"dr_priority"`
-	IgmpVersions []interface{} `json:"igmp_versions"`
+	Locked       string  `json:"_locked"`
+	ObjectType   string  `json:"_type"`
+	Reference    string  `json:"_ref"`
+	Comment      string  `json:"comment"`
+	DrPriority   int64   `json:"dr_priority"`
+	IgmpVersions []int64 `json:"igmp_versions"`
 	// Interface description: REF(interface/*)
-	Interface string `json:"interface"`
-	Name      string `json:"name"`
-	Comment   string `json:"comment"`
+	Interface InterfaceRef `json:"interface"`
+	Name      string       `json:"name"`
+}
+
+// NewPimSmInterface returns a PimSmInterface with the default values of its swagger definition
+func NewPimSmInterface() *PimSmInterface {
+	return &PimSmInterface{
+		ObjectType: "pim_sm/interface",
+	}
+}
+
+// Validate checks the PimSmInterface before it is sent, see ValidateWith
+func (p *PimSmInterface) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the PimSmInterface.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *PimSmInterface) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("PimSmInterface", typeOf)
+	check.Required("interface", string(p.Interface))
+	check.Ref("interface", string(p.Interface), "REF(interface/*)")
+	check.Required("name", p.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &PimSmInterface{}
 
-// GetPath implements sophos.RestObject and returns the PimSmInterfaces GET path
+// GetPath implements sophos.RestGetter and returns the PimSmInterfaces GET path
 // Returns all available pim_sm/interface objects
 func (*PimSmInterfaces) GetPath() string { return "/api/objects/pim_sm/interface/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*PimSmInterfaces) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the PimSmInterfaces GET path
+// GetPath implements sophos.RestGetter and returns the PimSmInterfaces GET path
 // Returns all available interface types
 func (p *PimSmInterface) GetPath() string {
 	return fmt.Sprintf("/api/objects/pim_sm/interface/%s", p.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *PimSmInterface) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the PimSmInterface DELETE path
+// DeletePath implements sophos.Deletable and returns the PimSmInterface DELETE path
 // Creates or updates the complete object interface
 func (*PimSmInterface) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/interface/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the PimSmInterface PATCH path
+// PatchPath implements sophos.Patchable and returns the PimSmInterface PATCH path
 // Changes to parts of the object interface types
 func (*PimSmInterface) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/interface/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the PimSmInterface POST path
+// PostPath implements sophos.Creatable and returns the PimSmInterface POST path
 // Create a new pim_sm/interface object
 func (*PimSmInterface) PostPath() string {
 	return "/api/objects/pim_sm/interface/"
 }
 
-// PutPath implements sophos.RestObject and returns the PimSmInterface PUT path
+// PutPath implements sophos.Updatable and returns the PimSmInterface PUT path
 // Creates or updates the complete object interface
 func (*PimSmInterface) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/interface/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*PimSmInterface) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/interface/%s/usedby", ref)
 }
 
-// PimSmRoutes is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (p *PimSmInterface) GetType() string { return p.ObjectType }
+
+// PimSmRoutes is an Sophos Endpoint subType and implements sophos.RestGetter
 type PimSmRoutes []PimSmRoute
 
 // PimSmRoute represents a UTM multicast route
@@ -204,66 +250,112 @@ type PimSmRoute struct {
 	Comment    string `json:"comment"`
 	// Gateway description: REF(network/host), REF(network/dns_host), REF(network/availability_group)
 	// Gateway default value is ""
-	Gateway string `json:"gateway"`
+	Gateway NetworkRef `json:"gateway"`
 	// Interface description: REF(interface/*)
 	// Interface default value is ""
-	Interface string `json:"interface"`
-	Name      string `json:"name"`
+	Interface InterfaceRef `json:"interface"`
+	Name      string       `json:"name"`
 	// Network description: REF(network/*)
-	Network string `json:"network"`
+	Network NetworkRef `json:"network"`
 	// Status default value is false
 	Status bool `json:"status"`
 	// Type can be one of: []string{"gateway", "interface"}
-	Type string `json:"type"`
+	Type PimSmRouteType `json:"type"`
+}
+
+// PimSmRouteType is the Type of a PimSmRoute
+type PimSmRouteType string
+
+// Known values of PimSmRouteType
+const (
+	PimSmRouteTypeGateway   PimSmRouteType = "gateway"
+	PimSmRouteTypeInterface PimSmRouteType = "interface"
+)
+
+// Valid returns true if the value is a known PimSmRouteType
+func (v PimSmRouteType) Valid() bool {
+	switch v {
+	case PimSmRouteTypeGateway, PimSmRouteTypeInterface:
+		return true
+	}
+	return false
+}
+
+// NewPimSmRoute returns a PimSmRoute with the default values of its swagger definition
+func NewPimSmRoute() *PimSmRoute {
+	return &PimSmRoute{
+		ObjectType: "pim_sm/route",
+	}
+}
+
+// Validate checks the PimSmRoute before it is sent, see ValidateWith
+func (p *PimSmRoute) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the PimSmRoute.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *PimSmRoute) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("PimSmRoute", typeOf)
+	check.Ref("gateway", string(p.Gateway), "REF(network/host), REF(network/dns_host), REF(network/availability_group)")
+	check.Ref("interface", string(p.Interface), "REF(interface/*)")
+	check.Required("name", p.Name)
+	check.Required("network", string(p.Network))
+	check.Ref("network", string(p.Network), "REF(network/*)")
+	check.Enum("type", string(p.Type), p.Type.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &PimSmRoute{}
 
-// GetPath implements sophos.RestObject and returns the PimSmRoutes GET path
+// GetPath implements sophos.RestGetter and returns the PimSmRoutes GET path
 // Returns all available pim_sm/route objects
 func (*PimSmRoutes) GetPath() string { return "/api/objects/pim_sm/route/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*PimSmRoutes) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the PimSmRoutes GET path
+// GetPath implements sophos.RestGetter and returns the PimSmRoutes GET path
 // Returns all available route types
-func (p *PimSmRoute) GetPath() string { return fmt.Sprintf("/api/objects/pim_sm/route/%s", p.Reference) }
+func (p *PimSmRoute) GetPath() string {
+	return fmt.Sprintf("/api/objects/pim_sm/route/%s", p.Reference)
+}
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *PimSmRoute) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the PimSmRoute DELETE path
+// DeletePath implements sophos.Deletable and returns the PimSmRoute DELETE path
 // Creates or updates the complete object route
 func (*PimSmRoute) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/route/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the PimSmRoute PATCH path
+// PatchPath implements sophos.Patchable and returns the PimSmRoute PATCH path
 // Changes to parts of the object route types
 func (*PimSmRoute) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/route/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the PimSmRoute POST path
+// PostPath implements sophos.Creatable and returns the PimSmRoute POST path
 // Create a new pim_sm/route object
 func (*PimSmRoute) PostPath() string {
 	return "/api/objects/pim_sm/route/"
 }
 
-// PutPath implements sophos.RestObject and returns the PimSmRoute PUT path
+// PutPath implements sophos.Updatable and returns the PimSmRoute PUT path
 // Creates or updates the complete object route
 func (*PimSmRoute) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/route/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*PimSmRoute) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/route/%s/usedby", ref)
 }
 
-// PimSmRpRouters is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (p *PimSmRoute) GetType() string { return p.ObjectType }
+
+// PimSmRpRouters is an Sophos Endpoint subType and implements sophos.RestGetter
 type PimSmRpRouters []PimSmRpRouter
 
 // PimSmRpRouter represents a UTM rendezvous point router
@@ -273,56 +365,79 @@ type PimSmRpRouter struct {
 	Reference  string `json:"_ref"`
 	Comment    string `json:"comment"`
 	// Host description: REF(network/host), REF(network/dns_host), REF(network/interface_address)
-	Host            string        `json:"host"`
-	MulticastGroups []interface{} `json:"multicast_groups"`
-	Name            string        `json:"name"`
-	RpPriority      int           `json:"rp_priority"`
+	Host            NetworkRef `json:"host"`
+	MulticastGroups []string   `json:"multicast_groups"`
+	Name            string     `json:"name"`
+	RpPriority      int64      `json:"rp_priority"`
+}
+
+// NewPimSmRpRouter returns a PimSmRpRouter with the default values of its swagger definition
+func NewPimSmRpRouter() *PimSmRpRouter {
+	return &PimSmRpRouter{
+		ObjectType: "pim_sm/rp_router",
+	}
+}
+
+// Validate checks the PimSmRpRouter before it is sent, see ValidateWith
+func (p *PimSmRpRouter) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the PimSmRpRouter.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *PimSmRpRouter) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("PimSmRpRouter", typeOf)
+	check.Required("host", string(p.Host))
+	check.Ref("host", string(p.Host), "REF(network/host), REF(network/dns_host), REF(network/interface_address)")
+	check.Required("name", p.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &PimSmRpRouter{}
 
-// GetPath implements sophos.RestObject and returns the PimSmRpRouters GET path
+// GetPath implements sophos.RestGetter and returns the PimSmRpRouters GET path
 // Returns all available pim_sm/rp_router objects
 func (*PimSmRpRouters) GetPath() string { return "/api/objects/pim_sm/rp_router/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*PimSmRpRouters) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the PimSmRpRouters GET path
+// GetPath implements sophos.RestGetter and returns the PimSmRpRouters GET path
 // Returns all available rp_router types
 func (p *PimSmRpRouter) GetPath() string {
 	return fmt.Sprintf("/api/objects/pim_sm/rp_router/%s", p.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *PimSmRpRouter) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the PimSmRpRouter DELETE path
+// DeletePath implements sophos.Deletable and returns the PimSmRpRouter DELETE path
 // Creates or updates the complete object rp_router
 func (*PimSmRpRouter) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/rp_router/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the PimSmRpRouter PATCH path
+// PatchPath implements sophos.Patchable and returns the PimSmRpRouter PATCH path
 // Changes to parts of the object rp_router types
 func (*PimSmRpRouter) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/rp_router/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the PimSmRpRouter POST path
+// PostPath implements sophos.Creatable and returns the PimSmRpRouter POST path
 // Create a new pim_sm/rp_router object
 func (*PimSmRpRouter) PostPath() string {
 	return "/api/objects/pim_sm/rp_router/"
 }
 
-// PutPath implements sophos.RestObject and returns the PimSmRpRouter PUT path
+// PutPath implements sophos.Updatable and returns the PimSmRpRouter PUT path
 // Creates or updates the complete object rp_router
 func (*PimSmRpRouter) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/rp_router/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*PimSmRpRouter) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pim_sm/rp_router/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (p *PimSmRpRouter) GetType() string { return p.ObjectType }
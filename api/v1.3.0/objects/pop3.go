@@ -9,42 +9,42 @@ import (
 // Pop3 is a generated struct representing the Sophos Pop3 Endpoint
 // GET /api/nodes/pop3
 type Pop3 struct {
-	AllowedClients            []interface{} `json:"allowed_clients"`
-	AllowedServers            []string      `json:"allowed_servers"`
-	CffAsMarker               string        `json:"cff_as_marker"`
-	CffAv                     int64         `json:"cff_av"`
-	CffAvAction               string        `json:"cff_av_action"`
-	CffAvEngines              string        `json:"cff_av_engines"`
-	CffFileExtensions         []string      `json:"cff_file_extensions"`
-	DirectlyDeleteQuarantined int64         `json:"directly_delete_quarantined"`
-	Exceptions                []interface{} `json:"exceptions"`
-	KnownServers              []interface{} `json:"known_servers"`
-	MaxMessageSize            int64         `json:"max_message_size"`
+	AllowedClients            []string `json:"allowed_clients"`
+	AllowedServers            []string `json:"allowed_servers"`
+	CffAsMarker               string   `json:"cff_as_marker"`
+	CffAv                     bool     `json:"cff_av"`
+	CffAvAction               string   `json:"cff_av_action"`
+	CffAvEngines              string   `json:"cff_av_engines"`
+	CffFileExtensions         []string `json:"cff_file_extensions"`
+	DirectlyDeleteQuarantined bool     `json:"directly_delete_quarantined"`
+	Exceptions                []string `json:"exceptions"`
+	KnownServers              []string `json:"known_servers"`
+	MaxMessageSize            int64    `json:"max_message_size"`
 	Prefetching               struct {
 		Interval           int64 `json:"interval"`
-		OptimizeStorage    int64 `json:"optimize_storage"`
-		Status             int64 `json:"status"`
+		OptimizeStorage    bool  `json:"optimize_storage"`
+		Status             bool  `json:"status"`
 		StorageMinHoldDays int64 `json:"storage_min_hold_days"`
 	} `json:"prefetching"`
-	QuarantineUnscannable int64         `json:"quarantine_unscannable"`
-	SandboxMaxFilesizeMb  int64         `json:"sandbox_max_filesize_mb"`
-	SandboxScanStatus     int64         `json:"sandbox_scan_status"`
-	ScanTLS               int64         `json:"scan_tls"`
-	SenderBlacklist       []interface{} `json:"sender_blacklist"`
-	Spam                  string        `json:"spam"`
-	SpamExpressions       []interface{} `json:"spam_expressions"`
-	Spamplus              string        `json:"spamplus"`
-	Spamstatus            int64         `json:"spamstatus"`
-	Status                int64         `json:"status"`
-	TLSCert               string        `json:"tls_cert"`
-	TransparentSkip       []interface{} `json:"transparent_skip"`
-	TransparentSkipAutoPf int64         `json:"transparent_skip_auto_pf"`
-	UserCharset           string        `json:"user_charset"`
+	QuarantineUnscannable bool        `json:"quarantine_unscannable"`
+	SandboxMaxFilesizeMb  int64       `json:"sandbox_max_filesize_mb"`
+	SandboxScanStatus     bool        `json:"sandbox_scan_status"`
+	ScanTLS               bool        `json:"scan_tls"`
+	SenderBlacklist       []string    `json:"sender_blacklist"`
+	Spam                  string      `json:"spam"`
+	SpamExpressions       []string    `json:"spam_expressions"`
+	Spamplus              string      `json:"spamplus"`
+	Spamstatus            bool        `json:"spamstatus"`
+	Status                bool        `json:"status"`
+	TLSCert               string      `json:"tls_cert"`
+	TransparentSkip       NetworkRefs `json:"transparent_skip"`
+	TransparentSkipAutoPf bool        `json:"transparent_skip_auto_pf"`
+	UserCharset           string      `json:"user_charset"`
 }
 
 var _ sophos.Endpoint = &Pop3{}
 
-var defsPop3 = map[string]sophos.RestObject{
+var defsPop3 = map[string]sophos.RestGetter{
 	"Pop3Account":   &Pop3Account{},
 	"Pop3Exception": &Pop3Exception{},
 	"Pop3Group":     &Pop3Group{},
@@ -52,7 +52,7 @@ var defsPop3 = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Pop3's Objects
-func (Pop3) RestObjects() map[string]sophos.RestObject { return defsPop3 }
+func (Pop3) RestObjects() map[string]sophos.RestGetter { return defsPop3 }
 
 // GetPath implements sophos.RestGetter
 func (*Pop3) GetPath() string { return "/api/nodes/pop3" }
@@ -94,7 +94,7 @@ func (Pop3) References() []string {
 	}
 }
 
-// Pop3Accounts is an Sophos Endpoint subType and implements sophos.RestObject
+// Pop3Accounts is an Sophos Endpoint subType and implements sophos.RestGetter
 type Pop3Accounts []Pop3Account
 
 // Pop3Account represents a UTM POP3 account
@@ -102,128 +102,172 @@ type Pop3Account struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
+	Comment    string `json:"comment"`
 	Name       string `json:"name"`
 	Password   string `json:"password"`
 	// Server description: REF(pop3/server)
-	Server   string `json:"server"`
-	Username string `json:"username"`
-	Comment  string `json:"comment"`
+	Server   Pop3Ref `json:"server"`
+	Username string  `json:"username"`
+}
+
+// NewPop3Account returns a Pop3Account with the default values of its swagger definition
+func NewPop3Account() *Pop3Account {
+	return &Pop3Account{
+		ObjectType: "pop3/account",
+	}
+}
+
+// Validate checks the Pop3Account before it is sent, see ValidateWith
+func (p *Pop3Account) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the Pop3Account.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *Pop3Account) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("Pop3Account", typeOf)
+	check.Required("name", p.Name)
+	check.Required("server", string(p.Server))
+	check.Ref("server", string(p.Server), "REF(pop3/server)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &Pop3Account{}
 
-// GetPath implements sophos.RestObject and returns the Pop3Accounts GET path
+// GetPath implements sophos.RestGetter and returns the Pop3Accounts GET path
 // Returns all available pop3/account objects
 func (*Pop3Accounts) GetPath() string { return "/api/objects/pop3/account/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*Pop3Accounts) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the Pop3Accounts GET path
+// GetPath implements sophos.RestGetter and returns the Pop3Accounts GET path
 // Returns all available account types
 func (p *Pop3Account) GetPath() string {
 	return fmt.Sprintf("/api/objects/pop3/account/%s", p.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *Pop3Account) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the Pop3Account DELETE path
+// DeletePath implements sophos.Deletable and returns the Pop3Account DELETE path
 // Creates or updates the complete object account
 func (*Pop3Account) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/account/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the Pop3Account PATCH path
+// PatchPath implements sophos.Patchable and returns the Pop3Account PATCH path
 // Changes to parts of the object account types
 func (*Pop3Account) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/account/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the Pop3Account POST path
+// PostPath implements sophos.Creatable and returns the Pop3Account POST path
 // Create a new pop3/account object
 func (*Pop3Account) PostPath() string {
 	return "/api/objects/pop3/account/"
 }
 
-// PutPath implements sophos.RestObject and returns the Pop3Account PUT path
+// PutPath implements sophos.Updatable and returns the Pop3Account PUT path
 // Creates or updates the complete object account
 func (*Pop3Account) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/account/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*Pop3Account) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/account/%s/usedby", ref)
 }
 
-// Pop3Exceptions is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (p *Pop3Account) GetType() string { return p.ObjectType }
+
+// Pop3Exceptions is an Sophos Endpoint subType and implements sophos.RestGetter
 type Pop3Exceptions []Pop3Exception
 
 // Pop3Exception represents a UTM POP3 filter exception
 type Pop3Exception struct {
-	Locked     string        `json:"_locked"`
-	ObjectType string        `json:"_type"`
-	Reference  string        `json:"_ref"`
-	Comment    string        `json:"comment"`
-	Name       string        `json:"name"`
-	Sender     []interface{} `json:"sender"`
-	Skiplist   []interface{} `json:"skiplist"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Client     []string `json:"client"`
+	Comment    string   `json:"comment"`
+	Name       string   `json:"name"`
+	Sender     []string `json:"sender"`
+	Skiplist   []string `json:"skiplist"`
 	// Status default value is false
-	Status bool          `json:"status"`
-	Client []interface{} `json:"client"`
+	Status bool `json:"status"`
+}
+
+// NewPop3Exception returns a Pop3Exception with the default values of its swagger definition
+func NewPop3Exception() *Pop3Exception {
+	return &Pop3Exception{
+		ObjectType: "pop3/exception",
+	}
+}
+
+// Validate checks the Pop3Exception before it is sent, see ValidateWith
+func (p *Pop3Exception) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the Pop3Exception.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *Pop3Exception) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("Pop3Exception", typeOf)
+	check.Required("name", p.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &Pop3Exception{}
 
-// GetPath implements sophos.RestObject and returns the Pop3Exceptions GET path
+// GetPath implements sophos.RestGetter and returns the Pop3Exceptions GET path
 // Returns all available pop3/exception objects
 func (*Pop3Exceptions) GetPath() string { return "/api/objects/pop3/exception/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*Pop3Exceptions) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the Pop3Exceptions GET path
+// GetPath implements sophos.RestGetter and returns the Pop3Exceptions GET path
 // Returns all available exception types
 func (p *Pop3Exception) GetPath() string {
 	return fmt.Sprintf("/api/objects/pop3/exception/%s", p.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *Pop3Exception) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the Pop3Exception DELETE path
+// DeletePath implements sophos.Deletable and returns the Pop3Exception DELETE path
 // Creates or updates the complete object exception
 func (*Pop3Exception) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/exception/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the Pop3Exception PATCH path
+// PatchPath implements sophos.Patchable and returns the Pop3Exception PATCH path
 // Changes to parts of the object exception types
 func (*Pop3Exception) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/exception/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the Pop3Exception POST path
+// PostPath implements sophos.Creatable and returns the Pop3Exception POST path
 // Create a new pop3/exception object
 func (*Pop3Exception) PostPath() string {
 	return "/api/objects/pop3/exception/"
 }
 
-// PutPath implements sophos.RestObject and returns the Pop3Exception PUT path
+// PutPath implements sophos.Updatable and returns the Pop3Exception PUT path
 // Creates or updates the complete object exception
 func (*Pop3Exception) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/exception/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*Pop3Exception) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/exception/%s/usedby", ref)
 }
 
-// Pop3Groups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (p *Pop3Exception) GetType() string { return p.ObjectType }
+
+// Pop3Groups is an Sophos Endpoint subType and implements sophos.RestGetter
 type Pop3Groups []Pop3Group
 
 // Pop3Group represents a UTM group
@@ -235,110 +279,153 @@ type Pop3Group struct {
 	Name       string `json:"name"`
 }
 
+// NewPop3Group returns a Pop3Group with the default values of its swagger definition
+func NewPop3Group() *Pop3Group {
+	return &Pop3Group{
+		ObjectType: "pop3/group",
+	}
+}
+
+// Validate checks the Pop3Group before it is sent, see ValidateWith
+func (p *Pop3Group) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the Pop3Group.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *Pop3Group) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("Pop3Group", typeOf)
+	check.Required("name", p.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &Pop3Group{}
 
-// GetPath implements sophos.RestObject and returns the Pop3Groups GET path
+// GetPath implements sophos.RestGetter and returns the Pop3Groups GET path
 // Returns all available pop3/group objects
 func (*Pop3Groups) GetPath() string { return "/api/objects/pop3/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*Pop3Groups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the Pop3Groups GET path
+// GetPath implements sophos.RestGetter and returns the Pop3Groups GET path
 // Returns all available group types
 func (p *Pop3Group) GetPath() string { return fmt.Sprintf("/api/objects/pop3/group/%s", p.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *Pop3Group) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the Pop3Group DELETE path
+// DeletePath implements sophos.Deletable and returns the Pop3Group DELETE path
 // Creates or updates the complete object group
 func (*Pop3Group) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the Pop3Group PATCH path
+// PatchPath implements sophos.Patchable and returns the Pop3Group PATCH path
 // Changes to parts of the object group types
 func (*Pop3Group) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the Pop3Group POST path
+// PostPath implements sophos.Creatable and returns the Pop3Group POST path
 // Create a new pop3/group object
 func (*Pop3Group) PostPath() string {
 	return "/api/objects/pop3/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the Pop3Group PUT path
+// PutPath implements sophos.Updatable and returns the Pop3Group PUT path
 // Creates or updates the complete object group
 func (*Pop3Group) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*Pop3Group) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/group/%s/usedby", ref)
 }
 
-// Pop3Servers is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (p *Pop3Group) GetType() string { return p.ObjectType }
+
+// Pop3Servers is an Sophos Endpoint subType and implements sophos.RestGetter
 type Pop3Servers []Pop3Server
 
 // Pop3Server represents a UTM POP3 server
 type Pop3Server struct {
-	Locked     string        `json:"_locked"`
-	ObjectType string        `json:"_type"`
-	Reference  string        `json:"_ref"`
-	Comment    string        `json:"comment"`
-	Hosts      []interface{} `json:"hosts"`
-	Name       string        `json:"name"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Comment    string   `json:"comment"`
+	Hosts      []string `json:"hosts"`
+	Name       string   `json:"name"`
 	// TlsCert description: REF(ca/host_key_cert)
 	// TlsCert default value is ""
-	TlsCert string `json:"tls_cert"`
+	TlsCert CaRef `json:"tls_cert"`
+}
+
+// NewPop3Server returns a Pop3Server with the default values of its swagger definition
+func NewPop3Server() *Pop3Server {
+	return &Pop3Server{
+		ObjectType: "pop3/server",
+	}
+}
+
+// Validate checks the Pop3Server before it is sent, see ValidateWith
+func (p *Pop3Server) Validate() error { return p.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the Pop3Server.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (p *Pop3Server) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("Pop3Server", typeOf)
+	check.Required("name", p.Name)
+	check.Ref("tls_cert", string(p.TlsCert), "REF(ca/host_key_cert)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &Pop3Server{}
 
-// GetPath implements sophos.RestObject and returns the Pop3Servers GET path
+// GetPath implements sophos.RestGetter and returns the Pop3Servers GET path
 // Returns all available pop3/server objects
 func (*Pop3Servers) GetPath() string { return "/api/objects/pop3/server/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*Pop3Servers) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the Pop3Servers GET path
+// GetPath implements sophos.RestGetter and returns the Pop3Servers GET path
 // Returns all available server types
 func (p *Pop3Server) GetPath() string { return fmt.Sprintf("/api/objects/pop3/server/%s", p.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (p *Pop3Server) RefRequired() (string, bool) { return p.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the Pop3Server DELETE path
+// DeletePath implements sophos.Deletable and returns the Pop3Server DELETE path
 // Creates or updates the complete object server
 func (*Pop3Server) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/server/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the Pop3Server PATCH path
+// PatchPath implements sophos.Patchable and returns the Pop3Server PATCH path
 // Changes to parts of the object server types
 func (*Pop3Server) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/server/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the Pop3Server POST path
+// PostPath implements sophos.Creatable and returns the Pop3Server POST path
 // Create a new pop3/server object
 func (*Pop3Server) PostPath() string {
 	return "/api/objects/pop3/server/"
 }
 
-// PutPath implements sophos.RestObject and returns the Pop3Server PUT path
+// PutPath implements sophos.Updatable and returns the Pop3Server PUT path
 // Creates or updates the complete object server
 func (*Pop3Server) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/server/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*Pop3Server) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/pop3/server/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (p *Pop3Server) GetType() string { return p.ObjectType }
@@ -11,14 +11,14 @@ import (
 type Qos struct {
 	Advanced struct {
 		Ecn                 int64 `json:"ecn"`
-		KeepClassAfterEncap int64 `json:"keep_class_after_encap"`
+		KeepClassAfterEncap bool  `json:"keep_class_after_encap"`
 	} `json:"advanced"`
 	Interfaces []string `json:"interfaces"`
 }
 
 var _ sophos.Endpoint = &Qos{}
 
-var defsQos = map[string]sophos.RestObject{
+var defsQos = map[string]sophos.RestGetter{
 	"QosApplicationSelector":  &QosApplicationSelector{},
 	"QosGroup":                &QosGroup{},
 	"QosIngressRule":          &QosIngressRule{},
@@ -29,7 +29,7 @@ var defsQos = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Qos's Objects
-func (Qos) RestObjects() map[string]sophos.RestObject { return defsQos }
+func (Qos) RestObjects() map[string]sophos.RestGetter { return defsQos }
 
 // GetPath implements sophos.RestGetter
 func (*Qos) GetPath() string { return "/api/nodes/qos" }
@@ -83,78 +83,103 @@ func (Qos) References() []string {
 	}
 }
 
-// QosApplicationSelectors is an Sophos Endpoint subType and implements sophos.RestObject
+// QosApplicationSelectors is an Sophos Endpoint subType and implements sophos.RestGetter
 type QosApplicationSelectors []QosApplicationSelector
 
 // QosApplicationSelector represents a UTM QoS application selector
 type QosApplicationSelector struct {
-	Locked                  string `json:"_locked"`
-	ObjectType              string `json:"_type"`
-	Reference               string `json:"_ref"`
-	GroupFilterProductivity int    `json:"group_filter_productivity"`
-	GroupFilterRisk         int    `json:"group_filter_risk"`
-	// Source description: REF(network/*)
-	Source    string `json:"source"`
-	Comment   string `json:"comment"`
-	Connbytes int    `json:"connbytes"`
-	// Destination description: REF(network/*)
-	Destination  string        `json:"destination"`
-	Groups       []interface{} `json:"groups"`
-	Name         string        `json:"name"`
-	Applications []interface{} `json:"applications"`
+	Locked       string   `json:"_locked"`
+	ObjectType   string   `json:"_type"`
+	Reference    string   `json:"_ref"`
+	Applications []string `json:"applications"`
+	Comment      string   `json:"comment"`
+	Connbytes    int64    `json:"connbytes"`
 	// ConnbytesUpperlimit default value is false
 	ConnbytesUpperlimit bool `json:"connbytes_upperlimit"`
+	// Destination description: REF(network/*)
+	Destination             NetworkRef `json:"destination"`
+	GroupFilterProductivity int64      `json:"group_filter_productivity"`
+	GroupFilterRisk         int64      `json:"group_filter_risk"`
+	Groups                  []string   `json:"groups"`
+	Name                    string     `json:"name"`
+	// Source description: REF(network/*)
+	Source NetworkRef `json:"source"`
+}
+
+// NewQosApplicationSelector returns a QosApplicationSelector with the default values of its swagger definition
+func NewQosApplicationSelector() *QosApplicationSelector {
+	return &QosApplicationSelector{
+		ObjectType: "qos/application_selector",
+	}
+}
+
+// Validate checks the QosApplicationSelector before it is sent, see ValidateWith
+func (q *QosApplicationSelector) Validate() error { return q.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the QosApplicationSelector.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (q *QosApplicationSelector) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("QosApplicationSelector", typeOf)
+	check.Required("destination", string(q.Destination))
+	check.Ref("destination", string(q.Destination), "REF(network/*)")
+	check.Required("name", q.Name)
+	check.Required("source", string(q.Source))
+	check.Ref("source", string(q.Source), "REF(network/*)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &QosApplicationSelector{}
 
-// GetPath implements sophos.RestObject and returns the QosApplicationSelectors GET path
+// GetPath implements sophos.RestGetter and returns the QosApplicationSelectors GET path
 // Returns all available qos/application_selector objects
 func (*QosApplicationSelectors) GetPath() string { return "/api/objects/qos/application_selector/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*QosApplicationSelectors) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the QosApplicationSelectors GET path
+// GetPath implements sophos.RestGetter and returns the QosApplicationSelectors GET path
 // Returns all available application_selector types
 func (q *QosApplicationSelector) GetPath() string {
 	return fmt.Sprintf("/api/objects/qos/application_selector/%s", q.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (q *QosApplicationSelector) RefRequired() (string, bool) { return q.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the QosApplicationSelector DELETE path
+// DeletePath implements sophos.Deletable and returns the QosApplicationSelector DELETE path
 // Creates or updates the complete object application_selector
 func (*QosApplicationSelector) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/application_selector/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the QosApplicationSelector PATCH path
+// PatchPath implements sophos.Patchable and returns the QosApplicationSelector PATCH path
 // Changes to parts of the object application_selector types
 func (*QosApplicationSelector) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/application_selector/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the QosApplicationSelector POST path
+// PostPath implements sophos.Creatable and returns the QosApplicationSelector POST path
 // Create a new qos/application_selector object
 func (*QosApplicationSelector) PostPath() string {
 	return "/api/objects/qos/application_selector/"
 }
 
-// PutPath implements sophos.RestObject and returns the QosApplicationSelector PUT path
+// PutPath implements sophos.Updatable and returns the QosApplicationSelector PUT path
 // Creates or updates the complete object application_selector
 func (*QosApplicationSelector) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/application_selector/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*QosApplicationSelector) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/application_selector/%s/usedby", ref)
 }
 
-// QosGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (q *QosApplicationSelector) GetType() string { return q.ObjectType }
+
+// QosGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type QosGroups []QosGroup
 
 // QosGroup represents a UTM group
@@ -162,57 +187,78 @@ type QosGroup struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Name       string `json:"name"`
 	Comment    string `json:"comment"`
+	Name       string `json:"name"`
+}
+
+// NewQosGroup returns a QosGroup with the default values of its swagger definition
+func NewQosGroup() *QosGroup {
+	return &QosGroup{
+		ObjectType: "qos/group",
+	}
+}
+
+// Validate checks the QosGroup before it is sent, see ValidateWith
+func (q *QosGroup) Validate() error { return q.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the QosGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (q *QosGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("QosGroup", typeOf)
+	check.Required("name", q.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &QosGroup{}
 
-// GetPath implements sophos.RestObject and returns the QosGroups GET path
+// GetPath implements sophos.RestGetter and returns the QosGroups GET path
 // Returns all available qos/group objects
 func (*QosGroups) GetPath() string { return "/api/objects/qos/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*QosGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the QosGroups GET path
+// GetPath implements sophos.RestGetter and returns the QosGroups GET path
 // Returns all available group types
 func (q *QosGroup) GetPath() string { return fmt.Sprintf("/api/objects/qos/group/%s", q.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (q *QosGroup) RefRequired() (string, bool) { return q.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the QosGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the QosGroup DELETE path
 // Creates or updates the complete object group
 func (*QosGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the QosGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the QosGroup PATCH path
 // Changes to parts of the object group types
 func (*QosGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the QosGroup POST path
+// PostPath implements sophos.Creatable and returns the QosGroup POST path
 // Create a new qos/group object
 func (*QosGroup) PostPath() string {
 	return "/api/objects/qos/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the QosGroup PUT path
+// PutPath implements sophos.Updatable and returns the QosGroup PUT path
 // Creates or updates the complete object group
 func (*QosGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*QosGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/group/%s/usedby", ref)
 }
 
-// QosIngressRules is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (q *QosGroup) GetType() string { return q.ObjectType }
+
+// QosIngressRules is an Sophos Endpoint subType and implements sophos.RestGetter
 type QosIngressRules []QosIngressRule
 
 // QosIngressRule represents a UTM QoS traffic throttling
@@ -220,129 +266,189 @@ type QosIngressRule struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Name       string `json:"name"`
-	// Status default value is false
-	Status           bool          `json:"status"`
-	TrafficSelectors []interface{} `json:"traffic_selectors"`
-	Comment          string        `json:"comment"`
-	Limit            int           `json:"limit"`
+	Comment    string `json:"comment"`
+	Limit      int64  `json:"limit"`
 	// Mode can be one of: []string{",", "srcip", "dstip", "srcip,dstip"}
 	// Mode default value is ""
-	Mode string `json:"mode"`
+	Mode QosIngressRuleMode `json:"mode"`
+	Name string             `json:"name"`
+	// Status default value is false
+	Status           bool     `json:"status"`
+	TrafficSelectors []string `json:"traffic_selectors"`
+}
+
+// QosIngressRuleMode is the Mode of a QosIngressRule
+type QosIngressRuleMode string
+
+// Known values of QosIngressRuleMode
+const (
+	QosIngressRuleModeValue0     QosIngressRuleMode = ","
+	QosIngressRuleModeSrcip      QosIngressRuleMode = "srcip"
+	QosIngressRuleModeDstip      QosIngressRuleMode = "dstip"
+	QosIngressRuleModeSrcipdstip QosIngressRuleMode = "srcip,dstip"
+)
+
+// Valid returns true if the value is a known QosIngressRuleMode
+func (v QosIngressRuleMode) Valid() bool {
+	switch v {
+	case QosIngressRuleModeValue0, QosIngressRuleModeSrcip, QosIngressRuleModeDstip, QosIngressRuleModeSrcipdstip:
+		return true
+	}
+	return false
+}
+
+// NewQosIngressRule returns a QosIngressRule with the default values of its swagger definition
+func NewQosIngressRule() *QosIngressRule {
+	return &QosIngressRule{
+		ObjectType: "qos/ingress_rule",
+	}
+}
+
+// Validate checks the QosIngressRule before it is sent, see ValidateWith
+func (q *QosIngressRule) Validate() error { return q.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the QosIngressRule.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (q *QosIngressRule) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("QosIngressRule", typeOf)
+	check.Enum("mode", string(q.Mode), q.Mode.Valid())
+	check.Required("name", q.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &QosIngressRule{}
 
-// GetPath implements sophos.RestObject and returns the QosIngressRules GET path
+// GetPath implements sophos.RestGetter and returns the QosIngressRules GET path
 // Returns all available qos/ingress_rule objects
 func (*QosIngressRules) GetPath() string { return "/api/objects/qos/ingress_rule/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*QosIngressRules) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the QosIngressRules GET path
+// GetPath implements sophos.RestGetter and returns the QosIngressRules GET path
 // Returns all available ingress_rule types
 func (q *QosIngressRule) GetPath() string {
 	return fmt.Sprintf("/api/objects/qos/ingress_rule/%s", q.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (q *QosIngressRule) RefRequired() (string, bool) { return q.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the QosIngressRule DELETE path
+// DeletePath implements sophos.Deletable and returns the QosIngressRule DELETE path
 // Creates or updates the complete object ingress_rule
 func (*QosIngressRule) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/ingress_rule/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the QosIngressRule PATCH path
+// PatchPath implements sophos.Patchable and returns the QosIngressRule PATCH path
 // Changes to parts of the object ingress_rule types
 func (*QosIngressRule) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/ingress_rule/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the QosIngressRule POST path
+// PostPath implements sophos.Creatable and returns the QosIngressRule POST path
 // Create a new qos/ingress_rule object
 func (*QosIngressRule) PostPath() string {
 	return "/api/objects/qos/ingress_rule/"
 }
 
-// PutPath implements sophos.RestObject and returns the QosIngressRule PUT path
+// PutPath implements sophos.Updatable and returns the QosIngressRule PUT path
 // Creates or updates the complete object ingress_rule
 func (*QosIngressRule) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/ingress_rule/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*QosIngressRule) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/ingress_rule/%s/usedby", ref)
 }
 
-// QosInterfaces is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (q *QosIngressRule) GetType() string { return q.ObjectType }
+
+// QosInterfaces is an Sophos Endpoint subType and implements sophos.RestGetter
 type QosInterfaces []QosInterface
 
-// QosInterface is a generated Sophos object
+// QosInterface represents a UTM qos/interface object
 type QosInterface struct {
-	Locked            string        `json:"_locked"`
-	Reference         string        `json:"_ref"`
-	ObjectType        string        `json:"_type"`
-	Comment           string        `json:"comment"`
-	Downlink          int64         `json:"downlink"`
-	DownlinkOptimizer bool          `json:"downlink_optimizer"`
-	IngressRules      []interface{} `json:"ingress_rules"`
-	Interface         string        `json:"interface"`
-	Name              string        `json:"name"`
-	Rules             []interface{} `json:"rules"`
-	Status            bool          `json:"status"`
-	Uplink            int64         `json:"uplink"`
-	UplinkLimit       bool          `json:"uplink_limit"`
-	UplinkOptimizer   bool          `json:"uplink_optimizer"`
+	Locked            string   `json:"_locked"`
+	ObjectType        string   `json:"_type"`
+	Reference         string   `json:"_ref"`
+	Comment           string   `json:"comment"`
+	Downlink          int64    `json:"downlink"`
+	DownlinkOptimizer bool     `json:"downlink_optimizer"`
+	IngressRules      []string `json:"ingress_rules"`
+	Interface         string   `json:"interface"`
+	Name              string   `json:"name"`
+	Rules             []string `json:"rules"`
+	Status            bool     `json:"status"`
+	Uplink            int64    `json:"uplink"`
+	UplinkLimit       bool     `json:"uplink_limit"`
+	UplinkOptimizer   bool     `json:"uplink_optimizer"`
+}
+
+// NewQosInterface returns a QosInterface with the default values of its swagger definition
+func NewQosInterface() *QosInterface {
+	return &QosInterface{
+		ObjectType: "qos/interface",
+	}
+}
+
+// Validate checks the QosInterface before it is sent, see ValidateWith
+func (q *QosInterface) Validate() error { return q.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the QosInterface.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (q *QosInterface) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("QosInterface", typeOf)
+	check.Required("name", q.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &QosInterface{}
 
-// GetPath implements sophos.RestObject and returns the QosInterfaces GET path
+// GetPath implements sophos.RestGetter and returns the QosInterfaces GET path
 // Returns all available qos/interface objects
 func (*QosInterfaces) GetPath() string { return "/api/objects/qos/interface/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*QosInterfaces) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the QosInterfaces GET path
+// GetPath implements sophos.RestGetter and returns the QosInterfaces GET path
 // Returns all available interface types
 func (q *QosInterface) GetPath() string {
 	return fmt.Sprintf("/api/objects/qos/interface/%s", q.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (q *QosInterface) RefRequired() (string, bool) { return q.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the QosInterface DELETE path
+// DeletePath implements sophos.Deletable and returns the QosInterface DELETE path
 // Creates or updates the complete object interface
 func (*QosInterface) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/interface/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the QosInterface PATCH path
+// PatchPath implements sophos.Patchable and returns the QosInterface PATCH path
 // Changes to parts of the object interface types
 func (*QosInterface) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/interface/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the QosInterface POST path
+// PostPath implements sophos.Creatable and returns the QosInterface POST path
 // Create a new qos/interface object
 func (*QosInterface) PostPath() string {
 	return "/api/objects/qos/interface/"
 }
 
-// PutPath implements sophos.RestObject and returns the QosInterface PUT path
+// PutPath implements sophos.Updatable and returns the QosInterface PUT path
 // Creates or updates the complete object interface
 func (*QosInterface) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/interface/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*QosInterface) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/interface/%s/usedby", ref)
@@ -351,7 +457,7 @@ func (*QosInterface) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (q *QosInterface) GetType() string { return q.ObjectType }
 
-// QosRules is an Sophos Endpoint subType and implements sophos.RestObject
+// QosRules is an Sophos Endpoint subType and implements sophos.RestGetter
 type QosRules []QosRule
 
 // QosRule represents a UTM QoS bandwidth pool
@@ -359,64 +465,85 @@ type QosRule struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Bandwidth  int    `json:"bandwidth"`
+	Bandwidth  int64  `json:"bandwidth"`
 	Comment    string `json:"comment"`
 	Name       string `json:"name"`
 	// Status default value is false
-	Status           bool          `json:"status"`
-	TrafficSelectors []interface{} `json:"traffic_selectors"`
+	Status           bool     `json:"status"`
+	TrafficSelectors []string `json:"traffic_selectors"`
 	// UpperLimitStatus default value is false
-	UpperLimitStatus bool `json:"upper_limit_status"`
-	UpperLimitValue  int  `json:"upper_limit_value"`
+	UpperLimitStatus bool  `json:"upper_limit_status"`
+	UpperLimitValue  int64 `json:"upper_limit_value"`
+}
+
+// NewQosRule returns a QosRule with the default values of its swagger definition
+func NewQosRule() *QosRule {
+	return &QosRule{
+		ObjectType: "qos/rule",
+	}
+}
+
+// Validate checks the QosRule before it is sent, see ValidateWith
+func (q *QosRule) Validate() error { return q.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the QosRule.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (q *QosRule) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("QosRule", typeOf)
+	check.Required("name", q.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &QosRule{}
 
-// GetPath implements sophos.RestObject and returns the QosRules GET path
+// GetPath implements sophos.RestGetter and returns the QosRules GET path
 // Returns all available qos/rule objects
 func (*QosRules) GetPath() string { return "/api/objects/qos/rule/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*QosRules) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the QosRules GET path
+// GetPath implements sophos.RestGetter and returns the QosRules GET path
 // Returns all available rule types
 func (q *QosRule) GetPath() string { return fmt.Sprintf("/api/objects/qos/rule/%s", q.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (q *QosRule) RefRequired() (string, bool) { return q.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the QosRule DELETE path
+// DeletePath implements sophos.Deletable and returns the QosRule DELETE path
 // Creates or updates the complete object rule
 func (*QosRule) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/rule/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the QosRule PATCH path
+// PatchPath implements sophos.Patchable and returns the QosRule PATCH path
 // Changes to parts of the object rule types
 func (*QosRule) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/rule/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the QosRule POST path
+// PostPath implements sophos.Creatable and returns the QosRule POST path
 // Create a new qos/rule object
 func (*QosRule) PostPath() string {
 	return "/api/objects/qos/rule/"
 }
 
-// PutPath implements sophos.RestObject and returns the QosRule PUT path
+// PutPath implements sophos.Updatable and returns the QosRule PUT path
 // Creates or updates the complete object rule
 func (*QosRule) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/rule/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*QosRule) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/rule/%s/usedby", ref)
 }
 
-// QosTrafficSelectors is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (q *QosRule) GetType() string { return q.ObjectType }
+
+// QosTrafficSelectors is an Sophos Endpoint subType and implements sophos.RestGetter
 type QosTrafficSelectors []QosTrafficSelector
 
 // QosTrafficSelector represents a UTM QoS traffic selector
@@ -424,141 +551,273 @@ type QosTrafficSelector struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
+	Comment    string `json:"comment"`
+	Connbytes  int64  `json:"connbytes"`
+	// ConnbytesUpperlimit default value is false
+	ConnbytesUpperlimit bool `json:"connbytes_upperlimit"`
+	// Destination description: REF(network/*)
+	Destination NetworkRef `json:"destination"`
+	// DscpString can be one of: []string{"BE", "AF11", "AF12", "AF13", "AF21", "AF22", "AF23", "AF31", "AF32", "AF33", "AF41", "AF42", "AF43", "CS1", "CS2", "CS3", "CS4", "CS5", "CS6", "CS7", "EF"}
+	// DscpString default value is "BE"
+	DscpString QosTrafficSelectorDscpString `json:"dscp_string"`
+	// DscpType can be one of: []string{"off", "value", "class"}
+	// DscpType default value is "off"
+	DscpType  QosTrafficSelectorDscpType `json:"dscp_type"`
+	DscpValue int64                      `json:"dscp_value"`
+	// Helper description: (REGEX)
+	// Helper default value is ""
+	Helper string `json:"helper"`
+	Name   string `json:"name"`
 	// PacketLength default value is ""
 	PacketLength string `json:"packet_length"`
 	// Service description: REF(service/*)
 	// Service default value is "REF_ServiceAny"
-	Service string `json:"service"`
+	Service ServiceRef `json:"service"`
 	// Source description: REF(network/*)
-	Source string `json:"source"`
-	// DscpType can be one of: []string{"off", "value", "class"}
-	// DscpType default value is "off"
-	DscpType string `json:"dscp_type"`
+	Source   NetworkRef `json:"source"`
+	TcpFlags []string   `json:"tcp_flags"`
 	// Tos can be one of: []string{"off", "normal", "min_cost", "max_reliable", "max_throughput", "min_delay"}
 	// Tos default value is "off"
-	Tos       string `json:"tos"`
-	Connbytes int    `json:"connbytes"`
-	// ConnbytesUpperlimit default value is false
-	ConnbytesUpperlimit bool `json:"connbytes_upperlimit"`
-	DscpValue           int  `json:"dscp_value"`
-	// Helper description: (REGEX)
-	// Helper default value is ""
-	Helper   string        `json:"helper"`
-	TcpFlags []interface{} `json:"tcp_flags"`
-	Comment  string        `json:"comment"`
-	// Destination description: REF(network/*)
-	Destination string `json:"destination"`
-	// DscpString can be one of: []string{"BE", "AF11", "AF12", "AF13", "AF21", "AF22", "AF23", "AF31", "AF32", "AF33", "AF41", "AF42", "AF43", "CS1", "CS2", "CS3", "CS4", "CS5", "CS6", "CS7", "EF"}
-	// DscpString default value is "BE"
-	DscpString string `json:"dscp_string"`
-	Name       string `json:"name"`
+	Tos QosTrafficSelectorTos `json:"tos"`
+}
+
+// QosTrafficSelectorDscpString is the DscpString of a QosTrafficSelector
+type QosTrafficSelectorDscpString string
+
+// Known values of QosTrafficSelectorDscpString
+const (
+	QosTrafficSelectorDscpStringBE   QosTrafficSelectorDscpString = "BE"
+	QosTrafficSelectorDscpStringAF11 QosTrafficSelectorDscpString = "AF11"
+	QosTrafficSelectorDscpStringAF12 QosTrafficSelectorDscpString = "AF12"
+	QosTrafficSelectorDscpStringAF13 QosTrafficSelectorDscpString = "AF13"
+	QosTrafficSelectorDscpStringAF21 QosTrafficSelectorDscpString = "AF21"
+	QosTrafficSelectorDscpStringAF22 QosTrafficSelectorDscpString = "AF22"
+	QosTrafficSelectorDscpStringAF23 QosTrafficSelectorDscpString = "AF23"
+	QosTrafficSelectorDscpStringAF31 QosTrafficSelectorDscpString = "AF31"
+	QosTrafficSelectorDscpStringAF32 QosTrafficSelectorDscpString = "AF32"
+	QosTrafficSelectorDscpStringAF33 QosTrafficSelectorDscpString = "AF33"
+	QosTrafficSelectorDscpStringAF41 QosTrafficSelectorDscpString = "AF41"
+	QosTrafficSelectorDscpStringAF42 QosTrafficSelectorDscpString = "AF42"
+	QosTrafficSelectorDscpStringAF43 QosTrafficSelectorDscpString = "AF43"
+	QosTrafficSelectorDscpStringCS1  QosTrafficSelectorDscpString = "CS1"
+	QosTrafficSelectorDscpStringCS2  QosTrafficSelectorDscpString = "CS2"
+	QosTrafficSelectorDscpStringCS3  QosTrafficSelectorDscpString = "CS3"
+	QosTrafficSelectorDscpStringCS4  QosTrafficSelectorDscpString = "CS4"
+	QosTrafficSelectorDscpStringCS5  QosTrafficSelectorDscpString = "CS5"
+	QosTrafficSelectorDscpStringCS6  QosTrafficSelectorDscpString = "CS6"
+	QosTrafficSelectorDscpStringCS7  QosTrafficSelectorDscpString = "CS7"
+	QosTrafficSelectorDscpStringEF   QosTrafficSelectorDscpString = "EF"
+)
+
+// Valid returns true if the value is a known QosTrafficSelectorDscpString
+func (v QosTrafficSelectorDscpString) Valid() bool {
+	switch v {
+	case QosTrafficSelectorDscpStringBE, QosTrafficSelectorDscpStringAF11, QosTrafficSelectorDscpStringAF12, QosTrafficSelectorDscpStringAF13, QosTrafficSelectorDscpStringAF21, QosTrafficSelectorDscpStringAF22, QosTrafficSelectorDscpStringAF23, QosTrafficSelectorDscpStringAF31, QosTrafficSelectorDscpStringAF32, QosTrafficSelectorDscpStringAF33, QosTrafficSelectorDscpStringAF41, QosTrafficSelectorDscpStringAF42, QosTrafficSelectorDscpStringAF43, QosTrafficSelectorDscpStringCS1, QosTrafficSelectorDscpStringCS2, QosTrafficSelectorDscpStringCS3, QosTrafficSelectorDscpStringCS4, QosTrafficSelectorDscpStringCS5, QosTrafficSelectorDscpStringCS6, QosTrafficSelectorDscpStringCS7, QosTrafficSelectorDscpStringEF:
+		return true
+	}
+	return false
+}
+
+// QosTrafficSelectorDscpType is the DscpType of a QosTrafficSelector
+type QosTrafficSelectorDscpType string
+
+// Known values of QosTrafficSelectorDscpType
+const (
+	QosTrafficSelectorDscpTypeOff   QosTrafficSelectorDscpType = "off"
+	QosTrafficSelectorDscpTypeValue QosTrafficSelectorDscpType = "value"
+	QosTrafficSelectorDscpTypeClass QosTrafficSelectorDscpType = "class"
+)
+
+// Valid returns true if the value is a known QosTrafficSelectorDscpType
+func (v QosTrafficSelectorDscpType) Valid() bool {
+	switch v {
+	case QosTrafficSelectorDscpTypeOff, QosTrafficSelectorDscpTypeValue, QosTrafficSelectorDscpTypeClass:
+		return true
+	}
+	return false
+}
+
+// QosTrafficSelectorTos is the Tos of a QosTrafficSelector
+type QosTrafficSelectorTos string
+
+// Known values of QosTrafficSelectorTos
+const (
+	QosTrafficSelectorTosOff           QosTrafficSelectorTos = "off"
+	QosTrafficSelectorTosNormal        QosTrafficSelectorTos = "normal"
+	QosTrafficSelectorTosMinCost       QosTrafficSelectorTos = "min_cost"
+	QosTrafficSelectorTosMaxReliable   QosTrafficSelectorTos = "max_reliable"
+	QosTrafficSelectorTosMaxThroughput QosTrafficSelectorTos = "max_throughput"
+	QosTrafficSelectorTosMinDelay      QosTrafficSelectorTos = "min_delay"
+)
+
+// Valid returns true if the value is a known QosTrafficSelectorTos
+func (v QosTrafficSelectorTos) Valid() bool {
+	switch v {
+	case QosTrafficSelectorTosOff, QosTrafficSelectorTosNormal, QosTrafficSelectorTosMinCost, QosTrafficSelectorTosMaxReliable, QosTrafficSelectorTosMaxThroughput, QosTrafficSelectorTosMinDelay:
+		return true
+	}
+	return false
+}
+
+// NewQosTrafficSelector returns a QosTrafficSelector with the default values of its swagger definition
+func NewQosTrafficSelector() *QosTrafficSelector {
+	return &QosTrafficSelector{
+		ObjectType: "qos/traffic_selector",
+		DscpString: QosTrafficSelectorDscpStringBE,
+		DscpType:   QosTrafficSelectorDscpTypeOff,
+		Service:    "REF_ServiceAny",
+		Tos:        QosTrafficSelectorTosOff,
+	}
+}
+
+// Validate checks the QosTrafficSelector before it is sent, see ValidateWith
+func (q *QosTrafficSelector) Validate() error { return q.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the QosTrafficSelector.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (q *QosTrafficSelector) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("QosTrafficSelector", typeOf)
+	check.Required("destination", string(q.Destination))
+	check.Ref("destination", string(q.Destination), "REF(network/*)")
+	check.Enum("dscp_string", string(q.DscpString), q.DscpString.Valid())
+	check.Enum("dscp_type", string(q.DscpType), q.DscpType.Valid())
+	check.Required("name", q.Name)
+	check.Ref("service", string(q.Service), "REF(service/*)")
+	check.Required("source", string(q.Source))
+	check.Ref("source", string(q.Source), "REF(network/*)")
+	check.Enum("tos", string(q.Tos), q.Tos.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &QosTrafficSelector{}
 
-// GetPath implements sophos.RestObject and returns the QosTrafficSelectors GET path
+// GetPath implements sophos.RestGetter and returns the QosTrafficSelectors GET path
 // Returns all available qos/traffic_selector objects
 func (*QosTrafficSelectors) GetPath() string { return "/api/objects/qos/traffic_selector/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*QosTrafficSelectors) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the QosTrafficSelectors GET path
+// GetPath implements sophos.RestGetter and returns the QosTrafficSelectors GET path
 // Returns all available traffic_selector types
 func (q *QosTrafficSelector) GetPath() string {
 	return fmt.Sprintf("/api/objects/qos/traffic_selector/%s", q.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (q *QosTrafficSelector) RefRequired() (string, bool) { return q.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the QosTrafficSelector DELETE path
+// DeletePath implements sophos.Deletable and returns the QosTrafficSelector DELETE path
 // Creates or updates the complete object traffic_selector
 func (*QosTrafficSelector) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/traffic_selector/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the QosTrafficSelector PATCH path
+// PatchPath implements sophos.Patchable and returns the QosTrafficSelector PATCH path
 // Changes to parts of the object traffic_selector types
 func (*QosTrafficSelector) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/traffic_selector/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the QosTrafficSelector POST path
+// PostPath implements sophos.Creatable and returns the QosTrafficSelector POST path
 // Create a new qos/traffic_selector object
 func (*QosTrafficSelector) PostPath() string {
 	return "/api/objects/qos/traffic_selector/"
 }
 
-// PutPath implements sophos.RestObject and returns the QosTrafficSelector PUT path
+// PutPath implements sophos.Updatable and returns the QosTrafficSelector PUT path
 // Creates or updates the complete object traffic_selector
 func (*QosTrafficSelector) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/traffic_selector/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*QosTrafficSelector) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/traffic_selector/%s/usedby", ref)
 }
 
-// QosTrafficSelectorGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (q *QosTrafficSelector) GetType() string { return q.ObjectType }
+
+// QosTrafficSelectorGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type QosTrafficSelectorGroups []QosTrafficSelectorGroup
 
 // QosTrafficSelectorGroup represents a UTM QoS traffic selector group
 type QosTrafficSelectorGroup struct {
-	Locked     string        `json:"_locked"`
-	ObjectType string        `json:"_type"`
-	Reference  string        `json:"_ref"`
-	Comment    string        `json:"comment"`
-	Members    []interface{} `json:"members"`
-	Name       string        `json:"name"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Comment    string   `json:"comment"`
+	Members    []string `json:"members"`
+	Name       string   `json:"name"`
+}
+
+// NewQosTrafficSelectorGroup returns a QosTrafficSelectorGroup with the default values of its swagger definition
+func NewQosTrafficSelectorGroup() *QosTrafficSelectorGroup {
+	return &QosTrafficSelectorGroup{
+		ObjectType: "qos/traffic_selector_group",
+	}
+}
+
+// Validate checks the QosTrafficSelectorGroup before it is sent, see ValidateWith
+func (q *QosTrafficSelectorGroup) Validate() error { return q.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the QosTrafficSelectorGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (q *QosTrafficSelectorGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("QosTrafficSelectorGroup", typeOf)
+	check.Required("name", q.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &QosTrafficSelectorGroup{}
 
-// GetPath implements sophos.RestObject and returns the QosTrafficSelectorGroups GET path
+// GetPath implements sophos.RestGetter and returns the QosTrafficSelectorGroups GET path
 // Returns all available qos/traffic_selector_group objects
 func (*QosTrafficSelectorGroups) GetPath() string { return "/api/objects/qos/traffic_selector_group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*QosTrafficSelectorGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the QosTrafficSelectorGroups GET path
+// GetPath implements sophos.RestGetter and returns the QosTrafficSelectorGroups GET path
 // Returns all available traffic_selector_group types
 func (q *QosTrafficSelectorGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/qos/traffic_selector_group/%s", q.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (q *QosTrafficSelectorGroup) RefRequired() (string, bool) { return q.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the QosTrafficSelectorGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the QosTrafficSelectorGroup DELETE path
 // Creates or updates the complete object traffic_selector_group
 func (*QosTrafficSelectorGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/traffic_selector_group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the QosTrafficSelectorGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the QosTrafficSelectorGroup PATCH path
 // Changes to parts of the object traffic_selector_group types
 func (*QosTrafficSelectorGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/traffic_selector_group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the QosTrafficSelectorGroup POST path
+// PostPath implements sophos.Creatable and returns the QosTrafficSelectorGroup POST path
 // Create a new qos/traffic_selector_group object
 func (*QosTrafficSelectorGroup) PostPath() string {
 	return "/api/objects/qos/traffic_selector_group/"
 }
 
-// PutPath implements sophos.RestObject and returns the QosTrafficSelectorGroup PUT path
+// PutPath implements sophos.Updatable and returns the QosTrafficSelectorGroup PUT path
 // Creates or updates the complete object traffic_selector_group
 func (*QosTrafficSelectorGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/traffic_selector_group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*QosTrafficSelectorGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/qos/traffic_selector_group/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (q *QosTrafficSelectorGroup) GetType() string { return q.ObjectType }
@@ -0,0 +1,1077 @@
+package objects
+
+// AaaRef is a Reference to an object of the aaa class
+type AaaRef string
+
+// AaaRefs are References to objects of the aaa class
+type AaaRefs []string
+
+// AaaObject is implemented by the objects of the aaa class, see AaaRef
+type AaaObject interface {
+	AaaRef() AaaRef
+}
+
+// Set sets the Reference to the object
+func (r *AaaRef) Set(o AaaObject) { *r = o.AaaRef() }
+
+// Class implements sophos.ClassRef
+func (AaaRef) Class() string { return "aaa" }
+
+// Add appends the References of the objects
+func (r *AaaRefs) Add(oo ...AaaObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.AaaRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (AaaRefs) Class() string { return "aaa" }
+
+// AaaRef implements AaaObject
+func (a *AaaGroup) AaaRef() AaaRef { return AaaRef(a.Reference) }
+
+// AaaRef implements AaaObject
+func (a *AaaUser) AaaRef() AaaRef { return AaaRef(a.Reference) }
+
+// AweRef is a Reference to an object of the awe class
+type AweRef string
+
+// AweRefs are References to objects of the awe class
+type AweRefs []string
+
+// AweObject is implemented by the objects of the awe class, see AweRef
+type AweObject interface {
+	AweRef() AweRef
+}
+
+// Set sets the Reference to the object
+func (r *AweRef) Set(o AweObject) { *r = o.AweRef() }
+
+// Class implements sophos.ClassRef
+func (AweRef) Class() string { return "awe" }
+
+// Add appends the References of the objects
+func (r *AweRefs) Add(oo ...AweObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.AweRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (AweRefs) Class() string { return "awe" }
+
+// AweRef implements AweObject
+func (a *AweClient) AweRef() AweRef { return AweRef(a.Reference) }
+
+// AweRef implements AweObject
+func (a *AweDevice) AweRef() AweRef { return AweRef(a.Reference) }
+
+// AweRef implements AweObject
+func (a *AweGroup) AweRef() AweRef { return AweRef(a.Reference) }
+
+// AweRef implements AweObject
+func (a *AweLocal) AweRef() AweRef { return AweRef(a.Reference) }
+
+// AweRef implements AweObject
+func (a *AweRed) AweRef() AweRef { return AweRef(a.Reference) }
+
+// AwsRef is a Reference to an object of the aws class
+type AwsRef string
+
+// AwsRefs are References to objects of the aws class
+type AwsRefs []string
+
+// AwsObject is implemented by the objects of the aws class, see AwsRef
+type AwsObject interface {
+	AwsRef() AwsRef
+}
+
+// Set sets the Reference to the object
+func (r *AwsRef) Set(o AwsObject) { *r = o.AwsRef() }
+
+// Class implements sophos.ClassRef
+func (AwsRef) Class() string { return "aws" }
+
+// Add appends the References of the objects
+func (r *AwsRefs) Add(oo ...AwsObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.AwsRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (AwsRefs) Class() string { return "aws" }
+
+// AwsRef implements AwsObject
+func (a *AwsGroup) AwsRef() AwsRef { return AwsRef(a.Reference) }
+
+// AwsRef implements AwsObject
+func (a *AwsInstanceType) AwsRef() AwsRef { return AwsRef(a.Reference) }
+
+// AwsRef implements AwsObject
+func (a *AwsRegion) AwsRef() AwsRef { return AwsRef(a.Reference) }
+
+// BgpRef is a Reference to an object of the bgp class
+type BgpRef string
+
+// BgpRefs are References to objects of the bgp class
+type BgpRefs []string
+
+// BgpObject is implemented by the objects of the bgp class, see BgpRef
+type BgpObject interface {
+	BgpRef() BgpRef
+}
+
+// Set sets the Reference to the object
+func (r *BgpRef) Set(o BgpObject) { *r = o.BgpRef() }
+
+// Class implements sophos.ClassRef
+func (BgpRef) Class() string { return "bgp" }
+
+// Add appends the References of the objects
+func (r *BgpRefs) Add(oo ...BgpObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.BgpRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (BgpRefs) Class() string { return "bgp" }
+
+// BgpRef implements BgpObject
+func (b *BgpAmazonVpc) BgpRef() BgpRef { return BgpRef(b.Reference) }
+
+// BgpRef implements BgpObject
+func (b *BgpFilter) BgpRef() BgpRef { return BgpRef(b.Reference) }
+
+// BgpRef implements BgpObject
+func (b *BgpGroup) BgpRef() BgpRef { return BgpRef(b.Reference) }
+
+// BgpRef implements BgpObject
+func (b *BgpNeighbor) BgpRef() BgpRef { return BgpRef(b.Reference) }
+
+// BgpRef implements BgpObject
+func (b *BgpRouteMap) BgpRef() BgpRef { return BgpRef(b.Reference) }
+
+// BgpRef implements BgpObject
+func (b *BgpSystem) BgpRef() BgpRef { return BgpRef(b.Reference) }
+
+// CaRef is a Reference to an object of the ca class
+type CaRef string
+
+// CaRefs are References to objects of the ca class
+type CaRefs []string
+
+// CaObject is implemented by the objects of the ca class, see CaRef
+type CaObject interface {
+	CaRef() CaRef
+}
+
+// Set sets the Reference to the object
+func (r *CaRef) Set(o CaObject) { *r = o.CaRef() }
+
+// Class implements sophos.ClassRef
+func (CaRef) Class() string { return "ca" }
+
+// Add appends the References of the objects
+func (r *CaRefs) Add(oo ...CaObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.CaRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (CaRefs) Class() string { return "ca" }
+
+// CaRef implements CaObject
+func (c *CaCrl) CaRef() CaRef { return CaRef(c.Reference) }
+
+// CaRef implements CaObject
+func (c *CaGroup) CaRef() CaRef { return CaRef(c.Reference) }
+
+// CaRef implements CaObject
+func (c *CaHostCert) CaRef() CaRef { return CaRef(c.Reference) }
+
+// CaRef implements CaObject
+func (c *CaHostKeyCert) CaRef() CaRef { return CaRef(c.Reference) }
+
+// CaRef implements CaObject
+func (c *CaHttpVerificationCa) CaRef() CaRef { return CaRef(c.Reference) }
+
+// CaRef implements CaObject
+func (c *CaMetaCrl) CaRef() CaRef { return CaRef(c.Reference) }
+
+// CaRef implements CaObject
+func (c *CaMetaX509) CaRef() CaRef { return CaRef(c.Reference) }
+
+// CaRef implements CaObject
+func (c *CaRsa) CaRef() CaRef { return CaRef(c.Reference) }
+
+// CaRef implements CaObject
+func (c *CaSigningCa) CaRef() CaRef { return CaRef(c.Reference) }
+
+// CaRef implements CaObject
+func (c *CaVerificationCa) CaRef() CaRef { return CaRef(c.Reference) }
+
+// ConditionRef is a Reference to an object of the condition class
+type ConditionRef string
+
+// ConditionRefs are References to objects of the condition class
+type ConditionRefs []string
+
+// ConditionObject is implemented by the objects of the condition class, see ConditionRef
+type ConditionObject interface {
+	ConditionRef() ConditionRef
+}
+
+// Set sets the Reference to the object
+func (r *ConditionRef) Set(o ConditionObject) { *r = o.ConditionRef() }
+
+// Class implements sophos.ClassRef
+func (ConditionRef) Class() string { return "condition" }
+
+// Add appends the References of the objects
+func (r *ConditionRefs) Add(oo ...ConditionObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.ConditionRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (ConditionRefs) Class() string { return "condition" }
+
+// ConditionRef implements ConditionObject
+func (c *ConditionGroup) ConditionRef() ConditionRef { return ConditionRef(c.Reference) }
+
+// ConditionRef implements ConditionObject
+func (c *ConditionObjref) ConditionRef() ConditionRef { return ConditionRef(c.Reference) }
+
+// EmailpkiRef is a Reference to an object of the emailpki class
+type EmailpkiRef string
+
+// EmailpkiRefs are References to objects of the emailpki class
+type EmailpkiRefs []string
+
+// EmailpkiObject is implemented by the objects of the emailpki class, see EmailpkiRef
+type EmailpkiObject interface {
+	EmailpkiRef() EmailpkiRef
+}
+
+// Set sets the Reference to the object
+func (r *EmailpkiRef) Set(o EmailpkiObject) { *r = o.EmailpkiRef() }
+
+// Class implements sophos.ClassRef
+func (EmailpkiRef) Class() string { return "emailpki" }
+
+// Add appends the References of the objects
+func (r *EmailpkiRefs) Add(oo ...EmailpkiObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.EmailpkiRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (EmailpkiRefs) Class() string { return "emailpki" }
+
+// EmailpkiRef implements EmailpkiObject
+func (e *EmailpkiGroup) EmailpkiRef() EmailpkiRef { return EmailpkiRef(e.Reference) }
+
+// EmailpkiRef implements EmailpkiObject
+func (e *EmailpkiOpenpgp) EmailpkiRef() EmailpkiRef { return EmailpkiRef(e.Reference) }
+
+// EmailpkiRef implements EmailpkiObject
+func (e *EmailpkiSmime) EmailpkiRef() EmailpkiRef { return EmailpkiRef(e.Reference) }
+
+// EmailpkiRef implements EmailpkiObject
+func (e *EmailpkiUser) EmailpkiRef() EmailpkiRef { return EmailpkiRef(e.Reference) }
+
+// EppRef is a Reference to an object of the epp class
+type EppRef string
+
+// EppRefs are References to objects of the epp class
+type EppRefs []string
+
+// EppObject is implemented by the objects of the epp class, see EppRef
+type EppObject interface {
+	EppRef() EppRef
+}
+
+// Set sets the Reference to the object
+func (r *EppRef) Set(o EppObject) { *r = o.EppRef() }
+
+// Class implements sophos.ClassRef
+func (EppRef) Class() string { return "epp" }
+
+// Add appends the References of the objects
+func (r *EppRefs) Add(oo ...EppObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.EppRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (EppRefs) Class() string { return "epp" }
+
+// EppRef implements EppObject
+func (e *EppAvException) EppRef() EppRef { return EppRef(e.Reference) }
+
+// EppRef implements EppObject
+func (e *EppAvPolicy) EppRef() EppRef { return EppRef(e.Reference) }
+
+// EppRef implements EppObject
+func (e *EppDcException) EppRef() EppRef { return EppRef(e.Reference) }
+
+// EppRef implements EppObject
+func (e *EppDcPolicy) EppRef() EppRef { return EppRef(e.Reference) }
+
+// EppRef implements EppObject
+func (e *EppDevice) EppRef() EppRef { return EppRef(e.Reference) }
+
+// EppRef implements EppObject
+func (e *EppEndpoint) EppRef() EppRef { return EppRef(e.Reference) }
+
+// EppRef implements EppObject
+func (e *EppEndpointsGroup) EppRef() EppRef { return EppRef(e.Reference) }
+
+// EppRef implements EppObject
+func (e *EppGroup) EppRef() EppRef { return EppRef(e.Reference) }
+
+// HttpRef is a Reference to an object of the http class
+type HttpRef string
+
+// HttpRefs are References to objects of the http class
+type HttpRefs []string
+
+// HttpObject is implemented by the objects of the http class, see HttpRef
+type HttpObject interface {
+	HttpRef() HttpRef
+}
+
+// Set sets the Reference to the object
+func (r *HttpRef) Set(o HttpObject) { *r = o.HttpRef() }
+
+// Class implements sophos.ClassRef
+func (HttpRef) Class() string { return "http" }
+
+// Add appends the References of the objects
+func (r *HttpRefs) Add(oo ...HttpObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.HttpRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (HttpRefs) Class() string { return "http" }
+
+// HttpRef implements HttpObject
+func (h *HttpCffAction) HttpRef() HttpRef { return HttpRef(h.Reference) }
+
+// HttpRef implements HttpObject
+func (h *HttpCffProfile) HttpRef() HttpRef { return HttpRef(h.Reference) }
+
+// HttpRef implements HttpObject
+func (h *HttpDeviceAuth) HttpRef() HttpRef { return HttpRef(h.Reference) }
+
+// HttpRef implements HttpObject
+func (h *HttpDomainRegex) HttpRef() HttpRef { return HttpRef(h.Reference) }
+
+// HttpRef implements HttpObject
+func (h *HttpException) HttpRef() HttpRef { return HttpRef(h.Reference) }
+
+// HttpRef implements HttpObject
+func (h *HttpGroup) HttpRef() HttpRef { return HttpRef(h.Reference) }
+
+// HttpRef implements HttpObject
+func (h *HttpLocalSite) HttpRef() HttpRef { return HttpRef(h.Reference) }
+
+// HttpRef implements HttpObject
+func (h *HttpLslTag) HttpRef() HttpRef { return HttpRef(h.Reference) }
+
+// HttpRef implements HttpObject
+func (h *HttpPacFile) HttpRef() HttpRef { return HttpRef(h.Reference) }
+
+// HttpRef implements HttpObject
+func (h *HttpParentProxy) HttpRef() HttpRef { return HttpRef(h.Reference) }
+
+// HttpRef implements HttpObject
+func (h *HttpProfile) HttpRef() HttpRef { return HttpRef(h.Reference) }
+
+// HttpRef implements HttpObject
+func (h *HttpSpCategory) HttpRef() HttpRef { return HttpRef(h.Reference) }
+
+// HttpRef implements HttpObject
+func (h *HttpSpSubcat) HttpRef() HttpRef { return HttpRef(h.Reference) }
+
+// InterfaceRef is a Reference to an object of the interface class
+type InterfaceRef string
+
+// InterfaceRefs are References to objects of the interface class
+type InterfaceRefs []string
+
+// InterfaceObject is implemented by the objects of the interface class, see InterfaceRef
+type InterfaceObject interface {
+	InterfaceRef() InterfaceRef
+}
+
+// Set sets the Reference to the object
+func (r *InterfaceRef) Set(o InterfaceObject) { *r = o.InterfaceRef() }
+
+// Class implements sophos.ClassRef
+func (InterfaceRef) Class() string { return "interface" }
+
+// Add appends the References of the objects
+func (r *InterfaceRefs) Add(oo ...InterfaceObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.InterfaceRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (InterfaceRefs) Class() string { return "interface" }
+
+// InterfaceRef implements InterfaceObject
+func (i *InterfaceBridge) InterfaceRef() InterfaceRef { return InterfaceRef(i.Reference) }
+
+// InterfaceRef implements InterfaceObject
+func (i *InterfaceEthernet) InterfaceRef() InterfaceRef { return InterfaceRef(i.Reference) }
+
+// InterfaceRef implements InterfaceObject
+func (i *InterfaceGroup) InterfaceRef() InterfaceRef { return InterfaceRef(i.Reference) }
+
+// InterfaceRef implements InterfaceObject
+func (i *InterfacePpp3G) InterfaceRef() InterfaceRef { return InterfaceRef(i.Reference) }
+
+// InterfaceRef implements InterfaceObject
+func (i *InterfacePppmodem) InterfaceRef() InterfaceRef { return InterfaceRef(i.Reference) }
+
+// InterfaceRef implements InterfaceObject
+func (i *InterfacePppoa) InterfaceRef() InterfaceRef { return InterfaceRef(i.Reference) }
+
+// InterfaceRef implements InterfaceObject
+func (i *InterfacePppoe) InterfaceRef() InterfaceRef { return InterfaceRef(i.Reference) }
+
+// InterfaceRef implements InterfaceObject
+func (i *InterfaceTunnel) InterfaceRef() InterfaceRef { return InterfaceRef(i.Reference) }
+
+// InterfaceRef implements InterfaceObject
+func (i *InterfaceVlan) InterfaceRef() InterfaceRef { return InterfaceRef(i.Reference) }
+
+// IpsecRef is a Reference to an object of the ipsec class
+type IpsecRef string
+
+// IpsecRefs are References to objects of the ipsec class
+type IpsecRefs []string
+
+// IpsecObject is implemented by the objects of the ipsec class, see IpsecRef
+type IpsecObject interface {
+	IpsecRef() IpsecRef
+}
+
+// Set sets the Reference to the object
+func (r *IpsecRef) Set(o IpsecObject) { *r = o.IpsecRef() }
+
+// Class implements sophos.ClassRef
+func (IpsecRef) Class() string { return "ipsec" }
+
+// Add appends the References of the objects
+func (r *IpsecRefs) Add(oo ...IpsecObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.IpsecRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (IpsecRefs) Class() string { return "ipsec" }
+
+// IpsecRef implements IpsecObject
+func (i *IpsecGroup) IpsecRef() IpsecRef { return IpsecRef(i.Reference) }
+
+// IpsecRef implements IpsecObject
+func (i *IpsecPolicy) IpsecRef() IpsecRef { return IpsecRef(i.Reference) }
+
+// IpsecRef implements IpsecObject
+func (i *IpsecRemoteGateway) IpsecRef() IpsecRef { return IpsecRef(i.Reference) }
+
+// IpsecRemoteAuthRef is a Reference to an object of the ipsec_remote_auth class
+type IpsecRemoteAuthRef string
+
+// IpsecRemoteAuthRefs are References to objects of the ipsec_remote_auth class
+type IpsecRemoteAuthRefs []string
+
+// IpsecRemoteAuthObject is implemented by the objects of the ipsec_remote_auth class, see IpsecRemoteAuthRef
+type IpsecRemoteAuthObject interface {
+	IpsecRemoteAuthRef() IpsecRemoteAuthRef
+}
+
+// Set sets the Reference to the object
+func (r *IpsecRemoteAuthRef) Set(o IpsecRemoteAuthObject) { *r = o.IpsecRemoteAuthRef() }
+
+// Class implements sophos.ClassRef
+func (IpsecRemoteAuthRef) Class() string { return "ipsec_remote_auth" }
+
+// Add appends the References of the objects
+func (r *IpsecRemoteAuthRefs) Add(oo ...IpsecRemoteAuthObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.IpsecRemoteAuthRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (IpsecRemoteAuthRefs) Class() string { return "ipsec_remote_auth" }
+
+// IpsecRemoteAuthRef implements IpsecRemoteAuthObject
+func (i *IpsecRemoteAuthCa) IpsecRemoteAuthRef() IpsecRemoteAuthRef {
+	return IpsecRemoteAuthRef(i.Reference)
+}
+
+// IpsecRemoteAuthRef implements IpsecRemoteAuthObject
+func (i *IpsecRemoteAuthGroup) IpsecRemoteAuthRef() IpsecRemoteAuthRef {
+	return IpsecRemoteAuthRef(i.Reference)
+}
+
+// IpsecRemoteAuthRef implements IpsecRemoteAuthObject
+func (i *IpsecRemoteAuthPsk) IpsecRemoteAuthRef() IpsecRemoteAuthRef {
+	return IpsecRemoteAuthRef(i.Reference)
+}
+
+// IpsecRemoteAuthRef implements IpsecRemoteAuthObject
+func (i *IpsecRemoteAuthRsa) IpsecRemoteAuthRef() IpsecRemoteAuthRef {
+	return IpsecRemoteAuthRef(i.Reference)
+}
+
+// IpsecRemoteAuthRef implements IpsecRemoteAuthObject
+func (i *IpsecRemoteAuthX509) IpsecRemoteAuthRef() IpsecRemoteAuthRef {
+	return IpsecRemoteAuthRef(i.Reference)
+}
+
+// ItfhwRef is a Reference to an object of the itfhw class
+type ItfhwRef string
+
+// ItfhwRefs are References to objects of the itfhw class
+type ItfhwRefs []string
+
+// ItfhwObject is implemented by the objects of the itfhw class, see ItfhwRef
+type ItfhwObject interface {
+	ItfhwRef() ItfhwRef
+}
+
+// Set sets the Reference to the object
+func (r *ItfhwRef) Set(o ItfhwObject) { *r = o.ItfhwRef() }
+
+// Class implements sophos.ClassRef
+func (ItfhwRef) Class() string { return "itfhw" }
+
+// Add appends the References of the objects
+func (r *ItfhwRefs) Add(oo ...ItfhwObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.ItfhwRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (ItfhwRefs) Class() string { return "itfhw" }
+
+// ItfhwRef implements ItfhwObject
+func (i *ItfhwAweNetwork) ItfhwRef() ItfhwRef { return ItfhwRef(i.Reference) }
+
+// ItfhwRef implements ItfhwObject
+func (i *ItfhwAweNetworkGroup) ItfhwRef() ItfhwRef { return ItfhwRef(i.Reference) }
+
+// ItfhwRef implements ItfhwObject
+func (i *ItfhwBridge) ItfhwRef() ItfhwRef { return ItfhwRef(i.Reference) }
+
+// ItfhwRef implements ItfhwObject
+func (i *ItfhwEthernet) ItfhwRef() ItfhwRef { return ItfhwRef(i.Reference) }
+
+// ItfhwRef implements ItfhwObject
+func (i *ItfhwGroup) ItfhwRef() ItfhwRef { return ItfhwRef(i.Reference) }
+
+// ItfhwRef implements ItfhwObject
+func (i *ItfhwLag) ItfhwRef() ItfhwRef { return ItfhwRef(i.Reference) }
+
+// ItfhwRef implements ItfhwObject
+func (i *ItfhwRedClient) ItfhwRef() ItfhwRef { return ItfhwRef(i.Reference) }
+
+// ItfhwRef implements ItfhwObject
+func (i *ItfhwRedServer) ItfhwRef() ItfhwRef { return ItfhwRef(i.Reference) }
+
+// ItfhwRef implements ItfhwObject
+func (i *ItfhwSerial) ItfhwRef() ItfhwRef { return ItfhwRef(i.Reference) }
+
+// ItfhwRef implements ItfhwObject
+func (i *ItfhwUsbserial) ItfhwRef() ItfhwRef { return ItfhwRef(i.Reference) }
+
+// ItfhwRef implements ItfhwObject
+func (i *ItfhwVirtual) ItfhwRef() ItfhwRef { return ItfhwRef(i.Reference) }
+
+// ItfparamsRef is a Reference to an object of the itfparams class
+type ItfparamsRef string
+
+// ItfparamsRefs are References to objects of the itfparams class
+type ItfparamsRefs []string
+
+// ItfparamsObject is implemented by the objects of the itfparams class, see ItfparamsRef
+type ItfparamsObject interface {
+	ItfparamsRef() ItfparamsRef
+}
+
+// Set sets the Reference to the object
+func (r *ItfparamsRef) Set(o ItfparamsObject) { *r = o.ItfparamsRef() }
+
+// Class implements sophos.ClassRef
+func (ItfparamsRef) Class() string { return "itfparams" }
+
+// Add appends the References of the objects
+func (r *ItfparamsRefs) Add(oo ...ItfparamsObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.ItfparamsRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (ItfparamsRefs) Class() string { return "itfparams" }
+
+// ItfparamsRef implements ItfparamsObject
+func (i *ItfparamsBridgePort) ItfparamsRef() ItfparamsRef { return ItfparamsRef(i.Reference) }
+
+// ItfparamsRef implements ItfparamsObject
+func (i *ItfparamsGroup) ItfparamsRef() ItfparamsRef { return ItfparamsRef(i.Reference) }
+
+// ItfparamsRef implements ItfparamsObject
+func (i *ItfparamsLinkAggregationGroup) ItfparamsRef() ItfparamsRef { return ItfparamsRef(i.Reference) }
+
+// ItfparamsRef implements ItfparamsObject
+func (i *ItfparamsPrimary) ItfparamsRef() ItfparamsRef { return ItfparamsRef(i.Reference) }
+
+// ItfparamsRef implements ItfparamsObject
+func (i *ItfparamsSecondary) ItfparamsRef() ItfparamsRef { return ItfparamsRef(i.Reference) }
+
+// MacListRef is a Reference to an object of the mac_list class
+type MacListRef string
+
+// MacListRefs are References to objects of the mac_list class
+type MacListRefs []string
+
+// MacListObject is implemented by the objects of the mac_list class, see MacListRef
+type MacListObject interface {
+	MacListRef() MacListRef
+}
+
+// Set sets the Reference to the object
+func (r *MacListRef) Set(o MacListObject) { *r = o.MacListRef() }
+
+// Class implements sophos.ClassRef
+func (MacListRef) Class() string { return "mac_list" }
+
+// Add appends the References of the objects
+func (r *MacListRefs) Add(oo ...MacListObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.MacListRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (MacListRefs) Class() string { return "mac_list" }
+
+// MacListRef implements MacListObject
+func (m *MacListGroup) MacListRef() MacListRef { return MacListRef(m.Reference) }
+
+// MacListRef implements MacListObject
+func (m *MacListMacList) MacListRef() MacListRef { return MacListRef(m.Reference) }
+
+// NetworkRef is a Reference to an object of the network class
+type NetworkRef string
+
+// NetworkRefs are References to objects of the network class
+type NetworkRefs []string
+
+// NetworkObject is implemented by the objects of the network class, see NetworkRef
+type NetworkObject interface {
+	NetworkRef() NetworkRef
+}
+
+// Set sets the Reference to the object
+func (r *NetworkRef) Set(o NetworkObject) { *r = o.NetworkRef() }
+
+// Class implements sophos.ClassRef
+func (NetworkRef) Class() string { return "network" }
+
+// Add appends the References of the objects
+func (r *NetworkRefs) Add(oo ...NetworkObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.NetworkRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (NetworkRefs) Class() string { return "network" }
+
+// NetworkRef implements NetworkObject
+func (n *NetworkAaa) NetworkRef() NetworkRef { return NetworkRef(n.Reference) }
+
+// NetworkRef implements NetworkObject
+func (n *NetworkAny) NetworkRef() NetworkRef { return NetworkRef(n.Reference) }
+
+// NetworkRef implements NetworkObject
+func (n *NetworkAvailabilityGroup) NetworkRef() NetworkRef { return NetworkRef(n.Reference) }
+
+// NetworkRef implements NetworkObject
+func (n *NetworkDnsGroup) NetworkRef() NetworkRef { return NetworkRef(n.Reference) }
+
+// NetworkRef implements NetworkObject
+func (n *NetworkDnsHost) NetworkRef() NetworkRef { return NetworkRef(n.Reference) }
+
+// NetworkRef implements NetworkObject
+func (n *NetworkGroup) NetworkRef() NetworkRef { return NetworkRef(n.Reference) }
+
+// NetworkRef implements NetworkObject
+func (n *NetworkHost) NetworkRef() NetworkRef { return NetworkRef(n.Reference) }
+
+// NetworkRef implements NetworkObject
+func (n *NetworkInterfaceAddress) NetworkRef() NetworkRef { return NetworkRef(n.Reference) }
+
+// NetworkRef implements NetworkObject
+func (n *NetworkInterfaceBroadcast) NetworkRef() NetworkRef { return NetworkRef(n.Reference) }
+
+// NetworkRef implements NetworkObject
+func (n *NetworkInterfaceNetwork) NetworkRef() NetworkRef { return NetworkRef(n.Reference) }
+
+// NetworkRef implements NetworkObject
+func (n *NetworkMulticast) NetworkRef() NetworkRef { return NetworkRef(n.Reference) }
+
+// NetworkRef implements NetworkObject
+func (n *NetworkNetwork) NetworkRef() NetworkRef { return NetworkRef(n.Reference) }
+
+// NetworkRef implements NetworkObject
+func (n *NetworkRange) NetworkRef() NetworkRef { return NetworkRef(n.Reference) }
+
+// OverrideRef is a Reference to an object of the override class
+type OverrideRef string
+
+// OverrideRefs are References to objects of the override class
+type OverrideRefs []string
+
+// OverrideObject is implemented by the objects of the override class, see OverrideRef
+type OverrideObject interface {
+	OverrideRef() OverrideRef
+}
+
+// Set sets the Reference to the object
+func (r *OverrideRef) Set(o OverrideObject) { *r = o.OverrideRef() }
+
+// Class implements sophos.ClassRef
+func (OverrideRef) Class() string { return "override" }
+
+// Add appends the References of the objects
+func (r *OverrideRefs) Add(oo ...OverrideObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.OverrideRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (OverrideRefs) Class() string { return "override" }
+
+// OverrideRef implements OverrideObject
+func (o *OverrideGroup) OverrideRef() OverrideRef { return OverrideRef(o.Reference) }
+
+// OverrideRef implements OverrideObject
+func (o *OverrideObjref) OverrideRef() OverrideRef { return OverrideRef(o.Reference) }
+
+// PacketfilterRef is a Reference to an object of the packetfilter class
+type PacketfilterRef string
+
+// PacketfilterRefs are References to objects of the packetfilter class
+type PacketfilterRefs []string
+
+// PacketfilterObject is implemented by the objects of the packetfilter class, see PacketfilterRef
+type PacketfilterObject interface {
+	PacketfilterRef() PacketfilterRef
+}
+
+// Set sets the Reference to the object
+func (r *PacketfilterRef) Set(o PacketfilterObject) { *r = o.PacketfilterRef() }
+
+// Class implements sophos.ClassRef
+func (PacketfilterRef) Class() string { return "packetfilter" }
+
+// Add appends the References of the objects
+func (r *PacketfilterRefs) Add(oo ...PacketfilterObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.PacketfilterRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (PacketfilterRefs) Class() string { return "packetfilter" }
+
+// PacketfilterRef implements PacketfilterObject
+func (p *Packetfilter1to1Nat) PacketfilterRef() PacketfilterRef { return PacketfilterRef(p.Reference) }
+
+// PacketfilterRef implements PacketfilterObject
+func (p *PacketfilterGenericProxy) PacketfilterRef() PacketfilterRef {
+	return PacketfilterRef(p.Reference)
+}
+
+// PacketfilterRef implements PacketfilterObject
+func (p *PacketfilterGroup) PacketfilterRef() PacketfilterRef { return PacketfilterRef(p.Reference) }
+
+// PacketfilterRef implements PacketfilterObject
+func (p *PacketfilterLoadbalance) PacketfilterRef() PacketfilterRef {
+	return PacketfilterRef(p.Reference)
+}
+
+// PacketfilterRef implements PacketfilterObject
+func (p *PacketfilterMangle) PacketfilterRef() PacketfilterRef { return PacketfilterRef(p.Reference) }
+
+// PacketfilterRef implements PacketfilterObject
+func (p *PacketfilterMasq) PacketfilterRef() PacketfilterRef { return PacketfilterRef(p.Reference) }
+
+// PacketfilterRef implements PacketfilterObject
+func (p *PacketfilterNat) PacketfilterRef() PacketfilterRef { return PacketfilterRef(p.Reference) }
+
+// PacketfilterRef implements PacketfilterObject
+func (p *PacketfilterPacketfilter) PacketfilterRef() PacketfilterRef {
+	return PacketfilterRef(p.Reference)
+}
+
+// PacketfilterRef implements PacketfilterObject
+func (p *PacketfilterRuleset) PacketfilterRef() PacketfilterRef { return PacketfilterRef(p.Reference) }
+
+// Pop3Ref is a Reference to an object of the pop3 class
+type Pop3Ref string
+
+// Pop3Refs are References to objects of the pop3 class
+type Pop3Refs []string
+
+// Pop3Object is implemented by the objects of the pop3 class, see Pop3Ref
+type Pop3Object interface {
+	Pop3Ref() Pop3Ref
+}
+
+// Set sets the Reference to the object
+func (r *Pop3Ref) Set(o Pop3Object) { *r = o.Pop3Ref() }
+
+// Class implements sophos.ClassRef
+func (Pop3Ref) Class() string { return "pop3" }
+
+// Add appends the References of the objects
+func (r *Pop3Refs) Add(oo ...Pop3Object) {
+	for _, o := range oo {
+		*r = append(*r, string(o.Pop3Ref()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (Pop3Refs) Class() string { return "pop3" }
+
+// Pop3Ref implements Pop3Object
+func (p *Pop3Account) Pop3Ref() Pop3Ref { return Pop3Ref(p.Reference) }
+
+// Pop3Ref implements Pop3Object
+func (p *Pop3Exception) Pop3Ref() Pop3Ref { return Pop3Ref(p.Reference) }
+
+// Pop3Ref implements Pop3Object
+func (p *Pop3Group) Pop3Ref() Pop3Ref { return Pop3Ref(p.Reference) }
+
+// Pop3Ref implements Pop3Object
+func (p *Pop3Server) Pop3Ref() Pop3Ref { return Pop3Ref(p.Reference) }
+
+// ReverseProxyRef is a Reference to an object of the reverse_proxy class
+type ReverseProxyRef string
+
+// ReverseProxyRefs are References to objects of the reverse_proxy class
+type ReverseProxyRefs []string
+
+// ReverseProxyObject is implemented by the objects of the reverse_proxy class, see ReverseProxyRef
+type ReverseProxyObject interface {
+	ReverseProxyRef() ReverseProxyRef
+}
+
+// Set sets the Reference to the object
+func (r *ReverseProxyRef) Set(o ReverseProxyObject) { *r = o.ReverseProxyRef() }
+
+// Class implements sophos.ClassRef
+func (ReverseProxyRef) Class() string { return "reverse_proxy" }
+
+// Add appends the References of the objects
+func (r *ReverseProxyRefs) Add(oo ...ReverseProxyObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.ReverseProxyRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (ReverseProxyRefs) Class() string { return "reverse_proxy" }
+
+// ReverseProxyRef implements ReverseProxyObject
+func (r *ReverseProxyAuthProfile) ReverseProxyRef() ReverseProxyRef {
+	return ReverseProxyRef(r.Reference)
+}
+
+// ReverseProxyRef implements ReverseProxyObject
+func (r *ReverseProxyBackend) ReverseProxyRef() ReverseProxyRef { return ReverseProxyRef(r.Reference) }
+
+// ReverseProxyRef implements ReverseProxyObject
+func (r *ReverseProxyException) ReverseProxyRef() ReverseProxyRef {
+	return ReverseProxyRef(r.Reference)
+}
+
+// ReverseProxyRef implements ReverseProxyObject
+func (r *ReverseProxyFilter) ReverseProxyRef() ReverseProxyRef { return ReverseProxyRef(r.Reference) }
+
+// ReverseProxyRef implements ReverseProxyObject
+func (r *ReverseProxyFormTemplate) ReverseProxyRef() ReverseProxyRef {
+	return ReverseProxyRef(r.Reference)
+}
+
+// ReverseProxyRef implements ReverseProxyObject
+func (r *ReverseProxyFrontend) ReverseProxyRef() ReverseProxyRef { return ReverseProxyRef(r.Reference) }
+
+// ReverseProxyRef implements ReverseProxyObject
+func (r *ReverseProxyGroup) ReverseProxyRef() ReverseProxyRef { return ReverseProxyRef(r.Reference) }
+
+// ReverseProxyRef implements ReverseProxyObject
+func (r *ReverseProxyLocation) ReverseProxyRef() ReverseProxyRef { return ReverseProxyRef(r.Reference) }
+
+// ReverseProxyRef implements ReverseProxyObject
+func (r *ReverseProxyProfile) ReverseProxyRef() ReverseProxyRef { return ReverseProxyRef(r.Reference) }
+
+// ReverseProxyRef implements ReverseProxyObject
+func (r *ReverseProxyRedirection) ReverseProxyRef() ReverseProxyRef {
+	return ReverseProxyRef(r.Reference)
+}
+
+// ReverseProxyRef implements ReverseProxyObject
+func (r *ReverseProxyThreatsFilter) ReverseProxyRef() ReverseProxyRef {
+	return ReverseProxyRef(r.Reference)
+}
+
+// SchedulerRef is a Reference to an object of the scheduler class
+type SchedulerRef string
+
+// SchedulerRefs are References to objects of the scheduler class
+type SchedulerRefs []string
+
+// SchedulerObject is implemented by the objects of the scheduler class, see SchedulerRef
+type SchedulerObject interface {
+	SchedulerRef() SchedulerRef
+}
+
+// Set sets the Reference to the object
+func (r *SchedulerRef) Set(o SchedulerObject) { *r = o.SchedulerRef() }
+
+// Class implements sophos.ClassRef
+func (SchedulerRef) Class() string { return "scheduler" }
+
+// Add appends the References of the objects
+func (r *SchedulerRefs) Add(oo ...SchedulerObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.SchedulerRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (SchedulerRefs) Class() string { return "scheduler" }
+
+// SchedulerRef implements SchedulerObject
+func (s *SchedulerGroup) SchedulerRef() SchedulerRef { return SchedulerRef(s.Reference) }
+
+// SchedulerRef implements SchedulerObject
+func (s *SchedulerLoadbalance) SchedulerRef() SchedulerRef { return SchedulerRef(s.Reference) }
+
+// SchedulerRef implements SchedulerObject
+func (s *SchedulerRule) SchedulerRef() SchedulerRef { return SchedulerRef(s.Reference) }
+
+// ServiceRef is a Reference to an object of the service class
+type ServiceRef string
+
+// ServiceRefs are References to objects of the service class
+type ServiceRefs []string
+
+// ServiceObject is implemented by the objects of the service class, see ServiceRef
+type ServiceObject interface {
+	ServiceRef() ServiceRef
+}
+
+// Set sets the Reference to the object
+func (r *ServiceRef) Set(o ServiceObject) { *r = o.ServiceRef() }
+
+// Class implements sophos.ClassRef
+func (ServiceRef) Class() string { return "service" }
+
+// Add appends the References of the objects
+func (r *ServiceRefs) Add(oo ...ServiceObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.ServiceRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (ServiceRefs) Class() string { return "service" }
+
+// ServiceRef implements ServiceObject
+func (s *ServiceAh) ServiceRef() ServiceRef { return ServiceRef(s.Reference) }
+
+// ServiceRef implements ServiceObject
+func (s *ServiceAny) ServiceRef() ServiceRef { return ServiceRef(s.Reference) }
+
+// ServiceRef implements ServiceObject
+func (s *ServiceEsp) ServiceRef() ServiceRef { return ServiceRef(s.Reference) }
+
+// ServiceRef implements ServiceObject
+func (s *ServiceGroup) ServiceRef() ServiceRef { return ServiceRef(s.Reference) }
+
+// ServiceRef implements ServiceObject
+func (s *ServiceIcmp) ServiceRef() ServiceRef { return ServiceRef(s.Reference) }
+
+// ServiceRef implements ServiceObject
+func (s *ServiceIcmpv6) ServiceRef() ServiceRef { return ServiceRef(s.Reference) }
+
+// ServiceRef implements ServiceObject
+func (s *ServiceIp) ServiceRef() ServiceRef { return ServiceRef(s.Reference) }
+
+// ServiceRef implements ServiceObject
+func (s *ServiceTcp) ServiceRef() ServiceRef { return ServiceRef(s.Reference) }
+
+// ServiceRef implements ServiceObject
+func (s *ServiceTcpudp) ServiceRef() ServiceRef { return ServiceRef(s.Reference) }
+
+// ServiceRef implements ServiceObject
+func (s *ServiceUdp) ServiceRef() ServiceRef { return ServiceRef(s.Reference) }
+
+// TimeRef is a Reference to an object of the time class
+type TimeRef string
+
+// TimeRefs are References to objects of the time class
+type TimeRefs []string
+
+// TimeObject is implemented by the objects of the time class, see TimeRef
+type TimeObject interface {
+	TimeRef() TimeRef
+}
+
+// Set sets the Reference to the object
+func (r *TimeRef) Set(o TimeObject) { *r = o.TimeRef() }
+
+// Class implements sophos.ClassRef
+func (TimeRef) Class() string { return "time" }
+
+// Add appends the References of the objects
+func (r *TimeRefs) Add(oo ...TimeObject) {
+	for _, o := range oo {
+		*r = append(*r, string(o.TimeRef()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func (TimeRefs) Class() string { return "time" }
+
+// TimeRef implements TimeObject
+func (t *TimeGroup) TimeRef() TimeRef { return TimeRef(t.Reference) }
+
+// TimeRef implements TimeObject
+func (t *TimeRecurring) TimeRef() TimeRef { return TimeRef(t.Reference) }
+
+// TimeRef implements TimeObject
+func (t *TimeSingle) TimeRef() TimeRef { return TimeRef(t.Reference) }
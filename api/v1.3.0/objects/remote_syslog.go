@@ -9,21 +9,21 @@ import (
 // RemoteSyslog is a generated struct representing the Sophos RemoteSyslog Endpoint
 // GET /api/nodes/remote_syslog
 type RemoteSyslog struct {
-	Buffer int64         `json:"buffer"`
-	Logs   []interface{} `json:"logs"`
-	Status int64         `json:"status"`
-	Target []interface{} `json:"target"`
+	Buffer int64    `json:"buffer"`
+	Logs   []string `json:"logs"`
+	Status bool     `json:"status"`
+	Target []string `json:"target"`
 }
 
 var _ sophos.Endpoint = &RemoteSyslog{}
 
-var defsRemoteSyslog = map[string]sophos.RestObject{
+var defsRemoteSyslog = map[string]sophos.RestGetter{
 	"RemoteSyslogGroup":  &RemoteSyslogGroup{},
 	"RemoteSyslogServer": &RemoteSyslogServer{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of RemoteSyslog's Objects
-func (RemoteSyslog) RestObjects() map[string]sophos.RestObject { return defsRemoteSyslog }
+func (RemoteSyslog) RestObjects() map[string]sophos.RestGetter { return defsRemoteSyslog }
 
 // GetPath implements sophos.RestGetter
 func (*RemoteSyslog) GetPath() string { return "/api/nodes/remote_syslog" }
@@ -57,7 +57,7 @@ func (RemoteSyslog) References() []string {
 	}
 }
 
-// RemoteSyslogGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// RemoteSyslogGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type RemoteSyslogGroups []RemoteSyslogGroup
 
 // RemoteSyslogGroup represents a UTM group
@@ -69,55 +69,76 @@ type RemoteSyslogGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewRemoteSyslogGroup returns a RemoteSyslogGroup with the default values of its swagger definition
+func NewRemoteSyslogGroup() *RemoteSyslogGroup {
+	return &RemoteSyslogGroup{
+		ObjectType: "remote_syslog/group",
+	}
+}
+
+// Validate checks the RemoteSyslogGroup before it is sent, see ValidateWith
+func (r *RemoteSyslogGroup) Validate() error { return r.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the RemoteSyslogGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (r *RemoteSyslogGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("RemoteSyslogGroup", typeOf)
+	check.Required("name", r.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &RemoteSyslogGroup{}
 
-// GetPath implements sophos.RestObject and returns the RemoteSyslogGroups GET path
+// GetPath implements sophos.RestGetter and returns the RemoteSyslogGroups GET path
 // Returns all available remote_syslog/group objects
 func (*RemoteSyslogGroups) GetPath() string { return "/api/objects/remote_syslog/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*RemoteSyslogGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the RemoteSyslogGroups GET path
+// GetPath implements sophos.RestGetter and returns the RemoteSyslogGroups GET path
 // Returns all available group types
 func (r *RemoteSyslogGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/remote_syslog/group/%s", r.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (r *RemoteSyslogGroup) RefRequired() (string, bool) { return r.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the RemoteSyslogGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the RemoteSyslogGroup DELETE path
 // Creates or updates the complete object group
 func (*RemoteSyslogGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/remote_syslog/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the RemoteSyslogGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the RemoteSyslogGroup PATCH path
 // Changes to parts of the object group types
 func (*RemoteSyslogGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/remote_syslog/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the RemoteSyslogGroup POST path
+// PostPath implements sophos.Creatable and returns the RemoteSyslogGroup POST path
 // Create a new remote_syslog/group object
 func (*RemoteSyslogGroup) PostPath() string {
 	return "/api/objects/remote_syslog/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the RemoteSyslogGroup PUT path
+// PutPath implements sophos.Updatable and returns the RemoteSyslogGroup PUT path
 // Creates or updates the complete object group
 func (*RemoteSyslogGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/remote_syslog/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*RemoteSyslogGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/remote_syslog/group/%s/usedby", ref)
 }
 
-// RemoteSyslogServers is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (r *RemoteSyslogGroup) GetType() string { return r.ObjectType }
+
+// RemoteSyslogServers is an Sophos Endpoint subType and implements sophos.RestGetter
 type RemoteSyslogServers []RemoteSyslogServer
 
 // RemoteSyslogServer represents a UTM remote syslog server
@@ -128,59 +149,86 @@ type RemoteSyslogServer struct {
 	Comment    string `json:"comment"`
 	// LocalAddr description: REF(network/interface_address), REF(network/any)
 	// LocalAddr default value is "REF_NetworkAny"
-	LocalAddr string `json:"local_addr"`
-	Name      string `json:"name"`
+	LocalAddr NetworkRef `json:"local_addr"`
+	Name      string     `json:"name"`
 	// Port description: REF(service/tcp), REF(service/udp)
 	// Port default value is "REF_SEzkPqGizE"
-	Port string `json:"port"`
+	Port ServiceRef `json:"port"`
 	// Server description: REF(network/host), REF(network/dns_host), REF(network/availability_group)
-	Server string `json:"server"`
+	Server NetworkRef `json:"server"`
+}
+
+// NewRemoteSyslogServer returns a RemoteSyslogServer with the default values of its swagger definition
+func NewRemoteSyslogServer() *RemoteSyslogServer {
+	return &RemoteSyslogServer{
+		ObjectType: "remote_syslog/server",
+		LocalAddr:  "REF_NetworkAny",
+		Port:       "REF_SEzkPqGizE",
+	}
+}
+
+// Validate checks the RemoteSyslogServer before it is sent, see ValidateWith
+func (r *RemoteSyslogServer) Validate() error { return r.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the RemoteSyslogServer.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (r *RemoteSyslogServer) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("RemoteSyslogServer", typeOf)
+	check.Ref("local_addr", string(r.LocalAddr), "REF(network/interface_address), REF(network/any)")
+	check.Required("name", r.Name)
+	check.Ref("port", string(r.Port), "REF(service/tcp), REF(service/udp)")
+	check.Required("server", string(r.Server))
+	check.Ref("server", string(r.Server), "REF(network/host), REF(network/dns_host), REF(network/availability_group)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &RemoteSyslogServer{}
 
-// GetPath implements sophos.RestObject and returns the RemoteSyslogServers GET path
+// GetPath implements sophos.RestGetter and returns the RemoteSyslogServers GET path
 // Returns all available remote_syslog/server objects
 func (*RemoteSyslogServers) GetPath() string { return "/api/objects/remote_syslog/server/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*RemoteSyslogServers) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the RemoteSyslogServers GET path
+// GetPath implements sophos.RestGetter and returns the RemoteSyslogServers GET path
 // Returns all available server types
 func (r *RemoteSyslogServer) GetPath() string {
 	return fmt.Sprintf("/api/objects/remote_syslog/server/%s", r.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (r *RemoteSyslogServer) RefRequired() (string, bool) { return r.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the RemoteSyslogServer DELETE path
+// DeletePath implements sophos.Deletable and returns the RemoteSyslogServer DELETE path
 // Creates or updates the complete object server
 func (*RemoteSyslogServer) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/remote_syslog/server/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the RemoteSyslogServer PATCH path
+// PatchPath implements sophos.Patchable and returns the RemoteSyslogServer PATCH path
 // Changes to parts of the object server types
 func (*RemoteSyslogServer) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/remote_syslog/server/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the RemoteSyslogServer POST path
+// PostPath implements sophos.Creatable and returns the RemoteSyslogServer POST path
 // Create a new remote_syslog/server object
 func (*RemoteSyslogServer) PostPath() string {
 	return "/api/objects/remote_syslog/server/"
 }
 
-// PutPath implements sophos.RestObject and returns the RemoteSyslogServer PUT path
+// PutPath implements sophos.Updatable and returns the RemoteSyslogServer PUT path
 // Creates or updates the complete object server
 func (*RemoteSyslogServer) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/remote_syslog/server/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*RemoteSyslogServer) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/remote_syslog/server/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (r *RemoteSyslogServer) GetType() string { return r.ObjectType }
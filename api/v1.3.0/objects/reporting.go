@@ -9,49 +9,49 @@ import (
 // Reporting is a generated struct representing the Sophos Reporting Endpoint
 // GET /api/nodes/reporting
 type Reporting struct {
-	AccountingKeepdays     int64         `json:"accounting_keepdays"`
-	AccountingStatus       int64         `json:"accounting_status"`
-	Anonymizing            int64         `json:"anonymizing"`
-	AppctrlKeepdays        int64         `json:"appctrl_keepdays"`
-	AppctrlStatus          int64         `json:"appctrl_status"`
-	AtpKeepdays            int64         `json:"atp_keepdays"`
-	AtpReset               int64         `json:"atp_reset"`
-	AtpStatus              int64         `json:"atp_status"`
-	AuthenticationKeepdays int64         `json:"authentication_keepdays"`
-	AuthenticationStatus   int64         `json:"authentication_status"`
-	CsvSeparator           string        `json:"csv_separator"`
-	EmailsecurityImport    []interface{} `json:"emailsecurity_import"`
-	EmailsecurityKeepdays  int64         `json:"emailsecurity_keepdays"`
-	EmailsecurityStatus    int64         `json:"emailsecurity_status"`
-	EnableVpnAccounting    int64         `json:"enable_vpn_accounting"`
-	HideAccountingips      []interface{} `json:"hide_accountingips"`
-	HideMailaddresses      []interface{} `json:"hide_mailaddresses"`
-	HideMaildomains        []interface{} `json:"hide_maildomains"`
-	HideNetsecips          []interface{} `json:"hide_netsecips"`
-	HideWebdomains         []interface{} `json:"hide_webdomains"`
-	IpsImport              []interface{} `json:"ips_import"`
-	IpsKeepdays            int64         `json:"ips_keepdays"`
-	IpsStatus              int64         `json:"ips_status"`
-	PacketfilterImport     []interface{} `json:"packetfilter_import"`
-	PacketfilterKeepdays   int64         `json:"packetfilter_keepdays"`
-	PacketfilterStatus     int64         `json:"packetfilter_status"`
-	Password1              string        `json:"password1"`
-	Password2              string        `json:"password2"`
-	SandboxKeepdays        int64         `json:"sandbox_keepdays"`
-	UserlogFromLogs        int64         `json:"userlog_from_logs"`
-	VpnKeepdays            int64         `json:"vpn_keepdays"`
-	VpnStatus              int64         `json:"vpn_status"`
-	WafKeepdays            int64         `json:"waf_keepdays"`
-	WafStatus              int64         `json:"waf_status"`
-	WebsecurityDetail      int64         `json:"websecurity_detail"`
-	WebsecurityImport      []interface{} `json:"websecurity_import"`
-	WebsecurityKeepdays    int64         `json:"websecurity_keepdays"`
-	WebsecurityStatus      int64         `json:"websecurity_status"`
+	AccountingKeepdays     int64                  `json:"accounting_keepdays"`
+	AccountingStatus       bool                   `json:"accounting_status"`
+	Anonymizing            bool                   `json:"anonymizing"`
+	AppctrlKeepdays        int64                  `json:"appctrl_keepdays"`
+	AppctrlStatus          bool                   `json:"appctrl_status"`
+	AtpKeepdays            int64                  `json:"atp_keepdays"`
+	AtpReset               map[string]interface{} `json:"atp_reset"`
+	AtpStatus              bool                   `json:"atp_status"`
+	AuthenticationKeepdays int64                  `json:"authentication_keepdays"`
+	AuthenticationStatus   bool                   `json:"authentication_status"`
+	CsvSeparator           string                 `json:"csv_separator"`
+	EmailsecurityImport    []string               `json:"emailsecurity_import"`
+	EmailsecurityKeepdays  int64                  `json:"emailsecurity_keepdays"`
+	EmailsecurityStatus    bool                   `json:"emailsecurity_status"`
+	EnableVpnAccounting    bool                   `json:"enable_vpn_accounting"`
+	HideAccountingips      []string               `json:"hide_accountingips"`
+	HideMailaddresses      []string               `json:"hide_mailaddresses"`
+	HideMaildomains        []string               `json:"hide_maildomains"`
+	HideNetsecips          []string               `json:"hide_netsecips"`
+	HideWebdomains         []string               `json:"hide_webdomains"`
+	IpsImport              []string               `json:"ips_import"`
+	IpsKeepdays            int64                  `json:"ips_keepdays"`
+	IpsStatus              bool                   `json:"ips_status"`
+	PacketfilterImport     []string               `json:"packetfilter_import"`
+	PacketfilterKeepdays   int64                  `json:"packetfilter_keepdays"`
+	PacketfilterStatus     bool                   `json:"packetfilter_status"`
+	Password1              string                 `json:"password1"`
+	Password2              string                 `json:"password2"`
+	SandboxKeepdays        int64                  `json:"sandbox_keepdays"`
+	UserlogFromLogs        bool                   `json:"userlog_from_logs"`
+	VpnKeepdays            int64                  `json:"vpn_keepdays"`
+	VpnStatus              bool                   `json:"vpn_status"`
+	WafKeepdays            int64                  `json:"waf_keepdays"`
+	WafStatus              bool                   `json:"waf_status"`
+	WebsecurityDetail      map[string]interface{} `json:"websecurity_detail"`
+	WebsecurityImport      []string               `json:"websecurity_import"`
+	WebsecurityKeepdays    int64                  `json:"websecurity_keepdays"`
+	WebsecurityStatus      bool                   `json:"websecurity_status"`
 }
 
 var _ sophos.Endpoint = &Reporting{}
 
-var defsReporting = map[string]sophos.RestObject{
+var defsReporting = map[string]sophos.RestGetter{
 	"ReportingDepartment": &ReportingDepartment{},
 	"ReportingFilter":     &ReportingFilter{},
 	"ReportingGroup":      &ReportingGroup{},
@@ -59,7 +59,7 @@ var defsReporting = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Reporting's Objects
-func (Reporting) RestObjects() map[string]sophos.RestObject { return defsReporting }
+func (Reporting) RestObjects() map[string]sophos.RestGetter { return defsReporting }
 
 // GetPath implements sophos.RestGetter
 func (*Reporting) GetPath() string { return "/api/nodes/reporting" }
@@ -101,134 +101,170 @@ func (Reporting) References() []string {
 	}
 }
 
-// ReportingDepartments is an Sophos Endpoint subType and implements sophos.RestObject
+// ReportingDepartments is an Sophos Endpoint subType and implements sophos.RestGetter
 type ReportingDepartments []ReportingDepartment
 
 // ReportingDepartment represents a UTM department
 type ReportingDepartment struct {
-	Locked     string        `json:"_locked"`
-	ObjectType string        `json:"_type"`
-	Reference  string        `json:"_ref"`
-	Comment    string        `json:"comment"`
-	Name       string        `json:"name"`
-	Networks   []interface{} `json:"networks"`
-	Users      []interface{} `json:"users"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Comment    string   `json:"comment"`
+	Name       string   `json:"name"`
+	Networks   []string `json:"networks"`
+	Users      []string `json:"users"`
+}
+
+// NewReportingDepartment returns a ReportingDepartment with the default values of its swagger definition
+func NewReportingDepartment() *ReportingDepartment {
+	return &ReportingDepartment{
+		ObjectType: "reporting/department",
+	}
+}
+
+// Validate checks the ReportingDepartment before it is sent, see ValidateWith
+func (r *ReportingDepartment) Validate() error { return r.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ReportingDepartment.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (r *ReportingDepartment) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ReportingDepartment", typeOf)
+	check.Required("name", r.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ReportingDepartment{}
 
-// GetPath implements sophos.RestObject and returns the ReportingDepartments GET path
+// GetPath implements sophos.RestGetter and returns the ReportingDepartments GET path
 // Returns all available reporting/department objects
 func (*ReportingDepartments) GetPath() string { return "/api/objects/reporting/department/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ReportingDepartments) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ReportingDepartments GET path
+// GetPath implements sophos.RestGetter and returns the ReportingDepartments GET path
 // Returns all available department types
 func (r *ReportingDepartment) GetPath() string {
 	return fmt.Sprintf("/api/objects/reporting/department/%s", r.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (r *ReportingDepartment) RefRequired() (string, bool) { return r.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ReportingDepartment DELETE path
+// DeletePath implements sophos.Deletable and returns the ReportingDepartment DELETE path
 // Creates or updates the complete object department
 func (*ReportingDepartment) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/department/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ReportingDepartment PATCH path
+// PatchPath implements sophos.Patchable and returns the ReportingDepartment PATCH path
 // Changes to parts of the object department types
 func (*ReportingDepartment) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/department/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ReportingDepartment POST path
+// PostPath implements sophos.Creatable and returns the ReportingDepartment POST path
 // Create a new reporting/department object
 func (*ReportingDepartment) PostPath() string {
 	return "/api/objects/reporting/department/"
 }
 
-// PutPath implements sophos.RestObject and returns the ReportingDepartment PUT path
+// PutPath implements sophos.Updatable and returns the ReportingDepartment PUT path
 // Creates or updates the complete object department
 func (*ReportingDepartment) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/department/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ReportingDepartment) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/department/%s/usedby", ref)
 }
 
-// ReportingFilters is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (r *ReportingDepartment) GetType() string { return r.ObjectType }
+
+// ReportingFilters is an Sophos Endpoint subType and implements sophos.RestGetter
 type ReportingFilters []ReportingFilter
 
-// ReportingFilter is a generated Sophos object
+// ReportingFilter represents a UTM reporting/filter object
 type ReportingFilter struct {
-	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
-	ObjectType string `json:"_type"`
-	Callname   string `json:"callname"`
-	Comment    string `json:"comment"`
-	Department string `json:"department"`
-	Filter     struct {
-		Action string `json:"action"`
-		Info   string `json:"info"`
-	} `json:"filter"`
-	Name      string `json:"name"`
-	SortBy    string `json:"sort_by"`
-	SortDir   string `json:"sort_dir"`
-	Subsystem string `json:"subsystem"`
-	Timeframe string `json:"timeframe"`
-	Top       int64  `json:"top"`
+	Locked     string                 `json:"_locked"`
+	ObjectType string                 `json:"_type"`
+	Reference  string                 `json:"_ref"`
+	Callname   string                 `json:"callname"`
+	Comment    string                 `json:"comment"`
+	Department string                 `json:"department"`
+	Filter     map[string]interface{} `json:"filter"`
+	Name       string                 `json:"name"`
+	SortBy     string                 `json:"sort_by"`
+	SortDir    string                 `json:"sort_dir"`
+	Subsystem  string                 `json:"subsystem"`
+	Timeframe  string                 `json:"timeframe"`
+	Top        int64                  `json:"top"`
+}
+
+// NewReportingFilter returns a ReportingFilter with the default values of its swagger definition
+func NewReportingFilter() *ReportingFilter {
+	return &ReportingFilter{
+		ObjectType: "reporting/filter",
+	}
+}
+
+// Validate checks the ReportingFilter before it is sent, see ValidateWith
+func (r *ReportingFilter) Validate() error { return r.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ReportingFilter.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (r *ReportingFilter) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ReportingFilter", typeOf)
+	check.Required("name", r.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ReportingFilter{}
 
-// GetPath implements sophos.RestObject and returns the ReportingFilters GET path
+// GetPath implements sophos.RestGetter and returns the ReportingFilters GET path
 // Returns all available reporting/filter objects
 func (*ReportingFilters) GetPath() string { return "/api/objects/reporting/filter/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ReportingFilters) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ReportingFilters GET path
+// GetPath implements sophos.RestGetter and returns the ReportingFilters GET path
 // Returns all available filter types
 func (r *ReportingFilter) GetPath() string {
 	return fmt.Sprintf("/api/objects/reporting/filter/%s", r.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (r *ReportingFilter) RefRequired() (string, bool) { return r.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ReportingFilter DELETE path
+// DeletePath implements sophos.Deletable and returns the ReportingFilter DELETE path
 // Creates or updates the complete object filter
 func (*ReportingFilter) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/filter/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ReportingFilter PATCH path
+// PatchPath implements sophos.Patchable and returns the ReportingFilter PATCH path
 // Changes to parts of the object filter types
 func (*ReportingFilter) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/filter/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ReportingFilter POST path
+// PostPath implements sophos.Creatable and returns the ReportingFilter POST path
 // Create a new reporting/filter object
 func (*ReportingFilter) PostPath() string {
 	return "/api/objects/reporting/filter/"
 }
 
-// PutPath implements sophos.RestObject and returns the ReportingFilter PUT path
+// PutPath implements sophos.Updatable and returns the ReportingFilter PUT path
 // Creates or updates the complete object filter
 func (*ReportingFilter) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/filter/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ReportingFilter) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/filter/%s/usedby", ref)
@@ -237,7 +273,7 @@ func (*ReportingFilter) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (r *ReportingFilter) GetType() string { return r.ObjectType }
 
-// ReportingGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// ReportingGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type ReportingGroups []ReportingGroup
 
 // ReportingGroup represents a UTM group
@@ -249,117 +285,180 @@ type ReportingGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewReportingGroup returns a ReportingGroup with the default values of its swagger definition
+func NewReportingGroup() *ReportingGroup {
+	return &ReportingGroup{
+		ObjectType: "reporting/group",
+	}
+}
+
+// Validate checks the ReportingGroup before it is sent, see ValidateWith
+func (r *ReportingGroup) Validate() error { return r.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ReportingGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (r *ReportingGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ReportingGroup", typeOf)
+	check.Required("name", r.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ReportingGroup{}
 
-// GetPath implements sophos.RestObject and returns the ReportingGroups GET path
+// GetPath implements sophos.RestGetter and returns the ReportingGroups GET path
 // Returns all available reporting/group objects
 func (*ReportingGroups) GetPath() string { return "/api/objects/reporting/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ReportingGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ReportingGroups GET path
+// GetPath implements sophos.RestGetter and returns the ReportingGroups GET path
 // Returns all available group types
 func (r *ReportingGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/reporting/group/%s", r.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (r *ReportingGroup) RefRequired() (string, bool) { return r.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ReportingGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the ReportingGroup DELETE path
 // Creates or updates the complete object group
 func (*ReportingGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ReportingGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the ReportingGroup PATCH path
 // Changes to parts of the object group types
 func (*ReportingGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ReportingGroup POST path
+// PostPath implements sophos.Creatable and returns the ReportingGroup POST path
 // Create a new reporting/group object
 func (*ReportingGroup) PostPath() string {
 	return "/api/objects/reporting/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the ReportingGroup PUT path
+// PutPath implements sophos.Updatable and returns the ReportingGroup PUT path
 // Creates or updates the complete object group
 func (*ReportingGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ReportingGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/group/%s/usedby", ref)
 }
 
-// ReportingMails is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (r *ReportingGroup) GetType() string { return r.ObjectType }
+
+// ReportingMails is an Sophos Endpoint subType and implements sophos.RestGetter
 type ReportingMails []ReportingMail
 
 // ReportingMail represents a UTM scheduled report
 type ReportingMail struct {
-	Locked     string `json:"_locked"`
-	ObjectType string `json:"_type"`
-	Reference  string `json:"_ref"`
+	Locked     string   `json:"_locked"`
+	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
+	Comment    string   `json:"comment"`
+	Filters    []string `json:"filters"`
 	// Interval can be one of: []string{"daily", "weekly", "monthly"}
 	// Interval default value is "daily"
-	Interval   string        `json:"interval"`
-	Name       string        `json:"name"`
-	Recipients []interface{} `json:"recipients"`
+	Interval   ReportingMailInterval `json:"interval"`
+	Name       string                `json:"name"`
+	Recipients []string              `json:"recipients"`
 	// Status default value is false
-	Status  bool          `json:"status"`
-	Comment string        `json:"comment"`
-	Filters []interface{} `json:"filters"`
+	Status bool `json:"status"`
+}
+
+// ReportingMailInterval is the Interval of a ReportingMail
+type ReportingMailInterval string
+
+// Known values of ReportingMailInterval
+const (
+	ReportingMailIntervalDaily   ReportingMailInterval = "daily"
+	ReportingMailIntervalWeekly  ReportingMailInterval = "weekly"
+	ReportingMailIntervalMonthly ReportingMailInterval = "monthly"
+)
+
+// Valid returns true if the value is a known ReportingMailInterval
+func (v ReportingMailInterval) Valid() bool {
+	switch v {
+	case ReportingMailIntervalDaily, ReportingMailIntervalWeekly, ReportingMailIntervalMonthly:
+		return true
+	}
+	return false
+}
+
+// NewReportingMail returns a ReportingMail with the default values of its swagger definition
+func NewReportingMail() *ReportingMail {
+	return &ReportingMail{
+		ObjectType: "reporting/mail",
+		Interval:   ReportingMailIntervalDaily,
+	}
+}
+
+// Validate checks the ReportingMail before it is sent, see ValidateWith
+func (r *ReportingMail) Validate() error { return r.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ReportingMail.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (r *ReportingMail) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ReportingMail", typeOf)
+	check.Enum("interval", string(r.Interval), r.Interval.Valid())
+	check.Required("name", r.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ReportingMail{}
 
-// GetPath implements sophos.RestObject and returns the ReportingMails GET path
+// GetPath implements sophos.RestGetter and returns the ReportingMails GET path
 // Returns all available reporting/mail objects
 func (*ReportingMails) GetPath() string { return "/api/objects/reporting/mail/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ReportingMails) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ReportingMails GET path
+// GetPath implements sophos.RestGetter and returns the ReportingMails GET path
 // Returns all available mail types
 func (r *ReportingMail) GetPath() string {
 	return fmt.Sprintf("/api/objects/reporting/mail/%s", r.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (r *ReportingMail) RefRequired() (string, bool) { return r.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ReportingMail DELETE path
+// DeletePath implements sophos.Deletable and returns the ReportingMail DELETE path
 // Creates or updates the complete object mail
 func (*ReportingMail) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/mail/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ReportingMail PATCH path
+// PatchPath implements sophos.Patchable and returns the ReportingMail PATCH path
 // Changes to parts of the object mail types
 func (*ReportingMail) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/mail/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ReportingMail POST path
+// PostPath implements sophos.Creatable and returns the ReportingMail POST path
 // Create a new reporting/mail object
 func (*ReportingMail) PostPath() string {
 	return "/api/objects/reporting/mail/"
 }
 
-// PutPath implements sophos.RestObject and returns the ReportingMail PUT path
+// PutPath implements sophos.Updatable and returns the ReportingMail PUT path
 // Creates or updates the complete object mail
 func (*ReportingMail) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/mail/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ReportingMail) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/reporting/mail/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (r *ReportingMail) GetType() string { return r.ObjectType }
@@ -15,13 +15,13 @@ type Right struct {
 
 var _ sophos.Endpoint = &Right{}
 
-var defsRight = map[string]sophos.RestObject{
+var defsRight = map[string]sophos.RestGetter{
 	"RightGroup": &RightGroup{},
 	"RightRight": &RightRight{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Right's Objects
-func (Right) RestObjects() map[string]sophos.RestObject { return defsRight }
+func (Right) RestObjects() map[string]sophos.RestGetter { return defsRight }
 
 // GetPath implements sophos.RestGetter
 func (*Right) GetPath() string { return "/api/nodes/right" }
@@ -55,7 +55,7 @@ func (Right) References() []string {
 	}
 }
 
-// RightGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// RightGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type RightGroups []RightGroup
 
 // RightGroup represents a UTM group
@@ -63,109 +63,148 @@ type RightGroup struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Name       string `json:"name"`
 	Comment    string `json:"comment"`
+	Name       string `json:"name"`
+}
+
+// NewRightGroup returns a RightGroup with the default values of its swagger definition
+func NewRightGroup() *RightGroup {
+	return &RightGroup{
+		ObjectType: "right/group",
+	}
+}
+
+// Validate checks the RightGroup before it is sent, see ValidateWith
+func (r *RightGroup) Validate() error { return r.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the RightGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (r *RightGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("RightGroup", typeOf)
+	check.Required("name", r.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &RightGroup{}
 
-// GetPath implements sophos.RestObject and returns the RightGroups GET path
+// GetPath implements sophos.RestGetter and returns the RightGroups GET path
 // Returns all available right/group objects
 func (*RightGroups) GetPath() string { return "/api/objects/right/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*RightGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the RightGroups GET path
+// GetPath implements sophos.RestGetter and returns the RightGroups GET path
 // Returns all available group types
 func (r *RightGroup) GetPath() string { return fmt.Sprintf("/api/objects/right/group/%s", r.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (r *RightGroup) RefRequired() (string, bool) { return r.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the RightGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the RightGroup DELETE path
 // Creates or updates the complete object group
 func (*RightGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/right/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the RightGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the RightGroup PATCH path
 // Changes to parts of the object group types
 func (*RightGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/right/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the RightGroup POST path
+// PostPath implements sophos.Creatable and returns the RightGroup POST path
 // Create a new right/group object
 func (*RightGroup) PostPath() string {
 	return "/api/objects/right/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the RightGroup PUT path
+// PutPath implements sophos.Updatable and returns the RightGroup PUT path
 // Creates or updates the complete object group
 func (*RightGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/right/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*RightGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/right/group/%s/usedby", ref)
 }
 
-// RightRights is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (r *RightGroup) GetType() string { return r.ObjectType }
+
+// RightRights is an Sophos Endpoint subType and implements sophos.RestGetter
 type RightRights []RightRight
 
-// RightRight is a generated Sophos object
+// RightRight represents a UTM right/right object
 type RightRight struct {
 	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
 	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	Comment    string `json:"comment"`
 	Name       string `json:"name"`
 }
 
+// NewRightRight returns a RightRight with the default values of its swagger definition
+func NewRightRight() *RightRight {
+	return &RightRight{
+		ObjectType: "right/right",
+	}
+}
+
+// Validate checks the RightRight before it is sent, see ValidateWith
+func (r *RightRight) Validate() error { return r.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the RightRight.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (r *RightRight) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("RightRight", typeOf)
+	check.Required("name", r.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &RightRight{}
 
-// GetPath implements sophos.RestObject and returns the RightRights GET path
+// GetPath implements sophos.RestGetter and returns the RightRights GET path
 // Returns all available right/right objects
 func (*RightRights) GetPath() string { return "/api/objects/right/right/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*RightRights) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the RightRights GET path
+// GetPath implements sophos.RestGetter and returns the RightRights GET path
 // Returns all available right types
 func (r *RightRight) GetPath() string { return fmt.Sprintf("/api/objects/right/right/%s", r.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (r *RightRight) RefRequired() (string, bool) { return r.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the RightRight DELETE path
+// DeletePath implements sophos.Deletable and returns the RightRight DELETE path
 // Creates or updates the complete object right
 func (*RightRight) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/right/right/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the RightRight PATCH path
+// PatchPath implements sophos.Patchable and returns the RightRight PATCH path
 // Changes to parts of the object right types
 func (*RightRight) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/right/right/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the RightRight POST path
+// PostPath implements sophos.Creatable and returns the RightRight POST path
 // Create a new right/right object
 func (*RightRight) PostPath() string {
 	return "/api/objects/right/right/"
 }
 
-// PutPath implements sophos.RestObject and returns the RightRight PUT path
+// PutPath implements sophos.Updatable and returns the RightRight PUT path
 // Creates or updates the complete object right
 func (*RightRight) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/right/right/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*RightRight) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/right/right/%s/usedby", ref)
@@ -15,13 +15,13 @@ type Role struct {
 
 var _ sophos.Endpoint = &Role{}
 
-var defsRole = map[string]sophos.RestObject{
+var defsRole = map[string]sophos.RestGetter{
 	"RoleGroup": &RoleGroup{},
 	"RoleRole":  &RoleRole{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Role's Objects
-func (Role) RestObjects() map[string]sophos.RestObject { return defsRole }
+func (Role) RestObjects() map[string]sophos.RestGetter { return defsRole }
 
 // GetPath implements sophos.RestGetter
 func (*Role) GetPath() string { return "/api/nodes/role" }
@@ -55,7 +55,7 @@ func (Role) References() []string {
 	}
 }
 
-// RoleGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// RoleGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type RoleGroups []RoleGroup
 
 // RoleGroup represents a UTM group
@@ -67,60 +67,81 @@ type RoleGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewRoleGroup returns a RoleGroup with the default values of its swagger definition
+func NewRoleGroup() *RoleGroup {
+	return &RoleGroup{
+		ObjectType: "role/group",
+	}
+}
+
+// Validate checks the RoleGroup before it is sent, see ValidateWith
+func (r *RoleGroup) Validate() error { return r.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the RoleGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (r *RoleGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("RoleGroup", typeOf)
+	check.Required("name", r.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &RoleGroup{}
 
-// GetPath implements sophos.RestObject and returns the RoleGroups GET path
+// GetPath implements sophos.RestGetter and returns the RoleGroups GET path
 // Returns all available role/group objects
 func (*RoleGroups) GetPath() string { return "/api/objects/role/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*RoleGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the RoleGroups GET path
+// GetPath implements sophos.RestGetter and returns the RoleGroups GET path
 // Returns all available group types
 func (r *RoleGroup) GetPath() string { return fmt.Sprintf("/api/objects/role/group/%s", r.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (r *RoleGroup) RefRequired() (string, bool) { return r.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the RoleGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the RoleGroup DELETE path
 // Creates or updates the complete object group
 func (*RoleGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/role/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the RoleGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the RoleGroup PATCH path
 // Changes to parts of the object group types
 func (*RoleGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/role/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the RoleGroup POST path
+// PostPath implements sophos.Creatable and returns the RoleGroup POST path
 // Create a new role/group object
 func (*RoleGroup) PostPath() string {
 	return "/api/objects/role/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the RoleGroup PUT path
+// PutPath implements sophos.Updatable and returns the RoleGroup PUT path
 // Creates or updates the complete object group
 func (*RoleGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/role/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*RoleGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/role/group/%s/usedby", ref)
 }
 
-// RoleRoles is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (r *RoleGroup) GetType() string { return r.ObjectType }
+
+// RoleRoles is an Sophos Endpoint subType and implements sophos.RestGetter
 type RoleRoles []RoleRole
 
-// RoleRole is a generated Sophos object
+// RoleRole represents a UTM role/role object
 type RoleRole struct {
 	Locked         string   `json:"_locked"`
-	Reference      string   `json:"_ref"`
 	ObjectType     string   `json:"_type"`
+	Reference      string   `json:"_ref"`
 	Comment        string   `json:"comment"`
 	Members        []string `json:"members"`
 	Name           string   `json:"name"`
@@ -128,47 +149,65 @@ type RoleRole struct {
 	WebadminAccess bool     `json:"webadmin_access"`
 }
 
+// NewRoleRole returns a RoleRole with the default values of its swagger definition
+func NewRoleRole() *RoleRole {
+	return &RoleRole{
+		ObjectType: "role/role",
+	}
+}
+
+// Validate checks the RoleRole before it is sent, see ValidateWith
+func (r *RoleRole) Validate() error { return r.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the RoleRole.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (r *RoleRole) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("RoleRole", typeOf)
+	check.Required("name", r.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &RoleRole{}
 
-// GetPath implements sophos.RestObject and returns the RoleRoles GET path
+// GetPath implements sophos.RestGetter and returns the RoleRoles GET path
 // Returns all available role/role objects
 func (*RoleRoles) GetPath() string { return "/api/objects/role/role/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*RoleRoles) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the RoleRoles GET path
+// GetPath implements sophos.RestGetter and returns the RoleRoles GET path
 // Returns all available role types
 func (r *RoleRole) GetPath() string { return fmt.Sprintf("/api/objects/role/role/%s", r.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (r *RoleRole) RefRequired() (string, bool) { return r.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the RoleRole DELETE path
+// DeletePath implements sophos.Deletable and returns the RoleRole DELETE path
 // Creates or updates the complete object role
 func (*RoleRole) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/role/role/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the RoleRole PATCH path
+// PatchPath implements sophos.Patchable and returns the RoleRole PATCH path
 // Changes to parts of the object role types
 func (*RoleRole) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/role/role/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the RoleRole POST path
+// PostPath implements sophos.Creatable and returns the RoleRole POST path
 // Create a new role/role object
 func (*RoleRole) PostPath() string {
 	return "/api/objects/role/role/"
 }
 
-// PutPath implements sophos.RestObject and returns the RoleRole PUT path
+// PutPath implements sophos.Updatable and returns the RoleRole PUT path
 // Creates or updates the complete object role
 func (*RoleRole) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/role/role/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*RoleRole) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/role/role/%s/usedby", ref)
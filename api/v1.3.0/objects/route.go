@@ -16,14 +16,14 @@ type Route struct {
 
 var _ sophos.Endpoint = &Route{}
 
-var defsRoute = map[string]sophos.RestObject{
+var defsRoute = map[string]sophos.RestGetter{
 	"RouteGroup":  &RouteGroup{},
 	"RoutePolicy": &RoutePolicy{},
 	"RouteStatic": &RouteStatic{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Route's Objects
-func (Route) RestObjects() map[string]sophos.RestObject { return defsRoute }
+func (Route) RestObjects() map[string]sophos.RestGetter { return defsRoute }
 
 // GetPath implements sophos.RestGetter
 func (*Route) GetPath() string { return "/api/nodes/route" }
@@ -61,7 +61,7 @@ func (Route) References() []string {
 	}
 }
 
-// RouteGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// RouteGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type RouteGroups []RouteGroup
 
 // RouteGroup represents a UTM group
@@ -73,53 +73,74 @@ type RouteGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewRouteGroup returns a RouteGroup with the default values of its swagger definition
+func NewRouteGroup() *RouteGroup {
+	return &RouteGroup{
+		ObjectType: "route/group",
+	}
+}
+
+// Validate checks the RouteGroup before it is sent, see ValidateWith
+func (r *RouteGroup) Validate() error { return r.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the RouteGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (r *RouteGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("RouteGroup", typeOf)
+	check.Required("name", r.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &RouteGroup{}
 
-// GetPath implements sophos.RestObject and returns the RouteGroups GET path
+// GetPath implements sophos.RestGetter and returns the RouteGroups GET path
 // Returns all available route/group objects
 func (*RouteGroups) GetPath() string { return "/api/objects/route/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*RouteGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the RouteGroups GET path
+// GetPath implements sophos.RestGetter and returns the RouteGroups GET path
 // Returns all available group types
 func (r *RouteGroup) GetPath() string { return fmt.Sprintf("/api/objects/route/group/%s", r.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (r *RouteGroup) RefRequired() (string, bool) { return r.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the RouteGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the RouteGroup DELETE path
 // Creates or updates the complete object group
 func (*RouteGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/route/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the RouteGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the RouteGroup PATCH path
 // Changes to parts of the object group types
 func (*RouteGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/route/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the RouteGroup POST path
+// PostPath implements sophos.Creatable and returns the RouteGroup POST path
 // Create a new route/group object
 func (*RouteGroup) PostPath() string {
 	return "/api/objects/route/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the RouteGroup PUT path
+// PutPath implements sophos.Updatable and returns the RouteGroup PUT path
 // Creates or updates the complete object group
 func (*RouteGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/route/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*RouteGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/route/group/%s/usedby", ref)
 }
 
-// RoutePolicys is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (r *RouteGroup) GetType() string { return r.ObjectType }
+
+// RoutePolicys is an Sophos Endpoint subType and implements sophos.RestGetter
 type RoutePolicys []RoutePolicy
 
 // RoutePolicy represents a UTM policy route
@@ -129,78 +150,127 @@ type RoutePolicy struct {
 	Reference  string `json:"_ref"`
 	Comment    string `json:"comment"`
 	// Destination description: REF(network/*)
-	Destination string `json:"destination"`
+	Destination NetworkRef `json:"destination"`
 	// Interface description: REF(interface/*)
-	Interface string `json:"interface"`
-	// Status default value is false
-	Status bool   `json:"status"`
-	Name   string `json:"name"`
+	Interface InterfaceRef `json:"interface"`
+	Name      string       `json:"name"`
 	// Service description: REF(service/*)
-	Service string `json:"service"`
+	Service ServiceRef `json:"service"`
 	// Source description: REF(network/*)
-	Source string `json:"source"`
+	Source NetworkRef `json:"source"`
+	// Status default value is false
+	Status bool `json:"status"`
 	// Target description: REF(/*)
-	Target string `json:"target"`
+	Target sophos.Reference `json:"target"`
 	// Type can be one of: []string{"itf", "host"}
-	Type string `json:"type"`
+	Type RoutePolicyType `json:"type"`
+}
+
+// RoutePolicyType is the Type of a RoutePolicy
+type RoutePolicyType string
+
+// Known values of RoutePolicyType
+const (
+	RoutePolicyTypeItf  RoutePolicyType = "itf"
+	RoutePolicyTypeHost RoutePolicyType = "host"
+)
+
+// Valid returns true if the value is a known RoutePolicyType
+func (v RoutePolicyType) Valid() bool {
+	switch v {
+	case RoutePolicyTypeItf, RoutePolicyTypeHost:
+		return true
+	}
+	return false
+}
+
+// NewRoutePolicy returns a RoutePolicy with the default values of its swagger definition
+func NewRoutePolicy() *RoutePolicy {
+	return &RoutePolicy{
+		ObjectType: "route/policy",
+	}
+}
+
+// Validate checks the RoutePolicy before it is sent, see ValidateWith
+func (r *RoutePolicy) Validate() error { return r.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the RoutePolicy.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (r *RoutePolicy) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("RoutePolicy", typeOf)
+	check.Required("destination", string(r.Destination))
+	check.Ref("destination", string(r.Destination), "REF(network/*)")
+	check.Ref("interface", string(r.Interface), "REF(interface/*)")
+	check.Required("name", r.Name)
+	check.Required("service", string(r.Service))
+	check.Ref("service", string(r.Service), "REF(service/*)")
+	check.Required("source", string(r.Source))
+	check.Ref("source", string(r.Source), "REF(network/*)")
+	check.Required("target", string(r.Target))
+	check.Ref("target", string(r.Target), "REF(/*)")
+	check.Enum("type", string(r.Type), r.Type.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &RoutePolicy{}
 
-// GetPath implements sophos.RestObject and returns the RoutePolicys GET path
+// GetPath implements sophos.RestGetter and returns the RoutePolicys GET path
 // Returns all available route/policy objects
 func (*RoutePolicys) GetPath() string { return "/api/objects/route/policy/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*RoutePolicys) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the RoutePolicys GET path
+// GetPath implements sophos.RestGetter and returns the RoutePolicys GET path
 // Returns all available policy types
 func (r *RoutePolicy) GetPath() string {
 	return fmt.Sprintf("/api/objects/route/policy/%s", r.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (r *RoutePolicy) RefRequired() (string, bool) { return r.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the RoutePolicy DELETE path
+// DeletePath implements sophos.Deletable and returns the RoutePolicy DELETE path
 // Creates or updates the complete object policy
 func (*RoutePolicy) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/route/policy/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the RoutePolicy PATCH path
+// PatchPath implements sophos.Patchable and returns the RoutePolicy PATCH path
 // Changes to parts of the object policy types
 func (*RoutePolicy) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/route/policy/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the RoutePolicy POST path
+// PostPath implements sophos.Creatable and returns the RoutePolicy POST path
 // Create a new route/policy object
 func (*RoutePolicy) PostPath() string {
 	return "/api/objects/route/policy/"
 }
 
-// PutPath implements sophos.RestObject and returns the RoutePolicy PUT path
+// PutPath implements sophos.Updatable and returns the RoutePolicy PUT path
 // Creates or updates the complete object policy
 func (*RoutePolicy) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/route/policy/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*RoutePolicy) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/route/policy/%s/usedby", ref)
 }
 
-// RouteStatics is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (r *RoutePolicy) GetType() string { return r.ObjectType }
+
+// RouteStatics is an Sophos Endpoint subType and implements sophos.RestGetter
 type RouteStatics []RouteStatic
 
-// RouteStatic is a generated Sophos object
+// RouteStatic represents a UTM route/static object
 type RouteStatic struct {
 	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
 	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	Comment    string `json:"comment"`
 	Metric     int64  `json:"metric"`
 	Name       string `json:"name"`
@@ -210,49 +280,67 @@ type RouteStatic struct {
 	Type       string `json:"type"`
 }
 
+// NewRouteStatic returns a RouteStatic with the default values of its swagger definition
+func NewRouteStatic() *RouteStatic {
+	return &RouteStatic{
+		ObjectType: "route/static",
+	}
+}
+
+// Validate checks the RouteStatic before it is sent, see ValidateWith
+func (r *RouteStatic) Validate() error { return r.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the RouteStatic.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (r *RouteStatic) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("RouteStatic", typeOf)
+	check.Required("name", r.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &RouteStatic{}
 
-// GetPath implements sophos.RestObject and returns the RouteStatics GET path
+// GetPath implements sophos.RestGetter and returns the RouteStatics GET path
 // Returns all available route/static objects
 func (*RouteStatics) GetPath() string { return "/api/objects/route/static/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*RouteStatics) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the RouteStatics GET path
+// GetPath implements sophos.RestGetter and returns the RouteStatics GET path
 // Returns all available static types
 func (r *RouteStatic) GetPath() string {
 	return fmt.Sprintf("/api/objects/route/static/%s", r.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (r *RouteStatic) RefRequired() (string, bool) { return r.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the RouteStatic DELETE path
+// DeletePath implements sophos.Deletable and returns the RouteStatic DELETE path
 // Creates or updates the complete object static
 func (*RouteStatic) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/route/static/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the RouteStatic PATCH path
+// PatchPath implements sophos.Patchable and returns the RouteStatic PATCH path
 // Changes to parts of the object static types
 func (*RouteStatic) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/route/static/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the RouteStatic POST path
+// PostPath implements sophos.Creatable and returns the RouteStatic POST path
 // Create a new route/static object
 func (*RouteStatic) PostPath() string {
 	return "/api/objects/route/static/"
 }
 
-// PutPath implements sophos.RestObject and returns the RouteStatic PUT path
+// PutPath implements sophos.Updatable and returns the RouteStatic PUT path
 // Creates or updates the complete object static
 func (*RouteStatic) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/route/static/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*RouteStatic) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/route/static/%s/usedby", ref)
@@ -16,14 +16,14 @@ type Scheduler struct {
 
 var _ sophos.Endpoint = &Scheduler{}
 
-var defsScheduler = map[string]sophos.RestObject{
+var defsScheduler = map[string]sophos.RestGetter{
 	"SchedulerGroup":       &SchedulerGroup{},
 	"SchedulerLoadbalance": &SchedulerLoadbalance{},
 	"SchedulerRule":        &SchedulerRule{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Scheduler's Objects
-func (Scheduler) RestObjects() map[string]sophos.RestObject { return defsScheduler }
+func (Scheduler) RestObjects() map[string]sophos.RestGetter { return defsScheduler }
 
 // GetPath implements sophos.RestGetter
 func (*Scheduler) GetPath() string { return "/api/nodes/scheduler" }
@@ -61,7 +61,7 @@ func (Scheduler) References() []string {
 	}
 }
 
-// SchedulerGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// SchedulerGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type SchedulerGroups []SchedulerGroup
 
 // SchedulerGroup represents a UTM group
@@ -73,121 +73,161 @@ type SchedulerGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewSchedulerGroup returns a SchedulerGroup with the default values of its swagger definition
+func NewSchedulerGroup() *SchedulerGroup {
+	return &SchedulerGroup{
+		ObjectType: "scheduler/group",
+	}
+}
+
+// Validate checks the SchedulerGroup before it is sent, see ValidateWith
+func (s *SchedulerGroup) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the SchedulerGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *SchedulerGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("SchedulerGroup", typeOf)
+	check.Required("name", s.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &SchedulerGroup{}
 
-// GetPath implements sophos.RestObject and returns the SchedulerGroups GET path
+// GetPath implements sophos.RestGetter and returns the SchedulerGroups GET path
 // Returns all available scheduler/group objects
 func (*SchedulerGroups) GetPath() string { return "/api/objects/scheduler/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*SchedulerGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the SchedulerGroups GET path
+// GetPath implements sophos.RestGetter and returns the SchedulerGroups GET path
 // Returns all available group types
 func (s *SchedulerGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/scheduler/group/%s", s.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *SchedulerGroup) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the SchedulerGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the SchedulerGroup DELETE path
 // Creates or updates the complete object group
 func (*SchedulerGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/scheduler/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the SchedulerGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the SchedulerGroup PATCH path
 // Changes to parts of the object group types
 func (*SchedulerGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/scheduler/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the SchedulerGroup POST path
+// PostPath implements sophos.Creatable and returns the SchedulerGroup POST path
 // Create a new scheduler/group object
 func (*SchedulerGroup) PostPath() string {
 	return "/api/objects/scheduler/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the SchedulerGroup PUT path
+// PutPath implements sophos.Updatable and returns the SchedulerGroup PUT path
 // Creates or updates the complete object group
 func (*SchedulerGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/scheduler/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*SchedulerGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/scheduler/group/%s/usedby", ref)
 }
 
-// SchedulerLoadbalances is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (s *SchedulerGroup) GetType() string { return s.ObjectType }
+
+// SchedulerLoadbalances is an Sophos Endpoint subType and implements sophos.RestGetter
 type SchedulerLoadbalances []SchedulerLoadbalance
 
-// SchedulerLoadbalance is a generated Sophos object
+// SchedulerLoadbalance represents a UTM scheduler/loadbalance object
 type SchedulerLoadbalance struct {
-	Locked          string        `json:"_locked"`
-	Reference       string        `json:"_ref"`
-	ObjectType      string        `json:"_type"`
-	Algorithm       string        `json:"algorithm"`
-	CheckData       string        `json:"check_data"`
-	CheckHosts      []interface{} `json:"check_hosts"`
-	CheckInterval   int64         `json:"check_interval"`
-	CheckPort       int64         `json:"check_port"`
-	CheckTimeout    int64         `json:"check_timeout"`
-	CheckType       string        `json:"check_type"`
-	Comment         string        `json:"comment"`
-	Name            string        `json:"name"`
-	Persistence     bool          `json:"persistence"`
-	PersistenceHash string        `json:"persistence_hash"`
-	PersistenceSize int64         `json:"persistence_size"`
-	PersistenceTime int64         `json:"persistence_time"`
-	Weight          struct{}      `json:"weight"`
+	Locked          string                 `json:"_locked"`
+	ObjectType      string                 `json:"_type"`
+	Reference       string                 `json:"_ref"`
+	Algorithm       string                 `json:"algorithm"`
+	CheckData       string                 `json:"check_data"`
+	CheckHosts      []string               `json:"check_hosts"`
+	CheckInterval   int64                  `json:"check_interval"`
+	CheckPort       int64                  `json:"check_port"`
+	CheckTimeout    int64                  `json:"check_timeout"`
+	CheckType       string                 `json:"check_type"`
+	Comment         string                 `json:"comment"`
+	Name            string                 `json:"name"`
+	Persistence     bool                   `json:"persistence"`
+	PersistenceHash string                 `json:"persistence_hash"`
+	PersistenceSize int64                  `json:"persistence_size"`
+	PersistenceTime int64                  `json:"persistence_time"`
+	Weight          map[string]interface{} `json:"weight"`
+}
+
+// NewSchedulerLoadbalance returns a SchedulerLoadbalance with the default values of its swagger definition
+func NewSchedulerLoadbalance() *SchedulerLoadbalance {
+	return &SchedulerLoadbalance{
+		ObjectType: "scheduler/loadbalance",
+	}
+}
+
+// Validate checks the SchedulerLoadbalance before it is sent, see ValidateWith
+func (s *SchedulerLoadbalance) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the SchedulerLoadbalance.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *SchedulerLoadbalance) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("SchedulerLoadbalance", typeOf)
+	check.Range("check_port", s.CheckPort, "0-65535")
+	check.Required("name", s.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &SchedulerLoadbalance{}
 
-// GetPath implements sophos.RestObject and returns the SchedulerLoadbalances GET path
+// GetPath implements sophos.RestGetter and returns the SchedulerLoadbalances GET path
 // Returns all available scheduler/loadbalance objects
 func (*SchedulerLoadbalances) GetPath() string { return "/api/objects/scheduler/loadbalance/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*SchedulerLoadbalances) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the SchedulerLoadbalances GET path
+// GetPath implements sophos.RestGetter and returns the SchedulerLoadbalances GET path
 // Returns all available loadbalance types
 func (s *SchedulerLoadbalance) GetPath() string {
 	return fmt.Sprintf("/api/objects/scheduler/loadbalance/%s", s.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *SchedulerLoadbalance) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the SchedulerLoadbalance DELETE path
+// DeletePath implements sophos.Deletable and returns the SchedulerLoadbalance DELETE path
 // Creates or updates the complete object loadbalance
 func (*SchedulerLoadbalance) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/scheduler/loadbalance/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the SchedulerLoadbalance PATCH path
+// PatchPath implements sophos.Patchable and returns the SchedulerLoadbalance PATCH path
 // Changes to parts of the object loadbalance types
 func (*SchedulerLoadbalance) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/scheduler/loadbalance/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the SchedulerLoadbalance POST path
+// PostPath implements sophos.Creatable and returns the SchedulerLoadbalance POST path
 // Create a new scheduler/loadbalance object
 func (*SchedulerLoadbalance) PostPath() string {
 	return "/api/objects/scheduler/loadbalance/"
 }
 
-// PutPath implements sophos.RestObject and returns the SchedulerLoadbalance PUT path
+// PutPath implements sophos.Updatable and returns the SchedulerLoadbalance PUT path
 // Creates or updates the complete object loadbalance
 func (*SchedulerLoadbalance) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/scheduler/loadbalance/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*SchedulerLoadbalance) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/scheduler/loadbalance/%s/usedby", ref)
@@ -196,14 +236,14 @@ func (*SchedulerLoadbalance) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (s *SchedulerLoadbalance) GetType() string { return s.ObjectType }
 
-// SchedulerRules is an Sophos Endpoint subType and implements sophos.RestObject
+// SchedulerRules is an Sophos Endpoint subType and implements sophos.RestGetter
 type SchedulerRules []SchedulerRule
 
-// SchedulerRule is a generated Sophos object
+// SchedulerRule represents a UTM scheduler/rule object
 type SchedulerRule struct {
 	Locked          string `json:"_locked"`
-	Reference       string `json:"_ref"`
 	ObjectType      string `json:"_type"`
+	Reference       string `json:"_ref"`
 	Comment         string `json:"comment"`
 	Destination     string `json:"destination"`
 	Interface       string `json:"interface"`
@@ -217,49 +257,67 @@ type SchedulerRule struct {
 	Status          bool   `json:"status"`
 }
 
+// NewSchedulerRule returns a SchedulerRule with the default values of its swagger definition
+func NewSchedulerRule() *SchedulerRule {
+	return &SchedulerRule{
+		ObjectType: "scheduler/rule",
+	}
+}
+
+// Validate checks the SchedulerRule before it is sent, see ValidateWith
+func (s *SchedulerRule) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the SchedulerRule.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *SchedulerRule) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("SchedulerRule", typeOf)
+	check.Required("name", s.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &SchedulerRule{}
 
-// GetPath implements sophos.RestObject and returns the SchedulerRules GET path
+// GetPath implements sophos.RestGetter and returns the SchedulerRules GET path
 // Returns all available scheduler/rule objects
 func (*SchedulerRules) GetPath() string { return "/api/objects/scheduler/rule/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*SchedulerRules) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the SchedulerRules GET path
+// GetPath implements sophos.RestGetter and returns the SchedulerRules GET path
 // Returns all available rule types
 func (s *SchedulerRule) GetPath() string {
 	return fmt.Sprintf("/api/objects/scheduler/rule/%s", s.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *SchedulerRule) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the SchedulerRule DELETE path
+// DeletePath implements sophos.Deletable and returns the SchedulerRule DELETE path
 // Creates or updates the complete object rule
 func (*SchedulerRule) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/scheduler/rule/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the SchedulerRule PATCH path
+// PatchPath implements sophos.Patchable and returns the SchedulerRule PATCH path
 // Changes to parts of the object rule types
 func (*SchedulerRule) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/scheduler/rule/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the SchedulerRule POST path
+// PostPath implements sophos.Creatable and returns the SchedulerRule POST path
 // Create a new scheduler/rule object
 func (*SchedulerRule) PostPath() string {
 	return "/api/objects/scheduler/rule/"
 }
 
-// PutPath implements sophos.RestObject and returns the SchedulerRule PUT path
+// PutPath implements sophos.Updatable and returns the SchedulerRule PUT path
 // Creates or updates the complete object rule
 func (*SchedulerRule) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/scheduler/rule/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*SchedulerRule) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/scheduler/rule/%s/usedby", ref)
@@ -0,0 +1,2764 @@
+package objects
+
+import "github.com/esurdam/go-sophos"
+
+// Schema is the metadata of the swagger definitions of Restd 1.3.0 the package was generated from,
+// see sophos.Client.CheckCompatibility
+var Schema = &sophos.Schema{
+	Restd: "1.3.0",
+	Objects: map[string]sophos.ObjectSchema{
+		"aaa/group": {
+			"adirectory_groups":      {Type: "array"},
+			"adirectory_groups_sids": {Type: "object"},
+			"backend_match":          {Type: "string"},
+			"comment":                {Type: "string"},
+			"dynamic":                {Type: "string"},
+			"edirectory_groups":      {Type: "array"},
+			"ipsec_dn":               {Type: "string"},
+			"ldap_attribute":         {Type: "string"},
+			"ldap_attribute_value":   {Type: "string"},
+			"members":                {Type: "array"},
+			"name":                   {Type: "string"},
+			"network":                {Type: "string"},
+			"radius_groups":          {Type: "array"},
+			"tacacs_groups":          {Type: "array"},
+		},
+		"aaa/user": {
+			"acc_managed":       {Type: "boolean"},
+			"allowed_networks":  {Type: "array"},
+			"authentication":    {Type: "string"},
+			"backend_update":    {Type: "boolean"},
+			"clearpass":         {Type: "string"},
+			"comment":           {Type: "string"},
+			"email_primary":     {Type: "string"},
+			"email_secondary":   {Type: "array"},
+			"enabled":           {Type: "boolean"},
+			"lastauth_backend":  {Type: "string"},
+			"lastauth_facility": {Type: "string"},
+			"lastauth_time":     {Type: "integer"},
+			"loc":               {Type: "string"},
+			"md4hash":           {Type: "string"},
+			"name":              {Type: "string"},
+			"network":           {Type: "string"},
+			"pop3_accounts":     {Type: "array"},
+			"ras_ip":            {Type: "string"},
+			"ras_online":        {Type: "boolean"},
+			"realname":          {Type: "string"},
+			"sender_blacklist":  {Type: "array"},
+			"sender_whitelist":  {Type: "array"},
+			"status":            {Type: "boolean"},
+			"use_ras_ip":        {Type: "boolean"},
+			"user_preferences":  {Type: "string"},
+			"x509_cert":         {Type: "string"},
+			"x509_cert_gost":    {Type: "string"},
+		},
+		"amazon_vpc/connection": {
+			"comment":     {Type: "string"},
+			"dev":         {Type: "string"},
+			"id":          {Type: "string"},
+			"name":        {Type: "string"},
+			"region":      {Type: "string"},
+			"status":      {Type: "boolean"},
+			"tunnel":      {Type: "array"},
+			"vpc_gateway": {Type: "string"},
+			"vpc_id":      {Type: "string"},
+			"vpc_netmask": {Type: "integer"},
+			"vpc_network": {Type: "string"},
+		},
+		"amazon_vpc/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"amazon_vpc/tunnel": {
+			"address": {Type: "string"},
+			"bgp":     {Type: "string"},
+			"comment": {Type: "string"},
+			"ipsec":   {Type: "string"},
+			"name":    {Type: "string"},
+			"netmask": {Type: "integer"},
+		},
+		"application_control/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"application_control/rule": {
+			"action":                    {Type: "string"},
+			"applications":              {Type: "array"},
+			"comment":                   {Type: "string"},
+			"destination_networks":      {Type: "array"},
+			"group":                     {Type: "string"},
+			"group_filter_productivity": {Type: "integer"},
+			"group_filter_risk":         {Type: "integer"},
+			"groups":                    {Type: "array"},
+			"log":                       {Type: "boolean"},
+			"name":                      {Type: "string"},
+			"source_networks":           {Type: "array"},
+			"status":                    {Type: "boolean"},
+		},
+		"authentication/adirectory": {
+			"backend":               {Type: "string"},
+			"base_dn":               {Type: "string"},
+			"bind_dn":               {Type: "string"},
+			"bind_pw":               {Type: "string"},
+			"comment":               {Type: "string"},
+			"name":                  {Type: "string"},
+			"port":                  {Type: "integer"},
+			"prefetch_backend_sync": {Type: "boolean"},
+			"prefetch_contexts":     {Type: "array"},
+			"prefetch_interval":     {Type: "array"},
+			"sasl":                  {Type: "boolean"},
+			"server":                {Type: "string"},
+			"ssl":                   {Type: "boolean"},
+			"status":                {Type: "boolean"},
+			"timeout":               {Type: "integer"},
+		},
+		"authentication/edirectory": {
+			"backend":               {Type: "string"},
+			"bind_dn":               {Type: "string"},
+			"bind_pw":               {Type: "string"},
+			"comment":               {Type: "string"},
+			"contexts":              {Type: "array"},
+			"name":                  {Type: "string"},
+			"port":                  {Type: "integer"},
+			"prefetch_backend_sync": {Type: "boolean"},
+			"prefetch_contexts":     {Type: "array"},
+			"prefetch_interval":     {Type: "array"},
+			"sasl":                  {Type: "boolean"},
+			"server":                {Type: "string"},
+			"ssl":                   {Type: "boolean"},
+			"status":                {Type: "boolean"},
+			"timeout":               {Type: "integer"},
+		},
+		"authentication/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"authentication/ldap": {
+			"backend":               {Type: "string"},
+			"base_dn":               {Type: "string"},
+			"bind_dn":               {Type: "string"},
+			"bind_pw":               {Type: "string"},
+			"comment":               {Type: "string"},
+			"name":                  {Type: "string"},
+			"port":                  {Type: "integer"},
+			"prefetch_backend_sync": {Type: "boolean"},
+			"prefetch_contexts":     {Type: "array"},
+			"prefetch_interval":     {Type: "array"},
+			"sasl":                  {Type: "boolean"},
+			"server":                {Type: "string"},
+			"ssl":                   {Type: "boolean"},
+			"status":                {Type: "boolean"},
+			"timeout":               {Type: "integer"},
+			"user_attrib":           {Type: "string", Enum: []string{"cn", "sn", "uid", "custom"}},
+			"user_attrib_custom":    {Type: "string"},
+		},
+		"authentication/otp_token": {
+			"comment":     {Type: "string"},
+			"digest":      {Type: "string", Enum: []string{"sha1", "sha256", "sha512"}},
+			"extra_codes": {Type: "array"},
+			"for_ssh":     {Type: "boolean"},
+			"hide":        {Type: "boolean"},
+			"lastuse":     {Type: "integer"},
+			"name":        {Type: "string"},
+			"offset":      {Type: "integer"},
+			"secret":      {Type: "string"},
+			"status":      {Type: "boolean"},
+			"timestep":    {Type: "integer"},
+			"user":        {Type: "string"},
+		},
+		"authentication/radius": {
+			"backend": {Type: "string"},
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+			"port":    {Type: "integer"},
+			"secret":  {Type: "string"},
+			"server":  {Type: "string"},
+			"status":  {Type: "boolean"},
+			"timeout": {Type: "integer"},
+		},
+		"authentication/tacacs": {
+			"backend": {Type: "string"},
+			"comment": {Type: "string"},
+			"key":     {Type: "string"},
+			"name":    {Type: "string"},
+			"port":    {Type: "integer"},
+			"server":  {Type: "string"},
+			"status":  {Type: "boolean"},
+			"timeout": {Type: "integer"},
+		},
+		"awe/client": {
+			"comment":  {Type: "string"},
+			"lastseen": {Type: "integer"},
+			"mac":      {Type: "string"},
+			"name":     {Type: "string"},
+			"vendor":   {Type: "string"},
+		},
+		"awe/device": {
+			"ac_ability":             {Type: "boolean"},
+			"active_channels":        {Type: "array"},
+			"allowed_channels":       {Type: "array"},
+			"allowed_countries":      {Type: "array"},
+			"ap_localdebuglevel":     {Type: "integer"},
+			"ap_vlantag":             {Type: "integer"},
+			"auto_channel":           {Type: "integer"},
+			"auto_channel11a":        {Type: "integer"},
+			"band":                   {Type: "string", Enum: []string{"g", "a"}},
+			"bridge_modes":           {Type: "array"},
+			"channel":                {Type: "integer"},
+			"channel11a":             {Type: "integer"},
+			"channel_width":          {Type: "string", Enum: []string{"HT20", "HT40"}},
+			"channel_width11a":       {Type: "string", Enum: []string{"HT20", "HT40", "VHT20", "VHT40", "VHT80"}},
+			"comment":                {Type: "string"},
+			"country":                {Type: "string"},
+			"dfs_ability":            {Type: "boolean"},
+			"enabled":                {Type: "boolean"},
+			"id":                     {Type: "string"},
+			"interface":              {Type: "string"},
+			"key":                    {Type: "string"},
+			"lan_mac":                {Type: "string"},
+			"last_ip":                {Type: "string"},
+			"location":               {Type: "string"},
+			"max_ssids":              {Type: "integer"},
+			"mesh_ability":           {Type: "boolean"},
+			"mesh_ability11a":        {Type: "boolean"},
+			"mesh_ability11g":        {Type: "boolean"},
+			"name":                   {Type: "string"},
+			"networks":               {Type: "array"},
+			"r0kh_secret":            {Type: "string"},
+			"scan_interval":          {Type: "integer"},
+			"scan_interval11a":       {Type: "integer"},
+			"sched_scan_interval":    {Type: "integer"},
+			"sched_scan_interval11a": {Type: "integer"},
+			"status":                 {Type: "boolean"},
+			"stp":                    {Type: "boolean"},
+			"time_scheduling":        {Type: "boolean"},
+			"time_scheduling11a":     {Type: "boolean"},
+			"time_select":            {Type: "array"},
+			"time_select11a":         {Type: "array"},
+			"tunnel_id":              {Type: "string"},
+			"tx_power_control":       {Type: "boolean"},
+			"txpower":                {Type: "integer"},
+			"txpower11a":             {Type: "integer"},
+			"type":                   {Type: "string"},
+			"vlantagging":            {Type: "boolean"},
+			"wifi_mac":               {Type: "string"},
+		},
+		"awe/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"awe/local": {
+			"active_channels":     {Type: "array"},
+			"allowed_channels":    {Type: "array"},
+			"ap_localdebuglevel":  {Type: "integer"},
+			"auto_channel":        {Type: "integer"},
+			"band":                {Type: "string", Enum: []string{"g", "a"}},
+			"bridge_modes":        {Type: "array"},
+			"channel":             {Type: "integer"},
+			"comment":             {Type: "string"},
+			"dfs_ability":         {Type: "boolean"},
+			"id":                  {Type: "string"},
+			"max_ssids":           {Type: "integer"},
+			"mesh_ability":        {Type: "boolean"},
+			"mesh_ability11a":     {Type: "boolean"},
+			"mesh_ability11g":     {Type: "boolean"},
+			"name":                {Type: "string"},
+			"networks":            {Type: "array"},
+			"scan_interval":       {Type: "integer"},
+			"sched_scan_interval": {Type: "integer"},
+			"status":              {Type: "boolean"},
+			"time_scheduling":     {Type: "boolean"},
+			"time_select":         {Type: "array"},
+			"tx_power_control":    {Type: "boolean"},
+			"txpower":             {Type: "integer"},
+			"type":                {Type: "string"},
+			"wifi_mac":            {Type: "string"},
+		},
+		"awe/red": {
+			"ac_ability":          {Type: "boolean"},
+			"active_channels":     {Type: "array"},
+			"allowed_channels":    {Type: "array"},
+			"allowed_countries":   {Type: "array"},
+			"ap_localdebuglevel":  {Type: "integer"},
+			"ap_vlantag":          {Type: "integer"},
+			"auto_channel":        {Type: "integer"},
+			"band":                {Type: "string", Enum: []string{"g", "a"}},
+			"bridge_modes":        {Type: "array"},
+			"channel":             {Type: "integer"},
+			"channel_width":       {Type: "string", Enum: []string{"HT20", "HT40"}},
+			"comment":             {Type: "string"},
+			"country":             {Type: "string"},
+			"dfs_ability":         {Type: "boolean"},
+			"enabled":             {Type: "boolean"},
+			"forced_country":      {Type: "string"},
+			"id":                  {Type: "string"},
+			"interface":           {Type: "string"},
+			"key":                 {Type: "string"},
+			"lan_mac":             {Type: "string"},
+			"last_ip":             {Type: "string"},
+			"location":            {Type: "string"},
+			"max_ssids":           {Type: "integer"},
+			"mesh_ability":        {Type: "boolean"},
+			"name":                {Type: "string"},
+			"networks":            {Type: "array"},
+			"r0kh_secret":         {Type: "string"},
+			"scan_interval":       {Type: "integer"},
+			"sched_scan_interval": {Type: "integer"},
+			"status":              {Type: "boolean"},
+			"time_scheduling":     {Type: "boolean"},
+			"time_select":         {Type: "array"},
+			"tunnel_id":           {Type: "string"},
+			"tx_power_control":    {Type: "boolean"},
+			"txpower":             {Type: "integer"},
+			"type":                {Type: "string"},
+			"vlantagging":         {Type: "boolean"},
+			"wifi_mac":            {Type: "string"},
+		},
+		"awe_network_device_association/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"awe_network_device_association/mesh_role": {
+			"comment": {Type: "string"},
+			"device":  {Type: "string"},
+			"mesh":    {Type: "string"},
+			"name":    {Type: "string"},
+			"role":    {Type: "string", Enum: []string{"point", "portal"}},
+		},
+		"aws/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"aws/instance_type": {
+			"comment":             {Type: "string"},
+			"cpu_cores":           {Type: "integer"},
+			"deprecated":          {Type: "boolean"},
+			"memory_bytes":        {Type: "integer"},
+			"model":               {Type: "string"},
+			"name":                {Type: "string"},
+			"network_performance": {Type: "string"},
+		},
+		"aws/region": {
+			"availability_zones": {Type: "array"},
+			"code":               {Type: "string"},
+			"comment":            {Type: "string"},
+			"instance_types":     {Type: "array"},
+			"name":               {Type: "string"},
+			"partition":          {Type: "string"},
+		},
+		"awscli/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"awscli/profile": {
+			"aws_access_key_id":     {Type: "string"},
+			"aws_secret_access_key": {Type: "string"},
+			"aws_session_token":     {Type: "string"},
+			"comment":               {Type: "string"},
+			"name":                  {Type: "string"},
+			"output":                {Type: "string", Enum: []string{"json", "text", "table"}},
+			"profile_name":          {Type: "string"},
+			"region":                {Type: "string"},
+		},
+		"bgp/amazon_vpc": {
+			"comment":       {Type: "string"},
+			"custom":        {Type: "string"},
+			"host":          {Type: "string"},
+			"id":            {Type: "string"},
+			"local_asn":     {Type: "integer"},
+			"maximum_paths": {Type: "integer"},
+			"name":          {Type: "string"},
+			"network":       {Type: "array"},
+			"remote_asn":    {Type: "integer"},
+		},
+		"bgp/filter": {
+			"action":   {Type: "string", Enum: []string{"permit", "deny"}},
+			"address":  {Type: "array"},
+			"as_regex": {Type: "array"},
+			"comment":  {Type: "string"},
+			"name":     {Type: "string"},
+			"type":     {Type: "string", Enum: []string{"as_number", "ip_address"}},
+		},
+		"bgp/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"bgp/neighbor": {
+			"asn":                  {Type: "integer"},
+			"authentication":       {Type: "string", Enum: []string{"null", "password"}},
+			"comment":              {Type: "string"},
+			"default_originate":    {Type: "boolean"},
+			"filter_in":            {Type: "string"},
+			"filter_out":           {Type: "string"},
+			"host":                 {Type: "string"},
+			"multihop":             {Type: "boolean"},
+			"name":                 {Type: "string"},
+			"next_hop_self":        {Type: "boolean"},
+			"password":             {Type: "string"},
+			"route_in":             {Type: "string"},
+			"route_out":            {Type: "string"},
+			"soft_reconfiguration": {Type: "boolean"},
+			"status":               {Type: "boolean"},
+			"weight":               {Type: "integer"},
+		},
+		"bgp/route_map": {
+			"address":    {Type: "array"},
+			"as_regex":   {Type: "array"},
+			"comment":    {Type: "string"},
+			"metric":     {Type: "integer"},
+			"name":       {Type: "string"},
+			"preference": {Type: "integer"},
+			"prepend":    {Type: "string"},
+			"type":       {Type: "string", Enum: []string{"as_number", "ip_address"}},
+			"weight":     {Type: "integer"},
+		},
+		"bgp/system": {
+			"asn":            {Type: "integer"},
+			"comment":        {Type: "string"},
+			"custom":         {Type: "string"},
+			"id":             {Type: "string"},
+			"install_routes": {Type: "boolean"},
+			"maximum_paths":  {Type: "integer"},
+			"name":           {Type: "string"},
+			"neighbor":       {Type: "array"},
+			"network":        {Type: "array"},
+			"status":         {Type: "boolean"},
+		},
+		"ca/crl": {
+			"comment": {Type: "string"},
+			"crl":     {Type: "string"},
+			"meta":    {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"ca/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"ca/host_cert": {
+			"certificate": {Type: "string"},
+			"comment":     {Type: "string"},
+			"meta":        {Type: "string"},
+			"name":        {Type: "string"},
+		},
+		"ca/host_key_cert": {
+			"ca":          {Type: "string"},
+			"certificate": {Type: "string"},
+			"comment":     {Type: "string"},
+			"encrypted":   {Type: "boolean"},
+			"key":         {Type: "string"},
+			"meta":        {Type: "string"},
+			"name":        {Type: "string"},
+		},
+		"ca/http_verification_ca": {
+			"certificate": {Type: "string"},
+			"comment":     {Type: "string"},
+			"meta":        {Type: "string"},
+			"name":        {Type: "string"},
+			"trust":       {Type: "boolean"},
+		},
+		"ca/meta_crl": {
+			"comment":    {Type: "string"},
+			"hash":       {Type: "string"},
+			"issuer":     {Type: "string"},
+			"lastupdate": {Type: "string"},
+			"name":       {Type: "string"},
+			"nextupdate": {Type: "string"},
+		},
+		"ca/meta_x509": {
+			"comment":              {Type: "string"},
+			"enddate":              {Type: "string"},
+			"fingerprint":          {Type: "string"},
+			"issuer":               {Type: "string"},
+			"issuer_hash":          {Type: "string"},
+			"name":                 {Type: "string"},
+			"public_key_algorithm": {Type: "string"},
+			"serial":               {Type: "string"},
+			"startdate":            {Type: "string"},
+			"subject":              {Type: "string"},
+			"subject_alt_names":    {Type: "array"},
+			"subject_hash":         {Type: "string"},
+			"vpn_id":               {Type: "string"},
+			"vpn_id_type":          {Type: "string"},
+		},
+		"ca/rsa": {
+			"comment":     {Type: "string"},
+			"key":         {Type: "string"},
+			"key_size":    {Type: "integer"},
+			"name":        {Type: "string"},
+			"pubkey":      {Type: "string"},
+			"vpn_id":      {Type: "string"},
+			"vpn_id_type": {Type: "string"},
+		},
+		"ca/signing_ca": {
+			"certificate": {Type: "string"},
+			"comment":     {Type: "string"},
+			"config":      {Type: "string"},
+			"encrypted":   {Type: "boolean"},
+			"index":       {Type: "string"},
+			"key":         {Type: "string"},
+			"meta":        {Type: "string"},
+			"name":        {Type: "string"},
+			"serial":      {Type: "string"},
+		},
+		"ca/verification_ca": {
+			"certificate": {Type: "string"},
+			"comment":     {Type: "string"},
+			"meta":        {Type: "string"},
+			"name":        {Type: "string"},
+		},
+		"clientless_vpn/connection": {
+			"allowed_users":  {Type: "array"},
+			"auto_login":     {Type: "boolean"},
+			"comment":        {Type: "string"},
+			"destination":    {Type: "string"},
+			"host_key_cert":  {Type: "string"},
+			"login":          {Type: "string"},
+			"name":           {Type: "string"},
+			"password":       {Type: "string"},
+			"pf_exceptions":  {Type: "array"},
+			"port":           {Type: "integer"},
+			"private_key":    {Type: "string"},
+			"rdp_security":   {Type: "string"},
+			"record_session": {Type: "boolean"},
+			"service":        {Type: "string"},
+			"share_session":  {Type: "boolean"},
+			"status":         {Type: "boolean"},
+			"uid":            {Type: "integer"},
+			"web_path":       {Type: "string"},
+		},
+		"clientless_vpn/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"condition/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"condition/objref": {
+			"attr":     {Type: "string"},
+			"comment":  {Type: "string"},
+			"name":     {Type: "string"},
+			"operator": {Type: "string"},
+			"ref":      {Type: "string"},
+			"value":    {Type: "string"},
+		},
+		"cron/at": {
+			"command": {Type: "string"},
+			"comment": {Type: "string"},
+			"date":    {Type: "string"},
+			"name":    {Type: "string"},
+			"time":    {Type: "string"},
+		},
+		"cron/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"dhcp/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"dhcp/option": {
+			"address":   {Type: "string"},
+			"code":      {Type: "integer"},
+			"comment":   {Type: "string"},
+			"dhcp_name": {Type: "string"},
+			"host":      {Type: "array"},
+			"integer":   {Type: "integer"},
+			"mac":       {Type: "string"},
+			"name":      {Type: "string"},
+			"scope":     {Type: "string"},
+			"server":    {Type: "array"},
+			"status":    {Type: "boolean"},
+			"string":    {Type: "string"},
+			"text":      {Type: "string"},
+			"type":      {Type: "string"},
+			"vendor":    {Type: "string"},
+		},
+		"dhcp/option6": {
+			"address":   {Type: "string"},
+			"code":      {Type: "integer"},
+			"comment":   {Type: "string"},
+			"dhcp_name": {Type: "string"},
+			"host":      {Type: "array"},
+			"integer":   {Type: "integer"},
+			"mac":       {Type: "string"},
+			"name":      {Type: "string"},
+			"scope":     {Type: "string", Enum: []string{"global", "server", "host", "mac", "vendor"}},
+			"server":    {Type: "array"},
+			"status":    {Type: "boolean"},
+			"string":    {Type: "string"},
+			"text":      {Type: "string"},
+			"type":      {Type: "string", Enum: []string{"ip-address", "text", "string", "integer"}},
+			"vendor":    {Type: "string"},
+		},
+		"dhcp/server": {
+			"address":          {Type: "string"},
+			"comment":          {Type: "string"},
+			"custom":           {Type: "string"},
+			"default_gateway":  {Type: "string"},
+			"deny_unknown":     {Type: "boolean"},
+			"dns1":             {Type: "string"},
+			"dns2":             {Type: "string"},
+			"domain":           {Type: "string"},
+			"interface":        {Type: "string"},
+			"lease_time":       {Type: "integer"},
+			"mappings":         {Type: "array"},
+			"name":             {Type: "string"},
+			"netmask":          {Type: "integer"},
+			"proxy_autoconfig": {Type: "boolean"},
+			"range_end":        {Type: "string"},
+			"range_start":      {Type: "string"},
+			"relay_mode":       {Type: "boolean"},
+			"status":           {Type: "boolean"},
+			"wins":             {Type: "string"},
+			"wins_node_type":   {Type: "string"},
+		},
+		"dhcp/server6": {
+			"address":          {Type: "string"},
+			"comment":          {Type: "string"},
+			"custom":           {Type: "string"},
+			"default_lft":      {Type: "integer"},
+			"deny_unknown":     {Type: "boolean"},
+			"dns1":             {Type: "string"},
+			"dns2":             {Type: "string"},
+			"domain":           {Type: "string"},
+			"interface":        {Type: "string"},
+			"mappings":         {Type: "array"},
+			"mtu":              {Type: "integer"},
+			"name":             {Type: "string"},
+			"netmask6":         {Type: "integer"},
+			"on_link":          {Type: "boolean"},
+			"prefd_lft":        {Type: "integer"},
+			"proxy_autoconfig": {Type: "boolean"},
+			"range_end":        {Type: "string"},
+			"range_start":      {Type: "string"},
+			"relay_mode":       {Type: "boolean"},
+			"status":           {Type: "boolean"},
+			"valid_lft":        {Type: "integer"},
+		},
+		"dhcp/stateless": {
+			"address":                 {Type: "string"},
+			"comment":                 {Type: "string"},
+			"custom":                  {Type: "string"},
+			"default_lft":             {Type: "integer"},
+			"dns1":                    {Type: "string"},
+			"dns2":                    {Type: "string"},
+			"domain":                  {Type: "string"},
+			"interface":               {Type: "string"},
+			"managed_flag":            {Type: "boolean"},
+			"mtu":                     {Type: "integer"},
+			"name":                    {Type: "string"},
+			"on_link":                 {Type: "boolean"},
+			"other_config":            {Type: "boolean"},
+			"prefd_lft":               {Type: "integer"},
+			"proxy_autoconfig":        {Type: "boolean"},
+			"stateless_server_status": {Type: "boolean"},
+			"status":                  {Type: "boolean"},
+			"valid_lft":               {Type: "integer"},
+		},
+		"dns/axfr": {
+			"comment": {Type: "string"},
+			"master":  {Type: "array"},
+			"name":    {Type: "string"},
+			"status":  {Type: "boolean"},
+			"zone":    {Type: "string"},
+		},
+		"dns/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"dns/route": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+			"prefix":  {Type: "string"},
+			"status":  {Type: "boolean"},
+			"targets": {Type: "array"},
+		},
+		"dyndns/dyndns": {
+			"aliases":   {Type: "array"},
+			"backupmx":  {Type: "boolean"},
+			"comment":   {Type: "string"},
+			"hostname":  {Type: "string"},
+			"interface": {Type: "string"},
+			"label":     {Type: "string"},
+			"mx":        {Type: "string"},
+			"mxpri":     {Type: "integer"},
+			"name":      {Type: "string"},
+			"password":  {Type: "string"},
+			"record":    {Type: "string", Enum: []string{"a", "aaaa", "both"}},
+			"status":    {Type: "boolean"},
+			"strategy":  {Type: "string", Enum: []string{"if", "web"}},
+			"type":      {Type: "string", Enum: []string{"dns-o-matic", "dnsdynamic", "dnspark", "dtdns", "dyndns", "dyndns-custom", "easydns", "freedns", "namecheap", "no-ip", "opendns", "selfhost", "strato", "zoneedit"}},
+			"user":      {Type: "string"},
+			"wildcard":  {Type: "boolean"},
+		},
+		"dyndns/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"emailpki/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"emailpki/openpgp": {
+			"comment":     {Type: "string"},
+			"emails":      {Type: "array"},
+			"expires":     {Type: "string"},
+			"fingerprint": {Type: "string"},
+			"name":        {Type: "string"},
+			"privkey":     {Type: "string"},
+			"pubkey":      {Type: "string"},
+			"realname":    {Type: "string"},
+		},
+		"emailpki/smime": {
+			"cert":        {Type: "string"},
+			"comment":     {Type: "string"},
+			"dn":          {Type: "string"},
+			"domaincert":  {Type: "boolean"},
+			"emails":      {Type: "array"},
+			"expires":     {Type: "string"},
+			"fingerprint": {Type: "string"},
+			"key":         {Type: "string"},
+			"name":        {Type: "string"},
+			"realname":    {Type: "string"},
+			"trust":       {Type: "boolean"},
+		},
+		"emailpki/user": {
+			"comment":        {Type: "string"},
+			"decrypt":        {Type: "string", Enum: []string{"global", "on", "off"}},
+			"domaincert":     {Type: "boolean"},
+			"encrypt":        {Type: "string", Enum: []string{"global", "on", "off"}},
+			"name":           {Type: "string"},
+			"openpgp":        {Type: "string"},
+			"openpgp_status": {Type: "boolean"},
+			"realname":       {Type: "string"},
+			"sign":           {Type: "string", Enum: []string{"global", "on", "off"}},
+			"smime":          {Type: "string"},
+			"smime_status":   {Type: "boolean"},
+			"verify":         {Type: "string", Enum: []string{"global", "on", "off"}},
+		},
+		"epp/av_exception": {
+			"checksum":         {Type: "string"},
+			"comment":          {Type: "string"},
+			"endpoints_groups": {Type: "array"},
+			"hips_name":        {Type: "string"},
+			"ip_address":       {Type: "string"},
+			"ip_address_mask":  {Type: "integer"},
+			"name":             {Type: "string"},
+			"timeline":         {Type: "string"},
+			"type":             {Type: "string", Enum: []string{"adware_pua", "scanning_exclusions", "scanning_extensions", "buffer_overflow", "suspicious_files", "suspicious_behaviours", "websites"}},
+			"web_format":       {Type: "string", Enum: []string{"domain_name", "ip_address", "ip_address_mask"}},
+		},
+		"epp/av_policy": {
+			"alert_only":                  {Type: "boolean"},
+			"auto_cleanup":                {Type: "boolean"},
+			"block_malicious_sites":       {Type: "boolean"},
+			"comment":                     {Type: "string"},
+			"detect_buffer_overflow":      {Type: "boolean"},
+			"detect_malicious_files":      {Type: "boolean"},
+			"detect_suspicious_behaviour": {Type: "boolean"},
+			"download_scanning":           {Type: "boolean"},
+			"hips":                        {Type: "boolean"},
+			"low_priority_scan":           {Type: "boolean"},
+			"name":                        {Type: "string"},
+			"on_access_scanning":          {Type: "boolean"},
+			"on_read":                     {Type: "boolean"},
+			"on_rename":                   {Type: "boolean"},
+			"on_write":                    {Type: "boolean"},
+			"root_kit_scan":               {Type: "boolean"},
+			"scan_for_pua":                {Type: "boolean"},
+			"scan_for_suspicious_files":   {Type: "boolean"},
+			"scan_inside_archive":         {Type: "boolean"},
+			"scan_system_memory":          {Type: "boolean"},
+			"scheduled_scanning":          {Type: "boolean"},
+			"send_sample_file":            {Type: "boolean"},
+			"sophos_live_protection":      {Type: "boolean"},
+			"time_event":                  {Type: "string"},
+			"web_protection":              {Type: "boolean"},
+		},
+		"epp/dc_exception": {
+			"allowed_endpoints_groups":        {Type: "array"},
+			"comment":                         {Type: "string"},
+			"custom_blocked_endpoints_groups": {Type: "array"},
+			"device_id":                       {Type: "string"},
+			"device_type":                     {Type: "string", Enum: []string{"floppy_drive", "optical_drive", "removable_storage", "encrypted_storage", "modem", "wireless", "bluetooth", "infrared"}},
+			"name":                            {Type: "string"},
+		},
+		"epp/dc_policy": {
+			"bluetooth":         {Type: "string"},
+			"comment":           {Type: "string"},
+			"encrypted_storage": {Type: "string"},
+			"floppy_drive":      {Type: "string"},
+			"infrared":          {Type: "string"},
+			"modem":             {Type: "string"},
+			"name":              {Type: "string"},
+			"optical_drive":     {Type: "string"},
+			"removable_storage": {Type: "string"},
+			"wireless":          {Type: "string"},
+		},
+		"epp/device": {
+			"allowed_endpoints_groups":        {Type: "array"},
+			"comment":                         {Type: "string"},
+			"custom_blocked_endpoints_groups": {Type: "array"},
+			"device_id":                       {Type: "string"},
+			"device_type":                     {Type: "string", Enum: []string{"floppy_drive", "optical_drive", "removable_storage", "encrypted_storage", "modem", "wireless", "bluetooth", "infrared"}},
+			"generic_flag":                    {Type: "boolean"},
+			"instance_id":                     {Type: "string"},
+			"last_endpoint":                   {Type: "string"},
+			"name":                            {Type: "string"},
+			"product_name":                    {Type: "string"},
+		},
+		"epp/endpoint": {
+			"accepted":          {Type: "boolean"},
+			"comment":           {Type: "string"},
+			"endpoint_type":     {Type: "string", Enum: []string{"laptop", "desktop", "server"}},
+			"inventory_number":  {Type: "string"},
+			"mcs_id":            {Type: "string"},
+			"name":              {Type: "string"},
+			"os":                {Type: "string"},
+			"sav_status":        {Type: "boolean"},
+			"sav_version":       {Type: "string"},
+			"tamper_protection": {Type: "boolean"},
+		},
+		"epp/endpoints_group": {
+			"av_policy":         {Type: "string"},
+			"comment":           {Type: "string"},
+			"dc_policy":         {Type: "string"},
+			"endpoints":         {Type: "array"},
+			"name":              {Type: "string"},
+			"proxy_address":     {Type: "string"},
+			"proxy_password":    {Type: "string"},
+			"proxy_port":        {Type: "integer"},
+			"proxy_support":     {Type: "boolean"},
+			"proxy_user":        {Type: "string"},
+			"tamper_protection": {Type: "boolean"},
+			"web_control":       {Type: "boolean"},
+		},
+		"epp/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"ftp/exception": {
+			"client":   {Type: "array"},
+			"comment":  {Type: "string"},
+			"name":     {Type: "string"},
+			"server":   {Type: "array"},
+			"skiplist": {Type: "array"},
+			"status":   {Type: "boolean"},
+		},
+		"ftp/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"geoip/dstexception": {
+			"comment":              {Type: "string"},
+			"countries":            {Type: "array"},
+			"destination_networks": {Type: "array"},
+			"name":                 {Type: "string"},
+			"services":             {Type: "array"},
+			"status":               {Type: "boolean"},
+		},
+		"geoip/geoipgroup": {
+			"comment":   {Type: "string"},
+			"countries": {Type: "array"},
+			"name":      {Type: "string"},
+		},
+		"geoip/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"geoip/srcexception": {
+			"comment":         {Type: "string"},
+			"countries":       {Type: "array"},
+			"name":            {Type: "string"},
+			"services":        {Type: "array"},
+			"source_networks": {Type: "array"},
+			"status":          {Type: "boolean"},
+		},
+		"hotspot/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"hotspot/portal": {
+			"admin_users":        {Type: "array"},
+			"comment":            {Type: "string"},
+			"custom_assets":      {Type: "object"},
+			"customization_type": {Type: "string", Enum: []string{"basic", "full"}},
+			"description":        {Type: "string"},
+			"expiry":             {Type: "integer"},
+			"fias_codeset":       {Type: "string", Enum: []string{"cp850", "cp1252"}},
+			"fias_port":          {Type: "string"},
+			"fias_server":        {Type: "string"},
+			"hostname":           {Type: "string"},
+			"hostname_type":      {Type: "string", Enum: []string{"none", "custom"}},
+			"hotspot_users":      {Type: "array"},
+			"interfaces":         {Type: "array"},
+			"logo":               {Type: "string"},
+			"logo_filename":      {Type: "string"},
+			"logo_resize":        {Type: "boolean"},
+			"maclimit":           {Type: "integer"},
+			"mail":               {Type: "array"},
+			"name":               {Type: "string"},
+			"pagesize":           {Type: "string"},
+			"pw_time":            {Type: "string"},
+			"redirect_url":       {Type: "string"},
+			"sms_text":           {Type: "string"},
+			"ssl_redirect":       {Type: "boolean"},
+			"sync_psk":           {Type: "boolean"},
+			"template":           {Type: "object"},
+			"terms":              {Type: "string"},
+			"title":              {Type: "string"},
+			"type":               {Type: "string", Enum: []string{"terms", "password", "voucher", "backend_auth", "sms", "fias"}},
+			"voucher_qrcode":     {Type: "boolean"},
+			"voucher_template":   {Type: "object"},
+			"vouchers":           {Type: "array"},
+			"vouchers_per_page":  {Type: "integer"},
+		},
+		"hotspot/voucher": {
+			"comment":      {Type: "string"},
+			"expiry":       {Type: "integer"},
+			"name":         {Type: "string"},
+			"timequota":    {Type: "integer"},
+			"trafficlimit": {Type: "integer"},
+		},
+		"http/cff_action": {
+			"allow_tags":                 {Type: "array"},
+			"av":                         {Type: "boolean"},
+			"av_engines":                 {Type: "string"},
+			"bing_safesearch":            {Type: "string"},
+			"block_tags":                 {Type: "array"},
+			"check_max_download":         {Type: "boolean"},
+			"comment":                    {Type: "string"},
+			"contenttype_blacklist":      {Type: "array"},
+			"contenttype_blacklist_warn": {Type: "array"},
+			"creative_commons_filter":    {Type: "boolean"},
+			"embedded_removal":           {Type: "boolean"},
+			"extensions":                 {Type: "array"},
+			"extensions_warn":            {Type: "array"},
+			"google_safesearch":          {Type: "string"},
+			"googleappdomains":           {Type: "array"},
+			"googleappdomains_enabled":   {Type: "boolean"},
+			"log_access":                 {Type: "boolean"},
+			"log_blocked":                {Type: "boolean"},
+			"max_download_size":          {Type: "integer"},
+			"max_filesize":               {Type: "integer"},
+			"mode":                       {Type: "string"},
+			"name":                       {Type: "string"},
+			"parent_proxies":             {Type: "array"},
+			"pua":                        {Type: "boolean"},
+			"quota_tags":                 {Type: "array"},
+			"quota_time":                 {Type: "integer"},
+			"sandbox":                    {Type: "boolean"},
+			"script_removal":             {Type: "boolean"},
+			"sp_categories":              {Type: "array"},
+			"sp_categories_quota":        {Type: "array"},
+			"sp_categories_warn":         {Type: "array"},
+			"sp_minreputation":           {Type: "string"},
+			"spyware":                    {Type: "boolean"},
+			"uncategorized_websites":     {Type: "string"},
+			"url_blacklist":              {Type: "array"},
+			"url_whitelist":              {Type: "array"},
+			"warn_tags":                  {Type: "array"},
+			"yahoo_safesearch":           {Type: "string"},
+		},
+		"http/cff_profile": {
+			"aaa":              {Type: "array"},
+			"action":           {Type: "string"},
+			"cff_profile_name": {Type: "string"},
+			"comment":          {Type: "string"},
+			"in_progress":      {Type: "string"},
+			"name":             {Type: "string"},
+			"skip_auth":        {Type: "boolean"},
+			"time_event":       {Type: "string"},
+		},
+		"http/device_auth": {
+			"auth_mode":   {Type: "string", Enum: []string{"none", "aua", "edir_sso", "ntlm", "opendirectory_auth", "browser", "agent"}},
+			"comment":     {Type: "string"},
+			"device_type": {Type: "string", Enum: []string{"Windows", "Mac OS X", "Linux", "iOS", "Android", "Kindle", "Blackberry"}},
+			"name":        {Type: "string"},
+		},
+		"http/domain_regex": {
+			"comment":            {Type: "string"},
+			"domain":             {Type: "array"},
+			"include_subdomains": {Type: "boolean"},
+			"mode":               {Type: "string", Enum: []string{"Domain", "Regex"}},
+			"name":               {Type: "string"},
+			"regexps":            {Type: "array"},
+			"restrict_regex":     {Type: "boolean"},
+		},
+		"http/exception": {
+			"aaa":              {Type: "array"},
+			"comment":          {Type: "string"},
+			"domains":          {Type: "array"},
+			"endpoints_groups": {Type: "array"},
+			"name":             {Type: "string"},
+			"networks":         {Type: "array"},
+			"operator":         {Type: "string"},
+			"skiplist":         {Type: "array"},
+			"sp_categories":    {Type: "array"},
+			"status":           {Type: "boolean"},
+			"tags":             {Type: "array"},
+			"user_agents":      {Type: "array"},
+		},
+		"http/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"http/local_site": {
+			"category":           {Type: "string"},
+			"comment":            {Type: "string"},
+			"include_subdomains": {Type: "boolean"},
+			"name":               {Type: "string"},
+			"reputation":         {Type: "string", Enum: []string{"off", "malicious", "suspicious", "unverified", "neutral", "trusted"}},
+			"site":               {Type: "string"},
+			"tags":               {Type: "array"},
+		},
+		"http/lsl_tag": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"http/pac_file": {
+			"comment": {Type: "string"},
+			"content": {Type: "string"},
+			"name":    {Type: "string"},
+			"status":  {Type: "boolean"},
+		},
+		"http/parent_proxy": {
+			"comment": {Type: "string"},
+			"match":   {Type: "array"},
+			"name":    {Type: "string"},
+			"pass":    {Type: "string"},
+			"port":    {Type: "integer"},
+			"target":  {Type: "string"},
+			"user":    {Type: "string"},
+		},
+		"http/profile": {
+			"aua":                  {Type: "boolean"},
+			"block_on_auth_failed": {Type: "boolean"},
+			"cff_profiles":         {Type: "array"},
+			"comment":              {Type: "string"},
+			"default_cff_action":   {Type: "string"},
+			"device_auth":          {Type: "array"},
+			"edir_sso":             {Type: "boolean"},
+			"enable_device_auth":   {Type: "boolean"},
+			"endpoints_groups":     {Type: "array"},
+			"full_transparent":     {Type: "boolean"},
+			"in_progress":          {Type: "boolean"},
+			"name":                 {Type: "string"},
+			"networks":             {Type: "array"},
+			"ntlm":                 {Type: "boolean"},
+			"opendirectory_auth":   {Type: "boolean"},
+			"ordered_cff_profiles": {Type: "array"},
+			"out_interface":        {Type: "string"},
+			"scan_ssl_opt":         {Type: "string"},
+			"selective_scan_cat":   {Type: "array"},
+			"selective_scan_tags":  {Type: "array"},
+			"status":               {Type: "boolean"},
+			"transparent":          {Type: "boolean"},
+			"transparent_aac":      {Type: "boolean"},
+			"transparent_auth":     {Type: "boolean"},
+		},
+		"http/sp_category": {
+			"comment": {Type: "string"},
+			"id":      {Type: "string"},
+			"name":    {Type: "string"},
+			"subcats": {Type: "array"},
+		},
+		"http/sp_subcat": {
+			"comment": {Type: "string"},
+			"id":      {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"interface/bridge": {
+			"additional_addresses": {Type: "array"},
+			"ageing":               {Type: "integer"},
+			"arp_bcast":            {Type: "boolean"},
+			"comment":              {Type: "string"},
+			"converted_from_hw":    {Type: "string"},
+			"forwarded_ethertypes": {Type: "array"},
+			"itfhw":                {Type: "string"},
+			"link":                 {Type: "boolean"},
+			"mtu":                  {Type: "integer"},
+			"mtu_auto_discovery":   {Type: "boolean"},
+			"name":                 {Type: "string"},
+			"ports":                {Type: "array"},
+			"primary_address":      {Type: "string"},
+			"proxyarp":             {Type: "boolean"},
+			"proxyndp":             {Type: "boolean"},
+			"status":               {Type: "boolean"},
+			"stp_fd":               {Type: "integer"},
+			"stp_hello":            {Type: "integer"},
+			"stp_maxage":           {Type: "integer"},
+			"stp_prio":             {Type: "integer"},
+			"stp_status":           {Type: "boolean"},
+			"use_dhcp":             {Type: "boolean"},
+			"use_dhcpv6":           {Type: "boolean"},
+			"virtual_mac":          {Type: "string"},
+		},
+		"interface/ethernet": {
+			"additional_addresses": {Type: "array"},
+			"bandwidth":            {Type: "integer"},
+			"comment":              {Type: "string"},
+			"inbandwidth":          {Type: "integer"},
+			"itfhw":                {Type: "string"},
+			"link":                 {Type: "boolean"},
+			"mtu":                  {Type: "integer"},
+			"mtu_auto_discovery":   {Type: "boolean"},
+			"name":                 {Type: "string"},
+			"outbandwidth":         {Type: "integer"},
+			"primary_address":      {Type: "string"},
+			"proxyarp":             {Type: "boolean"},
+			"proxyndp":             {Type: "boolean"},
+			"status":               {Type: "boolean"},
+		},
+		"interface/group": {
+			"comment":           {Type: "string"},
+			"link":              {Type: "boolean"},
+			"members":           {Type: "array"},
+			"name":              {Type: "string"},
+			"primary_addresses": {Type: "string"},
+		},
+		"interface/ppp3g": {
+			"apn":                {Type: "string"},
+			"apn_auto":           {Type: "boolean"},
+			"bandwidth":          {Type: "integer"},
+			"comment":            {Type: "string"},
+			"custom":             {Type: "string"},
+			"dial_string":        {Type: "string"},
+			"idle_time":          {Type: "string"},
+			"inbandwidth":        {Type: "integer"},
+			"init_string":        {Type: "string"},
+			"itfhw":              {Type: "string"},
+			"link":               {Type: "boolean"},
+			"mobile_network":     {Type: "string", Enum: []string{"gsm", "cdma", "lte"}},
+			"mtu":                {Type: "integer"},
+			"mtu_auto_discovery": {Type: "boolean"},
+			"multilink":          {Type: "boolean"},
+			"name":               {Type: "string"},
+			"outbandwidth":       {Type: "integer"},
+			"password":           {Type: "string"},
+			"pin":                {Type: "string"},
+			"primary_address":    {Type: "string"},
+			"reset_string":       {Type: "string"},
+			"signal":             {Type: "integer"},
+			"status":             {Type: "boolean"},
+			"username":           {Type: "string"},
+			"virtual_device":     {Type: "string"},
+		},
+		"interface/pppmodem": {
+			"bandwidth":          {Type: "integer"},
+			"comment":            {Type: "string"},
+			"custom":             {Type: "string"},
+			"dial_string":        {Type: "string"},
+			"flow_control":       {Type: "string", Enum: []string{"hardware", "software"}},
+			"idle_time":          {Type: "string"},
+			"inbandwidth":        {Type: "integer"},
+			"init_string":        {Type: "string"},
+			"itfhw":              {Type: "string"},
+			"line_speed":         {Type: "string", Enum: []string{"9600", "14400", "19200", "26400", "31200", "38400", "57600", "115200", "230400"}},
+			"link":               {Type: "boolean"},
+			"mtu":                {Type: "integer"},
+			"mtu_auto_discovery": {Type: "boolean"},
+			"multilink":          {Type: "boolean"},
+			"name":               {Type: "string"},
+			"outbandwidth":       {Type: "integer"},
+			"password":           {Type: "string"},
+			"primary_address":    {Type: "string"},
+			"reset_string":       {Type: "string"},
+			"status":             {Type: "boolean"},
+			"username":           {Type: "string"},
+			"virtual_device":     {Type: "string"},
+		},
+		"interface/pppoa": {
+			"bandwidth":          {Type: "integer"},
+			"comment":            {Type: "string"},
+			"custom":             {Type: "string"},
+			"inbandwidth":        {Type: "integer"},
+			"itfhw":              {Type: "string"},
+			"link":               {Type: "boolean"},
+			"modem_address":      {Type: "string"},
+			"mtu":                {Type: "integer"},
+			"mtu_auto_discovery": {Type: "boolean"},
+			"multilink":          {Type: "boolean"},
+			"name":               {Type: "string"},
+			"nic_address":        {Type: "string"},
+			"nic_netmask":        {Type: "integer"},
+			"outbandwidth":       {Type: "integer"},
+			"password":           {Type: "string"},
+			"ping_address":       {Type: "string"},
+			"primary_address":    {Type: "string"},
+			"reconnect_daily":    {Type: "string"},
+			"reconnect_timeout":  {Type: "integer"},
+			"status":             {Type: "boolean"},
+			"username":           {Type: "string"},
+			"virtual_device":     {Type: "string"},
+		},
+		"interface/pppoe": {
+			"additional_addresses": {Type: "array"},
+			"bandwidth":            {Type: "integer"},
+			"comment":              {Type: "string"},
+			"custom":               {Type: "string"},
+			"inbandwidth":          {Type: "integer"},
+			"itfhw":                {Type: "string"},
+			"itfhw_slaves":         {Type: "array"},
+			"link":                 {Type: "boolean"},
+			"macvlan":              {Type: "boolean"},
+			"mtu":                  {Type: "integer"},
+			"mtu_auto_discovery":   {Type: "boolean"},
+			"multilink":            {Type: "boolean"},
+			"multilink_status":     {Type: "object"},
+			"name":                 {Type: "string"},
+			"outbandwidth":         {Type: "integer"},
+			"password":             {Type: "string"},
+			"primary_address":      {Type: "string"},
+			"reconnect_daily":      {Type: "string"},
+			"reconnect_timeout":    {Type: "integer"},
+			"status":               {Type: "boolean"},
+			"username":             {Type: "string"},
+			"virtual_device":       {Type: "string"},
+			"vlantag":              {Type: "integer"},
+		},
+		"interface/tunnel": {
+			"additional_addresses": {Type: "array"},
+			"bandwidth":            {Type: "integer"},
+			"comment":              {Type: "string"},
+			"inbandwidth":          {Type: "integer"},
+			"itfhw":                {Type: "string"},
+			"link":                 {Type: "boolean"},
+			"mtu":                  {Type: "integer"},
+			"mtu_auto_discovery":   {Type: "boolean"},
+			"name":                 {Type: "string"},
+			"outbandwidth":         {Type: "integer"},
+			"primary_address":      {Type: "string"},
+			"status":               {Type: "boolean"},
+		},
+		"interface/vlan": {
+			"additional_addresses": {Type: "array"},
+			"bandwidth":            {Type: "integer"},
+			"comment":              {Type: "string"},
+			"inbandwidth":          {Type: "integer"},
+			"itfhw":                {Type: "string"},
+			"link":                 {Type: "boolean"},
+			"macvlan":              {Type: "boolean"},
+			"mtu":                  {Type: "integer"},
+			"mtu_auto_discovery":   {Type: "boolean"},
+			"name":                 {Type: "string"},
+			"outbandwidth":         {Type: "integer"},
+			"primary_address":      {Type: "string"},
+			"proxyarp":             {Type: "boolean"},
+			"proxyndp":             {Type: "boolean"},
+			"status":               {Type: "boolean"},
+			"vlantag":              {Type: "integer"},
+		},
+		"ipfix_connection/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"ipfix_connection/ipfix_connection": {
+			"comment": {Type: "string"},
+			"host":    {Type: "string"},
+			"name":    {Type: "string"},
+			"oid":     {Type: "integer"},
+			"status":  {Type: "boolean"},
+		},
+		"ips/exception": {
+			"comment":              {Type: "string"},
+			"destination_networks": {Type: "array"},
+			"name":                 {Type: "string"},
+			"operator":             {Type: "string"},
+			"services":             {Type: "array"},
+			"skiplist":             {Type: "array"},
+			"source_networks":      {Type: "array"},
+			"status":               {Type: "boolean"},
+		},
+		"ips/group": {
+			"action":       {Type: "string"},
+			"age":          {Type: "integer"},
+			"comment":      {Type: "string"},
+			"id":           {Type: "string"},
+			"name":         {Type: "string"},
+			"notification": {Type: "boolean"},
+			"status":       {Type: "boolean"},
+			"subgroups":    {Type: "array"},
+			"warnings":     {Type: "boolean"},
+		},
+		"ips/rule": {
+			"action":  {Type: "string", Enum: []string{"alert", "drop"}},
+			"comment": {Type: "string"},
+			"filter1": {Type: "string"},
+			"filter2": {Type: "string"},
+			"msg":     {Type: "string"},
+			"name":    {Type: "string"},
+			"sid":     {Type: "integer"},
+			"status":  {Type: "boolean"},
+		},
+		"ips/rule_modifier": {
+			"action":       {Type: "string", Enum: []string{"alert", "drop"}},
+			"comment":      {Type: "string"},
+			"name":         {Type: "string"},
+			"notification": {Type: "boolean"},
+			"sid":          {Type: "integer"},
+			"status":       {Type: "boolean"},
+		},
+		"ipsec/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"ipsec/policy": {
+			"comment":             {Type: "string"},
+			"ike_auth_alg":        {Type: "string"},
+			"ike_dh_group":        {Type: "string"},
+			"ike_enc_alg":         {Type: "string"},
+			"ike_sa_lifetime":     {Type: "integer"},
+			"ipsec_auth_alg":      {Type: "string"},
+			"ipsec_compression":   {Type: "boolean"},
+			"ipsec_enc_alg":       {Type: "string"},
+			"ipsec_pfs_group":     {Type: "string"},
+			"ipsec_sa_lifetime":   {Type: "integer"},
+			"ipsec_strict_policy": {Type: "boolean"},
+			"name":                {Type: "string"},
+		},
+		"ipsec/remote_gateway": {
+			"authentication": {Type: "string"},
+			"comment":        {Type: "string"},
+			"ecn":            {Type: "boolean"},
+			"host":           {Type: "string"},
+			"name":           {Type: "string"},
+			"networks":       {Type: "array"},
+			"pmtu_discovery": {Type: "boolean"},
+			"xauth":          {Type: "boolean"},
+			"xauth_password": {Type: "string"},
+			"xauth_username": {Type: "string"},
+		},
+		"ipsec_connection/amazon_vpc": {
+			"authentication": {Type: "string"},
+			"comment":        {Type: "string"},
+			"interface":      {Type: "string"},
+			"name":           {Type: "string"},
+			"policy":         {Type: "string"},
+			"remote":         {Type: "string"},
+		},
+		"ipsec_connection/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"ipsec_connection/l2tp": {
+			"authentication_type":          {Type: "string"},
+			"certificate":                  {Type: "string"},
+			"comment":                      {Type: "string"},
+			"debug":                        {Type: "boolean"},
+			"interface":                    {Type: "string"},
+			"ip_assignment_dhcp":           {Type: "string"},
+			"ip_assignment_dhcp_interface": {Type: "string"},
+			"ip_assignment_mode":           {Type: "string"},
+			"ip_assignment_pool":           {Type: "string"},
+			"iphone_connection_name":       {Type: "string"},
+			"iphone_hostname":              {Type: "string"},
+			"iphone_status":                {Type: "boolean"},
+			"name":                         {Type: "string"},
+			"policy":                       {Type: "string"},
+			"psk":                          {Type: "string"},
+			"status":                       {Type: "boolean"},
+			"users":                        {Type: "array"},
+		},
+		"ipsec_connection/roadwarrior_ca": {
+			"authentication": {Type: "string"},
+			"comment":        {Type: "string"},
+			"interface":      {Type: "string"},
+			"ip_pool":        {Type: "string"},
+			"name":           {Type: "string"},
+			"networks":       {Type: "array"},
+			"policy":         {Type: "string"},
+			"status":         {Type: "boolean"},
+			"use_ip_pool":    {Type: "boolean"},
+			"users":          {Type: "array"},
+			"xauth":          {Type: "boolean"},
+		},
+		"ipsec_connection/roadwarrior_cisco": {
+			"aaa":                     {Type: "array"},
+			"auto_pf_in":              {Type: "string"},
+			"auto_pf_out":             {Type: "string"},
+			"auto_pfrule":             {Type: "boolean"},
+			"certificate":             {Type: "string"},
+			"comment":                 {Type: "string"},
+			"interface":               {Type: "string"},
+			"ip_assignment_pool":      {Type: "string"},
+			"iphone_connection_name":  {Type: "string"},
+			"iphone_hostname":         {Type: "string"},
+			"iphone_ondemand_domains": {Type: "array"},
+			"iphone_ondemand_enabled": {Type: "boolean"},
+			"iphone_ondemand_type":    {Type: "string", Enum: []string{"OnDemandMatchDomainsAlways", "OnDemandMatchDomainsOnRetry"}},
+			"iphone_status":           {Type: "boolean"},
+			"name":                    {Type: "string"},
+			"networks":                {Type: "array"},
+			"policy":                  {Type: "string"},
+			"status":                  {Type: "boolean"},
+		},
+		"ipsec_connection/roadwarrior_psk": {
+			"authentication": {Type: "string"},
+			"comment":        {Type: "string"},
+			"interface":      {Type: "string"},
+			"ip_pool":        {Type: "string"},
+			"name":           {Type: "string"},
+			"networks":       {Type: "array"},
+			"policy":         {Type: "string"},
+			"status":         {Type: "boolean"},
+			"use_ip_pool":    {Type: "boolean"},
+			"users":          {Type: "array"},
+			"xauth":          {Type: "boolean"},
+		},
+		"ipsec_connection/roadwarrior_x509": {
+			"auto_pf_in":  {Type: "string"},
+			"auto_pf_out": {Type: "string"},
+			"auto_pfrule": {Type: "boolean"},
+			"comment":     {Type: "string"},
+			"interface":   {Type: "string"},
+			"ip_pool":     {Type: "string"},
+			"name":        {Type: "string"},
+			"networks":    {Type: "array"},
+			"policy":      {Type: "string"},
+			"status":      {Type: "boolean"},
+			"use_ip_pool": {Type: "boolean"},
+			"users":       {Type: "array"},
+			"xauth":       {Type: "boolean"},
+		},
+		"ipsec_connection/site_to_site": {
+			"auto_pf_in":     {Type: "string"},
+			"auto_pf_out":    {Type: "string"},
+			"auto_pfrule":    {Type: "boolean"},
+			"bind":           {Type: "boolean"},
+			"comment":        {Type: "string"},
+			"interface":      {Type: "string"},
+			"name":           {Type: "string"},
+			"networks":       {Type: "array"},
+			"policy":         {Type: "string"},
+			"remote_gateway": {Type: "string"},
+			"status":         {Type: "boolean"},
+			"strict_routing": {Type: "boolean"},
+		},
+		"ipsec_remote_auth/ca": {
+			"certificate": {Type: "string"},
+			"comment":     {Type: "string"},
+			"name":        {Type: "string"},
+			"vpn_id":      {Type: "string"},
+		},
+		"ipsec_remote_auth/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"ipsec_remote_auth/psk": {
+			"comment":     {Type: "string"},
+			"name":        {Type: "string"},
+			"psk":         {Type: "string"},
+			"vpn_id":      {Type: "string"},
+			"vpn_id_type": {Type: "string"},
+		},
+		"ipsec_remote_auth/rsa": {
+			"comment":     {Type: "string"},
+			"name":        {Type: "string"},
+			"pubkey":      {Type: "string"},
+			"vpn_id":      {Type: "string"},
+			"vpn_id_type": {Type: "string", Enum: []string{"ipv4_address", "fqdn", "user_fqdn"}},
+		},
+		"ipsec_remote_auth/x509": {
+			"certificate": {Type: "string"},
+			"comment":     {Type: "string"},
+			"name":        {Type: "string"},
+			"vpn_id":      {Type: "string"},
+			"vpn_id_type": {Type: "string"},
+		},
+		"itfhw/awe_network": {
+			"ap_bridgemode":      {Type: "string"},
+			"bridge":             {Type: "string"},
+			"client_isolation":   {Type: "boolean"},
+			"comment":            {Type: "string"},
+			"crypto_alg":         {Type: "string"},
+			"description":        {Type: "string"},
+			"dot11r":             {Type: "boolean"},
+			"dynamic_vlan":       {Type: "integer"},
+			"encryption_mode":    {Type: "string"},
+			"freq_bands":         {Type: "string"},
+			"hardware":           {Type: "string"},
+			"hide_ssid":          {Type: "boolean"},
+			"interface_name":     {Type: "string"},
+			"mac":                {Type: "string"},
+			"mac_filter":         {Type: "string"},
+			"mac_list":           {Type: "string"},
+			"mesh_id":            {Type: "string"},
+			"mesh_mode":          {Type: "string"},
+			"mesh_subtag":        {Type: "string"},
+			"name":               {Type: "string"},
+			"network_mode":       {Type: "string"},
+			"network_name":       {Type: "string"},
+			"psk":                {Type: "string"},
+			"r0kh_secret":        {Type: "string"},
+			"ssid":               {Type: "string"},
+			"ssid_vlantag":       {Type: "integer"},
+			"status":             {Type: "boolean"},
+			"time_scheduling":    {Type: "boolean"},
+			"time_select":        {Type: "array"},
+			"uapsd":              {Type: "boolean"},
+			"utf8_ssid":          {Type: "boolean"},
+			"vlantag":            {Type: "string"},
+			"wep128":             {Type: "string"},
+			"wep_authentication": {Type: "string"},
+		},
+		"itfhw/awe_network_group": {
+			"ap_vlantag":  {Type: "integer"},
+			"comment":     {Type: "string"},
+			"members":     {Type: "array"},
+			"name":        {Type: "string"},
+			"status":      {Type: "boolean"},
+			"vlantagging": {Type: "boolean"},
+		},
+		"itfhw/bridge": {
+			"comment":     {Type: "string"},
+			"description": {Type: "string"},
+			"hardware":    {Type: "string"},
+			"mac":         {Type: "string"},
+			"name":        {Type: "string"},
+		},
+		"itfhw/ethernet": {
+			"auto_negotiation":        {Type: "boolean"},
+			"auto_negotiation_status": {Type: "boolean"},
+			"comment":                 {Type: "string"},
+			"description":             {Type: "string"},
+			"duplex":                  {Type: "string"},
+			"hardware":                {Type: "string"},
+			"irq":                     {Type: "integer"},
+			"link_monitoring":         {Type: "boolean"},
+			"mac":                     {Type: "string"},
+			"mii":                     {Type: "boolean"},
+			"name":                    {Type: "string"},
+			"pcidev":                  {Type: "string"},
+			"poe_enabled":             {Type: "boolean"},
+			"poe_status":              {Type: "string"},
+			"slot":                    {Type: "string"},
+			"speed":                   {Type: "string"},
+			"supported_link_modes":    {Type: "string"},
+			"virtual_mac":             {Type: "string"},
+		},
+		"itfhw/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"itfhw/lag": {
+			"comment":         {Type: "string"},
+			"description":     {Type: "string"},
+			"hardware":        {Type: "string"},
+			"link_monitoring": {Type: "boolean"},
+			"mac":             {Type: "string"},
+			"name":            {Type: "string"},
+		},
+		"itfhw/red_client": {
+			"comment":                      {Type: "string"},
+			"description":                  {Type: "string"},
+			"hardware":                     {Type: "string"},
+			"hub_ca":                       {Type: "string"},
+			"hub_host":                     {Type: "string"},
+			"local_cert":                   {Type: "string"},
+			"local_key":                    {Type: "string"},
+			"mac":                          {Type: "string"},
+			"name":                         {Type: "string"},
+			"status":                       {Type: "boolean"},
+			"tunnel_compression":           {Type: "boolean"},
+			"tunnel_compression_algorithm": {Type: "string", Enum: []string{"deflate", "lzo", "gzip"}},
+			"tunnel_id":                    {Type: "integer"},
+		},
+		"itfhw/red_server": {
+			"activate_modem":               {Type: "boolean"},
+			"apn":                          {Type: "string"},
+			"authorized":                   {Type: "boolean"},
+			"bridge_address":               {Type: "string"},
+			"bridge_netmask":               {Type: "integer"},
+			"bridge_proto":                 {Type: "string", Enum: []string{"dhcp", "static", "none"}},
+			"comment":                      {Type: "string"},
+			"debug_level":                  {Type: "integer"},
+			"deployment_mode":              {Type: "string", Enum: []string{"online", "offline"}},
+			"description":                  {Type: "string"},
+			"dial_string":                  {Type: "string"},
+			"failover_direct":              {Type: "boolean"},
+			"fast_failover":                {Type: "boolean"},
+			"fullbr_dns":                   {Type: "string"},
+			"fullbr_domains":               {Type: "array"},
+			"hardware":                     {Type: "string"},
+			"hostname_balancing":           {Type: "string", Enum: []string{"balance", "failover"}},
+			"hub2_hostname":                {Type: "string"},
+			"hub_hostname":                 {Type: "string"},
+			"lan1_mode":                    {Type: "string", Enum: []string{"tagged", "untagged", "untagged_drop_tagged", "unused"}},
+			"lan1_vids":                    {Type: "string"},
+			"lan2_mode":                    {Type: "string", Enum: []string{"tagged", "untagged", "untagged_drop_tagged", "unused"}},
+			"lan2_vids":                    {Type: "string"},
+			"lan3_mode":                    {Type: "string", Enum: []string{"tagged", "untagged", "untagged_drop_tagged", "unused"}},
+			"lan3_vids":                    {Type: "string"},
+			"lan4_mode":                    {Type: "string", Enum: []string{"tagged", "untagged", "untagged_drop_tagged", "unused"}},
+			"lan4_vids":                    {Type: "string"},
+			"lanport_mode":                 {Type: "string", Enum: []string{"switch", "vlan"}},
+			"local_networks":               {Type: "array"},
+			"local_networks_target":        {Type: "string"},
+			"mac":                          {Type: "string"},
+			"mac_filter_entries_red10":     {Type: "integer"},
+			"mac_filter_entries_red15":     {Type: "integer"},
+			"mac_filter_entries_red15w":    {Type: "integer"},
+			"mac_filter_entries_red50":     {Type: "integer"},
+			"mac_filter_list":              {Type: "string"},
+			"mac_filter_type":              {Type: "string", Enum: []string{"none", "whitelist", "blacklist"}},
+			"manual2_address":              {Type: "string"},
+			"manual2_defgw":                {Type: "string"},
+			"manual2_dns":                  {Type: "string"},
+			"manual2_netmask":              {Type: "integer"},
+			"manual_address":               {Type: "string"},
+			"manual_defgw":                 {Type: "string"},
+			"manual_dns":                   {Type: "string"},
+			"manual_netmask":               {Type: "integer"},
+			"mobile_network":               {Type: "string", Enum: []string{"gsm", "cdma"}},
+			"name":                         {Type: "string"},
+			"password":                     {Type: "string"},
+			"pin":                          {Type: "integer"},
+			"pin_as_string":                {Type: "string"},
+			"prev_unlock_code":             {Type: "string"},
+			"red_id":                       {Type: "string"},
+			"remote_cert":                  {Type: "string"},
+			"route_mode":                   {Type: "string", Enum: []string{"default", "split", "fullbr"}},
+			"split_networks":               {Type: "array"},
+			"state":                        {Type: "string", Enum: []string{"initializing", "runnable", "notbound"}},
+			"status":                       {Type: "boolean"},
+			"tunnel_compression":           {Type: "boolean"},
+			"tunnel_compression_algorithm": {Type: "string", Enum: []string{"deflate", "lzo", "gzip"}},
+			"tunnel_id":                    {Type: "integer"},
+			"tunnel_state":                 {Type: "boolean"},
+			"type":                         {Type: "string", Enum: []string{"red", "red15", "red15w", "red50", "asg", "software"}},
+			"umts_state":                   {Type: "string", Enum: []string{"READY", "PIN", "PUK"}},
+			"unlock_code":                  {Type: "string"},
+			"uplink2_mode":                 {Type: "string", Enum: []string{"dhcp", "manual"}},
+			"uplink_balancing":             {Type: "string", Enum: []string{"balance", "failover"}},
+			"uplink_mode":                  {Type: "string", Enum: []string{"dhcp", "manual"}},
+			"username":                     {Type: "string"},
+		},
+		"itfhw/serial": {
+			"baud":        {Type: "string"},
+			"comment":     {Type: "string"},
+			"description": {Type: "string"},
+			"hardware":    {Type: "string"},
+			"irq":         {Type: "integer"},
+			"name":        {Type: "string"},
+			"port":        {Type: "string"},
+		},
+		"itfhw/usbserial": {
+			"comment":     {Type: "string"},
+			"control":     {Type: "string"},
+			"description": {Type: "string"},
+			"hardware":    {Type: "string"},
+			"name":        {Type: "string"},
+			"product":     {Type: "string"},
+			"vendor":      {Type: "string"},
+		},
+		"itfhw/virtual": {
+			"comment":     {Type: "string"},
+			"description": {Type: "string"},
+			"hardware":    {Type: "string", Enum: []string{"6to4", "aiccu", "tspc", "teredo", "he.net"}},
+			"name":        {Type: "string"},
+		},
+		"itfparams/bridge_port": {
+			"comment":      {Type: "string"},
+			"itfhw":        {Type: "string"},
+			"name":         {Type: "string"},
+			"status":       {Type: "boolean"},
+			"stp_pathcost": {Type: "integer"},
+			"stp_portprio": {Type: "integer"},
+		},
+		"itfparams/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"itfparams/link_aggregation_group": {
+			"ad_select":        {Type: "integer"},
+			"arp_interval":     {Type: "integer"},
+			"arp_ip_target":    {Type: "string"},
+			"comment":          {Type: "string"},
+			"downdelay":        {Type: "integer"},
+			"enforce_mac":      {Type: "boolean"},
+			"id":               {Type: "integer"},
+			"itfhw":            {Type: "array"},
+			"lacp_rate":        {Type: "integer"},
+			"mac":              {Type: "string"},
+			"miimon":           {Type: "integer"},
+			"mode":             {Type: "integer"},
+			"name":             {Type: "string"},
+			"primary":          {Type: "string"},
+			"status":           {Type: "boolean"},
+			"updelay":          {Type: "integer"},
+			"use_carrier":      {Type: "boolean"},
+			"virtual_mac":      {Type: "string"},
+			"xmit_hash_policy": {Type: "string"},
+		},
+		"itfparams/primary": {
+			"address":                  {Type: "string"},
+			"address6":                 {Type: "string"},
+			"comment":                  {Type: "string"},
+			"default_gateway_address":  {Type: "string"},
+			"default_gateway_address6": {Type: "string"},
+			"default_gateway_status":   {Type: "boolean"},
+			"default_gateway_status6":  {Type: "boolean"},
+			"dhcpv6_rapid_commit":      {Type: "boolean"},
+			"dns_server_1":             {Type: "string"},
+			"dns_server_2":             {Type: "string"},
+			"dns_server_3":             {Type: "string"},
+			"dns_server_4":             {Type: "string"},
+			"gateway_type":             {Type: "string"},
+			"gateway_type6":            {Type: "string"},
+			"hostname":                 {Type: "string"},
+			"interface_address":        {Type: "string"},
+			"interface_broadcast":      {Type: "string"},
+			"interface_network":        {Type: "string"},
+			"name":                     {Type: "string"},
+			"netmask":                  {Type: "integer"},
+			"netmask6":                 {Type: "integer"},
+			"pd_address6":              {Type: "string"},
+			"pd_netmask6":              {Type: "integer"},
+			"pd_resolved6":             {Type: "boolean"},
+			"resolved":                 {Type: "boolean"},
+			"resolved6":                {Type: "boolean"},
+			"six2four":                 {Type: "boolean"},
+			"type":                     {Type: "string"},
+			"type6":                    {Type: "string"},
+		},
+		"itfparams/secondary": {
+			"address":             {Type: "string"},
+			"address6":            {Type: "string"},
+			"comment":             {Type: "string"},
+			"ha_node":             {Type: "integer"},
+			"id":                  {Type: "string"},
+			"interface_address":   {Type: "string"},
+			"interface_broadcast": {Type: "string"},
+			"interface_network":   {Type: "string"},
+			"name":                {Type: "string"},
+			"netmask":             {Type: "integer"},
+			"netmask6":            {Type: "integer"},
+			"resolved":            {Type: "boolean"},
+			"resolved6":           {Type: "boolean"},
+			"status":              {Type: "boolean"},
+			"type":                {Type: "string", Enum: []string{"static"}},
+			"type6":               {Type: "string", Enum: []string{"static"}},
+		},
+		"mac_list/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"mac_list/mac_list": {
+			"address_list": {Type: "array"},
+			"comment":      {Type: "string"},
+			"host_list":    {Type: "array"},
+			"name":         {Type: "string"},
+		},
+		"network/aaa": {
+			"addresses":  {Type: "array"},
+			"addresses6": {Type: "array"},
+			"comment":    {Type: "string"},
+			"name":       {Type: "string"},
+			"resolved":   {Type: "boolean"},
+			"resolved6":  {Type: "boolean"},
+		},
+		"network/any": {
+			"address":   {Type: "string"},
+			"address6":  {Type: "string"},
+			"comment":   {Type: "string"},
+			"interface": {Type: "string"},
+			"name":      {Type: "string"},
+			"netmask":   {Type: "integer"},
+			"netmask6":  {Type: "integer"},
+			"resolved":  {Type: "boolean"},
+			"resolved6": {Type: "boolean"},
+		},
+		"network/availability_group": {
+			"address":    {Type: "string"},
+			"address6":   {Type: "string"},
+			"check_data": {Type: "string"},
+			"check_port": {Type: "integer"},
+			"check_type": {Type: "string", Enum: []string{"icmp", "udp", "tcp", "http", "https"}},
+			"comment":    {Type: "string"},
+			"interface":  {Type: "string"},
+			"members":    {Type: "array"},
+			"name":       {Type: "string"},
+			"resolved":   {Type: "boolean"},
+			"resolved6":  {Type: "boolean"},
+			"sticky":     {Type: "boolean"},
+			"timeout":    {Type: "integer"},
+			"timeout2":   {Type: "integer"},
+		},
+		"network/dns_group": {
+			"addresses":  {Type: "array"},
+			"addresses6": {Type: "array"},
+			"comment":    {Type: "string"},
+			"hostname":   {Type: "string"},
+			"interface":  {Type: "string"},
+			"name":       {Type: "string"},
+			"resolved":   {Type: "boolean"},
+			"resolved6":  {Type: "boolean"},
+			"timeout":    {Type: "integer"},
+		},
+		"network/dns_host": {
+			"address":   {Type: "string"},
+			"address6":  {Type: "string"},
+			"comment":   {Type: "string"},
+			"hostname":  {Type: "string"},
+			"interface": {Type: "string"},
+			"name":      {Type: "string"},
+			"resolved":  {Type: "boolean"},
+			"resolved6": {Type: "boolean"},
+			"timeout":   {Type: "integer"},
+		},
+		"network/group": {
+			"comment": {Type: "string"},
+			"members": {Type: "array"},
+			"name":    {Type: "string"},
+			"types":   {Type: "array"},
+		},
+		"network/host": {
+			"address":     {Type: "string"},
+			"address6":    {Type: "string"},
+			"comment":     {Type: "string"},
+			"duids":       {Type: "array"},
+			"hostnames":   {Type: "array"},
+			"interface":   {Type: "string"},
+			"macs":        {Type: "array"},
+			"name":        {Type: "string"},
+			"resolved":    {Type: "boolean"},
+			"resolved6":   {Type: "boolean"},
+			"reverse_dns": {Type: "boolean"},
+		},
+		"network/interface_address": {
+			"address":   {Type: "string"},
+			"address6":  {Type: "string"},
+			"comment":   {Type: "string"},
+			"name":      {Type: "string"},
+			"resolved":  {Type: "boolean"},
+			"resolved6": {Type: "boolean"},
+		},
+		"network/interface_broadcast": {
+			"address":  {Type: "string"},
+			"comment":  {Type: "string"},
+			"name":     {Type: "string"},
+			"resolved": {Type: "boolean"},
+		},
+		"network/interface_network": {
+			"address":   {Type: "string"},
+			"address6":  {Type: "string"},
+			"comment":   {Type: "string"},
+			"name":      {Type: "string"},
+			"netmask":   {Type: "integer"},
+			"netmask6":  {Type: "integer"},
+			"resolved":  {Type: "boolean"},
+			"resolved6": {Type: "boolean"},
+		},
+		"network/multicast": {
+			"address":   {Type: "string"},
+			"comment":   {Type: "string"},
+			"interface": {Type: "string"},
+			"name":      {Type: "string"},
+			"netmask":   {Type: "integer"},
+			"resolved":  {Type: "boolean"},
+		},
+		"network/network": {
+			"address":   {Type: "string"},
+			"address6":  {Type: "string"},
+			"comment":   {Type: "string"},
+			"interface": {Type: "string"},
+			"name":      {Type: "string"},
+			"netmask":   {Type: "integer"},
+			"netmask6":  {Type: "integer"},
+			"resolved":  {Type: "boolean"},
+			"resolved6": {Type: "boolean"},
+		},
+		"network/range": {
+			"comment":   {Type: "string"},
+			"from":      {Type: "string"},
+			"from6":     {Type: "string"},
+			"interface": {Type: "string"},
+			"name":      {Type: "string"},
+			"resolved":  {Type: "boolean"},
+			"resolved6": {Type: "boolean"},
+			"to":        {Type: "string"},
+			"to6":       {Type: "string"},
+		},
+		"notification/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"notification/notification": {
+			"comment": {Type: "string"},
+			"email":   {Type: "boolean"},
+			"id":      {Type: "string"},
+			"name":    {Type: "string"},
+			"snmp":    {Type: "boolean"},
+		},
+		"ospf/area": {
+			"authentication": {Type: "string", Enum: []string{"message-digest", "plain-text", "null"}},
+			"comment":        {Type: "string"},
+			"default_cost":   {Type: "integer"},
+			"id":             {Type: "string"},
+			"interfaces":     {Type: "array"},
+			"name":           {Type: "string"},
+			"type":           {Type: "string", Enum: []string{"normal", "stub", "nssa", "stub no-summary", "nssa no-summary"}},
+			"virtual_links":  {Type: "array"},
+		},
+		"ospf/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"ospf/interface": {
+			"authentication":      {Type: "string", Enum: []string{"message-digest", "plain-text", "null"}},
+			"authentication_key":  {Type: "string"},
+			"comment":             {Type: "string"},
+			"cost":                {Type: "integer"},
+			"dead_interval":       {Type: "integer"},
+			"hello_interval":      {Type: "integer"},
+			"interface":           {Type: "string"},
+			"message_digest_keys": {Type: "array"},
+			"name":                {Type: "string"},
+			"priority":            {Type: "integer"},
+			"retransmit_interval": {Type: "integer"},
+			"transmit_delay":      {Type: "integer"},
+		},
+		"ospf/message_digest_key": {
+			"comment":               {Type: "string"},
+			"message_digest_key":    {Type: "string"},
+			"message_digest_key_id": {Type: "integer"},
+			"name":                  {Type: "string"},
+		},
+		"override/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"override/objref": {
+			"attr":      {Type: "string"},
+			"comment":   {Type: "string"},
+			"condition": {Type: "string"},
+			"name":      {Type: "string"},
+			"ref":       {Type: "string"},
+			"value":     {Type: "string"},
+		},
+		"packetfilter/1to1nat": {
+			"auto_pf_in":  {Type: "string"},
+			"auto_pfrule": {Type: "boolean"},
+			"comment":     {Type: "string"},
+			"destination": {Type: "string"},
+			"group":       {Type: "string"},
+			"log":         {Type: "boolean"},
+			"map_to":      {Type: "string"},
+			"mode":        {Type: "string", Enum: []string{"mapsrc", "mapdst"}},
+			"name":        {Type: "string"},
+			"service":     {Type: "string"},
+			"source":      {Type: "string"},
+			"status":      {Type: "boolean"},
+		},
+		"packetfilter/generic_proxy": {
+			"allowed_networks": {Type: "array"},
+			"comment":          {Type: "string"},
+			"ininterface":      {Type: "string"},
+			"name":             {Type: "string"},
+			"service":          {Type: "string"},
+			"status":           {Type: "boolean"},
+			"tohost":           {Type: "string"},
+			"toservice":        {Type: "string"},
+		},
+		"packetfilter/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"packetfilter/loadbalance": {
+			"auto_pf_in":              {Type: "string"},
+			"auto_pfrule":             {Type: "boolean"},
+			"comment":                 {Type: "string"},
+			"destination":             {Type: "string"},
+			"destination_nat_group":   {Type: "array"},
+			"destination_nat_status":  {Type: "object"},
+			"destination_nat_status6": {Type: "object"},
+			"name":                    {Type: "string"},
+			"scheduler":               {Type: "string"},
+			"service":                 {Type: "string"},
+			"shutdown_address":        {Type: "boolean"},
+			"shutdown_condition":      {Type: "string"},
+			"shutdown_override":       {Type: "string"},
+			"status":                  {Type: "boolean"},
+		},
+		"packetfilter/mangle": {
+			"action":      {Type: "array"},
+			"comment":     {Type: "string"},
+			"destination": {Type: "string"},
+			"direction":   {Type: "string", Enum: []string{"in", "out"}},
+			"name":        {Type: "string"},
+			"service":     {Type: "string"},
+			"source":      {Type: "string"},
+			"status":      {Type: "boolean"},
+		},
+		"packetfilter/masq": {
+			"additional_address":         {Type: "string"},
+			"additional_address_restore": {Type: "string"},
+			"comment":                    {Type: "string"},
+			"name":                       {Type: "string"},
+			"source":                     {Type: "string"},
+			"source_nat_interface":       {Type: "string"},
+			"status":                     {Type: "boolean"},
+		},
+		"packetfilter/nat": {
+			"auto_pf_in":              {Type: "string"},
+			"auto_pfrule":             {Type: "boolean"},
+			"comment":                 {Type: "string"},
+			"destination":             {Type: "string"},
+			"destination_nat_address": {Type: "string"},
+			"destination_nat_service": {Type: "string"},
+			"group":                   {Type: "string"},
+			"ipsec":                   {Type: "boolean"},
+			"log":                     {Type: "boolean"},
+			"mode":                    {Type: "string", Enum: []string{"dnat", "snat", "fullnat", "nonat"}},
+			"name":                    {Type: "string"},
+			"service":                 {Type: "string"},
+			"source":                  {Type: "string"},
+			"source_nat_address":      {Type: "string"},
+			"source_nat_service":      {Type: "string"},
+			"status":                  {Type: "boolean"},
+		},
+		"packetfilter/packetfilter": {
+			"action":               {Type: "string", Enum: []string{"accept", "drop", "reject"}},
+			"auto":                 {Type: "boolean"},
+			"auto_type":            {Type: "string"},
+			"comment":              {Type: "string"},
+			"destinations":         {Type: "array"},
+			"direction":            {Type: "string"},
+			"group":                {Type: "string"},
+			"interface":            {Type: "string"},
+			"log":                  {Type: "boolean"},
+			"name":                 {Type: "string"},
+			"services":             {Type: "array"},
+			"source_mac_addresses": {Type: "string"},
+			"sources":              {Type: "array"},
+			"status":               {Type: "boolean"},
+			"time":                 {Type: "string"},
+		},
+		"packetfilter/ruleset": {
+			"comment":      {Type: "string"},
+			"name":         {Type: "string"},
+			"rules":        {Type: "array"},
+			"rules_status": {Type: "object"},
+			"status":       {Type: "boolean"},
+		},
+		"pim_sm/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"pim_sm/interface": {
+			"comment":       {Type: "string"},
+			"dr_priority":   {Type: "integer"},
+			"igmp_versions": {Type: "array"},
+			"interface":     {Type: "string"},
+			"name":          {Type: "string"},
+		},
+		"pim_sm/route": {
+			"comment":   {Type: "string"},
+			"gateway":   {Type: "string"},
+			"interface": {Type: "string"},
+			"name":      {Type: "string"},
+			"network":   {Type: "string"},
+			"status":    {Type: "boolean"},
+			"type":      {Type: "string", Enum: []string{"gateway", "interface"}},
+		},
+		"pim_sm/rp_router": {
+			"comment":          {Type: "string"},
+			"host":             {Type: "string"},
+			"multicast_groups": {Type: "array"},
+			"name":             {Type: "string"},
+			"rp_priority":      {Type: "integer"},
+		},
+		"pop3/account": {
+			"comment":  {Type: "string"},
+			"name":     {Type: "string"},
+			"password": {Type: "string"},
+			"server":   {Type: "string"},
+			"username": {Type: "string"},
+		},
+		"pop3/exception": {
+			"client":   {Type: "array"},
+			"comment":  {Type: "string"},
+			"name":     {Type: "string"},
+			"sender":   {Type: "array"},
+			"skiplist": {Type: "array"},
+			"status":   {Type: "boolean"},
+		},
+		"pop3/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"pop3/server": {
+			"comment":  {Type: "string"},
+			"hosts":    {Type: "array"},
+			"name":     {Type: "string"},
+			"tls_cert": {Type: "string"},
+		},
+		"qos/application_selector": {
+			"applications":              {Type: "array"},
+			"comment":                   {Type: "string"},
+			"connbytes":                 {Type: "integer"},
+			"connbytes_upperlimit":      {Type: "boolean"},
+			"destination":               {Type: "string"},
+			"group_filter_productivity": {Type: "integer"},
+			"group_filter_risk":         {Type: "integer"},
+			"groups":                    {Type: "array"},
+			"name":                      {Type: "string"},
+			"source":                    {Type: "string"},
+		},
+		"qos/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"qos/ingress_rule": {
+			"comment":           {Type: "string"},
+			"limit":             {Type: "integer"},
+			"mode":              {Type: "string", Enum: []string{",", "srcip", "dstip", "srcip,dstip"}},
+			"name":              {Type: "string"},
+			"status":            {Type: "boolean"},
+			"traffic_selectors": {Type: "array"},
+		},
+		"qos/interface": {
+			"comment":            {Type: "string"},
+			"downlink":           {Type: "integer"},
+			"downlink_optimizer": {Type: "boolean"},
+			"ingress_rules":      {Type: "array"},
+			"interface":          {Type: "string"},
+			"name":               {Type: "string"},
+			"rules":              {Type: "array"},
+			"status":             {Type: "boolean"},
+			"uplink":             {Type: "integer"},
+			"uplink_limit":       {Type: "boolean"},
+			"uplink_optimizer":   {Type: "boolean"},
+		},
+		"qos/rule": {
+			"bandwidth":          {Type: "integer"},
+			"comment":            {Type: "string"},
+			"name":               {Type: "string"},
+			"status":             {Type: "boolean"},
+			"traffic_selectors":  {Type: "array"},
+			"upper_limit_status": {Type: "boolean"},
+			"upper_limit_value":  {Type: "integer"},
+		},
+		"qos/traffic_selector": {
+			"comment":              {Type: "string"},
+			"connbytes":            {Type: "integer"},
+			"connbytes_upperlimit": {Type: "boolean"},
+			"destination":          {Type: "string"},
+			"dscp_string":          {Type: "string", Enum: []string{"BE", "AF11", "AF12", "AF13", "AF21", "AF22", "AF23", "AF31", "AF32", "AF33", "AF41", "AF42", "AF43", "CS1", "CS2", "CS3", "CS4", "CS5", "CS6", "CS7", "EF"}},
+			"dscp_type":            {Type: "string", Enum: []string{"off", "value", "class"}},
+			"dscp_value":           {Type: "integer"},
+			"helper":               {Type: "string"},
+			"name":                 {Type: "string"},
+			"packet_length":        {Type: "string"},
+			"service":              {Type: "string"},
+			"source":               {Type: "string"},
+			"tcp_flags":            {Type: "array"},
+			"tos":                  {Type: "string", Enum: []string{"off", "normal", "min_cost", "max_reliable", "max_throughput", "min_delay"}},
+		},
+		"qos/traffic_selector_group": {
+			"comment": {Type: "string"},
+			"members": {Type: "array"},
+			"name":    {Type: "string"},
+		},
+		"remote_syslog/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"remote_syslog/server": {
+			"comment":    {Type: "string"},
+			"local_addr": {Type: "string"},
+			"name":       {Type: "string"},
+			"port":       {Type: "string"},
+			"server":     {Type: "string"},
+		},
+		"reporting/department": {
+			"comment":  {Type: "string"},
+			"name":     {Type: "string"},
+			"networks": {Type: "array"},
+			"users":    {Type: "array"},
+		},
+		"reporting/filter": {
+			"callname":   {Type: "string"},
+			"comment":    {Type: "string"},
+			"department": {Type: "string"},
+			"filter":     {Type: "object"},
+			"name":       {Type: "string"},
+			"sort_by":    {Type: "string"},
+			"sort_dir":   {Type: "string"},
+			"subsystem":  {Type: "string"},
+			"timeframe":  {Type: "string"},
+			"top":        {Type: "integer"},
+		},
+		"reporting/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"reporting/mail": {
+			"comment":    {Type: "string"},
+			"filters":    {Type: "array"},
+			"interval":   {Type: "string", Enum: []string{"daily", "weekly", "monthly"}},
+			"name":       {Type: "string"},
+			"recipients": {Type: "array"},
+			"status":     {Type: "boolean"},
+		},
+		"reverse_proxy/auth_profile": {
+			"aaa":                                {Type: "array"},
+			"backend_mode":                       {Type: "string"},
+			"backend_strip_basic_auth":           {Type: "boolean"},
+			"backend_user_prefix":                {Type: "string"},
+			"backend_user_suffix":                {Type: "string"},
+			"basic_prompt":                       {Type: "string"},
+			"comment":                            {Type: "string"},
+			"frontend_cookie":                    {Type: "string"},
+			"frontend_cookie_secret":             {Type: "string"},
+			"frontend_form":                      {Type: "string"},
+			"frontend_form_template":             {Type: "string"},
+			"frontend_login":                     {Type: "string"},
+			"frontend_logout":                    {Type: "string"},
+			"frontend_mode":                      {Type: "string"},
+			"frontend_realm":                     {Type: "string"},
+			"frontend_session_allow_persistency": {Type: "boolean"},
+			"frontend_session_lifetime":          {Type: "integer"},
+			"frontend_session_lifetime_limited":  {Type: "boolean"},
+			"frontend_session_lifetime_scope":    {Type: "string"},
+			"frontend_session_timeout":           {Type: "integer"},
+			"frontend_session_timeout_enabled":   {Type: "boolean"},
+			"frontend_session_timeout_scope":     {Type: "string"},
+			"logout_delegation_urls":             {Type: "array"},
+			"logout_mode":                        {Type: "string"},
+			"name":                               {Type: "string"},
+			"redirect_to_requested_url":          {Type: "boolean"},
+		},
+		"reverse_proxy/backend": {
+			"comment":                            {Type: "string"},
+			"disable_backend_connection_pooling": {Type: "boolean"},
+			"host":                               {Type: "string"},
+			"keepalive":                          {Type: "boolean"},
+			"name":                               {Type: "string"},
+			"path":                               {Type: "string"},
+			"port":                               {Type: "integer"},
+			"ssl":                                {Type: "boolean"},
+			"status":                             {Type: "boolean"},
+			"timeout":                            {Type: "integer"},
+		},
+		"reverse_proxy/exception": {
+			"comment":                        {Type: "string"},
+			"name":                           {Type: "string"},
+			"op":                             {Type: "string", Enum: []string{"AND", "OR"}},
+			"path":                           {Type: "array"},
+			"skip_custom_threats_filters":    {Type: "array"},
+			"skip_threats_filter_categories": {Type: "array"},
+			"skipav":                         {Type: "boolean"},
+			"skipbadclients":                 {Type: "boolean"},
+			"skipcookie":                     {Type: "boolean"},
+			"skipform":                       {Type: "boolean"},
+			"skipform_missingtoken":          {Type: "boolean"},
+			"skiphtmlrewrite":                {Type: "boolean"},
+			"skiptft":                        {Type: "boolean"},
+			"skipurl":                        {Type: "boolean"},
+			"source":                         {Type: "array"},
+			"status":                         {Type: "boolean"},
+		},
+		"reverse_proxy/filter": {
+			"comment": {Type: "string"},
+			"expr":    {Type: "string"},
+			"name":    {Type: "string"},
+			"target":  {Type: "string", Enum: []string{"HTTP_REFERER", "REQUEST_URI", "THE_REQUEST"}},
+		},
+		"reverse_proxy/form_template": {
+			"assets":   {Type: "object"},
+			"comment":  {Type: "string"},
+			"filename": {Type: "string"},
+			"name":     {Type: "string"},
+			"template": {Type: "string"},
+		},
+		"reverse_proxy/frontend": {
+			"add_content_type_header": {Type: "boolean"},
+			"address":                 {Type: "string"},
+			"allowed_networks":        {Type: "array"},
+			"certificate":             {Type: "string"},
+			"comment":                 {Type: "string"},
+			"disable_compression":     {Type: "boolean"},
+			"domain":                  {Type: "array"},
+			"exceptions":              {Type: "array"},
+			"htmlrewrite":             {Type: "boolean"},
+			"htmlrewrite_cookies":     {Type: "boolean"},
+			"implicitredirect":        {Type: "boolean"},
+			"lbmethod":                {Type: "string"},
+			"locations":               {Type: "array"},
+			"min_tls":                 {Type: "string"},
+			"name":                    {Type: "string"},
+			"port":                    {Type: "integer"},
+			"preservehost":            {Type: "boolean"},
+			"profile":                 {Type: "string"},
+			"status":                  {Type: "boolean"},
+			"type":                    {Type: "string"},
+			"xheaders":                {Type: "boolean"},
+		},
+		"reverse_proxy/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"reverse_proxy/location": {
+			"access_control":        {Type: "string"},
+			"allowed_networks":      {Type: "array"},
+			"auth_profile":          {Type: "string"},
+			"backend":               {Type: "array"},
+			"be_path":               {Type: "string"},
+			"comment":               {Type: "string"},
+			"denied_networks":       {Type: "array"},
+			"hot_standby":           {Type: "boolean"},
+			"name":                  {Type: "string"},
+			"path":                  {Type: "string"},
+			"status":                {Type: "boolean"},
+			"stickysession_id":      {Type: "string"},
+			"stickysession_status":  {Type: "boolean"},
+			"websocket_passthrough": {Type: "boolean"},
+		},
+		"reverse_proxy/profile": {
+			"av":                              {Type: "boolean"},
+			"av_block_unscannable":            {Type: "boolean"},
+			"av_directions":                   {Type: "string"},
+			"av_engines":                      {Type: "string"},
+			"av_size_limit":                   {Type: "integer"},
+			"av_timeout":                      {Type: "integer"},
+			"bad_clients":                     {Type: "boolean"},
+			"bad_clients_no_dnslookup":        {Type: "boolean"},
+			"comment":                         {Type: "string"},
+			"cookiesign":                      {Type: "boolean"},
+			"cookiesign_drop_unsigned":        {Type: "boolean"},
+			"custom_threats_filters":          {Type: "array"},
+			"extensions":                      {Type: "array"},
+			"filter":                          {Type: "array"},
+			"filter_mode":                     {Type: "string"},
+			"formhardening":                   {Type: "boolean"},
+			"name":                            {Type: "string"},
+			"outlookanywhere":                 {Type: "boolean"},
+			"sec_request_body_no_files_limit": {Type: "integer"},
+			"skipwafrules":                    {Type: "array"},
+			"tft":                             {Type: "boolean"},
+			"tft_block_unscannable":           {Type: "boolean"},
+			"tft_blocked_mime_types":          {Type: "array"},
+			"threats_filter":                  {Type: "boolean"},
+			"threats_filter_categories":       {Type: "array"},
+			"threats_filter_rigid":            {Type: "boolean"},
+			"urlhardening":                    {Type: "boolean"},
+			"urlhardening_entrypages":         {Type: "array"},
+			"urlhardening_entrypages_source":  {Type: "string"},
+			"urlhardening_sitemap_update":     {Type: "integer"},
+			"urlhardening_sitemap_url":        {Type: "string"},
+			"waf":                             {Type: "boolean"},
+			"wafmode":                         {Type: "string"},
+			"wafparanoia":                     {Type: "boolean"},
+		},
+		"reverse_proxy/redirection": {
+			"comment":          {Type: "string"},
+			"frontend":         {Type: "string"},
+			"name":             {Type: "string"},
+			"response_code":    {Type: "string", Enum: []string{"301", "302", "303", "307", "308"}},
+			"source_path":      {Type: "string"},
+			"status":           {Type: "boolean"},
+			"target_host":      {Type: "string"},
+			"target_host_ipv6": {Type: "boolean"},
+			"target_path":      {Type: "string"},
+			"target_port":      {Type: "integer"},
+			"target_protocol":  {Type: "string", Enum: []string{"http", "https"}},
+		},
+		"reverse_proxy/threats_filter": {
+			"comment":           {Type: "string"},
+			"files":             {Type: "object"},
+			"name":              {Type: "string"},
+			"ordered_filenames": {Type: "array"},
+		},
+		"right/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"right/right": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"role/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"role/role": {
+			"comment":         {Type: "string"},
+			"members":         {Type: "array"},
+			"name":            {Type: "string"},
+			"rights":          {Type: "array"},
+			"webadmin_access": {Type: "boolean"},
+		},
+		"route/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"route/policy": {
+			"comment":     {Type: "string"},
+			"destination": {Type: "string"},
+			"interface":   {Type: "string"},
+			"name":        {Type: "string"},
+			"service":     {Type: "string"},
+			"source":      {Type: "string"},
+			"status":      {Type: "boolean"},
+			"target":      {Type: "string"},
+			"type":        {Type: "string", Enum: []string{"itf", "host"}},
+		},
+		"route/static": {
+			"comment": {Type: "string"},
+			"metric":  {Type: "integer"},
+			"name":    {Type: "string"},
+			"network": {Type: "string"},
+			"status":  {Type: "boolean"},
+			"target":  {Type: "string"},
+			"type":    {Type: "string"},
+		},
+		"scheduler/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"scheduler/loadbalance": {
+			"algorithm":        {Type: "string"},
+			"check_data":       {Type: "string"},
+			"check_hosts":      {Type: "array"},
+			"check_interval":   {Type: "integer"},
+			"check_port":       {Type: "integer"},
+			"check_timeout":    {Type: "integer"},
+			"check_type":       {Type: "string"},
+			"comment":          {Type: "string"},
+			"name":             {Type: "string"},
+			"persistence":      {Type: "boolean"},
+			"persistence_hash": {Type: "string"},
+			"persistence_size": {Type: "integer"},
+			"persistence_time": {Type: "integer"},
+			"weight":           {Type: "object"},
+		},
+		"scheduler/rule": {
+			"comment":          {Type: "string"},
+			"destination":      {Type: "string"},
+			"interface":        {Type: "string"},
+			"interface_group":  {Type: "string"},
+			"name":             {Type: "string"},
+			"persistence":      {Type: "boolean"},
+			"persistence_hash": {Type: "string"},
+			"service":          {Type: "string"},
+			"skip_on_error":    {Type: "boolean"},
+			"source":           {Type: "string"},
+			"status":           {Type: "boolean"},
+		},
+		"service/ah": {
+			"comment":  {Type: "string"},
+			"name":     {Type: "string"},
+			"spi_high": {Type: "integer"},
+			"spi_low":  {Type: "integer"},
+		},
+		"service/any": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"service/esp": {
+			"comment":  {Type: "string"},
+			"name":     {Type: "string"},
+			"spi_high": {Type: "integer"},
+			"spi_low":  {Type: "integer"},
+		},
+		"service/group": {
+			"comment": {Type: "string"},
+			"members": {Type: "array"},
+			"name":    {Type: "string"},
+			"types":   {Type: "array"},
+		},
+		"service/icmp": {
+			"code":    {Type: "integer"},
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+			"type":    {Type: "integer"},
+		},
+		"service/icmpv6": {
+			"code":    {Type: "integer"},
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+			"type":    {Type: "integer"},
+		},
+		"service/ip": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+			"proto":   {Type: "integer"},
+		},
+		"service/tcp": {
+			"auto_pf_svc_dst": {Type: "string"},
+			"auto_pf_svc_src": {Type: "string"},
+			"comment":         {Type: "string"},
+			"dst_high":        {Type: "integer"},
+			"dst_low":         {Type: "integer"},
+			"name":            {Type: "string"},
+			"src_high":        {Type: "integer"},
+			"src_low":         {Type: "integer"},
+		},
+		"service/tcpudp": {
+			"auto_pf_svc_dst": {Type: "string"},
+			"auto_pf_svc_src": {Type: "string"},
+			"comment":         {Type: "string"},
+			"dst_high":        {Type: "integer"},
+			"dst_low":         {Type: "integer"},
+			"name":            {Type: "string"},
+			"src_high":        {Type: "integer"},
+			"src_low":         {Type: "integer"},
+		},
+		"service/udp": {
+			"auto_pf_svc_dst": {Type: "string"},
+			"auto_pf_svc_src": {Type: "string"},
+			"comment":         {Type: "string"},
+			"dst_high":        {Type: "integer"},
+			"dst_low":         {Type: "integer"},
+			"name":            {Type: "string"},
+			"src_high":        {Type: "integer"},
+			"src_low":         {Type: "integer"},
+		},
+		"smtp/exception": {
+			"comment":    {Type: "string"},
+			"name":       {Type: "string"},
+			"networks":   {Type: "array"},
+			"recipients": {Type: "array"},
+			"senders":    {Type: "array"},
+			"skiplist":   {Type: "array"},
+			"status":     {Type: "boolean"},
+		},
+		"smtp/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"smtp/header_operation": {
+			"comment":     {Type: "string"},
+			"header_name": {Type: "string"},
+			"name":        {Type: "string"},
+			"operation":   {Type: "string", Enum: []string{"add", "delete"}},
+			"parameter":   {Type: "string"},
+		},
+		"smtp/profile": {
+			"ad_base_dn":                     {Type: "string"},
+			"batv":                           {Type: "boolean"},
+			"cff_av":                         {Type: "string"},
+			"cff_av_engines":                 {Type: "string"},
+			"cff_file_extensions":            {Type: "array"},
+			"comment":                        {Type: "string"},
+			"confidential_footer":            {Type: "string"},
+			"confidential_footer_status":     {Type: "boolean"},
+			"dlp_action":                     {Type: "string"},
+			"dlp_ccl_rules":                  {Type: "array"},
+			"dlp_custom_expressions":         {Type: "array"},
+			"dlp_notification_admin":         {Type: "boolean"},
+			"dlp_notification_other":         {Type: "boolean"},
+			"dlp_notification_other_address": {Type: "string"},
+			"dlp_notification_sender":        {Type: "boolean"},
+			"dlp_scan_attachments":           {Type: "boolean"},
+			"domains":                        {Type: "array"},
+			"global_add":                     {Type: "array"},
+			"global_copy":                    {Type: "array"},
+			"greylisting":                    {Type: "boolean"},
+			"header_modification":            {Type: "array"},
+			"mime_audio":                     {Type: "boolean"},
+			"mime_blacklist":                 {Type: "array"},
+			"mime_executable":                {Type: "boolean"},
+			"mime_video":                     {Type: "boolean"},
+			"mime_whitelist":                 {Type: "array"},
+			"name":                           {Type: "string"},
+			"rbl":                            {Type: "boolean"},
+			"rbl_extra":                      {Type: "array"},
+			"rcpt_ad_server":                 {Type: "string"},
+			"rcpt_verify":                    {Type: "string"},
+			"rdns_reject":                    {Type: "boolean"},
+			"rdns_reject_strict":             {Type: "boolean"},
+			"route_list":                     {Type: "array"},
+			"route_target":                   {Type: "string"},
+			"route_target_port":              {Type: "integer"},
+			"route_target_type":              {Type: "string"},
+			"sandbox_max_filesize_mb":        {Type: "integer"},
+			"sandbox_scan_status":            {Type: "boolean"},
+			"sender_blacklist":               {Type: "array"},
+			"spam":                           {Type: "string"},
+			"spam_expressions":               {Type: "array"},
+			"spamplus":                       {Type: "string"},
+			"spf":                            {Type: "boolean"},
+			"spx_template":                   {Type: "string"},
+			"status":                         {Type: "boolean"},
+			"unscannable":                    {Type: "string"},
+		},
+		"snmp/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"snmp/trap": {
+			"auth_password":    {Type: "string"},
+			"auth_type":        {Type: "string", Enum: []string{"MD5", "SHA"}},
+			"comment":          {Type: "string"},
+			"community":        {Type: "string"},
+			"encrypt_password": {Type: "string"},
+			"encrypt_type":     {Type: "string", Enum: []string{"None", "DES", "AES"}},
+			"engineid":         {Type: "string"},
+			"host":             {Type: "string"},
+			"name":             {Type: "string"},
+			"status":           {Type: "boolean"},
+			"username":         {Type: "string"},
+			"version":          {Type: "string", Enum: []string{"v2c", "v3"}},
+		},
+		"spx/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"spx/template": {
+			"comment":                            {Type: "string"},
+			"name":                               {Type: "string"},
+			"org_name":                           {Type: "string"},
+			"pdf_cover_page":                     {Type: "string"},
+			"pdf_cover_page_ext":                 {Type: "string"},
+			"pdf_cover_page_file":                {Type: "string"},
+			"pdf_encryption":                     {Type: "string"},
+			"pdf_language":                       {Type: "string"},
+			"pdf_page_size":                      {Type: "string"},
+			"portal_auth_required":               {Type: "boolean"},
+			"portal_footer_image":                {Type: "string"},
+			"portal_footer_image_ext":            {Type: "string"},
+			"portal_footer_image_file":           {Type: "string"},
+			"portal_header_image":                {Type: "string"},
+			"portal_header_image_ext":            {Type: "string"},
+			"portal_header_image_file":           {Type: "string"},
+			"portal_include_original_body":       {Type: "boolean"},
+			"portal_reply_all_button":            {Type: "boolean"},
+			"portal_secure_reply_enabled":        {Type: "boolean"},
+			"pwd_challenge_question_number":      {Type: "string"},
+			"pwd_function_change":                {Type: "boolean"},
+			"pwd_function_recover":               {Type: "boolean"},
+			"pwd_function_reset":                 {Type: "boolean"},
+			"pwd_notification_body":              {Type: "string"},
+			"pwd_notification_footer_image":      {Type: "string"},
+			"pwd_notification_footer_image_ext":  {Type: "string"},
+			"pwd_notification_footer_image_file": {Type: "string"},
+			"pwd_notification_header_image":      {Type: "string"},
+			"pwd_notification_header_image_ext":  {Type: "string"},
+			"pwd_notification_header_image_file": {Type: "string"},
+			"pwd_notification_subject":           {Type: "string"},
+			"pwd_rcpt_notification_body":         {Type: "string"},
+			"pwd_rcpt_notification_subject":      {Type: "string"},
+			"pwd_type":                           {Type: "string"},
+			"rcpt_footer_image":                  {Type: "string"},
+			"rcpt_footer_image_ext":              {Type: "string"},
+			"rcpt_footer_image_file":             {Type: "string"},
+			"rcpt_header_image":                  {Type: "string"},
+			"rcpt_header_image_ext":              {Type: "string"},
+			"rcpt_header_image_file":             {Type: "string"},
+			"rcpt_instructions":                  {Type: "string"},
+			"remove_sophos_logo":                 {Type: "boolean"},
+		},
+		"ssl_vpn/client_connection": {
+			"authentication_algorithm": {Type: "string"},
+			"auto_pf_in":               {Type: "string"},
+			"auto_pf_out":              {Type: "string"},
+			"auto_pfrule":              {Type: "boolean"},
+			"ca_cert":                  {Type: "string"},
+			"certificate":              {Type: "string"},
+			"comment":                  {Type: "string"},
+			"compression":              {Type: "boolean"},
+			"encryption_algorithm":     {Type: "string"},
+			"engine":                   {Type: "string"},
+			"interface":                {Type: "string"},
+			"key":                      {Type: "string"},
+			"local_networks":           {Type: "array"},
+			"name":                     {Type: "string"},
+			"password":                 {Type: "string"},
+			"plain_server_address":     {Type: "string"},
+			"protocol":                 {Type: "string"},
+			"proxy_auth_pass":          {Type: "string"},
+			"proxy_auth_status":        {Type: "boolean"},
+			"proxy_auth_user":          {Type: "string"},
+			"proxy_host":               {Type: "string"},
+			"proxy_port":               {Type: "integer"},
+			"proxy_status":             {Type: "boolean"},
+			"remote_networks":          {Type: "array"},
+			"server_address":           {Type: "string"},
+			"server_dn":                {Type: "string"},
+			"server_override_hostname": {Type: "string"},
+			"server_override_status":   {Type: "boolean"},
+			"server_port":              {Type: "integer"},
+			"status":                   {Type: "boolean"},
+			"username":                 {Type: "string"},
+		},
+		"ssl_vpn/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"ssl_vpn/remote_access_profile": {
+			"aaa":         {Type: "array"},
+			"auto_pf_in":  {Type: "string"},
+			"auto_pfrule": {Type: "boolean"},
+			"comment":     {Type: "string"},
+			"name":        {Type: "string"},
+			"networks":    {Type: "array"},
+			"status":      {Type: "boolean"},
+		},
+		"ssl_vpn/server_connection": {
+			"auto_pf_in":       {Type: "string"},
+			"auto_pf_out":      {Type: "string"},
+			"auto_pfrule":      {Type: "boolean"},
+			"comment":          {Type: "string"},
+			"local_networks":   {Type: "array"},
+			"name":             {Type: "string"},
+			"peer":             {Type: "string"},
+			"remote_networks":  {Type: "array"},
+			"static_ip":        {Type: "string"},
+			"static_ip6":       {Type: "string"},
+			"static_ip_status": {Type: "boolean"},
+			"status":           {Type: "boolean"},
+		},
+		"stas/collector": {
+			"comment": {Type: "string"},
+			"host":    {Type: "string"},
+			"name":    {Type: "string"},
+			"port":    {Type: "string"},
+		},
+		"stas/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"time/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"time/recurring": {
+			"comment":    {Type: "string"},
+			"end_time":   {Type: "string"},
+			"name":       {Type: "string"},
+			"start_time": {Type: "string"},
+			"weekdays":   {Type: "array"},
+		},
+		"time/single": {
+			"comment":    {Type: "string"},
+			"end_date":   {Type: "string"},
+			"end_time":   {Type: "string"},
+			"name":       {Type: "string"},
+			"start_date": {Type: "string"},
+			"start_time": {Type: "string"},
+		},
+		"user_preferences/group": {
+			"comment": {Type: "string"},
+			"name":    {Type: "string"},
+		},
+		"user_preferences/webadmin": {
+			"browser_title":         {Type: "string"},
+			"comment":               {Type: "string"},
+			"dashboard_autogroup":   {Type: "boolean"},
+			"dashboard_leftcolumn":  {Type: "array"},
+			"dashboard_rightcolumn": {Type: "array"},
+			"items_per_page":        {Type: "string"},
+			"language":              {Type: "string"},
+			"marketing_window":      {Type: "boolean"},
+			"name":                  {Type: "string"},
+			"shortcuts":             {Type: "object"},
+			"skip_terms_of_use":     {Type: "boolean"},
+		},
+	},
+}
+
+func init() { sophos.RegisterSchema(Schema) }
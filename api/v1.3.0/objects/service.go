@@ -23,7 +23,7 @@ type Service struct {
 
 var _ sophos.Endpoint = &Service{}
 
-var defsService = map[string]sophos.RestObject{
+var defsService = map[string]sophos.RestGetter{
 	"ServiceAh":     &ServiceAh{},
 	"ServiceAny":    &ServiceAny{},
 	"ServiceEsp":    &ServiceEsp{},
@@ -37,7 +37,7 @@ var defsService = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Service's Objects
-func (Service) RestObjects() map[string]sophos.RestObject { return defsService }
+func (Service) RestObjects() map[string]sophos.RestGetter { return defsService }
 
 // GetPath implements sophos.RestGetter
 func (*Service) GetPath() string { return "/api/nodes/service" }
@@ -103,7 +103,7 @@ func (Service) References() []string {
 	}
 }
 
-// ServiceAhs is an Sophos Endpoint subType and implements sophos.RestObject
+// ServiceAhs is an Sophos Endpoint subType and implements sophos.RestGetter
 type ServiceAhs []ServiceAh
 
 // ServiceAh represents a UTM AH service
@@ -111,111 +111,151 @@ type ServiceAh struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Name       string `json:"name"`
-	SpiHigh    int    `json:"spi_high"`
-	SpiLow     int    `json:"spi_low"`
 	Comment    string `json:"comment"`
+	Name       string `json:"name"`
+	SpiHigh    int64  `json:"spi_high"`
+	SpiLow     int64  `json:"spi_low"`
+}
+
+// NewServiceAh returns a ServiceAh with the default values of its swagger definition
+func NewServiceAh() *ServiceAh {
+	return &ServiceAh{
+		ObjectType: "service/ah",
+	}
+}
+
+// Validate checks the ServiceAh before it is sent, see ValidateWith
+func (s *ServiceAh) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ServiceAh.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *ServiceAh) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ServiceAh", typeOf)
+	check.Required("name", s.Name)
+	check.Order("spi_low", s.SpiLow, "spi_high", s.SpiHigh)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ServiceAh{}
 
-// GetPath implements sophos.RestObject and returns the ServiceAhs GET path
+// GetPath implements sophos.RestGetter and returns the ServiceAhs GET path
 // Returns all available service/ah objects
 func (*ServiceAhs) GetPath() string { return "/api/objects/service/ah/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ServiceAhs) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ServiceAhs GET path
+// GetPath implements sophos.RestGetter and returns the ServiceAhs GET path
 // Returns all available ah types
 func (s *ServiceAh) GetPath() string { return fmt.Sprintf("/api/objects/service/ah/%s", s.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *ServiceAh) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ServiceAh DELETE path
+// DeletePath implements sophos.Deletable and returns the ServiceAh DELETE path
 // Creates or updates the complete object ah
 func (*ServiceAh) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/ah/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ServiceAh PATCH path
+// PatchPath implements sophos.Patchable and returns the ServiceAh PATCH path
 // Changes to parts of the object ah types
 func (*ServiceAh) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/ah/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ServiceAh POST path
+// PostPath implements sophos.Creatable and returns the ServiceAh POST path
 // Create a new service/ah object
 func (*ServiceAh) PostPath() string {
 	return "/api/objects/service/ah/"
 }
 
-// PutPath implements sophos.RestObject and returns the ServiceAh PUT path
+// PutPath implements sophos.Updatable and returns the ServiceAh PUT path
 // Creates or updates the complete object ah
 func (*ServiceAh) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/ah/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ServiceAh) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/ah/%s/usedby", ref)
 }
 
-// ServiceAnys is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (s *ServiceAh) GetType() string { return s.ObjectType }
+
+// ServiceAnys is an Sophos Endpoint subType and implements sophos.RestGetter
 type ServiceAnys []ServiceAny
 
-// ServiceAny is a generated Sophos object
+// ServiceAny represents a UTM service/any object
 type ServiceAny struct {
 	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
 	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	Comment    string `json:"comment"`
 	Name       string `json:"name"`
 }
 
+// NewServiceAny returns a ServiceAny with the default values of its swagger definition
+func NewServiceAny() *ServiceAny {
+	return &ServiceAny{
+		ObjectType: "service/any",
+	}
+}
+
+// Validate checks the ServiceAny before it is sent, see ValidateWith
+func (s *ServiceAny) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ServiceAny.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *ServiceAny) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ServiceAny", typeOf)
+	check.Required("name", s.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ServiceAny{}
 
-// GetPath implements sophos.RestObject and returns the ServiceAnys GET path
+// GetPath implements sophos.RestGetter and returns the ServiceAnys GET path
 // Returns all available service/any objects
 func (*ServiceAnys) GetPath() string { return "/api/objects/service/any/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ServiceAnys) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ServiceAnys GET path
+// GetPath implements sophos.RestGetter and returns the ServiceAnys GET path
 // Returns all available any types
 func (s *ServiceAny) GetPath() string { return fmt.Sprintf("/api/objects/service/any/%s", s.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *ServiceAny) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ServiceAny DELETE path
+// DeletePath implements sophos.Deletable and returns the ServiceAny DELETE path
 // Creates or updates the complete object any
 func (*ServiceAny) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/any/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ServiceAny PATCH path
+// PatchPath implements sophos.Patchable and returns the ServiceAny PATCH path
 // Changes to parts of the object any types
 func (*ServiceAny) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/any/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ServiceAny POST path
+// PostPath implements sophos.Creatable and returns the ServiceAny POST path
 // Create a new service/any object
 func (*ServiceAny) PostPath() string {
 	return "/api/objects/service/any/"
 }
 
-// PutPath implements sophos.RestObject and returns the ServiceAny PUT path
+// PutPath implements sophos.Updatable and returns the ServiceAny PUT path
 // Creates or updates the complete object any
 func (*ServiceAny) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/any/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ServiceAny) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/any/%s/usedby", ref)
@@ -224,7 +264,7 @@ func (*ServiceAny) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (s *ServiceAny) GetType() string { return s.ObjectType }
 
-// ServiceEsps is an Sophos Endpoint subType and implements sophos.RestObject
+// ServiceEsps is an Sophos Endpoint subType and implements sophos.RestGetter
 type ServiceEsps []ServiceEsp
 
 // ServiceEsp represents a UTM ESP service
@@ -234,113 +274,153 @@ type ServiceEsp struct {
 	Reference  string `json:"_ref"`
 	Comment    string `json:"comment"`
 	Name       string `json:"name"`
-	SpiHigh    int    `json:"spi_high"`
-	SpiLow     int    `json:"spi_low"`
+	SpiHigh    int64  `json:"spi_high"`
+	SpiLow     int64  `json:"spi_low"`
+}
+
+// NewServiceEsp returns a ServiceEsp with the default values of its swagger definition
+func NewServiceEsp() *ServiceEsp {
+	return &ServiceEsp{
+		ObjectType: "service/esp",
+	}
+}
+
+// Validate checks the ServiceEsp before it is sent, see ValidateWith
+func (s *ServiceEsp) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ServiceEsp.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *ServiceEsp) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ServiceEsp", typeOf)
+	check.Required("name", s.Name)
+	check.Order("spi_low", s.SpiLow, "spi_high", s.SpiHigh)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ServiceEsp{}
 
-// GetPath implements sophos.RestObject and returns the ServiceEsps GET path
+// GetPath implements sophos.RestGetter and returns the ServiceEsps GET path
 // Returns all available service/esp objects
 func (*ServiceEsps) GetPath() string { return "/api/objects/service/esp/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ServiceEsps) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ServiceEsps GET path
+// GetPath implements sophos.RestGetter and returns the ServiceEsps GET path
 // Returns all available esp types
 func (s *ServiceEsp) GetPath() string { return fmt.Sprintf("/api/objects/service/esp/%s", s.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *ServiceEsp) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ServiceEsp DELETE path
+// DeletePath implements sophos.Deletable and returns the ServiceEsp DELETE path
 // Creates or updates the complete object esp
 func (*ServiceEsp) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/esp/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ServiceEsp PATCH path
+// PatchPath implements sophos.Patchable and returns the ServiceEsp PATCH path
 // Changes to parts of the object esp types
 func (*ServiceEsp) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/esp/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ServiceEsp POST path
+// PostPath implements sophos.Creatable and returns the ServiceEsp POST path
 // Create a new service/esp object
 func (*ServiceEsp) PostPath() string {
 	return "/api/objects/service/esp/"
 }
 
-// PutPath implements sophos.RestObject and returns the ServiceEsp PUT path
+// PutPath implements sophos.Updatable and returns the ServiceEsp PUT path
 // Creates or updates the complete object esp
 func (*ServiceEsp) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/esp/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ServiceEsp) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/esp/%s/usedby", ref)
 }
 
-// ServiceGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (s *ServiceEsp) GetType() string { return s.ObjectType }
+
+// ServiceGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type ServiceGroups []ServiceGroup
 
-// ServiceGroup is a generated Sophos object
+// ServiceGroup represents a UTM service/group object
 type ServiceGroup struct {
 	Locked     string   `json:"_locked"`
-	Reference  string   `json:"_ref"`
 	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
 	Comment    string   `json:"comment"`
 	Members    []string `json:"members"`
 	Name       string   `json:"name"`
 	Types      []string `json:"types"`
 }
 
+// NewServiceGroup returns a ServiceGroup with the default values of its swagger definition
+func NewServiceGroup() *ServiceGroup {
+	return &ServiceGroup{
+		ObjectType: "service/group",
+	}
+}
+
+// Validate checks the ServiceGroup before it is sent, see ValidateWith
+func (s *ServiceGroup) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ServiceGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *ServiceGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ServiceGroup", typeOf)
+	check.Required("name", s.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ServiceGroup{}
 
-// GetPath implements sophos.RestObject and returns the ServiceGroups GET path
+// GetPath implements sophos.RestGetter and returns the ServiceGroups GET path
 // Returns all available service/group objects
 func (*ServiceGroups) GetPath() string { return "/api/objects/service/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ServiceGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ServiceGroups GET path
+// GetPath implements sophos.RestGetter and returns the ServiceGroups GET path
 // Returns all available group types
 func (s *ServiceGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/service/group/%s", s.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *ServiceGroup) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ServiceGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the ServiceGroup DELETE path
 // Creates or updates the complete object group
 func (*ServiceGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ServiceGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the ServiceGroup PATCH path
 // Changes to parts of the object group types
 func (*ServiceGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ServiceGroup POST path
+// PostPath implements sophos.Creatable and returns the ServiceGroup POST path
 // Create a new service/group object
 func (*ServiceGroup) PostPath() string {
 	return "/api/objects/service/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the ServiceGroup PUT path
+// PutPath implements sophos.Updatable and returns the ServiceGroup PUT path
 // Creates or updates the complete object group
 func (*ServiceGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ServiceGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/group/%s/usedby", ref)
@@ -349,63 +429,81 @@ func (*ServiceGroup) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (s *ServiceGroup) GetType() string { return s.ObjectType }
 
-// ServiceIcmps is an Sophos Endpoint subType and implements sophos.RestObject
+// ServiceIcmps is an Sophos Endpoint subType and implements sophos.RestGetter
 type ServiceIcmps []ServiceIcmp
 
-// ServiceIcmp is a generated Sophos object
+// ServiceIcmp represents a UTM service/icmp object
 type ServiceIcmp struct {
 	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
 	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	Code       int64  `json:"code"`
 	Comment    string `json:"comment"`
 	Name       string `json:"name"`
 	Type       int64  `json:"type"`
 }
 
+// NewServiceIcmp returns a ServiceIcmp with the default values of its swagger definition
+func NewServiceIcmp() *ServiceIcmp {
+	return &ServiceIcmp{
+		ObjectType: "service/icmp",
+	}
+}
+
+// Validate checks the ServiceIcmp before it is sent, see ValidateWith
+func (s *ServiceIcmp) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ServiceIcmp.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *ServiceIcmp) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ServiceIcmp", typeOf)
+	check.Required("name", s.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ServiceIcmp{}
 
-// GetPath implements sophos.RestObject and returns the ServiceIcmps GET path
+// GetPath implements sophos.RestGetter and returns the ServiceIcmps GET path
 // Returns all available service/icmp objects
 func (*ServiceIcmps) GetPath() string { return "/api/objects/service/icmp/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ServiceIcmps) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ServiceIcmps GET path
+// GetPath implements sophos.RestGetter and returns the ServiceIcmps GET path
 // Returns all available icmp types
 func (s *ServiceIcmp) GetPath() string {
 	return fmt.Sprintf("/api/objects/service/icmp/%s", s.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *ServiceIcmp) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ServiceIcmp DELETE path
+// DeletePath implements sophos.Deletable and returns the ServiceIcmp DELETE path
 // Creates or updates the complete object icmp
 func (*ServiceIcmp) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/icmp/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ServiceIcmp PATCH path
+// PatchPath implements sophos.Patchable and returns the ServiceIcmp PATCH path
 // Changes to parts of the object icmp types
 func (*ServiceIcmp) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/icmp/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ServiceIcmp POST path
+// PostPath implements sophos.Creatable and returns the ServiceIcmp POST path
 // Create a new service/icmp object
 func (*ServiceIcmp) PostPath() string {
 	return "/api/objects/service/icmp/"
 }
 
-// PutPath implements sophos.RestObject and returns the ServiceIcmp PUT path
+// PutPath implements sophos.Updatable and returns the ServiceIcmp PUT path
 // Creates or updates the complete object icmp
 func (*ServiceIcmp) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/icmp/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ServiceIcmp) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/icmp/%s/usedby", ref)
@@ -414,7 +512,7 @@ func (*ServiceIcmp) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (s *ServiceIcmp) GetType() string { return s.ObjectType }
 
-// ServiceIcmpv6s is an Sophos Endpoint subType and implements sophos.RestObject
+// ServiceIcmpv6s is an Sophos Endpoint subType and implements sophos.RestGetter
 type ServiceIcmpv6s []ServiceIcmpv6
 
 // ServiceIcmpv6 represents a UTM ICMPv6 service
@@ -422,114 +520,153 @@ type ServiceIcmpv6 struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Code       int    `json:"code"`
+	Code       int64  `json:"code"`
 	Comment    string `json:"comment"`
 	Name       string `json:"name"`
-	Type       int    `json:"type"`
+	Type       int64  `json:"type"`
+}
+
+// NewServiceIcmpv6 returns a ServiceIcmpv6 with the default values of its swagger definition
+func NewServiceIcmpv6() *ServiceIcmpv6 {
+	return &ServiceIcmpv6{
+		ObjectType: "service/icmpv6",
+	}
+}
+
+// Validate checks the ServiceIcmpv6 before it is sent, see ValidateWith
+func (s *ServiceIcmpv6) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ServiceIcmpv6.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *ServiceIcmpv6) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ServiceIcmpv6", typeOf)
+	check.Required("name", s.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &ServiceIcmpv6{}
 
-// GetPath implements sophos.RestObject and returns the ServiceIcmpv6s GET path
+// GetPath implements sophos.RestGetter and returns the ServiceIcmpv6s GET path
 // Returns all available service/icmpv6 objects
 func (*ServiceIcmpv6s) GetPath() string { return "/api/objects/service/icmpv6/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ServiceIcmpv6s) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ServiceIcmpv6s GET path
+// GetPath implements sophos.RestGetter and returns the ServiceIcmpv6s GET path
 // Returns all available icmpv6 types
 func (s *ServiceIcmpv6) GetPath() string {
 	return fmt.Sprintf("/api/objects/service/icmpv6/%s", s.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *ServiceIcmpv6) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ServiceIcmpv6 DELETE path
+// DeletePath implements sophos.Deletable and returns the ServiceIcmpv6 DELETE path
 // Creates or updates the complete object icmpv6
 func (*ServiceIcmpv6) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/icmpv6/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ServiceIcmpv6 PATCH path
+// PatchPath implements sophos.Patchable and returns the ServiceIcmpv6 PATCH path
 // Changes to parts of the object icmpv6 types
 func (*ServiceIcmpv6) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/icmpv6/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ServiceIcmpv6 POST path
+// PostPath implements sophos.Creatable and returns the ServiceIcmpv6 POST path
 // Create a new service/icmpv6 object
 func (*ServiceIcmpv6) PostPath() string {
 	return "/api/objects/service/icmpv6/"
 }
 
-// PutPath implements sophos.RestObject and returns the ServiceIcmpv6 PUT path
+// PutPath implements sophos.Updatable and returns the ServiceIcmpv6 PUT path
 // Creates or updates the complete object icmpv6
 func (*ServiceIcmpv6) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/icmpv6/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ServiceIcmpv6) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/icmpv6/%s/usedby", ref)
 }
 
-// ServiceIps is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (s *ServiceIcmpv6) GetType() string { return s.ObjectType }
+
+// ServiceIps is an Sophos Endpoint subType and implements sophos.RestGetter
 type ServiceIps []ServiceIp
 
-// ServiceIp is a generated Sophos object
+// ServiceIp represents a UTM service/ip object
 type ServiceIp struct {
 	Locked     string `json:"_locked"`
-	Reference  string `json:"_ref"`
 	ObjectType string `json:"_type"`
+	Reference  string `json:"_ref"`
 	Comment    string `json:"comment"`
 	Name       string `json:"name"`
 	Proto      int64  `json:"proto"`
 }
 
+// NewServiceIp returns a ServiceIp with the default values of its swagger definition
+func NewServiceIp() *ServiceIp {
+	return &ServiceIp{
+		ObjectType: "service/ip",
+	}
+}
+
+// Validate checks the ServiceIp before it is sent, see ValidateWith
+func (s *ServiceIp) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ServiceIp.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *ServiceIp) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ServiceIp", typeOf)
+	check.Required("name", s.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ServiceIp{}
 
-// GetPath implements sophos.RestObject and returns the ServiceIps GET path
+// GetPath implements sophos.RestGetter and returns the ServiceIps GET path
 // Returns all available service/ip objects
 func (*ServiceIps) GetPath() string { return "/api/objects/service/ip/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ServiceIps) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ServiceIps GET path
+// GetPath implements sophos.RestGetter and returns the ServiceIps GET path
 // Returns all available ip types
 func (s *ServiceIp) GetPath() string { return fmt.Sprintf("/api/objects/service/ip/%s", s.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *ServiceIp) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ServiceIp DELETE path
+// DeletePath implements sophos.Deletable and returns the ServiceIp DELETE path
 // Creates or updates the complete object ip
 func (*ServiceIp) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/ip/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ServiceIp PATCH path
+// PatchPath implements sophos.Patchable and returns the ServiceIp PATCH path
 // Changes to parts of the object ip types
 func (*ServiceIp) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/ip/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ServiceIp POST path
+// PostPath implements sophos.Creatable and returns the ServiceIp POST path
 // Create a new service/ip object
 func (*ServiceIp) PostPath() string {
 	return "/api/objects/service/ip/"
 }
 
-// PutPath implements sophos.RestObject and returns the ServiceIp PUT path
+// PutPath implements sophos.Updatable and returns the ServiceIp PUT path
 // Creates or updates the complete object ip
 func (*ServiceIp) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/ip/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ServiceIp) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/ip/%s/usedby", ref)
@@ -538,14 +675,14 @@ func (*ServiceIp) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (s *ServiceIp) GetType() string { return s.ObjectType }
 
-// ServiceTcps is an Sophos Endpoint subType and implements sophos.RestObject
+// ServiceTcps is an Sophos Endpoint subType and implements sophos.RestGetter
 type ServiceTcps []ServiceTcp
 
-// ServiceTcp is a generated Sophos object
+// ServiceTcp represents a UTM service/tcp object
 type ServiceTcp struct {
 	Locked       string `json:"_locked"`
-	Reference    string `json:"_ref"`
 	ObjectType   string `json:"_type"`
+	Reference    string `json:"_ref"`
 	AutoPfSvcDst string `json:"auto_pf_svc_dst"`
 	AutoPfSvcSrc string `json:"auto_pf_svc_src"`
 	Comment      string `json:"comment"`
@@ -556,47 +693,71 @@ type ServiceTcp struct {
 	SrcLow       int64  `json:"src_low"`
 }
 
+// NewServiceTcp returns a ServiceTcp with the default values of its swagger definition
+func NewServiceTcp() *ServiceTcp {
+	return &ServiceTcp{
+		ObjectType: "service/tcp",
+	}
+}
+
+// Validate checks the ServiceTcp before it is sent, see ValidateWith
+func (s *ServiceTcp) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ServiceTcp.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *ServiceTcp) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ServiceTcp", typeOf)
+	check.Range("dst_high", s.DstHigh, "0-65535")
+	check.Range("dst_low", s.DstLow, "0-65535")
+	check.Order("dst_low", s.DstLow, "dst_high", s.DstHigh)
+	check.Required("name", s.Name)
+	check.Range("src_high", s.SrcHigh, "0-65535")
+	check.Range("src_low", s.SrcLow, "0-65535")
+	check.Order("src_low", s.SrcLow, "src_high", s.SrcHigh)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ServiceTcp{}
 
-// GetPath implements sophos.RestObject and returns the ServiceTcps GET path
+// GetPath implements sophos.RestGetter and returns the ServiceTcps GET path
 // Returns all available service/tcp objects
 func (*ServiceTcps) GetPath() string { return "/api/objects/service/tcp/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ServiceTcps) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ServiceTcps GET path
+// GetPath implements sophos.RestGetter and returns the ServiceTcps GET path
 // Returns all available tcp types
 func (s *ServiceTcp) GetPath() string { return fmt.Sprintf("/api/objects/service/tcp/%s", s.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *ServiceTcp) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ServiceTcp DELETE path
+// DeletePath implements sophos.Deletable and returns the ServiceTcp DELETE path
 // Creates or updates the complete object tcp
 func (*ServiceTcp) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/tcp/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ServiceTcp PATCH path
+// PatchPath implements sophos.Patchable and returns the ServiceTcp PATCH path
 // Changes to parts of the object tcp types
 func (*ServiceTcp) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/tcp/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ServiceTcp POST path
+// PostPath implements sophos.Creatable and returns the ServiceTcp POST path
 // Create a new service/tcp object
 func (*ServiceTcp) PostPath() string {
 	return "/api/objects/service/tcp/"
 }
 
-// PutPath implements sophos.RestObject and returns the ServiceTcp PUT path
+// PutPath implements sophos.Updatable and returns the ServiceTcp PUT path
 // Creates or updates the complete object tcp
 func (*ServiceTcp) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/tcp/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ServiceTcp) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/tcp/%s/usedby", ref)
@@ -605,14 +766,14 @@ func (*ServiceTcp) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (s *ServiceTcp) GetType() string { return s.ObjectType }
 
-// ServiceTcpudps is an Sophos Endpoint subType and implements sophos.RestObject
+// ServiceTcpudps is an Sophos Endpoint subType and implements sophos.RestGetter
 type ServiceTcpudps []ServiceTcpudp
 
-// ServiceTcpudp is a generated Sophos object
+// ServiceTcpudp represents a UTM service/tcpudp object
 type ServiceTcpudp struct {
 	Locked       string `json:"_locked"`
-	Reference    string `json:"_ref"`
 	ObjectType   string `json:"_type"`
+	Reference    string `json:"_ref"`
 	AutoPfSvcDst string `json:"auto_pf_svc_dst"`
 	AutoPfSvcSrc string `json:"auto_pf_svc_src"`
 	Comment      string `json:"comment"`
@@ -623,49 +784,73 @@ type ServiceTcpudp struct {
 	SrcLow       int64  `json:"src_low"`
 }
 
+// NewServiceTcpudp returns a ServiceTcpudp with the default values of its swagger definition
+func NewServiceTcpudp() *ServiceTcpudp {
+	return &ServiceTcpudp{
+		ObjectType: "service/tcpudp",
+	}
+}
+
+// Validate checks the ServiceTcpudp before it is sent, see ValidateWith
+func (s *ServiceTcpudp) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ServiceTcpudp.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *ServiceTcpudp) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ServiceTcpudp", typeOf)
+	check.Range("dst_high", s.DstHigh, "0-65535")
+	check.Range("dst_low", s.DstLow, "0-65535")
+	check.Order("dst_low", s.DstLow, "dst_high", s.DstHigh)
+	check.Required("name", s.Name)
+	check.Range("src_high", s.SrcHigh, "0-65535")
+	check.Range("src_low", s.SrcLow, "0-65535")
+	check.Order("src_low", s.SrcLow, "src_high", s.SrcHigh)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ServiceTcpudp{}
 
-// GetPath implements sophos.RestObject and returns the ServiceTcpudps GET path
+// GetPath implements sophos.RestGetter and returns the ServiceTcpudps GET path
 // Returns all available service/tcpudp objects
 func (*ServiceTcpudps) GetPath() string { return "/api/objects/service/tcpudp/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ServiceTcpudps) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ServiceTcpudps GET path
+// GetPath implements sophos.RestGetter and returns the ServiceTcpudps GET path
 // Returns all available tcpudp types
 func (s *ServiceTcpudp) GetPath() string {
 	return fmt.Sprintf("/api/objects/service/tcpudp/%s", s.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *ServiceTcpudp) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ServiceTcpudp DELETE path
+// DeletePath implements sophos.Deletable and returns the ServiceTcpudp DELETE path
 // Creates or updates the complete object tcpudp
 func (*ServiceTcpudp) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/tcpudp/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ServiceTcpudp PATCH path
+// PatchPath implements sophos.Patchable and returns the ServiceTcpudp PATCH path
 // Changes to parts of the object tcpudp types
 func (*ServiceTcpudp) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/tcpudp/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ServiceTcpudp POST path
+// PostPath implements sophos.Creatable and returns the ServiceTcpudp POST path
 // Create a new service/tcpudp object
 func (*ServiceTcpudp) PostPath() string {
 	return "/api/objects/service/tcpudp/"
 }
 
-// PutPath implements sophos.RestObject and returns the ServiceTcpudp PUT path
+// PutPath implements sophos.Updatable and returns the ServiceTcpudp PUT path
 // Creates or updates the complete object tcpudp
 func (*ServiceTcpudp) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/tcpudp/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ServiceTcpudp) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/tcpudp/%s/usedby", ref)
@@ -674,14 +859,14 @@ func (*ServiceTcpudp) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (s *ServiceTcpudp) GetType() string { return s.ObjectType }
 
-// ServiceUdps is an Sophos Endpoint subType and implements sophos.RestObject
+// ServiceUdps is an Sophos Endpoint subType and implements sophos.RestGetter
 type ServiceUdps []ServiceUdp
 
-// ServiceUdp is a generated Sophos object
+// ServiceUdp represents a UTM service/udp object
 type ServiceUdp struct {
 	Locked       string `json:"_locked"`
-	Reference    string `json:"_ref"`
 	ObjectType   string `json:"_type"`
+	Reference    string `json:"_ref"`
 	AutoPfSvcDst string `json:"auto_pf_svc_dst"`
 	AutoPfSvcSrc string `json:"auto_pf_svc_src"`
 	Comment      string `json:"comment"`
@@ -692,47 +877,71 @@ type ServiceUdp struct {
 	SrcLow       int64  `json:"src_low"`
 }
 
+// NewServiceUdp returns a ServiceUdp with the default values of its swagger definition
+func NewServiceUdp() *ServiceUdp {
+	return &ServiceUdp{
+		ObjectType: "service/udp",
+	}
+}
+
+// Validate checks the ServiceUdp before it is sent, see ValidateWith
+func (s *ServiceUdp) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the ServiceUdp.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *ServiceUdp) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("ServiceUdp", typeOf)
+	check.Range("dst_high", s.DstHigh, "0-65535")
+	check.Range("dst_low", s.DstLow, "0-65535")
+	check.Order("dst_low", s.DstLow, "dst_high", s.DstHigh)
+	check.Required("name", s.Name)
+	check.Range("src_high", s.SrcHigh, "0-65535")
+	check.Range("src_low", s.SrcLow, "0-65535")
+	check.Order("src_low", s.SrcLow, "src_high", s.SrcHigh)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &ServiceUdp{}
 
-// GetPath implements sophos.RestObject and returns the ServiceUdps GET path
+// GetPath implements sophos.RestGetter and returns the ServiceUdps GET path
 // Returns all available service/udp objects
 func (*ServiceUdps) GetPath() string { return "/api/objects/service/udp/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*ServiceUdps) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the ServiceUdps GET path
+// GetPath implements sophos.RestGetter and returns the ServiceUdps GET path
 // Returns all available udp types
 func (s *ServiceUdp) GetPath() string { return fmt.Sprintf("/api/objects/service/udp/%s", s.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *ServiceUdp) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the ServiceUdp DELETE path
+// DeletePath implements sophos.Deletable and returns the ServiceUdp DELETE path
 // Creates or updates the complete object udp
 func (*ServiceUdp) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/udp/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the ServiceUdp PATCH path
+// PatchPath implements sophos.Patchable and returns the ServiceUdp PATCH path
 // Changes to parts of the object udp types
 func (*ServiceUdp) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/udp/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the ServiceUdp POST path
+// PostPath implements sophos.Creatable and returns the ServiceUdp POST path
 // Create a new service/udp object
 func (*ServiceUdp) PostPath() string {
 	return "/api/objects/service/udp/"
 }
 
-// PutPath implements sophos.RestObject and returns the ServiceUdp PUT path
+// PutPath implements sophos.Updatable and returns the ServiceUdp PUT path
 // Creates or updates the complete object udp
 func (*ServiceUdp) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/udp/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*ServiceUdp) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/service/udp/%s/usedby", ref)
@@ -9,31 +9,31 @@ import (
 // Snmp is a generated struct representing the Sophos Snmp Endpoint
 // GET /api/nodes/snmp
 type Snmp struct {
-	AllowedNetworks []interface{} `json:"allowed_networks"`
-	AuthPassword    string        `json:"auth_password"`
-	AuthType        string        `json:"auth_type"`
-	Community       string        `json:"community"`
-	DeviceAdmin     string        `json:"device_admin"`
-	DeviceLocation  string        `json:"device_location"`
-	DeviceName      string        `json:"device_name"`
-	EncryptPassword string        `json:"encrypt_password"`
-	EncryptType     string        `json:"encrypt_type"`
-	Status          int64         `json:"status"`
-	Traps           []interface{} `json:"traps"`
-	TrapsUseOldOids int64         `json:"traps_use_old_oids"`
-	Username        string        `json:"username"`
-	Version         string        `json:"version"`
+	AllowedNetworks NetworkRefs `json:"allowed_networks"`
+	AuthPassword    string      `json:"auth_password"`
+	AuthType        string      `json:"auth_type"`
+	Community       string      `json:"community"`
+	DeviceAdmin     string      `json:"device_admin"`
+	DeviceLocation  string      `json:"device_location"`
+	DeviceName      string      `json:"device_name"`
+	EncryptPassword string      `json:"encrypt_password"`
+	EncryptType     string      `json:"encrypt_type"`
+	Status          bool        `json:"status"`
+	Traps           []string    `json:"traps"`
+	TrapsUseOldOids bool        `json:"traps_use_old_oids"`
+	Username        string      `json:"username"`
+	Version         string      `json:"version"`
 }
 
 var _ sophos.Endpoint = &Snmp{}
 
-var defsSnmp = map[string]sophos.RestObject{
+var defsSnmp = map[string]sophos.RestGetter{
 	"SnmpGroup": &SnmpGroup{},
 	"SnmpTrap":  &SnmpTrap{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Snmp's Objects
-func (Snmp) RestObjects() map[string]sophos.RestObject { return defsSnmp }
+func (Snmp) RestObjects() map[string]sophos.RestGetter { return defsSnmp }
 
 // GetPath implements sophos.RestGetter
 func (*Snmp) GetPath() string { return "/api/nodes/snmp" }
@@ -67,7 +67,7 @@ func (Snmp) References() []string {
 	}
 }
 
-// SnmpGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// SnmpGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type SnmpGroups []SnmpGroup
 
 // SnmpGroup represents a UTM group
@@ -79,53 +79,74 @@ type SnmpGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewSnmpGroup returns a SnmpGroup with the default values of its swagger definition
+func NewSnmpGroup() *SnmpGroup {
+	return &SnmpGroup{
+		ObjectType: "snmp/group",
+	}
+}
+
+// Validate checks the SnmpGroup before it is sent, see ValidateWith
+func (s *SnmpGroup) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the SnmpGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *SnmpGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("SnmpGroup", typeOf)
+	check.Required("name", s.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &SnmpGroup{}
 
-// GetPath implements sophos.RestObject and returns the SnmpGroups GET path
+// GetPath implements sophos.RestGetter and returns the SnmpGroups GET path
 // Returns all available snmp/group objects
 func (*SnmpGroups) GetPath() string { return "/api/objects/snmp/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*SnmpGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the SnmpGroups GET path
+// GetPath implements sophos.RestGetter and returns the SnmpGroups GET path
 // Returns all available group types
 func (s *SnmpGroup) GetPath() string { return fmt.Sprintf("/api/objects/snmp/group/%s", s.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *SnmpGroup) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the SnmpGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the SnmpGroup DELETE path
 // Creates or updates the complete object group
 func (*SnmpGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/snmp/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the SnmpGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the SnmpGroup PATCH path
 // Changes to parts of the object group types
 func (*SnmpGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/snmp/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the SnmpGroup POST path
+// PostPath implements sophos.Creatable and returns the SnmpGroup POST path
 // Create a new snmp/group object
 func (*SnmpGroup) PostPath() string {
 	return "/api/objects/snmp/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the SnmpGroup PUT path
+// PutPath implements sophos.Updatable and returns the SnmpGroup PUT path
 // Creates or updates the complete object group
 func (*SnmpGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/snmp/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*SnmpGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/snmp/group/%s/usedby", ref)
 }
 
-// SnmpTraps is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (s *SnmpGroup) GetType() string { return s.ObjectType }
+
+// SnmpTraps is an Sophos Endpoint subType and implements sophos.RestGetter
 type SnmpTraps []SnmpTrap
 
 // SnmpTrap represents a UTM SNMP trap
@@ -133,79 +154,165 @@ type SnmpTrap struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Comment    string `json:"comment"`
-	// EncryptType can be one of: []string{"None", "DES", "AES"}
-	// EncryptType default value is "None"
-	EncryptType string `json:"encrypt_type"`
-	// Host description: REF(network/host), REF(network/dns_host), REF(network/availability_group)
-	Host string `json:"host"`
-	// Username description: (SNMPSTRING)
-	// Username default value is ""
-	Username string `json:"username"`
-	// Version can be one of: []string{"v2c", "v3"}
-	// Version default value is "v2c"
-	Version string `json:"version"`
 	// AuthPassword description: (SNMPSTRING)
 	// AuthPassword default value is ""
 	AuthPassword string `json:"auth_password"`
 	// AuthType can be one of: []string{"MD5", "SHA"}
 	// AuthType default value is "MD5"
-	AuthType string `json:"auth_type"`
+	AuthType SnmpTrapAuthType `json:"auth_type"`
+	Comment  string           `json:"comment"`
 	// Community description: (SNMPSTRING)
 	// Community default value is "public"
 	Community string `json:"community"`
 	// EncryptPassword description: (SNMPSTRING)
 	// EncryptPassword default value is ""
 	EncryptPassword string `json:"encrypt_password"`
+	// EncryptType can be one of: []string{"None", "DES", "AES"}
+	// EncryptType default value is "None"
+	EncryptType SnmpTrapEncryptType `json:"encrypt_type"`
 	// Engineid default value is ""
 	Engineid string `json:"engineid"`
-	Name     string `json:"name"`
+	// Host description: REF(network/host), REF(network/dns_host), REF(network/availability_group)
+	Host NetworkRef `json:"host"`
+	Name string     `json:"name"`
 	// Status default value is true
 	Status bool `json:"status"`
+	// Username description: (SNMPSTRING)
+	// Username default value is ""
+	Username string `json:"username"`
+	// Version can be one of: []string{"v2c", "v3"}
+	// Version default value is "v2c"
+	Version SnmpTrapVersion `json:"version"`
+}
+
+// SnmpTrapAuthType is the AuthType of a SnmpTrap
+type SnmpTrapAuthType string
+
+// Known values of SnmpTrapAuthType
+const (
+	SnmpTrapAuthTypeMD5 SnmpTrapAuthType = "MD5"
+	SnmpTrapAuthTypeSHA SnmpTrapAuthType = "SHA"
+)
+
+// Valid returns true if the value is a known SnmpTrapAuthType
+func (v SnmpTrapAuthType) Valid() bool {
+	switch v {
+	case SnmpTrapAuthTypeMD5, SnmpTrapAuthTypeSHA:
+		return true
+	}
+	return false
+}
+
+// SnmpTrapEncryptType is the EncryptType of a SnmpTrap
+type SnmpTrapEncryptType string
+
+// Known values of SnmpTrapEncryptType
+const (
+	SnmpTrapEncryptTypeNone SnmpTrapEncryptType = "None"
+	SnmpTrapEncryptTypeDES  SnmpTrapEncryptType = "DES"
+	SnmpTrapEncryptTypeAES  SnmpTrapEncryptType = "AES"
+)
+
+// Valid returns true if the value is a known SnmpTrapEncryptType
+func (v SnmpTrapEncryptType) Valid() bool {
+	switch v {
+	case SnmpTrapEncryptTypeNone, SnmpTrapEncryptTypeDES, SnmpTrapEncryptTypeAES:
+		return true
+	}
+	return false
+}
+
+// SnmpTrapVersion is the Version of a SnmpTrap
+type SnmpTrapVersion string
+
+// Known values of SnmpTrapVersion
+const (
+	SnmpTrapVersionV2C SnmpTrapVersion = "v2c"
+	SnmpTrapVersionV3  SnmpTrapVersion = "v3"
+)
+
+// Valid returns true if the value is a known SnmpTrapVersion
+func (v SnmpTrapVersion) Valid() bool {
+	switch v {
+	case SnmpTrapVersionV2C, SnmpTrapVersionV3:
+		return true
+	}
+	return false
+}
+
+// NewSnmpTrap returns a SnmpTrap with the default values of its swagger definition
+func NewSnmpTrap() *SnmpTrap {
+	return &SnmpTrap{
+		ObjectType:  "snmp/trap",
+		AuthType:    SnmpTrapAuthTypeMD5,
+		Community:   "public",
+		EncryptType: SnmpTrapEncryptTypeNone,
+		Status:      true,
+		Version:     SnmpTrapVersionV2C,
+	}
+}
+
+// Validate checks the SnmpTrap before it is sent, see ValidateWith
+func (s *SnmpTrap) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the SnmpTrap.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *SnmpTrap) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("SnmpTrap", typeOf)
+	check.Enum("auth_type", string(s.AuthType), s.AuthType.Valid())
+	check.Enum("encrypt_type", string(s.EncryptType), s.EncryptType.Valid())
+	check.Required("host", string(s.Host))
+	check.Ref("host", string(s.Host), "REF(network/host), REF(network/dns_host), REF(network/availability_group)")
+	check.Required("name", s.Name)
+	check.Enum("version", string(s.Version), s.Version.Valid())
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &SnmpTrap{}
 
-// GetPath implements sophos.RestObject and returns the SnmpTraps GET path
+// GetPath implements sophos.RestGetter and returns the SnmpTraps GET path
 // Returns all available snmp/trap objects
 func (*SnmpTraps) GetPath() string { return "/api/objects/snmp/trap/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*SnmpTraps) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the SnmpTraps GET path
+// GetPath implements sophos.RestGetter and returns the SnmpTraps GET path
 // Returns all available trap types
 func (s *SnmpTrap) GetPath() string { return fmt.Sprintf("/api/objects/snmp/trap/%s", s.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *SnmpTrap) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the SnmpTrap DELETE path
+// DeletePath implements sophos.Deletable and returns the SnmpTrap DELETE path
 // Creates or updates the complete object trap
 func (*SnmpTrap) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/snmp/trap/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the SnmpTrap PATCH path
+// PatchPath implements sophos.Patchable and returns the SnmpTrap PATCH path
 // Changes to parts of the object trap types
 func (*SnmpTrap) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/snmp/trap/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the SnmpTrap POST path
+// PostPath implements sophos.Creatable and returns the SnmpTrap POST path
 // Create a new snmp/trap object
 func (*SnmpTrap) PostPath() string {
 	return "/api/objects/snmp/trap/"
 }
 
-// PutPath implements sophos.RestObject and returns the SnmpTrap PUT path
+// PutPath implements sophos.Updatable and returns the SnmpTrap PUT path
 // Creates or updates the complete object trap
 func (*SnmpTrap) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/snmp/trap/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*SnmpTrap) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/snmp/trap/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (s *SnmpTrap) GetType() string { return s.ObjectType }
@@ -19,17 +19,17 @@ type Spx struct {
 		} `json:"expiry_settings"`
 		PasswordStrength struct {
 			MinLength        int64 `json:"min_length"`
-			RequireSpecChars int64 `json:"require_spec_chars"`
+			RequireSpecChars bool  `json:"require_spec_chars"`
 		} `json:"password_strength"`
 		PortalSettings struct {
-			AllowedNetworks  []interface{} `json:"allowed_networks"`
-			Hostname         string        `json:"hostname"`
-			InterfaceAddress string        `json:"interface_address"`
-			Port             int64         `json:"port"`
+			AllowedNetworks  NetworkRefs `json:"allowed_networks"`
+			Hostname         string      `json:"hostname"`
+			InterfaceAddress string      `json:"interface_address"`
+			Port             int64       `json:"port"`
 		} `json:"portal_settings"`
 		PoweredByLogo string `json:"powered_by_logo"`
-		SpxPriority   int64  `json:"spx_priority"`
-		Status        int64  `json:"status"`
+		SpxPriority   bool   `json:"spx_priority"`
+		Status        bool   `json:"status"`
 	} `json:"global"`
 	SpxAuth struct {
 		Password string `json:"password"`
@@ -42,13 +42,13 @@ type Spx struct {
 
 var _ sophos.Endpoint = &Spx{}
 
-var defsSpx = map[string]sophos.RestObject{
+var defsSpx = map[string]sophos.RestGetter{
 	"SpxGroup":    &SpxGroup{},
 	"SpxTemplate": &SpxTemplate{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Spx's Objects
-func (Spx) RestObjects() map[string]sophos.RestObject { return defsSpx }
+func (Spx) RestObjects() map[string]sophos.RestGetter { return defsSpx }
 
 // GetPath implements sophos.RestGetter
 func (*Spx) GetPath() string { return "/api/nodes/spx" }
@@ -82,7 +82,7 @@ func (Spx) References() []string {
 	}
 }
 
-// SpxGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// SpxGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type SpxGroups []SpxGroup
 
 // SpxGroup represents a UTM group
@@ -94,60 +94,81 @@ type SpxGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewSpxGroup returns a SpxGroup with the default values of its swagger definition
+func NewSpxGroup() *SpxGroup {
+	return &SpxGroup{
+		ObjectType: "spx/group",
+	}
+}
+
+// Validate checks the SpxGroup before it is sent, see ValidateWith
+func (s *SpxGroup) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the SpxGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *SpxGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("SpxGroup", typeOf)
+	check.Required("name", s.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &SpxGroup{}
 
-// GetPath implements sophos.RestObject and returns the SpxGroups GET path
+// GetPath implements sophos.RestGetter and returns the SpxGroups GET path
 // Returns all available spx/group objects
 func (*SpxGroups) GetPath() string { return "/api/objects/spx/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*SpxGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the SpxGroups GET path
+// GetPath implements sophos.RestGetter and returns the SpxGroups GET path
 // Returns all available group types
 func (s *SpxGroup) GetPath() string { return fmt.Sprintf("/api/objects/spx/group/%s", s.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *SpxGroup) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the SpxGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the SpxGroup DELETE path
 // Creates or updates the complete object group
 func (*SpxGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/spx/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the SpxGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the SpxGroup PATCH path
 // Changes to parts of the object group types
 func (*SpxGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/spx/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the SpxGroup POST path
+// PostPath implements sophos.Creatable and returns the SpxGroup POST path
 // Create a new spx/group object
 func (*SpxGroup) PostPath() string {
 	return "/api/objects/spx/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the SpxGroup PUT path
+// PutPath implements sophos.Updatable and returns the SpxGroup PUT path
 // Creates or updates the complete object group
 func (*SpxGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/spx/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*SpxGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/spx/group/%s/usedby", ref)
 }
 
-// SpxTemplates is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (s *SpxGroup) GetType() string { return s.ObjectType }
+
+// SpxTemplates is an Sophos Endpoint subType and implements sophos.RestGetter
 type SpxTemplates []SpxTemplate
 
-// SpxTemplate is a generated Sophos object
+// SpxTemplate represents a UTM spx/template object
 type SpxTemplate struct {
 	Locked                         string `json:"_locked"`
-	Reference                      string `json:"_ref"`
 	ObjectType                     string `json:"_type"`
+	Reference                      string `json:"_ref"`
 	Comment                        string `json:"comment"`
 	Name                           string `json:"name"`
 	OrgName                        string `json:"org_name"`
@@ -192,49 +213,67 @@ type SpxTemplate struct {
 	RemoveSophosLogo               bool   `json:"remove_sophos_logo"`
 }
 
+// NewSpxTemplate returns a SpxTemplate with the default values of its swagger definition
+func NewSpxTemplate() *SpxTemplate {
+	return &SpxTemplate{
+		ObjectType: "spx/template",
+	}
+}
+
+// Validate checks the SpxTemplate before it is sent, see ValidateWith
+func (s *SpxTemplate) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the SpxTemplate.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *SpxTemplate) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("SpxTemplate", typeOf)
+	check.Required("name", s.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &SpxTemplate{}
 
-// GetPath implements sophos.RestObject and returns the SpxTemplates GET path
+// GetPath implements sophos.RestGetter and returns the SpxTemplates GET path
 // Returns all available spx/template objects
 func (*SpxTemplates) GetPath() string { return "/api/objects/spx/template/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*SpxTemplates) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the SpxTemplates GET path
+// GetPath implements sophos.RestGetter and returns the SpxTemplates GET path
 // Returns all available template types
 func (s *SpxTemplate) GetPath() string {
 	return fmt.Sprintf("/api/objects/spx/template/%s", s.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *SpxTemplate) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the SpxTemplate DELETE path
+// DeletePath implements sophos.Deletable and returns the SpxTemplate DELETE path
 // Creates or updates the complete object template
 func (*SpxTemplate) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/spx/template/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the SpxTemplate PATCH path
+// PatchPath implements sophos.Patchable and returns the SpxTemplate PATCH path
 // Changes to parts of the object template types
 func (*SpxTemplate) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/spx/template/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the SpxTemplate POST path
+// PostPath implements sophos.Creatable and returns the SpxTemplate POST path
 // Create a new spx/template object
 func (*SpxTemplate) PostPath() string {
 	return "/api/objects/spx/template/"
 }
 
-// PutPath implements sophos.RestObject and returns the SpxTemplate PUT path
+// PutPath implements sophos.Updatable and returns the SpxTemplate PUT path
 // Creates or updates the complete object template
 func (*SpxTemplate) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/spx/template/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*SpxTemplate) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/spx/template/%s/usedby", ref)
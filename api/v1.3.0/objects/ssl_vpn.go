@@ -11,11 +11,11 @@ import (
 type SslVpn struct {
 	AuthenticationAlgorithm string `json:"authentication_algorithm"`
 	Certificate             string `json:"certificate"`
-	Compression             int64  `json:"compression"`
+	Compression             bool   `json:"compression"`
 	DatachannelKeyLifetime  int64  `json:"datachannel_key_lifetime"`
-	Debug                   int64  `json:"debug"`
+	Debug                   bool   `json:"debug"`
 	DhKeySize               string `json:"dh_key_size"`
-	DuplicateCn             int64  `json:"duplicate_cn"`
+	DuplicateCn             bool   `json:"duplicate_cn"`
 	EncryptionAlgorithm     string `json:"encryption_algorithm"`
 	Hostname                string `json:"hostname"`
 	Interface               string `json:"interface"`
@@ -23,12 +23,12 @@ type SslVpn struct {
 	IPAssignmentPool        string `json:"ip_assignment_pool"`
 	Port                    int64  `json:"port"`
 	Protocol                string `json:"protocol"`
-	UserAuthOptional        int64  `json:"user_auth_optional"`
+	UserAuthOptional        bool   `json:"user_auth_optional"`
 }
 
 var _ sophos.Endpoint = &SslVpn{}
 
-var defsSslVpn = map[string]sophos.RestObject{
+var defsSslVpn = map[string]sophos.RestGetter{
 	"SslVpnClientConnection":    &SslVpnClientConnection{},
 	"SslVpnGroup":               &SslVpnGroup{},
 	"SslVpnRemoteAccessProfile": &SslVpnRemoteAccessProfile{},
@@ -36,7 +36,7 @@ var defsSslVpn = map[string]sophos.RestObject{
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of SslVpn's Objects
-func (SslVpn) RestObjects() map[string]sophos.RestObject { return defsSslVpn }
+func (SslVpn) RestObjects() map[string]sophos.RestGetter { return defsSslVpn }
 
 // GetPath implements sophos.RestGetter
 func (*SslVpn) GetPath() string { return "/api/nodes/ssl_vpn" }
@@ -78,14 +78,14 @@ func (SslVpn) References() []string {
 	}
 }
 
-// SslVpnClientConnections is an Sophos Endpoint subType and implements sophos.RestObject
+// SslVpnClientConnections is an Sophos Endpoint subType and implements sophos.RestGetter
 type SslVpnClientConnections []SslVpnClientConnection
 
-// SslVpnClientConnection is a generated Sophos object
+// SslVpnClientConnection represents a UTM ssl_vpn/client_connection object
 type SslVpnClientConnection struct {
 	Locked                  string   `json:"_locked"`
-	Reference               string   `json:"_ref"`
 	ObjectType              string   `json:"_type"`
+	Reference               string   `json:"_ref"`
 	AuthenticationAlgorithm string   `json:"authentication_algorithm"`
 	AutoPfIn                string   `json:"auto_pf_in"`
 	AutoPfOut               string   `json:"auto_pf_out"`
@@ -119,49 +119,69 @@ type SslVpnClientConnection struct {
 	Username                string   `json:"username"`
 }
 
+// NewSslVpnClientConnection returns a SslVpnClientConnection with the default values of its swagger definition
+func NewSslVpnClientConnection() *SslVpnClientConnection {
+	return &SslVpnClientConnection{
+		ObjectType: "ssl_vpn/client_connection",
+	}
+}
+
+// Validate checks the SslVpnClientConnection before it is sent, see ValidateWith
+func (s *SslVpnClientConnection) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the SslVpnClientConnection.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *SslVpnClientConnection) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("SslVpnClientConnection", typeOf)
+	check.Required("name", s.Name)
+	check.Range("proxy_port", s.ProxyPort, "0-65535")
+	check.Range("server_port", s.ServerPort, "0-65535")
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &SslVpnClientConnection{}
 
-// GetPath implements sophos.RestObject and returns the SslVpnClientConnections GET path
+// GetPath implements sophos.RestGetter and returns the SslVpnClientConnections GET path
 // Returns all available ssl_vpn/client_connection objects
 func (*SslVpnClientConnections) GetPath() string { return "/api/objects/ssl_vpn/client_connection/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*SslVpnClientConnections) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the SslVpnClientConnections GET path
+// GetPath implements sophos.RestGetter and returns the SslVpnClientConnections GET path
 // Returns all available client_connection types
 func (s *SslVpnClientConnection) GetPath() string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/client_connection/%s", s.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *SslVpnClientConnection) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the SslVpnClientConnection DELETE path
+// DeletePath implements sophos.Deletable and returns the SslVpnClientConnection DELETE path
 // Creates or updates the complete object client_connection
 func (*SslVpnClientConnection) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/client_connection/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the SslVpnClientConnection PATCH path
+// PatchPath implements sophos.Patchable and returns the SslVpnClientConnection PATCH path
 // Changes to parts of the object client_connection types
 func (*SslVpnClientConnection) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/client_connection/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the SslVpnClientConnection POST path
+// PostPath implements sophos.Creatable and returns the SslVpnClientConnection POST path
 // Create a new ssl_vpn/client_connection object
 func (*SslVpnClientConnection) PostPath() string {
 	return "/api/objects/ssl_vpn/client_connection/"
 }
 
-// PutPath implements sophos.RestObject and returns the SslVpnClientConnection PUT path
+// PutPath implements sophos.Updatable and returns the SslVpnClientConnection PUT path
 // Creates or updates the complete object client_connection
 func (*SslVpnClientConnection) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/client_connection/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*SslVpnClientConnection) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/client_connection/%s/usedby", ref)
@@ -170,7 +190,7 @@ func (*SslVpnClientConnection) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (s *SslVpnClientConnection) GetType() string { return s.ObjectType }
 
-// SslVpnGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// SslVpnGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type SslVpnGroups []SslVpnGroup
 
 // SslVpnGroup represents a UTM group
@@ -182,116 +202,158 @@ type SslVpnGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewSslVpnGroup returns a SslVpnGroup with the default values of its swagger definition
+func NewSslVpnGroup() *SslVpnGroup {
+	return &SslVpnGroup{
+		ObjectType: "ssl_vpn/group",
+	}
+}
+
+// Validate checks the SslVpnGroup before it is sent, see ValidateWith
+func (s *SslVpnGroup) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the SslVpnGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *SslVpnGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("SslVpnGroup", typeOf)
+	check.Required("name", s.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &SslVpnGroup{}
 
-// GetPath implements sophos.RestObject and returns the SslVpnGroups GET path
+// GetPath implements sophos.RestGetter and returns the SslVpnGroups GET path
 // Returns all available ssl_vpn/group objects
 func (*SslVpnGroups) GetPath() string { return "/api/objects/ssl_vpn/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*SslVpnGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the SslVpnGroups GET path
+// GetPath implements sophos.RestGetter and returns the SslVpnGroups GET path
 // Returns all available group types
 func (s *SslVpnGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/group/%s", s.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *SslVpnGroup) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the SslVpnGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the SslVpnGroup DELETE path
 // Creates or updates the complete object group
 func (*SslVpnGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the SslVpnGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the SslVpnGroup PATCH path
 // Changes to parts of the object group types
 func (*SslVpnGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the SslVpnGroup POST path
+// PostPath implements sophos.Creatable and returns the SslVpnGroup POST path
 // Create a new ssl_vpn/group object
 func (*SslVpnGroup) PostPath() string {
 	return "/api/objects/ssl_vpn/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the SslVpnGroup PUT path
+// PutPath implements sophos.Updatable and returns the SslVpnGroup PUT path
 // Creates or updates the complete object group
 func (*SslVpnGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*SslVpnGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/group/%s/usedby", ref)
 }
 
-// SslVpnRemoteAccessProfiles is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (s *SslVpnGroup) GetType() string { return s.ObjectType }
+
+// SslVpnRemoteAccessProfiles is an Sophos Endpoint subType and implements sophos.RestGetter
 type SslVpnRemoteAccessProfiles []SslVpnRemoteAccessProfile
 
-// SslVpnRemoteAccessProfile is a generated Sophos object
+// SslVpnRemoteAccessProfile represents a UTM ssl_vpn/remote_access_profile object
 type SslVpnRemoteAccessProfile struct {
 	Locked     string   `json:"_locked"`
-	Reference  string   `json:"_ref"`
 	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
 	Aaa        []string `json:"aaa"`
-	AutoPfIn   string   `json:"auto_pf_in"`
-	AutoPfrule bool     `json:"auto_pfrule"`
-	Comment    string   `json:"comment"`
-	Name       string   `json:"name"`
-	Networks   []string `json:"networks"`
-	Status     bool     `json:"status"`
+	// AutoPfIn description: REF(packetfilter/packetfilter)
+	// AutoPfIn default value is ""
+	AutoPfIn   PacketfilterRef `json:"auto_pf_in"`
+	AutoPfrule bool            `json:"auto_pfrule"`
+	Comment    string          `json:"comment"`
+	Name       string          `json:"name"`
+	Networks   []string        `json:"networks"`
+	Status     bool            `json:"status"`
+}
+
+// NewSslVpnRemoteAccessProfile returns a SslVpnRemoteAccessProfile with the default values of its swagger definition
+func NewSslVpnRemoteAccessProfile() *SslVpnRemoteAccessProfile {
+	return &SslVpnRemoteAccessProfile{
+		ObjectType: "ssl_vpn/remote_access_profile",
+	}
+}
+
+// Validate checks the SslVpnRemoteAccessProfile before it is sent, see ValidateWith
+func (s *SslVpnRemoteAccessProfile) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the SslVpnRemoteAccessProfile.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *SslVpnRemoteAccessProfile) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("SslVpnRemoteAccessProfile", typeOf)
+	check.Ref("auto_pf_in", string(s.AutoPfIn), "REF(packetfilter/packetfilter)")
+	check.Required("name", s.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &SslVpnRemoteAccessProfile{}
 
-// GetPath implements sophos.RestObject and returns the SslVpnRemoteAccessProfiles GET path
+// GetPath implements sophos.RestGetter and returns the SslVpnRemoteAccessProfiles GET path
 // Returns all available ssl_vpn/remote_access_profile objects
 func (*SslVpnRemoteAccessProfiles) GetPath() string {
 	return "/api/objects/ssl_vpn/remote_access_profile/"
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*SslVpnRemoteAccessProfiles) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the SslVpnRemoteAccessProfiles GET path
+// GetPath implements sophos.RestGetter and returns the SslVpnRemoteAccessProfiles GET path
 // Returns all available remote_access_profile types
 func (s *SslVpnRemoteAccessProfile) GetPath() string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/remote_access_profile/%s", s.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *SslVpnRemoteAccessProfile) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the SslVpnRemoteAccessProfile DELETE path
+// DeletePath implements sophos.Deletable and returns the SslVpnRemoteAccessProfile DELETE path
 // Creates or updates the complete object remote_access_profile
 func (*SslVpnRemoteAccessProfile) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/remote_access_profile/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the SslVpnRemoteAccessProfile PATCH path
+// PatchPath implements sophos.Patchable and returns the SslVpnRemoteAccessProfile PATCH path
 // Changes to parts of the object remote_access_profile types
 func (*SslVpnRemoteAccessProfile) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/remote_access_profile/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the SslVpnRemoteAccessProfile POST path
+// PostPath implements sophos.Creatable and returns the SslVpnRemoteAccessProfile POST path
 // Create a new ssl_vpn/remote_access_profile object
 func (*SslVpnRemoteAccessProfile) PostPath() string {
 	return "/api/objects/ssl_vpn/remote_access_profile/"
 }
 
-// PutPath implements sophos.RestObject and returns the SslVpnRemoteAccessProfile PUT path
+// PutPath implements sophos.Updatable and returns the SslVpnRemoteAccessProfile PUT path
 // Creates or updates the complete object remote_access_profile
 func (*SslVpnRemoteAccessProfile) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/remote_access_profile/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*SslVpnRemoteAccessProfile) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/remote_access_profile/%s/usedby", ref)
@@ -300,7 +362,7 @@ func (*SslVpnRemoteAccessProfile) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (s *SslVpnRemoteAccessProfile) GetType() string { return s.ObjectType }
 
-// SslVpnServerConnections is an Sophos Endpoint subType and implements sophos.RestObject
+// SslVpnServerConnections is an Sophos Endpoint subType and implements sophos.RestGetter
 type SslVpnServerConnections []SslVpnServerConnection
 
 // SslVpnServerConnection represents a UTM SSL VPN site-to-site server connection
@@ -310,75 +372,103 @@ type SslVpnServerConnection struct {
 	Reference  string `json:"_ref"`
 	// AutoPfIn description: REF(packetfilter/packetfilter)
 	// AutoPfIn default value is ""
-	AutoPfIn string `json:"auto_pf_in"`
+	AutoPfIn PacketfilterRef `json:"auto_pf_in"`
 	// AutoPfOut description: REF(packetfilter/packetfilter)
 	// AutoPfOut default value is ""
-	AutoPfOut     string        `json:"auto_pf_out"`
-	LocalNetworks []interface{} `json:"local_networks"`
+	AutoPfOut PacketfilterRef `json:"auto_pf_out"`
+	// AutoPfrule default value is false
+	AutoPfrule    bool     `json:"auto_pfrule"`
+	Comment       string   `json:"comment"`
+	LocalNetworks []string `json:"local_networks"`
+	Name          string   `json:"name"`
+	// Peer description: REF(aaa/user)
+	// Peer default value is ""
+	Peer           AaaRef   `json:"peer"`
+	RemoteNetworks []string `json:"remote_networks"`
 	// StaticIp description: (IPADDR)
 	// StaticIp default value is "0.0.0.0"
 	StaticIp string `json:"static_ip"`
+	// StaticIp6 description: (IP6ADDR)
+	// StaticIp6 default value is "::"
+	StaticIp6 string `json:"static_ip6"`
 	// StaticIpStatus default value is false
 	StaticIpStatus bool `json:"static_ip_status"`
 	// Status default value is false
 	Status bool `json:"status"`
-	// AutoPfrule default value is false
-	AutoPfrule bool   `json:"auto_pfrule"`
-	Comment    string `json:"comment"`
-	Name       string `json:"name"`
-	// Peer description: REF(aaa/user)
-	// Peer default value is ""
-	Peer           string        `json:"peer"`
-	RemoteNetworks []interface{} `json:"remote_networks"`
-	// StaticIp6 description: (IP6ADDR)
-	// StaticIp6 default value is "::"
-	StaticIp6 string `json:"static_ip6"`
+}
+
+// NewSslVpnServerConnection returns a SslVpnServerConnection with the default values of its swagger definition
+func NewSslVpnServerConnection() *SslVpnServerConnection {
+	return &SslVpnServerConnection{
+		ObjectType: "ssl_vpn/server_connection",
+		StaticIp:   "0.0.0.0",
+		StaticIp6:  "::",
+	}
+}
+
+// Validate checks the SslVpnServerConnection before it is sent, see ValidateWith
+func (s *SslVpnServerConnection) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the SslVpnServerConnection.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *SslVpnServerConnection) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("SslVpnServerConnection", typeOf)
+	check.Ref("auto_pf_in", string(s.AutoPfIn), "REF(packetfilter/packetfilter)")
+	check.Ref("auto_pf_out", string(s.AutoPfOut), "REF(packetfilter/packetfilter)")
+	check.Required("name", s.Name)
+	check.Ref("peer", string(s.Peer), "REF(aaa/user)")
+	check.Format("static_ip", sophos.FormatIPv4, s.StaticIp)
+	check.Format("static_ip6", sophos.FormatIPv6, s.StaticIp6)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &SslVpnServerConnection{}
 
-// GetPath implements sophos.RestObject and returns the SslVpnServerConnections GET path
+// GetPath implements sophos.RestGetter and returns the SslVpnServerConnections GET path
 // Returns all available ssl_vpn/server_connection objects
 func (*SslVpnServerConnections) GetPath() string { return "/api/objects/ssl_vpn/server_connection/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*SslVpnServerConnections) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the SslVpnServerConnections GET path
+// GetPath implements sophos.RestGetter and returns the SslVpnServerConnections GET path
 // Returns all available server_connection types
 func (s *SslVpnServerConnection) GetPath() string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/server_connection/%s", s.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *SslVpnServerConnection) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the SslVpnServerConnection DELETE path
+// DeletePath implements sophos.Deletable and returns the SslVpnServerConnection DELETE path
 // Creates or updates the complete object server_connection
 func (*SslVpnServerConnection) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/server_connection/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the SslVpnServerConnection PATCH path
+// PatchPath implements sophos.Patchable and returns the SslVpnServerConnection PATCH path
 // Changes to parts of the object server_connection types
 func (*SslVpnServerConnection) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/server_connection/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the SslVpnServerConnection POST path
+// PostPath implements sophos.Creatable and returns the SslVpnServerConnection POST path
 // Create a new ssl_vpn/server_connection object
 func (*SslVpnServerConnection) PostPath() string {
 	return "/api/objects/ssl_vpn/server_connection/"
 }
 
-// PutPath implements sophos.RestObject and returns the SslVpnServerConnection PUT path
+// PutPath implements sophos.Updatable and returns the SslVpnServerConnection PUT path
 // Creates or updates the complete object server_connection
 func (*SslVpnServerConnection) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/server_connection/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*SslVpnServerConnection) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/ssl_vpn/server_connection/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (s *SslVpnServerConnection) GetType() string { return s.ObjectType }
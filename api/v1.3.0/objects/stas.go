@@ -15,13 +15,13 @@ type Stas struct {
 
 var _ sophos.Endpoint = &Stas{}
 
-var defsStas = map[string]sophos.RestObject{
+var defsStas = map[string]sophos.RestGetter{
 	"StasCollector": &StasCollector{},
 	"StasGroup":     &StasGroup{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Stas's Objects
-func (Stas) RestObjects() map[string]sophos.RestObject { return defsStas }
+func (Stas) RestObjects() map[string]sophos.RestGetter { return defsStas }
 
 // GetPath implements sophos.RestGetter
 func (*Stas) GetPath() string { return "/api/nodes/stas" }
@@ -55,7 +55,7 @@ func (Stas) References() []string {
 	}
 }
 
-// StasCollectors is an Sophos Endpoint subType and implements sophos.RestObject
+// StasCollectors is an Sophos Endpoint subType and implements sophos.RestGetter
 type StasCollectors []StasCollector
 
 // StasCollector represents a UTM STAS Collector
@@ -63,64 +63,89 @@ type StasCollector struct {
 	Locked     string `json:"_locked"`
 	ObjectType string `json:"_type"`
 	Reference  string `json:"_ref"`
-	Name       string `json:"name"`
+	Comment    string `json:"comment"`
+	// Host description: REF(network/host), REF(network/dns_host)
+	Host NetworkRef `json:"host"`
+	Name string     `json:"name"`
 	// Port description: REF(service/udp)
 	// Port default value is "REF_ServiceSTASCollector"
-	Port    string `json:"port"`
-	Comment string `json:"comment"`
-	// Host description: REF(network/host), REF(network/dns_host)
-	Host string `json:"host"`
+	Port ServiceRef `json:"port"`
+}
+
+// NewStasCollector returns a StasCollector with the default values of its swagger definition
+func NewStasCollector() *StasCollector {
+	return &StasCollector{
+		ObjectType: "stas/collector",
+		Port:       "REF_ServiceSTASCollector",
+	}
+}
+
+// Validate checks the StasCollector before it is sent, see ValidateWith
+func (s *StasCollector) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the StasCollector.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *StasCollector) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("StasCollector", typeOf)
+	check.Required("host", string(s.Host))
+	check.Ref("host", string(s.Host), "REF(network/host), REF(network/dns_host)")
+	check.Required("name", s.Name)
+	check.Ref("port", string(s.Port), "REF(service/udp)")
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &StasCollector{}
 
-// GetPath implements sophos.RestObject and returns the StasCollectors GET path
+// GetPath implements sophos.RestGetter and returns the StasCollectors GET path
 // Returns all available stas/collector objects
 func (*StasCollectors) GetPath() string { return "/api/objects/stas/collector/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*StasCollectors) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the StasCollectors GET path
+// GetPath implements sophos.RestGetter and returns the StasCollectors GET path
 // Returns all available collector types
 func (s *StasCollector) GetPath() string {
 	return fmt.Sprintf("/api/objects/stas/collector/%s", s.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *StasCollector) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the StasCollector DELETE path
+// DeletePath implements sophos.Deletable and returns the StasCollector DELETE path
 // Creates or updates the complete object collector
 func (*StasCollector) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/stas/collector/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the StasCollector PATCH path
+// PatchPath implements sophos.Patchable and returns the StasCollector PATCH path
 // Changes to parts of the object collector types
 func (*StasCollector) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/stas/collector/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the StasCollector POST path
+// PostPath implements sophos.Creatable and returns the StasCollector POST path
 // Create a new stas/collector object
 func (*StasCollector) PostPath() string {
 	return "/api/objects/stas/collector/"
 }
 
-// PutPath implements sophos.RestObject and returns the StasCollector PUT path
+// PutPath implements sophos.Updatable and returns the StasCollector PUT path
 // Creates or updates the complete object collector
 func (*StasCollector) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/stas/collector/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*StasCollector) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/stas/collector/%s/usedby", ref)
 }
 
-// StasGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (s *StasCollector) GetType() string { return s.ObjectType }
+
+// StasGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type StasGroups []StasGroup
 
 // StasGroup represents a UTM stas->group
@@ -132,48 +157,69 @@ type StasGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewStasGroup returns a StasGroup with the default values of its swagger definition
+func NewStasGroup() *StasGroup {
+	return &StasGroup{
+		ObjectType: "stas/group",
+	}
+}
+
+// Validate checks the StasGroup before it is sent, see ValidateWith
+func (s *StasGroup) Validate() error { return s.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the StasGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (s *StasGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("StasGroup", typeOf)
+	check.Required("name", s.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &StasGroup{}
 
-// GetPath implements sophos.RestObject and returns the StasGroups GET path
+// GetPath implements sophos.RestGetter and returns the StasGroups GET path
 // Returns all available stas/group objects
 func (*StasGroups) GetPath() string { return "/api/objects/stas/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*StasGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the StasGroups GET path
+// GetPath implements sophos.RestGetter and returns the StasGroups GET path
 // Returns all available group types
 func (s *StasGroup) GetPath() string { return fmt.Sprintf("/api/objects/stas/group/%s", s.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (s *StasGroup) RefRequired() (string, bool) { return s.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the StasGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the StasGroup DELETE path
 // Creates or updates the complete object group
 func (*StasGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/stas/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the StasGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the StasGroup PATCH path
 // Changes to parts of the object group types
 func (*StasGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/stas/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the StasGroup POST path
+// PostPath implements sophos.Creatable and returns the StasGroup POST path
 // Create a new stas/group object
 func (*StasGroup) PostPath() string {
 	return "/api/objects/stas/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the StasGroup PUT path
+// PutPath implements sophos.Updatable and returns the StasGroup PUT path
 // Creates or updates the complete object group
 func (*StasGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/stas/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*StasGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/stas/group/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (s *StasGroup) GetType() string { return s.ObjectType }
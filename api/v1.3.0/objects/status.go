@@ -1,8 +1,6 @@
 package objects
 
 import (
-	"fmt"
-
 	"github.com/esurdam/go-sophos"
 )
 
@@ -14,12 +12,12 @@ type Status struct {
 
 var _ sophos.Endpoint = &Status{}
 
-var defsStatus = map[string]sophos.RestObject{
+var defsStatus = map[string]sophos.RestGetter{
 	"StatusVersion": &StatusVersion{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Status's Objects
-func (Status) RestObjects() map[string]sophos.RestObject { return defsStatus }
+func (Status) RestObjects() map[string]sophos.RestGetter { return defsStatus }
 
 // GetPath implements sophos.RestGetter
 func (*Status) GetPath() string { return "/api/nodes/status" }
@@ -45,42 +43,17 @@ func (Status) References() []string {
 	return []string{}
 }
 
-// StatusVersion is an Sophos Endpoint subType and implements sophos.RestObject
+// StatusVersion is an Sophos Endpoint subType and implements sophos.RestGetter
 type StatusVersion struct {
 	Restd string `json:"restd"`
 	Utm   string `json:"utm"`
 }
 
-var _ sophos.RestObject = &StatusVersion{}
+var _ sophos.RestGetter = &StatusVersion{}
 
-// GetPath implements sophos.RestObject and returns the StatusVersion GET path
+// GetPath implements sophos.RestGetter and returns the StatusVersion GET path
 // Returns some version numbers of the UTM software
 func (*StatusVersion) GetPath() string { return "/api/status/version" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*StatusVersion) RefRequired() (string, bool) { return "", false }
-
-// DeletePath implements sophos.RestObject and returns the StatusVersion DELETE path
-func (*StatusVersion) DeletePath(ref string) string {
-	return fmt.Sprintf("/api", ref)
-}
-
-// PatchPath implements sophos.RestObject and returns the StatusVersion PATCH path
-func (*StatusVersion) PatchPath(ref string) string {
-	return fmt.Sprintf("/api", ref)
-}
-
-// PostPath implements sophos.RestObject and returns the StatusVersion POST path
-func (*StatusVersion) PostPath() string {
-	return "/api"
-}
-
-// PutPath implements sophos.RestObject and returns the StatusVersion PUT path
-func (*StatusVersion) PutPath(ref string) string {
-	return fmt.Sprintf("/api", ref)
-}
-
-// UsedByPath implements sophos.RestObject
-func (*StatusVersion) UsedByPath(ref string) string {
-	return fmt.Sprintf("/api/usedby", ref)
-}
@@ -16,14 +16,14 @@ type Time struct {
 
 var _ sophos.Endpoint = &Time{}
 
-var defsTime = map[string]sophos.RestObject{
+var defsTime = map[string]sophos.RestGetter{
 	"TimeGroup":     &TimeGroup{},
 	"TimeRecurring": &TimeRecurring{},
 	"TimeSingle":    &TimeSingle{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of Time's Objects
-func (Time) RestObjects() map[string]sophos.RestObject { return defsTime }
+func (Time) RestObjects() map[string]sophos.RestGetter { return defsTime }
 
 // GetPath implements sophos.RestGetter
 func (*Time) GetPath() string { return "/api/nodes/time" }
@@ -61,7 +61,7 @@ func (Time) References() []string {
 	}
 }
 
-// TimeGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// TimeGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type TimeGroups []TimeGroup
 
 // TimeGroup represents a UTM group
@@ -73,60 +73,81 @@ type TimeGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewTimeGroup returns a TimeGroup with the default values of its swagger definition
+func NewTimeGroup() *TimeGroup {
+	return &TimeGroup{
+		ObjectType: "time/group",
+	}
+}
+
+// Validate checks the TimeGroup before it is sent, see ValidateWith
+func (t *TimeGroup) Validate() error { return t.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the TimeGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (t *TimeGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("TimeGroup", typeOf)
+	check.Required("name", t.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &TimeGroup{}
 
-// GetPath implements sophos.RestObject and returns the TimeGroups GET path
+// GetPath implements sophos.RestGetter and returns the TimeGroups GET path
 // Returns all available time/group objects
 func (*TimeGroups) GetPath() string { return "/api/objects/time/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*TimeGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the TimeGroups GET path
+// GetPath implements sophos.RestGetter and returns the TimeGroups GET path
 // Returns all available group types
 func (t *TimeGroup) GetPath() string { return fmt.Sprintf("/api/objects/time/group/%s", t.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (t *TimeGroup) RefRequired() (string, bool) { return t.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the TimeGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the TimeGroup DELETE path
 // Creates or updates the complete object group
 func (*TimeGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/time/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the TimeGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the TimeGroup PATCH path
 // Changes to parts of the object group types
 func (*TimeGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/time/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the TimeGroup POST path
+// PostPath implements sophos.Creatable and returns the TimeGroup POST path
 // Create a new time/group object
 func (*TimeGroup) PostPath() string {
 	return "/api/objects/time/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the TimeGroup PUT path
+// PutPath implements sophos.Updatable and returns the TimeGroup PUT path
 // Creates or updates the complete object group
 func (*TimeGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/time/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*TimeGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/time/group/%s/usedby", ref)
 }
 
-// TimeRecurrings is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (t *TimeGroup) GetType() string { return t.ObjectType }
+
+// TimeRecurrings is an Sophos Endpoint subType and implements sophos.RestGetter
 type TimeRecurrings []TimeRecurring
 
-// TimeRecurring is a generated Sophos object
+// TimeRecurring represents a UTM time/recurring object
 type TimeRecurring struct {
 	Locked     string   `json:"_locked"`
-	Reference  string   `json:"_ref"`
 	ObjectType string   `json:"_type"`
+	Reference  string   `json:"_ref"`
 	Comment    string   `json:"comment"`
 	EndTime    string   `json:"end_time"`
 	Name       string   `json:"name"`
@@ -134,49 +155,67 @@ type TimeRecurring struct {
 	Weekdays   []string `json:"weekdays"`
 }
 
+// NewTimeRecurring returns a TimeRecurring with the default values of its swagger definition
+func NewTimeRecurring() *TimeRecurring {
+	return &TimeRecurring{
+		ObjectType: "time/recurring",
+	}
+}
+
+// Validate checks the TimeRecurring before it is sent, see ValidateWith
+func (t *TimeRecurring) Validate() error { return t.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the TimeRecurring.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (t *TimeRecurring) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("TimeRecurring", typeOf)
+	check.Required("name", t.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &TimeRecurring{}
 
-// GetPath implements sophos.RestObject and returns the TimeRecurrings GET path
+// GetPath implements sophos.RestGetter and returns the TimeRecurrings GET path
 // Returns all available time/recurring objects
 func (*TimeRecurrings) GetPath() string { return "/api/objects/time/recurring/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*TimeRecurrings) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the TimeRecurrings GET path
+// GetPath implements sophos.RestGetter and returns the TimeRecurrings GET path
 // Returns all available recurring types
 func (t *TimeRecurring) GetPath() string {
 	return fmt.Sprintf("/api/objects/time/recurring/%s", t.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (t *TimeRecurring) RefRequired() (string, bool) { return t.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the TimeRecurring DELETE path
+// DeletePath implements sophos.Deletable and returns the TimeRecurring DELETE path
 // Creates or updates the complete object recurring
 func (*TimeRecurring) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/time/recurring/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the TimeRecurring PATCH path
+// PatchPath implements sophos.Patchable and returns the TimeRecurring PATCH path
 // Changes to parts of the object recurring types
 func (*TimeRecurring) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/time/recurring/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the TimeRecurring POST path
+// PostPath implements sophos.Creatable and returns the TimeRecurring POST path
 // Create a new time/recurring object
 func (*TimeRecurring) PostPath() string {
 	return "/api/objects/time/recurring/"
 }
 
-// PutPath implements sophos.RestObject and returns the TimeRecurring PUT path
+// PutPath implements sophos.Updatable and returns the TimeRecurring PUT path
 // Creates or updates the complete object recurring
 func (*TimeRecurring) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/time/recurring/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*TimeRecurring) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/time/recurring/%s/usedby", ref)
@@ -185,7 +224,7 @@ func (*TimeRecurring) UsedByPath(ref string) string {
 // GetType implements sophos.Object
 func (t *TimeRecurring) GetType() string { return t.ObjectType }
 
-// TimeSingles is an Sophos Endpoint subType and implements sophos.RestObject
+// TimeSingles is an Sophos Endpoint subType and implements sophos.RestGetter
 type TimeSingles []TimeSingle
 
 // TimeSingle represents a UTM single time period
@@ -205,48 +244,69 @@ type TimeSingle struct {
 	StartTime string `json:"start_time"`
 }
 
+// NewTimeSingle returns a TimeSingle with the default values of its swagger definition
+func NewTimeSingle() *TimeSingle {
+	return &TimeSingle{
+		ObjectType: "time/single",
+	}
+}
+
+// Validate checks the TimeSingle before it is sent, see ValidateWith
+func (t *TimeSingle) Validate() error { return t.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the TimeSingle.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (t *TimeSingle) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("TimeSingle", typeOf)
+	check.Required("name", t.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &TimeSingle{}
 
-// GetPath implements sophos.RestObject and returns the TimeSingles GET path
+// GetPath implements sophos.RestGetter and returns the TimeSingles GET path
 // Returns all available time/single objects
 func (*TimeSingles) GetPath() string { return "/api/objects/time/single/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*TimeSingles) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the TimeSingles GET path
+// GetPath implements sophos.RestGetter and returns the TimeSingles GET path
 // Returns all available single types
 func (t *TimeSingle) GetPath() string { return fmt.Sprintf("/api/objects/time/single/%s", t.Reference) }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (t *TimeSingle) RefRequired() (string, bool) { return t.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the TimeSingle DELETE path
+// DeletePath implements sophos.Deletable and returns the TimeSingle DELETE path
 // Creates or updates the complete object single
 func (*TimeSingle) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/time/single/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the TimeSingle PATCH path
+// PatchPath implements sophos.Patchable and returns the TimeSingle PATCH path
 // Changes to parts of the object single types
 func (*TimeSingle) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/time/single/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the TimeSingle POST path
+// PostPath implements sophos.Creatable and returns the TimeSingle POST path
 // Create a new time/single object
 func (*TimeSingle) PostPath() string {
 	return "/api/objects/time/single/"
 }
 
-// PutPath implements sophos.RestObject and returns the TimeSingle PUT path
+// PutPath implements sophos.Updatable and returns the TimeSingle PUT path
 // Creates or updates the complete object single
 func (*TimeSingle) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/time/single/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*TimeSingle) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/time/single/%s/usedby", ref)
 }
+
+// GetType implements sophos.Object
+func (t *TimeSingle) GetType() string { return t.ObjectType }
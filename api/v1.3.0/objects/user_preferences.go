@@ -15,13 +15,13 @@ type UserPreferences struct {
 
 var _ sophos.Endpoint = &UserPreferences{}
 
-var defsUserPreferences = map[string]sophos.RestObject{
+var defsUserPreferences = map[string]sophos.RestGetter{
 	"UserPreferencesGroup":    &UserPreferencesGroup{},
 	"UserPreferencesWebadmin": &UserPreferencesWebadmin{},
 }
 
 // RestObjects implements the sophos.Node interface and returns a map of UserPreferences's Objects
-func (UserPreferences) RestObjects() map[string]sophos.RestObject { return defsUserPreferences }
+func (UserPreferences) RestObjects() map[string]sophos.RestGetter { return defsUserPreferences }
 
 // GetPath implements sophos.RestGetter
 func (*UserPreferences) GetPath() string { return "/api/nodes/user_preferences" }
@@ -55,7 +55,7 @@ func (UserPreferences) References() []string {
 	}
 }
 
-// UserPreferencesGroups is an Sophos Endpoint subType and implements sophos.RestObject
+// UserPreferencesGroups is an Sophos Endpoint subType and implements sophos.RestGetter
 type UserPreferencesGroups []UserPreferencesGroup
 
 // UserPreferencesGroup represents a UTM group
@@ -67,125 +67,157 @@ type UserPreferencesGroup struct {
 	Name       string `json:"name"`
 }
 
+// NewUserPreferencesGroup returns a UserPreferencesGroup with the default values of its swagger definition
+func NewUserPreferencesGroup() *UserPreferencesGroup {
+	return &UserPreferencesGroup{
+		ObjectType: "user_preferences/group",
+	}
+}
+
+// Validate checks the UserPreferencesGroup before it is sent, see ValidateWith
+func (u *UserPreferencesGroup) Validate() error { return u.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the UserPreferencesGroup.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (u *UserPreferencesGroup) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("UserPreferencesGroup", typeOf)
+	check.Required("name", u.Name)
+	return check.Err()
+}
+
 var _ sophos.RestGetter = &UserPreferencesGroup{}
 
-// GetPath implements sophos.RestObject and returns the UserPreferencesGroups GET path
+// GetPath implements sophos.RestGetter and returns the UserPreferencesGroups GET path
 // Returns all available user_preferences/group objects
 func (*UserPreferencesGroups) GetPath() string { return "/api/objects/user_preferences/group/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*UserPreferencesGroups) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the UserPreferencesGroups GET path
+// GetPath implements sophos.RestGetter and returns the UserPreferencesGroups GET path
 // Returns all available group types
 func (u *UserPreferencesGroup) GetPath() string {
 	return fmt.Sprintf("/api/objects/user_preferences/group/%s", u.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (u *UserPreferencesGroup) RefRequired() (string, bool) { return u.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the UserPreferencesGroup DELETE path
+// DeletePath implements sophos.Deletable and returns the UserPreferencesGroup DELETE path
 // Creates or updates the complete object group
 func (*UserPreferencesGroup) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/user_preferences/group/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the UserPreferencesGroup PATCH path
+// PatchPath implements sophos.Patchable and returns the UserPreferencesGroup PATCH path
 // Changes to parts of the object group types
 func (*UserPreferencesGroup) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/user_preferences/group/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the UserPreferencesGroup POST path
+// PostPath implements sophos.Creatable and returns the UserPreferencesGroup POST path
 // Create a new user_preferences/group object
 func (*UserPreferencesGroup) PostPath() string {
 	return "/api/objects/user_preferences/group/"
 }
 
-// PutPath implements sophos.RestObject and returns the UserPreferencesGroup PUT path
+// PutPath implements sophos.Updatable and returns the UserPreferencesGroup PUT path
 // Creates or updates the complete object group
 func (*UserPreferencesGroup) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/user_preferences/group/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*UserPreferencesGroup) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/user_preferences/group/%s/usedby", ref)
 }
 
-// UserPreferencesWebadmins is an Sophos Endpoint subType and implements sophos.RestObject
+// GetType implements sophos.Object
+func (u *UserPreferencesGroup) GetType() string { return u.ObjectType }
+
+// UserPreferencesWebadmins is an Sophos Endpoint subType and implements sophos.RestGetter
 type UserPreferencesWebadmins []UserPreferencesWebadmin
 
-// UserPreferencesWebadmin is a generated Sophos object
+// UserPreferencesWebadmin represents a UTM user_preferences/webadmin object
 type UserPreferencesWebadmin struct {
-	Locked               string   `json:"_locked"`
-	Reference            string   `json:"_ref"`
-	ObjectType           string   `json:"_type"`
-	BrowserTitle         string   `json:"browser_title"`
-	Comment              string   `json:"comment"`
-	DashboardAutogroup   bool     `json:"dashboard_autogroup"`
-	DashboardLeftcolumn  []string `json:"dashboard_leftcolumn"`
-	DashboardRightcolumn []string `json:"dashboard_rightcolumn"`
-	ItemsPerPage         string   `json:"items_per_page"`
-	Language             string   `json:"language"`
-	MarketingWindow      bool     `json:"marketing_window"`
-	Name                 string   `json:"name"`
-	Shortcuts            struct {
-		Aaa       []string `json:"aaa"`
-		Epp       []string `json:"epp"`
-		Interface []string `json:"interface"`
-		Network   []string `json:"network"`
-		Searchbox []string `json:"searchbox"`
-		Service   []string `json:"service"`
-	} `json:"shortcuts"`
-	SkipTermsOfUse bool `json:"skip_terms_of_use"`
+	Locked               string                 `json:"_locked"`
+	ObjectType           string                 `json:"_type"`
+	Reference            string                 `json:"_ref"`
+	BrowserTitle         string                 `json:"browser_title"`
+	Comment              string                 `json:"comment"`
+	DashboardAutogroup   bool                   `json:"dashboard_autogroup"`
+	DashboardLeftcolumn  []string               `json:"dashboard_leftcolumn"`
+	DashboardRightcolumn []string               `json:"dashboard_rightcolumn"`
+	ItemsPerPage         string                 `json:"items_per_page"`
+	Language             string                 `json:"language"`
+	MarketingWindow      bool                   `json:"marketing_window"`
+	Name                 string                 `json:"name"`
+	Shortcuts            map[string]interface{} `json:"shortcuts"`
+	SkipTermsOfUse       bool                   `json:"skip_terms_of_use"`
+}
+
+// NewUserPreferencesWebadmin returns a UserPreferencesWebadmin with the default values of its swagger definition
+func NewUserPreferencesWebadmin() *UserPreferencesWebadmin {
+	return &UserPreferencesWebadmin{
+		ObjectType: "user_preferences/webadmin",
+	}
+}
+
+// Validate checks the UserPreferencesWebadmin before it is sent, see ValidateWith
+func (u *UserPreferencesWebadmin) Validate() error { return u.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the UserPreferencesWebadmin.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func (u *UserPreferencesWebadmin) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("UserPreferencesWebadmin", typeOf)
+	check.Required("name", u.Name)
+	return check.Err()
 }
 
 var _ sophos.RestGetter = &UserPreferencesWebadmin{}
 
-// GetPath implements sophos.RestObject and returns the UserPreferencesWebadmins GET path
+// GetPath implements sophos.RestGetter and returns the UserPreferencesWebadmins GET path
 // Returns all available user_preferences/webadmin objects
 func (*UserPreferencesWebadmins) GetPath() string { return "/api/objects/user_preferences/webadmin/" }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (*UserPreferencesWebadmins) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the UserPreferencesWebadmins GET path
+// GetPath implements sophos.RestGetter and returns the UserPreferencesWebadmins GET path
 // Returns all available webadmin types
 func (u *UserPreferencesWebadmin) GetPath() string {
 	return fmt.Sprintf("/api/objects/user_preferences/webadmin/%s", u.Reference)
 }
 
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func (u *UserPreferencesWebadmin) RefRequired() (string, bool) { return u.Reference, true }
 
-// DeletePath implements sophos.RestObject and returns the UserPreferencesWebadmin DELETE path
+// DeletePath implements sophos.Deletable and returns the UserPreferencesWebadmin DELETE path
 // Creates or updates the complete object webadmin
 func (*UserPreferencesWebadmin) DeletePath(ref string) string {
 	return fmt.Sprintf("/api/objects/user_preferences/webadmin/%s", ref)
 }
 
-// PatchPath implements sophos.RestObject and returns the UserPreferencesWebadmin PATCH path
+// PatchPath implements sophos.Patchable and returns the UserPreferencesWebadmin PATCH path
 // Changes to parts of the object webadmin types
 func (*UserPreferencesWebadmin) PatchPath(ref string) string {
 	return fmt.Sprintf("/api/objects/user_preferences/webadmin/%s", ref)
 }
 
-// PostPath implements sophos.RestObject and returns the UserPreferencesWebadmin POST path
+// PostPath implements sophos.Creatable and returns the UserPreferencesWebadmin POST path
 // Create a new user_preferences/webadmin object
 func (*UserPreferencesWebadmin) PostPath() string {
 	return "/api/objects/user_preferences/webadmin/"
 }
 
-// PutPath implements sophos.RestObject and returns the UserPreferencesWebadmin PUT path
+// PutPath implements sophos.Updatable and returns the UserPreferencesWebadmin PUT path
 // Creates or updates the complete object webadmin
 func (*UserPreferencesWebadmin) PutPath(ref string) string {
 	return fmt.Sprintf("/api/objects/user_preferences/webadmin/%s", ref)
 }
 
-// UsedByPath implements sophos.RestObject
+// UsedByPath implements sophos.UsedByer
 // Returns the objects and the nodes that use the object with the given ref
 func (*UserPreferencesWebadmin) UsedByPath(ref string) string {
 	return fmt.Sprintf("/api/objects/user_preferences/webadmin/%s/usedby", ref)
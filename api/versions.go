@@ -0,0 +1,11 @@
+package api
+
+import (
+	v1_3_0_nodes "github.com/esurdam/go-sophos/api/v1.3.0/nodes"
+	v1_3_0 "github.com/esurdam/go-sophos/api/v1.3.0/objects"
+)
+
+// Versions are the generated packages sorted by Restd version
+var Versions = []Version{
+	{Restd: "1.3.0", Schema: v1_3_0.Schema, Endpoints: v1_3_0.Endpoints, NewObject: v1_3_0.NewObject, LookupNode: v1_3_0_nodes.Lookup},
+}
@@ -0,0 +1,227 @@
+// Package baseline applies and verifies named, versioned profiles of node values, e.g. to keep the
+// SSH, NTP, syslog and notification settings of many UTMs identical
+package baseline
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/nodes"
+	"github.com/esurdam/go-sophos/cleanup"
+)
+
+// A Profile is a named and versioned set of desired node values
+type Profile struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+	// Settings maps node paths (e.g. ssh.status) to their desired values
+	Settings map[string]interface{} `json:"settings"`
+}
+
+// Read decodes a Profile from JSON or, if the document is not a JSON object, from the YAML subset
+// of block mappings, block sequences and flow sequences of scalars.
+//
+// Nested settings are joined to node paths, e.g. the settings {"ssh": {"status": true}} and
+// {"ssh.status": true} are equal. Values of nodes of map type are not joined.
+func Read(r io.Reader) (*Profile, error) {
+	byt, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var doc interface{}
+	if trimmed := bytes.TrimSpace(byt); len(trimmed) > 0 && trimmed[0] == '{' {
+		dec := json.NewDecoder(bytes.NewReader(trimmed))
+		dec.UseNumber()
+		if err := dec.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("baseline: error decoding profile: %s", err.Error())
+		}
+	} else if doc, err = parseYAML(byt); err != nil {
+		return nil, err
+	}
+
+	m, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("baseline: profile is not a mapping")
+	}
+	p := &Profile{Settings: map[string]interface{}{}}
+	for k, v := range m {
+		switch k {
+		case "name", "version", "description":
+			s, ok := v.(string)
+			if n, isNum := v.(json.Number); isNum {
+				s, ok = n.String(), true
+			}
+			if !ok {
+				return nil, fmt.Errorf("baseline: %s of profile is not a string", k)
+			}
+			switch k {
+			case "name":
+				p.Name = s
+			case "version":
+				p.Version = s
+			default:
+				p.Description = s
+			}
+		case "settings":
+			settings, ok := v.(map[string]interface{})
+			if !ok && v != nil {
+				return nil, fmt.Errorf("baseline: settings of profile are not a mapping")
+			}
+			flatten("", settings, p.Settings)
+		default:
+			return nil, fmt.Errorf("baseline: unknown profile key %s", k)
+		}
+	}
+	if p.Name == "" {
+		return nil, fmt.Errorf("baseline: profile has no name")
+	}
+	return p, nil
+}
+
+// flatten joins the keys of nested mappings until they are the path of a node
+func flatten(prefix string, v interface{}, settings map[string]interface{}) {
+	m, ok := v.(map[string]interface{})
+	if !ok || (prefix != "" && nodes.Lookup(prefix) != nil) {
+		settings[prefix] = v
+		return
+	}
+	for k, vv := range m {
+		if prefix != "" {
+			k = prefix + "." + k
+		}
+		flatten(k, vv, settings)
+	}
+}
+
+// Nodes returns the sorted node paths of the Settings
+func (p *Profile) Nodes() []string {
+	paths := make([]string, 0, len(p.Settings))
+	for path := range p.Settings {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Validate checks that every node of the Settings is known to the nodes package and that its value
+// is of the node's type
+func (p *Profile) Validate() error {
+	var errs []string
+	for _, path := range p.Nodes() {
+		if _, err := desired(path, p.Settings[path]); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("baseline: profile %s is invalid: %s", p.Name, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// newNode returns a new instance of the generated type of the node
+func newNode(path string) (sophos.Node, reflect.Value, error) {
+	n := nodes.Lookup(path)
+	if n == nil {
+		return nil, reflect.Value{}, fmt.Errorf("unknown node %s", path)
+	}
+	v := reflect.New(reflect.TypeOf(n).Elem())
+	return v.Interface().(sophos.Node), v.Elem().FieldByName("Value"), nil
+}
+
+// desired returns the value of the node converted to the type of the node
+func desired(path string, val interface{}) (interface{}, error) {
+	_, v, err := newNode(path)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, fmt.Errorf("value of node %s is null", path)
+	}
+	byt, err := json.Marshal(val)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(byt, v.Addr().Interface()); err != nil {
+		return nil, fmt.Errorf("value %s of node %s is not of type %s", byt, path, v.Type())
+	}
+	return v.Interface(), nil
+}
+
+// A Change is a node whose current value differs from the desired value of a Profile
+type Change struct {
+	Node    string      `json:"node"`
+	Current interface{} `json:"current"`
+	Desired interface{} `json:"desired"`
+}
+
+// String returns the Change as a single line, e.g. ssh.port: 22 -> 2222
+func (c Change) String() string {
+	cur, _ := json.Marshal(c.Current)
+	want, _ := json.Marshal(c.Desired)
+	return fmt.Sprintf("%s: %s -> %s", c.Node, cur, want)
+}
+
+// Verify returns the drift of the UTM from the Profile: a Change for every node whose value differs,
+// sorted by node path
+func Verify(c sophos.ClientInterface, p *Profile, options ...sophos.Option) ([]Change, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	var changes []Change
+	for _, path := range p.Nodes() {
+		want, err := desired(path, p.Settings[path])
+		if err != nil {
+			return nil, err
+		}
+		n, v, err := newNode(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := n.Get(c, options...); err != nil {
+			return nil, fmt.Errorf("baseline: error retrieving node %s: %s", path, err.Error())
+		}
+		if !reflect.DeepEqual(v.Interface(), want) {
+			changes = append(changes, Change{Node: path, Current: v.Interface(), Desired: want})
+		}
+	}
+	return changes, nil
+}
+
+// Plan returns a cleanup.Plan updating the nodes of the Changes, each Step is undone by restoring
+// the current value
+func Plan(changes []Change) cleanup.Plan {
+	var plan cleanup.Plan
+	for _, ch := range changes {
+		path := "/api/nodes/" + ch.Node
+		plan = append(plan, cleanup.Step{
+			Description: "update " + ch.String(),
+			Method:      http.MethodPut,
+			Path:        path,
+			Body:        ch.Desired,
+			Undo:        &cleanup.Step{Description: "restore " + ch.Node, Method: http.MethodPut, Path: path, Body: ch.Current},
+		})
+	}
+	return plan
+}
+
+// Apply updates the nodes whose values differ from the Profile in a single confd session and returns
+// the applied Changes. If an update fails the previous updates are reverted.
+func Apply(c sophos.ClientInterface, p *Profile, options ...sophos.Option) ([]Change, error) {
+	changes, err := Verify(c, p, options...)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+	if err := Plan(changes).Apply(c, options...); err != nil {
+		return nil, fmt.Errorf("baseline: error applying profile %s: %s", p.Name, err.Error())
+	}
+	return changes, nil
+}
@@ -0,0 +1,175 @@
+package baseline_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/baseline"
+)
+
+const branchYAML = `# branch office baseline
+name: branch
+version: 1.10
+settings:
+  ssh:
+    status: true
+    port: 2222
+    allowed_networks: [REF_NetworkAny, "REF_NetNetAdmin"]
+  ntp.servers:
+    - REF_NetHosNtp
+  remote_syslog.status: false  # disabled until the collector is migrated
+  notifications.sender: 'utm''s@example.com'
+`
+
+// fakeNodes serves GET and PUT of /api/nodes/
+type fakeNodes struct {
+	mu     sync.Mutex
+	values map[string]json.RawMessage
+	puts   []string
+	closed bool
+	// failPut fails updates of the node
+	failPut string
+}
+
+func (f *fakeNodes) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	f.closed = r.Header.Get(sophos.XRestdSession) == "close"
+	if r.URL.Path == "/api/status/version" {
+		w.Write([]byte(`{"restapi":"1.3.0"}`))
+		return
+	}
+	node := strings.TrimPrefix(r.URL.Path, "/api/nodes/")
+	if _, ok := f.values[node]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if r.Method == http.MethodPut && node == f.failPut {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		return
+	}
+	if r.Method == http.MethodPut {
+		var v json.RawMessage
+		json.NewDecoder(r.Body).Decode(&v)
+		f.values[node] = v
+		f.puts = append(f.puts, node)
+	}
+	w.Write(f.values[node])
+}
+
+func TestRead(t *testing.T) {
+	p, err := baseline.Read(strings.NewReader(branchYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "branch" || p.Version != "1.10" {
+		t.Errorf("unexpected profile %+v", p)
+	}
+	want := []string{"notifications.sender", "ntp.servers", "remote_syslog.status", "ssh.allowed_networks", "ssh.port", "ssh.status"}
+	if !reflect.DeepEqual(p.Nodes(), want) {
+		t.Errorf("unexpected nodes %v", p.Nodes())
+	}
+	if p.Settings["notifications.sender"] != "utm's@example.com" {
+		t.Errorf("unexpected sender %v", p.Settings["notifications.sender"])
+	}
+	if err := p.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	j, err := baseline.Read(strings.NewReader(`{"name": "branch", "version": "1.10", "settings": {"ssh.status": true, "ssh.port": 2222,
+		"ssh.allowed_networks": ["REF_NetworkAny", "REF_NetNetAdmin"], "ntp": {"servers": ["REF_NetHosNtp"]},
+		"remote_syslog.status": false, "notifications.sender": "utm's@example.com"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(p, j) {
+		t.Errorf("YAML and JSON profiles differ\n%+v\n%+v", p, j)
+	}
+
+	for _, doc := range []string{
+		"name: x\nsettings:\n  ssh.port: 22.5\n",
+		"name: x\nsettings:\n  ssh.status: yes please\n",
+		"name: x\nsettings:\n  ssh.unknown: true\n",
+	} {
+		p, err := baseline.Read(strings.NewReader(doc))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := p.Validate(); err == nil {
+			t.Errorf("%q should be invalid", doc)
+		}
+	}
+	if _, err := baseline.Read(strings.NewReader("name: x\n  settings: {a: b}\n")); err == nil {
+		t.Error("invalid YAML should fail")
+	}
+}
+
+func TestApply(t *testing.T) {
+	f := &fakeNodes{values: map[string]json.RawMessage{
+		"ssh.status":           json.RawMessage(`true`),
+		"ssh.port":             json.RawMessage(`22`),
+		"ssh.allowed_networks": json.RawMessage(`["REF_NetworkAny"]`),
+		"ntp.servers":          json.RawMessage(`["REF_NetHosNtp"]`),
+		"remote_syslog.status": json.RawMessage(`true`),
+		"notifications.sender": json.RawMessage(`"utm's@example.com"`),
+	}}
+	ts := httptest.NewServer(f)
+	defer ts.Close()
+	sophos.DefaultHTTPClient = ts.Client()
+	c, err := sophos.New(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := baseline.Read(strings.NewReader(branchYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	drift, err := baseline.Verify(c, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, ch := range drift {
+		got = append(got, ch.String())
+	}
+	want := []string{
+		`remote_syslog.status: true -> false`,
+		`ssh.allowed_networks: ["REF_NetworkAny"] -> ["REF_NetworkAny","REF_NetNetAdmin"]`,
+		`ssh.port: 22 -> 2222`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected drift\n%s", strings.Join(got, "\n"))
+	}
+
+	applied, err := baseline.Apply(c, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(applied, drift) || !reflect.DeepEqual(f.puts, []string{"remote_syslog.status", "ssh.allowed_networks", "ssh.port"}) {
+		t.Errorf("only differing nodes should be updated, updated %v", f.puts)
+	}
+	if !f.closed {
+		t.Error("the session should be closed by the last request")
+	}
+	if drift, err := baseline.Verify(c, p); err != nil || len(drift) != 0 {
+		t.Errorf("unexpected drift after apply %v %v", drift, err)
+	}
+
+	f.failPut = "ssh.port"
+	p.Settings["ssh.port"] = 22
+	p.Settings["remote_syslog.status"] = true
+	if _, err := baseline.Apply(c, p); err == nil {
+		t.Fatal("failed updates should fail")
+	}
+	if string(f.values["remote_syslog.status"]) != "false" {
+		t.Errorf("failed updates should be rolled back, got %s", f.values["remote_syslog.status"])
+	}
+}
@@ -0,0 +1,268 @@
+package baseline
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// yamlLine is a non-empty line of a YAML document without comment
+type yamlLine struct {
+	num    int
+	indent int
+	text   string
+}
+
+// parseYAML parses the YAML subset of profiles: block mappings, block sequences, flow sequences of
+// scalars and scalars (quoted strings, booleans, null, numbers and plain strings). Anchors, multi-line
+// strings, flow mappings and multiple documents are not supported. Numbers are returned as json.Number.
+func parseYAML(data []byte) (interface{}, error) {
+	var lines []yamlLine
+	for i, l := range strings.Split(string(data), "\n") {
+		l = strings.TrimRight(stripComment(l), " \t\r")
+		trimmed := strings.TrimLeft(l, " ")
+		if trimmed == "" || trimmed == "---" {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, fmt.Errorf("baseline: yaml line %d: tabs are not allowed for indentation", i+1)
+		}
+		lines = append(lines, yamlLine{num: i + 1, indent: len(l) - len(trimmed), text: trimmed})
+	}
+	if len(lines) == 0 {
+		return map[string]interface{}{}, nil
+	}
+	p := &yamlParser{lines: lines}
+	v, err := p.block(lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(lines) {
+		return nil, fmt.Errorf("baseline: yaml line %d: unexpected indentation", lines[p.pos].num)
+	}
+	return v, nil
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// block parses the mapping or sequence starting at the current line with the indent
+func (p *yamlParser) block(indent int) (interface{}, error) {
+	if l := p.lines[p.pos]; l.text == "-" || strings.HasPrefix(l.text, "- ") {
+		return p.sequence(indent)
+	}
+	return p.mapping(indent)
+}
+
+func (p *yamlParser) sequence(indent int) (interface{}, error) {
+	seq := []interface{}{}
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			break
+		}
+		if l.indent > indent || !(l.text == "-" || strings.HasPrefix(l.text, "- ")) {
+			return nil, fmt.Errorf("baseline: yaml line %d: expected a sequence item", l.num)
+		}
+		p.pos++
+		item := strings.TrimSpace(strings.TrimPrefix(l.text, "-"))
+		if item == "" {
+			var v interface{}
+			if p.pos < len(p.lines) && p.lines[p.pos].indent > indent {
+				var err error
+				if v, err = p.block(p.lines[p.pos].indent); err != nil {
+					return nil, err
+				}
+			}
+			seq = append(seq, v)
+			continue
+		}
+		v, err := flow(item, l.num)
+		if err != nil {
+			return nil, err
+		}
+		seq = append(seq, v)
+	}
+	return seq, nil
+}
+
+func (p *yamlParser) mapping(indent int) (interface{}, error) {
+	m := map[string]interface{}{}
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			break
+		}
+		if l.indent > indent {
+			return nil, fmt.Errorf("baseline: yaml line %d: unexpected indentation", l.num)
+		}
+		i := keyEnd(l.text)
+		if i < 0 {
+			return nil, fmt.Errorf("baseline: yaml line %d: expected key: value", l.num)
+		}
+		key, err := unquote(strings.TrimSpace(l.text[:i]), l.num)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := m[key]; ok {
+			return nil, fmt.Errorf("baseline: yaml line %d: duplicate key %s", l.num, key)
+		}
+		p.pos++
+		val := strings.TrimSpace(l.text[i+1:])
+		if val == "" {
+			if m[key], err = p.nested(indent); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if m[key], err = flow(val, l.num); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// nested parses the block of a mapping value indented deeper than indent, a missing block is null.
+// Sequences may be indented as deep as their key.
+func (p *yamlParser) nested(indent int) (interface{}, error) {
+	if p.pos >= len(p.lines) {
+		return nil, nil
+	}
+	l := p.lines[p.pos]
+	isSeq := l.text == "-" || strings.HasPrefix(l.text, "- ")
+	if l.indent > indent || (l.indent == indent && isSeq) {
+		return p.block(l.indent)
+	}
+	return nil, nil
+}
+
+// keyEnd returns the index of the colon ending the key of a mapping entry
+func keyEnd(s string) int {
+	quote := byte(0)
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == ':' && (i == len(s)-1 || s[i+1] == ' '):
+			return i
+		}
+	}
+	return -1
+}
+
+// stripComment removes a comment which starts with # at the start of the line or after a space
+func stripComment(s string) string {
+	quote := byte(0)
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t'):
+			return s[:i]
+		}
+	}
+	return s
+}
+
+// flow parses a scalar or a flow sequence of scalars
+func flow(s string, num int) (interface{}, error) {
+	if !strings.HasPrefix(s, "[") {
+		if strings.HasPrefix(s, "{") {
+			if s == "{}" {
+				return map[string]interface{}{}, nil
+			}
+			return nil, fmt.Errorf("baseline: yaml line %d: flow mappings are not supported", num)
+		}
+		return scalar(s, num)
+	}
+	if !strings.HasSuffix(s, "]") {
+		return nil, fmt.Errorf("baseline: yaml line %d: unterminated flow sequence", num)
+	}
+	seq := []interface{}{}
+	inner := strings.TrimSpace(s[1 : len(s)-1])
+	if inner == "" {
+		return seq, nil
+	}
+	start, quote := 0, byte(0)
+	for i := 0; i <= len(inner); i++ {
+		if i < len(inner) {
+			c := inner[i]
+			if quote != 0 {
+				if c == '\\' && quote == '"' {
+					i++
+				} else if c == quote {
+					quote = 0
+				}
+				continue
+			}
+			if c == '"' || c == '\'' {
+				quote = c
+				continue
+			}
+			if c == '[' || c == '{' {
+				return nil, fmt.Errorf("baseline: yaml line %d: nested flow collections are not supported", num)
+			}
+			if c != ',' {
+				continue
+			}
+		}
+		v, err := scalar(strings.TrimSpace(inner[start:i]), num)
+		if err != nil {
+			return nil, err
+		}
+		seq = append(seq, v)
+		start = i + 1
+	}
+	return seq, nil
+}
+
+func scalar(s string, num int) (interface{}, error) {
+	switch s {
+	case "":
+		return nil, fmt.Errorf("baseline: yaml line %d: empty value", num)
+	case "~", "null", "Null", "NULL":
+		return nil, nil
+	case "true", "True", "TRUE":
+		return true, nil
+	case "false", "False", "FALSE":
+		return false, nil
+	}
+	if s[0] == '"' || s[0] == '\'' {
+		return unquote(s, num)
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil && json.Valid([]byte(s)) {
+		return json.Number(s), nil
+	}
+	return s, nil
+}
+
+// unquote returns the string of a double or single quoted scalar, other strings are returned as is
+func unquote(s string, num int) (string, error) {
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return strings.Replace(s[1:len(s)-1], "''", "'", -1), nil
+	}
+	if len(s) > 0 && s[0] == '"' {
+		u, err := strconv.Unquote(s)
+		if err != nil {
+			return "", fmt.Errorf("baseline: yaml line %d: invalid quoted string %s", num, s)
+		}
+		return u, nil
+	}
+	if len(s) > 0 && s[0] == '\'' {
+		return "", fmt.Errorf("baseline: yaml line %d: invalid quoted string %s", num, s)
+	}
+	return s, nil
+}
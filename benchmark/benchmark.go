@@ -0,0 +1,238 @@
+// Package benchmark evaluates hardening profiles against the node values of a snapshot.Snapshot or a live UTM
+package benchmark
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/netset"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+// Status of a Result
+type Status string
+
+// Statuses of a Result
+const (
+	Pass Status = "pass"
+	Fail Status = "fail"
+	// Unknown is the Status of Controls whose nodes are missing or could not be evaluated
+	Unknown Status = "unknown"
+)
+
+// A Control is a requirement of a Profile evaluated against node values
+type Control struct {
+	ID    string
+	Title string
+	// Nodes are the nodes read by Check, their values are the evidence of the Result
+	Nodes []string
+	// Weight of the Control in the score, 1 by default
+	Weight int
+	// Check returns whether the values satisfy the Control and a message explaining why
+	Check func(v *Values) (bool, string, error)
+}
+
+func (c Control) weight() int {
+	if c.Weight <= 0 {
+		return 1
+	}
+	return c.Weight
+}
+
+// A Profile is a named list of Controls
+type Profile struct {
+	Name     string
+	Controls []Control
+}
+
+func (p Profile) validate() error {
+	seen := map[string]bool{}
+	for _, c := range p.Controls {
+		if c.ID == "" || c.Check == nil {
+			return fmt.Errorf("benchmark: controls of profile %s require an ID and a Check func", p.Name)
+		}
+		if seen[c.ID] {
+			return fmt.Errorf("benchmark: control %s of profile %s is not unique", c.ID, p.Name)
+		}
+		seen[c.ID] = true
+	}
+	return nil
+}
+
+// Values provides the node values and network objects of a Snapshot to Controls
+type Values struct {
+	s *snapshot.Snapshot
+	r *netset.Resolver
+}
+
+// Node decodes the value of the node into val
+func (v *Values) Node(node string, val interface{}) error { return v.s.Node(node, val) }
+
+// Bool returns the value of a boolean node
+func (v *Values) Bool(node string) (b bool, err error) {
+	err = v.Node(node, &b)
+	return
+}
+
+// Int returns the value of an integer node
+func (v *Values) Int(node string) (i int64, err error) {
+	err = v.Node(node, &i)
+	return
+}
+
+// String returns the value of a string node
+func (v *Values) String(node string) (s string, err error) {
+	err = v.Node(node, &s)
+	return
+}
+
+// Networks returns the addresses of the network objects referenced by the node
+func (v *Values) Networks(node string) (netset.Set, error) {
+	var refs []string
+	if err := v.Node(node, &refs); err != nil {
+		return netset.Set{}, err
+	}
+	var sets []netset.Set
+	for _, ref := range refs {
+		set, _, err := v.r.Resolve(ref)
+		if err != nil {
+			return netset.Set{}, err
+		}
+		sets = append(sets, set)
+	}
+	return netset.Union(sets...), nil
+}
+
+// A Result is the outcome of a Control
+type Result struct {
+	Control string `json:"control"`
+	Title   string `json:"title"`
+	Status  Status `json:"status"`
+	Weight  int    `json:"weight"`
+	Message string `json:"message"`
+	// Evidence contains the values of the Control's nodes
+	Evidence map[string]json.RawMessage `json:"evidence,omitempty"`
+}
+
+// Report is the result of Evaluate
+type Report struct {
+	Profile string   `json:"profile"`
+	Results []Result `json:"results"`
+	// Score is the weighted percentage of passed Controls, Controls of Unknown Status are not scored
+	Score float64 `json:"score"`
+}
+
+// Evaluate evaluates the Controls of the Profile against the Snapshot in order
+func Evaluate(s *snapshot.Snapshot, p Profile) (*Report, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	v := &Values{s: s, r: netset.NewResolver(s)}
+	r := &Report{Profile: p.Name}
+	var passed, scored int
+	for _, c := range p.Controls {
+		res := evaluate(v, c)
+		r.Results = append(r.Results, res)
+		if res.Status == Unknown {
+			continue
+		}
+		scored += res.Weight
+		if res.Status == Pass {
+			passed += res.Weight
+		}
+	}
+	if scored > 0 {
+		r.Score = 100 * float64(passed) / float64(scored)
+	}
+	return r, nil
+}
+
+func evaluate(v *Values, c Control) Result {
+	res := Result{Control: c.ID, Title: c.Title, Status: Unknown, Weight: c.weight(), Evidence: map[string]json.RawMessage{}}
+	var missing []string
+	for _, n := range c.Nodes {
+		raw, ok := v.s.RawNode(n)
+		if !ok {
+			missing = append(missing, n)
+			continue
+		}
+		res.Evidence[n] = raw
+	}
+	if len(missing) > 0 {
+		res.Message = fmt.Sprintf("%s: node %s", snapshot.ErrNotFound, strings.Join(missing, ", "))
+		return res
+	}
+
+	ok, msg, err := c.Check(v)
+	if err != nil {
+		res.Message = err.Error()
+		return res
+	}
+	res.Status, res.Message = Fail, msg
+	if ok {
+		res.Status = Pass
+	}
+	return res
+}
+
+// EvaluateLive captures a Snapshot of the nodes and the objects of the Endpoints (objects.Network by default)
+// and evaluates the Profile against it
+func EvaluateLive(c sophos.ClientInterface, p Profile, endpoints ...sophos.Endpoint) (*Report, error) {
+	if len(endpoints) == 0 {
+		endpoints = []sophos.Endpoint{&objects.Network{}}
+	}
+	s, err := snapshot.Capture(c, endpoints...)
+	if err != nil {
+		return nil, err
+	}
+	return Evaluate(s, p)
+}
+
+// Count returns the number of Results of the Status
+func (r *Report) Count(status Status) int {
+	n := 0
+	for _, res := range r.Results {
+		if res.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// WriteText writes the Results as a text table followed by the score
+func (r *Report) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "STATUS\tCONTROL\tMESSAGE\tEVIDENCE")
+	for _, res := range r.Results {
+		var evidence []string
+		for _, n := range sortedNodes(res.Evidence) {
+			evidence = append(evidence, n+"="+string(res.Evidence[n]))
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", res.Status, res.Control, res.Message, strings.Join(evidence, " "))
+	}
+	fmt.Fprintf(tw, "\n%s: %d passed, %d failed, %d unknown, score %.1f%%\n",
+		r.Profile, r.Count(Pass), r.Count(Fail), r.Count(Unknown), r.Score)
+	return tw.Flush()
+}
+
+// WriteJSON writes the Report as JSON
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+func sortedNodes(evidence map[string]json.RawMessage) []string {
+	nodes := make([]string, 0, len(evidence))
+	for n := range evidence {
+		nodes = append(nodes, n)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
@@ -0,0 +1,110 @@
+package benchmark_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/benchmark"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+func testSnapshot(t *testing.T) *snapshot.Snapshot {
+	s := snapshot.New()
+	if err := s.Add(objects.NetworkNetwork{Reference: "REF_NetNetLan", ObjectType: "network/network", Name: "LAN", Address: "10.0.0.0", Netmask: 24}); err != nil {
+		t.Fatal(err)
+	}
+	for node, val := range map[string]interface{}{
+		"ssh.status":                              true,
+		"ssh.allowed_networks":                    []string{"REF_NetNetLan", sophos.RefNetworkAny},
+		"ssh.password_auth":                       false,
+		"webadmin.allowed_networks":               []string{"REF_NetNetLan"},
+		"settings.password_complexity.status":     true,
+		"settings.password_complexity.min_length": 6,
+		"support_access.status":                   false,
+		"flood_protection.syn.status":             true,
+		"flood_protection.udp.status":             false,
+		"flood_protection.icmp.status":            true,
+	} {
+		if err := s.SetNode(node, val); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s
+}
+
+func TestEvaluate(t *testing.T) {
+	r, err := benchmark.Evaluate(testSnapshot(t), benchmark.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := map[string]benchmark.Status{}
+	for _, res := range r.Results {
+		got[res.Control] = res.Status
+	}
+	want := map[string]benchmark.Status{
+		benchmark.ControlSSHAllowedNetworks:      benchmark.Fail,
+		benchmark.ControlSSHPasswordAuth:         benchmark.Pass,
+		benchmark.ControlWebadminAllowedNetworks: benchmark.Pass,
+		benchmark.ControlPasswordComplexity:      benchmark.Fail,
+		benchmark.ControlSupportAccess:           benchmark.Pass,
+		benchmark.ControlFloodProtection:         benchmark.Fail,
+		benchmark.ControlLoginLockout:            benchmark.Unknown,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected results %v", got)
+	}
+	// passed weights 1+3+2 of scored 3+1+3+2+2+1
+	if r.Score != 50 {
+		t.Errorf("unexpected score %v", r.Score)
+	}
+
+	ssh := r.Results[0]
+	if string(ssh.Evidence["ssh.allowed_networks"]) != `["REF_NetNetLan","REF_NetworkAny"]` || ssh.Message != "SSH is allowed from any address" {
+		t.Errorf("unexpected evidence %+v", ssh)
+	}
+	if msg := r.Results[5].Message; msg != "UDP flood protection is disabled" {
+		t.Errorf("unexpected message %q", msg)
+	}
+	if msg := r.Results[6].Message; !strings.Contains(msg, "auth.block.lockout") {
+		t.Errorf("missing nodes should be reported, got %q", msg)
+	}
+
+	var buf bytes.Buffer
+	if err := r.WriteText(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "3 passed, 3 failed, 1 unknown, score 50.0%") {
+		t.Errorf("unexpected text report\n%s", buf.String())
+	}
+}
+
+func TestEvaluate_Custom(t *testing.T) {
+	s := testSnapshot(t)
+	p := benchmark.Profile{Name: "custom", Controls: []benchmark.Control{
+		{ID: "ssh-enabled", Nodes: []string{"ssh.status"}, Check: func(v *benchmark.Values) (bool, string, error) {
+			status, err := v.Bool("ssh.status")
+			return status, "", err
+		}},
+		{ID: "ssh-port", Check: func(v *benchmark.Values) (bool, string, error) {
+			_, err := v.Int("ssh.port")
+			return true, "", err
+		}},
+	}}
+	r, err := benchmark.Evaluate(s, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Results[0].Status != benchmark.Pass || r.Results[1].Status != benchmark.Unknown || r.Score != 100 {
+		t.Errorf("unexpected report %+v", r)
+	}
+
+	p.Controls = append(p.Controls, p.Controls[0])
+	if _, err := benchmark.Evaluate(s, p); err == nil {
+		t.Error("duplicate controls should fail")
+	}
+}
@@ -0,0 +1,145 @@
+package benchmark
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/esurdam/go-sophos/netset"
+)
+
+// IDs of the Controls of the Default Profile
+const (
+	ControlSSHAllowedNetworks      = "ssh-allowed-networks"
+	ControlSSHPasswordAuth         = "ssh-password-auth"
+	ControlWebadminAllowedNetworks = "webadmin-allowed-networks"
+	ControlPasswordComplexity      = "password-complexity"
+	ControlSupportAccess           = "support-access"
+	ControlFloodProtection         = "flood-protection"
+	ControlLoginLockout            = "login-lockout"
+)
+
+// MinPasswordLength is the minimum password length required by ControlPasswordComplexity
+var MinPasswordLength int64 = 8
+
+// floodProtections are the protocols of flood_protection.*.status
+var floodProtections = []string{"syn", "udp", "icmp"}
+
+// Default returns the built-in hardening Profile
+func Default() Profile {
+	return Profile{Name: "utm-hardening", Controls: []Control{
+		{ID: ControlSSHAllowedNetworks, Title: "SSH is not allowed from any address", Weight: 3,
+			Nodes: []string{"ssh.status", "ssh.allowed_networks"}, Check: sshAllowedNetworks},
+		{ID: ControlSSHPasswordAuth, Title: "SSH requires public key authentication",
+			Nodes: []string{"ssh.status", "ssh.password_auth"}, Check: sshPasswordAuth},
+		{ID: ControlWebadminAllowedNetworks, Title: "WebAdmin is not allowed from any address", Weight: 3,
+			Nodes: []string{"webadmin.allowed_networks"}, Check: webadminAllowedNetworks},
+		{ID: ControlPasswordComplexity, Title: "Password complexity is enforced", Weight: 2,
+			Nodes: []string{"settings.password_complexity.status", "settings.password_complexity.min_length"}, Check: passwordComplexity},
+		{ID: ControlSupportAccess, Title: "Support access is disabled", Weight: 2,
+			Nodes: []string{"support_access.status"}, Check: supportAccess},
+		{ID: ControlFloodProtection, Title: "Flood protection is enabled",
+			Nodes: []string{"flood_protection.syn.status", "flood_protection.udp.status", "flood_protection.icmp.status"}, Check: floodProtection},
+		{ID: ControlLoginLockout, Title: "Failed logins are blocked",
+			Nodes: []string{"auth.block.lockout"}, Check: loginLockout},
+	}}
+}
+
+// allowedFromAny checks that the networks of the node do not contain every IPv4 or IPv6 address
+func allowedFromAny(v *Values, node, service string) (bool, string, error) {
+	set, err := v.Networks(node)
+	if err != nil {
+		return false, "", err
+	}
+	any := netset.Any()
+	if set.Contains(any.IPv4()) || set.Contains(any.IPv6()) {
+		return false, fmt.Sprintf("%s is allowed from any address", service), nil
+	}
+	if set.IsEmpty() {
+		return true, fmt.Sprintf("%s is not allowed from any network", service), nil
+	}
+	return true, fmt.Sprintf("%s is allowed from %s", service, set), nil
+}
+
+func sshAllowedNetworks(v *Values) (bool, string, error) {
+	status, err := v.Bool("ssh.status")
+	if err != nil || !status {
+		return true, "SSH is disabled", err
+	}
+	return allowedFromAny(v, "ssh.allowed_networks", "SSH")
+}
+
+func sshPasswordAuth(v *Values) (bool, string, error) {
+	status, err := v.Bool("ssh.status")
+	if err != nil || !status {
+		return true, "SSH is disabled", err
+	}
+	password, err := v.Bool("ssh.password_auth")
+	if err != nil {
+		return false, "", err
+	}
+	if password {
+		return false, "SSH allows password authentication", nil
+	}
+	return true, "SSH allows public key authentication only", nil
+}
+
+func webadminAllowedNetworks(v *Values) (bool, string, error) {
+	return allowedFromAny(v, "webadmin.allowed_networks", "WebAdmin")
+}
+
+func passwordComplexity(v *Values) (bool, string, error) {
+	status, err := v.Bool("settings.password_complexity.status")
+	if err != nil {
+		return false, "", err
+	}
+	if !status {
+		return false, "password complexity is disabled", nil
+	}
+	length, err := v.Int("settings.password_complexity.min_length")
+	if err != nil {
+		return false, "", err
+	}
+	if length < MinPasswordLength {
+		return false, fmt.Sprintf("minimum password length %d is less than %d", length, MinPasswordLength), nil
+	}
+	return true, fmt.Sprintf("passwords require at least %d characters", length), nil
+}
+
+func supportAccess(v *Values) (bool, string, error) {
+	status, err := v.Bool("support_access.status")
+	if err != nil {
+		return false, "", err
+	}
+	if status {
+		return false, "support access is enabled", nil
+	}
+	return true, "support access is disabled", nil
+}
+
+func floodProtection(v *Values) (bool, string, error) {
+	var disabled []string
+	for _, p := range floodProtections {
+		status, err := v.Bool("flood_protection." + p + ".status")
+		if err != nil {
+			return false, "", err
+		}
+		if !status {
+			disabled = append(disabled, strings.ToUpper(p))
+		}
+	}
+	if len(disabled) > 0 {
+		return false, fmt.Sprintf("%s flood protection is disabled", strings.Join(disabled, ", ")), nil
+	}
+	return true, "SYN, UDP and ICMP flood protection is enabled", nil
+}
+
+func loginLockout(v *Values) (bool, string, error) {
+	lockout, err := v.Bool("auth.block.lockout")
+	if err != nil {
+		return false, "", err
+	}
+	if !lockout {
+		return false, "failed logins are not blocked", nil
+	}
+	return true, "failed logins are blocked", nil
+}
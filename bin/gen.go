@@ -4,26 +4,37 @@ package main
 import (
 	"bytes"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"math"
 	"os"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"sort"
 	"strings"
 	"text/template"
+	"unicode"
 
-	"github.com/ChimeraCoder/gojson"
 	"github.com/esurdam/go-sophos"
 )
 
 var (
-	client  *sophos.Client
+	src     source
 	rootDir string
 	debug   bool
 
+	// refClasses are the classes of typed References by class, classObjects the objects of a class
+	refClasses   = map[string]bool{}
+	classObjects = map[string][]string{}
+	// objectSchemas are the attributes of the object types embedded by schema.go, objectNames
+	// their struct names
+	objectSchemas = map[string]sophos.ObjectSchema{}
+	objectNames   = map[string]string{}
+
 	numberSequence    = regexp.MustCompile(`([a-zA-Z])(\d+)([a-zA-Z]?)`)
 	numberReplacement = []byte(`$1 $2 $3`)
 	header            = `package objects
@@ -52,15 +63,20 @@ type (
 		Definitions map[string]subTypeDef
 	}
 	subTypeDef struct {
-		Properties map[string]struct {
-			Type        string
-			Enum        []string
-			Items       map[string]string
-			Default     interface{}
-			Description string
-		}
+		Properties  map[string]property
 		Description string
 		Type        string
+		Required    []string
+	}
+	// property is the swagger schema of an Object attribute
+	property struct {
+		Type        string
+		Enum        []string
+		Items       map[string]interface{}
+		Default     interface{}
+		Description string
+		// Minimum and Maximum are the bounds of integers
+		Minimum, Maximum *float64
 	}
 	methodMap map[string]struct {
 		Description string
@@ -95,20 +111,67 @@ type (
 		Type              subTypeDef
 		DeletePath        string
 		PatchPath         string
+		UsedByPath        string
 		HasRef            bool
 		Bytes             string
 		Node              *endpoint `json:"-"`
 		IsPlural          bool
-		IsPluralInterface bool
 		IsType            bool
 	}
 )
 
 func main() {
+	fixtures := flag.String("fixtures", os.Getenv("FIXTURES"), "directory of saved /api documents, types are generated offline from it if set")
+	flag.BoolVar(&debug, "debug", false, "print the endpoints as JSON")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, `usage: gen [flags] [endpoint token]
+       gen [flags] fetch [endpoint token]
+
+gen generates the types from the UTM (endpoint and token as args or from env $ENDPOINT, $TOKEN)
+or offline from the fixtures directory. fetch saves the documents required by gen to the fixtures
+directory (default fixtures).
+
+`)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) > 0 && args[0] == "fetch" {
+		dir := *fixtures
+		if dir == "" {
+			dir = "fixtures"
+		}
+		src = recordingSource{source: newLiveSource(args[1:]), dir: dir}
+		if err := fetch(); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if *fixtures != "" {
+		src = fixtureSource{dir: *fixtures}
+	} else {
+		src = newLiveSource(args)
+	}
+	generate()
+}
+
+// source provides the documents the types are generated from
+type source interface {
+	// get returns the body of a GET request of the path, e.g. /api/definitions
+	get(path string) ([]byte, error)
+}
+
+// liveSource GETs the documents from the UTM
+type liveSource struct{ client *sophos.Client }
+
+// newLiveSource returns a liveSource of the endpoint and token args or $ENDPOINT and $TOKEN
+func newLiveSource(args []string) liveSource {
 	var ep, token string
-	if len(os.Args) == 2 {
-		ep = os.Args[1]
-		token = os.Args[2]
+	if len(args) == 2 {
+		ep = args[0]
+		token = args[1]
 	}
 
 	if ep == "" {
@@ -122,44 +185,171 @@ func main() {
 		panic("need endpoint and token as args or from env ($ENDPOINT, $TOKEN)")
 	}
 
-	var err error
-	client, err = sophos.New(ep, sophos.WithAPIToken(token))
+	client, err := sophos.New(ep, sophos.WithAPIToken(token))
 	if err != nil {
 		log.Fatal(err)
 	}
+	return liveSource{client: client}
+}
+
+func (l liveSource) get(path string) ([]byte, error) {
+	r, err := l.client.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+	return ioutil.ReadAll(r.Body)
+}
+
+// fixtureSource reads the documents saved by fetch
+type fixtureSource struct{ dir string }
+
+func (f fixtureSource) get(path string) ([]byte, error) {
+	return ioutil.ReadFile(fixturePath(f.dir, path))
+}
+
+// recordingSource saves the documents of its source to the fixtures directory
+type recordingSource struct {
+	source
+	dir string
+}
+
+func (r recordingSource) get(path string) ([]byte, error) {
+	byt, err := r.source.get(path)
+	if err != nil {
+		return nil, err
+	}
+	name := fixturePath(r.dir, path)
+	if err := os.MkdirAll(filepath.Dir(name), 0777); err != nil {
+		return nil, err
+	}
+	// indent the documents to keep fixtures diffable
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, bytes.TrimSpace(byt), "", "  "); err != nil {
+		return nil, fmt.Errorf("%s is not JSON: %s", path, err.Error())
+	}
+	buf.WriteByte('\n')
+	return byt, ioutil.WriteFile(name, buf.Bytes(), 0666)
+}
 
+// fixturePath returns the file of the document of the path, e.g. /api/definitions/aws is saved
+// as definitions/aws.json
+func fixturePath(dir, path string) string {
+	path = strings.Trim(strings.TrimPrefix(path, "/api"), "/")
+	if path == "" {
+		path = "api"
+	}
+	return filepath.Join(dir, filepath.FromSlash(path)+".json")
+}
+
+// getJSON decodes the document of the path into v
+func getJSON(path string, v interface{}) error {
+	byt, err := src.get(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(byt, v)
+}
+
+// hasSample returns true if the GET response of the swagger path is sampled to generate its struct,
+// objects are built from their definitions and nodes from /api/nodes
+func hasSample(path string) bool {
+	return !strings.HasPrefix(path, "/objects/") && !strings.HasPrefix(path, "/nodes/") &&
+		!strings.Contains(path, "{ref}") && !strings.HasSuffix(path, "/usedby")
+}
+
+func sortedPaths(paths map[string]methodMap) []string {
+	keys := make([]string, 0, len(paths))
+	for k := range paths {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedMethods(m methodMap) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedProperties(t subTypeDef) []string {
+	keys := make([]string, 0, len(t.Properties))
+	for k := range t.Properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// fetch saves the version, the definitions, the nodes and the sample payloads used by generate. Sample
+// payloads are optional, their errors are logged.
+func fetch() error {
+	var v sophos.Version
+	if err := getJSON("/api/status/version", &v); err != nil {
+		return err
+	}
+	fmt.Println(v.Restd)
+
+	var dd []definition
+	if err := getJSON("/api/definitions", &dd); err != nil {
+		return err
+	}
+	if _, err := src.get("/api/nodes"); err != nil {
+		return err
+	}
+	for _, def := range dd {
+		if err := getJSON(def.Link, &def.Swag); err != nil {
+			return err
+		}
+		fmt.Printf("fetched %s\n", def.Name)
+		for _, path := range sortedPaths(def.Swag.Paths) {
+			if _, ok := def.Swag.Paths[path]["get"]; !ok || !hasSample(path) {
+				continue
+			}
+			if _, err := src.get("/api" + path); err != nil {
+				log.Printf("could not fetch sample %s: %s\n", path, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+// generate writes the objects and nodes packages of the version of the source
+func generate() {
 	// TODD: version api against UTM
 	var v sophos.Version
-	r, err := client.Get("/api/status/version")
-	if err != nil {
+	if err := getJSON("/api/status/version", &v); err != nil {
 		log.Fatal(err)
 	}
-	r.MarshalTo(&v)
 	fmt.Println(v.Restd)
 
 	rootDir = "api/v" + v.Restd
 	os.RemoveAll(rootDir)
 
-	err = os.MkdirAll(rootDir, 0777)
+	err := os.MkdirAll(rootDir, 0777)
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	var dd []definition
-	r, err = client.Get("/api/definitions")
-	if err != nil {
+	if err := getJSON("/api/definitions", &dd); err != nil {
 		log.Fatal(err)
 	}
 
-	err = r.MarshalTo(&dd)
+	nodes, err := loadNodes(dd)
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	var d int
+	var titles []string
 	for _, def := range dd {
 		d++
-		err := def.process()
+		err := def.process(nodes)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -192,9 +382,124 @@ func main() {
 		if err != nil {
 			log.Fatal(err.Error())
 		}
+		titles = append(titles, def.Endpoint.Title)
+	}
+
+	sort.Strings(titles)
+	f, err := os.Create(rootDir + "/objects/endpoints.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	executeTmpl(f, endpointsTemplate, struct {
+		Titles  []string
+		Objects map[string]string
+	}{titles, objectNames})
+	f.Close()
+
+	var refs []refsData
+	for class := range refClasses {
+		objs := classObjects[class]
+		sort.Strings(objs)
+		refs = append(refs, refsData{Name: toCamelInitCase(class, true), Class: class, Objects: objs})
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i].Class < refs[j].Class })
+	f, err = os.Create(rootDir + "/objects/refs.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	executeTmpl(f, refsTemplate, refs)
+	f.Close()
+
+	f, err = os.Create(rootDir + "/objects/schema.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	executeTmpl(f, schemaTemplate, sophos.Schema{Restd: v.Restd, Objects: objectSchemas})
+	f.Close()
+
+	if err := writeVersions(); err != nil {
+		log.Fatal(err)
 	}
 }
 
+// writeVersions writes the Versions of the api facade package from the generated objects and nodes
+// packages kept side by side in api/v<Restd>
+func writeVersions() error {
+	dirs, err := filepath.Glob("api/v*/objects")
+	if err != nil {
+		return err
+	}
+	var versions []string
+	for _, dir := range dirs {
+		versions = append(versions, strings.TrimPrefix(filepath.Base(filepath.Dir(dir)), "v"))
+	}
+	sort.Slice(versions, func(i, j int) bool { return sophos.VersionLess(versions[i], versions[j]) })
+	f, err := os.Create("api/versions.go")
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	executeTmpl(f, versionsTemplate, versions)
+	return nil
+}
+
+var versionsTemplate = `package api
+
+import (
+	{{range .}}{{versionAlias .}}_nodes "github.com/esurdam/go-sophos/api/v{{.}}/nodes"
+	{{versionAlias .}} "github.com/esurdam/go-sophos/api/v{{.}}/objects"
+	{{end}}
+)
+
+// Versions are the generated packages sorted by Restd version
+var Versions = []Version{
+	{{range .}}{Restd: "{{.}}", Schema: {{versionAlias .}}.Schema, Endpoints: {{versionAlias .}}.Endpoints, NewObject: {{versionAlias .}}.NewObject, LookupNode: {{versionAlias .}}_nodes.Lookup},
+	{{end}}
+}
+`
+
+var schemaTemplate = `package objects
+
+import "github.com/esurdam/go-sophos"
+
+// Schema is the metadata of the swagger definitions of Restd {{.Restd}} the package was generated from,
+// see sophos.Client.CheckCompatibility
+var Schema = &sophos.Schema{
+	Restd: "{{.Restd}}",
+	Objects: map[string]sophos.ObjectSchema{
+		{{range $typ, $o := .Objects}}"{{$typ}}": {
+			{{range $k, $a := $o}}"{{$k}}": {Type: "{{$a.Type}}"{{if $a.Enum}}, Enum: {{printf "%#v" $a.Enum}}{{end}}},
+			{{end}}
+		},
+		{{end}}
+	},
+}
+
+func init() { sophos.RegisterSchema(Schema) }
+`
+
+var endpointsTemplate = `package objects
+
+import "github.com/esurdam/go-sophos"
+
+// Endpoints returns all known sophos.Endpoint(s)
+func Endpoints() []sophos.Endpoint {
+	return []sophos.Endpoint{
+		{{range .Titles}}&{{.}}{},
+		{{end}}
+	}
+}
+
+// NewObject returns a new object of the type (e.g. network/host) or false if the type is unknown
+func NewObject(objType string) (sophos.RestGetter, bool) {
+	switch objType {
+	{{range $typ, $name := .Objects}}case "{{$typ}}":
+		return New{{$name}}(), true
+	{{end}}}
+	return nil, false
+}
+`
+
 type nftd struct {
 	Name, Val, Path string
 }
@@ -251,7 +556,7 @@ func TestUpdate{{.Name}}(t *testing.T) {
 `
 
 func executeTmpl(f io.Writer, v string, data interface{}) {
-	tmpl, err := template.New("").Parse(v)
+	tmpl, err := template.New("").Funcs(funcMap).Parse(v)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
@@ -267,22 +572,11 @@ import "github.com/esurdam/go-sophos"
 import "encoding/json"
 `
 
-func handleNodesNode() error {
-	var nodes map[string]interface{}
-	r, err := client.Get("/api/nodes")
-	if err != nil {
-		log.Fatal(err)
-	}
-	r.MarshalTo(&nodes)
-
-	keys := make([]string, 0, len(nodes))
-	for key := range nodes {
-		keys = append(keys, key)
-	}
-	sort.Strings(keys)
+func handleNodesNode(nodes *nodeSet) error {
+	keys := nodes.keys("")
 
 	subDir := rootDir + "/nodes"
-	err = os.MkdirAll(subDir, 0777)
+	err := os.MkdirAll(subDir, 0777)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -291,10 +585,6 @@ func handleNodesNode() error {
 	if err != nil {
 		log.Fatal(err)
 	}
-	f2.Write([]byte(`// Package nodes contains generated types and Get/Update functions for sophos.Node(s)
-//
-// This file was generated by bin/gen.go! DO NOT EDIT!
-` + nodesHeader))
 	//
 	// for _, key := range keys {
 	//	strKey := strings.Replace(key, ".", "_", -1)
@@ -302,15 +592,21 @@ func handleNodesNode() error {
 	//	f2.Write([]byte(fmt.Sprintf("type %s struct{ Path string, Value %s}", toCamelInitCase(strKey, true), key)))
 	// }
 
+	// the types are written after the header which imports objects only if a value uses its References
+	var types bytes.Buffer
+	var usesObjects bool
+	valueTypes := map[string]string{}
 	for _, key := range keys {
-		value := nodes[key]
-		valueType := typeForValue(value)
 		strKey := strings.Replace(key, ".", "_", -1)
+		var decls strings.Builder
+		valueType := nodes.valueType("objects.", key, &decls)
+		valueTypes[key] = valueType
+		usesObjects = usesObjects || strings.Contains(valueType+decls.String(), "objects.")
 		tmpl, err := template.New("").Funcs(funcMap).Parse(nodeTypeFuncsTemplate)
 		if err != nil {
 			log.Fatal(err.Error())
 		}
-		err = tmpl.Execute(f2, &nftd{
+		err = tmpl.Execute(&types, &nftd{
 			Name: toCamelInitCase(strKey, true),
 			Val:  valueType,
 			Path: key,
@@ -318,8 +614,18 @@ func handleNodesNode() error {
 		if err != nil {
 			log.Fatal(err.Error())
 		}
+		types.WriteString(decls.String())
 		// f.Write([]byte(fmt.Sprintf(" %sValue %s\n", toCamelInitCase(strKey, true), valueType)))
 	}
+	header := nodesHeader
+	if usesObjects {
+		header += fmt.Sprintf("import \"github.com/esurdam/go-sophos/%s/objects\"\n", rootDir)
+	}
+	f2.Write([]byte(`// Package nodes contains generated types and Get/Update functions for sophos.Node(s)
+//
+// This file was generated by bin/gen.go! DO NOT EDIT!
+` + header))
+	f2.Write(types.Bytes())
 	f2.Close()
 
 	f, err := os.Create(subDir + "/handlers.go")
@@ -332,7 +638,7 @@ func handleNodesNode() error {
 	// }
 	// ft.Write([]byte(nodesTestTemp))
 
-	f.Write([]byte(nodesHeader))
+	f.Write([]byte(header))
 	f.Write([]byte(`
 func get(c sophos.ClientInterface, path string, val interface{}, options ...sophos.Option) (err error) {
 	res, err := c.Get(path, options...)
@@ -351,8 +657,7 @@ func put(c sophos.ClientInterface, path string, val interface{}, options ...soph
 `))
 
 	for _, key := range keys {
-		value := nodes[key]
-		valueType := typeForValue(value)
+		valueType := valueTypes[key]
 		strKey := strings.Replace(key, ".", "_", -1)
 
 		executeTmpl(f, nodeFuncsTemplate, &nftd{
@@ -390,21 +695,257 @@ func put(c sophos.ClientInterface, path string, val interface{}, options ...soph
 	return nil
 }
 
-func (def *definition) process() error {
-	// get definition itself from UTM
-	resp, err := client.Get(def.Link)
-	if err != nil {
-		return err
+// nodeSet are the sampled values of the nodes and their schemas declared by the Nodes definition
+type nodeSet struct {
+	values  map[string]interface{}
+	schemas map[string]*schema
+}
+
+// loadNodes returns the sampled nodes and, if the definitions contain the Nodes definition, their schemas
+func loadNodes(dd []definition) (*nodeSet, error) {
+	nodes := &nodeSet{schemas: map[string]*schema{}}
+	if err := getJSON("/api/nodes", &nodes.values); err != nil {
+		return nil, err
 	}
+	for _, def := range dd {
+		if def.Name != "Nodes" {
+			continue
+		}
+		schemas, err := nodeSchemas(def.Link, nodes.values)
+		if err != nil {
+			return nil, err
+		}
+		nodes.schemas = schemas
+	}
+	return nodes, nil
+}
 
-	if err = resp.MarshalTo(&def.Swag); err != nil {
-		return err
+// keys returns the sorted nodes of the class (e.g. dhcp for dhcp.relay.status) or all nodes if class
+// is empty
+func (n *nodeSet) keys(class string) []string {
+	keys := make([]string, 0, len(n.values))
+	for key := range n.values {
+		if class == "" || strings.HasPrefix(key, class+".") {
+			keys = append(keys, key)
+		}
 	}
+	sort.Strings(keys)
+	return keys
+}
 
-	// format the path, see if its a endpoint endpoint
-	path := fmt.Sprintf("/api/%s", strings.ToLower(def.Name))
-	if def.Name != "Nodes" {
-		path = fmt.Sprintf("/api/nodes/%s", strings.ToLower(def.Name))
+// valueType returns the Go type of the value of a node. Nodes are typed by their schema, the sampled
+// value is only used for nodes without one. qual qualifies the types of the objects package, hashes
+// are written to decls.
+func (n *nodeSet) valueType(qual, key string, decls *strings.Builder) string {
+	name := toCamelInitCase(strings.Replace(key, ".", "_", -1), true) + "Value"
+	if typ, ok := nodeValueTypes[key]; ok {
+		return typ
+	}
+	if typ := nodeType(qual, name, n.schemas[key], decls); typ != "" {
+		return typ
+	}
+	return typeForValue(n.values[key])
+}
+
+// nodeValueTypes are the types of the nodes which can not be derived from their schema or sampled value
+var nodeValueTypes = map[string]string{
+	// the tokens are hashes of the token and its comment
+	"auth.api_tokens": "map[string]string",
+}
+
+// endpointStruct returns the struct of the nodes of the class of an endpoint, e.g. the Relay field of
+// Dhcp contains the dhcp.relay.* nodes, or an empty string if there are none. If class is empty the
+// struct contains a field for every node and the hashes of the node values.
+func (n *nodeSet) endpointStruct(title, class string) string {
+	keys := n.keys(class)
+	if len(keys) == 0 {
+		return ""
+	}
+	var b, decls strings.Builder
+	fmt.Fprintf(&b, "type %s struct {\n", title)
+	if class == "" {
+		for _, key := range keys {
+			fmt.Fprintf(&b, "%s %s `json:\"%s\"`\n", fieldName(title, key), n.valueType("", key, &decls), key)
+		}
+		b.WriteString("}\n")
+		b.WriteString(decls.String())
+		return b.String()
+	}
+	// the hashes are declared by the struct of all nodes
+	n.writeFields(&b, title, class, keys, &decls)
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// writeFields writes the fields of the nodes below the prefix, levels of the node paths are nested structs
+func (n *nodeSet) writeFields(b *strings.Builder, owner, prefix string, keys []string, decls *strings.Builder) {
+	for i := 0; i < len(keys); {
+		k := strings.SplitN(strings.TrimPrefix(keys[i], prefix+"."), ".", 2)[0]
+		j := i
+		for j < len(keys) && strings.HasPrefix(keys[j], prefix+"."+k+".") {
+			j++
+		}
+		if j == i {
+			fmt.Fprintf(b, "%s %s `json:\"%s\"`\n", fieldName(owner, k), n.valueType("", keys[i], decls), k)
+			i++
+			continue
+		}
+		field := fieldName(owner, k)
+		fmt.Fprintf(b, "%s struct {\n", field)
+		n.writeFields(b, owner+"."+field, prefix+"."+k, keys[i:j], decls)
+		fmt.Fprintf(b, "} `json:\"%s\"`\n", k)
+		i = j
+	}
+}
+
+// sampleStruct returns the struct of the sampled GET response of a path without definition, e.g.
+// /status/version
+func sampleStruct(name, path string) (string, error) {
+	var v map[string]interface{}
+	if err := getJSON("/api"+path, &v); err != nil {
+		return "", err
+	}
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	fmt.Fprintf(&b, "type %s struct {\n", name)
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%s %s `json:\"%s\"`\n", fieldName(name, k), typeForValue(v[k]), k)
+	}
+	b.WriteString("}\n")
+	return b.String(), nil
+}
+
+// schema is the swagger schema of a node value, hashes are described by their Properties
+type schema struct {
+	Type        string
+	Description string
+	Items       *schema
+	Properties  map[string]*schema
+}
+
+// nodeSchemas returns the schemas of the nodes declared by the Nodes definition, either as the body
+// parameter of the PUT path of a node or as a definition named after the node path. Nodes declared
+// by a path are added to the sampled nodes.
+func nodeSchemas(link string, nodes map[string]interface{}) (map[string]*schema, error) {
+	var swag struct {
+		Paths map[string]map[string]struct {
+			Parameters []struct {
+				In     string
+				Schema *schema
+			}
+		}
+		Definitions map[string]*schema
+	}
+	if err := getJSON(link, &swag); err != nil {
+		return nil, err
+	}
+	schemas := map[string]*schema{}
+	for path, methods := range swag.Paths {
+		node := strings.TrimPrefix(path, "/nodes/")
+		if node == path || strings.Contains(node, "{") {
+			continue
+		}
+		if _, ok := nodes[node]; !ok {
+			nodes[node] = nil
+		}
+		for _, p := range methods["put"].Parameters {
+			if p.In == "body" && p.Schema != nil {
+				schemas[node] = p.Schema
+			}
+		}
+	}
+	for node, s := range swag.Definitions {
+		if _, ok := nodes[node]; ok && schemas[node] == nil {
+			schemas[node] = s
+		}
+	}
+	return schemas, nil
+}
+
+// nodeType returns the Go type of a node schema or an empty string if it is unknown. Hashes with
+// properties are structs named name (e.g. SshValue) which are written to decls, References of a single
+// class are typed (e.g. objects.NetworkRefs with the qualifier objects.).
+func nodeType(qual, name string, s *schema, decls *strings.Builder) string {
+	if s == nil {
+		return ""
+	}
+	if strings.Contains(s.Description, "REF(") || (s.Items != nil && strings.Contains(s.Items.Description, "REF(")) {
+		typ, desc := "string", s.Description
+		if s.Type == "array" {
+			typ = "[]string"
+			if s.Items != nil && s.Items.Description != "" {
+				desc = s.Items.Description
+			}
+		}
+		if r := refType(property{Description: desc}, typ); r != "" && !strings.Contains(r, ".") {
+			return qual + r
+		} else if r != "" {
+			return r
+		}
+		return typ
+	}
+	switch s.Type {
+	case "boolean":
+		return "bool"
+	case "integer":
+		return "int64"
+	case "number":
+		return "float64"
+	case "string":
+		return "string"
+	case "array":
+		if elem := nodeType(qual, name+"Item", s.Items, decls); elem != "" {
+			return "[]" + elem
+		}
+		return "[]interface{}"
+	case "object":
+		if len(s.Properties) == 0 {
+			return "map[string]interface{}"
+		}
+		keys := make([]string, 0, len(s.Properties))
+		for k := range s.Properties {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var b strings.Builder
+		fmt.Fprintf(&b, "\n// %s is a generated hash of a node value\ntype %s struct {\n", name, name)
+		for _, k := range keys {
+			field := fieldName(name, k)
+			typ := nodeType(qual, name+field, s.Properties[k], decls)
+			if typ == "" {
+				typ = "interface{}"
+			}
+			if d := s.Properties[k].Description; d != "" {
+				fmt.Fprintf(&b, "// %s description: %s\n", field, d)
+			}
+			fmt.Fprintf(&b, "%s %s `json:\"%s\"`\n", field, typ, k)
+		}
+		b.WriteString("}\n")
+		decls.WriteString(b.String())
+		return name
+	}
+	if strings.Contains(s.Description, "(HASH)") {
+		return "map[string]interface{}"
+	}
+	return ""
+}
+
+// endpointPath returns the path of the endpoint of the definition, e.g. /api/nodes/network
+func (def *definition) endpointPath() string {
+	if def.Name == "Nodes" {
+		return "/api/nodes"
+	}
+	return fmt.Sprintf("/api/nodes/%s", strings.ToLower(def.Name))
+}
+
+func (def *definition) process(nodes *nodeSet) error {
+	// get definition itself from UTM
+	if err := getJSON(def.Link, &def.Swag); err != nil {
+		return err
 	}
 
 	// the endpoint will represent this definition
@@ -412,19 +953,21 @@ func (def *definition) process() error {
 		Definition: def,
 		Title:      toCamelInitCase(def.Name, true),
 		Name:       def.Name,
-		Path:       path,
+		Path:       def.endpointPath(),
 	}
 
 	ep := def.Endpoint
 
-	def.Endpoint.fetch()
 	ep.Paths = def.Swag.Paths
 	if def.Name == "Nodes" {
-		return handleNodesNode()
+		ep.Bytes = nodes.endpointStruct(ep.Title, "")
+		return handleNodesNode(nodes)
 	}
+	ep.Bytes = nodes.endpointStruct(ep.Title, strings.ToLower(def.Name))
 
 	// Swag.Paths contains a mapping of path -> map[method]methodDescription
-	for path, methodMap := range def.Swag.Paths {
+	for _, path := range sortedPaths(def.Swag.Paths) {
+		methodMap := def.Swag.Paths[path]
 		// add the path to the known Endpoint Paths
 		ep.Routes = append(ep.Routes, path)
 		// make a human readable name
@@ -437,7 +980,8 @@ func (def *definition) process() error {
 		}
 
 		// parse each method and generate subtypes
-		for method, d := range methodMap {
+		for _, method := range sortedMethods(methodMap) {
+			d := methodMap[method]
 			// add the method to the known Endpoint methods
 			ep.AddMethod(method)
 			s := subtype{
@@ -464,12 +1008,23 @@ func (def *definition) process() error {
 			if method == "get" {
 				s.Type = def.Swag.Definitions[strings.Replace(d.Tags[0], "/", ".", -1)]
 				s.GetPaths = []string{path}
-				if !s.HasRef && !usedby {
-					// s.GetPath = path
-					// // if the path does not have ref, then we can fetch it and make a struct for it
-					byt, err := makeStructBytes(&s, path, def.Name+"_"+name)
-					if err == nil {
-						s.Bytes = string(bytes.TrimLeft(byt.Bytes(), "\n"))
+				if objectType(path) != "" && !s.HasRef && !usedby {
+					// objects are built from their swagger definition, sampling them would lose the
+					// constructor, the validation and the typed References
+					t, ok := def.Swag.objectDefinition(path, d.Tags[0])
+					if !ok {
+						log.Fatalf("no swagger definition with properties for %s in %s", path, def.Link)
+					}
+					s.Type = t
+					s.IsPlural = true
+					s.IsType = true
+					s.Bytes = fmt.Sprintf("type %ss []%s\n\n", s.Name, s.Name) + structFromDefinition(s.Name, objectType(path), s.Type)
+				} else if hasSample(path) {
+					// if the path does not have ref, then we can fetch it and make a struct for it
+					if b, err := sampleStruct(s.Name, path); err != nil {
+						log.Printf("could not sample %s: %s\n", path, err.Error())
+					} else {
+						s.Bytes = b
 					}
 				}
 			}
@@ -486,6 +1041,9 @@ func (def *definition) process() error {
 			if method == "patch" {
 				s.PatchPath = path
 			}
+			if method == "get" && usedby {
+				s.UsedByPath = path
+			}
 
 			// Add the subtype to the endpoint
 			ep.AddSubType(s)
@@ -500,68 +1058,16 @@ func (def *definition) process() error {
 	return nil
 }
 
-// fetch fetches the endpoint itself
-func (n *endpoint) fetch() error {
-	// get the struct
-	r, err := client.Get(n.Path)
-	if err != nil {
-		// error here is okay since endpoint/endpoint is not a /endpoint/{{Path}} subtype
-		// objects wil be retrieved from endpoints
-		// log.Println("could not get path")
-		return err
-	}
-	// write the endpoint data
-	byt, err := gojson.Generate(r.Body, gojson.ParseJson, n.Title, "main", []string{"json"}, false, true)
-	if err != nil {
-		log.Printf("could not gojson response: %s, %s\n", n.Path, err.Error())
-		// error here means we will manually create a parent struct
-		return err
-	}
-
-	if n.Name == "Nodes" {
-		// read all the Object lines to remove apitoken
-		buf := bytes.NewBuffer(byt)
-		outBuf := bytes.NewBuffer([]byte{})
-		for {
-			line, err := buf.ReadString('\n')
-			if err != nil {
-				if err == io.EOF {
-					break
-				}
-
-				return fmt.Errorf("read file line error: %v", err)
-			}
-			_ = line
-
-			if strings.Contains(line, "Auth_apiTokens") {
-				outBuf.Write([]byte("AuthApiTokens map[string]string `json:\"auth.api_tokens\"`\n"))
-				buf.ReadString('\n')
-				buf.ReadString('\n')
-				continue
-			}
-
-			// fix the Nodes formatted Keys
-			parts := strings.Split(strings.TrimSpace(line), " ")
-			for _, p := range parts {
-				if p == "type" || p == "}" {
-					break
-				}
-				if p != "" {
-					line = strings.Replace(line, p, toCamelInitCase(p, true), -1)
-					break
-				}
-			}
-
-			outBuf.Write([]byte(line))
+// objectDefinition returns the swagger definition of the objects of the path. It is looked up by the
+// tag of the GET method and else by the object type of the path, definitions without properties are
+// not returned.
+func (s *swag) objectDefinition(path, tag string) (subTypeDef, bool) {
+	for _, name := range []string{tag, objectType(path)} {
+		if t := s.Definitions[strings.Replace(name, "/", ".", -1)]; len(t.Properties) > 0 {
+			return t, true
 		}
-		byt = outBuf.Bytes()
 	}
-	// write the generated struct to the file
-	buf := bytes.NewBuffer(byt)
-	_, _ = buf.ReadString('\n')
-	n.Bytes = string(bytes.TrimLeft(buf.Bytes(), "\n"))
-
-	return nil
+	return subTypeDef{}, false
 }
 
 func (n *endpoint) AddReference(ref string) {
@@ -613,6 +1119,10 @@ func (n *endpoint) AddSubType(s subtype) {
 				n.SubTypes[idx].PatchPath = s.PatchPath
 			}
 
+			if s.UsedByPath != "" {
+				n.SubTypes[idx].UsedByPath = s.UsedByPath
+			}
+
 			if s.Bytes != "" {
 				n.SubTypes[idx].Bytes = s.Bytes
 			}
@@ -621,10 +1131,6 @@ func (n *endpoint) AddSubType(s subtype) {
 				n.SubTypes[idx].IsPlural = s.IsPlural
 			}
 
-			if !se.IsPluralInterface && s.IsPluralInterface {
-				n.SubTypes[idx].IsPluralInterface = s.IsPluralInterface
-			}
-
 			if !se.IsType && s.IsType {
 				n.SubTypes[idx].IsType = s.IsType
 			}
@@ -641,111 +1147,543 @@ func addWordBoundariesToNumbers(s string) string {
 	return string(b)
 }
 
-func makeStructBytes(s *subtype, path, name string) (*bytes.Buffer, error) {
-	if !strings.HasPrefix(path, "/api") {
-		path = "/api" + path
+// structFromDefinition returns the struct of an Object from its swagger definition, its NewXxx
+// constructor and Validate methods, the fields are sorted by their JSON name
+func structFromDefinition(name, objType string, t subTypeDef) string {
+	var b strings.Builder
+	if t.Description != "" {
+		fmt.Fprintf(&b, "// %s represents a UTM %s\n", name, t.Description)
+	} else if objType != "" {
+		fmt.Fprintf(&b, "// %s represents a UTM %s object\n", name, objType)
+	} else {
+		fmt.Fprintf(&b, "// %s is a generated Sophos object\n", name)
+	}
+	fmt.Fprintf(&b, "type %s struct {\n", name)
+	b.WriteString("Locked string `json:\"_locked\"`\n")
+	b.WriteString("ObjectType string `json:\"_type\"`\n")
+	b.WriteString("Reference string `json:\"_ref\"`\n")
+	var enums []enum
+	var defaults, checks []string
+	attributes := sophos.ObjectSchema{}
+	for _, k := range sortedProperties(t) {
+		p := t.Properties[k]
+		attributes[k] = sophos.AttributeSchema{Type: p.Type, Enum: p.Enum}
+		field := fieldName(name, k)
+		if p.Description != "" {
+			fmt.Fprintf(&b, "// %s description: %s\n", field, p.Description)
+		}
+		if len(p.Enum) > 0 {
+			fmt.Fprintf(&b, "// %s can be one of: %#v\n", field, p.Enum)
+		}
+		switch v := p.Default.(type) {
+		case string, bool:
+			fmt.Fprintf(&b, "// %s default value is %#v\n", field, v)
+		case float64:
+			// JSON numbers are decoded as float64
+			if p.Type == "integer" {
+				fmt.Fprintf(&b, "// %s default value is %d\n", field, int64(v))
+			}
+		}
+		typ := goType(p)
+		if typ == "interface{}" {
+			fmt.Printf("Do not know type \"%s\" for %s: %s\n", p.Type, k, p.Description)
+		}
+		var e *enum
+		if typ == "string" && len(p.Enum) > 0 {
+			en := newEnum(name, field, p.Enum)
+			enums = append(enums, en)
+			typ, e = en.Name, &en
+		}
+		// References not declared by the definition are typed too, e.g. the interface of network/host
+		if r := refType(property{Description: attributeDescription(objType, k, p)}, typ); r != "" {
+			typ = r
+		}
+		if v := defaultValue(p, e); v != "" {
+			defaults = append(defaults, fmt.Sprintf("%s: %s,\n", field, v))
+		}
+		checks = append(checks, propertyChecks(name, t, objType, strings.ToLower(name[:1])+"."+field, k, typ, p, e)...)
+		fmt.Fprintf(&b, "%s %s `json:\"%s\"`\n", field, typ, k)
+	}
+	b.WriteString("}\n")
+	for _, e := range enums {
+		executeTmpl(&b, enumTemplate, e)
+	}
+	if class := strings.Split(objType, "/")[0]; class != "" {
+		classObjects[class] = append(classObjects[class], name)
+		objectSchemas[objType] = attributes
+		objectNames[objType] = name
+	}
+	fmt.Fprintf(&b, "\n// New%s returns a %s with the default values of its swagger definition\n", name, name)
+	fmt.Fprintf(&b, "func New%s() *%s {\nreturn &%s{\n", name, name, name)
+	if objType != "" {
+		fmt.Fprintf(&b, "ObjectType: %q,\n", objType)
+	}
+	b.WriteString(strings.Join(defaults, ""))
+	b.WriteString("}\n}\n")
+	executeTmpl(&b, validateTemplate, struct {
+		Name, Receiver string
+		Checks         []string
+	}{name, strings.ToLower(name[:1]), checks})
+	return b.String()
+}
+
+var validateTemplate = `
+// Validate checks the {{.Name}} before it is sent, see ValidateWith
+func ({{.Receiver}} *{{.Name}}) Validate() error { return {{.Receiver}}.ValidateWith(nil) }
+
+// ValidateWith returns a *sophos.ValidationError with the invalid attributes of the {{.Name}}.
+// If typeOf is not nil (e.g. Snapshot.Type) References are checked against their REF(...) constraints.
+func ({{.Receiver}} *{{.Name}}) ValidateWith(typeOf func(ref string) string) error {
+	check := sophos.NewValidation("{{.Name}}", typeOf)
+	{{range .Checks}}{{.}}
+	{{end}}return check.Err()
+}
+`
+
+// propertyChecks returns the sophos.Validation calls of the property k of the struct name with the
+// Go value: the required attributes of the definition, enums, integer bounds, ports, netmasks, low
+// and high values of ranges, address formats and References
+func propertyChecks(name string, t subTypeDef, objType, value, k, typ string, p property, e *enum) []string {
+	var checks []string
+	// typed References are checked as strings
+	str := value
+	if base := goType(p); base != typ && base == "string" && e == nil {
+		typ, str = base, "string("+value+")"
+	} else if base == "[]string" {
+		typ = base
+	}
+	for _, r := range t.Required {
+		if r != k {
+			continue
+		}
+		// the zero values of integers and booleans are valid values, Enum refuses empty enums
+		switch {
+		case e != nil:
+		case typ == "string":
+			checks = append(checks, fmt.Sprintf("check.Required(%q, %s)", k, str))
+		case strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "map["):
+			checks = append(checks, fmt.Sprintf("check.NotEmpty(%q, len(%s))", k, value))
+		}
 	}
-	resp, err := client.Get(path)
-	if err != nil {
-		log.Printf("could not get path: %s\n", path)
-		return nil, err
+	if e != nil {
+		checks = append(checks, fmt.Sprintf("check.Enum(%q, string(%s), %s.Valid())", k, value, value))
+	}
+	if typ == "int64" {
+		if c := strings.TrimPrefix(p.Description, "Constraints: "); c != p.Description {
+			checks = append(checks, fmt.Sprintf("check.Range(%q, %s, %q)", k, value, c))
+		} else if p.Minimum != nil || p.Maximum != nil {
+			min, max := int64(math.MinInt64), int64(math.MaxInt64)
+			if p.Minimum != nil {
+				min = int64(*p.Minimum)
+			}
+			if p.Maximum != nil {
+				max = int64(*p.Maximum)
+			}
+			checks = append(checks, fmt.Sprintf("check.Between(%q, %s, %d, %d)", k, value, min, max))
+		} else if isPort(k) {
+			checks = append(checks, fmt.Sprintf("check.Range(%q, %s, %q)", k, value, "0-65535"))
+		} else if bits := netmaskBits(k); bits != 0 {
+			checks = append(checks, fmt.Sprintf("check.Between(%q, %s, 0, %d)", k, value, bits))
+		}
+		if low := strings.TrimSuffix(k, "_low"); low != k {
+			if h, ok := t.Properties[low+"_high"]; ok && h.Type == "integer" {
+				high := value[:strings.Index(value, ".")+1] + fieldName(name, low+"_high")
+				checks = append(checks, fmt.Sprintf("check.Order(%q, %s, %q, %s)", k, value, low+"_high", high))
+			}
+		}
+	}
+	desc := attributeDescription(objType, k, p)
+	if f, ok := sophos.ParseFormat(desc); ok {
+		switch typ {
+		case "string":
+			checks = append(checks, fmt.Sprintf("check.Format(%q, sophos.%s, %s)", k, formatConstant[f], str))
+		case "[]string":
+			checks = append(checks, fmt.Sprintf("check.Formats(%q, sophos.%s, %s)", k, formatConstant[f], value))
+		}
 	}
+	if strings.Contains(desc, "REF(") {
+		switch typ {
+		case "string":
+			checks = append(checks, fmt.Sprintf("check.Ref(%q, %s, %q)", k, str, desc))
+		case "[]string":
+			checks = append(checks, fmt.Sprintf("check.Refs(%q, %s, %q)", k, value, desc))
+		}
+	}
+	return checks
+}
 
-	name = toCamelInitCase(name, true)
-	if name == "StatusStatus" {
-		name = "StatusVersion"
+// isPort returns true if the integer attribute is a TCP or UDP port, e.g. dst_low of service/tcp
+func isPort(k string) bool {
+	switch k {
+	case "port", "dst_low", "dst_high", "src_low", "src_high":
+		return true
 	}
-	byt, err := gojson.Generate(resp.Body, gojson.ParseJson, name, "main", []string{"json"}, false, true)
-	if err != nil {
-		log.Printf("could not gojson response: %s\n", path)
-		return nil, err
+	return strings.HasSuffix(k, "_port")
+}
+
+// netmaskBits returns the length of the addresses of an integer netmask attribute (e.g. 128 for
+// netmask6 of network/network) or 0
+func netmaskBits(k string) int {
+	switch {
+	case strings.HasSuffix(k, "netmask6"):
+		return 128
+	case strings.HasSuffix(k, "netmask"):
+		return 32
 	}
-	// read all the Object lines to remove duplicate Type declarations
-	buf := bytes.NewBuffer(byt)
-	outBuf := bytes.NewBuffer([]byte{})
-	for {
-		line, err := buf.ReadString('\n')
-		if err != nil {
-			if err == io.EOF {
-				break
-			}
+	return 0
+}
 
-			return nil, fmt.Errorf("read file line error: %v", err)
-		}
-		_ = line
-		if strings.HasPrefix(line, "type") {
-			if strings.HasSuffix(line, " []struct {\n") {
-				s.IsPlural = true
-				// make pluralized
-				newLine := strings.Replace(line, " []struct {", "s []"+name, 1)
-				outBuf.Write([]byte(newLine))
-				outBuf.Write([]byte("// " + name + " is a generated Sophos object\n"))
-				newType := strings.Replace(line, " []struct {", " struct {", 1)
-				outBuf.Write([]byte(newType))
-				continue
-			}
-			if strings.HasSuffix(line, " []interface{}\n") {
-				//	// make pluralized
-				s.IsPlural = true
-				s.IsPluralInterface = true
-				newLine := strings.Replace(line, " []interface{}", "s []"+name, 1)
-				outBuf.Write([]byte(newLine))
-				outBuf.Write([]byte("// " + name + " represents a UTM " + s.Type.Description + "\n"))
-				newType := strings.Replace(line, " []interface{}", " struct {", 1)
-
-				// Write the struct from the subtType
-				outBuf.Write([]byte(newType))
-				outBuf.Write([]byte("Locked string `json:\"_locked\"`\n"))
-				outBuf.Write([]byte("ObjectType string `json:\"_type\"`\n"))
-				outBuf.Write([]byte("Reference string `json:\"_ref\"`\n"))
-				for k, p := range s.Type.Properties {
-					if p.Description != "" {
-						outBuf.Write([]byte(fmt.Sprintf("// %s description: %s\n", toCamelInitCase(k, true), p.Description)))
-					}
-					if len(p.Enum) > 0 {
-						outBuf.Write([]byte(fmt.Sprintf("// %s can be one of: %#v\n", toCamelInitCase(k, true), p.Enum)))
-					}
-					switch p.Type {
-					case "string":
-						if v, ok := p.Default.(string); ok {
-							outBuf.Write([]byte(fmt.Sprintf("// %s default value is %#v\n", toCamelInitCase(k, true), v)))
-						}
-						outBuf.Write([]byte(fmt.Sprintf("%s string `json:\"%s\"`\n", toCamelInitCase(k, true), k)))
-					case "integer":
-						if v, ok := p.Default.(int); ok {
-							outBuf.Write([]byte(fmt.Sprintf("// %s default value is %d\n", toCamelInitCase(k, true), v)))
-						}
-						outBuf.Write([]byte(fmt.Sprintf("%s int `json:\"%s\"`\n", toCamelInitCase(k, true), k)))
-					case "boolean":
-						if v, ok := p.Default.(bool); ok {
-							outBuf.Write([]byte(fmt.Sprintf("// %s default value is %#v\n", toCamelInitCase(k, true), v)))
-						}
-						outBuf.Write([]byte(fmt.Sprintf("%s bool `json:\"%s\"`\n", toCamelInitCase(k, true), k)))
-					case "array":
-						outBuf.Write([]byte(fmt.Sprintf("%s []interface{} `json:\"%s\"`\n", toCamelInitCase(k, true), k)))
-					default:
-						fmt.Printf("Do not know type \"%s\" for %s: %s\n", p.Type, k, p.Description)
-						outBuf.Write([]byte(fmt.Sprintf("%s interface{} `json:\"%s\"`\n", toCamelInitCase(k, true), k)))
-					}
+// attributeDescriptions are the formats and References of the attributes of an object type or of
+// a class which are not declared by their definitions, e.g. the address of network/host
+var attributeDescriptions = map[string]map[string]string{
+	"network": {
+		"address":   "(IPADDR)",
+		"address6":  "(IP6ADDR)",
+		"hostname":  "(HOSTNAME)",
+		"hostnames": "(HOSTNAME)",
+		"interface": "REF(interface/*)",
+		"macs":      "(MACADDR)",
+	},
+	"itfparams": {
+		"address":  "(IPADDR)",
+		"address6": "(IP6ADDR)",
+	},
+	"packetfilter/nat": {
+		"auto_pf_in":              "REF(packetfilter/packetfilter)",
+		"destination":             "REF(network/*)",
+		"destination_nat_address": "REF(network/*)",
+		"destination_nat_service": "REF(service/*)",
+		"service":                 "REF(service/*)",
+		"source":                  "REF(network/*)",
+		"source_nat_address":      "REF(network/*)",
+		"source_nat_service":      "REF(service/*)",
+	},
+	"packetfilter/packetfilter": {
+		"interface": "REF(interface/*)",
+		"time":      "REF(time/*)",
+	},
+}
+
+// attributeDescription returns the description of the property k of the object type, or the one of
+// attributeDescriptions if the definition declares neither its format nor its References
+func attributeDescription(objType, k string, p property) string {
+	if _, ok := sophos.ParseFormat(p.Description); ok || strings.Contains(p.Description, "REF(") {
+		return p.Description
+	}
+	if d, ok := attributeDescriptions[objType][k]; ok {
+		return d
+	}
+	if d, ok := attributeDescriptions[strings.Split(objType, "/")[0]][k]; ok {
+		return d
+	}
+	return p.Description
+}
+
+// refType returns the typed Reference of a property whose description only allows objects of a single
+// class, e.g. NetworkRefs for an array of REF(network/host), REF(network/dns_host). References to any
+// class (REF(/*)) are sophos.References.
+func refType(p property, typ string) string {
+	if !strings.Contains(p.Description, "REF(") || (typ != "string" && typ != "[]string") {
+		return ""
+	}
+	classes := map[string]bool{}
+	for _, r := range sophos.ParseRefConstraints(p.Description) {
+		classes[r.Class] = true
+	}
+	if len(classes) == 0 && strings.Contains(p.Description, "REF(/*)") {
+		if typ == "string" {
+			return "sophos.Reference"
+		}
+		return ""
+	}
+	if len(classes) != 1 || classes["*"] {
+		return ""
+	}
+	var class string
+	for c := range classes {
+		class = c
+	}
+	refClasses[class] = true
+	if typ == "string" {
+		return toCamelInitCase(class, true) + "Ref"
+	}
+	return toCamelInitCase(class, true) + "Refs"
+}
+
+// refsData is the typed Reference of a class, Objects are the objects of the class
+type refsData struct {
+	Name, Class string
+	Objects     []string
+}
+
+var refsTemplate = `package objects
+
+{{range $r := .}}
+// {{.Name}}Ref is a Reference to an object of the {{.Class}} class
+type {{.Name}}Ref string
+
+// {{.Name}}Refs are References to objects of the {{.Class}} class
+type {{.Name}}Refs []string
+
+// {{.Name}}Object is implemented by the objects of the {{.Class}} class, see {{.Name}}Ref
+type {{.Name}}Object interface {
+	{{.Name}}Ref() {{.Name}}Ref
+}
+
+// Set sets the Reference to the object
+func (r *{{.Name}}Ref) Set(o {{.Name}}Object) { *r = o.{{.Name}}Ref() }
+
+// Class implements sophos.ClassRef
+func ({{.Name}}Ref) Class() string { return "{{.Class}}" }
+
+// Add appends the References of the objects
+func (r *{{.Name}}Refs) Add(oo ...{{.Name}}Object) {
+	for _, o := range oo {
+		*r = append(*r, string(o.{{.Name}}Ref()))
+	}
+}
+
+// Class implements sophos.ClassRef
+func ({{.Name}}Refs) Class() string { return "{{.Class}}" }
+{{range .Objects}}
+// {{$r.Name}}Ref implements {{$r.Name}}Object
+func ({{firstLetter .}} *{{.}}) {{$r.Name}}Ref() {{$r.Name}}Ref { return {{$r.Name}}Ref({{firstLetter .}}.Reference) }
+{{end}}
+{{end}}
+`
+
+// formatConstant is the name of the sophos.Format constants
+var formatConstant = map[sophos.Format]string{
+	sophos.FormatIPv4:     "FormatIPv4",
+	sophos.FormatIPv6:     "FormatIPv6",
+	sophos.FormatMAC:      "FormatMAC",
+	sophos.FormatHostname: "FormatHostname",
+}
+
+// defaultValue returns the Go literal of the non-zero default value of a property or an empty string.
+// Defaults of enums are their constants.
+func defaultValue(p property, e *enum) string {
+	switch v := p.Default.(type) {
+	case string:
+		if v == "" {
+			return ""
+		}
+		if e != nil {
+			for _, c := range e.Constants {
+				if c.Value == v {
+					return c.Name
 				}
-				outBuf.Write([]byte("}\n"))
-				continue
 			}
+			return fmt.Sprintf("%s(%q)", e.Name, v)
+		}
+		if goType(p) == "string" {
+			return fmt.Sprintf("%q", v)
+		}
+	case bool:
+		if v && p.Type == "boolean" {
+			return "true"
 		}
+	case float64:
+		// JSON numbers are decoded as float64
+		if v != 0 && p.Type == "integer" {
+			return fmt.Sprintf("%d", int64(v))
+		}
+	}
+	return ""
+}
 
-		if strings.Contains(line, "`json:\"_type\"`") {
-			s.IsType = true
-			line = strings.Replace(line, "Type", "ObjectType", -1)
+// objectType returns the _type of the objects of a path, e.g. packetfilter/packetfilter for
+// /objects/packetfilter/packetfilter/
+func objectType(path string) string {
+	if !strings.HasPrefix(path, "/objects/") {
+		return ""
+	}
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(path, "/objects/"), "/"), "/")
+	if len(parts) > 2 {
+		parts = parts[:2]
+	}
+	return strings.Join(parts, "/")
+}
+
+// enum is a named string type of a property with an Enum list
+type enum struct {
+	Name, Owner, Field string
+	Constants          []enumConstant
+}
+
+type enumConstant struct{ Name, Value string }
+
+// newEnum returns the enum type of the field, e.g. Packetfilter1to1NatMode with the constant
+// Packetfilter1to1NatModeMapsrc for the value mapsrc
+func newEnum(owner, field string, values []string) enum {
+	e := enum{Name: owner + field, Owner: owner, Field: field}
+	seen := map[string]bool{}
+	for i, v := range values {
+		suffix := toCamelInitCase(v, true)
+		if v == "" {
+			suffix = "Empty"
 		}
-		if strings.Contains(line, "`json:\"_ref\"`") {
-			line = strings.Replace(line, "Ref", "Reference", -1)
+		if suffix == "" || seen[suffix] {
+			suffix = fmt.Sprintf("Value%d", i)
 		}
-		outBuf.Write([]byte(line))
+		seen[suffix] = true
+		e.Constants = append(e.Constants, enumConstant{Name: e.Name + suffix, Value: v})
 	}
+	return e
+}
+
+var enumTemplate = `
+// {{.Name}} is the {{.Field}} of a {{.Owner}}
+type {{.Name}} string
+
+// Known values of {{.Name}}
+const (
+	{{range .Constants}}{{.Name}} {{$.Name}} = {{printf "%q" .Value}}
+	{{end}}
+)
 
-	// write the generated Object struct to the file, removing package name
-	_, _ = outBuf.ReadString('\n')
-	return outBuf, nil
+// Valid returns true if the value is a known {{.Name}}
+func (v {{.Name}}) Valid() bool {
+	switch v {
+	case {{range $i, $c := .Constants}}{{if $i}}, {{end}}{{$c.Name}}{{end}}:
+		return true
+	}
+	return false
+}
+`
+
+// goType returns the Go type of a swagger property. Integers are int64, hashes (objects) are maps
+// and arrays are typed by their items or, for References, as []string.
+func goType(p property) string {
+	switch p.Type {
+	case "string":
+		return "string"
+	case "integer":
+		return "int64"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "object":
+		return "map[string]interface{}"
+	case "array":
+		t, _ := p.Items["type"].(string)
+		switch {
+		case t != "" && t != "array" && t != "object":
+			return "[]" + goType(property{Type: t})
+		case strings.HasPrefix(p.Description, "REF("):
+			return "[]string"
+		}
+		return "[]interface{}"
+	}
+	if strings.Contains(p.Description, "(HASH)") {
+		return "map[string]interface{}"
+	}
+	return "interface{}"
+}
+
+// commonInitialisms are the words of field names in upper case, e.g. ReverseDNS for reverse_dns
+var commonInitialisms = map[string]bool{
+	"API": true, "ASCII": true, "CPU": true, "CSS": true, "DB": true, "DNS": true, "EOF": true,
+	"GUID": true, "HTML": true, "HTTP": true, "HTTPS": true, "ID": true, "IP": true, "JSON": true,
+	"LHS": true, "NTP": true, "QPS": true, "RAM": true, "RHS": true, "RPC": true, "SLA": true,
+	"SMTP": true, "SSH": true, "TLS": true, "TTL": true, "UI": true, "UID": true, "UUID": true,
+	"URI": true, "URL": true, "UTF8": true, "VM": true, "XML": true,
+}
+
+// fieldNames are the names of the fields (keyed by struct and JSON name) of earlier versions of the
+// packages which do not follow fieldName, they are kept to not break their users
+var fieldNames = map[string]string{
+	"AaaUser.md4hash": "Md4hash",
+	"AuthenticationOtpToken.for_ssh": "ForSsh",
+	"AweDevice.id": "Id",
+	"AweDevice.last_ip": "LastIp",
+	"AweDevice.tunnel_id": "TunnelId",
+	"AweLocal.id": "Id",
+	"AweRed.id": "Id",
+	"AweRed.last_ip": "LastIp",
+	"AweRed.tunnel_id": "TunnelId",
+	"AwscliProfile.aws_access_key_id": "AwsAccessKeyId",
+	"BgpSystem.id": "Id",
+	"DhcpServer6.dns1": "Dns1",
+	"DhcpServer6.dns2": "Dns2",
+	"DhcpStateless.dns1": "Dns1",
+	"DhcpStateless.dns2": "Dns2",
+	"EppAvException.ip_address": "IpAddress",
+	"EppAvException.ip_address_mask": "IpAddressMask",
+	"EppDcException.device_id": "DeviceId",
+	"EppDevice.device_id": "DeviceId",
+	"EppDevice.instance_id": "InstanceId",
+	"EppEndpoint.mcs_id": "McsId",
+	"HotspotPortal.redirect_url": "RedirectUrl",
+	"Http.allow_tls_1_2": "AllowTLS1_2",
+	"IpsecConnectionRoadwarriorCa.ip_pool": "IpPool",
+	"IpsecConnectionRoadwarriorCa.use_ip_pool": "UseIpPool",
+	"IpsecConnectionRoadwarriorCisco.ip_assignment_pool": "IpAssignmentPool",
+	"IpsecConnectionRoadwarriorPsk.ip_pool": "IpPool",
+	"IpsecConnectionRoadwarriorPsk.use_ip_pool": "UseIpPool",
+	"IpsecConnectionRoadwarriorX509.ip_pool": "IpPool",
+	"IpsecConnectionRoadwarriorX509.use_ip_pool": "UseIpPool",
+	"IpsecRemoteAuthCa.vpn_id": "VpnId",
+	"IpsecRemoteAuthRsa.vpn_id": "VpnId",
+	"IpsecRemoteAuthRsa.vpn_id_type": "VpnIdType",
+	"ItfhwAweNetwork.dot11r": "Dot11r",
+	"ItfhwAweNetwork.r0kh_secret": "R0khSecret",
+	"ItfhwRedClient.tunnel_id": "TunnelId",
+	"ItfhwRedServer.fullbr_dns": "FullbrDns",
+	"ItfhwRedServer.manual2_dns": "Manual2Dns",
+	"ItfhwRedServer.manual_dns": "ManualDns",
+	"ItfhwRedServer.red_id": "RedId",
+	"ItfhwRedServer.tunnel_id": "TunnelId",
+	"ItfparamsLinkAggregationGroup.arp_ip_target": "ArpIpTarget",
+	"ItfparamsLinkAggregationGroup.id": "Id",
+	"ItfparamsPrimary.six2four": "Six2four",
+	"ItfparamsSecondary.id": "Id",
+	"NotificationNotification.id": "Id",
+	"OspfArea.id": "Id",
+	"OspfMessageDigestKey.message_digest_key_id": "MessageDigestKeyId",
+	"Pop3Server.tls_cert": "TlsCert",
+	"SslVpnServerConnection.static_ip": "StaticIp",
+	"SslVpnServerConnection.static_ip6": "StaticIp6",
+	"SslVpnServerConnection.static_ip_status": "StaticIpStatus",
+}
+
+// fieldName returns the name of the field of the JSON name k of the struct owner (e.g. Dhcp.Relay for
+// nested structs). Words are separated by underscores and follow lower case letters, initialisms are
+// upper case except after a dot, e.g. AfcHttpRedirectURL for afc.http_redirect_url.
+func fieldName(owner, k string) string {
+	if name, ok := fieldNames[owner+"."+k]; ok {
+		return name
+	}
+	runes := []rune(k)
+	if len(runes) == 0 {
+		return ""
+	}
+	runes[0] = unicode.ToUpper(runes[0])
+	var b strings.Builder
+	w := 0
+	for i := range runes {
+		if runes[i] == '_' {
+			// keep the separator of numbers, e.g. TLS1_2
+			if i > w && i+1 < len(runes) && unicode.IsDigit(runes[i-1]) && unicode.IsDigit(runes[i+1]) {
+				b.WriteByte('_')
+			}
+			w = i + 1
+			continue
+		}
+		if i+1 < len(runes) && runes[i+1] != '_' && !(unicode.IsLower(runes[i]) && !unicode.IsLower(runes[i+1])) {
+			continue
+		}
+		word := string(runes[w : i+1])
+		if u := strings.ToUpper(word); commonInitialisms[u] {
+			word = u
+		} else if w > 0 {
+			word = strings.ToUpper(word[:1]) + word[1:]
+		}
+		b.WriteString(word)
+		w = i + 1
+	}
+	return toCamelInitCase(strings.Replace(b.String(), ".", "_", -1), true)
 }
 
 // Converts a string to CamelCase
@@ -795,13 +1733,13 @@ var endpointTemplate = `
 
 var _ sophos.Endpoint = &{{.Title}}{}
 
-var defs{{.Title}} =  map[string]sophos.RestObject{
+var defs{{.Title}} =  map[string]sophos.RestGetter{
 		{{range .SubTypes}}"{{.Name}}": &{{.Name}}{},
 		{{end}}
 	}
 
 // RestObjects implements the sophos.Node interface and returns a map of {{.Title}}'s Objects
-func({{.Title}}) RestObjects() map[string]sophos.RestObject { return defs{{.Title}} }
+func({{.Title}}) RestObjects() map[string]sophos.RestGetter { return defs{{.Title}} }
 
 // GetPath implements sophos.RestGetter
 func(*{{.Title}}) GetPath() string { return "{{.Path}}" }
@@ -831,56 +1769,62 @@ func({{.Title}}) References() []string {
 }
 
 {{range .SubTypes}}
-// {{.Name}}{{if .IsPlural}}s{{end}} is an Sophos Endpoint subType and implements sophos.RestObject
+// {{.Name}}{{if .IsPlural}}s{{end}} is an Sophos Endpoint subType and implements sophos.RestGetter
 {{.Bytes}}
 
 {{if .IsPlural}}
 
 var _ sophos.RestGetter = &{{.Name}}{}
-// GetPath implements sophos.RestObject and returns the {{.Name}}s GET path{{getDesc . .GetPath "get"}}
+// GetPath implements sophos.RestGetter and returns the {{.Name}}s GET path{{getDesc . .GetPath "get"}}
 func(*{{.Name}}s) GetPath() string { return "/api{{.GetPath}}" }
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func(*{{.Name}}s) RefRequired() (string, bool) { return "", false }
 
-// GetPath implements sophos.RestObject and returns the {{.Name}}s GET path{{getDesc . .PatchPath "get"}}
+// GetPath implements sophos.RestGetter and returns the {{.Name}}s GET path{{getDesc . .PatchPath "get"}}
 func({{firstLetter .Name}} *{{.Name}}) GetPath() string { return fmt.Sprintf("/api{{asRefUrl .PatchPath}}", {{firstLetter .Name}}.Reference) }
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func({{firstLetter .Name}} *{{.Name}}) RefRequired() (string, bool) { return {{firstLetter .Name}}.Reference, true }
 
 {{else}}
 
-var _ sophos.RestObject = &{{.Name}}{}
-// GetPath implements sophos.RestObject and returns the {{.Name}} GET path{{getDesc . .GetPath "get"}}
+var _ sophos.RestGetter = &{{.Name}}{}
+// GetPath implements sophos.RestGetter and returns the {{.Name}} GET path{{getDesc . .GetPath "get"}}
 func(*{{.Name}}) GetPath() string {	return "/api{{.GetPath}}" }
-// RefRequired implements sophos.RestObject
+// RefRequired implements sophos.RestGetter
 func(*{{.Name}}) RefRequired() (string, bool) { return "", false }
 
 {{end}}
 
-// DeletePath implements sophos.RestObject and returns the {{.Name}} DELETE path{{getDesc . .DeletePath "delete"}}
+{{if .DeletePath}}
+// DeletePath implements sophos.Deletable and returns the {{.Name}} DELETE path{{getDesc . .DeletePath "delete"}}
 func(*{{.Name}}) DeletePath(ref string) string {
 	return fmt.Sprintf("/api{{asRefUrl .DeletePath}}", ref)
 }
-
-// PatchPath implements sophos.RestObject and returns the {{.Name}} PATCH path{{getDesc . .PatchPath "patch"}}
+{{end}}
+{{if .PatchPath}}
+// PatchPath implements sophos.Patchable and returns the {{.Name}} PATCH path{{getDesc . .PatchPath "patch"}}
 func(*{{.Name}}) PatchPath(ref string) string {
 	return fmt.Sprintf("/api{{asRefUrl .PatchPath}}", ref)
 }
-
-// PostPath implements sophos.RestObject and returns the {{.Name}} POST path{{getDesc . .PostPath "post"}}
+{{end}}
+{{if .PostPath}}
+// PostPath implements sophos.Creatable and returns the {{.Name}} POST path{{getDesc . .PostPath "post"}}
 func(*{{.Name}}) PostPath() string {
 	return "/api{{.PostPath}}"
 }
-
-// PutPath implements sophos.RestObject and returns the {{.Name}} PUT path{{getDesc . .PutPath "put"}}
+{{end}}
+{{if .PutPath}}
+// PutPath implements sophos.Updatable and returns the {{.Name}} PUT path{{getDesc . .PutPath "put"}}
 func(*{{.Name}}) PutPath(ref string) string {
 	return fmt.Sprintf("/api{{asRefUrl .PutPath}}", ref)
 }
-
-// UsedByPath implements sophos.RestObject{{getUsedBy .}}
+{{end}}
+{{if .UsedByPath}}
+// UsedByPath implements sophos.UsedByer{{getUsedBy .}}
 func(*{{.Name}}) UsedByPath(ref string) string {
-	return fmt.Sprintf("/api{{asRefUrl .PutPath}}/usedby", ref)
+	return fmt.Sprintf("/api{{asRefUrl .UsedByPath}}", ref)
 }
+{{end}}
 
 {{if .IsType}}
 // GetType implements sophos.Object
@@ -900,13 +1844,15 @@ var funcMap = template.FuncMap{
 		return fmt.Sprintf("\n// %s", v)
 	},
 	"getUsedBy": func(s *subtype) string {
-		v := s.Node.Paths[s.PatchPath+"/usedby"]["get"].Description
+		v := s.Node.Paths[s.UsedByPath]["get"].Description
 		if strings.TrimSpace(v) == "" {
 			return ""
 		}
 		return fmt.Sprintf("\n// %s", v)
 	},
 	"firstLetter": func(name string) string { return strings.ToLower(name)[0:1] },
+	// versionAlias is the import name of the objects package of a version, e.g. v1_3_0
+	"versionAlias": func(restd string) string { return "v" + strings.Replace(restd, ".", "_", -1) },
 	"asSwag": func(swag *swag) string {
 		a := strings.Replace(fmt.Sprintf("%#v", swag.Paths), "main.methodMap", "sophos.MethodMap", -1)
 		a = strings.Replace(a, "main.methodDescriptions", "sophos.MethodDescriptions", -1)
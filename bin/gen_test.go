@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/format"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files of TestGenerate")
+
+// readTree returns the files of the directory keyed by their slash separated path
+func readTree(t *testing.T, dir string) map[string][]byte {
+	files := map[string][]byte{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		byt, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, path)
+		files[filepath.ToSlash(rel)] = byt
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func TestFetch(t *testing.T) {
+	var requested []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		byt, err := ioutil.ReadFile(fixturePath("testdata/fixtures", r.URL.Path))
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(byt)
+	}))
+	defer ts.Close()
+	sophos.DefaultHTTPClient = ts.Client()
+	client, _ := sophos.New(ts.URL)
+
+	dir, err := ioutil.TempDir("", "fixtures")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	src = recordingSource{source: liveSource{client: client}, dir: dir}
+	if err := fetch(); err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(requested)
+	want := []string{
+		"/api/definitions",
+		"/api/definitions/network",
+		"/api/definitions/nodes",
+		"/api/definitions/service",
+		"/api/nodes",
+		"/api/status/version",
+	}
+	if !reflect.DeepEqual(requested, want) {
+		t.Errorf("unexpected requests %v", requested)
+	}
+	if got, want := readTree(t, dir), readTree(t, "testdata/fixtures"); !reflect.DeepEqual(got, want) {
+		t.Errorf("the recorded documents differ from testdata/fixtures")
+	}
+}
+
+// TestGenerate generates the packages from testdata/fixtures and compares them with testdata/golden,
+// run go test -update to rewrite the golden files after changing the generator
+func TestGenerate(t *testing.T) {
+	fixtures, err := filepath.Abs("testdata/fixtures")
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden, err := filepath.Abs("testdata/golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "gen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	// reset the types collected by a previous run
+	refClasses, classObjects = map[string]bool{}, map[string][]string{}
+	objectSchemas, objectNames = map[string]sophos.ObjectSchema{}, map[string]string{}
+	src = fixtureSource{dir: fixtures}
+	generate()
+
+	got := map[string][]byte{}
+	for name, byt := range readTree(t, dir) {
+		// the Makefile formats the generated files
+		if got[name+".golden"], err = format.Source(byt); err != nil {
+			t.Fatalf("%s: %s", name, err.Error())
+		}
+	}
+	if *update {
+		os.RemoveAll(golden)
+		for name, byt := range got {
+			name = filepath.Join(golden, filepath.FromSlash(name))
+			if err := os.MkdirAll(filepath.Dir(name), 0777); err != nil {
+				t.Fatal(err)
+			}
+			if err := ioutil.WriteFile(name, byt, 0666); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return
+	}
+
+	want := readTree(t, golden)
+	for name, byt := range want {
+		if !bytes.Equal(got[name], byt) {
+			t.Errorf("%s differs from testdata/golden, run go test -update if the change is expected", name)
+		}
+	}
+	for name := range got {
+		if _, ok := want[name]; !ok {
+			t.Errorf("%s is not in testdata/golden", name)
+		}
+	}
+}
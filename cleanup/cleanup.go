@@ -0,0 +1,31 @@
+// Package cleanup contains tools to find and remove configuration clutter from a UTM
+package cleanup
+
+import (
+	"strings"
+
+	"github.com/esurdam/go-sophos"
+)
+
+// Builtin contains the well-known References which exist on every UTM and are never reported
+var Builtin = []string{
+	sophos.RefServiceAny,
+	sophos.RefNetworkAny,
+	sophos.RefNtpPool,
+}
+
+const defaultRefPrefix = "REF_Default"
+
+// IsBuiltin returns true if the Reference is listed in Builtin, is one of the REF_Default* References
+// or its lock level is "global" which means the object is owned by the system.
+func IsBuiltin(ref, locked string) bool {
+	if locked == "global" || strings.HasPrefix(ref, defaultRefPrefix) {
+		return true
+	}
+	for _, b := range Builtin {
+		if b == ref {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,191 @@
+package cleanup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+// DuplicateAttributes maps an object type to the attributes which define its semantics.
+// Objects of the same type with equal attributes are duplicates regardless of their name.
+// List attributes (e.g. group members) are compared unordered.
+var DuplicateAttributes = map[string][]string{
+	"network/host":       {"address", "address6", "interface"},
+	"network/network":    {"address", "netmask", "address6", "netmask6", "interface"},
+	"network/range":      {"from", "to", "from6", "to6", "interface"},
+	"network/dns_host":   {"hostname", "interface"},
+	"network/dns_group":  {"hostname", "interface"},
+	"network/multicast":  {"address", "netmask", "interface"},
+	"network/group":      {"members"},
+	"service/tcp":        {"dst_low", "dst_high", "src_low", "src_high"},
+	"service/udp":        {"dst_low", "dst_high", "src_low", "src_high"},
+	"service/tcpudp":     {"dst_low", "dst_high", "src_low", "src_high"},
+	"service/icmp":       {"type", "code"},
+	"service/icmpv6":     {"type", "code"},
+	"service/ip":         {"proto"},
+	"service/ah":         {"spi_low", "spi_high"},
+	"service/esp":        {"spi_low", "spi_high"},
+	"service/group":      {"members"},
+	"time/recurring":     {"start_time", "end_time", "weekdays"},
+	"time/single":        {"start_date", "start_time", "end_date", "end_time"},
+	"time/group":         {"members"},
+	"interface/group":    {"members"},
+	"packetfilter/group": {"members"},
+}
+
+// A Duplicate is a set of semantically equal objects
+type Duplicate struct {
+	Type string `json:"type"`
+	// Key contains the attributes the objects share
+	Key string `json:"key"`
+	// Canonical is the object the Duplicates should be merged into
+	Canonical Object `json:"canonical"`
+	// Duplicates are the objects equal to Canonical
+	Duplicates []Object `json:"duplicates"`
+}
+
+// FindDuplicates returns the semantically equal objects of the types (e.g. network/* or service/tcp)
+// as defined by DuplicateAttributes. The network, service and time classes are checked when no types are provided.
+//
+// The canonical object of each Duplicate is a built-in object if there is one, otherwise the object
+// with the lowest name. It may be changed by the caller before merging. Locked objects other than the
+// canonical one are not listed as duplicates since they are never deleted.
+func FindDuplicates(s *snapshot.Snapshot, types ...string) ([]Duplicate, error) {
+	if len(types) == 0 {
+		types = []string{"network/*", "service/*", "time/*"}
+	}
+
+	groups := map[string][]string{}
+	for _, ref := range s.References(types...) {
+		objType := s.Type(ref)
+		attrs, ok := DuplicateAttributes[objType]
+		if !ok {
+			continue
+		}
+		m, err := s.Attributes(ref)
+		if err != nil {
+			return nil, err
+		}
+		key := duplicateKey(m, attrs)
+		groups[objType+"\x00"+key] = append(groups[objType+"\x00"+key], ref)
+	}
+
+	var dd []Duplicate
+	for k, refs := range groups {
+		if len(refs) < 2 {
+			continue
+		}
+		parts := strings.SplitN(k, "\x00", 2)
+		oo := make([]Object, len(refs))
+		for i, ref := range refs {
+			oo[i] = Object{Reference: ref, Type: parts[0], Name: s.Name(ref)}
+		}
+		sort.Slice(oo, func(i, j int) bool {
+			bi, bj := IsBuiltin(oo[i].Reference, s.Locked(oo[i].Reference)), IsBuiltin(oo[j].Reference, s.Locked(oo[j].Reference))
+			if bi != bj {
+				return bi
+			}
+			if oo[i].Name != oo[j].Name {
+				return oo[i].Name < oo[j].Name
+			}
+			return oo[i].Reference < oo[j].Reference
+		})
+		// locked objects are kept, they are never merged into the canonical object
+		var duplicates []Object
+		for _, o := range oo[1:] {
+			if s.Locked(o.Reference) == "" {
+				duplicates = append(duplicates, o)
+			}
+		}
+		if len(duplicates) == 0 {
+			continue
+		}
+		dd = append(dd, Duplicate{Type: parts[0], Key: parts[1], Canonical: oo[0], Duplicates: duplicates})
+	}
+
+	sort.Slice(dd, func(i, j int) bool {
+		if dd[i].Type != dd[j].Type {
+			return dd[i].Type < dd[j].Type
+		}
+		return dd[i].Canonical.Name < dd[j].Canonical.Name
+	})
+	return dd, nil
+}
+
+// duplicateKey returns the normalized attributes of the object
+func duplicateKey(m map[string]interface{}, attrs []string) string {
+	parts := make([]string, len(attrs))
+	for i, a := range attrs {
+		switch v := m[a].(type) {
+		case []interface{}:
+			ss := make([]string, len(v))
+			for j, e := range v {
+				ss[j] = fmt.Sprint(e)
+			}
+			sort.Strings(ss)
+			parts[i] = a + "=[" + strings.Join(ss, " ") + "]"
+		case nil:
+			parts[i] = a + "="
+		default:
+			parts[i] = a + "=" + fmt.Sprint(v)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// MergePlan returns a Plan which replaces every use of the Duplicate's duplicates with the canonical
+// object and then deletes the duplicates
+func MergePlan(c sophos.ClientInterface, s *snapshot.Snapshot, d Duplicate, options ...sophos.Option) (Plan, error) {
+	repl := map[string]string{}
+	refs := make([]string, len(d.Duplicates))
+	for i, o := range d.Duplicates {
+		repl[o.Reference] = d.Canonical.Reference
+		refs[i] = o.Reference
+	}
+	replace, err := replacePlan(c, s, repl, options...)
+	if err != nil {
+		return nil, err
+	}
+	remove, err := DeletePlan(s, refs...)
+	if err != nil {
+		return nil, err
+	}
+	return append(replace, remove...), nil
+}
+
+// Merge merges the Duplicate's duplicates into its canonical object, see MergePlan.
+// All changes are rolled back if any of them fails.
+func Merge(c sophos.ClientInterface, s *snapshot.Snapshot, d Duplicate, opts ReplaceOptions) (Plan, error) {
+	plan, err := MergePlan(c, s, d, opts.Options...)
+	if err != nil || opts.DryRun {
+		return plan, err
+	}
+	return plan, plan.Apply(c, opts.Options...)
+}
+
+// WriteDuplicatesTable writes the Duplicates as a text table
+func WriteDuplicatesTable(w io.Writer, dd []Duplicate) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "TYPE\tCANONICAL\tDUPLICATES\tATTRIBUTES")
+	for _, d := range dd {
+		names := make([]string, len(d.Duplicates))
+		for i, o := range d.Duplicates {
+			names[i] = o.Name
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", d.Type, d.Canonical.Name, strings.Join(names, ", "), d.Key)
+	}
+	return tw.Flush()
+}
+
+// WriteDuplicatesJSON writes the Duplicates as JSON
+func WriteDuplicatesJSON(w io.Writer, dd []Duplicate) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(dd)
+}
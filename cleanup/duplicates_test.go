@@ -0,0 +1,139 @@
+package cleanup_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/cleanup"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+func duplicatesSnapshot(t *testing.T) *snapshot.Snapshot {
+	s := snapshot.New()
+	for _, o := range []interface{}{
+		objects.NetworkHost{Reference: "REF_NetHosWeb1", ObjectType: "network/host", Name: "web", Address: "10.0.0.1"},
+		objects.NetworkHost{Reference: "REF_NetHosWeb2", ObjectType: "network/host", Name: "webserver", Address: "10.0.0.1"},
+		objects.NetworkHost{Reference: "REF_NetHosWeb3", ObjectType: "network/host", Name: "www", Address: "10.0.0.1"},
+		objects.NetworkHost{Reference: "REF_NetHosDb", ObjectType: "network/host", Name: "db", Address: "10.0.0.2"},
+		objects.NetworkGroup{Reference: "REF_NetGroA", ObjectType: "network/group", Name: "a", Members: []string{"REF_NetHosDb", "REF_NetHosWeb1"}},
+		objects.NetworkGroup{Reference: "REF_NetGroB", ObjectType: "network/group", Name: "b", Members: []string{"REF_NetHosWeb1", "REF_NetHosDb"}},
+		objects.ServiceTcp{Reference: "REF_SerTcpHttps", ObjectType: "service/tcp", Name: "HTTPS", DstLow: 443, DstHigh: 443, SrcLow: 1, SrcHigh: 65535},
+		objects.ServiceTcp{Reference: "REF_DefaultServiceHttps", ObjectType: "service/tcp", Name: "zz HTTPS", DstLow: 443, DstHigh: 443, SrcLow: 1, SrcHigh: 65535},
+		objects.ServiceUdp{Reference: "REF_SerUdpHttps", ObjectType: "service/udp", Name: "QUIC", DstLow: 443, DstHigh: 443, SrcLow: 1, SrcHigh: 65535},
+		objects.TimeRecurring{Reference: "REF_TimRecA", ObjectType: "time/recurring", Name: "a", StartTime: "08:00", EndTime: "18:00", Weekdays: []string{"Mon", "Tue"}},
+		objects.TimeRecurring{Reference: "REF_TimRecB", ObjectType: "time/recurring", Name: "b", StartTime: "08:00", EndTime: "18:00", Weekdays: []string{"Tue", "Mon"}},
+		objects.TimeRecurring{Reference: "REF_TimRecC", ObjectType: "time/recurring", Name: "c", StartTime: "08:00", EndTime: "18:00", Weekdays: []string{"Mon", "Tue"}, Locked: "user"},
+	} {
+		if err := s.Add(o); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s
+}
+
+func TestFindDuplicates(t *testing.T) {
+	dd, err := cleanup.FindDuplicates(duplicatesSnapshot(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dd) != 4 {
+		t.Fatalf("wanted 4 duplicates, got %v", dd)
+	}
+
+	if dd[0].Type != "network/group" || dd[0].Canonical.Name != "a" {
+		t.Errorf("groups with equal members should be duplicates: %v", dd[0])
+	}
+	if dd[1].Canonical.Reference != "REF_NetHosWeb1" || len(dd[1].Duplicates) != 2 {
+		t.Errorf("unexpected host duplicates: %v", dd[1])
+	}
+	if dd[2].Canonical.Reference != "REF_DefaultServiceHttps" {
+		t.Errorf("builtin object should be canonical: %v", dd[2])
+	}
+	if dd[3].Type != "time/recurring" || len(dd[3].Duplicates) != 1 || dd[3].Duplicates[0].Reference != "REF_TimRecB" {
+		t.Errorf("unexpected duplicate: %v", dd[3])
+	}
+
+	dd, _ = cleanup.FindDuplicates(duplicatesSnapshot(t), "service/*")
+	if len(dd) != 1 {
+		t.Errorf("only services should be checked: %v", dd)
+	}
+
+	var buf bytes.Buffer
+	if err := cleanup.WriteDuplicatesTable(&buf, dd); err != nil || !strings.Contains(buf.String(), "zz HTTPS   HTTPS") {
+		t.Errorf("unexpected table: %s %v", buf.String(), err)
+	}
+	buf.Reset()
+	if err := cleanup.WriteDuplicatesJSON(&buf, dd); err != nil || !strings.Contains(buf.String(), `"REF_SerTcpHttps"`) {
+		t.Errorf("unexpected json: %s %v", buf.String(), err)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	srv := &server{
+		usedBy: map[string]sophos.UsedBy{
+			"REF_NetHosWeb2": {Objects: []sophos.Reference{"REF_NetGroA"}},
+			"REF_NetHosWeb3": {Objects: []sophos.Reference{"REF_NetGroA"}},
+		},
+		responses: map[string]interface{}{
+			"/api/objects/network/group/REF_NetGroA": map[string]interface{}{
+				"members": []string{"REF_NetHosWeb2", "REF_NetHosWeb3"},
+			},
+			"/api/definitions/network": networkSwag,
+		},
+	}
+	client, td := setupTestCase(t, srv)
+	defer td()
+
+	s := duplicatesSnapshot(t)
+	dd, _ := cleanup.FindDuplicates(s, "network/host")
+	plan, err := cleanup.Merge(client, s, dd[0], cleanup.ReplaceOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan) != 3 {
+		t.Fatalf("wanted one patch and two deletes, got %s", plan)
+	}
+	members := plan[0].Body.(map[string]interface{})["members"].([]interface{})
+	if len(members) != 1 || members[0] != "REF_NetHosWeb1" {
+		t.Errorf("all duplicates should be replaced at once: %v", members)
+	}
+	want := "PATCH /api/objects/network/group/REF_NetGroA,DELETE /api/objects/network/host/REF_NetHosWeb2,DELETE /api/objects/network/host/REF_NetHosWeb3"
+	var got []string
+	for _, r := range srv.requests {
+		if !strings.HasPrefix(r, "GET") {
+			got = append(got, r)
+		}
+	}
+	if strings.Join(got, ",") != want {
+		t.Errorf("wanted %s, got %v", want, got)
+	}
+}
+
+func TestMerge_Rollback(t *testing.T) {
+	srv := &server{
+		fail:   "REF_NetHosWeb3",
+		usedBy: map[string]sophos.UsedBy{"REF_NetHosWeb2": {Objects: []sophos.Reference{"REF_NetGroA"}}},
+		responses: map[string]interface{}{
+			"/api/objects/network/group/REF_NetGroA": map[string]interface{}{"members": []string{"REF_NetHosWeb2"}},
+			"/api/definitions/network":               networkSwag,
+		},
+	}
+	client, td := setupTestCase(t, srv)
+	defer td()
+
+	s := duplicatesSnapshot(t)
+	dd, _ := cleanup.FindDuplicates(s, "network/host")
+	if _, err := cleanup.Merge(client, s, dd[0], cleanup.ReplaceOptions{}); err == nil || !strings.Contains(err.Error(), "rolled back") {
+		t.Fatalf("merge should be rolled back: %v", err)
+	}
+	if body := srv.bodies["POST /api/objects/network/host/"]; !strings.Contains(body, `"webserver"`) {
+		t.Errorf("deleted host should be recreated from its attributes: %s", body)
+	}
+	// the restored group references the recreated host
+	if body := srv.bodies["PATCH /api/objects/network/group/REF_NetGroA"]; !strings.Contains(body, `"members":["REF_Recreated"]`) {
+		t.Errorf("restored references should be remapped: %s", body)
+	}
+}
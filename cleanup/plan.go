@@ -0,0 +1,203 @@
+package cleanup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+// A Step is a single REST call of a Plan
+type Step struct {
+	Description string      `json:"description"`
+	Method      string      `json:"method"`
+	Path        string      `json:"path"`
+	Body        interface{} `json:"body,omitempty"`
+	// Recreates is the Reference of a deleted object which is recreated by POSTing the Step.
+	// The object gets a new Reference which replaces the deleted one in the Steps undone afterwards.
+	Recreates string `json:"recreates,omitempty"`
+	// Undo reverts the Step, it is executed when a later Step of the Plan fails
+	Undo *Step `json:"undo,omitempty"`
+}
+
+// A Plan is an ordered list of Steps which can be reviewed (or encoded as JSON) before being applied
+type Plan []Step
+
+// Apply executes each Step in order. The confd session is closed once all requests are done.
+//
+// If a Step fails, the Undo of every previously applied Step is executed in reverse order
+// and the error of the failed Step is returned.
+func (p Plan) Apply(c sophos.ClientInterface, options ...sophos.Option) (err error) {
+	defer func() {
+		if cerr := sophos.CloseSession(c, options...); cerr != nil && err == nil {
+			err = fmt.Errorf("cleanup: %s", cerr.Error())
+		}
+	}()
+	for i, s := range p {
+		if err := s.Do(c, options...); err != nil {
+			if rerr := p[:i].rollback(c, options...); rerr != nil {
+				return fmt.Errorf("cleanup: %s failed: %s (rollback failed: %s)", s.Description, err.Error(), rerr.Error())
+			}
+			return fmt.Errorf("cleanup: %s failed, changes were rolled back: %s", s.Description, err.Error())
+		}
+	}
+	return nil
+}
+
+func (p Plan) rollback(c sophos.ClientInterface, options ...sophos.Option) error {
+	recreated := map[string]string{}
+	for i := len(p) - 1; i >= 0; i-- {
+		if p[i].Undo == nil {
+			continue
+		}
+		undo := p[i].Undo.remap(recreated)
+		res, err := undo.do(c, options...)
+		if err != nil {
+			return err
+		}
+		if undo.Recreates == "" {
+			continue
+		}
+		var created struct {
+			Reference string `json:"_ref"`
+		}
+		if res.StatusCode != http.StatusCreated || res.MarshalTo(&created) != nil || created.Reference == "" {
+			return fmt.Errorf("cleanup: %s did not recreate %s: %s", undo.Description, undo.Recreates, res.Status)
+		}
+		recreated[undo.Recreates] = created.Reference
+	}
+	return nil
+}
+
+// remap returns a copy of the Step whose Path and Body use the new References of recreated objects
+func (s Step) remap(recreated map[string]string) Step {
+	if len(recreated) == 0 {
+		return s
+	}
+	for old, ref := range recreated {
+		s.Path = strings.Replace(s.Path, old, ref, -1)
+	}
+	s.Body = remapValue(s.Body, recreated)
+	return s
+}
+
+func remapValue(v interface{}, recreated map[string]string) interface{} {
+	switch v := v.(type) {
+	case string:
+		if ref, ok := recreated[v]; ok {
+			return ref
+		}
+	case []string:
+		vv := make([]string, len(v))
+		for i, e := range v {
+			vv[i] = remapValue(e, recreated).(string)
+		}
+		return vv
+	case []interface{}:
+		vv := make([]interface{}, len(v))
+		for i, e := range v {
+			vv[i] = remapValue(e, recreated)
+		}
+		return vv
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = remapValue(e, recreated)
+		}
+		return m
+	}
+	return v
+}
+
+// String returns a human readable listing of the Plan
+func (p Plan) String() string {
+	var b strings.Builder
+	for i, s := range p {
+		fmt.Fprintf(&b, "%d. %s %s", i+1, s.Method, s.Path)
+		if s.Description != "" {
+			fmt.Fprintf(&b, " (%s)", s.Description)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// Do executes the Step
+func (s Step) Do(c sophos.ClientInterface, options ...sophos.Option) error {
+	_, err := s.do(c, options...)
+	return err
+}
+
+func (s Step) do(c sophos.ClientInterface, options ...sophos.Option) (res *sophos.Response, err error) {
+	var body io.Reader
+	if s.Body != nil {
+		byt, err := json.Marshal(s.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(byt)
+	}
+
+	switch s.Method {
+	case http.MethodDelete:
+		res, err = c.Delete(s.Path, options...)
+	case http.MethodPatch:
+		res, err = c.Patch(s.Path, body, options...)
+	case http.MethodPost:
+		res, err = c.Post(s.Path, body, options...)
+	case http.MethodPut:
+		res, err = c.Put(s.Path, body, options...)
+	default:
+		err = fmt.Errorf("cleanup: unsupported step method %q", s.Method)
+	}
+	return
+}
+
+// DeletePlan returns a Plan deleting the objects with the References, locked objects are never deleted.
+// Each Step is undone by POSTing the attributes of the object as it is contained in the Snapshot.
+// The recreated object gets a new Reference which replaces the deleted one in the Steps undone
+// afterwards, e.g. the replacements of MergePlan.
+func DeletePlan(s *snapshot.Snapshot, refs ...string) (Plan, error) {
+	var p Plan
+	for _, ref := range refs {
+		if locked := s.Locked(ref); locked != "" {
+			return nil, fmt.Errorf("cleanup: %s %q is locked (%s) and cannot be deleted", s.Type(ref), s.Name(ref), locked)
+		}
+		attrs, err := editable(s, ref)
+		if err != nil {
+			return nil, err
+		}
+		p = append(p, Step{
+			Description: fmt.Sprintf("delete %s %q", s.Type(ref), s.Name(ref)),
+			Method:      http.MethodDelete,
+			Path:        s.Path(ref),
+			Undo: &Step{
+				Description: fmt.Sprintf("recreate %s %q", s.Type(ref), s.Name(ref)),
+				Method:      http.MethodPost,
+				Path:        fmt.Sprintf("/api/objects/%s/", s.Type(ref)),
+				Body:        attrs,
+				Recreates:   ref,
+			},
+		})
+	}
+	return p, nil
+}
+
+// editable returns the object's attributes without the read-only "_" attributes
+func editable(s *snapshot.Snapshot, ref string) (map[string]interface{}, error) {
+	attrs, err := s.Attributes(ref)
+	if err != nil {
+		return nil, err
+	}
+	for k := range attrs {
+		if strings.HasPrefix(k, "_") {
+			delete(attrs, k)
+		}
+	}
+	return attrs, nil
+}
@@ -0,0 +1,308 @@
+package cleanup
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+// ReplaceOptions configure ReplaceReference
+type ReplaceOptions struct {
+	// DryRun returns the Plan without applying it
+	DryRun bool
+	// Options are passed to every request
+	Options []sophos.Option
+}
+
+// ReplaceReference replaces every use of the old Reference with the new one.
+//
+// The objects and nodes using old are requested from the UTM (usedby). Each object attribute
+// and node containing old is type checked against the REF(class/type) constraints of its swagger
+// definition before any change is made, values which are not declared as references are an error.
+// All changes are applied as one Plan: if any change fails the already applied changes are rolled
+// back. The Snapshot is used to resolve object types.
+func ReplaceReference(c sophos.ClientInterface, s *snapshot.Snapshot, old, new string, opts ReplaceOptions) (Plan, error) {
+	plan, err := ReplacePlan(c, s, old, new, opts.Options...)
+	if err != nil || opts.DryRun {
+		return plan, err
+	}
+	return plan, plan.Apply(c, opts.Options...)
+}
+
+// ReplacePlan returns the Plan used by ReplaceReference
+func ReplacePlan(c sophos.ClientInterface, s *snapshot.Snapshot, old, new string, options ...sophos.Option) (Plan, error) {
+	return replacePlan(c, s, map[string]string{old: new}, options...)
+}
+
+// replacePlan returns a Plan replacing each key of repl with its value. All replacements of an
+// object or node are made by a single Step.
+func replacePlan(c sophos.ClientInterface, s *snapshot.Snapshot, repl map[string]string, options ...sophos.Option) (Plan, error) {
+	olds := make([]string, 0, len(repl))
+	for old, new := range repl {
+		if old == new {
+			return nil, fmt.Errorf("cleanup: cannot replace %s with itself", old)
+		}
+		for _, ref := range []string{old, new} {
+			if s.Type(ref) == "" {
+				return nil, fmt.Errorf("cleanup: %s: type of %s is unknown", snapshot.ErrNotFound, ref)
+			}
+		}
+		olds = append(olds, old)
+	}
+	sort.Strings(olds)
+
+	var objs, nodes []string
+	seen := map[string]bool{}
+	for _, old := range olds {
+		ub, err := usedBy(c, s, old, options...)
+		if err != nil {
+			return nil, err
+		}
+		for _, ref := range ub.Objects {
+			if !seen[string(ref)] && repl[string(ref)] == "" {
+				seen[string(ref)] = true
+				objs = append(objs, string(ref))
+			}
+		}
+		for _, node := range ub.Nodes {
+			if !seen["node:"+string(node)] {
+				seen["node:"+string(node)] = true
+				nodes = append(nodes, string(node))
+			}
+		}
+	}
+
+	r := replacer{c: c, s: s, repl: repl, swags: map[string]sophos.Swag{}, options: options}
+	var plan Plan
+	for _, ref := range objs {
+		p, err := r.object(ref)
+		if err != nil {
+			return nil, err
+		}
+		plan = append(plan, p...)
+	}
+	for _, node := range nodes {
+		p, err := r.node(node)
+		if err != nil {
+			return nil, err
+		}
+		plan = append(plan, p...)
+	}
+	return plan, nil
+}
+
+type replacer struct {
+	c       sophos.ClientInterface
+	s       *snapshot.Snapshot
+	repl    map[string]string
+	swags   map[string]sophos.Swag
+	options []sophos.Option
+}
+
+// describe returns a short description of the replacements
+func (r *replacer) describe() string {
+	var ss []string
+	for old, new := range r.repl {
+		ss = append(ss, old+" with "+new)
+	}
+	sort.Strings(ss)
+	return strings.Join(ss, ", ")
+}
+
+// object returns the PATCH Step of the object using any of the replaced References
+func (r *replacer) object(ref string) (Plan, error) {
+	objType := r.s.Type(ref)
+	if objType == "" {
+		return nil, fmt.Errorf("cleanup: %s: type of %s which uses %s is unknown", snapshot.ErrNotFound, ref, r.describe())
+	}
+	path := r.s.Path(ref)
+	res, err := r.c.Get(path, r.options...)
+	if err != nil {
+		return nil, fmt.Errorf("cleanup: error retrieving %s: %s", ref, err.Error())
+	}
+	var attrs map[string]interface{}
+	if err := res.MarshalTo(&attrs); err != nil {
+		return nil, err
+	}
+
+	def, err := r.definition(objType)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, undo := map[string]interface{}{}, map[string]interface{}{}
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if strings.HasPrefix(k, "_") {
+			continue
+		}
+		v, replaced := replaceValue(attrs[k], r.repl)
+		if len(replaced) == 0 {
+			continue
+		}
+		rr := def.Properties[k].RefConstraints()
+		if len(rr) == 0 {
+			return nil, fmt.Errorf("cleanup: attribute %s of %s %q contains %s but is not a reference in the definition",
+				k, objType, r.s.Name(ref), r.describe())
+		}
+		for _, new := range replaced {
+			if newType := r.s.Type(new); !sophos.AllowsAny(rr, newType) {
+				return nil, fmt.Errorf("cleanup: %s (%s) is not allowed for attribute %s of %s %q, allowed are %v",
+					new, newType, k, objType, r.s.Name(ref), rr)
+			}
+		}
+		patch[k] = v
+		undo[k] = attrs[k]
+	}
+	if len(patch) == 0 {
+		return nil, nil
+	}
+
+	return Plan{{
+		Description: fmt.Sprintf("replace %s in %s %q", r.describe(), objType, r.s.Name(ref)),
+		Method:      http.MethodPatch,
+		Path:        path,
+		Body:        patch,
+		Undo: &Step{
+			Description: fmt.Sprintf("restore %s %q", objType, r.s.Name(ref)),
+			Method:      http.MethodPatch,
+			Path:        path,
+			Body:        undo,
+		},
+	}}, nil
+}
+
+// node returns the PUT Step of the node using any of the replaced References
+func (r *replacer) node(name string) (Plan, error) {
+	path := "/api/nodes/" + name
+	res, err := r.c.Get(path, r.options...)
+	if err != nil {
+		return nil, fmt.Errorf("cleanup: error retrieving node %s: %s", name, err.Error())
+	}
+	var val interface{}
+	if err := res.MarshalTo(&val); err != nil {
+		return nil, err
+	}
+	v, replaced := replaceValue(val, r.repl)
+	if len(replaced) == 0 {
+		return nil, nil
+	}
+	rr, err := r.nodeConstraints(name)
+	if err != nil {
+		return nil, err
+	}
+	for _, new := range replaced {
+		if newType := r.s.Type(new); !sophos.AllowsAny(rr, newType) {
+			return nil, fmt.Errorf("cleanup: %s (%s) is not allowed for node %s, allowed are %v", new, newType, name, rr)
+		}
+	}
+	return Plan{{
+		Description: fmt.Sprintf("replace %s in node %s", r.describe(), name),
+		Method:      http.MethodPut,
+		Path:        path,
+		Body:        v,
+		Undo: &Step{
+			Description: fmt.Sprintf("restore node %s", name),
+			Method:      http.MethodPut,
+			Path:        path,
+			Body:        val,
+		},
+	}}, nil
+}
+
+// definition returns the swagger definition of the object type
+func (r *replacer) definition(objType string) (sophos.SwagDefinition, error) {
+	swag, err := r.swag(strings.SplitN(objType, "/", 2)[0])
+	if err != nil {
+		return sophos.SwagDefinition{}, err
+	}
+	def, ok := swag.SwagDefinition(objType)
+	if !ok {
+		return def, fmt.Errorf("cleanup: %s: definition of %s is missing", snapshot.ErrNotFound, objType)
+	}
+	return def, nil
+}
+
+// nodeConstraints returns the REF constraints of the node value, declared by its PUT path or by the
+// properties of the definition named after the node
+func (r *replacer) nodeConstraints(name string) ([]sophos.RefConstraint, error) {
+	swag, err := r.swag("nodes")
+	if err != nil {
+		return nil, err
+	}
+	var rr []sophos.RefConstraint
+	if p, ok := swag.NodeSchema(name); ok {
+		rr = p.RefConstraints()
+	} else if def, ok := swag.SwagDefinition(name); ok {
+		for _, p := range def.Properties {
+			rr = append(rr, p.RefConstraints()...)
+		}
+	}
+	if len(rr) == 0 {
+		return nil, fmt.Errorf("cleanup: node %s contains %s but is not a reference in the definition", name, r.describe())
+	}
+	return rr, nil
+}
+
+// swag returns the swagger of the class (or of the nodes), swaggers are requested once per class
+func (r *replacer) swag(class string) (sophos.Swag, error) {
+	if swag, ok := r.swags[class]; ok {
+		return swag, nil
+	}
+	d := sophos.Definition{Name: class, Link: "/api/definitions/" + class}
+	swag, err := d.GetSwag(r.c, r.options...)
+	if err != nil {
+		return swag, fmt.Errorf("cleanup: error retrieving definitions of %s: %s", class, err.Error())
+	}
+	r.swags[class] = swag
+	return swag, nil
+}
+
+// replaceValue returns a copy of v where every string matching a key of repl is replaced by its value
+// and the new values which were introduced. Duplicates created by the replacement are removed from lists.
+func replaceValue(v interface{}, repl map[string]string) (interface{}, []string) {
+	switch v := v.(type) {
+	case string:
+		if new, ok := repl[v]; ok {
+			return new, []string{new}
+		}
+	case []interface{}:
+		var replaced []string
+		out := make([]interface{}, 0, len(v))
+		seen := map[interface{}]bool{}
+		for _, e := range v {
+			e, r := replaceValue(e, repl)
+			replaced = append(replaced, r...)
+			if s, ok := e.(string); ok && sophos.IsReference(s) {
+				if seen[s] {
+					continue
+				}
+				seen[s] = true
+			}
+			out = append(out, e)
+		}
+		if len(replaced) > 0 {
+			return out, replaced
+		}
+	case map[string]interface{}:
+		var replaced []string
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			e, r := replaceValue(e, repl)
+			replaced = append(replaced, r...)
+			out[k] = e
+		}
+		if len(replaced) > 0 {
+			return out, replaced
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,133 @@
+package cleanup_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/cleanup"
+)
+
+// networkSwag declares the members of network groups as references
+var networkSwag = sophos.Swag{Definitions: map[string]sophos.SwagDefinition{
+	"network.group": {Properties: map[string]sophos.Property{"members": {Type: "array", Items: map[string]interface{}{"description": "REF(network/*)"}}}},
+}}
+
+func replaceServer() *server {
+	return &server{
+		usedBy: map[string]sophos.UsedBy{
+			"REF_NetHosUnused": {
+				Objects: []sophos.Reference{"REF_NetGroServers", "REF_PacPacAllow"},
+				Nodes:   []sophos.Reference{"ssh.allowed_networks"},
+			},
+		},
+		responses: map[string]interface{}{
+			"/api/objects/network/group/REF_NetGroServers": map[string]interface{}{
+				"_ref": "REF_NetGroServers", "name": "servers", "members": []string{"REF_NetHosUnused", "REF_NetHosUsed"},
+			},
+			"/api/objects/packetfilter/packetfilter/REF_PacPacAllow": map[string]interface{}{
+				"_ref": "REF_PacPacAllow", "name": "allow", "sources": []string{"REF_NetHosUnused"}, "comment": "allow unused",
+			},
+			"/api/nodes/ssh.allowed_networks": []string{"REF_NetHosUnused"},
+			"/api/definitions/network":        networkSwag,
+			"/api/definitions/packetfilter": sophos.Swag{Definitions: map[string]sophos.SwagDefinition{
+				"packetfilter.packetfilter": {Properties: map[string]sophos.Property{
+					"sources": {Description: "REF(network/*)"},
+					"comment": {Type: "string"},
+				}},
+			}},
+			"/api/definitions/nodes": sophos.Swag{Paths: map[string]sophos.MethodMap{
+				"/nodes/ssh.allowed_networks": {"put": {Parameters: []sophos.Parameter{{In: "body", Name: "body", Schema: &sophos.Property{
+					Type: "array", Items: map[string]interface{}{"type": "string", "description": "REF(network/*)"},
+				}}}}},
+			}},
+		},
+	}
+}
+
+func TestReplaceReference(t *testing.T) {
+	srv := replaceServer()
+	client, td := setupTestCase(t, srv)
+	defer td()
+
+	s := testSnapshot(t)
+	s.Add(objects.NetworkGroup{Reference: "REF_NetGroServers", ObjectType: "network/group", Name: "servers"})
+	s.Add(objects.PacketfilterPacketfilter{Reference: "REF_PacPacAllow", ObjectType: "packetfilter/packetfilter", Name: "allow"})
+
+	plan, err := cleanup.ReplaceReference(client, s, "REF_NetHosUnused", "REF_NetHosUsed", cleanup.ReplaceOptions{DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan) != 3 {
+		t.Fatalf("wanted 3 steps, got %s", plan)
+	}
+	members := plan[0].Body.(map[string]interface{})["members"].([]interface{})
+	if len(members) != 1 || members[0] != "REF_NetHosUsed" {
+		t.Errorf("replacement should not duplicate members: %v", members)
+	}
+	if plan[2].Path != "/api/nodes/ssh.allowed_networks" || plan[2].Undo.Body.([]interface{})[0] != "REF_NetHosUnused" {
+		t.Errorf("unexpected node step: %#v", plan[2])
+	}
+	for _, r := range srv.requests {
+		if !strings.HasPrefix(r, "GET") {
+			t.Errorf("dry run should not modify: %s", r)
+		}
+	}
+
+	srv.requests = nil
+	srv.fail = "ssh.allowed_networks"
+	if _, err = cleanup.ReplaceReference(client, s, "REF_NetHosUnused", "REF_NetHosUsed", cleanup.ReplaceOptions{}); err == nil {
+		t.Fatal("replacement should fail")
+	}
+	var modifying []string
+	for _, r := range srv.requests {
+		if !strings.HasPrefix(r, "GET") {
+			modifying = append(modifying, r)
+		}
+	}
+	if len(modifying) != 5 || modifying[3] != "PATCH /api/objects/packetfilter/packetfilter/REF_PacPacAllow" {
+		t.Errorf("changes should have been rolled back in reverse order: %v", modifying)
+	}
+}
+
+func TestReplaceReference_TypeCheck(t *testing.T) {
+	srv := replaceServer()
+	client, td := setupTestCase(t, srv)
+	defer td()
+
+	s := testSnapshot(t)
+	s.Add(objects.NetworkGroup{Reference: "REF_NetGroServers", ObjectType: "network/group", Name: "servers"})
+	s.Add(objects.PacketfilterPacketfilter{Reference: "REF_PacPacAllow", ObjectType: "packetfilter/packetfilter", Name: "allow"})
+
+	_, err := cleanup.ReplaceReference(client, s, "REF_NetHosUnused", "REF_SerTcpUnused", cleanup.ReplaceOptions{DryRun: true})
+	if err == nil || !strings.Contains(err.Error(), "REF(network/*)") {
+		t.Errorf("service should not be allowed as group member: %v", err)
+	}
+
+	// only the node uses the host
+	srv.usedBy["REF_NetHosUnused"] = sophos.UsedBy{Nodes: []sophos.Reference{"ssh.allowed_networks"}}
+	_, err = cleanup.ReplaceReference(client, s, "REF_NetHosUnused", "REF_SerTcpUnused", cleanup.ReplaceOptions{DryRun: true})
+	if err == nil || !strings.Contains(err.Error(), "node ssh.allowed_networks") {
+		t.Errorf("service should not be allowed in ssh.allowed_networks: %v", err)
+	}
+
+	// a free text attribute containing the reference
+	srv.usedBy["REF_NetHosUnused"] = sophos.UsedBy{Objects: []sophos.Reference{"REF_PacPacAllow"}}
+	srv.responses["/api/objects/packetfilter/packetfilter/REF_PacPacAllow"] = map[string]interface{}{"name": "allow", "comment": "REF_NetHosUnused"}
+	_, err = cleanup.ReplaceReference(client, s, "REF_NetHosUnused", "REF_NetHosUsed", cleanup.ReplaceOptions{DryRun: true})
+	if err == nil || !strings.Contains(err.Error(), "attribute comment") {
+		t.Errorf("attributes which are not references should not be replaced: %v", err)
+	}
+	delete(srv.responses, "/api/definitions/packetfilter")
+	if _, err = cleanup.ReplaceReference(client, s, "REF_NetHosUnused", "REF_NetHosUsed", cleanup.ReplaceOptions{DryRun: true}); err == nil {
+		t.Error("missing definitions should error")
+	}
+
+	if _, err := cleanup.ReplaceReference(client, s, "REF_NetHosUnused", "REF_Missing", cleanup.ReplaceOptions{}); err == nil {
+		t.Error("unknown reference should error")
+	}
+	if _, err := cleanup.ReplaceReference(client, s, "REF_NetHosUnused", "REF_NetHosUnused", cleanup.ReplaceOptions{}); err == nil {
+		t.Error("replacing a reference with itself should error")
+	}
+}
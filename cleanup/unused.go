@@ -0,0 +1,175 @@
+package cleanup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+const (
+	defaultConcurrency = 8
+	defaultBatchSize   = 50
+)
+
+// UnusedOptions configure FindUnused
+type UnusedOptions struct {
+	// Types limits the check to objects of these types, e.g. network/host or service/*.
+	// All objects are checked when empty.
+	Types []string
+	// Exclude contains additional References which are never reported
+	Exclude []string
+	// Concurrency is the number of concurrent usedby requests, defaults to 8
+	Concurrency int
+	// BatchSize is the number of objects checked by a worker at once, defaults to 50
+	BatchSize int
+	// Options are passed to every request
+	Options []sophos.Option
+}
+
+// An Object identifies a confd object in a report
+type Object struct {
+	Reference string `json:"reference"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+}
+
+// UnusedReport is the result of FindUnused
+type UnusedReport struct {
+	// Checked is the number of objects whose usage was requested
+	Checked int `json:"checked"`
+	// Objects are the objects neither used by a node nor another object
+	Objects []Object `json:"objects"`
+}
+
+// FindUnused requests the UsedBy data of every object in the Snapshot and returns the objects
+// which are neither used by any node nor by any other object. Built-in objects (see IsBuiltin)
+// are never reported. The workers share the confd session, it is closed once all of them are done.
+func FindUnused(c sophos.ClientInterface, s *snapshot.Snapshot, opts UnusedOptions) (_ *UnusedReport, err error) {
+	defer func() {
+		if cerr := sophos.CloseSession(c, opts.Options...); cerr != nil && err == nil {
+			err = fmt.Errorf("cleanup: %s", cerr.Error())
+		}
+	}()
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultConcurrency
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBatchSize
+	}
+	excluded := make(map[string]bool, len(opts.Exclude))
+	for _, ref := range opts.Exclude {
+		excluded[ref] = true
+	}
+
+	var refs []string
+	for _, ref := range s.References(opts.Types...) {
+		if excluded[ref] || IsBuiltin(ref, s.Locked(ref)) {
+			continue
+		}
+		refs = append(refs, ref)
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		report  = &UnusedReport{Checked: len(refs)}
+		firstEr error
+		batches = make(chan []string)
+	)
+	for i := 0; i < opts.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				for _, ref := range batch {
+					ub, err := usedBy(c, s, ref, opts.Options...)
+					mu.Lock()
+					if err != nil && firstEr == nil {
+						firstEr = err
+					}
+					if err == nil && len(ub.Nodes) == 0 && len(ub.Objects) == 0 {
+						report.Objects = append(report.Objects, Object{
+							Reference: ref,
+							Type:      s.Type(ref),
+							Name:      s.Name(ref),
+						})
+					}
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < len(refs); i += opts.BatchSize {
+		end := i + opts.BatchSize
+		if end > len(refs) {
+			end = len(refs)
+		}
+		batches <- refs[i:end]
+	}
+	close(batches)
+	wg.Wait()
+
+	if firstEr != nil {
+		return nil, firstEr
+	}
+	sort.Slice(report.Objects, func(i, j int) bool {
+		a, b := report.Objects[i], report.Objects[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Reference < b.Reference
+	})
+	return report, nil
+}
+
+func usedBy(c sophos.ClientInterface, s *snapshot.Snapshot, ref string, options ...sophos.Option) (*sophos.UsedBy, error) {
+	res, err := c.Get(s.Path(ref)+"/usedby", options...)
+	if err != nil {
+		return nil, fmt.Errorf("cleanup: error retrieving usedby of %s: %s", ref, err.Error())
+	}
+	var ub sophos.UsedBy
+	err = res.MarshalTo(&ub)
+	return &ub, err
+}
+
+// References returns the References of the reported objects
+func (r *UnusedReport) References() []string {
+	refs := make([]string, len(r.Objects))
+	for i, o := range r.Objects {
+		refs[i] = o.Reference
+	}
+	return refs
+}
+
+// DeletePlan returns a Plan deleting all reported objects
+func (r *UnusedReport) DeletePlan(s *snapshot.Snapshot) (Plan, error) {
+	return DeletePlan(s, r.References()...)
+}
+
+// WriteTable writes the report as a text table
+func (r *UnusedReport) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "TYPE\tREFERENCE\tNAME")
+	for _, o := range r.Objects {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", o.Type, o.Reference, o.Name)
+	}
+	fmt.Fprintf(tw, "\n%d of %d objects are unused\n", len(r.Objects), r.Checked)
+	return tw.Flush()
+}
+
+// WriteJSON writes the report as JSON
+func (r *UnusedReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
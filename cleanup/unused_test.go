@@ -0,0 +1,176 @@
+package cleanup_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/cleanup"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+// server records every request, answers usedby requests from usedBy
+// and other GET requests from responses
+type server struct {
+	sync.Mutex
+	requests  []string
+	usedBy    map[string]sophos.UsedBy
+	responses map[string]interface{}
+	fail      string
+	bodies    map[string]string
+	closes    []string
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	s.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	if s.bodies == nil {
+		s.bodies = map[string]string{}
+	}
+	s.bodies[r.Method+" "+r.URL.Path] = string(body)
+	if r.Header.Get(sophos.XRestdSession) == "close" {
+		s.closes = append(s.closes, r.Method+" "+r.URL.Path)
+	}
+	s.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if s.fail != "" && strings.HasSuffix(r.URL.Path, s.fail) && r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(sophos.Errors{{Fatal: 1, Name: "fail"}})
+		return
+	}
+	if r.Method == http.MethodPost {
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]string{"_ref": "REF_Recreated"})
+		return
+	}
+	if strings.HasSuffix(r.URL.Path, "/usedby") {
+		parts := strings.Split(r.URL.Path, "/")
+		json.NewEncoder(w).Encode(s.usedBy[parts[len(parts)-2]])
+		return
+	}
+	if v, ok := s.responses[r.URL.Path]; ok && r.Method == http.MethodGet {
+		json.NewEncoder(w).Encode(v)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{})
+}
+
+func setupTestCase(t *testing.T, s *server) (*sophos.Client, func()) {
+	ts := httptest.NewServer(s)
+	sophos.DefaultHTTPClient = ts.Client()
+	client, err := sophos.New(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, ts.Close
+}
+
+func testSnapshot(t *testing.T) *snapshot.Snapshot {
+	s := snapshot.New()
+	for _, o := range []interface{}{
+		objects.NetworkAny{Reference: sophos.RefNetworkAny, ObjectType: "network/any", Name: "Any"},
+		objects.NetworkHost{Reference: "REF_NetHosUsed", ObjectType: "network/host", Name: "used"},
+		objects.NetworkHost{Reference: "REF_NetHosUnused", ObjectType: "network/host", Name: "unused", Address: "10.0.0.1"},
+		objects.NetworkHost{Reference: "REF_NetHosSystem", ObjectType: "network/host", Name: "system", Locked: "global"},
+		objects.ServiceTcp{Reference: "REF_SerTcpUnused", ObjectType: "service/tcp", Name: "tcp unused"},
+		objects.ServiceTcp{Reference: "REF_DefaultServiceTcp", ObjectType: "service/tcp", Name: "default"},
+	} {
+		if err := s.Add(o); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s
+}
+
+func TestFindUnused(t *testing.T) {
+	srv := &server{usedBy: map[string]sophos.UsedBy{
+		"REF_NetHosUsed": {Objects: []sophos.Reference{"REF_PacPacAllow"}},
+	}}
+	client, td := setupTestCase(t, srv)
+	defer td()
+
+	s := testSnapshot(t)
+	report, err := cleanup.FindUnused(client, s, cleanup.UnusedOptions{BatchSize: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Checked != 3 {
+		t.Errorf("builtin objects should not be checked, checked %d", report.Checked)
+	}
+	if len(srv.closes) != 1 || srv.closes[0] != srv.requests[len(srv.requests)-1] {
+		t.Errorf("the session should be closed once by the last request: %v", srv.closes)
+	}
+	refs := report.References()
+	if len(refs) != 2 || refs[0] != "REF_NetHosUnused" || refs[1] != "REF_SerTcpUnused" {
+		t.Errorf("unexpected unused objects: %v", refs)
+	}
+
+	report, err = cleanup.FindUnused(client, s, cleanup.UnusedOptions{Types: []string{"network/*"}, Exclude: []string{"REF_NetHosUnused"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Objects) != 0 {
+		t.Errorf("excluded objects should not be reported: %v", report.Objects)
+	}
+
+	var buf bytes.Buffer
+	report, _ = cleanup.FindUnused(client, s, cleanup.UnusedOptions{})
+	if err := report.WriteTable(&buf); err != nil || !strings.Contains(buf.String(), "REF_SerTcpUnused") {
+		t.Errorf("table should contain the unused service: %s %v", buf.String(), err)
+	}
+	buf.Reset()
+	if err := report.WriteJSON(&buf); err != nil || !strings.Contains(buf.String(), `"checked": 3`) {
+		t.Errorf("unexpected json: %s %v", buf.String(), err)
+	}
+}
+
+func TestPlan_Apply(t *testing.T) {
+	srv := &server{fail: "REF_SerTcpUnused"}
+	client, td := setupTestCase(t, srv)
+	defer td()
+
+	s := testSnapshot(t)
+	report := &cleanup.UnusedReport{Objects: []cleanup.Object{{Reference: "REF_NetHosUnused"}, {Reference: "REF_SerTcpUnused"}}}
+	plan, err := report.DeletePlan(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan) != 2 || plan[0].Undo == nil || plan[0].Undo.Body.(map[string]interface{})["address"] != "10.0.0.1" {
+		t.Fatalf("unexpected plan: %#v", plan)
+	}
+	if !strings.Contains(plan.String(), "DELETE /api/objects/network/host/REF_NetHosUnused") {
+		t.Errorf("unexpected plan listing: %s", plan)
+	}
+
+	if err := plan.Apply(client); err == nil {
+		t.Error("apply should fail")
+	}
+	want := []string{
+		"DELETE /api/objects/network/host/REF_NetHosUnused",
+		"DELETE /api/objects/service/tcp/REF_SerTcpUnused",
+		"POST /api/objects/network/host/",
+		"GET /api/status/version",
+	}
+	if strings.Join(srv.requests, ",") != strings.Join(want, ",") {
+		t.Errorf("wanted requests %v, got %v", want, srv.requests)
+	}
+	if len(srv.closes) != 1 || srv.closes[0] != "GET /api/status/version" {
+		t.Errorf("the session should be closed after the rollback: %v", srv.closes)
+	}
+
+	if _, err := cleanup.DeletePlan(s, "REF_Missing"); err == nil {
+		t.Error("delete plan of missing reference should error")
+	}
+	if _, err := cleanup.DeletePlan(s, "REF_NetHosSystem"); err == nil {
+		t.Error("locked objects should never be deleted")
+	}
+}
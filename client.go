@@ -32,11 +32,11 @@ type ClientInterface interface {
 // ObjectClient is an interface with syntactic sugar dealing with Objects
 type ObjectClient interface {
 	GetObject(o RestGetter, options ...Option) error
-	PutObject(o RestObject, options ...Option) error
-	PatchObject(o RestObject, options ...Option) error
-	PostObject(o RestObject, options ...Option) error
-	DeleteObject(o RestObject, options ...Option) error
-	GetUsedBy(o RestObject, options ...Option) (*UsedBy, error)
+	PutObject(o Updatable, options ...Option) error
+	PatchObject(o Patchable, options ...Option) error
+	PostObject(o Creatable, options ...Option) error
+	DeleteObject(o Deletable, options ...Option) error
+	GetUsedBy(o UsedByer, options ...Option) (*UsedBy, error)
 	GetEndpointSwag(e Endpoint, options ...Option) (Swag, error)
 }
 
@@ -231,8 +231,8 @@ func (c Client) GetObject(o RestGetter, options ...Option) error {
 	return err
 }
 
-// PostObject POSTs the RestObject
-func (c Client) PostObject(o RestObject, options ...Option) error {
+// PostObject POSTs the Creatable object
+func (c Client) PostObject(o Creatable, options ...Option) error {
 	byt, _ := json.Marshal(o)
 	res, err := c.Post(o.PostPath(), bytes.NewReader(byt), options...)
 
@@ -246,8 +246,8 @@ func (c Client) PostObject(o RestObject, options ...Option) error {
 	return err
 }
 
-// PatchObject PATCHes the RestObject
-func (c Client) PatchObject(o RestObject, options ...Option) error {
+// PatchObject PATCHes the Patchable object
+func (c Client) PatchObject(o Patchable, options ...Option) error {
 	ref, required := o.RefRequired()
 	if required && ref == "" {
 		return ErrRefRequired
@@ -257,8 +257,8 @@ func (c Client) PatchObject(o RestObject, options ...Option) error {
 	return err
 }
 
-// PutObject PUTs the RestObject
-func (c Client) PutObject(o RestObject, options ...Option) error {
+// PutObject PUTs the Updatable object
+func (c Client) PutObject(o Updatable, options ...Option) error {
 	ref, required := o.RefRequired()
 	if required && ref == "" {
 		return ErrRefRequired
@@ -268,8 +268,8 @@ func (c Client) PutObject(o RestObject, options ...Option) error {
 	return err
 }
 
-// DeleteObject DELETEs the RestObject
-func (c Client) DeleteObject(o RestObject, options ...Option) error {
+// DeleteObject DELETEs the Deletable object
+func (c Client) DeleteObject(o Deletable, options ...Option) error {
 	ref, required := o.RefRequired()
 	if required && ref == "" {
 		return ErrRefRequired
@@ -285,7 +285,7 @@ func (c Client) GetEndpointSwag(e Endpoint, options ...Option) (Swag, error) {
 }
 
 // GetUsedBy GETs the Objects UsedBy data
-func (c Client) GetUsedBy(o RestObject, options ...Option) (*UsedBy, error) {
+func (c Client) GetUsedBy(o UsedByer, options ...Option) (*UsedBy, error) {
 	ref, required := o.RefRequired()
 	if required && ref == "" {
 		return nil, ErrRefRequired
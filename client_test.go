@@ -362,10 +362,10 @@ type dnsMock struct {
 	Routes          []string      `json:"routes"`
 }
 
-var defsDns = map[string]sophos.RestObject{}
+var defsDns = map[string]sophos.RestGetter{}
 
 // RestObjects implements the sophos.Node interface and returns a map of dnsMock's Objects
-func (dnsMock) RestObjects() map[string]sophos.RestObject { return defsDns }
+func (dnsMock) RestObjects() map[string]sophos.RestGetter { return defsDns }
 
 // GetPath implements sophos.RestGetter
 func (*dnsMock) GetPath() string { return "/api/nodes/dns" }
@@ -0,0 +1,313 @@
+package sophos
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// A Schema is the metadata of the swagger definitions a package of objects was generated from.
+// Generated packages register their Schema, it is compared with the definitions of a gateway by
+// Client.CheckCompatibility.
+type Schema struct {
+	// Restd is the version of the API, e.g. 1.3.0
+	Restd string
+	// Objects are keyed by object type, e.g. network/host
+	Objects map[string]ObjectSchema
+}
+
+// An ObjectSchema contains the attributes of an object type keyed by name
+type ObjectSchema map[string]AttributeSchema
+
+// An AttributeSchema is the swagger type of an attribute and its values if it is an enum.
+// An empty Type is unknown and is not compared.
+type AttributeSchema struct {
+	Type string
+	Enum []string
+}
+
+var (
+	schemasMu sync.RWMutex
+	schemas   = map[string]*Schema{}
+)
+
+// RegisterSchema registers the Schema of a generated package, it is called by the init function of
+// the package
+func RegisterSchema(s *Schema) {
+	schemasMu.Lock()
+	defer schemasMu.Unlock()
+	schemas[s.Restd] = s
+}
+
+// RegisteredSchema returns the registered Schema of the Restd version or, if there is none, of the
+// version returned by ResolveVersion. It returns nil if no Schema of the same major version is registered.
+func RegisteredSchema(restd string) *Schema {
+	schemasMu.RLock()
+	defer schemasMu.RUnlock()
+	versions := make([]string, 0, len(schemas))
+	for v := range schemas {
+		versions = append(versions, v)
+	}
+	v, ok := ResolveVersion(restd, versions)
+	if !ok {
+		return nil
+	}
+	return schemas[v]
+}
+
+// ResolveVersion returns the version of versions used for the Restd version: the version itself or else
+// the closest older version of the same major version or else the oldest newer one. It returns false if
+// no version has the same major version.
+func ResolveVersion(restd string, versions []string) (string, bool) {
+	var older, newer string
+	for _, v := range versions {
+		if v == restd {
+			return v, true
+		}
+		if major(v) != major(restd) {
+			continue
+		}
+		if VersionLess(v, restd) {
+			if older == "" || VersionLess(older, v) {
+				older = v
+			}
+		} else if newer == "" || VersionLess(v, newer) {
+			newer = v
+		}
+	}
+	if older != "" {
+		return older, true
+	}
+	return newer, newer != ""
+}
+
+// major returns the major number of the version, e.g. 1 of 1.3.0
+func major(restd string) string {
+	return strings.SplitN(restd, ".", 2)[0]
+}
+
+// VersionLess returns true if the Restd version a is older than b, e.g. 1.3.0 < 1.10.0
+func VersionLess(a, b string) bool {
+	aa, bb := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aa) && i < len(bb); i++ {
+		x, errX := strconv.Atoi(aa[i])
+		y, errY := strconv.Atoi(bb[i])
+		if errX != nil || errY != nil {
+			if aa[i] != bb[i] {
+				return aa[i] < bb[i]
+			}
+			continue
+		}
+		if x != y {
+			return x < y
+		}
+	}
+	return len(aa) < len(bb)
+}
+
+// SchemaFromSwag returns the ObjectSchemas of the object definitions of the Swag keyed by object type
+func SchemaFromSwag(s Swag) map[string]ObjectSchema {
+	objects := make(map[string]ObjectSchema)
+	for name, def := range s.Definitions {
+		if !strings.Contains(name, ".") {
+			continue
+		}
+		o := make(ObjectSchema, len(def.Properties))
+		for k, p := range def.Properties {
+			o[k] = AttributeSchema{Type: p.Type, Enum: p.Enum}
+		}
+		objects[strings.Replace(name, ".", "/", -1)] = o
+	}
+	return objects
+}
+
+// FetchSchema returns the Schema of the object definitions of the gateway
+func (c Client) FetchSchema(options ...Option) (*Schema, error) {
+	v, err := c.Ping(options...)
+	if err != nil {
+		return nil, fmt.Errorf("schema: %s", err.Error())
+	}
+	r, err := c.Get("/api/definitions", options...)
+	if err != nil {
+		return nil, fmt.Errorf("schema: error retrieving definitions: %s", err.Error())
+	}
+	var dd []Definition
+	if err := r.MarshalTo(&dd); err != nil {
+		return nil, fmt.Errorf("schema: error decoding definitions: %s", err.Error())
+	}
+
+	s := &Schema{Restd: v.Restd, Objects: make(map[string]ObjectSchema)}
+	for _, d := range dd {
+		swag, err := d.GetSwag(c, options...)
+		if err != nil {
+			return nil, fmt.Errorf("schema: error retrieving definition %s: %s", d.Name, err.Error())
+		}
+		for typ, o := range SchemaFromSwag(swag) {
+			s.Objects[typ] = o
+		}
+	}
+	return s, nil
+}
+
+// CheckCompatibility fetches the definitions of the gateway and compares them with the registered
+// Schema of its version, see RegisteredSchema. Use Compatibility.Strict to refuse writes to the
+// object types which have diverged.
+func (c Client) CheckCompatibility(ctx context.Context, options ...Option) (*Compatibility, error) {
+	live, err := c.FetchSchema(append(options, WithContext(ctx))...)
+	if err != nil {
+		return nil, fmt.Errorf("compatibility: %s", err.Error())
+	}
+	s := RegisteredSchema(live.Restd)
+	if s == nil {
+		return nil, fmt.Errorf("compatibility: no schema registered for restd %s, import a generated objects package", live.Restd)
+	}
+	return s.Compare(live), nil
+}
+
+// Compatibility reports the differences between the Schema of a generated package and the
+// definitions of a gateway
+type Compatibility struct {
+	// Generated is the Restd version of the package, Restd the version of the gateway
+	Generated, Restd string
+	// MissingEndpoints are the object types of the package unknown to the gateway
+	MissingEndpoints []string
+	// NewEndpoints are the object types of the gateway unknown to the package
+	NewEndpoints []string
+	// Objects are the object types whose attributes differ, sorted by type
+	Objects []ObjectDrift
+}
+
+// ObjectDrift contains the differences of the attributes of an object type
+type ObjectDrift struct {
+	Type string
+	// Added are the attributes only known to the gateway, Removed the ones only known to the package
+	Added, Removed []string
+	Changed        []AttributeChange
+}
+
+// An AttributeChange is an attribute whose type or enum values differ
+type AttributeChange struct {
+	Attribute string
+	// Type is the type of the package, LiveType the type of the gateway
+	Type, LiveType string
+	// AddedValues are the enum values only known to the gateway, RemovedValues the ones only
+	// known to the package
+	AddedValues, RemovedValues []string
+}
+
+// Compare returns the differences of the live Schema of a gateway
+func (s *Schema) Compare(live *Schema) *Compatibility {
+	c := &Compatibility{Generated: s.Restd, Restd: live.Restd}
+	for typ := range live.Objects {
+		if _, ok := s.Objects[typ]; !ok {
+			c.NewEndpoints = append(c.NewEndpoints, typ)
+		}
+	}
+	for typ, o := range s.Objects {
+		l, ok := live.Objects[typ]
+		if !ok {
+			c.MissingEndpoints = append(c.MissingEndpoints, typ)
+			continue
+		}
+		if d := compareObject(typ, o, l); d != nil {
+			c.Objects = append(c.Objects, *d)
+		}
+	}
+	sort.Strings(c.NewEndpoints)
+	sort.Strings(c.MissingEndpoints)
+	sort.Slice(c.Objects, func(i, j int) bool { return c.Objects[i].Type < c.Objects[j].Type })
+	return c
+}
+
+// compareObject returns the ObjectDrift of the type or nil if the attributes are the same
+func compareObject(typ string, o, live ObjectSchema) *ObjectDrift {
+	d := ObjectDrift{Type: typ}
+	for k := range live {
+		if _, ok := o[k]; !ok {
+			d.Added = append(d.Added, k)
+		}
+	}
+	for k, a := range o {
+		l, ok := live[k]
+		if !ok {
+			d.Removed = append(d.Removed, k)
+			continue
+		}
+		ch := AttributeChange{Attribute: k, Type: a.Type, LiveType: l.Type}
+		ch.AddedValues, ch.RemovedValues = difference(l.Enum, a.Enum), difference(a.Enum, l.Enum)
+		if (a.Type != "" && l.Type != "" && a.Type != l.Type) || len(ch.AddedValues) > 0 || len(ch.RemovedValues) > 0 {
+			d.Changed = append(d.Changed, ch)
+		}
+	}
+	if len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0 {
+		return nil
+	}
+	sort.Strings(d.Added)
+	sort.Strings(d.Removed)
+	sort.Slice(d.Changed, func(i, j int) bool { return d.Changed[i].Attribute < d.Changed[j].Attribute })
+	return &d
+}
+
+// difference returns the values of a which are not in b
+func difference(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, v := range b {
+		in[v] = true
+	}
+	var diff []string
+	for _, v := range a {
+		if !in[v] {
+			diff = append(diff, v)
+		}
+	}
+	return diff
+}
+
+// Compatible returns true if the gateway has the same object definitions as the package
+func (c *Compatibility) Compatible() bool {
+	return len(c.MissingEndpoints) == 0 && len(c.NewEndpoints) == 0 && len(c.Objects) == 0
+}
+
+// Diverged returns true if the object type is unknown to the gateway or its attributes differ
+func (c *Compatibility) Diverged(objType string) bool {
+	for _, typ := range c.MissingEndpoints {
+		if typ == objType {
+			return true
+		}
+	}
+	for _, d := range c.Objects {
+		if d.Type == objType {
+			return true
+		}
+	}
+	return false
+}
+
+// Strict returns an Option which refuses POST, PUT, PATCH and DELETE requests to the objects whose
+// schema has diverged, so that attributes unknown to the package are not reset and objects are not
+// deleted based on a misread state. Set it on New to apply it to every request of the Client.
+func (c *Compatibility) Strict() Option {
+	return func(r *http.Request) error {
+		switch r.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			return nil
+		}
+		i := strings.Index(r.URL.Path, "/api/objects/")
+		if i < 0 {
+			return nil
+		}
+		parts := strings.SplitN(r.URL.Path[i+len("/api/objects/"):], "/", 3)
+		if len(parts) < 2 {
+			return nil
+		}
+		if typ := parts[0] + "/" + parts[1]; c.Diverged(typ) {
+			return fmt.Errorf("compatibility: refusing %s of %s, its schema has diverged from restd %s", r.Method, typ, c.Restd)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,149 @@
+package sophos_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+)
+
+func TestClient_CheckCompatibility(t *testing.T) {
+	live := sophos.Swag{Definitions: map[string]sophos.SwagDefinition{}}
+	for typ, o := range objects.Schema.Objects {
+		d := sophos.SwagDefinition{Type: "object", Properties: map[string]sophos.Property{}}
+		for k, a := range o {
+			d.Properties[k] = sophos.Property{Type: a.Type, Enum: a.Enum}
+		}
+		live.Definitions[strings.Replace(typ, "/", ".", -1)] = d
+	}
+	host := live.Definitions["network.host"]
+	delete(host.Properties, "comment")
+	host.Properties["vendor"] = sophos.Property{Type: "string"}
+	host.Properties["macs"] = sophos.Property{Type: "string"}
+	live.Definitions["snmp.trap"].Properties["version"] = sophos.Property{Type: "string", Enum: []string{"v3", "v4"}}
+	delete(live.Definitions, "network.range")
+	live.Definitions["network.fqdn"] = sophos.SwagDefinition{}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/status/version":
+			json.NewEncoder(w).Encode(sophos.Version{UTM: "9.510-5", Restd: "1.3.0"})
+		case "/api/definitions":
+			json.NewEncoder(w).Encode([]sophos.Definition{{Name: "all", Link: "/api/definitions/all"}})
+		case "/api/definitions/all":
+			json.NewEncoder(w).Encode(live)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	sophos.DefaultHTTPClient = ts.Client()
+	c, _ := sophos.New(ts.URL)
+
+	report, err := c.CheckCompatibility(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &sophos.Compatibility{
+		Generated:        "1.3.0",
+		Restd:            "1.3.0",
+		MissingEndpoints: []string{"network/range"},
+		NewEndpoints:     []string{"network/fqdn"},
+		Objects: []sophos.ObjectDrift{
+			{
+				Type:    "network/host",
+				Added:   []string{"vendor"},
+				Removed: []string{"comment"},
+				Changed: []sophos.AttributeChange{{Attribute: "macs", Type: "array", LiveType: "string"}},
+			},
+			{
+				Type: "snmp/trap",
+				Changed: []sophos.AttributeChange{{
+					Attribute: "version", Type: "string", LiveType: "string",
+					AddedValues: []string{"v4"}, RemovedValues: []string{"v2c"},
+				}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("unexpected report %+v", report)
+	}
+	if report.Compatible() || !report.Diverged("network/range") || report.Diverged("network/dns_host") {
+		t.Error("unexpected divergence")
+	}
+
+	strict := report.Strict()
+	tests := []struct {
+		method, path string
+		refused      bool
+	}{
+		{http.MethodPost, "/api/objects/network/host/", true},
+		{http.MethodPut, "/api/objects/snmp/trap/REF_SnmTraTrap", true},
+		{http.MethodGet, "/api/objects/network/host/", false},
+		{http.MethodDelete, "/api/objects/network/host/REF_NetHosHost", true},
+		{http.MethodDelete, "/api/objects/network/dns_host/REF_NetDnsHost", false},
+		{http.MethodPatch, "/api/objects/network/dns_host/REF_NetDnsHost", false},
+		{http.MethodPatch, "/api/nodes/snmp.trap.status", false},
+	}
+	for _, tt := range tests {
+		_, err := sophos.Request(tt.method, ts.URL+tt.path, nil, strict)
+		if (err != nil) != tt.refused {
+			t.Errorf("%s %s: got %v, want refused %v", tt.method, tt.path, err, tt.refused)
+		}
+	}
+}
+
+// TestClient_CheckCompatibility_Fixtures checks that the generated Schema does not drift from the
+// definitions it was generated from
+func TestClient_CheckCompatibility_Fixtures(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		byt, err := ioutil.ReadFile(filepath.Join("fixtures", filepath.FromSlash(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api"), "/"))+".json"))
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(byt)
+	}))
+	defer ts.Close()
+	sophos.DefaultHTTPClient = ts.Client()
+	c, _ := sophos.New(ts.URL)
+
+	report, err := c.CheckCompatibility(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Compatible() || len(report.Objects) != 0 {
+		t.Errorf("unexpected report %+v", report)
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	versions := []string{"1.2.0", "1.3.0", "1.10.1", "2.0.0"}
+	tests := []struct {
+		restd, want string
+		ok          bool
+	}{
+		{"1.3.0", "1.3.0", true},
+		{"1.9.0", "1.3.0", true},
+		{"1.11.0", "1.10.1", true},
+		{"1.1.0", "1.2.0", true},
+		{"2.1.0", "2.0.0", true},
+		{"3.0.0", "", false},
+	}
+	for _, tt := range tests {
+		if v, ok := sophos.ResolveVersion(tt.restd, versions); v != tt.want || ok != tt.ok {
+			t.Errorf("%s: got %s %v, want %s %v", tt.restd, v, ok, tt.want, tt.ok)
+		}
+	}
+	if sophos.RegisteredSchema("1.9.0") != objects.Schema || sophos.RegisteredSchema("2.0.0") != nil {
+		t.Error("RegisteredSchema should use the version of ResolveVersion")
+	}
+}
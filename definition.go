@@ -1,5 +1,7 @@
 package sophos
 
+import "strings"
+
 // Definition represents a Swagger API endpoint
 // You can use the Swagger API Documents to identify all the different RESTful API end
 // points with descriptions for each object and node.
@@ -15,7 +17,7 @@ type Definition struct {
 }
 
 // GetSwag will use the Client to request its Swag
-func (d *Definition) GetSwag(c *Client, options ...Option) (Swag, error) {
+func (d *Definition) GetSwag(c ClientInterface, options ...Option) (Swag, error) {
 	var swag Swag
 	r, err := c.Get(d.Link, options...)
 	if err != nil {
@@ -35,6 +37,53 @@ func (d *Definition) GetSwag(c *Client, options ...Option) (Swag, error) {
 // erences while REF(network/host) means that only network host objects can be used.
 type Swag struct {
 	Paths map[string]MethodMap
+	// Definitions are the object definitions keyed by class and type, e.g. network.host
+	Definitions map[string]SwagDefinition
+}
+
+// SwagDefinition returns the definition of the object type, e.g. network/host
+func (s Swag) SwagDefinition(objType string) (SwagDefinition, bool) {
+	d, ok := s.Definitions[strings.Replace(objType, "/", ".", -1)]
+	return d, ok
+}
+
+// NodeSchema returns the schema of the value of the node (e.g. ssh.allowed_networks) as declared by
+// the body parameter of its PUT path in the Nodes Swag
+func (s Swag) NodeSchema(node string) (Property, bool) {
+	for _, p := range s.Paths["/nodes/"+node]["put"].Parameters {
+		if p.In == "body" && p.Schema != nil {
+			return *p.Schema, true
+		}
+	}
+	return Property{}, false
+}
+
+// A SwagDefinition describes an object and its properties
+type SwagDefinition struct {
+	Description string
+	Type        string
+	Properties  map[string]Property
+}
+
+// A Property describes an attribute of an object.
+// Reference attributes contain their allowed targets in the Description, see ParseRefConstraints.
+type Property struct {
+	Type        string
+	Description string
+	Enum        []string
+	// Items is the schema of the values of an array, it may contain nested objects
+	Items   map[string]interface{}
+	Default interface{}
+}
+
+// RefConstraints returns the allowed Reference targets of the Property, the targets of an array are
+// described by its Items
+func (p Property) RefConstraints() []RefConstraint {
+	rr := ParseRefConstraints(p.Description)
+	if desc, ok := p.Items["description"].(string); ok {
+		rr = append(rr, ParseRefConstraints(desc)...)
+	}
+	return rr
 }
 
 // MethodMap is a map of Methods -> MethodDescriptions
@@ -56,4 +105,6 @@ type Parameter struct {
 	Description string
 	Type        string
 	Required    bool
+	// Schema describes the body parameter, e.g. the value of a node
+	Schema *Property
 }
@@ -1,6 +1,11 @@
 package sophos_test
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+)
 
 func TestDefinition_Get(t *testing.T) {
 	td := setupTestCase(t)
@@ -17,3 +22,15 @@ func TestDefinition_Get(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestSwag_NestedItems(t *testing.T) {
+	var s sophos.Swag
+	err := json.Unmarshal([]byte(`{"definitions": {"network.host": {"properties": {"macs": {
+		"type": "array", "items": {"type": "object", "properties": {"mac": {"type": "string"}}}}}}}}`), &s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, _ := s.SwagDefinition("network/host"); d.Properties["macs"].Items["type"] != "object" {
+		t.Errorf("unexpected items %v", d.Properties["macs"].Items)
+	}
+}
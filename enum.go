@@ -0,0 +1,91 @@
+package sophos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+// An Enum is a generated enum type (e.g. objects.Packetfilter1to1NatMode), Valid returns false
+// for unknown values
+type Enum interface {
+	Valid() bool
+}
+
+// An UnknownEnumError is returned when strictly decoding an unknown value of an enum type, see StrictEnums
+type UnknownEnumError struct {
+	Type  string
+	Value string
+}
+
+// Error implements error
+func (e *UnknownEnumError) Error() string {
+	return fmt.Sprintf("enum: unknown %s value %q", e.Type, e.Value)
+}
+
+type strictEnumsKey struct{}
+
+// StrictEnums is an Option which makes Response.MarshalTo fail with an UnknownEnumError when an
+// enum type has an unknown value. By default unknown values are kept and reported by the Valid method
+// of the type. Set it on New to apply it to every request of the Client.
+func StrictEnums(r *http.Request) error {
+	*r = *r.WithContext(context.WithValue(r.Context(), strictEnumsKey{}, true))
+	return nil
+}
+
+func strictEnums(ctx context.Context) bool {
+	strict, _ := ctx.Value(strictEnumsKey{}).(bool)
+	return strict
+}
+
+// DecodeStrict decodes the JSON into v and returns an UnknownEnumError if an enum type has an
+// unknown value, see CheckEnums
+func DecodeStrict(b []byte, v interface{}) error {
+	if err := json.Unmarshal(b, v); err != nil {
+		return err
+	}
+	return CheckEnums(v)
+}
+
+// CheckEnums returns an UnknownEnumError for the first unknown value of an enum type contained in v.
+// Empty values, which are also decoded from null, are accepted.
+func CheckEnums(v interface{}) error {
+	return checkEnums(reflect.ValueOf(v))
+}
+
+func checkEnums(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.String:
+		if e, ok := v.Interface().(Enum); ok && v.String() != "" && !e.Valid() {
+			return &UnknownEnumError{Type: v.Type().Name(), Value: v.String()}
+		}
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			return checkEnums(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			if err := checkEnums(v.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := checkEnums(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			if err := checkEnums(v.MapIndex(k)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package sophos_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+)
+
+func TestDecodeStrict(t *testing.T) {
+	var r objects.PacketfilterPacketfilter
+	if err := json.Unmarshal([]byte(`{"action": "drop"}`), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Action != objects.PacketfilterPacketfilterActionDrop || !r.Action.Valid() {
+		t.Errorf("unexpected action %q", r.Action)
+	}
+
+	if err := json.Unmarshal([]byte(`{"action": "bounce"}`), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Action != "bounce" || r.Action.Valid() {
+		t.Errorf("unknown values should be kept and flagged, got %q", r.Action)
+	}
+
+	err := sophos.DecodeStrict([]byte(`{"action": "bounce"}`), &r)
+	if e, ok := err.(*sophos.UnknownEnumError); !ok || e.Type != "PacketfilterPacketfilterAction" || e.Value != "bounce" {
+		t.Errorf("wanted UnknownEnumError, got %v", err)
+	}
+	var rr []objects.PacketfilterPacketfilter
+	if err := sophos.DecodeStrict([]byte(`[{"action": "drop"}, {"action": "bounce"}]`), &rr); err == nil {
+		t.Error("unknown values in slices should fail")
+	}
+	for _, b := range []string{`{"action": ""}`, `{"action": null}`} {
+		if err := sophos.DecodeStrict([]byte(b), &r); err != nil || r.Action != "" {
+			t.Errorf("%s: empty values should be accepted, got %q %v", b, r.Action, err)
+		}
+	}
+	if err := sophos.DecodeStrict([]byte(`{"action": 1}`), &r); err == nil {
+		t.Error("non string values should fail")
+	}
+}
+
+func TestStrictEnums(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"_ref": "REF_PacPacBounce", "action": "bounce"}`))
+	}))
+	defer ts.Close()
+	defer func(c sophos.HTTPClient) { sophos.DefaultHTTPClient = c }(sophos.DefaultHTTPClient)
+	sophos.DefaultHTTPClient = ts.Client()
+
+	c, _ := sophos.New(ts.URL)
+	r := objects.PacketfilterPacketfilter{Reference: "REF_PacPacBounce"}
+	if err := c.GetObject(&r); err != nil || r.Action != "bounce" {
+		t.Errorf("unknown values should be kept, got %q %v", r.Action, err)
+	}
+
+	c, _ = sophos.New(ts.URL, sophos.StrictEnums)
+	err := c.GetObject(&r, sophos.WithContext(context.Background()))
+	if _, ok := err.(*sophos.UnknownEnumError); !ok {
+		t.Errorf("wanted UnknownEnumError, got %v", err)
+	}
+}
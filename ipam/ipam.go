@@ -0,0 +1,292 @@
+// Package ipam finds free addresses within the networks of a snapshot.Snapshot
+package ipam
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/netset"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+// ErrExhausted is returned when a network has no free address left
+var ErrExhausted = errors.New("ipam: no free address")
+
+// An Allocator finds free addresses within networks. Addresses are in use if they are the
+// address of a network/host, within a network/range or DHCP server range, a fixed DHCP mapping
+// or an interface primary, secondary or gateway address. The network and broadcast addresses of
+// IPv4 networks are never free.
+//
+// An Allocator is safe for concurrent use. Reserve serializes allocations, the Snapshot must not
+// be modified concurrently by others.
+type Allocator struct {
+	s   *snapshot.Snapshot
+	mu  sync.Mutex
+	res *netset.Resolver
+	// reserved contains addresses reserved by this Allocator
+	reserved netset.Set
+}
+
+// NewAllocator returns an Allocator of the Snapshot
+func NewAllocator(s *snapshot.Snapshot) *Allocator {
+	return &Allocator{s: s, res: netset.NewResolver(s)}
+}
+
+// itfparams is the subset of attributes of itfparams/primary and itfparams/secondary
+type itfparams struct {
+	Address               string `json:"address"`
+	Netmask               int    `json:"netmask"`
+	Address6              string `json:"address6"`
+	Netmask6              int    `json:"netmask6"`
+	DefaultGatewayAddress string `json:"default_gateway_address"`
+}
+
+// iface is the subset of attributes of interface objects
+type iface struct {
+	PrimaryAddress      string        `json:"primary_address"`
+	AdditionalAddresses []interface{} `json:"additional_addresses"`
+}
+
+// dhcpServer is the subset of attributes of dhcp/server
+type dhcpServer struct {
+	RangeStart string   `json:"range_start"`
+	RangeEnd   string   `json:"range_end"`
+	Mappings   []string `json:"mappings"`
+}
+
+// Network returns the addresses of the network object (e.g. network/network) or of the
+// primary and additional addresses of the interface object (e.g. interface/ethernet)
+func (a *Allocator) Network(ref string) (netset.Set, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.network(ref)
+}
+
+func (a *Allocator) network(ref string) (netset.Set, error) {
+	objType := a.s.Type(ref)
+	if !strings.HasPrefix(objType, "interface/") {
+		set, _, err := a.res.Resolve(ref)
+		return set, err
+	}
+
+	var i iface
+	if err := a.s.Decode(ref, &i); err != nil {
+		return netset.Set{}, err
+	}
+	refs := []string{i.PrimaryAddress}
+	for _, v := range i.AdditionalAddresses {
+		if s, ok := v.(string); ok {
+			refs = append(refs, s)
+		}
+	}
+	var sets []netset.Set
+	for _, r := range refs {
+		var p itfparams
+		if err := a.s.Decode(r, &p); err != nil {
+			continue
+		}
+		for _, n := range []string{
+			fmt.Sprintf("%s/%d", p.Address, p.Netmask),
+			fmt.Sprintf("%s/%d", p.Address6, p.Netmask6),
+		} {
+			if set, err := netset.Parse(n); err == nil {
+				sets = append(sets, set)
+			}
+		}
+	}
+	if len(sets) == 0 {
+		return netset.Set{}, fmt.Errorf("ipam: interface %s has no address", ref)
+	}
+	return netset.Union(sets...), nil
+}
+
+// Used returns the addresses in use within the network
+func (a *Allocator) Used(network netset.Set) netset.Set {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.used(network)
+}
+
+func (a *Allocator) used(network netset.Set) netset.Set {
+	var sets []netset.Set
+	add := func(s netset.Set, err error) {
+		if err == nil {
+			sets = append(sets, s)
+		}
+	}
+
+	for _, ref := range a.s.References("network/host", "network/range") {
+		set, _, err := a.res.Resolve(ref)
+		add(set, err)
+	}
+	for _, ref := range a.s.References("itfparams/primary", "itfparams/secondary") {
+		var p itfparams
+		if err := a.s.Decode(ref, &p); err != nil {
+			continue
+		}
+		for _, addr := range []string{p.Address, p.Address6, p.DefaultGatewayAddress} {
+			add(netset.Parse(addr))
+		}
+	}
+	for _, ref := range a.s.References("dhcp/server") {
+		var d dhcpServer
+		if err := a.s.Decode(ref, &d); err != nil {
+			continue
+		}
+		add(netset.FromRange(net.ParseIP(d.RangeStart), net.ParseIP(d.RangeEnd)))
+		for _, m := range d.Mappings {
+			if sophos.IsReference(m) {
+				set, _, err := a.res.Resolve(m)
+				add(set, err)
+				continue
+			}
+			for _, f := range strings.Fields(m) {
+				add(netset.Parse(f))
+			}
+		}
+	}
+
+	// network and broadcast addresses of IPv4 networks, the subnet-router anycast address of IPv6 networks
+	for _, p := range network.Prefixes() {
+		ones, bits := p.Mask.Size()
+		if bits-ones < 2 {
+			continue
+		}
+		add(netset.FromIP(p.IP))
+		if bits == 32 {
+			last := make(net.IP, len(p.IP))
+			for i := range p.IP {
+				last[i] = p.IP[i] | ^p.Mask[i]
+			}
+			add(netset.FromIP(last))
+		}
+	}
+
+	return netset.Union(sets...).Intersect(network)
+}
+
+// Free returns the free addresses of the network object or interface, see Network
+func (a *Allocator) Free(ref string) (netset.Set, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.free(ref)
+}
+
+func (a *Allocator) free(ref string) (netset.Set, error) {
+	network, err := a.network(ref)
+	if err != nil {
+		return netset.Set{}, err
+	}
+	return network.Subtract(a.used(network)).Subtract(a.reserved), nil
+}
+
+// Next returns the lowest free address of the network, IPv4 addresses are preferred
+func (a *Allocator) Next(ref string) (net.IP, error) {
+	free, err := a.Free(ref)
+	if err != nil {
+		return nil, err
+	}
+	return first(free)
+}
+
+func first(free netset.Set) (net.IP, error) {
+	rr := free.Ranges()
+	if len(rr) == 0 {
+		return nil, ErrExhausted
+	}
+	return rr[0].From, nil
+}
+
+// NextBlock returns the lowest free block of the prefix length within the network
+func (a *Allocator) NextBlock(ref string, prefixLen int) (*net.IPNet, error) {
+	free, err := a.Free(ref)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range free.Prefixes() {
+		ones, bits := p.Mask.Size()
+		if ones <= prefixLen && prefixLen <= bits {
+			return &net.IPNet{IP: p.IP, Mask: net.CIDRMask(prefixLen, bits)}, nil
+		}
+	}
+	return nil, ErrExhausted
+}
+
+// Reserve assigns the lowest free address of the network to the host and creates it.
+//
+// Reservations of the Allocator are serialized: the network/host objects of the UTM are requested
+// to detect hosts created since the Snapshot was taken and the created host is added to the Snapshot,
+// so concurrent calls of this process never assign the same address. Hosts created at the same time
+// by other clients of the UTM are not locked out.
+//
+// IPv4 addresses are assigned to the address attribute, IPv6 addresses to address6.
+func (a *Allocator) Reserve(c sophos.ClientInterface, network string, host *objects.NetworkHost, options ...sophos.Option) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	free, err := a.free(network)
+	if err != nil {
+		return err
+	}
+
+	var current objects.NetworkHosts
+	res, err := c.Get(current.GetPath(), options...)
+	if err != nil {
+		return fmt.Errorf("ipam: error retrieving hosts: %s", err.Error())
+	}
+	if err := res.MarshalTo(&current); err != nil {
+		return err
+	}
+	for _, h := range current {
+		for _, addr := range []string{h.Address, h.Address6} {
+			if set, err := netset.Parse(addr); err == nil {
+				free = free.Subtract(set)
+			}
+		}
+	}
+
+	ip, err := first(free)
+	if err != nil {
+		return err
+	}
+	if ip.To4() != nil {
+		host.Address = ip.String()
+	} else {
+		host.Address6 = ip.String()
+	}
+	host.ObjectType = "network/host"
+
+	byt, err := json.Marshal(host)
+	if err != nil {
+		return err
+	}
+	res, err = c.Post(host.PostPath(), bytes.NewReader(byt), append(options[:len(options):len(options)], sophos.WithSessionClose)...)
+	if err != nil {
+		return fmt.Errorf("ipam: error creating host %q with address %s: %s", host.Name, ip, err.Error())
+	}
+	if res.StatusCode != http.StatusCreated {
+		return fmt.Errorf("ipam: host %q with address %s was not created: %s", host.Name, ip, res.Status)
+	}
+	if err := res.MarshalTo(host); err != nil {
+		return err
+	}
+
+	a.reserved = a.reserved.Union(mustIP(ip))
+	if host.Reference != "" {
+		return a.s.Add(host)
+	}
+	return nil
+}
+
+func mustIP(ip net.IP) netset.Set {
+	s, _ := netset.FromIP(ip)
+	return s
+}
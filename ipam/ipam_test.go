@@ -0,0 +1,142 @@
+package ipam_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/ipam"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+func testSnapshot(t *testing.T) *snapshot.Snapshot {
+	s := snapshot.New()
+	for _, o := range []interface{}{
+		objects.NetworkNetwork{Reference: "REF_NetNetLan", ObjectType: "network/network", Name: "lan", Address: "10.0.0.0", Netmask: 28},
+		objects.NetworkHost{Reference: "REF_NetHosA", ObjectType: "network/host", Name: "a", Address: "10.0.0.2"},
+		objects.NetworkRange{Reference: "REF_NetRanB", ObjectType: "network/range", Name: "b", From: "10.0.0.4", To: "10.0.0.5"},
+		objects.DhcpServer{Reference: "REF_DhcSerLan", ObjectType: "dhcp/server", Name: "lan", RangeStart: "10.0.0.8", RangeEnd: "10.0.0.11", Mappings: []string{"10.0.0.12"}},
+		objects.ItfparamsPrimary{Reference: "REF_ItfPriEth0", ObjectType: "itfparams/primary", Name: "eth0", Address: "10.0.0.1", Netmask: 28},
+		objects.InterfaceEthernet{Reference: "REF_IntEthEth0", ObjectType: "interface/ethernet", Name: "eth0", PrimaryAddress: "REF_ItfPriEth0"},
+	} {
+		if err := s.Add(o); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s
+}
+
+func TestAllocator(t *testing.T) {
+	a := ipam.NewAllocator(testSnapshot(t))
+
+	for _, ref := range []string{"REF_NetNetLan", "REF_IntEthEth0"} {
+		free, err := a.Free(ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := free.String(); got != "10.0.0.3/32, 10.0.0.6/31, 10.0.0.13/32, 10.0.0.14/32" {
+			t.Errorf("%s: unexpected free addresses %s", ref, got)
+		}
+	}
+
+	if ip, err := a.Next("REF_NetNetLan"); err != nil || ip.String() != "10.0.0.3" {
+		t.Errorf("wanted 10.0.0.3, got %s %v", ip, err)
+	}
+	if n, err := a.NextBlock("REF_NetNetLan", 31); err != nil || n.String() != "10.0.0.6/31" {
+		t.Errorf("wanted 10.0.0.6/31, got %s %v", n, err)
+	}
+	if _, err := a.NextBlock("REF_NetNetLan", 30); err != ipam.ErrExhausted {
+		t.Errorf("wanted ErrExhausted, got %v", err)
+	}
+}
+
+func TestReserve(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		created []string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			// 10.0.0.3 was created after the snapshot was taken
+			json.NewEncoder(w).Encode([]objects.NetworkHost{{Reference: "REF_NetHosNew", Address: "10.0.0.3"}})
+		case http.MethodPost:
+			var h objects.NetworkHost
+			json.NewDecoder(r.Body).Decode(&h)
+			mu.Lock()
+			created = append(created, h.Address)
+			h.Reference = "REF_NetHos" + h.Name
+			mu.Unlock()
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(h)
+		}
+	}))
+	defer ts.Close()
+	defer func(c sophos.HTTPClient) { sophos.DefaultHTTPClient = c }(sophos.DefaultHTTPClient)
+	sophos.DefaultHTTPClient = ts.Client()
+	client, err := sophos.New(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := testSnapshot(t)
+	a := ipam.NewAllocator(s)
+	var wg sync.WaitGroup
+	for _, name := range []string{"x", "y"} {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			if err := a.Reserve(client, "REF_NetNetLan", &objects.NetworkHost{Name: name}); err != nil {
+				t.Error(err)
+			}
+		}(name)
+	}
+	wg.Wait()
+
+	if len(created) != 2 || created[0] == created[1] || created[0] == "10.0.0.3" || created[1] == "10.0.0.3" {
+		t.Errorf("unexpected reservations %v", created)
+	}
+	if !s.Has("REF_NetHosx") || !s.Has("REF_NetHosy") {
+		t.Error("created hosts should have been added to the snapshot")
+	}
+}
+
+func TestReserve_IPv6(t *testing.T) {
+	status := http.StatusCreated
+	var created objects.NetworkHost
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			json.NewEncoder(w).Encode([]objects.NetworkHost{{Reference: "REF_NetHosNew", Address6: "2001:db8::1"}})
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&created)
+		created.Reference = "REF_NetHosV6"
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(created)
+	}))
+	defer ts.Close()
+	defer func(c sophos.HTTPClient) { sophos.DefaultHTTPClient = c }(sophos.DefaultHTTPClient)
+	sophos.DefaultHTTPClient = ts.Client()
+	client, _ := sophos.New(ts.URL)
+
+	s := snapshot.New()
+	s.Add(objects.NetworkNetwork{Reference: "REF_NetNetV6", ObjectType: "network/network", Name: "v6", Address6: "2001:db8::", Netmask6: 126})
+	a := ipam.NewAllocator(s)
+	if err := a.Reserve(client, "REF_NetNetV6", &objects.NetworkHost{Name: "v6"}); err != nil {
+		t.Fatal(err)
+	}
+	if created.Address != "" || created.Address6 != "2001:db8::2" || !s.Has("REF_NetHosV6") {
+		t.Errorf("unexpected host %+v", created)
+	}
+
+	status = http.StatusOK
+	if err := a.Reserve(client, "REF_NetNetV6", &objects.NetworkHost{Name: "v6b"}); err == nil {
+		t.Error("wanted error for a host which was not created")
+	}
+}
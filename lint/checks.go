@@ -0,0 +1,223 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/cleanup"
+	"github.com/esurdam/go-sophos/policy"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+// IDs of the built-in Checks
+const (
+	CheckAnyAnyAllow     = "any-any-allow"
+	CheckRuleLogging     = "rule-logging"
+	CheckEmptyComment    = "empty-comment"
+	CheckNaming          = "naming"
+	CheckDisabledRule    = "disabled-rule"
+	CheckHostReverseDNS  = "host-reverse-dns"
+	CheckPolicyConflicts = "policy-conflicts"
+)
+
+// commentTypes are the object types whose comments are checked
+var commentTypes = []string{"network/*", "service/*", "time/*", "packetfilter/*"}
+
+func init() {
+	for _, c := range []Check{
+		{ID: CheckAnyAnyAllow, Severity: Error, Run: anyAnyAllow,
+			Description: "enabled packet filter rules accepting traffic from any to any address"},
+		{ID: CheckRuleLogging, Severity: Warning, Run: ruleLogging,
+			Description: "enabled packet filter rules without logging"},
+		{ID: CheckEmptyComment, Severity: Info, Run: emptyComment,
+			Description: "network, service, time and packet filter objects without comment"},
+		{ID: CheckNaming, Severity: Warning, Run: naming,
+			Description: "objects whose names do not match the pattern of their type, see Config.Names"},
+		{ID: CheckDisabledRule, Severity: Warning, Run: disabledRule,
+			Description: "packet filter rules disabled for longer than Config.DisabledDays, see Config.Baseline"},
+		{ID: CheckHostReverseDNS, Severity: Info, Run: hostReverseDNS,
+			Description: "network/host objects without reverse DNS lookup"},
+		{ID: CheckPolicyConflicts, Severity: Warning, Run: policyConflicts,
+			Description: "shadowed, redundant and conflicting packet filter rules and rules using missing objects"},
+	} {
+		MustRegister(c)
+	}
+}
+
+// finding returns a Finding of the object
+func finding(s *snapshot.Snapshot, sev Severity, ref, format string, args ...interface{}) Finding {
+	return Finding{Severity: sev, Reference: ref, Type: s.Type(ref), Name: s.Name(ref), Message: fmt.Sprintf(format, args...)}
+}
+
+// rules returns the packet filter rules of packetfilter.rules in order
+func rules(s *snapshot.Snapshot) ([]objects.PacketfilterPacketfilter, error) {
+	var refs []string
+	if !s.HasNode(policy.RulesNode) {
+		return nil, nil
+	}
+	if err := s.Node(policy.RulesNode, &refs); err != nil {
+		return nil, err
+	}
+	var rr []objects.PacketfilterPacketfilter
+	for _, ref := range refs {
+		var r objects.PacketfilterPacketfilter
+		if err := s.Decode(ref, &r); err != nil {
+			continue
+		}
+		r.Reference = ref
+		rr = append(rr, r)
+	}
+	return rr, nil
+}
+
+func isAny(s *snapshot.Snapshot, refs []string, any, anyType string) bool {
+	for _, ref := range refs {
+		if ref == any || s.Type(ref) == anyType {
+			return true
+		}
+	}
+	return false
+}
+
+func anyAnyAllow(s *snapshot.Snapshot, _ *Config) ([]Finding, error) {
+	rr, err := rules(s)
+	if err != nil {
+		return nil, err
+	}
+	var ff []Finding
+	for _, r := range rr {
+		if !r.Status || r.Action != policy.ActionAccept ||
+			!isAny(s, r.Sources, sophos.RefNetworkAny, "network/any") || !isAny(s, r.Destinations, sophos.RefNetworkAny, "network/any") {
+			continue
+		}
+		msg := "accepts traffic from any to any address"
+		if isAny(s, r.Services, sophos.RefServiceAny, "service/any") {
+			msg = "accepts any traffic from any to any address"
+		}
+		ff = append(ff, finding(s, Error, r.Reference, "%s", msg))
+	}
+	return ff, nil
+}
+
+func ruleLogging(s *snapshot.Snapshot, _ *Config) ([]Finding, error) {
+	rr, err := rules(s)
+	if err != nil {
+		return nil, err
+	}
+	var ff []Finding
+	for _, r := range rr {
+		if r.Status && !r.Log {
+			ff = append(ff, finding(s, Warning, r.Reference, "%s rule does not log", r.Action))
+		}
+	}
+	return ff, nil
+}
+
+func emptyComment(s *snapshot.Snapshot, _ *Config) ([]Finding, error) {
+	var ff []Finding
+	for _, ref := range s.References(commentTypes...) {
+		if cleanup.IsBuiltin(ref, s.Locked(ref)) {
+			continue
+		}
+		var o struct {
+			Comment string `json:"comment"`
+		}
+		if err := s.Decode(ref, &o); err != nil {
+			return nil, err
+		}
+		if o.Comment == "" {
+			ff = append(ff, finding(s, Info, ref, "comment is empty"))
+		}
+	}
+	return ff, nil
+}
+
+func naming(s *snapshot.Snapshot, cfg *Config) ([]Finding, error) {
+	types := make([]string, 0, len(cfg.Names))
+	for t := range cfg.Names {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	var ff []Finding
+	for _, t := range types {
+		re, err := regexp.Compile(cfg.Names[t])
+		if err != nil {
+			return nil, err
+		}
+		for _, ref := range s.References(t) {
+			if cleanup.IsBuiltin(ref, s.Locked(ref)) {
+				continue
+			}
+			if !re.MatchString(s.Name(ref)) {
+				ff = append(ff, finding(s, Warning, ref, "name does not match %s", re))
+			}
+		}
+	}
+	return ff, nil
+}
+
+func disabledRule(s *snapshot.Snapshot, cfg *Config) ([]Finding, error) {
+	if cfg.Baseline == nil || cfg.BaselineTime.IsZero() {
+		return nil, nil
+	}
+	days := cfg.DisabledDays
+	if days <= 0 {
+		days = 30
+	}
+	age := int(cfg.now().Sub(cfg.BaselineTime).Hours() / 24)
+	if age < days {
+		return nil, nil
+	}
+
+	rr, err := rules(s)
+	if err != nil {
+		return nil, err
+	}
+	var ff []Finding
+	for _, r := range rr {
+		var before objects.PacketfilterPacketfilter
+		if r.Status || cfg.Baseline.Decode(r.Reference, &before) != nil || before.Status {
+			continue
+		}
+		ff = append(ff, finding(s, Warning, r.Reference, "disabled since at least %s (%d days)", cfg.BaselineTime.Format("2006-01-02"), age))
+	}
+	return ff, nil
+}
+
+func hostReverseDNS(s *snapshot.Snapshot, _ *Config) ([]Finding, error) {
+	var ff []Finding
+	for _, ref := range s.References("network/host") {
+		var h objects.NetworkHost
+		if err := s.Decode(ref, &h); err != nil {
+			return nil, err
+		}
+		if !h.ReverseDNS && !cleanup.IsBuiltin(ref, s.Locked(ref)) {
+			ff = append(ff, finding(s, Info, ref, "reverse DNS lookup is disabled"))
+		}
+	}
+	return ff, nil
+}
+
+func policyConflicts(s *snapshot.Snapshot, _ *Config) ([]Finding, error) {
+	report, err := policy.Analyze(s)
+	if err != nil {
+		return nil, err
+	}
+	var ff []Finding
+	for _, f := range report.Findings {
+		sev := Warning
+		if f.Kind == policy.MissingReference {
+			sev = Error
+		}
+		if f.Kind == policy.Inexact {
+			sev = Info
+		}
+		ff = append(ff, Finding{Severity: sev, Reference: f.Rule.Reference, Type: "packetfilter/packetfilter", Name: f.Rule.Name,
+			Message: fmt.Sprintf("%s: %s", f.Kind, f.Message)})
+	}
+	return ff, nil
+}
@@ -0,0 +1,316 @@
+// Package lint runs configurable checks over a snapshot.Snapshot or a live UTM
+package lint
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+// Severity of a Finding
+type Severity int
+
+// Severities in ascending order
+const (
+	Info Severity = iota
+	Warning
+	Error
+)
+
+var severityNames = []string{"info", "warning", "error"}
+
+func (s Severity) String() string {
+	if s < Info || s > Error {
+		return fmt.Sprintf("severity(%d)", int(s))
+	}
+	return severityNames[s]
+}
+
+// ParseSeverity returns the Severity of its name, e.g. warning
+func ParseSeverity(name string) (Severity, error) {
+	for i, n := range severityNames {
+		if strings.EqualFold(n, name) {
+			return Severity(i), nil
+		}
+	}
+	return Info, fmt.Errorf("lint: unknown severity %q", name)
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (s Severity) MarshalText() ([]byte, error) { return []byte(s.String()), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (s *Severity) UnmarshalText(b []byte) (err error) {
+	*s, err = ParseSeverity(string(b))
+	return
+}
+
+// A Finding is an issue of an object or node found by a Check
+type Finding struct {
+	Check     string   `json:"check"`
+	Severity  Severity `json:"severity"`
+	Reference string   `json:"reference,omitempty"`
+	Type      string   `json:"type,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Message   string   `json:"message"`
+}
+
+// String returns the Finding as a single line
+func (f Finding) String() string {
+	subject := f.Name
+	if f.Type != "" {
+		subject = fmt.Sprintf("%s %q", f.Type, f.Name)
+	}
+	return fmt.Sprintf("%s [%s] %s: %s", f.Severity, f.Check, subject, f.Message)
+}
+
+// A Check inspects a Snapshot. Run sets the Severity of each Finding, Check.Severity is the
+// highest Severity of its Findings. Run does not need to set Finding.Check.
+type Check struct {
+	ID          string
+	Description string
+	Severity    Severity
+	Run         func(s *snapshot.Snapshot, cfg *Config) ([]Finding, error)
+}
+
+// Config configures which Checks run and their parameters
+type Config struct {
+	// Enabled contains the IDs of the Checks to run, all registered Checks run if empty
+	Enabled []string `json:"enabled,omitempty"`
+	// Disabled contains the IDs of Checks not to run
+	Disabled []string `json:"disabled,omitempty"`
+	// Severities overrides the Severity of Checks by ID
+	Severities map[string]Severity `json:"severities,omitempty"`
+
+	// Names maps object types (e.g. network/* or packetfilter/packetfilter) to the pattern their names must match
+	Names map[string]string `json:"names,omitempty"`
+	// DisabledDays is the number of days after which disabled rules are reported, 30 by default
+	DisabledDays int `json:"disabled_days,omitempty"`
+	// Baseline is an earlier Snapshot taken at BaselineTime. Rules disabled in both Snapshots have been
+	// disabled at least since BaselineTime, confd does not record when objects change.
+	Baseline     *snapshot.Snapshot `json:"-"`
+	BaselineTime time.Time          `json:"-"`
+	// Now is the time of the Snapshot, the current time by default
+	Now time.Time `json:"-"`
+}
+
+func (cfg *Config) now() time.Time {
+	if cfg.Now.IsZero() {
+		return time.Now()
+	}
+	return cfg.Now
+}
+
+// enabled returns true if the Check should run
+func (cfg *Config) enabled(id string) bool {
+	for _, d := range cfg.Disabled {
+		if d == id {
+			return false
+		}
+	}
+	if len(cfg.Enabled) == 0 {
+		return true
+	}
+	for _, e := range cfg.Enabled {
+		if e == id {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	mu       sync.RWMutex
+	registry = map[string]Check{}
+)
+
+// Register registers a Check, its ID must be unique
+func Register(c Check) error {
+	if c.ID == "" || c.Run == nil {
+		return fmt.Errorf("lint: checks require an ID and a Run func")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := registry[c.ID]; ok {
+		return fmt.Errorf("lint: check %s is already registered", c.ID)
+	}
+	registry[c.ID] = c
+	return nil
+}
+
+// MustRegister is like Register but panics on errors
+func MustRegister(c Check) {
+	if err := Register(c); err != nil {
+		panic(err)
+	}
+}
+
+// Checks returns the registered Checks sorted by ID
+func Checks() []Check {
+	mu.RLock()
+	defer mu.RUnlock()
+	cc := make([]Check, 0, len(registry))
+	for _, c := range registry {
+		cc = append(cc, c)
+	}
+	sort.Slice(cc, func(i, j int) bool { return cc[i].ID < cc[j].ID })
+	return cc
+}
+
+// Report is the result of Run
+type Report struct {
+	// Checks contains the IDs of the Checks which ran
+	Checks   []string  `json:"checks"`
+	Findings []Finding `json:"findings"`
+}
+
+// Run runs the enabled Checks over the Snapshot. Findings are sorted by severity (descending), check and name.
+func Run(s *snapshot.Snapshot, cfg Config) (*Report, error) {
+	for id := range cfg.Names {
+		if _, err := regexp.Compile(cfg.Names[id]); err != nil {
+			return nil, fmt.Errorf("lint: invalid name pattern of %s: %s", id, err.Error())
+		}
+	}
+	for _, id := range cfg.Enabled {
+		mu.RLock()
+		_, ok := registry[id]
+		mu.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("lint: unknown check %s", id)
+		}
+	}
+
+	r := &Report{}
+	for _, c := range Checks() {
+		if !cfg.enabled(c.ID) {
+			continue
+		}
+		ff, err := c.Run(s, &cfg)
+		if err != nil {
+			return nil, fmt.Errorf("lint: check %s failed: %s", c.ID, err.Error())
+		}
+		sev, ok := cfg.Severities[c.ID]
+		for _, f := range ff {
+			f.Check = c.ID
+			if ok {
+				f.Severity = sev
+			}
+			r.Findings = append(r.Findings, f)
+		}
+		r.Checks = append(r.Checks, c.ID)
+	}
+	sort.SliceStable(r.Findings, func(i, j int) bool {
+		a, b := r.Findings[i], r.Findings[j]
+		if a.Severity != b.Severity {
+			return a.Severity > b.Severity
+		}
+		if a.Check != b.Check {
+			return a.Check < b.Check
+		}
+		return a.Name < b.Name
+	})
+	return r, nil
+}
+
+// RunLive captures a Snapshot of the Endpoints (all by default) and runs the Checks over it
+func RunLive(c sophos.ClientInterface, cfg Config, endpoints ...sophos.Endpoint) (*Report, error) {
+	s, err := snapshot.Capture(c, endpoints...)
+	if err != nil {
+		return nil, err
+	}
+	return Run(s, cfg)
+}
+
+// Failed returns true if the Report contains Findings of at least the Severity
+func (r *Report) Failed(min Severity) bool {
+	for _, f := range r.Findings {
+		if f.Severity >= min {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteText writes the Findings as a text table
+func (r *Report) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "SEVERITY\tCHECK\tTYPE\tNAME\tMESSAGE")
+	for _, f := range r.Findings {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", f.Severity, f.Check, f.Type, f.Name, f.Message)
+	}
+	fmt.Fprintf(tw, "\n%d findings of %d checks\n", len(r.Findings), len(r.Checks))
+	return tw.Flush()
+}
+
+// WriteJSON writes the Report as JSON
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+type junitSuite struct {
+	XMLName  xml.Name    `xml:"testsuite"`
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the Report as JUnit XML for CI systems. Every Finding is a test case which fails
+// if its Severity is at least min, Checks without Findings are passing test cases.
+func (r *Report) WriteJUnit(w io.Writer, min Severity) error {
+	suite := junitSuite{Name: "sophos-lint"}
+	found := map[string]bool{}
+	for _, f := range r.Findings {
+		found[f.Check] = true
+		c := junitCase{ClassName: "lint." + f.Check, Name: strings.TrimSpace(f.Type + " " + f.Name)}
+		if f.Severity >= min {
+			c.Failure = &junitFailure{Message: f.Message, Type: f.Severity.String(), Text: f.String()}
+			suite.Failures++
+		} else {
+			c.SystemOut = f.String()
+		}
+		suite.Cases = append(suite.Cases, c)
+	}
+	for _, id := range r.Checks {
+		if !found[id] {
+			suite.Cases = append(suite.Cases, junitCase{ClassName: "lint." + id, Name: id})
+		}
+	}
+	suite.Tests = len(suite.Cases)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suite); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
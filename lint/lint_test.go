@@ -0,0 +1,125 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/lint"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+func testSnapshot(t *testing.T, disabled bool) *snapshot.Snapshot {
+	s := snapshot.New()
+	for _, o := range []interface{}{
+		objects.NetworkHost{Reference: "REF_NetHosWeb", ObjectType: "network/host", Name: "web", Address: "10.0.1.1", Comment: "web server", ReverseDNS: true},
+		objects.NetworkHost{Reference: "REF_NetHosDb", ObjectType: "network/host", Name: "DB", Address: "10.0.1.2"},
+		objects.PacketfilterPacketfilter{Reference: "REF_PacPacAll", ObjectType: "packetfilter/packetfilter", Name: "all", Comment: "temporary",
+			Action: "accept", Status: true, Sources: []string{sophos.RefNetworkAny}, Destinations: []string{sophos.RefNetworkAny}, Services: []string{sophos.RefServiceAny}},
+		objects.PacketfilterPacketfilter{Reference: "REF_PacPacOld", ObjectType: "packetfilter/packetfilter", Name: "old", Comment: "legacy",
+			Action: "accept", Status: !disabled, Log: true, Sources: []string{"REF_NetHosDb"}, Destinations: []string{"REF_NetHosWeb"}, Services: []string{sophos.RefServiceAny}},
+	} {
+		if err := s.Add(o); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.SetNode("packetfilter.rules", []string{"REF_PacPacAll", "REF_PacPacOld"})
+	return s
+}
+
+func TestRun(t *testing.T) {
+	now := time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+	cfg := lint.Config{
+		Disabled:     []string{lint.CheckPolicyConflicts},
+		Names:        map[string]string{"network/*": "^[a-z]+$"},
+		Baseline:     testSnapshot(t, true),
+		BaselineTime: now.AddDate(0, 0, -45),
+		Now:          now,
+	}
+	r, err := lint.Run(testSnapshot(t, true), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, f := range r.Findings {
+		got = append(got, f.Severity.String()+" "+f.Check+" "+f.Name)
+	}
+	want := []string{
+		"error any-any-allow all",
+		"warning disabled-rule old",
+		"warning naming DB",
+		"warning rule-logging all",
+		"info empty-comment DB",
+		"info host-reverse-dns DB",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected findings\n%s", strings.Join(got, "\n"))
+	}
+	if !r.Failed(lint.Error) || len(r.Checks) != 6 {
+		t.Errorf("unexpected report %+v", r)
+	}
+
+	cfg.Enabled, cfg.Severities = []string{lint.CheckAnyAnyAllow}, map[string]lint.Severity{lint.CheckAnyAnyAllow: lint.Warning}
+	if r, err = lint.Run(testSnapshot(t, false), cfg); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Findings) != 1 || r.Failed(lint.Error) {
+		t.Errorf("severities should have been overridden: %+v", r)
+	}
+
+	cfg.Enabled = []string{"unknown"}
+	if _, err := lint.Run(testSnapshot(t, false), cfg); err == nil {
+		t.Error("unknown checks should fail")
+	}
+}
+
+func TestRegister(t *testing.T) {
+	err := lint.Register(lint.Check{ID: "test-hosts", Severity: lint.Error, Run: func(s *snapshot.Snapshot, _ *lint.Config) ([]lint.Finding, error) {
+		return []lint.Finding{{Severity: lint.Error, Message: "count", Name: strconv.Itoa(len(s.References("network/host")))}}, nil
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := lint.Register(lint.Check{ID: "test-hosts", Run: nil}); err == nil {
+		t.Error("incomplete checks should fail")
+	}
+
+	r, err := lint.Run(testSnapshot(t, false), lint.Config{Enabled: []string{"test-hosts", lint.CheckRuleLogging}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Findings) != 2 || r.Findings[0].Check != "test-hosts" || r.Findings[0].Name != "2" {
+		t.Errorf("unexpected findings %+v", r.Findings)
+	}
+
+	var buf bytes.Buffer
+	if err := r.WriteJUnit(&buf, lint.Error); err != nil {
+		t.Fatal(err)
+	}
+	var suite struct {
+		Tests    int `xml:"tests,attr"`
+		Failures int `xml:"failures,attr"`
+		Cases    []struct {
+			Name    string    `xml:"name,attr"`
+			Failure *struct{} `xml:"failure"`
+		} `xml:"testcase"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &suite); err != nil {
+		t.Fatal(err)
+	}
+	if suite.Tests != 2 || suite.Failures != 1 || suite.Cases[0].Failure == nil || suite.Cases[1].Failure != nil {
+		t.Errorf("unexpected JUnit report\n%s", buf.String())
+	}
+
+	buf.Reset()
+	if err := r.WriteJSON(&buf); err != nil || !strings.Contains(buf.String(), `"severity": "error"`) {
+		t.Errorf("unexpected JSON %s %v", buf.String(), err)
+	}
+}
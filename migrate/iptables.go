@@ -0,0 +1,215 @@
+package migrate
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/esurdam/go-sophos/svcset"
+)
+
+// ParseIptablesSave reads the output of iptables-save. Rules of the filter and nat tables are
+// returned, LOG rules are merged into the following rule with the same conditions.
+// Options which can not be imported, e.g. negations, are listed in Rule.Unsupported.
+func ParseIptablesSave(r io.Reader) (*Ruleset, error) {
+	rs := &Ruleset{Policies: map[string]string{}}
+	var table string
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || line == "COMMIT":
+		case strings.HasPrefix(line, "*"):
+			table = line[1:]
+		case strings.HasPrefix(line, ":"):
+			f := strings.Fields(line[1:])
+			if len(f) >= 2 && f[1] != "-" {
+				rs.Policies[table+"/"+f[0]] = f[1]
+			}
+		case strings.HasPrefix(line, "-A "):
+			if table != TableFilter && table != TableNAT {
+				continue
+			}
+			args, err := splitArgs(line)
+			if err != nil {
+				return nil, fmt.Errorf("migrate: line %d: %s", n, err.Error())
+			}
+			rule, err := parseIptablesRule(args)
+			if err != nil {
+				return nil, fmt.Errorf("migrate: line %d: %s", n, err.Error())
+			}
+			rule.Line, rule.Table = n, table
+			rs.Rules = append(rs.Rules, rule)
+		default:
+			return nil, fmt.Errorf("migrate: line %d: unexpected %q", n, line)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	rs.Rules = mergeLog(rs.Rules)
+	return rs, nil
+}
+
+// splitArgs splits the line into arguments, double quoted arguments may contain spaces
+func splitArgs(line string) ([]string, error) {
+	var (
+		args   []string
+		b      strings.Builder
+		quoted bool
+		inArg  bool
+	)
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\' && quoted && i+1 < len(line):
+			i++
+			b.WriteByte(line[i])
+		case c == '"':
+			quoted, inArg = !quoted, true
+		case (c == ' ' || c == '\t') && !quoted:
+			if inArg {
+				args = append(args, b.String())
+				b.Reset()
+				inArg = false
+			}
+		default:
+			b.WriteByte(c)
+			inArg = true
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+	if inArg {
+		args = append(args, b.String())
+	}
+	return args, nil
+}
+
+// parseIptablesRule parses the arguments of an -A line
+func parseIptablesRule(args []string) (Rule, error) {
+	var r Rule
+	for i := 0; i < len(args); i++ {
+		opt := args[i]
+		if opt == "!" {
+			r.Unsupported = append(r.Unsupported, "negated "+strings.Join(args[i+1:min2(i+3, len(args))], " "))
+			i += 2
+			continue
+		}
+		// modules and options without value
+		switch opt {
+		case "-m", "--match":
+			i++
+			continue
+		case "--log-tcp-sequence", "--log-tcp-options", "--log-ip-options", "--log-uid":
+			continue
+		case "--syn", "-f", "--fragment":
+			r.Unsupported = append(r.Unsupported, opt)
+			continue
+		}
+		if i+1 >= len(args) {
+			return r, fmt.Errorf("option %s has no value", opt)
+		}
+		i++
+		v := args[i]
+		var err error
+		switch opt {
+		case "-A", "--append":
+			r.Chain = v
+		case "-s", "--source":
+			r.Sources = append(r.Sources, strings.Split(v, ",")...)
+		case "-d", "--destination":
+			r.Destinations = append(r.Destinations, strings.Split(v, ",")...)
+		case "--src-range":
+			r.Sources = append(r.Sources, v)
+		case "--dst-range":
+			r.Destinations = append(r.Destinations, v)
+		case "-p", "--protocol":
+			r.Protocol = strings.ToLower(v)
+		case "-i", "--in-interface":
+			r.InInterface = v
+		case "-o", "--out-interface":
+			r.OutInterface = v
+		case "--dport", "--destination-port", "--dports", "--destination-ports":
+			r.DstPorts, err = parsePorts(v, ":")
+		case "--sport", "--source-port", "--sports", "--source-ports":
+			r.SrcPorts, err = parsePorts(v, ":")
+		case "--icmp-type":
+			r.DstPorts, r.SrcPorts, err = parseICMPType(v)
+		case "--ctstate", "--state":
+			r.State = v
+		case "--comment":
+			r.Comment = v
+		case "-j", "--jump":
+			r.Target = v
+		case "--to-destination", "--to-source":
+			r.To = v
+		case "--reject-with", "--log-prefix", "--log-level":
+			// informational only
+		default:
+			r.Unsupported = append(r.Unsupported, opt+" "+v)
+		}
+		if err != nil {
+			return r, fmt.Errorf("%s %s: %s", opt, v, err.Error())
+		}
+	}
+	if r.Chain == "" {
+		return r, fmt.Errorf("rule has no chain")
+	}
+	return r, nil
+}
+
+func min2(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// parsePorts parses comma separated ports and port ranges, e.g. 80,443,8000:8080
+func parsePorts(v, sep string) ([]svcset.Range, error) {
+	var rr []svcset.Range
+	for _, p := range strings.Split(v, ",") {
+		lo, hi := p, p
+		if i := strings.Index(p, sep); i >= 0 {
+			lo, hi = p[:i], p[i+len(sep):]
+		}
+		l, err := strconv.Atoi(lo)
+		if err != nil {
+			return nil, err
+		}
+		h, err := strconv.Atoi(hi)
+		if err != nil {
+			return nil, err
+		}
+		rr = append(rr, svcset.Range{Low: l, High: h})
+	}
+	return rr, nil
+}
+
+// parseICMPType parses an ICMP type name or type[/code]
+func parseICMPType(v string) (types, codes []svcset.Range, err error) {
+	if v == "any" {
+		return nil, nil, nil
+	}
+	if t, ok := icmpTypes[v]; ok {
+		return []svcset.Range{{Low: t, High: t}}, nil, nil
+	}
+	parts := strings.SplitN(v, "/", 2)
+	t, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("unknown ICMP type")
+	}
+	types = []svcset.Range{{Low: t, High: t}}
+	if len(parts) == 2 {
+		c, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, nil, fmt.Errorf("unknown ICMP code")
+		}
+		codes = []svcset.Range{{Low: c, High: c}}
+	}
+	return types, codes, nil
+}
@@ -0,0 +1,122 @@
+// Package migrate imports the rules of Linux firewalls (iptables-save files or nft JSON rulesets)
+// into a UTM. Rulesets are converted into a reviewable Plan which reuses equivalent objects.
+package migrate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/esurdam/go-sophos/svcset"
+)
+
+// Tables and chains of imported rules
+const (
+	TableFilter = "filter"
+	TableNAT    = "nat"
+
+	ChainInput       = "INPUT"
+	ChainForward     = "FORWARD"
+	ChainOutput      = "OUTPUT"
+	ChainPrerouting  = "PREROUTING"
+	ChainPostrouting = "POSTROUTING"
+)
+
+// Targets of imported rules
+const (
+	TargetAccept     = "ACCEPT"
+	TargetDrop       = "DROP"
+	TargetReject     = "REJECT"
+	TargetLog        = "LOG"
+	TargetDNAT       = "DNAT"
+	TargetSNAT       = "SNAT"
+	TargetMasquerade = "MASQUERADE"
+)
+
+// A Ruleset contains the rules of a Linux firewall
+type Ruleset struct {
+	// Policies maps table/chain (e.g. filter/INPUT) to the default policy of the chain
+	Policies map[string]string `json:"policies"`
+	Rules    []Rule            `json:"rules"`
+}
+
+// A Rule is a firewall rule independent of the format it was read from
+type Rule struct {
+	// Line is the line of the iptables-save file or the handle of the nft rule
+	Line  int    `json:"line"`
+	Table string `json:"table"`
+	Chain string `json:"chain"`
+	// Sources and Destinations contain addresses, prefixes (10.0.0.0/8) or ranges (10.0.0.1-10.0.0.9),
+	// empty matches any address
+	Sources      []string `json:"sources,omitempty"`
+	Destinations []string `json:"destinations,omitempty"`
+	// Protocol is the protocol name or number, empty matches any protocol
+	Protocol string `json:"protocol,omitempty"`
+	// DstPorts and SrcPorts contain the port ranges. For ICMP DstPorts contains the type and SrcPorts the code.
+	DstPorts     []svcset.Range `json:"dst_ports,omitempty"`
+	SrcPorts     []svcset.Range `json:"src_ports,omitempty"`
+	InInterface  string         `json:"in_interface,omitempty"`
+	OutInterface string         `json:"out_interface,omitempty"`
+	// State contains the connection tracking states, e.g. ESTABLISHED,RELATED
+	State  string `json:"state,omitempty"`
+	Target string `json:"target"`
+	// To is the address[:port] of DNAT and SNAT targets
+	To      string `json:"to,omitempty"`
+	Log     bool   `json:"log,omitempty"`
+	Comment string `json:"comment,omitempty"`
+	// Unsupported contains the matches or targets which can not be imported
+	Unsupported []string `json:"unsupported,omitempty"`
+}
+
+// String returns the location of the Rule, e.g. filter/INPUT line 12
+func (r Rule) String() string {
+	return fmt.Sprintf("%s/%s line %d", r.Table, r.Chain, r.Line)
+}
+
+// matchKey returns the conditions of the Rule, used to merge LOG rules into the following rule
+func (r Rule) matchKey() string {
+	return fmt.Sprint(r.Table, r.Chain, r.Sources, r.Destinations, r.Protocol, r.DstPorts, r.SrcPorts,
+		r.InInterface, r.OutInterface, r.State, r.Unsupported)
+}
+
+// stateful returns true if the Rule only matches established or related connections,
+// which the UTM accepts without rules
+func (r Rule) stateful() bool {
+	if r.State == "" {
+		return false
+	}
+	for _, s := range strings.Split(strings.ToUpper(r.State), ",") {
+		if s != "ESTABLISHED" && s != "RELATED" {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeLog sets Log of the rules following LOG rules with the same conditions and removes the LOG rules
+func mergeLog(rules []Rule) []Rule {
+	var out []Rule
+	for i := 0; i < len(rules); i++ {
+		r := rules[i]
+		if r.Target == TargetLog && i+1 < len(rules) && rules[i+1].matchKey() == r.matchKey() && rules[i+1].Target != TargetLog {
+			rules[i+1].Log = true
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// icmpTypes contains the names of ICMP types used by iptables and nft
+var icmpTypes = map[string]int{
+	"echo-reply":              0,
+	"destination-unreachable": 3,
+	"source-quench":           4,
+	"redirect":                5,
+	"echo-request":            8,
+	"router-advertisement":    9,
+	"router-solicitation":     10,
+	"time-exceeded":           11,
+	"parameter-problem":       12,
+	"timestamp-request":       13,
+	"timestamp-reply":         14,
+}
@@ -0,0 +1,270 @@
+package migrate_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/migrate"
+	"github.com/esurdam/go-sophos/snapshot"
+	"github.com/esurdam/go-sophos/svcset"
+)
+
+const iptablesSave = `# Generated by iptables-save v1.8.4
+*nat
+:PREROUTING ACCEPT [0:0]
+:INPUT ACCEPT [0:0]
+:OUTPUT ACCEPT [0:0]
+:POSTROUTING ACCEPT [0:0]
+-A PREROUTING -d 203.0.113.10/32 -p tcp -m tcp --dport 443 -j DNAT --to-destination 10.0.1.1:8443
+-A POSTROUTING -s 10.0.0.0/24 -o eth1 -j MASQUERADE
+COMMIT
+*filter
+:INPUT DROP [0:0]
+:FORWARD DROP [0:0]
+:OUTPUT ACCEPT [0:0]
+-A INPUT -i lo -j ACCEPT
+-A INPUT -m conntrack --ctstate RELATED,ESTABLISHED -j ACCEPT
+-A INPUT -s 10.0.0.0/24 -p tcp -m tcp --dport 22 -m comment --comment "SSH from LAN" -j ACCEPT
+-A INPUT -p icmp -m icmp --icmp-type echo-request -j ACCEPT
+-A FORWARD -m conntrack --ctstate RELATED,ESTABLISHED -j ACCEPT
+-A FORWARD -d 10.0.1.1/32 -p tcp -m multiport --dports 8443,8000:8080 -j LOG --log-prefix "web "
+-A FORWARD -d 10.0.1.1/32 -p tcp -m multiport --dports 8443,8000:8080 -j ACCEPT
+-A FORWARD -s 10.0.0.0/24 -i eth0 -j ACCEPT
+-A FORWARD ! -s 10.0.0.0/24 -j DROP
+-A FORWARD -m iprange --src-range 10.0.2.10-10.0.2.20 -p udp --dport 53 -j REJECT --reject-with icmp-port-unreachable
+COMMIT
+`
+
+const nftJSON = `{"nftables": [
+ {"metainfo": {"version": "1.0.2", "json_schema_version": 1}},
+ {"table": {"family": "inet", "name": "filter", "handle": 1}},
+ {"chain": {"family": "inet", "table": "filter", "name": "forward", "handle": 1, "type": "filter", "hook": "forward", "prio": 0, "policy": "drop"}},
+ {"chain": {"family": "inet", "table": "filter", "name": "lan", "handle": 2}},
+ {"rule": {"family": "inet", "table": "filter", "chain": "forward", "handle": 3, "expr": [
+   {"match": {"op": "in", "left": {"ct": {"key": "state"}}, "right": ["established", "related"]}},
+   {"accept": null}]}},
+ {"rule": {"family": "inet", "table": "filter", "chain": "forward", "handle": 4, "comment": "web", "expr": [
+   {"match": {"op": "==", "left": {"payload": {"protocol": "ip", "field": "daddr"}}, "right": {"prefix": {"addr": "10.0.1.0", "len": 24}}}},
+   {"match": {"op": "==", "left": {"payload": {"protocol": "tcp", "field": "dport"}}, "right": {"set": [80, 443, {"range": [8000, 8080]}]}}},
+   {"counter": {"packets": 0, "bytes": 0}},
+   {"log": {"prefix": "web "}},
+   {"accept": null}]}},
+ {"rule": {"family": "inet", "table": "filter", "chain": "forward", "handle": 5, "expr": [
+   {"match": {"op": "==", "left": {"meta": {"key": "iifname"}}, "right": "eth0"}},
+   {"jump": {"target": "lan"}}]}},
+ {"rule": {"family": "inet", "table": "filter", "chain": "lan", "handle": 6, "expr": [
+   {"accept": null}]}}
+]}
+`
+
+func testSnapshot(t *testing.T) *snapshot.Snapshot {
+	s := snapshot.New()
+	for _, o := range []interface{}{
+		objects.NetworkNetwork{Reference: "REF_NetNetLan", ObjectType: "network/network", Name: "lan", Address: "10.0.0.0", Netmask: 24},
+		objects.ServiceTcp{Reference: "REF_SerTcpSsh", ObjectType: "service/tcp", Name: "SSH", DstLow: 22, DstHigh: 22, SrcLow: 1, SrcHigh: 65535},
+		map[string]interface{}{"_ref": "REF_ItfEthInternal", "_type": "interface/ethernet", "name": "Internal", "itfhw": "REF_ItfEthEth0"},
+		map[string]interface{}{"_ref": "REF_ItfEthEth0", "_type": "itfhw/ethernet", "name": "eth0", "hardware": "eth0"},
+		map[string]interface{}{"_ref": "REF_ItfEthExternal", "_type": "interface/ethernet", "name": "External", "itfhw": "REF_ItfEthEth1"},
+		map[string]interface{}{"_ref": "REF_ItfEthEth1", "_type": "itfhw/ethernet", "name": "eth1", "hardware": "eth1"},
+	} {
+		if err := s.Add(o); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.SetNode(migrate.RulesNode, []string{})
+	return s
+}
+
+func TestParseIptablesSave(t *testing.T) {
+	rs, err := migrate.ParseIptablesSave(strings.NewReader(iptablesSave))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs.Rules) != 11 {
+		t.Fatalf("wanted 11 rules, got %d", len(rs.Rules))
+	}
+	web := rs.Rules[7]
+	want := []svcset.Range{{Low: 8443, High: 8443}, {Low: 8000, High: 8080}}
+	if !web.Log || web.Target != migrate.TargetAccept || !reflect.DeepEqual(web.DstPorts, want) {
+		t.Errorf("the LOG rule should have been merged: %+v", web)
+	}
+	if r := rs.Rules[4]; r.Comment != "SSH from LAN" || r.Sources[0] != "10.0.0.0/24" {
+		t.Errorf("unexpected rule %+v", r)
+	}
+	if r := rs.Rules[9]; len(r.Unsupported) != 1 {
+		t.Errorf("negations should be unsupported: %+v", r)
+	}
+	if rs.Policies["filter/OUTPUT"] != migrate.TargetAccept {
+		t.Errorf("unexpected policies %v", rs.Policies)
+	}
+
+	if _, err := migrate.ParseIptablesSave(strings.NewReader("*filter\n-A INPUT -s\n")); err == nil {
+		t.Error("options without value should fail")
+	}
+}
+
+func TestParseNftJSON(t *testing.T) {
+	rs, err := migrate.ParseNftJSON(strings.NewReader(nftJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs.Rules) != 4 || rs.Policies["filter/FORWARD"] != migrate.TargetDrop {
+		t.Fatalf("unexpected ruleset %+v", rs)
+	}
+	web := rs.Rules[1]
+	if web.Chain != migrate.ChainForward || web.Protocol != "tcp" || !web.Log || web.Comment != "web" ||
+		web.Destinations[0] != "10.0.1.0/24" || len(web.DstPorts) != 3 || web.DstPorts[2] != (svcset.Range{Low: 8000, High: 8080}) {
+		t.Errorf("unexpected rule %+v", web)
+	}
+	if rs.Rules[0].State != "ESTABLISHED,RELATED" || len(rs.Rules[2].Unsupported) != 1 || len(rs.Rules[3].Unsupported) != 1 {
+		t.Errorf("unexpected rules %+v", rs.Rules)
+	}
+}
+
+func TestNewPlan(t *testing.T) {
+	rs, err := migrate.ParseIptablesSave(strings.NewReader(iptablesSave))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := migrate.NewPlan(testSnapshot(t), rs, migrate.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, o := range plan.Objects {
+		names = append(names, o.Type+" "+o.Name)
+	}
+	want := []string{
+		"network/host 203.0.113.10", "service/tcp tcp/443", "network/host 10.0.1.1", "service/tcp tcp/8443",
+		"packetfilter/nat PREROUTING line 7",
+		"packetfilter/masq POSTROUTING line 8",
+		"packetfilter/packetfilter SSH from LAN",
+		"service/icmp icmp/8", "packetfilter/packetfilter INPUT line 17",
+		"service/tcp tcp/8000-8080", "packetfilter/packetfilter FORWARD line 20",
+		"packetfilter/packetfilter FORWARD line 21",
+		"network/range 10.0.2.10-10.0.2.20", "service/udp udp/53", "packetfilter/packetfilter FORWARD line 23",
+	}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("unexpected objects\n%s", strings.Join(names, "\n"))
+	}
+	if plan.Reused["10.0.0.0/24"] != "REF_NetNetLan" || plan.Reused["tcp/22"] != "REF_SerTcpSsh" {
+		t.Errorf("existing objects should have been reused: %v", plan.Reused)
+	}
+	if len(plan.Nodes[migrate.RulesNode]) != 5 || len(plan.Nodes[migrate.NATNode]) != 1 || len(plan.Nodes[migrate.MasqueradeNode]) != 1 {
+		t.Errorf("unexpected nodes %v", plan.Nodes)
+	}
+
+	ssh := plan.Objects[6].Attributes
+	if ssh["status"] != false || !reflect.DeepEqual(ssh["sources"], []string{"REF_NetNetLan"}) || !reflect.DeepEqual(ssh["services"], []string{"REF_SerTcpSsh"}) {
+		t.Errorf("unexpected rule %v", ssh)
+	}
+	if fw := plan.Objects[11].Attributes; fw["interface"] != "REF_ItfEthInternal" {
+		t.Errorf("the interface should have been mapped: %v", fw)
+	}
+
+	var issues []string
+	for _, i := range plan.Issues {
+		issues = append(issues, i.Rule)
+	}
+	want = []string{"filter/FORWARD line 18", "filter/FORWARD line 22", "filter/INPUT line 14", "filter/INPUT line 15", "filter/OUTPUT"}
+	if !reflect.DeepEqual(issues, want) {
+		t.Errorf("unexpected issues\n%s", plan)
+	}
+
+	rs, err = migrate.ParseIptablesSave(strings.NewReader("*filter\n-A INPUT -s 0.0.0.0/0 -j ACCEPT\n-A INPUT -s ::/0 -j ACCEPT\nCOMMIT\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan, err = migrate.NewPlan(testSnapshot(t), rs, migrate.Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if v4, v6 := plan.Objects[0].Attributes["sources"], plan.Objects[1].Attributes["sources"]; !reflect.DeepEqual(v4, []string{sophos.RefNetworkAny4}) ||
+		!reflect.DeepEqual(v6, []string{sophos.RefNetworkAny6}) {
+		t.Errorf("the default routes should be the any object of their family: %v, %v", v4, v6)
+	}
+}
+
+func TestApply(t *testing.T) {
+	rs, err := migrate.ParseIptablesSave(strings.NewReader("*filter\n-A INPUT -s 10.0.9.9 -p tcp --dport 22 -j ACCEPT\nCOMMIT\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := migrate.NewPlan(testSnapshot(t), rs, migrate.Options{Enable: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		created []map[string]interface{}
+		node    []string
+		fail    = map[string]bool{}
+		closes  int
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get(sophos.XRestdSession) == "close" {
+			closes++
+		}
+		if fail[r.Method] {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.Method {
+		case http.MethodPost:
+			var attrs map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&attrs)
+			created = append(created, attrs)
+			attrs["_ref"] = "REF_New" + attrs["name"].(string)
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(attrs)
+		case http.MethodGet:
+			json.NewEncoder(w).Encode([]string{"REF_PacPacExisting"})
+		case http.MethodPut:
+			json.NewDecoder(r.Body).Decode(&node)
+			json.NewEncoder(w).Encode(node)
+		}
+	}))
+	defer ts.Close()
+	sophos.DefaultHTTPClient = ts.Client()
+	client, err := sophos.New(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	refs, err := plan.Apply(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created) != 2 || len(refs) != 2 {
+		t.Fatalf("unexpected objects %v", created)
+	}
+	if !reflect.DeepEqual(created[1]["sources"], []interface{}{"REF_New10.0.9.9"}) {
+		t.Errorf("placeholders should have been replaced: %v", created[1])
+	}
+	if !reflect.DeepEqual(node, []string{"REF_PacPacExisting", "REF_NewINPUT line 2"}) {
+		t.Errorf("the rule should have been appended: %v", node)
+	}
+	if closes != 1 {
+		t.Errorf("the session should be closed once: %d", closes)
+	}
+
+	fail[http.MethodPut] = true
+	if _, err := plan.Apply(client); err == nil || !strings.HasSuffix(err.Error(), "changes were rolled back") {
+		t.Errorf("created objects should have been deleted: %v", err)
+	}
+	fail[http.MethodDelete] = true
+	_, err = plan.Apply(client)
+	if err == nil || strings.Contains(err.Error(), "rolled back") || !strings.Contains(err.Error(), "rollback failed: deleting /api/objects/packetfilter/packetfilter/REF_NewINPUT line 2") {
+		t.Errorf("failed rollback should be reported: %v", err)
+	}
+	if closes != 3 {
+		t.Errorf("the session should be closed by failed applies: %d", closes)
+	}
+}
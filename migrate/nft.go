@@ -0,0 +1,231 @@
+package migrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/esurdam/go-sophos/svcset"
+)
+
+// nftRuleset is the output of nft -j list ruleset
+type nftRuleset struct {
+	Nftables []map[string]json.RawMessage `json:"nftables"`
+}
+
+type nftChain struct {
+	Family string `json:"family"`
+	Table  string `json:"table"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Hook   string `json:"hook"`
+	Policy string `json:"policy"`
+}
+
+type nftRule struct {
+	Family  string                   `json:"family"`
+	Table   string                   `json:"table"`
+	Chain   string                   `json:"chain"`
+	Handle  int                      `json:"handle"`
+	Comment string                   `json:"comment"`
+	Expr    []map[string]interface{} `json:"expr"`
+}
+
+// ParseNftJSON reads the output of nft -j list ruleset. Rules of base chains hooked into
+// input, forward, output (filter) and prerouting, postrouting (nat) are returned with the
+// chain names of iptables. Expressions which can not be imported are listed in Rule.Unsupported.
+func ParseNftJSON(r io.Reader) (*Ruleset, error) {
+	var nft nftRuleset
+	if err := json.NewDecoder(r).Decode(&nft); err != nil {
+		return nil, fmt.Errorf("migrate: error decoding nft ruleset: %s", err.Error())
+	}
+
+	rs := &Ruleset{Policies: map[string]string{}}
+	chains := map[string]nftChain{}
+	for _, e := range nft.Nftables {
+		if raw, ok := e["chain"]; ok {
+			var c nftChain
+			if err := json.Unmarshal(raw, &c); err != nil {
+				return nil, fmt.Errorf("migrate: error decoding nft chain: %s", err.Error())
+			}
+			key := c.Family + " " + c.Table + " " + c.Name
+			if c.Hook != "" {
+				// base chains are imported with the chain name of iptables
+				c.Name = strings.ToUpper(c.Hook)
+				if c.Type == "filter" && c.Policy != "" {
+					rs.Policies[TableFilter+"/"+c.Name] = strings.ToUpper(c.Policy)
+				}
+			}
+			chains[key] = c
+			continue
+		}
+		raw, ok := e["rule"]
+		if !ok {
+			continue
+		}
+		var nr nftRule
+		if err := json.Unmarshal(raw, &nr); err != nil {
+			return nil, fmt.Errorf("migrate: error decoding nft rule: %s", err.Error())
+		}
+		rs.Rules = append(rs.Rules, parseNftRule(nr, chains))
+	}
+	return rs, nil
+}
+
+func parseNftRule(nr nftRule, chains map[string]nftChain) Rule {
+	r := Rule{Line: nr.Handle, Chain: nr.Chain, Comment: nr.Comment}
+	c, ok := chains[nr.Family+" "+nr.Table+" "+nr.Chain]
+	switch {
+	case !ok || c.Hook == "":
+		r.Table = TableFilter
+		r.Unsupported = append(r.Unsupported, "regular chain "+nr.Chain)
+	case c.Type == "nat":
+		r.Table, r.Chain = TableNAT, c.Name
+	default:
+		r.Table, r.Chain = TableFilter, c.Name
+	}
+
+	for _, expr := range nr.Expr {
+		for k, v := range expr {
+			switch k {
+			case "match":
+				parseNftMatch(&r, v)
+			case "accept", "drop", "reject", "masquerade":
+				r.Target = strings.ToUpper(k)
+			case "dnat", "snat":
+				r.Target = strings.ToUpper(k)
+				m, _ := v.(map[string]interface{})
+				r.To = nftString(m["addr"])
+				if p := nftString(m["port"]); p != "" {
+					r.To += ":" + p
+				}
+			case "log":
+				r.Log = true
+			case "counter", "comment":
+			default:
+				r.Unsupported = append(r.Unsupported, "expression "+k)
+			}
+		}
+	}
+	return r
+}
+
+// parseNftMatch sets the conditions of the match expression
+func parseNftMatch(r *Rule, v interface{}) {
+	m, _ := v.(map[string]interface{})
+	left, _ := m["left"].(map[string]interface{})
+	right := m["right"]
+	if op, _ := m["op"].(string); op != "==" && op != "in" {
+		r.Unsupported = append(r.Unsupported, fmt.Sprintf("operator %s", op))
+		return
+	}
+
+	unsupported := func() {
+		byt, _ := json.Marshal(left)
+		r.Unsupported = append(r.Unsupported, "match "+string(byt))
+	}
+	if meta, ok := left["meta"].(map[string]interface{}); ok {
+		switch meta["key"] {
+		case "iifname":
+			r.InInterface = nftString(right)
+		case "oifname":
+			r.OutInterface = nftString(right)
+		case "l4proto":
+			r.Protocol = nftString(right)
+		default:
+			unsupported()
+		}
+		return
+	}
+	if ct, ok := left["ct"].(map[string]interface{}); ok && ct["key"] == "state" {
+		r.State = strings.ToUpper(strings.Join(nftValues(right), ","))
+		return
+	}
+	payload, ok := left["payload"].(map[string]interface{})
+	if !ok {
+		unsupported()
+		return
+	}
+	proto, _ := payload["protocol"].(string)
+	switch field, _ := payload["field"].(string); {
+	case field == "saddr":
+		r.Sources = append(r.Sources, nftValues(right)...)
+	case field == "daddr":
+		r.Destinations = append(r.Destinations, nftValues(right)...)
+	case field == "protocol" || field == "nexthdr":
+		r.Protocol = nftString(right)
+	case field == "dport" || field == "sport":
+		r.Protocol = proto
+		rr, err := parsePorts(strings.Join(nftValues(right), ","), "-")
+		if err != nil {
+			unsupported()
+			return
+		}
+		if field == "dport" {
+			r.DstPorts = rr
+		} else {
+			r.SrcPorts = rr
+		}
+	case (proto == "icmp" || proto == "icmpv6") && (field == "type" || field == "code"):
+		r.Protocol = proto
+		var rr []svcset.Range
+		for _, t := range nftValues(right) {
+			if n, ok := icmpTypes[t]; ok {
+				t = strconv.Itoa(n)
+			}
+			parsed, err := parsePorts(t, "-")
+			if err != nil {
+				unsupported()
+				return
+			}
+			rr = append(rr, parsed...)
+		}
+		if field == "type" {
+			r.DstPorts = rr
+		} else {
+			r.SrcPorts = rr
+		}
+	default:
+		unsupported()
+	}
+}
+
+// nftValues returns the values of a single value, set, prefix or range as strings
+func nftValues(v interface{}) []string {
+	switch t := v.(type) {
+	case []interface{}:
+		var ss []string
+		for _, e := range t {
+			ss = append(ss, nftValues(e)...)
+		}
+		return ss
+	case map[string]interface{}:
+		if s, ok := t["set"]; ok {
+			return nftValues(s)
+		}
+		if p, ok := t["prefix"].(map[string]interface{}); ok {
+			return []string{fmt.Sprintf("%s/%s", nftString(p["addr"]), nftString(p["len"]))}
+		}
+		if rr, ok := t["range"].([]interface{}); ok && len(rr) == 2 {
+			return []string{nftString(rr[0]) + "-" + nftString(rr[1])}
+		}
+		return nil
+	default:
+		return []string{nftString(v)}
+	}
+}
+
+func nftString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(t)
+	}
+}
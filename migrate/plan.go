@@ -0,0 +1,621 @@
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/netset"
+	"github.com/esurdam/go-sophos/snapshot"
+	"github.com/esurdam/go-sophos/svcset"
+)
+
+// Ordered nodes the imported rules are appended to
+const (
+	RulesNode      = "packetfilter.rules"
+	NATNode        = "nat.rules"
+	MasqueradeNode = "masq.rules"
+)
+
+// placeholderPrefix prefixes the References of objects created by a Plan until it is applied
+const placeholderPrefix = "REF_Import"
+
+// Options of NewPlan
+type Options struct {
+	// Enable creates enabled rules, by default imported rules are disabled until reviewed
+	Enable bool
+}
+
+// An Object is created by a Plan. Its Reference is a placeholder which is replaced in the
+// attributes of later Objects and the nodes once the object is created.
+type Object struct {
+	Reference  string                 `json:"reference"`
+	Type       string                 `json:"type"`
+	Name       string                 `json:"name"`
+	Attributes map[string]interface{} `json:"attributes"`
+}
+
+// An Issue is a rule, or part of a rule, which was not imported
+type Issue struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+func (i Issue) String() string { return i.Rule + ": " + i.Message }
+
+// A Plan contains the objects to create and the References to append to the ordered nodes
+// to import a Ruleset. It can be reviewed (or encoded as JSON) before being applied.
+type Plan struct {
+	Objects []Object `json:"objects"`
+	// Nodes maps an ordered node to the References appended to it
+	Nodes map[string][]string `json:"nodes"`
+	// Reused maps addresses and services of the Ruleset to the existing objects used for them
+	Reused map[string]string `json:"reused"`
+	Issues []Issue           `json:"issues"`
+}
+
+// planner converts a Ruleset into a Plan
+type planner struct {
+	s    *snapshot.Snapshot
+	opts Options
+	plan *Plan
+	// networks and services map the normalized addresses and services to References
+	networks, services map[string]string
+	names              map[string]bool
+	// interfaces maps hardware names (e.g. eth0) to interface References
+	interfaces map[string]string
+}
+
+// NewPlan returns the Plan importing the Ruleset into the UTM of the Snapshot.
+//
+// Addresses and services are imported as network/host, network/network, network/range and
+// service objects. Existing objects with the same addresses or services are reused. Filter rules
+// become packet filter rules, DNAT and SNAT rules NAT rules and MASQUERADE rules masquerading rules,
+// each appended in order of the Ruleset. Rules matching established connections and the loopback
+// interface are skipped as the UTM accepts them without rules, rules which can not be imported
+// completely are reported as Issues.
+func NewPlan(s *snapshot.Snapshot, rs *Ruleset, opts Options) (*Plan, error) {
+	p := &planner{
+		s:    s,
+		opts: opts,
+		plan: &Plan{Nodes: map[string][]string{}, Reused: map[string]string{}},
+		networks: map[string]string{
+			netset.Any().String():  sophos.RefNetworkAny,
+			netset.Any4().String(): sophos.RefNetworkAny4,
+			netset.Any6().String(): sophos.RefNetworkAny6,
+		},
+		services: map[string]string{
+			svcset.Any().String(): sophos.RefServiceAny,
+		},
+		names:      map[string]bool{},
+		interfaces: map[string]string{},
+	}
+	p.index()
+
+	for key, policy := range rs.Policies {
+		if strings.HasPrefix(key, TableFilter+"/") && policy == TargetAccept {
+			p.issue(key, "the default policy ACCEPT is not imported, the UTM drops unmatched packets")
+		}
+	}
+	for _, r := range rs.Rules {
+		p.rule(r)
+	}
+	sort.Slice(p.plan.Issues, func(i, j int) bool { return p.plan.Issues[i].Rule < p.plan.Issues[j].Rule })
+	return p.plan, nil
+}
+
+// index indexes the existing network and service objects, names and interfaces
+func (p *planner) index() {
+	nets, svcs := netset.NewResolver(p.s), svcset.NewResolver(p.s)
+	for _, ref := range p.s.References("network/host", "network/network", "network/range") {
+		if set, exact, err := nets.Resolve(ref); err == nil && exact {
+			p.reuse(p.networks, set.String(), ref)
+		}
+	}
+	for _, ref := range p.s.References("service/tcp", "service/udp", "service/tcpudp", "service/icmp", "service/icmpv6", "service/ip") {
+		if set, exact, err := svcs.Resolve(ref); err == nil && exact {
+			p.reuse(p.services, set.String(), ref)
+		}
+	}
+	for _, ref := range p.s.References("network/*", "service/*", "packetfilter/*") {
+		p.names[p.s.Type(ref)+" "+p.s.Name(ref)] = true
+	}
+	for _, ref := range p.s.References("interface/*") {
+		var itf struct {
+			Itfhw string `json:"itfhw"`
+		}
+		var hw struct {
+			Hardware string `json:"hardware"`
+		}
+		if p.s.Decode(ref, &itf) == nil && p.s.Decode(itf.Itfhw, &hw) == nil && hw.Hardware != "" {
+			p.interfaces[hw.Hardware] = ref
+		}
+	}
+}
+
+// reuse indexes the object, objects with lower names are preferred
+func (p *planner) reuse(index map[string]string, key, ref string) {
+	if cur, ok := index[key]; ok && p.s.Name(cur) <= p.s.Name(ref) {
+		return
+	}
+	index[key] = ref
+}
+
+func (p *planner) issue(rule, format string, args ...interface{}) {
+	p.plan.Issues = append(p.plan.Issues, Issue{Rule: rule, Message: fmt.Sprintf(format, args...)})
+}
+
+// create adds the object to the Plan and returns its placeholder Reference
+func (p *planner) create(objType, name string, attrs map[string]interface{}) string {
+	unique := name
+	for i := 2; p.names[objType+" "+unique]; i++ {
+		unique = fmt.Sprintf("%s (%d)", name, i)
+	}
+	p.names[objType+" "+unique] = true
+	ref := fmt.Sprintf("%s%d", placeholderPrefix, len(p.plan.Objects)+1)
+	attrs["name"] = unique
+	p.plan.Objects = append(p.plan.Objects, Object{Reference: ref, Type: objType, Name: unique, Attributes: attrs})
+	return ref
+}
+
+// network returns the References of the addresses, the network objects are created if needed
+func (p *planner) network(addrs []string) ([]string, error) {
+	if len(addrs) == 0 {
+		return []string{sophos.RefNetworkAny}, nil
+	}
+	var refs []string
+	for _, a := range addrs {
+		set, err := netset.Parse(a)
+		if err != nil {
+			return nil, err
+		}
+		key := set.String()
+		if ref, ok := p.networks[key]; ok {
+			if !strings.HasPrefix(ref, placeholderPrefix) {
+				p.plan.Reused[a] = ref
+			}
+			refs = append(refs, ref)
+			continue
+		}
+		ref := p.createNetwork(a, set)
+		p.networks[key] = ref
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+func (p *planner) createNetwork(a string, set netset.Set) string {
+	attrs := map[string]interface{}{"comment": "Imported " + a}
+	rr := set.Ranges()
+	v6 := rr[0].From.To4() == nil
+	suffix := ""
+	if v6 {
+		suffix = "6"
+	}
+	switch pp := set.Prefixes(); {
+	case len(rr) == 1 && rr[0].From.Equal(rr[0].To):
+		attrs["address"+suffix] = rr[0].From.String()
+		return p.create("network/host", rr[0].From.String(), attrs)
+	case len(pp) == 1:
+		ones, _ := pp[0].Mask.Size()
+		attrs["address"+suffix], attrs["netmask"+suffix] = pp[0].IP.String(), ones
+		return p.create("network/network", pp[0].String(), attrs)
+	default:
+		attrs["from"+suffix], attrs["to"+suffix] = rr[0].From.String(), rr[0].To.String()
+		return p.create("network/range", rr[0].From.String()+"-"+rr[0].To.String(), attrs)
+	}
+}
+
+// service returns the References of the protocol and ports, the service objects are created if needed
+func (p *planner) service(r Rule) ([]string, error) {
+	proto := strings.ToLower(r.Protocol)
+	if proto == "" || proto == "all" || proto == "0" {
+		if len(r.DstPorts) > 0 || len(r.SrcPorts) > 0 {
+			return nil, fmt.Errorf("ports without protocol")
+		}
+		return []string{sophos.RefServiceAny}, nil
+	}
+	num, ok := svcset.ProtocolNumber(proto)
+	if !ok {
+		return nil, fmt.Errorf("unknown protocol %s", r.Protocol)
+	}
+
+	ranges := func(rr []svcset.Range, any svcset.Range) []svcset.Range {
+		if len(rr) == 0 {
+			return []svcset.Range{any}
+		}
+		return rr
+	}
+	var (
+		objType string
+		dsts    []svcset.Range
+		srcs    []svcset.Range
+	)
+	switch num {
+	case svcset.TCP, svcset.UDP:
+		objType = "service/" + svcset.ProtocolName(num)
+		dsts, srcs = ranges(r.DstPorts, svcset.Range{Low: 1, High: 65535}), ranges(r.SrcPorts, svcset.Range{Low: 1, High: 65535})
+	case svcset.ICMP, svcset.ICMPv6:
+		objType = "service/" + svcset.ProtocolName(num)
+		if len(r.DstPorts) == 0 {
+			objType = "service/ip"
+		}
+		dsts, srcs = ranges(r.DstPorts, svcset.Range{Low: -1, High: -1}), ranges(r.SrcPorts, svcset.Range{Low: -1, High: -1})
+	default:
+		if len(r.DstPorts) > 0 || len(r.SrcPorts) > 0 {
+			return nil, fmt.Errorf("ports of protocol %s", r.Protocol)
+		}
+		objType, dsts, srcs = "service/ip", []svcset.Range{{}}, []svcset.Range{{}}
+	}
+
+	var refs []string
+	for _, d := range dsts {
+		for _, src := range srcs {
+			var (
+				attrs = map[string]interface{}{}
+				sv    svcset.Service
+				err   error
+			)
+			switch objType {
+			case "service/tcp", "service/udp":
+				attrs["dst_low"], attrs["dst_high"], attrs["src_low"], attrs["src_high"] = d.Low, d.High, src.Low, src.High
+				sv, err = svcset.NewService(num, d, src)
+			case "service/icmp", "service/icmpv6":
+				attrs["type"], attrs["code"] = d.Low, src.Low
+				code := svcset.Range{Low: 0, High: 255}
+				if src.Low >= 0 {
+					code = src
+				}
+				sv, err = svcset.NewService(num, d, code)
+			default:
+				attrs["proto"] = num
+				sv, err = svcset.NewService(num, svcset.Range{Low: 0, High: 65535}, svcset.Range{Low: 0, High: 65535})
+			}
+			if err != nil {
+				return nil, err
+			}
+			key := svcset.New(sv).String()
+			if ref, ok := p.services[key]; ok {
+				if !strings.HasPrefix(ref, placeholderPrefix) {
+					p.plan.Reused[key] = ref
+				}
+				refs = append(refs, ref)
+				continue
+			}
+			name := key
+			if objType == "service/icmp" || objType == "service/icmpv6" {
+				// any ICMP code is not part of the name
+				name = strings.TrimSuffix(key, ":0-255")
+			}
+			attrs["comment"] = "Imported " + name
+			ref := p.create(objType, name, attrs)
+			p.services[key] = ref
+			refs = append(refs, ref)
+		}
+	}
+	return refs, nil
+}
+
+// address returns the network and service References of a DNAT or SNAT target address[:port]
+func (p *planner) address(r Rule) (network, service string, err error) {
+	host, port := r.To, ""
+	if i := strings.LastIndex(r.To, ":"); i >= 0 && (strings.Count(r.To, ":") == 1 || strings.HasPrefix(r.To, "[")) {
+		host, port = strings.Trim(r.To[:i], "[]"), r.To[i+1:]
+	}
+	if strings.Contains(host, "-") || strings.Contains(port, "-") {
+		return "", "", fmt.Errorf("address and port ranges of %s are not supported", r.To)
+	}
+	if host != "" {
+		if net.ParseIP(host) == nil {
+			return "", "", fmt.Errorf("invalid address %s", r.To)
+		}
+		refs, err := p.network([]string{host})
+		if err != nil {
+			return "", "", err
+		}
+		network = refs[0]
+	}
+	if port != "" {
+		pr := Rule{Protocol: r.Protocol}
+		if pr.DstPorts, err = parsePorts(port, ":"); err != nil {
+			return "", "", err
+		}
+		refs, err := p.service(pr)
+		if err != nil {
+			return "", "", err
+		}
+		service = refs[0]
+	}
+	return network, service, nil
+}
+
+// single returns the only Reference of the addresses or services
+func single(refs []string, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	if len(refs) != 1 {
+		return "", fmt.Errorf("multiple addresses or services are not supported")
+	}
+	return refs[0], nil
+}
+
+// rule adds the objects of the Rule to the Plan
+func (p *planner) rule(r Rule) {
+	loc := r.String()
+	switch {
+	case len(r.Unsupported) > 0:
+		p.issue(loc, "not imported, unsupported %s", strings.Join(r.Unsupported, ", "))
+		return
+	case r.stateful():
+		p.issue(loc, "not imported, the UTM accepts %s connections", strings.ToLower(r.State))
+		return
+	case r.InInterface == "lo" || r.OutInterface == "lo":
+		p.issue(loc, "not imported, loopback traffic is accepted by the UTM")
+		return
+	case r.State != "":
+		p.issue(loc, "connection state %s is ignored", r.State)
+	}
+
+	name := r.Comment
+	if name == "" {
+		name = fmt.Sprintf("%s line %d", r.Chain, r.Line)
+	}
+	comment := "Imported from " + loc
+
+	var err error
+	switch {
+	case r.Table == TableFilter && (r.Chain == ChainInput || r.Chain == ChainForward || r.Chain == ChainOutput):
+		err = p.filter(r, name, comment)
+	case r.Table == TableNAT && r.Target == TargetDNAT && r.Chain == ChainPrerouting:
+		err = p.nat(r, name, comment, "destination")
+	case r.Table == TableNAT && r.Target == TargetSNAT && r.Chain == ChainPostrouting:
+		err = p.nat(r, name, comment, "source")
+	case r.Table == TableNAT && r.Target == TargetMasquerade && r.Chain == ChainPostrouting:
+		err = p.masquerade(r, name, comment)
+	default:
+		err = fmt.Errorf("target %s of chain %s is not supported", r.Target, r.Chain)
+	}
+	if err != nil {
+		p.issue(loc, "not imported, %s", err.Error())
+	}
+}
+
+func (p *planner) filter(r Rule, name, comment string) error {
+	action := map[string]string{TargetAccept: "accept", TargetDrop: "drop", TargetReject: "reject"}[r.Target]
+	if action == "" {
+		return fmt.Errorf("target %s is not supported", r.Target)
+	}
+	attrs := map[string]interface{}{
+		"action":  action,
+		"status":  p.opts.Enable,
+		"log":     r.Log,
+		"comment": comment,
+	}
+	if r.InInterface != "" {
+		ref, ok := p.interfaces[r.InInterface]
+		if !ok {
+			return fmt.Errorf("interface %s is unknown", r.InInterface)
+		}
+		attrs["interface"] = ref
+	}
+	if r.OutInterface != "" {
+		p.issue(r.String(), "output interface %s is ignored", r.OutInterface)
+	}
+
+	sources, err := p.network(r.Sources)
+	if err != nil {
+		return err
+	}
+	destinations, err := p.network(r.Destinations)
+	if err != nil {
+		return err
+	}
+	services, err := p.service(r)
+	if err != nil {
+		return err
+	}
+	attrs["sources"], attrs["destinations"], attrs["services"] = sources, destinations, services
+	p.plan.Nodes[RulesNode] = append(p.plan.Nodes[RulesNode], p.create("packetfilter/packetfilter", name, attrs))
+	return nil
+}
+
+// nat adds a NAT rule translating the destination or source
+func (p *planner) nat(r Rule, name, comment, translated string) error {
+	source, err := single(p.network(r.Sources))
+	if err != nil {
+		return err
+	}
+	destination, err := single(p.network(r.Destinations))
+	if err != nil {
+		return err
+	}
+	service, err := single(p.service(r))
+	if err != nil {
+		return err
+	}
+	network, natService, err := p.address(r)
+	if err != nil {
+		return err
+	}
+	attrs := map[string]interface{}{
+		"source":      source,
+		"destination": destination,
+		"service":     service,
+		"status":      p.opts.Enable,
+		"log":         r.Log,
+		"comment":     comment,
+	}
+	if translated == "destination" {
+		attrs["mode"], attrs["destination_nat_address"], attrs["destination_nat_service"] = "dnat", network, natService
+	} else {
+		attrs["mode"], attrs["source_nat_address"], attrs["source_nat_service"] = "snat", network, natService
+	}
+	p.plan.Nodes[NATNode] = append(p.plan.Nodes[NATNode], p.create("packetfilter/nat", name, attrs))
+	return nil
+}
+
+func (p *planner) masquerade(r Rule, name, comment string) error {
+	if r.Protocol != "" || len(r.Destinations) > 0 {
+		return fmt.Errorf("masquerading of protocols or destinations is not supported")
+	}
+	itf, ok := p.interfaces[r.OutInterface]
+	if !ok {
+		return fmt.Errorf("interface %q is unknown", r.OutInterface)
+	}
+	source, err := single(p.network(r.Sources))
+	if err != nil {
+		return err
+	}
+	attrs := map[string]interface{}{
+		"source":               source,
+		"source_nat_interface": itf,
+		"status":               p.opts.Enable,
+		"comment":              comment,
+	}
+	p.plan.Nodes[MasqueradeNode] = append(p.plan.Nodes[MasqueradeNode], p.create("packetfilter/masq", name, attrs))
+	return nil
+}
+
+// String returns a human readable listing of the Plan
+func (p *Plan) String() string {
+	var b strings.Builder
+	for i, o := range p.Objects {
+		fmt.Fprintf(&b, "%d. create %s %q (%s)\n", i+1, o.Type, o.Name, o.Reference)
+	}
+	for _, node := range sortedNodes(p.Nodes) {
+		fmt.Fprintf(&b, "append %s to %s\n", strings.Join(p.Nodes[node], ", "), node)
+	}
+	keys := make([]string, 0, len(p.Reused))
+	for k := range p.Reused {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&b, "reuse %s for %s\n", p.Reused[k], k)
+	}
+	for _, i := range p.Issues {
+		fmt.Fprintf(&b, "issue %s\n", i)
+	}
+	return b.String()
+}
+
+func sortedNodes(nodes map[string][]string) []string {
+	var names []string
+	for _, n := range []string{RulesNode, NATNode, MasqueradeNode} {
+		if len(nodes[n]) > 0 {
+			names = append(names, n)
+		}
+	}
+	return names
+}
+
+// Apply creates the objects in order and appends the created rules to the current value of the
+// ordered nodes. The confd session is closed once all requests are done. It returns the References
+// of the created objects by placeholder.
+//
+// If a request fails the nodes are restored and the created objects are deleted. The returned error
+// lists the rollback requests which failed as well.
+func (p *Plan) Apply(c sophos.ClientInterface, options ...sophos.Option) (_ map[string]string, err error) {
+	defer func() {
+		if cerr := sophos.CloseSession(c, options...); cerr != nil && err == nil {
+			err = fmt.Errorf("migrate: %s", cerr.Error())
+		}
+	}()
+	created := map[string]string{}
+	var (
+		order    []string
+		restored = map[string][]string{}
+	)
+	fail := func(err error) (map[string]string, error) {
+		var rerrs []string
+		for _, node := range sortedNodes(restored) {
+			byt, jerr := json.Marshal(restored[node])
+			if jerr == nil {
+				_, jerr = c.Put("/api/nodes/"+node, bytes.NewReader(byt), options...)
+			}
+			if jerr != nil {
+				rerrs = append(rerrs, fmt.Sprintf("restoring node %s: %s", node, jerr.Error()))
+			}
+		}
+		for i := len(order) - 1; i >= 0; i-- {
+			if _, derr := c.Delete(order[i], options...); derr != nil {
+				rerrs = append(rerrs, fmt.Sprintf("deleting %s: %s", order[i], derr.Error()))
+			}
+		}
+		if len(rerrs) > 0 {
+			return nil, fmt.Errorf("migrate: %s (rollback failed: %s)", err.Error(), strings.Join(rerrs, "; "))
+		}
+		return nil, fmt.Errorf("migrate: %s, changes were rolled back", err.Error())
+	}
+
+	for _, o := range p.Objects {
+		byt, err := json.Marshal(replacePlaceholders(o.Attributes, created))
+		if err != nil {
+			return fail(err)
+		}
+		path := "/api/objects/" + o.Type + "/"
+		res, err := c.Post(path, bytes.NewReader(byt), options...)
+		if err != nil {
+			return fail(fmt.Errorf("error creating %s %q: %s", o.Type, o.Name, err.Error()))
+		}
+		var obj struct {
+			Reference string `json:"_ref"`
+		}
+		if err := res.MarshalTo(&obj); err != nil || obj.Reference == "" {
+			return fail(fmt.Errorf("no reference returned creating %s %q", o.Type, o.Name))
+		}
+		created[o.Reference] = obj.Reference
+		order = append(order, path+obj.Reference)
+	}
+
+	for _, node := range sortedNodes(p.Nodes) {
+		res, err := c.Get("/api/nodes/"+node, options...)
+		if err != nil {
+			return fail(fmt.Errorf("error retrieving node %s: %s", node, err.Error()))
+		}
+		var refs []string
+		if err := res.MarshalTo(&refs); err != nil {
+			return fail(err)
+		}
+		value := append(refs[:len(refs):len(refs)], replacePlaceholders(p.Nodes[node], created).([]string)...)
+		byt, err := json.Marshal(value)
+		if err != nil {
+			return fail(err)
+		}
+		if _, err := c.Put("/api/nodes/"+node, bytes.NewReader(byt), options...); err != nil {
+			return fail(fmt.Errorf("error updating node %s: %s", node, err.Error()))
+		}
+		restored[node] = refs
+	}
+	return created, nil
+}
+
+// replacePlaceholders returns the value with the placeholder References replaced
+func replacePlaceholders(v interface{}, created map[string]string) interface{} {
+	switch t := v.(type) {
+	case string:
+		if ref, ok := created[t]; ok {
+			return ref
+		}
+		return t
+	case []string:
+		out := make([]string, len(t))
+		for i, s := range t {
+			out[i] = replacePlaceholders(s, created).(string)
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			out[k] = replacePlaceholders(e, created)
+		}
+		return out
+	default:
+		return v
+	}
+}
@@ -0,0 +1,337 @@
+// Package netset resolves network objects into normalized IPv4 and IPv6 address sets
+package netset
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+// family is the address family of a range
+type family int
+
+const (
+	v4 family = iota
+	v6
+)
+
+func (f family) bits() int {
+	if f == v4 {
+		return 32
+	}
+	return 128
+}
+
+func (f family) max() Uint128 { return ones(f.bits()) }
+
+func fromIP(ip net.IP) (family, Uint128, bool) {
+	if ip4 := ip.To4(); ip4 != nil {
+		return v4, Uint64(uint64(ip4[0])<<24 | uint64(ip4[1])<<16 | uint64(ip4[2])<<8 | uint64(ip4[3])), true
+	}
+	u, err := IPValue(ip)
+	if err != nil {
+		return 0, Uint128{}, false
+	}
+	return v6, u, true
+}
+
+func toIP(f family, u Uint128) net.IP {
+	if f == v4 {
+		return net.IPv4(byte(u.Lo>>24), byte(u.Lo>>16), byte(u.Lo>>8), byte(u.Lo)).To4()
+	}
+	return u.ip6()
+}
+
+// rng is the closed interval [lo, hi] of a family
+type rng struct{ lo, hi Uint128 }
+
+// A Range is an inclusive range of addresses of the same family
+type Range struct {
+	From net.IP `json:"from"`
+	To   net.IP `json:"to"`
+}
+
+func (r Range) String() string {
+	if r.From.Equal(r.To) {
+		return r.From.String()
+	}
+	return r.From.String() + "-" + r.To.String()
+}
+
+// A Set is a normalized set of IPv4 and IPv6 addresses. The zero value is the empty set.
+// Sets are immutable, all operations return new Sets.
+type Set struct {
+	// ranges per family, sorted, disjoint and not adjacent
+	ranges [2][]rng
+}
+
+// Any contains all IPv4 and IPv6 addresses
+func Any() Set {
+	return Set{ranges: [2][]rng{{{hi: v4.max()}}, {{hi: v6.max()}}}}
+}
+
+// Any4 contains all IPv4 addresses
+func Any4() Set {
+	return Set{ranges: [2][]rng{{{hi: v4.max()}}, nil}}
+}
+
+// Any6 contains all IPv6 addresses
+func Any6() Set {
+	return Set{ranges: [2][]rng{nil, {{hi: v6.max()}}}}
+}
+
+// FromIP returns the Set containing the single address
+func FromIP(ip net.IP) (Set, error) { return FromRange(ip, ip) }
+
+// FromRange returns the Set containing all addresses from through to
+func FromRange(from, to net.IP) (Set, error) {
+	ff, lo, ok := fromIP(from)
+	ft, hi, ok2 := fromIP(to)
+	if !ok || !ok2 || ff != ft || lo.Cmp(hi) > 0 {
+		return Set{}, fmt.Errorf("netset: invalid range %s-%s", from, to)
+	}
+	var s Set
+	s.ranges[ff] = []rng{{lo, hi}}
+	return s, nil
+}
+
+// FromPrefix returns the Set containing all addresses of the network
+func FromPrefix(n *net.IPNet) (Set, error) {
+	f, lo, ok := fromIP(n.IP)
+	size, total := n.Mask.Size()
+	if !ok || total != f.bits() && !(f == v4 && total == 128 && size >= 96) {
+		return Set{}, fmt.Errorf("netset: invalid prefix %s", n)
+	}
+	if total == 128 && f == v4 {
+		size -= 96
+	}
+	host := ones(f.bits() - size)
+	lo = Uint128{lo.Hi &^ host.Hi, lo.Lo &^ host.Lo}
+	var s Set
+	s.ranges[f] = []rng{{lo, lo.Or(host)}}
+	return s, nil
+}
+
+// Parse returns the union of addresses (10.0.0.1), prefixes (10.0.0.0/8) and ranges (10.0.0.1-10.0.0.9)
+func Parse(ss ...string) (Set, error) {
+	var sets []Set
+	for _, v := range ss {
+		v = strings.TrimSpace(v)
+		var (
+			s   Set
+			err error
+		)
+		switch {
+		case strings.Contains(v, "/"):
+			var n *net.IPNet
+			if _, n, err = net.ParseCIDR(v); err == nil {
+				s, err = FromPrefix(n)
+			}
+		case strings.Contains(v, "-"):
+			parts := strings.SplitN(v, "-", 2)
+			s, err = FromRange(net.ParseIP(strings.TrimSpace(parts[0])), net.ParseIP(strings.TrimSpace(parts[1])))
+		default:
+			s, err = FromIP(net.ParseIP(v))
+		}
+		if err != nil {
+			return Set{}, fmt.Errorf("netset: invalid address %q: %s", v, err.Error())
+		}
+		sets = append(sets, s)
+	}
+	return Union(sets...), nil
+}
+
+// MustParse is like Parse but panics on errors
+func MustParse(ss ...string) Set {
+	s, err := Parse(ss...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Union returns the Set containing the addresses of all sets
+func Union(sets ...Set) Set {
+	var r Set
+	for f := range r.ranges {
+		var all []rng
+		for _, s := range sets {
+			all = append(all, s.ranges[f]...)
+		}
+		r.ranges[f] = normalize(all)
+	}
+	return r
+}
+
+func normalize(rr []rng) []rng {
+	if len(rr) == 0 {
+		return nil
+	}
+	sort.Slice(rr, func(i, j int) bool { return rr[i].lo.Cmp(rr[j].lo) < 0 })
+	out := []rng{rr[0]}
+	for _, r := range rr[1:] {
+		last := &out[len(out)-1]
+		// merge overlapping and adjacent ranges
+		if last.hi.Cmp(r.lo) >= 0 || last.hi.Add1() == r.lo {
+			if r.hi.Cmp(last.hi) > 0 {
+				last.hi = r.hi
+			}
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// Union returns the Set containing the addresses of s and t
+func (s Set) Union(t Set) Set { return Union(s, t) }
+
+// Intersect returns the Set containing the addresses in both s and t
+func (s Set) Intersect(t Set) Set {
+	var r Set
+	for f := range r.ranges {
+		a, b := s.ranges[f], t.ranges[f]
+		for i, j := 0, 0; i < len(a) && j < len(b); {
+			lo, hi := a[i].lo, a[i].hi
+			if b[j].lo.Cmp(lo) > 0 {
+				lo = b[j].lo
+			}
+			if b[j].hi.Cmp(hi) < 0 {
+				hi = b[j].hi
+			}
+			if lo.Cmp(hi) <= 0 {
+				r.ranges[f] = append(r.ranges[f], rng{lo, hi})
+			}
+			if a[i].hi.Cmp(b[j].hi) < 0 {
+				i++
+			} else {
+				j++
+			}
+		}
+	}
+	return r
+}
+
+// Subtract returns the Set containing the addresses of s which are not in t
+func (s Set) Subtract(t Set) Set {
+	var r Set
+	for f := range r.ranges {
+		b := t.ranges[f]
+		for _, a := range s.ranges[f] {
+			lo := a.lo
+			done := false
+			for _, c := range b {
+				if c.hi.Cmp(lo) < 0 {
+					continue
+				}
+				if c.lo.Cmp(a.hi) > 0 {
+					break
+				}
+				if c.lo.Cmp(lo) > 0 {
+					r.ranges[f] = append(r.ranges[f], rng{lo, c.lo.Sub1()})
+				}
+				if c.hi.Cmp(a.hi) >= 0 {
+					done = true
+					break
+				}
+				lo = c.hi.Add1()
+			}
+			if !done {
+				r.ranges[f] = append(r.ranges[f], rng{lo, a.hi})
+			}
+		}
+	}
+	return r
+}
+
+// IsEmpty returns true if the Set contains no address
+func (s Set) IsEmpty() bool { return len(s.ranges[v4]) == 0 && len(s.ranges[v6]) == 0 }
+
+// Equal returns true if both Sets contain the same addresses
+func (s Set) Equal(t Set) bool {
+	for f := range s.ranges {
+		if len(s.ranges[f]) != len(t.ranges[f]) {
+			return false
+		}
+		for i := range s.ranges[f] {
+			if s.ranges[f][i] != t.ranges[f][i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Contains returns true if all addresses of t are in s
+func (s Set) Contains(t Set) bool { return t.Subtract(s).IsEmpty() }
+
+// Overlaps returns true if s and t have any address in common
+func (s Set) Overlaps(t Set) bool { return !s.Intersect(t).IsEmpty() }
+
+// ContainsIP returns true if the address is in s
+func (s Set) ContainsIP(ip net.IP) bool {
+	f, u, ok := fromIP(ip)
+	if !ok {
+		return false
+	}
+	rr := s.ranges[f]
+	i := sort.Search(len(rr), func(i int) bool { return rr[i].hi.Cmp(u) >= 0 })
+	return i < len(rr) && rr[i].lo.Cmp(u) <= 0
+}
+
+// IPv4 returns the IPv4 addresses of s
+func (s Set) IPv4() Set { return Set{ranges: [2][]rng{s.ranges[v4], nil}} }
+
+// IPv6 returns the IPv6 addresses of s
+func (s Set) IPv6() Set { return Set{ranges: [2][]rng{nil, s.ranges[v6]}} }
+
+// Ranges returns the normalized address ranges of s, IPv4 before IPv6
+func (s Set) Ranges() []Range {
+	var out []Range
+	for f, rr := range s.ranges {
+		for _, r := range rr {
+			out = append(out, Range{From: toIP(family(f), r.lo), To: toIP(family(f), r.hi)})
+		}
+	}
+	return out
+}
+
+// Prefixes returns the minimal list of prefixes covering exactly the addresses of s, IPv4 before IPv6
+func (s Set) Prefixes() []*net.IPNet {
+	var out []*net.IPNet
+	for fi, rr := range s.ranges {
+		f := family(fi)
+		n := f.bits()
+		for _, r := range rr {
+			lo := r.lo
+			for {
+				k := lo.trailingZeros()
+				if k > n {
+					k = n
+				}
+				last := lo.Or(ones(k))
+				for last.Cmp(r.hi) > 0 {
+					k--
+					last = lo.Or(ones(k))
+				}
+				out = append(out, &net.IPNet{IP: toIP(f, lo), Mask: net.CIDRMask(n-k, n)})
+				if last == r.hi {
+					break
+				}
+				lo = last.Add1()
+			}
+		}
+	}
+	return out
+}
+
+// String returns the comma separated prefixes of s
+func (s Set) String() string {
+	pp := s.Prefixes()
+	ss := make([]string, len(pp))
+	for i, p := range pp {
+		ss[i] = p.String()
+	}
+	return strings.Join(ss, ", ")
+}
@@ -0,0 +1,106 @@
+package netset_test
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/netset"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+func TestSet(t *testing.T) {
+	a := netset.MustParse("10.0.0.0/24", "10.0.1.0/24", "2001:db8::/32")
+	if a.String() != "10.0.0.0/23, 2001:db8::/32" {
+		t.Errorf("adjacent prefixes should have been merged: %s", a)
+	}
+
+	b := netset.MustParse("10.0.0.5-10.0.0.20")
+	if !a.Contains(b) || b.Contains(a) || !a.Overlaps(b) {
+		t.Error("10.0.0.0/23 should contain 10.0.0.5-10.0.0.20")
+	}
+	if got := b.String(); got != "10.0.0.5/32, 10.0.0.6/31, 10.0.0.8/29, 10.0.0.16/30, 10.0.0.20/32" {
+		t.Errorf("unexpected prefixes %s", got)
+	}
+
+	if got := a.Subtract(b).IPv4().String(); got != "10.0.0.0/30, 10.0.0.4/32, 10.0.0.21/32, 10.0.0.22/31, 10.0.0.24/29, 10.0.0.32/27, 10.0.0.64/26, 10.0.0.128/25, 10.0.1.0/24" {
+		t.Errorf("unexpected difference %s", got)
+	}
+	if !a.Intersect(b).Equal(b) {
+		t.Errorf("intersection should equal the range, got %s", a.Intersect(b))
+	}
+	if a.Intersect(netset.MustParse("192.168.0.0/16")).IsEmpty() != true {
+		t.Error("intersection should be empty")
+	}
+
+	if !a.ContainsIP(net.ParseIP("2001:db8::1")) || a.ContainsIP(net.ParseIP("10.0.2.1")) {
+		t.Error("unexpected ContainsIP result")
+	}
+	if got := netset.Any().String(); got != "0.0.0.0/0, ::/0" {
+		t.Errorf("unexpected any %s", got)
+	}
+	if _, err := netset.Parse("10.0.0.9-10.0.0.1"); err == nil {
+		t.Error("reversed ranges should fail")
+	}
+}
+
+func TestUint128(t *testing.T) {
+	u, err := netset.IPValue(net.ParseIP("10.0.0.255"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := u.Add1().IP().String(); got != "10.0.1.0" {
+		t.Errorf("unexpected successor %s", got)
+	}
+	v, _ := netset.IPValue(net.ParseIP("2001:db8::"))
+	if got := v.Sub1().Add(netset.Uint64(2)).IP().String(); got != "2001:db8::1" || v.Sub1().Cmp(v) != -1 {
+		t.Errorf("unexpected carry %s", got)
+	}
+	if _, err := netset.IPValue(net.IP{10, 0, 0}); err == nil {
+		t.Error("invalid addresses should fail")
+	}
+}
+
+func TestResolver(t *testing.T) {
+	s := snapshot.New()
+	for _, o := range []interface{}{
+		objects.NetworkNetwork{Reference: "REF_NetNetLan", ObjectType: "network/network", Name: "lan", Address: "10.1.0.0", Netmask: 16, Address6: "2001:db8::", Netmask6: 64},
+		objects.NetworkHost{Reference: "REF_NetHosWeb", ObjectType: "network/host", Name: "web", Address: "10.1.2.3"},
+		objects.NetworkRange{Reference: "REF_NetRanDhcp", ObjectType: "network/range", Name: "dhcp", From: "10.1.2.0", To: "10.1.2.99"},
+		objects.NetworkDnsHost{Reference: "REF_NetDnsExample", ObjectType: "network/dns_host", Name: "example", Hostname: "example.com", Address: "93.184.216.34"},
+		objects.NetworkGroup{Reference: "REF_NetGroAll", ObjectType: "network/group", Name: "all", Members: []string{"REF_NetHosWeb", "REF_NetDnsExample"}},
+		objects.NetworkGroup{Reference: "REF_NetGroLoop", ObjectType: "network/group", Name: "loop", Members: []string{"REF_NetGroLoop"}},
+	} {
+		if err := s.Add(o); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := netset.NewResolver(s)
+	set, exact, err := r.Resolve("REF_NetGroAll")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exact || set.String() != "10.1.2.3/32, 93.184.216.34/32" {
+		t.Errorf("unexpected group %s exact %v", set, exact)
+	}
+	if set, exact, _ := r.Resolve("REF_NetNetLan"); !exact || set.String() != "10.1.0.0/16, 2001:db8::/64" {
+		t.Errorf("unexpected network %s exact %v", set, exact)
+	}
+	if _, _, err := r.Resolve("REF_NetGroLoop"); err == nil {
+		t.Error("reference cycles should fail")
+	}
+	if set, _, _ := r.Resolve(sophos.RefNetworkAny); !set.Equal(netset.Any()) {
+		t.Errorf("unexpected any %s", set)
+	}
+	if set, _, _ := r.Resolve(sophos.RefNetworkAny6); set.String() != "::/0" {
+		t.Errorf("unexpected any IPv6 %s", set)
+	}
+
+	want := []string{"REF_NetGroAll", "REF_NetHosWeb", "REF_NetNetLan", "REF_NetRanDhcp"}
+	if got := r.Containing(net.ParseIP("10.1.2.3")); !reflect.DeepEqual(got, want) {
+		t.Errorf("wanted %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,172 @@
+package netset
+
+import (
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+// address is the subset of attributes describing the addresses of network objects
+type address struct {
+	Address  string        `json:"address"`
+	Netmask  interface{}   `json:"netmask"`
+	Address6 string        `json:"address6"`
+	Netmask6 interface{}   `json:"netmask6"`
+	From     string        `json:"from"`
+	To       string        `json:"to"`
+	From6    string        `json:"from6"`
+	To6      string        `json:"to6"`
+	Members  []string      `json:"members"`
+	Addrs    []interface{} `json:"addresses"`
+}
+
+// A Resolver resolves network References of a Snapshot into Sets. Results are cached,
+// a Resolver must not be used concurrently.
+type Resolver struct {
+	s     *snapshot.Snapshot
+	cache map[string]result
+}
+
+type result struct {
+	set   Set
+	exact bool
+	err   error
+}
+
+// NewResolver returns a Resolver of the Snapshot's network objects
+func NewResolver(s *snapshot.Snapshot) *Resolver {
+	return &Resolver{s: s, cache: map[string]result{}}
+}
+
+// Resolve returns the addresses of the network object, groups are resolved recursively.
+//
+// exact is false if the addresses are only known to the UTM at runtime and may change,
+// e.g. DNS hosts and interface networks. Their addresses at the time of the Snapshot are returned.
+func (r *Resolver) Resolve(ref string) (set Set, exact bool, err error) {
+	res := r.resolve(ref)
+	return res.set, res.exact, res.err
+}
+
+func (r *Resolver) resolve(ref string) result {
+	if res, ok := r.cache[ref]; ok {
+		return res
+	}
+	// mark as in progress to break reference cycles
+	r.cache[ref] = result{err: fmt.Errorf("netset: reference cycle at %s", ref)}
+	res := r.resolveObject(ref)
+	r.cache[ref] = res
+	return res
+}
+
+func (r *Resolver) resolveObject(ref string) result {
+	switch ref {
+	case sophos.RefNetworkAny:
+		return result{set: Any(), exact: true}
+	case sophos.RefNetworkAny4:
+		return result{set: Any4(), exact: true}
+	case sophos.RefNetworkAny6:
+		return result{set: Any6(), exact: true}
+	}
+	objType := r.s.Type(ref)
+	if objType == "" {
+		return result{err: fmt.Errorf("%s: %s", snapshot.ErrNotFound, ref)}
+	}
+	var a address
+	if err := r.s.Decode(ref, &a); err != nil {
+		return result{err: err}
+	}
+
+	res := result{exact: true}
+	var sets []Set
+	add := func(s Set, err error) {
+		if err == nil {
+			sets = append(sets, s)
+		}
+	}
+	switch objType {
+	case "network/any":
+		sets = append(sets, Any())
+	case "network/group", "network/dns_group", "network/availability_group":
+		for _, m := range a.Members {
+			mr := r.resolve(m)
+			if mr.err != nil {
+				return mr
+			}
+			sets = append(sets, mr.set)
+			res.exact = res.exact && mr.exact
+		}
+		if objType == "network/group" {
+			break
+		}
+		fallthrough
+	case "network/host", "network/network", "network/multicast", "network/interface_network",
+		"network/interface_address", "network/interface_broadcast", "network/dns_host", "network/aaa":
+		add(prefix(a.Address, a.Netmask))
+		add(prefix(a.Address6, a.Netmask6))
+		for _, addr := range a.Addrs {
+			if s, ok := addr.(string); ok {
+				add(prefix(s, nil))
+			}
+		}
+		// dynamic objects are only known while resolved on the UTM and may change
+		switch objType {
+		case "network/host", "network/network", "network/multicast":
+		default:
+			res.exact = false
+		}
+	case "network/range":
+		add(FromRange(net.ParseIP(a.From), net.ParseIP(a.To)))
+		add(FromRange(net.ParseIP(a.From6), net.ParseIP(a.To6)))
+	default:
+		return result{err: fmt.Errorf("netset: %s is not a network object (%s)", ref, objType)}
+	}
+	res.set = Union(sets...)
+	if res.set.IsEmpty() {
+		res.exact = false
+	}
+	return res
+}
+
+// prefix returns the Set of the address and its netmask (prefix length).
+// Without netmask the address is a single host.
+func prefix(addr string, netmask interface{}) (Set, error) {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return Set{}, fmt.Errorf("netset: invalid address %q", addr)
+	}
+	bits := 128
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, bits = ip4, 32
+	}
+	size := bits
+	switch n := netmask.(type) {
+	case float64:
+		size = int(n)
+	case string:
+		fmt.Sscan(n, &size)
+	}
+	if size < 0 || size > bits {
+		return Set{}, fmt.Errorf("netset: invalid netmask %v of %s", netmask, addr)
+	}
+	return FromPrefix(&net.IPNet{IP: ip, Mask: net.CIDRMask(size, bits)})
+}
+
+// Containing returns the sorted References of the network objects containing the address.
+// Types limit the objects, e.g. network/host or network/*, all network objects are searched when empty.
+// Objects which can not be resolved are skipped.
+func (r *Resolver) Containing(ip net.IP, types ...string) []string {
+	if len(types) == 0 {
+		types = []string{"network/*"}
+	}
+	var refs []string
+	for _, ref := range r.s.References(types...) {
+		if res := r.resolve(ref); res.err == nil && res.set.ContainsIP(ip) {
+			refs = append(refs, ref)
+		}
+	}
+	sort.Strings(refs)
+	return refs
+}
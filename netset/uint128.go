@@ -0,0 +1,108 @@
+package netset
+
+import (
+	"fmt"
+	"math/bits"
+	"net"
+)
+
+// A Uint128 is an unsigned 128 bit integer, it is the numeric value of an IPv6 address
+type Uint128 struct{ Hi, Lo uint64 }
+
+// Uint64 returns the Uint128 of v
+func Uint64(v uint64) Uint128 { return Uint128{Lo: v} }
+
+// IPValue returns the value of the IPv6 address, IPv4 addresses are valued as their IPv4-mapped
+// IPv6 address
+func IPValue(ip net.IP) (Uint128, error) {
+	ip16 := ip.To16()
+	if ip16 == nil {
+		return Uint128{}, fmt.Errorf("netset: invalid IP address %s", ip)
+	}
+	var u Uint128
+	for i := 0; i < 8; i++ {
+		u.Hi = u.Hi<<8 | uint64(ip16[i])
+		u.Lo = u.Lo<<8 | uint64(ip16[i+8])
+	}
+	return u, nil
+}
+
+// IP returns the address of the value, IPv4-mapped addresses are returned as IPv4 addresses
+func (u Uint128) IP() net.IP {
+	ip := u.ip6()
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4
+	}
+	return ip
+}
+
+func (u Uint128) ip6() net.IP {
+	ip := make(net.IP, net.IPv6len)
+	for i := 0; i < 8; i++ {
+		ip[7-i] = byte(u.Hi >> (8 * uint(i)))
+		ip[15-i] = byte(u.Lo >> (8 * uint(i)))
+	}
+	return ip
+}
+
+// Cmp returns -1, 0 or 1 if u is less than, equal to or greater than v
+func (u Uint128) Cmp(v Uint128) int {
+	switch {
+	case u.Hi < v.Hi:
+		return -1
+	case u.Hi > v.Hi:
+		return 1
+	case u.Lo < v.Lo:
+		return -1
+	case u.Lo > v.Lo:
+		return 1
+	}
+	return 0
+}
+
+// Add returns u+v, it wraps around on overflow
+func (u Uint128) Add(v Uint128) Uint128 {
+	w := Uint128{u.Hi + v.Hi, u.Lo + v.Lo}
+	if w.Lo < u.Lo {
+		w.Hi++
+	}
+	return w
+}
+
+// Sub returns u-v, it wraps around on underflow
+func (u Uint128) Sub(v Uint128) Uint128 {
+	w := Uint128{u.Hi - v.Hi, u.Lo - v.Lo}
+	if u.Lo < v.Lo {
+		w.Hi--
+	}
+	return w
+}
+
+// Add1 returns u+1
+func (u Uint128) Add1() Uint128 { return u.Add(Uint64(1)) }
+
+// Sub1 returns u-1
+func (u Uint128) Sub1() Uint128 { return u.Sub(Uint64(1)) }
+
+// Or returns the bitwise or of u and v
+func (u Uint128) Or(v Uint128) Uint128 { return Uint128{u.Hi | v.Hi, u.Lo | v.Lo} }
+
+// ones returns a Uint128 with the lower n bits set
+func ones(n int) Uint128 {
+	switch {
+	case n <= 0:
+		return Uint128{}
+	case n < 64:
+		return Uint128{Lo: 1<<uint(n) - 1}
+	case n < 128:
+		return Uint128{Hi: 1<<uint(n-64) - 1, Lo: ^uint64(0)}
+	}
+	return Uint128{^uint64(0), ^uint64(0)}
+}
+
+func (u Uint128) trailingZeros() int {
+	if u.Lo != 0 {
+		return bits.TrailingZeros64(u.Lo)
+	}
+	return 64 + bits.TrailingZeros64(u.Hi)
+}
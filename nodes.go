@@ -5,8 +5,9 @@ package sophos
 type Endpoint interface {
 	// Definition returns the endpoint Definition
 	Definition() Definition
-	// RestObjects returns a map of the Node's editable Objects
-	RestObjects() map[string]RestObject
+	// RestObjects returns a map of the Node's Objects, their capabilities depend on the paths
+	// declared by the definition (see Creatable, Updatable, Patchable, Deletable and UsedByer)
+	RestObjects() map[string]RestGetter
 	// ApiRoutes returns all known Node paths
 	ApiRoutes() []string
 }
@@ -43,13 +44,42 @@ type UsedBy struct {
 	Objects []Reference
 }
 
-// RestObject is an interface for REST objects
+// RestObject is an interface for REST objects supporting every operation
 type RestObject interface {
+	RestGetter
+	Creatable
+	Updatable
+	Patchable
+	Deletable
+	UsedByer
+}
+
+// Creatable is an Object whose definition declares a POST path
+type Creatable interface {
+	PostPath() string // PostPath returns the POST path of the Object
+}
+
+// Updatable is an Object whose definition declares a PUT path
+type Updatable interface {
+	RestGetter
+	PutPath(ref string) string // PutPath returns the PUT path of the Object
+}
+
+// Patchable is an Object whose definition declares a PATCH path
+type Patchable interface {
+	RestGetter
+	PatchPath(ref string) string // PatchPath returns the PATCH path of the Object
+}
+
+// Deletable is an Object whose definition declares a DELETE path
+type Deletable interface {
 	RestGetter
 	DeletePath(ref string) string // DeletePath returns the DELETE path of the Object
-	PatchPath(ref string) string  // PatchPath returns the PATCH path of the Object
-	PostPath() string             // PostPath returns the POST path of the Object
-	PutPath(ref string) string    // GetPath returns the PUT path of the Object
+}
+
+// UsedByer is an Object whose definition declares a usedby path
+type UsedByer interface {
+	RestGetter
 	UsedByPath(ref string) string // UsedByPath returns the usedby URL path to query for UsedBy data
 }
 
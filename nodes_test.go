@@ -1,9 +1,11 @@
 package sophos_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
 )
 
 func TestIsReference(t *testing.T) {
@@ -12,3 +14,78 @@ func TestIsReference(t *testing.T) {
 		t.Error("abc should not be a Reference")
 	}
 }
+
+func TestParseRefConstraints(t *testing.T) {
+	rr := sophos.ParseRefConstraints("REF(network/host), REF(network/dns_host), REF(service/*)")
+	if len(rr) != 3 {
+		t.Fatalf("wanted 3 constraints, got %v", rr)
+	}
+	if rr[1].String() != "REF(network/dns_host)" {
+		t.Errorf("unexpected constraint %s", rr[1])
+	}
+	tests := []struct {
+		objType string
+		want    bool
+	}{
+		{"network/host", true},
+		{"network/dns_host", true},
+		{"network/network", false},
+		{"service/tcp", true},
+		{"tcp", false},
+	}
+	for _, tt := range tests {
+		if got := sophos.AllowsAny(rr, tt.objType); got != tt.want {
+			t.Errorf("AllowsAny(%s) = %v, want %v", tt.objType, got, tt.want)
+		}
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	var _ sophos.RestObject = &objects.NetworkHost{}
+	var o interface{} = &objects.StatusVersion{}
+	if _, ok := o.(sophos.RestGetter); !ok {
+		t.Error("StatusVersion should be a RestGetter")
+	}
+	for name, ok := range map[string]bool{
+		"Creatable": isCreatable(o),
+		"Updatable": isUpdatable(o),
+		"Patchable": isPatchable(o),
+		"Deletable": isDeletable(o),
+		"UsedByer":  isUsedByer(o),
+	} {
+		if ok {
+			t.Errorf("read-only StatusVersion should not be %s", name)
+		}
+	}
+}
+
+func isCreatable(o interface{}) bool { _, ok := o.(sophos.Creatable); return ok }
+func isUpdatable(o interface{}) bool { _, ok := o.(sophos.Updatable); return ok }
+func isPatchable(o interface{}) bool { _, ok := o.(sophos.Patchable); return ok }
+func isDeletable(o interface{}) bool { _, ok := o.(sophos.Deletable); return ok }
+func isUsedByer(o interface{}) bool  { _, ok := o.(sophos.UsedByer); return ok }
+
+func TestClassReferences(t *testing.T) {
+	host := &objects.NetworkHost{Reference: "REF_NetHosWeb"}
+	http := &objects.ServiceTcp{Reference: "REF_SerTcpHttp"}
+	rule := objects.NewPacketfilterPacketfilter()
+	rule.Sources.Add(host)
+	rule.Services.Add(http)
+	rule.Destinations = append(rule.Destinations, sophos.RefNetworkAny)
+
+	want := []sophos.ClassReference{
+		{Field: "destinations", Class: "network", Reference: sophos.RefNetworkAny},
+		{Field: "services", Class: "service", Reference: "REF_SerTcpHttp"},
+		{Field: "sources", Class: "network", Reference: "REF_NetHosWeb"},
+	}
+	if got := sophos.ClassReferences(rule); !reflect.DeepEqual(got, want) {
+		t.Errorf("ClassReferences() = %v, want %v", got, want)
+	}
+
+	var nat objects.Packetfilter1to1Nat
+	nat.Source.Set(host)
+	nat.Service.Set(http)
+	if nat.Source != "REF_NetHosWeb" || nat.Service.Class() != "service" || len(sophos.ClassReferences(nat)) != 2 {
+		t.Errorf("unexpected references %+v", nat)
+	}
+}
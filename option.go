@@ -1,9 +1,13 @@
 package sophos
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 )
 
@@ -41,9 +45,14 @@ func WithBasicAuth(username, password string) Option {
 }
 
 // WithContext is an Option which sets the provided context to the the client's request.
+// StrictEnums set by a previous Option is kept.
 func WithContext(ctx context.Context) Option {
 	return func(r *http.Request) error {
-		*r = *r.WithContext(ctx)
+		c := ctx
+		if strictEnums(r.Context()) {
+			c = context.WithValue(c, strictEnumsKey{}, true)
+		}
+		*r = *r.WithContext(c)
 		return nil
 	}
 }
@@ -76,6 +85,46 @@ func WithSessionClose(r *http.Request) error {
 	return nil
 }
 
+// CloseSession closes the confd session with a GET request of the version, it is meant to be deferred
+// by functions sending several requests so the session is closed on every return.
+func CloseSession(c ClientInterface, options ...Option) error {
+	if _, err := c.Get("/api/status/version", append(options[:len(options):len(options)], WithSessionClose)...); err != nil {
+		return fmt.Errorf("error closing session: %s", err.Error())
+	}
+	return nil
+}
+
+// OmitUnsetFields is an Option which removes the fields with zero values (null, false, 0, "", [] and {})
+// from a JSON object body so confd applies its own defaults, e.g. when POSTing an object with PostObject.
+// Zero values cannot be told from unset ones: to send false or 0 for a field with another default use
+// the object's NewXxx constructor without this Option.
+func OmitUnsetFields(r *http.Request) error {
+	if r.Body == nil {
+		return nil
+	}
+	byt, err := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return fmt.Errorf("error reading body: %s", err.Error())
+	}
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(byt, &fields) == nil && fields != nil {
+		for k, v := range fields {
+			switch string(bytes.TrimSpace(v)) {
+			case "null", "false", "0", `""`, "[]", "{}":
+				delete(fields, k)
+			}
+		}
+		if byt, err = json.Marshal(fields); err != nil {
+			return fmt.Errorf("error encoding body: %s", err.Error())
+		}
+	}
+	r.ContentLength = int64(len(byt))
+	r.Body = ioutil.NopCloser(bytes.NewReader(byt))
+	r.GetBody = func() (io.ReadCloser, error) { return ioutil.NopCloser(bytes.NewReader(byt)), nil }
+	return nil
+}
+
 func evaluateOpts(r *http.Request, oo []Option) error {
 	for _, o := range oo {
 		if err := o(r); err != nil {
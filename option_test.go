@@ -1,12 +1,17 @@
 package sophos_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 
 	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
 )
 
 func TestAutoResolveErrsMode(t *testing.T) {
@@ -256,3 +261,33 @@ func TestWithContext(t *testing.T) {
 		})
 	}
 }
+
+func TestOmitUnsetFields(t *testing.T) {
+	lb := objects.NewPacketfilterLoadbalance()
+	lb.Name = "web"
+	byt, _ := json.Marshal(lb)
+	r := httptest.NewRequest("POST", "/api", bytes.NewReader(byt))
+	if err := sophos.OmitUnsetFields(r); err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(r.Body)
+	var got map[string]interface{}
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{"_type": "packetfilter/loadbalance", "auto_pfrule": true, "name": "web"}
+	if !reflect.DeepEqual(got, want) || r.ContentLength != int64(len(body)) {
+		t.Errorf("OmitUnsetFields() = %s, want %v", body, want)
+	}
+
+	r = httptest.NewRequest("POST", "/api", bytes.NewReader([]byte(`[0, ""]`)))
+	if err := sophos.OmitUnsetFields(r); err != nil {
+		t.Fatal(err)
+	}
+	if body, _ := ioutil.ReadAll(r.Body); string(body) != `[0, ""]` {
+		t.Errorf("bodies which are not objects should be kept, got %s", body)
+	}
+	if err := sophos.OmitUnsetFields(httptest.NewRequest("GET", "/api", nil)); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,219 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+// Kinds of Findings
+const (
+	// Shadowed rules never match, all their traffic is handled by earlier rules with a different action
+	Shadowed = "shadowed"
+	// Redundant rules can be removed without changing the policy
+	Redundant = "redundant"
+	// Conflict rules partially overlap an earlier rule with a different action
+	Conflict = "conflict"
+	// MissingReference rules use objects which do not exist (anymore)
+	MissingReference = "missing_reference"
+	// Inexact rules use objects which can not be resolved offline (e.g. DNS hosts) and were not analyzed
+	Inexact = "inexact"
+)
+
+// RuleID identifies a Rule in a Finding
+type RuleID struct {
+	Position  int    `json:"position"`
+	Reference string `json:"reference"`
+	Name      string `json:"name"`
+}
+
+func (id RuleID) String() string { return fmt.Sprintf("#%d %q", id.Position, id.Name) }
+
+func ruleID(r *Rule) RuleID {
+	return RuleID{Position: r.Position, Reference: r.Reference, Name: r.Name}
+}
+
+// A Finding is an issue of a Rule, Related contains the rules causing it
+type Finding struct {
+	Kind    string   `json:"kind"`
+	Rule    RuleID   `json:"rule"`
+	Related []RuleID `json:"related,omitempty"`
+	Message string   `json:"message"`
+}
+
+// Report is the result of Analyze
+type Report struct {
+	// Rules is the number of analyzed rules
+	Rules    int       `json:"rules"`
+	Findings []Finding `json:"findings"`
+}
+
+// Analyze analyzes the packet filter rules of the Snapshot. It reports rules which are shadowed
+// by or redundant to other rules, rules conflicting with earlier rules and rules using deleted objects.
+//
+// Only enabled rules are compared. Rules only apply to the traffic of another rule if their
+// interface, time and direction are unset or equal. Traffic not matched by any rule is dropped.
+func Analyze(s *snapshot.Snapshot) (*Report, error) {
+	rules, err := Rules(s)
+	if err != nil {
+		return nil, err
+	}
+	return AnalyzeRules(rules), nil
+}
+
+// AnalyzeRules analyzes the rules as returned by Rules, see Analyze
+func AnalyzeRules(rules []*Rule) *Report {
+	report := &Report{Rules: len(rules)}
+	add := func(kind string, r *Rule, related []*Rule, format string, args ...interface{}) {
+		f := Finding{Kind: kind, Rule: ruleID(r), Message: fmt.Sprintf(format, args...)}
+		for _, o := range related {
+			f.Related = append(f.Related, ruleID(o))
+		}
+		report.Findings = append(report.Findings, f)
+	}
+
+	var active []*Rule
+	for _, r := range rules {
+		switch {
+		case len(r.Missing) > 0 && !r.Status:
+			add(MissingReference, r, nil, "disabled rule uses deleted objects %s", strings.Join(r.Missing, ", "))
+		case len(r.Missing) > 0:
+			add(MissingReference, r, nil, "rule uses deleted objects %s", strings.Join(r.Missing, ", "))
+		case r.Status && !r.Exact:
+			add(Inexact, r, nil, "rule uses objects which can not be resolved offline")
+		case r.Status && len(r.space) > 0:
+			active = append(active, r)
+		}
+	}
+
+	// rules whose traffic is fully matched by earlier rules are never applied and ignored below
+	dead := map[*Rule]bool{}
+	for i, r := range active {
+		if related, sameAct, ok := coveredByEarlier(active[:i], r); ok {
+			if sameAct {
+				add(Redundant, r, related, "all traffic is matched by earlier rules with the same action")
+			} else {
+				add(Shadowed, r, related, "all traffic is matched by earlier rules, the rule never applies")
+			}
+			dead[r] = true
+		}
+	}
+	alive := func(rr []*Rule) []*Rule {
+		var out []*Rule
+		for _, r := range rr {
+			if !dead[r] {
+				out = append(out, r)
+			}
+		}
+		return out
+	}
+
+	for i, r := range active {
+		if dead[r] {
+			continue
+		}
+		earlier := alive(active[:i])
+		if related, ok := coveredByLater(earlier, alive(active[i+1:]), r); ok {
+			if len(related) == 0 {
+				add(Redundant, r, nil, "rule only drops traffic which is dropped by default")
+			} else {
+				add(Redundant, r, related, "removing the rule does not change the policy, its traffic is handled by later rules with the same action")
+			}
+			continue
+		}
+		for _, e := range earlier {
+			if !sameAction(e.Action, r.Action) && conditionsOverlap(e, r) && e.space.overlaps(r.space) &&
+				!r.space.within(e.space) && !e.space.within(r.space) {
+				add(Conflict, r, []*Rule{e}, "rule partially overlaps earlier rule %s which will %s the overlapping traffic", e, e.Action)
+			}
+		}
+	}
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		return report.Findings[i].Rule.Position < report.Findings[j].Rule.Position
+	})
+	return report
+}
+
+// coveredByEarlier returns the earlier rules overlapping r if they match all traffic of r,
+// sameAct is true if all of them have the same action as r
+func coveredByEarlier(earlier []*Rule, r *Rule) (related []*Rule, sameAct bool, ok bool) {
+	rem, sameAct := r.space, true
+	for _, e := range earlier {
+		if !e.covers(r) || !e.space.overlaps(rem) {
+			continue
+		}
+		related = append(related, e)
+		sameAct = sameAct && sameAction(e.Action, r.Action)
+		if rem, ok = rem.subtract(e.space); !ok {
+			return nil, false, false
+		}
+		if len(rem) == 0 {
+			return related, sameAct, true
+		}
+	}
+	return nil, false, false
+}
+
+// coveredByLater returns the later rules which would match the traffic of r if r was removed.
+// ok is false if removing r changes the policy.
+func coveredByLater(earlier, later []*Rule, r *Rule) (related []*Rule, ok bool) {
+	rem := r.space
+	for _, e := range earlier {
+		if e.covers(r) {
+			if rem, ok = rem.subtract(e.space); !ok {
+				return nil, false
+			}
+		}
+	}
+	for _, l := range later {
+		if len(rem) == 0 {
+			return related, true
+		}
+		if !conditionsOverlap(l, r) || !l.space.overlaps(rem) {
+			continue
+		}
+		if !sameAction(l.Action, r.Action) || !l.covers(r) {
+			return nil, false
+		}
+		related = append(related, l)
+		if rem, ok = rem.subtract(l.space); !ok {
+			return nil, false
+		}
+	}
+	// remaining traffic is dropped by default
+	return related, len(rem) == 0 || r.Action == ActionDrop
+}
+
+// conditionsOverlap returns true if traffic exists which both rules could apply to
+func conditionsOverlap(a, b *Rule) bool {
+	return (a.Interface == "" || b.Interface == "" || a.Interface == b.Interface) &&
+		(a.Time == "" || b.Time == "" || a.Time == b.Time) &&
+		(a.Direction == "" || b.Direction == "" || a.Direction == b.Direction)
+}
+
+// WriteTable writes the Findings as a table
+func (r *Report) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "POSITION\tRULE\tKIND\tRELATED\tMESSAGE")
+	for _, f := range r.Findings {
+		related := make([]string, len(f.Related))
+		for i, id := range f.Related {
+			related[i] = fmt.Sprintf("#%d", id.Position)
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\n", f.Rule.Position, f.Rule.Name, f.Kind, strings.Join(related, ","), f.Message)
+	}
+	fmt.Fprintf(tw, "\n%d findings in %d rules\n", len(r.Findings), r.Rules)
+	return tw.Flush()
+}
+
+// WriteJSON writes the report as JSON
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
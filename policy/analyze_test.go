@@ -0,0 +1,128 @@
+package policy_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/policy"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+func rule(ref, name string, action objects.PacketfilterPacketfilterAction, src, dst, svc string) objects.PacketfilterPacketfilter {
+	return objects.PacketfilterPacketfilter{
+		Reference:    ref,
+		ObjectType:   "packetfilter/packetfilter",
+		Name:         name,
+		Action:       action,
+		Sources:      []string{src},
+		Destinations: []string{dst},
+		Services:     []string{svc},
+		Status:       true,
+	}
+}
+
+func policySnapshot(t *testing.T, rules ...objects.PacketfilterPacketfilter) *snapshot.Snapshot {
+	s := snapshot.New()
+	for _, o := range []interface{}{
+		objects.NetworkNetwork{Reference: "REF_NetNetLan", ObjectType: "network/network", Name: "lan", Address: "10.0.0.0", Netmask: 24},
+		objects.NetworkHost{Reference: "REF_NetHosClient", ObjectType: "network/host", Name: "client", Address: "10.0.0.5"},
+		objects.NetworkHost{Reference: "REF_NetHosWeb", ObjectType: "network/host", Name: "web", Address: "10.0.1.1"},
+		objects.ServiceTcp{Reference: "REF_SerTcpHttps", ObjectType: "service/tcp", Name: "HTTPS", DstLow: 443, DstHigh: 443, SrcLow: 1, SrcHigh: 65535},
+	} {
+		if err := s.Add(o); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var refs []string
+	for _, r := range rules {
+		if err := s.Add(r); err != nil {
+			t.Fatal(err)
+		}
+		refs = append(refs, r.Reference)
+	}
+	if err := s.SetNode(policy.RulesNode, refs); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestAnalyze(t *testing.T) {
+	gone := rule("REF_PacPacGone", "Gone", policy.ActionAccept, "REF_NetHosGone", "REF_NetHosWeb", "REF_SerTcpHttps")
+	gone.Status = false
+
+	s := policySnapshot(t,
+		rule("REF_PacPacHttps", "LAN HTTPS", policy.ActionAccept, "REF_NetNetLan", "REF_NetHosWeb", "REF_SerTcpHttps"),
+		rule("REF_PacPacHttps2", "LAN HTTPS again", policy.ActionAccept, "REF_NetNetLan", "REF_NetHosWeb", "REF_SerTcpHttps"),
+		rule("REF_PacPacBlock", "Block client HTTPS", policy.ActionDrop, "REF_NetHosClient", "REF_NetHosWeb", "REF_SerTcpHttps"),
+		rule("REF_PacPacBlockAll", "Block client", policy.ActionDrop, "REF_NetHosClient", sophos.RefNetworkAny, sophos.RefServiceAny),
+		rule("REF_PacPacLan", "LAN", policy.ActionAccept, "REF_NetNetLan", sophos.RefNetworkAny, sophos.RefServiceAny),
+		gone,
+		rule("REF_PacPacDrop", "Drop", policy.ActionDrop, sophos.RefNetworkAny, sophos.RefNetworkAny, sophos.RefServiceAny),
+	)
+
+	report, err := policy.Analyze(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		kind     string
+		position int
+		related  []int
+	}{
+		{policy.Redundant, 2, []int{1}},
+		{policy.Shadowed, 3, []int{1}},
+		{policy.Conflict, 4, []int{1}},
+		{policy.MissingReference, 6, nil},
+		{policy.Redundant, 7, nil},
+	}
+	if len(report.Findings) != len(want) {
+		t.Fatalf("wanted %d findings, got %+v", len(want), report.Findings)
+	}
+	for i, w := range want {
+		f := report.Findings[i]
+		if f.Kind != w.kind || f.Rule.Position != w.position || len(f.Related) != len(w.related) {
+			t.Errorf("wanted %s of #%d related %v, got %+v", w.kind, w.position, w.related, f)
+			continue
+		}
+		for j, p := range w.related {
+			if f.Related[j].Position != p {
+				t.Errorf("wanted #%d related to #%d, got %+v", w.position, p, f.Related)
+			}
+		}
+	}
+	if !strings.Contains(report.Findings[3].Message, "REF_NetHosGone") {
+		t.Errorf("missing reference should be named: %s", report.Findings[3].Message)
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteTable(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "Block client HTTPS") || !strings.Contains(buf.String(), "5 findings in 7 rules") {
+		t.Errorf("unexpected table:\n%s", buf.String())
+	}
+}
+
+func TestAnalyzeConditions(t *testing.T) {
+	eth0 := rule("REF_PacPacEth0", "eth0", policy.ActionAccept, "REF_NetNetLan", "REF_NetHosWeb", "REF_SerTcpHttps")
+	eth0.Interface = "REF_ItfEthEth0"
+	s := policySnapshot(t,
+		eth0,
+		rule("REF_PacPacBlock", "Block client", policy.ActionDrop, "REF_NetHosClient", "REF_NetHosWeb", "REF_SerTcpHttps"),
+		rule("REF_PacPacHttps", "LAN HTTPS", policy.ActionAccept, "REF_NetNetLan", "REF_NetHosWeb", "REF_SerTcpHttps"),
+	)
+	s.Add(map[string]interface{}{"_ref": "REF_ItfEthEth0", "_type": "interface/ethernet", "name": "eth0"})
+
+	report, err := policy.Analyze(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the interface rule only applies to part of the traffic and shadows nothing
+	if len(report.Findings) != 0 {
+		t.Errorf("wanted no findings, got %+v", report.Findings)
+	}
+}
@@ -0,0 +1,425 @@
+package policy
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/netset"
+	"github.com/esurdam/go-sophos/snapshot"
+	"github.com/esurdam/go-sophos/svcset"
+)
+
+// Nodes containing the ordered NAT rules
+const (
+	NATNode        = "nat.rules"
+	MasqueradeNode = "masq.rules"
+)
+
+// A Flow describes the first packet of a connection
+type Flow struct {
+	Src net.IP `json:"src"`
+	Dst net.IP `json:"dst"`
+	// Proto is the protocol name (e.g. tcp, udp, icmp, esp) or number
+	Proto string `json:"proto"`
+	// Port is the destination port, for ICMP the type
+	Port int `json:"port"`
+	// SrcPort is the source port, for ICMP the code. Source ports of services are ignored when 0.
+	SrcPort int `json:"src_port,omitempty"`
+	// Interface is the Reference of the incoming interface.
+	// Rules restricted to an interface are assumed to apply when empty.
+	Interface string `json:"interface,omitempty"`
+	// Time is the time of the packet. Rules restricted by a time object are assumed to apply when zero.
+	Time time.Time `json:"time"`
+}
+
+func (f Flow) String() string {
+	return fmt.Sprintf("%s -> %s %s/%d", f.Src, f.Dst, f.Proto, f.Port)
+}
+
+// A Translation is a NAT rule applied to a Flow
+type Translation struct {
+	// Type is the type of the NAT rule, e.g. packetfilter/nat
+	Type string `json:"type"`
+	// Rule is the NAT rule and its position in nat.rules or masq.rules
+	Rule        RuleID `json:"rule"`
+	Description string `json:"description"`
+}
+
+// Result is the result of Evaluate
+type Result struct {
+	// Flow is the evaluated Flow
+	Flow Flow `json:"flow"`
+	// Filtered is the Flow after destination NAT as matched against the packet filter rules
+	Filtered Flow `json:"filtered"`
+	// Final is the Flow after source NAT as it leaves the UTM
+	Final Flow `json:"final"`
+	// Decision is the action of the matching rule, traffic not matched by any rule is dropped
+	Decision objects.PacketfilterPacketfilterAction `json:"decision"`
+	// Rule is the matching rule, nil if the Flow is dropped by default
+	Rule *RuleID `json:"rule,omitempty"`
+	// Translations are the applied NAT rules in order
+	Translations []Translation `json:"translations,omitempty"`
+	// Notes explain assumptions made during the evaluation, e.g. skipped rules
+	Notes []string `json:"notes,omitempty"`
+}
+
+// Allowed returns true if the Flow is accepted
+func (r *Result) Allowed() bool { return r.Decision == ActionAccept }
+
+// Evaluate walks the Flow through the NAT and packet filter rules of the Snapshot and returns
+// the matching rule and its decision.
+//
+// The first matching rule of nat.rules (packetfilter/nat and packetfilter/1to1nat) translates the Flow
+// before the packet filter rules are matched in packetfilter.rules order. NAT rules creating an
+// automatic packet filter rule accept the translated Flow. Accepted Flows without source NAT
+// are masqueraded by the first matching rule of masq.rules.
+func Evaluate(s *snapshot.Snapshot, f Flow) (*Result, error) {
+	proto, err := protocol(f.Proto)
+	if err != nil {
+		return nil, err
+	}
+	if f.Src == nil || f.Dst == nil {
+		return nil, fmt.Errorf("policy: source and destination of flow %s are required", f)
+	}
+	for _, port := range []int{f.Port, f.SrcPort} {
+		if port < 0 || port > 65535 {
+			return nil, fmt.Errorf("policy: port %d of flow %s is not within 0-65535", port, f)
+		}
+	}
+
+	e := &evaluation{s: s, res: newResolver(s), proto: proto}
+	result := &Result{Flow: f, Filtered: f, Decision: ActionDrop}
+
+	post, auto, err := e.nat(result)
+	if err != nil {
+		return nil, err
+	}
+	result.Final = result.Filtered
+
+	if auto != nil {
+		result.Decision, result.Rule = ActionAccept, auto
+	} else if err := e.filter(result); err != nil {
+		return nil, err
+	}
+
+	if result.Decision == ActionAccept {
+		if post != nil {
+			post(result)
+		} else if err := e.masquerade(result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+type evaluation struct {
+	s     *snapshot.Snapshot
+	res   *resolver
+	proto uint64
+}
+
+// point returns the Flow as a point in the traffic space and the dimensions to ignore
+func (e *evaluation) point(f Flow) (p box, ignore []int, err error) {
+	if p[dimSrc], err = ipInterval(f.Src); err != nil {
+		return p, nil, err
+	}
+	if p[dimDst], err = ipInterval(f.Dst); err != nil {
+		return p, nil, err
+	}
+	p[dimProto] = interval{lo: netset.Uint64(e.proto), hi: netset.Uint64(e.proto)}
+	p[dimDstPort] = interval{lo: netset.Uint64(uint64(f.Port)), hi: netset.Uint64(uint64(f.Port))}
+	p[dimSrcPort] = interval{lo: netset.Uint64(uint64(f.SrcPort)), hi: netset.Uint64(uint64(f.SrcPort))}
+	if f.SrcPort == 0 {
+		p[dimSrcPort] = anyPort
+		return p, []int{dimSrcPort}, nil
+	}
+	return p, nil, nil
+}
+
+// match returns true if the Flow matches the sources, destinations and services, empty References match any
+func (e *evaluation) match(f Flow, src, dst, service string) (bool, error) {
+	sp, missing, _ := e.res.space(anyRef(src, sophos.RefNetworkAny), anyRef(dst, sophos.RefNetworkAny), anyRef(service, sophos.RefServiceAny))
+	if len(missing) > 0 {
+		return false, fmt.Errorf("%s: %s", snapshot.ErrNotFound, strings.Join(missing, ", "))
+	}
+	p, ignore, err := e.point(f)
+	if err != nil {
+		return false, err
+	}
+	return sp.matches(p, ignore...), nil
+}
+
+func anyRef(ref, any string) []string {
+	if ref == "" {
+		return []string{any}
+	}
+	return []string{ref}
+}
+
+// nat applies the destination NAT of the first matching rule of nat.rules. It returns the
+// source NAT to apply after filtering and the automatic packet filter rule, if any.
+func (e *evaluation) nat(result *Result) (post func(*Result), auto *RuleID, err error) {
+	refs, err := nodeRefs(e.s, NATNode)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, ref := range refs {
+		var n natRule
+		if err := e.s.Decode(ref, &n); err != nil {
+			result.Notes = append(result.Notes, fmt.Sprintf("NAT rule #%d %s skipped: %s", i+1, ref, err.Error()))
+			continue
+		}
+		if !n.Status {
+			continue
+		}
+		id := RuleID{Position: i + 1, Reference: ref, Name: n.Name}
+		objType := e.s.Type(ref)
+
+		switch objType {
+		case "packetfilter/nat":
+			ok, err := e.match(result.Flow, n.Source, n.Destination, n.Service)
+			if err != nil {
+				result.Notes = append(result.Notes, fmt.Sprintf("NAT rule %s skipped: %s", id, err.Error()))
+				continue
+			}
+			if !ok {
+				continue
+			}
+			if n.DestinationNatAddress != "" || n.DestinationNatService != "" {
+				before := result.Filtered
+				if err := e.translate(&result.Filtered.Dst, &result.Filtered.Port, n.DestinationNatAddress, n.DestinationNatService); err != nil {
+					return nil, nil, fmt.Errorf("policy: NAT rule %s: %s", id, err.Error())
+				}
+				result.Translations = append(result.Translations, Translation{
+					Type: objType, Rule: id,
+					Description: fmt.Sprintf("destination %s/%d translated to %s/%d", before.Dst, before.Port, result.Filtered.Dst, result.Filtered.Port),
+				})
+			}
+			if n.SourceNatAddress != "" || n.SourceNatService != "" {
+				post = func(r *Result) {
+					before := r.Final
+					if err := e.translate(&r.Final.Src, &r.Final.SrcPort, n.SourceNatAddress, n.SourceNatService); err != nil {
+						r.Notes = append(r.Notes, fmt.Sprintf("source NAT of %s skipped: %s", id, err.Error()))
+						return
+					}
+					r.Translations = append(r.Translations, Translation{
+						Type: objType, Rule: id,
+						Description: fmt.Sprintf("source %s translated to %s", before.Src, r.Final.Src),
+					})
+				}
+			}
+		case "packetfilter/1to1nat":
+			ok, err := e.match(result.Flow, n.Source, n.Destination, n.Service)
+			if err != nil {
+				result.Notes = append(result.Notes, fmt.Sprintf("NAT rule %s skipped: %s", id, err.Error()))
+				continue
+			}
+			if !ok {
+				continue
+			}
+			switch n.Mode {
+			case "mapdst":
+				before := result.Filtered.Dst
+				if result.Filtered.Dst, err = e.mapAddress(before, n.Destination, n.MapTo); err != nil {
+					return nil, nil, fmt.Errorf("policy: NAT rule %s: %s", id, err.Error())
+				}
+				result.Translations = append(result.Translations, Translation{
+					Type: objType, Rule: id, Description: fmt.Sprintf("destination %s mapped to %s", before, result.Filtered.Dst),
+				})
+			case "mapsrc":
+				post = func(r *Result) {
+					before := r.Final.Src
+					src, err := e.mapAddress(before, n.Source, n.MapTo)
+					if err != nil {
+						r.Notes = append(r.Notes, fmt.Sprintf("source mapping of %s skipped: %s", id, err.Error()))
+						return
+					}
+					r.Final.Src = src
+					r.Translations = append(r.Translations, Translation{
+						Type: objType, Rule: id, Description: fmt.Sprintf("source %s mapped to %s", before, src),
+					})
+				}
+			}
+		default:
+			continue
+		}
+
+		if n.AutoPfrule {
+			auto = &RuleID{Reference: n.AutoPfIn, Name: fmt.Sprintf("automatic rule of NAT rule %q", n.Name)}
+		}
+		return post, auto, nil
+	}
+	return nil, nil, nil
+}
+
+// filter matches the Flow against packetfilter.rules
+func (e *evaluation) filter(result *Result) error {
+	if !e.s.HasNode(RulesNode) {
+		result.Notes = append(result.Notes, fmt.Sprintf("node %s is not contained in the snapshot", RulesNode))
+		return nil
+	}
+	rules, err := Rules(e.s)
+	if err != nil {
+		return err
+	}
+	p, ignore, err := e.point(result.Filtered)
+	if err != nil {
+		return err
+	}
+	for _, r := range rules {
+		if !r.Status {
+			continue
+		}
+		if len(r.Missing) > 0 {
+			result.Notes = append(result.Notes, fmt.Sprintf("rule %s skipped, it uses deleted objects %s", r, strings.Join(r.Missing, ", ")))
+			continue
+		}
+		if !r.space.matches(p, ignore...) {
+			continue
+		}
+		if r.Interface != "" {
+			if result.Filtered.Interface == "" {
+				result.Notes = append(result.Notes, fmt.Sprintf("rule %s assumed to apply to interface %s", r, e.s.Name(string(r.Interface))))
+			} else if string(r.Interface) != result.Filtered.Interface {
+				continue
+			}
+		}
+		if r.Time != "" {
+			if result.Filtered.Time.IsZero() {
+				result.Notes = append(result.Notes, fmt.Sprintf("rule %s assumed to be active at time %s", r, e.s.Name(string(r.Time))))
+			} else if active, err := e.res.active(string(r.Time), result.Filtered.Time); err != nil {
+				result.Notes = append(result.Notes, fmt.Sprintf("rule %s skipped: %s", r, err.Error()))
+				continue
+			} else if !active {
+				continue
+			}
+		}
+		if !r.Exact {
+			result.Notes = append(result.Notes, fmt.Sprintf("rule %s uses objects which can not be resolved offline", r))
+		}
+		id := ruleID(r)
+		result.Decision, result.Rule = r.Action, &id
+		return nil
+	}
+	return nil
+}
+
+// masquerade applies the first matching rule of masq.rules
+func (e *evaluation) masquerade(result *Result) error {
+	refs, err := nodeRefs(e.s, MasqueradeNode)
+	if err != nil {
+		return err
+	}
+	for i, ref := range refs {
+		var m natRule
+		if err := e.s.Decode(ref, &m); err != nil || !m.Status {
+			continue
+		}
+		ok, err := e.match(result.Flow, m.Source, "", "")
+		if err != nil || !ok {
+			continue
+		}
+		result.Translations = append(result.Translations, Translation{
+			Type: e.s.Type(ref),
+			Rule: RuleID{Position: i + 1, Reference: ref, Name: m.Name},
+			Description: fmt.Sprintf("source %s masqueraded behind interface %s if routed through it",
+				result.Final.Src, e.s.Name(m.SourceNatInterface)),
+		})
+		return nil
+	}
+	return nil
+}
+
+// translate replaces the address and port with the first address of the network and the
+// first destination port of the service
+func (e *evaluation) translate(addr *net.IP, port *int, network, service string) error {
+	if network != "" {
+		n := e.res.network(network)
+		if n.err != nil {
+			return n.err
+		}
+		if len(n.intervals) == 0 {
+			return fmt.Errorf("%s has no address", network)
+		}
+		*addr = n.intervals[0].lo.IP()
+	}
+	if service != "" {
+		sv := e.res.service(service)
+		if sv.err != nil {
+			return sv.err
+		}
+		if len(sv.services) == 0 {
+			return fmt.Errorf("%s has no port", service)
+		}
+		*port = int(sv.services[0].dst.lo.Lo)
+	}
+	return nil
+}
+
+// mapAddress maps the address from the network to the same offset within the mapped network
+func (e *evaluation) mapAddress(addr net.IP, from, to string) (net.IP, error) {
+	f, t := e.res.network(from), e.res.network(to)
+	for _, r := range []resolved{f, t} {
+		if r.err != nil {
+			return nil, r.err
+		}
+		if len(r.intervals) == 0 {
+			return nil, fmt.Errorf("%s or %s has no address", from, to)
+		}
+	}
+	v, err := netset.IPValue(addr)
+	if err != nil {
+		return nil, err
+	}
+	if !f.intervals[0].contains(interval{lo: v, hi: v}) {
+		return addr, nil
+	}
+	return v.Sub(f.intervals[0].lo).Add(t.intervals[0].lo).IP(), nil
+}
+
+// natRule is the subset of attributes of packetfilter/nat, packetfilter/1to1nat and packetfilter/masq
+type natRule struct {
+	Name                  string `json:"name"`
+	Status                bool   `json:"status"`
+	Mode                  string `json:"mode"`
+	Source                string `json:"source"`
+	Destination           string `json:"destination"`
+	Service               string `json:"service"`
+	DestinationNatAddress string `json:"destination_nat_address"`
+	DestinationNatService string `json:"destination_nat_service"`
+	SourceNatAddress      string `json:"source_nat_address"`
+	SourceNatService      string `json:"source_nat_service"`
+	SourceNatInterface    string `json:"source_nat_interface"`
+	AdditionalAddress     string `json:"additional_address"`
+	MapTo                 string `json:"map_to"`
+	AutoPfrule            bool   `json:"auto_pfrule"`
+	AutoPfIn              string `json:"auto_pf_in"`
+	Log                   bool   `json:"log"`
+}
+
+// nodeRefs returns the References of the node, nodes not contained in the Snapshot are empty
+func nodeRefs(s *snapshot.Snapshot, node string) ([]string, error) {
+	var refs []string
+	if !s.HasNode(node) {
+		return nil, nil
+	}
+	return refs, s.Node(node, &refs)
+}
+
+func ipInterval(ip net.IP) (interval, error) {
+	v, err := netset.IPValue(ip)
+	return interval{lo: v, hi: v}, err
+}
+
+// protocol returns the IP protocol number of the name or number
+func protocol(p string) (uint64, error) {
+	n, ok := svcset.ProtocolNumber(strings.ToLower(p))
+	if !ok {
+		return 0, fmt.Errorf("policy: unknown protocol %q", p)
+	}
+	return uint64(n), nil
+}
@@ -0,0 +1,104 @@
+package policy_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/policy"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+func evaluateSnapshot(t *testing.T) *snapshot.Snapshot {
+	office := rule("REF_PacPacWeb", "Web", policy.ActionAccept, sophos.RefNetworkAny, "REF_NetHosWeb", "REF_SerTcpHttps")
+	office.Time = "REF_TimRecOffice"
+	s := policySnapshot(t,
+		office,
+		rule("REF_PacPacLan", "LAN", policy.ActionAccept, "REF_NetNetLan", sophos.RefNetworkAny, sophos.RefServiceAny),
+	)
+	for _, o := range []interface{}{
+		objects.NetworkHost{Reference: "REF_NetHosPublic", ObjectType: "network/host", Name: "public", Address: "203.0.113.10"},
+		objects.TimeRecurring{Reference: "REF_TimRecOffice", ObjectType: "time/recurring", Name: "office", StartTime: "08:00", EndTime: "18:00", Weekdays: []string{"Mon", "Tue", "Wed", "Thu", "Fri"}},
+		objects.PacketfilterNat{Reference: "REF_PacNatWeb", ObjectType: "packetfilter/nat", Name: "Web DNAT", Status: true,
+			Destination: "REF_NetHosPublic", Service: "REF_SerTcpHttps", DestinationNatAddress: "REF_NetHosWeb"},
+		objects.PacketfilterMasq{Reference: "REF_PacMasLan", ObjectType: "packetfilter/masq", Name: "LAN", Status: true,
+			Source: "REF_NetNetLan", SourceNatInterface: "REF_ItfEthExternal"},
+		map[string]interface{}{"_ref": "REF_ItfEthExternal", "_type": "interface/ethernet", "name": "External"},
+	} {
+		if err := s.Add(o); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.SetNode(policy.NATNode, []string{"REF_PacNatWeb"})
+	s.SetNode(policy.MasqueradeNode, []string{"REF_PacMasLan"})
+	return s
+}
+
+func TestEvaluate(t *testing.T) {
+	s := evaluateSnapshot(t)
+	monday := time.Date(2019, 3, 4, 10, 0, 0, 0, time.UTC)
+	sunday := time.Date(2019, 3, 3, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		flow         policy.Flow
+		decision     objects.PacketfilterPacketfilterAction
+		rule         int
+		dst          string
+		translations int
+	}{
+		{"dnat during office hours", policy.Flow{Src: net.ParseIP("198.51.100.7"), Dst: net.ParseIP("203.0.113.10"), Proto: "tcp", Port: 443, Time: monday}, policy.ActionAccept, 1, "10.0.1.1", 1},
+		{"dnat on sunday", policy.Flow{Src: net.ParseIP("198.51.100.7"), Dst: net.ParseIP("203.0.113.10"), Proto: "tcp", Port: 443, Time: sunday}, policy.ActionDrop, 0, "10.0.1.1", 1},
+		{"masqueraded lan", policy.Flow{Src: net.ParseIP("10.0.0.5"), Dst: net.ParseIP("8.8.8.8"), Proto: "udp", Port: 53}, policy.ActionAccept, 2, "8.8.8.8", 1},
+		{"unknown source", policy.Flow{Src: net.ParseIP("198.51.100.7"), Dst: net.ParseIP("8.8.8.8"), Proto: "6", Port: 80}, policy.ActionDrop, 0, "8.8.8.8", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := policy.Evaluate(s, tt.flow)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Decision != tt.decision {
+				t.Errorf("wanted %s, got %s", tt.decision, res.Decision)
+			}
+			if tt.rule == 0 && res.Rule != nil {
+				t.Errorf("wanted default drop, got %s", res.Rule)
+			}
+			if tt.rule != 0 && (res.Rule == nil || res.Rule.Position != tt.rule) {
+				t.Errorf("wanted rule #%d, got %v", tt.rule, res.Rule)
+			}
+			if res.Filtered.Dst.String() != tt.dst {
+				t.Errorf("wanted filtered destination %s, got %s", tt.dst, res.Filtered.Dst)
+			}
+			if len(res.Translations) != tt.translations {
+				t.Errorf("wanted %d translations, got %+v", tt.translations, res.Translations)
+			}
+		})
+	}
+}
+
+func TestEvaluateAssumptions(t *testing.T) {
+	res, err := policy.Evaluate(evaluateSnapshot(t), policy.Flow{Src: net.ParseIP("198.51.100.7"), Dst: net.ParseIP("10.0.1.1"), Proto: "tcp", Port: 443})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Allowed() || len(res.Notes) != 1 || !strings.Contains(res.Notes[0], "office") {
+		t.Errorf("time restriction should have been assumed: %+v", res)
+	}
+
+	if _, err := policy.Evaluate(evaluateSnapshot(t), policy.Flow{Src: net.ParseIP("10.0.0.5"), Dst: net.ParseIP("10.0.1.1"), Proto: "foo"}); err == nil {
+		t.Error("unknown protocols should fail")
+	}
+	if _, err := policy.Evaluate(evaluateSnapshot(t), policy.Flow{Src: net.IP{10, 0, 0}, Dst: net.ParseIP("10.0.1.1"), Proto: "tcp", Port: 443}); err == nil {
+		t.Error("invalid addresses should fail")
+	}
+	for _, f := range []policy.Flow{{Port: -1}, {Port: 443, SrcPort: 65536}} {
+		f.Src, f.Dst, f.Proto = net.ParseIP("10.0.0.5"), net.ParseIP("10.0.1.1"), "tcp"
+		if _, err := policy.Evaluate(evaluateSnapshot(t), f); err == nil || !strings.Contains(err.Error(), "0-65535") {
+			t.Errorf("invalid ports should fail: %v", err)
+		}
+	}
+}
@@ -0,0 +1,761 @@
+package policy
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/netset"
+	"github.com/esurdam/go-sophos/snapshot"
+	"github.com/esurdam/go-sophos/svcset"
+)
+
+// Export formats
+const (
+	FormatNft      = "nft"
+	FormatIptables = "iptables"
+)
+
+// An Issue is a part of the policy which could not be exported exactly
+type Issue struct {
+	// Node is the node containing the rule, e.g. packetfilter.rules
+	Node    string `json:"node"`
+	Rule    RuleID `json:"rule"`
+	Message string `json:"message"`
+}
+
+func (i Issue) String() string { return fmt.Sprintf("%s %s: %s", i.Node, i.Rule, i.Message) }
+
+// Export writes the packet filter, NAT and masquerading rules of the Snapshot as an nft script
+// (FormatNft) or an iptables-save file (FormatIptables) to load into a Linux network namespace.
+//
+// Packet filter rules are added to a chain jumped to from the input and forward chains which drop
+// by default and accept established connections. Parts which can not be expressed are returned as
+// Issues and written as comments: e.g. iptables-save files only contain IPv4 rules and addresses of
+// DNS hosts are those known at the time of the Snapshot.
+func Export(w io.Writer, s *snapshot.Snapshot, format string) ([]Issue, error) {
+	e := &exporter{s: s, res: newResolver(s), format: format}
+	switch format {
+	case FormatNft, FormatIptables:
+	default:
+		return nil, fmt.Errorf("policy: unknown export format %q", format)
+	}
+
+	var (
+		pre, post, auto, filter []string
+		err                     error
+	)
+	if pre, post, auto, err = e.natRules(); err != nil {
+		return nil, err
+	}
+	if filter, err = e.filterRules(); err != nil {
+		return nil, err
+	}
+	filter = append(auto, filter...)
+	masq, err := e.masqRules()
+	if err != nil {
+		return nil, err
+	}
+	post = append(post, masq...)
+
+	if format == FormatNft {
+		writeNft(w, pre, post, filter)
+	} else {
+		writeIptables(w, pre, post, filter)
+	}
+	return e.issues, nil
+}
+
+type exporter struct {
+	s      *snapshot.Snapshot
+	res    *resolver
+	format string
+	issues []Issue
+
+	// current rule for issues
+	node string
+	rule RuleID
+}
+
+// flag records an Issue of the current rule and returns it as a comment line
+func (e *exporter) flag(format string, args ...interface{}) string {
+	i := Issue{Node: e.node, Rule: e.rule, Message: fmt.Sprintf(format, args...)}
+	e.issues = append(e.issues, i)
+	return "# " + i.String()
+}
+
+// match is a family specific condition of a rule
+type match struct {
+	// family is ip, ip6 or empty for both
+	family   string
+	src, dst []string
+	// proto is the protocol number, -1 matches any protocol
+	proto        int
+	dport, sport []svcset.Range
+	iif, oif     string
+	time         *schedule
+	comment      string
+}
+
+// networks returns the addresses of the References per family, nil matches any address
+func (e *exporter) networks(refs []string) (v4, v6 []string, any bool, lines []string) {
+	var sets []netset.Set
+	for _, ref := range refs {
+		set, exact, err := e.res.nets.Resolve(ref)
+		if err != nil {
+			lines = append(lines, e.flag("%s can not be resolved: %s", ref, err.Error()))
+			continue
+		}
+		if !exact {
+			lines = append(lines, e.flag("%s %q uses its addresses at the time of the snapshot", ref, e.s.Name(ref)))
+		}
+		sets = append(sets, set)
+	}
+	set := netset.Union(sets...)
+	if set.Contains(netset.Any()) {
+		return nil, nil, true, lines
+	}
+	for _, p := range set.IPv4().Prefixes() {
+		v4 = append(v4, prefixString(p.String()))
+	}
+	for _, p := range set.IPv6().Prefixes() {
+		v6 = append(v6, prefixString(p.String()))
+	}
+	return v4, v6, false, lines
+}
+
+// prefixString omits the prefix length of single hosts
+func prefixString(p string) string { return strings.TrimSuffix(strings.TrimSuffix(p, "/32"), "/128") }
+
+// services groups the services of the References by protocol and source ports
+func (e *exporter) services(refs []string) (ms []match, lines []string) {
+	var sets []svcset.Set
+	for _, ref := range refs {
+		set, exact, err := e.res.svcs.Resolve(ref)
+		if err != nil {
+			lines = append(lines, e.flag("%s can not be resolved: %s", ref, err.Error()))
+			continue
+		}
+		if !exact {
+			lines = append(lines, e.flag("%s %q is not supported", ref, e.s.Name(ref)))
+		}
+		sets = append(sets, set)
+	}
+	var set svcset.Set
+	for _, s := range sets {
+		set = set.Union(s)
+	}
+
+	anyPorts := svcset.Range{Low: 0, High: 65535}
+	index := map[string]int{}
+	for _, v := range set.Services() {
+		m := match{proto: v.Proto.Low}
+		if v.Proto.Low != v.Proto.High {
+			if v.Proto != (svcset.Range{Low: 0, High: 255}) {
+				lines = append(lines, e.flag("protocol range %s is not supported", v.Proto))
+				continue
+			}
+			m.proto = -1
+		}
+		ported := m.proto == svcset.TCP || m.proto == svcset.UDP
+		icmp := m.proto == svcset.ICMP || m.proto == svcset.ICMPv6
+		switch {
+		case ported, icmp && v.Dst.Low == v.Dst.High:
+			if v.Dst != anyPorts {
+				m.dport = []svcset.Range{v.Dst}
+			}
+			if v.Src != anyPorts && (ported || v.Src.Low == v.Src.High) {
+				m.sport = []svcset.Range{v.Src}
+			}
+		case v.Dst != anyPorts && !(icmp && v.Dst == svcset.Range{Low: 0, High: 255}):
+			lines = append(lines, e.flag("ports of %s are not supported", v))
+			continue
+		}
+		// merge destination ports of the same protocol and source ports
+		key := fmt.Sprintf("%d %v %v", m.proto, m.sport, icmp)
+		if i, ok := index[key]; ok && !icmp && len(m.dport) > 0 && len(ms[i].dport) > 0 {
+			ms[i].dport = append(ms[i].dport, m.dport...)
+			continue
+		}
+		index[key] = len(ms)
+		ms = append(ms, m)
+	}
+	return ms, lines
+}
+
+// matches returns the family specific matches of the sources, destinations and services
+func (e *exporter) matches(sources, destinations, services []string) (ms []match, lines []string) {
+	s4, s6, sAny, l := e.networks(sources)
+	lines = append(lines, l...)
+	d4, d6, dAny, l := e.networks(destinations)
+	lines = append(lines, l...)
+	svcs, l := e.services(services)
+	lines = append(lines, l...)
+
+	type fam struct {
+		name     string
+		src, dst []string
+	}
+	var fams []fam
+	switch {
+	case sAny && dAny:
+		fams = []fam{{}}
+	default:
+		for _, f := range []fam{{"ip", s4, d4}, {"ip6", s6, d6}} {
+			if (sAny || len(f.src) > 0) && (dAny || len(f.dst) > 0) {
+				fams = append(fams, f)
+			}
+		}
+	}
+	for _, f := range fams {
+		for _, sv := range svcs {
+			m := sv
+			m.family, m.src, m.dst = f.name, f.src, f.dst
+			if m.proto == svcset.ICMP && f.name == "ip6" || m.proto == svcset.ICMPv6 && f.name == "ip" {
+				continue
+			}
+			ms = append(ms, m)
+		}
+	}
+	return ms, lines
+}
+
+// hardware returns the device name of the interface object, e.g. eth0
+func (e *exporter) hardware(ref string) string {
+	var itf struct {
+		Itfhw string `json:"itfhw"`
+	}
+	var hw struct {
+		Hardware string `json:"hardware"`
+	}
+	if err := e.s.Decode(ref, &itf); err != nil || e.s.Decode(itf.Itfhw, &hw) != nil || hw.Hardware == "" {
+		return ""
+	}
+	return hw.Hardware
+}
+
+// schedule returns the time object of the rule, time groups are not supported
+func (e *exporter) schedule(ref string) (*schedule, string) {
+	if ref == "" {
+		return nil, ""
+	}
+	var sc schedule
+	if err := e.s.Decode(ref, &sc); err != nil || e.s.Type(ref) == "time/group" {
+		return nil, e.flag("time %s %q is not supported, the rule applies at any time", ref, e.s.Name(ref))
+	}
+	return &sc, ""
+}
+
+func (e *exporter) filterRules() ([]string, error) {
+	refs, err := nodeRefs(e.s, RulesNode)
+	if err != nil {
+		return nil, err
+	}
+	e.node = RulesNode
+	var lines []string
+	for i, ref := range refs {
+		var r Rule
+		if err := e.s.Decode(ref, &r.PacketfilterPacketfilter); err != nil || !r.Status {
+			continue
+		}
+		e.rule = RuleID{Position: i + 1, Reference: ref, Name: r.Name}
+
+		ms, l := e.matches(r.Sources, r.Destinations, r.Services)
+		lines = append(lines, l...)
+		var iif string
+		if r.Interface != "" {
+			if iif = e.hardware(string(r.Interface)); iif == "" {
+				lines = append(lines, e.flag("interface %s has no hardware, the rule applies to any interface", r.Interface))
+			}
+		}
+		sc, l2 := e.schedule(string(r.Time))
+		if l2 != "" {
+			lines = append(lines, l2)
+		}
+
+		verdict := map[objects.PacketfilterPacketfilterAction]string{ActionAccept: "accept", ActionDrop: "drop", ActionReject: "reject"}[r.Action]
+		if verdict == "" {
+			lines = append(lines, e.flag("action %q is not supported", r.Action))
+			continue
+		}
+		for _, m := range ms {
+			m.iif, m.time, m.comment = iif, sc, fmt.Sprintf("#%d %s", e.rule.Position, r.Name)
+			lines = append(lines, e.rules(m, verdict, r.Log)...)
+		}
+	}
+	return lines, nil
+}
+
+// natRules returns the DNAT and SNAT rules and the accept rules of automatic packet filter rules
+func (e *exporter) natRules() (pre, post, auto []string, err error) {
+	refs, err := nodeRefs(e.s, NATNode)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	e.node = NATNode
+	for i, ref := range refs {
+		var n natRule
+		if err := e.s.Decode(ref, &n); err != nil || !n.Status {
+			continue
+		}
+		e.rule = RuleID{Position: i + 1, Reference: ref, Name: n.Name}
+		comment := fmt.Sprintf("NAT #%d %s", i+1, n.Name)
+		ms, lines := e.matches(anyRef(n.Source, sophos.RefNetworkAny), anyRef(n.Destination, sophos.RefNetworkAny), anyRef(n.Service, sophos.RefServiceAny))
+		pre = append(pre, lines...)
+
+		var dnat, snat string
+		switch e.s.Type(ref) {
+		case "packetfilter/1to1nat":
+			to, l := e.prefix(n.MapTo)
+			if l != "" {
+				pre = append(pre, l)
+				continue
+			}
+			if n.Mode == "mapsrc" {
+				snat = "snat-prefix " + to
+			} else {
+				dnat = "dnat-prefix " + to
+			}
+		case "packetfilter/nat":
+			if n.DestinationNatAddress != "" || n.DestinationNatService != "" {
+				to, l, ok := e.address(n.DestinationNatAddress, n.DestinationNatService)
+				pre = append(pre, l...)
+				if !ok {
+					continue
+				}
+				dnat = "dnat " + to
+			}
+			if n.SourceNatAddress != "" || n.SourceNatService != "" {
+				to, l, ok := e.address(n.SourceNatAddress, n.SourceNatService)
+				post = append(post, l...)
+				if !ok {
+					continue
+				}
+				snat = "snat " + to
+			}
+		default:
+			continue
+		}
+		for _, m := range ms {
+			m.comment = comment
+			if dnat != "" {
+				pre = append(pre, e.rules(m, dnat, n.Log)...)
+			}
+			if snat != "" {
+				post = append(post, e.rules(m, snat, n.Log)...)
+			}
+		}
+		if !n.AutoPfrule {
+			continue
+		}
+		// the automatic rule accepts the traffic after destination translation
+		dst, service := anyRef(n.Destination, sophos.RefNetworkAny), anyRef(n.Service, sophos.RefServiceAny)
+		if n.DestinationNatAddress != "" {
+			dst = []string{n.DestinationNatAddress}
+		}
+		if n.DestinationNatService != "" {
+			service = []string{n.DestinationNatService}
+		}
+		if e.s.Type(ref) == "packetfilter/1to1nat" && n.Mode != "mapsrc" {
+			dst = []string{n.MapTo}
+		}
+		ms, lines = e.matches(anyRef(n.Source, sophos.RefNetworkAny), dst, service)
+		auto = append(auto, lines...)
+		for _, m := range ms {
+			m.comment = comment
+			auto = append(auto, e.rules(m, "accept", n.Log)...)
+		}
+	}
+	return pre, post, auto, nil
+}
+
+func (e *exporter) masqRules() ([]string, error) {
+	refs, err := nodeRefs(e.s, MasqueradeNode)
+	if err != nil {
+		return nil, err
+	}
+	e.node = MasqueradeNode
+	var lines []string
+	for i, ref := range refs {
+		var m natRule
+		if err := e.s.Decode(ref, &m); err != nil || !m.Status {
+			continue
+		}
+		e.rule = RuleID{Position: i + 1, Reference: ref, Name: m.Name}
+		oif := e.hardware(m.SourceNatInterface)
+		if oif == "" {
+			lines = append(lines, e.flag("interface %s has no hardware", m.SourceNatInterface))
+			continue
+		}
+		// masquerading uses the primary address of the interface unless an additional address is set
+		verdict := "masquerade"
+		if m.AdditionalAddress != "" {
+			to, l, ok := e.address(m.AdditionalAddress, "")
+			lines = append(lines, l...)
+			if !ok {
+				continue
+			}
+			verdict = "snat " + to
+		}
+		ms, l := e.matches([]string{m.Source}, []string{sophos.RefNetworkAny}, []string{sophos.RefServiceAny})
+		lines = append(lines, l...)
+		for _, mm := range ms {
+			mm.oif, mm.comment = oif, fmt.Sprintf("masquerading #%d %s", i+1, m.Name)
+			lines = append(lines, e.rules(mm, verdict, false)...)
+		}
+	}
+	return lines, nil
+}
+
+// address returns the translation target address[:port]. Only the first address and port are used,
+// translations to several addresses or ports are flagged. It returns false if the target can not
+// be resolved.
+func (e *exporter) address(network, service string) (string, []string, bool) {
+	var (
+		to    string
+		lines []string
+	)
+	if network != "" {
+		set, _, err := e.res.nets.Resolve(network)
+		rr := set.Ranges()
+		if err != nil || len(rr) == 0 {
+			return "", []string{e.flag("NAT address %s can not be resolved", network)}, false
+		}
+		to = rr[0].From.String()
+		if rr[0].From.To4() == nil {
+			to = "[" + to + "]"
+		}
+		if len(rr) > 1 || !rr[0].From.Equal(rr[0].To) {
+			lines = append(lines, e.flag("NAT address %s %q has several addresses, only %s is used", network, e.s.Name(network), to))
+		}
+	}
+	if service != "" {
+		set, _, err := e.res.svcs.Resolve(service)
+		ss := set.Services()
+		if err != nil || len(ss) == 0 {
+			return "", append(lines, e.flag("NAT service %s can not be resolved", service)), false
+		}
+		to += ":" + strconv.Itoa(ss[0].Dst.Low)
+		if len(ss) > 1 || ss[0].Dst.Low != ss[0].Dst.High {
+			lines = append(lines, e.flag("NAT service %s %q has several ports, only %d is used", service, e.s.Name(service), ss[0].Dst.Low))
+		}
+	}
+	return to, lines, true
+}
+
+// prefix returns the single prefix of the network
+func (e *exporter) prefix(network string) (string, string) {
+	set, _, err := e.res.nets.Resolve(network)
+	pp := set.Prefixes()
+	if err != nil || len(pp) != 1 {
+		return "", e.flag("mapped network %s is not a single prefix", network)
+	}
+	return pp[0].String(), ""
+}
+
+// rules returns the lines of the match and verdict. Verdicts are accept, drop, reject,
+// masquerade, dnat <to>, snat <to>, dnat-prefix <prefix> or snat-prefix <prefix>.
+func (e *exporter) rules(m match, verdict string, log bool) []string {
+	if e.format == FormatNft {
+		return []string{nftRule(m, verdict, log)}
+	}
+	if m.family == "ip6" || m.family == "" && m.proto == svcset.ICMPv6 {
+		return []string{e.flag("IPv6 rules are not contained in iptables-save files")}
+	}
+	if m.family == "" && m.proto != svcset.ICMP {
+		// rules of both families only match IPv4 packets in iptables-save files
+		return append([]string{e.flag("the IPv6 half of the rule is not contained in iptables-save files")}, iptablesRules(m, verdict, log)...)
+	}
+	return iptablesRules(m, verdict, log)
+}
+
+func rangeString(r svcset.Range, sep string) string {
+	if r.Low == r.High {
+		return strconv.Itoa(r.Low)
+	}
+	return strconv.Itoa(r.Low) + sep + strconv.Itoa(r.High)
+}
+
+var weekdayNames = map[string]string{
+	"Mon": "Monday", "Tue": "Tuesday", "Wed": "Wednesday", "Thu": "Thursday",
+	"Fri": "Friday", "Sat": "Saturday", "Sun": "Sunday",
+}
+
+func shortDays(dd []string) []string {
+	var out []string
+	for _, d := range dd {
+		if len(d) >= 3 {
+			out = append(out, strings.ToUpper(d[:1])+strings.ToLower(d[1:3]))
+		}
+	}
+	return out
+}
+
+func nftRule(m match, verdict string, log bool) string {
+	var p []string
+	if m.iif != "" {
+		p = append(p, "iifname "+strconv.Quote(m.iif))
+	}
+	if m.oif != "" {
+		p = append(p, "oifname "+strconv.Quote(m.oif))
+	}
+	set := func(vv []string) string {
+		if len(vv) == 1 {
+			return vv[0]
+		}
+		return "{ " + strings.Join(vv, ", ") + " }"
+	}
+	if len(m.src) > 0 {
+		p = append(p, m.family+" saddr "+set(m.src))
+	}
+	if len(m.dst) > 0 {
+		p = append(p, m.family+" daddr "+set(m.dst))
+	}
+	switch m.proto {
+	case -1:
+	case svcset.ICMP, svcset.ICMPv6:
+		name := map[int]string{svcset.ICMP: "icmp", svcset.ICMPv6: "icmpv6"}[m.proto]
+		if len(m.dport) > 0 {
+			p = append(p, fmt.Sprintf("%s type %d", name, m.dport[0].Low))
+		} else {
+			p = append(p, "meta l4proto "+name)
+		}
+		if len(m.sport) > 0 {
+			p = append(p, fmt.Sprintf("%s code %d", name, m.sport[0].Low))
+		}
+	default:
+		name := svcset.ProtocolName(m.proto)
+		ports := func(rr []svcset.Range) string {
+			var vv []string
+			for _, r := range rr {
+				vv = append(vv, rangeString(r, "-"))
+			}
+			return set(vv)
+		}
+		if len(m.dport) == 0 && len(m.sport) == 0 {
+			p = append(p, "meta l4proto "+name)
+		}
+		if len(m.sport) > 0 {
+			p = append(p, name+" sport "+ports(m.sport))
+		}
+		if len(m.dport) > 0 {
+			p = append(p, name+" dport "+ports(m.dport))
+		}
+	}
+	if m.time != nil {
+		if m.time.StartDate != "" {
+			p = append(p, fmt.Sprintf("meta time %q-%q",
+				strings.TrimSpace(m.time.StartDate+" "+m.time.StartTime), strings.TrimSpace(m.time.EndDate+" "+m.time.EndTime)))
+		} else {
+			if len(m.time.Weekdays) > 0 {
+				var dd []string
+				for _, d := range shortDays(m.time.Weekdays) {
+					dd = append(dd, strconv.Quote(weekdayNames[d]))
+				}
+				p = append(p, "meta day "+set(dd))
+			}
+			if m.time.StartTime != "" || m.time.EndTime != "" {
+				p = append(p, fmt.Sprintf("meta hour %q-%q", defaultString(m.time.StartTime, "00:00"), defaultString(m.time.EndTime, "23:59")))
+			}
+		}
+	}
+	if log {
+		p = append(p, fmt.Sprintf("log prefix %q", "utm "+truncate(m.comment, 20)+" "))
+	}
+	switch {
+	case strings.HasPrefix(verdict, "dnat "):
+		verdict = "dnat " + familyPrefix(m.family) + "to " + strings.TrimPrefix(verdict, "dnat ")
+	case strings.HasPrefix(verdict, "snat "):
+		verdict = "snat " + familyPrefix(m.family) + "to " + strings.TrimPrefix(verdict, "snat ")
+	case strings.HasPrefix(verdict, "dnat-prefix "):
+		verdict = "dnat " + familyPrefix(m.family) + "prefix to " + strings.TrimPrefix(verdict, "dnat-prefix ")
+	case strings.HasPrefix(verdict, "snat-prefix "):
+		verdict = "snat " + familyPrefix(m.family) + "prefix to " + strings.TrimPrefix(verdict, "snat-prefix ")
+	}
+	p = append(p, verdict, "comment "+strconv.Quote(m.comment))
+	return strings.Join(p, " ")
+}
+
+func familyPrefix(f string) string {
+	if f == "" {
+		return "ip "
+	}
+	return f + " "
+}
+
+func defaultString(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
+
+func iptablesRules(m match, verdict string, log bool) []string {
+	var (
+		base []string
+		tm   string
+	)
+	if m.iif != "" {
+		base = append(base, "-i "+m.iif)
+	}
+	if m.oif != "" {
+		base = append(base, "-o "+m.oif)
+	}
+	switch m.proto {
+	case -1:
+	case svcset.ICMP:
+		base = append(base, "-p icmp")
+		if len(m.dport) > 0 {
+			t := strconv.Itoa(m.dport[0].Low)
+			if len(m.sport) > 0 {
+				t += "/" + strconv.Itoa(m.sport[0].Low)
+			}
+			base = append(base, "--icmp-type "+t)
+		}
+	default:
+		base = append(base, "-p "+svcset.ProtocolName(m.proto))
+		if len(m.sport) > 0 {
+			base = append(base, "--sport "+rangeString(m.sport[0], ":"))
+		}
+	}
+	if m.time != nil {
+		t := "-m time"
+		if m.time.StartDate != "" {
+			t += fmt.Sprintf(" --datestart %sT%s --datestop %sT%s", m.time.StartDate, defaultString(m.time.StartTime, "00:00"),
+				m.time.EndDate, defaultString(m.time.EndTime, "23:59"))
+		} else {
+			if m.time.StartTime != "" || m.time.EndTime != "" {
+				t += fmt.Sprintf(" --timestart %s --timestop %s", defaultString(m.time.StartTime, "00:00"), defaultString(m.time.EndTime, "23:59"))
+			}
+			if len(m.time.Weekdays) > 0 {
+				t += " --weekdays " + strings.Join(shortDays(m.time.Weekdays), ",")
+			}
+		}
+		tm = t
+	}
+
+	target := map[string]string{"accept": "ACCEPT", "drop": "DROP", "reject": "REJECT", "masquerade": "MASQUERADE"}[verdict]
+	switch {
+	case strings.HasPrefix(verdict, "dnat "):
+		target = "DNAT --to-destination " + strings.TrimPrefix(verdict, "dnat ")
+	case strings.HasPrefix(verdict, "snat "):
+		target = "SNAT --to-source " + strings.TrimPrefix(verdict, "snat ")
+	case strings.HasPrefix(verdict, "dnat-prefix "):
+		target = "NETMAP --to " + strings.TrimPrefix(verdict, "dnat-prefix ")
+	case strings.HasPrefix(verdict, "snat-prefix "):
+		target = "NETMAP --to " + strings.TrimPrefix(verdict, "snat-prefix ")
+	}
+
+	srcs, dsts, dports := m.src, m.dst, []string{""}
+	if len(srcs) == 0 {
+		srcs = []string{""}
+	}
+	if len(dsts) == 0 {
+		dsts = []string{""}
+	}
+	if len(m.dport) > 0 && m.proto != svcset.ICMP {
+		dports = nil
+		for _, r := range m.dport {
+			dports = append(dports, "--dport "+rangeString(r, ":"))
+		}
+	}
+	comment := "-m comment --comment " + strconv.Quote(m.comment)
+
+	var lines []string
+	for _, s := range srcs {
+		for _, d := range dsts {
+			for _, dp := range dports {
+				var p []string
+				if s != "" {
+					p = append(p, "-s "+s)
+				}
+				if d != "" {
+					p = append(p, "-d "+d)
+				}
+				p = append(p, base...)
+				if dp != "" {
+					p = append(p, dp)
+				}
+				if tm != "" {
+					p = append(p, tm)
+				}
+				p = append(p, comment)
+				rule := strings.Join(p, " ")
+				if log {
+					lines = append(lines, fmt.Sprintf("%s -j LOG --log-prefix %q", rule, "utm "+truncate(m.comment, 20)+" "))
+				}
+				lines = append(lines, rule+" -j "+target)
+			}
+		}
+	}
+	return lines
+}
+
+func writeNft(w io.Writer, pre, post, filter []string) {
+	fmt.Fprintln(w, "#!/usr/sbin/nft -f")
+	fmt.Fprintln(w, "flush ruleset")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "table inet utm {")
+	chain := func(name, hook string, lines []string) {
+		if name != "prerouting" {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "\tchain %s {\n", name)
+		if hook != "" {
+			fmt.Fprintf(w, "\t\t%s\n", hook)
+		}
+		for _, l := range lines {
+			fmt.Fprintf(w, "\t\t%s\n", l)
+		}
+		fmt.Fprintln(w, "\t}")
+	}
+	chain("prerouting", "type nat hook prerouting priority -100; policy accept;", pre)
+	chain("postrouting", "type nat hook postrouting priority 100; policy accept;", post)
+	chain("rules", "", filter)
+	established := []string{"ct state established,related accept", "jump rules"}
+	chain("input", "type filter hook input priority 0; policy drop;", append([]string{"iifname \"lo\" accept"}, established...))
+	chain("forward", "type filter hook forward priority 0; policy drop;", established)
+	fmt.Fprintln(w, "}")
+}
+
+func writeIptables(w io.Writer, pre, post, filter []string) {
+	rules := func(chain string, lines []string) {
+		for _, l := range lines {
+			if strings.HasPrefix(l, "#") {
+				fmt.Fprintln(w, l)
+				continue
+			}
+			fmt.Fprintf(w, "-A %s %s\n", chain, l)
+		}
+	}
+	fmt.Fprintln(w, "*nat")
+	fmt.Fprintln(w, ":PREROUTING ACCEPT [0:0]")
+	fmt.Fprintln(w, ":INPUT ACCEPT [0:0]")
+	fmt.Fprintln(w, ":OUTPUT ACCEPT [0:0]")
+	fmt.Fprintln(w, ":POSTROUTING ACCEPT [0:0]")
+	rules("PREROUTING", pre)
+	rules("POSTROUTING", post)
+	fmt.Fprintln(w, "COMMIT")
+	fmt.Fprintln(w, "*filter")
+	fmt.Fprintln(w, ":INPUT DROP [0:0]")
+	fmt.Fprintln(w, ":FORWARD DROP [0:0]")
+	fmt.Fprintln(w, ":OUTPUT ACCEPT [0:0]")
+	fmt.Fprintln(w, ":UTM - [0:0]")
+	fmt.Fprintln(w, "-A INPUT -i lo -j ACCEPT")
+	for _, c := range []string{"INPUT", "FORWARD"} {
+		fmt.Fprintf(w, "-A %s -m conntrack --ctstate RELATED,ESTABLISHED -j ACCEPT\n", c)
+		fmt.Fprintf(w, "-A %s -j UTM\n", c)
+	}
+	rules("UTM", filter)
+	fmt.Fprintln(w, "COMMIT")
+}
@@ -0,0 +1,118 @@
+package policy_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/policy"
+)
+
+func TestExport(t *testing.T) {
+	s := evaluateSnapshot(t)
+	for _, o := range []interface{}{
+		map[string]interface{}{"_ref": "REF_ItfEthExternal", "_type": "interface/ethernet", "name": "External", "itfhw": "REF_ItfEthEth1"},
+		map[string]interface{}{"_ref": "REF_ItfEthEth1", "_type": "itfhw/ethernet", "name": "eth1", "hardware": "eth1"},
+	} {
+		if err := s.Add(o); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{policy.FormatNft, []string{
+			`ip daddr 203.0.113.10 tcp dport 443 dnat ip to 10.0.1.1 comment "NAT #1 Web DNAT"`,
+			`oifname "eth1" ip saddr 10.0.0.0/24 masquerade`,
+			`meta hour "08:00"-"18:00" accept comment "#1 Web"`,
+			`ip saddr 10.0.0.0/24 accept comment "#2 LAN"`,
+			`type filter hook forward priority 0; policy drop;`,
+		}},
+		{policy.FormatIptables, []string{
+			`-A PREROUTING -d 203.0.113.10 -p tcp --dport 443 -m comment --comment "NAT #1 Web DNAT" -j DNAT --to-destination 10.0.1.1`,
+			`-A POSTROUTING -s 10.0.0.0/24 -o eth1 -m comment --comment "masquerading #1 LAN" -j MASQUERADE`,
+			`-A UTM -d 10.0.1.1 -p tcp --dport 443 -m time --timestart 08:00 --timestop 18:00 --weekdays Mon,Tue,Wed,Thu,Fri`,
+			`-A UTM -s 10.0.0.0/24 -m comment --comment "#2 LAN" -j ACCEPT`,
+			`:FORWARD DROP [0:0]`,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			issues, err := policy.Export(&buf, s, tt.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(issues) != 0 {
+				t.Errorf("wanted no issues, got %v", issues)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(buf.String(), w) {
+					t.Errorf("wanted %q in:\n%s", w, buf.String())
+				}
+			}
+		})
+	}
+}
+
+func TestExportIssues(t *testing.T) {
+	s := policySnapshot(t, rule("REF_PacPacMissing", "Missing", policy.ActionAccept, "REF_NetNetGone", "REF_NetNetLan", "REF_SerTcpHttps"))
+	var buf bytes.Buffer
+	issues, err := policy.Export(&buf, s, policy.FormatIptables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 1 || issues[0].Rule.Position != 1 || !strings.Contains(issues[0].Message, "REF_NetNetGone") {
+		t.Fatalf("wanted the missing reference, got %v", issues)
+	}
+	if !strings.Contains(buf.String(), "# "+issues[0].String()) {
+		t.Errorf("issues should be written as comments:\n%s", buf.String())
+	}
+	if _, err := policy.Export(&buf, s, "pf"); err == nil {
+		t.Error("unknown formats should fail")
+	}
+}
+
+func TestExportLossy(t *testing.T) {
+	s := policySnapshot(t, rule("REF_PacPacAll", "All", policy.ActionAccept, sophos.RefNetworkAny, sophos.RefNetworkAny, sophos.RefServiceAny))
+	for _, o := range []interface{}{
+		objects.ServiceTcp{Reference: "REF_SerTcpAlt", ObjectType: "service/tcp", Name: "alt", DstLow: 8080, DstHigh: 8090, SrcLow: 1, SrcHigh: 65535},
+		objects.PacketfilterNat{Reference: "REF_PacNatLan", ObjectType: "packetfilter/nat", Name: "to lan", Status: true,
+			Destination: "REF_NetHosClient", Service: "REF_SerTcpHttps", DestinationNatAddress: "REF_NetNetLan", DestinationNatService: "REF_SerTcpAlt"},
+		objects.PacketfilterMasq{Reference: "REF_PacMasLan", ObjectType: "packetfilter/masq", Name: "LAN", Status: true,
+			Source: "REF_NetNetLan", SourceNatInterface: "REF_ItfEthExternal", AdditionalAddress: "REF_NetHosWeb"},
+		map[string]interface{}{"_ref": "REF_ItfEthExternal", "_type": "interface/ethernet", "name": "External", "itfhw": "REF_ItfEthEth1"},
+		map[string]interface{}{"_ref": "REF_ItfEthEth1", "_type": "itfhw/ethernet", "name": "eth1", "hardware": "eth1"},
+	} {
+		if err := s.Add(o); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.SetNode(policy.NATNode, []string{"REF_PacNatLan"})
+	s.SetNode(policy.MasqueradeNode, []string{"REF_PacMasLan"})
+
+	var buf bytes.Buffer
+	issues, err := policy.Export(&buf, s, policy.FormatIptables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var messages []string
+	for _, i := range issues {
+		messages = append(messages, i.Message)
+	}
+	want := []string{
+		`NAT address REF_NetNetLan "lan" has several addresses, only 10.0.0.0 is used`,
+		`NAT service REF_SerTcpAlt "alt" has several ports, only 8080 is used`,
+		"the IPv6 half of the rule is not contained in iptables-save files",
+	}
+	if strings.Join(messages, "\n") != strings.Join(want, "\n") {
+		t.Errorf("unexpected issues\n%s", strings.Join(messages, "\n"))
+	}
+	if w := `-j SNAT --to-source 10.0.1.1`; !strings.Contains(buf.String(), w) {
+		t.Errorf("the additional address should be used by masquerading: %s", buf.String())
+	}
+}
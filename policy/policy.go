@@ -0,0 +1,84 @@
+// Package policy analyzes the packet filter rules of a snapshot.Snapshot offline
+package policy
+
+import (
+	"fmt"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+// RulesNode is the node containing the ordered packet filter rules
+const RulesNode = "packetfilter.rules"
+
+// Packet filter actions
+const (
+	ActionAccept = objects.PacketfilterPacketfilterActionAccept
+	ActionDrop   = objects.PacketfilterPacketfilterActionDrop
+	ActionReject = objects.PacketfilterPacketfilterActionReject
+)
+
+// A Rule is a packet filter rule and its position within packetfilter.rules
+type Rule struct {
+	objects.PacketfilterPacketfilter
+	// Position is the 1-based position of the rule in packetfilter.rules
+	Position int
+	// Missing contains the References used by the rule which are not in the Snapshot
+	Missing []string
+	// Exact is false when an address or service of the rule could not be resolved completely
+	Exact bool
+
+	space space
+}
+
+// String returns the position and name of the Rule, e.g. #12 "Allow LAN"
+func (r *Rule) String() string { return fmt.Sprintf("#%d %q", r.Position, r.Name) }
+
+// Rules returns the packet filter rules of the Snapshot in packetfilter.rules order
+func Rules(s *snapshot.Snapshot) ([]*Rule, error) {
+	var refs []string
+	if err := s.Node(RulesNode, &refs); err != nil {
+		return nil, err
+	}
+
+	res := newResolver(s)
+	rules := make([]*Rule, 0, len(refs))
+	for i, ref := range refs {
+		r := &Rule{Position: i + 1, Exact: true}
+		if err := s.Decode(ref, &r.PacketfilterPacketfilter); err != nil {
+			r.Reference = ref
+			r.Missing = append(r.Missing, ref)
+			r.Exact = false
+			rules = append(rules, r)
+			continue
+		}
+		r.resolve(s, res)
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+// resolve resolves the Rule's References into its traffic space
+func (r *Rule) resolve(s *snapshot.Snapshot, res *resolver) {
+	for _, ref := range []string{string(r.Time), string(r.Interface)} {
+		if sophos.IsReference(ref) && !s.Has(ref) {
+			r.Missing = append(r.Missing, ref)
+		}
+	}
+
+	sp, missing, exact := res.space(r.Sources, r.Destinations, r.Services)
+	r.space, r.Missing, r.Exact = sp, append(r.Missing, missing...), r.Exact && exact
+}
+
+// covers returns true if the conditions (interface, time and direction) of r apply to all traffic of o
+func (r *Rule) covers(o *Rule) bool {
+	return (r.Interface == "" || r.Interface == o.Interface) &&
+		(r.Time == "" || r.Time == o.Time) &&
+		(r.Direction == "" || r.Direction == o.Direction)
+}
+
+// sameAction returns true if both actions handle traffic identically, drop and reject both deny
+func sameAction(a, b objects.PacketfilterPacketfilterAction) bool {
+	return a == b || (a != ActionAccept && b != ActionAccept)
+}
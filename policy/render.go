@@ -0,0 +1,565 @@
+package policy
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+// Render formats
+const (
+	FormatText     = "text"
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+	FormatCSV      = "csv"
+)
+
+// Render columns
+const (
+	ColumnPosition     = "position"
+	ColumnName         = "name"
+	ColumnAction       = "action"
+	ColumnSources      = "sources"
+	ColumnServices     = "services"
+	ColumnDestinations = "destinations"
+	ColumnTranslation  = "translation"
+	ColumnInterface    = "interface"
+	ColumnTime         = "time"
+	ColumnLog          = "log"
+	ColumnStatus       = "status"
+	ColumnGroup        = "group"
+	ColumnComment      = "comment"
+)
+
+// DefaultColumns are rendered when RenderOptions.Columns is empty
+var DefaultColumns = []string{
+	ColumnPosition, ColumnName, ColumnAction, ColumnSources, ColumnServices, ColumnDestinations,
+	ColumnTranslation, ColumnInterface, ColumnTime, ColumnLog,
+}
+
+var columnTitles = map[string]string{
+	ColumnPosition:     "#",
+	ColumnName:         "Name",
+	ColumnAction:       "Action",
+	ColumnSources:      "Sources",
+	ColumnServices:     "Services",
+	ColumnDestinations: "Destinations",
+	ColumnTranslation:  "Translation",
+	ColumnInterface:    "Interface",
+	ColumnTime:         "Time",
+	ColumnLog:          "Log",
+	ColumnStatus:       "Status",
+	ColumnGroup:        "Group",
+	ColumnComment:      "Comment",
+}
+
+// Render grouping
+const (
+	GroupByInterface = "interface"
+	GroupByGroup     = "group"
+)
+
+// RenderOptions configure Render
+type RenderOptions struct {
+	// Format is one of FormatText (default), FormatMarkdown, FormatHTML or FormatCSV
+	Format string
+	// Columns are the rendered columns in order, defaults to DefaultColumns.
+	// The text format renders each rule as a sentence of its columns.
+	Columns []string
+	// GroupBy groups the rules of each section by GroupByInterface or GroupByGroup
+	GroupBy string
+	// Disabled includes disabled rules
+	Disabled bool
+	// Details adds the addresses and ports of network and service objects (except groups) to their names
+	Details bool
+}
+
+// A Section is a titled list of rows, e.g. the packet filter rules
+type Section struct {
+	Title  string
+	Groups []Group
+}
+
+// A Group contains the rows of a Section with the same interface or group
+type Group struct {
+	Name string
+	Rows []map[string]string
+}
+
+// Sections returns the packet filter, NAT and masquerading rules of the Snapshot in node order
+// with all References resolved to names
+func Sections(s *snapshot.Snapshot, opts RenderOptions) ([]Section, error) {
+	r := &renderer{s: s, res: newResolver(s), opts: opts}
+	var sections []Section
+	for _, part := range []struct {
+		title, node string
+		row         func(int, string) (map[string]string, bool)
+	}{
+		{"Packet filter rules", RulesNode, r.packetfilter},
+		{"NAT rules", NATNode, r.nat},
+		{"Masquerading rules", MasqueradeNode, r.masquerade},
+	} {
+		refs, err := nodeRefs(s, part.node)
+		if err != nil {
+			return nil, err
+		}
+		sec := Section{Title: part.title}
+		index := map[string]int{}
+		for i, ref := range refs {
+			row, ok := part.row(i+1, ref)
+			if !ok {
+				continue
+			}
+			var name string
+			switch opts.GroupBy {
+			case GroupByInterface:
+				name = row[ColumnInterface]
+				if name == "" {
+					name = "Any interface"
+				}
+			case GroupByGroup:
+				name = row[ColumnGroup]
+				if name == "" {
+					name = "No group"
+				}
+			}
+			g, ok := index[name]
+			if !ok {
+				g = len(sec.Groups)
+				index[name] = g
+				sec.Groups = append(sec.Groups, Group{Name: name})
+			}
+			sec.Groups[g].Rows = append(sec.Groups[g].Rows, row)
+		}
+		sections = append(sections, sec)
+	}
+	return sections, nil
+}
+
+// Render writes the packet filter, NAT and masquerading rules of the Snapshot in plain language
+func Render(w io.Writer, s *snapshot.Snapshot, opts RenderOptions) error {
+	if len(opts.Columns) == 0 {
+		opts.Columns = DefaultColumns
+	}
+	for _, c := range opts.Columns {
+		if _, ok := columnTitles[c]; !ok {
+			return fmt.Errorf("policy: unknown column %q", c)
+		}
+	}
+	sections, err := Sections(s, opts)
+	if err != nil {
+		return err
+	}
+
+	switch opts.Format {
+	case "", FormatText:
+		return renderText(w, sections, opts)
+	case FormatMarkdown:
+		return renderMarkdown(w, sections, opts)
+	case FormatHTML:
+		return renderHTML(w, sections, opts)
+	case FormatCSV:
+		return renderCSV(w, sections, opts)
+	}
+	return fmt.Errorf("policy: unknown format %q", opts.Format)
+}
+
+type renderer struct {
+	s    *snapshot.Snapshot
+	res  *resolver
+	opts RenderOptions
+}
+
+// name returns the name of the object, with its addresses or ports if requested
+func (r *renderer) name(ref string) string {
+	if ref == "" {
+		return ""
+	}
+	if !r.s.Has(ref) && !sophos.IsReference(ref) {
+		return ref
+	}
+	name := r.s.Name(ref)
+	switch {
+	case name != "":
+	case ref == sophos.RefNetworkAny || ref == sophos.RefServiceAny:
+		name = "Any"
+	default:
+		name = ref
+	}
+	objType := r.s.Type(ref)
+	if !r.opts.Details || strings.HasSuffix(objType, "/group") || strings.HasSuffix(objType, "/any") {
+		return name
+	}
+	var detail string
+	switch {
+	case strings.HasPrefix(objType, "network/"):
+		if set, _, err := r.res.nets.Resolve(ref); err == nil && !set.IsEmpty() {
+			detail = set.String()
+		}
+	case strings.HasPrefix(objType, "service/"):
+		if set, _, err := r.res.svcs.Resolve(ref); err == nil && !set.IsEmpty() {
+			detail = set.String()
+		}
+	}
+	if detail == "" || detail == name {
+		return name
+	}
+	return name + " (" + detail + ")"
+}
+
+func (r *renderer) names(refs ...string) string {
+	var nn []string
+	for _, ref := range refs {
+		if n := r.name(ref); n != "" {
+			nn = append(nn, n)
+		}
+	}
+	return strings.Join(nn, ", ")
+}
+
+// schedule returns the description of the time object, e.g. Mon–Fri 08:00–18:00
+func (r *renderer) schedule(ref string) string {
+	if ref == "" {
+		return ""
+	}
+	var sc schedule
+	if err := r.s.Decode(ref, &sc); err != nil {
+		return ref
+	}
+	var d string
+	switch r.s.Type(ref) {
+	case "time/recurring":
+		d = strings.TrimSpace(weekdays(sc.Weekdays) + " " + timeRange(sc.StartTime, sc.EndTime))
+	case "time/single":
+		d = timeRange(strings.TrimSpace(sc.StartDate+" "+sc.StartTime), strings.TrimSpace(sc.EndDate+" "+sc.EndTime))
+	}
+	if d == "" {
+		return r.s.Name(ref)
+	}
+	return d
+}
+
+func timeRange(from, to string) string {
+	if from == "" && to == "" {
+		return ""
+	}
+	return from + "–" + to
+}
+
+var days = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+// weekdays returns the days compressed to ranges, e.g. Mon–Fri, Sun
+func weekdays(dd []string) string {
+	set := map[string]bool{}
+	for _, d := range dd {
+		if len(d) >= 3 {
+			set[strings.ToUpper(d[:1])+strings.ToLower(d[1:3])] = true
+		}
+	}
+	if len(set) == 0 || len(set) == len(days) {
+		return ""
+	}
+	var parts []string
+	for i := 0; i < len(days); i++ {
+		if !set[days[i]] {
+			continue
+		}
+		j := i
+		for j+1 < len(days) && set[days[j+1]] {
+			j++
+		}
+		switch {
+		case j == i:
+			parts = append(parts, days[i])
+		case j == i+1:
+			parts = append(parts, days[i], days[j])
+		default:
+			parts = append(parts, days[i]+"–"+days[j])
+		}
+		i = j
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (r *renderer) group(g string) string {
+	if sophos.IsReference(g) {
+		return r.name(g)
+	}
+	return g
+}
+
+func yesNo(b bool, yes, no string) string {
+	if b {
+		return yes
+	}
+	return no
+}
+
+func (r *renderer) packetfilter(pos int, ref string) (map[string]string, bool) {
+	var pf Rule
+	if err := r.s.Decode(ref, &pf.PacketfilterPacketfilter); err != nil || (!pf.Status && !r.opts.Disabled) {
+		return nil, false
+	}
+	return map[string]string{
+		ColumnPosition:     fmt.Sprintf("%d", pos),
+		ColumnName:         pf.Name,
+		ColumnAction:       actionTitle(pf.Action),
+		ColumnSources:      r.names(pf.Sources...),
+		ColumnServices:     r.names(pf.Services...),
+		ColumnDestinations: r.names(pf.Destinations...),
+		ColumnInterface:    r.name(string(pf.Interface)),
+		ColumnTime:         r.schedule(string(pf.Time)),
+		ColumnLog:          yesNo(pf.Log, "logged", ""),
+		ColumnStatus:       yesNo(pf.Status, "enabled", "disabled"),
+		ColumnGroup:        r.group(pf.Group),
+		ColumnComment:      pf.Comment,
+	}, true
+}
+
+func actionTitle(a objects.PacketfilterPacketfilterAction) string {
+	switch a {
+	case ActionAccept:
+		return "Allow"
+	case ActionDrop:
+		return "Drop"
+	case ActionReject:
+		return "Reject"
+	}
+	return string(a)
+}
+
+func (r *renderer) nat(pos int, ref string) (map[string]string, bool) {
+	var n struct {
+		natRule
+		Log     bool   `json:"log"`
+		Group   string `json:"group"`
+		Comment string `json:"comment"`
+	}
+	if err := r.s.Decode(ref, &n); err != nil || (!n.Status && !r.opts.Disabled) {
+		return nil, false
+	}
+
+	var action string
+	var translation []string
+	switch r.s.Type(ref) {
+	case "packetfilter/1to1nat":
+		action = "1:1 NAT"
+		if n.Mode == "mapsrc" {
+			translation = append(translation, "source → "+r.name(n.MapTo))
+		} else {
+			translation = append(translation, "destination → "+r.name(n.MapTo))
+		}
+	default:
+		if n.DestinationNatAddress != "" || n.DestinationNatService != "" {
+			translation = append(translation, "destination → "+r.names(n.DestinationNatAddress, n.DestinationNatService))
+		}
+		if n.SourceNatAddress != "" || n.SourceNatService != "" {
+			translation = append(translation, "source → "+r.names(n.SourceNatAddress, n.SourceNatService))
+		}
+		switch len(translation) {
+		case 0:
+			action = "No NAT"
+		case 2:
+			action = "Full NAT"
+		default:
+			action = strings.ToUpper(translation[0][:1]) + "NAT"
+		}
+	}
+	if n.AutoPfrule {
+		translation = append(translation, "automatic packet filter rule")
+	}
+
+	return map[string]string{
+		ColumnPosition:     fmt.Sprintf("%d", pos),
+		ColumnName:         n.Name,
+		ColumnAction:       action,
+		ColumnSources:      r.name(anyName(n.Source)),
+		ColumnServices:     r.name(anyName(n.Service)),
+		ColumnDestinations: r.name(anyName(n.Destination)),
+		ColumnTranslation:  strings.Join(translation, ", "),
+		ColumnLog:          yesNo(n.Log, "logged", ""),
+		ColumnStatus:       yesNo(n.Status, "enabled", "disabled"),
+		ColumnGroup:        r.group(n.Group),
+		ColumnComment:      n.Comment,
+	}, true
+}
+
+func anyName(ref string) string {
+	if ref == "" {
+		return "Any"
+	}
+	return ref
+}
+
+func (r *renderer) masquerade(pos int, ref string) (map[string]string, bool) {
+	var m struct {
+		natRule
+		Comment string `json:"comment"`
+	}
+	if err := r.s.Decode(ref, &m); err != nil || (!m.Status && !r.opts.Disabled) {
+		return nil, false
+	}
+	return map[string]string{
+		ColumnPosition:    fmt.Sprintf("%d", pos),
+		ColumnName:        m.Name,
+		ColumnAction:      "Masquerade",
+		ColumnSources:     r.name(m.Source),
+		ColumnTranslation: "source → " + r.name(m.SourceNatInterface),
+		ColumnStatus:      yesNo(m.Status, "enabled", "disabled"),
+		ColumnComment:     m.Comment,
+	}, true
+}
+
+// sentence returns the row in plain language, e.g.
+// #12 Allow LAN (10.0.0.0/24) → Web Servers, HTTPS, logged, Mon–Fri 08:00–18:00
+func sentence(row map[string]string, columns []string) string {
+	var head, parts, name []string
+	for _, c := range columns {
+		v := row[c]
+		if v == "" {
+			continue
+		}
+		switch c {
+		case ColumnPosition:
+			head = append(head, "#"+v)
+		case ColumnAction:
+			head = append(head, v)
+		case ColumnSources:
+			if d, ok := row[ColumnDestinations]; ok && d != "" && contains(columns, ColumnDestinations) {
+				v += " → " + d
+			}
+			head = append(head, v)
+		case ColumnDestinations:
+			if contains(columns, ColumnSources) && row[ColumnSources] != "" {
+				continue
+			}
+			head = append(head, "→ "+v)
+		case ColumnName:
+			name = append(name, fmt.Sprintf("%q", v))
+		case ColumnInterface:
+			parts = append(parts, "on "+v)
+		case ColumnGroup:
+			parts = append(parts, "group "+v)
+		default:
+			parts = append(parts, v)
+		}
+	}
+	parts = append(append([]string{strings.Join(head, " ")}, parts...), name...)
+	return strings.Join(parts, ", ")
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func renderText(w io.Writer, sections []Section, opts RenderOptions) error {
+	for _, sec := range sections {
+		fmt.Fprintf(w, "%s\n\n", sec.Title)
+		for _, g := range sec.Groups {
+			indent := ""
+			if g.Name != "" {
+				fmt.Fprintf(w, "  %s\n", g.Name)
+				indent = "  "
+			}
+			for _, row := range g.Rows {
+				if _, err := fmt.Fprintf(w, "%s  %s\n", indent, sentence(row, opts.Columns)); err != nil {
+					return err
+				}
+			}
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+func renderMarkdown(w io.Writer, sections []Section, opts RenderOptions) error {
+	esc := strings.NewReplacer("|", `\|`, "\n", " ")
+	for _, sec := range sections {
+		fmt.Fprintf(w, "## %s\n\n", sec.Title)
+		for _, g := range sec.Groups {
+			if g.Name != "" {
+				fmt.Fprintf(w, "### %s\n\n", g.Name)
+			}
+			titles := make([]string, len(opts.Columns))
+			seps := make([]string, len(opts.Columns))
+			for i, c := range opts.Columns {
+				titles[i], seps[i] = columnTitles[c], "---"
+			}
+			fmt.Fprintf(w, "| %s |\n| %s |\n", strings.Join(titles, " | "), strings.Join(seps, " | "))
+			for _, row := range g.Rows {
+				vv := make([]string, len(opts.Columns))
+				for i, c := range opts.Columns {
+					vv[i] = esc.Replace(row[c])
+				}
+				if _, err := fmt.Fprintf(w, "| %s |\n", strings.Join(vv, " | ")); err != nil {
+					return err
+				}
+			}
+			fmt.Fprintln(w)
+		}
+	}
+	return nil
+}
+
+func renderHTML(w io.Writer, sections []Section, opts RenderOptions) error {
+	for _, sec := range sections {
+		fmt.Fprintf(w, "<h2>%s</h2>\n", html.EscapeString(sec.Title))
+		for _, g := range sec.Groups {
+			if g.Name != "" {
+				fmt.Fprintf(w, "<h3>%s</h3>\n", html.EscapeString(g.Name))
+			}
+			fmt.Fprint(w, "<table>\n<thead><tr>")
+			for _, c := range opts.Columns {
+				fmt.Fprintf(w, "<th>%s</th>", html.EscapeString(columnTitles[c]))
+			}
+			fmt.Fprint(w, "</tr></thead>\n<tbody>\n")
+			for _, row := range g.Rows {
+				fmt.Fprint(w, "<tr>")
+				for _, c := range opts.Columns {
+					fmt.Fprintf(w, "<td>%s</td>", html.EscapeString(row[c]))
+				}
+				fmt.Fprint(w, "</tr>\n")
+			}
+			if _, err := fmt.Fprint(w, "</tbody>\n</table>\n"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func renderCSV(w io.Writer, sections []Section, opts RenderOptions) error {
+	cw := csv.NewWriter(w)
+	header := []string{"section"}
+	if opts.GroupBy != "" {
+		header = append(header, opts.GroupBy)
+	}
+	cw.Write(append(header, opts.Columns...))
+	for _, sec := range sections {
+		for _, g := range sec.Groups {
+			for _, row := range g.Rows {
+				rec := []string{sec.Title}
+				if opts.GroupBy != "" {
+					rec = append(rec, g.Name)
+				}
+				for _, c := range opts.Columns {
+					rec = append(rec, row[c])
+				}
+				cw.Write(rec)
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,55 @@
+package policy_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/esurdam/go-sophos/policy"
+)
+
+func TestRender(t *testing.T) {
+	s := evaluateSnapshot(t)
+
+	var buf bytes.Buffer
+	if err := policy.Render(&buf, s, policy.RenderOptions{Details: true}); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`#1 Allow Any → web (10.0.1.1/32), HTTPS (tcp/443), Mon–Fri 08:00–18:00, "Web"`,
+		`#2 Allow lan (10.0.0.0/24) → Any, Any, "LAN"`,
+		`#1 DNAT Any → public (203.0.113.10/32), HTTPS (tcp/443), destination → web (10.0.1.1/32), "Web DNAT"`,
+		`#1 Masquerade lan (10.0.0.0/24), source → External, "LAN"`,
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("missing %s in\n%s", want, buf.String())
+		}
+	}
+
+	tests := []struct {
+		opts policy.RenderOptions
+		want []string
+	}{
+		{policy.RenderOptions{Format: policy.FormatMarkdown, Columns: []string{policy.ColumnPosition, policy.ColumnName, policy.ColumnTime}},
+			[]string{"## Packet filter rules", "| # | Name | Time |", "| 1 | Web | Mon–Fri 08:00–18:00 |"}},
+		{policy.RenderOptions{Format: policy.FormatHTML, GroupBy: policy.GroupByInterface, Columns: []string{policy.ColumnName}},
+			[]string{"<h3>Any interface</h3>", "<th>Name</th>", "<td>Web DNAT</td>"}},
+		{policy.RenderOptions{Format: policy.FormatCSV, Columns: []string{policy.ColumnPosition, policy.ColumnAction}},
+			[]string{"section,position,action\n", "Packet filter rules,2,Allow\n", "NAT rules,1,DNAT\n"}},
+	}
+	for _, tt := range tests {
+		buf.Reset()
+		if err := policy.Render(&buf, s, tt.opts); err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(buf.String(), want) {
+				t.Errorf("%s: missing %q in\n%s", tt.opts.Format, want, buf.String())
+			}
+		}
+	}
+
+	if err := policy.Render(&buf, s, policy.RenderOptions{Columns: []string{"foo"}}); err == nil {
+		t.Error("unknown columns should fail")
+	}
+}
@@ -0,0 +1,150 @@
+package policy
+
+import (
+	"github.com/esurdam/go-sophos/netset"
+	"github.com/esurdam/go-sophos/snapshot"
+	"github.com/esurdam/go-sophos/svcset"
+)
+
+// resolver resolves network and service References of a Snapshot
+type resolver struct {
+	s        *snapshot.Snapshot
+	nets     *netset.Resolver
+	svcs     *svcset.Resolver
+	networks map[string]resolved
+	services map[string]resolved
+}
+
+// resolved is the result of resolving a Reference. exact is false if the
+// Reference could not be resolved completely, e.g. unresolved DNS hosts.
+type resolved struct {
+	intervals []interval
+	services  []svc
+	exact     bool
+	err       error
+}
+
+func newResolver(s *snapshot.Snapshot) *resolver {
+	return &resolver{s: s, nets: netset.NewResolver(s), svcs: svcset.NewResolver(s),
+		networks: map[string]resolved{}, services: map[string]resolved{}}
+}
+
+// network resolves the network Reference to address intervals
+func (r *resolver) network(ref string) resolved {
+	if res, ok := r.networks[ref]; ok {
+		return res
+	}
+	set, exact, err := r.nets.Resolve(ref)
+	res := resolved{exact: exact, err: err}
+	for _, rr := range set.IPv4().Ranges() {
+		i, err := rangeInterval(rr)
+		if err != nil {
+			res.err = err
+			break
+		}
+		res.intervals = append(res.intervals, i)
+	}
+	// IPv4 addresses are represented by their IPv4-mapped IPv6 address, which must not be matched by IPv6 ranges
+	for _, rr := range set.IPv6().Ranges() {
+		i, err := rangeInterval(rr)
+		if err != nil {
+			res.err = err
+			break
+		}
+		if !i.overlaps(ipv4Mapped) {
+			res.intervals = append(res.intervals, i)
+			continue
+		}
+		if i.lo.Cmp(ipv4Mapped.lo) < 0 {
+			res.intervals = append(res.intervals, interval{lo: i.lo, hi: ipv4Mapped.lo.Sub1()})
+		}
+		if i.hi.Cmp(ipv4Mapped.hi) > 0 {
+			res.intervals = append(res.intervals, interval{lo: ipv4Mapped.hi.Add1(), hi: i.hi})
+		}
+	}
+	r.networks[ref] = res
+	return res
+}
+
+func rangeInterval(r netset.Range) (interval, error) {
+	lo, err := netset.IPValue(r.From)
+	if err != nil {
+		return interval{}, err
+	}
+	hi, err := netset.IPValue(r.To)
+	return interval{lo: lo, hi: hi}, err
+}
+
+// ipv4Mapped contains the IPv4-mapped IPv6 addresses ::ffff:0:0/96
+var ipv4Mapped = interval{lo: netset.Uint64(0xffff << 32), hi: netset.Uint64(0xffffffffffff)}
+
+// space resolves the network and service References into the cartesian product of sources,
+// destinations and services. missing contains the References which could not be resolved.
+func (r *resolver) space(sources, destinations, services []string) (sp space, missing []string, exact bool) {
+	exact = true
+	network := func(refs []string) []interval {
+		var ii []interval
+		for _, ref := range refs {
+			n := r.network(ref)
+			if n.err != nil {
+				missing = append(missing, ref)
+				exact = false
+				continue
+			}
+			exact = exact && n.exact
+			ii = append(ii, n.intervals...)
+		}
+		return ii
+	}
+	src, dst := network(sources), network(destinations)
+
+	var ss []svc
+	for _, ref := range services {
+		sr := r.service(ref)
+		if sr.err != nil {
+			missing = append(missing, ref)
+			exact = false
+			continue
+		}
+		exact = exact && sr.exact
+		ss = append(ss, sr.services...)
+	}
+
+	for _, si := range src {
+		for _, di := range dst {
+			for _, sv := range ss {
+				var b box
+				b[dimSrc], b[dimDst] = si, di
+				b[dimProto], b[dimDstPort], b[dimSrcPort] = sv.proto, sv.dst, sv.src
+				sp = append(sp, b)
+			}
+		}
+	}
+	return sp, missing, exact
+}
+
+// svc is a protocol with destination and source port ranges.
+// For ICMP the type is stored as destination and the code as source port.
+type svc struct {
+	proto, dst, src interval
+}
+
+var anyPort = interval{lo: netset.Uint64(0), hi: netset.Uint64(65535)}
+
+// service resolves the service Reference to protocol and port ranges
+func (r *resolver) service(ref string) resolved {
+	if res, ok := r.services[ref]; ok {
+		return res
+	}
+	set, exact, err := r.svcs.Resolve(ref)
+	res := resolved{exact: exact, err: err}
+	for _, v := range set.Services() {
+		res.services = append(res.services, svc{portInterval(v.Proto), portInterval(v.Dst), portInterval(v.Src)})
+	}
+	r.services[ref] = res
+	return res
+}
+
+func portInterval(r svcset.Range) interval {
+	return interval{lo: netset.Uint64(uint64(r.Low)), hi: netset.Uint64(uint64(r.High))}
+}
@@ -0,0 +1,112 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+// schedule is the subset of attributes describing time objects
+type schedule struct {
+	StartDate string   `json:"start_date"`
+	EndDate   string   `json:"end_date"`
+	StartTime string   `json:"start_time"`
+	EndTime   string   `json:"end_time"`
+	Weekdays  []string `json:"weekdays"`
+	Members   []string `json:"members"`
+}
+
+// active returns true if the time object is active at t
+func (r *resolver) active(ref string, t time.Time) (bool, error) {
+	return r.activeAt(ref, t, map[string]bool{})
+}
+
+func (r *resolver) activeAt(ref string, t time.Time, seen map[string]bool) (bool, error) {
+	if seen[ref] {
+		return false, fmt.Errorf("policy: reference cycle at %s", ref)
+	}
+	seen[ref] = true
+
+	objType := r.s.Type(ref)
+	if objType == "" {
+		return false, fmt.Errorf("%s: %s", snapshot.ErrNotFound, ref)
+	}
+	var sc schedule
+	if err := r.s.Decode(ref, &sc); err != nil {
+		return false, err
+	}
+
+	switch objType {
+	case "time/recurring":
+		if len(sc.Weekdays) > 0 {
+			day := t.Weekday().String()[:3]
+			found := false
+			for _, d := range sc.Weekdays {
+				found = found || (len(d) >= 3 && strings.EqualFold(d[:3], day))
+			}
+			if !found {
+				return false, nil
+			}
+		}
+		start, err := clock(sc.StartTime, 0)
+		if err != nil {
+			return false, fmt.Errorf("policy: start_time of %s: %s", ref, err.Error())
+		}
+		end, err := clock(sc.EndTime, 24*time.Hour)
+		if err != nil {
+			return false, fmt.Errorf("policy: end_time of %s: %s", ref, err.Error())
+		}
+		now := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+		if end < start {
+			// wraps around midnight
+			return now >= start || now < end, nil
+		}
+		return now >= start && now < end, nil
+	case "time/single":
+		start, err := dateTime(sc.StartDate, sc.StartTime, 0, t.Location())
+		if err != nil {
+			return false, fmt.Errorf("policy: start of %s: %s", ref, err.Error())
+		}
+		end, err := dateTime(sc.EndDate, sc.EndTime, 24*time.Hour, t.Location())
+		if err != nil {
+			return false, fmt.Errorf("policy: end of %s: %s", ref, err.Error())
+		}
+		return !t.Before(start) && t.Before(end), nil
+	case "time/group":
+		for _, m := range sc.Members {
+			ok, err := r.activeAt(m, t, seen)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
+	return false, fmt.Errorf("policy: unsupported time object %s (%s)", ref, objType)
+}
+
+// clock parses HH:MM into the duration since midnight, def is returned for empty values
+func clock(v string, def time.Duration) (time.Duration, error) {
+	if v == "" {
+		return def, nil
+	}
+	c, err := time.Parse("15:04", v)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(c.Hour())*time.Hour + time.Duration(c.Minute())*time.Minute, nil
+}
+
+// dateTime parses the date YYYY-MM-DD and time HH:MM, def is added to the date if the time is empty
+func dateTime(date, clk string, def time.Duration, loc *time.Location) (time.Time, error) {
+	d, err := time.ParseInLocation("2006-01-02", date, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	c, err := clock(clk, def)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return d.Add(c), nil
+}
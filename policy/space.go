@@ -0,0 +1,141 @@
+package policy
+
+import "github.com/esurdam/go-sophos/netset"
+
+// interval is the closed interval [lo, hi]
+type interval struct{ lo, hi netset.Uint128 }
+
+func (i interval) overlaps(j interval) bool { return i.lo.Cmp(j.hi) <= 0 && j.lo.Cmp(i.hi) <= 0 }
+
+func (i interval) contains(j interval) bool { return i.lo.Cmp(j.lo) <= 0 && j.hi.Cmp(i.hi) <= 0 }
+
+func (i interval) intersect(j interval) interval {
+	r := i
+	if j.lo.Cmp(r.lo) > 0 {
+		r.lo = j.lo
+	}
+	if j.hi.Cmp(r.hi) < 0 {
+		r.hi = j.hi
+	}
+	return r
+}
+
+// dimensions of the traffic space
+const (
+	dimSrc = iota
+	dimDst
+	dimProto
+	dimDstPort
+	dimSrcPort
+	dims
+)
+
+// box is a hyperrectangle in the traffic space
+type box [dims]interval
+
+func (b box) overlaps(c box) bool {
+	for d := range b {
+		if !b[d].overlaps(c[d]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (b box) contains(c box) bool {
+	for d := range b {
+		if !b[d].contains(c[d]) {
+			return false
+		}
+	}
+	return true
+}
+
+// subtract returns the disjoint boxes covering b without c
+func (b box) subtract(c box) []box {
+	if !b.overlaps(c) {
+		return []box{b}
+	}
+	var out []box
+	rest := b
+	for d := range rest {
+		if rest[d].lo.Cmp(c[d].lo) < 0 {
+			part := rest
+			part[d].hi = c[d].lo.Sub1()
+			out = append(out, part)
+			rest[d].lo = c[d].lo
+		}
+		if rest[d].hi.Cmp(c[d].hi) > 0 {
+			part := rest
+			part[d].lo = c[d].hi.Add1()
+			out = append(out, part)
+			rest[d].hi = c[d].hi
+		}
+	}
+	return out
+}
+
+// maxBoxes limits the fragmentation of a space, operations exceeding it are considered inexact
+const maxBoxes = 1 << 14
+
+// space is a union of boxes
+type space []box
+
+func (s space) overlaps(t space) bool {
+	for _, b := range s {
+		for _, c := range t {
+			if b.overlaps(c) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// subtract returns s without t. ok is false if the result became too fragmented.
+func (s space) subtract(t space) (r space, ok bool) {
+	r = s
+	for _, c := range t {
+		var next space
+		for _, b := range r {
+			next = append(next, b.subtract(c)...)
+		}
+		if len(next) > maxBoxes {
+			return nil, false
+		}
+		r = next
+		if len(r) == 0 {
+			break
+		}
+	}
+	return r, true
+}
+
+// matches returns true if a box of s contains the point p. Dimensions in ignore only have to overlap.
+func (s space) matches(p box, ignore ...int) bool {
+next:
+	for _, b := range s {
+		for d := range b {
+			if !b[d].contains(p[d]) && !(inDims(d, ignore) && b[d].overlaps(p[d])) {
+				continue next
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func inDims(d int, dd []int) bool {
+	for _, e := range dd {
+		if e == d {
+			return true
+		}
+	}
+	return false
+}
+
+// within returns true if s is fully covered by t
+func (s space) within(t space) bool {
+	r, ok := s.subtract(t)
+	return ok && len(r) == 0
+}
@@ -1,6 +1,10 @@
 package sophos
 
-import "strings"
+import (
+	"reflect"
+	"regexp"
+	"strings"
+)
 
 // A Reference is the connections between nodes and objects as well as between one object and another object.
 // Each confd node and object has a list of attributes with pre defined types, where one of the types can be a
@@ -14,6 +18,10 @@ const (
 	RefServiceAny = "REF_ServiceAny"
 	// RefNetworkAny is a well-known Reference referring to any UTM Network
 	RefNetworkAny = "REF_NetworkAny"
+	// RefNetworkAny4 is a well-known Reference referring to any IPv4 UTM Network
+	RefNetworkAny4 = "REF_NetworkAny4"
+	// RefNetworkAny6 is a well-known Reference referring to any IPv6 UTM Network
+	RefNetworkAny6 = "REF_NetworkAny6"
 	// RefNtpPool is a well-known Reference referring to the NTP Pool
 	RefNtpPool = "REF_NtpPool"
 	// RefDefaultSuperAdminGroup is a Reference to the UTM's Default Super Admin Group
@@ -41,3 +49,97 @@ func IsReference(ref string) bool { return strings.HasPrefix(ref, refPrefix) }
 
 // IsReference returns true if the string has the prefex "REF_"
 func (r Reference) IsReference() bool { return IsReference(string(r)) }
+
+// A RefConstraint is an allowed Reference target of an attribute as declared by the swagger
+// descriptions, e.g. REF(network/host). Class or Type may be the wildcard "*".
+type RefConstraint struct {
+	Class string
+	Type  string
+}
+
+var refConstraintRegexp = regexp.MustCompile(`REF\(([\w*]+)/([\w*]+)\)`)
+
+// ParseRefConstraints returns all RefConstraints found in the description,
+// e.g. "REF(network/host), REF(network/dns_host)"
+func ParseRefConstraints(description string) []RefConstraint {
+	var rr []RefConstraint
+	for _, m := range refConstraintRegexp.FindAllStringSubmatch(description, -1) {
+		rr = append(rr, RefConstraint{Class: m[1], Type: m[2]})
+	}
+	return rr
+}
+
+// Allows returns true if an object of objType (e.g. network/host) satisfies the RefConstraint
+func (r RefConstraint) Allows(objType string) bool {
+	parts := strings.SplitN(objType, "/", 2)
+	if len(parts) != 2 {
+		return false
+	}
+	return (r.Class == "*" || r.Class == parts[0]) && (r.Type == "*" || r.Type == parts[1])
+}
+
+// String returns the RefConstraint as it is written in swagger descriptions
+func (r RefConstraint) String() string { return "REF(" + r.Class + "/" + r.Type + ")" }
+
+// AllowsAny returns true if any of the RefConstraints allow objType
+func AllowsAny(rr []RefConstraint, objType string) bool {
+	for _, r := range rr {
+		if r.Allows(objType) {
+			return true
+		}
+	}
+	return false
+}
+
+// A ClassRef is a typed Reference attribute which only refers to objects of a class, e.g. the
+// generated objects.NetworkRef and objects.NetworkRefs
+type ClassRef interface {
+	// Class returns the class of the referenced objects, e.g. network
+	Class() string
+}
+
+// A ClassReference is a Reference of a ClassRef attribute found by ClassReferences
+type ClassReference struct {
+	// Field is the JSON name of the attribute
+	Field     string
+	Class     string
+	Reference string
+}
+
+// ClassReferences returns the References of the ClassRef attributes of the object (a struct or a pointer
+// to a struct) in the order of the fields, empty References are skipped
+func ClassReferences(o interface{}) []ClassReference {
+	v := reflect.Indirect(reflect.ValueOf(o))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	var rr []ClassReference
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if v.Type().Field(i).PkgPath != "" {
+			continue
+		}
+		cr, ok := f.Interface().(ClassRef)
+		if !ok {
+			continue
+		}
+		name := strings.Split(v.Type().Field(i).Tag.Get("json"), ",")[0]
+		if name == "" {
+			name = v.Type().Field(i).Name
+		}
+		add := func(ref string) {
+			if ref != "" {
+				rr = append(rr, ClassReference{Field: name, Class: cr.Class(), Reference: ref})
+			}
+		}
+		switch f.Kind() {
+		case reflect.String:
+			add(f.String())
+		case reflect.Slice:
+			for j := 0; j < f.Len(); j++ {
+				add(f.Index(j).String())
+			}
+		}
+	}
+	return rr
+}
@@ -12,7 +12,14 @@ type Response struct {
 	Errors *Errors
 }
 
-// MarshalTo marshals the response's body to the provided interface
+// MarshalTo marshals the response's body to the provided interface. Unknown enum values fail if
+// the request was sent with StrictEnums.
 func (r *Response) MarshalTo(x interface{}) error {
-	return json.NewDecoder(r.Body).Decode(x)
+	if err := json.NewDecoder(r.Body).Decode(x); err != nil {
+		return err
+	}
+	if r.Response != nil && r.Request != nil && strictEnums(r.Request.Context()) {
+		return CheckEnums(x)
+	}
+	return nil
 }
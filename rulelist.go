@@ -0,0 +1,360 @@
+package sophos
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// OrderedNodes maps the nodes containing ordered lists of rule References to the
+// object types of the rules
+var OrderedNodes = map[string][]string{
+	"packetfilter.rules": {"packetfilter/packetfilter"},
+	"nat.rules":          {"packetfilter/nat", "packetfilter/1to1nat"},
+	"masq.rules":         {"packetfilter/masq"},
+}
+
+// ErrConcurrentModification is returned by RuleList.Save when the node was changed since it was loaded
+var ErrConcurrentModification = errors.New("rulelist: node was modified concurrently")
+
+// A RuleListEntry is a rule of a RuleList
+type RuleListEntry struct {
+	Reference string `json:"_ref"`
+	Type      string `json:"_type"`
+	Name      string `json:"name"`
+	Status    bool   `json:"status"`
+}
+
+// A RuleList edits the order of an ordered node (e.g. packetfilter.rules) and the status of its rules.
+// Changes are made locally and written by Save, which fails with ErrConcurrentModification if the node
+// was changed by others since it was loaded.
+//
+// Rules are identified by their Reference or their unique name.
+type RuleList struct {
+	Node  string
+	types []string
+	// loaded is the value of the node when it was loaded or saved
+	loaded  []string
+	entries []RuleListEntry
+	// status contains the pending status changes by Reference
+	status map[string]bool
+}
+
+// NewRuleList loads the ordered node and its rules. The object types of the rules default to
+// those of OrderedNodes.
+func NewRuleList(c ClientInterface, node string, types []string, options ...Option) (*RuleList, error) {
+	if len(types) == 0 {
+		types = OrderedNodes[node]
+	}
+	if len(types) == 0 {
+		return nil, fmt.Errorf("rulelist: object types of node %s are unknown", node)
+	}
+	l := &RuleList{Node: node, types: types, status: map[string]bool{}}
+	refs, err := l.get(c, options...)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := l.objects(c, options...)
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range refs {
+		e, ok := objects[ref]
+		if !ok {
+			return nil, fmt.Errorf("rulelist: %s of node %s does not exist", ref, node)
+		}
+		l.entries = append(l.entries, e)
+	}
+	l.loaded = refs
+	return l, nil
+}
+
+// get returns the References of the node
+func (l *RuleList) get(c ClientInterface, options ...Option) ([]string, error) {
+	res, err := c.Get("/api/nodes/"+l.Node, options...)
+	if err != nil {
+		return nil, fmt.Errorf("rulelist: error retrieving node %s: %s", l.Node, err.Error())
+	}
+	var refs []string
+	if err := res.MarshalTo(&refs); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// objects returns the objects of the rule types by Reference
+func (l *RuleList) objects(c ClientInterface, options ...Option) (map[string]RuleListEntry, error) {
+	objects := map[string]RuleListEntry{}
+	for _, t := range l.types {
+		res, err := c.Get("/api/objects/"+t+"/", options...)
+		if err != nil {
+			return nil, fmt.Errorf("rulelist: error retrieving %s objects: %s", t, err.Error())
+		}
+		var ee []RuleListEntry
+		if err := res.MarshalTo(&ee); err != nil {
+			return nil, err
+		}
+		for _, e := range ee {
+			objects[e.Reference] = e
+		}
+	}
+	return objects, nil
+}
+
+// Rules returns the rules in order, with pending status changes applied
+func (l *RuleList) Rules() []RuleListEntry {
+	ee := make([]RuleListEntry, len(l.entries))
+	for i, e := range l.entries {
+		if s, ok := l.status[e.Reference]; ok {
+			e.Status = s
+		}
+		ee[i] = e
+	}
+	return ee
+}
+
+// References returns the References in order
+func (l *RuleList) References() []string {
+	refs := make([]string, len(l.entries))
+	for i, e := range l.entries {
+		refs[i] = e.Reference
+	}
+	return refs
+}
+
+// Index returns the index of the rule with the Reference or name
+func (l *RuleList) Index(rule string) (int, error) {
+	index := -1
+	for i, e := range l.entries {
+		if e.Reference == rule {
+			return i, nil
+		}
+		if e.Name == rule {
+			if index >= 0 {
+				return -1, fmt.Errorf("rulelist: name %q of node %s is ambiguous", rule, l.Node)
+			}
+			index = i
+		}
+	}
+	if index < 0 {
+		return -1, fmt.Errorf("rulelist: rule %q is not contained in node %s", rule, l.Node)
+	}
+	return index, nil
+}
+
+// MoveTo moves the rule to the index
+func (l *RuleList) MoveTo(rule string, index int) error {
+	i, err := l.Index(rule)
+	if err != nil {
+		return err
+	}
+	if index < 0 || index >= len(l.entries) {
+		return fmt.Errorf("rulelist: index %d is out of range of node %s", index, l.Node)
+	}
+	e := l.entries[i]
+	l.entries = append(l.entries[:i], l.entries[i+1:]...)
+	l.entries = append(l.entries[:index], append([]RuleListEntry{e}, l.entries[index:]...)...)
+	return nil
+}
+
+// MoveBefore moves the rule before the other rule
+func (l *RuleList) MoveBefore(rule, other string) error { return l.move(rule, other, 0) }
+
+// MoveAfter moves the rule after the other rule
+func (l *RuleList) MoveAfter(rule, other string) error { return l.move(rule, other, 1) }
+
+func (l *RuleList) move(rule, other string, offset int) error {
+	i, err := l.Index(rule)
+	if err != nil {
+		return err
+	}
+	j, err := l.Index(other)
+	if err != nil {
+		return err
+	}
+	if i == j {
+		return fmt.Errorf("rulelist: can not move %q relative to itself", rule)
+	}
+	if i < j {
+		// the other rule moves up once the rule is removed
+		j--
+	}
+	return l.MoveTo(rule, j+offset)
+}
+
+// Remove removes the rule from the node, the rule object is not deleted
+func (l *RuleList) Remove(rule string) error {
+	i, err := l.Index(rule)
+	if err != nil {
+		return err
+	}
+	delete(l.status, l.entries[i].Reference)
+	l.entries = append(l.entries[:i], l.entries[i+1:]...)
+	return nil
+}
+
+// InsertAt inserts the rule object with the Reference at the index, the index of the number of rules
+// appends it. The object must exist when the RuleList is saved.
+func (l *RuleList) InsertAt(index int, ref string) error {
+	if !IsReference(ref) {
+		return fmt.Errorf("rulelist: %q is not a Reference", ref)
+	}
+	if index < 0 || index > len(l.entries) {
+		return fmt.Errorf("rulelist: index %d is out of range of node %s", index, l.Node)
+	}
+	for _, e := range l.entries {
+		if e.Reference == ref {
+			return fmt.Errorf("rulelist: %s is already contained in node %s", ref, l.Node)
+		}
+	}
+	l.entries = append(l.entries[:index], append([]RuleListEntry{{Reference: ref}}, l.entries[index:]...)...)
+	return nil
+}
+
+// Enable enables the rule
+func (l *RuleList) Enable(rule string) error { return l.setStatus(rule, true) }
+
+// Disable disables the rule
+func (l *RuleList) Disable(rule string) error { return l.setStatus(rule, false) }
+
+func (l *RuleList) setStatus(rule string, status bool) error {
+	i, err := l.Index(rule)
+	if err != nil {
+		return err
+	}
+	e := l.entries[i]
+	if e.Status == status && e.Type != "" {
+		delete(l.status, e.Reference)
+		return nil
+	}
+	l.status[e.Reference] = status
+	return nil
+}
+
+// Save writes the changes. It fails with ErrConcurrentModification if the node was changed since it
+// was loaded or is changed while saving, and validates that every Reference is an object of the rule
+// types. The node is written before the status changes, if a status change fails the changed statuses
+// and the order of the node are restored, as is the order when a concurrent change is detected after
+// writing it. The confd session is closed once all requests are done.
+func (l *RuleList) Save(c ClientInterface, options ...Option) (err error) {
+	defer func() {
+		if cerr := CloseSession(c, options...); cerr != nil && err == nil {
+			err = fmt.Errorf("rulelist: %s", cerr.Error())
+		}
+	}()
+	current, err := l.get(c, options...)
+	if err != nil {
+		return err
+	}
+	if !equalRefs(current, l.loaded) {
+		return ErrConcurrentModification
+	}
+	objects, err := l.objects(c, options...)
+	if err != nil {
+		return err
+	}
+	var missing []string
+	for i, e := range l.entries {
+		o, ok := objects[e.Reference]
+		if !ok {
+			missing = append(missing, e.Reference)
+			continue
+		}
+		// inserted rules are only known by their Reference until now
+		l.entries[i] = o
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("rulelist: %s of node %s do not exist", strings.Join(missing, ", "), l.Node)
+	}
+
+	var changed []RuleListEntry
+	for _, e := range l.entries {
+		if status, ok := l.status[e.Reference]; ok && status != e.Status {
+			changed = append(changed, e)
+		}
+	}
+	// the order is written first, the statuses are only changed once it is saved
+	refs := l.References()
+	if err := l.put(c, refs, options...); err != nil {
+		return err
+	}
+	// re-read the node to detect changes made concurrently
+	saved, err := l.get(c, options...)
+	if err != nil {
+		return l.rollback(c, current, nil, err, options...)
+	}
+	if !equalRefs(saved, refs) {
+		if perr := l.put(c, current, options...); perr != nil {
+			return fmt.Errorf("%s (rollback failed: %s)", ErrConcurrentModification.Error(), perr.Error())
+		}
+		return ErrConcurrentModification
+	}
+
+	for i, e := range changed {
+		if err := l.patchStatus(c, e, l.status[e.Reference], options...); err != nil {
+			return l.rollback(c, current, changed[:i], err, options...)
+		}
+	}
+
+	for i, e := range l.entries {
+		if s, ok := l.status[e.Reference]; ok {
+			l.entries[i].Status = s
+		}
+	}
+	l.status = map[string]bool{}
+	l.loaded = saved
+	return nil
+}
+
+func (l *RuleList) put(c ClientInterface, refs []string, options ...Option) error {
+	byt, err := json.Marshal(refs)
+	if err != nil {
+		return err
+	}
+	if _, err := c.Put("/api/nodes/"+l.Node, bytes.NewReader(byt), options...); err != nil {
+		return fmt.Errorf("rulelist: error updating node %s: %s", l.Node, err.Error())
+	}
+	return nil
+}
+
+func (l *RuleList) patchStatus(c ClientInterface, e RuleListEntry, status bool, options ...Option) error {
+	byt, err := json.Marshal(map[string]bool{"status": status})
+	if err != nil {
+		return err
+	}
+	if _, err := c.Patch("/api/objects/"+e.Type+"/"+e.Reference, bytes.NewReader(byt), options...); err != nil {
+		return fmt.Errorf("rulelist: error changing status of %s %q: %s", e.Type, e.Name, err.Error())
+	}
+	return nil
+}
+
+// rollback restores the statuses of the patched rules and the order of the node after err
+func (l *RuleList) rollback(c ClientInterface, order []string, patched []RuleListEntry, err error, options ...Option) error {
+	var rerrs []string
+	for i := len(patched) - 1; i >= 0; i-- {
+		if perr := l.patchStatus(c, patched[i], patched[i].Status, options...); perr != nil {
+			rerrs = append(rerrs, perr.Error())
+		}
+	}
+	if perr := l.put(c, order, options...); perr != nil {
+		rerrs = append(rerrs, perr.Error())
+	}
+	if len(rerrs) > 0 {
+		return fmt.Errorf("%s (rollback failed: %s)", err.Error(), strings.Join(rerrs, "; "))
+	}
+	return fmt.Errorf("%s, changes were rolled back", err.Error())
+}
+
+func equalRefs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
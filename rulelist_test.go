@@ -0,0 +1,193 @@
+package sophos_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+)
+
+// fakeRules serves packetfilter.rules and its packetfilter/packetfilter objects
+type fakeRules struct {
+	mu      sync.Mutex
+	node    []string
+	rules   []sophos.RuleListEntry
+	patched map[string]bool
+	// changeOnPut modifies the node once after it was written
+	changeOnPut bool
+	// failPatch is the Reference whose status changes fail
+	failPatch string
+	// closes counts the requests closing the session
+	closes int
+}
+
+func (f *fakeRules) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	if r.Header.Get(sophos.XRestdSession) == "close" {
+		f.closes++
+	}
+	switch {
+	case r.URL.Path == "/api/status/version":
+		json.NewEncoder(w).Encode(map[string]string{"restapi": "1.3.0"})
+	case r.URL.Path == "/api/nodes/packetfilter.rules" && r.Method == http.MethodGet:
+		json.NewEncoder(w).Encode(f.node)
+	case r.URL.Path == "/api/nodes/packetfilter.rules" && r.Method == http.MethodPut:
+		json.NewDecoder(r.Body).Decode(&f.node)
+		if f.changeOnPut {
+			f.node, f.changeOnPut = append(f.node[1:], f.node[0]), false
+		}
+		json.NewEncoder(w).Encode(f.node)
+	case r.URL.Path == "/api/objects/packetfilter/packetfilter/":
+		json.NewEncoder(w).Encode(f.rules)
+	case r.Method == http.MethodPatch && strings.HasSuffix(r.URL.Path, "/"+f.failPatch):
+		w.WriteHeader(http.StatusBadRequest)
+	case r.Method == http.MethodPatch:
+		var body map[string]bool
+		json.NewDecoder(r.Body).Decode(&body)
+		f.patched[r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]] = body["status"]
+		json.NewEncoder(w).Encode(body)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newFakeRules(t *testing.T) (*fakeRules, *sophos.Client, func()) {
+	f := &fakeRules{node: []string{"REF_A", "REF_B", "REF_C"}, patched: map[string]bool{}}
+	for _, n := range []string{"A", "B", "C", "D"} {
+		f.rules = append(f.rules, sophos.RuleListEntry{Reference: "REF_" + n, Type: "packetfilter/packetfilter", Name: "rule " + n, Status: n != "B"})
+	}
+	ts := httptest.NewServer(f)
+	sophos.DefaultHTTPClient = ts.Client()
+	c, err := sophos.New(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f, c, ts.Close
+}
+
+func TestRuleList(t *testing.T) {
+	f, c, done := newFakeRules(t)
+	defer done()
+
+	l, err := sophos.NewRuleList(c, "packetfilter.rules", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []struct {
+		name string
+		do   func() error
+		want []string
+	}{
+		{"move after", func() error { return l.MoveAfter("rule A", "REF_C") }, []string{"REF_B", "REF_C", "REF_A"}},
+		{"move before", func() error { return l.MoveBefore("REF_A", "rule B") }, []string{"REF_A", "REF_B", "REF_C"}},
+		{"move to", func() error { return l.MoveTo("rule C", 0) }, []string{"REF_C", "REF_A", "REF_B"}},
+		{"insert", func() error { return l.InsertAt(1, "REF_D") }, []string{"REF_C", "REF_D", "REF_A", "REF_B"}},
+		{"remove", func() error { return l.Remove("rule A") }, []string{"REF_C", "REF_D", "REF_B"}},
+	}
+	for _, s := range steps {
+		if err := s.do(); err != nil {
+			t.Fatalf("%s: %s", s.name, err)
+		}
+		if got := l.References(); !reflect.DeepEqual(got, s.want) {
+			t.Fatalf("%s: wanted %v, got %v", s.name, s.want, got)
+		}
+	}
+	if err := l.Enable("rule B"); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Disable("REF_D"); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.MoveTo("rule X", 0); err == nil {
+		t.Error("unknown rules should fail")
+	}
+	if err := l.InsertAt(0, "REF_B"); err == nil {
+		t.Error("duplicate rules should fail")
+	}
+
+	if err := l.Save(c); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(f.node, []string{"REF_C", "REF_D", "REF_B"}) {
+		t.Errorf("unexpected node %v", f.node)
+	}
+	if !reflect.DeepEqual(f.patched, map[string]bool{"REF_B": true, "REF_D": false}) {
+		t.Errorf("unexpected status changes %v", f.patched)
+	}
+	if rr := l.Rules(); rr[1].Name != "rule D" || rr[1].Status || !rr[2].Status {
+		t.Errorf("unexpected rules %+v", rr)
+	}
+}
+
+func TestRuleList_Concurrent(t *testing.T) {
+	f, c, done := newFakeRules(t)
+	defer done()
+
+	l, err := sophos.NewRuleList(c, "packetfilter.rules", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := l.MoveTo("rule C", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	f.node = []string{"REF_B", "REF_A", "REF_C"}
+	if err := l.Save(c); err != sophos.ErrConcurrentModification {
+		t.Errorf("wanted ErrConcurrentModification, got %v", err)
+	}
+
+	if l, err = sophos.NewRuleList(c, "packetfilter.rules", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.MoveTo("rule C", 0); err != nil {
+		t.Fatal(err)
+	}
+	f.changeOnPut = true
+	if err := l.Save(c); err != sophos.ErrConcurrentModification {
+		t.Errorf("changes while saving should be detected, got %v", err)
+	}
+	if !reflect.DeepEqual(f.node, []string{"REF_B", "REF_A", "REF_C"}) {
+		t.Errorf("the previous order should be restored: %v", f.node)
+	}
+
+	if l, err = sophos.NewRuleList(c, "packetfilter.rules", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.InsertAt(0, "REF_Missing"); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Save(c); err == nil || !strings.Contains(err.Error(), "REF_Missing") {
+		t.Errorf("missing objects should fail, got %v", err)
+	}
+	if f.closes != 3 {
+		t.Errorf("every save should close the session: %d", f.closes)
+	}
+}
+
+func TestRuleList_Rollback(t *testing.T) {
+	f, c, done := newFakeRules(t)
+	defer done()
+
+	l, err := sophos.NewRuleList(c, "packetfilter.rules", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.MoveTo("rule C", 0)
+	l.Disable("rule A")
+	l.Enable("rule B")
+	f.failPatch = "REF_B"
+	if err := l.Save(c); err == nil || !strings.HasSuffix(err.Error(), "changes were rolled back") {
+		t.Fatalf("failed status change should be rolled back, got %v", err)
+	}
+	if !reflect.DeepEqual(f.node, []string{"REF_A", "REF_B", "REF_C"}) || !reflect.DeepEqual(f.patched, map[string]bool{"REF_A": true}) {
+		t.Errorf("unexpected node %v and statuses %v", f.node, f.patched)
+	}
+}
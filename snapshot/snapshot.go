@@ -0,0 +1,320 @@
+// Package snapshot captures a UTM's confd objects and nodes for offline use
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+)
+
+// ErrNotFound is returned when a Reference or node is not contained in the Snapshot
+var ErrNotFound = errors.New("snapshot: not found")
+
+// A Snapshot is an offline copy of a UTM configuration.
+// Objects are kept as their raw JSON keyed by Reference so that they can be decoded
+// into the generated types from the objects package or inspected generically.
+//
+// The methods of a Snapshot are safe for concurrent use. The Nodes and Objects maps are exported to
+// encode the Snapshot, they must not be accessed directly while it is modified, use Node, RawNode,
+// HasNode and Decode instead.
+type Snapshot struct {
+	Version sophos.Version             `json:"version"`
+	Nodes   map[string]json.RawMessage `json:"nodes"`
+	Objects map[string]json.RawMessage `json:"objects"`
+
+	mu    sync.RWMutex
+	types map[string]string
+}
+
+// header contains the attributes every confd object has
+type header struct {
+	Reference string `json:"_ref"`
+	Type      string `json:"_type"`
+	Locked    string `json:"_locked"`
+	Name      string `json:"name"`
+}
+
+// New returns an empty Snapshot
+func New() *Snapshot {
+	return &Snapshot{
+		Nodes:   map[string]json.RawMessage{},
+		Objects: map[string]json.RawMessage{},
+	}
+}
+
+// Capture GETs the version, all nodes and every object collection of the provided Endpoints.
+// When no Endpoints are provided all known objects.Endpoints are captured.
+func Capture(c sophos.ClientInterface, endpoints ...sophos.Endpoint) (*Snapshot, error) {
+	if len(endpoints) == 0 {
+		endpoints = objects.Endpoints()
+	}
+
+	s := New()
+	res, err := c.Get("/api/status/version")
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: error retrieving version from gateway: %s", err.Error())
+	}
+	if err = res.MarshalTo(&s.Version); err != nil {
+		return nil, err
+	}
+
+	res, err = c.Get("/api/nodes")
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: error retrieving nodes from gateway: %s", err.Error())
+	}
+	if err = res.MarshalTo(&s.Nodes); err != nil {
+		return nil, err
+	}
+
+	for _, path := range CollectionPaths(endpoints...) {
+		res, err := c.Get(path)
+		if err != nil {
+			return nil, fmt.Errorf("snapshot: error retrieving %s from gateway: %s", path, err.Error())
+		}
+		var oo []json.RawMessage
+		if err = res.MarshalTo(&oo); err != nil {
+			return nil, fmt.Errorf("snapshot: error decoding %s: %s", path, err.Error())
+		}
+		for _, o := range oo {
+			if err := s.AddRaw(o); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return s, nil
+}
+
+// CollectionPaths returns the sorted object collection paths (e.g. /api/objects/network/host/)
+// of the Endpoints' RestObjects
+func CollectionPaths(endpoints ...sophos.Endpoint) []string {
+	seen := map[string]bool{}
+	var paths []string
+	for _, e := range endpoints {
+		for _, o := range e.RestObjects() {
+			c, ok := o.(sophos.Creatable)
+			if !ok {
+				continue
+			}
+			p := c.PostPath()
+			if !strings.HasPrefix(p, "/api/objects/") || seen[p] {
+				continue
+			}
+			seen[p] = true
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Read decodes a Snapshot previously written with Write
+func Read(r io.Reader) (*Snapshot, error) {
+	s := New()
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, fmt.Errorf("snapshot: error decoding: %s", err.Error())
+	}
+	if s.Nodes == nil {
+		s.Nodes = map[string]json.RawMessage{}
+	}
+	if s.Objects == nil {
+		s.Objects = map[string]json.RawMessage{}
+	}
+	return s, nil
+}
+
+// Write encodes the Snapshot as JSON
+func (s *Snapshot) Write(w io.Writer) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// Add adds an object (e.g. an objects.NetworkHost) to the Snapshot.
+// The object must have its Reference and ObjectType set.
+func (s *Snapshot) Add(o interface{}) error {
+	byt, err := json.Marshal(o)
+	if err != nil {
+		return err
+	}
+	return s.AddRaw(byt)
+}
+
+// AddRaw adds a raw JSON object to the Snapshot
+func (s *Snapshot) AddRaw(raw json.RawMessage) error {
+	var h header
+	if err := json.Unmarshal(raw, &h); err != nil {
+		return fmt.Errorf("snapshot: error decoding object: %s", err.Error())
+	}
+	if !sophos.IsReference(h.Reference) {
+		return fmt.Errorf("snapshot: object has invalid reference %q", h.Reference)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Objects == nil {
+		s.Objects = map[string]json.RawMessage{}
+	}
+	s.Objects[h.Reference] = raw
+	if s.types != nil {
+		s.types[h.Reference] = h.Type
+	}
+	return nil
+}
+
+// Remove removes the object with the Reference from the Snapshot
+func (s *Snapshot) Remove(ref string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.Objects, ref)
+	if s.types != nil {
+		delete(s.types, ref)
+	}
+}
+
+// SetNode sets the value of the node (e.g. packetfilter.rules)
+func (s *Snapshot) SetNode(path string, val interface{}) error {
+	byt, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Nodes == nil {
+		s.Nodes = map[string]json.RawMessage{}
+	}
+	s.Nodes[path] = byt
+	return nil
+}
+
+// Node decodes the value of the node into val
+func (s *Snapshot) Node(path string, val interface{}) error {
+	raw, ok := s.RawNode(path)
+	if !ok {
+		return fmt.Errorf("%s: node %s", ErrNotFound, path)
+	}
+	return json.Unmarshal(raw, val)
+}
+
+// RawNode returns the JSON value of the node
+func (s *Snapshot) RawNode(path string) (json.RawMessage, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	raw, ok := s.Nodes[path]
+	return raw, ok
+}
+
+// HasNode returns true if the Snapshot contains the node
+func (s *Snapshot) HasNode(path string) bool {
+	_, ok := s.RawNode(path)
+	return ok
+}
+
+// Decode decodes the object with the Reference into o
+func (s *Snapshot) Decode(ref string, o interface{}) error {
+	s.mu.RLock()
+	raw, ok := s.Objects[ref]
+	s.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("%s: object %s", ErrNotFound, ref)
+	}
+	return json.Unmarshal(raw, o)
+}
+
+// Attributes returns the object with the Reference decoded as a map
+func (s *Snapshot) Attributes(ref string) (map[string]interface{}, error) {
+	var m map[string]interface{}
+	err := s.Decode(ref, &m)
+	return m, err
+}
+
+// Has returns true if the Snapshot contains an object with the Reference
+func (s *Snapshot) Has(ref string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.Objects[ref]
+	return ok
+}
+
+// Type returns the class/type (e.g. network/host) of the object with the Reference
+func (s *Snapshot) Type(ref string) string {
+	s.index()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.types[ref]
+}
+
+// Name returns the name of the object with the Reference
+func (s *Snapshot) Name(ref string) string {
+	var h header
+	_ = s.Decode(ref, &h)
+	return h.Name
+}
+
+// Locked returns the lock level (global, user or empty) of the object with the Reference
+func (s *Snapshot) Locked(ref string) string {
+	var h header
+	_ = s.Decode(ref, &h)
+	return h.Locked
+}
+
+// Path returns the REST path of the object with the Reference
+// e.g. /api/objects/network/host/REF_NetHosFoo
+func (s *Snapshot) Path(ref string) string {
+	return fmt.Sprintf("/api/objects/%s/%s", s.Type(ref), ref)
+}
+
+// References returns the sorted References of all objects matching any of the types.
+// Types can be a class/type (network/host) or a whole class (network/*).
+// When no types are provided all References are returned.
+func (s *Snapshot) References(types ...string) []string {
+	s.index()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var refs []string
+	for ref, t := range s.types {
+		if len(types) == 0 || MatchType(t, types...) {
+			refs = append(refs, ref)
+		}
+	}
+	sort.Strings(refs)
+	return refs
+}
+
+// MatchType returns true if objType (e.g. network/host) matches any of the patterns
+// which are either a class/type or a class wildcard (network/*)
+func MatchType(objType string, patterns ...string) bool {
+	for _, p := range patterns {
+		if p == objType || p == "*" {
+			return true
+		}
+		if strings.HasSuffix(p, "/*") && strings.HasPrefix(objType, strings.TrimSuffix(p, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Snapshot) index() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.types != nil {
+		return
+	}
+	s.types = make(map[string]string, len(s.Objects))
+	for ref, raw := range s.Objects {
+		var h header
+		_ = json.Unmarshal(raw, &h)
+		s.types[ref] = h.Type
+	}
+}
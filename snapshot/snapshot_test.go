@@ -0,0 +1,147 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+func setupTestCase(t *testing.T) (*sophos.Client, func()) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/status/version":
+			json.NewEncoder(w).Encode(sophos.Version{UTM: "9.6", Restd: "1.3.0"})
+		case "/api/nodes":
+			json.NewEncoder(w).Encode(map[string]interface{}{"packetfilter.rules": []string{"REF_PacPacAllow"}})
+		case "/api/objects/network/host/":
+			json.NewEncoder(w).Encode([]objects.NetworkHost{{Reference: "REF_NetHosWeb", ObjectType: "network/host", Name: "web", Address: "10.0.0.1"}})
+		case "/api/objects/network/network/":
+			json.NewEncoder(w).Encode([]objects.NetworkNetwork{{Reference: "REF_NetNetLan", ObjectType: "network/network", Name: "lan"}})
+		default:
+			json.NewEncoder(w).Encode([]interface{}{})
+		}
+	}))
+	sophos.DefaultHTTPClient = ts.Client()
+	client, err := sophos.New(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, ts.Close
+}
+
+func TestCapture(t *testing.T) {
+	client, td := setupTestCase(t)
+	defer td()
+
+	s, err := snapshot.Capture(client, &objects.Network{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Version.Restd != "1.3.0" {
+		t.Errorf("wanted restd 1.3.0, got %s", s.Version.Restd)
+	}
+
+	var rules []string
+	if err := s.Node("packetfilter.rules", &rules); err != nil || len(rules) != 1 {
+		t.Errorf("packetfilter.rules should have been captured: %v %v", rules, err)
+	}
+
+	if got := s.References("network/*"); !reflect.DeepEqual(got, []string{"REF_NetHosWeb", "REF_NetNetLan"}) {
+		t.Errorf("unexpected references: %v", got)
+	}
+	if got := s.References("network/host"); !reflect.DeepEqual(got, []string{"REF_NetHosWeb"}) {
+		t.Errorf("unexpected references: %v", got)
+	}
+
+	var host objects.NetworkHost
+	if err := s.Decode("REF_NetHosWeb", &host); err != nil || host.Address != "10.0.0.1" {
+		t.Errorf("could not decode host: %v", err)
+	}
+	if s.Path("REF_NetHosWeb") != "/api/objects/network/host/REF_NetHosWeb" {
+		t.Errorf("unexpected path %s", s.Path("REF_NetHosWeb"))
+	}
+	if err := s.Decode("REF_Missing", &host); err == nil {
+		t.Error("decoding a missing reference should error")
+	}
+}
+
+func TestReadWrite(t *testing.T) {
+	s := snapshot.New()
+	if err := s.Add(objects.ServiceTcp{Reference: "REF_SerTcpHttps", ObjectType: "service/tcp", Name: "HTTPS", DstLow: 443, DstHigh: 443}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Add(objects.ServiceTcp{Name: "no ref"}); err == nil {
+		t.Error("adding an object without reference should error")
+	}
+
+	var buf bytes.Buffer
+	if err := s.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	s2, err := snapshot.Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s2.Type("REF_SerTcpHttps") != "service/tcp" || s2.Name("REF_SerTcpHttps") != "HTTPS" {
+		t.Errorf("object was not restored: %v", s2.Objects)
+	}
+
+	s2.Remove("REF_SerTcpHttps")
+	if s2.Has("REF_SerTcpHttps") || len(s2.References()) != 0 {
+		t.Error("object should have been removed")
+	}
+}
+
+func TestMatchType(t *testing.T) {
+	tests := []struct {
+		objType  string
+		patterns []string
+		want     bool
+	}{
+		{"network/host", []string{"network/host"}, true},
+		{"network/host", []string{"network/*"}, true},
+		{"network/host", []string{"*"}, true},
+		{"network/host", []string{"service/*", "network/network"}, false},
+		{"networks/host", []string{"network/*"}, false},
+	}
+	for _, tt := range tests {
+		if got := snapshot.MatchType(tt.objType, tt.patterns...); got != tt.want {
+			t.Errorf("MatchType(%s, %v) = %v, want %v", tt.objType, tt.patterns, got, tt.want)
+		}
+	}
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	s := snapshot.New()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			ref := fmt.Sprintf("REF_NetHos%d", i)
+			s.Add(objects.NetworkHost{Reference: ref, ObjectType: "network/host", Name: ref})
+			s.SetNode(fmt.Sprintf("node.%d", i), i)
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			s.Has(fmt.Sprintf("REF_NetHos%d", i))
+			s.HasNode(fmt.Sprintf("node.%d", i))
+			s.Name(fmt.Sprintf("REF_NetHos%d", i))
+			s.References("network/*")
+		}(i)
+	}
+	wg.Wait()
+	if len(s.References("network/host")) != 4 || !s.HasNode("node.3") {
+		t.Errorf("unexpected snapshot %v", s.References())
+	}
+}
@@ -0,0 +1,105 @@
+package svcset
+
+import "strconv"
+
+var protocols = map[string]int{
+	"icmp":   ICMP,
+	"igmp":   2,
+	"tcp":    TCP,
+	"udp":    UDP,
+	"gre":    47,
+	"esp":    ESP,
+	"ah":     AH,
+	"icmpv6": ICMPv6,
+	"sctp":   132,
+}
+
+// ProtocolNumber returns the number of the protocol name (e.g. tcp) or number
+func ProtocolNumber(name string) (int, bool) {
+	if p, ok := protocols[name]; ok {
+		return p, true
+	}
+	p, err := strconv.Atoi(name)
+	return p, err == nil && p >= 0 && p <= maxProto
+}
+
+// ProtocolName returns the name of the protocol number, or the number if unknown
+func ProtocolName(proto int) string {
+	for name, p := range protocols {
+		if p == proto {
+			return name
+		}
+	}
+	return strconv.Itoa(proto)
+}
+
+// wellKnown are common services by their IANA service name
+var wellKnown = []struct {
+	name  string
+	proto int
+	port  int
+}{
+	{"ftp", TCP, 21},
+	{"ssh", TCP, 22},
+	{"telnet", TCP, 23},
+	{"smtp", TCP, 25},
+	{"domain", TCP, 53},
+	{"domain", UDP, 53},
+	{"bootps", UDP, 67},
+	{"bootpc", UDP, 68},
+	{"tftp", UDP, 69},
+	{"http", TCP, 80},
+	{"kerberos", TCP, 88},
+	{"kerberos", UDP, 88},
+	{"pop3", TCP, 110},
+	{"ntp", UDP, 123},
+	{"netbios-ns", UDP, 137},
+	{"imap", TCP, 143},
+	{"snmp", UDP, 161},
+	{"ldap", TCP, 389},
+	{"https", TCP, 443},
+	{"https", UDP, 443},
+	{"microsoft-ds", TCP, 445},
+	{"isakmp", UDP, 500},
+	{"syslog", UDP, 514},
+	{"submission", TCP, 587},
+	{"ldaps", TCP, 636},
+	{"imaps", TCP, 993},
+	{"pop3s", TCP, 995},
+	{"openvpn", UDP, 1194},
+	{"ms-sql-s", TCP, 1433},
+	{"l2tp", UDP, 1701},
+	{"pptp", TCP, 1723},
+	{"mysql", TCP, 3306},
+	{"ms-wbt-server", TCP, 3389},
+	{"ipsec-nat-t", UDP, 4500},
+	{"postgresql", TCP, 5432},
+	{"http-alt", TCP, 8080},
+}
+
+// aliases are common alternative names of well-known services
+var aliases = map[string]string{"dns": "domain", "rdp": "ms-wbt-server", "smb": "microsoft-ds", "dhcp": "bootps"}
+
+// Lookup returns the Services of the well-known service name, e.g. https or dns
+func Lookup(name string) ([]Service, bool) {
+	if a, ok := aliases[name]; ok {
+		name = a
+	}
+	var ss []Service
+	for _, w := range wellKnown {
+		if w.name == name {
+			ss = append(ss, Service{Proto: Range{w.proto, w.proto}, Dst: Range{w.port, w.port}, Src: anyPort})
+		}
+	}
+	return ss, len(ss) > 0
+}
+
+// WellKnown returns the IANA service name of the protocol and port, e.g. https for TCP 443
+func WellKnown(proto, port int) (string, bool) {
+	for _, w := range wellKnown {
+		if w.proto == proto && w.port == port {
+			return w.name, true
+		}
+	}
+	return "", false
+}
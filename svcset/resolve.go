@@ -0,0 +1,141 @@
+package svcset
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/snapshot"
+)
+
+// attributes is the subset of attributes describing service objects
+type attributes struct {
+	DstLow  int      `json:"dst_low"`
+	DstHigh int      `json:"dst_high"`
+	SrcLow  int      `json:"src_low"`
+	SrcHigh int      `json:"src_high"`
+	Type    int      `json:"type"`
+	Code    int      `json:"code"`
+	Proto   int      `json:"proto"`
+	Members []string `json:"members"`
+}
+
+// A Resolver resolves service References of a Snapshot into Sets. Results are cached,
+// a Resolver must not be used concurrently.
+type Resolver struct {
+	s     *snapshot.Snapshot
+	cache map[string]result
+}
+
+type result struct {
+	set   Set
+	exact bool
+	err   error
+}
+
+// NewResolver returns a Resolver of the Snapshot's service objects
+func NewResolver(s *snapshot.Snapshot) *Resolver {
+	return &Resolver{s: s, cache: map[string]result{}}
+}
+
+// Resolve returns the protocols and ports of the service object, groups are resolved recursively.
+// exact is false if the service type is unknown.
+func (r *Resolver) Resolve(ref string) (set Set, exact bool, err error) {
+	res := r.resolve(ref)
+	return res.set, res.exact, res.err
+}
+
+func (r *Resolver) resolve(ref string) result {
+	if res, ok := r.cache[ref]; ok {
+		return res
+	}
+	// mark as in progress to break reference cycles
+	r.cache[ref] = result{err: fmt.Errorf("svcset: reference cycle at %s", ref)}
+	res := r.resolveObject(ref)
+	r.cache[ref] = res
+	return res
+}
+
+func (r *Resolver) resolveObject(ref string) result {
+	if ref == sophos.RefServiceAny {
+		return result{set: Any(), exact: true}
+	}
+	objType := r.s.Type(ref)
+	if objType == "" {
+		return result{err: fmt.Errorf("%s: %s", snapshot.ErrNotFound, ref)}
+	}
+	var a attributes
+	if err := r.s.Decode(ref, &a); err != nil {
+		return result{err: err}
+	}
+
+	ports := func(lo, hi int) Range {
+		if lo == 0 && hi == 0 {
+			return anyPort
+		}
+		if hi < lo {
+			hi = lo
+		}
+		return Range{lo, hi}
+	}
+	icmp := func(v int) Range {
+		if v < 0 || v > 255 {
+			return Range{0, 255}
+		}
+		return Range{v, v}
+	}
+	single := func(proto int, dst, src Range) Set {
+		return New(Service{Proto: Range{proto, proto}, Dst: normalizePorts(dst), Src: normalizePorts(src)})
+	}
+
+	res := result{exact: true}
+	switch objType {
+	case "service/any":
+		res.set = Any()
+	case "service/tcp":
+		res.set = single(TCP, ports(a.DstLow, a.DstHigh), ports(a.SrcLow, a.SrcHigh))
+	case "service/udp":
+		res.set = single(UDP, ports(a.DstLow, a.DstHigh), ports(a.SrcLow, a.SrcHigh))
+	case "service/tcpudp":
+		res.set = single(TCP, ports(a.DstLow, a.DstHigh), ports(a.SrcLow, a.SrcHigh)).
+			Union(single(UDP, ports(a.DstLow, a.DstHigh), ports(a.SrcLow, a.SrcHigh)))
+	case "service/icmp":
+		res.set = single(ICMP, icmp(a.Type), icmp(a.Code))
+	case "service/icmpv6":
+		res.set = single(ICMPv6, icmp(a.Type), icmp(a.Code))
+	case "service/ip":
+		res.set = single(a.Proto, anyPort, anyPort)
+	case "service/esp":
+		res.set = single(ESP, anyPort, anyPort)
+	case "service/ah":
+		res.set = single(AH, anyPort, anyPort)
+	case "service/group":
+		for _, m := range a.Members {
+			mr := r.resolve(m)
+			if mr.err != nil {
+				return mr
+			}
+			res.set = res.set.Union(mr.set)
+			res.exact = res.exact && mr.exact
+		}
+	default:
+		if !snapshot.MatchType(objType, "service/*") {
+			return result{err: fmt.Errorf("svcset: %s is not a service object (%s)", ref, objType)}
+		}
+		res.exact = false
+	}
+	return res
+}
+
+// Matching returns the sorted References of the service objects containing the destination port
+// (or ICMP type) of the protocol. Objects which can not be resolved are skipped.
+func (r *Resolver) Matching(proto, port int) []string {
+	var refs []string
+	for _, ref := range r.s.References("service/*") {
+		if res := r.resolve(ref); res.err == nil && res.set.Match(proto, port) {
+			refs = append(refs, ref)
+		}
+	}
+	sort.Strings(refs)
+	return refs
+}
@@ -0,0 +1,386 @@
+// Package svcset resolves service objects into normalized protocol and port range sets
+package svcset
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// IP protocol numbers
+const (
+	ICMP   = 1
+	TCP    = 6
+	UDP    = 17
+	ESP    = 50
+	AH     = 51
+	ICMPv6 = 58
+)
+
+const (
+	maxProto = 255
+	maxPort  = 65535
+)
+
+// A Range is an inclusive range of protocol numbers or ports
+type Range struct {
+	Low  int `json:"low"`
+	High int `json:"high"`
+}
+
+var (
+	anyProto = Range{0, maxProto}
+	anyPort  = Range{0, maxPort}
+)
+
+func (r Range) overlaps(o Range) bool { return r.Low <= o.High && o.Low <= r.High }
+
+func (r Range) contains(o Range) bool { return r.Low <= o.Low && o.High <= r.High }
+
+func (r Range) String() string {
+	if r.Low == r.High {
+		return strconv.Itoa(r.Low)
+	}
+	return strconv.Itoa(r.Low) + "-" + strconv.Itoa(r.High)
+}
+
+// A Service is a range of protocols with destination and source port ranges.
+// For ICMP and ICMPv6 the destination is the type and the source the code.
+type Service struct {
+	Proto Range `json:"proto"`
+	Dst   Range `json:"dst"`
+	Src   Range `json:"src"`
+}
+
+// dims returns the ranges of the Service
+func (s Service) dims() [3]Range { return [3]Range{s.Proto, s.Dst, s.Src} }
+
+func service(d [3]Range) Service { return Service{Proto: d[0], Dst: d[1], Src: d[2]} }
+
+// NewService returns the Service of the protocol and ports. Port ranges covering 1-65535 match any
+// port, as UTM uses 1-65535 for any source port.
+func NewService(proto int, dst, src Range) (Service, error) {
+	s := Service{Proto: Range{proto, proto}, Dst: normalizePorts(dst), Src: normalizePorts(src)}
+	if proto < 0 || proto > maxProto {
+		return s, fmt.Errorf("svcset: invalid protocol %d", proto)
+	}
+	for _, r := range []Range{s.Dst, s.Src} {
+		if r.Low < 0 || r.High > maxPort || r.Low > r.High {
+			return s, fmt.Errorf("svcset: invalid port range %s", r)
+		}
+	}
+	return s, nil
+}
+
+func normalizePorts(r Range) Range {
+	if r.Low <= 1 && r.High == maxPort {
+		return anyPort
+	}
+	return r
+}
+
+// String returns the IANA-style notation of the Service, e.g. tcp/443, udp/1000-2000 or icmp/8.
+// Restricted source ports follow the destination ports, e.g. tcp/443:1024-65535.
+func (s Service) String() string {
+	if s.Proto == anyProto {
+		if s.Dst == anyPort && s.Src == anyPort {
+			return "any"
+		}
+		return "any/" + s.ports()
+	}
+	if s.Proto.Low != s.Proto.High {
+		return "ip/" + s.Proto.String()
+	}
+	name := ProtocolName(s.Proto.Low)
+	if s.Dst == anyPort && s.Src == anyPort {
+		return name
+	}
+	return name + "/" + s.ports()
+}
+
+func (s Service) ports() string {
+	dst := s.Dst.String()
+	if s.Dst == anyPort {
+		dst = "any"
+	}
+	if s.Src == anyPort {
+		return dst
+	}
+	return dst + ":" + s.Src.String()
+}
+
+// A Set is a normalized set of Services. The zero value is the empty set.
+// Sets are immutable, all operations return new Sets.
+type Set struct {
+	// disjoint services sorted by protocol, destination and source
+	services []Service
+}
+
+// Any contains all protocols and ports
+func Any() Set { return Set{services: []Service{{anyProto, anyPort, anyPort}}} }
+
+// New returns the Set of the Services
+func New(ss ...Service) Set {
+	var r Set
+	for _, s := range ss {
+		r = r.Union(Set{services: []Service{s}})
+	}
+	return r
+}
+
+// Parse returns the Set of the IANA-style services, e.g. tcp/443, udp/1000-2000, tcp/443:1024-65535,
+// icmp/8, esp, 47, any or well-known names like https.
+func Parse(ss ...string) (Set, error) {
+	var svcs []Service
+	for _, v := range ss {
+		s, err := parse(strings.ToLower(strings.TrimSpace(v)))
+		if err != nil {
+			return Set{}, err
+		}
+		svcs = append(svcs, s...)
+	}
+	return New(svcs...), nil
+}
+
+// MustParse is like Parse but panics on errors
+func MustParse(ss ...string) Set {
+	s, err := Parse(ss...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func parse(v string) ([]Service, error) {
+	if ss, ok := Lookup(v); ok {
+		return ss, nil
+	}
+	parts := strings.SplitN(v, "/", 2)
+	proto := anyProto
+	switch parts[0] {
+	case "any", "ip":
+	default:
+		p, ok := ProtocolNumber(parts[0])
+		if !ok {
+			return nil, fmt.Errorf("svcset: unknown protocol %q", parts[0])
+		}
+		proto = Range{p, p}
+	}
+	s := Service{Proto: proto, Dst: anyPort, Src: anyPort}
+	if len(parts) == 1 {
+		return []Service{s}, nil
+	}
+	if parts[0] == "ip" {
+		r, err := parseRange(parts[1], maxProto)
+		if err != nil {
+			return nil, err
+		}
+		s.Proto = r
+		return []Service{s}, nil
+	}
+
+	ports := strings.SplitN(parts[1], ":", 2)
+	for i, p := range ports {
+		r, err := parseRange(p, maxPort)
+		if err != nil {
+			return nil, fmt.Errorf("svcset: invalid service %q: %s", v, err.Error())
+		}
+		if i == 0 {
+			s.Dst = normalizePorts(r)
+		} else {
+			s.Src = normalizePorts(r)
+		}
+	}
+	return []Service{s}, nil
+}
+
+func parseRange(v string, max int) (Range, error) {
+	if v == "any" || v == "" {
+		return Range{0, max}, nil
+	}
+	bounds := strings.SplitN(v, "-", 2)
+	var r Range
+	var err error
+	if r.Low, err = strconv.Atoi(bounds[0]); err != nil {
+		return r, err
+	}
+	r.High = r.Low
+	if len(bounds) == 2 {
+		if r.High, err = strconv.Atoi(bounds[1]); err != nil {
+			return r, err
+		}
+	}
+	if r.Low < 0 || r.High > max || r.Low > r.High {
+		return r, fmt.Errorf("range %s out of bounds", v)
+	}
+	return r, nil
+}
+
+// subtract returns the disjoint services covering s without o
+func (s Service) subtract(o Service) []Service {
+	a, b := s.dims(), o.dims()
+	for d := range a {
+		if !a[d].overlaps(b[d]) {
+			return []Service{s}
+		}
+	}
+	var out []Service
+	for d := range a {
+		if a[d].Low < b[d].Low {
+			part := a
+			part[d].High = b[d].Low - 1
+			out = append(out, service(part))
+			a[d].Low = b[d].Low
+		}
+		if a[d].High > b[d].High {
+			part := a
+			part[d].Low = b[d].High + 1
+			out = append(out, service(part))
+			a[d].High = b[d].High
+		}
+	}
+	return out
+}
+
+func (s Service) intersect(o Service) (Service, bool) {
+	a, b := s.dims(), o.dims()
+	for d := range a {
+		if !a[d].overlaps(b[d]) {
+			return Service{}, false
+		}
+		if b[d].Low > a[d].Low {
+			a[d].Low = b[d].Low
+		}
+		if b[d].High < a[d].High {
+			a[d].High = b[d].High
+		}
+	}
+	return service(a), true
+}
+
+// normalize merges adjacent services and sorts them
+func normalize(ss []Service) []Service {
+	for merged := true; merged; {
+		merged = false
+	outer:
+		for i := range ss {
+			for j := i + 1; j < len(ss); j++ {
+				if m, ok := merge(ss[i], ss[j]); ok {
+					ss[i] = m
+					ss = append(ss[:j], ss[j+1:]...)
+					merged = true
+					break outer
+				}
+			}
+		}
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		a, b := ss[i].dims(), ss[j].dims()
+		for d := range a {
+			if a[d].Low != b[d].Low {
+				return a[d].Low < b[d].Low
+			}
+		}
+		return false
+	})
+	if len(ss) == 0 {
+		return nil
+	}
+	return ss
+}
+
+// merge returns the union of two disjoint services if it is a single service
+func merge(s, o Service) (Service, bool) {
+	a, b := s.dims(), o.dims()
+	diff := -1
+	for d := range a {
+		if a[d] == b[d] {
+			continue
+		}
+		if diff >= 0 {
+			return Service{}, false
+		}
+		diff = d
+	}
+	if diff < 0 {
+		return s, true
+	}
+	if a[diff].High+1 == b[diff].Low {
+		a[diff].High = b[diff].High
+		return service(a), true
+	}
+	if b[diff].High+1 == a[diff].Low {
+		a[diff].Low = b[diff].Low
+		return service(a), true
+	}
+	return Service{}, false
+}
+
+// Union returns the Set containing the services of s and t
+func (s Set) Union(t Set) Set {
+	rest := t.Subtract(s)
+	all := make([]Service, 0, len(s.services)+len(rest.services))
+	all = append(append(all, s.services...), rest.services...)
+	return Set{services: normalize(all)}
+}
+
+// Intersect returns the Set containing the services in both s and t
+func (s Set) Intersect(t Set) Set {
+	var out []Service
+	for _, a := range s.services {
+		for _, b := range t.services {
+			if i, ok := a.intersect(b); ok {
+				out = append(out, i)
+			}
+		}
+	}
+	return Set{services: normalize(out)}
+}
+
+// Subtract returns the Set containing the services of s which are not in t
+func (s Set) Subtract(t Set) Set {
+	r := append([]Service(nil), s.services...)
+	for _, b := range t.services {
+		var next []Service
+		for _, a := range r {
+			next = append(next, a.subtract(b)...)
+		}
+		r = next
+	}
+	return Set{services: normalize(r)}
+}
+
+// IsEmpty returns true if the Set contains no service
+func (s Set) IsEmpty() bool { return len(s.services) == 0 }
+
+// Contains returns true if all services of t are in s
+func (s Set) Contains(t Set) bool { return t.Subtract(s).IsEmpty() }
+
+// Overlaps returns true if s and t have any protocol and port in common
+func (s Set) Overlaps(t Set) bool { return !s.Intersect(t).IsEmpty() }
+
+// Equal returns true if both Sets contain the same services
+func (s Set) Equal(t Set) bool { return s.Contains(t) && t.Contains(s) }
+
+// Match returns true if the Set contains the destination port (or ICMP type) of the protocol for any source port
+func (s Set) Match(proto, port int) bool {
+	for _, v := range s.services {
+		if v.Proto.contains(Range{proto, proto}) && v.Dst.contains(Range{port, port}) {
+			return true
+		}
+	}
+	return false
+}
+
+// Services returns the normalized services of s
+func (s Set) Services() []Service { return append([]Service(nil), s.services...) }
+
+// String returns the comma separated IANA-style notation of s
+func (s Set) String() string {
+	ss := make([]string, len(s.services))
+	for i, v := range s.services {
+		ss[i] = v.String()
+	}
+	return strings.Join(ss, ", ")
+}
@@ -0,0 +1,96 @@
+package svcset_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+	"github.com/esurdam/go-sophos/snapshot"
+	"github.com/esurdam/go-sophos/svcset"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"tcp/443", "tcp/443"},
+		{"udp/1000-2000", "udp/1000-2000"},
+		{"tcp/443:1024-2048", "tcp/443:1024-2048"},
+		{"tcp/443:1-65535", "tcp/443"},
+		{"icmp/8", "icmp/8"},
+		{"esp", "esp"},
+		{"47", "gre"},
+		{"https", "tcp/443, udp/443"},
+		{"any", "any"},
+	}
+	for _, tt := range tests {
+		s, err := svcset.Parse(tt.in)
+		if err != nil {
+			t.Errorf("%s: %s", tt.in, err)
+			continue
+		}
+		if s.String() != tt.want {
+			t.Errorf("%s: wanted %s, got %s", tt.in, tt.want, s)
+		}
+	}
+
+	for _, in := range []string{"foo/1", "tcp/70000", "tcp/20-10"} {
+		if _, err := svcset.Parse(in); err == nil {
+			t.Errorf("%s should fail", in)
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	web := svcset.MustParse("tcp/80", "tcp/443", "tcp/81-442")
+	if web.String() != "tcp/80-443" {
+		t.Errorf("adjacent ranges should have been merged: %s", web)
+	}
+	if web.Contains(svcset.MustParse("https")) {
+		t.Error("tcp/80-443 should not contain udp/443")
+	}
+	if !web.Contains(svcset.MustParse("http")) || !web.Overlaps(svcset.MustParse("https")) {
+		t.Error("tcp/80-443 should contain http and overlap https")
+	}
+	if got := web.Subtract(svcset.MustParse("tcp/100-200")).String(); got != "tcp/80-99, tcp/201-443" {
+		t.Errorf("unexpected difference %s", got)
+	}
+	if got := web.Intersect(svcset.MustParse("tcp/400-500", "udp/443")).String(); got != "tcp/400-443" {
+		t.Errorf("unexpected intersection %s", got)
+	}
+	if !svcset.Any().Contains(web) || !web.Match(svcset.TCP, 443) || web.Match(svcset.UDP, 443) {
+		t.Error("unexpected containment")
+	}
+	if name, ok := svcset.WellKnown(svcset.TCP, 22); !ok || name != "ssh" {
+		t.Errorf("wanted ssh, got %s", name)
+	}
+}
+
+func TestResolver(t *testing.T) {
+	s := snapshot.New()
+	for _, o := range []interface{}{
+		objects.ServiceTcp{Reference: "REF_SerTcpHttps", ObjectType: "service/tcp", Name: "HTTPS", DstLow: 443, DstHigh: 443, SrcLow: 1, SrcHigh: 65535},
+		objects.ServiceTcpudp{Reference: "REF_SerTcpDns", ObjectType: "service/tcpudp", Name: "DNS", DstLow: 53, DstHigh: 53, SrcLow: 1, SrcHigh: 65535},
+		objects.ServiceGroup{Reference: "REF_SerGroWeb", ObjectType: "service/group", Name: "Web", Members: []string{"REF_SerTcpHttps", "REF_SerTcpDns"}},
+		objects.ServiceGroup{Reference: "REF_SerGroLoop", ObjectType: "service/group", Name: "Loop", Members: []string{"REF_SerGroLoop"}},
+	} {
+		if err := s.Add(o); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := svcset.NewResolver(s)
+	set, exact, err := r.Resolve("REF_SerGroWeb")
+	if err != nil || !exact {
+		t.Fatal(err, exact)
+	}
+	if set.String() != "tcp/53, tcp/443, udp/53" {
+		t.Errorf("unexpected group %s", set)
+	}
+	if _, _, err := r.Resolve("REF_SerGroLoop"); err == nil {
+		t.Error("reference cycles should fail")
+	}
+	if got := r.Matching(svcset.TCP, 443); !reflect.DeepEqual(got, []string{"REF_SerGroWeb", "REF_SerTcpHttps"}) {
+		t.Errorf("unexpected matches %v", got)
+	}
+}
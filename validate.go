@@ -0,0 +1,200 @@
+package sophos
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// A Format is a syntax of string attributes as declared by the swagger descriptions, e.g. (IPADDR)
+type Format string
+
+// Formats checked by Validation
+const (
+	FormatIPv4     Format = "IPADDR"
+	FormatIPv6     Format = "IP6ADDR"
+	FormatMAC      Format = "MACADDR"
+	FormatHostname Format = "HOSTNAME"
+)
+
+// ParseFormat returns the Format declared by the description, e.g. "(IPADDR)", or false
+func ParseFormat(description string) (Format, bool) {
+	switch f := Format(strings.Trim(description, "()")); f {
+	case FormatIPv4, FormatIPv6, FormatMAC, FormatHostname:
+		return f, true
+	}
+	return "", false
+}
+
+// A FieldError is an invalid attribute of an object, Field is the JSON path of the attribute,
+// e.g. macs[1]
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// Error implements error
+func (e FieldError) Error() string { return e.Field + ": " + e.Message }
+
+// A ValidationError contains all FieldErrors of an invalid object
+type ValidationError struct {
+	Object string
+	Errors []FieldError
+}
+
+// Error implements error
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		msgs[i] = fe.Error()
+	}
+	return fmt.Sprintf("validate: invalid %s: %s", e.Object, strings.Join(msgs, "; "))
+}
+
+// Validation collects the FieldErrors of an object, it is used by the generated Validate methods
+type Validation struct {
+	object string
+	typeOf func(ref string) string
+	errs   []FieldError
+}
+
+// NewValidation returns a Validation of the object. If typeOf is not nil it returns the type of a
+// Reference (e.g. network/host) which is checked against the REF(...) constraints of the attributes.
+func NewValidation(object string, typeOf func(ref string) string) *Validation {
+	return &Validation{object: object, typeOf: typeOf}
+}
+
+// Errorf adds a FieldError of the field
+func (v *Validation) Errorf(field, format string, a ...interface{}) {
+	v.errs = append(v.errs, FieldError{Field: field, Message: fmt.Sprintf(format, a...)})
+}
+
+// Err returns a *ValidationError if any field is invalid
+func (v *Validation) Err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Object: v.object, Errors: v.errs}
+}
+
+// Required checks that the mandatory attribute is set
+func (v *Validation) Required(field, val string) {
+	if val == "" {
+		v.Errorf(field, "is required")
+	}
+}
+
+// NotEmpty checks that the mandatory array or hash attribute of length n has values
+func (v *Validation) NotEmpty(field string, n int) {
+	if n == 0 {
+		v.Errorf(field, "is required")
+	}
+}
+
+// Enum checks that val is a known value of the enum
+func (v *Validation) Enum(field, val string, valid bool) {
+	if !valid {
+		v.Errorf(field, "unknown value %q", val)
+	}
+}
+
+// Range checks that val is within the constraints of the swagger description, e.g. "0, 1-65535"
+func (v *Validation) Range(field string, val int64, constraints string) {
+	for _, c := range strings.Split(constraints, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(c), "-", 2)
+		min, err := strconv.ParseInt(bounds[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		max := min
+		if len(bounds) == 2 {
+			if max, err = strconv.ParseInt(bounds[1], 10, 64); err != nil {
+				continue
+			}
+		}
+		if val >= min && val <= max {
+			return
+		}
+	}
+	v.Errorf(field, "%d is not within %s", val, constraints)
+}
+
+// Between checks that val is within the minimum and maximum of the swagger schema
+func (v *Validation) Between(field string, val, min, max int64) {
+	if val < min || val > max {
+		v.Errorf(field, "%d is not within %d-%d", val, min, max)
+	}
+}
+
+// Order checks that the low value of a range (e.g. dst_low) is not greater than its high value
+func (v *Validation) Order(lowField string, low int64, highField string, high int64) {
+	if low > high {
+		v.Errorf(lowField, "%d is greater than %s %d", low, highField, high)
+	}
+}
+
+var (
+	macRegexp      = regexp.MustCompile(`^([0-9a-fA-F]{2}:){5}[0-9a-fA-F]{2}$`)
+	hostnameRegexp = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.?$`)
+)
+
+// Format checks the syntax of the attribute, empty values are valid
+func (v *Validation) Format(field string, f Format, val string) {
+	if val == "" {
+		return
+	}
+	var ok bool
+	switch f {
+	case FormatIPv4:
+		ip := net.ParseIP(val)
+		ok = ip != nil && ip.To4() != nil && !strings.Contains(val, ":")
+	case FormatIPv6:
+		ip := net.ParseIP(val)
+		ok = ip != nil && strings.Contains(val, ":")
+	case FormatMAC:
+		ok = macRegexp.MatchString(val)
+	case FormatHostname:
+		ok = len(val) <= 255 && hostnameRegexp.MatchString(val)
+	default:
+		ok = true
+	}
+	if !ok {
+		v.Errorf(field, "%q is not a valid %s", val, f)
+	}
+}
+
+// Formats checks the syntax of every item of the attribute
+func (v *Validation) Formats(field string, f Format, vals []string) {
+	for i, val := range vals {
+		v.Format(fmt.Sprintf("%s[%d]", field, i), f, val)
+	}
+}
+
+// Ref checks that val is a Reference and, if the Validation has a typeOf func, that the type of
+// the referenced object satisfies the constraints of the swagger description, e.g. "REF(network/*)".
+// Empty values are valid, see Required.
+func (v *Validation) Ref(field, val, constraints string) {
+	if val == "" {
+		return
+	}
+	if !IsReference(val) {
+		v.Errorf(field, "%q is not a reference", val)
+		return
+	}
+	if v.typeOf == nil {
+		return
+	}
+	typ := v.typeOf(val)
+	if rr := ParseRefConstraints(constraints); typ != "" && len(rr) > 0 && !AllowsAny(rr, typ) {
+		v.Errorf(field, "%s of type %s does not match %s", val, typ, constraints)
+	}
+}
+
+// Refs checks every Reference of the attribute, see Ref
+func (v *Validation) Refs(field string, vals []string, constraints string) {
+	for i, val := range vals {
+		v.Ref(fmt.Sprintf("%s[%d]", field, i), val, constraints)
+	}
+}
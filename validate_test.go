@@ -0,0 +1,149 @@
+package sophos_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/esurdam/go-sophos"
+	"github.com/esurdam/go-sophos/api/v1.3.0/objects"
+)
+
+func TestValidate(t *testing.T) {
+	d := objects.NewDhcpServer6()
+	d.Name = "lan6"
+	d.Address = "REF_ItfPriLan"
+	d.Interface = "eth1"
+	d.Dns1 = "2001:db8::53"
+	d.Dns2 = "10.0.0.53"
+	d.Mtu = 1000
+	err := d.Validate()
+	e, ok := err.(*sophos.ValidationError)
+	if !ok {
+		t.Fatalf("wanted ValidationError, got %v", err)
+	}
+	want := []sophos.FieldError{
+		{Field: "dns2", Message: `"10.0.0.53" is not a valid IP6ADDR`},
+		{Field: "interface", Message: `"eth1" is not a reference`},
+		{Field: "mtu", Message: "1000 is not within 0, 1280-9000"},
+	}
+	if e.Object != "DhcpServer6" || !reflect.DeepEqual(e.Errors, want) {
+		t.Errorf("unexpected errors %s", e)
+	}
+
+	d.Interface, d.Dns2, d.Mtu = "REF_IntEthLan", "", 1500
+	if err := d.Validate(); err != nil {
+		t.Error(err)
+	}
+	types := map[string]string{"REF_ItfPriLan": "itfparams/primary", "REF_IntEthLan": "interface/ppp"}
+	err = d.ValidateWith(func(ref string) string { return types[ref] })
+	if err == nil || err.Error() != "validate: invalid DhcpServer6: interface: REF_IntEthLan of type interface/ppp does not match "+
+		"REF(interface/ethernet), REF(interface/vlan), REF(interface/bridge)" {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	n := objects.NewPacketfilter1to1Nat()
+	n.Mode = "mapall"
+	err = n.Validate()
+	if e, ok := err.(*sophos.ValidationError); !ok || len(e.Errors) != 6 || e.Errors[2] != (sophos.FieldError{Field: "mode", Message: `unknown value "mapall"`}) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	s := objects.NewServiceTcp()
+	s.Name, s.DstLow, s.DstHigh, s.SrcLow, s.SrcHigh = "web", 443, 80, 1, 70000
+	err = s.Validate()
+	want = []sophos.FieldError{
+		{Field: "dst_low", Message: "443 is greater than dst_high 80"},
+		{Field: "src_high", Message: "70000 is not within 0-65535"},
+	}
+	if e, ok := err.(*sophos.ValidationError); !ok || !reflect.DeepEqual(e.Errors, want) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	h := objects.NewNetworkHost()
+	h.Name, h.Address, h.Interface, h.Macs = "gw", "10.0.0.256", "eth0", []string{"00:1a:2b:3c:4d:5e"}
+	err = h.Validate()
+	want = []sophos.FieldError{
+		{Field: "address", Message: `"10.0.0.256" is not a valid IPADDR`},
+		{Field: "interface", Message: `"eth0" is not a reference`},
+	}
+	if e, ok := err.(*sophos.ValidationError); !ok || !reflect.DeepEqual(e.Errors, want) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	// optional References may be empty
+	b := objects.NewBgpNeighbor()
+	b.Name, b.Host = "peer", "REF_NetHosPeer"
+	if err := b.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	nat := objects.NewPacketfilterNat()
+	nat.Name, nat.Mode, nat.Source = "web", objects.PacketfilterNatModeDnat, "REF_NetworkAny"
+	err = nat.Validate()
+	want = []sophos.FieldError{
+		{Field: "destination", Message: "is required"},
+		{Field: "service", Message: "is required"},
+	}
+	if e, ok := err.(*sophos.ValidationError); !ok || !reflect.DeepEqual(e.Errors, want) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	pf := objects.NewPacketfilterPacketfilter()
+	pf.Name, pf.Action, pf.Sources, pf.Services = "web", objects.PacketfilterPacketfilterActionAccept, []string{"REF_NetworkAny"}, []string{"REF_ServiceHTTP"}
+	err = pf.Validate()
+	if e, ok := err.(*sophos.ValidationError); !ok || !reflect.DeepEqual(e.Errors, []sophos.FieldError{{Field: "destinations", Message: "is required"}}) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	nw := objects.NewNetworkNetwork()
+	nw.Name, nw.Address, nw.Netmask, nw.Netmask6 = "lan", "10.0.0.0", 33, 64
+	err = nw.Validate()
+	if e, ok := err.(*sophos.ValidationError); !ok || !reflect.DeepEqual(e.Errors, []sophos.FieldError{{Field: "netmask", Message: "33 is not within 0-32"}}) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestValidation_Format(t *testing.T) {
+	tests := []struct {
+		f     sophos.Format
+		value string
+		valid bool
+	}{
+		{sophos.FormatIPv4, "192.168.0.1", true},
+		{sophos.FormatIPv4, "192.168.0.256", false},
+		{sophos.FormatIPv4, "::ffff:192.168.0.1", false},
+		{sophos.FormatIPv6, "fe80::1", true},
+		{sophos.FormatIPv6, "fe80::1::", false},
+		{sophos.FormatMAC, "00:1a:2B:3c:4d:5e", true},
+		{sophos.FormatMAC, "00-1a-2b-3c-4d-5e", false},
+		{sophos.FormatHostname, "utm.example.com", true},
+		{sophos.FormatHostname, "-utm.example.com", false},
+	}
+	for _, tt := range tests {
+		v := sophos.NewValidation("Test", nil)
+		v.Formats("values", tt.f, []string{"", tt.value})
+		if err := v.Err(); (err == nil) != tt.valid {
+			t.Errorf("%s %q: got %v, want valid %v", tt.f, tt.value, err, tt.valid)
+		}
+	}
+
+	v := sophos.NewValidation("Test", nil)
+	for _, port := range []int64{0, 443, 65535, 65536, -1} {
+		v.Range("port", port, "0-65535")
+	}
+	if e, ok := v.Err().(*sophos.ValidationError); !ok || len(e.Errors) != 2 {
+		t.Errorf("unexpected port errors %v", v.Err())
+	}
+
+	v = sophos.NewValidation("Test", nil)
+	v.Between("timeout", 30, 1, 60)
+	v.Between("timeout", 61, 1, 60)
+	v.Order("dst_low", 80, "dst_high", 80)
+	v.Order("dst_low", 443, "dst_high", 80)
+	if e, ok := v.Err().(*sophos.ValidationError); !ok || len(e.Errors) != 2 || e.Errors[0].Field != "timeout" || e.Errors[1].Field != "dst_low" {
+		t.Errorf("unexpected range errors %v", v.Err())
+	}
+	if f, ok := sophos.ParseFormat("(MACADDR)"); !ok || f != sophos.FormatMAC {
+		t.Errorf("unexpected format %s", f)
+	}
+}